@@ -21,11 +21,14 @@ val services = mapOf(
     "athena" to ServiceSpec("Athena"),
     "autoscaling" to ServiceSpec("Auto Scaling", vpcLock = true),
     "autoscalingplans" to ServiceSpec("Auto Scaling Plans"),
+    "b2bi" to ServiceSpec("B2B Data Interchange"),
     "backup" to ServiceSpec("Backup"),
     "batch" to ServiceSpec("Batch", vpcLock = true),
     "budgets" to ServiceSpec("Web Services Budgets"),
     "ce" to ServiceSpec("CE (Cost Explorer)"),
     "chime" to ServiceSpec("Chime"),
+    "cleanrooms" to ServiceSpec("Clean Rooms"),
+    "cleanroomsml" to ServiceSpec("Clean Rooms ML"),
     "cloud9" to ServiceSpec("Cloud9"),
     "cloudcontrol" to ServiceSpec("Cloud Control API"),
     "cloudformation" to ServiceSpec("CloudFormation", vpcLock = true),
@@ -48,6 +51,7 @@ val services = mapOf(
     "dataexchange" to ServiceSpec("Data Exchange"),
     "datapipeline" to ServiceSpec("Data Pipeline"),
     "datasync" to ServiceSpec("DataSync", vpcLock = true),
+    "datazone" to ServiceSpec("DataZone"),
     "dax" to ServiceSpec("DynamoDB Accelerator (DAX)"),
     "deploy" to ServiceSpec("CodeDeploy"),
     "detective" to ServiceSpec("Detective"),
@@ -73,6 +77,7 @@ val services = mapOf(
     "emr" to ServiceSpec("EMR", vpcLock = true),
     "emrcontainers" to ServiceSpec("EMR Containers"),
     "emrserverless" to ServiceSpec("EMR Serverless"),
+    "entityresolution" to ServiceSpec("Entity Resolution"),
     "events" to ServiceSpec("EventBridge"),
     "firehose" to ServiceSpec("Kinesis Firehose"),
     "fis" to ServiceSpec("FIS (Fault Injection Simulator)"),
@@ -92,6 +97,8 @@ val services = mapOf(
     "iot" to ServiceSpec("IoT Core"),
     "iotanalytics" to ServiceSpec("IoT Analytics"),
     "iotevents" to ServiceSpec("IoT Events"),
+    "ivs" to ServiceSpec("IVS (Interactive Video)"),
+    "ivschat" to ServiceSpec("IVS (Interactive Video) Chat"),
     "kafka" to ServiceSpec("Managed Streaming for Kafka", vpcLock = true),
     "kafkaconnect" to ServiceSpec("Managed Streaming for Kafka Connect"),
     "kendra" to ServiceSpec("Kendra"),
@@ -108,12 +115,12 @@ val services = mapOf(
     "lightsail" to ServiceSpec("Lightsail"),
     "location" to ServiceSpec("Location"),
     "logs" to ServiceSpec("CloudWatch Logs"),
-    "macie" to ServiceSpec("Macie Classic"),
     "macie2" to ServiceSpec("Macie"),
     "mediaconnect" to ServiceSpec("Elemental MediaConnect"),
     "mediaconvert" to ServiceSpec("Elemental MediaConvert"),
     "medialive" to ServiceSpec("Elemental MediaLive"),
     "mediapackage" to ServiceSpec("Elemental MediaPackage"),
+    "mediapackagev2" to ServiceSpec("Elemental MediaPackage Version 2"),
     "mediastore" to ServiceSpec("Elemental MediaStore"),
     "memorydb" to ServiceSpec("MemoryDB for Redis"),
     "mq" to ServiceSpec("MQ", vpcLock = true),
@@ -125,6 +132,7 @@ val services = mapOf(
     "opsworks" to ServiceSpec("OpsWorks", vpcLock = true),
     "organizations" to ServiceSpec("Organizations"),
     "outposts" to ServiceSpec("Outposts"),
+    "paymentcryptography" to ServiceSpec("Payment Cryptography"),
     "pinpoint" to ServiceSpec("Pinpoint"),
     "pricing" to ServiceSpec("Pricing Calculator"),
     "qldb" to ServiceSpec("QLDB (Quantum Ledger Database)"),
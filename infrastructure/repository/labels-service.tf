@@ -5,7 +5,6 @@ variable "service_labels" {
     "account",
     "acm",
     "acmpca",
-    "alexaforbusiness",
     "amp",
     "amplify",
     "amplifybackend",
@@ -20,6 +19,7 @@ variable "service_labels" {
     "appintegrations",
     "applicationcostprofiler",
     "applicationinsights",
+    "applicationsignals",
     "appmesh",
     "apprunner",
     "appstream",
@@ -28,6 +28,7 @@ variable "service_labels" {
     "auditmanager",
     "autoscaling",
     "autoscalingplans",
+    "b2bi",
     "backup",
     "backupgateway",
     "batch",
@@ -35,10 +36,13 @@ variable "service_labels" {
     "braket",
     "budgets",
     "ce",
+    "chatbot",
     "chime",
     "chimesdkidentity",
     "chimesdkmeetings",
     "chimesdkmessaging",
+    "cleanrooms",
+    "cleanroomsml",
     "cloud9",
     "cloudcontrol",
     "clouddirectory",
@@ -68,12 +72,14 @@ variable "service_labels" {
     "connect",
     "connectcontactlens",
     "connectparticipant",
+    "controltower",
     "cur",
     "customerprofiles",
     "databrew",
     "dataexchange",
     "datapipeline",
     "datasync",
+    "datazone",
     "dax",
     "deploy",
     "detective",
@@ -108,6 +114,7 @@ variable "service_labels" {
     "emr",
     "emrcontainers",
     "emrserverless",
+    "entityresolution",
     "events",
     "evidently",
     "finspace",
@@ -130,7 +137,6 @@ variable "service_labels" {
     "guardduty",
     "health",
     "healthlake",
-    "honeycode",
     "iam",
     "identitystore",
     "imagebuilder",
@@ -153,6 +159,8 @@ variable "service_labels" {
     "iotwireless",
     "ipam",
     "ivs",
+    "ivschat",
+    "ivsrealtime",
     "kafka",
     "kafkaconnect",
     "kendra",
@@ -179,8 +187,8 @@ variable "service_labels" {
     "lookoutmetrics",
     "lookoutvision",
     "machinelearning",
-    "macie",
     "macie2",
+    "mailmanager",
     "managedblockchain",
     "marketplacecatalog",
     "marketplacecommerceanalytics",
@@ -190,6 +198,7 @@ variable "service_labels" {
     "mediaconvert",
     "medialive",
     "mediapackage",
+    "mediapackagev2",
     "mediapackagevod",
     "mediastore",
     "mediastoredata",
@@ -201,7 +210,6 @@ variable "service_labels" {
     "migrationhubconfig",
     "migrationhubrefactorspaces",
     "migrationhubstrategy",
-    "mobile",
     "mq",
     "mturk",
     "mwaa",
@@ -209,12 +217,16 @@ variable "service_labels" {
     "networkfirewall",
     "networkmanager",
     "nimble",
+    "oam",
     "opensearch",
     "opsworks",
     "opsworkscm",
     "organizations",
     "outposts",
     "panorama",
+    "paymentcryptography",
+    "pcaconnectorad",
+    "pcaconnectorscep",
     "personalize",
     "personalizeevents",
     "personalizeruntime",
@@ -14,7 +14,6 @@ import (
 	"github.com/aws/aws-sdk-go/service/account"
 	"github.com/aws/aws-sdk-go/service/acm"
 	"github.com/aws/aws-sdk-go/service/acmpca"
-	"github.com/aws/aws-sdk-go/service/alexaforbusiness"
 	"github.com/aws/aws-sdk-go/service/amplify"
 	"github.com/aws/aws-sdk-go/service/amplifybackend"
 	"github.com/aws/aws-sdk-go/service/amplifyuibuilder"
@@ -29,6 +28,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/applicationcostprofiler"
 	"github.com/aws/aws-sdk-go/service/applicationdiscoveryservice"
 	"github.com/aws/aws-sdk-go/service/applicationinsights"
+	"github.com/aws/aws-sdk-go/service/applicationsignals"
 	"github.com/aws/aws-sdk-go/service/appmesh"
 	"github.com/aws/aws-sdk-go/service/appregistry"
 	"github.com/aws/aws-sdk-go/service/apprunner"
@@ -39,16 +39,20 @@ import (
 	"github.com/aws/aws-sdk-go/service/augmentedairuntime"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/aws/aws-sdk-go/service/autoscalingplans"
+	"github.com/aws/aws-sdk-go/service/b2bi"
 	"github.com/aws/aws-sdk-go/service/backup"
 	"github.com/aws/aws-sdk-go/service/backupgateway"
 	"github.com/aws/aws-sdk-go/service/batch"
 	"github.com/aws/aws-sdk-go/service/billingconductor"
 	"github.com/aws/aws-sdk-go/service/braket"
 	"github.com/aws/aws-sdk-go/service/budgets"
+	"github.com/aws/aws-sdk-go/service/chatbot"
 	"github.com/aws/aws-sdk-go/service/chime"
 	"github.com/aws/aws-sdk-go/service/chimesdkidentity"
 	"github.com/aws/aws-sdk-go/service/chimesdkmeetings"
 	"github.com/aws/aws-sdk-go/service/chimesdkmessaging"
+	"github.com/aws/aws-sdk-go/service/cleanrooms"
+	"github.com/aws/aws-sdk-go/service/cleanroomsml"
 	"github.com/aws/aws-sdk-go/service/cloud9"
 	"github.com/aws/aws-sdk-go/service/cloudcontrolapi"
 	"github.com/aws/aws-sdk-go/service/clouddirectory"
@@ -83,6 +87,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/connectcontactlens"
 	"github.com/aws/aws-sdk-go/service/connectparticipant"
 	"github.com/aws/aws-sdk-go/service/connectwisdomservice"
+	"github.com/aws/aws-sdk-go/service/controltower"
 	"github.com/aws/aws-sdk-go/service/costandusagereportservice"
 	"github.com/aws/aws-sdk-go/service/costexplorer"
 	"github.com/aws/aws-sdk-go/service/customerprofiles"
@@ -90,6 +95,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/dataexchange"
 	"github.com/aws/aws-sdk-go/service/datapipeline"
 	"github.com/aws/aws-sdk-go/service/datasync"
+	"github.com/aws/aws-sdk-go/service/datazone"
 	"github.com/aws/aws-sdk-go/service/dax"
 	"github.com/aws/aws-sdk-go/service/detective"
 	"github.com/aws/aws-sdk-go/service/devicefarm"
@@ -119,6 +125,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/emr"
 	"github.com/aws/aws-sdk-go/service/emrcontainers"
 	"github.com/aws/aws-sdk-go/service/emrserverless"
+	"github.com/aws/aws-sdk-go/service/entityresolution"
 	"github.com/aws/aws-sdk-go/service/eventbridge"
 	"github.com/aws/aws-sdk-go/service/finspace"
 	"github.com/aws/aws-sdk-go/service/finspacedata"
@@ -139,7 +146,6 @@ import (
 	"github.com/aws/aws-sdk-go/service/guardduty"
 	"github.com/aws/aws-sdk-go/service/health"
 	"github.com/aws/aws-sdk-go/service/healthlake"
-	"github.com/aws/aws-sdk-go/service/honeycode"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/aws/aws-sdk-go/service/identitystore"
 	"github.com/aws/aws-sdk-go/service/imagebuilder"
@@ -161,6 +167,8 @@ import (
 	"github.com/aws/aws-sdk-go/service/iottwinmaker"
 	"github.com/aws/aws-sdk-go/service/iotwireless"
 	"github.com/aws/aws-sdk-go/service/ivs"
+	"github.com/aws/aws-sdk-go/service/ivschat"
+	"github.com/aws/aws-sdk-go/service/ivsrealtime"
 	"github.com/aws/aws-sdk-go/service/kafka"
 	"github.com/aws/aws-sdk-go/service/kafkaconnect"
 	"github.com/aws/aws-sdk-go/service/keyspaces"
@@ -185,8 +193,8 @@ import (
 	"github.com/aws/aws-sdk-go/service/lookoutforvision"
 	"github.com/aws/aws-sdk-go/service/lookoutmetrics"
 	"github.com/aws/aws-sdk-go/service/machinelearning"
-	"github.com/aws/aws-sdk-go/service/macie"
 	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/aws/aws-sdk-go/service/mailmanager"
 	"github.com/aws/aws-sdk-go/service/managedblockchain"
 	"github.com/aws/aws-sdk-go/service/managedgrafana"
 	"github.com/aws/aws-sdk-go/service/marketplacecatalog"
@@ -197,6 +205,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/mediaconvert"
 	"github.com/aws/aws-sdk-go/service/medialive"
 	"github.com/aws/aws-sdk-go/service/mediapackage"
+	"github.com/aws/aws-sdk-go/service/mediapackagev2"
 	"github.com/aws/aws-sdk-go/service/mediapackagevod"
 	"github.com/aws/aws-sdk-go/service/mediastore"
 	"github.com/aws/aws-sdk-go/service/mediastoredata"
@@ -207,7 +216,6 @@ import (
 	"github.com/aws/aws-sdk-go/service/migrationhubconfig"
 	"github.com/aws/aws-sdk-go/service/migrationhubrefactorspaces"
 	"github.com/aws/aws-sdk-go/service/migrationhubstrategyrecommendations"
-	"github.com/aws/aws-sdk-go/service/mobile"
 	"github.com/aws/aws-sdk-go/service/mq"
 	"github.com/aws/aws-sdk-go/service/mturk"
 	"github.com/aws/aws-sdk-go/service/mwaa"
@@ -215,12 +223,16 @@ import (
 	"github.com/aws/aws-sdk-go/service/networkfirewall"
 	"github.com/aws/aws-sdk-go/service/networkmanager"
 	"github.com/aws/aws-sdk-go/service/nimblestudio"
+	"github.com/aws/aws-sdk-go/service/oam"
 	"github.com/aws/aws-sdk-go/service/opensearchservice"
 	"github.com/aws/aws-sdk-go/service/opsworks"
 	"github.com/aws/aws-sdk-go/service/opsworkscm"
 	"github.com/aws/aws-sdk-go/service/organizations"
 	"github.com/aws/aws-sdk-go/service/outposts"
 	"github.com/aws/aws-sdk-go/service/panorama"
+	"github.com/aws/aws-sdk-go/service/paymentcryptography"
+	"github.com/aws/aws-sdk-go/service/pcaconnectorad"
+	"github.com/aws/aws-sdk-go/service/pcaconnectorscep"
 	"github.com/aws/aws-sdk-go/service/personalize"
 	"github.com/aws/aws-sdk-go/service/personalizeevents"
 	"github.com/aws/aws-sdk-go/service/personalizeruntime"
@@ -247,6 +259,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/resourcegroups"
 	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
 	"github.com/aws/aws-sdk-go/service/robomaker"
+	rolesanywherev1 "github.com/aws/aws-sdk-go/service/rolesanywhere"
 	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/aws/aws-sdk-go/service/route53recoverycluster"
 	"github.com/aws/aws-sdk-go/service/route53recoverycontrolconfig"
@@ -320,6 +333,7 @@ type AWSClient struct {
 	Region                    string
 	ReverseDNSPrefix          string
 	S3ConnURICleaningDisabled *s3.S3
+	ServiceQuotaPrecheck      string
 	Session                   *session.Session
 	SupportedPlatforms        []string
 	TerraformVersion          string
@@ -332,7 +346,6 @@ type AWSClient struct {
 	APIGatewayV2Conn                 *apigatewayv2.ApiGatewayV2
 	AccessAnalyzerConn               *accessanalyzer.AccessAnalyzer
 	AccountConn                      *account.Account
-	AlexaForBusinessConn             *alexaforbusiness.AlexaForBusiness
 	AmplifyConn                      *amplify.Amplify
 	AmplifyBackendConn               *amplifybackend.AmplifyBackend
 	AmplifyUIBuilderConn             *amplifyuibuilder.AmplifyUIBuilder
@@ -347,10 +360,12 @@ type AWSClient struct {
 	AppSyncConn                      *appsync.AppSync
 	ApplicationCostProfilerConn      *applicationcostprofiler.ApplicationCostProfiler
 	ApplicationInsightsConn          *applicationinsights.ApplicationInsights
+	ApplicationSignalsConn           *applicationsignals.ApplicationSignals
 	AthenaConn                       *athena.Athena
 	AuditManagerConn                 *auditmanager.AuditManager
 	AutoScalingConn                  *autoscaling.AutoScaling
 	AutoScalingPlansConn             *autoscalingplans.AutoScalingPlans
+	B2BIConn                         *b2bi.B2bi
 	BackupConn                       *backup.Backup
 	BackupGatewayConn                *backupgateway.BackupGateway
 	BatchConn                        *batch.Batch
@@ -359,10 +374,13 @@ type AWSClient struct {
 	BudgetsConn                      *budgets.Budgets
 	CEConn                           *costexplorer.CostExplorer
 	CURConn                          *costandusagereportservice.CostandUsageReportService
+	ChatbotConn                      *chatbot.Chatbot
 	ChimeConn                        *chime.Chime
 	ChimeSDKIdentityConn             *chimesdkidentity.ChimeSDKIdentity
 	ChimeSDKMeetingsConn             *chimesdkmeetings.ChimeSDKMeetings
 	ChimeSDKMessagingConn            *chimesdkmessaging.ChimeSDKMessaging
+	CleanRoomsConn                   *cleanrooms.CleanRooms
+	CleanRoomsMLConn                 *cleanroomsml.CleanRoomsML
 	Cloud9Conn                       *cloud9.Cloud9
 	CloudControlConn                 *cloudcontrolapi.CloudControlApi
 	CloudDirectoryConn               *clouddirectory.CloudDirectory
@@ -392,6 +410,7 @@ type AWSClient struct {
 	ConnectConn                      *connect.Connect
 	ConnectContactLensConn           *connectcontactlens.ConnectContactLens
 	ConnectParticipantConn           *connectparticipant.ConnectParticipant
+	ControlTowerConn                 *controltower.ControlTower
 	CustomerProfilesConn             *customerprofiles.CustomerProfiles
 	DAXConn                          *dax.DAX
 	DLMConn                          *dlm.DLM
@@ -402,6 +421,7 @@ type AWSClient struct {
 	DataExchangeConn                 *dataexchange.DataExchange
 	DataPipelineConn                 *datapipeline.DataPipeline
 	DataSyncConn                     *datasync.DataSync
+	DataZoneConn                     *datazone.DataZone
 	DeployConn                       *codedeploy.CodeDeploy
 	DetectiveConn                    *detective.Detective
 	DevOpsGuruConn                   *devopsguru.DevOpsGuru
@@ -429,6 +449,7 @@ type AWSClient struct {
 	ElasticInferenceConn             *elasticinference.ElasticInference
 	ElasticTranscoderConn            *elastictranscoder.ElasticTranscoder
 	ElasticsearchConn                *elasticsearchservice.ElasticsearchService
+	EntityResolutionConn             *entityresolution.EntityResolution
 	EventsConn                       *eventbridge.EventBridge
 	EvidentlyConn                    *cloudwatchevidently.CloudWatchEvidently
 	FISConn                          *fis.Client
@@ -451,9 +472,10 @@ type AWSClient struct {
 	GuardDutyConn                    *guardduty.GuardDuty
 	HealthConn                       *health.Health
 	HealthLakeConn                   *healthlake.HealthLake
-	HoneycodeConn                    *honeycode.Honeycode
 	IAMConn                          *iam.IAM
 	IVSConn                          *ivs.IVS
+	IVSChatConn                      *ivschat.Ivschat
+	IVSRealTimeConn                  *ivsrealtime.IVSRealTime
 	IdentityStoreConn                *identitystore.IdentityStore
 	ImageBuilderConn                 *imagebuilder.Imagebuilder
 	InspectorConn                    *inspector.Inspector
@@ -502,8 +524,8 @@ type AWSClient struct {
 	MTurkConn                        *mturk.MTurk
 	MWAAConn                         *mwaa.MWAA
 	MachineLearningConn              *machinelearning.MachineLearning
-	MacieConn                        *macie.Macie
 	Macie2Conn                       *macie2.Macie2
+	MailManagerConn                  *mailmanager.MailManager
 	ManagedBlockchainConn            *managedblockchain.ManagedBlockchain
 	MarketplaceCatalogConn           *marketplacecatalog.MarketplaceCatalog
 	MarketplaceCommerceAnalyticsConn *marketplacecommerceanalytics.MarketplaceCommerceAnalytics
@@ -513,6 +535,7 @@ type AWSClient struct {
 	MediaConvertConn                 *mediaconvert.MediaConvert
 	MediaLiveConn                    *medialive.MediaLive
 	MediaPackageConn                 *mediapackage.MediaPackage
+	MediaPackageV2Conn               *mediapackagev2.MediaPackageV2
 	MediaPackageVODConn              *mediapackagevod.MediaPackageVod
 	MediaStoreConn                   *mediastore.MediaStore
 	MediaStoreDataConn               *mediastoredata.MediaStoreData
@@ -523,18 +546,21 @@ type AWSClient struct {
 	MigrationHubConfigConn           *migrationhubconfig.MigrationHubConfig
 	MigrationHubRefactorSpacesConn   *migrationhubrefactorspaces.MigrationHubRefactorSpaces
 	MigrationHubStrategyConn         *migrationhubstrategyrecommendations.MigrationHubStrategyRecommendations
-	MobileConn                       *mobile.Mobile
 	NeptuneConn                      *neptune.Neptune
 	NetworkFirewallConn              *networkfirewall.NetworkFirewall
 	NetworkManagerConn               *networkmanager.NetworkManager
 	NimbleConn                       *nimblestudio.NimbleStudio
+	ObservabilityAccessManagerConn   *oam.OAM
 	OpenSearchConn                   *opensearchservice.OpenSearchService
 	OpsWorksConn                     *opsworks.OpsWorks
 	OpsWorksCMConn                   *opsworkscm.OpsWorksCM
 	OrganizationsConn                *organizations.Organizations
 	OutpostsConn                     *outposts.Outposts
+	PCAConnectorADConn               *pcaconnectorad.PcaConnectorAd
+	PCAConnectorSCEPConn             *pcaconnectorscep.PcaConnectorScep
 	PIConn                           *pi.PI
 	PanoramaConn                     *panorama.Panorama
+	PaymentCryptographyConn          *paymentcryptography.PaymentCryptography
 	PersonalizeConn                  *personalize.Personalize
 	PersonalizeEventsConn            *personalizeevents.PersonalizeEvents
 	PersonalizeRuntimeConn           *personalizeruntime.PersonalizeRuntime
@@ -561,6 +587,7 @@ type AWSClient struct {
 	ResourceGroupsTaggingAPIConn     *resourcegroupstaggingapi.ResourceGroupsTaggingAPI
 	RoboMakerConn                    *robomaker.RoboMaker
 	RolesAnywhereConn                *rolesanywhere.Client
+	RolesAnywhereV1Conn              *rolesanywherev1.RolesAnywhere
 	Route53Conn                      *route53.Route53
 	Route53DomainsConn               *route53domains.Client
 	Route53RecoveryClusterConn       *route53recoverycluster.Route53RecoveryCluster
@@ -67,6 +67,7 @@ type Config struct {
 	Region                         string
 	S3UsePathStyle                 bool
 	SecretKey                      string
+	ServiceQuotaPrecheck           string
 	SharedConfigFiles              []string
 	SharedCredentialsFiles         []string
 	SkipCredsValidation            bool
@@ -191,6 +192,7 @@ func (c *Config) Client(ctx context.Context) (interface{}, diag.Diagnostics) {
 	client.Partition = partition
 	client.Region = c.Region
 	client.ReverseDNSPrefix = ReverseDNS(DNSSuffix)
+	client.ServiceQuotaPrecheck = c.ServiceQuotaPrecheck
 	client.Session = sess
 	client.TerraformVersion = c.TerraformVersion
 
@@ -24,8 +24,10 @@ const (
 type ServiceDatum struct {
 	SDKVersion        string
 	GoPackage         string
+	GoPackageAlias    string
 	ProviderNameUpper string
 	ClientTypeName    string
+	ClientSuffix      string
 }
 
 type TemplateData struct {
@@ -73,11 +75,25 @@ func main() {
 			s.GoPackage = l[names.ColGoV1Package]
 			s.ClientTypeName = l[names.ColGoV1ClientTypeName]
 		} else {
+			s.SDKVersion = "2"
 			s.GoPackage = l[names.ColGoV2Package]
 			s.ClientTypeName = "Client"
 		}
 
 		td.Services = append(td.Services, s)
+
+		// Services using AWS SDK for Go v2 that also need an AWS SDK for Go v1 client,
+		// e.g. for APIs not supported by the v2 module in use, get a <Name>V1Conn.
+		if l[names.ColSDKVersion] == "1;2" {
+			td.Services = append(td.Services, ServiceDatum{
+				ProviderNameUpper: l[names.ColProviderNameUpper],
+				SDKVersion:        "1",
+				GoPackage:         l[names.ColGoV1Package],
+				GoPackageAlias:    l[names.ColGoV1Package] + "v1",
+				ClientTypeName:    l[names.ColGoV1ClientTypeName],
+				ClientSuffix:      "V1",
+			})
+		}
 	}
 
 	sort.SliceStable(td.Services, func(i, j int) bool {
@@ -129,7 +145,7 @@ import (
 
 
 {{ range .Services }}
-	"github.com/aws/aws-sdk-go{{ if eq .SDKVersion "2" }}-v2{{ end }}/service/{{ .GoPackage }}"
+	{{ .GoPackageAlias }} "github.com/aws/aws-sdk-go{{ if eq .SDKVersion "2" }}-v2{{ end }}/service/{{ .GoPackage }}"
 {{- end }}
 	"github.com/aws/aws-sdk-go/aws/session"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -145,12 +161,13 @@ type AWSClient struct {
 	Region                    string
 	ReverseDNSPrefix          string
 	S3ConnURICleaningDisabled *s3.S3
+	ServiceQuotaPrecheck      string
 	Session                   *session.Session
 	SupportedPlatforms        []string
 	TerraformVersion          string
 
 	{{ range .Services }}
-	{{ .ProviderNameUpper }}Conn *{{ .GoPackage }}.{{ .ClientTypeName }}
+	{{ .ProviderNameUpper }}{{ .ClientSuffix }}Conn *{{ or .GoPackageAlias .GoPackage }}.{{ .ClientTypeName }}
 	{{- end }}
 }
 
@@ -107,7 +107,7 @@ func main() {
 			}
 		}
 
-		if l[names.ColSDKVersion] != "1" && l[names.ColSDKVersion] != "2" && l[names.ColExclude] == "" {
+		if l[names.ColSDKVersion] != "1" && l[names.ColSDKVersion] != "2" && l[names.ColSDKVersion] != "1;2" && l[names.ColExclude] == "" {
 			log.Fatalf("in names_data.csv, for service %s, SDKVersion must have a value if Exclude is blank", l[names.ColHumanFriendly])
 		}
 
@@ -119,6 +119,10 @@ func main() {
 			log.Fatalf("in names_data.csv, for service %s, SDKVersion is set to 2 so GoV2Package cannot be blank", l[names.ColHumanFriendly])
 		}
 
+		if l[names.ColSDKVersion] == "1;2" && (l[names.ColGoV1Package] == "" || l[names.ColGoV1ClientTypeName] == "" || l[names.ColGoV2Package] == "") {
+			log.Fatalf("in names_data.csv, for service %s, SDKVersion is set to 1;2 so none of GoV1Package, GoV1ClientTypeName and GoV2Package can be blank", l[names.ColHumanFriendly])
+		}
+
 		if l[names.ColResourcePrefixCorrect] != "" && l[names.ColResourcePrefixCorrect] != fmt.Sprintf("aws_%s_", l[names.ColProviderPackageCorrect]) {
 			log.Fatalf("in names_data.csv, for service %s, ResourcePrefixCorrect should be aws_<package>_, where <package> is ProviderPackageCorrect", l[names.ColHumanFriendly])
 		}
@@ -24,7 +24,9 @@ const (
 type ServiceDatum struct {
 	SDKVersion        string
 	GoPackage         string
+	GoPackageAlias    string
 	ProviderNameUpper string
+	ClientSuffix      string
 }
 
 type TemplateData struct {
@@ -55,7 +57,7 @@ func main() {
 			continue
 		}
 
-		if l[names.ColExclude] != "" || l[names.ColSkipClientGenerate] != "" {
+		if l[names.ColExclude] != "" {
 			continue
 		}
 
@@ -63,6 +65,22 @@ func main() {
 			continue
 		}
 
+		// Services using AWS SDK for Go v2 that also need an AWS SDK for Go v1 client
+		// get a <Name>V1Conn, configured here even if the v2 client is configured manually.
+		if l[names.ColSDKVersion] == "1;2" {
+			td.Services = append(td.Services, ServiceDatum{
+				ProviderNameUpper: l[names.ColProviderNameUpper],
+				SDKVersion:        "1",
+				GoPackage:         l[names.ColGoV1Package],
+				GoPackageAlias:    l[names.ColGoV1Package] + "v1",
+				ClientSuffix:      "V1",
+			})
+		}
+
+		if l[names.ColSkipClientGenerate] != "" {
+			continue
+		}
+
 		s := ServiceDatum{
 			ProviderNameUpper: l[names.ColProviderNameUpper],
 			SDKVersion:        l[names.ColSDKVersion],
@@ -125,7 +143,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 {{- range .Services }}
-	"github.com/aws/aws-sdk-go{{ if eq .SDKVersion "2" }}-v2{{ end }}/service/{{ .GoPackage }}"
+	{{ .GoPackageAlias }} "github.com/aws/aws-sdk-go{{ if eq .SDKVersion "2" }}-v2{{ end }}/service/{{ .GoPackage }}"
 {{- end }}
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -133,7 +151,7 @@ import (
 func (c *Config) clientConns(sess *session.Session) *AWSClient {
 	return &AWSClient{
 		{{- range .Services }}
-		{{ .ProviderNameUpper }}Conn: {{ .GoPackage }}.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.{{ .ProviderNameUpper }}])})),
+		{{ .ProviderNameUpper }}{{ .ClientSuffix }}Conn: {{ or .GoPackageAlias .GoPackage }}.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.{{ .ProviderNameUpper }}])})),
 		{{- end }}
 	}
 }
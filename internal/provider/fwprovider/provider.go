@@ -96,6 +96,11 @@ func (p *provider) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostic
 				Optional:    true,
 				Description: "The secret key for API operations. You can retrieve this\nfrom the 'Security & Credentials' section of the AWS console.",
 			},
+			"service_quota_precheck": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "Check Service Quotas at plan time for resources that commonly hit account limits. Valid values are `warn` and `error`.",
+			},
 			"shared_config_files": {
 				Type:        types.ListType{ElemType: types.StringType},
 				Optional:    true,
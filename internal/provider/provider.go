@@ -28,18 +28,24 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/appflow"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/appintegrations"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/applicationinsights"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/applicationsignals"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/appmesh"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/apprunner"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/appstream"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/appsync"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/athena"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/auditmanager"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/autoscaling"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/autoscalingplans"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/b2bi"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/backup"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/batch"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/budgets"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/ce"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/chatbot"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/chime"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/cleanrooms"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/cleanroomsml"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/cloud9"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/cloudcontrol"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/cloudformation"
@@ -58,10 +64,12 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/cognitoidp"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/configservice"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/connect"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/controltower"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/cur"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/dataexchange"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/datapipeline"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/datasync"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/datazone"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/dax"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/deploy"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/detective"
@@ -70,6 +78,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/dlm"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/dms"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/docdb"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/drs"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/ds"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/dynamodb"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
@@ -87,6 +96,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/emr"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/emrcontainers"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/emrserverless"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/entityresolution"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/events"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/firehose"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/fis"
@@ -102,7 +112,10 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/identitystore"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/imagebuilder"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/inspector"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/inspector2"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/iot"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/ivs"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/ivschat"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/kafka"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/kafkaconnect"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/kendra"
@@ -119,10 +132,11 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/lightsail"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/location"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/logs"
-	"github.com/hashicorp/terraform-provider-aws/internal/service/macie"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/macie2"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/mailmanager"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mediaconvert"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mediapackage"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/mediapackagev2"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mediastore"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/memorydb"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/meta"
@@ -131,10 +145,14 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/neptune"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/networkfirewall"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/networkmanager"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/oam"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/opensearch"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/opsworks"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/organizations"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/outposts"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/paymentcryptography"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/pcaconnectorad"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/pcaconnectorscep"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/pinpoint"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/pricing"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/qldb"
@@ -144,6 +162,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/redshift"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/redshiftdata"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/redshiftserverless"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/resiliencehub"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/resourcegroups"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/resourcegroupstaggingapi"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/rolesanywhere"
@@ -165,6 +184,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/servicediscovery"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/servicequotas"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/ses"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/sesv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/sfn"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/shield"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/signer"
@@ -185,6 +205,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/wafv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/worklink"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/workspaces"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/workspacesweb"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/xray"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -337,6 +358,13 @@ func Provider() *schema.Provider {
 				Description: "The secret key for API operations. You can retrieve this\n" +
 					"from the 'Security & Credentials' section of the AWS console.",
 			},
+			"service_quota_precheck": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(servicequotas.PrecheckModes(), false),
+				Description: "Check Service Quotas before creating resources that commonly hit account limits. " +
+					"Valid values are `warn`, which logs a warning during plan, and `error`, which fails the plan.",
+			},
 			"shared_config_files": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -426,12 +454,15 @@ func Provider() *schema.Provider {
 			"aws_api_gateway_sdk":         apigateway.DataSourceSdk(),
 			"aws_api_gateway_vpc_link":    apigateway.DataSourceVPCLink(),
 
-			"aws_apigatewayv2_api":    apigatewayv2.DataSourceAPI(),
-			"aws_apigatewayv2_apis":   apigatewayv2.DataSourceAPIs(),
-			"aws_apigatewayv2_export": apigatewayv2.DataSourceExport(),
+			"aws_apigatewayv2_api":                  apigatewayv2.DataSourceAPI(),
+			"aws_apigatewayv2_apis":                 apigatewayv2.DataSourceAPIs(),
+			"aws_apigatewayv2_connections_endpoint": apigatewayv2.DataSourceConnectionsEndpoint(),
+			"aws_apigatewayv2_export":               apigatewayv2.DataSourceExport(),
 
-			"aws_appmesh_mesh":            appmesh.DataSourceMesh(),
-			"aws_appmesh_virtual_service": appmesh.DataSourceVirtualService(),
+			"aws_appmesh_mesh":                          appmesh.DataSourceMesh(),
+			"aws_appmesh_service_connect_configuration": appmesh.DataSourceServiceConnectConfiguration(),
+			"aws_appmesh_virtual_service":               appmesh.DataSourceVirtualService(),
+			"aws_appmesh_vpc_lattice_configuration":     appmesh.DataSourceVPCLatticeConfiguration(),
 
 			"aws_autoscaling_group":    autoscaling.DataSourceGroup(),
 			"aws_autoscaling_groups":   autoscaling.DataSourceGroups(),
@@ -468,6 +499,7 @@ func Provider() *schema.Provider {
 
 			"aws_cloudhsm_v2_cluster": cloudhsmv2.DataSourceCluster(),
 
+			"aws_cloudtrail_query":           cloudtrail.DataSourceQuery(),
 			"aws_cloudtrail_service_account": cloudtrail.DataSourceServiceAccount(),
 
 			"aws_cloudwatch_event_bus":        events.DataSourceBus(),
@@ -490,6 +522,8 @@ func Provider() *schema.Provider {
 			"aws_cognito_user_pool_signing_certificate": cognitoidp.DataSourceUserPoolSigningCertificate(),
 			"aws_cognito_user_pools":                    cognitoidp.DataSourceUserPools(),
 
+			"aws_config_conformance_pack_compliance": configservice.DataSourceConformancePackCompliance(),
+
 			"aws_connect_bot_association":             connect.DataSourceBotAssociation(),
 			"aws_connect_contact_flow":                connect.DataSourceContactFlow(),
 			"aws_connect_contact_flow_module":         connect.DataSourceContactFlowModule(),
@@ -504,11 +538,15 @@ func Provider() *schema.Provider {
 			"aws_connect_user_hierarchy_group":        connect.DataSourceUserHierarchyGroup(),
 			"aws_connect_user_hierarchy_structure":    connect.DataSourceUserHierarchyStructure(),
 
+			"aws_controltower_controls": controltower.DataSourceControls(),
+
 			"aws_cur_report_definition": cur.DataSourceReportDefinition(),
 
 			"aws_datapipeline_pipeline":            datapipeline.DataSourcePipeline(),
 			"aws_datapipeline_pipeline_definition": datapipeline.DataSourcePipelineDefinition(),
 
+			"aws_datazone_environment_blueprint": datazone.DataSourceEnvironmentBlueprint(),
+
 			"aws_docdb_engine_version":        docdb.DataSourceEngineVersion(),
 			"aws_docdb_orderable_db_instance": docdb.DataSourceOrderableDBInstance(),
 
@@ -524,6 +562,7 @@ func Provider() *schema.Provider {
 			"aws_ami":                                        ec2.DataSourceAMI(),
 			"aws_ami_ids":                                    ec2.DataSourceAMIIDs(),
 			"aws_availability_zone":                          ec2.DataSourceAvailabilityZone(),
+			"aws_availability_zone_mappings":                 ec2.DataSourceAvailabilityZoneMappings(),
 			"aws_availability_zones":                         ec2.DataSourceAvailabilityZones(),
 			"aws_customer_gateway":                           ec2.DataSourceCustomerGateway(),
 			"aws_ebs_default_kms_key":                        ec2.DataSourceEBSDefaultKMSKey(),
@@ -648,9 +687,10 @@ func Provider() *schema.Provider {
 
 			"aws_globalaccelerator_accelerator": globalaccelerator.DataSourceAccelerator(),
 
-			"aws_glue_connection":                       glue.DataSourceConnection(),
-			"aws_glue_data_catalog_encryption_settings": glue.DataSourceDataCatalogEncryptionSettings(),
-			"aws_glue_script":                           glue.DataSourceScript(),
+			"aws_glue_connection":                           glue.DataSourceConnection(),
+			"aws_glue_data_catalog_encryption_settings":     glue.DataSourceDataCatalogEncryptionSettings(),
+			"aws_glue_data_quality_rule_recommendation_run": glue.DataSourceDataQualityRuleRecommendationRun(),
+			"aws_glue_script":                               glue.DataSourceScript(),
 
 			"aws_grafana_workspace": grafana.DataSourceWorkspace(),
 
@@ -762,7 +802,8 @@ func Provider() *schema.Provider {
 			"aws_neptune_engine_version":        neptune.DataSourceEngineVersion(),
 			"aws_neptune_orderable_db_instance": neptune.DataSourceOrderableDBInstance(),
 
-			"aws_networkfirewall_firewall_policy": networkfirewall.DataSourceFirewallPolicy(),
+			"aws_networkfirewall_firewall_policy":     networkfirewall.DataSourceFirewallPolicy(),
+			"aws_networkfirewall_rule_group_metadata": networkfirewall.DataSourceRuleGroupMetadata(),
 
 			"aws_networkmanager_connection":                   networkmanager.DataSourceConnection(),
 			"aws_networkmanager_connections":                  networkmanager.DataSourceConnections(),
@@ -793,6 +834,9 @@ func Provider() *schema.Provider {
 			"aws_outposts_site":                   outposts.DataSourceSite(),
 			"aws_outposts_sites":                  outposts.DataSourceSites(),
 
+			"aws_paymentcryptography_parameters_for_export": paymentcryptography.DataSourceParametersForExport(),
+			"aws_paymentcryptography_parameters_for_import": paymentcryptography.DataSourceParametersForImport(),
+
 			"aws_pricing_product": pricing.DataSourceProduct(),
 
 			"aws_qldb_ledger": qldb.DataSourceLedger(),
@@ -858,6 +902,7 @@ func Provider() *schema.Provider {
 
 			"aws_service_discovery_dns_namespace":  servicediscovery.DataSourceDNSNamespace(),
 			"aws_service_discovery_http_namespace": servicediscovery.DataSourceHTTPNamespace(),
+			"aws_service_discovery_instances":      servicediscovery.DataSourceInstances(),
 			"aws_service_discovery_service":        servicediscovery.DataSourceService(),
 
 			"aws_servicequotas_service":       servicequotas.DataSourceService(),
@@ -887,7 +932,8 @@ func Provider() *schema.Provider {
 
 			"aws_caller_identity": sts.DataSourceCallerIdentity(),
 
-			"aws_transfer_server": transfer.DataSourceServer(),
+			"aws_transfer_connector": transfer.DataSourceConnector(),
+			"aws_transfer_server":    transfer.DataSourceServer(),
 
 			"aws_waf_ipset":           waf.DataSourceIPSet(),
 			"aws_waf_rule":            waf.DataSourceRule(),
@@ -916,6 +962,7 @@ func Provider() *schema.Provider {
 
 			"aws_account_alternate_contact": account.ResourceAlternateContact(),
 
+			"aws_acm_account":                acm.ResourceAccount(),
 			"aws_acm_certificate":            acm.ResourceCertificate(),
 			"aws_acm_certificate_validation": acm.ResourceCertificateValidation(),
 
@@ -927,9 +974,12 @@ func Provider() *schema.Provider {
 
 			"aws_applicationinsights_application": applicationinsights.ResourceApplication(),
 
+			"aws_applicationsignals_service_level_objective": applicationsignals.ResourceServiceLevelObjective(),
+
 			"aws_prometheus_workspace":                amp.ResourceWorkspace(),
 			"aws_prometheus_alert_manager_definition": amp.ResourceAlertManagerDefinition(),
 			"aws_prometheus_rule_group_namespace":     amp.ResourceRuleGroupNamespace(),
+			"aws_prometheus_scraper":                  amp.ResourceScraper(),
 
 			"aws_amplify_app":                 amplify.ResourceApp(),
 			"aws_amplify_backend_environment": amplify.ResourceBackendEnvironment(),
@@ -999,12 +1049,13 @@ func Provider() *schema.Provider {
 			"aws_appmesh_virtual_router":  appmesh.ResourceVirtualRouter(),
 			"aws_appmesh_virtual_service": appmesh.ResourceVirtualService(),
 
-			"aws_apprunner_vpc_connector":                      apprunner.ResourceVPCConnector(),
-			"aws_apprunner_auto_scaling_configuration_version": apprunner.ResourceAutoScalingConfigurationVersion(),
-			"aws_apprunner_observability_configuration":        apprunner.ResourceObservabilityConfiguration(),
-			"aws_apprunner_connection":                         apprunner.ResourceConnection(),
-			"aws_apprunner_custom_domain_association":          apprunner.ResourceCustomDomainAssociation(),
-			"aws_apprunner_service":                            apprunner.ResourceService(),
+			"aws_apprunner_vpc_connector":                              apprunner.ResourceVPCConnector(),
+			"aws_apprunner_auto_scaling_configuration_version":         apprunner.ResourceAutoScalingConfigurationVersion(),
+			"aws_apprunner_default_auto_scaling_configuration_version": apprunner.ResourceDefaultAutoScalingConfigurationVersion(),
+			"aws_apprunner_observability_configuration":                apprunner.ResourceObservabilityConfiguration(),
+			"aws_apprunner_connection":                                 apprunner.ResourceConnection(),
+			"aws_apprunner_custom_domain_association":                  apprunner.ResourceCustomDomainAssociation(),
+			"aws_apprunner_service":                                    apprunner.ResourceService(),
 
 			"aws_appstream_directory_config":        appstream.ResourceDirectoryConfig(),
 			"aws_appstream_fleet":                   appstream.ResourceFleet(),
@@ -1022,11 +1073,21 @@ func Provider() *schema.Provider {
 			"aws_appsync_function":                    appsync.ResourceFunction(),
 			"aws_appsync_graphql_api":                 appsync.ResourceGraphQLAPI(),
 			"aws_appsync_resolver":                    appsync.ResourceResolver(),
-
-			"aws_athena_database":     athena.ResourceDatabase(),
-			"aws_athena_data_catalog": athena.ResourceDataCatalog(),
-			"aws_athena_named_query":  athena.ResourceNamedQuery(),
-			"aws_athena_workgroup":    athena.ResourceWorkGroup(),
+			"aws_appsync_source_api_association":      appsync.ResourceSourceAPIAssociation(),
+
+			"aws_athena_capacity_reservation": athena.ResourceCapacityReservation(),
+			"aws_athena_database":             athena.ResourceDatabase(),
+			"aws_athena_data_catalog":         athena.ResourceDataCatalog(),
+			"aws_athena_named_query":          athena.ResourceNamedQuery(),
+			"aws_athena_workgroup":            athena.ResourceWorkGroup(),
+
+			"aws_auditmanager_account_registration":                    auditmanager.ResourceAccountRegistration(),
+			"aws_auditmanager_assessment":                              auditmanager.ResourceAssessment(),
+			"aws_auditmanager_assessment_delegation":                   auditmanager.ResourceAssessmentDelegation(),
+			"aws_auditmanager_assessment_report":                       auditmanager.ResourceAssessmentReport(),
+			"aws_auditmanager_control":                                 auditmanager.ResourceControl(),
+			"aws_auditmanager_framework":                               auditmanager.ResourceFramework(),
+			"aws_auditmanager_organization_admin_account_registration": auditmanager.ResourceOrganizationAdminAccountRegistration(),
 
 			"aws_autoscaling_attachment":     autoscaling.ResourceAttachment(),
 			"aws_autoscaling_group":          autoscaling.ResourceGroup(),
@@ -1039,16 +1100,24 @@ func Provider() *schema.Provider {
 
 			"aws_autoscalingplans_scaling_plan": autoscalingplans.ResourceScalingPlan(),
 
-			"aws_backup_framework":                backup.ResourceFramework(),
-			"aws_backup_global_settings":          backup.ResourceGlobalSettings(),
-			"aws_backup_plan":                     backup.ResourcePlan(),
-			"aws_backup_region_settings":          backup.ResourceRegionSettings(),
-			"aws_backup_report_plan":              backup.ResourceReportPlan(),
-			"aws_backup_selection":                backup.ResourceSelection(),
-			"aws_backup_vault":                    backup.ResourceVault(),
-			"aws_backup_vault_lock_configuration": backup.ResourceVaultLockConfiguration(),
-			"aws_backup_vault_notifications":      backup.ResourceVaultNotifications(),
-			"aws_backup_vault_policy":             backup.ResourceVaultPolicy(),
+			"aws_b2bi_capability":  b2bi.ResourceCapability(),
+			"aws_b2bi_partnership": b2bi.ResourcePartnership(),
+			"aws_b2bi_profile":     b2bi.ResourceProfile(),
+			"aws_b2bi_transformer": b2bi.ResourceTransformer(),
+
+			"aws_backup_framework":                  backup.ResourceFramework(),
+			"aws_backup_global_settings":            backup.ResourceGlobalSettings(),
+			"aws_backup_logically_air_gapped_vault": backup.ResourceLogicallyAirGappedVault(),
+			"aws_backup_plan":                       backup.ResourcePlan(),
+			"aws_backup_region_settings":            backup.ResourceRegionSettings(),
+			"aws_backup_report_plan":                backup.ResourceReportPlan(),
+			"aws_backup_restore_testing_plan":       backup.ResourceRestoreTestingPlan(),
+			"aws_backup_restore_testing_selection":  backup.ResourceRestoreTestingSelection(),
+			"aws_backup_selection":                  backup.ResourceSelection(),
+			"aws_backup_vault":                      backup.ResourceVault(),
+			"aws_backup_vault_lock_configuration":   backup.ResourceVaultLockConfiguration(),
+			"aws_backup_vault_notifications":        backup.ResourceVaultNotifications(),
+			"aws_backup_vault_policy":               backup.ResourceVaultPolicy(),
 
 			"aws_batch_compute_environment": batch.ResourceComputeEnvironment(),
 			"aws_batch_job_definition":      batch.ResourceJobDefinition(),
@@ -1063,6 +1132,9 @@ func Provider() *schema.Provider {
 			"aws_ce_cost_allocation_tag":  ce.ResourceCostAllocationTag(),
 			"aws_ce_cost_category":        ce.ResourceCostCategory(),
 
+			"aws_chatbot_slack_channel_configuration": chatbot.ResourceSlackChannelConfiguration(),
+			"aws_chatbot_teams_channel_configuration": chatbot.ResourceTeamsChannelConfiguration(),
+
 			"aws_chime_voice_connector":                         chime.ResourceVoiceConnector(),
 			"aws_chime_voice_connector_group":                   chime.ResourceVoiceConnectorGroup(),
 			"aws_chime_voice_connector_logging":                 chime.ResourceVoiceConnectorLogging(),
@@ -1071,6 +1143,18 @@ func Provider() *schema.Provider {
 			"aws_chime_voice_connector_termination":             chime.ResourceVoiceConnectorTermination(),
 			"aws_chime_voice_connector_termination_credentials": chime.ResourceVoiceConnectorTerminationCredentials(),
 
+			"aws_cleanrooms_analysis_template":                          cleanrooms.ResourceAnalysisTemplate(),
+			"aws_cleanrooms_collaboration":                              cleanrooms.ResourceCollaboration(),
+			"aws_cleanrooms_configured_table":                           cleanrooms.ResourceConfiguredTable(),
+			"aws_cleanrooms_configured_table_analysis_rule":             cleanrooms.ResourceConfiguredTableAnalysisRule(),
+			"aws_cleanrooms_configured_table_association":               cleanrooms.ResourceConfiguredTableAssociation(),
+			"aws_cleanrooms_configured_table_association_analysis_rule": cleanrooms.ResourceConfiguredTableAssociationAnalysisRule(),
+			"aws_cleanrooms_id_mapping_table":                           cleanrooms.ResourceIDMappingTable(),
+			"aws_cleanrooms_membership":                                 cleanrooms.ResourceMembership(),
+			"aws_cleanrooms_privacy_budget_template":                    cleanrooms.ResourcePrivacyBudgetTemplate(),
+
+			"aws_cleanroomsml_configured_audience_model": cleanroomsml.ResourceConfiguredAudienceModel(),
+
 			"aws_cloud9_environment_ec2":        cloud9.ResourceEnvironmentEC2(),
 			"aws_cloud9_environment_membership": cloud9.ResourceEnvironmentMembership(),
 
@@ -1088,6 +1172,7 @@ func Provider() *schema.Provider {
 			"aws_cloudfront_function":                       cloudfront.ResourceFunction(),
 			"aws_cloudfront_key_group":                      cloudfront.ResourceKeyGroup(),
 			"aws_cloudfront_monitoring_subscription":        cloudfront.ResourceMonitoringSubscription(),
+			"aws_cloudfront_origin_access_control":          cloudfront.ResourceOriginAccessControl(),
 			"aws_cloudfront_origin_access_identity":         cloudfront.ResourceOriginAccessIdentity(),
 			"aws_cloudfront_origin_request_policy":          cloudfront.ResourceOriginRequestPolicy(),
 			"aws_cloudfront_public_key":                     cloudfront.ResourcePublicKey(),
@@ -1103,10 +1188,11 @@ func Provider() *schema.Provider {
 			"aws_cloudtrail":                  cloudtrail.ResourceCloudTrail(),
 			"aws_cloudtrail_event_data_store": cloudtrail.ResourceEventDataStore(),
 
-			"aws_cloudwatch_composite_alarm": cloudwatch.ResourceCompositeAlarm(),
-			"aws_cloudwatch_dashboard":       cloudwatch.ResourceDashboard(),
-			"aws_cloudwatch_metric_alarm":    cloudwatch.ResourceMetricAlarm(),
-			"aws_cloudwatch_metric_stream":   cloudwatch.ResourceMetricStream(),
+			"aws_cloudwatch_anomaly_detector": cloudwatch.ResourceAnomalyDetector(),
+			"aws_cloudwatch_composite_alarm":  cloudwatch.ResourceCompositeAlarm(),
+			"aws_cloudwatch_dashboard":        cloudwatch.ResourceDashboard(),
+			"aws_cloudwatch_metric_alarm":     cloudwatch.ResourceMetricAlarm(),
+			"aws_cloudwatch_metric_stream":    cloudwatch.ResourceMetricStream(),
 
 			"aws_cloudwatch_event_api_destination": events.ResourceAPIDestination(),
 			"aws_cloudwatch_event_archive":         events.ResourceArchive(),
@@ -1189,6 +1275,7 @@ func Provider() *schema.Provider {
 			"aws_connect_instance":                    connect.ResourceInstance(),
 			"aws_connect_hours_of_operation":          connect.ResourceHoursOfOperation(),
 			"aws_connect_lambda_function_association": connect.ResourceLambdaFunctionAssociation(),
+			"aws_connect_predefined_attribute":        connect.ResourcePredefinedAttribute(),
 			"aws_connect_queue":                       connect.ResourceQueue(),
 			"aws_connect_quick_connect":               connect.ResourceQuickConnect(),
 			"aws_connect_routing_profile":             connect.ResourceRoutingProfile(),
@@ -1198,6 +1285,9 @@ func Provider() *schema.Provider {
 			"aws_connect_user_hierarchy_structure":    connect.ResourceUserHierarchyStructure(),
 			"aws_connect_vocabulary":                  connect.ResourceVocabulary(),
 
+			"aws_controltower_control":      controltower.ResourceControl(),
+			"aws_controltower_landing_zone": controltower.ResourceLandingZone(),
+
 			"aws_cur_report_definition": cur.ResourceReportDefinition(),
 
 			"aws_dataexchange_data_set": dataexchange.ResourceDataSet(),
@@ -1217,6 +1307,13 @@ func Provider() *schema.Provider {
 			"aws_datasync_location_smb":                     datasync.ResourceLocationSMB(),
 			"aws_datasync_task":                             datasync.ResourceTask(),
 
+			"aws_datazone_asset_type":                          datazone.ResourceAssetType(),
+			"aws_datazone_domain":                              datazone.ResourceDomain(),
+			"aws_datazone_environment":                         datazone.ResourceEnvironment(),
+			"aws_datazone_environment_blueprint_configuration": datazone.ResourceEnvironmentBlueprintConfiguration(),
+			"aws_datazone_glossary":                            datazone.ResourceGlossary(),
+			"aws_datazone_project":                             datazone.ResourceProject(),
+
 			"aws_dax_cluster":         dax.ResourceCluster(),
 			"aws_dax_parameter_group": dax.ResourceParameterGroup(),
 			"aws_dax_subnet_group":    dax.ResourceSubnetGroup(),
@@ -1268,9 +1365,14 @@ func Provider() *schema.Provider {
 			"aws_docdb_global_cluster":          docdb.ResourceGlobalCluster(),
 			"aws_docdb_subnet_group":            docdb.ResourceSubnetGroup(),
 
+			"aws_drs_launch_configuration_template":      drs.ResourceLaunchConfigurationTemplate(),
+			"aws_drs_replication_configuration_template": drs.ResourceReplicationConfigurationTemplate(),
+			"aws_drs_source_network":                     drs.ResourceSourceNetwork(),
+
 			"aws_directory_service_conditional_forwarder":     ds.ResourceConditionalForwarder(),
 			"aws_directory_service_directory":                 ds.ResourceDirectory(),
 			"aws_directory_service_log_subscription":          ds.ResourceLogSubscription(),
+			"aws_directory_service_schema_extension":          ds.ResourceSchemaExtension(),
 			"aws_directory_service_shared_directory_accepter": ds.ResourceSharedDirectoryAccepter(),
 			"aws_directory_service_shared_directory":          ds.ResourceSharedDirectory(),
 
@@ -1281,118 +1383,125 @@ func Provider() *schema.Provider {
 			"aws_dynamodb_table_item":                    dynamodb.ResourceTableItem(),
 			"aws_dynamodb_tag":                           dynamodb.ResourceTag(),
 
-			"aws_ami":                                              ec2.ResourceAMI(),
-			"aws_ami_copy":                                         ec2.ResourceAMICopy(),
-			"aws_ami_from_instance":                                ec2.ResourceAMIFromInstance(),
-			"aws_ami_launch_permission":                            ec2.ResourceAMILaunchPermission(),
-			"aws_customer_gateway":                                 ec2.ResourceCustomerGateway(),
-			"aws_default_network_acl":                              ec2.ResourceDefaultNetworkACL(),
-			"aws_default_route_table":                              ec2.ResourceDefaultRouteTable(),
-			"aws_default_security_group":                           ec2.ResourceDefaultSecurityGroup(),
-			"aws_default_subnet":                                   ec2.ResourceDefaultSubnet(),
-			"aws_default_vpc":                                      ec2.ResourceDefaultVPC(),
-			"aws_default_vpc_dhcp_options":                         ec2.ResourceDefaultVPCDHCPOptions(),
-			"aws_ebs_default_kms_key":                              ec2.ResourceEBSDefaultKMSKey(),
-			"aws_ebs_encryption_by_default":                        ec2.ResourceEBSEncryptionByDefault(),
-			"aws_ebs_snapshot":                                     ec2.ResourceEBSSnapshot(),
-			"aws_ebs_snapshot_copy":                                ec2.ResourceEBSSnapshotCopy(),
-			"aws_ebs_snapshot_import":                              ec2.ResourceEBSSnapshotImport(),
-			"aws_ebs_volume":                                       ec2.ResourceEBSVolume(),
-			"aws_ec2_availability_zone_group":                      ec2.ResourceAvailabilityZoneGroup(),
-			"aws_ec2_capacity_reservation":                         ec2.ResourceCapacityReservation(),
-			"aws_ec2_carrier_gateway":                              ec2.ResourceCarrierGateway(),
-			"aws_ec2_client_vpn_authorization_rule":                ec2.ResourceClientVPNAuthorizationRule(),
-			"aws_ec2_client_vpn_endpoint":                          ec2.ResourceClientVPNEndpoint(),
-			"aws_ec2_client_vpn_network_association":               ec2.ResourceClientVPNNetworkAssociation(),
-			"aws_ec2_client_vpn_route":                             ec2.ResourceClientVPNRoute(),
-			"aws_ec2_fleet":                                        ec2.ResourceFleet(),
-			"aws_ec2_host":                                         ec2.ResourceHost(),
-			"aws_ec2_local_gateway_route":                          ec2.ResourceLocalGatewayRoute(),
-			"aws_ec2_local_gateway_route_table_vpc_association":    ec2.ResourceLocalGatewayRouteTableVPCAssociation(),
-			"aws_ec2_managed_prefix_list":                          ec2.ResourceManagedPrefixList(),
-			"aws_ec2_managed_prefix_list_entry":                    ec2.ResourceManagedPrefixListEntry(),
-			"aws_ec2_network_insights_path":                        ec2.ResourceNetworkInsightsPath(),
-			"aws_ec2_serial_console_access":                        ec2.ResourceSerialConsoleAccess(),
-			"aws_ec2_subnet_cidr_reservation":                      ec2.ResourceSubnetCIDRReservation(),
-			"aws_ec2_tag":                                          ec2.ResourceTag(),
-			"aws_ec2_traffic_mirror_filter":                        ec2.ResourceTrafficMirrorFilter(),
-			"aws_ec2_traffic_mirror_filter_rule":                   ec2.ResourceTrafficMirrorFilterRule(),
-			"aws_ec2_traffic_mirror_session":                       ec2.ResourceTrafficMirrorSession(),
-			"aws_ec2_traffic_mirror_target":                        ec2.ResourceTrafficMirrorTarget(),
-			"aws_ec2_transit_gateway":                              ec2.ResourceTransitGateway(),
-			"aws_ec2_transit_gateway_connect":                      ec2.ResourceTransitGatewayConnect(),
-			"aws_ec2_transit_gateway_connect_peer":                 ec2.ResourceTransitGatewayConnectPeer(),
-			"aws_ec2_transit_gateway_multicast_domain":             ec2.ResourceTransitGatewayMulticastDomain(),
-			"aws_ec2_transit_gateway_multicast_domain_association": ec2.ResourceTransitGatewayMulticastDomainAssociation(),
-			"aws_ec2_transit_gateway_multicast_group_member":       ec2.ResourceTransitGatewayMulticastGroupMember(),
-			"aws_ec2_transit_gateway_multicast_group_source":       ec2.ResourceTransitGatewayMulticastGroupSource(),
-			"aws_ec2_transit_gateway_peering_attachment":           ec2.ResourceTransitGatewayPeeringAttachment(),
-			"aws_ec2_transit_gateway_peering_attachment_accepter":  ec2.ResourceTransitGatewayPeeringAttachmentAccepter(),
-			"aws_ec2_transit_gateway_prefix_list_reference":        ec2.ResourceTransitGatewayPrefixListReference(),
-			"aws_ec2_transit_gateway_route":                        ec2.ResourceTransitGatewayRoute(),
-			"aws_ec2_transit_gateway_route_table":                  ec2.ResourceTransitGatewayRouteTable(),
-			"aws_ec2_transit_gateway_route_table_association":      ec2.ResourceTransitGatewayRouteTableAssociation(),
-			"aws_ec2_transit_gateway_route_table_propagation":      ec2.ResourceTransitGatewayRouteTablePropagation(),
-			"aws_ec2_transit_gateway_vpc_attachment":               ec2.ResourceTransitGatewayVPCAttachment(),
-			"aws_ec2_transit_gateway_vpc_attachment_accepter":      ec2.ResourceTransitGatewayVPCAttachmentAccepter(),
-			"aws_egress_only_internet_gateway":                     ec2.ResourceEgressOnlyInternetGateway(),
-			"aws_eip":                                              ec2.ResourceEIP(),
-			"aws_eip_association":                                  ec2.ResourceEIPAssociation(),
-			"aws_flow_log":                                         ec2.ResourceFlowLog(),
-			"aws_instance":                                         ec2.ResourceInstance(),
-			"aws_internet_gateway":                                 ec2.ResourceInternetGateway(),
-			"aws_internet_gateway_attachment":                      ec2.ResourceInternetGatewayAttachment(),
-			"aws_key_pair":                                         ec2.ResourceKeyPair(),
-			"aws_launch_template":                                  ec2.ResourceLaunchTemplate(),
-			"aws_main_route_table_association":                     ec2.ResourceMainRouteTableAssociation(),
-			"aws_nat_gateway":                                      ec2.ResourceNATGateway(),
-			"aws_network_acl":                                      ec2.ResourceNetworkACL(),
-			"aws_network_acl_association":                          ec2.ResourceNetworkACLAssociation(),
-			"aws_network_acl_rule":                                 ec2.ResourceNetworkACLRule(),
-			"aws_network_interface":                                ec2.ResourceNetworkInterface(),
-			"aws_network_interface_attachment":                     ec2.ResourceNetworkInterfaceAttachment(),
-			"aws_network_interface_sg_attachment":                  ec2.ResourceNetworkInterfaceSGAttachment(),
-			"aws_placement_group":                                  ec2.ResourcePlacementGroup(),
-			"aws_route":                                            ec2.ResourceRoute(),
-			"aws_route_table":                                      ec2.ResourceRouteTable(),
-			"aws_route_table_association":                          ec2.ResourceRouteTableAssociation(),
-			"aws_security_group":                                   ec2.ResourceSecurityGroup(),
-			"aws_security_group_rule":                              ec2.ResourceSecurityGroupRule(),
-			"aws_snapshot_create_volume_permission":                ec2.ResourceSnapshotCreateVolumePermission(),
-			"aws_spot_datafeed_subscription":                       ec2.ResourceSpotDataFeedSubscription(),
-			"aws_spot_fleet_request":                               ec2.ResourceSpotFleetRequest(),
-			"aws_spot_instance_request":                            ec2.ResourceSpotInstanceRequest(),
-			"aws_subnet":                                           ec2.ResourceSubnet(),
-			"aws_volume_attachment":                                ec2.ResourceVolumeAttachment(),
-			"aws_vpc":                                              ec2.ResourceVPC(),
-			"aws_vpc_dhcp_options":                                 ec2.ResourceVPCDHCPOptions(),
-			"aws_vpc_dhcp_options_association":                     ec2.ResourceVPCDHCPOptionsAssociation(),
-			"aws_vpc_endpoint":                                     ec2.ResourceVPCEndpoint(),
-			"aws_vpc_endpoint_connection_accepter":                 ec2.ResourceVPCEndpointConnectionAccepter(),
-			"aws_vpc_endpoint_connection_notification":             ec2.ResourceVPCEndpointConnectionNotification(),
-			"aws_vpc_endpoint_policy":                              ec2.ResourceVPCEndpointPolicy(),
-			"aws_vpc_endpoint_route_table_association":             ec2.ResourceVPCEndpointRouteTableAssociation(),
-			"aws_vpc_endpoint_security_group_association":          ec2.ResourceVPCEndpointSecurityGroupAssociation(),
-			"aws_vpc_endpoint_service":                             ec2.ResourceVPCEndpointService(),
-			"aws_vpc_endpoint_service_allowed_principal":           ec2.ResourceVPCEndpointServiceAllowedPrincipal(),
-			"aws_vpc_endpoint_subnet_association":                  ec2.ResourceVPCEndpointSubnetAssociation(),
-			"aws_vpc_ipam":                                         ec2.ResourceIPAM(),
-			"aws_vpc_ipam_organization_admin_account":              ec2.ResourceIPAMOrganizationAdminAccount(),
-			"aws_vpc_ipam_pool":                                    ec2.ResourceIPAMPool(),
-			"aws_vpc_ipam_pool_cidr_allocation":                    ec2.ResourceIPAMPoolCIDRAllocation(),
-			"aws_vpc_ipam_pool_cidr":                               ec2.ResourceIPAMPoolCIDR(),
-			"aws_vpc_ipam_preview_next_cidr":                       ec2.ResourceIPAMPreviewNextCIDR(),
-			"aws_vpc_ipam_scope":                                   ec2.ResourceIPAMScope(),
-			"aws_vpc_ipv4_cidr_block_association":                  ec2.ResourceVPCIPv4CIDRBlockAssociation(),
-			"aws_vpc_ipv6_cidr_block_association":                  ec2.ResourceVPCIPv6CIDRBlockAssociation(),
-			"aws_vpc_peering_connection":                           ec2.ResourceVPCPeeringConnection(),
-			"aws_vpc_peering_connection_accepter":                  ec2.ResourceVPCPeeringConnectionAccepter(),
-			"aws_vpc_peering_connection_options":                   ec2.ResourceVPCPeeringConnectionOptions(),
-			"aws_vpn_connection":                                   ec2.ResourceVPNConnection(),
-			"aws_vpn_connection_route":                             ec2.ResourceVPNConnectionRoute(),
-			"aws_vpn_gateway":                                      ec2.ResourceVPNGateway(),
-			"aws_vpn_gateway_attachment":                           ec2.ResourceVPNGatewayAttachment(),
-			"aws_vpn_gateway_route_propagation":                    ec2.ResourceVPNGatewayRoutePropagation(),
+			"aws_ami":                                               ec2.ResourceAMI(),
+			"aws_ami_copy":                                          ec2.ResourceAMICopy(),
+			"aws_ami_from_instance":                                 ec2.ResourceAMIFromInstance(),
+			"aws_ami_launch_permission":                             ec2.ResourceAMILaunchPermission(),
+			"aws_customer_gateway":                                  ec2.ResourceCustomerGateway(),
+			"aws_default_network_acl":                               ec2.ResourceDefaultNetworkACL(),
+			"aws_default_route_table":                               ec2.ResourceDefaultRouteTable(),
+			"aws_default_security_group":                            ec2.ResourceDefaultSecurityGroup(),
+			"aws_default_subnet":                                    ec2.ResourceDefaultSubnet(),
+			"aws_default_vpc":                                       ec2.ResourceDefaultVPC(),
+			"aws_default_vpc_dhcp_options":                          ec2.ResourceDefaultVPCDHCPOptions(),
+			"aws_ebs_default_kms_key":                               ec2.ResourceEBSDefaultKMSKey(),
+			"aws_ebs_encryption_by_default":                         ec2.ResourceEBSEncryptionByDefault(),
+			"aws_ebs_snapshot":                                      ec2.ResourceEBSSnapshot(),
+			"aws_ebs_snapshot_copy":                                 ec2.ResourceEBSSnapshotCopy(),
+			"aws_ebs_snapshot_import":                               ec2.ResourceEBSSnapshotImport(),
+			"aws_ebs_volume":                                        ec2.ResourceEBSVolume(),
+			"aws_ec2_availability_zone_group":                       ec2.ResourceAvailabilityZoneGroup(),
+			"aws_ec2_capacity_reservation":                          ec2.ResourceCapacityReservation(),
+			"aws_ec2_capacity_reservation_fleet":                    ec2.ResourceCapacityReservationFleet(),
+			"aws_ec2_carrier_gateway":                               ec2.ResourceCarrierGateway(),
+			"aws_ec2_client_vpn_authorization_rule":                 ec2.ResourceClientVPNAuthorizationRule(),
+			"aws_ec2_client_vpn_endpoint":                           ec2.ResourceClientVPNEndpoint(),
+			"aws_ec2_client_vpn_network_association":                ec2.ResourceClientVPNNetworkAssociation(),
+			"aws_ec2_client_vpn_route":                              ec2.ResourceClientVPNRoute(),
+			"aws_ec2_fleet":                                         ec2.ResourceFleet(),
+			"aws_ec2_host":                                          ec2.ResourceHost(),
+			"aws_ec2_local_gateway_route":                           ec2.ResourceLocalGatewayRoute(),
+			"aws_ec2_local_gateway_route_table_vpc_association":     ec2.ResourceLocalGatewayRouteTableVPCAssociation(),
+			"aws_ec2_managed_prefix_list":                           ec2.ResourceManagedPrefixList(),
+			"aws_ec2_managed_prefix_list_entry":                     ec2.ResourceManagedPrefixListEntry(),
+			"aws_ec2_network_insights_path":                         ec2.ResourceNetworkInsightsPath(),
+			"aws_ec2_serial_console_access":                         ec2.ResourceSerialConsoleAccess(),
+			"aws_ec2_subnet_cidr_reservation":                       ec2.ResourceSubnetCIDRReservation(),
+			"aws_ec2_tag":                                           ec2.ResourceTag(),
+			"aws_ec2_traffic_mirror_filter":                         ec2.ResourceTrafficMirrorFilter(),
+			"aws_ec2_traffic_mirror_filter_rule":                    ec2.ResourceTrafficMirrorFilterRule(),
+			"aws_ec2_traffic_mirror_session":                        ec2.ResourceTrafficMirrorSession(),
+			"aws_ec2_traffic_mirror_target":                         ec2.ResourceTrafficMirrorTarget(),
+			"aws_ec2_transit_gateway":                               ec2.ResourceTransitGateway(),
+			"aws_ec2_transit_gateway_connect":                       ec2.ResourceTransitGatewayConnect(),
+			"aws_ec2_transit_gateway_connect_peer":                  ec2.ResourceTransitGatewayConnectPeer(),
+			"aws_ec2_transit_gateway_multicast_domain":              ec2.ResourceTransitGatewayMulticastDomain(),
+			"aws_ec2_transit_gateway_multicast_domain_association":  ec2.ResourceTransitGatewayMulticastDomainAssociation(),
+			"aws_ec2_transit_gateway_multicast_group_member":        ec2.ResourceTransitGatewayMulticastGroupMember(),
+			"aws_ec2_transit_gateway_multicast_group_source":        ec2.ResourceTransitGatewayMulticastGroupSource(),
+			"aws_ec2_transit_gateway_peering_attachment":            ec2.ResourceTransitGatewayPeeringAttachment(),
+			"aws_ec2_transit_gateway_peering_attachment_accepter":   ec2.ResourceTransitGatewayPeeringAttachmentAccepter(),
+			"aws_ec2_transit_gateway_prefix_list_reference":         ec2.ResourceTransitGatewayPrefixListReference(),
+			"aws_ec2_transit_gateway_route":                         ec2.ResourceTransitGatewayRoute(),
+			"aws_ec2_transit_gateway_route_table":                   ec2.ResourceTransitGatewayRouteTable(),
+			"aws_ec2_transit_gateway_route_table_association":       ec2.ResourceTransitGatewayRouteTableAssociation(),
+			"aws_ec2_transit_gateway_route_table_propagation":       ec2.ResourceTransitGatewayRouteTablePropagation(),
+			"aws_ec2_transit_gateway_vpc_attachment":                ec2.ResourceTransitGatewayVPCAttachment(),
+			"aws_ec2_transit_gateway_vpc_attachment_accepter":       ec2.ResourceTransitGatewayVPCAttachmentAccepter(),
+			"aws_egress_only_internet_gateway":                      ec2.ResourceEgressOnlyInternetGateway(),
+			"aws_eip":                                               ec2.ResourceEIP(),
+			"aws_eip_association":                                   ec2.ResourceEIPAssociation(),
+			"aws_flow_log":                                          ec2.ResourceFlowLog(),
+			"aws_instance":                                          ec2.ResourceInstance(),
+			"aws_internet_gateway":                                  ec2.ResourceInternetGateway(),
+			"aws_internet_gateway_attachment":                       ec2.ResourceInternetGatewayAttachment(),
+			"aws_key_pair":                                          ec2.ResourceKeyPair(),
+			"aws_launch_template":                                   ec2.ResourceLaunchTemplate(),
+			"aws_main_route_table_association":                      ec2.ResourceMainRouteTableAssociation(),
+			"aws_nat_gateway":                                       ec2.ResourceNATGateway(),
+			"aws_network_acl":                                       ec2.ResourceNetworkACL(),
+			"aws_network_acl_association":                           ec2.ResourceNetworkACLAssociation(),
+			"aws_network_acl_rule":                                  ec2.ResourceNetworkACLRule(),
+			"aws_network_interface":                                 ec2.ResourceNetworkInterface(),
+			"aws_network_interface_attachment":                      ec2.ResourceNetworkInterfaceAttachment(),
+			"aws_network_interface_sg_attachment":                   ec2.ResourceNetworkInterfaceSGAttachment(),
+			"aws_placement_group":                                   ec2.ResourcePlacementGroup(),
+			"aws_route":                                             ec2.ResourceRoute(),
+			"aws_route_table":                                       ec2.ResourceRouteTable(),
+			"aws_route_table_association":                           ec2.ResourceRouteTableAssociation(),
+			"aws_security_group":                                    ec2.ResourceSecurityGroup(),
+			"aws_security_group_rule":                               ec2.ResourceSecurityGroupRule(),
+			"aws_snapshot_create_volume_permission":                 ec2.ResourceSnapshotCreateVolumePermission(),
+			"aws_spot_datafeed_subscription":                        ec2.ResourceSpotDataFeedSubscription(),
+			"aws_spot_fleet_request":                                ec2.ResourceSpotFleetRequest(),
+			"aws_spot_instance_request":                             ec2.ResourceSpotInstanceRequest(),
+			"aws_subnet":                                            ec2.ResourceSubnet(),
+			"aws_volume_attachment":                                 ec2.ResourceVolumeAttachment(),
+			"aws_verifiedaccess_endpoint":                           ec2.ResourceVerifiedAccessEndpoint(),
+			"aws_verifiedaccess_group":                              ec2.ResourceVerifiedAccessGroup(),
+			"aws_verifiedaccess_instance":                           ec2.ResourceVerifiedAccessInstance(),
+			"aws_verifiedaccess_instance_logging_configuration":     ec2.ResourceVerifiedAccessInstanceLoggingConfiguration(),
+			"aws_verifiedaccess_instance_trust_provider_attachment": ec2.ResourceVerifiedAccessInstanceTrustProviderAttachment(),
+			"aws_verifiedaccess_trust_provider":                     ec2.ResourceVerifiedAccessTrustProvider(),
+			"aws_vpc":                                               ec2.ResourceVPC(),
+			"aws_vpc_dhcp_options":                                  ec2.ResourceVPCDHCPOptions(),
+			"aws_vpc_dhcp_options_association":                      ec2.ResourceVPCDHCPOptionsAssociation(),
+			"aws_vpc_endpoint":                                      ec2.ResourceVPCEndpoint(),
+			"aws_vpc_endpoint_connection_accepter":                  ec2.ResourceVPCEndpointConnectionAccepter(),
+			"aws_vpc_endpoint_connection_notification":              ec2.ResourceVPCEndpointConnectionNotification(),
+			"aws_vpc_endpoint_policy":                               ec2.ResourceVPCEndpointPolicy(),
+			"aws_vpc_endpoint_route_table_association":              ec2.ResourceVPCEndpointRouteTableAssociation(),
+			"aws_vpc_endpoint_security_group_association":           ec2.ResourceVPCEndpointSecurityGroupAssociation(),
+			"aws_vpc_endpoint_service":                              ec2.ResourceVPCEndpointService(),
+			"aws_vpc_endpoint_service_allowed_principal":            ec2.ResourceVPCEndpointServiceAllowedPrincipal(),
+			"aws_vpc_endpoint_subnet_association":                   ec2.ResourceVPCEndpointSubnetAssociation(),
+			"aws_vpc_ipam":                                          ec2.ResourceIPAM(),
+			"aws_vpc_ipam_organization_admin_account":               ec2.ResourceIPAMOrganizationAdminAccount(),
+			"aws_vpc_ipam_pool":                                     ec2.ResourceIPAMPool(),
+			"aws_vpc_ipam_pool_cidr_allocation":                     ec2.ResourceIPAMPoolCIDRAllocation(),
+			"aws_vpc_ipam_pool_cidr":                                ec2.ResourceIPAMPoolCIDR(),
+			"aws_vpc_ipam_preview_next_cidr":                        ec2.ResourceIPAMPreviewNextCIDR(),
+			"aws_vpc_ipam_scope":                                    ec2.ResourceIPAMScope(),
+			"aws_vpc_ipv4_cidr_block_association":                   ec2.ResourceVPCIPv4CIDRBlockAssociation(),
+			"aws_vpc_ipv6_cidr_block_association":                   ec2.ResourceVPCIPv6CIDRBlockAssociation(),
+			"aws_vpc_peering_connection":                            ec2.ResourceVPCPeeringConnection(),
+			"aws_vpc_peering_connection_accepter":                   ec2.ResourceVPCPeeringConnectionAccepter(),
+			"aws_vpc_peering_connection_options":                    ec2.ResourceVPCPeeringConnectionOptions(),
+			"aws_vpn_connection":                                    ec2.ResourceVPNConnection(),
+			"aws_vpn_connection_route":                              ec2.ResourceVPNConnectionRoute(),
+			"aws_vpn_gateway":                                       ec2.ResourceVPNGateway(),
+			"aws_vpn_gateway_attachment":                            ec2.ResourceVPNGatewayAttachment(),
+			"aws_vpn_gateway_route_propagation":                     ec2.ResourceVPNGatewayRoutePropagation(),
 
 			"aws_ecr_lifecycle_policy":                ecr.ResourceLifecyclePolicy(),
 			"aws_ecr_pull_through_cache_rule":         ecr.ResourcePullThroughCacheRule(),
@@ -1441,6 +1550,7 @@ func Provider() *schema.Provider {
 			"aws_elastic_beanstalk_application_version":    elasticbeanstalk.ResourceApplicationVersion(),
 			"aws_elastic_beanstalk_configuration_template": elasticbeanstalk.ResourceConfigurationTemplate(),
 			"aws_elastic_beanstalk_environment":            elasticbeanstalk.ResourceEnvironment(),
+			"aws_elastic_beanstalk_environment_cname_swap": elasticbeanstalk.ResourceEnvironmentCNAMESwap(),
 
 			"aws_elasticsearch_domain":              elasticsearch.ResourceDomain(),
 			"aws_elasticsearch_domain_policy":       elasticsearch.ResourceDomainPolicy(),
@@ -1480,12 +1590,20 @@ func Provider() *schema.Provider {
 			"aws_emr_studio":                 emr.ResourceStudio(),
 			"aws_emr_studio_session_mapping": emr.ResourceStudioSessionMapping(),
 
-			"aws_emrcontainers_virtual_cluster": emrcontainers.ResourceVirtualCluster(),
+			"aws_emrcontainers_managed_endpoint":       emrcontainers.ResourceManagedEndpoint(),
+			"aws_emrcontainers_security_configuration": emrcontainers.ResourceSecurityConfiguration(),
+			"aws_emrcontainers_virtual_cluster":        emrcontainers.ResourceVirtualCluster(),
 
 			"aws_emrserverless_application": emrserverless.ResourceApplication(),
 
+			"aws_entityresolution_id_mapping_workflow": entityresolution.ResourceIDMappingWorkflow(),
+			"aws_entityresolution_id_namespace":        entityresolution.ResourceIDNamespace(),
+			"aws_entityresolution_matching_workflow":   entityresolution.ResourceMatchingWorkflow(),
+			"aws_entityresolution_schema_mapping":      entityresolution.ResourceSchemaMapping(),
+
 			"aws_kinesis_firehose_delivery_stream": firehose.ResourceDeliveryStream(),
 
+			"aws_fis_experiment":          fis.ResourceExperiment(),
 			"aws_fis_experiment_template": fis.ResourceExperimentTemplate(),
 
 			"aws_fms_admin_account": fms.ResourceAdminAccount(),
@@ -1512,9 +1630,10 @@ func Provider() *schema.Provider {
 			"aws_glacier_vault":      glacier.ResourceVault(),
 			"aws_glacier_vault_lock": glacier.ResourceVaultLock(),
 
-			"aws_globalaccelerator_accelerator":    globalaccelerator.ResourceAccelerator(),
-			"aws_globalaccelerator_endpoint_group": globalaccelerator.ResourceEndpointGroup(),
-			"aws_globalaccelerator_listener":       globalaccelerator.ResourceListener(),
+			"aws_globalaccelerator_accelerator":                     globalaccelerator.ResourceAccelerator(),
+			"aws_globalaccelerator_custom_routing_endpoint_traffic": globalaccelerator.ResourceCustomRoutingEndpointTraffic(),
+			"aws_globalaccelerator_endpoint_group":                  globalaccelerator.ResourceEndpointGroup(),
+			"aws_globalaccelerator_listener":                        globalaccelerator.ResourceListener(),
 
 			"aws_glue_catalog_database":                 glue.ResourceCatalogDatabase(),
 			"aws_glue_catalog_table":                    glue.ResourceCatalogTable(),
@@ -1522,6 +1641,7 @@ func Provider() *schema.Provider {
 			"aws_glue_connection":                       glue.ResourceConnection(),
 			"aws_glue_crawler":                          glue.ResourceCrawler(),
 			"aws_glue_data_catalog_encryption_settings": glue.ResourceDataCatalogEncryptionSettings(),
+			"aws_glue_data_quality_ruleset":             glue.ResourceDataQualityRuleset(),
 			"aws_glue_dev_endpoint":                     glue.ResourceDevEndpoint(),
 			"aws_glue_job":                              glue.ResourceJob(),
 			"aws_glue_ml_transform":                     glue.ResourceMLTransform(),
@@ -1535,47 +1655,60 @@ func Provider() *schema.Provider {
 			"aws_glue_user_defined_function":            glue.ResourceUserDefinedFunction(),
 			"aws_glue_workflow":                         glue.ResourceWorkflow(),
 
-			"aws_grafana_license_association":          grafana.ResourceLicenseAssociation(),
-			"aws_grafana_role_association":             grafana.ResourceRoleAssociation(),
-			"aws_grafana_workspace":                    grafana.ResourceWorkspace(),
-			"aws_grafana_workspace_saml_configuration": grafana.ResourceWorkspaceSAMLConfiguration(),
-
-			"aws_guardduty_detector":                   guardduty.ResourceDetector(),
-			"aws_guardduty_filter":                     guardduty.ResourceFilter(),
-			"aws_guardduty_invite_accepter":            guardduty.ResourceInviteAccepter(),
-			"aws_guardduty_ipset":                      guardduty.ResourceIPSet(),
-			"aws_guardduty_member":                     guardduty.ResourceMember(),
-			"aws_guardduty_organization_admin_account": guardduty.ResourceOrganizationAdminAccount(),
-			"aws_guardduty_organization_configuration": guardduty.ResourceOrganizationConfiguration(),
-			"aws_guardduty_publishing_destination":     guardduty.ResourcePublishingDestination(),
-			"aws_guardduty_threatintelset":             guardduty.ResourceThreatintelset(),
-
-			"aws_iam_access_key":                  iam.ResourceAccessKey(),
-			"aws_iam_account_alias":               iam.ResourceAccountAlias(),
-			"aws_iam_account_password_policy":     iam.ResourceAccountPasswordPolicy(),
-			"aws_iam_group":                       iam.ResourceGroup(),
-			"aws_iam_group_membership":            iam.ResourceGroupMembership(),
-			"aws_iam_group_policy":                iam.ResourceGroupPolicy(),
-			"aws_iam_group_policy_attachment":     iam.ResourceGroupPolicyAttachment(),
-			"aws_iam_instance_profile":            iam.ResourceInstanceProfile(),
-			"aws_iam_openid_connect_provider":     iam.ResourceOpenIDConnectProvider(),
-			"aws_iam_policy":                      iam.ResourcePolicy(),
-			"aws_iam_policy_attachment":           iam.ResourcePolicyAttachment(),
-			"aws_iam_role":                        iam.ResourceRole(),
-			"aws_iam_role_policy":                 iam.ResourceRolePolicy(),
-			"aws_iam_role_policy_attachment":      iam.ResourceRolePolicyAttachment(),
-			"aws_iam_saml_provider":               iam.ResourceSAMLProvider(),
-			"aws_iam_server_certificate":          iam.ResourceServerCertificate(),
-			"aws_iam_service_linked_role":         iam.ResourceServiceLinkedRole(),
-			"aws_iam_service_specific_credential": iam.ResourceServiceSpecificCredential(),
-			"aws_iam_signing_certificate":         iam.ResourceSigningCertificate(),
-			"aws_iam_user":                        iam.ResourceUser(),
-			"aws_iam_user_group_membership":       iam.ResourceUserGroupMembership(),
-			"aws_iam_user_login_profile":          iam.ResourceUserLoginProfile(),
-			"aws_iam_user_policy":                 iam.ResourceUserPolicy(),
-			"aws_iam_user_policy_attachment":      iam.ResourceUserPolicyAttachment(),
-			"aws_iam_user_ssh_key":                iam.ResourceUserSSHKey(),
-			"aws_iam_virtual_mfa_device":          iam.ResourceVirtualMFADevice(),
+			"aws_grafana_license_association":             grafana.ResourceLicenseAssociation(),
+			"aws_grafana_role_association":                grafana.ResourceRoleAssociation(),
+			"aws_grafana_workspace":                       grafana.ResourceWorkspace(),
+			"aws_grafana_workspace_saml_configuration":    grafana.ResourceWorkspaceSAMLConfiguration(),
+			"aws_grafana_workspace_service_account":       grafana.ResourceWorkspaceServiceAccount(),
+			"aws_grafana_workspace_service_account_token": grafana.ResourceWorkspaceServiceAccountToken(),
+
+			"aws_guardduty_detector":                           guardduty.ResourceDetector(),
+			"aws_guardduty_detector_feature":                   guardduty.ResourceDetectorFeature(),
+			"aws_guardduty_filter":                             guardduty.ResourceFilter(),
+			"aws_guardduty_invite_accepter":                    guardduty.ResourceInviteAccepter(),
+			"aws_guardduty_ipset":                              guardduty.ResourceIPSet(),
+			"aws_guardduty_malware_protection_plan":            guardduty.ResourceMalwareProtectionPlan(),
+			"aws_guardduty_member":                             guardduty.ResourceMember(),
+			"aws_guardduty_organization_admin_account":         guardduty.ResourceOrganizationAdminAccount(),
+			"aws_guardduty_organization_configuration":         guardduty.ResourceOrganizationConfiguration(),
+			"aws_guardduty_organization_configuration_feature": guardduty.ResourceOrganizationConfigurationFeature(),
+			"aws_guardduty_publishing_destination":             guardduty.ResourcePublishingDestination(),
+			"aws_guardduty_threatintelset":                     guardduty.ResourceThreatintelset(),
+
+			"aws_iam_access_key":                         iam.ResourceAccessKey(),
+			"aws_iam_account_alias":                      iam.ResourceAccountAlias(),
+			"aws_iam_account_password_policy":            iam.ResourceAccountPasswordPolicy(),
+			"aws_iam_group":                              iam.ResourceGroup(),
+			"aws_iam_group_membership":                   iam.ResourceGroupMembership(),
+			"aws_iam_group_policies_exclusive":           iam.ResourceGroupPoliciesExclusive(),
+			"aws_iam_group_policy":                       iam.ResourceGroupPolicy(),
+			"aws_iam_group_policy_attachment":            iam.ResourceGroupPolicyAttachment(),
+			"aws_iam_group_policy_attachments_exclusive": iam.ResourceGroupPolicyAttachmentsExclusive(),
+			"aws_iam_instance_profile":                   iam.ResourceInstanceProfile(),
+			"aws_iam_openid_connect_provider":            iam.ResourceOpenIDConnectProvider(),
+			"aws_iam_policy":                             iam.ResourcePolicy(),
+			"aws_iam_policy_attachment":                  iam.ResourcePolicyAttachment(),
+			"aws_iam_role":                               iam.ResourceRole(),
+			"aws_iam_role_policies_exclusive":            iam.ResourceRolePoliciesExclusive(),
+			"aws_iam_role_policy":                        iam.ResourceRolePolicy(),
+			"aws_iam_role_policy_attachment":             iam.ResourceRolePolicyAttachment(),
+			"aws_iam_role_policy_attachments_exclusive":  iam.ResourceRolePolicyAttachmentsExclusive(),
+			"aws_iam_saml_provider":                      iam.ResourceSAMLProvider(),
+			"aws_iam_server_certificate":                 iam.ResourceServerCertificate(),
+			"aws_iam_service_linked_role":                iam.ResourceServiceLinkedRole(),
+			"aws_iam_service_specific_credential":        iam.ResourceServiceSpecificCredential(),
+			"aws_iam_signing_certificate":                iam.ResourceSigningCertificate(),
+			"aws_iam_user":                               iam.ResourceUser(),
+			"aws_iam_user_group_membership":              iam.ResourceUserGroupMembership(),
+			"aws_iam_user_login_profile":                 iam.ResourceUserLoginProfile(),
+			"aws_iam_user_policies_exclusive":            iam.ResourceUserPoliciesExclusive(),
+			"aws_iam_user_policy":                        iam.ResourceUserPolicy(),
+			"aws_iam_user_policy_attachment":             iam.ResourceUserPolicyAttachment(),
+			"aws_iam_user_policy_attachments_exclusive":  iam.ResourceUserPolicyAttachmentsExclusive(),
+			"aws_iam_user_ssh_key":                       iam.ResourceUserSSHKey(),
+			"aws_iam_virtual_mfa_device":                 iam.ResourceVirtualMFADevice(),
+
+			"aws_identitystore_group_memberships": identitystore.ResourceGroupMemberships(),
 
 			"aws_imagebuilder_component":                    imagebuilder.ResourceComponent(),
 			"aws_imagebuilder_container_recipe":             imagebuilder.ResourceContainerRecipe(),
@@ -1589,6 +1722,9 @@ func Provider() *schema.Provider {
 			"aws_inspector_assessment_template": inspector.ResourceAssessmentTemplate(),
 			"aws_inspector_resource_group":      inspector.ResourceResourceGroup(),
 
+			"aws_inspector2_filter":                     inspector2.ResourceFilter(),
+			"aws_inspector2_organization_configuration": inspector2.ResourceOrganizationConfiguration(),
+
 			"aws_iot_authorizer":                 iot.ResourceAuthorizer(),
 			"aws_iot_certificate":                iot.ResourceCertificate(),
 			"aws_iot_indexing_configuration":     iot.ResourceIndexingConfiguration(),
@@ -1605,9 +1741,18 @@ func Provider() *schema.Provider {
 			"aws_iot_topic_rule":                 iot.ResourceTopicRule(),
 			"aws_iot_topic_rule_destination":     iot.ResourceTopicRuleDestination(),
 
+			"aws_ivs_encoder_configuration": ivs.ResourceEncoderConfiguration(),
+			"aws_ivs_stage":                 ivs.ResourceStage(),
+			"aws_ivs_storage_configuration": ivs.ResourceStorageConfiguration(),
+
+			"aws_ivschat_logging_configuration": ivschat.ResourceLoggingConfiguration(),
+			"aws_ivschat_room":                  ivschat.ResourceRoom(),
+
 			"aws_msk_cluster":                  kafka.ResourceCluster(),
 			"aws_msk_configuration":            kafka.ResourceConfiguration(),
+			"aws_msk_replicator":               kafka.ResourceReplicator(),
 			"aws_msk_scram_secret_association": kafka.ResourceScramSecretAssociation(),
+			"aws_msk_vpc_connection":           kafka.ResourceVPCConnection(),
 
 			"aws_mskconnect_connector":            kafkaconnect.ResourceConnector(),
 			"aws_mskconnect_custom_plugin":        kafkaconnect.ResourceCustomPlugin(),
@@ -1622,6 +1767,7 @@ func Provider() *schema.Provider {
 			"aws_keyspaces_keyspace": keyspaces.ResourceKeyspace(),
 			"aws_keyspaces_table":    keyspaces.ResourceTable(),
 
+			"aws_kinesis_resource_policy": kinesis.ResourceResourcePolicy(),
 			"aws_kinesis_stream":          kinesis.ResourceStream(),
 			"aws_kinesis_stream_consumer": kinesis.ResourceStreamConsumer(),
 
@@ -1631,13 +1777,15 @@ func Provider() *schema.Provider {
 
 			"aws_kinesis_video_stream": kinesisvideo.ResourceStream(),
 
-			"aws_kms_alias":                kms.ResourceAlias(),
-			"aws_kms_ciphertext":           kms.ResourceCiphertext(),
-			"aws_kms_external_key":         kms.ResourceExternalKey(),
-			"aws_kms_grant":                kms.ResourceGrant(),
-			"aws_kms_key":                  kms.ResourceKey(),
-			"aws_kms_replica_external_key": kms.ResourceReplicaExternalKey(),
-			"aws_kms_replica_key":          kms.ResourceReplicaKey(),
+			"aws_kms_alias":                  kms.ResourceAlias(),
+			"aws_kms_ciphertext":             kms.ResourceCiphertext(),
+			"aws_kms_custom_key_store":       kms.ResourceCustomKeyStore(),
+			"aws_kms_external_key":           kms.ResourceExternalKey(),
+			"aws_kms_grant":                  kms.ResourceGrant(),
+			"aws_kms_key":                    kms.ResourceKey(),
+			"aws_kms_key_on_demand_rotation": kms.ResourceKeyOnDemandRotation(),
+			"aws_kms_replica_external_key":   kms.ResourceReplicaExternalKey(),
+			"aws_kms_replica_key":            kms.ResourceReplicaKey(),
 
 			"aws_lakeformation_data_lake_settings": lakeformation.ResourceDataLakeSettings(),
 			"aws_lakeformation_lf_tag":             lakeformation.ResourceLFTag(),
@@ -1681,9 +1829,6 @@ func Provider() *schema.Provider {
 			"aws_location_route_calculator":    location.ResourceRouteCalculator(),
 			"aws_location_tracker":             location.ResourceTracker(),
 
-			"aws_macie_member_account_association": macie.ResourceMemberAccountAssociation(),
-			"aws_macie_s3_bucket_association":      macie.ResourceS3BucketAssociation(),
-
 			"aws_macie2_account":                    macie2.ResourceAccount(),
 			"aws_macie2_classification_job":         macie2.ResourceClassificationJob(),
 			"aws_macie2_custom_data_identifier":     macie2.ResourceCustomDataIdentifier(),
@@ -1692,10 +1837,20 @@ func Provider() *schema.Provider {
 			"aws_macie2_member":                     macie2.ResourceMember(),
 			"aws_macie2_organization_admin_account": macie2.ResourceOrganizationAdminAccount(),
 
+			"aws_mailmanager_archive":        mailmanager.ResourceArchive(),
+			"aws_mailmanager_ingress_point":  mailmanager.ResourceIngressPoint(),
+			"aws_mailmanager_rule_set":       mailmanager.ResourceRuleSet(),
+			"aws_mailmanager_traffic_policy": mailmanager.ResourceTrafficPolicy(),
+
 			"aws_media_convert_queue": mediaconvert.ResourceQueue(),
 
 			"aws_media_package_channel": mediapackage.ResourceChannel(),
 
+			"aws_mediapackagev2_channel":         mediapackagev2.ResourceChannel(),
+			"aws_mediapackagev2_channel_group":   mediapackagev2.ResourceChannelGroup(),
+			"aws_mediapackagev2_channel_policy":  mediapackagev2.ResourceChannelPolicy(),
+			"aws_mediapackagev2_origin_endpoint": mediapackagev2.ResourceOriginEndpoint(),
+
 			"aws_media_store_container":        mediastore.ResourceContainer(),
 			"aws_media_store_container_policy": mediastore.ResourceContainerPolicy(),
 
@@ -1720,11 +1875,12 @@ func Provider() *schema.Provider {
 			"aws_neptune_parameter_group":         neptune.ResourceParameterGroup(),
 			"aws_neptune_subnet_group":            neptune.ResourceSubnetGroup(),
 
-			"aws_networkfirewall_firewall":              networkfirewall.ResourceFirewall(),
-			"aws_networkfirewall_firewall_policy":       networkfirewall.ResourceFirewallPolicy(),
-			"aws_networkfirewall_logging_configuration": networkfirewall.ResourceLoggingConfiguration(),
-			"aws_networkfirewall_resource_policy":       networkfirewall.ResourceResourcePolicy(),
-			"aws_networkfirewall_rule_group":            networkfirewall.ResourceRuleGroup(),
+			"aws_networkfirewall_firewall":                     networkfirewall.ResourceFirewall(),
+			"aws_networkfirewall_firewall_policy":              networkfirewall.ResourceFirewallPolicy(),
+			"aws_networkfirewall_logging_configuration":        networkfirewall.ResourceLoggingConfiguration(),
+			"aws_networkfirewall_resource_policy":              networkfirewall.ResourceResourcePolicy(),
+			"aws_networkfirewall_rule_group":                   networkfirewall.ResourceRuleGroup(),
+			"aws_networkfirewall_tls_inspection_configuration": networkfirewall.ResourceTLSInspectionConfiguration(),
 
 			"aws_networkmanager_connection":                               networkmanager.ResourceConnection(),
 			"aws_networkmanager_customer_gateway_association":             networkmanager.ResourceCustomerGatewayAssociation(),
@@ -1736,6 +1892,8 @@ func Provider() *schema.Provider {
 			"aws_networkmanager_transit_gateway_connect_peer_association": networkmanager.ResourceTransitGatewayConnectPeerAssociation(),
 			"aws_networkmanager_transit_gateway_registration":             networkmanager.ResourceTransitGatewayRegistration(),
 
+			"aws_oam_link": oam.ResourceLink(),
+
 			"aws_opensearch_domain":              opensearch.ResourceDomain(),
 			"aws_opensearch_domain_policy":       opensearch.ResourceDomainPolicy(),
 			"aws_opensearch_domain_saml_options": opensearch.ResourceDomainSAMLOptions(),
@@ -1764,6 +1922,18 @@ func Provider() *schema.Provider {
 			"aws_organizations_organizational_unit":     organizations.ResourceOrganizationalUnit(),
 			"aws_organizations_policy":                  organizations.ResourcePolicy(),
 			"aws_organizations_policy_attachment":       organizations.ResourcePolicyAttachment(),
+			"aws_organizations_resource_policy":         organizations.ResourceResourcePolicy(),
+
+			"aws_paymentcryptography_key":       paymentcryptography.ResourceKey(),
+			"aws_paymentcryptography_key_alias": paymentcryptography.ResourceKeyAlias(),
+
+			"aws_pcaconnectorad_connector":              pcaconnectorad.ResourceConnector(),
+			"aws_pcaconnectorad_directory_registration": pcaconnectorad.ResourceDirectoryRegistration(),
+			"aws_pcaconnectorad_service_principal_name": pcaconnectorad.ResourceServicePrincipalName(),
+			"aws_pcaconnectorad_template":               pcaconnectorad.ResourceTemplate(),
+
+			"aws_pcaconnectorscep_challenge": pcaconnectorscep.ResourceChallenge(),
+			"aws_pcaconnectorscep_connector": pcaconnectorscep.ResourceConnector(),
 
 			"aws_pinpoint_adm_channel":               pinpoint.ResourceADMChannel(),
 			"aws_pinpoint_apns_channel":              pinpoint.ResourceAPNSChannel(),
@@ -1831,7 +2001,13 @@ func Provider() *schema.Provider {
 
 			"aws_redshiftdata_statement": redshiftdata.ResourceStatement(),
 
-			"aws_redshiftserverless_namespace": redshiftserverless.ResourceNamespace(),
+			"aws_redshiftserverless_namespace":        redshiftserverless.ResourceNamespace(),
+			"aws_redshiftserverless_scheduled_action": redshiftserverless.ResourceScheduledAction(),
+			"aws_redshiftserverless_snapshot_copy":    redshiftserverless.ResourceSnapshotCopy(),
+
+			"aws_resiliencehub_app":               resiliencehub.ResourceApp(),
+			"aws_resiliencehub_app_version":       resiliencehub.ResourceAppVersion(),
+			"aws_resiliencehub_resiliency_policy": resiliencehub.ResourceResiliencyPolicy(),
 
 			"aws_resourcegroups_group": resourcegroups.ResourceGroup(),
 
@@ -1869,6 +2045,7 @@ func Provider() *schema.Provider {
 			"aws_route53_resolver_firewall_rule":                   route53resolver.ResourceFirewallRule(),
 			"aws_route53_resolver_firewall_rule_group":             route53resolver.ResourceFirewallRuleGroup(),
 			"aws_route53_resolver_firewall_rule_group_association": route53resolver.ResourceFirewallRuleGroupAssociation(),
+			"aws_route53_resolver_outpost_resolver":                route53resolver.ResourceOutpostResolver(),
 			"aws_route53_resolver_query_log_config":                route53resolver.ResourceQueryLogConfig(),
 			"aws_route53_resolver_query_log_config_association":    route53resolver.ResourceQueryLogConfigAssociation(),
 			"aws_route53_resolver_rule":                            route53resolver.ResourceRule(),
@@ -1917,6 +2094,7 @@ func Provider() *schema.Provider {
 			"aws_sagemaker_device":                                    sagemaker.ResourceDevice(),
 			"aws_sagemaker_device_fleet":                              sagemaker.ResourceDeviceFleet(),
 			"aws_sagemaker_domain":                                    sagemaker.ResourceDomain(),
+			"aws_sagemaker_domain_default_project_templates":          sagemaker.ResourceDomainDefaultProjectTemplates(),
 			"aws_sagemaker_endpoint":                                  sagemaker.ResourceEndpoint(),
 			"aws_sagemaker_endpoint_configuration":                    sagemaker.ResourceEndpointConfiguration(),
 			"aws_sagemaker_feature_group":                             sagemaker.ResourceFeatureGroup(),
@@ -1930,6 +2108,7 @@ func Provider() *schema.Provider {
 			"aws_sagemaker_notebook_instance":                         sagemaker.ResourceNotebookInstance(),
 			"aws_sagemaker_notebook_instance_lifecycle_configuration": sagemaker.ResourceNotebookInstanceLifeCycleConfiguration(),
 			"aws_sagemaker_project":                                   sagemaker.ResourceProject(),
+			"aws_sagemaker_servicecatalog_portfolio_status":           sagemaker.ResourceServicecatalogPortfolioStatus(),
 			"aws_sagemaker_studio_lifecycle_config":                   sagemaker.ResourceStudioLifecycleConfig(),
 			"aws_sagemaker_user_profile":                              sagemaker.ResourceUserProfile(),
 			"aws_sagemaker_workforce":                                 sagemaker.ResourceWorkforce(),
@@ -1939,22 +2118,26 @@ func Provider() *schema.Provider {
 			"aws_schemas_registry":   schemas.ResourceRegistry(),
 			"aws_schemas_schema":     schemas.ResourceSchema(),
 
-			"aws_secretsmanager_secret":          secretsmanager.ResourceSecret(),
-			"aws_secretsmanager_secret_policy":   secretsmanager.ResourceSecretPolicy(),
-			"aws_secretsmanager_secret_rotation": secretsmanager.ResourceSecretRotation(),
-			"aws_secretsmanager_secret_version":  secretsmanager.ResourceSecretVersion(),
-
-			"aws_securityhub_account":                    securityhub.ResourceAccount(),
-			"aws_securityhub_action_target":              securityhub.ResourceActionTarget(),
-			"aws_securityhub_insight":                    securityhub.ResourceInsight(),
-			"aws_securityhub_invite_accepter":            securityhub.ResourceInviteAccepter(),
-			"aws_securityhub_member":                     securityhub.ResourceMember(),
-			"aws_securityhub_organization_admin_account": securityhub.ResourceOrganizationAdminAccount(),
-			"aws_securityhub_organization_configuration": securityhub.ResourceOrganizationConfiguration(),
-			"aws_securityhub_product_subscription":       securityhub.ResourceProductSubscription(),
-			"aws_securityhub_standards_control":          securityhub.ResourceStandardsControl(),
-			"aws_securityhub_standards_subscription":     securityhub.ResourceStandardsSubscription(),
-			"aws_securityhub_finding_aggregator":         securityhub.ResourceFindingAggregator(),
+			"aws_secretsmanager_secret":                  secretsmanager.ResourceSecret(),
+			"aws_secretsmanager_secret_managed_rotation": secretsmanager.ResourceSecretManagedRotation(),
+			"aws_secretsmanager_secret_policy":           secretsmanager.ResourceSecretPolicy(),
+			"aws_secretsmanager_secret_rotation":         secretsmanager.ResourceSecretRotation(),
+			"aws_secretsmanager_secret_version":          secretsmanager.ResourceSecretVersion(),
+
+			"aws_securityhub_account":                          securityhub.ResourceAccount(),
+			"aws_securityhub_action_target":                    securityhub.ResourceActionTarget(),
+			"aws_securityhub_automation_rule":                  securityhub.ResourceAutomationRule(),
+			"aws_securityhub_configuration_policy":             securityhub.ResourceConfigurationPolicy(),
+			"aws_securityhub_configuration_policy_association": securityhub.ResourceConfigurationPolicyAssociation(),
+			"aws_securityhub_insight":                          securityhub.ResourceInsight(),
+			"aws_securityhub_invite_accepter":                  securityhub.ResourceInviteAccepter(),
+			"aws_securityhub_member":                           securityhub.ResourceMember(),
+			"aws_securityhub_organization_admin_account":       securityhub.ResourceOrganizationAdminAccount(),
+			"aws_securityhub_organization_configuration":       securityhub.ResourceOrganizationConfiguration(),
+			"aws_securityhub_product_subscription":             securityhub.ResourceProductSubscription(),
+			"aws_securityhub_standards_control":                securityhub.ResourceStandardsControl(),
+			"aws_securityhub_standards_subscription":           securityhub.ResourceStandardsSubscription(),
+			"aws_securityhub_finding_aggregator":               securityhub.ResourceFindingAggregator(),
 
 			"aws_serverlessapplicationrepository_cloudformation_stack": serverlessrepo.ResourceCloudFormationStack(),
 
@@ -1995,12 +2178,17 @@ func Provider() *schema.Provider {
 			"aws_ses_receipt_rule_set":             ses.ResourceReceiptRuleSet(),
 			"aws_ses_template":                     ses.ResourceTemplate(),
 
+			"aws_sesv2_account_vdm_attributes": sesv2.ResourceAccountVDMAttributes(),
+			"aws_sesv2_configuration_set":      sesv2.ResourceConfigurationSet(),
+
 			"aws_sfn_activity":      sfn.ResourceActivity(),
 			"aws_sfn_state_machine": sfn.ResourceStateMachine(),
 
-			"aws_shield_protection":                          shield.ResourceProtection(),
-			"aws_shield_protection_group":                    shield.ResourceProtectionGroup(),
-			"aws_shield_protection_health_check_association": shield.ResourceProtectionHealthCheckAssociation(),
+			"aws_shield_application_layer_automatic_response": shield.ResourceApplicationLayerAutomaticResponse(),
+			"aws_shield_proactive_engagement":                 shield.ResourceProactiveEngagement(),
+			"aws_shield_protection":                           shield.ResourceProtection(),
+			"aws_shield_protection_group":                     shield.ResourceProtectionGroup(),
+			"aws_shield_protection_health_check_association":  shield.ResourceProtectionHealthCheckAssociation(),
 
 			"aws_signer_signing_job":                signer.ResourceSigningJob(),
 			"aws_signer_signing_profile":            signer.ResourceSigningProfile(),
@@ -2008,11 +2196,12 @@ func Provider() *schema.Provider {
 
 			"aws_simpledb_domain": simpledb.ResourceDomain(),
 
-			"aws_sns_platform_application": sns.ResourcePlatformApplication(),
-			"aws_sns_sms_preferences":      sns.ResourceSMSPreferences(),
-			"aws_sns_topic":                sns.ResourceTopic(),
-			"aws_sns_topic_policy":         sns.ResourceTopicPolicy(),
-			"aws_sns_topic_subscription":   sns.ResourceTopicSubscription(),
+			"aws_sns_platform_application":         sns.ResourcePlatformApplication(),
+			"aws_sns_sms_preferences":              sns.ResourceSMSPreferences(),
+			"aws_sns_topic":                        sns.ResourceTopic(),
+			"aws_sns_topic_data_protection_policy": sns.ResourceTopicDataProtectionPolicy(),
+			"aws_sns_topic_policy":                 sns.ResourceTopicPolicy(),
+			"aws_sns_topic_subscription":           sns.ResourceTopicSubscription(),
 
 			"aws_sqs_queue":        sqs.ResourceQueue(),
 			"aws_sqs_queue_policy": sqs.ResourceQueuePolicy(),
@@ -2030,9 +2219,13 @@ func Provider() *schema.Provider {
 			"aws_ssm_service_setting":           ssm.ResourceServiceSetting(),
 
 			"aws_ssoadmin_account_assignment":           ssoadmin.ResourceAccountAssignment(),
+			"aws_ssoadmin_application":                  ssoadmin.ResourceApplication(),
+			"aws_ssoadmin_application_access_scope":     ssoadmin.ResourceApplicationAccessScope(),
+			"aws_ssoadmin_application_assignment":       ssoadmin.ResourceApplicationAssignment(),
 			"aws_ssoadmin_managed_policy_attachment":    ssoadmin.ResourceManagedPolicyAttachment(),
 			"aws_ssoadmin_permission_set":               ssoadmin.ResourcePermissionSet(),
 			"aws_ssoadmin_permission_set_inline_policy": ssoadmin.ResourcePermissionSetInlinePolicy(),
+			"aws_ssoadmin_trusted_token_issuer":         ssoadmin.ResourceTrustedTokenIssuer(),
 
 			"aws_storagegateway_cache":                   storagegateway.ResourceCache(),
 			"aws_storagegateway_cached_iscsi_volume":     storagegateway.ResourceCachediSCSIVolume(),
@@ -2103,8 +2296,16 @@ func Provider() *schema.Provider {
 			"aws_workspaces_ip_group":  workspaces.ResourceIPGroup(),
 			"aws_workspaces_workspace": workspaces.ResourceWorkspace(),
 
+			"aws_workspacesweb_browser_settings":   workspacesweb.ResourceBrowserSettings(),
+			"aws_workspacesweb_ip_access_settings": workspacesweb.ResourceIPAccessSettings(),
+			"aws_workspacesweb_network_settings":   workspacesweb.ResourceNetworkSettings(),
+			"aws_workspacesweb_portal":             workspacesweb.ResourcePortal(),
+			"aws_workspacesweb_trust_store":        workspacesweb.ResourceTrustStore(),
+			"aws_workspacesweb_user_settings":      workspacesweb.ResourceUserSettings(),
+
 			"aws_xray_encryption_config": xray.ResourceEncryptionConfig(),
 			"aws_xray_group":             xray.ResourceGroup(),
+			"aws_xray_resource_policy":   xray.ResourceResourcePolicy(),
 			"aws_xray_sampling_rule":     xray.ResourceSamplingRule(),
 		},
 	}
@@ -2138,6 +2339,7 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData, terraformVer
 		Region:                         d.Get("region").(string),
 		S3UsePathStyle:                 d.Get("s3_use_path_style").(bool) || d.Get("s3_force_path_style").(bool),
 		SecretKey:                      d.Get("secret_key").(string),
+		ServiceQuotaPrecheck:           d.Get("service_quota_precheck").(string),
 		SkipCredsValidation:            d.Get("skip_credentials_validation").(bool),
 		SkipGetEC2Platforms:            d.Get("skip_get_ec2_platforms").(bool),
 		SkipRegionValidation:           d.Get("skip_region_validation").(bool),
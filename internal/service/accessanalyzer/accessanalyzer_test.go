@@ -13,14 +13,17 @@ import (
 func TestAccAccessAnalyzer_serial(t *testing.T) {
 	testCases := map[string]map[string]func(t *testing.T){
 		"Analyzer": {
-			"basic":             testAccAnalyzer_basic,
-			"disappears":        testAccAnalyzer_disappears,
-			"Tags":              testAccAnalyzer_Tags,
-			"Type_Organization": testAccAnalyzer_Type_Organization,
+			"basic":                     testAccAnalyzer_basic,
+			"configurationNotSupported": testAccAnalyzer_configurationNotSupported,
+			"disappears":                testAccAnalyzer_disappears,
+			"Tags":                      testAccAnalyzer_Tags,
+			"Type_AccountUnusedAccess":  testAccAnalyzer_Type_AccountUnusedAccess,
+			"Type_Organization":         testAccAnalyzer_Type_Organization,
 		},
 		"ArchiveRule": {
 			"basic":          testAccAnalyzerArchiveRule_basic,
 			"disappears":     testAccAnalyzerArchiveRule_disappears,
+			"unused_access":  testAccAnalyzerArchiveRule_unusedAccess,
 			"update_filters": testAccAnalyzerArchiveRule_updateFilters,
 		},
 	}
@@ -1,6 +1,7 @@
 package accessanalyzer
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -9,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/accessanalyzer"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -50,21 +52,50 @@ func ResourceAnalyzer() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"unused_access": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"unused_access_age": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntBetween(1, 180),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
 			"type": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
-				Default:  accessanalyzer.TypeAccount,
-				ValidateFunc: validation.StringInSlice([]string{
-					accessanalyzer.TypeAccount,
-					accessanalyzer.TypeOrganization,
-				}, false),
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      accessanalyzer.TypeAccount,
+				ValidateFunc: validation.StringInSlice(accessanalyzer.Type_Values(), false),
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			customizeDiffAnalyzerConfiguration,
+		),
 	}
 }
 
@@ -81,6 +112,10 @@ func resourceAnalyzerCreate(d *schema.ResourceData, meta interface{}) error {
 		Type:         aws.String(d.Get("type").(string)),
 	}
 
+	if v, ok := d.GetOk("configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Configuration = expandAnalyzerConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	// Handle Organizations eventual consistency
 	err := resource.Retry(organizationCreationTimeout, func() *resource.RetryError {
 		_, err := conn.CreateAnalyzer(input)
@@ -136,6 +171,9 @@ func resourceAnalyzerRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("analyzer_name", output.Analyzer.Name)
 	d.Set("arn", output.Analyzer.Arn)
+	if err := d.Set("configuration", flattenAnalyzerConfiguration(output.Analyzer.Configuration)); err != nil {
+		return fmt.Errorf("error setting configuration: %w", err)
+	}
 
 	tags := KeyValueTags(output.Analyzer.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
@@ -185,3 +223,52 @@ func resourceAnalyzerDelete(d *schema.ResourceData, meta interface{}) error {
 
 	return nil
 }
+
+// customizeDiffAnalyzerConfiguration reports at plan time that configuration is
+// only supported by unused access analyzers.
+func customizeDiffAnalyzerConfiguration(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if v, ok := diff.GetOk("configuration"); !ok || len(v.([]interface{})) == 0 {
+		return nil
+	}
+
+	switch analyzerType := diff.Get("type").(string); analyzerType {
+	case accessanalyzer.TypeAccountUnusedAccess, accessanalyzer.TypeOrganizationUnusedAccess:
+		return nil
+	default:
+		return fmt.Errorf("configuration is not supported for analyzers of type %s", analyzerType)
+	}
+}
+
+func expandAnalyzerConfiguration(tfMap map[string]interface{}) *accessanalyzer.AnalyzerConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &accessanalyzer.AnalyzerConfiguration{}
+
+	if v, ok := tfMap["unused_access"].([]interface{}); ok && len(v) > 0 {
+		apiObject.UnusedAccess = &accessanalyzer.UnusedAccessConfiguration{}
+
+		if tfMap, ok := v[0].(map[string]interface{}); ok {
+			if v, ok := tfMap["unused_access_age"].(int); ok && v != 0 {
+				apiObject.UnusedAccess.UnusedAccessAge = aws.Int64(int64(v))
+			}
+		}
+	}
+
+	return apiObject
+}
+
+func flattenAnalyzerConfiguration(apiObject *accessanalyzer.AnalyzerConfiguration) []interface{} {
+	if apiObject == nil || apiObject.UnusedAccess == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"unused_access": []interface{}{map[string]interface{}{
+			"unused_access_age": aws.Int64Value(apiObject.UnusedAccess.UnusedAccessAge),
+		}},
+	}
+
+	return []interface{}{tfMap}
+}
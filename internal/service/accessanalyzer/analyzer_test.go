@@ -2,6 +2,7 @@ package accessanalyzer_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -147,6 +148,61 @@ func testAccAnalyzer_Type_Organization(t *testing.T) {
 	})
 }
 
+func testAccAnalyzer_Type_AccountUnusedAccess(t *testing.T) {
+	var analyzer accessanalyzer.AnalyzerSummary
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_accessanalyzer_analyzer.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, accessanalyzer.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAnalyzerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnalyzerConfig_typeAccountUnusedAccess(rName, 180),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnalyzerExists(resourceName, &analyzer),
+					resource.TestCheckResourceAttr(resourceName, "configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.unused_access.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.unused_access.0.unused_access_age", "180"),
+					resource.TestCheckResourceAttr(resourceName, "type", accessanalyzer.TypeAccountUnusedAccess),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAnalyzerConfig_typeAccountUnusedAccess(rName, 90),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnalyzerExists(resourceName, &analyzer),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.unused_access.0.unused_access_age", "90"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAnalyzer_configurationNotSupported(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, accessanalyzer.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAnalyzerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAnalyzerConfig_configurationAccount(rName),
+				ExpectError: regexp.MustCompile(`configuration is not supported for analyzers of type ACCOUNT`),
+			},
+		},
+	})
+}
+
 func testAccCheckAnalyzerDestroy(s *terraform.State) error {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).AccessAnalyzerConn
 
@@ -270,3 +326,32 @@ resource "aws_accessanalyzer_analyzer" "test" {
 }
 `, rName)
 }
+
+func testAccAnalyzerConfig_typeAccountUnusedAccess(rName string, unusedAccessAge int) string {
+	return fmt.Sprintf(`
+resource "aws_accessanalyzer_analyzer" "test" {
+  analyzer_name = %[1]q
+  type          = "ACCOUNT_UNUSED_ACCESS"
+
+  configuration {
+    unused_access {
+      unused_access_age = %[2]d
+    }
+  }
+}
+`, rName, unusedAccessAge)
+}
+
+func testAccAnalyzerConfig_configurationAccount(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_accessanalyzer_analyzer" "test" {
+  analyzer_name = %[1]q
+
+  configuration {
+    unused_access {
+      unused_access_age = 90
+    }
+  }
+}
+`, rName)
+}
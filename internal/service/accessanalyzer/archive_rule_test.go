@@ -117,6 +117,46 @@ filter {
 	})
 }
 
+func testAccAnalyzerArchiveRule_unusedAccess(t *testing.T) {
+	var archiveRule accessanalyzer.ArchiveRuleSummary
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_accessanalyzer_archive_rule.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(accessanalyzer.EndpointsID, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, accessanalyzer.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckArchiveRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArchiveRuleConfig_unusedAccess(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckArchiveRuleExists(resourceName, &archiveRule),
+					resource.TestCheckResourceAttr(resourceName, "filter.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "filter.*", map[string]string{
+						"criteria": "findingType",
+						"eq.#":     "1",
+						"eq.0":     "UnusedIAMRole",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "filter.*", map[string]string{
+						"criteria":   "resource",
+						"contains.#": "1",
+						"contains.0": "service-role",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccAnalyzerArchiveRule_disappears(t *testing.T) {
 	var archiveRule accessanalyzer.ArchiveRuleSummary
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -238,3 +278,27 @@ resource "aws_accessanalyzer_archive_rule" "test" {
 }
 `, rName, filters))
 }
+
+func testAccArchiveRuleConfig_unusedAccess(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_accessanalyzer_analyzer" "test" {
+  analyzer_name = %[1]q
+  type          = "ACCOUNT_UNUSED_ACCESS"
+}
+
+resource "aws_accessanalyzer_archive_rule" "test" {
+  analyzer_name = aws_accessanalyzer_analyzer.test.analyzer_name
+  rule_name     = %[1]q
+
+  filter {
+    criteria = "findingType"
+    eq       = ["UnusedIAMRole"]
+  }
+
+  filter {
+    criteria = "resource"
+    contains = ["service-role"]
+  }
+}
+`, rName)
+}
@@ -0,0 +1,159 @@
+package acm
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	// ACM sends expiry events 45 days before a certificate expires unless configured otherwise.
+	accountDefaultDaysBeforeExpiry = 45
+
+	accountConfigurationConflictTimeout = 2 * time.Minute
+)
+
+func ResourceAccount() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAccountPut,
+		Read:   resourceAccountRead,
+		Update: resourceAccountPut,
+		Delete: resourceAccountDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"expiry_events": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"days_before_expiry": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 45),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAccountPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ACMConn
+
+	input := &acm.PutAccountConfigurationInput{
+		ExpiryEvents:     expandExpiryEventsConfiguration(d.Get("expiry_events").([]interface{})[0].(map[string]interface{})),
+		IdempotencyToken: aws.String(resource.UniqueId()),
+	}
+
+	if err := putAccountConfiguration(conn, input); err != nil {
+		return fmt.Errorf("putting ACM Account Configuration: %w", err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(meta.(*conns.AWSClient).AccountID)
+	}
+
+	return resourceAccountRead(d, meta)
+}
+
+func resourceAccountRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ACMConn
+
+	output, err := FindAccountConfiguration(conn)
+
+	if err != nil {
+		return fmt.Errorf("reading ACM Account Configuration (%s): %w", d.Id(), err)
+	}
+
+	if err := d.Set("expiry_events", []interface{}{flattenExpiryEventsConfiguration(output.ExpiryEvents)}); err != nil {
+		return fmt.Errorf("setting expiry_events: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAccountDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ACMConn
+
+	// Removing the resource restores the default expiry events configuration.
+	input := &acm.PutAccountConfigurationInput{
+		ExpiryEvents: &acm.ExpiryEventsConfiguration{
+			DaysBeforeExpiry: aws.Int64(accountDefaultDaysBeforeExpiry),
+		},
+		IdempotencyToken: aws.String(resource.UniqueId()),
+	}
+
+	if err := putAccountConfiguration(conn, input); err != nil {
+		return fmt.Errorf("resetting ACM Account Configuration (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func putAccountConfiguration(conn *acm.ACM, input *acm.PutAccountConfigurationInput) error {
+	// Concurrent updates of the account configuration return ConflictException.
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(accountConfigurationConflictTimeout, func() (interface{}, error) {
+		return conn.PutAccountConfiguration(input)
+	}, acm.ErrCodeConflictException)
+
+	return err
+}
+
+func FindAccountConfiguration(conn *acm.ACM) (*acm.GetAccountConfigurationOutput, error) {
+	input := &acm.GetAccountConfigurationInput{}
+
+	output, err := conn.GetAccountConfiguration(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandExpiryEventsConfiguration(tfMap map[string]interface{}) *acm.ExpiryEventsConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &acm.ExpiryEventsConfiguration{}
+
+	if v, ok := tfMap["days_before_expiry"].(int); ok && v != 0 {
+		apiObject.DaysBeforeExpiry = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func flattenExpiryEventsConfiguration(apiObject *acm.ExpiryEventsConfiguration) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"days_before_expiry": accountDefaultDaysBeforeExpiry,
+	}
+
+	if apiObject == nil {
+		return tfMap
+	}
+
+	if v := apiObject.DaysBeforeExpiry; v != nil {
+		tfMap["days_before_expiry"] = aws.Int64Value(v)
+	}
+
+	return tfMap
+}
@@ -0,0 +1,84 @@
+package acm_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfacm "github.com/hashicorp/terraform-provider-aws/internal/service/acm"
+)
+
+func TestAccACMAccount_basic(t *testing.T) {
+	resourceName := "aws_acm_account.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, acm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountConfig_basic(30),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountDaysBeforeExpiry(30),
+					acctest.CheckResourceAttrAccountID(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "expiry_events.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "expiry_events.0.days_before_expiry", "30"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAccountConfig_basic(10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountDaysBeforeExpiry(10),
+					resource.TestCheckResourceAttr(resourceName, "expiry_events.0.days_before_expiry", "10"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAccountDestroy(s *terraform.State) error {
+	return testAccCheckAccountDaysBeforeExpiry(45)(s)
+}
+
+func testAccCheckAccountDaysBeforeExpiry(days int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ACMConn
+
+		output, err := tfacm.FindAccountConfiguration(conn)
+
+		if err != nil {
+			return err
+		}
+
+		if output.ExpiryEvents == nil {
+			return fmt.Errorf("ACM Account Configuration has no expiry events configuration")
+		}
+
+		if got := aws.Int64Value(output.ExpiryEvents.DaysBeforeExpiry); got != int64(days) {
+			return fmt.Errorf("ACM Account Configuration days before expiry = %d, want %d", got, days)
+		}
+
+		return nil
+	}
+}
+
+func testAccAccountConfig_basic(days int) string {
+	return fmt.Sprintf(`
+resource "aws_acm_account" "test" {
+  expiry_events {
+    days_before_expiry = %[1]d
+  }
+}
+`, days)
+}
@@ -4,18 +4,25 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/aws/aws-sdk-go/service/route53"
 	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfroute53 "github.com/hashicorp/terraform-provider-aws/internal/service/route53"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+const (
+	certificateValidationRecordTTL = 60
+)
+
 func ResourceCertificateValidation() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceCertificateValidationCreate,
@@ -32,11 +39,19 @@ func ResourceCertificateValidation() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"route53_zone_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"validation_record_fqdns"},
+			},
 			"validation_record_fqdns": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				ForceNew: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"route53_zone_id"},
 			},
 		},
 	}
@@ -56,7 +71,33 @@ func resourceCertificateValidationCreate(d *schema.ResourceData, meta interface{
 		return fmt.Errorf("ACM Certificate (%s) has type %s, no validation necessary", arn, v)
 	}
 
-	if v, ok := d.GetOk("validation_record_fqdns"); ok && v.(*schema.Set).Len() > 0 {
+	if v, ok := d.GetOk("route53_zone_id"); ok {
+		zoneID := tfroute53.CleanZoneID(v.(string))
+
+		certificate, err = waitCertificateDNSValidationRecordsAvailable(conn, arn, certificateDNSValidationAssignmentTimeout)
+
+		if err != nil {
+			return fmt.Errorf("waiting for ACM Certificate (%s) DNS validation records: %w", arn, err)
+		}
+
+		resourceRecords, err := certificateDNSValidationRecords(certificate)
+
+		if err != nil {
+			return err
+		}
+
+		if err := upsertCertificateValidationRecords(meta.(*conns.AWSClient).Route53Conn, zoneID, resourceRecords); err != nil {
+			return fmt.Errorf("creating ACM Certificate (%s) DNS validation records in Route 53 Hosted Zone (%s): %w", arn, zoneID, err)
+		}
+
+		var fqdns []string
+
+		for _, v := range resourceRecords {
+			fqdns = append(fqdns, strings.TrimSuffix(aws.StringValue(v.Name), "."))
+		}
+
+		d.Set("validation_record_fqdns", fqdns)
+	} else if v, ok := d.GetOk("validation_record_fqdns"); ok && v.(*schema.Set).Len() > 0 {
 		fqdns := make(map[string]*acm.DomainValidation)
 
 		for _, domainValidation := range certificate.DomainValidationOptions {
@@ -177,3 +218,109 @@ func waitCertificateIssued(conn *acm.ACM, arn string, timeout time.Duration) (*a
 
 	return nil, err
 }
+
+// certificateDNSValidationRecords returns the unique DNS validation records for a certificate.
+// Wildcard and apex domain names (e.g. "*.example.com" and "example.com") share a validation record.
+func certificateDNSValidationRecords(certificate *acm.CertificateDetail) ([]*acm.ResourceRecord, error) {
+	var resourceRecords []*acm.ResourceRecord
+	seen := make(map[string]bool)
+
+	for _, domainValidation := range certificate.DomainValidationOptions {
+		if v := aws.StringValue(domainValidation.ValidationMethod); v != acm.ValidationMethodDns {
+			return nil, fmt.Errorf("route53_zone_id is not valid for %s validation", v)
+		}
+
+		resourceRecord := domainValidation.ResourceRecord
+
+		if resourceRecord == nil {
+			return nil, fmt.Errorf("missing %s DNS validation record", aws.StringValue(domainValidation.DomainName))
+		}
+
+		name := strings.TrimSuffix(aws.StringValue(resourceRecord.Name), ".")
+
+		if seen[name] {
+			continue
+		}
+
+		seen[name] = true
+		resourceRecords = append(resourceRecords, resourceRecord)
+	}
+
+	return resourceRecords, nil
+}
+
+func upsertCertificateValidationRecords(conn *route53.Route53, zoneID string, resourceRecords []*acm.ResourceRecord) error {
+	var changes []*route53.Change
+
+	for _, v := range resourceRecords {
+		changes = append(changes, &route53.Change{
+			Action: aws.String(route53.ChangeActionUpsert),
+			ResourceRecordSet: &route53.ResourceRecordSet{
+				Name: v.Name,
+				ResourceRecords: []*route53.ResourceRecord{{
+					Value: v.Value,
+				}},
+				TTL:  aws.Int64(certificateValidationRecordTTL),
+				Type: v.Type,
+			},
+		})
+	}
+
+	input := &route53.ChangeResourceRecordSetsInput{
+		ChangeBatch: &route53.ChangeBatch{
+			Changes: changes,
+			Comment: aws.String("Managed by Terraform"),
+		},
+		HostedZoneId: aws.String(zoneID),
+	}
+
+	log.Printf("[DEBUG] Changing Route 53 Resource Record Sets: %s", input)
+	outputRaw, err := tfroute53.ChangeRecordSet(conn, input)
+
+	if err != nil {
+		return err
+	}
+
+	changeInfo := outputRaw.(*route53.ChangeResourceRecordSetsOutput).ChangeInfo
+
+	return tfroute53.WaitForRecordSetToSync(conn, tfroute53.CleanChangeID(aws.StringValue(changeInfo.Id)))
+}
+
+func statusCertificateDNSValidationRecordsAvailable(conn *acm.ACM, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		certificate, err := FindCertificateByARN(conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		for _, v := range certificate.DomainValidationOptions {
+			if aws.StringValue(v.ValidationMethod) == acm.ValidationMethodDns && v.ResourceRecord == nil {
+				return certificate, strconv.FormatBool(false), nil
+			}
+		}
+
+		return certificate, strconv.FormatBool(true), nil
+	}
+}
+
+func waitCertificateDNSValidationRecordsAvailable(conn *acm.ACM, arn string, timeout time.Duration) (*acm.CertificateDetail, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{strconv.FormatBool(false)},
+		Target:  []string{strconv.FormatBool(true)},
+		Refresh: statusCertificateDNSValidationRecordsAvailable(conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*acm.CertificateDetail); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -220,6 +220,50 @@ func TestAccACMCertificateValidation_validationRecordFQDNSWildcardAndRoot(t *tes
 	})
 }
 
+func TestAccACMCertificateValidation_route53ZoneID(t *testing.T) {
+	rootDomain := acctest.ACMCertificateDomainFromEnv(t)
+	wildcardDomain := fmt.Sprintf("*.%s", rootDomain)
+	certificateResourceName := "aws_acm_certificate.test"
+	resourceName := "aws_acm_certificate_validation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, acm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCertificateValidationConfig_route53ZoneID(rootDomain, wildcardDomain, strconv.Quote(rootDomain)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCertificateValidationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "certificate_arn", certificateResourceName, "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "route53_zone_id", "data.aws_route53_zone.test", "zone_id"),
+					// The wildcard and apex domain names share a single validation record.
+					resource.TestCheckResourceAttr(resourceName, "validation_record_fqdns.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccACMCertificateValidation_route53ZoneIDEmail(t *testing.T) {
+	rootDomain := acctest.ACMCertificateDomainFromEnv(t)
+	domain := acctest.ACMCertificateRandomSubDomain(rootDomain)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, acm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCertificateValidationConfig_route53ZoneIDEmail(rootDomain, domain),
+				ExpectError: regexp.MustCompile("route53_zone_id is not valid for EMAIL validation"),
+			},
+		},
+	})
+}
+
 func testAccCheckCertificateValidationExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -452,3 +496,42 @@ resource "aws_acm_certificate_validation" "test" {
 }
 `, domainName)
 }
+
+func testAccCertificateValidationConfig_route53ZoneID(rootZoneDomain, domainName, subjectAlternativeNames string) string {
+	return fmt.Sprintf(`
+resource "aws_acm_certificate" "test" {
+  domain_name               = %[1]q
+  subject_alternative_names = [%[2]s]
+  validation_method         = "DNS"
+}
+
+data "aws_route53_zone" "test" {
+  name         = %[3]q
+  private_zone = false
+}
+
+resource "aws_acm_certificate_validation" "test" {
+  certificate_arn = aws_acm_certificate.test.arn
+  route53_zone_id = data.aws_route53_zone.test.zone_id
+}
+`, domainName, subjectAlternativeNames, rootZoneDomain)
+}
+
+func testAccCertificateValidationConfig_route53ZoneIDEmail(rootZoneDomain, domainName string) string {
+	return fmt.Sprintf(`
+resource "aws_acm_certificate" "test" {
+  domain_name       = %[1]q
+  validation_method = "EMAIL"
+}
+
+data "aws_route53_zone" "test" {
+  name         = %[2]q
+  private_zone = false
+}
+
+resource "aws_acm_certificate_validation" "test" {
+  certificate_arn = aws_acm_certificate.test.arn
+  route53_zone_id = data.aws_route53_zone.test.zone_id
+}
+`, domainName, rootZoneDomain)
+}
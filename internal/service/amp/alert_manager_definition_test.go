@@ -3,6 +3,7 @@ package amp_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/prometheusservice"
@@ -73,6 +74,44 @@ func TestAccAMPAlertManagerDefinition_disappears(t *testing.T) {
 	})
 }
 
+func TestAccAMPAlertManagerDefinition_equivalentFormatting(t *testing.T) {
+	resourceName := "aws_prometheus_alert_manager_definition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(prometheusservice.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, prometheusservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAlertManagerDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAlertManagerDefinitionConfig_basic(defaultAlertManagerDefinition()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlertManagerDefinitionExists(resourceName),
+				),
+			},
+			{
+				Config:   testAccAlertManagerDefinitionConfig_basic(reformattedAlertManagerDefinition()),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccAMPAlertManagerDefinition_invalid(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(prometheusservice.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, prometheusservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAlertManagerDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAlertManagerDefinitionConfig_basic(invalidAlertManagerDefinition()),
+				ExpectError: regexp.MustCompile(`must contain a "route"`),
+			},
+		},
+	})
+}
+
 func testAccCheckAlertManagerDefinitionExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -140,6 +179,24 @@ alertmanager_config: |
 `
 }
 
+func reformattedAlertManagerDefinition() string {
+	return `---
+alertmanager_config: |
+    receivers:
+        -   name: default
+    route:
+        receiver: "default"
+`
+}
+
+func invalidAlertManagerDefinition() string {
+	return `
+alertmanager_config: |
+  receivers:
+    - name: 'default'
+`
+}
+
 func testAccAlertManagerDefinitionConfig_basic(definition string) string {
 	return fmt.Sprintf(`
 resource "aws_prometheus_workspace" "test" {
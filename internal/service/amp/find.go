@@ -75,3 +75,28 @@ func FindRuleGroupNamespaceByARN(ctx context.Context, conn *prometheusservice.Pr
 
 	return output.RuleGroupsNamespace, nil
 }
+
+func FindScraperByID(ctx context.Context, conn *prometheusservice.PrometheusService, id string) (*prometheusservice.ScraperDescription, error) {
+	input := &prometheusservice.DescribeScraperInput{
+		ScraperId: aws.String(id),
+	}
+
+	output, err := conn.DescribeScraperWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, prometheusservice.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Scraper == nil || output.Scraper.Status == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Scraper, nil
+}
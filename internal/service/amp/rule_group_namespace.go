@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 func ResourceRuleGroupNamespace() *schema.Resource {
@@ -26,8 +27,10 @@ func ResourceRuleGroupNamespace() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"data": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validRuleGroupNamespaceData,
+				DiffSuppressFunc: verify.SuppressEquivalentYAMLDiffs,
 			},
 			"name": {
 				Type:     schema.TypeString,
@@ -3,6 +3,7 @@ package amp_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/prometheusservice"
@@ -73,6 +74,44 @@ func TestAccAMPRuleGroupNamespace_disappears(t *testing.T) {
 	})
 }
 
+func TestAccAMPRuleGroupNamespace_equivalentFormatting(t *testing.T) {
+	resourceName := "aws_prometheus_rule_group_namespace.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(prometheusservice.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, prometheusservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuleGroupNamespaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuleGroupNamespaceConfig_basic(defaultRuleGroupNamespace()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuleGroupNamespaceExists(resourceName),
+				),
+			},
+			{
+				Config:   testAccRuleGroupNamespaceConfig_basic(reformattedRuleGroupNamespace()),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccAMPRuleGroupNamespace_invalid(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(prometheusservice.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, prometheusservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuleGroupNamespaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRuleGroupNamespaceConfig_basic(invalidRuleGroupNamespace()),
+				ExpectError: regexp.MustCompile(`has an invalid "expr"`),
+			},
+		},
+	})
+}
+
 func testAccCheckRuleGroupNamespaceExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -145,6 +184,31 @@ groups:
 `
 }
 
+func reformattedRuleGroupNamespace() string {
+	return `---
+groups:
+    -   name: "test"
+        rules:
+            -   expr: "avg(rate(container_cpu_usage_seconds_total[5m]))"
+                record: "metric:recording_rule"
+    -   name: "alert-test"
+        rules:
+            -   alert: "metric:alerting_rule"
+                expr: "avg(rate(container_cpu_usage_seconds_total[5m])) > 0"
+                for: "2m"
+`
+}
+
+func invalidRuleGroupNamespace() string {
+	return `
+groups:
+  - name: test
+    rules:
+    - record: metric:recording_rule
+      expr: avg(rate(container_cpu_usage_seconds_total[5m])
+`
+}
+
 func testAccRuleGroupNamespaceConfig_basic(data string) string {
 	return fmt.Sprintf(`
 resource "aws_prometheus_workspace" "test" {
@@ -0,0 +1,330 @@
+package amp
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/prometheusservice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceScraper() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceScraperCreate,
+		ReadContext:   resourceScraperRead,
+		UpdateContext: resourceScraperUpdate,
+		DeleteContext: resourceScraperDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"alias": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"destination": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"amp": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"workspace_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"role_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"scrape_configuration": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"source": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"eks": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"cluster_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"security_group_ids": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Computed: true,
+										ForceNew: true,
+										MinItems: 1,
+										MaxItems: 5,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"subnet_ids": {
+										Type:     schema.TypeSet,
+										Required: true,
+										ForceNew: true,
+										MinItems: 1,
+										MaxItems: 5,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceScraperCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AMPConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &prometheusservice.CreateScraperInput{
+		ClientToken: aws.String(resource.UniqueId()),
+		Destination: expandDestination(d.Get("destination").([]interface{})),
+		ScrapeConfiguration: &prometheusservice.ScrapeConfiguration{
+			ConfigurationBlob: []byte(d.Get("scrape_configuration").(string)),
+		},
+		Source: expandSource(d.Get("source").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("alias"); ok {
+		input.Alias = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Prometheus Scraper: %s", input)
+	output, err := conn.CreateScraperWithContext(ctx, input)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Prometheus Scraper: %w", err))
+	}
+
+	d.SetId(aws.StringValue(output.ScraperId))
+
+	if _, err := waitScraperCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(fmt.Errorf("error waiting for Prometheus Scraper (%s) create: %w", d.Id(), err))
+	}
+
+	return resourceScraperRead(ctx, d, meta)
+}
+
+func resourceScraperRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AMPConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	scraper, err := FindScraperByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Prometheus Scraper (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading Prometheus Scraper (%s): %w", d.Id(), err))
+	}
+
+	d.Set("alias", scraper.Alias)
+	d.Set("arn", scraper.Arn)
+	if err := d.Set("destination", flattenDestination(scraper.Destination)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting destination: %w", err))
+	}
+	d.Set("role_arn", scraper.RoleArn)
+	if scraper.ScrapeConfiguration != nil {
+		d.Set("scrape_configuration", string(scraper.ScrapeConfiguration.ConfigurationBlob))
+	} else {
+		d.Set("scrape_configuration", nil)
+	}
+	if err := d.Set("source", flattenSource(scraper.Source)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting source: %w", err))
+	}
+
+	tags := KeyValueTags(scraper.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting tags: %w", err))
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting tags_all: %w", err))
+	}
+
+	return nil
+}
+
+func resourceScraperUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AMPConn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating Prometheus Scraper (%s) tags: %w", d.Id(), err))
+		}
+	}
+
+	return resourceScraperRead(ctx, d, meta)
+}
+
+func resourceScraperDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AMPConn
+
+	log.Printf("[INFO] Deleting Prometheus Scraper: %s", d.Id())
+	_, err := conn.DeleteScraperWithContext(ctx, &prometheusservice.DeleteScraperInput{
+		ClientToken: aws.String(resource.UniqueId()),
+		ScraperId:   aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, prometheusservice.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting Prometheus Scraper (%s): %w", d.Id(), err))
+	}
+
+	if _, err := waitScraperDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.FromErr(fmt.Errorf("error waiting for Prometheus Scraper (%s) delete: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func expandDestination(tfList []interface{}) *prometheusservice.Destination {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &prometheusservice.Destination{}
+
+	if v, ok := tfMap["amp"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.AmpConfiguration = &prometheusservice.AmpConfiguration{
+			WorkspaceArn: aws.String(v[0].(map[string]interface{})["workspace_arn"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func expandSource(tfList []interface{}) *prometheusservice.Source {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &prometheusservice.Source{}
+
+	if v, ok := tfMap["eks"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		eksConfiguration := &prometheusservice.EksConfiguration{
+			ClusterArn: aws.String(tfMap["cluster_arn"].(string)),
+		}
+
+		if v, ok := tfMap["security_group_ids"].(*schema.Set); ok && v.Len() > 0 {
+			eksConfiguration.SecurityGroupIds = flex.ExpandStringSet(v)
+		}
+
+		if v, ok := tfMap["subnet_ids"].(*schema.Set); ok && v.Len() > 0 {
+			eksConfiguration.SubnetIds = flex.ExpandStringSet(v)
+		}
+
+		apiObject.EksConfiguration = eksConfiguration
+	}
+
+	return apiObject
+}
+
+func flattenDestination(apiObject *prometheusservice.Destination) []interface{} {
+	if apiObject == nil || apiObject.AmpConfiguration == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"amp": []interface{}{map[string]interface{}{
+			"workspace_arn": aws.StringValue(apiObject.AmpConfiguration.WorkspaceArn),
+		}},
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenSource(apiObject *prometheusservice.Source) []interface{} {
+	if apiObject == nil || apiObject.EksConfiguration == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"eks": []interface{}{map[string]interface{}{
+			"cluster_arn":        aws.StringValue(apiObject.EksConfiguration.ClusterArn),
+			"security_group_ids": aws.StringValueSlice(apiObject.EksConfiguration.SecurityGroupIds),
+			"subnet_ids":         aws.StringValueSlice(apiObject.EksConfiguration.SubnetIds),
+		}},
+	}
+
+	return []interface{}{tfMap}
+}
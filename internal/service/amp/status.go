@@ -93,3 +93,19 @@ func statusWorkspaceDeleted(ctx context.Context, conn *prometheusservice.Prometh
 		return output.Workspace, aws.StringValue(output.Workspace.Status.StatusCode), nil
 	}
 }
+
+func statusScraper(ctx context.Context, conn *prometheusservice.PrometheusService, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindScraperByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status.StatusCode), nil
+	}
+}
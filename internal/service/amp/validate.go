@@ -0,0 +1,194 @@
+package amp
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"gopkg.in/yaml.v2"
+)
+
+type ruleGroupsNamespaceData struct {
+	Groups []struct {
+		Interval string `yaml:"interval"`
+		Name     string `yaml:"name"`
+		Rules    []struct {
+			Alert  string `yaml:"alert"`
+			Expr   string `yaml:"expr"`
+			Record string `yaml:"record"`
+		} `yaml:"rules"`
+	} `yaml:"groups"`
+}
+
+// validRuleGroupNamespaceData checks that a rule groups namespace is a YAML
+// Prometheus rules file: every group is uniquely named and every rule is
+// either a recording or an alerting rule with a well-formed expression.
+func validRuleGroupNamespaceData(v interface{}, k string) (ws []string, errors []error) {
+	var data ruleGroupsNamespaceData
+
+	if err := yaml.Unmarshal([]byte(v.(string)), &data); err != nil {
+		errors = append(errors, fmt.Errorf("%q contains invalid YAML: %w", k, err))
+		return
+	}
+
+	if len(data.Groups) == 0 {
+		errors = append(errors, fmt.Errorf("%q must contain at least one rule group under \"groups\"", k))
+		return
+	}
+
+	names := make(map[string]struct{})
+	for i, group := range data.Groups {
+		if group.Name == "" {
+			errors = append(errors, fmt.Errorf("%q: groups[%d] must have a name", k, i))
+			continue
+		}
+
+		if _, ok := names[group.Name]; ok {
+			errors = append(errors, fmt.Errorf("%q: duplicate rule group name %q", k, group.Name))
+		}
+		names[group.Name] = struct{}{}
+
+		for j, rule := range group.Rules {
+			switch {
+			case rule.Alert == "" && rule.Record == "":
+				errors = append(errors, fmt.Errorf("%q: group %q rules[%d] must set one of \"alert\" or \"record\"", k, group.Name, j))
+			case rule.Alert != "" && rule.Record != "":
+				errors = append(errors, fmt.Errorf("%q: group %q rules[%d] cannot set both \"alert\" and \"record\"", k, group.Name, j))
+			}
+
+			if rule.Expr == "" {
+				errors = append(errors, fmt.Errorf("%q: group %q rules[%d] must have an \"expr\"", k, group.Name, j))
+			} else if err := checkPromQLDelimiters(rule.Expr); err != nil {
+				errors = append(errors, fmt.Errorf("%q: group %q rules[%d] has an invalid \"expr\" (%s): %w", k, group.Name, j, rule.Expr, err))
+			}
+		}
+	}
+
+	return
+}
+
+// checkPromQLDelimiters performs a lightweight syntax check of a PromQL
+// expression, verifying that brackets and string literals are balanced.
+func checkPromQLDelimiters(expr string) error {
+	pairs := map[rune]rune{')': '(', ']': '[', '}': '{'}
+	var stack []rune
+	var quote rune
+	comment, escaped := false, false
+
+	for _, r := range expr {
+		if comment {
+			comment = r != '\n'
+			continue
+		}
+
+		if quote != 0 {
+			switch {
+			case escaped:
+				escaped = false
+			case r == '\\' && quote != '`':
+				escaped = true
+			case r == quote:
+				quote = 0
+			}
+			continue
+		}
+
+		switch r {
+		case '#':
+			comment = true
+		case '"', '\'', '`':
+			quote = r
+		case '(', '[', '{':
+			stack = append(stack, r)
+		case ')', ']', '}':
+			if len(stack) == 0 || stack[len(stack)-1] != pairs[r] {
+				return fmt.Errorf("unexpected %q", r)
+			}
+			stack = stack[:len(stack)-1]
+		}
+	}
+
+	if quote != 0 {
+		return fmt.Errorf("unterminated string literal")
+	}
+
+	if len(stack) > 0 {
+		return fmt.Errorf("unclosed %q", stack[len(stack)-1])
+	}
+
+	return nil
+}
+
+type alertManagerDefinition struct {
+	AlertmanagerConfig string            `yaml:"alertmanager_config"`
+	TemplateFiles      map[string]string `yaml:"template_files"`
+}
+
+// validAlertManagerDefinition checks that an alert manager definition uses the
+// Amazon Managed Service for Prometheus layout, where "alertmanager_config"
+// holds the Alertmanager configuration as an embedded YAML document.
+func validAlertManagerDefinition(v interface{}, k string) (ws []string, errors []error) {
+	var definition alertManagerDefinition
+
+	if err := yaml.UnmarshalStrict([]byte(v.(string)), &definition); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid alert manager definition: %w", k, err))
+		return
+	}
+
+	if definition.AlertmanagerConfig == "" {
+		errors = append(errors, fmt.Errorf("%q must contain \"alertmanager_config\"", k))
+		return
+	}
+
+	var config map[string]interface{}
+
+	if err := yaml.Unmarshal([]byte(definition.AlertmanagerConfig), &config); err != nil {
+		errors = append(errors, fmt.Errorf("%q: \"alertmanager_config\" contains invalid YAML: %w", k, err))
+		return
+	}
+
+	if _, ok := config["route"]; !ok {
+		errors = append(errors, fmt.Errorf("%q: \"alertmanager_config\" must contain a \"route\"", k))
+	}
+
+	return
+}
+
+// suppressEquivalentAlertManagerDefinitionDiffs compares alert manager
+// definitions semantically, including the embedded "alertmanager_config"
+// document.
+func suppressEquivalentAlertManagerDefinitionDiffs(k, old, new string, d *schema.ResourceData) bool {
+	o, err := normalizeAlertManagerDefinition(old)
+
+	if err != nil {
+		return false
+	}
+
+	n, err := normalizeAlertManagerDefinition(new)
+
+	if err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(o, n)
+}
+
+func normalizeAlertManagerDefinition(s string) (interface{}, error) {
+	var definition map[string]interface{}
+
+	if err := yaml.Unmarshal([]byte(s), &definition); err != nil {
+		return nil, err
+	}
+
+	if v, ok := definition["alertmanager_config"].(string); ok {
+		var config interface{}
+
+		if err := yaml.Unmarshal([]byte(v), &config); err != nil {
+			return nil, err
+		}
+
+		definition["alertmanager_config"] = config
+	}
+
+	return definition, nil
+}
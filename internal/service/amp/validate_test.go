@@ -0,0 +1,211 @@
+package amp
+
+import (
+	"testing"
+)
+
+func TestValidRuleGroupNamespaceData(t *testing.T) {
+	validData := []string{
+		`
+groups:
+  - name: test
+    rules:
+    - record: metric:recording_rule
+      expr: avg(rate(container_cpu_usage_seconds_total[5m]))
+  - name: alert-test
+    rules:
+    - alert: HighCPU
+      expr: sum by (pod) (rate(container_cpu_usage_seconds_total{namespace=~"kube-(system|public)"}[5m])) > 0.9
+      for: 2m
+      labels:
+        severity: page
+      annotations:
+        summary: "CPU usage is high (instance {{ $labels.instance }})"
+`,
+		`
+groups:
+  - name: comment
+    rules:
+    - record: job:up:sum
+      expr: |
+        sum by (job) (up) # unbalanced ( in a comment
+`,
+	}
+	for _, v := range validData {
+		_, errors := validRuleGroupNamespaceData(v, "data")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be valid rule group namespace data: %q", v, errors)
+		}
+	}
+
+	invalidData := []string{
+		`groups: [`,
+		`foo: bar`,
+		`
+groups:
+  - rules:
+    - record: job:up:sum
+      expr: sum(up)
+`,
+		`
+groups:
+  - name: dup
+    rules:
+    - record: job:up:sum
+      expr: sum(up)
+  - name: dup
+    rules:
+    - record: job:up:count
+      expr: count(up)
+`,
+		`
+groups:
+  - name: test
+    rules:
+    - expr: sum(up)
+`,
+		`
+groups:
+  - name: test
+    rules:
+    - record: job:up:sum
+      alert: UpDown
+      expr: sum(up)
+`,
+		`
+groups:
+  - name: test
+    rules:
+    - record: job:up:sum
+`,
+		`
+groups:
+  - name: test
+    rules:
+    - record: job:up:sum
+      expr: sum(rate(up[5m])
+`,
+		`
+groups:
+  - name: test
+    rules:
+    - record: job:up:sum
+      expr: sum(up{job="api})
+`,
+	}
+	for _, v := range invalidData {
+		_, errors := validRuleGroupNamespaceData(v, "data")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be invalid rule group namespace data", v)
+		}
+	}
+}
+
+func TestValidAlertManagerDefinition(t *testing.T) {
+	validDefinitions := []string{
+		`
+alertmanager_config: |
+  route:
+    receiver: 'default'
+  receivers:
+    - name: 'default'
+`,
+		`
+template_files:
+  default_template: |
+    {{ define "sns.default.message" }}{{ .Status }}{{ end }}
+alertmanager_config: |
+  templates:
+    - 'default_template'
+  route:
+    receiver: 'default'
+  receivers:
+    - name: 'default'
+`,
+	}
+	for _, v := range validDefinitions {
+		_, errors := validAlertManagerDefinition(v, "definition")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid alert manager definition: %q", v, errors)
+		}
+	}
+
+	invalidDefinitions := []string{
+		`alertmanager_config: [`,
+		`
+route:
+  receiver: 'default'
+`,
+		`
+alertmanager_config: |
+  receivers:
+    - name: 'default'
+`,
+		`
+alertmanager_config: |
+  route: [
+`,
+	}
+	for _, v := range invalidDefinitions {
+		_, errors := validAlertManagerDefinition(v, "definition")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid alert manager definition", v)
+		}
+	}
+}
+
+func TestSuppressEquivalentAlertManagerDefinitionDiffs(t *testing.T) {
+	testCases := []struct {
+		description string
+		equivalent  bool
+		old         string
+		new         string
+	}{
+		{
+			description: "embedded config reformatted",
+			equivalent:  true,
+			old: `
+alertmanager_config: |
+  route:
+    receiver: 'default'
+  receivers:
+    - name: 'default'
+`,
+			new: `---
+alertmanager_config: |
+    receivers:
+        -   name: default
+    route:
+        receiver: "default"
+`,
+		},
+		{
+			description: "receiver changed",
+			equivalent:  false,
+			old: `
+alertmanager_config: |
+  route:
+    receiver: 'default'
+  receivers:
+    - name: 'default'
+`,
+			new: `
+alertmanager_config: |
+  route:
+    receiver: 'default2'
+  receivers:
+    - name: 'default2'
+`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.description, func(t *testing.T) {
+			if got, want := suppressEquivalentAlertManagerDefinitionDiffs("definition", testCase.old, testCase.new, nil), testCase.equivalent; got != want {
+				t.Errorf("suppressEquivalentAlertManagerDefinitionDiffs() = %t, want %t", got, want)
+			}
+		})
+	}
+}
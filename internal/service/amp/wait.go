@@ -161,3 +161,45 @@ func waitRuleGroupNamespaceUpdated(ctx context.Context, conn *prometheusservice.
 
 	return nil, err
 }
+
+func waitScraperCreated(ctx context.Context, conn *prometheusservice.PrometheusService, id string, timeout time.Duration) (*prometheusservice.ScraperDescription, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{prometheusservice.ScraperStatusCodeCreating},
+		Target:  []string{prometheusservice.ScraperStatusCodeActive},
+		Refresh: statusScraper(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*prometheusservice.ScraperDescription); ok {
+		if statusCode := aws.StringValue(output.Status.StatusCode); statusCode == prometheusservice.ScraperStatusCodeCreationFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusReason)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitScraperDeleted(ctx context.Context, conn *prometheusservice.PrometheusService, id string, timeout time.Duration) (*prometheusservice.ScraperDescription, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{prometheusservice.ScraperStatusCodeDeleting},
+		Target:  []string{},
+		Refresh: statusScraper(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*prometheusservice.ScraperDescription); ok {
+		if statusCode := aws.StringValue(output.Status.StatusCode); statusCode == prometheusservice.ScraperStatusCodeDeletionFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusReason)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
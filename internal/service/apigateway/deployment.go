@@ -1,8 +1,12 @@
 package apigateway
 
 import (
+	"context"
+	"crypto/sha1"
+	"encoding/json"
 	"fmt"
 	"log"
+	"sort"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -22,6 +26,8 @@ func ResourceDeployment() *schema.Resource {
 		Update: resourceDeploymentUpdate,
 		Delete: resourceDeploymentDelete,
 
+		CustomizeDiff: resourceDeploymentCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"rest_api_id": {
 				Type:     schema.TypeString,
@@ -60,6 +66,17 @@ func ResourceDeployment() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 
+			"redeploy_on_configuration_change": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"configuration_hash": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"created_date": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -83,8 +100,21 @@ func resourceDeploymentCreate(d *schema.ResourceData, meta interface{}) error {
 	// Create the gateway
 	log.Printf("[DEBUG] Creating API Gateway Deployment")
 
+	restApiId := d.Get("rest_api_id").(string)
+
+	// The hash of the REST API configuration that is being deployed.
+	var configurationHash string
+	if d.Get("redeploy_on_configuration_change").(bool) {
+		v, err := restAPIConfigurationHash(conn, restApiId)
+		if err != nil {
+			return fmt.Errorf("error reading API Gateway REST API (%s) configuration: %w", restApiId, err)
+		}
+
+		configurationHash = v
+	}
+
 	deployment, err := conn.CreateDeployment(&apigateway.CreateDeploymentInput{
-		RestApiId:        aws.String(d.Get("rest_api_id").(string)),
+		RestApiId:        aws.String(restApiId),
 		StageName:        aws.String(d.Get("stage_name").(string)),
 		Description:      aws.String(d.Get("description").(string)),
 		StageDescription: aws.String(d.Get("stage_description").(string)),
@@ -95,6 +125,7 @@ func resourceDeploymentCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.SetId(aws.StringValue(deployment.Id))
+	d.Set("configuration_hash", configurationHash)
 	log.Printf("[DEBUG] API Gateway Deployment ID: %s", d.Id())
 
 	return resourceDeploymentRead(d, meta)
@@ -159,13 +190,34 @@ func resourceDeploymentUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[DEBUG] Updating API Gateway API Key: %s", d.Id())
 
-	_, err := conn.UpdateDeployment(&apigateway.UpdateDeploymentInput{
-		DeploymentId:    aws.String(d.Id()),
-		RestApiId:       aws.String(d.Get("rest_api_id").(string)),
-		PatchOperations: resourceDeploymentUpdateOperations(d),
-	})
-	if err != nil {
-		return err
+	if d.HasChange("description") {
+		_, err := conn.UpdateDeployment(&apigateway.UpdateDeploymentInput{
+			DeploymentId:    aws.String(d.Id()),
+			RestApiId:       aws.String(d.Get("rest_api_id").(string)),
+			PatchOperations: resourceDeploymentUpdateOperations(d),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Enabling redeploy_on_configuration_change on an existing deployment records the
+	// current REST API configuration, against which later changes are detected.
+	if d.HasChange("redeploy_on_configuration_change") {
+		var configurationHash string
+
+		if d.Get("redeploy_on_configuration_change").(bool) {
+			restApiId := d.Get("rest_api_id").(string)
+
+			v, err := restAPIConfigurationHash(conn, restApiId)
+			if err != nil {
+				return fmt.Errorf("error reading API Gateway REST API (%s) configuration: %w", restApiId, err)
+			}
+
+			configurationHash = v
+		}
+
+		d.Set("configuration_hash", configurationHash)
 	}
 
 	return resourceDeploymentRead(d, meta)
@@ -221,3 +273,76 @@ func resourceDeploymentDelete(d *schema.ResourceData, meta interface{}) error {
 
 	return nil
 }
+
+func resourceDeploymentCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// Nothing to compare against for a new deployment, or for a deployment without a recorded hash,
+	// e.g. one that was imported or on which redeploy_on_configuration_change is being enabled.
+	if diff.Id() == "" || !diff.Get("redeploy_on_configuration_change").(bool) || diff.Get("configuration_hash").(string) == "" {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).APIGatewayConn
+	restApiId := diff.Get("rest_api_id").(string)
+
+	if restApiId == "" {
+		return nil
+	}
+
+	configurationHash, err := restAPIConfigurationHash(conn, restApiId)
+
+	if tfawserr.ErrCodeEquals(err, apigateway.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading API Gateway REST API (%s) configuration: %w", restApiId, err)
+	}
+
+	if configurationHash == diff.Get("configuration_hash").(string) {
+		return nil
+	}
+
+	log.Printf("[DEBUG] API Gateway REST API (%s) configuration changed since Deployment (%s) was created", restApiId, diff.Id())
+
+	if err := diff.SetNewComputed("configuration_hash"); err != nil {
+		return err
+	}
+
+	return diff.ForceNew("configuration_hash")
+}
+
+// restAPIConfigurationHash returns a hash of the resources, methods and integrations of a REST API.
+func restAPIConfigurationHash(conn *apigateway.APIGateway, restApiId string) (string, error) {
+	input := &apigateway.GetResourcesInput{
+		Embed:     aws.StringSlice([]string{"methods"}),
+		RestApiId: aws.String(restApiId),
+	}
+	var resources []*apigateway.Resource
+
+	err := conn.GetResourcesPages(input, func(page *apigateway.GetResourcesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		resources = append(resources, page.Items...)
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	sort.Slice(resources, func(i, j int) bool {
+		return aws.StringValue(resources[i].Path) < aws.StringValue(resources[j].Path)
+	})
+
+	// Maps, including each resource's methods, are marshaled with sorted keys.
+	b, err := json.Marshal(resources)
+
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%x", sha1.Sum(b)), nil
+}
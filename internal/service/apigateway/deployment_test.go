@@ -129,6 +129,51 @@ func TestAccAPIGatewayDeployment_triggers(t *testing.T) {
 	})
 }
 
+func TestAccAPIGatewayDeployment_redeployOnConfigurationChange(t *testing.T) {
+	var deployment1, deployment2, deployment3, deployment4 apigateway.Deployment
+	resourceName := "aws_api_gateway_deployment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckAPIGatewayTypeEDGE(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, apigateway.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfig_redeployOnConfigurationChange("https://example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentExists(resourceName, &deployment1),
+					resource.TestCheckResourceAttrSet(resourceName, "configuration_hash"),
+					resource.TestCheckResourceAttr(resourceName, "redeploy_on_configuration_change", "true"),
+				),
+			},
+			// The integration is updated after the plan was made, so the change is only detected by the next plan.
+			{
+				Config: testAccDeploymentConfig_redeployOnConfigurationChange("https://example.org"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentExists(resourceName, &deployment2),
+					testAccCheckDeploymentNotRecreated(&deployment1, &deployment2),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccDeploymentConfig_redeployOnConfigurationChange("https://example.org"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentExists(resourceName, &deployment3),
+					testAccCheckDeploymentRecreated(&deployment2, &deployment3),
+				),
+			},
+			{
+				Config: testAccDeploymentConfig_redeployOnConfigurationChange("https://example.org"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentExists(resourceName, &deployment4),
+					testAccCheckDeploymentNotRecreated(&deployment3, &deployment4),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAPIGatewayDeployment_description(t *testing.T) {
 	var deployment apigateway.Deployment
 	resourceName := "aws_api_gateway_deployment.test"
@@ -401,6 +446,21 @@ resource "aws_api_gateway_deployment" "test" {
 `, description)
 }
 
+func testAccDeploymentConfig_redeployOnConfigurationChange(url string) string {
+	return testAccDeploymentBaseConfig(url) + `
+resource "aws_api_gateway_deployment" "test" {
+  rest_api_id                      = aws_api_gateway_rest_api.test.id
+  redeploy_on_configuration_change = true
+
+  depends_on = [aws_api_gateway_integration_response.test]
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+`
+}
+
 func testAccDeploymentConfig_description(description string) string {
 	return testAccDeploymentBaseConfig("http://example.com") + fmt.Sprintf(`
 resource "aws_api_gateway_deployment" "test" {
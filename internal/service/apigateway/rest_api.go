@@ -1,9 +1,14 @@
 package apigateway
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
+	"net/url"
+	"path"
+	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -18,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"gopkg.in/yaml.v2"
 )
 
 func ResourceRestAPI() *schema.Resource {
@@ -69,8 +75,9 @@ func ResourceRestAPI() *schema.Resource {
 			},
 
 			"body": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppressEquivalentOpenAPIDiffs,
 			},
 
 			"disable_execute_api_endpoint": {
@@ -92,6 +99,13 @@ func ResourceRestAPI() *schema.Resource {
 				ValidateFunc: validation.IntBetween(-1, 10485760),
 			},
 
+			"put_rest_api_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      apigateway.PutModeOverwrite,
+				ValidateFunc: validation.StringInSlice(apigateway.PutMode_Values(), false),
+			},
+
 			"root_resource_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -216,7 +230,7 @@ func resourceRestAPICreate(d *schema.ResourceData, meta interface{}) error {
 
 		input := &apigateway.PutRestApiInput{
 			RestApiId: gateway.Id,
-			Mode:      aws.String(apigateway.PutModeOverwrite),
+			Mode:      aws.String(d.Get("put_rest_api_mode").(string)),
 			Body:      []byte(body.(string)),
 		}
 
@@ -362,14 +376,26 @@ func resourceRestAPIRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error reading API Gateway REST API (%s): %s", d.Id(), err)
 	}
 
+	// When the API is defined by an OpenAPI specification, also fetch the methods
+	// so that changes made outside of Terraform can be detected.
+	body := d.Get("body").(string)
 	getResourcesInput := &apigateway.GetResourcesInput{
 		RestApiId: aws.String(d.Id()),
 	}
+	if body != "" {
+		getResourcesInput.Embed = aws.StringSlice([]string{"methods"})
+	}
+	operations := make(map[string]struct{})
 	err = conn.GetResourcesPages(getResourcesInput, func(page *apigateway.GetResourcesOutput, lastPage bool) bool {
 		for _, item := range page.Items {
 			if aws.StringValue(item.Path) == "/" {
 				d.Set("root_resource_id", item.Id)
-				return false
+				if body == "" {
+					return false
+				}
+			}
+			for method := range item.ResourceMethods {
+				operations[restAPIOperation(method, aws.StringValue(item.Path))] = struct{}{}
 			}
 		}
 		return !lastPage
@@ -378,6 +404,23 @@ func resourceRestAPIRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error reading API Gateway REST API (%s) resources: %s", d.Id(), err)
 	}
 
+	// put_rest_api_mode is not returned by the API; default it for imported resources.
+	if _, ok := d.GetOk("put_rest_api_mode"); !ok {
+		d.Set("put_rest_api_mode", apigateway.PutModeOverwrite)
+	}
+
+	if body != "" {
+		drifted, err := restAPIBodyDrifted(body, operations, d.Get("parameters").(map[string]interface{}))
+
+		if err != nil {
+			log.Printf("[WARN] Unable to compare API Gateway REST API (%s) with its OpenAPI specification: %s", d.Id(), err)
+		} else if drifted {
+			// Clearing the body forces the specification to be put again.
+			log.Printf("[WARN] API Gateway REST API (%s) is missing methods defined by its OpenAPI specification", d.Id())
+			d.Set("body", "")
+		}
+	}
+
 	d.Set("name", api.Name)
 	d.Set("description", api.Description)
 	d.Set("api_key_source", api.ApiKeySource)
@@ -591,13 +634,13 @@ func resourceRestAPIUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	if d.HasChanges("body", "parameters") {
+	if d.HasChanges("body", "parameters", "put_rest_api_mode") {
 		if body, ok := d.GetOk("body"); ok {
 			log.Printf("[DEBUG] Updating API Gateway from OpenAPI spec: %s", d.Id())
 
 			input := &apigateway.PutRestApiInput{
 				RestApiId: aws.String(d.Id()),
-				Mode:      aws.String(apigateway.PutModeOverwrite),
+				Mode:      aws.String(d.Get("put_rest_api_mode").(string)),
 				Body:      []byte(body.(string)),
 			}
 
@@ -790,3 +833,202 @@ func flattenEndpointConfiguration(endpointConfiguration *apigateway.EndpointConf
 
 	return []interface{}{m}
 }
+
+// openAPIOperationAnyMethod is the OpenAPI extension used by API Gateway for the ANY method.
+const openAPIOperationAnyMethod = "x-amazon-apigateway-any-method"
+
+func restAPIOperation(method, path string) string {
+	return fmt.Sprintf("%s %s", strings.ToUpper(method), path)
+}
+
+// openAPIOperations returns the operations, e.g. "GET /pets", defined by an
+// OpenAPI or Swagger specification in JSON or YAML format. The specification's
+// base path is applied to the operation paths as the specified basepath import
+// parameter does: ignored by default, prepended in full with "prepend", and
+// prepended without its first segment with "split".
+func openAPIOperations(body, basePathMode string) (map[string]struct{}, error) {
+	var spec map[string]interface{}
+
+	// JSON is a subset of YAML, so both formats can be parsed by the YAML parser.
+	if err := yaml.Unmarshal([]byte(body), &spec); err != nil {
+		return nil, fmt.Errorf("parsing OpenAPI specification: %w", err)
+	}
+
+	operations := make(map[string]struct{})
+
+	paths, ok := spec["paths"]
+
+	if !ok {
+		return operations, nil
+	}
+
+	var prefix string
+
+	switch basePath := openAPIBasePath(spec); basePathMode {
+	case "prepend":
+		prefix = basePath
+	case "split":
+		if parts := strings.SplitN(strings.TrimPrefix(basePath, "/"), "/", 2); len(parts) == 2 {
+			prefix = "/" + parts[1]
+		}
+	}
+
+	for p, pathItem := range yamlMap(paths) {
+		if prefix != "" {
+			p = strings.TrimSuffix(prefix+p, "/")
+		}
+
+		for key := range yamlMap(pathItem) {
+			switch method := strings.ToLower(key); method {
+			case "delete", "get", "head", "options", "patch", "post", "put":
+				operations[restAPIOperation(method, p)] = struct{}{}
+			case openAPIOperationAnyMethod:
+				operations[restAPIOperation("ANY", p)] = struct{}{}
+			}
+		}
+	}
+
+	return operations, nil
+}
+
+// openAPIBasePath returns the base path of an OpenAPI specification without a trailing slash:
+// the basePath of a Swagger 2.0 specification, or the path of the first server URL of an
+// OpenAPI 3.0 specification, with its variables replaced by their default values.
+func openAPIBasePath(spec map[string]interface{}) string {
+	var basePath string
+
+	if v, ok := spec["basePath"].(string); ok {
+		basePath = v
+	} else if v, ok := spec["servers"].([]interface{}); ok && len(v) > 0 {
+		server := yamlMap(v[0])
+		serverURL, _ := server["url"].(string)
+
+		for name, variable := range yamlMap(server["variables"]) {
+			if v, ok := yamlMap(variable)["default"]; ok {
+				serverURL = strings.ReplaceAll(serverURL, "{"+name+"}", fmt.Sprint(v))
+			}
+		}
+
+		u, err := url.Parse(serverURL)
+
+		if err != nil {
+			return ""
+		}
+
+		basePath = u.Path
+	}
+
+	if basePath == "" {
+		return ""
+	}
+
+	if basePath = path.Clean("/" + basePath); basePath == "/" {
+		return ""
+	}
+
+	return basePath
+}
+
+// suppressEquivalentOpenAPIDiffs suppresses differences between OpenAPI specifications
+// that differ only in formatting, key ordering or choice of JSON or YAML.
+func suppressEquivalentOpenAPIDiffs(k, old, new string, d *schema.ResourceData) bool {
+	if old == "" || new == "" {
+		return old == new
+	}
+
+	normalizedOld, err := normalizeOpenAPI(old)
+
+	if err != nil {
+		return false
+	}
+
+	normalizedNew, err := normalizeOpenAPI(new)
+
+	if err != nil {
+		return false
+	}
+
+	return normalizedOld == normalizedNew
+}
+
+// normalizeOpenAPI returns an OpenAPI specification in JSON or YAML format as canonical JSON.
+func normalizeOpenAPI(body string) (string, error) {
+	var v interface{}
+
+	if err := yaml.Unmarshal([]byte(body), &v); err != nil {
+		return "", err
+	}
+
+	b, err := json.Marshal(yamlValue(v))
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// yamlValue recursively converts YAML mappings into maps with string keys so that they can be marshaled as JSON.
+func yamlValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, v := range v {
+			m[fmt.Sprint(k)] = yamlValue(v)
+		}
+		return m
+	case []interface{}:
+		l := make([]interface{}, len(v))
+		for i, v := range v {
+			l[i] = yamlValue(v)
+		}
+		return l
+	default:
+		return v
+	}
+}
+
+// yamlMap converts a YAML mapping into a map with string keys.
+func yamlMap(v interface{}) map[string]interface{} {
+	m := make(map[string]interface{})
+
+	switch v := v.(type) {
+	case map[string]interface{}:
+		return v
+	case map[interface{}]interface{}:
+		for k, v := range v {
+			m[fmt.Sprint(k)] = v
+		}
+	}
+
+	return m
+}
+
+// restAPIBodyDrifted returns whether the REST API is missing any of the methods defined by its
+// OpenAPI specification. Methods not defined by the specification are ignored, as they may be
+// managed separately, e.g. by aws_api_gateway_method resources.
+func restAPIBodyDrifted(body string, operations map[string]struct{}, parameters map[string]interface{}) (bool, error) {
+	basePathMode, _ := parameters["basepath"].(string)
+	expected, err := openAPIOperations(body, basePathMode)
+
+	if err != nil {
+		return false, err
+	}
+
+	var missing []string
+
+	for operation := range expected {
+		if _, ok := operations[operation]; !ok {
+			missing = append(missing, operation)
+		}
+	}
+
+	if len(missing) == 0 {
+		return false, nil
+	}
+
+	sort.Strings(missing)
+	log.Printf("[DEBUG] REST API methods missing from API: %v", missing)
+
+	return true, nil
+}
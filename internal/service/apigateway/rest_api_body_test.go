@@ -0,0 +1,172 @@
+package apigateway
+
+import (
+	"testing"
+)
+
+func TestSuppressEquivalentOpenAPIDiffs(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Old      string
+		New      string
+		Expected bool
+	}{
+		{
+			Name:     "empty",
+			Old:      "",
+			New:      "",
+			Expected: true,
+		},
+		{
+			Name:     "new body",
+			Old:      "",
+			New:      `{"openapi":"3.0.1"}`,
+			Expected: false,
+		},
+		{
+			Name:     "JSON key ordering",
+			Old:      `{"openapi":"3.0.1","info":{"title":"test","version":"1.0"}}`,
+			New:      `{"info":{"version":"1.0","title":"test"},"openapi":"3.0.1"}`,
+			Expected: true,
+		},
+		{
+			Name: "JSON and YAML",
+			Old:  `{"openapi":"3.0.1","info":{"title":"test","version":"1.0"}}`,
+			New: `
+openapi: "3.0.1"
+info:
+  version: "1.0"
+  title: test
+`,
+			Expected: true,
+		},
+		{
+			Name:     "different value",
+			Old:      `{"openapi":"3.0.1","info":{"title":"test","version":"1.0"}}`,
+			New:      `{"openapi":"3.0.1","info":{"title":"test","version":"2.0"}}`,
+			Expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got, want := suppressEquivalentOpenAPIDiffs("body", testCase.Old, testCase.New, nil), testCase.Expected; got != want {
+				t.Errorf("got %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestRestAPIBodyDrifted(t *testing.T) {
+	body := `
+openapi: "3.0.1"
+paths:
+  /test:
+    get:
+      responses: {}
+    parameters: []
+  /any:
+    x-amazon-apigateway-any-method: {}
+`
+	serversBody := `
+openapi: "3.0.1"
+servers:
+  - url: "https://example.com/{basePath}"
+    variables:
+      basePath:
+        default: "v1/api"
+paths:
+  /:
+    get: {}
+  /test:
+    get: {}
+`
+	swaggerBody := `{"swagger": "2.0", "basePath": "/v1/api", "paths": {"/test": {"get": {}}}}`
+
+	testCases := []struct {
+		Name       string
+		Body       string
+		Operations []string
+		Parameters map[string]interface{}
+		Expected   bool
+	}{
+		{
+			Name:       "in sync",
+			Body:       body,
+			Operations: []string{"GET /test", "ANY /any"},
+			Expected:   false,
+		},
+		{
+			Name:       "method added",
+			Body:       body,
+			Operations: []string{"GET /test", "ANY /any", "POST /test", "OPTIONS /test"},
+			Expected:   false,
+		},
+		{
+			Name:       "method removed",
+			Body:       body,
+			Operations: []string{"GET /test"},
+			Expected:   true,
+		},
+		{
+			Name:       "servers base path ignored",
+			Body:       serversBody,
+			Operations: []string{"GET /", "GET /test"},
+			Expected:   false,
+		},
+		{
+			Name:       "servers base path prepended",
+			Body:       serversBody,
+			Operations: []string{"GET /v1/api", "GET /v1/api/test"},
+			Parameters: map[string]interface{}{"basepath": "prepend"},
+			Expected:   false,
+		},
+		{
+			Name:       "servers base path prepended method removed",
+			Body:       serversBody,
+			Operations: []string{"GET /v1/api", "GET /test"},
+			Parameters: map[string]interface{}{"basepath": "prepend"},
+			Expected:   true,
+		},
+		{
+			Name:       "servers base path split",
+			Body:       serversBody,
+			Operations: []string{"GET /api", "GET /api/test"},
+			Parameters: map[string]interface{}{"basepath": "split"},
+			Expected:   false,
+		},
+		{
+			Name:       "swagger base path prepended",
+			Body:       swaggerBody,
+			Operations: []string{"GET /v1/api/test"},
+			Parameters: map[string]interface{}{"basepath": "prepend"},
+			Expected:   false,
+		},
+		{
+			Name:       "swagger base path ignored",
+			Body:       swaggerBody,
+			Operations: []string{"GET /v1/api/test"},
+			Parameters: map[string]interface{}{"basepath": "ignore"},
+			Expected:   true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			operations := make(map[string]struct{})
+			for _, operation := range testCase.Operations {
+				operations[operation] = struct{}{}
+			}
+
+			got, err := restAPIBodyDrifted(testCase.Body, operations, testCase.Parameters)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if want := testCase.Expected; got != want {
+				t.Errorf("got %t, want %t", got, want)
+			}
+		})
+	}
+}
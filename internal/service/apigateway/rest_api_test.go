@@ -525,6 +525,43 @@ func TestAccAPIGatewayRestAPI_body(t *testing.T) {
 	})
 }
 
+func TestAccAPIGatewayRestAPI_putRestAPIMode(t *testing.T) {
+	var conf apigateway.RestApi
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_api_gateway_rest_api.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckAPIGatewayTypeEDGE(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, apigateway.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRestAPIDestroy,
+		Steps: []resource.TestStep{
+			// Methods managed outside of the body are kept in merge mode and must not cause a diff.
+			{
+				Config: testAccRestAPIConfig_putRestAPIModeMerge(rName, "/test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRestAPIExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "put_rest_api_mode", "merge"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"body", "put_rest_api_mode"},
+			},
+			{
+				Config: testAccRestAPIConfig_putRestAPIModeMerge(rName, "/update"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRestAPIExists(resourceName, &conf),
+					testAccCheckRestAPIRoutes(&conf, []string{"/", "/extra", "/test", "/update"}),
+					resource.TestCheckResourceAttr(resourceName, "put_rest_api_mode", "merge"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAPIGatewayRestAPI_description(t *testing.T) {
 	var conf apigateway.RestApi
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -2024,6 +2061,58 @@ resource "aws_api_gateway_rest_api" "test" {
 `, rName, basePath)
 }
 
+func testAccRestAPIConfig_putRestAPIModeMerge(rName string, basePath string) string {
+	return fmt.Sprintf(`
+resource "aws_api_gateway_rest_api" "test" {
+  name              = %[1]q
+  put_rest_api_mode = "merge"
+
+  body = jsonencode({
+    swagger = "2.0"
+    info = {
+      title   = "test"
+      version = "2017-04-20T04:08:08Z"
+    }
+    schemes = ["https"]
+    paths = {
+      %[2]q = {
+        get = {
+          responses = {
+            "200" = {
+              description = "OK"
+            }
+          }
+          x-amazon-apigateway-integration = {
+            httpMethod = "GET"
+            type       = "HTTP"
+            responses = {
+              default = {
+                statusCode = 200
+              }
+            }
+            uri = "https://api.example.com/"
+          }
+        }
+      }
+    }
+  })
+}
+
+resource "aws_api_gateway_resource" "test" {
+  parent_id   = aws_api_gateway_rest_api.test.root_resource_id
+  path_part   = "extra"
+  rest_api_id = aws_api_gateway_rest_api.test.id
+}
+
+resource "aws_api_gateway_method" "test" {
+  authorization = "NONE"
+  http_method   = "GET"
+  resource_id   = aws_api_gateway_resource.test.id
+  rest_api_id   = aws_api_gateway_rest_api.test.id
+}
+`, rName, basePath)
+}
+
 func testAccRestAPIConfig_description(rName string, description string) string {
 	return fmt.Sprintf(`
 resource "aws_api_gateway_rest_api" "test" {
@@ -0,0 +1,81 @@
+package apigatewayv2
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// DataSourceConnectionsEndpoint returns the @connections management endpoint of a WebSocket API stage.
+// Backend services use this endpoint to send messages to, and disconnect, connected clients.
+func DataSourceConnectionsEndpoint() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceConnectionsEndpointRead,
+
+		Schema: map[string]*schema.Schema{
+			"api_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"execution_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"stage_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceConnectionsEndpointRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).APIGatewayV2Conn
+	apiID := d.Get("api_id").(string)
+	stageName := d.Get("stage_name").(string)
+
+	api, err := FindAPIByID(conn, apiID)
+
+	if tfresource.NotFound(err) {
+		return fmt.Errorf("no API Gateway v2 API matched; change the search criteria and try again")
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading API Gateway v2 API (%s): %w", apiID, err)
+	}
+
+	if protocolType := aws.StringValue(api.ProtocolType); protocolType != apigatewayv2.ProtocolTypeWebsocket {
+		return fmt.Errorf("API Gateway v2 API (%s) has protocol type %s, only %s APIs have an @connections endpoint", apiID, protocolType, apigatewayv2.ProtocolTypeWebsocket)
+	}
+
+	if _, err := FindStageByTwoPartKey(conn, apiID, stageName); err != nil {
+		return fmt.Errorf("error reading API Gateway v2 Stage (%s/%s): %w", apiID, stageName, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", apiID, stageName))
+
+	// The management API is served from the same host as the WebSocket endpoint, over HTTPS.
+	host := strings.TrimPrefix(aws.StringValue(api.ApiEndpoint), "wss://")
+	d.Set("url", fmt.Sprintf("https://%s/%s/@connections", host, stageName))
+
+	executionArn := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Service:   "execute-api",
+		Region:    meta.(*conns.AWSClient).Region,
+		AccountID: meta.(*conns.AWSClient).AccountID,
+		Resource:  fmt.Sprintf("%s/%s/POST/@connections/*", apiID, stageName),
+	}.String()
+	d.Set("execution_arn", executionArn)
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package apigatewayv2_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccAPIGatewayV2ConnectionsEndpointDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_apigatewayv2_connections_endpoint.test"
+	stageResourceName := "aws_apigatewayv2_stage.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, apigatewayv2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConnectionsEndpointDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "api_id", stageResourceName, "api_id"),
+					acctest.MatchResourceAttrRegionalARN(dataSourceName, "execution_arn", "execute-api", regexp.MustCompile(fmt.Sprintf(`.+/%s/POST/@connections/\*$`, rName))),
+					resource.TestCheckResourceAttrPair(dataSourceName, "stage_name", stageResourceName, "name"),
+					resource.TestMatchResourceAttr(dataSourceName, "url", regexp.MustCompile(fmt.Sprintf(`^https://[a-z0-9]+\.execute-api\..+/%s/@connections$`, rName))),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAPIGatewayV2ConnectionsEndpointDataSource_http(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, apigatewayv2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccConnectionsEndpointDataSourceConfig_http(rName),
+				ExpectError: regexp.MustCompile(`only WEBSOCKET APIs have an @connections endpoint`),
+			},
+		},
+	})
+}
+
+func testAccConnectionsEndpointDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccStageConfig_basicWebSocket(rName), `
+data "aws_apigatewayv2_connections_endpoint" "test" {
+  api_id     = aws_apigatewayv2_stage.test.api_id
+  stage_name = aws_apigatewayv2_stage.test.name
+}
+`)
+}
+
+func testAccConnectionsEndpointDataSourceConfig_http(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_apigatewayv2_api" "test" {
+  name          = %[1]q
+  protocol_type = "HTTP"
+}
+
+data "aws_apigatewayv2_connections_endpoint" "test" {
+  api_id     = aws_apigatewayv2_api.test.id
+  stage_name = "$default"
+}
+`, rName)
+}
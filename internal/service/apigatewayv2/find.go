@@ -104,3 +104,35 @@ func FindDomainName(conn *apigatewayv2.ApiGatewayV2, input *apigatewayv2.GetDoma
 
 	return output, nil
 }
+
+// FindStageByTwoPartKey returns the stage corresponding to the specified API ID and stage name.
+// Returns NotFoundError if no stage is found.
+func FindStageByTwoPartKey(conn *apigatewayv2.ApiGatewayV2, apiID, stageName string) (*apigatewayv2.GetStageOutput, error) {
+	input := &apigatewayv2.GetStageInput{
+		ApiId:     aws.String(apiID),
+		StageName: aws.String(stageName),
+	}
+
+	output, err := conn.GetStage(input)
+
+	if tfawserr.ErrCodeEquals(err, apigatewayv2.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Handle any empty result.
+	if output == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
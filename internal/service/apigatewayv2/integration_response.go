@@ -44,8 +44,9 @@ func ResourceIntegrationResponse() *schema.Resource {
 				ForceNew: true,
 			},
 			"integration_response_key": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validIntegrationResponseKey(),
 			},
 			"response_templates": {
 				Type:     schema.TypeMap,
@@ -86,8 +86,9 @@ func ResourceRoute() *schema.Resource {
 				Required: true,
 			},
 			"route_response_selection_expression": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validRouteResponseKey(),
 			},
 			"target": {
 				Type:         schema.TypeString,
@@ -13,6 +13,10 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 )
 
+const (
+	routeResponseDefaultKey = "$default"
+)
+
 func ResourceRouteResponse() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceRouteResponseCreate,
@@ -44,8 +48,9 @@ func ResourceRouteResponse() *schema.Resource {
 				ForceNew: true,
 			},
 			"route_response_key": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validRouteResponseKey(),
 			},
 		},
 	}
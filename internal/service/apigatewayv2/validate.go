@@ -1,6 +1,8 @@
 package apigatewayv2
 
 import (
+	"regexp"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -17,3 +19,17 @@ func validHTTPMethod() schema.SchemaValidateFunc {
 		"PUT",
 	}, false)
 }
+
+// validIntegrationResponseKey validates a WebSocket API integration response key,
+// which is either $default or a regular expression enclosed in forward slashes.
+func validIntegrationResponseKey() schema.SchemaValidateFunc {
+	return validation.StringMatch(regexp.MustCompile(`^(\$default|/.+/)$`), "must be $default or a regular expression enclosed in forward slashes, e.g. /2\\d\\d/")
+}
+
+// validRouteResponseKey validates a WebSocket API route response key.
+// $default is currently the only supported value.
+func validRouteResponseKey() schema.SchemaValidateFunc {
+	return validation.StringInSlice([]string{
+		routeResponseDefaultKey,
+	}, false)
+}
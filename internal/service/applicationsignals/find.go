@@ -0,0 +1,36 @@
+package applicationsignals
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/applicationsignals"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindServiceLevelObjectiveByID(ctx context.Context, conn *applicationsignals.ApplicationSignals, id string) (*applicationsignals.ServiceLevelObjective, error) {
+	input := &applicationsignals.GetServiceLevelObjectiveInput{
+		Id: aws.String(id),
+	}
+
+	output, err := conn.GetServiceLevelObjectiveWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, applicationsignals.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Slo == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Slo, nil
+}
@@ -0,0 +1,725 @@
+package applicationsignals
+
+import (
+	"context"
+	"log"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/applicationsignals"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceServiceLevelObjective() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceServiceLevelObjectiveCreate,
+		ReadWithoutTimeout:   resourceServiceLevelObjectiveRead,
+		UpdateWithoutTimeout: resourceServiceLevelObjectiveUpdate,
+		DeleteWithoutTimeout: resourceServiceLevelObjectiveDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"goal": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attainment_goal": {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.FloatBetween(0, 100),
+						},
+						"interval": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"calendar_interval": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"duration": {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ValidateFunc: validation.IntAtLeast(1),
+												},
+												"duration_unit": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(applicationsignals.DurationUnit_Values(), false),
+												},
+												"start_time": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: verify.ValidUTCTimestamp,
+												},
+											},
+										},
+									},
+									"rolling_interval": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"duration": {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ValidateFunc: validation.IntAtLeast(1),
+												},
+												"duration_unit": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(applicationsignals.DurationUnit_Values(), false),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"warning_threshold": {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.FloatBetween(0, 100),
+						},
+					},
+				},
+			},
+			"last_updated_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 128),
+					validation.StringMatch(regexp.MustCompile(`^[0-9A-Za-z][-._0-9A-Za-z ]*$`), "must start with a letter or number and contain only letters, numbers, spaces, hyphens, periods and underscores"),
+				),
+			},
+			"sli": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"comparison_operator": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(applicationsignals.ServiceLevelIndicatorComparisonOperator_Values(), false),
+						},
+						"metric_threshold": {
+							Type:     schema.TypeFloat,
+							Required: true,
+						},
+						"sli_metric": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key_attributes": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"metric_data_query": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"account_id": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: verify.ValidAccountID,
+												},
+												"expression": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringLenBetween(1, 2048),
+												},
+												"id": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 255),
+												},
+												"label": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"metric_stat": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"metric": {
+																Type:     schema.TypeList,
+																Required: true,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"dimensions": {
+																			Type:     schema.TypeMap,
+																			Optional: true,
+																			Elem:     &schema.Schema{Type: schema.TypeString},
+																		},
+																		"metric_name": {
+																			Type:         schema.TypeString,
+																			Required:     true,
+																			ValidateFunc: validation.StringLenBetween(1, 255),
+																		},
+																		"namespace": {
+																			Type:         schema.TypeString,
+																			Required:     true,
+																			ValidateFunc: validation.StringLenBetween(1, 255),
+																		},
+																	},
+																},
+															},
+															"period": {
+																Type:         schema.TypeInt,
+																Required:     true,
+																ValidateFunc: validation.IntAtLeast(1),
+															},
+															"stat": {
+																Type:     schema.TypeString,
+																Required: true,
+															},
+															"unit": {
+																Type:         schema.TypeString,
+																Optional:     true,
+																ValidateFunc: validation.StringInSlice(applicationsignals.StandardUnit_Values(), false),
+															},
+														},
+													},
+												},
+												"period": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntAtLeast(1),
+												},
+												"return_data": {
+													Type:     schema.TypeBool,
+													Optional: true,
+													Computed: true,
+												},
+											},
+										},
+									},
+									"metric_type": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(applicationsignals.ServiceLevelIndicatorMetricType_Values(), false),
+									},
+									"operation_name": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 255),
+									},
+									"period_seconds": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(60, 900),
+									},
+									"statistic": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceServiceLevelObjectiveCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ApplicationSignalsConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &applicationsignals.CreateServiceLevelObjectiveInput{
+		Name:      aws.String(name),
+		SliConfig: expandServiceLevelIndicatorConfig(d.Get("sli").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("goal"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Goal = expandGoal(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateServiceLevelObjectiveWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Application Signals Service Level Objective (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Slo.Arn))
+
+	return resourceServiceLevelObjectiveRead(ctx, d, meta)
+}
+
+func resourceServiceLevelObjectiveRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ApplicationSignalsConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	slo, err := FindServiceLevelObjectiveByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Application Signals Service Level Objective (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Application Signals Service Level Objective (%s): %s", d.Id(), err)
+	}
+
+	arn := aws.StringValue(slo.Arn)
+	d.Set("arn", arn)
+	d.Set("created_time", aws.TimeValue(slo.CreatedTime).Format(time.RFC3339))
+	d.Set("description", slo.Description)
+	if slo.Goal != nil {
+		if err := d.Set("goal", []interface{}{flattenGoal(slo.Goal)}); err != nil {
+			return diag.Errorf("setting goal: %s", err)
+		}
+	} else {
+		d.Set("goal", nil)
+	}
+	d.Set("last_updated_time", aws.TimeValue(slo.LastUpdatedTime).Format(time.RFC3339))
+	d.Set("name", slo.Name)
+	if slo.Sli != nil {
+		if err := d.Set("sli", []interface{}{flattenServiceLevelIndicator(slo.Sli, d.Get("sli").([]interface{}))}); err != nil {
+			return diag.Errorf("setting sli: %s", err)
+		}
+	} else {
+		d.Set("sli", nil)
+	}
+
+	tags, err := ListTagsWithContext(ctx, conn, arn)
+
+	if err != nil {
+		return diag.Errorf("listing tags for Application Signals Service Level Objective (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceServiceLevelObjectiveUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ApplicationSignalsConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &applicationsignals.UpdateServiceLevelObjectiveInput{
+			Id: aws.String(d.Id()),
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("goal") {
+			if v, ok := d.GetOk("goal"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.Goal = expandGoal(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("sli") {
+			input.SliConfig = expandServiceLevelIndicatorConfig(d.Get("sli").([]interface{}))
+		}
+
+		_, err := conn.UpdateServiceLevelObjectiveWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating Application Signals Service Level Objective (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating Application Signals Service Level Objective (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceServiceLevelObjectiveRead(ctx, d, meta)
+}
+
+func resourceServiceLevelObjectiveDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ApplicationSignalsConn
+
+	log.Printf("[DEBUG] Deleting Application Signals Service Level Objective: %s", d.Id())
+	_, err := conn.DeleteServiceLevelObjectiveWithContext(ctx, &applicationsignals.DeleteServiceLevelObjectiveInput{
+		Id: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, applicationsignals.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Application Signals Service Level Objective (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandGoal(tfMap map[string]interface{}) *applicationsignals.Goal {
+	apiObject := &applicationsignals.Goal{}
+
+	if v, ok := tfMap["attainment_goal"].(float64); ok && v != 0 {
+		apiObject.AttainmentGoal = aws.Float64(v)
+	}
+
+	if v, ok := tfMap["interval"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Interval = expandInterval(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["warning_threshold"].(float64); ok && v != 0 {
+		apiObject.WarningThreshold = aws.Float64(v)
+	}
+
+	return apiObject
+}
+
+func expandInterval(tfMap map[string]interface{}) *applicationsignals.Interval {
+	apiObject := &applicationsignals.Interval{}
+
+	if v, ok := tfMap["calendar_interval"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		// Value has already been validated as an RFC3339 timestamp.
+		startTime, _ := time.Parse(time.RFC3339, tfMap["start_time"].(string))
+
+		apiObject.CalendarInterval = &applicationsignals.CalendarInterval{
+			Duration:     aws.Int64(int64(tfMap["duration"].(int))),
+			DurationUnit: aws.String(tfMap["duration_unit"].(string)),
+			StartTime:    aws.Time(startTime),
+		}
+	}
+
+	if v, ok := tfMap["rolling_interval"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.RollingInterval = &applicationsignals.RollingInterval{
+			Duration:     aws.Int64(int64(tfMap["duration"].(int))),
+			DurationUnit: aws.String(tfMap["duration_unit"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func expandServiceLevelIndicatorConfig(tfList []interface{}) *applicationsignals.ServiceLevelIndicatorConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &applicationsignals.ServiceLevelIndicatorConfig{
+		ComparisonOperator: aws.String(tfMap["comparison_operator"].(string)),
+		MetricThreshold:    aws.Float64(tfMap["metric_threshold"].(float64)),
+	}
+
+	if v, ok := tfMap["sli_metric"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SliMetricConfig = expandServiceLevelIndicatorMetricConfig(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandServiceLevelIndicatorMetricConfig(tfMap map[string]interface{}) *applicationsignals.ServiceLevelIndicatorMetricConfig {
+	apiObject := &applicationsignals.ServiceLevelIndicatorMetricConfig{}
+
+	if v, ok := tfMap["key_attributes"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.KeyAttributes = flex.ExpandStringMap(v)
+	}
+
+	// Metric data queries are generated by the service when key attributes are used.
+	if v, ok := tfMap["metric_data_query"].([]interface{}); ok && len(v) > 0 && apiObject.KeyAttributes == nil {
+		apiObject.MetricDataQueries = expandMetricDataQueries(v)
+	}
+
+	if v, ok := tfMap["metric_type"].(string); ok && v != "" {
+		apiObject.MetricType = aws.String(v)
+	}
+
+	if v, ok := tfMap["operation_name"].(string); ok && v != "" {
+		apiObject.OperationName = aws.String(v)
+	}
+
+	if v, ok := tfMap["period_seconds"].(int); ok && v != 0 {
+		apiObject.PeriodSeconds = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["statistic"].(string); ok && v != "" {
+		apiObject.Statistic = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandMetricDataQueries(tfList []interface{}) []*applicationsignals.MetricDataQuery {
+	var apiObjects []*applicationsignals.MetricDataQuery
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &applicationsignals.MetricDataQuery{
+			Id: aws.String(tfMap["id"].(string)),
+		}
+
+		if v, ok := tfMap["account_id"].(string); ok && v != "" {
+			apiObject.AccountId = aws.String(v)
+		}
+
+		if v, ok := tfMap["expression"].(string); ok && v != "" {
+			apiObject.Expression = aws.String(v)
+		}
+
+		if v, ok := tfMap["label"].(string); ok && v != "" {
+			apiObject.Label = aws.String(v)
+		}
+
+		if v, ok := tfMap["metric_stat"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.MetricStat = expandMetricStat(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["period"].(int); ok && v != 0 {
+			apiObject.Period = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["return_data"].(bool); ok && v {
+			apiObject.ReturnData = aws.Bool(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandMetricStat(tfMap map[string]interface{}) *applicationsignals.MetricStat {
+	apiObject := &applicationsignals.MetricStat{
+		Metric: &applicationsignals.Metric{},
+		Period: aws.Int64(int64(tfMap["period"].(int))),
+		Stat:   aws.String(tfMap["stat"].(string)),
+	}
+
+	if v, ok := tfMap["metric"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		if v, ok := tfMap["dimensions"].(map[string]interface{}); ok && len(v) > 0 {
+			apiObject.Metric.Dimensions = expandDimensions(v)
+		}
+
+		apiObject.Metric.MetricName = aws.String(tfMap["metric_name"].(string))
+		apiObject.Metric.Namespace = aws.String(tfMap["namespace"].(string))
+	}
+
+	if v, ok := tfMap["unit"].(string); ok && v != "" {
+		apiObject.Unit = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandDimensions(tfMap map[string]interface{}) []*applicationsignals.Dimension {
+	keys := make([]string, 0, len(tfMap))
+
+	for k := range tfMap {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	apiObjects := make([]*applicationsignals.Dimension, 0, len(keys))
+
+	for _, k := range keys {
+		apiObjects = append(apiObjects, &applicationsignals.Dimension{
+			Name:  aws.String(k),
+			Value: aws.String(tfMap[k].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenGoal(apiObject *applicationsignals.Goal) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"attainment_goal":   aws.Float64Value(apiObject.AttainmentGoal),
+		"warning_threshold": aws.Float64Value(apiObject.WarningThreshold),
+	}
+
+	if v := apiObject.Interval; v != nil {
+		interval := map[string]interface{}{}
+
+		if v := v.CalendarInterval; v != nil {
+			interval["calendar_interval"] = []interface{}{map[string]interface{}{
+				"duration":      int(aws.Int64Value(v.Duration)),
+				"duration_unit": aws.StringValue(v.DurationUnit),
+				"start_time":    aws.TimeValue(v.StartTime).Format(time.RFC3339),
+			}}
+		}
+
+		if v := v.RollingInterval; v != nil {
+			interval["rolling_interval"] = []interface{}{map[string]interface{}{
+				"duration":      int(aws.Int64Value(v.Duration)),
+				"duration_unit": aws.StringValue(v.DurationUnit),
+			}}
+		}
+
+		tfMap["interval"] = []interface{}{interval}
+	}
+
+	return tfMap
+}
+
+// flattenServiceLevelIndicator flattens the SLI returned by the API.
+// The period and statistic used to generate metric data queries from key
+// attributes are not returned, so they are carried over from configuration.
+func flattenServiceLevelIndicator(apiObject *applicationsignals.ServiceLevelIndicator, tfList []interface{}) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"comparison_operator": aws.StringValue(apiObject.ComparisonOperator),
+		"metric_threshold":    aws.Float64Value(apiObject.MetricThreshold),
+	}
+
+	if v := apiObject.SliMetric; v != nil {
+		sliMetric := map[string]interface{}{
+			"key_attributes":    aws.StringValueMap(v.KeyAttributes),
+			"metric_data_query": flattenMetricDataQueries(v.MetricDataQueries),
+			"metric_type":       aws.StringValue(v.MetricType),
+			"operation_name":    aws.StringValue(v.OperationName),
+		}
+
+		if len(tfList) > 0 && tfList[0] != nil {
+			if v, ok := tfList[0].(map[string]interface{})["sli_metric"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				tfMap := v[0].(map[string]interface{})
+				sliMetric["period_seconds"] = tfMap["period_seconds"]
+				sliMetric["statistic"] = tfMap["statistic"]
+			}
+		}
+
+		tfMap["sli_metric"] = []interface{}{sliMetric}
+	}
+
+	return tfMap
+}
+
+func flattenMetricDataQueries(apiObjects []*applicationsignals.MetricDataQuery) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"account_id":  aws.StringValue(apiObject.AccountId),
+			"expression":  aws.StringValue(apiObject.Expression),
+			"id":          aws.StringValue(apiObject.Id),
+			"label":       aws.StringValue(apiObject.Label),
+			"period":      int(aws.Int64Value(apiObject.Period)),
+			"return_data": aws.BoolValue(apiObject.ReturnData),
+		}
+
+		if v := apiObject.MetricStat; v != nil {
+			metricStat := map[string]interface{}{
+				"period": int(aws.Int64Value(v.Period)),
+				"stat":   aws.StringValue(v.Stat),
+				"unit":   aws.StringValue(v.Unit),
+			}
+
+			if v := v.Metric; v != nil {
+				metricStat["metric"] = []interface{}{map[string]interface{}{
+					"dimensions":  flattenDimensions(v.Dimensions),
+					"metric_name": aws.StringValue(v.MetricName),
+					"namespace":   aws.StringValue(v.Namespace),
+				}}
+			}
+
+			tfMap["metric_stat"] = []interface{}{metricStat}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenDimensions(apiObjects []*applicationsignals.Dimension) map[string]interface{} {
+	tfMap := map[string]interface{}{}
+
+	for _, apiObject := range apiObjects {
+		tfMap[aws.StringValue(apiObject.Name)] = aws.StringValue(apiObject.Value)
+	}
+
+	return tfMap
+}
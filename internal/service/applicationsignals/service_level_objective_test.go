@@ -0,0 +1,313 @@
+package applicationsignals_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/applicationsignals"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfapplicationsignals "github.com/hashicorp/terraform-provider-aws/internal/service/applicationsignals"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccApplicationSignalsServiceLevelObjective_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_applicationsignals_service_level_objective.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, applicationsignals.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceLevelObjectiveDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceLevelObjectiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceLevelObjectiveExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "application-signals", regexp.MustCompile(`slo/.+`)),
+					acctest.CheckResourceAttrRFC3339(resourceName, "created_time"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "goal.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "sli.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "sli.0.comparison_operator", applicationsignals.ServiceLevelIndicatorComparisonOperatorLessThan),
+					resource.TestCheckResourceAttr(resourceName, "sli.0.metric_threshold", "2"),
+					resource.TestCheckResourceAttr(resourceName, "sli.0.sli_metric.0.metric_data_query.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "sli.0.sli_metric.0.metric_data_query.0.id", "m1"),
+					resource.TestCheckResourceAttr(resourceName, "sli.0.sli_metric.0.metric_data_query.0.metric_stat.0.metric.0.namespace", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccApplicationSignalsServiceLevelObjective_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_applicationsignals_service_level_objective.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, applicationsignals.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceLevelObjectiveDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceLevelObjectiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceLevelObjectiveExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfapplicationsignals.ResourceServiceLevelObjective(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccApplicationSignalsServiceLevelObjective_goal(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_applicationsignals_service_level_objective.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, applicationsignals.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceLevelObjectiveDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceLevelObjectiveConfig_rollingGoal(rName, "first", 99.5, 7),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceLevelObjectiveExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "first"),
+					resource.TestCheckResourceAttr(resourceName, "goal.0.attainment_goal", "99.5"),
+					resource.TestCheckResourceAttr(resourceName, "goal.0.warning_threshold", "30"),
+					resource.TestCheckResourceAttr(resourceName, "goal.0.interval.0.rolling_interval.0.duration", "7"),
+					resource.TestCheckResourceAttr(resourceName, "goal.0.interval.0.rolling_interval.0.duration_unit", applicationsignals.DurationUnitDay),
+				),
+			},
+			{
+				Config: testAccServiceLevelObjectiveConfig_rollingGoal(rName, "second", 99.9, 14),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceLevelObjectiveExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "second"),
+					resource.TestCheckResourceAttr(resourceName, "goal.0.attainment_goal", "99.9"),
+					resource.TestCheckResourceAttr(resourceName, "goal.0.interval.0.rolling_interval.0.duration", "14"),
+				),
+			},
+			{
+				Config: testAccServiceLevelObjectiveConfig_calendarGoal(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceLevelObjectiveExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "goal.0.interval.0.calendar_interval.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "goal.0.interval.0.calendar_interval.0.duration", "1"),
+					resource.TestCheckResourceAttr(resourceName, "goal.0.interval.0.calendar_interval.0.duration_unit", applicationsignals.DurationUnitMonth),
+					resource.TestCheckResourceAttr(resourceName, "goal.0.interval.0.calendar_interval.0.start_time", "2030-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "goal.0.interval.0.rolling_interval.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccApplicationSignalsServiceLevelObjective_tags(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_applicationsignals_service_level_objective.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, applicationsignals.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceLevelObjectiveDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceLevelObjectiveConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceLevelObjectiveExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccServiceLevelObjectiveConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceLevelObjectiveExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccServiceLevelObjectiveConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceLevelObjectiveExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckServiceLevelObjectiveExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Application Signals Service Level Objective ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ApplicationSignalsConn
+
+		_, err := tfapplicationsignals.FindServiceLevelObjectiveByID(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckServiceLevelObjectiveDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ApplicationSignalsConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_applicationsignals_service_level_objective" {
+			continue
+		}
+
+		_, err := tfapplicationsignals.FindServiceLevelObjectiveByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Application Signals Service Level Objective %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccServiceLevelObjectiveConfig_sli(rName string) string {
+	return fmt.Sprintf(`
+  sli {
+    comparison_operator = "LessThan"
+    metric_threshold    = 2
+
+    sli_metric {
+      metric_data_query {
+        id          = "m1"
+        return_data = true
+
+        metric_stat {
+          metric {
+            namespace   = %[1]q
+            metric_name = "Latency"
+
+            dimensions = {
+              Service = "test"
+            }
+          }
+
+          period = 60
+          stat   = "Average"
+        }
+      }
+    }
+  }
+`, rName)
+}
+
+func testAccServiceLevelObjectiveConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_applicationsignals_service_level_objective" "test" {
+  name = %[1]q
+%[2]s
+}
+`, rName, testAccServiceLevelObjectiveConfig_sli(rName))
+}
+
+func testAccServiceLevelObjectiveConfig_rollingGoal(rName, description string, attainmentGoal float64, duration int) string {
+	return fmt.Sprintf(`
+resource "aws_applicationsignals_service_level_objective" "test" {
+  name        = %[1]q
+  description = %[2]q
+%[5]s
+  goal {
+    attainment_goal   = %[3]g
+    warning_threshold = 30
+
+    interval {
+      rolling_interval {
+        duration      = %[4]d
+        duration_unit = "DAY"
+      }
+    }
+  }
+}
+`, rName, description, attainmentGoal, duration, testAccServiceLevelObjectiveConfig_sli(rName))
+}
+
+func testAccServiceLevelObjectiveConfig_calendarGoal(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_applicationsignals_service_level_objective" "test" {
+  name = %[1]q
+%[2]s
+  goal {
+    attainment_goal = 99.9
+
+    interval {
+      calendar_interval {
+        duration      = 1
+        duration_unit = "MONTH"
+        start_time    = "2030-01-01T00:00:00Z"
+      }
+    }
+  }
+}
+`, rName, testAccServiceLevelObjectiveConfig_sli(rName))
+}
+
+func testAccServiceLevelObjectiveConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_applicationsignals_service_level_objective" "test" {
+  name = %[1]q
+%[4]s
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1, testAccServiceLevelObjectiveConfig_sli(rName))
+}
+
+func testAccServiceLevelObjectiveConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_applicationsignals_service_level_objective" "test" {
+  name = %[1]q
+%[6]s
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2, testAccServiceLevelObjectiveConfig_sli(rName))
+}
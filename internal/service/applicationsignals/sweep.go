@@ -0,0 +1,65 @@
+//go:build sweep
+// +build sweep
+
+package applicationsignals
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/applicationsignals"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_applicationsignals_service_level_objective", &resource.Sweeper{
+		Name: "aws_applicationsignals_service_level_objective",
+		F:    sweepServiceLevelObjectives,
+	})
+}
+
+func sweepServiceLevelObjectives(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).ApplicationSignalsConn
+	input := &applicationsignals.ListServiceLevelObjectivesInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListServiceLevelObjectivesPages(input, func(page *applicationsignals.ListServiceLevelObjectivesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.SloSummaries {
+			r := ResourceServiceLevelObjective()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Arn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Application Signals Service Level Objective sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Application Signals Service Level Objectives (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Application Signals Service Level Objectives (%s): %w", region, err)
+	}
+
+	return nil
+}
@@ -51,3 +51,96 @@ func FindVirtualGateway(conn *appmesh.AppMesh, meshName, virtualGatewayName, mes
 
 	return output.VirtualGateway, nil
 }
+
+// FindRoute returns the route corresponding to the specified mesh name, virtual router name, route name and optional mesh owner.
+// Returns an error if no route is found.
+func FindRoute(conn *appmesh.AppMesh, meshName, virtualRouterName, routeName, meshOwner string) (*appmesh.RouteData, error) {
+	input := &appmesh.DescribeRouteInput{
+		MeshName:          aws.String(meshName),
+		RouteName:         aws.String(routeName),
+		VirtualRouterName: aws.String(virtualRouterName),
+	}
+	if meshOwner != "" {
+		input.MeshOwner = aws.String(meshOwner)
+	}
+
+	output, err := conn.DescribeRoute(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output.Route, nil
+}
+
+// FindVirtualNode returns the virtual node corresponding to the specified mesh name, virtual node name and optional mesh owner.
+// Returns an error if no virtual node is found.
+func FindVirtualNode(conn *appmesh.AppMesh, meshName, virtualNodeName, meshOwner string) (*appmesh.VirtualNodeData, error) {
+	input := &appmesh.DescribeVirtualNodeInput{
+		MeshName:        aws.String(meshName),
+		VirtualNodeName: aws.String(virtualNodeName),
+	}
+	if meshOwner != "" {
+		input.MeshOwner = aws.String(meshOwner)
+	}
+
+	output, err := conn.DescribeVirtualNode(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output.VirtualNode, nil
+}
+
+// FindVirtualRouter returns the virtual router corresponding to the specified mesh name, virtual router name and optional mesh owner.
+// Returns an error if no virtual router is found.
+func FindVirtualRouter(conn *appmesh.AppMesh, meshName, virtualRouterName, meshOwner string) (*appmesh.VirtualRouterData, error) {
+	input := &appmesh.DescribeVirtualRouterInput{
+		MeshName:          aws.String(meshName),
+		VirtualRouterName: aws.String(virtualRouterName),
+	}
+	if meshOwner != "" {
+		input.MeshOwner = aws.String(meshOwner)
+	}
+
+	output, err := conn.DescribeVirtualRouter(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output.VirtualRouter, nil
+}
+
+// FindVirtualService returns the virtual service corresponding to the specified mesh name, virtual service name and optional mesh owner.
+// Returns an error if no virtual service is found.
+func FindVirtualService(conn *appmesh.AppMesh, meshName, virtualServiceName, meshOwner string) (*appmesh.VirtualServiceData, error) {
+	input := &appmesh.DescribeVirtualServiceInput{
+		MeshName:           aws.String(meshName),
+		VirtualServiceName: aws.String(virtualServiceName),
+	}
+	if meshOwner != "" {
+		input.MeshOwner = aws.String(meshOwner)
+	}
+
+	output, err := conn.DescribeVirtualService(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output.VirtualService, nil
+}
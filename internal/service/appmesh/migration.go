@@ -0,0 +1,341 @@
+package appmesh
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appmesh"
+)
+
+// App Mesh is being retired. The helpers in this file walk the virtual services of a mesh
+// and describe, independently of any target service, how traffic for each virtual service
+// reaches its virtual nodes. The aws_appmesh_service_connect_configuration and
+// aws_appmesh_vpc_lattice_configuration data sources translate that description into
+// ECS Service Connect and VPC Lattice equivalents.
+
+type meshServiceMapping struct {
+	virtualServiceName string
+	port               int64
+	protocol           string
+	targets            []*meshServiceTarget
+	notes              []string
+}
+
+type meshServiceTarget struct {
+	virtualNodeName   string
+	port              int64
+	protocol          string
+	weight            int64
+	cloudMapNamespace string
+	cloudMapService   string
+}
+
+// primaryTarget returns the target receiving the largest share of traffic.
+func (m *meshServiceMapping) primaryTarget() *meshServiceTarget {
+	var primary *meshServiceTarget
+
+	for _, target := range m.targets {
+		if primary == nil || target.weight > primary.weight {
+			primary = target
+		}
+	}
+
+	return primary
+}
+
+func (m *meshServiceMapping) addNote(format string, a ...interface{}) {
+	note := fmt.Sprintf(format, a...)
+
+	for _, v := range m.notes {
+		if v == note {
+			return
+		}
+	}
+
+	m.notes = append(m.notes, note)
+}
+
+func findMeshServiceMappings(conn *appmesh.AppMesh, meshName, meshOwner string) ([]*meshServiceMapping, error) {
+	input := &appmesh.ListVirtualServicesInput{
+		MeshName: aws.String(meshName),
+	}
+	if meshOwner != "" {
+		input.MeshOwner = aws.String(meshOwner)
+	}
+
+	var virtualServiceNames []string
+
+	err := conn.ListVirtualServicesPages(input, func(page *appmesh.ListVirtualServicesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.VirtualServices {
+			if v != nil {
+				virtualServiceNames = append(virtualServiceNames, aws.StringValue(v.VirtualServiceName))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("error listing App Mesh Virtual Services (%s): %w", meshName, err)
+	}
+
+	sort.Strings(virtualServiceNames)
+
+	virtualNodes := map[string]*appmesh.VirtualNodeData{}
+	var mappings []*meshServiceMapping
+
+	for _, virtualServiceName := range virtualServiceNames {
+		virtualService, err := FindVirtualService(conn, meshName, virtualServiceName, meshOwner)
+
+		if err != nil {
+			return nil, fmt.Errorf("error reading App Mesh Virtual Service (%s): %w", virtualServiceName, err)
+		}
+
+		mapping := &meshServiceMapping{
+			virtualServiceName: virtualServiceName,
+		}
+
+		var provider *appmesh.VirtualServiceProvider
+		if virtualService != nil && virtualService.Spec != nil {
+			provider = virtualService.Spec.Provider
+		}
+
+		switch {
+		case provider != nil && provider.VirtualNode != nil:
+			virtualNodeName := aws.StringValue(provider.VirtualNode.VirtualNodeName)
+			target, err := newMeshServiceTarget(conn, virtualNodes, mapping, meshName, meshOwner, virtualNodeName, 100, 0)
+
+			if err != nil {
+				return nil, err
+			}
+
+			mapping.port = target.port
+			mapping.protocol = target.protocol
+			mapping.targets = append(mapping.targets, target)
+
+		case provider != nil && provider.VirtualRouter != nil:
+			virtualRouterName := aws.StringValue(provider.VirtualRouter.VirtualRouterName)
+
+			if err := expandMeshServiceMappingFromRouter(conn, virtualNodes, mapping, meshName, meshOwner, virtualRouterName); err != nil {
+				return nil, err
+			}
+
+		default:
+			mapping.addNote("virtual service %s has no provider and cannot be migrated", virtualServiceName)
+		}
+
+		mappings = append(mappings, mapping)
+	}
+
+	return mappings, nil
+}
+
+func expandMeshServiceMappingFromRouter(conn *appmesh.AppMesh, virtualNodes map[string]*appmesh.VirtualNodeData, mapping *meshServiceMapping, meshName, meshOwner, virtualRouterName string) error {
+	virtualRouter, err := FindVirtualRouter(conn, meshName, virtualRouterName, meshOwner)
+
+	if err != nil {
+		return fmt.Errorf("error reading App Mesh Virtual Router (%s): %w", virtualRouterName, err)
+	}
+
+	if virtualRouter != nil && virtualRouter.Spec != nil && len(virtualRouter.Spec.Listeners) > 0 {
+		if v := virtualRouter.Spec.Listeners[0].PortMapping; v != nil {
+			mapping.port = aws.Int64Value(v.Port)
+			mapping.protocol = aws.StringValue(v.Protocol)
+		}
+
+		if len(virtualRouter.Spec.Listeners) > 1 {
+			mapping.addNote("virtual router %s has %d listeners; only the first listener was translated", virtualRouterName, len(virtualRouter.Spec.Listeners))
+		}
+	}
+
+	input := &appmesh.ListRoutesInput{
+		MeshName:          aws.String(meshName),
+		VirtualRouterName: aws.String(virtualRouterName),
+	}
+	if meshOwner != "" {
+		input.MeshOwner = aws.String(meshOwner)
+	}
+
+	var routeNames []string
+
+	err = conn.ListRoutesPages(input, func(page *appmesh.ListRoutesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Routes {
+			if v != nil {
+				routeNames = append(routeNames, aws.StringValue(v.RouteName))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error listing App Mesh Routes (%s): %w", virtualRouterName, err)
+	}
+
+	var routes []*appmesh.RouteData
+
+	for _, routeName := range routeNames {
+		route, err := FindRoute(conn, meshName, virtualRouterName, routeName, meshOwner)
+
+		if err != nil {
+			return fmt.Errorf("error reading App Mesh Route (%s): %w", routeName, err)
+		}
+
+		if route != nil && route.Spec != nil {
+			routes = append(routes, route)
+		}
+	}
+
+	if len(routes) == 0 {
+		mapping.addNote("virtual router %s has no routes and cannot be migrated", virtualRouterName)
+
+		return nil
+	}
+
+	// Routes without a priority are evaluated after those with one.
+	sort.SliceStable(routes, func(i, j int) bool {
+		pi, pj := routes[i].Spec.Priority, routes[j].Spec.Priority
+
+		if pi == nil || pj == nil {
+			return pj == nil && pi != nil
+		}
+
+		return aws.Int64Value(pi) < aws.Int64Value(pj)
+	})
+
+	route := routes[0]
+
+	if len(routes) > 1 {
+		mapping.addNote("virtual router %s has %d routes; only the weighted targets of route %s were translated", virtualRouterName, len(routes), aws.StringValue(route.RouteName))
+	}
+
+	var weightedTargets []*appmesh.WeightedTarget
+
+	switch spec := route.Spec; {
+	case spec.GrpcRoute != nil && spec.GrpcRoute.Action != nil:
+		weightedTargets = spec.GrpcRoute.Action.WeightedTargets
+	case spec.Http2Route != nil && spec.Http2Route.Action != nil:
+		weightedTargets = spec.Http2Route.Action.WeightedTargets
+	case spec.HttpRoute != nil && spec.HttpRoute.Action != nil:
+		weightedTargets = spec.HttpRoute.Action.WeightedTargets
+	case spec.TcpRoute != nil && spec.TcpRoute.Action != nil:
+		weightedTargets = spec.TcpRoute.Action.WeightedTargets
+	}
+
+	for _, weightedTarget := range weightedTargets {
+		if weightedTarget == nil {
+			continue
+		}
+
+		target, err := newMeshServiceTarget(conn, virtualNodes, mapping, meshName, meshOwner, aws.StringValue(weightedTarget.VirtualNode), aws.Int64Value(weightedTarget.Weight), aws.Int64Value(weightedTarget.Port))
+
+		if err != nil {
+			return err
+		}
+
+		mapping.targets = append(mapping.targets, target)
+	}
+
+	return nil
+}
+
+func newMeshServiceTarget(conn *appmesh.AppMesh, virtualNodes map[string]*appmesh.VirtualNodeData, mapping *meshServiceMapping, meshName, meshOwner, virtualNodeName string, weight, port int64) (*meshServiceTarget, error) {
+	virtualNode, ok := virtualNodes[virtualNodeName]
+
+	if !ok {
+		var err error
+		virtualNode, err = FindVirtualNode(conn, meshName, virtualNodeName, meshOwner)
+
+		if err != nil {
+			return nil, fmt.Errorf("error reading App Mesh Virtual Node (%s): %w", virtualNodeName, err)
+		}
+
+		virtualNodes[virtualNodeName] = virtualNode
+	}
+
+	target := &meshServiceTarget{
+		virtualNodeName: virtualNodeName,
+		weight:          weight,
+	}
+
+	if virtualNode == nil || virtualNode.Spec == nil {
+		return target, nil
+	}
+
+	spec := virtualNode.Spec
+
+	if v := spec.ServiceDiscovery; v != nil && v.AwsCloudMap != nil {
+		target.cloudMapNamespace = aws.StringValue(v.AwsCloudMap.NamespaceName)
+		target.cloudMapService = aws.StringValue(v.AwsCloudMap.ServiceName)
+	}
+
+	var listener *appmesh.Listener
+
+	for _, v := range spec.Listeners {
+		if v == nil || v.PortMapping == nil {
+			continue
+		}
+
+		if port == 0 || aws.Int64Value(v.PortMapping.Port) == port {
+			listener = v
+			break
+		}
+	}
+
+	if listener == nil {
+		mapping.addNote("virtual node %s has no matching listener", virtualNodeName)
+
+		return target, nil
+	}
+
+	target.port = aws.Int64Value(listener.PortMapping.Port)
+	target.protocol = aws.StringValue(listener.PortMapping.Protocol)
+
+	if len(spec.Listeners) > 1 {
+		mapping.addNote("virtual node %s has %d listeners; only port %d was translated", virtualNodeName, len(spec.Listeners), target.port)
+	}
+
+	if listener.Tls != nil {
+		mapping.addNote("virtual node %s terminates TLS on its listener; configure TLS on the replacement separately", virtualNodeName)
+	}
+
+	if listener.OutlierDetection != nil {
+		mapping.addNote("virtual node %s uses outlier detection, which is not translated", virtualNodeName)
+	}
+
+	if listener.ConnectionPool != nil {
+		mapping.addNote("virtual node %s uses a connection pool, which is not translated", virtualNodeName)
+	}
+
+	if v := spec.BackendDefaults; v != nil && v.ClientPolicy != nil && v.ClientPolicy.Tls != nil {
+		mapping.addNote("virtual node %s enforces client TLS for its backends, which is not translated", virtualNodeName)
+	}
+
+	return target, nil
+}
+
+var invalidMigrationNameCharacters = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// migrationName converts an App Mesh name into a lowercase, hyphen-separated name
+// of at most maxLength characters.
+func migrationName(name string, maxLength int) string {
+	name = invalidMigrationNameCharacters.ReplaceAllString(strings.ToLower(name), "-")
+	name = strings.Trim(name, "-")
+
+	if len(name) > maxLength {
+		name = strings.TrimRight(name[:maxLength], "-")
+	}
+
+	return name
+}
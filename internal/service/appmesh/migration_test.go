@@ -0,0 +1,173 @@
+package appmesh
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMigrationName(t *testing.T) {
+	cases := []struct {
+		Name      string
+		MaxLength int
+		Expected  string
+	}{
+		{
+			Name:      "servicea.simpleapp.local",
+			MaxLength: 40,
+			Expected:  "servicea-simpleapp-local",
+		},
+		{
+			Name:      "Service_B",
+			MaxLength: 40,
+			Expected:  "service-b",
+		},
+		{
+			Name:      "-leading.and.trailing.",
+			MaxLength: 40,
+			Expected:  "leading-and-trailing",
+		},
+		{
+			Name:      "a-very-long-virtual-service-name.mesh.local",
+			MaxLength: 32,
+			Expected:  "a-very-long-virtual-service-name",
+		},
+		{
+			Name:      "truncated-at.hyphen",
+			MaxLength: 13,
+			Expected:  "truncated-at",
+		},
+	}
+
+	for _, tc := range cases {
+		if got := migrationName(tc.Name, tc.MaxLength); got != tc.Expected {
+			t.Errorf("migrationName(%q, %d) = %q, expected %q", tc.Name, tc.MaxLength, got, tc.Expected)
+		}
+	}
+}
+
+func TestFlattenServiceConnectServices(t *testing.T) {
+	mappings := []*meshServiceMapping{
+		{
+			virtualServiceName: "servicea.simpleapp.local",
+			port:               8080,
+			protocol:           "http",
+			targets: []*meshServiceTarget{
+				{
+					virtualNodeName:   "serviceBv1",
+					port:              8080,
+					protocol:          "http",
+					weight:            90,
+					cloudMapNamespace: "simpleapp.local",
+					cloudMapService:   "serviceb",
+				},
+				{
+					virtualNodeName: "serviceBv2",
+					port:            8080,
+					protocol:        "http",
+					weight:          10,
+				},
+			},
+		},
+		{
+			virtualServiceName: "servicec.simpleapp.local",
+			notes:              []string{"virtual service servicec.simpleapp.local has no provider and cannot be migrated"},
+		},
+	}
+
+	expected := []interface{}{
+		map[string]interface{}{
+			"app_protocol": "http",
+			"client_alias": []interface{}{
+				map[string]interface{}{
+					"dns_name": "servicea.simpleapp.local",
+					"port":     8080,
+				},
+			},
+			"discovery_name":       "serviceb",
+			"notes":                []string{"ECS Service Connect does not split traffic by weight; all traffic is sent to virtual node serviceBv1"},
+			"port_name":            "http-8080",
+			"virtual_node_name":    "serviceBv1",
+			"virtual_service_name": "servicea.simpleapp.local",
+		},
+		map[string]interface{}{
+			"notes":                []string{"virtual service servicec.simpleapp.local has no provider and cannot be migrated"},
+			"virtual_service_name": "servicec.simpleapp.local",
+		},
+	}
+
+	if got := flattenServiceConnectServices(mappings); !reflect.DeepEqual(got, expected) {
+		t.Errorf("flattenServiceConnectServices() = %#v, expected %#v", got, expected)
+	}
+}
+
+func TestFlattenVPCLatticeServices(t *testing.T) {
+	mappings := []*meshServiceMapping{
+		{
+			virtualServiceName: "servicea.simpleapp.local",
+			port:               8080,
+			protocol:           "grpc",
+			targets: []*meshServiceTarget{
+				{
+					virtualNodeName: "serviceBv1",
+					port:            8080,
+					protocol:        "grpc",
+					weight:          90,
+				},
+				{
+					virtualNodeName: "serviceBv2",
+					port:            8081,
+					protocol:        "grpc",
+					weight:          10,
+				},
+			},
+		},
+		{
+			virtualServiceName: "db.simpleapp.local",
+			port:               5432,
+			protocol:           "tcp",
+		},
+	}
+
+	expected := []interface{}{
+		map[string]interface{}{
+			"custom_domain_name": "servicea.simpleapp.local",
+			"listener": []interface{}{
+				map[string]interface{}{
+					"port":     8080,
+					"protocol": "HTTP",
+				},
+			},
+			"name":  "servicea-simpleapp-local",
+			"notes": []string(nil),
+			"target_group": []interface{}{
+				map[string]interface{}{
+					"name":              "servicebv1",
+					"port":              8080,
+					"protocol":          "HTTP",
+					"protocol_version":  "GRPC",
+					"virtual_node_name": "serviceBv1",
+					"weight":            90,
+				},
+				map[string]interface{}{
+					"name":              "servicebv2",
+					"port":              8081,
+					"protocol":          "HTTP",
+					"protocol_version":  "GRPC",
+					"virtual_node_name": "serviceBv2",
+					"weight":            10,
+				},
+			},
+			"virtual_service_name": "servicea.simpleapp.local",
+		},
+		map[string]interface{}{
+			"custom_domain_name":   "db.simpleapp.local",
+			"name":                 "db-simpleapp-local",
+			"notes":                []string{"VPC Lattice services do not support plain TCP listeners; no listener or target groups were translated"},
+			"virtual_service_name": "db.simpleapp.local",
+		},
+	}
+
+	if got := flattenVPCLatticeServices(mappings); !reflect.DeepEqual(got, expected) {
+		t.Errorf("flattenVPCLatticeServices() = %#v, expected %#v", got, expected)
+	}
+}
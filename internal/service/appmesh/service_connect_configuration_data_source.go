@@ -0,0 +1,184 @@
+package appmesh
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/service/appmesh"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceServiceConnectConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceServiceConnectConfigurationRead,
+
+		Schema: map[string]*schema.Schema{
+			"mesh_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"mesh_owner": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"namespace": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"service": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"app_protocol": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"client_alias": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"dns_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+
+									"port": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+
+						"discovery_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"notes": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+
+						"port_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"virtual_node_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"virtual_service_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceServiceConnectConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AppMeshConn
+
+	meshName := d.Get("mesh_name").(string)
+	mappings, err := findMeshServiceMappings(conn, meshName, d.Get("mesh_owner").(string))
+
+	if err != nil {
+		return err
+	}
+
+	namespace := d.Get("namespace").(string)
+
+	// Default to the Cloud Map namespace the mesh's virtual nodes already register in.
+	if namespace == "" {
+		for _, mapping := range mappings {
+			if target := mapping.primaryTarget(); target != nil && target.cloudMapNamespace != "" {
+				namespace = target.cloudMapNamespace
+				break
+			}
+		}
+	}
+
+	if namespace == "" {
+		namespace = meshName
+	}
+
+	d.SetId(meshName)
+	d.Set("mesh_name", meshName)
+	d.Set("namespace", namespace)
+
+	if err := d.Set("service", flattenServiceConnectServices(mappings)); err != nil {
+		return fmt.Errorf("error setting service: %w", err)
+	}
+
+	return nil
+}
+
+func flattenServiceConnectServices(mappings []*meshServiceMapping) []interface{} {
+	tfList := []interface{}{}
+
+	for _, mapping := range mappings {
+		tfMap := map[string]interface{}{
+			"virtual_service_name": mapping.virtualServiceName,
+		}
+
+		notes := mapping.notes
+		target := mapping.primaryTarget()
+
+		if target != nil {
+			port := target.port
+			if mapping.port != 0 {
+				port = mapping.port
+			}
+
+			discoveryName := target.cloudMapService
+			if discoveryName == "" {
+				discoveryName = mapping.virtualServiceName
+			}
+
+			tfMap["app_protocol"] = serviceConnectAppProtocol(target.protocol)
+			tfMap["client_alias"] = []interface{}{
+				map[string]interface{}{
+					"dns_name": mapping.virtualServiceName,
+					"port":     int(port),
+				},
+			}
+			tfMap["discovery_name"] = migrationName(discoveryName, 64)
+			tfMap["port_name"] = migrationName(fmt.Sprintf("%s-%d", target.protocol, target.port), 64)
+			tfMap["virtual_node_name"] = target.virtualNodeName
+
+			if len(mapping.targets) > 1 {
+				notes = append(notes, fmt.Sprintf("ECS Service Connect does not split traffic by weight; all traffic is sent to virtual node %s", target.virtualNodeName))
+			}
+		}
+
+		tfMap["notes"] = notes
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+// serviceConnectAppProtocol returns the ECS port mapping application protocol for an App Mesh port protocol.
+// TCP listeners have no application protocol.
+func serviceConnectAppProtocol(protocol string) string {
+	switch protocol {
+	case appmesh.PortProtocolGrpc, appmesh.PortProtocolHttp, appmesh.PortProtocolHttp2:
+		return protocol
+	}
+
+	return ""
+}
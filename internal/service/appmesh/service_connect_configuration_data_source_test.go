@@ -0,0 +1,153 @@
+package appmesh_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/appmesh"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccAppMeshServiceConnectConfigurationDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_appmesh_service_connect_configuration.test"
+	vsName := fmt.Sprintf("tf-acc-test-%d.mesh.local", sdkacctest.RandInt())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appmesh.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, appmesh.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVirtualServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMigrationDataSourceConfig_base(rName, vsName) + `
+data "aws_appmesh_service_connect_configuration" "test" {
+  mesh_name = aws_appmesh_virtual_service.test.mesh_name
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "namespace", rName),
+					resource.TestCheckResourceAttr(dataSourceName, "service.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "service.0.app_protocol", "http"),
+					resource.TestCheckResourceAttr(dataSourceName, "service.0.client_alias.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "service.0.client_alias.0.dns_name", vsName),
+					resource.TestCheckResourceAttr(dataSourceName, "service.0.client_alias.0.port", "8080"),
+					resource.TestCheckResourceAttr(dataSourceName, "service.0.notes.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "service.0.port_name", "http-8080"),
+					resource.TestCheckResourceAttr(dataSourceName, "service.0.virtual_node_name", fmt.Sprintf("%s-v1", rName)),
+					resource.TestCheckResourceAttr(dataSourceName, "service.0.virtual_service_name", vsName),
+				),
+			},
+		},
+	})
+}
+
+func testAccMigrationDataSourceConfig_base(rName, vsName string) string {
+	return fmt.Sprintf(`
+resource "aws_appmesh_mesh" "test" {
+  name = %[1]q
+}
+
+resource "aws_appmesh_virtual_node" "test" {
+  count = 2
+
+  name      = "%[1]s-v${count.index + 1}"
+  mesh_name = aws_appmesh_mesh.test.id
+
+  spec {
+    listener {
+      port_mapping {
+        port     = 8080
+        protocol = "http"
+      }
+    }
+
+    service_discovery {
+      dns {
+        hostname = "v${count.index + 1}.%[2]s"
+      }
+    }
+  }
+}
+
+resource "aws_appmesh_virtual_router" "test" {
+  name      = %[1]q
+  mesh_name = aws_appmesh_mesh.test.id
+
+  spec {
+    listener {
+      port_mapping {
+        port     = 8080
+        protocol = "http"
+      }
+    }
+  }
+}
+
+resource "aws_appmesh_route" "test" {
+  name                = %[1]q
+  mesh_name           = aws_appmesh_mesh.test.id
+  virtual_router_name = aws_appmesh_virtual_router.test.name
+
+  spec {
+    http_route {
+      match {
+        prefix = "/"
+      }
+
+      action {
+        weighted_target {
+          virtual_node = aws_appmesh_virtual_node.test[0].name
+          weight       = 90
+        }
+
+        weighted_target {
+          virtual_node = aws_appmesh_virtual_node.test[1].name
+          weight       = 10
+        }
+      }
+    }
+  }
+}
+
+resource "aws_appmesh_route" "test2" {
+  name                = "%[1]s-2"
+  mesh_name           = aws_appmesh_mesh.test.id
+  virtual_router_name = aws_appmesh_virtual_router.test.name
+
+  spec {
+    priority = 10
+
+    http_route {
+      match {
+        prefix = "/v1"
+      }
+
+      action {
+        weighted_target {
+          virtual_node = aws_appmesh_virtual_node.test[0].name
+          weight       = 100
+        }
+      }
+    }
+  }
+}
+
+resource "aws_appmesh_virtual_service" "test" {
+  name      = %[2]q
+  mesh_name = aws_appmesh_mesh.test.id
+
+  spec {
+    provider {
+      virtual_router {
+        virtual_router_name = aws_appmesh_virtual_router.test.name
+      }
+    }
+  }
+
+  depends_on = [aws_appmesh_route.test, aws_appmesh_route.test2]
+}
+`, rName, vsName)
+}
@@ -0,0 +1,190 @@
+package appmesh
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/service/appmesh"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceVPCLatticeConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceVPCLatticeConfigurationRead,
+
+		Schema: map[string]*schema.Schema{
+			"mesh_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"mesh_owner": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"service": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"custom_domain_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"listener": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"port": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+
+									"protocol": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"notes": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+
+						"target_group": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+
+									"port": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+
+									"protocol": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+
+									"protocol_version": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+
+									"virtual_node_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+
+									"weight": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+
+						"virtual_service_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceVPCLatticeConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AppMeshConn
+
+	meshName := d.Get("mesh_name").(string)
+	mappings, err := findMeshServiceMappings(conn, meshName, d.Get("mesh_owner").(string))
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(meshName)
+	d.Set("mesh_name", meshName)
+
+	if err := d.Set("service", flattenVPCLatticeServices(mappings)); err != nil {
+		return fmt.Errorf("error setting service: %w", err)
+	}
+
+	return nil
+}
+
+func flattenVPCLatticeServices(mappings []*meshServiceMapping) []interface{} {
+	tfList := []interface{}{}
+
+	for _, mapping := range mappings {
+		tfMap := map[string]interface{}{
+			"custom_domain_name":   mapping.virtualServiceName,
+			"name":                 migrationName(mapping.virtualServiceName, 40),
+			"virtual_service_name": mapping.virtualServiceName,
+		}
+
+		notes := mapping.notes
+
+		if mapping.protocol == appmesh.PortProtocolTcp {
+			notes = append(notes, "VPC Lattice services do not support plain TCP listeners; no listener or target groups were translated")
+		} else if mapping.port != 0 {
+			tfMap["listener"] = []interface{}{
+				map[string]interface{}{
+					"port":     int(mapping.port),
+					"protocol": "HTTP",
+				},
+			}
+
+			var targetGroups []interface{}
+
+			for _, target := range mapping.targets {
+				targetGroups = append(targetGroups, map[string]interface{}{
+					"name":              migrationName(target.virtualNodeName, 128),
+					"port":              int(target.port),
+					"protocol":          "HTTP",
+					"protocol_version":  vpcLatticeProtocolVersion(target.protocol),
+					"virtual_node_name": target.virtualNodeName,
+					"weight":            int(target.weight),
+				})
+			}
+
+			tfMap["target_group"] = targetGroups
+		}
+
+		tfMap["notes"] = notes
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+// vpcLatticeProtocolVersion returns the VPC Lattice target group protocol version for an App Mesh port protocol.
+func vpcLatticeProtocolVersion(protocol string) string {
+	switch protocol {
+	case appmesh.PortProtocolGrpc:
+		return "GRPC"
+	case appmesh.PortProtocolHttp2:
+		return "HTTP2"
+	}
+
+	return "HTTP1"
+}
@@ -0,0 +1,47 @@
+package appmesh_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/appmesh"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccAppMeshVPCLatticeConfigurationDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_appmesh_vpc_lattice_configuration.test"
+	vsName := fmt.Sprintf("tf-acc-test-%d.mesh.local", sdkacctest.RandInt())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appmesh.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, appmesh.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVirtualServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMigrationDataSourceConfig_base(rName, vsName) + `
+data "aws_appmesh_vpc_lattice_configuration" "test" {
+  mesh_name = aws_appmesh_virtual_service.test.mesh_name
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "service.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "service.0.custom_domain_name", vsName),
+					resource.TestCheckResourceAttr(dataSourceName, "service.0.listener.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "service.0.listener.0.port", "8080"),
+					resource.TestCheckResourceAttr(dataSourceName, "service.0.listener.0.protocol", "HTTP"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "service.0.name"),
+					resource.TestCheckResourceAttr(dataSourceName, "service.0.notes.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "service.0.target_group.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "service.0.target_group.0.protocol_version", "HTTP1"),
+					resource.TestCheckResourceAttr(dataSourceName, "service.0.target_group.0.virtual_node_name", fmt.Sprintf("%s-v1", rName)),
+					resource.TestCheckResourceAttr(dataSourceName, "service.0.target_group.0.weight", "100"),
+					resource.TestCheckResourceAttr(dataSourceName, "service.0.virtual_service_name", vsName),
+				),
+			},
+		},
+	})
+}
@@ -5,3 +5,8 @@ import "time"
 const (
 	propagationTimeout = 2 * time.Minute
 )
+
+const (
+	// AutoScalingConfigurationNameDefault is the name of the AutoScaling configuration App Runner creates in each account.
+	AutoScalingConfigurationNameDefault = "DefaultConfiguration"
+)
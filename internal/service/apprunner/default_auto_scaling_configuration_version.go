@@ -0,0 +1,111 @@
+package apprunner
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apprunner"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceDefaultAutoScalingConfigurationVersion() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDefaultAutoScalingConfigurationVersionPut,
+		ReadWithoutTimeout:   resourceDefaultAutoScalingConfigurationVersionRead,
+		UpdateWithoutTimeout: resourceDefaultAutoScalingConfigurationVersionPut,
+		DeleteWithoutTimeout: resourceDefaultAutoScalingConfigurationVersionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"auto_scaling_configuration_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func resourceDefaultAutoScalingConfigurationVersionPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AppRunnerConn
+
+	arn := d.Get("auto_scaling_configuration_arn").(string)
+	input := &apprunner.UpdateDefaultAutoScalingConfigurationInput{
+		AutoScalingConfigurationArn: aws.String(arn),
+	}
+
+	_, err := conn.UpdateDefaultAutoScalingConfigurationWithContext(ctx, input)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error setting App Runner default AutoScaling Configuration Version (%s): %w", arn, err))
+	}
+
+	if d.IsNewResource() {
+		d.SetId(meta.(*conns.AWSClient).Region)
+	}
+
+	return resourceDefaultAutoScalingConfigurationVersionRead(ctx, d, meta)
+}
+
+func resourceDefaultAutoScalingConfigurationVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AppRunnerConn
+
+	summary, err := FindDefaultAutoScalingConfigurationSummary(ctx, conn)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] App Runner default AutoScaling Configuration Version (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading App Runner default AutoScaling Configuration Version (%s): %w", d.Id(), err))
+	}
+
+	d.Set("auto_scaling_configuration_arn", summary.AutoScalingConfigurationArn)
+
+	return nil
+}
+
+func resourceDefaultAutoScalingConfigurationVersionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AppRunnerConn
+
+	// The default AutoScaling configuration can't be removed, only changed, so
+	// revert to the configuration App Runner provides for each account.
+	summary, err := FindLatestAutoScalingConfigurationSummaryByName(ctx, conn, AutoScalingConfigurationNameDefault)
+
+	if tfresource.NotFound(err) {
+		log.Printf("[WARN] App Runner AutoScaling Configuration (%s) not found, removing default AutoScaling Configuration Version (%s) from state", AutoScalingConfigurationNameDefault, d.Id())
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading App Runner AutoScaling Configuration (%s): %w", AutoScalingConfigurationNameDefault, err))
+	}
+
+	if aws.BoolValue(summary.IsDefault) {
+		return nil
+	}
+
+	arn := aws.StringValue(summary.AutoScalingConfigurationArn)
+	input := &apprunner.UpdateDefaultAutoScalingConfigurationInput{
+		AutoScalingConfigurationArn: aws.String(arn),
+	}
+
+	_, err = conn.UpdateDefaultAutoScalingConfigurationWithContext(ctx, input)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error resetting App Runner default AutoScaling Configuration Version to (%s): %w", arn, err))
+	}
+
+	return nil
+}
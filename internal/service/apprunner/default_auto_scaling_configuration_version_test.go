@@ -0,0 +1,116 @@
+package apprunner_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apprunner"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfapprunner "github.com/hashicorp/terraform-provider-aws/internal/service/apprunner"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// Serialized as the default AutoScaling configuration is an account-level setting.
+func TestAccAppRunnerDefaultAutoScalingConfigurationVersion_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_apprunner_default_auto_scaling_configuration_version.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, apprunner.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDefaultAutoScalingConfigurationVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDefaultAutoScalingConfigurationVersionConfig_basic(rName, "test1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDefaultAutoScalingConfigurationVersionIs(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "auto_scaling_configuration_arn", "aws_apprunner_auto_scaling_configuration_version.test1", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDefaultAutoScalingConfigurationVersionConfig_basic(rName, "test2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDefaultAutoScalingConfigurationVersionIs(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "auto_scaling_configuration_arn", "aws_apprunner_auto_scaling_configuration_version.test2", "arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDefaultAutoScalingConfigurationVersionDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).AppRunnerConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_apprunner_default_auto_scaling_configuration_version" {
+			continue
+		}
+
+		summary, err := tfapprunner.FindDefaultAutoScalingConfigurationSummary(context.Background(), conn)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if name := aws.StringValue(summary.AutoScalingConfigurationName); name != tfapprunner.AutoScalingConfigurationNameDefault {
+			return fmt.Errorf("App Runner default AutoScaling Configuration Version is %s, expected %s", name, tfapprunner.AutoScalingConfigurationNameDefault)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckDefaultAutoScalingConfigurationVersionIs(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppRunnerConn
+
+		summary, err := tfapprunner.FindDefaultAutoScalingConfigurationSummary(context.Background(), conn)
+
+		if err != nil {
+			return err
+		}
+
+		if got, want := aws.StringValue(summary.AutoScalingConfigurationArn), rs.Primary.Attributes["auto_scaling_configuration_arn"]; got != want {
+			return fmt.Errorf("App Runner default AutoScaling Configuration Version is %s, expected %s", got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccDefaultAutoScalingConfigurationVersionConfig_basic(rName, defaultName string) string {
+	return fmt.Sprintf(`
+resource "aws_apprunner_auto_scaling_configuration_version" "test1" {
+  auto_scaling_configuration_name = "%[1]s-1"
+}
+
+resource "aws_apprunner_auto_scaling_configuration_version" "test2" {
+  auto_scaling_configuration_name = "%[1]s-2"
+}
+
+resource "aws_apprunner_default_auto_scaling_configuration_version" "test" {
+  auto_scaling_configuration_arn = aws_apprunner_auto_scaling_configuration_version.%[2]s.arn
+}
+`, rName, defaultName)
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/apprunner"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
 func FindConnectionSummaryByName(ctx context.Context, conn *apprunner.AppRunner, name string) (*apprunner.ConnectionSummary, error) {
@@ -80,3 +81,57 @@ func FindCustomDomain(ctx context.Context, conn *apprunner.AppRunner, domainName
 
 	return customDomain, nil
 }
+
+func FindDefaultAutoScalingConfigurationSummary(ctx context.Context, conn *apprunner.AppRunner) (*apprunner.AutoScalingConfigurationSummary, error) {
+	input := &apprunner.ListAutoScalingConfigurationsInput{}
+
+	return findAutoScalingConfigurationSummary(ctx, conn, input, func(v *apprunner.AutoScalingConfigurationSummary) bool {
+		return aws.BoolValue(v.IsDefault)
+	})
+}
+
+func FindLatestAutoScalingConfigurationSummaryByName(ctx context.Context, conn *apprunner.AppRunner, name string) (*apprunner.AutoScalingConfigurationSummary, error) {
+	input := &apprunner.ListAutoScalingConfigurationsInput{
+		AutoScalingConfigurationName: aws.String(name),
+		LatestOnly:                   aws.Bool(true),
+	}
+
+	return findAutoScalingConfigurationSummary(ctx, conn, input, func(v *apprunner.AutoScalingConfigurationSummary) bool {
+		return aws.StringValue(v.AutoScalingConfigurationName) == name
+	})
+}
+
+func findAutoScalingConfigurationSummary(ctx context.Context, conn *apprunner.AppRunner, input *apprunner.ListAutoScalingConfigurationsInput, filter func(*apprunner.AutoScalingConfigurationSummary) bool) (*apprunner.AutoScalingConfigurationSummary, error) {
+	var summary *apprunner.AutoScalingConfigurationSummary
+
+	err := conn.ListAutoScalingConfigurationsPagesWithContext(ctx, input, func(page *apprunner.ListAutoScalingConfigurationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.AutoScalingConfigurationSummaryList {
+			if v == nil {
+				continue
+			}
+
+			if filter(v) {
+				summary = v
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if summary == nil {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return summary, nil
+}
@@ -178,6 +178,21 @@ func ResourceService() *schema.Resource {
 								},
 							},
 						},
+						"ingress_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"is_publicly_accessible": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Computed: true,
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -329,6 +344,12 @@ func ResourceService() *schema.Resource {
 											},
 										},
 									},
+									"source_directory": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.StringLenBetween(1, 4096),
+									},
 								},
 							},
 							ExactlyOneOf: []string{"source_configuration.0.code_repository", "source_configuration.0.image_repository"},
@@ -755,6 +776,10 @@ func expandNetworkConfiguration(l []interface{}) *apprunner.NetworkConfiguration
 		result.EgressConfiguration = expandNetworkEgressConfiguration(v)
 	}
 
+	if v, ok := tfMap["ingress_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		result.IngressConfiguration = expandNetworkIngressConfiguration(v)
+	}
+
 	return result
 }
 
@@ -862,6 +887,26 @@ func expandNetworkEgressConfiguration(l []interface{}) *apprunner.EgressConfigur
 	return result
 }
 
+func expandNetworkIngressConfiguration(l []interface{}) *apprunner.IngressConfiguration {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	tfMap, ok := l[0].(map[string]interface{})
+
+	if !ok {
+		return nil
+	}
+
+	result := &apprunner.IngressConfiguration{}
+
+	if v, ok := tfMap["is_publicly_accessible"].(bool); ok {
+		result.IsPubliclyAccessible = aws.Bool(v)
+	}
+
+	return result
+}
+
 func expandServiceImageConfiguration(l []interface{}) *apprunner.ImageConfiguration {
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -915,6 +960,10 @@ func expandServiceCodeRepository(l []interface{}) *apprunner.CodeRepository {
 		result.RepositoryUrl = aws.String(v)
 	}
 
+	if v, ok := tfMap["source_directory"].(string); ok && v != "" {
+		result.SourceDirectory = aws.String(v)
+	}
+
 	return result
 }
 
@@ -1079,7 +1128,8 @@ func flattenNetworkConfiguration(config *apprunner.NetworkConfiguration) []inter
 	}
 
 	m := map[string]interface{}{
-		"egress_configuration": flattenNetworkEgressConfiguration(config.EgressConfiguration),
+		"egress_configuration":  flattenNetworkEgressConfiguration(config.EgressConfiguration),
+		"ingress_configuration": flattenNetworkIngressConfiguration(config.IngressConfiguration),
 	}
 
 	return []interface{}{m}
@@ -1098,6 +1148,18 @@ func flattenNetworkEgressConfiguration(config *apprunner.EgressConfiguration) []
 	return []interface{}{m}
 }
 
+func flattenNetworkIngressConfiguration(config *apprunner.IngressConfiguration) []interface{} {
+	if config == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"is_publicly_accessible": aws.BoolValue(config.IsPubliclyAccessible),
+	}
+
+	return []interface{}{m}
+}
+
 func flattenServiceObservabilityConfiguration(config *apprunner.ServiceObservabilityConfiguration) []interface{} {
 	if config == nil {
 		return []interface{}{}
@@ -1120,6 +1182,7 @@ func flattenServiceCodeRepository(r *apprunner.CodeRepository) []interface{} {
 		"code_configuration":  flattenServiceCodeConfiguration(r.CodeConfiguration),
 		"repository_url":      aws.StringValue(r.RepositoryUrl),
 		"source_code_version": flattenServiceSourceCodeVersion(r.SourceCodeVersion),
+		"source_directory":    aws.StringValue(r.SourceDirectory),
 	}
 
 	return []interface{}{m}
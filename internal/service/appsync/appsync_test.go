@@ -20,6 +20,7 @@ func TestAccAppSync_serial(t *testing.T) {
 			"HTTP_endpoint":                 testAccDataSource_HTTP_endpoint,
 			"type":                          testAccDataSource_type,
 			"Type_dynamoDB":                 testAccDataSource_Type_dynamoDB,
+			"Type_eventBridge":              testAccDataSource_Type_eventBridge,
 			"Type_http":                     testAccDataSource_Type_http,
 			"Type_http_auth":                testAccDataSource_Type_httpAuth,
 			"Type_lambda":                   testAccDataSource_Type_lambda,
@@ -58,9 +59,12 @@ func TestAccAppSync_serial(t *testing.T) {
 			"AdditionalAuthentication_awsLambda":        testAccGraphQLAPI_AdditionalAuthentication_lambda,
 			"AdditionalAuthentication_multiple":         testAccGraphQLAPI_AdditionalAuthentication_multiple,
 			"xrayEnabled":                               testAccGraphQLAPI_xrayEnabled,
+			"merged":                                    testAccGraphQLAPI_merged,
 		},
 		"Function": {
 			"basic":                   testAccFunction_basic,
+			"code":                    testAccFunction_code,
+			"codeInvalid":             testAccFunction_codeInvalid,
 			"disappears":              testAccFunction_disappears,
 			"description":             testAccFunction_description,
 			"responseMappingTemplate": testAccFunction_responseMappingTemplate,
@@ -76,6 +80,7 @@ func TestAccAppSync_serial(t *testing.T) {
 			"multipleResolvers": testAccResolver_multipleResolvers,
 			"pipeline":          testAccResolver_pipeline,
 			"caching":           testAccResolver_caching,
+			"code":              testAccResolver_code,
 			"sync":              testAccResolver_syncConfig,
 		},
 		"ApiCache": {
@@ -91,6 +96,11 @@ func TestAccAppSync_serial(t *testing.T) {
 			"basic":      testAccDomainNameAPIAssociation_basic,
 			"disappears": testAccDomainNameAPIAssociation_disappears,
 		},
+		"SourceAPIAssociation": {
+			"basic":      testAccSourceAPIAssociation_basic,
+			"disappears": testAccSourceAPIAssociation_disappears,
+			"mergeType":  testAccSourceAPIAssociation_mergeType,
+		},
 	}
 
 	for group, m := range testCases {
@@ -0,0 +1,123 @@
+package appsync
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appsync"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// codeEvaluationErrorRegexp matches the runtime errors that evaluating code against any context would raise.
+var codeEvaluationErrorRegexp = regexp.MustCompile(`\b(ReferenceError|SyntaxError)\b`)
+
+// evaluateCodeContext is the minimal resolver context used when checking code.
+const evaluateCodeContext = `{"arguments":{},"source":{},"stash":{}}`
+
+func codeSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		RequiredWith: []string{"runtime"},
+	}
+}
+
+func runtimeSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(appsync.RuntimeName_Values(), false),
+				},
+				"runtime_version": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+// evaluateCode checks code with the AppSync EvaluateCode API so that syntax
+// errors are reported before the resolver or function is created or updated.
+func evaluateCode(conn *appsync.AppSync, code string, runtime *appsync.AppSyncRuntime) error {
+	output, err := conn.EvaluateCode(&appsync.EvaluateCodeInput{
+		Code:     aws.String(code),
+		Context:  aws.String(evaluateCodeContext),
+		Function: aws.String("request"),
+		Runtime:  runtime,
+	})
+
+	if err != nil {
+		return fmt.Errorf("error evaluating AppSync code: %w", err)
+	}
+
+	if output.Error == nil {
+		return nil
+	}
+
+	// Code errors (e.g. parser or lint errors) are always reported. Of the runtime
+	// errors, only those that indicate broken code are: the code is evaluated against
+	// an empty context, so type errors from reading its properties are expected.
+	if len(output.Error.CodeErrors) == 0 {
+		if msg := aws.StringValue(output.Error.Message); codeEvaluationErrorRegexp.MatchString(msg) {
+			return fmt.Errorf("invalid AppSync code: %s", msg)
+		}
+
+		return nil
+	}
+
+	var errs []string
+
+	for _, v := range output.Error.CodeErrors {
+		if v == nil {
+			continue
+		}
+
+		msg := fmt.Sprintf("%s: %s", aws.StringValue(v.ErrorType), aws.StringValue(v.Value))
+
+		if l := v.Location; l != nil {
+			msg = fmt.Sprintf("%s (line %d, column %d)", msg, aws.Int64Value(l.Line), aws.Int64Value(l.Column))
+		}
+
+		errs = append(errs, msg)
+	}
+
+	return fmt.Errorf("invalid AppSync code:\n%s", strings.Join(errs, "\n"))
+}
+
+func expandRuntime(l []interface{}) *appsync.AppSyncRuntime {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	configured := l[0].(map[string]interface{})
+
+	result := &appsync.AppSyncRuntime{
+		Name:           aws.String(configured["name"].(string)),
+		RuntimeVersion: aws.String(configured["runtime_version"].(string)),
+	}
+
+	return result
+}
+
+func flattenRuntime(config *appsync.AppSyncRuntime) []map[string]interface{} {
+	if config == nil {
+		return nil
+	}
+
+	result := map[string]interface{}{
+		"name":            aws.StringValue(config.Name),
+		"runtime_version": aws.StringValue(config.RuntimeVersion),
+	}
+
+	return []map[string]interface{}{result}
+}
@@ -93,7 +93,7 @@ func ResourceDataSource() *schema.Resource {
 						},
 					},
 				},
-				ConflictsWith: []string{"elasticsearch_config", "http_config", "lambda_config", "relational_database_config"},
+				ConflictsWith: []string{"elasticsearch_config", "event_bridge_config", "http_config", "lambda_config", "relational_database_config"},
 			},
 			"elasticsearch_config": {
 				Type:     schema.TypeList,
@@ -112,7 +112,22 @@ func ResourceDataSource() *schema.Resource {
 						},
 					},
 				},
-				ConflictsWith: []string{"dynamodb_config", "http_config", "lambda_config"},
+				ConflictsWith: []string{"dynamodb_config", "event_bridge_config", "http_config", "lambda_config"},
+			},
+			"event_bridge_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"event_bus_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+				ConflictsWith: []string{"dynamodb_config", "elasticsearch_config", "http_config", "lambda_config", "relational_database_config"},
 			},
 			"http_config": {
 				Type:     schema.TypeList,
@@ -158,7 +173,7 @@ func ResourceDataSource() *schema.Resource {
 						},
 					},
 				},
-				ConflictsWith: []string{"dynamodb_config", "elasticsearch_config", "lambda_config", "relational_database_config"},
+				ConflictsWith: []string{"dynamodb_config", "elasticsearch_config", "event_bridge_config", "lambda_config", "relational_database_config"},
 			},
 			"lambda_config": {
 				Type:     schema.TypeList,
@@ -173,7 +188,7 @@ func ResourceDataSource() *schema.Resource {
 						},
 					},
 				},
-				ConflictsWith: []string{"dynamodb_config", "elasticsearch_config", "http_config", "relational_database_config"},
+				ConflictsWith: []string{"dynamodb_config", "elasticsearch_config", "event_bridge_config", "http_config", "relational_database_config"},
 			},
 			"relational_database_config": {
 				Type:     schema.TypeList,
@@ -220,7 +235,7 @@ func ResourceDataSource() *schema.Resource {
 						},
 					},
 				},
-				ConflictsWith: []string{"dynamodb_config", "elasticsearch_config", "http_config", "lambda_config"},
+				ConflictsWith: []string{"dynamodb_config", "elasticsearch_config", "event_bridge_config", "http_config", "lambda_config"},
 			},
 			"service_role_arn": {
 				Type:         schema.TypeString,
@@ -257,6 +272,10 @@ func resourceDataSourceCreate(d *schema.ResourceData, meta interface{}) error {
 		input.ElasticsearchConfig = expandElasticsearchDataSourceConfig(v.([]interface{}), region)
 	}
 
+	if v, ok := d.GetOk("event_bridge_config"); ok {
+		input.EventBridgeConfig = expandEventBridgeDataSourceConfig(v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk("http_config"); ok {
 		input.HttpConfig = expandHTTPDataSourceConfig(v.([]interface{}))
 	}
@@ -321,6 +340,10 @@ func resourceDataSourceRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error setting elasticsearch_config: %w", err)
 	}
 
+	if err := d.Set("event_bridge_config", flattenEventBridgeDataSourceConfig(dataSource.EventBridgeConfig)); err != nil {
+		return fmt.Errorf("error setting event_bridge_config: %w", err)
+	}
+
 	if err := d.Set("http_config", flattenHTTPDataSourceConfig(dataSource.HttpConfig)); err != nil {
 		return fmt.Errorf("error setting http_config: %w", err)
 	}
@@ -368,6 +391,10 @@ func resourceDataSourceUpdate(d *schema.ResourceData, meta interface{}) error {
 		input.ElasticsearchConfig = expandElasticsearchDataSourceConfig(v.([]interface{}), region)
 	}
 
+	if v, ok := d.GetOk("event_bridge_config"); ok {
+		input.EventBridgeConfig = expandEventBridgeDataSourceConfig(v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk("http_config"); ok {
 		input.HttpConfig = expandHTTPDataSourceConfig(v.([]interface{}))
 	}
@@ -558,6 +585,32 @@ func flattenElasticsearchDataSourceConfig(config *appsync.ElasticsearchDataSourc
 	return []map[string]interface{}{result}
 }
 
+func expandEventBridgeDataSourceConfig(l []interface{}) *appsync.EventBridgeDataSourceConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	configured := l[0].(map[string]interface{})
+
+	result := &appsync.EventBridgeDataSourceConfig{
+		EventBusArn: aws.String(configured["event_bus_arn"].(string)),
+	}
+
+	return result
+}
+
+func flattenEventBridgeDataSourceConfig(config *appsync.EventBridgeDataSourceConfig) []map[string]interface{} {
+	if config == nil {
+		return nil
+	}
+
+	result := map[string]interface{}{
+		"event_bus_arn": aws.StringValue(config.EventBusArn),
+	}
+
+	return []map[string]interface{}{result}
+}
+
 func expandHTTPDataSourceConfig(l []interface{}) *appsync.HttpDataSourceConfig {
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -32,6 +32,7 @@ func ResourceFunction() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"code": codeSchema(),
 			"data_source": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -48,12 +49,13 @@ func ResourceFunction() *schema.Resource {
 			},
 			"request_mapping_template": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
 			},
 			"response_mapping_template": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
 			},
+			"runtime": runtimeSchema(),
 			"description": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -117,21 +119,32 @@ func resourceFunctionCreate(d *schema.ResourceData, meta interface{}) error {
 	apiID := d.Get("api_id").(string)
 
 	input := &appsync.CreateFunctionInput{
-		ApiId:                  aws.String(apiID),
-		DataSourceName:         aws.String(d.Get("data_source").(string)),
-		FunctionVersion:        aws.String(d.Get("function_version").(string)),
-		Name:                   aws.String(d.Get("name").(string)),
-		RequestMappingTemplate: aws.String(d.Get("request_mapping_template").(string)),
+		ApiId:           aws.String(apiID),
+		DataSourceName:  aws.String(d.Get("data_source").(string)),
+		FunctionVersion: aws.String(d.Get("function_version").(string)),
+		Name:            aws.String(d.Get("name").(string)),
 	}
 
 	if v, ok := d.GetOk("description"); ok {
 		input.Description = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("request_mapping_template"); ok {
+		input.RequestMappingTemplate = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("response_mapping_template"); ok {
 		input.ResponseMappingTemplate = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("code"); ok {
+		input.Code = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("runtime"); ok {
+		input.Runtime = expandRuntime(v.([]interface{}))
+	}
+
 	if v, ok := d.GetOkExists("max_batch_size"); ok {
 		input.MaxBatchSize = aws.Int64(int64(v.(int)))
 	}
@@ -140,6 +153,12 @@ func resourceFunctionCreate(d *schema.ResourceData, meta interface{}) error {
 		input.SyncConfig = expandSyncConfig(v.([]interface{}))
 	}
 
+	if input.Code != nil {
+		if err := evaluateCode(conn, aws.StringValue(input.Code), input.Runtime); err != nil {
+			return err
+		}
+	}
+
 	resp, err := conn.CreateFunction(input)
 	if err != nil {
 		return fmt.Errorf("Error creating AppSync Function: %w", err)
@@ -184,6 +203,11 @@ func resourceFunctionRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("request_mapping_template", function.RequestMappingTemplate)
 	d.Set("response_mapping_template", function.ResponseMappingTemplate)
 	d.Set("max_batch_size", function.MaxBatchSize)
+	d.Set("code", function.Code)
+
+	if err := d.Set("runtime", flattenRuntime(function.Runtime)); err != nil {
+		return fmt.Errorf("error setting runtime: %w", err)
+	}
 
 	if err := d.Set("sync_config", flattenSyncConfig(function.SyncConfig)); err != nil {
 		return fmt.Errorf("error setting sync_config: %w", err)
@@ -201,22 +225,33 @@ func resourceFunctionUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	input := &appsync.UpdateFunctionInput{
-		ApiId:                  aws.String(apiID),
-		DataSourceName:         aws.String(d.Get("data_source").(string)),
-		FunctionId:             aws.String(functionID),
-		FunctionVersion:        aws.String(d.Get("function_version").(string)),
-		Name:                   aws.String(d.Get("name").(string)),
-		RequestMappingTemplate: aws.String(d.Get("request_mapping_template").(string)),
+		ApiId:           aws.String(apiID),
+		DataSourceName:  aws.String(d.Get("data_source").(string)),
+		FunctionId:      aws.String(functionID),
+		FunctionVersion: aws.String(d.Get("function_version").(string)),
+		Name:            aws.String(d.Get("name").(string)),
 	}
 
 	if v, ok := d.GetOk("description"); ok {
 		input.Description = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("request_mapping_template"); ok {
+		input.RequestMappingTemplate = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("response_mapping_template"); ok {
 		input.ResponseMappingTemplate = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("code"); ok {
+		input.Code = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("runtime"); ok {
+		input.Runtime = expandRuntime(v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk("max_batch_size"); ok {
 		input.MaxBatchSize = aws.Int64(int64(v.(int)))
 	}
@@ -225,6 +260,12 @@ func resourceFunctionUpdate(d *schema.ResourceData, meta interface{}) error {
 		input.SyncConfig = expandSyncConfig(v.([]interface{}))
 	}
 
+	if input.Code != nil && d.HasChanges("code", "runtime") {
+		if err := evaluateCode(conn, aws.StringValue(input.Code), input.Runtime); err != nil {
+			return err
+		}
+	}
+
 	_, err = conn.UpdateFunction(input)
 	if err != nil {
 		return fmt.Errorf("Error updating AppSync Function %s: %w", d.Id(), err)
@@ -87,6 +87,60 @@ func testAccFunction_syncConfig(t *testing.T) {
 	})
 }
 
+func testAccFunction_code(t *testing.T) {
+	rName := fmt.Sprintf("tfacctest%d", sdkacctest.RandInt())
+	resourceName := "aws_appsync_function.test"
+	var config appsync.FunctionConfiguration
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appsync.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, appsync.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionConfig_code(rName, "ctx.args"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(resourceName, &config),
+					resource.TestMatchResourceAttr(resourceName, "code", regexp.MustCompile(`payload: ctx.args`)),
+					resource.TestCheckResourceAttr(resourceName, "runtime.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "runtime.0.name", "APPSYNC_JS"),
+					resource.TestCheckResourceAttr(resourceName, "runtime.0.runtime_version", "1.0.0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFunctionConfig_code(rName, "ctx.source"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(resourceName, &config),
+					resource.TestMatchResourceAttr(resourceName, "code", regexp.MustCompile(`payload: ctx.source`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccFunction_codeInvalid(t *testing.T) {
+	rName := fmt.Sprintf("tfacctest%d", sdkacctest.RandInt())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appsync.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, appsync.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFunctionConfig_code(rName, "ctx.args;;{"),
+				ExpectError: regexp.MustCompile(`invalid AppSync code`),
+			},
+		},
+	})
+}
+
 func testAccFunction_description(t *testing.T) {
 	rName1 := fmt.Sprintf("tfacctest%d", sdkacctest.RandInt())
 	rName2 := fmt.Sprintf("tfexample%s", sdkacctest.RandString(8))
@@ -319,6 +373,46 @@ EOF
 `, rName, region)
 }
 
+func testAccFunctionConfig_codeBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_appsync_graphql_api" "test" {
+  authentication_type = "API_KEY"
+  name                = %[1]q
+}
+
+resource "aws_appsync_datasource" "test" {
+  api_id = aws_appsync_graphql_api.test.id
+  name   = %[1]q
+  type   = "NONE"
+}
+`, rName)
+}
+
+func testAccFunctionConfig_code(rName, payload string) string {
+	return testAccFunctionConfig_codeBase(rName) + fmt.Sprintf(`
+resource "aws_appsync_function" "test" {
+  api_id      = aws_appsync_graphql_api.test.id
+  data_source = aws_appsync_datasource.test.name
+  name        = %[1]q
+
+  code = <<EOF
+export function request(ctx) {
+  return { payload: %[2]s };
+}
+
+export function response(ctx) {
+  return ctx.result;
+}
+EOF
+
+  runtime {
+    name            = "APPSYNC_JS"
+    runtime_version = "1.0.0"
+  }
+}
+`, rName, payload)
+}
+
 func testAccFunctionConfig_description(r1, r2, region, description string) string {
 	return fmt.Sprintf(`
 %[1]s
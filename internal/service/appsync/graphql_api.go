@@ -1,6 +1,7 @@
 package appsync
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -8,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/appsync"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -115,11 +117,23 @@ func ResourceGraphQLAPI() *schema.Resource {
 					},
 				},
 			},
+			"api_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      appsync.GraphQLApiTypeGraphql,
+				ValidateFunc: validation.StringInSlice(appsync.GraphQLApiType_Values(), false),
+			},
 			"authentication_type": {
 				Type:         schema.TypeString,
 				Required:     true,
 				ValidateFunc: validation.StringInSlice(appsync.AuthenticationType_Values(), false),
 			},
+			"merged_api_execution_role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
 			"schema": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -257,8 +271,25 @@ func ResourceGraphQLAPI() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			resourceGraphQLAPICustomizeDiff,
+		),
+	}
+}
+
+func resourceGraphQLAPICustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	apiType := diff.Get("api_type").(string)
+
+	if apiType == appsync.GraphQLApiTypeMerged && diff.Get("merged_api_execution_role_arn").(string) == "" {
+		return fmt.Errorf("merged_api_execution_role_arn must be set when api_type is %s", appsync.GraphQLApiTypeMerged)
+	}
+
+	if apiType == appsync.GraphQLApiTypeMerged && diff.Get("schema").(string) != "" {
+		return fmt.Errorf("schema cannot be set when api_type is %s, the schema is merged from the associated source APIs", appsync.GraphQLApiTypeMerged)
 	}
+
+	return nil
 }
 
 func resourceGraphQLAPICreate(d *schema.ResourceData, meta interface{}) error {
@@ -267,10 +298,15 @@ func resourceGraphQLAPICreate(d *schema.ResourceData, meta interface{}) error {
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
 	input := &appsync.CreateGraphqlApiInput{
+		ApiType:            aws.String(d.Get("api_type").(string)),
 		AuthenticationType: aws.String(d.Get("authentication_type").(string)),
 		Name:               aws.String(d.Get("name").(string)),
 	}
 
+	if v, ok := d.GetOk("merged_api_execution_role_arn"); ok {
+		input.MergedApiExecutionRoleArn = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("log_config"); ok {
 		input.LogConfig = expandGraphQLAPILogConfig(v.([]interface{}))
 	}
@@ -335,7 +371,13 @@ func resourceGraphQLAPIRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.Set("arn", resp.GraphqlApi.Arn)
+	if v := resp.GraphqlApi.ApiType; v != nil {
+		d.Set("api_type", v)
+	} else {
+		d.Set("api_type", appsync.GraphQLApiTypeGraphql)
+	}
 	d.Set("authentication_type", resp.GraphqlApi.AuthenticationType)
+	d.Set("merged_api_execution_role_arn", resp.GraphqlApi.MergedApiExecutionRoleArn)
 	d.Set("name", resp.GraphqlApi.Name)
 
 	if err := d.Set("log_config", flattenGraphQLAPILogConfig(resp.GraphqlApi.LogConfig)); err != nil {
@@ -397,6 +439,10 @@ func resourceGraphQLAPIUpdate(d *schema.ResourceData, meta interface{}) error {
 		Name:               aws.String(d.Get("name").(string)),
 	}
 
+	if v, ok := d.GetOk("merged_api_execution_role_arn"); ok {
+		input.MergedApiExecutionRoleArn = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("log_config"); ok {
 		input.LogConfig = expandGraphQLAPILogConfig(v.([]interface{}))
 	}
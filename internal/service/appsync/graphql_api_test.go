@@ -1140,6 +1140,34 @@ func testAccGraphQLAPI_xrayEnabled(t *testing.T) {
 	})
 }
 
+func testAccGraphQLAPI_merged(t *testing.T) {
+	var api1 appsync.GraphqlApi
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appsync_graphql_api.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appsync.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, appsync.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGraphQLAPIDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGraphQLAPIConfig_merged(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGraphQLAPIExists(resourceName, &api1),
+					resource.TestCheckResourceAttr(resourceName, "api_type", "MERGED"),
+					resource.TestCheckResourceAttrPair(resourceName, "merged_api_execution_role_arn", "aws_iam_role.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckGraphQLAPIDestroy(s *terraform.State) error {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).AppSyncConn
 	for _, rs := range s.RootModule().Resources {
@@ -1638,3 +1666,31 @@ resource "aws_appsync_graphql_api" "test" {
 }
 `, rName, xrayEnabled)
 }
+
+func testAccGraphQLAPIConfig_merged(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "appsync.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_appsync_graphql_api" "test" {
+  api_type                      = "MERGED"
+  authentication_type           = "API_KEY"
+  merged_api_execution_role_arn = aws_iam_role.test.arn
+  name                          = %[1]q
+}
+`, rName)
+}
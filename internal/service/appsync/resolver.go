@@ -44,6 +44,7 @@ func ResourceResolver() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"code": codeSchema(),
 			"data_source": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -85,6 +86,7 @@ func ResourceResolver() *schema.Resource {
 					},
 				},
 			},
+			"runtime": runtimeSchema(),
 			"caching_config": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -187,6 +189,20 @@ func resourceResolverCreate(d *schema.ResourceData, meta interface{}) error {
 		input.CachingConfig = expandResolverCachingConfig(v.([]interface{}))
 	}
 
+	if v, ok := d.GetOk("code"); ok {
+		input.Code = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("runtime"); ok {
+		input.Runtime = expandRuntime(v.([]interface{}))
+	}
+
+	if input.Code != nil {
+		if err := evaluateCode(conn, aws.StringValue(input.Code), input.Runtime); err != nil {
+			return err
+		}
+	}
+
 	mutexKey := fmt.Sprintf("appsync-schema-%s", d.Get("api_id").(string))
 	conns.GlobalMutexKV.Lock(mutexKey)
 	defer conns.GlobalMutexKV.Unlock(mutexKey)
@@ -241,6 +257,11 @@ func resourceResolverRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("response_template", resolver.ResponseMappingTemplate)
 	d.Set("kind", resolver.Kind)
 	d.Set("max_batch_size", resolver.MaxBatchSize)
+	d.Set("code", resolver.Code)
+
+	if err := d.Set("runtime", flattenRuntime(resolver.Runtime)); err != nil {
+		return fmt.Errorf("error setting runtime: %w", err)
+	}
 
 	if err := d.Set("sync_config", flattenSyncConfig(resolver.SyncConfig)); err != nil {
 		return fmt.Errorf("error setting sync_config: %w", err)
@@ -290,6 +311,14 @@ func resourceResolverUpdate(d *schema.ResourceData, meta interface{}) error {
 		input.CachingConfig = expandResolverCachingConfig(v.([]interface{}))
 	}
 
+	if v, ok := d.GetOk("code"); ok {
+		input.Code = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("runtime"); ok {
+		input.Runtime = expandRuntime(v.([]interface{}))
+	}
+
 	if v, ok := d.GetOkExists("max_batch_size"); ok {
 		input.MaxBatchSize = aws.Int64(int64(v.(int)))
 	}
@@ -298,6 +327,12 @@ func resourceResolverUpdate(d *schema.ResourceData, meta interface{}) error {
 		input.SyncConfig = expandSyncConfig(v.([]interface{}))
 	}
 
+	if input.Code != nil && d.HasChanges("code", "runtime") {
+		if err := evaluateCode(conn, aws.StringValue(input.Code), input.Runtime); err != nil {
+			return err
+		}
+	}
+
 	mutexKey := fmt.Sprintf("appsync-schema-%s", d.Get("api_id").(string))
 	conns.GlobalMutexKV.Lock(mutexKey)
 	defer conns.GlobalMutexKV.Unlock(mutexKey)
@@ -317,6 +317,38 @@ func testAccResolver_caching(t *testing.T) {
 	})
 }
 
+func testAccResolver_code(t *testing.T) {
+	var resolver appsync.Resolver
+	rName := fmt.Sprintf("tfacctest%d", sdkacctest.RandInt())
+	resourceName := "aws_appsync_resolver.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appsync.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, appsync.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResolverDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResolverConfig_code(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResolverExists(resourceName, &resolver),
+					resource.TestCheckResourceAttrSet(resourceName, "code"),
+					resource.TestCheckResourceAttr(resourceName, "kind", "PIPELINE"),
+					resource.TestCheckResourceAttr(resourceName, "pipeline_config.0.functions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "runtime.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "runtime.0.name", "APPSYNC_JS"),
+					resource.TestCheckResourceAttr(resourceName, "runtime.0.runtime_version", "1.0.0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckResolverDestroy(s *terraform.State) error {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).AppSyncConn
 	for _, rs := range s.RootModule().Resources {
@@ -775,6 +807,57 @@ EOF
 `
 }
 
+func testAccResolverConfig_code(rName string) string {
+	return testAccResolverConfig_base(rName) + fmt.Sprintf(`
+resource "aws_appsync_function" "test" {
+  api_id      = aws_appsync_graphql_api.test.id
+  data_source = aws_appsync_datasource.test.name
+  name        = %[1]q
+
+  code = <<EOF
+export function request(ctx) {
+  return { method: "GET", resourcePath: "/" };
+}
+
+export function response(ctx) {
+  return ctx.result.body;
+}
+EOF
+
+  runtime {
+    name            = "APPSYNC_JS"
+    runtime_version = "1.0.0"
+  }
+}
+
+resource "aws_appsync_resolver" "test" {
+  api_id = aws_appsync_graphql_api.test.id
+  field  = "singlePost"
+  type   = "Query"
+  kind   = "PIPELINE"
+
+  code = <<EOF
+export function request(ctx) {
+  return {};
+}
+
+export function response(ctx) {
+  return ctx.prev.result;
+}
+EOF
+
+  runtime {
+    name            = "APPSYNC_JS"
+    runtime_version = "1.0.0"
+  }
+
+  pipeline_config {
+    functions = [aws_appsync_function.test.function_id]
+  }
+}
+`, rName)
+}
+
 func testAccResolverConfig_sync(rName string) string {
 	return testAccDatasourceConfig_dynamoDBBase(rName) + fmt.Sprintf(`
 resource "aws_appsync_graphql_api" "test" {
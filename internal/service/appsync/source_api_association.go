@@ -0,0 +1,297 @@
+package appsync
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appsync"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceSourceAPIAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSourceAPIAssociationCreate,
+		Read:   resourceSourceAPIAssociationRead,
+		Update: resourceSourceAPIAssociationUpdate,
+		Delete: resourceSourceAPIAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"association_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"last_successful_merge_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"merged_api_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"merged_api_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"source_api_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"source_api_association_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"merge_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      appsync.MergeTypeManualMerge,
+							ValidateFunc: validation.StringInSlice(appsync.MergeType_Values(), false),
+						},
+					},
+				},
+			},
+			"source_api_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSourceAPIAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AppSyncConn
+
+	mergedAPIID := d.Get("merged_api_id").(string)
+	input := &appsync.AssociateSourceGraphqlApiInput{
+		MergedApiIdentifier: aws.String(mergedAPIID),
+		SourceApiIdentifier: aws.String(d.Get("source_api_id").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("source_api_association_config"); ok {
+		input.SourceApiAssociationConfig = expandSourceAPIAssociationConfig(v.([]interface{}))
+	}
+
+	output, err := conn.AssociateSourceGraphqlApi(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating AppSync Source API Association (%s): %w", mergedAPIID, err)
+	}
+
+	d.SetId(SourceAPIAssociationCreateResourceID(mergedAPIID, aws.StringValue(output.SourceApiAssociation.AssociationId)))
+
+	if err := startSourceAPIAssociationMerge(conn, output.SourceApiAssociation, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error merging AppSync Source API Association (%s): %w", d.Id(), err)
+	}
+
+	return resourceSourceAPIAssociationRead(d, meta)
+}
+
+func resourceSourceAPIAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AppSyncConn
+
+	mergedAPIID, associationID, err := SourceAPIAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	association, err := FindSourceAPIAssociationByTwoPartKey(conn, mergedAPIID, associationID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] AppSync Source API Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading AppSync Source API Association (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", association.AssociationArn)
+	d.Set("association_id", association.AssociationId)
+	d.Set("description", association.Description)
+	if association.LastSuccessfulMergeDate != nil {
+		d.Set("last_successful_merge_date", aws.TimeValue(association.LastSuccessfulMergeDate).Format(time.RFC3339))
+	} else {
+		d.Set("last_successful_merge_date", nil)
+	}
+	d.Set("merged_api_arn", association.MergedApiArn)
+	d.Set("merged_api_id", association.MergedApiId)
+	d.Set("source_api_arn", association.SourceApiArn)
+	if err := d.Set("source_api_association_config", flattenSourceAPIAssociationConfig(association.SourceApiAssociationConfig)); err != nil {
+		return fmt.Errorf("error setting source_api_association_config: %w", err)
+	}
+	d.Set("source_api_id", association.SourceApiId)
+	d.Set("status", association.SourceApiAssociationStatus)
+
+	return nil
+}
+
+func resourceSourceAPIAssociationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AppSyncConn
+
+	mergedAPIID, associationID, err := SourceAPIAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &appsync.UpdateSourceApiAssociationInput{
+		AssociationId:       aws.String(associationID),
+		Description:         aws.String(d.Get("description").(string)),
+		MergedApiIdentifier: aws.String(mergedAPIID),
+	}
+
+	if v, ok := d.GetOk("source_api_association_config"); ok {
+		input.SourceApiAssociationConfig = expandSourceAPIAssociationConfig(v.([]interface{}))
+	}
+
+	output, err := conn.UpdateSourceApiAssociation(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating AppSync Source API Association (%s): %w", d.Id(), err)
+	}
+
+	if err := startSourceAPIAssociationMerge(conn, output.SourceApiAssociation, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return fmt.Errorf("error merging AppSync Source API Association (%s): %w", d.Id(), err)
+	}
+
+	return resourceSourceAPIAssociationRead(d, meta)
+}
+
+func resourceSourceAPIAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AppSyncConn
+
+	mergedAPIID, associationID, err := SourceAPIAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleting AppSync Source API Association: %s", d.Id())
+	_, err = conn.DisassociateSourceGraphqlApi(&appsync.DisassociateSourceGraphqlApiInput{
+		AssociationId:       aws.String(associationID),
+		MergedApiIdentifier: aws.String(mergedAPIID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, appsync.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting AppSync Source API Association (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitSourceAPIAssociationDeleted(conn, mergedAPIID, associationID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for AppSync Source API Association (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// startSourceAPIAssociationMerge brings the merged API schema up to date after
+// the association is created or changed. Associations with the MANUAL_MERGE
+// merge type are not merged by the service, so the merge is started explicitly.
+func startSourceAPIAssociationMerge(conn *appsync.AppSync, association *appsync.SourceApiAssociation, timeout time.Duration) error {
+	mergedAPIID := aws.StringValue(association.MergedApiId)
+	associationID := aws.StringValue(association.AssociationId)
+
+	if v := association.SourceApiAssociationConfig; v == nil || aws.StringValue(v.MergeType) == appsync.MergeTypeManualMerge {
+		_, err := conn.StartSchemaMerge(&appsync.StartSchemaMergeInput{
+			AssociationId:       aws.String(associationID),
+			MergedApiIdentifier: aws.String(mergedAPIID),
+		})
+
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err := waitSourceAPIAssociationMerged(conn, mergedAPIID, associationID, timeout)
+
+	return err
+}
+
+const sourceAPIAssociationResourceIDSeparator = ","
+
+func SourceAPIAssociationCreateResourceID(mergedAPIID, associationID string) string {
+	parts := []string{mergedAPIID, associationID}
+	id := strings.Join(parts, sourceAPIAssociationResourceIDSeparator)
+
+	return id
+}
+
+func SourceAPIAssociationParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, sourceAPIAssociationResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected MERGED-API-ID%[2]sASSOCIATION-ID", id, sourceAPIAssociationResourceIDSeparator)
+}
+
+func expandSourceAPIAssociationConfig(l []interface{}) *appsync.SourceApiAssociationConfig {
+	if len(l) < 1 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	config := &appsync.SourceApiAssociationConfig{}
+
+	if v, ok := m["merge_type"].(string); ok && v != "" {
+		config.MergeType = aws.String(v)
+	}
+
+	return config
+}
+
+func flattenSourceAPIAssociationConfig(config *appsync.SourceApiAssociationConfig) []interface{} {
+	if config == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"merge_type": aws.StringValue(config.MergeType),
+	}
+
+	return []interface{}{m}
+}
@@ -1,10 +1,13 @@
 package appsync
 
 import (
+	"errors"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/appsync"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -65,3 +68,45 @@ func waitDomainNameAPIDisassociation(conn *appsync.AppSync, id string) error {
 
 	return err
 }
+
+func waitSourceAPIAssociationMerged(conn *appsync.AppSync, mergedAPIID, associationID string, timeout time.Duration) (*appsync.SourceApiAssociation, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{appsync.SourceApiAssociationStatusMergeScheduled, appsync.SourceApiAssociationStatusMergeInProgress},
+		Target:  []string{appsync.SourceApiAssociationStatusMergeSuccess},
+		Refresh: statusSourceAPIAssociation(conn, mergedAPIID, associationID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*appsync.SourceApiAssociation); ok {
+		if detail := aws.StringValue(output.SourceApiAssociationStatusDetail); detail != "" {
+			tfresource.SetLastError(err, errors.New(detail))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitSourceAPIAssociationDeleted(conn *appsync.AppSync, mergedAPIID, associationID string, timeout time.Duration) (*appsync.SourceApiAssociation, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{appsync.SourceApiAssociationStatusDeletionScheduled, appsync.SourceApiAssociationStatusDeletionInProgress},
+		Target:  []string{},
+		Refresh: statusSourceAPIAssociation(conn, mergedAPIID, associationID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*appsync.SourceApiAssociation); ok {
+		if detail := aws.StringValue(output.SourceApiAssociationStatusDetail); detail != "" {
+			tfresource.SetLastError(err, errors.New(detail))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,331 @@
+package athena
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/athena"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceCapacityReservation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCapacityReservationCreate,
+		ReadWithoutTimeout:   resourceCapacityReservationRead,
+		UpdateWithoutTimeout: resourceCapacityReservationUpdate,
+		DeleteWithoutTimeout: resourceCapacityReservationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"allocated_dpus": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"capacity_assignment": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"workgroup_names": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"creation_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 128),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9._-]+$`), "must contain only alphanumeric characters, periods, underscores, and hyphens"),
+				),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"target_dpus": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(24),
+			},
+		},
+	}
+}
+
+func resourceCapacityReservationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AthenaConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &athena.CreateCapacityReservationInput{
+		Name:       aws.String(name),
+		TargetDpus: aws.Int64(int64(d.Get("target_dpus").(int))),
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Athena Capacity Reservation: %s", input)
+	_, err := conn.CreateCapacityReservationWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Athena Capacity Reservation (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waitCapacityReservationActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for Athena Capacity Reservation (%s) create: %s", d.Id(), err)
+	}
+
+	if v, ok := d.GetOk("capacity_assignment"); ok && v.(*schema.Set).Len() > 0 {
+		if err := putCapacityAssignmentConfiguration(ctx, conn, d.Id(), v.(*schema.Set).List()); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceCapacityReservationRead(ctx, d, meta)
+}
+
+func resourceCapacityReservationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AthenaConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	reservation, err := FindCapacityReservationByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Athena Capacity Reservation (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Athena Capacity Reservation (%s): %s", d.Id(), err)
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Region:    meta.(*conns.AWSClient).Region,
+		Service:   "athena",
+		AccountID: meta.(*conns.AWSClient).AccountID,
+		Resource:  fmt.Sprintf("capacity-reservation/%s", d.Id()),
+	}.String()
+	d.Set("allocated_dpus", reservation.AllocatedDpus)
+	d.Set("arn", arn)
+	if reservation.CreationTime != nil {
+		d.Set("creation_time", aws.TimeValue(reservation.CreationTime).Format(time.RFC3339))
+	} else {
+		d.Set("creation_time", nil)
+	}
+	d.Set("name", reservation.Name)
+	d.Set("status", reservation.Status)
+	d.Set("target_dpus", reservation.TargetDpus)
+
+	assignmentConfiguration, err := FindCapacityAssignmentConfigurationByName(ctx, conn, d.Id())
+
+	if err != nil && !tfresource.NotFound(err) {
+		return diag.Errorf("reading Athena Capacity Reservation (%s) capacity assignment configuration: %s", d.Id(), err)
+	}
+
+	if assignmentConfiguration != nil {
+		if err := d.Set("capacity_assignment", flattenCapacityAssignments(assignmentConfiguration.CapacityAssignments)); err != nil {
+			return diag.Errorf("setting capacity_assignment: %s", err)
+		}
+	} else {
+		d.Set("capacity_assignment", nil)
+	}
+
+	tags, err := ListTagsWithContext(ctx, conn, arn)
+
+	if err != nil {
+		return diag.Errorf("listing tags for Athena Capacity Reservation (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceCapacityReservationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AthenaConn
+
+	if d.HasChange("target_dpus") {
+		input := &athena.UpdateCapacityReservationInput{
+			Name:       aws.String(d.Id()),
+			TargetDpus: aws.Int64(int64(d.Get("target_dpus").(int))),
+		}
+
+		log.Printf("[DEBUG] Updating Athena Capacity Reservation: %s", input)
+		_, err := conn.UpdateCapacityReservationWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating Athena Capacity Reservation (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitCapacityReservationActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("waiting for Athena Capacity Reservation (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("capacity_assignment") {
+		if err := putCapacityAssignmentConfiguration(ctx, conn, d.Id(), d.Get("capacity_assignment").(*schema.Set).List()); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating Athena Capacity Reservation (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceCapacityReservationRead(ctx, d, meta)
+}
+
+func resourceCapacityReservationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AthenaConn
+
+	// A capacity reservation must be cancelled before it can be deleted.
+	log.Printf("[DEBUG] Cancelling Athena Capacity Reservation: %s", d.Id())
+	_, err := conn.CancelCapacityReservationWithContext(ctx, &athena.CancelCapacityReservationInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrMessageContains(err, athena.ErrCodeInvalidRequestException, "not found") {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("cancelling Athena Capacity Reservation (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitCapacityReservationCancelled(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for Athena Capacity Reservation (%s) cancel: %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Deleting Athena Capacity Reservation: %s", d.Id())
+	_, err = conn.DeleteCapacityReservationWithContext(ctx, &athena.DeleteCapacityReservationInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrMessageContains(err, athena.ErrCodeInvalidRequestException, "not found") {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Athena Capacity Reservation (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func putCapacityAssignmentConfiguration(ctx context.Context, conn *athena.Athena, name string, tfList []interface{}) error {
+	input := &athena.PutCapacityAssignmentConfigurationInput{
+		CapacityAssignments:     expandCapacityAssignments(tfList),
+		CapacityReservationName: aws.String(name),
+	}
+
+	log.Printf("[DEBUG] Putting Athena Capacity Assignment Configuration: %s", input)
+	_, err := conn.PutCapacityAssignmentConfigurationWithContext(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("putting Athena Capacity Reservation (%s) capacity assignment configuration: %w", name, err)
+	}
+
+	return nil
+}
+
+func expandCapacityAssignments(tfList []interface{}) []*athena.CapacityAssignment {
+	apiObjects := []*athena.CapacityAssignment{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &athena.CapacityAssignment{}
+
+		if v, ok := tfMap["workgroup_names"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.WorkGroupNames = flex.ExpandStringSet(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenCapacityAssignments(apiObjects []*athena.CapacityAssignment) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"workgroup_names": aws.StringValueSlice(apiObject.WorkGroupNames),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,201 @@
+package athena_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/athena"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfathena "github.com/hashicorp/terraform-provider-aws/internal/service/athena"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccAthenaCapacityReservation_basic(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v athena.CapacityReservation
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_athena_capacity_reservation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, athena.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCapacityReservationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCapacityReservationConfig_basic(rName, 24),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCapacityReservationExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "allocated_dpus", "24"),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "athena", fmt.Sprintf("capacity-reservation/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "capacity_assignment.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_time"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "status", athena.CapacityReservationStatusActive),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "target_dpus", "24"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCapacityReservationConfig_basic(rName, 28),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCapacityReservationExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "target_dpus", "28"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAthenaCapacityReservation_disappears(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v athena.CapacityReservation
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_athena_capacity_reservation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, athena.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCapacityReservationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCapacityReservationConfig_basic(rName, 24),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCapacityReservationExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfathena.ResourceCapacityReservation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAthenaCapacityReservation_capacityAssignment(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v athena.CapacityReservation
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_athena_capacity_reservation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, athena.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCapacityReservationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCapacityReservationConfig_capacityAssignment(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCapacityReservationExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "capacity_assignment.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "capacity_assignment.*.workgroup_names.*", "aws_athena_workgroup.test", "name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCapacityReservationExists(n string, v *athena.CapacityReservation) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Athena Capacity Reservation ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AthenaConn
+
+		output, err := tfathena.FindCapacityReservationByName(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckCapacityReservationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).AthenaConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_athena_capacity_reservation" {
+			continue
+		}
+
+		_, err := tfathena.FindCapacityReservationByName(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Athena Capacity Reservation %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCapacityReservationConfig_basic(rName string, targetDPUs int) string {
+	return fmt.Sprintf(`
+resource "aws_athena_capacity_reservation" "test" {
+  name        = %[1]q
+  target_dpus = %[2]d
+}
+`, rName, targetDPUs)
+}
+
+func testAccCapacityReservationConfig_capacityAssignment(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_athena_workgroup" "test" {
+  name = %[1]q
+
+  configuration {
+    engine_version {
+      selected_engine_version = "Athena engine version 3"
+    }
+  }
+}
+
+resource "aws_athena_capacity_reservation" "test" {
+  name        = %[1]q
+  target_dpus = 24
+
+  capacity_assignment {
+    workgroup_names = [aws_athena_workgroup.test.name]
+  }
+}
+`, rName)
+}
@@ -0,0 +1,68 @@
+package athena
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/athena"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindCapacityReservationByName(ctx context.Context, conn *athena.Athena, name string) (*athena.CapacityReservation, error) {
+	input := &athena.GetCapacityReservationInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetCapacityReservationWithContext(ctx, input)
+
+	if tfawserr.ErrMessageContains(err, athena.ErrCodeInvalidRequestException, "not found") {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.CapacityReservation == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if status := aws.StringValue(output.CapacityReservation.Status); status == athena.CapacityReservationStatusCancelled {
+		return nil, &resource.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	return output.CapacityReservation, nil
+}
+
+func FindCapacityAssignmentConfigurationByName(ctx context.Context, conn *athena.Athena, name string) (*athena.CapacityAssignmentConfiguration, error) {
+	input := &athena.GetCapacityAssignmentConfigurationInput{
+		CapacityReservationName: aws.String(name),
+	}
+
+	output, err := conn.GetCapacityAssignmentConfigurationWithContext(ctx, input)
+
+	if tfawserr.ErrMessageContains(err, athena.ErrCodeInvalidRequestException, "not found") {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.CapacityAssignmentConfiguration == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.CapacityAssignmentConfiguration, nil
+}
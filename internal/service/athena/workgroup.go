@@ -69,11 +69,59 @@ func ResourceWorkGroup() *schema.Resource {
 								},
 							},
 						},
+						"execution_role": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"identity_center_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enable_identity_center": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+									},
+									"identity_center_instance_arn": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
 						"publish_cloudwatch_metrics_enabled": {
 							Type:     schema.TypeBool,
 							Optional: true,
 							Default:  true,
 						},
+						"query_results_s3_access_grants_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"authentication_type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(athena.AuthenticationType_Values(), false),
+									},
+									"create_user_level_prefix": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"enable_s3_access_grants": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+								},
+							},
+						},
 						"result_configuration": {
 							Type:     schema.TypeList,
 							Optional: true,
@@ -350,10 +398,22 @@ func expandWorkGroupConfiguration(l []interface{}) *athena.WorkGroupConfiguratio
 		configuration.EngineVersion = expandWorkGroupEngineVersion(v)
 	}
 
+	if v, ok := m["execution_role"].(string); ok && v != "" {
+		configuration.ExecutionRole = aws.String(v)
+	}
+
+	if v, ok := m["identity_center_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		configuration.IdentityCenterConfiguration = expandWorkGroupIdentityCenterConfiguration(v)
+	}
+
 	if v, ok := m["publish_cloudwatch_metrics_enabled"]; ok {
 		configuration.PublishCloudWatchMetricsEnabled = aws.Bool(v.(bool))
 	}
 
+	if v, ok := m["query_results_s3_access_grants_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		configuration.QueryResultsS3AccessGrantsConfiguration = expandWorkGroupQueryResultsS3AccessGrantsConfiguration(v)
+	}
+
 	if v, ok := m["result_configuration"]; ok {
 		configuration.ResultConfiguration = expandWorkGroupResultConfiguration(v.([]interface{}))
 	}
@@ -381,6 +441,50 @@ func expandWorkGroupEngineVersion(l []interface{}) *athena.EngineVersion {
 	return engineVersion
 }
 
+func expandWorkGroupIdentityCenterConfiguration(l []interface{}) *athena.IdentityCenterConfiguration {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	identityCenterConfiguration := &athena.IdentityCenterConfiguration{}
+
+	if v, ok := m["enable_identity_center"].(bool); ok {
+		identityCenterConfiguration.EnableIdentityCenter = aws.Bool(v)
+	}
+
+	if v, ok := m["identity_center_instance_arn"].(string); ok && v != "" {
+		identityCenterConfiguration.IdentityCenterInstanceArn = aws.String(v)
+	}
+
+	return identityCenterConfiguration
+}
+
+func expandWorkGroupQueryResultsS3AccessGrantsConfiguration(l []interface{}) *athena.QueryResultsS3AccessGrantsConfiguration {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	s3AccessGrantsConfiguration := &athena.QueryResultsS3AccessGrantsConfiguration{}
+
+	if v, ok := m["authentication_type"].(string); ok && v != "" {
+		s3AccessGrantsConfiguration.AuthenticationType = aws.String(v)
+	}
+
+	if v, ok := m["create_user_level_prefix"].(bool); ok {
+		s3AccessGrantsConfiguration.CreateUserLevelPrefix = aws.Bool(v)
+	}
+
+	if v, ok := m["enable_s3_access_grants"].(bool); ok {
+		s3AccessGrantsConfiguration.EnableS3AccessGrants = aws.Bool(v)
+	}
+
+	return s3AccessGrantsConfiguration
+}
+
 func expandWorkGroupConfigurationUpdates(l []interface{}) *athena.WorkGroupConfigurationUpdates {
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -404,10 +508,18 @@ func expandWorkGroupConfigurationUpdates(l []interface{}) *athena.WorkGroupConfi
 		configurationUpdates.EngineVersion = expandWorkGroupEngineVersion(v)
 	}
 
+	if v, ok := m["execution_role"].(string); ok && v != "" {
+		configurationUpdates.ExecutionRole = aws.String(v)
+	}
+
 	if v, ok := m["publish_cloudwatch_metrics_enabled"]; ok {
 		configurationUpdates.PublishCloudWatchMetricsEnabled = aws.Bool(v.(bool))
 	}
 
+	if v, ok := m["query_results_s3_access_grants_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		configurationUpdates.QueryResultsS3AccessGrantsConfiguration = expandWorkGroupQueryResultsS3AccessGrantsConfiguration(v)
+	}
+
 	if v, ok := m["result_configuration"]; ok {
 		configurationUpdates.ResultConfigurationUpdates = expandWorkGroupResultConfigurationUpdates(v.([]interface{}))
 	}
@@ -509,12 +621,15 @@ func flattenWorkGroupConfiguration(configuration *athena.WorkGroupConfiguration)
 	}
 
 	m := map[string]interface{}{
-		"bytes_scanned_cutoff_per_query":     aws.Int64Value(configuration.BytesScannedCutoffPerQuery),
-		"enforce_workgroup_configuration":    aws.BoolValue(configuration.EnforceWorkGroupConfiguration),
-		"engine_version":                     flattenWorkGroupEngineVersion(configuration.EngineVersion),
-		"publish_cloudwatch_metrics_enabled": aws.BoolValue(configuration.PublishCloudWatchMetricsEnabled),
-		"result_configuration":               flattenWorkGroupResultConfiguration(configuration.ResultConfiguration),
-		"requester_pays_enabled":             aws.BoolValue(configuration.RequesterPaysEnabled),
+		"bytes_scanned_cutoff_per_query":               aws.Int64Value(configuration.BytesScannedCutoffPerQuery),
+		"enforce_workgroup_configuration":              aws.BoolValue(configuration.EnforceWorkGroupConfiguration),
+		"engine_version":                               flattenWorkGroupEngineVersion(configuration.EngineVersion),
+		"execution_role":                               aws.StringValue(configuration.ExecutionRole),
+		"identity_center_configuration":                flattenWorkGroupIdentityCenterConfiguration(configuration.IdentityCenterConfiguration),
+		"publish_cloudwatch_metrics_enabled":           aws.BoolValue(configuration.PublishCloudWatchMetricsEnabled),
+		"query_results_s3_access_grants_configuration": flattenWorkGroupQueryResultsS3AccessGrantsConfiguration(configuration.QueryResultsS3AccessGrantsConfiguration),
+		"result_configuration":                         flattenWorkGroupResultConfiguration(configuration.ResultConfiguration),
+		"requester_pays_enabled":                       aws.BoolValue(configuration.RequesterPaysEnabled),
 	}
 
 	return []interface{}{m}
@@ -533,6 +648,33 @@ func flattenWorkGroupEngineVersion(engineVersion *athena.EngineVersion) []interf
 	return []interface{}{m}
 }
 
+func flattenWorkGroupIdentityCenterConfiguration(identityCenterConfiguration *athena.IdentityCenterConfiguration) []interface{} {
+	if identityCenterConfiguration == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"enable_identity_center":       aws.BoolValue(identityCenterConfiguration.EnableIdentityCenter),
+		"identity_center_instance_arn": aws.StringValue(identityCenterConfiguration.IdentityCenterInstanceArn),
+	}
+
+	return []interface{}{m}
+}
+
+func flattenWorkGroupQueryResultsS3AccessGrantsConfiguration(s3AccessGrantsConfiguration *athena.QueryResultsS3AccessGrantsConfiguration) []interface{} {
+	if s3AccessGrantsConfiguration == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"authentication_type":      aws.StringValue(s3AccessGrantsConfiguration.AuthenticationType),
+		"create_user_level_prefix": aws.BoolValue(s3AccessGrantsConfiguration.CreateUserLevelPrefix),
+		"enable_s3_access_grants":  aws.BoolValue(s3AccessGrantsConfiguration.EnableS3AccessGrants),
+	}
+
+	return []interface{}{m}
+}
+
 func flattenWorkGroupResultConfiguration(resultConfiguration *athena.ResultConfiguration) []interface{} {
 	if resultConfiguration == nil {
 		return []interface{}{}
@@ -238,6 +238,42 @@ func TestAccAthenaWorkGroup_configurationEngineVersion(t *testing.T) {
 	})
 }
 
+func TestAccAthenaWorkGroup_identityCenterConfiguration(t *testing.T) {
+	var workgroup1 athena.WorkGroup
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_athena_workgroup.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckSSOAdminInstances(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, athena.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWorkGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkGroupConfig_identityCenterConfiguration(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWorkGroupExists(resourceName, &workgroup1),
+					resource.TestCheckResourceAttr(resourceName, "configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.engine_version.0.selected_engine_version", "Athena engine version 3"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.identity_center_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.identity_center_configuration.0.enable_identity_center", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "configuration.0.identity_center_configuration.0.identity_center_instance_arn", "data.aws_ssoadmin_instances.test", "arns.0"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.query_results_s3_access_grants_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.query_results_s3_access_grants_configuration.0.authentication_type", athena.AuthenticationTypeDirectoryIdentity),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.query_results_s3_access_grants_configuration.0.create_user_level_prefix", "true"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.query_results_s3_access_grants_configuration.0.enable_s3_access_grants", "true"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_destroy"},
+			},
+		},
+	})
+}
+
 func TestAccAthenaWorkGroup_publishCloudWatchMetricsEnabled(t *testing.T) {
 	var workgroup1, workgroup2 athena.WorkGroup
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -755,6 +791,61 @@ resource "aws_athena_workgroup" "test" {
 `, rName, engineVersion)
 }
 
+func testAccWorkGroupConfig_identityCenterConfiguration(rName string) string {
+	return fmt.Sprintf(`
+data "aws_ssoadmin_instances" "test" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = ["sts:AssumeRole", "sts:SetContext"]
+      Effect = "Allow"
+      Principal = {
+        Service = "athena.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_athena_workgroup" "test" {
+  name = %[1]q
+
+  configuration {
+    execution_role = aws_iam_role.test.arn
+
+    engine_version {
+      selected_engine_version = "Athena engine version 3"
+    }
+
+    identity_center_configuration {
+      enable_identity_center       = true
+      identity_center_instance_arn = tolist(data.aws_ssoadmin_instances.test.arns)[0]
+    }
+
+    query_results_s3_access_grants_configuration {
+      authentication_type      = "DIRECTORY_IDENTITY"
+      create_user_level_prefix = true
+      enable_s3_access_grants  = true
+    }
+
+    result_configuration {
+      output_location = "s3://${aws_s3_bucket.test.bucket}/"
+    }
+  }
+}
+`, rName)
+}
+
 func testAccWorkGroupConfig_configurationPublishCloudWatchMetricsEnabled(rName string, publishCloudwatchMetricsEnabled bool) string {
 	return fmt.Sprintf(`
 resource "aws_athena_workgroup" "test" {
@@ -0,0 +1,128 @@
+package auditmanager
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/auditmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceAccountRegistration() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAccountRegistrationCreate,
+		ReadWithoutTimeout:   resourceAccountRegistrationRead,
+		UpdateWithoutTimeout: resourceAccountRegistrationUpdate,
+		DeleteWithoutTimeout: resourceAccountRegistrationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"delegated_admin_account": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"deregister_on_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"kms_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAccountRegistrationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AuditManagerConn
+	region := meta.(*conns.AWSClient).Region
+
+	if err := registerAccount(ctx, conn, d); err != nil {
+		return diag.Errorf("creating Audit Manager Account Registration (%s): %s", region, err)
+	}
+
+	d.SetId(region)
+
+	return resourceAccountRegistrationRead(ctx, d, meta)
+}
+
+func resourceAccountRegistrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AuditManagerConn
+
+	status, err := FindAccountStatus(ctx, conn)
+
+	if err != nil {
+		return diag.Errorf("reading Audit Manager Account Registration (%s): %s", d.Id(), err)
+	}
+
+	if !d.IsNewResource() && status == auditmanager.AccountStatusInactive {
+		log.Printf("[WARN] Audit Manager Account Registration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("status", status)
+
+	return nil
+}
+
+func resourceAccountRegistrationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AuditManagerConn
+
+	if d.HasChanges("delegated_admin_account", "kms_key") {
+		if err := registerAccount(ctx, conn, d); err != nil {
+			return diag.Errorf("updating Audit Manager Account Registration (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceAccountRegistrationRead(ctx, d, meta)
+}
+
+func resourceAccountRegistrationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AuditManagerConn
+
+	// Deregistering disables Audit Manager and, depending on the account's data retention
+	// settings, may delete its evidence, so it is opt-in.
+	if !d.Get("deregister_on_destroy").(bool) {
+		log.Printf("[DEBUG] Removing Audit Manager Account Registration (%s) from state without deregistering", d.Id())
+		return nil
+	}
+
+	log.Printf("[DEBUG] Deregistering Audit Manager Account: %s", d.Id())
+	_, err := conn.DeregisterAccountWithContext(ctx, &auditmanager.DeregisterAccountInput{})
+
+	if err != nil {
+		return diag.Errorf("deleting Audit Manager Account Registration (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func registerAccount(ctx context.Context, conn *auditmanager.AuditManager, d *schema.ResourceData) error {
+	input := &auditmanager.RegisterAccountInput{}
+
+	if v, ok := d.GetOk("delegated_admin_account"); ok {
+		input.DelegatedAdminAccount = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kms_key"); ok {
+		input.KmsKey = aws.String(v.(string))
+	}
+
+	_, err := conn.RegisterAccountWithContext(ctx, input)
+
+	return err
+}
@@ -0,0 +1,111 @@
+package auditmanager_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/auditmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfauditmanager "github.com/hashicorp/terraform-provider-aws/internal/service/auditmanager"
+)
+
+func testAccAccountRegistration_basic(t *testing.T) {
+	resourceName := "aws_auditmanager_account_registration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(auditmanager.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, auditmanager.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		// Audit Manager is left enabled so that other tests in the account are unaffected.
+		CheckDestroy: acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountRegistrationConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountRegistrationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "deregister_on_destroy", "false"),
+					resource.TestCheckResourceAttr(resourceName, "status", auditmanager.AccountStatusActive),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deregister_on_destroy"},
+			},
+		},
+	})
+}
+
+func testAccAccountRegistration_kmsKey(t *testing.T) {
+	resourceName := "aws_auditmanager_account_registration.test"
+	kmsKeyResourceName := "aws_kms_key.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(auditmanager.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, auditmanager.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountRegistrationConfig_kmsKey,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountRegistrationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "kms_key", kmsKeyResourceName, "arn"),
+				),
+			},
+			{
+				// Revert to the AWS owned key before the customer managed key is deleted.
+				Config: testAccAccountRegistrationConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountRegistrationExists(resourceName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAccountRegistrationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Audit Manager Account Registration ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AuditManagerConn
+
+		status, err := tfauditmanager.FindAccountStatus(context.Background(), conn)
+
+		if err != nil {
+			return err
+		}
+
+		if status != auditmanager.AccountStatusActive {
+			return fmt.Errorf("Audit Manager Account Registration %s status is %s", rs.Primary.ID, status)
+		}
+
+		return nil
+	}
+}
+
+const testAccAccountRegistrationConfig_basic = `
+resource "aws_auditmanager_account_registration" "test" {}
+`
+
+const testAccAccountRegistrationConfig_kmsKey = `
+resource "aws_kms_key" "test" {
+  deletion_window_in_days = 7
+}
+
+resource "aws_auditmanager_account_registration" "test" {
+  kms_key = aws_kms_key.test.arn
+}
+`
@@ -0,0 +1,436 @@
+package auditmanager
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/auditmanager"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceAssessment() *schema.Resource {
+	roleSchema := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"role_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(auditmanager.RoleType_Values(), false),
+			},
+		},
+	}
+
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAssessmentCreate,
+		ReadWithoutTimeout:   resourceAssessmentRead,
+		UpdateWithoutTimeout: resourceAssessmentUpdate,
+		DeleteWithoutTimeout: resourceAssessmentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"assessment_reports_destination": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"destination": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"destination_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(auditmanager.AssessmentReportDestinationType_Values(), false),
+						},
+					},
+				},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1000),
+			},
+			"framework_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 300),
+			},
+			"roles": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     roleSchema,
+			},
+			// The service adds the roles of delegated reviewers to the assessment,
+			// so the complete set of roles is exposed separately from those configured.
+			"roles_all": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     roleSchema,
+			},
+			"scope": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"aws_accounts": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidAccountID,
+									},
+								},
+							},
+						},
+						"aws_services": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"service_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceAssessmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AuditManagerConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &auditmanager.CreateAssessmentInput{
+		AssessmentReportsDestination: expandAssessmentReportsDestination(d.Get("assessment_reports_destination").([]interface{})),
+		FrameworkId:                  aws.String(d.Get("framework_id").(string)),
+		Name:                         aws.String(name),
+		Roles:                        expandRoles(d.Get("roles").(*schema.Set).List()),
+		Scope:                        expandScope(d.Get("scope").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateAssessmentWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Audit Manager Assessment (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Assessment.Metadata.Id))
+
+	return resourceAssessmentRead(ctx, d, meta)
+}
+
+func resourceAssessmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AuditManagerConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	assessment, err := FindAssessmentByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Audit Manager Assessment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Audit Manager Assessment (%s): %s", d.Id(), err)
+	}
+
+	metadata := assessment.Metadata
+	d.Set("arn", assessment.Arn)
+	if err := d.Set("assessment_reports_destination", flattenAssessmentReportsDestination(metadata.AssessmentReportsDestination)); err != nil {
+		return diag.Errorf("setting assessment_reports_destination: %s", err)
+	}
+	d.Set("description", metadata.Description)
+	if assessment.Framework != nil {
+		d.Set("framework_id", assessment.Framework.Id)
+	}
+	d.Set("name", metadata.Name)
+	if d.Get("roles").(*schema.Set).Len() == 0 {
+		if err := d.Set("roles", flattenRoles(metadata.Roles)); err != nil {
+			return diag.Errorf("setting roles: %s", err)
+		}
+	}
+	if err := d.Set("roles_all", flattenRoles(metadata.Roles)); err != nil {
+		return diag.Errorf("setting roles_all: %s", err)
+	}
+	if err := d.Set("scope", flattenScope(metadata.Scope)); err != nil {
+		return diag.Errorf("setting scope: %s", err)
+	}
+	d.Set("status", metadata.Status)
+
+	tags := KeyValueTags(assessment.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAssessmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AuditManagerConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &auditmanager.UpdateAssessmentInput{
+			AssessmentId:   aws.String(d.Id()),
+			AssessmentName: aws.String(d.Get("name").(string)),
+			Scope:          expandScope(d.Get("scope").([]interface{})),
+		}
+
+		if d.HasChange("assessment_reports_destination") {
+			input.AssessmentReportsDestination = expandAssessmentReportsDestination(d.Get("assessment_reports_destination").([]interface{}))
+		}
+
+		if d.HasChange("description") {
+			input.AssessmentDescription = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("roles") {
+			input.Roles = expandRoles(d.Get("roles").(*schema.Set).List())
+		}
+
+		_, err := conn.UpdateAssessmentWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating Audit Manager Assessment (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating Audit Manager Assessment (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAssessmentRead(ctx, d, meta)
+}
+
+func resourceAssessmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AuditManagerConn
+
+	log.Printf("[DEBUG] Deleting Audit Manager Assessment: %s", d.Id())
+	_, err := conn.DeleteAssessmentWithContext(ctx, &auditmanager.DeleteAssessmentInput{
+		AssessmentId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, auditmanager.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Audit Manager Assessment (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandAssessmentReportsDestination(tfList []interface{}) *auditmanager.AssessmentReportsDestination {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &auditmanager.AssessmentReportsDestination{}
+
+	if v, ok := tfMap["destination"].(string); ok && v != "" {
+		apiObject.Destination = aws.String(v)
+	}
+
+	if v, ok := tfMap["destination_type"].(string); ok && v != "" {
+		apiObject.DestinationType = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandRoles(tfList []interface{}) []*auditmanager.Role {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*auditmanager.Role
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &auditmanager.Role{}
+
+		if v, ok := tfMap["role_arn"].(string); ok && v != "" {
+			apiObject.RoleArn = aws.String(v)
+		}
+
+		if v, ok := tfMap["role_type"].(string); ok && v != "" {
+			apiObject.RoleType = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandScope(tfList []interface{}) *auditmanager.Scope {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return &auditmanager.Scope{}
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &auditmanager.Scope{}
+
+	if v, ok := tfMap["aws_accounts"].(*schema.Set); ok && v.Len() > 0 {
+		for _, tfMapRaw := range v.List() {
+			if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+				apiObject.AwsAccounts = append(apiObject.AwsAccounts, &auditmanager.AWSAccount{
+					Id: aws.String(tfMap["id"].(string)),
+				})
+			}
+		}
+	}
+
+	if v, ok := tfMap["aws_services"].(*schema.Set); ok && v.Len() > 0 {
+		for _, tfMapRaw := range v.List() {
+			if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+				apiObject.AwsServices = append(apiObject.AwsServices, &auditmanager.AWSService{
+					ServiceName: aws.String(tfMap["service_name"].(string)),
+				})
+			}
+		}
+	}
+
+	return apiObject
+}
+
+func flattenAssessmentReportsDestination(apiObject *auditmanager.AssessmentReportsDestination) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Destination; v != nil {
+		tfMap["destination"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.DestinationType; v != nil {
+		tfMap["destination_type"] = aws.StringValue(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenRoles(apiObjects []*auditmanager.Role) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"role_arn":  aws.StringValue(apiObject.RoleArn),
+			"role_type": aws.StringValue(apiObject.RoleType),
+		})
+	}
+
+	return tfList
+}
+
+func flattenScope(apiObject *auditmanager.Scope) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	var awsAccounts, awsServices []interface{}
+
+	for _, v := range apiObject.AwsAccounts {
+		if v != nil {
+			awsAccounts = append(awsAccounts, map[string]interface{}{
+				"id": aws.StringValue(v.Id),
+			})
+		}
+	}
+
+	for _, v := range apiObject.AwsServices {
+		if v != nil {
+			awsServices = append(awsServices, map[string]interface{}{
+				"service_name": aws.StringValue(v.ServiceName),
+			})
+		}
+	}
+
+	tfMap := map[string]interface{}{
+		"aws_accounts": awsAccounts,
+		"aws_services": awsServices,
+	}
+
+	return []interface{}{tfMap}
+}
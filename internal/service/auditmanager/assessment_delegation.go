@@ -0,0 +1,183 @@
+package auditmanager
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/auditmanager"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceAssessmentDelegation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAssessmentDelegationCreate,
+		ReadWithoutTimeout:   resourceAssessmentDelegationRead,
+		DeleteWithoutTimeout: resourceAssessmentDelegationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"assessment_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"comment": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 350),
+			},
+			"control_set_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 300),
+			},
+			"delegation_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"role_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(auditmanager.RoleType_Values(), false),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAssessmentDelegationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AuditManagerConn
+
+	assessmentID := d.Get("assessment_id").(string)
+	controlSetID := d.Get("control_set_id").(string)
+	roleARN := d.Get("role_arn").(string)
+	id := AssessmentDelegationCreateResourceID(assessmentID, roleARN, controlSetID)
+	delegation := &auditmanager.CreateDelegationRequest{
+		ControlSetId: aws.String(controlSetID),
+		RoleArn:      aws.String(roleARN),
+		RoleType:     aws.String(d.Get("role_type").(string)),
+	}
+
+	if v, ok := d.GetOk("comment"); ok {
+		delegation.Comment = aws.String(v.(string))
+	}
+
+	input := &auditmanager.BatchCreateDelegationByAssessmentInput{
+		AssessmentId:             aws.String(assessmentID),
+		CreateDelegationRequests: []*auditmanager.CreateDelegationRequest{delegation},
+	}
+
+	output, err := conn.BatchCreateDelegationByAssessmentWithContext(ctx, input)
+
+	if err == nil && output != nil && len(output.Errors) > 0 {
+		err = fmt.Errorf("%s: %s", aws.StringValue(output.Errors[0].ErrorCode), aws.StringValue(output.Errors[0].ErrorMessage))
+	}
+
+	if err != nil {
+		return diag.Errorf("creating Audit Manager Assessment Delegation (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceAssessmentDelegationRead(ctx, d, meta)
+}
+
+func resourceAssessmentDelegationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AuditManagerConn
+
+	assessmentID, roleARN, controlSetID, err := AssessmentDelegationParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	delegation, err := FindAssessmentDelegationByThreePartKey(ctx, conn, assessmentID, roleARN, controlSetID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Audit Manager Assessment Delegation (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Audit Manager Assessment Delegation (%s): %s", d.Id(), err)
+	}
+
+	d.Set("assessment_id", delegation.AssessmentId)
+	d.Set("comment", delegation.Comment)
+	d.Set("control_set_id", delegation.ControlSetId)
+	d.Set("delegation_id", delegation.Id)
+	d.Set("role_arn", delegation.RoleArn)
+	d.Set("role_type", delegation.RoleType)
+	d.Set("status", delegation.Status)
+
+	return nil
+}
+
+func resourceAssessmentDelegationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AuditManagerConn
+
+	log.Printf("[DEBUG] Deleting Audit Manager Assessment Delegation: %s", d.Id())
+	output, err := conn.BatchDeleteDelegationByAssessmentWithContext(ctx, &auditmanager.BatchDeleteDelegationByAssessmentInput{
+		AssessmentId:  aws.String(d.Get("assessment_id").(string)),
+		DelegationIds: aws.StringSlice([]string{d.Get("delegation_id").(string)}),
+	})
+
+	if tfawserr.ErrCodeEquals(err, auditmanager.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err == nil && output != nil && len(output.Errors) > 0 {
+		err = fmt.Errorf("%s: %s", aws.StringValue(output.Errors[0].ErrorCode), aws.StringValue(output.Errors[0].ErrorMessage))
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Audit Manager Assessment Delegation (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// Role ARNs contain colons, so a comma separates the parts of the ID.
+const assessmentDelegationIDSeparator = ","
+
+func AssessmentDelegationCreateResourceID(assessmentID, roleARN, controlSetID string) string {
+	parts := []string{assessmentID, roleARN, controlSetID}
+	id := strings.Join(parts, assessmentDelegationIDSeparator)
+
+	return id
+}
+
+func AssessmentDelegationParseResourceID(id string) (string, string, string, error) {
+	parts := strings.Split(id, assessmentDelegationIDSeparator)
+
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected ASSESSMENT-ID%[2]sROLE-ARN%[2]sCONTROL-SET-ID", id, assessmentDelegationIDSeparator)
+}
@@ -0,0 +1,160 @@
+package auditmanager_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/auditmanager"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfauditmanager "github.com/hashicorp/terraform-provider-aws/internal/service/auditmanager"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccAuditManagerAssessmentDelegation_basic(t *testing.T) {
+	var v auditmanager.Delegation
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_auditmanager_assessment_delegation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, auditmanager.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAssessmentDelegationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssessmentDelegationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssessmentDelegationExists(resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "assessment_id", "aws_auditmanager_assessment.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "comment", "please review"),
+					resource.TestCheckResourceAttr(resourceName, "control_set_id", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "delegation_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.delegate", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "role_type", "RESOURCE_OWNER"),
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAuditManagerAssessmentDelegation_disappears(t *testing.T) {
+	var v auditmanager.Delegation
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_auditmanager_assessment_delegation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, auditmanager.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAssessmentDelegationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssessmentDelegationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssessmentDelegationExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfauditmanager.ResourceAssessmentDelegation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAssessmentDelegationExists(n string, v *auditmanager.Delegation) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Audit Manager Assessment Delegation ID is set")
+		}
+
+		assessmentID, roleARN, controlSetID, err := tfauditmanager.AssessmentDelegationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AuditManagerConn
+
+		output, err := tfauditmanager.FindAssessmentDelegationByThreePartKey(context.Background(), conn, assessmentID, roleARN, controlSetID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAssessmentDelegationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).AuditManagerConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_auditmanager_assessment_delegation" {
+			continue
+		}
+
+		assessmentID, roleARN, controlSetID, err := tfauditmanager.AssessmentDelegationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfauditmanager.FindAssessmentDelegationByThreePartKey(context.Background(), conn, assessmentID, roleARN, controlSetID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Audit Manager Assessment Delegation %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAssessmentDelegationConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccAssessmentConfig_basic(rName, "description"), fmt.Sprintf(`
+resource "aws_iam_role" "delegate" {
+  name = "%[1]s-delegate"
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        AWS = data.aws_caller_identity.current.account_id
+      }
+    }]
+  })
+}
+
+resource "aws_auditmanager_assessment_delegation" "test" {
+  assessment_id  = aws_auditmanager_assessment.test.id
+  comment        = "please review"
+  control_set_id = %[1]q
+  role_arn       = aws_iam_role.delegate.arn
+  role_type      = "RESOURCE_OWNER"
+}
+`, rName))
+}
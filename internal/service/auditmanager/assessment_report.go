@@ -0,0 +1,167 @@
+package auditmanager
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/auditmanager"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceAssessmentReport() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAssessmentReportCreate,
+		ReadWithoutTimeout:   resourceAssessmentReportRead,
+		DeleteWithoutTimeout: resourceAssessmentReportDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"assessment_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"author": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1000),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 300),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAssessmentReportCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AuditManagerConn
+
+	assessmentID := d.Get("assessment_id").(string)
+	name := d.Get("name").(string)
+	input := &auditmanager.CreateAssessmentReportInput{
+		AssessmentId: aws.String(assessmentID),
+		Name:         aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateAssessmentReportWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Audit Manager Assessment Report (%s): %s", name, err)
+	}
+
+	reportID := aws.StringValue(output.AssessmentReport.Id)
+	d.SetId(AssessmentReportCreateResourceID(assessmentID, reportID))
+
+	if _, err := waitAssessmentReportCompleted(ctx, conn, assessmentID, reportID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for Audit Manager Assessment Report (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceAssessmentReportRead(ctx, d, meta)
+}
+
+func resourceAssessmentReportRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AuditManagerConn
+
+	assessmentID, reportID, err := AssessmentReportParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	report, err := FindAssessmentReportByTwoPartKey(ctx, conn, assessmentID, reportID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Audit Manager Assessment Report (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Audit Manager Assessment Report (%s): %s", d.Id(), err)
+	}
+
+	d.Set("assessment_id", report.AssessmentId)
+	d.Set("author", report.Author)
+	d.Set("description", report.Description)
+	d.Set("name", report.Name)
+	d.Set("status", report.Status)
+
+	return nil
+}
+
+func resourceAssessmentReportDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AuditManagerConn
+
+	assessmentID, reportID, err := AssessmentReportParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting Audit Manager Assessment Report: %s", d.Id())
+	_, err = conn.DeleteAssessmentReportWithContext(ctx, &auditmanager.DeleteAssessmentReportInput{
+		AssessmentId:       aws.String(assessmentID),
+		AssessmentReportId: aws.String(reportID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, auditmanager.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Audit Manager Assessment Report (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+const assessmentReportIDSeparator = ","
+
+func AssessmentReportCreateResourceID(assessmentID, reportID string) string {
+	parts := []string{assessmentID, reportID}
+	id := strings.Join(parts, assessmentReportIDSeparator)
+
+	return id
+}
+
+func AssessmentReportParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, assessmentReportIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected ASSESSMENT-ID%[2]sREPORT-ID", id, assessmentReportIDSeparator)
+}
@@ -0,0 +1,141 @@
+package auditmanager_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/auditmanager"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfauditmanager "github.com/hashicorp/terraform-provider-aws/internal/service/auditmanager"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccAuditManagerAssessmentReport_basic(t *testing.T) {
+	var v auditmanager.AssessmentReportMetadata
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_auditmanager_assessment_report.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, auditmanager.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAssessmentReportDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssessmentReportConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssessmentReportExists(resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "assessment_id", "aws_auditmanager_assessment.test", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "author"),
+					resource.TestCheckResourceAttr(resourceName, "description", "description"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "status", "COMPLETE"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAuditManagerAssessmentReport_disappears(t *testing.T) {
+	var v auditmanager.AssessmentReportMetadata
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_auditmanager_assessment_report.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, auditmanager.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAssessmentReportDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssessmentReportConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssessmentReportExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfauditmanager.ResourceAssessmentReport(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAssessmentReportExists(n string, v *auditmanager.AssessmentReportMetadata) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Audit Manager Assessment Report ID is set")
+		}
+
+		assessmentID, reportID, err := tfauditmanager.AssessmentReportParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AuditManagerConn
+
+		output, err := tfauditmanager.FindAssessmentReportByTwoPartKey(context.Background(), conn, assessmentID, reportID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAssessmentReportDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).AuditManagerConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_auditmanager_assessment_report" {
+			continue
+		}
+
+		assessmentID, reportID, err := tfauditmanager.AssessmentReportParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfauditmanager.FindAssessmentReportByTwoPartKey(context.Background(), conn, assessmentID, reportID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Audit Manager Assessment Report %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAssessmentReportConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccAssessmentConfig_basic(rName, "description"), fmt.Sprintf(`
+resource "aws_auditmanager_assessment_report" "test" {
+  name          = %[1]q
+  description   = "description"
+  assessment_id = aws_auditmanager_assessment.test.id
+}
+`, rName))
+}
@@ -0,0 +1,55 @@
+package auditmanager_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/auditmanager"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfauditmanager "github.com/hashicorp/terraform-provider-aws/internal/service/auditmanager"
+)
+
+func TestAccAuditManager_serial(t *testing.T) {
+	testCases := map[string]map[string]func(t *testing.T){
+		"AccountRegistration": {
+			"basic":  testAccAccountRegistration_basic,
+			"KMSKey": testAccAccountRegistration_kmsKey,
+		},
+		"OrganizationAdminAccountRegistration": {
+			"basic":      testAccOrganizationAdminAccountRegistration_basic,
+			"disappears": testAccOrganizationAdminAccountRegistration_disappears,
+		},
+	}
+
+	for group, m := range testCases {
+		m := m
+		t.Run(group, func(t *testing.T) {
+			for name, tc := range m {
+				tc := tc
+				t.Run(name, func(t *testing.T) {
+					tc(t)
+				})
+			}
+		})
+	}
+}
+
+// testAccPreCheck skips the test unless Audit Manager is enabled in the current account and Region.
+func testAccPreCheck(t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).AuditManagerConn
+
+	status, err := tfauditmanager.FindAccountStatus(context.Background(), conn)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+
+	if status != auditmanager.AccountStatusActive {
+		t.Skipf("skipping acceptance testing: Audit Manager account status is %s", status)
+	}
+}
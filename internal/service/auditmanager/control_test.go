@@ -0,0 +1,293 @@
+package auditmanager_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/auditmanager"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfauditmanager "github.com/hashicorp/terraform-provider-aws/internal/service/auditmanager"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccAuditManagerControl_basic(t *testing.T) {
+	var v auditmanager.Control
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_auditmanager_control.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, auditmanager.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckControlDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccControlConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckControlExists(resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "auditmanager", regexp.MustCompile(`control/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "control_mapping_sources.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "control_mapping_sources.0.source_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "control_mapping_sources.0.source_set_up_option", "Procedural_Controls_Mapping"),
+					resource.TestCheckResourceAttr(resourceName, "control_mapping_sources.0.source_type", "MANUAL"),
+					resource.TestCheckResourceAttrSet(resourceName, "control_mapping_sources.0.source_id"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "type", "Custom"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAuditManagerControl_disappears(t *testing.T) {
+	var v auditmanager.Control
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_auditmanager_control.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, auditmanager.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckControlDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccControlConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckControlExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfauditmanager.ResourceControl(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAuditManagerControl_updates(t *testing.T) {
+	var v auditmanager.Control
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_auditmanager_control.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, auditmanager.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckControlDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccControlConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckControlExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "control_mapping_sources.#", "1"),
+				),
+			},
+			{
+				Config: testAccControlConfig_updated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckControlExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "action_plan_instructions", "action plan instructions"),
+					resource.TestCheckResourceAttr(resourceName, "action_plan_title", "action plan title"),
+					resource.TestCheckResourceAttr(resourceName, "control_mapping_sources.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "control_mapping_sources.1.source_frequency", "DAILY"),
+					resource.TestCheckResourceAttr(resourceName, "control_mapping_sources.1.source_keyword.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "control_mapping_sources.1.source_keyword.0.keyword_input_type", "SELECT_FROM_LIST"),
+					resource.TestCheckResourceAttr(resourceName, "control_mapping_sources.1.source_keyword.0.keyword_value", "s3-bucket-public-read-prohibited"),
+					resource.TestCheckResourceAttr(resourceName, "control_mapping_sources.1.source_type", "AWS_Config"),
+					resource.TestCheckResourceAttr(resourceName, "description", "description"),
+					resource.TestCheckResourceAttr(resourceName, "testing_information", "testing information"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAuditManagerControl_tags(t *testing.T) {
+	var v auditmanager.Control
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_auditmanager_control.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, auditmanager.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckControlDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccControlConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckControlExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccControlConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckControlExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccControlConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckControlExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckControlExists(n string, v *auditmanager.Control) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Audit Manager Control ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AuditManagerConn
+
+		output, err := tfauditmanager.FindControlByID(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckControlDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).AuditManagerConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_auditmanager_control" {
+			continue
+		}
+
+		_, err := tfauditmanager.FindControlByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Audit Manager Control %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccControlConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_auditmanager_control" "test" {
+  name = %[1]q
+
+  control_mapping_sources {
+    source_name          = %[1]q
+    source_set_up_option = "Procedural_Controls_Mapping"
+    source_type          = "MANUAL"
+  }
+}
+`, rName)
+}
+
+func testAccControlConfig_updated(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_auditmanager_control" "test" {
+  name                     = %[1]q
+  action_plan_instructions = "action plan instructions"
+  action_plan_title        = "action plan title"
+  description              = "description"
+  testing_information      = "testing information"
+
+  control_mapping_sources {
+    source_name          = %[1]q
+    source_set_up_option = "Procedural_Controls_Mapping"
+    source_type          = "MANUAL"
+  }
+
+  control_mapping_sources {
+    source_name          = "%[1]s-config"
+    source_frequency     = "DAILY"
+    source_set_up_option = "System_Controls_Mapping"
+    source_type          = "AWS_Config"
+
+    source_keyword {
+      keyword_input_type = "SELECT_FROM_LIST"
+      keyword_value      = "s3-bucket-public-read-prohibited"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccControlConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_auditmanager_control" "test" {
+  name = %[1]q
+
+  control_mapping_sources {
+    source_name          = %[1]q
+    source_set_up_option = "Procedural_Controls_Mapping"
+    source_type          = "MANUAL"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccControlConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_auditmanager_control" "test" {
+  name = %[1]q
+
+  control_mapping_sources {
+    source_name          = %[1]q
+    source_set_up_option = "Procedural_Controls_Mapping"
+    source_type          = "MANUAL"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
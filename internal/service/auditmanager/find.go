@@ -0,0 +1,188 @@
+package auditmanager
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/auditmanager"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindAccountStatus(ctx context.Context, conn *auditmanager.AuditManager) (string, error) {
+	input := &auditmanager.GetAccountStatusInput{}
+
+	output, err := conn.GetAccountStatusWithContext(ctx, input)
+
+	if err != nil {
+		return "", err
+	}
+
+	if output == nil || output.Status == nil {
+		return "", tfresource.NewEmptyResultError(input)
+	}
+
+	return aws.StringValue(output.Status), nil
+}
+
+func FindAssessmentByID(ctx context.Context, conn *auditmanager.AuditManager, id string) (*auditmanager.Assessment, error) {
+	input := &auditmanager.GetAssessmentInput{
+		AssessmentId: aws.String(id),
+	}
+
+	output, err := conn.GetAssessmentWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, auditmanager.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Assessment == nil || output.Assessment.Metadata == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Assessment, nil
+}
+
+func FindAssessmentDelegationByThreePartKey(ctx context.Context, conn *auditmanager.AuditManager, assessmentID, roleARN, controlSetID string) (*auditmanager.Delegation, error) {
+	assessment, err := FindAssessmentByID(ctx, conn, assessmentID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var output []*auditmanager.Delegation
+
+	for _, v := range assessment.Metadata.Delegations {
+		if v == nil {
+			continue
+		}
+
+		if aws.StringValue(v.RoleArn) == roleARN && aws.StringValue(v.ControlSetId) == controlSetID {
+			output = append(output, v)
+		}
+	}
+
+	if len(output) == 0 {
+		return nil, tfresource.NewEmptyResultError(nil)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, nil)
+	}
+
+	return output[0], nil
+}
+
+func FindAssessmentReportByTwoPartKey(ctx context.Context, conn *auditmanager.AuditManager, assessmentID, id string) (*auditmanager.AssessmentReportMetadata, error) {
+	input := &auditmanager.ListAssessmentReportsInput{}
+	var output []*auditmanager.AssessmentReportMetadata
+
+	err := conn.ListAssessmentReportsPagesWithContext(ctx, input, func(page *auditmanager.ListAssessmentReportsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.AssessmentReports {
+			if v != nil && aws.StringValue(v.AssessmentId) == assessmentID && aws.StringValue(v.Id) == id {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
+
+func FindControlByID(ctx context.Context, conn *auditmanager.AuditManager, id string) (*auditmanager.Control, error) {
+	input := &auditmanager.GetControlInput{
+		ControlId: aws.String(id),
+	}
+
+	output, err := conn.GetControlWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, auditmanager.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Control == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Control, nil
+}
+
+func FindFrameworkByID(ctx context.Context, conn *auditmanager.AuditManager, id string) (*auditmanager.Framework, error) {
+	input := &auditmanager.GetAssessmentFrameworkInput{
+		FrameworkId: aws.String(id),
+	}
+
+	output, err := conn.GetAssessmentFrameworkWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, auditmanager.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Framework == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Framework, nil
+}
+
+func FindOrganizationAdminAccount(ctx context.Context, conn *auditmanager.AuditManager) (*auditmanager.GetOrganizationAdminAccountOutput, error) {
+	input := &auditmanager.GetOrganizationAdminAccountInput{}
+
+	output, err := conn.GetOrganizationAdminAccountWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, auditmanager.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.AdminAccountId == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,103 @@
+package auditmanager
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/auditmanager"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceOrganizationAdminAccountRegistration() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceOrganizationAdminAccountRegistrationCreate,
+		ReadWithoutTimeout:   resourceOrganizationAdminAccountRegistrationRead,
+		DeleteWithoutTimeout: resourceOrganizationAdminAccountRegistrationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"admin_account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"organization_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceOrganizationAdminAccountRegistrationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AuditManagerConn
+
+	adminAccountID := d.Get("admin_account_id").(string)
+	input := &auditmanager.RegisterOrganizationAdminAccountInput{
+		AdminAccountId: aws.String(adminAccountID),
+	}
+
+	_, err := conn.RegisterOrganizationAdminAccountWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Audit Manager Organization Admin Account Registration (%s): %s", adminAccountID, err)
+	}
+
+	d.SetId(adminAccountID)
+
+	return resourceOrganizationAdminAccountRegistrationRead(ctx, d, meta)
+}
+
+func resourceOrganizationAdminAccountRegistrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AuditManagerConn
+
+	output, err := FindOrganizationAdminAccount(ctx, conn)
+
+	if err == nil && aws.StringValue(output.AdminAccountId) != d.Id() {
+		err = tfresource.NewEmptyResultError(nil)
+	}
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Audit Manager Organization Admin Account Registration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Audit Manager Organization Admin Account Registration (%s): %s", d.Id(), err)
+	}
+
+	d.Set("admin_account_id", output.AdminAccountId)
+	d.Set("organization_id", output.OrganizationId)
+
+	return nil
+}
+
+func resourceOrganizationAdminAccountRegistrationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AuditManagerConn
+
+	log.Printf("[DEBUG] Deleting Audit Manager Organization Admin Account Registration: %s", d.Id())
+	_, err := conn.DeregisterOrganizationAdminAccountWithContext(ctx, &auditmanager.DeregisterOrganizationAdminAccountInput{
+		AdminAccountId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, auditmanager.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Audit Manager Organization Admin Account Registration (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
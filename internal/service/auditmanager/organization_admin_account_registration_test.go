@@ -0,0 +1,134 @@
+package auditmanager_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/auditmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfauditmanager "github.com/hashicorp/terraform-provider-aws/internal/service/auditmanager"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccOrganizationAdminAccountRegistration_basic(t *testing.T) {
+	resourceName := "aws_auditmanager_organization_admin_account_registration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckOrganizationManagementAccount(t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, auditmanager.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOrganizationAdminAccountRegistrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationAdminAccountRegistrationConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrganizationAdminAccountRegistrationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "admin_account_id", "data.aws_caller_identity.current", "account_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "organization_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccOrganizationAdminAccountRegistration_disappears(t *testing.T) {
+	resourceName := "aws_auditmanager_organization_admin_account_registration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckOrganizationManagementAccount(t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, auditmanager.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOrganizationAdminAccountRegistrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationAdminAccountRegistrationConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrganizationAdminAccountRegistrationExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfauditmanager.ResourceOrganizationAdminAccountRegistration(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckOrganizationAdminAccountRegistrationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Audit Manager Organization Admin Account Registration ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AuditManagerConn
+
+		output, err := tfauditmanager.FindOrganizationAdminAccount(context.Background(), conn)
+
+		if err != nil {
+			return err
+		}
+
+		if adminAccountID := aws.StringValue(output.AdminAccountId); adminAccountID != rs.Primary.ID {
+			return fmt.Errorf("Audit Manager Organization Admin Account is %s, expected %s", adminAccountID, rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckOrganizationAdminAccountRegistrationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).AuditManagerConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_auditmanager_organization_admin_account_registration" {
+			continue
+		}
+
+		output, err := tfauditmanager.FindOrganizationAdminAccount(context.Background(), conn)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if aws.StringValue(output.AdminAccountId) != rs.Primary.ID {
+			continue
+		}
+
+		return fmt.Errorf("Audit Manager Organization Admin Account Registration %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+const testAccOrganizationAdminAccountRegistrationConfig_basic = `
+data "aws_caller_identity" "current" {}
+
+resource "aws_auditmanager_organization_admin_account_registration" "test" {
+  admin_account_id = data.aws_caller_identity.current.account_id
+}
+`
@@ -0,0 +1,26 @@
+package auditmanager
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/auditmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusAssessmentReport(ctx context.Context, conn *auditmanager.AuditManager, assessmentID, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindAssessmentReportByTwoPartKey(ctx, conn, assessmentID, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
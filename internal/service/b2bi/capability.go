@@ -0,0 +1,383 @@
+package b2bi
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/b2bi"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceCapability() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCapabilityCreate,
+		ReadWithoutTimeout:   resourceCapabilityRead,
+		UpdateWithoutTimeout: resourceCapabilityUpdate,
+		DeleteWithoutTimeout: resourceCapabilityDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"capability_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"edi": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"input_location":  s3LocationSchema(),
+									"output_location": s3LocationSchema(),
+									"transformer_id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"type": ediTypeSchema(),
+								},
+							},
+						},
+					},
+				},
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"instructions_documents": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 5,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(3, 63),
+						},
+						"key": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(0, 1024),
+						},
+					},
+				},
+			},
+			"modified_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 254),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      b2bi.CapabilityTypeEdi,
+				ValidateFunc: validation.StringInSlice(b2bi.CapabilityType_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceCapabilityCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).B2BIConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &b2bi.CreateCapabilityInput{
+		Configuration: expandCapabilityConfiguration(d.Get("configuration").([]interface{})),
+		Name:          aws.String(name),
+		Type:          aws.String(d.Get("type").(string)),
+	}
+
+	if v, ok := d.GetOk("instructions_documents"); ok && len(v.([]interface{})) > 0 {
+		input.InstructionsDocuments = expandS3Locations(v.([]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating B2BI Capability: %s", input)
+	output, err := conn.CreateCapabilityWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating B2BI Capability (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.CapabilityId))
+
+	return resourceCapabilityRead(ctx, d, meta)
+}
+
+func resourceCapabilityRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).B2BIConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindCapabilityByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] B2BI Capability (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading B2BI Capability (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.CapabilityArn)
+	d.Set("capability_id", output.CapabilityId)
+	if err := d.Set("configuration", flattenCapabilityConfiguration(output.Configuration)); err != nil {
+		return diag.Errorf("setting configuration: %s", err)
+	}
+	d.Set("created_at", aws.TimeValue(output.CreatedAt).Format(time.RFC3339))
+	if err := d.Set("instructions_documents", flattenS3Locations(output.InstructionsDocuments)); err != nil {
+		return diag.Errorf("setting instructions_documents: %s", err)
+	}
+	if output.ModifiedAt != nil {
+		d.Set("modified_at", aws.TimeValue(output.ModifiedAt).Format(time.RFC3339))
+	} else {
+		d.Set("modified_at", nil)
+	}
+	d.Set("name", output.Name)
+	d.Set("type", output.Type)
+
+	tags, err := ListTagsWithContext(ctx, conn, d.Get("arn").(string))
+
+	if err != nil {
+		return diag.Errorf("listing tags for B2BI Capability (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceCapabilityUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).B2BIConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &b2bi.UpdateCapabilityInput{
+			CapabilityId: aws.String(d.Id()),
+		}
+
+		if d.HasChange("configuration") {
+			input.Configuration = expandCapabilityConfiguration(d.Get("configuration").([]interface{}))
+		}
+
+		if d.HasChange("instructions_documents") {
+			input.InstructionsDocuments = expandS3Locations(d.Get("instructions_documents").([]interface{}))
+		}
+
+		if d.HasChange("name") {
+			input.Name = aws.String(d.Get("name").(string))
+		}
+
+		log.Printf("[DEBUG] Updating B2BI Capability: %s", input)
+		_, err := conn.UpdateCapabilityWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating B2BI Capability (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating B2BI Capability (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceCapabilityRead(ctx, d, meta)
+}
+
+func resourceCapabilityDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).B2BIConn
+
+	log.Printf("[DEBUG] Deleting B2BI Capability: %s", d.Id())
+	_, err := conn.DeleteCapabilityWithContext(ctx, &b2bi.DeleteCapabilityInput{
+		CapabilityId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, b2bi.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting B2BI Capability (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func s3LocationSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"bucket_name": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(3, 63),
+				},
+				"key": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(0, 1024),
+				},
+			},
+		},
+	}
+}
+
+func expandCapabilityConfiguration(tfList []interface{}) *b2bi.CapabilityConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &b2bi.CapabilityConfiguration{}
+
+	if v, ok := tfMap["edi"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.Edi = &b2bi.EdiConfiguration{
+			InputLocation:  expandS3Location(tfMap["input_location"].([]interface{})),
+			OutputLocation: expandS3Location(tfMap["output_location"].([]interface{})),
+			TransformerId:  aws.String(tfMap["transformer_id"].(string)),
+			Type:           expandEDIType(tfMap["type"].([]interface{})),
+		}
+	}
+
+	return apiObject
+}
+
+func expandS3Location(tfList []interface{}) *b2bi.S3Location {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	return expandS3LocationMap(tfList[0].(map[string]interface{}))
+}
+
+func expandS3LocationMap(tfMap map[string]interface{}) *b2bi.S3Location {
+	apiObject := &b2bi.S3Location{}
+
+	if v, ok := tfMap["bucket_name"].(string); ok && v != "" {
+		apiObject.BucketName = aws.String(v)
+	}
+
+	if v, ok := tfMap["key"].(string); ok && v != "" {
+		apiObject.Key = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandS3Locations(tfList []interface{}) []*b2bi.S3Location {
+	apiObjects := []*b2bi.S3Location{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, expandS3LocationMap(tfMap))
+	}
+
+	return apiObjects
+}
+
+func flattenCapabilityConfiguration(apiObject *b2bi.CapabilityConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Edi; v != nil {
+		tfMap["edi"] = []interface{}{map[string]interface{}{
+			"input_location":  flattenS3Location(v.InputLocation),
+			"output_location": flattenS3Location(v.OutputLocation),
+			"transformer_id":  aws.StringValue(v.TransformerId),
+			"type":            flattenEDIType(v.Type),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenS3Location(apiObject *b2bi.S3Location) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"bucket_name": aws.StringValue(apiObject.BucketName),
+		"key":         aws.StringValue(apiObject.Key),
+	}}
+}
+
+func flattenS3Locations(apiObjects []*b2bi.S3Location) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"bucket_name": aws.StringValue(apiObject.BucketName),
+			"key":         aws.StringValue(apiObject.Key),
+		})
+	}
+
+	return tfList
+}
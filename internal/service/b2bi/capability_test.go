@@ -0,0 +1,217 @@
+package b2bi_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/b2bi"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfb2bi "github.com/hashicorp/terraform-provider-aws/internal/service/b2bi"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccB2BICapability_basic(t *testing.T) {
+	var v b2bi.GetCapabilityOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_b2bi_capability.test"
+	transformerResourceName := "aws_b2bi_transformer.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, b2bi.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCapabilityDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCapabilityConfig_basic(rName, "input/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCapabilityExists(resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "b2bi", regexp.MustCompile(`capability/.+`)),
+					resource.TestCheckResourceAttrPair(resourceName, "capability_id", resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.edi.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "configuration.0.edi.0.input_location.0.bucket_name", "aws_s3_bucket.test", "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.edi.0.input_location.0.key", "input/"),
+					resource.TestCheckResourceAttrPair(resourceName, "configuration.0.edi.0.output_location.0.bucket_name", "aws_s3_bucket.test", "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.edi.0.output_location.0.key", "output/"),
+					resource.TestCheckResourceAttrPair(resourceName, "configuration.0.edi.0.transformer_id", transformerResourceName, "transformer_id"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.edi.0.type.0.x12_details.0.transaction_set", "X12_110"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.edi.0.type.0.x12_details.0.version", "VERSION_4010"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+					resource.TestCheckResourceAttr(resourceName, "instructions_documents.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "type", "edi"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCapabilityConfig_basic(rName, "inbound/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCapabilityExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.edi.0.input_location.0.key", "inbound/"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccB2BICapability_disappears(t *testing.T) {
+	var v b2bi.GetCapabilityOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_b2bi_capability.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, b2bi.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCapabilityDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCapabilityConfig_basic(rName, "input/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCapabilityExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfb2bi.ResourceCapability(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCapabilityDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).B2BIConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_b2bi_capability" {
+			continue
+		}
+
+		_, err := tfb2bi.FindCapabilityByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("B2BI Capability %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckCapabilityExists(n string, v *b2bi.GetCapabilityOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No B2BI Capability ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).B2BIConn
+
+		output, err := tfb2bi.FindCapabilityByID(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCapabilityConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_policy" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = "b2bi.${data.aws_partition.current.dns_suffix}"
+      }
+      Action = [
+        "s3:GetObject",
+        "s3:GetObjectAttributes",
+        "s3:PutObject",
+      ]
+      Resource = "${aws_s3_bucket.test.arn}/*"
+    }]
+  })
+}
+
+resource "aws_b2bi_transformer" "test" {
+  file_format      = "JSON"
+  mapping_template = "$"
+  name             = %[1]q
+  status           = "active"
+
+  edi_type {
+    x12_details {
+      transaction_set = "X12_110"
+      version         = "VERSION_4010"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccCapabilityConfig_basic(rName, inputKey string) string {
+	return acctest.ConfigCompose(testAccCapabilityConfig_base(rName), fmt.Sprintf(`
+resource "aws_b2bi_capability" "test" {
+  name = %[1]q
+
+  configuration {
+    edi {
+      transformer_id = aws_b2bi_transformer.test.transformer_id
+
+      input_location {
+        bucket_name = aws_s3_bucket.test.bucket
+        key         = %[2]q
+      }
+
+      output_location {
+        bucket_name = aws_s3_bucket.test.bucket
+        key         = "output/"
+      }
+
+      type {
+        x12_details {
+          transaction_set = "X12_110"
+          version         = "VERSION_4010"
+        }
+      }
+    }
+  }
+
+  depends_on = [aws_s3_bucket_policy.test]
+}
+`, rName, inputKey))
+}
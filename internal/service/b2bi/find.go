@@ -0,0 +1,111 @@
+package b2bi
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/b2bi"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindCapabilityByID(ctx context.Context, conn *b2bi.B2bi, id string) (*b2bi.GetCapabilityOutput, error) {
+	input := &b2bi.GetCapabilityInput{
+		CapabilityId: aws.String(id),
+	}
+
+	output, err := conn.GetCapabilityWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, b2bi.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindPartnershipByID(ctx context.Context, conn *b2bi.B2bi, id string) (*b2bi.GetPartnershipOutput, error) {
+	input := &b2bi.GetPartnershipInput{
+		PartnershipId: aws.String(id),
+	}
+
+	output, err := conn.GetPartnershipWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, b2bi.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindProfileByID(ctx context.Context, conn *b2bi.B2bi, id string) (*b2bi.GetProfileOutput, error) {
+	input := &b2bi.GetProfileInput{
+		ProfileId: aws.String(id),
+	}
+
+	output, err := conn.GetProfileWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, b2bi.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindTransformerByID(ctx context.Context, conn *b2bi.B2bi, id string) (*b2bi.GetTransformerOutput, error) {
+	input := &b2bi.GetTransformerInput{
+		TransformerId: aws.String(id),
+	}
+
+	output, err := conn.GetTransformerWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, b2bi.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
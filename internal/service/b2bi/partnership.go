@@ -0,0 +1,230 @@
+package b2bi
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/b2bi"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourcePartnership() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePartnershipCreate,
+		ReadWithoutTimeout:   resourcePartnershipRead,
+		UpdateWithoutTimeout: resourcePartnershipUpdate,
+		DeleteWithoutTimeout: resourcePartnershipDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"capabilities": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 64),
+				},
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"email": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(5, 254),
+			},
+			"modified_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 254),
+			},
+			"partnership_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"phone": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(7, 22),
+			},
+			"profile_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"trading_partner_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourcePartnershipCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).B2BIConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &b2bi.CreatePartnershipInput{
+		Capabilities: flex.ExpandStringList(d.Get("capabilities").([]interface{})),
+		Email:        aws.String(d.Get("email").(string)),
+		Name:         aws.String(name),
+		ProfileId:    aws.String(d.Get("profile_id").(string)),
+	}
+
+	if v, ok := d.GetOk("phone"); ok {
+		input.Phone = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating B2BI Partnership: %s", input)
+	output, err := conn.CreatePartnershipWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating B2BI Partnership (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.PartnershipId))
+
+	return resourcePartnershipRead(ctx, d, meta)
+}
+
+func resourcePartnershipRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).B2BIConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindPartnershipByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] B2BI Partnership (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading B2BI Partnership (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.PartnershipArn)
+	d.Set("capabilities", aws.StringValueSlice(output.Capabilities))
+	d.Set("created_at", aws.TimeValue(output.CreatedAt).Format(time.RFC3339))
+	d.Set("email", output.Email)
+	if output.ModifiedAt != nil {
+		d.Set("modified_at", aws.TimeValue(output.ModifiedAt).Format(time.RFC3339))
+	} else {
+		d.Set("modified_at", nil)
+	}
+	d.Set("name", output.Name)
+	d.Set("partnership_id", output.PartnershipId)
+	d.Set("phone", output.Phone)
+	d.Set("profile_id", output.ProfileId)
+	d.Set("trading_partner_id", output.TradingPartnerId)
+
+	tags, err := ListTagsWithContext(ctx, conn, d.Get("arn").(string))
+
+	if err != nil {
+		return diag.Errorf("listing tags for B2BI Partnership (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourcePartnershipUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).B2BIConn
+
+	if d.HasChanges("capabilities", "name") {
+		input := &b2bi.UpdatePartnershipInput{
+			PartnershipId: aws.String(d.Id()),
+		}
+
+		if d.HasChange("capabilities") {
+			input.Capabilities = flex.ExpandStringList(d.Get("capabilities").([]interface{}))
+		}
+
+		if d.HasChange("name") {
+			input.Name = aws.String(d.Get("name").(string))
+		}
+
+		log.Printf("[DEBUG] Updating B2BI Partnership: %s", input)
+		_, err := conn.UpdatePartnershipWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating B2BI Partnership (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating B2BI Partnership (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourcePartnershipRead(ctx, d, meta)
+}
+
+func resourcePartnershipDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).B2BIConn
+
+	log.Printf("[DEBUG] Deleting B2BI Partnership: %s", d.Id())
+	_, err := conn.DeletePartnershipWithContext(ctx, &b2bi.DeletePartnershipInput{
+		PartnershipId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, b2bi.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting B2BI Partnership (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,150 @@
+package b2bi_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/b2bi"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfb2bi "github.com/hashicorp/terraform-provider-aws/internal/service/b2bi"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccB2BIPartnership_basic(t *testing.T) {
+	var v b2bi.GetPartnershipOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_b2bi_partnership.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, b2bi.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPartnershipDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPartnershipConfig_basic(rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPartnershipExists(resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "b2bi", regexp.MustCompile(`partnership/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "capabilities.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "capabilities.0", "aws_b2bi_capability.test", "capability_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+					resource.TestCheckResourceAttr(resourceName, "email", "jane@example.com"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "partnership_id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "profile_id", "aws_b2bi_profile.test", "profile_id"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "trading_partner_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPartnershipConfig_basic(rName, rName+"-updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPartnershipExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", rName+"-updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccB2BIPartnership_disappears(t *testing.T) {
+	var v b2bi.GetPartnershipOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_b2bi_partnership.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, b2bi.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPartnershipDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPartnershipConfig_basic(rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPartnershipExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfb2bi.ResourcePartnership(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPartnershipDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).B2BIConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_b2bi_partnership" {
+			continue
+		}
+
+		_, err := tfb2bi.FindPartnershipByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("B2BI Partnership %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckPartnershipExists(n string, v *b2bi.GetPartnershipOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No B2BI Partnership ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).B2BIConn
+
+		output, err := tfb2bi.FindPartnershipByID(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccPartnershipConfig_basic(rName, partnershipName string) string {
+	return acctest.ConfigCompose(testAccCapabilityConfig_basic(rName, "input/"), fmt.Sprintf(`
+resource "aws_b2bi_profile" "test" {
+  business_name = "Example Corp"
+  logging       = "DISABLED"
+  name          = %[1]q
+  phone         = "5555555555"
+}
+
+resource "aws_b2bi_partnership" "test" {
+  capabilities = [aws_b2bi_capability.test.capability_id]
+  email        = "jane@example.com"
+  name         = %[2]q
+  profile_id   = aws_b2bi_profile.test.profile_id
+}
+`, rName, partnershipName))
+}
@@ -0,0 +1,233 @@
+package b2bi
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/b2bi"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceProfile() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceProfileCreate,
+		ReadWithoutTimeout:   resourceProfileRead,
+		UpdateWithoutTimeout: resourceProfileUpdate,
+		DeleteWithoutTimeout: resourceProfileDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"business_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 254),
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"email": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(5, 254),
+			},
+			"log_group_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"logging": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(b2bi.Logging_Values(), false),
+			},
+			"modified_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 254),
+			},
+			"phone": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(7, 22),
+			},
+			"profile_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceProfileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).B2BIConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &b2bi.CreateProfileInput{
+		BusinessName: aws.String(d.Get("business_name").(string)),
+		Logging:      aws.String(d.Get("logging").(string)),
+		Name:         aws.String(name),
+		Phone:        aws.String(d.Get("phone").(string)),
+	}
+
+	if v, ok := d.GetOk("email"); ok {
+		input.Email = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating B2BI Profile: %s", input)
+	output, err := conn.CreateProfileWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating B2BI Profile (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.ProfileId))
+
+	return resourceProfileRead(ctx, d, meta)
+}
+
+func resourceProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).B2BIConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindProfileByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] B2BI Profile (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading B2BI Profile (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.ProfileArn)
+	d.Set("business_name", output.BusinessName)
+	d.Set("created_at", aws.TimeValue(output.CreatedAt).Format(time.RFC3339))
+	d.Set("email", output.Email)
+	d.Set("log_group_name", output.LogGroupName)
+	d.Set("logging", output.Logging)
+	if output.ModifiedAt != nil {
+		d.Set("modified_at", aws.TimeValue(output.ModifiedAt).Format(time.RFC3339))
+	} else {
+		d.Set("modified_at", nil)
+	}
+	d.Set("name", output.Name)
+	d.Set("phone", output.Phone)
+	d.Set("profile_id", output.ProfileId)
+
+	tags, err := ListTagsWithContext(ctx, conn, d.Get("arn").(string))
+
+	if err != nil {
+		return diag.Errorf("listing tags for B2BI Profile (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceProfileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).B2BIConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &b2bi.UpdateProfileInput{
+			ProfileId: aws.String(d.Id()),
+		}
+
+		if d.HasChange("business_name") {
+			input.BusinessName = aws.String(d.Get("business_name").(string))
+		}
+
+		if d.HasChange("email") {
+			input.Email = aws.String(d.Get("email").(string))
+		}
+
+		if d.HasChange("name") {
+			input.Name = aws.String(d.Get("name").(string))
+		}
+
+		if d.HasChange("phone") {
+			input.Phone = aws.String(d.Get("phone").(string))
+		}
+
+		log.Printf("[DEBUG] Updating B2BI Profile: %s", input)
+		_, err := conn.UpdateProfileWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating B2BI Profile (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating B2BI Profile (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceProfileRead(ctx, d, meta)
+}
+
+func resourceProfileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).B2BIConn
+
+	log.Printf("[DEBUG] Deleting B2BI Profile: %s", d.Id())
+	_, err := conn.DeleteProfileWithContext(ctx, &b2bi.DeleteProfileInput{
+		ProfileId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, b2bi.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting B2BI Profile (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,235 @@
+package b2bi_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/b2bi"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfb2bi "github.com/hashicorp/terraform-provider-aws/internal/service/b2bi"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccB2BIProfile_basic(t *testing.T) {
+	var v b2bi.GetProfileOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_b2bi_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, b2bi.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProfileConfig_basic(rName, "Example Corp"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProfileExists(resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "b2bi", regexp.MustCompile(`profile/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "business_name", "Example Corp"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+					resource.TestCheckResourceAttr(resourceName, "email", "john@example.com"),
+					resource.TestCheckResourceAttrSet(resourceName, "log_group_name"),
+					resource.TestCheckResourceAttr(resourceName, "logging", "ENABLED"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "phone", "5555555555"),
+					resource.TestCheckResourceAttrPair(resourceName, "profile_id", resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccProfileConfig_basic(rName, "Example Corp Updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProfileExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "business_name", "Example Corp Updated"),
+					resource.TestCheckResourceAttrSet(resourceName, "modified_at"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccB2BIProfile_disappears(t *testing.T) {
+	var v b2bi.GetProfileOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_b2bi_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, b2bi.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProfileConfig_basic(rName, "Example Corp"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProfileExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfb2bi.ResourceProfile(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccB2BIProfile_tags(t *testing.T) {
+	var v b2bi.GetProfileOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_b2bi_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, b2bi.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProfileConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProfileExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccProfileConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProfileExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccProfileConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProfileExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckProfileDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).B2BIConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_b2bi_profile" {
+			continue
+		}
+
+		_, err := tfb2bi.FindProfileByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("B2BI Profile %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckProfileExists(n string, v *b2bi.GetProfileOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No B2BI Profile ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).B2BIConn
+
+		output, err := tfb2bi.FindProfileByID(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccPreCheck(t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).B2BIConn
+
+	_, err := conn.ListProfilesWithContext(context.Background(), &b2bi.ListProfilesInput{})
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccProfileConfig_basic(rName, businessName string) string {
+	return fmt.Sprintf(`
+resource "aws_b2bi_profile" "test" {
+  business_name = %[2]q
+  email         = "john@example.com"
+  logging       = "ENABLED"
+  name          = %[1]q
+  phone         = "5555555555"
+}
+`, rName, businessName)
+}
+
+func testAccProfileConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_b2bi_profile" "test" {
+  business_name = "Example Corp"
+  logging       = "DISABLED"
+  name          = %[1]q
+  phone         = "5555555555"
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccProfileConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_b2bi_profile" "test" {
+  business_name = "Example Corp"
+  logging       = "DISABLED"
+  name          = %[1]q
+  phone         = "5555555555"
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
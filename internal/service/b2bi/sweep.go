@@ -0,0 +1,218 @@
+//go:build sweep
+// +build sweep
+
+package b2bi
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/b2bi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_b2bi_capability", &resource.Sweeper{
+		Name: "aws_b2bi_capability",
+		F:    sweepCapabilities,
+		Dependencies: []string{
+			"aws_b2bi_partnership",
+		},
+	})
+
+	resource.AddTestSweepers("aws_b2bi_partnership", &resource.Sweeper{
+		Name: "aws_b2bi_partnership",
+		F:    sweepPartnerships,
+	})
+
+	resource.AddTestSweepers("aws_b2bi_profile", &resource.Sweeper{
+		Name: "aws_b2bi_profile",
+		F:    sweepProfiles,
+		Dependencies: []string{
+			"aws_b2bi_partnership",
+		},
+	})
+
+	resource.AddTestSweepers("aws_b2bi_transformer", &resource.Sweeper{
+		Name: "aws_b2bi_transformer",
+		F:    sweepTransformers,
+		Dependencies: []string{
+			"aws_b2bi_capability",
+		},
+	})
+}
+
+func sweepCapabilities(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).B2BIConn
+	input := &b2bi.ListCapabilitiesInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListCapabilitiesPages(input, func(page *b2bi.ListCapabilitiesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Capabilities {
+			r := ResourceCapability()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.CapabilityId))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping B2BI Capability sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing B2BI Capabilities (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping B2BI Capabilities (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepPartnerships(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).B2BIConn
+	input := &b2bi.ListPartnershipsInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListPartnershipsPages(input, func(page *b2bi.ListPartnershipsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Partnerships {
+			r := ResourcePartnership()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.PartnershipId))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping B2BI Partnership sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing B2BI Partnerships (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping B2BI Partnerships (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepProfiles(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).B2BIConn
+	input := &b2bi.ListProfilesInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListProfilesPages(input, func(page *b2bi.ListProfilesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Profiles {
+			r := ResourceProfile()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.ProfileId))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping B2BI Profile sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing B2BI Profiles (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping B2BI Profiles (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepTransformers(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).B2BIConn
+	input := &b2bi.ListTransformersInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListTransformersPages(input, func(page *b2bi.ListTransformersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Transformers {
+			r := ResourceTransformer()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.TransformerId))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping B2BI Transformer sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing B2BI Transformers (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping B2BI Transformers (%s): %w", region, err)
+	}
+
+	return nil
+}
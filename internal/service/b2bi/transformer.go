@@ -0,0 +1,327 @@
+package b2bi
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/b2bi"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceTransformer() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTransformerCreate,
+		ReadWithoutTimeout:   resourceTransformerRead,
+		UpdateWithoutTimeout: resourceTransformerUpdate,
+		DeleteWithoutTimeout: resourceTransformerDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"edi_type": ediTypeSchema(),
+			"file_format": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(b2bi.FileFormat_Values(), false),
+			},
+			"mapping_template": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(0, 350000),
+			},
+			"modified_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 254),
+			},
+			"sample_document": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(b2bi.TransformerStatus_Values(), false),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"transformer_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceTransformerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).B2BIConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &b2bi.CreateTransformerInput{
+		EdiType:         expandEDIType(d.Get("edi_type").([]interface{})),
+		FileFormat:      aws.String(d.Get("file_format").(string)),
+		MappingTemplate: aws.String(d.Get("mapping_template").(string)),
+		Name:            aws.String(name),
+	}
+
+	if v, ok := d.GetOk("sample_document"); ok {
+		input.SampleDocument = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating B2BI Transformer: %s", input)
+	output, err := conn.CreateTransformerWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating B2BI Transformer (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.TransformerId))
+
+	// Transformers are created inactive.
+	if v, ok := d.GetOk("status"); ok && v.(string) != aws.StringValue(output.Status) {
+		input := &b2bi.UpdateTransformerInput{
+			Status:        aws.String(v.(string)),
+			TransformerId: aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating B2BI Transformer: %s", input)
+		_, err := conn.UpdateTransformerWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("setting B2BI Transformer (%s) status: %s", d.Id(), err)
+		}
+	}
+
+	return resourceTransformerRead(ctx, d, meta)
+}
+
+func resourceTransformerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).B2BIConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindTransformerByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] B2BI Transformer (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading B2BI Transformer (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.TransformerArn)
+	d.Set("created_at", aws.TimeValue(output.CreatedAt).Format(time.RFC3339))
+	if err := d.Set("edi_type", flattenEDIType(output.EdiType)); err != nil {
+		return diag.Errorf("setting edi_type: %s", err)
+	}
+	d.Set("file_format", output.FileFormat)
+	d.Set("mapping_template", output.MappingTemplate)
+	if output.ModifiedAt != nil {
+		d.Set("modified_at", aws.TimeValue(output.ModifiedAt).Format(time.RFC3339))
+	} else {
+		d.Set("modified_at", nil)
+	}
+	d.Set("name", output.Name)
+	d.Set("sample_document", output.SampleDocument)
+	d.Set("status", output.Status)
+	d.Set("transformer_id", output.TransformerId)
+
+	tags, err := ListTagsWithContext(ctx, conn, d.Get("arn").(string))
+
+	if err != nil {
+		return diag.Errorf("listing tags for B2BI Transformer (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceTransformerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).B2BIConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &b2bi.UpdateTransformerInput{
+			TransformerId: aws.String(d.Id()),
+		}
+
+		if d.HasChange("edi_type") {
+			input.EdiType = expandEDIType(d.Get("edi_type").([]interface{}))
+		}
+
+		if d.HasChange("file_format") {
+			input.FileFormat = aws.String(d.Get("file_format").(string))
+		}
+
+		if d.HasChange("mapping_template") {
+			input.MappingTemplate = aws.String(d.Get("mapping_template").(string))
+		}
+
+		if d.HasChange("name") {
+			input.Name = aws.String(d.Get("name").(string))
+		}
+
+		if d.HasChange("sample_document") {
+			input.SampleDocument = aws.String(d.Get("sample_document").(string))
+		}
+
+		if d.HasChange("status") {
+			input.Status = aws.String(d.Get("status").(string))
+		}
+
+		log.Printf("[DEBUG] Updating B2BI Transformer: %s", input)
+		_, err := conn.UpdateTransformerWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating B2BI Transformer (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating B2BI Transformer (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceTransformerRead(ctx, d, meta)
+}
+
+func resourceTransformerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).B2BIConn
+
+	log.Printf("[DEBUG] Deleting B2BI Transformer: %s", d.Id())
+	_, err := conn.DeleteTransformerWithContext(ctx, &b2bi.DeleteTransformerInput{
+		TransformerId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, b2bi.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting B2BI Transformer (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func ediTypeSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"x12_details": {
+					Type:     schema.TypeList,
+					Required: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"transaction_set": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringInSlice(b2bi.X12TransactionSet_Values(), false),
+							},
+							"version": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringInSlice(b2bi.X12Version_Values(), false),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandEDIType(tfList []interface{}) *b2bi.EdiType {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &b2bi.EdiType{}
+
+	if v, ok := tfMap["x12_details"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		x12Details := &b2bi.X12Details{}
+
+		if v, ok := tfMap["transaction_set"].(string); ok && v != "" {
+			x12Details.TransactionSet = aws.String(v)
+		}
+
+		if v, ok := tfMap["version"].(string); ok && v != "" {
+			x12Details.Version = aws.String(v)
+		}
+
+		apiObject.X12Details = x12Details
+	}
+
+	return apiObject
+}
+
+func flattenEDIType(apiObject *b2bi.EdiType) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.X12Details; v != nil {
+		tfMap["x12_details"] = []interface{}{map[string]interface{}{
+			"transaction_set": aws.StringValue(v.TransactionSet),
+			"version":         aws.StringValue(v.Version),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
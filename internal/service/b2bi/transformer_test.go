@@ -0,0 +1,152 @@
+package b2bi_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/b2bi"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfb2bi "github.com/hashicorp/terraform-provider-aws/internal/service/b2bi"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccB2BITransformer_basic(t *testing.T) {
+	var v b2bi.GetTransformerOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_b2bi_transformer.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, b2bi.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTransformerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransformerConfig_basic(rName, "inactive"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransformerExists(resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "b2bi", regexp.MustCompile(`transformer/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+					resource.TestCheckResourceAttr(resourceName, "edi_type.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "edi_type.0.x12_details.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "edi_type.0.x12_details.0.transaction_set", "X12_110"),
+					resource.TestCheckResourceAttr(resourceName, "edi_type.0.x12_details.0.version", "VERSION_4010"),
+					resource.TestCheckResourceAttr(resourceName, "file_format", "JSON"),
+					resource.TestCheckResourceAttr(resourceName, "mapping_template", "$"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "status", "inactive"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "transformer_id", resourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTransformerConfig_basic(rName, "active"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransformerExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "status", "active"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccB2BITransformer_disappears(t *testing.T) {
+	var v b2bi.GetTransformerOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_b2bi_transformer.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, b2bi.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTransformerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransformerConfig_basic(rName, "inactive"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransformerExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfb2bi.ResourceTransformer(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckTransformerDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).B2BIConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_b2bi_transformer" {
+			continue
+		}
+
+		_, err := tfb2bi.FindTransformerByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("B2BI Transformer %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckTransformerExists(n string, v *b2bi.GetTransformerOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No B2BI Transformer ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).B2BIConn
+
+		output, err := tfb2bi.FindTransformerByID(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccTransformerConfig_basic(rName, status string) string {
+	return fmt.Sprintf(`
+resource "aws_b2bi_transformer" "test" {
+  file_format      = "JSON"
+  mapping_template = "$"
+  name             = %[1]q
+  status           = %[2]q
+
+  edi_type {
+    x12_details {
+      transaction_set = "X12_110"
+      version         = "VERSION_4010"
+    }
+  }
+}
+`, rName, status)
+}
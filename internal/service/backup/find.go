@@ -1,6 +1,9 @@
 package backup
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/backup"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
@@ -57,3 +60,86 @@ func FindVaultByName(conn *backup.Backup, name string) (*backup.DescribeBackupVa
 
 	return output, nil
 }
+
+func FindLogicallyAirGappedVaultByName(ctx context.Context, conn *backup.Backup, name string) (*backup.DescribeBackupVaultOutput, error) {
+	input := &backup.DescribeBackupVaultInput{
+		BackupVaultName: aws.String(name),
+	}
+
+	output, err := conn.DescribeBackupVaultWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, backup.ErrCodeResourceNotFoundException) || tfawserr.ErrCodeEquals(err, errCodeAccessDeniedException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if vaultType := aws.StringValue(output.VaultType); vaultType != backup.VaultTypeLogicallyAirGappedBackupVault {
+		return nil, &resource.NotFoundError{
+			Message:     fmt.Sprintf("Backup Vault (%s) is of type %s", name, vaultType),
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func FindRestoreTestingPlanByName(ctx context.Context, conn *backup.Backup, name string) (*backup.RestoreTestingPlanForGet, error) {
+	input := &backup.GetRestoreTestingPlanInput{
+		RestoreTestingPlanName: aws.String(name),
+	}
+
+	output, err := conn.GetRestoreTestingPlanWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, backup.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.RestoreTestingPlan == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.RestoreTestingPlan, nil
+}
+
+func FindRestoreTestingSelectionByTwoPartKey(ctx context.Context, conn *backup.Backup, planName, selectionName string) (*backup.RestoreTestingSelectionForGet, error) {
+	input := &backup.GetRestoreTestingSelectionInput{
+		RestoreTestingPlanName:      aws.String(planName),
+		RestoreTestingSelectionName: aws.String(selectionName),
+	}
+
+	output, err := conn.GetRestoreTestingSelectionWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, backup.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.RestoreTestingSelection == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.RestoreTestingSelection, nil
+}
@@ -0,0 +1,166 @@
+package backup
+
+import (
+	"context"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/backup"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceLogicallyAirGappedVault() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceLogicallyAirGappedVaultCreate,
+		ReadWithoutTimeout:   resourceLogicallyAirGappedVaultRead,
+		UpdateWithoutTimeout: resourceLogicallyAirGappedVaultUpdate,
+		DeleteWithoutTimeout: resourceLogicallyAirGappedVaultDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"max_retention_days": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"min_retention_days": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(7),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9\-\_]{2,50}$`), "must consist of letters, numbers, hyphens and underscores."),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceLogicallyAirGappedVaultCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).BackupConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &backup.CreateLogicallyAirGappedBackupVaultInput{
+		BackupVaultName:  aws.String(name),
+		MaxRetentionDays: aws.Int64(int64(d.Get("max_retention_days").(int))),
+		MinRetentionDays: aws.Int64(int64(d.Get("min_retention_days").(int))),
+	}
+
+	if len(tags) > 0 {
+		input.BackupVaultTags = Tags(tags.IgnoreAWS())
+	}
+
+	_, err := conn.CreateLogicallyAirGappedBackupVaultWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Backup Logically Air Gapped Vault (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceLogicallyAirGappedVaultRead(ctx, d, meta)
+}
+
+func resourceLogicallyAirGappedVaultRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).BackupConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	// The vault is created asynchronously.
+	outputRaw, err := tfresource.RetryWhenNewResourceNotFoundContext(ctx, propagationTimeout, func() (interface{}, error) {
+		return FindLogicallyAirGappedVaultByName(ctx, conn, d.Id())
+	}, d.IsNewResource())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Backup Logically Air Gapped Vault (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Backup Logically Air Gapped Vault (%s): %s", d.Id(), err)
+	}
+
+	output := outputRaw.(*backup.DescribeBackupVaultOutput)
+
+	d.Set("arn", output.BackupVaultArn)
+	d.Set("max_retention_days", output.MaxRetentionDays)
+	d.Set("min_retention_days", output.MinRetentionDays)
+	d.Set("name", output.BackupVaultName)
+
+	tags, err := ListTagsWithContext(ctx, conn, d.Get("arn").(string))
+
+	if err != nil {
+		return diag.Errorf("listing tags for Backup Logically Air Gapped Vault (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceLogicallyAirGappedVaultUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).BackupConn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+		if err := UpdateTagsWithContext(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating tags for Backup Logically Air Gapped Vault (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceLogicallyAirGappedVaultRead(ctx, d, meta)
+}
+
+func resourceLogicallyAirGappedVaultDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).BackupConn
+
+	log.Printf("[DEBUG] Deleting Backup Logically Air Gapped Vault: %s", d.Id())
+	_, err := conn.DeleteBackupVaultWithContext(ctx, &backup.DeleteBackupVaultInput{
+		BackupVaultName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, backup.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Backup Logically Air Gapped Vault (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,240 @@
+package backup_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/backup"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfbackup "github.com/hashicorp/terraform-provider-aws/internal/service/backup"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccBackupLogicallyAirGappedVault_basic(t *testing.T) {
+	var v backup.DescribeBackupVaultOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_backup_logically_air_gapped_vault.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, backup.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLogicallyAirGappedVaultDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLogicallyAirGappedVaultConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLogicallyAirGappedVaultExists(resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "backup", regexp.MustCompile(`backup-vault:.+`)),
+					resource.TestCheckResourceAttr(resourceName, "max_retention_days", "10"),
+					resource.TestCheckResourceAttr(resourceName, "min_retention_days", "7"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccBackupLogicallyAirGappedVault_disappears(t *testing.T) {
+	var v backup.DescribeBackupVaultOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_backup_logically_air_gapped_vault.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, backup.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLogicallyAirGappedVaultDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLogicallyAirGappedVaultConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLogicallyAirGappedVaultExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfbackup.ResourceLogicallyAirGappedVault(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccBackupLogicallyAirGappedVault_tags(t *testing.T) {
+	var v backup.DescribeBackupVaultOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_backup_logically_air_gapped_vault.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, backup.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLogicallyAirGappedVaultDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLogicallyAirGappedVaultConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLogicallyAirGappedVaultExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccLogicallyAirGappedVaultConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLogicallyAirGappedVaultExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccLogicallyAirGappedVaultConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLogicallyAirGappedVaultExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBackupLogicallyAirGappedVault_ramShare(t *testing.T) {
+	var v backup.DescribeBackupVaultOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_backup_logically_air_gapped_vault.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, backup.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLogicallyAirGappedVaultDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLogicallyAirGappedVaultConfig_ramShare(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLogicallyAirGappedVaultExists(resourceName, &v),
+					resource.TestCheckResourceAttrPair("aws_ram_resource_association.test", "resource_arn", resourceName, "arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLogicallyAirGappedVaultExists(n string, v *backup.DescribeBackupVaultOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Backup Logically Air Gapped Vault ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BackupConn
+
+		output, err := tfbackup.FindLogicallyAirGappedVaultByName(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckLogicallyAirGappedVaultDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).BackupConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_backup_logically_air_gapped_vault" {
+			continue
+		}
+
+		_, err := tfbackup.FindLogicallyAirGappedVaultByName(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Backup Logically Air Gapped Vault %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccLogicallyAirGappedVaultConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_backup_logically_air_gapped_vault" "test" {
+  name               = %[1]q
+  max_retention_days = 10
+  min_retention_days = 7
+}
+`, rName)
+}
+
+func testAccLogicallyAirGappedVaultConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_backup_logically_air_gapped_vault" "test" {
+  name               = %[1]q
+  max_retention_days = 10
+  min_retention_days = 7
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccLogicallyAirGappedVaultConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_backup_logically_air_gapped_vault" "test" {
+  name               = %[1]q
+  max_retention_days = 10
+  min_retention_days = 7
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
+
+func testAccLogicallyAirGappedVaultConfig_ramShare(rName string) string {
+	return acctest.ConfigCompose(testAccLogicallyAirGappedVaultConfig_basic(rName), fmt.Sprintf(`
+resource "aws_ram_resource_share" "test" {
+  name                      = %[1]q
+  allow_external_principals = true
+}
+
+resource "aws_ram_resource_association" "test" {
+  resource_arn       = aws_backup_logically_air_gapped_vault.test.arn
+  resource_share_arn = aws_ram_resource_share.test.arn
+}
+`, rName))
+}
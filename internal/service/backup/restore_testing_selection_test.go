@@ -0,0 +1,241 @@
+package backup_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/backup"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfbackup "github.com/hashicorp/terraform-provider-aws/internal/service/backup"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccBackupRestoreTestingSelection_basic(t *testing.T) {
+	var v backup.RestoreTestingSelectionForGet
+	rName := fmt.Sprintf("tf_acc_test_%s", sdkacctest.RandString(7))
+	resourceName := "aws_backup_restore_testing_selection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, backup.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRestoreTestingSelectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRestoreTestingSelectionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRestoreTestingSelectionExists(resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "iam_role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "protected_resource_arns.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "protected_resource_arns.*", "*"),
+					resource.TestCheckResourceAttr(resourceName, "protected_resource_type", "EBS"),
+					resource.TestCheckResourceAttrPair(resourceName, "restore_testing_plan_name", "aws_backup_restore_testing_plan.test", "name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccBackupRestoreTestingSelection_disappears(t *testing.T) {
+	var v backup.RestoreTestingSelectionForGet
+	rName := fmt.Sprintf("tf_acc_test_%s", sdkacctest.RandString(7))
+	resourceName := "aws_backup_restore_testing_selection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, backup.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRestoreTestingSelectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRestoreTestingSelectionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRestoreTestingSelectionExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfbackup.ResourceRestoreTestingSelection(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccBackupRestoreTestingSelection_conditions(t *testing.T) {
+	var v backup.RestoreTestingSelectionForGet
+	rName := fmt.Sprintf("tf_acc_test_%s", sdkacctest.RandString(7))
+	resourceName := "aws_backup_restore_testing_selection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, backup.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRestoreTestingSelectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRestoreTestingSelectionConfig_conditions(rName, 24),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRestoreTestingSelectionExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "protected_resource_conditions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "protected_resource_conditions.0.string_equals.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "protected_resource_conditions.0.string_equals.0.key", "aws:ResourceTag/backup"),
+					resource.TestCheckResourceAttr(resourceName, "protected_resource_conditions.0.string_equals.0.value", "restore-test"),
+					resource.TestCheckResourceAttr(resourceName, "protected_resource_conditions.0.string_not_equals.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "restore_metadata_overrides.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "restore_metadata_overrides.availabilityZone", acctest.Region()+"a"),
+					resource.TestCheckResourceAttr(resourceName, "validation_window_hours", "24"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRestoreTestingSelectionConfig_conditions(rName, 48),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRestoreTestingSelectionExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "validation_window_hours", "48"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckRestoreTestingSelectionExists(n string, v *backup.RestoreTestingSelectionForGet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Backup Restore Testing Selection ID is set")
+		}
+
+		planName, name, err := tfbackup.RestoreTestingSelectionParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BackupConn
+
+		output, err := tfbackup.FindRestoreTestingSelectionByTwoPartKey(context.Background(), conn, planName, name)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckRestoreTestingSelectionDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).BackupConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_backup_restore_testing_selection" {
+			continue
+		}
+
+		planName, name, err := tfbackup.RestoreTestingSelectionParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfbackup.FindRestoreTestingSelectionByTwoPartKey(context.Background(), conn, planName, name)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Backup Restore Testing Selection %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccRestoreTestingSelectionConfig_base(rName string) string {
+	return acctest.ConfigCompose(testAccRestoreTestingPlanConfig_basic(rName), fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "backup.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/service-role/AWSBackupServiceRolePolicyForRestores"
+  role       = aws_iam_role.test.name
+}
+`, rName))
+}
+
+func testAccRestoreTestingSelectionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccRestoreTestingSelectionConfig_base(rName), fmt.Sprintf(`
+resource "aws_backup_restore_testing_selection" "test" {
+  name                      = %[1]q
+  restore_testing_plan_name = aws_backup_restore_testing_plan.test.name
+  protected_resource_type   = "EBS"
+  iam_role_arn              = aws_iam_role.test.arn
+  protected_resource_arns   = ["*"]
+}
+`, rName))
+}
+
+func testAccRestoreTestingSelectionConfig_conditions(rName string, validationWindowHours int) string {
+	return acctest.ConfigCompose(testAccRestoreTestingSelectionConfig_base(rName), fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_backup_restore_testing_selection" "test" {
+  name                      = %[1]q
+  restore_testing_plan_name = aws_backup_restore_testing_plan.test.name
+  protected_resource_type   = "EBS"
+  iam_role_arn              = aws_iam_role.test.arn
+  validation_window_hours   = %[2]d
+
+  protected_resource_conditions {
+    string_equals {
+      key   = "aws:ResourceTag/backup"
+      value = "restore-test"
+    }
+
+    string_not_equals {
+      key   = "aws:ResourceTag/environment"
+      value = "production"
+    }
+  }
+
+  restore_metadata_overrides = {
+    availabilityZone = "${data.aws_region.current.name}a"
+  }
+}
+`, rName, validationWindowHours))
+}
@@ -21,3 +21,11 @@ func validFrameworkName(v interface{}, k string) (ws []string, errors []error) {
 	}
 	return
 }
+
+func validRestoreTestingName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if !regexp.MustCompile(`^[a-zA-Z][_a-zA-Z0-9]{0,49}$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q (%q) must be between 1 and 50 characters, starting with a letter, and consisting of letters, numbers, and underscores.", k, v))
+	}
+	return
+}
@@ -50,3 +50,29 @@ func TestValidFrameworkName(t *testing.T) {
 		}
 	}
 }
+
+func TestValidRestoreTestingName(t *testing.T) {
+	validNames := []string{
+		"test_plan_1",
+		strings.Repeat("W", 50), // <= 50
+	}
+	for _, v := range validNames {
+		_, errors := validRestoreTestingName(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Backup Restore Testing name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"@error",
+		"1test",
+		"test-plan",
+		strings.Repeat("W", 51), // >= 51
+	}
+	for _, v := range invalidNames {
+		_, errors := validRestoreTestingName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be a invalid Backup Restore Testing name: %q", v, errors)
+		}
+	}
+}
@@ -0,0 +1,36 @@
+package chatbot_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/chatbot"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func testAccPreCheck(t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ChatbotConn
+
+	_, err := conn.DescribeChimeWebhookConfigurations(&chatbot.DescribeChimeWebhookConfigurationsInput{})
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+// Chat channel configurations can only be created for workspaces and teams
+// that have already been authorized in the Chatbot console.
+func testAccEnvVarValue(t *testing.T, key string) string {
+	v := os.Getenv(key)
+
+	if v == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+
+	return v
+}
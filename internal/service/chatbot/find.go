@@ -0,0 +1,93 @@
+package chatbot
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/chatbot"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindSlackChannelConfigurationByARN(ctx context.Context, conn *chatbot.Chatbot, arn string) (*chatbot.SlackChannelConfiguration, error) {
+	input := &chatbot.DescribeSlackChannelConfigurationsInput{
+		ChatConfigurationArn: aws.String(arn),
+	}
+	var output []*chatbot.SlackChannelConfiguration
+
+	err := conn.DescribeSlackChannelConfigurationsPagesWithContext(ctx, input, func(page *chatbot.DescribeSlackChannelConfigurationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.SlackChannelConfigurations {
+			if v != nil && aws.StringValue(v.ChatConfigurationArn) == arn {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, chatbot.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
+
+func FindTeamsChannelConfigurationByARN(ctx context.Context, conn *chatbot.Chatbot, arn string) (*chatbot.TeamsChannelConfiguration, error) {
+	input := &chatbot.ListMicrosoftTeamsChannelConfigurationsInput{}
+	var output []*chatbot.TeamsChannelConfiguration
+
+	err := conn.ListMicrosoftTeamsChannelConfigurationsPagesWithContext(ctx, input, func(page *chatbot.ListMicrosoftTeamsChannelConfigurationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.TeamChannelConfigurations {
+			if v != nil && aws.StringValue(v.ChatConfigurationArn) == arn {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, chatbot.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
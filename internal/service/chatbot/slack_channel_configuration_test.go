@@ -0,0 +1,269 @@
+package chatbot_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/chatbot"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfchatbot "github.com/hashicorp/terraform-provider-aws/internal/service/chatbot"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccChatbotSlackChannelConfiguration_basic(t *testing.T) {
+	var v chatbot.SlackChannelConfiguration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_chatbot_slack_channel_configuration.test"
+	teamID := testAccEnvVarValue(t, "CHATBOT_SLACK_TEAM_ID")
+	channelID := testAccEnvVarValue(t, "CHATBOT_SLACK_CHANNEL_ID")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, chatbot.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSlackChannelConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSlackChannelConfigurationConfig_basic(rName, teamID, channelID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSlackChannelConfigurationExists(resourceName, &v),
+					acctest.MatchResourceAttrGlobalARN(resourceName, "chat_configuration_arn", "chatbot", regexp.MustCompile(`chat-configuration/slack-channel/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "configuration_name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "iam_role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "logging_level", "NONE"),
+					resource.TestCheckResourceAttr(resourceName, "slack_channel_id", channelID),
+					resource.TestCheckResourceAttrSet(resourceName, "slack_channel_name"),
+					resource.TestCheckResourceAttr(resourceName, "slack_team_id", teamID),
+					resource.TestCheckResourceAttrSet(resourceName, "slack_team_name"),
+					resource.TestCheckResourceAttr(resourceName, "sns_topic_arns.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSlackChannelConfigurationConfig_updated(rName, teamID, channelID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSlackChannelConfigurationExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "guardrail_policy_arns.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "logging_level", "ERROR"),
+					resource.TestCheckResourceAttr(resourceName, "sns_topic_arns.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "sns_topic_arns.*", "aws_sns_topic.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "user_authorization_required", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccChatbotSlackChannelConfiguration_disappears(t *testing.T) {
+	var v chatbot.SlackChannelConfiguration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_chatbot_slack_channel_configuration.test"
+	teamID := testAccEnvVarValue(t, "CHATBOT_SLACK_TEAM_ID")
+	channelID := testAccEnvVarValue(t, "CHATBOT_SLACK_CHANNEL_ID")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, chatbot.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSlackChannelConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSlackChannelConfigurationConfig_basic(rName, teamID, channelID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSlackChannelConfigurationExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfchatbot.ResourceSlackChannelConfiguration(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccChatbotSlackChannelConfiguration_tags(t *testing.T) {
+	var v chatbot.SlackChannelConfiguration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_chatbot_slack_channel_configuration.test"
+	teamID := testAccEnvVarValue(t, "CHATBOT_SLACK_TEAM_ID")
+	channelID := testAccEnvVarValue(t, "CHATBOT_SLACK_CHANNEL_ID")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, chatbot.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSlackChannelConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSlackChannelConfigurationConfig_tags1(rName, teamID, channelID, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSlackChannelConfigurationExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSlackChannelConfigurationConfig_tags2(rName, teamID, channelID, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSlackChannelConfigurationExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccSlackChannelConfigurationConfig_tags1(rName, teamID, channelID, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSlackChannelConfigurationExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSlackChannelConfigurationExists(n string, v *chatbot.SlackChannelConfiguration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Chatbot Slack Channel Configuration ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ChatbotConn
+
+		output, err := tfchatbot.FindSlackChannelConfigurationByARN(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckSlackChannelConfigurationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ChatbotConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_chatbot_slack_channel_configuration" {
+			continue
+		}
+
+		_, err := tfchatbot.FindSlackChannelConfigurationByARN(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Chatbot Slack Channel Configuration %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccChannelConfigurationConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "chatbot.amazonaws.com"
+      }
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccSlackChannelConfigurationConfig_basic(rName, teamID, channelID string) string {
+	return acctest.ConfigCompose(testAccChannelConfigurationConfig_base(rName), fmt.Sprintf(`
+resource "aws_chatbot_slack_channel_configuration" "test" {
+  configuration_name = %[1]q
+  iam_role_arn       = aws_iam_role.test.arn
+  slack_channel_id   = %[3]q
+  slack_team_id      = %[2]q
+}
+`, rName, teamID, channelID))
+}
+
+func testAccSlackChannelConfigurationConfig_updated(rName, teamID, channelID string) string {
+	return acctest.ConfigCompose(testAccChannelConfigurationConfig_base(rName), fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_chatbot_slack_channel_configuration" "test" {
+  configuration_name          = %[1]q
+  guardrail_policy_arns       = ["arn:${data.aws_partition.current.partition}:iam::aws:policy/ReadOnlyAccess"]
+  iam_role_arn                = aws_iam_role.test.arn
+  logging_level               = "ERROR"
+  slack_channel_id            = %[3]q
+  slack_team_id               = %[2]q
+  sns_topic_arns              = [aws_sns_topic.test.arn]
+  user_authorization_required = true
+}
+`, rName, teamID, channelID))
+}
+
+func testAccSlackChannelConfigurationConfig_tags1(rName, teamID, channelID, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccChannelConfigurationConfig_base(rName), fmt.Sprintf(`
+resource "aws_chatbot_slack_channel_configuration" "test" {
+  configuration_name = %[1]q
+  iam_role_arn       = aws_iam_role.test.arn
+  slack_channel_id   = %[3]q
+  slack_team_id      = %[2]q
+
+  tags = {
+    %[4]q = %[5]q
+  }
+}
+`, rName, teamID, channelID, tagKey1, tagValue1))
+}
+
+func testAccSlackChannelConfigurationConfig_tags2(rName, teamID, channelID, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccChannelConfigurationConfig_base(rName), fmt.Sprintf(`
+resource "aws_chatbot_slack_channel_configuration" "test" {
+  configuration_name = %[1]q
+  iam_role_arn       = aws_iam_role.test.arn
+  slack_channel_id   = %[3]q
+  slack_team_id      = %[2]q
+
+  tags = {
+    %[4]q = %[5]q
+    %[6]q = %[7]q
+  }
+}
+`, rName, teamID, channelID, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,113 @@
+//go:build sweep
+// +build sweep
+
+package chatbot
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/chatbot"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_chatbot_slack_channel_configuration", &resource.Sweeper{
+		Name: "aws_chatbot_slack_channel_configuration",
+		F:    sweepSlackChannelConfigurations,
+	})
+
+	resource.AddTestSweepers("aws_chatbot_teams_channel_configuration", &resource.Sweeper{
+		Name: "aws_chatbot_teams_channel_configuration",
+		F:    sweepTeamsChannelConfigurations,
+	})
+}
+
+func sweepSlackChannelConfigurations(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).ChatbotConn
+	input := &chatbot.DescribeSlackChannelConfigurationsInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.DescribeSlackChannelConfigurationsPages(input, func(page *chatbot.DescribeSlackChannelConfigurationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.SlackChannelConfigurations {
+			r := ResourceSlackChannelConfiguration()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.ChatConfigurationArn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Chatbot Slack Channel Configuration sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Chatbot Slack Channel Configurations (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Chatbot Slack Channel Configurations (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepTeamsChannelConfigurations(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).ChatbotConn
+	input := &chatbot.ListMicrosoftTeamsChannelConfigurationsInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListMicrosoftTeamsChannelConfigurationsPages(input, func(page *chatbot.ListMicrosoftTeamsChannelConfigurationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.TeamChannelConfigurations {
+			r := ResourceTeamsChannelConfiguration()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.ChatConfigurationArn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Chatbot Microsoft Teams Channel Configuration sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Chatbot Microsoft Teams Channel Configurations (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Chatbot Microsoft Teams Channel Configurations (%s): %w", region, err)
+	}
+
+	return nil
+}
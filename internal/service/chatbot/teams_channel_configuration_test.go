@@ -0,0 +1,172 @@
+package chatbot_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/chatbot"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfchatbot "github.com/hashicorp/terraform-provider-aws/internal/service/chatbot"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccChatbotTeamsChannelConfiguration_basic(t *testing.T) {
+	var v chatbot.TeamsChannelConfiguration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_chatbot_teams_channel_configuration.test"
+	tenantID := testAccEnvVarValue(t, "CHATBOT_TEAMS_TENANT_ID")
+	teamID := testAccEnvVarValue(t, "CHATBOT_TEAMS_TEAM_ID")
+	channelID := testAccEnvVarValue(t, "CHATBOT_TEAMS_CHANNEL_ID")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, chatbot.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTeamsChannelConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeamsChannelConfigurationConfig_basic(rName, tenantID, teamID, channelID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTeamsChannelConfigurationExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "channel_id", channelID),
+					acctest.MatchResourceAttrGlobalARN(resourceName, "chat_configuration_arn", "chatbot", regexp.MustCompile(`chat-configuration/microsoft-teams-channel/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "configuration_name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "iam_role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "logging_level", "NONE"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "team_id", teamID),
+					resource.TestCheckResourceAttr(resourceName, "tenant_id", tenantID),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTeamsChannelConfigurationConfig_updated(rName, tenantID, teamID, channelID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTeamsChannelConfigurationExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "guardrail_policy_arns.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "logging_level", "INFO"),
+					resource.TestCheckResourceAttr(resourceName, "sns_topic_arns.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "sns_topic_arns.*", "aws_sns_topic.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "user_authorization_required", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccChatbotTeamsChannelConfiguration_disappears(t *testing.T) {
+	var v chatbot.TeamsChannelConfiguration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_chatbot_teams_channel_configuration.test"
+	tenantID := testAccEnvVarValue(t, "CHATBOT_TEAMS_TENANT_ID")
+	teamID := testAccEnvVarValue(t, "CHATBOT_TEAMS_TEAM_ID")
+	channelID := testAccEnvVarValue(t, "CHATBOT_TEAMS_CHANNEL_ID")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, chatbot.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTeamsChannelConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeamsChannelConfigurationConfig_basic(rName, tenantID, teamID, channelID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTeamsChannelConfigurationExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfchatbot.ResourceTeamsChannelConfiguration(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckTeamsChannelConfigurationExists(n string, v *chatbot.TeamsChannelConfiguration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Chatbot Microsoft Teams Channel Configuration ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ChatbotConn
+
+		output, err := tfchatbot.FindTeamsChannelConfigurationByARN(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckTeamsChannelConfigurationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ChatbotConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_chatbot_teams_channel_configuration" {
+			continue
+		}
+
+		_, err := tfchatbot.FindTeamsChannelConfigurationByARN(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Chatbot Microsoft Teams Channel Configuration %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccTeamsChannelConfigurationConfig_basic(rName, tenantID, teamID, channelID string) string {
+	return acctest.ConfigCompose(testAccChannelConfigurationConfig_base(rName), fmt.Sprintf(`
+resource "aws_chatbot_teams_channel_configuration" "test" {
+  channel_id         = %[4]q
+  configuration_name = %[1]q
+  iam_role_arn       = aws_iam_role.test.arn
+  team_id            = %[3]q
+  tenant_id          = %[2]q
+}
+`, rName, tenantID, teamID, channelID))
+}
+
+func testAccTeamsChannelConfigurationConfig_updated(rName, tenantID, teamID, channelID string) string {
+	return acctest.ConfigCompose(testAccChannelConfigurationConfig_base(rName), fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_chatbot_teams_channel_configuration" "test" {
+  channel_id                  = %[4]q
+  configuration_name          = %[1]q
+  guardrail_policy_arns       = ["arn:${data.aws_partition.current.partition}:iam::aws:policy/ReadOnlyAccess"]
+  iam_role_arn                = aws_iam_role.test.arn
+  logging_level               = "INFO"
+  sns_topic_arns              = [aws_sns_topic.test.arn]
+  team_id                     = %[3]q
+  tenant_id                   = %[2]q
+  user_authorization_required = true
+}
+`, rName, tenantID, teamID, channelID))
+}
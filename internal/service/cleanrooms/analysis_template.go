@@ -0,0 +1,341 @@
+package cleanrooms
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cleanrooms"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceAnalysisTemplate() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAnalysisTemplateCreate,
+		ReadWithoutTimeout:   resourceAnalysisTemplateRead,
+		UpdateWithoutTimeout: resourceAnalysisTemplateUpdate,
+		DeleteWithoutTimeout: resourceAnalysisTemplateDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"analysis_parameter": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 10,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"default_value": {
+							Type:      schema.TypeString,
+							Optional:  true,
+							ForceNew:  true,
+							Sensitive: true,
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 100),
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(cleanrooms.ParameterType_Values(), false),
+						},
+					},
+				},
+			},
+			"analysis_template_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"collaboration_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"collaboration_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      cleanrooms.AnalysisFormatSql,
+				ValidateFunc: validation.StringInSlice(cleanrooms.AnalysisFormat_Values(), false),
+			},
+			"membership_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"membership_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"referenced_tables": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"source": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(1, 90000),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAnalysisTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CleanRoomsConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	membershipID := d.Get("membership_id").(string)
+	name := d.Get("name").(string)
+	input := &cleanrooms.CreateAnalysisTemplateInput{
+		Format:               aws.String(d.Get("format").(string)),
+		MembershipIdentifier: aws.String(membershipID),
+		Name:                 aws.String(name),
+		Source: &cleanrooms.AnalysisSource{
+			Text: aws.String(d.Get("source").(string)),
+		},
+	}
+
+	if v, ok := d.GetOk("analysis_parameter"); ok && len(v.([]interface{})) > 0 {
+		input.AnalysisParameters = expandAnalysisParameters(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Clean Rooms Analysis Template: %s", input)
+	output, err := conn.CreateAnalysisTemplateWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Clean Rooms Analysis Template (%s): %s", name, err)
+	}
+
+	d.SetId(createResourceID(membershipID, aws.StringValue(output.AnalysisTemplate.Id)))
+
+	return resourceAnalysisTemplateRead(ctx, d, meta)
+}
+
+func resourceAnalysisTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CleanRoomsConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	membershipID, templateID, err := parseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	template, err := FindAnalysisTemplateByTwoPartKey(ctx, conn, membershipID, templateID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Clean Rooms Analysis Template (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Clean Rooms Analysis Template (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("analysis_parameter", flattenAnalysisParameters(template.AnalysisParameters)); err != nil {
+		return diag.Errorf("setting analysis_parameter: %s", err)
+	}
+	d.Set("analysis_template_id", template.Id)
+	d.Set("arn", template.Arn)
+	d.Set("collaboration_arn", template.CollaborationArn)
+	d.Set("collaboration_id", template.CollaborationId)
+	d.Set("create_time", aws.TimeValue(template.CreateTime).Format(time.RFC3339))
+	d.Set("description", template.Description)
+	d.Set("format", template.Format)
+	d.Set("membership_arn", template.MembershipArn)
+	d.Set("membership_id", template.MembershipId)
+	d.Set("name", template.Name)
+	if template.Schema != nil {
+		d.Set("referenced_tables", aws.StringValueSlice(template.Schema.ReferencedTables))
+	} else {
+		d.Set("referenced_tables", nil)
+	}
+	if template.Source != nil {
+		d.Set("source", template.Source.Text)
+	} else {
+		d.Set("source", nil)
+	}
+	d.Set("update_time", aws.TimeValue(template.UpdateTime).Format(time.RFC3339))
+
+	tags, err := ListTagsWithContext(ctx, conn, d.Get("arn").(string))
+
+	if err != nil {
+		return diag.Errorf("listing tags for Clean Rooms Analysis Template (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAnalysisTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CleanRoomsConn
+
+	if d.HasChange("description") {
+		membershipID, templateID, err := parseResourceID(d.Id())
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		input := &cleanrooms.UpdateAnalysisTemplateInput{
+			AnalysisTemplateIdentifier: aws.String(templateID),
+			Description:                aws.String(d.Get("description").(string)),
+			MembershipIdentifier:       aws.String(membershipID),
+		}
+
+		log.Printf("[DEBUG] Updating Clean Rooms Analysis Template: %s", input)
+		_, err = conn.UpdateAnalysisTemplateWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating Clean Rooms Analysis Template (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating Clean Rooms Analysis Template (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAnalysisTemplateRead(ctx, d, meta)
+}
+
+func resourceAnalysisTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CleanRoomsConn
+
+	membershipID, templateID, err := parseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting Clean Rooms Analysis Template: %s", d.Id())
+	_, err = conn.DeleteAnalysisTemplateWithContext(ctx, &cleanrooms.DeleteAnalysisTemplateInput{
+		AnalysisTemplateIdentifier: aws.String(templateID),
+		MembershipIdentifier:       aws.String(membershipID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, cleanrooms.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Clean Rooms Analysis Template (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandAnalysisParameters(tfList []interface{}) []*cleanrooms.AnalysisParameter {
+	var apiObjects []*cleanrooms.AnalysisParameter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &cleanrooms.AnalysisParameter{
+			Name: aws.String(tfMap["name"].(string)),
+			Type: aws.String(tfMap["type"].(string)),
+		}
+
+		if v, ok := tfMap["default_value"].(string); ok && v != "" {
+			apiObject.DefaultValue = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenAnalysisParameters(apiObjects []*cleanrooms.AnalysisParameter) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"default_value": aws.StringValue(apiObject.DefaultValue),
+			"name":          aws.StringValue(apiObject.Name),
+			"type":          aws.StringValue(apiObject.Type),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,150 @@
+package cleanrooms_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cleanrooms"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcleanrooms "github.com/hashicorp/terraform-provider-aws/internal/service/cleanrooms"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccCleanRoomsAnalysisTemplate_basic(t *testing.T) {
+	var v cleanrooms.AnalysisTemplate
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_analysis_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cleanrooms.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAnalysisTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnalysisTemplateConfig_basic(rName, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnalysisTemplateExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "analysis_parameter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "analysis_parameter.0.name", "min_value"),
+					resource.TestCheckResourceAttr(resourceName, "analysis_parameter.0.type", "INTEGER"),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "collaboration_id", "aws_cleanrooms_collaboration.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "description", "first"),
+					resource.TestCheckResourceAttr(resourceName, "format", "SQL"),
+					resource.TestCheckResourceAttrPair(resourceName, "membership_id", "aws_cleanrooms_membership.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "referenced_tables.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAnalysisTemplateConfig_basic(rName, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnalysisTemplateExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "second"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCleanRoomsAnalysisTemplate_disappears(t *testing.T) {
+	var v cleanrooms.AnalysisTemplate
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_analysis_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cleanrooms.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAnalysisTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnalysisTemplateConfig_basic(rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnalysisTemplateExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfcleanrooms.ResourceAnalysisTemplate(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAnalysisTemplateDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cleanrooms_analysis_template" {
+			continue
+		}
+
+		_, err := tfcleanrooms.FindAnalysisTemplateByTwoPartKey(context.Background(), conn, rs.Primary.Attributes["membership_id"], rs.Primary.Attributes["analysis_template_id"])
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Clean Rooms Analysis Template %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAnalysisTemplateExists(n string, v *cleanrooms.AnalysisTemplate) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Clean Rooms Analysis Template ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsConn
+
+		output, err := tfcleanrooms.FindAnalysisTemplateByTwoPartKey(context.Background(), conn, rs.Primary.Attributes["membership_id"], rs.Primary.Attributes["analysis_template_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAnalysisTemplateConfig_basic(rName, description string) string {
+	return acctest.ConfigCompose(testAccConfiguredTableAssociationConfig_basic(rName, rName), fmt.Sprintf(`
+resource "aws_cleanrooms_analysis_template" "test" {
+  membership_id = aws_cleanrooms_membership.test.id
+  name          = %[1]q
+  description   = %[2]q
+  format        = "SQL"
+  source        = "SELECT my_column_1 FROM ${aws_cleanrooms_configured_table_association.test.name} WHERE my_column_2 > :min_value"
+
+  analysis_parameter {
+    name          = "min_value"
+    type          = "INTEGER"
+    default_value = "10"
+  }
+}
+`, rName, description))
+}
@@ -0,0 +1,390 @@
+package cleanrooms
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cleanrooms"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceCollaboration() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCollaborationCreate,
+		ReadWithoutTimeout:   resourceCollaborationRead,
+		UpdateWithoutTimeout: resourceCollaborationUpdate,
+		DeleteWithoutTimeout: resourceCollaborationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creator_display_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"creator_member_abilities": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(cleanrooms.MemberAbility_Values(), false),
+				},
+			},
+			"data_encryption_metadata": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allow_clear_text": {
+							Type:     schema.TypeBool,
+							Required: true,
+							ForceNew: true,
+						},
+						"allow_duplicates": {
+							Type:     schema.TypeBool,
+							Required: true,
+							ForceNew: true,
+						},
+						"allow_joins_on_columns_with_different_names": {
+							Type:     schema.TypeBool,
+							Required: true,
+							ForceNew: true,
+						},
+						"preserve_nulls": {
+							Type:     schema.TypeBool,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"member": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidAccountID,
+						},
+						"display_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 100),
+						},
+						"member_abilities": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(cleanrooms.MemberAbility_Values(), false),
+							},
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"query_log_status": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(cleanrooms.CollaborationQueryLogStatus_Values(), false),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceCollaborationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CleanRoomsConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &cleanrooms.CreateCollaborationInput{
+		CreatorDisplayName:     aws.String(d.Get("creator_display_name").(string)),
+		CreatorMemberAbilities: flex.ExpandStringList(d.Get("creator_member_abilities").([]interface{})),
+		Description:            aws.String(d.Get("description").(string)),
+		Members:                expandMemberSpecifications(d.Get("member").(*schema.Set).List()),
+		Name:                   aws.String(name),
+		QueryLogStatus:         aws.String(d.Get("query_log_status").(string)),
+	}
+
+	if v, ok := d.GetOk("data_encryption_metadata"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.DataEncryptionMetadata = expandDataEncryptionMetadata(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Clean Rooms Collaboration: %s", input)
+	output, err := conn.CreateCollaborationWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Clean Rooms Collaboration (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Collaboration.Id))
+
+	return resourceCollaborationRead(ctx, d, meta)
+}
+
+func resourceCollaborationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CleanRoomsConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	collaboration, err := FindCollaborationByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Clean Rooms Collaboration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Clean Rooms Collaboration (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", collaboration.Arn)
+	d.Set("create_time", aws.TimeValue(collaboration.CreateTime).Format(time.RFC3339))
+	d.Set("creator_display_name", collaboration.CreatorDisplayName)
+	if collaboration.DataEncryptionMetadata != nil {
+		if err := d.Set("data_encryption_metadata", []interface{}{flattenDataEncryptionMetadata(collaboration.DataEncryptionMetadata)}); err != nil {
+			return diag.Errorf("setting data_encryption_metadata: %s", err)
+		}
+	} else {
+		d.Set("data_encryption_metadata", nil)
+	}
+	d.Set("description", collaboration.Description)
+	d.Set("name", collaboration.Name)
+	d.Set("query_log_status", collaboration.QueryLogStatus)
+	d.Set("update_time", aws.TimeValue(collaboration.UpdateTime).Format(time.RFC3339))
+
+	members, err := FindMembersByCollaborationID(ctx, conn, d.Id())
+
+	if err != nil {
+		return diag.Errorf("reading Clean Rooms Collaboration (%s) members: %s", d.Id(), err)
+	}
+
+	creatorAccountID := aws.StringValue(collaboration.CreatorAccountId)
+	var tfList []interface{}
+
+	for _, v := range members {
+		if aws.StringValue(v.AccountId) == creatorAccountID {
+			d.Set("creator_member_abilities", flex.FlattenStringList(v.Abilities))
+			continue
+		}
+
+		tfList = append(tfList, flattenMemberSummary(v))
+	}
+
+	if err := d.Set("member", tfList); err != nil {
+		return diag.Errorf("setting member: %s", err)
+	}
+
+	tags, err := ListTagsWithContext(ctx, conn, d.Get("arn").(string))
+
+	if err != nil {
+		return diag.Errorf("listing tags for Clean Rooms Collaboration (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceCollaborationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CleanRoomsConn
+
+	if d.HasChanges("description", "name") {
+		input := &cleanrooms.UpdateCollaborationInput{
+			CollaborationIdentifier: aws.String(d.Id()),
+			Description:             aws.String(d.Get("description").(string)),
+			Name:                    aws.String(d.Get("name").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating Clean Rooms Collaboration: %s", input)
+		_, err := conn.UpdateCollaborationWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating Clean Rooms Collaboration (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating Clean Rooms Collaboration (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceCollaborationRead(ctx, d, meta)
+}
+
+func resourceCollaborationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CleanRoomsConn
+
+	log.Printf("[DEBUG] Deleting Clean Rooms Collaboration: %s", d.Id())
+	_, err := conn.DeleteCollaborationWithContext(ctx, &cleanrooms.DeleteCollaborationInput{
+		CollaborationIdentifier: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, cleanrooms.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Clean Rooms Collaboration (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandDataEncryptionMetadata(tfMap map[string]interface{}) *cleanrooms.DataEncryptionMetadata {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cleanrooms.DataEncryptionMetadata{}
+
+	if v, ok := tfMap["allow_clear_text"].(bool); ok {
+		apiObject.AllowCleartext = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["allow_duplicates"].(bool); ok {
+		apiObject.AllowDuplicates = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["allow_joins_on_columns_with_different_names"].(bool); ok {
+		apiObject.AllowJoinsOnColumnsWithDifferentNames = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["preserve_nulls"].(bool); ok {
+		apiObject.PreserveNulls = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func flattenDataEncryptionMetadata(apiObject *cleanrooms.DataEncryptionMetadata) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"allow_clear_text": aws.BoolValue(apiObject.AllowCleartext),
+		"allow_duplicates": aws.BoolValue(apiObject.AllowDuplicates),
+		"allow_joins_on_columns_with_different_names": aws.BoolValue(apiObject.AllowJoinsOnColumnsWithDifferentNames),
+		"preserve_nulls": aws.BoolValue(apiObject.PreserveNulls),
+	}
+
+	return tfMap
+}
+
+func expandMemberSpecifications(tfList []interface{}) []*cleanrooms.MemberSpecification {
+	// Members is a required field, send an empty list rather than nil.
+	apiObjects := make([]*cleanrooms.MemberSpecification, 0)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &cleanrooms.MemberSpecification{}
+
+		if v, ok := tfMap["account_id"].(string); ok && v != "" {
+			apiObject.AccountId = aws.String(v)
+		}
+
+		if v, ok := tfMap["display_name"].(string); ok && v != "" {
+			apiObject.DisplayName = aws.String(v)
+		}
+
+		if v, ok := tfMap["member_abilities"].([]interface{}); ok {
+			apiObject.MemberAbilities = flex.ExpandStringList(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenMemberSummary(apiObject *cleanrooms.MemberSummary) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"account_id":       aws.StringValue(apiObject.AccountId),
+		"display_name":     aws.StringValue(apiObject.DisplayName),
+		"member_abilities": flex.FlattenStringList(apiObject.Abilities),
+		"status":           aws.StringValue(apiObject.Status),
+	}
+
+	return tfMap
+}
@@ -0,0 +1,269 @@
+package cleanrooms
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cleanrooms"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceConfiguredTable() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceConfiguredTableCreate,
+		ReadWithoutTimeout:   resourceConfiguredTableRead,
+		UpdateWithoutTimeout: resourceConfiguredTableUpdate,
+		DeleteWithoutTimeout: resourceConfiguredTableDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"allowed_columns": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"analysis_method": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(cleanrooms.AnalysisMethod_Values(), false),
+			},
+			"analysis_rule_types": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"table_reference": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"database_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"table_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceConfiguredTableCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CleanRoomsConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &cleanrooms.CreateConfiguredTableInput{
+		AllowedColumns: flex.ExpandStringSet(d.Get("allowed_columns").(*schema.Set)),
+		AnalysisMethod: aws.String(d.Get("analysis_method").(string)),
+		Name:           aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("table_reference"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.TableReference = expandTableReference(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Clean Rooms Configured Table: %s", input)
+	output, err := conn.CreateConfiguredTableWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Clean Rooms Configured Table (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.ConfiguredTable.Id))
+
+	return resourceConfiguredTableRead(ctx, d, meta)
+}
+
+func resourceConfiguredTableRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CleanRoomsConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	configuredTable, err := FindConfiguredTableByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Clean Rooms Configured Table (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Clean Rooms Configured Table (%s): %s", d.Id(), err)
+	}
+
+	d.Set("allowed_columns", aws.StringValueSlice(configuredTable.AllowedColumns))
+	d.Set("analysis_method", configuredTable.AnalysisMethod)
+	d.Set("analysis_rule_types", aws.StringValueSlice(configuredTable.AnalysisRuleTypes))
+	d.Set("arn", configuredTable.Arn)
+	d.Set("create_time", aws.TimeValue(configuredTable.CreateTime).Format(time.RFC3339))
+	d.Set("description", configuredTable.Description)
+	d.Set("name", configuredTable.Name)
+	if configuredTable.TableReference != nil && configuredTable.TableReference.Glue != nil {
+		if err := d.Set("table_reference", []interface{}{flattenGlueTableReference(configuredTable.TableReference.Glue)}); err != nil {
+			return diag.Errorf("setting table_reference: %s", err)
+		}
+	} else {
+		d.Set("table_reference", nil)
+	}
+	d.Set("update_time", aws.TimeValue(configuredTable.UpdateTime).Format(time.RFC3339))
+
+	tags, err := ListTagsWithContext(ctx, conn, d.Get("arn").(string))
+
+	if err != nil {
+		return diag.Errorf("listing tags for Clean Rooms Configured Table (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceConfiguredTableUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CleanRoomsConn
+
+	if d.HasChanges("description", "name") {
+		input := &cleanrooms.UpdateConfiguredTableInput{
+			ConfiguredTableIdentifier: aws.String(d.Id()),
+			Description:               aws.String(d.Get("description").(string)),
+			Name:                      aws.String(d.Get("name").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating Clean Rooms Configured Table: %s", input)
+		_, err := conn.UpdateConfiguredTableWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating Clean Rooms Configured Table (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating Clean Rooms Configured Table (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceConfiguredTableRead(ctx, d, meta)
+}
+
+func resourceConfiguredTableDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CleanRoomsConn
+
+	log.Printf("[DEBUG] Deleting Clean Rooms Configured Table: %s", d.Id())
+	_, err := conn.DeleteConfiguredTableWithContext(ctx, &cleanrooms.DeleteConfiguredTableInput{
+		ConfiguredTableIdentifier: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, cleanrooms.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Clean Rooms Configured Table (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandTableReference(tfMap map[string]interface{}) *cleanrooms.TableReference {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cleanrooms.GlueTableReference{}
+
+	if v, ok := tfMap["database_name"].(string); ok && v != "" {
+		apiObject.DatabaseName = aws.String(v)
+	}
+
+	if v, ok := tfMap["table_name"].(string); ok && v != "" {
+		apiObject.TableName = aws.String(v)
+	}
+
+	return &cleanrooms.TableReference{
+		Glue: apiObject,
+	}
+}
+
+func flattenGlueTableReference(apiObject *cleanrooms.GlueTableReference) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"database_name": aws.StringValue(apiObject.DatabaseName),
+		"table_name":    aws.StringValue(apiObject.TableName),
+	}
+
+	return tfMap
+}
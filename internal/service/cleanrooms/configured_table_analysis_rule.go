@@ -0,0 +1,592 @@
+package cleanrooms
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cleanrooms"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceConfiguredTableAnalysisRule() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceConfiguredTableAnalysisRuleCreate,
+		ReadWithoutTimeout:   resourceConfiguredTableAnalysisRuleRead,
+		UpdateWithoutTimeout: resourceConfiguredTableAnalysisRuleUpdate,
+		DeleteWithoutTimeout: resourceConfiguredTableAnalysisRuleDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"analysis_rule_policy": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"v1": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"aggregation": {
+										Type:         schema.TypeList,
+										Optional:     true,
+										MaxItems:     1,
+										ExactlyOneOf: []string{"analysis_rule_policy.0.v1.0.aggregation", "analysis_rule_policy.0.v1.0.custom", "analysis_rule_policy.0.v1.0.list"},
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"additional_analyses": additionalAnalysesSchema(),
+												"aggregate_columns": {
+													Type:     schema.TypeList,
+													Required: true,
+													MinItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"column_names": {
+																Type:     schema.TypeList,
+																Required: true,
+																MinItems: 1,
+																Elem:     &schema.Schema{Type: schema.TypeString},
+															},
+															"function": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringInSlice(cleanrooms.AggregateFunctionName_Values(), false),
+															},
+														},
+													},
+												},
+												"allowed_join_operators": allowedJoinOperatorsSchema(),
+												"dimension_columns": {
+													Type:     schema.TypeList,
+													Required: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+												"join_columns": {
+													Type:     schema.TypeList,
+													Required: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+												"join_required": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringInSlice(cleanrooms.JoinRequiredOption_Values(), false),
+												},
+												"output_constraints": {
+													Type:     schema.TypeList,
+													Required: true,
+													MinItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"column_name": {
+																Type:     schema.TypeString,
+																Required: true,
+															},
+															"minimum": {
+																Type:         schema.TypeInt,
+																Required:     true,
+																ValidateFunc: validation.IntAtLeast(2),
+															},
+															"type": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringInSlice(cleanrooms.AggregationType_Values(), false),
+															},
+														},
+													},
+												},
+												"scalar_functions": {
+													Type:     schema.TypeList,
+													Required: true,
+													Elem: &schema.Schema{
+														Type:         schema.TypeString,
+														ValidateFunc: validation.StringInSlice(cleanrooms.ScalarFunctions_Values(), false),
+													},
+												},
+											},
+										},
+									},
+									"custom": {
+										Type:         schema.TypeList,
+										Optional:     true,
+										MaxItems:     1,
+										ExactlyOneOf: []string{"analysis_rule_policy.0.v1.0.aggregation", "analysis_rule_policy.0.v1.0.custom", "analysis_rule_policy.0.v1.0.list"},
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"additional_analyses": additionalAnalysesSchema(),
+												"allowed_analyses": {
+													Type:     schema.TypeList,
+													Required: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+												"allowed_analysis_providers": {
+													Type:     schema.TypeList,
+													Optional: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+												"disallowed_output_columns": {
+													Type:     schema.TypeList,
+													Optional: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+									"list": {
+										Type:         schema.TypeList,
+										Optional:     true,
+										MaxItems:     1,
+										ExactlyOneOf: []string{"analysis_rule_policy.0.v1.0.aggregation", "analysis_rule_policy.0.v1.0.custom", "analysis_rule_policy.0.v1.0.list"},
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"additional_analyses":    additionalAnalysesSchema(),
+												"allowed_join_operators": allowedJoinOperatorsSchema(),
+												"join_columns": {
+													Type:     schema.TypeList,
+													Required: true,
+													MinItems: 1,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+												"list_columns": {
+													Type:     schema.TypeList,
+													Required: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"analysis_rule_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(cleanrooms.ConfiguredTableAnalysisRuleType_Values(), false),
+			},
+			"configured_table_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configured_table_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func additionalAnalysesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validation.StringInSlice(cleanrooms.AdditionalAnalyses_Values(), false),
+	}
+}
+
+func allowedJoinOperatorsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringInSlice(cleanrooms.JoinOperator_Values(), false),
+		},
+	}
+}
+
+func resourceConfiguredTableAnalysisRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CleanRoomsConn
+
+	configuredTableID := d.Get("configured_table_id").(string)
+	analysisRuleType := d.Get("analysis_rule_type").(string)
+	id := createResourceID(configuredTableID, analysisRuleType)
+	input := &cleanrooms.CreateConfiguredTableAnalysisRuleInput{
+		AnalysisRuleType:          aws.String(analysisRuleType),
+		ConfiguredTableIdentifier: aws.String(configuredTableID),
+	}
+
+	if v, ok := d.GetOk("analysis_rule_policy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.AnalysisRulePolicy = expandConfiguredTableAnalysisRulePolicy(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating Clean Rooms Configured Table Analysis Rule: %s", input)
+	_, err := conn.CreateConfiguredTableAnalysisRuleWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Clean Rooms Configured Table Analysis Rule (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceConfiguredTableAnalysisRuleRead(ctx, d, meta)
+}
+
+func resourceConfiguredTableAnalysisRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CleanRoomsConn
+
+	configuredTableID, analysisRuleType, err := parseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	analysisRule, err := FindConfiguredTableAnalysisRuleByTwoPartKey(ctx, conn, configuredTableID, analysisRuleType)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Clean Rooms Configured Table Analysis Rule (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Clean Rooms Configured Table Analysis Rule (%s): %s", d.Id(), err)
+	}
+
+	if analysisRule.Policy != nil {
+		if err := d.Set("analysis_rule_policy", []interface{}{flattenConfiguredTableAnalysisRulePolicy(analysisRule.Policy)}); err != nil {
+			return diag.Errorf("setting analysis_rule_policy: %s", err)
+		}
+	} else {
+		d.Set("analysis_rule_policy", nil)
+	}
+	d.Set("analysis_rule_type", analysisRule.Type)
+	d.Set("configured_table_arn", analysisRule.ConfiguredTableArn)
+	d.Set("configured_table_id", analysisRule.ConfiguredTableId)
+	d.Set("create_time", aws.TimeValue(analysisRule.CreateTime).Format(time.RFC3339))
+	d.Set("update_time", aws.TimeValue(analysisRule.UpdateTime).Format(time.RFC3339))
+
+	return nil
+}
+
+func resourceConfiguredTableAnalysisRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CleanRoomsConn
+
+	configuredTableID, analysisRuleType, err := parseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	input := &cleanrooms.UpdateConfiguredTableAnalysisRuleInput{
+		AnalysisRuleType:          aws.String(analysisRuleType),
+		ConfiguredTableIdentifier: aws.String(configuredTableID),
+	}
+
+	if v, ok := d.GetOk("analysis_rule_policy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.AnalysisRulePolicy = expandConfiguredTableAnalysisRulePolicy(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Updating Clean Rooms Configured Table Analysis Rule: %s", input)
+	_, err = conn.UpdateConfiguredTableAnalysisRuleWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("updating Clean Rooms Configured Table Analysis Rule (%s): %s", d.Id(), err)
+	}
+
+	return resourceConfiguredTableAnalysisRuleRead(ctx, d, meta)
+}
+
+func resourceConfiguredTableAnalysisRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CleanRoomsConn
+
+	configuredTableID, analysisRuleType, err := parseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting Clean Rooms Configured Table Analysis Rule: %s", d.Id())
+	_, err = conn.DeleteConfiguredTableAnalysisRuleWithContext(ctx, &cleanrooms.DeleteConfiguredTableAnalysisRuleInput{
+		AnalysisRuleType:          aws.String(analysisRuleType),
+		ConfiguredTableIdentifier: aws.String(configuredTableID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, cleanrooms.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Clean Rooms Configured Table Analysis Rule (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandConfiguredTableAnalysisRulePolicy(tfMap map[string]interface{}) *cleanrooms.ConfiguredTableAnalysisRulePolicy {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cleanrooms.ConfiguredTableAnalysisRulePolicy{}
+
+	if v, ok := tfMap["v1"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.V1 = expandConfiguredTableAnalysisRulePolicyV1(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandConfiguredTableAnalysisRulePolicyV1(tfMap map[string]interface{}) *cleanrooms.ConfiguredTableAnalysisRulePolicyV1 {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cleanrooms.ConfiguredTableAnalysisRulePolicyV1{}
+
+	if v, ok := tfMap["aggregation"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Aggregation = expandAnalysisRuleAggregation(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["custom"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Custom = expandAnalysisRuleCustom(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["list"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.List = expandAnalysisRuleList(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandAnalysisRuleAggregation(tfMap map[string]interface{}) *cleanrooms.AnalysisRuleAggregation {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cleanrooms.AnalysisRuleAggregation{
+		DimensionColumns: flex.ExpandStringList(tfMap["dimension_columns"].([]interface{})),
+		JoinColumns:      flex.ExpandStringList(tfMap["join_columns"].([]interface{})),
+		ScalarFunctions:  flex.ExpandStringList(tfMap["scalar_functions"].([]interface{})),
+	}
+
+	if v, ok := tfMap["additional_analyses"].(string); ok && v != "" {
+		apiObject.AdditionalAnalyses = aws.String(v)
+	}
+
+	if v, ok := tfMap["aggregate_columns"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiObject.AggregateColumns = append(apiObject.AggregateColumns, &cleanrooms.AggregateColumn{
+				ColumnNames: flex.ExpandStringList(tfMap["column_names"].([]interface{})),
+				Function:    aws.String(tfMap["function"].(string)),
+			})
+		}
+	}
+
+	if v, ok := tfMap["allowed_join_operators"].([]interface{}); ok && len(v) > 0 {
+		apiObject.AllowedJoinOperators = flex.ExpandStringList(v)
+	}
+
+	if v, ok := tfMap["join_required"].(string); ok && v != "" {
+		apiObject.JoinRequired = aws.String(v)
+	}
+
+	if v, ok := tfMap["output_constraints"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiObject.OutputConstraints = append(apiObject.OutputConstraints, &cleanrooms.AggregationConstraint{
+				ColumnName: aws.String(tfMap["column_name"].(string)),
+				Minimum:    aws.Int64(int64(tfMap["minimum"].(int))),
+				Type:       aws.String(tfMap["type"].(string)),
+			})
+		}
+	}
+
+	return apiObject
+}
+
+func expandAnalysisRuleCustom(tfMap map[string]interface{}) *cleanrooms.AnalysisRuleCustom {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cleanrooms.AnalysisRuleCustom{
+		AllowedAnalyses: flex.ExpandStringList(tfMap["allowed_analyses"].([]interface{})),
+	}
+
+	if v, ok := tfMap["additional_analyses"].(string); ok && v != "" {
+		apiObject.AdditionalAnalyses = aws.String(v)
+	}
+
+	if v, ok := tfMap["allowed_analysis_providers"].([]interface{}); ok && len(v) > 0 {
+		apiObject.AllowedAnalysisProviders = flex.ExpandStringList(v)
+	}
+
+	if v, ok := tfMap["disallowed_output_columns"].([]interface{}); ok && len(v) > 0 {
+		apiObject.DisallowedOutputColumns = flex.ExpandStringList(v)
+	}
+
+	return apiObject
+}
+
+func expandAnalysisRuleList(tfMap map[string]interface{}) *cleanrooms.AnalysisRuleList {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cleanrooms.AnalysisRuleList{
+		JoinColumns: flex.ExpandStringList(tfMap["join_columns"].([]interface{})),
+		ListColumns: flex.ExpandStringList(tfMap["list_columns"].([]interface{})),
+	}
+
+	if v, ok := tfMap["additional_analyses"].(string); ok && v != "" {
+		apiObject.AdditionalAnalyses = aws.String(v)
+	}
+
+	if v, ok := tfMap["allowed_join_operators"].([]interface{}); ok && len(v) > 0 {
+		apiObject.AllowedJoinOperators = flex.ExpandStringList(v)
+	}
+
+	return apiObject
+}
+
+func flattenConfiguredTableAnalysisRulePolicy(apiObject *cleanrooms.ConfiguredTableAnalysisRulePolicy) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.V1; v != nil {
+		tfMap["v1"] = []interface{}{flattenConfiguredTableAnalysisRulePolicyV1(v)}
+	}
+
+	return tfMap
+}
+
+func flattenConfiguredTableAnalysisRulePolicyV1(apiObject *cleanrooms.ConfiguredTableAnalysisRulePolicyV1) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Aggregation; v != nil {
+		tfMap["aggregation"] = []interface{}{flattenAnalysisRuleAggregation(v)}
+	}
+
+	if v := apiObject.Custom; v != nil {
+		tfMap["custom"] = []interface{}{flattenAnalysisRuleCustom(v)}
+	}
+
+	if v := apiObject.List; v != nil {
+		tfMap["list"] = []interface{}{flattenAnalysisRuleList(v)}
+	}
+
+	return tfMap
+}
+
+func flattenAnalysisRuleAggregation(apiObject *cleanrooms.AnalysisRuleAggregation) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"additional_analyses":    aws.StringValue(apiObject.AdditionalAnalyses),
+		"allowed_join_operators": flex.FlattenStringList(apiObject.AllowedJoinOperators),
+		"dimension_columns":      flex.FlattenStringList(apiObject.DimensionColumns),
+		"join_columns":           flex.FlattenStringList(apiObject.JoinColumns),
+		"join_required":          aws.StringValue(apiObject.JoinRequired),
+		"scalar_functions":       flex.FlattenStringList(apiObject.ScalarFunctions),
+	}
+
+	var aggregateColumns []interface{}
+
+	for _, v := range apiObject.AggregateColumns {
+		if v == nil {
+			continue
+		}
+
+		aggregateColumns = append(aggregateColumns, map[string]interface{}{
+			"column_names": flex.FlattenStringList(v.ColumnNames),
+			"function":     aws.StringValue(v.Function),
+		})
+	}
+
+	tfMap["aggregate_columns"] = aggregateColumns
+
+	var outputConstraints []interface{}
+
+	for _, v := range apiObject.OutputConstraints {
+		if v == nil {
+			continue
+		}
+
+		outputConstraints = append(outputConstraints, map[string]interface{}{
+			"column_name": aws.StringValue(v.ColumnName),
+			"minimum":     aws.Int64Value(v.Minimum),
+			"type":        aws.StringValue(v.Type),
+		})
+	}
+
+	tfMap["output_constraints"] = outputConstraints
+
+	return tfMap
+}
+
+func flattenAnalysisRuleCustom(apiObject *cleanrooms.AnalysisRuleCustom) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"additional_analyses":        aws.StringValue(apiObject.AdditionalAnalyses),
+		"allowed_analyses":           flex.FlattenStringList(apiObject.AllowedAnalyses),
+		"allowed_analysis_providers": flex.FlattenStringList(apiObject.AllowedAnalysisProviders),
+		"disallowed_output_columns":  flex.FlattenStringList(apiObject.DisallowedOutputColumns),
+	}
+
+	return tfMap
+}
+
+func flattenAnalysisRuleList(apiObject *cleanrooms.AnalysisRuleList) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"additional_analyses":    aws.StringValue(apiObject.AdditionalAnalyses),
+		"allowed_join_operators": flex.FlattenStringList(apiObject.AllowedJoinOperators),
+		"join_columns":           flex.FlattenStringList(apiObject.JoinColumns),
+		"list_columns":           flex.FlattenStringList(apiObject.ListColumns),
+	}
+
+	return tfMap
+}
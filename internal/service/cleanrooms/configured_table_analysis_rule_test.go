@@ -0,0 +1,210 @@
+package cleanrooms_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cleanrooms"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcleanrooms "github.com/hashicorp/terraform-provider-aws/internal/service/cleanrooms"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccCleanRoomsConfiguredTableAnalysisRule_list(t *testing.T) {
+	var v cleanrooms.ConfiguredTableAnalysisRule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_configured_table_analysis_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cleanrooms.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfiguredTableAnalysisRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfiguredTableAnalysisRuleConfig_list(rName, "my_column_2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfiguredTableAnalysisRuleExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule_type", "LIST"),
+					resource.TestCheckResourceAttrPair(resourceName, "configured_table_arn", "aws_cleanrooms_configured_table.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "configured_table_id", "aws_cleanrooms_configured_table.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule_policy.0.v1.0.list.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule_policy.0.v1.0.list.0.join_columns.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule_policy.0.v1.0.list.0.join_columns.0", "my_column_1"),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule_policy.0.v1.0.list.0.list_columns.0", "my_column_2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfiguredTableAnalysisRuleConfig_list(rName, "my_column_1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfiguredTableAnalysisRuleExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule_policy.0.v1.0.list.0.list_columns.0", "my_column_1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCleanRoomsConfiguredTableAnalysisRule_aggregation(t *testing.T) {
+	var v cleanrooms.ConfiguredTableAnalysisRule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_configured_table_analysis_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cleanrooms.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfiguredTableAnalysisRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfiguredTableAnalysisRuleConfig_aggregation(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfiguredTableAnalysisRuleExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule_type", "AGGREGATION"),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule_policy.0.v1.0.aggregation.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule_policy.0.v1.0.aggregation.0.aggregate_columns.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule_policy.0.v1.0.aggregation.0.aggregate_columns.0.function", "COUNT_DISTINCT"),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule_policy.0.v1.0.aggregation.0.output_constraints.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule_policy.0.v1.0.aggregation.0.output_constraints.0.minimum", "100"),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule_policy.0.v1.0.aggregation.0.scalar_functions.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCleanRoomsConfiguredTableAnalysisRule_disappears(t *testing.T) {
+	var v cleanrooms.ConfiguredTableAnalysisRule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_configured_table_analysis_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cleanrooms.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfiguredTableAnalysisRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfiguredTableAnalysisRuleConfig_list(rName, "my_column_2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfiguredTableAnalysisRuleExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfcleanrooms.ResourceConfiguredTableAnalysisRule(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckConfiguredTableAnalysisRuleDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cleanrooms_configured_table_analysis_rule" {
+			continue
+		}
+
+		_, err := tfcleanrooms.FindConfiguredTableAnalysisRuleByTwoPartKey(context.Background(), conn, rs.Primary.Attributes["configured_table_id"], rs.Primary.Attributes["analysis_rule_type"])
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Clean Rooms Configured Table Analysis Rule %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckConfiguredTableAnalysisRuleExists(n string, v *cleanrooms.ConfiguredTableAnalysisRule) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Clean Rooms Configured Table Analysis Rule ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsConn
+
+		output, err := tfcleanrooms.FindConfiguredTableAnalysisRuleByTwoPartKey(context.Background(), conn, rs.Primary.Attributes["configured_table_id"], rs.Primary.Attributes["analysis_rule_type"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccConfiguredTableAnalysisRuleConfig_list(rName, listColumn string) string {
+	return acctest.ConfigCompose(testAccConfiguredTableConfig_basic(rName, rName), fmt.Sprintf(`
+resource "aws_cleanrooms_configured_table_analysis_rule" "test" {
+  configured_table_id = aws_cleanrooms_configured_table.test.id
+  analysis_rule_type  = "LIST"
+
+  analysis_rule_policy {
+    v1 {
+      list {
+        join_columns = ["my_column_1"]
+        list_columns = [%[1]q]
+      }
+    }
+  }
+}
+`, listColumn))
+}
+
+func testAccConfiguredTableAnalysisRuleConfig_aggregation(rName string) string {
+	return acctest.ConfigCompose(testAccConfiguredTableConfig_basic(rName, rName), `
+resource "aws_cleanrooms_configured_table_analysis_rule" "test" {
+  configured_table_id = aws_cleanrooms_configured_table.test.id
+  analysis_rule_type  = "AGGREGATION"
+
+  analysis_rule_policy {
+    v1 {
+      aggregation {
+        aggregate_columns {
+          column_names = ["my_column_2"]
+          function     = "COUNT_DISTINCT"
+        }
+
+        dimension_columns = []
+        join_columns      = ["my_column_1"]
+        scalar_functions  = ["LOWER", "UPPER"]
+
+        output_constraints {
+          column_name = "my_column_2"
+          minimum     = 100
+          type        = "COUNT_DISTINCT"
+        }
+      }
+    }
+  }
+}
+`)
+}
@@ -0,0 +1,331 @@
+package cleanrooms
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cleanrooms"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceConfiguredTableAssociationAnalysisRule() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceConfiguredTableAssociationAnalysisRuleCreate,
+		ReadWithoutTimeout:   resourceConfiguredTableAssociationAnalysisRuleRead,
+		UpdateWithoutTimeout: resourceConfiguredTableAssociationAnalysisRuleUpdate,
+		DeleteWithoutTimeout: resourceConfiguredTableAssociationAnalysisRuleDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"analysis_rule_policy": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"v1": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"aggregation": configuredTableAssociationAnalysisRuleSchema(),
+									"custom":      configuredTableAssociationAnalysisRuleSchema(),
+									"list":        configuredTableAssociationAnalysisRuleSchema(),
+								},
+							},
+						},
+					},
+				},
+			},
+			"analysis_rule_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(cleanrooms.ConfiguredTableAssociationAnalysisRuleType_Values(), false),
+			},
+			"configured_table_association_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configured_table_association_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"membership_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func configuredTableAssociationAnalysisRuleSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeList,
+		Optional:     true,
+		MaxItems:     1,
+		ExactlyOneOf: []string{"analysis_rule_policy.0.v1.0.aggregation", "analysis_rule_policy.0.v1.0.custom", "analysis_rule_policy.0.v1.0.list"},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"allowed_additional_analyses": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"allowed_result_receivers": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
+func configuredTableAssociationAnalysisRuleCreateResourceID(membershipID, associationID, analysisRuleType string) string {
+	parts := []string{membershipID, associationID, analysisRuleType}
+	id := strings.Join(parts, resourceIDSeparator)
+
+	return id
+}
+
+func configuredTableAssociationAnalysisRuleParseResourceID(id string) (string, string, string, error) {
+	parts := strings.Split(id, resourceIDSeparator)
+
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected MEMBERSHIP-ID%[2]sCONFIGURED-TABLE-ASSOCIATION-ID%[2]sANALYSIS-RULE-TYPE", id, resourceIDSeparator)
+}
+
+func resourceConfiguredTableAssociationAnalysisRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CleanRoomsConn
+
+	membershipID := d.Get("membership_id").(string)
+	associationID := d.Get("configured_table_association_id").(string)
+	analysisRuleType := d.Get("analysis_rule_type").(string)
+	id := configuredTableAssociationAnalysisRuleCreateResourceID(membershipID, associationID, analysisRuleType)
+	input := &cleanrooms.CreateConfiguredTableAssociationAnalysisRuleInput{
+		AnalysisRuleType:                     aws.String(analysisRuleType),
+		ConfiguredTableAssociationIdentifier: aws.String(associationID),
+		MembershipIdentifier:                 aws.String(membershipID),
+	}
+
+	if v, ok := d.GetOk("analysis_rule_policy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.AnalysisRulePolicy = expandConfiguredTableAssociationAnalysisRulePolicy(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating Clean Rooms Configured Table Association Analysis Rule: %s", input)
+	_, err := conn.CreateConfiguredTableAssociationAnalysisRuleWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Clean Rooms Configured Table Association Analysis Rule (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceConfiguredTableAssociationAnalysisRuleRead(ctx, d, meta)
+}
+
+func resourceConfiguredTableAssociationAnalysisRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CleanRoomsConn
+
+	membershipID, associationID, analysisRuleType, err := configuredTableAssociationAnalysisRuleParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	analysisRule, err := FindConfiguredTableAssociationAnalysisRuleByThreePartKey(ctx, conn, membershipID, associationID, analysisRuleType)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Clean Rooms Configured Table Association Analysis Rule (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Clean Rooms Configured Table Association Analysis Rule (%s): %s", d.Id(), err)
+	}
+
+	if analysisRule.Policy != nil {
+		if err := d.Set("analysis_rule_policy", []interface{}{flattenConfiguredTableAssociationAnalysisRulePolicy(analysisRule.Policy)}); err != nil {
+			return diag.Errorf("setting analysis_rule_policy: %s", err)
+		}
+	} else {
+		d.Set("analysis_rule_policy", nil)
+	}
+	d.Set("analysis_rule_type", analysisRule.Type)
+	d.Set("configured_table_association_arn", analysisRule.ConfiguredTableAssociationArn)
+	d.Set("configured_table_association_id", analysisRule.ConfiguredTableAssociationId)
+	d.Set("create_time", aws.TimeValue(analysisRule.CreateTime).Format(time.RFC3339))
+	d.Set("membership_id", membershipID)
+	d.Set("update_time", aws.TimeValue(analysisRule.UpdateTime).Format(time.RFC3339))
+
+	return nil
+}
+
+func resourceConfiguredTableAssociationAnalysisRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CleanRoomsConn
+
+	membershipID, associationID, analysisRuleType, err := configuredTableAssociationAnalysisRuleParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	input := &cleanrooms.UpdateConfiguredTableAssociationAnalysisRuleInput{
+		AnalysisRuleType:                     aws.String(analysisRuleType),
+		ConfiguredTableAssociationIdentifier: aws.String(associationID),
+		MembershipIdentifier:                 aws.String(membershipID),
+	}
+
+	if v, ok := d.GetOk("analysis_rule_policy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.AnalysisRulePolicy = expandConfiguredTableAssociationAnalysisRulePolicy(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Updating Clean Rooms Configured Table Association Analysis Rule: %s", input)
+	_, err = conn.UpdateConfiguredTableAssociationAnalysisRuleWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("updating Clean Rooms Configured Table Association Analysis Rule (%s): %s", d.Id(), err)
+	}
+
+	return resourceConfiguredTableAssociationAnalysisRuleRead(ctx, d, meta)
+}
+
+func resourceConfiguredTableAssociationAnalysisRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CleanRoomsConn
+
+	membershipID, associationID, analysisRuleType, err := configuredTableAssociationAnalysisRuleParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting Clean Rooms Configured Table Association Analysis Rule: %s", d.Id())
+	_, err = conn.DeleteConfiguredTableAssociationAnalysisRuleWithContext(ctx, &cleanrooms.DeleteConfiguredTableAssociationAnalysisRuleInput{
+		AnalysisRuleType:                     aws.String(analysisRuleType),
+		ConfiguredTableAssociationIdentifier: aws.String(associationID),
+		MembershipIdentifier:                 aws.String(membershipID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, cleanrooms.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Clean Rooms Configured Table Association Analysis Rule (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandConfiguredTableAssociationAnalysisRulePolicy(tfMap map[string]interface{}) *cleanrooms.ConfiguredTableAssociationAnalysisRulePolicy {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cleanrooms.ConfiguredTableAssociationAnalysisRulePolicy{}
+
+	v, ok := tfMap["v1"].([]interface{})
+
+	if !ok || len(v) == 0 || v[0] == nil {
+		return apiObject
+	}
+
+	tfMap = v[0].(map[string]interface{})
+	apiObject.V1 = &cleanrooms.ConfiguredTableAssociationAnalysisRulePolicyV1{}
+
+	if v, ok := tfMap["aggregation"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.V1.Aggregation = &cleanrooms.ConfiguredTableAssociationAnalysisRuleAggregation{
+			AllowedAdditionalAnalyses: flex.ExpandStringList(tfMap["allowed_additional_analyses"].([]interface{})),
+			AllowedResultReceivers:    flex.ExpandStringList(tfMap["allowed_result_receivers"].([]interface{})),
+		}
+	}
+
+	if v, ok := tfMap["custom"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.V1.Custom = &cleanrooms.ConfiguredTableAssociationAnalysisRuleCustom{
+			AllowedAdditionalAnalyses: flex.ExpandStringList(tfMap["allowed_additional_analyses"].([]interface{})),
+			AllowedResultReceivers:    flex.ExpandStringList(tfMap["allowed_result_receivers"].([]interface{})),
+		}
+	}
+
+	if v, ok := tfMap["list"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.V1.List = &cleanrooms.ConfiguredTableAssociationAnalysisRuleList{
+			AllowedAdditionalAnalyses: flex.ExpandStringList(tfMap["allowed_additional_analyses"].([]interface{})),
+			AllowedResultReceivers:    flex.ExpandStringList(tfMap["allowed_result_receivers"].([]interface{})),
+		}
+	}
+
+	return apiObject
+}
+
+func flattenConfiguredTableAssociationAnalysisRulePolicy(apiObject *cleanrooms.ConfiguredTableAssociationAnalysisRulePolicy) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	v1 := apiObject.V1
+
+	if v1 == nil {
+		return tfMap
+	}
+
+	tfMapV1 := map[string]interface{}{}
+
+	if v := v1.Aggregation; v != nil {
+		tfMapV1["aggregation"] = []interface{}{map[string]interface{}{
+			"allowed_additional_analyses": flex.FlattenStringList(v.AllowedAdditionalAnalyses),
+			"allowed_result_receivers":    flex.FlattenStringList(v.AllowedResultReceivers),
+		}}
+	}
+
+	if v := v1.Custom; v != nil {
+		tfMapV1["custom"] = []interface{}{map[string]interface{}{
+			"allowed_additional_analyses": flex.FlattenStringList(v.AllowedAdditionalAnalyses),
+			"allowed_result_receivers":    flex.FlattenStringList(v.AllowedResultReceivers),
+		}}
+	}
+
+	if v := v1.List; v != nil {
+		tfMapV1["list"] = []interface{}{map[string]interface{}{
+			"allowed_additional_analyses": flex.FlattenStringList(v.AllowedAdditionalAnalyses),
+			"allowed_result_receivers":    flex.FlattenStringList(v.AllowedResultReceivers),
+		}}
+	}
+
+	tfMap["v1"] = []interface{}{tfMapV1}
+
+	return tfMap
+}
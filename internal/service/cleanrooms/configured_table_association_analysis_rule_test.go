@@ -0,0 +1,158 @@
+package cleanrooms_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cleanrooms"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcleanrooms "github.com/hashicorp/terraform-provider-aws/internal/service/cleanrooms"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccCleanRoomsConfiguredTableAssociationAnalysisRule_list(t *testing.T) {
+	var v cleanrooms.ConfiguredTableAssociationAnalysisRule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_configured_table_association_analysis_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cleanrooms.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfiguredTableAssociationAnalysisRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfiguredTableAssociationAnalysisRuleConfig_list(rName, "ALLOWED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfiguredTableAssociationAnalysisRuleExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule_type", "LIST"),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule_policy.0.v1.0.list.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule_policy.0.v1.0.list.0.allowed_result_receivers.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "configured_table_association_arn", "aws_cleanrooms_configured_table_association.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "configured_table_association_id", "aws_cleanrooms_configured_table_association.test", "configured_table_association_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "membership_id", "aws_cleanrooms_membership.test", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCleanRoomsConfiguredTableAssociationAnalysisRule_disappears(t *testing.T) {
+	var v cleanrooms.ConfiguredTableAssociationAnalysisRule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_configured_table_association_analysis_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cleanrooms.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfiguredTableAssociationAnalysisRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfiguredTableAssociationAnalysisRuleConfig_list(rName, "ALLOWED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfiguredTableAssociationAnalysisRuleExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfcleanrooms.ResourceConfiguredTableAssociationAnalysisRule(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckConfiguredTableAssociationAnalysisRuleDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cleanrooms_configured_table_association_analysis_rule" {
+			continue
+		}
+
+		_, err := tfcleanrooms.FindConfiguredTableAssociationAnalysisRuleByThreePartKey(context.Background(), conn, rs.Primary.Attributes["membership_id"], rs.Primary.Attributes["configured_table_association_id"], rs.Primary.Attributes["analysis_rule_type"])
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Clean Rooms Configured Table Association Analysis Rule %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckConfiguredTableAssociationAnalysisRuleExists(n string, v *cleanrooms.ConfiguredTableAssociationAnalysisRule) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Clean Rooms Configured Table Association Analysis Rule ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsConn
+
+		output, err := tfcleanrooms.FindConfiguredTableAssociationAnalysisRuleByThreePartKey(context.Background(), conn, rs.Primary.Attributes["membership_id"], rs.Primary.Attributes["configured_table_association_id"], rs.Primary.Attributes["analysis_rule_type"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccConfiguredTableAssociationAnalysisRuleConfig_list(rName, additionalAnalyses string) string {
+	return acctest.ConfigCompose(testAccConfiguredTableAssociationConfig_basic(rName, rName), fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_cleanrooms_configured_table_analysis_rule" "test" {
+  configured_table_id = aws_cleanrooms_configured_table.test.id
+  analysis_rule_type  = "LIST"
+
+  analysis_rule_policy {
+    v1 {
+      list {
+        additional_analyses = %[1]q
+        join_columns        = ["my_column_1"]
+        list_columns        = ["my_column_2"]
+      }
+    }
+  }
+}
+
+resource "aws_cleanrooms_configured_table_association_analysis_rule" "test" {
+  membership_id                   = aws_cleanrooms_membership.test.id
+  configured_table_association_id = aws_cleanrooms_configured_table_association.test.configured_table_association_id
+  analysis_rule_type              = "LIST"
+
+  analysis_rule_policy {
+    v1 {
+      list {
+        allowed_result_receivers = [data.aws_caller_identity.current.account_id]
+      }
+    }
+  }
+
+  depends_on = [aws_cleanrooms_configured_table_analysis_rule.test]
+}
+`, additionalAnalyses))
+}
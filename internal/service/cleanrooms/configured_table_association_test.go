@@ -0,0 +1,178 @@
+package cleanrooms_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cleanrooms"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcleanrooms "github.com/hashicorp/terraform-provider-aws/internal/service/cleanrooms"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccCleanRoomsConfiguredTableAssociation_basic(t *testing.T) {
+	var v cleanrooms.ConfiguredTableAssociation
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_configured_table_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cleanrooms.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfiguredTableAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfiguredTableAssociationConfig_basic(rName, "desc"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfiguredTableAssociationExists(resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "configured_table_arn", "aws_cleanrooms_configured_table.test", "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "configured_table_association_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "configured_table_id", "aws_cleanrooms_configured_table.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "description", "desc"),
+					resource.TestCheckResourceAttrPair(resourceName, "membership_arn", "aws_cleanrooms_membership.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "membership_id", "aws_cleanrooms_membership.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", "test_table"),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfiguredTableAssociationConfig_basic(rName, "updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfiguredTableAssociationExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCleanRoomsConfiguredTableAssociation_disappears(t *testing.T) {
+	var v cleanrooms.ConfiguredTableAssociation
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_configured_table_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cleanrooms.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfiguredTableAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfiguredTableAssociationConfig_basic(rName, "desc"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfiguredTableAssociationExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfcleanrooms.ResourceConfiguredTableAssociation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckConfiguredTableAssociationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cleanrooms_configured_table_association" {
+			continue
+		}
+
+		_, err := tfcleanrooms.FindConfiguredTableAssociationByTwoPartKey(context.Background(), conn, rs.Primary.Attributes["membership_id"], rs.Primary.Attributes["configured_table_association_id"])
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Clean Rooms Configured Table Association %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckConfiguredTableAssociationExists(n string, v *cleanrooms.ConfiguredTableAssociation) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Clean Rooms Configured Table Association ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsConn
+
+		output, err := tfcleanrooms.FindConfiguredTableAssociationByTwoPartKey(context.Background(), conn, rs.Primary.Attributes["membership_id"], rs.Primary.Attributes["configured_table_association_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccConfiguredTableAssociationConfig_basic(rName, description string) string {
+	return acctest.ConfigCompose(
+		testAccMembershipConfig_basic(rName, "DISABLED"),
+		testAccConfiguredTableConfig_basic(rName, rName),
+		fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "cleanrooms.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = ["glue:GetDatabase", "glue:GetDatabases", "glue:GetTable", "glue:GetTables", "glue:GetPartition", "glue:GetPartitions", "glue:BatchGetPartition", "s3:GetObject", "s3:ListBucket", "s3:GetBucketLocation"]
+      Effect   = "Allow"
+      Resource = "*"
+    }]
+  })
+}
+
+resource "aws_cleanrooms_configured_table_association" "test" {
+  membership_id       = aws_cleanrooms_membership.test.id
+  configured_table_id = aws_cleanrooms_configured_table.test.id
+  name                = "test_table"
+  description         = %[2]q
+  role_arn            = aws_iam_role.test.arn
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, description))
+}
@@ -0,0 +1,177 @@
+package cleanrooms_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cleanrooms"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcleanrooms "github.com/hashicorp/terraform-provider-aws/internal/service/cleanrooms"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccCleanRoomsConfiguredTable_basic(t *testing.T) {
+	var v cleanrooms.ConfiguredTable
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_configured_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cleanrooms.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfiguredTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfiguredTableConfig_basic(rName, "desc"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfiguredTableExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "allowed_columns.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "allowed_columns.*", "my_column_1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "allowed_columns.*", "my_column_2"),
+					resource.TestCheckResourceAttr(resourceName, "analysis_method", "DIRECT_QUERY"),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule_types.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "create_time"),
+					resource.TestCheckResourceAttr(resourceName, "description", "desc"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "table_reference.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "table_reference.0.database_name", "aws_glue_catalog_database.test", "name"),
+					resource.TestCheckResourceAttrPair(resourceName, "table_reference.0.table_name", "aws_glue_catalog_table.test", "name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfiguredTableConfig_basic(rName, "updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfiguredTableExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCleanRoomsConfiguredTable_disappears(t *testing.T) {
+	var v cleanrooms.ConfiguredTable
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_configured_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cleanrooms.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfiguredTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfiguredTableConfig_basic(rName, "desc"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfiguredTableExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfcleanrooms.ResourceConfiguredTable(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckConfiguredTableDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cleanrooms_configured_table" {
+			continue
+		}
+
+		_, err := tfcleanrooms.FindConfiguredTableByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Clean Rooms Configured Table %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckConfiguredTableExists(n string, v *cleanrooms.ConfiguredTable) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Clean Rooms Configured Table ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsConn
+
+		output, err := tfcleanrooms.FindConfiguredTableByID(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccConfiguredTableConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_glue_catalog_database" "test" {
+  name = replace(%[1]q, "-", "_")
+}
+
+resource "aws_glue_catalog_table" "test" {
+  database_name = aws_glue_catalog_database.test.name
+  name          = replace(%[1]q, "-", "_")
+  table_type    = "EXTERNAL_TABLE"
+
+  storage_descriptor {
+    location = "s3://%[1]s/data/"
+
+    columns {
+      name = "my_column_1"
+      type = "string"
+    }
+
+    columns {
+      name = "my_column_2"
+      type = "string"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccConfiguredTableConfig_basic(rName, description string) string {
+	return acctest.ConfigCompose(testAccConfiguredTableConfig_base(rName), fmt.Sprintf(`
+resource "aws_cleanrooms_configured_table" "test" {
+  name            = %[1]q
+  description     = %[2]q
+  analysis_method = "DIRECT_QUERY"
+  allowed_columns = ["my_column_1", "my_column_2"]
+
+  table_reference {
+    database_name = aws_glue_catalog_database.test.name
+    table_name    = aws_glue_catalog_table.test.name
+  }
+}
+`, rName, description))
+}
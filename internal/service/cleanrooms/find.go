@@ -0,0 +1,284 @@
+package cleanrooms
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cleanrooms"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindCollaborationByID(ctx context.Context, conn *cleanrooms.CleanRooms, id string) (*cleanrooms.Collaboration, error) {
+	input := &cleanrooms.GetCollaborationInput{
+		CollaborationIdentifier: aws.String(id),
+	}
+
+	output, err := conn.GetCollaborationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, cleanrooms.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Collaboration == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Collaboration, nil
+}
+
+func FindMembersByCollaborationID(ctx context.Context, conn *cleanrooms.CleanRooms, id string) ([]*cleanrooms.MemberSummary, error) {
+	input := &cleanrooms.ListMembersInput{
+		CollaborationIdentifier: aws.String(id),
+	}
+	var output []*cleanrooms.MemberSummary
+
+	err := conn.ListMembersPagesWithContext(ctx, input, func(page *cleanrooms.ListMembersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.MemberSummaries {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, cleanrooms.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func FindMembershipByID(ctx context.Context, conn *cleanrooms.CleanRooms, id string) (*cleanrooms.Membership, error) {
+	input := &cleanrooms.GetMembershipInput{
+		MembershipIdentifier: aws.String(id),
+	}
+
+	output, err := conn.GetMembershipWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, cleanrooms.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Membership == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if status := aws.StringValue(output.Membership.Status); status == cleanrooms.MembershipStatusRemoved || status == cleanrooms.MembershipStatusCollaborationDeleted {
+		return nil, &resource.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	return output.Membership, nil
+}
+
+func FindConfiguredTableByID(ctx context.Context, conn *cleanrooms.CleanRooms, id string) (*cleanrooms.ConfiguredTable, error) {
+	input := &cleanrooms.GetConfiguredTableInput{
+		ConfiguredTableIdentifier: aws.String(id),
+	}
+
+	output, err := conn.GetConfiguredTableWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, cleanrooms.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ConfiguredTable == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ConfiguredTable, nil
+}
+
+func FindConfiguredTableAssociationByTwoPartKey(ctx context.Context, conn *cleanrooms.CleanRooms, membershipID, id string) (*cleanrooms.ConfiguredTableAssociation, error) {
+	input := &cleanrooms.GetConfiguredTableAssociationInput{
+		ConfiguredTableAssociationIdentifier: aws.String(id),
+		MembershipIdentifier:                 aws.String(membershipID),
+	}
+
+	output, err := conn.GetConfiguredTableAssociationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, cleanrooms.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ConfiguredTableAssociation == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ConfiguredTableAssociation, nil
+}
+
+func FindConfiguredTableAnalysisRuleByTwoPartKey(ctx context.Context, conn *cleanrooms.CleanRooms, configuredTableID, analysisRuleType string) (*cleanrooms.ConfiguredTableAnalysisRule, error) {
+	input := &cleanrooms.GetConfiguredTableAnalysisRuleInput{
+		AnalysisRuleType:          aws.String(analysisRuleType),
+		ConfiguredTableIdentifier: aws.String(configuredTableID),
+	}
+
+	output, err := conn.GetConfiguredTableAnalysisRuleWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, cleanrooms.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.AnalysisRule == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.AnalysisRule, nil
+}
+
+func FindConfiguredTableAssociationAnalysisRuleByThreePartKey(ctx context.Context, conn *cleanrooms.CleanRooms, membershipID, associationID, analysisRuleType string) (*cleanrooms.ConfiguredTableAssociationAnalysisRule, error) {
+	input := &cleanrooms.GetConfiguredTableAssociationAnalysisRuleInput{
+		AnalysisRuleType:                     aws.String(analysisRuleType),
+		ConfiguredTableAssociationIdentifier: aws.String(associationID),
+		MembershipIdentifier:                 aws.String(membershipID),
+	}
+
+	output, err := conn.GetConfiguredTableAssociationAnalysisRuleWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, cleanrooms.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.AnalysisRule == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.AnalysisRule, nil
+}
+
+func FindAnalysisTemplateByTwoPartKey(ctx context.Context, conn *cleanrooms.CleanRooms, membershipID, id string) (*cleanrooms.AnalysisTemplate, error) {
+	input := &cleanrooms.GetAnalysisTemplateInput{
+		AnalysisTemplateIdentifier: aws.String(id),
+		MembershipIdentifier:       aws.String(membershipID),
+	}
+
+	output, err := conn.GetAnalysisTemplateWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, cleanrooms.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.AnalysisTemplate == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.AnalysisTemplate, nil
+}
+
+func FindPrivacyBudgetTemplateByTwoPartKey(ctx context.Context, conn *cleanrooms.CleanRooms, membershipID, id string) (*cleanrooms.PrivacyBudgetTemplate, error) {
+	input := &cleanrooms.GetPrivacyBudgetTemplateInput{
+		MembershipIdentifier:            aws.String(membershipID),
+		PrivacyBudgetTemplateIdentifier: aws.String(id),
+	}
+
+	output, err := conn.GetPrivacyBudgetTemplateWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, cleanrooms.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.PrivacyBudgetTemplate == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.PrivacyBudgetTemplate, nil
+}
+
+func FindIDMappingTableByTwoPartKey(ctx context.Context, conn *cleanrooms.CleanRooms, membershipID, id string) (*cleanrooms.IdMappingTable, error) {
+	input := &cleanrooms.GetIdMappingTableInput{
+		IdMappingTableIdentifier: aws.String(id),
+		MembershipIdentifier:     aws.String(membershipID),
+	}
+
+	output, err := conn.GetIdMappingTableWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, cleanrooms.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.IdMappingTable == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.IdMappingTable, nil
+}
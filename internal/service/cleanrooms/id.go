@@ -0,0 +1,25 @@
+package cleanrooms
+
+import (
+	"fmt"
+	"strings"
+)
+
+const resourceIDSeparator = ","
+
+func createResourceID(parentID, id string) string {
+	parts := []string{parentID, id}
+	id = strings.Join(parts, resourceIDSeparator)
+
+	return id
+}
+
+func parseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, resourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected PARENT-ID%[2]sRESOURCE-ID", id, resourceIDSeparator)
+}
@@ -0,0 +1,332 @@
+package cleanrooms
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cleanrooms"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceIDMappingTable() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceIDMappingTableCreate,
+		ReadWithoutTimeout:   resourceIDMappingTableRead,
+		UpdateWithoutTimeout: resourceIDMappingTableUpdate,
+		DeleteWithoutTimeout: resourceIDMappingTableDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"collaboration_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"collaboration_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"id_mapping_table_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"input_reference_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"input_reference_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"manage_resource_policies": {
+							Type:     schema.TypeBool,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"input_reference_properties": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id_mapping_table_input_source": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id_namespace_association_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"kms_key_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"membership_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"membership_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceIDMappingTableCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CleanRoomsConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	membershipID := d.Get("membership_id").(string)
+	name := d.Get("name").(string)
+	input := &cleanrooms.CreateIdMappingTableInput{
+		MembershipIdentifier: aws.String(membershipID),
+		Name:                 aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("input_reference_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+		input.InputReferenceConfig = &cleanrooms.IdMappingTableInputReferenceConfig{
+			InputReferenceArn:      aws.String(tfMap["input_reference_arn"].(string)),
+			ManageResourcePolicies: aws.Bool(tfMap["manage_resource_policies"].(bool)),
+		}
+	}
+
+	if v, ok := d.GetOk("kms_key_arn"); ok {
+		input.KmsKeyArn = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Clean Rooms ID Mapping Table: %s", input)
+	output, err := conn.CreateIdMappingTableWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Clean Rooms ID Mapping Table (%s): %s", name, err)
+	}
+
+	d.SetId(createResourceID(membershipID, aws.StringValue(output.IdMappingTable.Id)))
+
+	return resourceIDMappingTableRead(ctx, d, meta)
+}
+
+func resourceIDMappingTableRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CleanRoomsConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	membershipID, tableID, err := parseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	table, err := FindIDMappingTableByTwoPartKey(ctx, conn, membershipID, tableID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Clean Rooms ID Mapping Table (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Clean Rooms ID Mapping Table (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", table.Arn)
+	d.Set("collaboration_arn", table.CollaborationArn)
+	d.Set("collaboration_id", table.CollaborationId)
+	d.Set("create_time", aws.TimeValue(table.CreateTime).Format(time.RFC3339))
+	d.Set("description", table.Description)
+	d.Set("id_mapping_table_id", table.Id)
+	if v := table.InputReferenceConfig; v != nil {
+		if err := d.Set("input_reference_config", []interface{}{map[string]interface{}{
+			"input_reference_arn":      aws.StringValue(v.InputReferenceArn),
+			"manage_resource_policies": aws.BoolValue(v.ManageResourcePolicies),
+		}}); err != nil {
+			return diag.Errorf("setting input_reference_config: %s", err)
+		}
+	} else {
+		d.Set("input_reference_config", nil)
+	}
+	if err := d.Set("input_reference_properties", flattenIDMappingTableInputReferenceProperties(table.InputReferenceProperties)); err != nil {
+		return diag.Errorf("setting input_reference_properties: %s", err)
+	}
+	d.Set("kms_key_arn", table.KmsKeyArn)
+	d.Set("membership_arn", table.MembershipArn)
+	d.Set("membership_id", table.MembershipId)
+	d.Set("name", table.Name)
+	d.Set("update_time", aws.TimeValue(table.UpdateTime).Format(time.RFC3339))
+
+	tags, err := ListTagsWithContext(ctx, conn, d.Get("arn").(string))
+
+	if err != nil {
+		return diag.Errorf("listing tags for Clean Rooms ID Mapping Table (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceIDMappingTableUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CleanRoomsConn
+
+	if d.HasChanges("description", "kms_key_arn") {
+		membershipID, tableID, err := parseResourceID(d.Id())
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		input := &cleanrooms.UpdateIdMappingTableInput{
+			Description:              aws.String(d.Get("description").(string)),
+			IdMappingTableIdentifier: aws.String(tableID),
+			MembershipIdentifier:     aws.String(membershipID),
+		}
+
+		if v, ok := d.GetOk("kms_key_arn"); ok {
+			input.KmsKeyArn = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Updating Clean Rooms ID Mapping Table: %s", input)
+		_, err = conn.UpdateIdMappingTableWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating Clean Rooms ID Mapping Table (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating Clean Rooms ID Mapping Table (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceIDMappingTableRead(ctx, d, meta)
+}
+
+func resourceIDMappingTableDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CleanRoomsConn
+
+	membershipID, tableID, err := parseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting Clean Rooms ID Mapping Table: %s", d.Id())
+	_, err = conn.DeleteIdMappingTableWithContext(ctx, &cleanrooms.DeleteIdMappingTableInput{
+		IdMappingTableIdentifier: aws.String(tableID),
+		MembershipIdentifier:     aws.String(membershipID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, cleanrooms.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Clean Rooms ID Mapping Table (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func flattenIDMappingTableInputReferenceProperties(apiObject *cleanrooms.IdMappingTableInputReferenceProperties) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	var sources []interface{}
+
+	for _, v := range apiObject.IdMappingTableInputSource {
+		if v == nil {
+			continue
+		}
+
+		sources = append(sources, map[string]interface{}{
+			"id_namespace_association_id": aws.StringValue(v.IdNamespaceAssociationId),
+			"type":                        aws.StringValue(v.Type),
+		})
+	}
+
+	return []interface{}{map[string]interface{}{
+		"id_mapping_table_input_source": sources,
+	}}
+}
@@ -0,0 +1,128 @@
+package cleanrooms_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cleanrooms"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcleanrooms "github.com/hashicorp/terraform-provider-aws/internal/service/cleanrooms"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccCleanRoomsIDMappingTable_basic(t *testing.T) {
+	key := "CLEANROOMS_ID_MAPPING_WORKFLOW_ARN"
+	workflowARN := os.Getenv(key)
+	if workflowARN == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+
+	var v cleanrooms.IdMappingTable
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_id_mapping_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cleanrooms.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIDMappingTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIDMappingTableConfig_basic(rName, workflowARN, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDMappingTableExists(resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "description", "first"),
+					resource.TestCheckResourceAttr(resourceName, "input_reference_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "input_reference_config.0.input_reference_arn", workflowARN),
+					resource.TestCheckResourceAttr(resourceName, "input_reference_config.0.manage_resource_policies", "true"),
+					resource.TestCheckResourceAttr(resourceName, "input_reference_properties.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "membership_id", "aws_cleanrooms_membership.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccIDMappingTableConfig_basic(rName, workflowARN, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDMappingTableExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "second"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIDMappingTableDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cleanrooms_id_mapping_table" {
+			continue
+		}
+
+		_, err := tfcleanrooms.FindIDMappingTableByTwoPartKey(context.Background(), conn, rs.Primary.Attributes["membership_id"], rs.Primary.Attributes["id_mapping_table_id"])
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Clean Rooms ID Mapping Table %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckIDMappingTableExists(n string, v *cleanrooms.IdMappingTable) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Clean Rooms ID Mapping Table ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsConn
+
+		output, err := tfcleanrooms.FindIDMappingTableByTwoPartKey(context.Background(), conn, rs.Primary.Attributes["membership_id"], rs.Primary.Attributes["id_mapping_table_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccIDMappingTableConfig_basic(rName, workflowARN, description string) string {
+	return acctest.ConfigCompose(testAccMembershipConfig_basic(rName, "DISABLED"), fmt.Sprintf(`
+resource "aws_cleanrooms_id_mapping_table" "test" {
+  membership_id = aws_cleanrooms_membership.test.id
+  name          = %[1]q
+  description   = %[3]q
+
+  input_reference_config {
+    input_reference_arn      = %[2]q
+    manage_resource_policies = true
+  }
+}
+`, rName, workflowARN, description))
+}
@@ -0,0 +1,382 @@
+package cleanrooms
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cleanrooms"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceMembership() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceMembershipCreate,
+		ReadWithoutTimeout:   resourceMembershipRead,
+		UpdateWithoutTimeout: resourceMembershipUpdate,
+		DeleteWithoutTimeout: resourceMembershipDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"collaboration_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"collaboration_creator_account_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"collaboration_creator_display_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"collaboration_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"collaboration_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_result_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"output_configuration": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"s3": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"bucket": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"key_prefix": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"result_format": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(cleanrooms.ResultFormat_Values(), false),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"role_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+			"member_abilities": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"query_log_status": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(cleanrooms.MembershipQueryLogStatus_Values(), false),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceMembershipCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CleanRoomsConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	collaborationID := d.Get("collaboration_id").(string)
+	input := &cleanrooms.CreateMembershipInput{
+		CollaborationIdentifier: aws.String(collaborationID),
+		QueryLogStatus:          aws.String(d.Get("query_log_status").(string)),
+	}
+
+	if v, ok := d.GetOk("default_result_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.DefaultResultConfiguration = expandMembershipProtectedQueryResultConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Clean Rooms Membership: %s", input)
+	output, err := conn.CreateMembershipWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Clean Rooms Membership (%s): %s", collaborationID, err)
+	}
+
+	d.SetId(aws.StringValue(output.Membership.Id))
+
+	return resourceMembershipRead(ctx, d, meta)
+}
+
+func resourceMembershipRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CleanRoomsConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	membership, err := FindMembershipByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Clean Rooms Membership (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Clean Rooms Membership (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", membership.Arn)
+	d.Set("collaboration_arn", membership.CollaborationArn)
+	d.Set("collaboration_creator_account_id", membership.CollaborationCreatorAccountId)
+	d.Set("collaboration_creator_display_name", membership.CollaborationCreatorDisplayName)
+	d.Set("collaboration_id", membership.CollaborationId)
+	d.Set("collaboration_name", membership.CollaborationName)
+	d.Set("create_time", aws.TimeValue(membership.CreateTime).Format(time.RFC3339))
+	if membership.DefaultResultConfiguration != nil {
+		if err := d.Set("default_result_configuration", []interface{}{flattenMembershipProtectedQueryResultConfiguration(membership.DefaultResultConfiguration)}); err != nil {
+			return diag.Errorf("setting default_result_configuration: %s", err)
+		}
+	} else {
+		d.Set("default_result_configuration", nil)
+	}
+	d.Set("member_abilities", flex.FlattenStringList(membership.MemberAbilities))
+	d.Set("query_log_status", membership.QueryLogStatus)
+	d.Set("status", membership.Status)
+	d.Set("update_time", aws.TimeValue(membership.UpdateTime).Format(time.RFC3339))
+
+	tags, err := ListTagsWithContext(ctx, conn, d.Get("arn").(string))
+
+	if err != nil {
+		return diag.Errorf("listing tags for Clean Rooms Membership (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceMembershipUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CleanRoomsConn
+
+	if d.HasChanges("default_result_configuration", "query_log_status") {
+		input := &cleanrooms.UpdateMembershipInput{
+			MembershipIdentifier: aws.String(d.Id()),
+		}
+
+		if d.HasChange("default_result_configuration") {
+			if v, ok := d.GetOk("default_result_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.DefaultResultConfiguration = expandMembershipProtectedQueryResultConfiguration(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("query_log_status") {
+			input.QueryLogStatus = aws.String(d.Get("query_log_status").(string))
+		}
+
+		log.Printf("[DEBUG] Updating Clean Rooms Membership: %s", input)
+		_, err := conn.UpdateMembershipWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating Clean Rooms Membership (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating Clean Rooms Membership (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceMembershipRead(ctx, d, meta)
+}
+
+func resourceMembershipDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CleanRoomsConn
+
+	log.Printf("[DEBUG] Deleting Clean Rooms Membership: %s", d.Id())
+	_, err := conn.DeleteMembershipWithContext(ctx, &cleanrooms.DeleteMembershipInput{
+		MembershipIdentifier: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, cleanrooms.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Clean Rooms Membership (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandMembershipProtectedQueryResultConfiguration(tfMap map[string]interface{}) *cleanrooms.MembershipProtectedQueryResultConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cleanrooms.MembershipProtectedQueryResultConfiguration{}
+
+	if v, ok := tfMap["output_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.OutputConfiguration = expandMembershipProtectedQueryOutputConfiguration(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["role_arn"].(string); ok && v != "" {
+		apiObject.RoleArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandMembershipProtectedQueryOutputConfiguration(tfMap map[string]interface{}) *cleanrooms.MembershipProtectedQueryOutputConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cleanrooms.MembershipProtectedQueryOutputConfiguration{}
+
+	if v, ok := tfMap["s3"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.S3 = expandProtectedQueryS3OutputConfiguration(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandProtectedQueryS3OutputConfiguration(tfMap map[string]interface{}) *cleanrooms.ProtectedQueryS3OutputConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cleanrooms.ProtectedQueryS3OutputConfiguration{}
+
+	if v, ok := tfMap["bucket"].(string); ok && v != "" {
+		apiObject.Bucket = aws.String(v)
+	}
+
+	if v, ok := tfMap["key_prefix"].(string); ok && v != "" {
+		apiObject.KeyPrefix = aws.String(v)
+	}
+
+	if v, ok := tfMap["result_format"].(string); ok && v != "" {
+		apiObject.ResultFormat = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenMembershipProtectedQueryResultConfiguration(apiObject *cleanrooms.MembershipProtectedQueryResultConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.OutputConfiguration; v != nil {
+		tfMap["output_configuration"] = []interface{}{flattenMembershipProtectedQueryOutputConfiguration(v)}
+	}
+
+	if v := apiObject.RoleArn; v != nil {
+		tfMap["role_arn"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenMembershipProtectedQueryOutputConfiguration(apiObject *cleanrooms.MembershipProtectedQueryOutputConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.S3; v != nil {
+		tfMap["s3"] = []interface{}{flattenProtectedQueryS3OutputConfiguration(v)}
+	}
+
+	return tfMap
+}
+
+func flattenProtectedQueryS3OutputConfiguration(apiObject *cleanrooms.ProtectedQueryS3OutputConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Bucket; v != nil {
+		tfMap["bucket"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.KeyPrefix; v != nil {
+		tfMap["key_prefix"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.ResultFormat; v != nil {
+		tfMap["result_format"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
@@ -0,0 +1,201 @@
+package cleanrooms_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cleanrooms"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcleanrooms "github.com/hashicorp/terraform-provider-aws/internal/service/cleanrooms"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccCleanRoomsMembership_basic(t *testing.T) {
+	var v cleanrooms.Membership
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_membership.test"
+	collaborationResourceName := "aws_cleanrooms_collaboration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cleanrooms.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMembershipDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMembershipConfig_basic(rName, "DISABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMembershipExists(resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "collaboration_arn", collaborationResourceName, "arn"),
+					acctest.CheckResourceAttrAccountID(resourceName, "collaboration_creator_account_id"),
+					resource.TestCheckResourceAttr(resourceName, "collaboration_creator_display_name", "creator"),
+					resource.TestCheckResourceAttrPair(resourceName, "collaboration_id", collaborationResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "collaboration_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "default_result_configuration.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "member_abilities.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "query_log_status", "DISABLED"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMembershipConfig_basic(rName, "ENABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMembershipExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "query_log_status", "ENABLED"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCleanRoomsMembership_disappears(t *testing.T) {
+	var v cleanrooms.Membership
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_membership.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cleanrooms.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMembershipDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMembershipConfig_basic(rName, "DISABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMembershipExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfcleanrooms.ResourceMembership(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccCleanRoomsMembership_defaultResultConfiguration(t *testing.T) {
+	var v cleanrooms.Membership
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_membership.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cleanrooms.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMembershipDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMembershipConfig_defaultResultConfiguration(rName, "CSV"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMembershipExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "default_result_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "default_result_configuration.0.output_configuration.0.s3.0.key_prefix", "results/"),
+					resource.TestCheckResourceAttr(resourceName, "default_result_configuration.0.output_configuration.0.s3.0.result_format", "CSV"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMembershipConfig_defaultResultConfiguration(rName, "PARQUET"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMembershipExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "default_result_configuration.0.output_configuration.0.s3.0.result_format", "PARQUET"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckMembershipDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cleanrooms_membership" {
+			continue
+		}
+
+		_, err := tfcleanrooms.FindMembershipByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Clean Rooms Membership %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckMembershipExists(n string, v *cleanrooms.Membership) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Clean Rooms Membership ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsConn
+
+		output, err := tfcleanrooms.FindMembershipByID(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccMembershipConfig_basic(rName, queryLogStatus string) string {
+	return acctest.ConfigCompose(testAccCollaborationConfig_basic(rName, rName), fmt.Sprintf(`
+resource "aws_cleanrooms_membership" "test" {
+  collaboration_id = aws_cleanrooms_collaboration.test.id
+  query_log_status = %[1]q
+}
+`, queryLogStatus))
+}
+
+func testAccMembershipConfig_defaultResultConfiguration(rName, resultFormat string) string {
+	return acctest.ConfigCompose(testAccCollaborationConfig_basic(rName, rName), fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_cleanrooms_membership" "test" {
+  collaboration_id = aws_cleanrooms_collaboration.test.id
+  query_log_status = "DISABLED"
+
+  default_result_configuration {
+    output_configuration {
+      s3 {
+        bucket        = aws_s3_bucket.test.bucket
+        key_prefix    = "results/"
+        result_format = %[2]q
+      }
+    }
+  }
+}
+`, rName, resultFormat))
+}
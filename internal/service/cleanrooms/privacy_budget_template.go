@@ -0,0 +1,325 @@
+package cleanrooms
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cleanrooms"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourcePrivacyBudgetTemplate() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePrivacyBudgetTemplateCreate,
+		ReadWithoutTimeout:   resourcePrivacyBudgetTemplateRead,
+		UpdateWithoutTimeout: resourcePrivacyBudgetTemplateUpdate,
+		DeleteWithoutTimeout: resourcePrivacyBudgetTemplateDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"auto_refresh": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(cleanrooms.PrivacyBudgetTemplateAutoRefresh_Values(), false),
+			},
+			"collaboration_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"collaboration_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"membership_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"membership_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"parameters": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"differential_privacy": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"epsilon": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(1, 20),
+									},
+									"users_noise_per_query": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(10, 100),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"privacy_budget_template_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"privacy_budget_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      cleanrooms.PrivacyBudgetTypeDifferentialPrivacy,
+				ValidateFunc: validation.StringInSlice(cleanrooms.PrivacyBudgetType_Values(), false),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourcePrivacyBudgetTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CleanRoomsConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	membershipID := d.Get("membership_id").(string)
+	input := &cleanrooms.CreatePrivacyBudgetTemplateInput{
+		AutoRefresh:          aws.String(d.Get("auto_refresh").(string)),
+		MembershipIdentifier: aws.String(membershipID),
+		PrivacyBudgetType:    aws.String(d.Get("privacy_budget_type").(string)),
+	}
+
+	if v, ok := d.GetOk("parameters"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Parameters = expandPrivacyBudgetTemplateParametersInput(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Clean Rooms Privacy Budget Template: %s", input)
+	output, err := conn.CreatePrivacyBudgetTemplateWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Clean Rooms Privacy Budget Template (%s): %s", membershipID, err)
+	}
+
+	d.SetId(createResourceID(membershipID, aws.StringValue(output.PrivacyBudgetTemplate.Id)))
+
+	return resourcePrivacyBudgetTemplateRead(ctx, d, meta)
+}
+
+func resourcePrivacyBudgetTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CleanRoomsConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	membershipID, templateID, err := parseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	template, err := FindPrivacyBudgetTemplateByTwoPartKey(ctx, conn, membershipID, templateID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Clean Rooms Privacy Budget Template (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Clean Rooms Privacy Budget Template (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", template.Arn)
+	d.Set("auto_refresh", template.AutoRefresh)
+	d.Set("collaboration_arn", template.CollaborationArn)
+	d.Set("collaboration_id", template.CollaborationId)
+	d.Set("create_time", aws.TimeValue(template.CreateTime).Format(time.RFC3339))
+	d.Set("membership_arn", template.MembershipArn)
+	d.Set("membership_id", template.MembershipId)
+	if template.Parameters != nil {
+		if err := d.Set("parameters", []interface{}{flattenPrivacyBudgetTemplateParametersOutput(template.Parameters)}); err != nil {
+			return diag.Errorf("setting parameters: %s", err)
+		}
+	} else {
+		d.Set("parameters", nil)
+	}
+	d.Set("privacy_budget_template_id", template.Id)
+	d.Set("privacy_budget_type", template.PrivacyBudgetType)
+	d.Set("update_time", aws.TimeValue(template.UpdateTime).Format(time.RFC3339))
+
+	tags, err := ListTagsWithContext(ctx, conn, d.Get("arn").(string))
+
+	if err != nil {
+		return diag.Errorf("listing tags for Clean Rooms Privacy Budget Template (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourcePrivacyBudgetTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CleanRoomsConn
+
+	if d.HasChange("parameters") {
+		membershipID, templateID, err := parseResourceID(d.Id())
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		input := &cleanrooms.UpdatePrivacyBudgetTemplateInput{
+			MembershipIdentifier:            aws.String(membershipID),
+			PrivacyBudgetTemplateIdentifier: aws.String(templateID),
+			PrivacyBudgetType:               aws.String(d.Get("privacy_budget_type").(string)),
+		}
+
+		if v, ok := d.GetOk("parameters"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.Parameters = expandPrivacyBudgetTemplateUpdateParameters(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		log.Printf("[DEBUG] Updating Clean Rooms Privacy Budget Template: %s", input)
+		_, err = conn.UpdatePrivacyBudgetTemplateWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating Clean Rooms Privacy Budget Template (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating Clean Rooms Privacy Budget Template (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourcePrivacyBudgetTemplateRead(ctx, d, meta)
+}
+
+func resourcePrivacyBudgetTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CleanRoomsConn
+
+	membershipID, templateID, err := parseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting Clean Rooms Privacy Budget Template: %s", d.Id())
+	_, err = conn.DeletePrivacyBudgetTemplateWithContext(ctx, &cleanrooms.DeletePrivacyBudgetTemplateInput{
+		MembershipIdentifier:            aws.String(membershipID),
+		PrivacyBudgetTemplateIdentifier: aws.String(templateID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, cleanrooms.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Clean Rooms Privacy Budget Template (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandPrivacyBudgetTemplateParametersInput(tfMap map[string]interface{}) *cleanrooms.PrivacyBudgetTemplateParametersInput_ {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cleanrooms.PrivacyBudgetTemplateParametersInput_{}
+
+	if v, ok := tfMap["differential_privacy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.DifferentialPrivacy = &cleanrooms.DifferentialPrivacyTemplateParametersInput_{
+			Epsilon:            aws.Int64(int64(tfMap["epsilon"].(int))),
+			UsersNoisePerQuery: aws.Int64(int64(tfMap["users_noise_per_query"].(int))),
+		}
+	}
+
+	return apiObject
+}
+
+func expandPrivacyBudgetTemplateUpdateParameters(tfMap map[string]interface{}) *cleanrooms.PrivacyBudgetTemplateUpdateParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cleanrooms.PrivacyBudgetTemplateUpdateParameters{}
+
+	if v, ok := tfMap["differential_privacy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.DifferentialPrivacy = &cleanrooms.DifferentialPrivacyTemplateUpdateParameters{
+			Epsilon:            aws.Int64(int64(tfMap["epsilon"].(int))),
+			UsersNoisePerQuery: aws.Int64(int64(tfMap["users_noise_per_query"].(int))),
+		}
+	}
+
+	return apiObject
+}
+
+func flattenPrivacyBudgetTemplateParametersOutput(apiObject *cleanrooms.PrivacyBudgetTemplateParametersOutput_) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.DifferentialPrivacy; v != nil {
+		tfMap["differential_privacy"] = []interface{}{map[string]interface{}{
+			"epsilon":               aws.Int64Value(v.Epsilon),
+			"users_noise_per_query": aws.Int64Value(v.UsersNoisePerQuery),
+		}}
+	}
+
+	return tfMap
+}
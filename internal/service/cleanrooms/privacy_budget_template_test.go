@@ -0,0 +1,124 @@
+package cleanrooms_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cleanrooms"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcleanrooms "github.com/hashicorp/terraform-provider-aws/internal/service/cleanrooms"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccCleanRoomsPrivacyBudgetTemplate_basic(t *testing.T) {
+	var v cleanrooms.PrivacyBudgetTemplate
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_privacy_budget_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cleanrooms.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPrivacyBudgetTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPrivacyBudgetTemplateConfig_basic(rName, 1, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPrivacyBudgetTemplateExists(resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "auto_refresh", "CALENDAR_MONTH"),
+					resource.TestCheckResourceAttrPair(resourceName, "membership_id", "aws_cleanrooms_membership.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.differential_privacy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.differential_privacy.0.epsilon", "1"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.differential_privacy.0.users_noise_per_query", "10"),
+					resource.TestCheckResourceAttr(resourceName, "privacy_budget_type", "DIFFERENTIAL_PRIVACY"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPrivacyBudgetTemplateConfig_basic(rName, 5, 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPrivacyBudgetTemplateExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.differential_privacy.0.epsilon", "5"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.differential_privacy.0.users_noise_per_query", "20"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPrivacyBudgetTemplateDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cleanrooms_privacy_budget_template" {
+			continue
+		}
+
+		_, err := tfcleanrooms.FindPrivacyBudgetTemplateByTwoPartKey(context.Background(), conn, rs.Primary.Attributes["membership_id"], rs.Primary.Attributes["privacy_budget_template_id"])
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Clean Rooms Privacy Budget Template %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckPrivacyBudgetTemplateExists(n string, v *cleanrooms.PrivacyBudgetTemplate) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Clean Rooms Privacy Budget Template ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsConn
+
+		output, err := tfcleanrooms.FindPrivacyBudgetTemplateByTwoPartKey(context.Background(), conn, rs.Primary.Attributes["membership_id"], rs.Primary.Attributes["privacy_budget_template_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccPrivacyBudgetTemplateConfig_basic(rName string, epsilon, usersNoisePerQuery int) string {
+	return acctest.ConfigCompose(testAccMembershipConfig_basic(rName, "DISABLED"), fmt.Sprintf(`
+resource "aws_cleanrooms_privacy_budget_template" "test" {
+  membership_id = aws_cleanrooms_membership.test.id
+  auto_refresh  = "CALENDAR_MONTH"
+
+  parameters {
+    differential_privacy {
+      epsilon               = %[1]d
+      users_noise_per_query = %[2]d
+    }
+  }
+}
+`, epsilon, usersNoisePerQuery))
+}
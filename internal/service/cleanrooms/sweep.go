@@ -0,0 +1,115 @@
+//go:build sweep
+// +build sweep
+
+package cleanrooms
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cleanrooms"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_cleanrooms_collaboration", &resource.Sweeper{
+		Name: "aws_cleanrooms_collaboration",
+		F:    sweepCollaborations,
+	})
+
+	resource.AddTestSweepers("aws_cleanrooms_configured_table", &resource.Sweeper{
+		Name: "aws_cleanrooms_configured_table",
+		F:    sweepConfiguredTables,
+	})
+}
+
+func sweepCollaborations(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).CleanRoomsConn
+	input := &cleanrooms.ListCollaborationsInput{
+		MemberStatus: aws.String(cleanrooms.FilterableMemberStatusActive),
+	}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListCollaborationsPages(input, func(page *cleanrooms.ListCollaborationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.CollaborationList {
+			r := ResourceCollaboration()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Id))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Clean Rooms Collaboration sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Clean Rooms Collaborations (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Clean Rooms Collaborations (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepConfiguredTables(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).CleanRoomsConn
+	input := &cleanrooms.ListConfiguredTablesInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListConfiguredTablesPages(input, func(page *cleanrooms.ListConfiguredTablesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ConfiguredTableSummaries {
+			r := ResourceConfiguredTable()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Id))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Clean Rooms Configured Table sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Clean Rooms Configured Tables (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Clean Rooms Configured Tables (%s): %w", region, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,403 @@
+package cleanroomsml
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cleanroomsml"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceConfiguredAudienceModel() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceConfiguredAudienceModelCreate,
+		ReadWithoutTimeout:   resourceConfiguredAudienceModelRead,
+		UpdateWithoutTimeout: resourceConfiguredAudienceModelUpdate,
+		DeleteWithoutTimeout: resourceConfiguredAudienceModelDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"audience_model_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"audience_size_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"audience_size_bins": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeInt},
+						},
+						"audience_size_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(cleanroomsml.AudienceSizeType_Values(), false),
+						},
+					},
+				},
+			},
+			"child_resource_tag_on_create_policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(cleanroomsml.TagOnCreatePolicy_Values(), false),
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"min_matching_seed_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(25),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"output_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"destination": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"s3_destination": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"s3_uri": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"role_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+			"shared_audience_metrics": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(cleanroomsml.SharedAudienceMetrics_Values(), false),
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceConfiguredAudienceModelCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CleanRoomsMLConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &cleanroomsml.CreateConfiguredAudienceModelInput{
+		AudienceModelArn:      aws.String(d.Get("audience_model_arn").(string)),
+		Name:                  aws.String(name),
+		SharedAudienceMetrics: flex.ExpandStringSet(d.Get("shared_audience_metrics").(*schema.Set)),
+	}
+
+	if v, ok := d.GetOk("audience_size_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.AudienceSizeConfig = expandAudienceSizeConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("child_resource_tag_on_create_policy"); ok {
+		input.ChildResourceTagOnCreatePolicy = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("min_matching_seed_size"); ok {
+		input.MinMatchingSeedSize = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("output_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.OutputConfig = expandConfiguredAudienceModelOutputConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Clean Rooms ML Configured Audience Model: %s", input)
+	output, err := conn.CreateConfiguredAudienceModelWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Clean Rooms ML Configured Audience Model (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.ConfiguredAudienceModelArn))
+
+	return resourceConfiguredAudienceModelRead(ctx, d, meta)
+}
+
+func resourceConfiguredAudienceModelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CleanRoomsMLConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	model, err := FindConfiguredAudienceModelByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Clean Rooms ML Configured Audience Model (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Clean Rooms ML Configured Audience Model (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", model.ConfiguredAudienceModelArn)
+	d.Set("audience_model_arn", model.AudienceModelArn)
+	if model.AudienceSizeConfig != nil {
+		if err := d.Set("audience_size_config", []interface{}{flattenAudienceSizeConfig(model.AudienceSizeConfig)}); err != nil {
+			return diag.Errorf("setting audience_size_config: %s", err)
+		}
+	} else {
+		d.Set("audience_size_config", nil)
+	}
+	d.Set("child_resource_tag_on_create_policy", model.ChildResourceTagOnCreatePolicy)
+	d.Set("create_time", aws.TimeValue(model.CreateTime).Format(time.RFC3339))
+	d.Set("description", model.Description)
+	d.Set("min_matching_seed_size", model.MinMatchingSeedSize)
+	d.Set("name", model.Name)
+	if model.OutputConfig != nil {
+		if err := d.Set("output_config", []interface{}{flattenConfiguredAudienceModelOutputConfig(model.OutputConfig)}); err != nil {
+			return diag.Errorf("setting output_config: %s", err)
+		}
+	} else {
+		d.Set("output_config", nil)
+	}
+	d.Set("shared_audience_metrics", aws.StringValueSlice(model.SharedAudienceMetrics))
+	d.Set("status", model.Status)
+	d.Set("update_time", aws.TimeValue(model.UpdateTime).Format(time.RFC3339))
+
+	tags := KeyValueTags(model.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceConfiguredAudienceModelUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CleanRoomsMLConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &cleanroomsml.UpdateConfiguredAudienceModelInput{
+			ConfiguredAudienceModelArn: aws.String(d.Id()),
+		}
+
+		if d.HasChange("audience_model_arn") {
+			input.AudienceModelArn = aws.String(d.Get("audience_model_arn").(string))
+		}
+
+		if d.HasChange("audience_size_config") {
+			if v, ok := d.GetOk("audience_size_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.AudienceSizeConfig = expandAudienceSizeConfig(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("min_matching_seed_size") {
+			if v, ok := d.GetOk("min_matching_seed_size"); ok {
+				input.MinMatchingSeedSize = aws.Int64(int64(v.(int)))
+			}
+		}
+
+		if d.HasChange("output_config") {
+			if v, ok := d.GetOk("output_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.OutputConfig = expandConfiguredAudienceModelOutputConfig(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("shared_audience_metrics") {
+			input.SharedAudienceMetrics = flex.ExpandStringSet(d.Get("shared_audience_metrics").(*schema.Set))
+		}
+
+		log.Printf("[DEBUG] Updating Clean Rooms ML Configured Audience Model: %s", input)
+		_, err := conn.UpdateConfiguredAudienceModelWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating Clean Rooms ML Configured Audience Model (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Id(), o, n); err != nil {
+			return diag.Errorf("updating Clean Rooms ML Configured Audience Model (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceConfiguredAudienceModelRead(ctx, d, meta)
+}
+
+func resourceConfiguredAudienceModelDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CleanRoomsMLConn
+
+	log.Printf("[DEBUG] Deleting Clean Rooms ML Configured Audience Model: %s", d.Id())
+	_, err := conn.DeleteConfiguredAudienceModelWithContext(ctx, &cleanroomsml.DeleteConfiguredAudienceModelInput{
+		ConfiguredAudienceModelArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, cleanroomsml.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Clean Rooms ML Configured Audience Model (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandAudienceSizeConfig(tfMap map[string]interface{}) *cleanroomsml.AudienceSizeConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cleanroomsml.AudienceSizeConfig{}
+
+	if v, ok := tfMap["audience_size_bins"].([]interface{}); ok && len(v) > 0 {
+		apiObject.AudienceSizeBins = flex.ExpandInt64List(v)
+	}
+
+	if v, ok := tfMap["audience_size_type"].(string); ok && v != "" {
+		apiObject.AudienceSizeType = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandConfiguredAudienceModelOutputConfig(tfMap map[string]interface{}) *cleanroomsml.ConfiguredAudienceModelOutputConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cleanroomsml.ConfiguredAudienceModelOutputConfig{}
+
+	if v, ok := tfMap["destination"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Destination = &cleanroomsml.AudienceDestination{}
+
+		if v, ok := tfMap["s3_destination"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.Destination.S3Destination = &cleanroomsml.S3ConfigMap{
+				S3Uri: aws.String(tfMap["s3_uri"].(string)),
+			}
+		}
+	}
+
+	if v, ok := tfMap["role_arn"].(string); ok && v != "" {
+		apiObject.RoleArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenAudienceSizeConfig(apiObject *cleanroomsml.AudienceSizeConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"audience_size_bins": aws.Int64ValueSlice(apiObject.AudienceSizeBins),
+		"audience_size_type": aws.StringValue(apiObject.AudienceSizeType),
+	}
+}
+
+func flattenConfiguredAudienceModelOutputConfig(apiObject *cleanroomsml.ConfiguredAudienceModelOutputConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"role_arn": aws.StringValue(apiObject.RoleArn),
+	}
+
+	if v := apiObject.Destination; v != nil && v.S3Destination != nil {
+		tfMap["destination"] = []interface{}{map[string]interface{}{
+			"s3_destination": []interface{}{map[string]interface{}{
+				"s3_uri": aws.StringValue(v.S3Destination.S3Uri),
+			}},
+		}}
+	}
+
+	return tfMap
+}
@@ -0,0 +1,293 @@
+package cleanroomsml_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cleanroomsml"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcleanroomsml "github.com/hashicorp/terraform-provider-aws/internal/service/cleanroomsml"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccCleanRoomsMLConfiguredAudienceModel_basic(t *testing.T) {
+	audienceModelARN := testAccAudienceModelARN(t)
+	var v cleanroomsml.GetConfiguredAudienceModelOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanroomsml_configured_audience_model.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cleanroomsml.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfiguredAudienceModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfiguredAudienceModelConfig_basic(rName, audienceModelARN, "desc"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfiguredAudienceModelExists(resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "cleanrooms-ml", regexp.MustCompile(`configured-audience-model/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "audience_model_arn", audienceModelARN),
+					resource.TestCheckResourceAttr(resourceName, "audience_size_config.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "create_time"),
+					resource.TestCheckResourceAttr(resourceName, "description", "desc"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "output_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "output_config.0.destination.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "output_config.0.destination.0.s3_destination.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "output_config.0.role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "shared_audience_metrics.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "shared_audience_metrics.*", "NONE"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfiguredAudienceModelConfig_basic(rName, audienceModelARN, "updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfiguredAudienceModelExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCleanRoomsMLConfiguredAudienceModel_disappears(t *testing.T) {
+	audienceModelARN := testAccAudienceModelARN(t)
+	var v cleanroomsml.GetConfiguredAudienceModelOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanroomsml_configured_audience_model.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cleanroomsml.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfiguredAudienceModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfiguredAudienceModelConfig_basic(rName, audienceModelARN, "desc"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfiguredAudienceModelExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfcleanroomsml.ResourceConfiguredAudienceModel(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccCleanRoomsMLConfiguredAudienceModel_audienceSizeConfig(t *testing.T) {
+	audienceModelARN := testAccAudienceModelARN(t)
+	var v cleanroomsml.GetConfiguredAudienceModelOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanroomsml_configured_audience_model.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cleanroomsml.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfiguredAudienceModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfiguredAudienceModelConfig_audienceSizeConfig(rName, audienceModelARN),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfiguredAudienceModelExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "audience_size_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "audience_size_config.0.audience_size_bins.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "audience_size_config.0.audience_size_bins.0", "1000"),
+					resource.TestCheckResourceAttr(resourceName, "audience_size_config.0.audience_size_bins.1", "5000"),
+					resource.TestCheckResourceAttr(resourceName, "audience_size_config.0.audience_size_type", "ABSOLUTE"),
+					resource.TestCheckResourceAttr(resourceName, "min_matching_seed_size", "50"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckConfiguredAudienceModelDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsMLConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cleanroomsml_configured_audience_model" {
+			continue
+		}
+
+		_, err := tfcleanroomsml.FindConfiguredAudienceModelByARN(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Clean Rooms ML Configured Audience Model %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckConfiguredAudienceModelExists(n string, v *cleanroomsml.GetConfiguredAudienceModelOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Clean Rooms ML Configured Audience Model ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsMLConn
+
+		output, err := tfcleanroomsml.FindConfiguredAudienceModelByARN(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccPreCheck(t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsMLConn
+
+	_, err := conn.ListConfiguredAudienceModelsWithContext(context.Background(), &cleanroomsml.ListConfiguredAudienceModelsInput{})
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+// testAccAudienceModelARN returns the ARN of an existing audience model.
+// Audience models are trained from a training dataset and cannot be created by this provider.
+func testAccAudienceModelARN(t *testing.T) string {
+	key := "CLEANROOMSML_AUDIENCE_MODEL_ARN"
+	audienceModelARN := os.Getenv(key)
+	if audienceModelARN == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+
+	return audienceModelARN
+}
+
+func testAccConfiguredAudienceModelConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action    = "sts:AssumeRole"
+      Effect    = "Allow"
+      Principal = { Service = "cleanrooms-ml.${data.aws_partition.current.dns_suffix}" }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = [
+        "s3:GetBucketLocation",
+        "s3:ListBucket",
+        "s3:PutObject",
+      ]
+      Effect = "Allow"
+      Resource = [
+        aws_s3_bucket.test.arn,
+        "${aws_s3_bucket.test.arn}/*",
+      ]
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccConfiguredAudienceModelConfig_basic(rName, audienceModelARN, description string) string {
+	return acctest.ConfigCompose(testAccConfiguredAudienceModelConfig_base(rName), fmt.Sprintf(`
+resource "aws_cleanroomsml_configured_audience_model" "test" {
+  name                    = %[1]q
+  audience_model_arn      = %[2]q
+  description             = %[3]q
+  shared_audience_metrics = ["NONE"]
+
+  output_config {
+    role_arn = aws_iam_role.test.arn
+
+    destination {
+      s3_destination {
+        s3_uri = "s3://${aws_s3_bucket.test.bucket}/output/"
+      }
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, audienceModelARN, description))
+}
+
+func testAccConfiguredAudienceModelConfig_audienceSizeConfig(rName, audienceModelARN string) string {
+	return acctest.ConfigCompose(testAccConfiguredAudienceModelConfig_base(rName), fmt.Sprintf(`
+resource "aws_cleanroomsml_configured_audience_model" "test" {
+  name                    = %[1]q
+  audience_model_arn      = %[2]q
+  min_matching_seed_size  = 50
+  shared_audience_metrics = ["ALL"]
+
+  audience_size_config {
+    audience_size_bins = [1000, 5000]
+    audience_size_type = "ABSOLUTE"
+  }
+
+  output_config {
+    role_arn = aws_iam_role.test.arn
+
+    destination {
+      s3_destination {
+        s3_uri = "s3://${aws_s3_bucket.test.bucket}/output/"
+      }
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, audienceModelARN))
+}
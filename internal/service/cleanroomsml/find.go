@@ -0,0 +1,36 @@
+package cleanroomsml
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cleanroomsml"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindConfiguredAudienceModelByARN(ctx context.Context, conn *cleanroomsml.CleanRoomsML, arn string) (*cleanroomsml.GetConfiguredAudienceModelOutput, error) {
+	input := &cleanroomsml.GetConfiguredAudienceModelInput{
+		ConfiguredAudienceModelArn: aws.String(arn),
+	}
+
+	output, err := conn.GetConfiguredAudienceModelWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, cleanroomsml.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,65 @@
+//go:build sweep
+// +build sweep
+
+package cleanroomsml
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cleanroomsml"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_cleanroomsml_configured_audience_model", &resource.Sweeper{
+		Name: "aws_cleanroomsml_configured_audience_model",
+		F:    sweepConfiguredAudienceModels,
+	})
+}
+
+func sweepConfiguredAudienceModels(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).CleanRoomsMLConn
+	input := &cleanroomsml.ListConfiguredAudienceModelsInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListConfiguredAudienceModelsPages(input, func(page *cleanroomsml.ListConfiguredAudienceModelsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ConfiguredAudienceModels {
+			r := ResourceConfiguredAudienceModel()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.ConfiguredAudienceModelArn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Clean Rooms ML Configured Audience Model sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Clean Rooms ML Configured Audience Models (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Clean Rooms ML Configured Audience Models (%s): %w", region, err)
+	}
+
+	return nil
+}
@@ -720,6 +720,9 @@ func ExpandOrigin(m map[string]interface{}) *cloudfront.Origin {
 			origin.CustomOriginConfig = ExpandCustomOriginConfig(s[0].(map[string]interface{}))
 		}
 	}
+	if v, ok := m["origin_access_control_id"]; ok && v.(string) != "" {
+		origin.OriginAccessControlId = aws.String(v.(string))
+	}
 	if v, ok := m["origin_path"]; ok {
 		origin.OriginPath = aws.String(v.(string))
 	}
@@ -763,6 +766,9 @@ func FlattenOrigin(or *cloudfront.Origin) map[string]interface{} {
 	if or.CustomOriginConfig != nil {
 		m["custom_origin_config"] = []interface{}{FlattenCustomOriginConfig(or.CustomOriginConfig)}
 	}
+	if or.OriginAccessControlId != nil {
+		m["origin_access_control_id"] = aws.StringValue(or.OriginAccessControlId)
+	}
 	if or.OriginPath != nil {
 		m["origin_path"] = aws.StringValue(or.OriginPath)
 	}
@@ -894,6 +900,9 @@ func OriginHash(v interface{}) int {
 			buf.WriteString(fmt.Sprintf("%d-", customOriginConfigHash((s[0].(map[string]interface{})))))
 		}
 	}
+	if v, ok := m["origin_access_control_id"]; ok && v.(string) != "" {
+		buf.WriteString(fmt.Sprintf("%s-", v.(string)))
+	}
 	if v, ok := m["origin_path"]; ok {
 		buf.WriteString(fmt.Sprintf("%s-", v.(string)))
 	}
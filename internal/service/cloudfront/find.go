@@ -159,6 +159,31 @@ func FindMonitoringSubscriptionByDistributionID(conn *cloudfront.CloudFront, id
 	return output, nil
 }
 
+func FindOriginAccessControlByID(conn *cloudfront.CloudFront, id string) (*cloudfront.GetOriginAccessControlOutput, error) {
+	input := &cloudfront.GetOriginAccessControlInput{
+		Id: aws.String(id),
+	}
+
+	output, err := conn.GetOriginAccessControl(input)
+
+	if tfawserr.ErrCodeEquals(err, cloudfront.ErrCodeNoSuchOriginAccessControl) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.OriginAccessControl == nil || output.OriginAccessControl.OriginAccessControlConfig == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
 func FindOriginRequestPolicyByID(conn *cloudfront.CloudFront, id string) (*cloudfront.GetOriginRequestPolicyOutput, error) {
 	input := &cloudfront.GetOriginRequestPolicyInput{
 		Id: aws.String(id),
@@ -0,0 +1,407 @@
+package cloudfront
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceOriginAccessControl() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceOriginAccessControlCreate,
+		Read:   resourceOriginAccessControlRead,
+		Update: resourceOriginAccessControlUpdate,
+		Delete: resourceOriginAccessControlDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "Managed by Terraform",
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"migrate_distribution": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"distribution_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"origin_ids": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"update_origins": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+			"migration_bucket_policy": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"migration_origin_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"origin_access_control_origin_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(cloudfront.OriginAccessControlOriginTypes_Values(), false),
+			},
+			"signing_behavior": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(cloudfront.OriginAccessControlSigningBehaviors_Values(), false),
+			},
+			"signing_protocol": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(cloudfront.OriginAccessControlSigningProtocols_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceOriginAccessControlCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CloudFrontConn
+
+	name := d.Get("name").(string)
+	input := &cloudfront.CreateOriginAccessControlInput{
+		OriginAccessControlConfig: &cloudfront.OriginAccessControlConfig{
+			Description:                   aws.String(d.Get("description").(string)),
+			Name:                          aws.String(name),
+			OriginAccessControlOriginType: aws.String(d.Get("origin_access_control_origin_type").(string)),
+			SigningBehavior:               aws.String(d.Get("signing_behavior").(string)),
+			SigningProtocol:               aws.String(d.Get("signing_protocol").(string)),
+		},
+	}
+
+	log.Printf("[DEBUG] Creating CloudFront Origin Access Control: (%s)", input)
+	output, err := conn.CreateOriginAccessControl(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating CloudFront Origin Access Control (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.OriginAccessControl.Id))
+
+	if v, ok := d.GetOk("migrate_distribution"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+		distributionID := tfMap["distribution_id"].(string)
+
+		originIDs, policy, err := originAccessControlMigration(conn, meta.(*conns.AWSClient).Partition, distributionID, tfMap["origin_ids"].(*schema.Set))
+
+		if err != nil {
+			return fmt.Errorf("error planning CloudFront Distribution (%s) migration to Origin Access Control (%s): %w", distributionID, d.Id(), err)
+		}
+
+		d.Set("migration_bucket_policy", policy)
+		d.Set("migration_origin_ids", originIDs)
+
+		if tfMap["update_origins"].(bool) {
+			if err := updateDistributionOriginsToOriginAccessControl(conn, meta, distributionID, d.Id(), originIDs); err != nil {
+				return fmt.Errorf("error migrating CloudFront Distribution (%s) to Origin Access Control (%s): %w", distributionID, d.Id(), err)
+			}
+		}
+	}
+
+	return resourceOriginAccessControlRead(d, meta)
+}
+
+func resourceOriginAccessControlRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CloudFrontConn
+
+	output, err := FindOriginAccessControlByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudFront Origin Access Control (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading CloudFront Origin Access Control (%s): %w", d.Id(), err)
+	}
+
+	apiObject := output.OriginAccessControl.OriginAccessControlConfig
+	d.Set("description", apiObject.Description)
+	d.Set("etag", output.ETag)
+	d.Set("name", apiObject.Name)
+	d.Set("origin_access_control_origin_type", apiObject.OriginAccessControlOriginType)
+	d.Set("signing_behavior", apiObject.SigningBehavior)
+	d.Set("signing_protocol", apiObject.SigningProtocol)
+
+	return nil
+}
+
+func resourceOriginAccessControlUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CloudFrontConn
+
+	if d.HasChangesExcept("migrate_distribution") {
+		input := &cloudfront.UpdateOriginAccessControlInput{
+			Id:      aws.String(d.Id()),
+			IfMatch: aws.String(d.Get("etag").(string)),
+			OriginAccessControlConfig: &cloudfront.OriginAccessControlConfig{
+				Description:                   aws.String(d.Get("description").(string)),
+				Name:                          aws.String(d.Get("name").(string)),
+				OriginAccessControlOriginType: aws.String(d.Get("origin_access_control_origin_type").(string)),
+				SigningBehavior:               aws.String(d.Get("signing_behavior").(string)),
+				SigningProtocol:               aws.String(d.Get("signing_protocol").(string)),
+			},
+		}
+
+		log.Printf("[DEBUG] Updating CloudFront Origin Access Control: (%s)", input)
+		_, err := conn.UpdateOriginAccessControl(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating CloudFront Origin Access Control (%s): %w", d.Id(), err)
+		}
+	}
+
+	// The migration is planned when the origin access control is created. Afterwards the only change acted upon is
+	// setting update_origins, which re-points the origins planned for migration.
+	if d.HasChange("migrate_distribution") {
+		o, n := d.GetChange("migrate_distribution")
+
+		if migrateDistributionUpdateOrigins(n) && !migrateDistributionUpdateOrigins(o) {
+			if originIDs := flex.ExpandStringValueSet(d.Get("migration_origin_ids").(*schema.Set)); len(originIDs) > 0 {
+				distributionID := n.([]interface{})[0].(map[string]interface{})["distribution_id"].(string)
+
+				if err := updateDistributionOriginsToOriginAccessControl(conn, meta, distributionID, d.Id(), originIDs); err != nil {
+					return fmt.Errorf("error migrating CloudFront Distribution (%s) to Origin Access Control (%s): %w", distributionID, d.Id(), err)
+				}
+			}
+		}
+	}
+
+	return resourceOriginAccessControlRead(d, meta)
+}
+
+func resourceOriginAccessControlDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CloudFrontConn
+
+	log.Printf("[DEBUG] Deleting CloudFront Origin Access Control: (%s)", d.Id())
+	_, err := conn.DeleteOriginAccessControl(&cloudfront.DeleteOriginAccessControlInput{
+		Id:      aws.String(d.Id()),
+		IfMatch: aws.String(d.Get("etag").(string)),
+	})
+
+	if tfawserr.ErrCodeEquals(err, cloudfront.ErrCodeNoSuchOriginAccessControl) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting CloudFront Origin Access Control (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// s3OriginBucketRegexp matches the REST API endpoints of S3 buckets, e.g. "bucket.s3.amazonaws.com" or "bucket.s3.us-west-2.amazonaws.com".
+var s3OriginBucketRegexp = regexp.MustCompile(`^(.+)\.s3(?:[.-][a-z0-9-]+)?\.amazonaws\.com(?:\.cn)?$`)
+
+// originAccessControlMigration returns the S3 origins of the specified distribution that use a legacy origin access
+// identity (OAI) and a bucket policy granting the distribution access to those origins' buckets through an origin access
+// control (OAC). If originIDs is empty all such origins are returned.
+// The distribution is not modified: as documented for the OAI to OAC migration, the bucket policy must be in place
+// before the origins are re-pointed to the origin access control, see updateDistributionOriginsToOriginAccessControl.
+func originAccessControlMigration(conn *cloudfront.CloudFront, partition, distributionID string, originIDs *schema.Set) ([]string, string, error) {
+	output, err := FindDistributionByID(conn, distributionID)
+
+	if err != nil {
+		return nil, "", err
+	}
+
+	config := output.Distribution.DistributionConfig
+	var migrationOriginIDs, bucketNames []string
+	seenBucketNames := make(map[string]bool)
+
+	if config.Origins != nil {
+		for _, origin := range config.Origins.Items {
+			originID := aws.StringValue(origin.Id)
+
+			if originIDs.Len() > 0 && !originIDs.Contains(originID) {
+				continue
+			}
+
+			if origin.S3OriginConfig == nil || aws.StringValue(origin.S3OriginConfig.OriginAccessIdentity) == "" {
+				continue
+			}
+
+			domainName := aws.StringValue(origin.DomainName)
+			match := s3OriginBucketRegexp.FindStringSubmatch(domainName)
+
+			if match == nil {
+				return nil, "", fmt.Errorf("origin (%s) domain name (%s) is not an S3 bucket REST API endpoint", originID, domainName)
+			}
+
+			migrationOriginIDs = append(migrationOriginIDs, originID)
+			if !seenBucketNames[match[1]] {
+				seenBucketNames[match[1]] = true
+				bucketNames = append(bucketNames, match[1])
+			}
+		}
+	}
+
+	if len(migrationOriginIDs) == 0 {
+		return nil, "", fmt.Errorf("no S3 origins using an origin access identity found")
+	}
+
+	policy, err := originAccessControlBucketPolicy(partition, aws.StringValue(output.Distribution.ARN), bucketNames)
+
+	if err != nil {
+		return nil, "", err
+	}
+
+	return migrationOriginIDs, policy, nil
+}
+
+// updateDistributionOriginsToOriginAccessControl re-points the specified S3 origins of a distribution from their origin
+// access identity to the specified origin access control and waits for the distribution to deploy.
+// Origins already using the origin access control are left unchanged.
+func updateDistributionOriginsToOriginAccessControl(conn *cloudfront.CloudFront, meta interface{}, distributionID, oacID string, originIDs []string) error {
+	migrate := make(map[string]bool, len(originIDs))
+	for _, v := range originIDs {
+		migrate[v] = true
+	}
+
+	// The distribution is re-read on each attempt so that a concurrent change, rejected with PreconditionFailed, isn't overwritten.
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(2*time.Minute, func() (interface{}, error) {
+		output, err := FindDistributionByID(conn, distributionID)
+
+		if err != nil {
+			return nil, err
+		}
+
+		config := output.Distribution.DistributionConfig
+		var updated bool
+
+		if config.Origins != nil {
+			for _, origin := range config.Origins.Items {
+				if !migrate[aws.StringValue(origin.Id)] || aws.StringValue(origin.OriginAccessControlId) == oacID {
+					continue
+				}
+
+				origin.OriginAccessControlId = aws.String(oacID)
+				if origin.S3OriginConfig != nil {
+					origin.S3OriginConfig.OriginAccessIdentity = aws.String("")
+				}
+				updated = true
+			}
+		}
+
+		if !updated {
+			return nil, nil
+		}
+
+		input := &cloudfront.UpdateDistributionInput{
+			DistributionConfig: config,
+			Id:                 aws.String(distributionID),
+			IfMatch:            output.ETag,
+		}
+
+		log.Printf("[DEBUG] Updating CloudFront Distribution: (%s)", input)
+		return conn.UpdateDistribution(input)
+	}, cloudfront.ErrCodePreconditionFailed)
+
+	if err != nil {
+		return err
+	}
+
+	if err := DistributionWaitUntilDeployed(distributionID, meta); err != nil {
+		return fmt.Errorf("waiting until deployed: %w", err)
+	}
+
+	return nil
+}
+
+// migrateDistributionUpdateOrigins returns whether the specified migrate_distribution value has update_origins set.
+func migrateDistributionUpdateOrigins(v interface{}) bool {
+	if l, ok := v.([]interface{}); ok && len(l) > 0 && l[0] != nil {
+		return l[0].(map[string]interface{})["update_origins"].(bool)
+	}
+
+	return false
+}
+
+type originAccessControlPolicyStatement struct {
+	Sid       string                       `json:"Sid"`
+	Effect    string                       `json:"Effect"`
+	Principal map[string]string            `json:"Principal"`
+	Action    string                       `json:"Action"`
+	Resource  []string                     `json:"Resource"`
+	Condition map[string]map[string]string `json:"Condition"`
+}
+
+type originAccessControlPolicy struct {
+	Version   string                               `json:"Version"`
+	Statement []originAccessControlPolicyStatement `json:"Statement"`
+}
+
+// originAccessControlBucketPolicy returns the bucket policy statement CloudFront requires to read objects from the specified
+// buckets using an origin access control.
+func originAccessControlBucketPolicy(partition, distributionARN string, bucketNames []string) (string, error) {
+	var resources []string
+
+	for _, bucketName := range bucketNames {
+		resources = append(resources, fmt.Sprintf("arn:%s:s3:::%s/*", partition, bucketName))
+	}
+
+	policy := originAccessControlPolicy{
+		Version: "2012-10-17",
+		Statement: []originAccessControlPolicyStatement{
+			{
+				Sid:       "AllowCloudFrontServicePrincipalReadOnly",
+				Effect:    "Allow",
+				Principal: map[string]string{"Service": "cloudfront.amazonaws.com"},
+				Action:    "s3:GetObject",
+				Resource:  resources,
+				Condition: map[string]map[string]string{
+					"StringEquals": {
+						"AWS:SourceArn": distributionARN,
+					},
+				},
+			},
+		},
+	}
+
+	b, err := json.Marshal(policy)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
@@ -0,0 +1,306 @@
+package cloudfront_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcloudfront "github.com/hashicorp/terraform-provider-aws/internal/service/cloudfront"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccCloudFrontOriginAccessControl_basic(t *testing.T) {
+	var v cloudfront.GetOriginAccessControlOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudfront_origin_access_control.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(cloudfront.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudfront.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOriginAccessControlDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOriginAccessControlConfig_basic(rName, "always"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOriginAccessControlExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "Managed by Terraform"),
+					resource.TestCheckResourceAttrSet(resourceName, "etag"),
+					resource.TestCheckResourceAttr(resourceName, "migrate_distribution.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "origin_access_control_origin_type", "s3"),
+					resource.TestCheckResourceAttr(resourceName, "signing_behavior", "always"),
+					resource.TestCheckResourceAttr(resourceName, "signing_protocol", "sigv4"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccOriginAccessControlConfig_basic(rName, "no-override"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOriginAccessControlExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "signing_behavior", "no-override"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudFrontOriginAccessControl_disappears(t *testing.T) {
+	var v cloudfront.GetOriginAccessControlOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudfront_origin_access_control.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(cloudfront.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudfront.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOriginAccessControlDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOriginAccessControlConfig_basic(rName, "always"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOriginAccessControlExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfcloudfront.ResourceOriginAccessControl(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudFrontOriginAccessControl_migrateDistribution(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v cloudfront.GetOriginAccessControlOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudfront_origin_access_control.test"
+	distributionResourceName := "aws_cloudfront_distribution.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(cloudfront.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudfront.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOriginAccessControlDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOriginAccessControlConfig_migrateDistribution(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOriginAccessControlExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "migrate_distribution.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "migrate_distribution.0.update_origins", "false"),
+					resource.TestMatchResourceAttr(resourceName, "migration_bucket_policy", regexp.MustCompile(`cloudfront\.amazonaws\.com`)),
+					resource.TestCheckResourceAttr(resourceName, "migration_origin_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "migration_origin_ids.*", "test"),
+					testAccCheckOriginAccessControlDistributionNotModified(distributionResourceName, "test"),
+				),
+			},
+			{
+				Config: testAccOriginAccessControlConfig_migrateDistribution(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOriginAccessControlExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "migrate_distribution.0.update_origins", "true"),
+					testAccCheckOriginAccessControlDistributionMigrated(distributionResourceName, "test", &v),
+				),
+				// The aws_cloudfront_distribution resource's configuration still uses the origin access identity.
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckOriginAccessControlDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFrontConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cloudfront_origin_access_control" {
+			continue
+		}
+
+		_, err := tfcloudfront.FindOriginAccessControlByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("CloudFront Origin Access Control %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckOriginAccessControlExists(n string, v *cloudfront.GetOriginAccessControlOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No CloudFront Origin Access Control ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFrontConn
+
+		output, err := tfcloudfront.FindOriginAccessControlByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckOriginAccessControlDistributionNotModified(distributionName, originID string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFrontConn
+
+		output, err := tfcloudfront.FindDistributionByID(conn, s.RootModule().Resources[distributionName].Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		for _, origin := range output.Distribution.DistributionConfig.Origins.Items {
+			if aws.StringValue(origin.Id) != originID {
+				continue
+			}
+
+			if got := aws.StringValue(origin.OriginAccessControlId); got != "" {
+				return fmt.Errorf("origin (%s) unexpectedly uses origin access control %s", originID, got)
+			}
+
+			if origin.S3OriginConfig == nil || aws.StringValue(origin.S3OriginConfig.OriginAccessIdentity) == "" {
+				return fmt.Errorf("origin (%s) no longer uses an origin access identity", originID)
+			}
+
+			return nil
+		}
+
+		return fmt.Errorf("origin (%s) not found", originID)
+	}
+}
+
+func testAccCheckOriginAccessControlDistributionMigrated(distributionName, originID string, v *cloudfront.GetOriginAccessControlOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFrontConn
+
+		output, err := tfcloudfront.FindDistributionByID(conn, s.RootModule().Resources[distributionName].Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		for _, origin := range output.Distribution.DistributionConfig.Origins.Items {
+			if aws.StringValue(origin.Id) != originID {
+				continue
+			}
+
+			if got, want := aws.StringValue(origin.OriginAccessControlId), aws.StringValue(v.OriginAccessControl.Id); got != want {
+				return fmt.Errorf("origin (%s) uses origin access control %q, want %q", originID, got, want)
+			}
+
+			if origin.S3OriginConfig != nil && aws.StringValue(origin.S3OriginConfig.OriginAccessIdentity) != "" {
+				return fmt.Errorf("origin (%s) still uses an origin access identity", originID)
+			}
+
+			return nil
+		}
+
+		return fmt.Errorf("origin (%s) not found", originID)
+	}
+}
+
+func testAccOriginAccessControlConfig_basic(rName, signingBehavior string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudfront_origin_access_control" "test" {
+  name                              = %[1]q
+  origin_access_control_origin_type = "s3"
+  signing_behavior                  = %[2]q
+  signing_protocol                  = "sigv4"
+}
+`, rName, signingBehavior)
+}
+
+func testAccOriginAccessControlConfig_migrateDistribution(rName string, updateOrigins bool) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_cloudfront_origin_access_identity" "test" {
+  comment = %[1]q
+}
+
+resource "aws_cloudfront_distribution" "test" {
+  # Faster acceptance testing
+  enabled             = false
+  wait_for_deployment = false
+
+  origin {
+    domain_name = aws_s3_bucket.test.bucket_regional_domain_name
+    origin_id   = "test"
+
+    s3_origin_config {
+      origin_access_identity = aws_cloudfront_origin_access_identity.test.cloudfront_access_identity_path
+    }
+  }
+
+  default_cache_behavior {
+    allowed_methods        = ["GET", "HEAD"]
+    cached_methods         = ["GET", "HEAD"]
+    target_origin_id       = "test"
+    viewer_protocol_policy = "allow-all"
+
+    forwarded_values {
+      query_string = false
+
+      cookies {
+        forward = "none"
+      }
+    }
+  }
+
+  restrictions {
+    geo_restriction {
+      restriction_type = "none"
+    }
+  }
+
+  viewer_certificate {
+    cloudfront_default_certificate = true
+  }
+}
+
+resource "aws_cloudfront_origin_access_control" "test" {
+  name                              = %[1]q
+  origin_access_control_origin_type = "s3"
+  signing_behavior                  = "always"
+  signing_protocol                  = "sigv4"
+
+  migrate_distribution {
+    distribution_id = aws_cloudfront_distribution.test.id
+    update_origins  = %[2]t
+  }
+}
+`, rName, updateOrigins)
+}
@@ -1,6 +1,7 @@
 package cloudtrail
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -8,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudtrail"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -242,10 +244,21 @@ func ResourceCloudTrail() *schema.Resource { // nosemgrep:ci.cloudtrail-in-func-
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			resourceCloudTrailAdvancedEventSelectorCustomizeDiff,
+		),
 	}
 }
 
+func resourceCloudTrailAdvancedEventSelectorCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error { // nosemgrep:ci.cloudtrail-in-func-name
+	if !d.NewValueKnown("advanced_event_selector") {
+		return nil
+	}
+
+	return validTrailAdvancedEventSelectors(expandAdvancedEventSelector(d.Get("advanced_event_selector").([]interface{})))
+}
+
 func resourceCloudTrailCreate(d *schema.ResourceData, meta interface{}) error { // nosemgrep:ci.cloudtrail-in-func-name
 	conn := meta.(*conns.AWSClient).CloudTrailConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
@@ -31,21 +31,23 @@ func testAccErrorCheckSkip(t *testing.T) resource.ErrorCheckFunc {
 func TestAccCloudTrail_serial(t *testing.T) {
 	testCases := map[string]map[string]func(t *testing.T){
 		"Trail": {
-			"basic":                 testAcc_basic,
-			"cloudwatch":            testAcc_cloudWatch,
-			"enableLogging":         testAcc_enableLogging,
-			"globalServiceEvents":   testAcc_globalServiceEvents,
-			"multiRegion":           testAcc_multiRegion,
-			"organization":          testAcc_organization,
-			"logValidation":         testAcc_logValidation,
-			"kmsKey":                testAcc_kmsKey,
-			"tags":                  testAcc_tags,
-			"eventSelector":         testAcc_eventSelector,
-			"eventSelectorDynamoDB": testAcc_eventSelectorDynamoDB,
-			"eventSelectorExclude":  testAcc_eventSelectorExclude,
-			"insightSelector":       testAcc_insightSelector,
-			"advancedEventSelector": testAcc_advanced_event_selector,
-			"disappears":            testAcc_disappears,
+			"basic":                                testAcc_basic,
+			"cloudwatch":                           testAcc_cloudWatch,
+			"enableLogging":                        testAcc_enableLogging,
+			"globalServiceEvents":                  testAcc_globalServiceEvents,
+			"multiRegion":                          testAcc_multiRegion,
+			"organization":                         testAcc_organization,
+			"logValidation":                        testAcc_logValidation,
+			"kmsKey":                               testAcc_kmsKey,
+			"tags":                                 testAcc_tags,
+			"eventSelector":                        testAcc_eventSelector,
+			"eventSelectorDynamoDB":                testAcc_eventSelectorDynamoDB,
+			"eventSelectorExclude":                 testAcc_eventSelectorExclude,
+			"insightSelector":                      testAcc_insightSelector,
+			"advancedEventSelector":                testAcc_advanced_event_selector,
+			"advancedEventSelectorNetworkActivity": testAcc_advancedEventSelectorNetworkActivity,
+			"advancedEventSelectorInvalid":         testAcc_advancedEventSelectorInvalid,
+			"disappears":                           testAcc_disappears,
 		},
 	}
 
@@ -735,6 +737,69 @@ func testAcc_advanced_event_selector(t *testing.T) {
 	})
 }
 
+func testAcc_advancedEventSelectorNetworkActivity(t *testing.T) {
+	resourceName := "aws_cloudtrail.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudtrail.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudTrailConfig_advancedEventSelectorNetworkActivity(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "advanced_event_selector.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "advanced_event_selector.0.name", "kmsNetworkActivity"),
+					resource.TestCheckResourceAttr(resourceName, "advanced_event_selector.0.field_selector.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "advanced_event_selector.0.field_selector.*", map[string]string{
+						"field":    "eventCategory",
+						"equals.#": "1",
+						"equals.0": "NetworkActivity",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "advanced_event_selector.0.field_selector.*", map[string]string{
+						"field":    "eventSource",
+						"equals.#": "1",
+						"equals.0": "kms.amazonaws.com",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "advanced_event_selector.0.field_selector.*", map[string]string{
+						"field":    "errorCode",
+						"equals.#": "1",
+						"equals.0": "VpceAccessDenied",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAcc_advancedEventSelectorInvalid(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudtrail.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCloudTrailConfig_advancedEventSelectorInvalidOperator(rName),
+				ExpectError: regexp.MustCompile(`field "readOnly" only supports the equals operator`),
+			},
+			{
+				Config:      testAccCloudTrailConfig_advancedEventSelectorInvalidNetworkActivity(rName),
+				ExpectError: regexp.MustCompile(`field "eventSource" with the equals operator is required for NetworkActivity events`),
+			},
+		},
+	})
+}
+
 func testAcc_disappears(t *testing.T) {
 	var trail cloudtrail.Trail
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -1627,3 +1692,82 @@ resource "aws_s3_bucket" "test2" {
 }
 `, rName)
 }
+
+func testAccCloudTrailConfig_advancedEventSelectorNetworkActivity(rName string) string {
+	return acctest.ConfigCompose(testAccBaseConfig(rName), fmt.Sprintf(`
+resource "aws_cloudtrail" "test" {
+  # Must have bucket policy attached first
+  depends_on = [aws_s3_bucket_policy.test]
+
+  name           = %[1]q
+  s3_bucket_name = aws_s3_bucket.test.id
+
+  advanced_event_selector {
+    name = "kmsNetworkActivity"
+
+    field_selector {
+      field  = "eventCategory"
+      equals = ["NetworkActivity"]
+    }
+
+    field_selector {
+      field  = "eventSource"
+      equals = ["kms.amazonaws.com"]
+    }
+
+    field_selector {
+      field  = "errorCode"
+      equals = ["VpceAccessDenied"]
+    }
+  }
+}
+`, rName))
+}
+
+func testAccCloudTrailConfig_advancedEventSelectorInvalidOperator(rName string) string {
+	return acctest.ConfigCompose(testAccBaseConfig(rName), fmt.Sprintf(`
+resource "aws_cloudtrail" "test" {
+  # Must have bucket policy attached first
+  depends_on = [aws_s3_bucket_policy.test]
+
+  name           = %[1]q
+  s3_bucket_name = aws_s3_bucket.test.id
+
+  advanced_event_selector {
+    field_selector {
+      field  = "eventCategory"
+      equals = ["Management"]
+    }
+
+    field_selector {
+      field      = "readOnly"
+      not_equals = ["true"]
+    }
+  }
+}
+`, rName))
+}
+
+func testAccCloudTrailConfig_advancedEventSelectorInvalidNetworkActivity(rName string) string {
+	return acctest.ConfigCompose(testAccBaseConfig(rName), fmt.Sprintf(`
+resource "aws_cloudtrail" "test" {
+  # Must have bucket policy attached first
+  depends_on = [aws_s3_bucket_policy.test]
+
+  name           = %[1]q
+  s3_bucket_name = aws_s3_bucket.test.id
+
+  advanced_event_selector {
+    field_selector {
+      field  = "eventCategory"
+      equals = ["NetworkActivity"]
+    }
+
+    field_selector {
+      field  = "vpcEndpointId"
+      equals = ["vpce-12345678"]
+    }
+  }
+}
+`, rName))
+}
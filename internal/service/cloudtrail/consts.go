@@ -19,25 +19,47 @@ func resourceType_Values() []string {
 }
 
 const (
+	fieldErrorCode     = "errorCode"
 	fieldEventCategory = "eventCategory"
 	fieldEventName     = "eventName"
 	fieldEventSource   = "eventSource"
 	fieldReadOnly      = "readOnly"
 	fieldResourcesARN  = "resources.ARN"
 	fieldResourcesType = "resources.type"
+	fieldVPCEndpointID = "vpcEndpointId"
 )
 
 func field_Values() []string {
 	return []string{
+		fieldErrorCode,
 		fieldEventCategory,
 		fieldEventName,
 		fieldEventSource,
 		fieldReadOnly,
 		fieldResourcesARN,
 		fieldResourcesType,
+		fieldVPCEndpointID,
 	}
 }
 
+const (
+	eventCategoryData            = "Data"
+	eventCategoryManagement      = "Management"
+	eventCategoryNetworkActivity = "NetworkActivity"
+)
+
+func trailEventCategory_Values() []string {
+	return []string{
+		eventCategoryData,
+		eventCategoryManagement,
+		eventCategoryNetworkActivity,
+	}
+}
+
+const (
+	errorCodeVPCEAccessDenied = "VpceAccessDenied"
+)
+
 const (
 	propagationTimeout = 2 * time.Minute
 )
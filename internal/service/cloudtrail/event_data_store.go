@@ -2,6 +2,7 @@ package cloudtrail
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
@@ -9,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/cloudtrail"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -34,7 +36,16 @@ func ResourceEventDataStore() *schema.Resource {
 			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			customdiff.IfValue("federation_enabled", func(_ context.Context, v, _ interface{}) bool { return v.(bool) }, func(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+				if d.Get("federation_role_arn").(string) == "" {
+					return fmt.Errorf("federation_role_arn is required when federation_enabled is true")
+				}
+
+				return nil
+			}),
+		),
 
 		Schema: map[string]*schema.Schema{
 			"advanced_event_selector": {
@@ -132,6 +143,28 @@ func ResourceEventDataStore() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"billing_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      cloudtrail.BillingModeExtendableRetentionPricing,
+				ValidateFunc: validation.StringInSlice(cloudtrail.BillingMode_Values(), false),
+			},
+			"federation_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"federation_role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 350),
+			},
 			"multi_region_enabled": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -153,7 +186,7 @@ func ResourceEventDataStore() *schema.Resource {
 				Optional: true,
 				Default:  2555,
 				ValidateFunc: validation.All(
-					validation.IntBetween(7, 2555),
+					validation.IntBetween(7, 3653),
 				),
 			},
 			"tags":     tftags.TagsSchema(),
@@ -174,6 +207,7 @@ func resourceEventDataStoreCreate(ctx context.Context, d *schema.ResourceData, m
 
 	name := d.Get("name").(string)
 	input := &cloudtrail.CreateEventDataStoreInput{
+		BillingMode:                  aws.String(d.Get("billing_mode").(string)),
 		Name:                         aws.String(name),
 		OrganizationEnabled:          aws.Bool(d.Get("organization_enabled").(bool)),
 		MultiRegionEnabled:           aws.Bool(d.Get("multi_region_enabled").(bool)),
@@ -185,6 +219,10 @@ func resourceEventDataStoreCreate(ctx context.Context, d *schema.ResourceData, m
 		input.AdvancedEventSelectors = expandAdvancedEventSelector(d.Get("advanced_event_selector").([]interface{}))
 	}
 
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.KmsKeyId = aws.String(v.(string))
+	}
+
 	if len(tags) > 0 {
 		input.TagsList = Tags(tags.IgnoreAWS())
 	}
@@ -202,6 +240,12 @@ func resourceEventDataStoreCreate(ctx context.Context, d *schema.ResourceData, m
 		return diag.Errorf("error waiting for CloudTrail Event Data Store (%s) to be created: %s", name, err)
 	}
 
+	if d.Get("federation_enabled").(bool) {
+		if err := enableEventDataStoreFederation(ctx, conn, d.Id(), d.Get("federation_role_arn").(string), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.Errorf("error enabling CloudTrail Event Data Store (%s) federation: %s", d.Id(), err)
+		}
+	}
+
 	return resourceEventDataStoreRead(ctx, d, meta)
 }
 
@@ -226,6 +270,10 @@ func resourceEventDataStoreRead(ctx context.Context, d *schema.ResourceData, met
 		return diag.Errorf("error setting advanced_event_selector: %s", err)
 	}
 	d.Set("arn", eventDataStore.EventDataStoreArn)
+	d.Set("billing_mode", eventDataStore.BillingMode)
+	d.Set("federation_enabled", aws.StringValue(eventDataStore.FederationStatus) == cloudtrail.FederationStatusEnabled)
+	d.Set("federation_role_arn", eventDataStore.FederationRoleArn)
+	d.Set("kms_key_id", eventDataStore.KmsKeyId)
 	d.Set("multi_region_enabled", eventDataStore.MultiRegionEnabled)
 	d.Set("name", eventDataStore.Name)
 	d.Set("organization_enabled", eventDataStore.OrganizationEnabled)
@@ -255,11 +303,15 @@ func resourceEventDataStoreRead(ctx context.Context, d *schema.ResourceData, met
 func resourceEventDataStoreUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).CloudTrailConn
 
-	if d.HasChangesExcept("tags", "tags_all") {
+	if d.HasChangesExcept("tags", "tags_all", "federation_enabled", "federation_role_arn") {
 		input := &cloudtrail.UpdateEventDataStoreInput{
 			EventDataStore: aws.String(d.Id()),
 		}
 
+		if d.HasChange("billing_mode") {
+			input.BillingMode = aws.String(d.Get("billing_mode").(string))
+		}
+
 		if d.HasChange("name") {
 			input.Name = aws.String(d.Get("name").(string))
 		}
@@ -296,6 +348,23 @@ func resourceEventDataStoreUpdate(ctx context.Context, d *schema.ResourceData, m
 		}
 	}
 
+	if d.HasChanges("federation_enabled", "federation_role_arn") {
+		o, _ := d.GetChange("federation_enabled")
+
+		// Federation is disabled before it is re-enabled with a different role.
+		if o.(bool) {
+			if err := disableEventDataStoreFederation(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diag.Errorf("error disabling CloudTrail Event Data Store (%s) federation: %s", d.Id(), err)
+			}
+		}
+
+		if d.Get("federation_enabled").(bool) {
+			if err := enableEventDataStoreFederation(ctx, conn, d.Id(), d.Get("federation_role_arn").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diag.Errorf("error enabling CloudTrail Event Data Store (%s) federation: %s", d.Id(), err)
+			}
+		}
+	}
+
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 
@@ -311,6 +380,13 @@ func resourceEventDataStoreUpdate(ctx context.Context, d *schema.ResourceData, m
 func resourceEventDataStoreDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).CloudTrailConn
 
+	// An event data store can't be deleted while federation is enabled.
+	if d.Get("federation_enabled").(bool) {
+		if err := disableEventDataStoreFederation(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+			return diag.Errorf("error disabling CloudTrail Event Data Store (%s) federation: %s", d.Id(), err)
+		}
+	}
+
 	log.Printf("[DEBUG] Deleting CloudTrail Event Data Store: (%s)", d.Id())
 	_, err := conn.DeleteEventDataStoreWithContext(ctx, &cloudtrail.DeleteEventDataStoreInput{
 		EventDataStore: aws.String(d.Id()),
@@ -330,3 +406,36 @@ func resourceEventDataStoreDelete(ctx context.Context, d *schema.ResourceData, m
 
 	return nil
 }
+
+func enableEventDataStoreFederation(ctx context.Context, conn *cloudtrail.CloudTrail, arn, roleARN string, timeout time.Duration) error {
+	input := &cloudtrail.EnableFederationInput{
+		EventDataStore:    aws.String(arn),
+		FederationRoleArn: aws.String(roleARN),
+	}
+
+	log.Printf("[DEBUG] Enabling CloudTrail Event Data Store federation: %s", input)
+	if _, err := conn.EnableFederationWithContext(ctx, input); err != nil {
+		return err
+	}
+
+	return waitEventDataStoreFederationUpdated(ctx, conn, arn, cloudtrail.FederationStatusEnabled, timeout)
+}
+
+func disableEventDataStoreFederation(ctx context.Context, conn *cloudtrail.CloudTrail, arn string, timeout time.Duration) error {
+	input := &cloudtrail.DisableFederationInput{
+		EventDataStore: aws.String(arn),
+	}
+
+	log.Printf("[DEBUG] Disabling CloudTrail Event Data Store federation: %s", input)
+	_, err := conn.DisableFederationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, cloudtrail.ErrCodeEventDataStoreNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return waitEventDataStoreFederationUpdated(ctx, conn, arn, cloudtrail.FederationStatusDisabled, timeout)
+}
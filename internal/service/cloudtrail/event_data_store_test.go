@@ -39,6 +39,9 @@ func TestAccCloudTrailEventDataStore_basic(t *testing.T) {
 					}),
 					resource.TestCheckResourceAttr(resourceName, "advanced_event_selector.0.name", "Default management events"),
 					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "cloudtrail", regexp.MustCompile(`eventdatastore/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "billing_mode", "EXTENDABLE_RETENTION_PRICING"),
+					resource.TestCheckResourceAttr(resourceName, "federation_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "kms_key_id", ""),
 					resource.TestCheckResourceAttr(resourceName, "multi_region_enabled", "true"),
 					resource.TestCheckResourceAttr(resourceName, "name", rName),
 					resource.TestCheckResourceAttr(resourceName, "organization_enabled", "false"),
@@ -255,6 +258,103 @@ func TestAccCloudTrailEventDataStore_advancedEventSelector(t *testing.T) {
 	})
 }
 
+func TestAccCloudTrailEventDataStore_kmsKey(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudtrail_event_data_store.test"
+	kmsKeyResourceName := "aws_kms_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudtrail.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEventDataStoreDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEventDataStoreConfig_kmsKey(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEventDataStoreExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "kms_key_id", kmsKeyResourceName, "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudTrailEventDataStore_billingMode(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudtrail_event_data_store.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudtrail.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEventDataStoreDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEventDataStoreConfig_billingMode(rName, "FIXED_RETENTION_PRICING", 2555),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEventDataStoreExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "billing_mode", "FIXED_RETENTION_PRICING"),
+					resource.TestCheckResourceAttr(resourceName, "retention_period", "2555"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEventDataStoreConfig_billingMode(rName, "EXTENDABLE_RETENTION_PRICING", 3653),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEventDataStoreExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "billing_mode", "EXTENDABLE_RETENTION_PRICING"),
+					resource.TestCheckResourceAttr(resourceName, "retention_period", "3653"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudTrailEventDataStore_federation(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudtrail_event_data_store.test"
+	roleResourceName := "aws_iam_role.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudtrail.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEventDataStoreDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEventDataStoreConfig_federation(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEventDataStoreExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "federation_enabled", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "federation_role_arn", roleResourceName, "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEventDataStoreConfig_federation(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEventDataStoreExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "federation_enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckEventDataStoreExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -452,3 +552,85 @@ resource "aws_cloudtrail_event_data_store" "test" {
 }
 `, rName)
 }
+
+func testAccEventDataStoreConfig_kmsKey(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Sid    = "Enable IAM User Permissions"
+      Effect = "Allow"
+      Principal = {
+        AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"
+      }
+      Action   = "kms:*"
+      Resource = "*"
+    }, {
+      Sid    = "Allow CloudTrail to encrypt event data"
+      Effect = "Allow"
+      Principal = {
+        Service = "cloudtrail.${data.aws_partition.current.dns_suffix}"
+      }
+      Action   = ["kms:GenerateDataKey", "kms:Decrypt"]
+      Resource = "*"
+    }]
+  })
+}
+
+resource "aws_cloudtrail_event_data_store" "test" {
+  name       = %[1]q
+  kms_key_id = aws_kms_key.test.arn
+
+  termination_protection_enabled = false
+}
+`, rName)
+}
+
+func testAccEventDataStoreConfig_billingMode(rName, billingMode string, retentionPeriod int) string {
+	return fmt.Sprintf(`
+resource "aws_cloudtrail_event_data_store" "test" {
+  name             = %[1]q
+  billing_mode     = %[2]q
+  retention_period = %[3]d
+
+  termination_protection_enabled = false
+}
+`, rName, billingMode, retentionPeriod)
+}
+
+func testAccEventDataStoreConfig_federation(rName string, federationEnabled bool) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "lakeformation.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_cloudtrail_event_data_store" "test" {
+  name                = %[1]q
+  federation_enabled  = %[2]t
+  federation_role_arn = aws_iam_role.test.arn
+
+  termination_protection_enabled = false
+}
+`, rName, federationEnabled)
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/cloudtrail"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func FindEventDataStoreByARN(ctx context.Context, conn *cloudtrail.CloudTrail, eventDataStoreArn string) (*cloudtrail.GetEventDataStoreOutput, error) {
@@ -36,3 +37,28 @@ func FindEventDataStoreByARN(ctx context.Context, conn *cloudtrail.CloudTrail, e
 
 	return output, nil
 }
+
+func FindQueryByID(ctx context.Context, conn *cloudtrail.CloudTrail, id string) (*cloudtrail.DescribeQueryOutput, error) {
+	input := &cloudtrail.DescribeQueryInput{
+		QueryId: aws.String(id),
+	}
+
+	output, err := conn.DescribeQueryWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, cloudtrail.ErrCodeQueryIdNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,214 @@
+package cloudtrail
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudtrail"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+func DataSourceQuery() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceQueryRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"delivery_s3_uri": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"error_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"max_results": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1000,
+				ValidateFunc: validation.IntBetween(1, 1000),
+			},
+			"query_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"query_id", "query_statement"},
+			},
+			"query_parameters": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"query_id"},
+			},
+			"query_statement": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"query_id", "query_statement"},
+			},
+			"query_statistics": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bytes_scanned": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"creation_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"events_matched": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"events_scanned": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"execution_time_in_millis": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"query_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"results": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeMap,
+					Elem: &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceQueryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CloudTrailConn
+
+	queryID := d.Get("query_id").(string)
+
+	// A query statement starts a new query; a query ID references an existing one.
+	if v, ok := d.GetOk("query_statement"); ok {
+		input := &cloudtrail.StartQueryInput{
+			QueryStatement: aws.String(v.(string)),
+		}
+
+		if v, ok := d.GetOk("delivery_s3_uri"); ok {
+			input.DeliveryS3Uri = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("query_parameters"); ok && len(v.([]interface{})) > 0 {
+			input.QueryParameters = flex.ExpandStringList(v.([]interface{}))
+		}
+
+		output, err := conn.StartQueryWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error starting CloudTrail Query: %s", err)
+		}
+
+		queryID = aws.StringValue(output.QueryId)
+	}
+
+	query, err := waitQueryFinished(ctx, conn, queryID, d.Timeout(schema.TimeoutRead))
+
+	if err != nil {
+		return diag.Errorf("error waiting for CloudTrail Query (%s) to finish: %s", queryID, err)
+	}
+
+	results, err := findQueryResults(ctx, conn, queryID, d.Get("max_results").(int))
+
+	if err != nil {
+		return diag.Errorf("error reading CloudTrail Query (%s) results: %s", queryID, err)
+	}
+
+	d.SetId(queryID)
+	d.Set("delivery_s3_uri", query.DeliveryS3Uri)
+	d.Set("error_message", query.ErrorMessage)
+	d.Set("query_id", query.QueryId)
+	d.Set("query_statement", query.QueryString)
+	if err := d.Set("query_statistics", flattenQueryStatistics(query.QueryStatistics)); err != nil {
+		return diag.Errorf("error setting query_statistics: %s", err)
+	}
+	d.Set("query_status", query.QueryStatus)
+	if err := d.Set("results", results); err != nil {
+		return diag.Errorf("error setting results: %s", err)
+	}
+
+	return nil
+}
+
+// findQueryResults returns up to maxResults rows of a finished query.
+// Each row is returned as a map of column name to value.
+func findQueryResults(ctx context.Context, conn *cloudtrail.CloudTrail, queryID string, maxResults int) ([]interface{}, error) {
+	input := &cloudtrail.GetQueryResultsInput{
+		QueryId: aws.String(queryID),
+	}
+	var results []interface{}
+
+	err := conn.GetQueryResultsPagesWithContext(ctx, input, func(page *cloudtrail.GetQueryResultsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, row := range page.QueryResultRows {
+			if len(results) >= maxResults {
+				return false
+			}
+
+			tfMap := map[string]interface{}{}
+
+			for _, column := range row {
+				for k, v := range column {
+					tfMap[k] = aws.StringValue(v)
+				}
+			}
+
+			results = append(results, tfMap)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+func flattenQueryStatistics(apiObject *cloudtrail.QueryStatisticsForDescribeQuery) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"bytes_scanned":            aws.Int64Value(apiObject.BytesScanned),
+		"events_matched":           aws.Int64Value(apiObject.EventsMatched),
+		"events_scanned":           aws.Int64Value(apiObject.EventsScanned),
+		"execution_time_in_millis": aws.Int64Value(apiObject.ExecutionTimeInMillis),
+	}
+
+	if v := apiObject.CreationTime; v != nil {
+		tfMap["creation_time"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,87 @@
+package cloudtrail_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudtrail"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccCloudTrailQueryDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_cloudtrail_query.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudtrail.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEventDataStoreDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueryDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "query_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "query_statistics.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "query_status", "FINISHED"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "results.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudTrailQueryDataSource_queryID(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_cloudtrail_query.by_id"
+	queryDataSourceName := "data.aws_cloudtrail_query.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudtrail.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEventDataStoreDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueryDataSourceConfig_queryID(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "query_id", queryDataSourceName, "query_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "query_statement", queryDataSourceName, "query_statement"),
+					resource.TestCheckResourceAttr(dataSourceName, "query_status", "FINISHED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccQueryDataSourceConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudtrail_event_data_store" "test" {
+  name = %[1]q
+
+  termination_protection_enabled = false
+}
+
+locals {
+  event_data_store_id = element(split("/", aws_cloudtrail_event_data_store.test.arn), 1)
+}
+`, rName)
+}
+
+func testAccQueryDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccQueryDataSourceConfig_base(rName), `
+data "aws_cloudtrail_query" "test" {
+  query_statement = "SELECT eventName, eventSource FROM ${local.event_data_store_id} LIMIT 10"
+}
+`)
+}
+
+func testAccQueryDataSourceConfig_queryID(rName string) string {
+	return acctest.ConfigCompose(testAccQueryDataSourceConfig_basic(rName), `
+data "aws_cloudtrail_query" "by_id" {
+  query_id = data.aws_cloudtrail_query.test.query_id
+}
+`)
+}
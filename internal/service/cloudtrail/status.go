@@ -24,3 +24,35 @@ func statusEventDataStore(ctx context.Context, conn *cloudtrail.CloudTrail, arn
 		return eventDataStore, aws.StringValue(eventDataStore.Status), nil
 	}
 }
+
+func statusEventDataStoreFederation(ctx context.Context, conn *cloudtrail.CloudTrail, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		eventDataStore, err := FindEventDataStoreByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return eventDataStore, aws.StringValue(eventDataStore.FederationStatus), nil
+	}
+}
+
+func statusQuery(ctx context.Context, conn *cloudtrail.CloudTrail, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindQueryByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.QueryStatus), nil
+	}
+}
@@ -0,0 +1,114 @@
+package cloudtrail
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudtrail"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// validTrailAdvancedEventSelectors checks the field and operator combinations of a
+// trail's advanced event selectors so that invalid selectors fail at plan time
+// rather than when the selectors are put on the trail.
+func validTrailAdvancedEventSelectors(apiObjects []*cloudtrail.AdvancedEventSelector) error {
+	for i, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		if err := validTrailAdvancedEventSelector(apiObject); err != nil {
+			return fmt.Errorf("advanced_event_selector.%d (%s): %w", i, aws.StringValue(apiObject.Name), err)
+		}
+	}
+
+	return nil
+}
+
+func validTrailAdvancedEventSelector(apiObject *cloudtrail.AdvancedEventSelector) error {
+	fieldSelectors := make(map[string]*cloudtrail.AdvancedFieldSelector)
+
+	for _, fieldSelector := range apiObject.FieldSelectors {
+		if fieldSelector == nil {
+			continue
+		}
+
+		field := aws.StringValue(fieldSelector.Field)
+
+		if _, ok := fieldSelectors[field]; ok {
+			return fmt.Errorf("field %q can only be specified once", field)
+		}
+
+		fieldSelectors[field] = fieldSelector
+
+		if len(fieldSelector.Equals) == 0 && len(fieldSelector.NotEquals) == 0 &&
+			len(fieldSelector.StartsWith) == 0 && len(fieldSelector.NotStartsWith) == 0 &&
+			len(fieldSelector.EndsWith) == 0 && len(fieldSelector.NotEndsWith) == 0 {
+			return fmt.Errorf("field %q must specify at least one operator", field)
+		}
+
+		switch field {
+		case fieldErrorCode, fieldEventCategory, fieldReadOnly, fieldResourcesType:
+			if len(fieldSelector.NotEquals) > 0 || len(fieldSelector.StartsWith) > 0 || len(fieldSelector.NotStartsWith) > 0 ||
+				len(fieldSelector.EndsWith) > 0 || len(fieldSelector.NotEndsWith) > 0 {
+				return fmt.Errorf("field %q only supports the equals operator", field)
+			}
+		}
+	}
+
+	eventCategory, ok := fieldSelectors[fieldEventCategory]
+
+	if !ok {
+		return fmt.Errorf("field %q is required", fieldEventCategory)
+	}
+
+	categories := make(map[string]bool)
+
+	for _, category := range aws.StringValueSlice(eventCategory.Equals) {
+		if _, errs := validation.StringInSlice(trailEventCategory_Values(), false)(category, fieldEventCategory); len(errs) > 0 {
+			return fmt.Errorf("field %q value %q must be one of %q", fieldEventCategory, category, trailEventCategory_Values())
+		}
+
+		categories[category] = true
+	}
+
+	if categories[eventCategoryNetworkActivity] {
+		if len(categories) > 1 {
+			return fmt.Errorf("field %q value %q cannot be combined with other event categories", fieldEventCategory, eventCategoryNetworkActivity)
+		}
+
+		if v, ok := fieldSelectors[fieldEventSource]; !ok || len(v.Equals) == 0 {
+			return fmt.Errorf("field %q with the equals operator is required for %s events", fieldEventSource, eventCategoryNetworkActivity)
+		}
+
+		for _, field := range []string{fieldResourcesARN, fieldResourcesType} {
+			if _, ok := fieldSelectors[field]; ok {
+				return fmt.Errorf("field %q is not supported for %s events", field, eventCategoryNetworkActivity)
+			}
+		}
+
+		if v, ok := fieldSelectors[fieldErrorCode]; ok {
+			for _, errorCode := range aws.StringValueSlice(v.Equals) {
+				if errorCode != errorCodeVPCEAccessDenied {
+					return fmt.Errorf("field %q value %q must be %q", fieldErrorCode, errorCode, errorCodeVPCEAccessDenied)
+				}
+			}
+		}
+
+		return nil
+	}
+
+	for _, field := range []string{fieldErrorCode, fieldVPCEndpointID} {
+		if _, ok := fieldSelectors[field]; ok {
+			return fmt.Errorf("field %q is only supported for %s events", field, eventCategoryNetworkActivity)
+		}
+	}
+
+	if categories[eventCategoryData] {
+		if _, ok := fieldSelectors[fieldResourcesType]; !ok {
+			return fmt.Errorf("field %q is required for %s events", fieldResourcesType, eventCategoryData)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,162 @@
+package cloudtrail
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudtrail"
+)
+
+func TestValidTrailAdvancedEventSelectors(t *testing.T) {
+	t.Parallel()
+
+	fieldSelector := func(field string, equals ...string) *cloudtrail.AdvancedFieldSelector {
+		return &cloudtrail.AdvancedFieldSelector{
+			Field:  aws.String(field),
+			Equals: aws.StringSlice(equals),
+		}
+	}
+
+	testCases := map[string]struct {
+		fieldSelectors []*cloudtrail.AdvancedFieldSelector
+		expectError    bool
+	}{
+		"management": {
+			fieldSelectors: []*cloudtrail.AdvancedFieldSelector{
+				fieldSelector(fieldEventCategory, eventCategoryManagement),
+			},
+		},
+		"data": {
+			fieldSelectors: []*cloudtrail.AdvancedFieldSelector{
+				fieldSelector(fieldEventCategory, eventCategoryData),
+				fieldSelector(fieldResourcesType, resourceTypeS3Object),
+				{
+					Field:      aws.String(fieldResourcesARN),
+					StartsWith: aws.StringSlice([]string{"arn:aws:s3:::example/"}),
+				},
+			},
+		},
+		"data without resources.type": {
+			fieldSelectors: []*cloudtrail.AdvancedFieldSelector{
+				fieldSelector(fieldEventCategory, eventCategoryData),
+			},
+			expectError: true,
+		},
+		"network activity": {
+			fieldSelectors: []*cloudtrail.AdvancedFieldSelector{
+				fieldSelector(fieldEventCategory, eventCategoryNetworkActivity),
+				fieldSelector(fieldEventSource, "kms.amazonaws.com"),
+				fieldSelector(fieldErrorCode, errorCodeVPCEAccessDenied),
+				{
+					Field:     aws.String(fieldVPCEndpointID),
+					NotEquals: aws.StringSlice([]string{"vpce-12345678"}),
+				},
+			},
+		},
+		"network activity without eventSource": {
+			fieldSelectors: []*cloudtrail.AdvancedFieldSelector{
+				fieldSelector(fieldEventCategory, eventCategoryNetworkActivity),
+			},
+			expectError: true,
+		},
+		"network activity with resources.type": {
+			fieldSelectors: []*cloudtrail.AdvancedFieldSelector{
+				fieldSelector(fieldEventCategory, eventCategoryNetworkActivity),
+				fieldSelector(fieldEventSource, "kms.amazonaws.com"),
+				fieldSelector(fieldResourcesType, resourceTypeS3Object),
+			},
+			expectError: true,
+		},
+		"network activity with invalid errorCode": {
+			fieldSelectors: []*cloudtrail.AdvancedFieldSelector{
+				fieldSelector(fieldEventCategory, eventCategoryNetworkActivity),
+				fieldSelector(fieldEventSource, "kms.amazonaws.com"),
+				fieldSelector(fieldErrorCode, "AccessDenied"),
+			},
+			expectError: true,
+		},
+		"network activity combined with data": {
+			fieldSelectors: []*cloudtrail.AdvancedFieldSelector{
+				fieldSelector(fieldEventCategory, eventCategoryNetworkActivity, eventCategoryData),
+				fieldSelector(fieldEventSource, "kms.amazonaws.com"),
+			},
+			expectError: true,
+		},
+		"vpcEndpointId outside network activity": {
+			fieldSelectors: []*cloudtrail.AdvancedFieldSelector{
+				fieldSelector(fieldEventCategory, eventCategoryManagement),
+				fieldSelector(fieldVPCEndpointID, "vpce-12345678"),
+			},
+			expectError: true,
+		},
+		"missing eventCategory": {
+			fieldSelectors: []*cloudtrail.AdvancedFieldSelector{
+				fieldSelector(fieldEventName, "PutObject"),
+			},
+			expectError: true,
+		},
+		"invalid eventCategory": {
+			fieldSelectors: []*cloudtrail.AdvancedFieldSelector{
+				fieldSelector(fieldEventCategory, "Insight"),
+			},
+			expectError: true,
+		},
+		"eventCategory with starts_with": {
+			fieldSelectors: []*cloudtrail.AdvancedFieldSelector{
+				{
+					Field:      aws.String(fieldEventCategory),
+					StartsWith: aws.StringSlice([]string{"Manage"}),
+				},
+			},
+			expectError: true,
+		},
+		"readOnly with not_equals": {
+			fieldSelectors: []*cloudtrail.AdvancedFieldSelector{
+				fieldSelector(fieldEventCategory, eventCategoryManagement),
+				{
+					Field:     aws.String(fieldReadOnly),
+					NotEquals: aws.StringSlice([]string{"true"}),
+				},
+			},
+			expectError: true,
+		},
+		"no operator": {
+			fieldSelectors: []*cloudtrail.AdvancedFieldSelector{
+				fieldSelector(fieldEventCategory, eventCategoryManagement),
+				{
+					Field: aws.String(fieldEventName),
+				},
+			},
+			expectError: true,
+		},
+		"duplicate field": {
+			fieldSelectors: []*cloudtrail.AdvancedFieldSelector{
+				fieldSelector(fieldEventCategory, eventCategoryManagement),
+				fieldSelector(fieldEventName, "CreateTrail"),
+				fieldSelector(fieldEventName, "DeleteTrail"),
+			},
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validTrailAdvancedEventSelectors([]*cloudtrail.AdvancedEventSelector{{
+				Name:           aws.String(name),
+				FieldSelectors: testCase.fieldSelectors,
+			}})
+
+			if err == nil && testCase.expectError {
+				t.Fatal("expected error, got none")
+			}
+
+			if err != nil && !testCase.expectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
@@ -2,10 +2,13 @@ package cloudtrail
 
 import (
 	"context"
+	"errors"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudtrail"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func waitEventDataStoreAvailable(ctx context.Context, conn *cloudtrail.CloudTrail, arn string, timeout time.Duration) error {
@@ -33,3 +36,37 @@ func waitEventDataStoreDeleted(ctx context.Context, conn *cloudtrail.CloudTrail,
 
 	return err
 }
+
+func waitEventDataStoreFederationUpdated(ctx context.Context, conn *cloudtrail.CloudTrail, arn, target string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{cloudtrail.FederationStatusEnabling, cloudtrail.FederationStatusDisabling},
+		Target:  []string{target},
+		Refresh: statusEventDataStoreFederation(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
+}
+
+func waitQueryFinished(ctx context.Context, conn *cloudtrail.CloudTrail, id string, timeout time.Duration) (*cloudtrail.DescribeQueryOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{cloudtrail.QueryStatusQueued, cloudtrail.QueryStatusRunning},
+		Target:  []string{cloudtrail.QueryStatusFinished},
+		Refresh: statusQuery(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*cloudtrail.DescribeQueryOutput); ok {
+		if errorMessage := aws.StringValue(output.ErrorMessage); errorMessage != "" {
+			tfresource.SetLastError(err, errors.New(errorMessage))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
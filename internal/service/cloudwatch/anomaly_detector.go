@@ -0,0 +1,624 @@
+package cloudwatch
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceAnomalyDetector() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAnomalyDetectorPut,
+		ReadWithoutTimeout:   resourceAnomalyDetectorRead,
+		UpdateWithoutTimeout: resourceAnomalyDetectorPut,
+		DeleteWithoutTimeout: resourceAnomalyDetectorDelete,
+
+		Schema: map[string]*schema.Schema{
+			"configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"excluded_time_range": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"end_time": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidUTCTimestamp,
+									},
+									"start_time": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidUTCTimestamp,
+									},
+								},
+							},
+						},
+						"metric_timezone": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"metric_characteristics": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"periodic_spikes": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"metric_math_anomaly_detector": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"metric_math_anomaly_detector", "single_metric_anomaly_detector"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"metric_data_query": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"account_id": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidAccountID,
+									},
+									"expression": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringLenBetween(1, 2048),
+									},
+									"id": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringLenBetween(1, 255),
+									},
+									"label": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"metric_stat": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"metric": {
+													Type:     schema.TypeList,
+													Required: true,
+													ForceNew: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"dimensions": {
+																Type:     schema.TypeMap,
+																Optional: true,
+																ForceNew: true,
+																Elem:     &schema.Schema{Type: schema.TypeString},
+															},
+															"metric_name": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ForceNew:     true,
+																ValidateFunc: validation.StringLenBetween(1, 255),
+															},
+															"namespace": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ForceNew:     true,
+																ValidateFunc: validation.StringLenBetween(1, 255),
+															},
+														},
+													},
+												},
+												"period": {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.IntAtLeast(1),
+												},
+												"stat": {
+													Type:     schema.TypeString,
+													Required: true,
+													ForceNew: true,
+												},
+												"unit": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.StringInSlice(cloudwatch.StandardUnit_Values(), false),
+												},
+											},
+										},
+									},
+									"period": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"return_data": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Computed: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"single_metric_anomaly_detector": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"metric_math_anomaly_detector", "single_metric_anomaly_detector"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidAccountID,
+						},
+						"dimensions": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"metric_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+						"namespace": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+						"stat": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"state_value": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAnomalyDetectorPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CloudWatchConn
+
+	input := &cloudwatch.PutAnomalyDetectorInput{
+		MetricMathAnomalyDetector:   expandMetricMathAnomalyDetector(d.Get("metric_math_anomaly_detector").([]interface{})),
+		SingleMetricAnomalyDetector: expandSingleMetricAnomalyDetector(d.Get("single_metric_anomaly_detector").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Configuration = expandAnomalyDetectorConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	} else {
+		// An empty configuration removes any excluded time ranges.
+		input.Configuration = &cloudwatch.AnomalyDetectorConfiguration{}
+	}
+
+	if v, ok := d.GetOk("metric_characteristics"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.MetricCharacteristics = expandMetricCharacteristics(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Putting CloudWatch Anomaly Detector: %s", input)
+	_, err := conn.PutAnomalyDetectorWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("putting CloudWatch Anomaly Detector: %s", err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(resource.UniqueId())
+	}
+
+	return resourceAnomalyDetectorRead(ctx, d, meta)
+}
+
+func resourceAnomalyDetectorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CloudWatchConn
+
+	singleMetric := expandSingleMetricAnomalyDetector(d.Get("single_metric_anomaly_detector").([]interface{}))
+	metricMath := expandMetricMathAnomalyDetector(d.Get("metric_math_anomaly_detector").([]interface{}))
+
+	anomalyDetector, err := FindAnomalyDetector(ctx, conn, singleMetric, metricMath)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudWatch Anomaly Detector (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading CloudWatch Anomaly Detector (%s): %s", d.Id(), err)
+	}
+
+	if anomalyDetector.Configuration != nil && (len(anomalyDetector.Configuration.ExcludedTimeRanges) > 0 || aws.StringValue(anomalyDetector.Configuration.MetricTimezone) != "") {
+		if err := d.Set("configuration", []interface{}{flattenAnomalyDetectorConfiguration(anomalyDetector.Configuration)}); err != nil {
+			return diag.Errorf("setting configuration: %s", err)
+		}
+	} else {
+		d.Set("configuration", nil)
+	}
+
+	if anomalyDetector.MetricCharacteristics != nil {
+		if err := d.Set("metric_characteristics", []interface{}{flattenMetricCharacteristics(anomalyDetector.MetricCharacteristics)}); err != nil {
+			return diag.Errorf("setting metric_characteristics: %s", err)
+		}
+	} else {
+		d.Set("metric_characteristics", nil)
+	}
+
+	if anomalyDetector.MetricMathAnomalyDetector != nil {
+		if err := d.Set("metric_math_anomaly_detector", []interface{}{flattenMetricMathAnomalyDetector(anomalyDetector.MetricMathAnomalyDetector)}); err != nil {
+			return diag.Errorf("setting metric_math_anomaly_detector: %s", err)
+		}
+	}
+
+	if anomalyDetector.SingleMetricAnomalyDetector != nil {
+		if err := d.Set("single_metric_anomaly_detector", []interface{}{flattenSingleMetricAnomalyDetector(anomalyDetector.SingleMetricAnomalyDetector)}); err != nil {
+			return diag.Errorf("setting single_metric_anomaly_detector: %s", err)
+		}
+	}
+
+	d.Set("state_value", anomalyDetector.StateValue)
+
+	return nil
+}
+
+func resourceAnomalyDetectorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CloudWatchConn
+
+	log.Printf("[DEBUG] Deleting CloudWatch Anomaly Detector: %s", d.Id())
+	_, err := conn.DeleteAnomalyDetectorWithContext(ctx, &cloudwatch.DeleteAnomalyDetectorInput{
+		MetricMathAnomalyDetector:   expandMetricMathAnomalyDetector(d.Get("metric_math_anomaly_detector").([]interface{})),
+		SingleMetricAnomalyDetector: expandSingleMetricAnomalyDetector(d.Get("single_metric_anomaly_detector").([]interface{})),
+	})
+
+	if tfawserr.ErrCodeEquals(err, cloudwatch.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting CloudWatch Anomaly Detector (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func singleMetricAnomalyDetectorsEqual(a, b *cloudwatch.SingleMetricAnomalyDetector) bool {
+	if a == nil || b == nil {
+		return false
+	}
+
+	if aws.StringValue(a.Namespace) != aws.StringValue(b.Namespace) ||
+		aws.StringValue(a.MetricName) != aws.StringValue(b.MetricName) ||
+		aws.StringValue(a.Stat) != aws.StringValue(b.Stat) {
+		return false
+	}
+
+	if a, b := aws.StringValue(a.AccountId), aws.StringValue(b.AccountId); a != "" && b != "" && a != b {
+		return false
+	}
+
+	return dimensionsEqual(a.Dimensions, b.Dimensions)
+}
+
+func metricMathAnomalyDetectorsEqual(a, b *cloudwatch.MetricMathAnomalyDetector) bool {
+	if a == nil || b == nil || len(a.MetricDataQueries) != len(b.MetricDataQueries) {
+		return false
+	}
+
+	for i, a := range a.MetricDataQueries {
+		b := b.MetricDataQueries[i]
+
+		if aws.StringValue(a.Id) != aws.StringValue(b.Id) ||
+			aws.StringValue(a.Expression) != aws.StringValue(b.Expression) {
+			return false
+		}
+
+		if (a.MetricStat == nil) != (b.MetricStat == nil) {
+			return false
+		}
+
+		if a.MetricStat != nil {
+			a, b := a.MetricStat, b.MetricStat
+
+			if aws.StringValue(a.Stat) != aws.StringValue(b.Stat) || aws.Int64Value(a.Period) != aws.Int64Value(b.Period) {
+				return false
+			}
+
+			if a.Metric == nil || b.Metric == nil {
+				return a.Metric == b.Metric
+			}
+
+			if aws.StringValue(a.Metric.Namespace) != aws.StringValue(b.Metric.Namespace) ||
+				aws.StringValue(a.Metric.MetricName) != aws.StringValue(b.Metric.MetricName) ||
+				!dimensionsEqual(a.Metric.Dimensions, b.Metric.Dimensions) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func dimensionsEqual(a, b []*cloudwatch.Dimension) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	m := make(map[string]string, len(a))
+
+	for _, v := range a {
+		m[aws.StringValue(v.Name)] = aws.StringValue(v.Value)
+	}
+
+	for _, v := range b {
+		if value, ok := m[aws.StringValue(v.Name)]; !ok || value != aws.StringValue(v.Value) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func expandSingleMetricAnomalyDetector(tfList []interface{}) *cloudwatch.SingleMetricAnomalyDetector {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &cloudwatch.SingleMetricAnomalyDetector{}
+
+	if v, ok := tfMap["account_id"].(string); ok && v != "" {
+		apiObject.AccountId = aws.String(v)
+	}
+
+	if v, ok := tfMap["dimensions"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.Dimensions = expandMetricAlarmDimensions(v)
+	}
+
+	if v, ok := tfMap["metric_name"].(string); ok && v != "" {
+		apiObject.MetricName = aws.String(v)
+	}
+
+	if v, ok := tfMap["namespace"].(string); ok && v != "" {
+		apiObject.Namespace = aws.String(v)
+	}
+
+	if v, ok := tfMap["stat"].(string); ok && v != "" {
+		apiObject.Stat = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandMetricMathAnomalyDetector(tfList []interface{}) *cloudwatch.MetricMathAnomalyDetector {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &cloudwatch.MetricMathAnomalyDetector{}
+
+	for _, tfMapRaw := range tfMap["metric_data_query"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		query := &cloudwatch.MetricDataQuery{
+			Id: aws.String(tfMap["id"].(string)),
+		}
+
+		if v, ok := tfMap["account_id"].(string); ok && v != "" {
+			query.AccountId = aws.String(v)
+		}
+
+		if v, ok := tfMap["expression"].(string); ok && v != "" {
+			query.Expression = aws.String(v)
+		}
+
+		if v, ok := tfMap["label"].(string); ok && v != "" {
+			query.Label = aws.String(v)
+		}
+
+		if v, ok := tfMap["metric_stat"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			query.MetricStat = expandAnomalyDetectorMetricStat(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["period"].(int); ok && v != 0 {
+			query.Period = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["return_data"].(bool); ok && v {
+			query.ReturnData = aws.Bool(v)
+		}
+
+		apiObject.MetricDataQueries = append(apiObject.MetricDataQueries, query)
+	}
+
+	return apiObject
+}
+
+func expandAnomalyDetectorMetricStat(tfMap map[string]interface{}) *cloudwatch.MetricStat {
+	apiObject := &cloudwatch.MetricStat{
+		Metric: &cloudwatch.Metric{},
+		Period: aws.Int64(int64(tfMap["period"].(int))),
+		Stat:   aws.String(tfMap["stat"].(string)),
+	}
+
+	if v, ok := tfMap["metric"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		if v, ok := tfMap["dimensions"].(map[string]interface{}); ok && len(v) > 0 {
+			apiObject.Metric.Dimensions = expandMetricAlarmDimensions(v)
+		}
+
+		apiObject.Metric.MetricName = aws.String(tfMap["metric_name"].(string))
+		apiObject.Metric.Namespace = aws.String(tfMap["namespace"].(string))
+	}
+
+	if v, ok := tfMap["unit"].(string); ok && v != "" {
+		apiObject.Unit = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandAnomalyDetectorConfiguration(tfMap map[string]interface{}) *cloudwatch.AnomalyDetectorConfiguration {
+	apiObject := &cloudwatch.AnomalyDetectorConfiguration{}
+
+	for _, tfMapRaw := range tfMap["excluded_time_range"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		// Values have already been validated as RFC3339 timestamps.
+		endTime, _ := time.Parse(time.RFC3339, tfMap["end_time"].(string))
+		startTime, _ := time.Parse(time.RFC3339, tfMap["start_time"].(string))
+
+		apiObject.ExcludedTimeRanges = append(apiObject.ExcludedTimeRanges, &cloudwatch.Range{
+			EndTime:   aws.Time(endTime),
+			StartTime: aws.Time(startTime),
+		})
+	}
+
+	if v, ok := tfMap["metric_timezone"].(string); ok && v != "" {
+		apiObject.MetricTimezone = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandMetricCharacteristics(tfMap map[string]interface{}) *cloudwatch.MetricCharacteristics {
+	apiObject := &cloudwatch.MetricCharacteristics{}
+
+	if v, ok := tfMap["periodic_spikes"].(bool); ok {
+		apiObject.PeriodicSpikes = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func flattenSingleMetricAnomalyDetector(apiObject *cloudwatch.SingleMetricAnomalyDetector) map[string]interface{} {
+	return map[string]interface{}{
+		"account_id":  aws.StringValue(apiObject.AccountId),
+		"dimensions":  flattenMetricAlarmDimensions(apiObject.Dimensions),
+		"metric_name": aws.StringValue(apiObject.MetricName),
+		"namespace":   aws.StringValue(apiObject.Namespace),
+		"stat":        aws.StringValue(apiObject.Stat),
+	}
+}
+
+func flattenMetricMathAnomalyDetector(apiObject *cloudwatch.MetricMathAnomalyDetector) map[string]interface{} {
+	var tfList []interface{}
+
+	for _, query := range apiObject.MetricDataQueries {
+		tfMap := map[string]interface{}{
+			"account_id":  aws.StringValue(query.AccountId),
+			"expression":  aws.StringValue(query.Expression),
+			"id":          aws.StringValue(query.Id),
+			"label":       aws.StringValue(query.Label),
+			"period":      int(aws.Int64Value(query.Period)),
+			"return_data": aws.BoolValue(query.ReturnData),
+		}
+
+		if v := query.MetricStat; v != nil {
+			metricStat := map[string]interface{}{
+				"period": int(aws.Int64Value(v.Period)),
+				"stat":   aws.StringValue(v.Stat),
+				"unit":   aws.StringValue(v.Unit),
+			}
+
+			if v := v.Metric; v != nil {
+				metricStat["metric"] = []interface{}{map[string]interface{}{
+					"dimensions":  flattenMetricAlarmDimensions(v.Dimensions),
+					"metric_name": aws.StringValue(v.MetricName),
+					"namespace":   aws.StringValue(v.Namespace),
+				}}
+			}
+
+			tfMap["metric_stat"] = []interface{}{metricStat}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return map[string]interface{}{
+		"metric_data_query": tfList,
+	}
+}
+
+func flattenAnomalyDetectorConfiguration(apiObject *cloudwatch.AnomalyDetectorConfiguration) map[string]interface{} {
+	var excludedTimeRanges []interface{}
+
+	for _, v := range apiObject.ExcludedTimeRanges {
+		excludedTimeRanges = append(excludedTimeRanges, map[string]interface{}{
+			"end_time":   aws.TimeValue(v.EndTime).Format(time.RFC3339),
+			"start_time": aws.TimeValue(v.StartTime).Format(time.RFC3339),
+		})
+	}
+
+	return map[string]interface{}{
+		"excluded_time_range": excludedTimeRanges,
+		"metric_timezone":     aws.StringValue(apiObject.MetricTimezone),
+	}
+}
+
+func flattenMetricCharacteristics(apiObject *cloudwatch.MetricCharacteristics) map[string]interface{} {
+	return map[string]interface{}{
+		"periodic_spikes": aws.BoolValue(apiObject.PeriodicSpikes),
+	}
+}
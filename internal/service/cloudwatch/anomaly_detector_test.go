@@ -0,0 +1,335 @@
+package cloudwatch_test
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcloudwatch "github.com/hashicorp/terraform-provider-aws/internal/service/cloudwatch"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccCloudWatchAnomalyDetector_basic(t *testing.T) {
+	var detector cloudwatch.AnomalyDetector
+	resourceName := "aws_cloudwatch_anomaly_detector.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudwatch.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAnomalyDetectorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnomalyDetectorConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnomalyDetectorExists(resourceName, &detector),
+					resource.TestCheckResourceAttr(resourceName, "configuration.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "metric_math_anomaly_detector.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "single_metric_anomaly_detector.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "single_metric_anomaly_detector.0.dimensions.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "single_metric_anomaly_detector.0.dimensions.InstanceId", "i-abcd1234"),
+					resource.TestCheckResourceAttr(resourceName, "single_metric_anomaly_detector.0.metric_name", "CPUUtilization"),
+					resource.TestCheckResourceAttr(resourceName, "single_metric_anomaly_detector.0.namespace", rName),
+					resource.TestCheckResourceAttr(resourceName, "single_metric_anomaly_detector.0.stat", "Average"),
+					resource.TestCheckResourceAttrSet(resourceName, "state_value"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchAnomalyDetector_disappears(t *testing.T) {
+	var detector cloudwatch.AnomalyDetector
+	resourceName := "aws_cloudwatch_anomaly_detector.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudwatch.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAnomalyDetectorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnomalyDetectorConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnomalyDetectorExists(resourceName, &detector),
+					acctest.CheckResourceDisappears(acctest.Provider, tfcloudwatch.ResourceAnomalyDetector(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchAnomalyDetector_configuration(t *testing.T) {
+	var detector cloudwatch.AnomalyDetector
+	resourceName := "aws_cloudwatch_anomaly_detector.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudwatch.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAnomalyDetectorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnomalyDetectorConfig_configuration(rName, "2030-01-01T00:00:00Z", "2030-01-02T00:00:00Z", "UTC"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnomalyDetectorExists(resourceName, &detector),
+					resource.TestCheckResourceAttr(resourceName, "configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.excluded_time_range.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.excluded_time_range.0.start_time", "2030-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.excluded_time_range.0.end_time", "2030-01-02T00:00:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.metric_timezone", "UTC"),
+					resource.TestCheckResourceAttr(resourceName, "metric_characteristics.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "metric_characteristics.0.periodic_spikes", "true"),
+				),
+			},
+			{
+				Config: testAccAnomalyDetectorConfig_configuration(rName, "2030-02-01T00:00:00Z", "2030-02-03T00:00:00Z", "Europe/London"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnomalyDetectorExists(resourceName, &detector),
+					resource.TestCheckResourceAttr(resourceName, "configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.excluded_time_range.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.excluded_time_range.0.start_time", "2030-02-01T00:00:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.excluded_time_range.0.end_time", "2030-02-03T00:00:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.metric_timezone", "Europe/London"),
+				),
+			},
+			{
+				Config: testAccAnomalyDetectorConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnomalyDetectorExists(resourceName, &detector),
+					resource.TestCheckResourceAttr(resourceName, "configuration.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchAnomalyDetector_metricMath(t *testing.T) {
+	var detector cloudwatch.AnomalyDetector
+	resourceName := "aws_cloudwatch_anomaly_detector.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudwatch.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAnomalyDetectorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnomalyDetectorConfig_metricMath(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnomalyDetectorExists(resourceName, &detector),
+					resource.TestCheckResourceAttr(resourceName, "single_metric_anomaly_detector.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "metric_math_anomaly_detector.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "metric_math_anomaly_detector.0.metric_data_query.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "metric_math_anomaly_detector.0.metric_data_query.0.id", "m1"),
+					resource.TestCheckResourceAttr(resourceName, "metric_math_anomaly_detector.0.metric_data_query.0.metric_stat.0.metric.0.namespace", rName),
+					resource.TestCheckResourceAttr(resourceName, "metric_math_anomaly_detector.0.metric_data_query.0.metric_stat.0.period", "300"),
+					resource.TestCheckResourceAttr(resourceName, "metric_math_anomaly_detector.0.metric_data_query.1.id", "e1"),
+					resource.TestCheckResourceAttr(resourceName, "metric_math_anomaly_detector.0.metric_data_query.1.expression", "m1 * 2"),
+					resource.TestCheckResourceAttr(resourceName, "metric_math_anomaly_detector.0.metric_data_query.1.return_data", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAnomalyDetectorExists(n string, v *cloudwatch.AnomalyDetector) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No CloudWatch Anomaly Detector ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudWatchConn
+
+		singleMetric, metricMath := testAccAnomalyDetectorFromAttributes(rs.Primary.Attributes)
+		output, err := tfcloudwatch.FindAnomalyDetector(context.Background(), conn, singleMetric, metricMath)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAnomalyDetectorDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).CloudWatchConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cloudwatch_anomaly_detector" {
+			continue
+		}
+
+		singleMetric, metricMath := testAccAnomalyDetectorFromAttributes(rs.Primary.Attributes)
+		_, err := tfcloudwatch.FindAnomalyDetector(context.Background(), conn, singleMetric, metricMath)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("CloudWatch Anomaly Detector %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+// testAccAnomalyDetectorFromAttributes rebuilds the detector identity from flattened state.
+func testAccAnomalyDetectorFromAttributes(attributes map[string]string) (*cloudwatch.SingleMetricAnomalyDetector, *cloudwatch.MetricMathAnomalyDetector) {
+	dimensions := func(prefix string) []*cloudwatch.Dimension {
+		var apiObjects []*cloudwatch.Dimension
+
+		for k, v := range attributes {
+			if name := strings.TrimPrefix(k, prefix); name != k && name != "%" {
+				apiObjects = append(apiObjects, &cloudwatch.Dimension{
+					Name:  aws.String(name),
+					Value: aws.String(v),
+				})
+			}
+		}
+
+		return apiObjects
+	}
+
+	if attributes["single_metric_anomaly_detector.#"] == "1" {
+		return &cloudwatch.SingleMetricAnomalyDetector{
+			Dimensions: dimensions("single_metric_anomaly_detector.0.dimensions."),
+			MetricName: aws.String(attributes["single_metric_anomaly_detector.0.metric_name"]),
+			Namespace:  aws.String(attributes["single_metric_anomaly_detector.0.namespace"]),
+			Stat:       aws.String(attributes["single_metric_anomaly_detector.0.stat"]),
+		}, nil
+	}
+
+	metricMath := &cloudwatch.MetricMathAnomalyDetector{}
+	count, _ := strconv.Atoi(attributes["metric_math_anomaly_detector.0.metric_data_query.#"])
+
+	for i := 0; i < count; i++ {
+		prefix := fmt.Sprintf("metric_math_anomaly_detector.0.metric_data_query.%d.", i)
+		query := &cloudwatch.MetricDataQuery{
+			Id: aws.String(attributes[prefix+"id"]),
+		}
+
+		if v := attributes[prefix+"expression"]; v != "" {
+			query.Expression = aws.String(v)
+		}
+
+		if attributes[prefix+"metric_stat.#"] == "1" {
+			period, _ := strconv.Atoi(attributes[prefix+"metric_stat.0.period"])
+			query.MetricStat = &cloudwatch.MetricStat{
+				Metric: &cloudwatch.Metric{
+					Dimensions: dimensions(prefix + "metric_stat.0.metric.0.dimensions."),
+					MetricName: aws.String(attributes[prefix+"metric_stat.0.metric.0.metric_name"]),
+					Namespace:  aws.String(attributes[prefix+"metric_stat.0.metric.0.namespace"]),
+				},
+				Period: aws.Int64(int64(period)),
+				Stat:   aws.String(attributes[prefix+"metric_stat.0.stat"]),
+			}
+		}
+
+		metricMath.MetricDataQueries = append(metricMath.MetricDataQueries, query)
+	}
+
+	return nil, metricMath
+}
+
+func testAccAnomalyDetectorConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_anomaly_detector" "test" {
+  single_metric_anomaly_detector {
+    namespace   = %[1]q
+    metric_name = "CPUUtilization"
+    stat        = "Average"
+
+    dimensions = {
+      InstanceId = "i-abcd1234"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccAnomalyDetectorConfig_configuration(rName, startTime, endTime, timezone string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_anomaly_detector" "test" {
+  single_metric_anomaly_detector {
+    namespace   = %[1]q
+    metric_name = "CPUUtilization"
+    stat        = "Average"
+
+    dimensions = {
+      InstanceId = "i-abcd1234"
+    }
+  }
+
+  configuration {
+    excluded_time_range {
+      start_time = %[2]q
+      end_time   = %[3]q
+    }
+
+    metric_timezone = %[4]q
+  }
+
+  metric_characteristics {
+    periodic_spikes = true
+  }
+}
+`, rName, startTime, endTime, timezone)
+}
+
+func testAccAnomalyDetectorConfig_metricMath(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_anomaly_detector" "test" {
+  metric_math_anomaly_detector {
+    metric_data_query {
+      id          = "m1"
+      return_data = false
+
+      metric_stat {
+        metric {
+          namespace   = %[1]q
+          metric_name = "CPUUtilization"
+
+          dimensions = {
+            InstanceId = "i-abcd1234"
+          }
+        }
+
+        period = 300
+        stat   = "Average"
+      }
+    }
+
+    metric_data_query {
+      id          = "e1"
+      expression  = "m1 * 2"
+      return_data = true
+    }
+  }
+}
+`, rName)
+}
@@ -1,6 +1,7 @@
 package cloudwatch
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -26,10 +27,12 @@ func ResourceDashboard() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
-		// Note that we specify both the `dashboard_body` and
-		// the `dashboard_name` as being required, even though
-		// according to the REST API documentation both are
-		// optional: http://docs.aws.amazon.com/AmazonCloudWatch/latest/APIReference/API_PutDashboard.html#API_PutDashboard_RequestParameters
+		CustomizeDiff: resourceDashboardCustomizeDiff,
+
+		// Note that we specify the `dashboard_name` as being required
+		// and the dashboard body (either as raw JSON or as `widget` blocks)
+		// as being required, even though according to the REST API
+		// documentation both are optional: http://docs.aws.amazon.com/AmazonCloudWatch/latest/APIReference/API_PutDashboard.html#API_PutDashboard_RequestParameters
 		Schema: map[string]*schema.Schema{
 			"dashboard_arn": {
 				Type:     schema.TypeString,
@@ -37,7 +40,9 @@ func ResourceDashboard() *schema.Resource {
 			},
 			"dashboard_body": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"dashboard_body", "widget"},
 				ValidateFunc: validation.StringIsJSON,
 				StateFunc: func(v interface{}) string {
 					json, _ := structure.NormalizeJsonString(v)
@@ -51,6 +56,7 @@ func ResourceDashboard() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validDashboardName,
 			},
+			"widget": dashboardWidgetSchema(),
 		},
 	}
 }
@@ -77,7 +83,13 @@ func resourceDashboardRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("dashboard_arn", resp.DashboardArn)
 	d.Set("dashboard_name", resp.DashboardName)
-	d.Set("dashboard_body", resp.DashboardBody)
+	body, err := structure.NormalizeJsonString(aws.StringValue(resp.DashboardBody))
+
+	if err != nil {
+		return names.Error(names.CloudWatch, names.ErrActionReading, ResDashboard, d.Id(), err)
+	}
+
+	d.Set("dashboard_body", body)
 	return nil
 }
 
@@ -88,6 +100,16 @@ func resourceDashboardPut(d *schema.ResourceData, meta interface{}) error {
 		DashboardName: aws.String(d.Get("dashboard_name").(string)),
 	}
 
+	if v, ok := d.GetOk("widget"); ok && len(v.([]interface{})) > 0 {
+		body, err := expandDashboardBody(v.([]interface{}), meta.(*conns.AWSClient).Region)
+
+		if err != nil {
+			return fmt.Errorf("Putting dashboard failed: %s", err)
+		}
+
+		params.DashboardBody = aws.String(body)
+	}
+
 	log.Printf("[DEBUG] Putting CloudWatch Dashboard: %#v", params)
 
 	_, err := conn.PutDashboard(&params)
@@ -118,6 +140,39 @@ func resourceDashboardDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+// resourceDashboardCustomizeDiff renders `widget` blocks so that the planned
+// `dashboard_body` reflects them. Formatting-only differences from the stored
+// body are ignored.
+func resourceDashboardCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	widgets := diff.GetRawConfig().GetAttr("widget")
+
+	if widgets.IsNull() || (widgets.IsKnown() && widgets.LengthInt() == 0) {
+		return nil
+	}
+
+	if !widgets.IsWhollyKnown() {
+		return diff.SetNewComputed("dashboard_body")
+	}
+
+	body, err := expandDashboardBody(diff.Get("widget").([]interface{}), meta.(*conns.AWSClient).Region)
+
+	if err != nil {
+		return err
+	}
+
+	if old, _ := diff.GetChange("dashboard_body"); verify.JSONBytesEqual([]byte(old.(string)), []byte(body)) {
+		return nil
+	}
+
+	body, err = structure.NormalizeJsonString(body)
+
+	if err != nil {
+		return err
+	}
+
+	return diff.SetNew("dashboard_body", body)
+}
+
 func IsDashboardNotFoundErr(err error) bool {
 	return tfawserr.ErrMessageContains(
 		err,
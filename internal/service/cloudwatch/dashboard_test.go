@@ -112,6 +112,62 @@ func TestAccCloudWatchDashboard_updateName(t *testing.T) {
 	})
 }
 
+func TestAccCloudWatchDashboard_widgets(t *testing.T) {
+	var dashboard cloudwatch.GetDashboardOutput
+	resourceName := "aws_cloudwatch_dashboard.test"
+	rInt := sdkacctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudwatch.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDashboardDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardConfig_widgets(rInt, "Hi there from Terraform: CloudWatch"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDashboardExists(resourceName, &dashboard),
+					testAccCheckDashboardBodyIsExpected(resourceName, basicWidget),
+					resource.TestCheckResourceAttr(resourceName, "dashboard_name", testAccDashboardName(rInt)),
+					resource.TestCheckResourceAttr(resourceName, "widget.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "widget.0.text.0.markdown", "Hi there from Terraform: CloudWatch"),
+				),
+			},
+			{
+				Config: testAccDashboardConfig_widgets(rInt, "Hi there from Terraform: CloudWatch - updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDashboardExists(resourceName, &dashboard),
+					testAccCheckDashboardBodyIsExpected(resourceName, updatedWidget),
+					resource.TestCheckResourceAttr(resourceName, "widget.0.text.0.markdown", "Hi there from Terraform: CloudWatch - updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchDashboard_widgetTypes(t *testing.T) {
+	var dashboard cloudwatch.GetDashboardOutput
+	resourceName := "aws_cloudwatch_dashboard.test"
+	rInt := sdkacctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudwatch.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDashboardDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardConfig_widgetTypes(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDashboardExists(resourceName, &dashboard),
+					resource.TestCheckResourceAttr(resourceName, "widget.#", "3"),
+					resource.TestCheckResourceAttrSet(resourceName, "dashboard_body"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckDashboardExists(n string, dashboard *cloudwatch.GetDashboardOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -241,6 +297,97 @@ EOF
 `, rInt, updatedWidget)
 }
 
+func testAccDashboardConfig_widgets(rInt int, markdown string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_dashboard" "test" {
+  dashboard_name = "terraform-test-dashboard-%[1]d"
+
+  widget {
+    x = 0
+    y = 0
+
+    text {
+      markdown = %[2]q
+    }
+  }
+}
+`, rInt, markdown)
+}
+
+func testAccDashboardConfig_widgetTypes(rInt int) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_log_group" "test" {
+  name = "terraform-test-dashboard-%[1]d"
+}
+
+resource "aws_cloudwatch_metric_alarm" "test" {
+  alarm_name          = "terraform-test-dashboard-%[1]d"
+  comparison_operator = "GreaterThanOrEqualToThreshold"
+  evaluation_periods  = 2
+  metric_name         = "CPUUtilization"
+  namespace           = "AWS/EC2"
+  period              = 120
+  statistic           = "Average"
+  threshold           = 80
+}
+
+resource "aws_cloudwatch_dashboard" "test" {
+  dashboard_name = "terraform-test-dashboard-%[1]d"
+
+  widget {
+    x     = 0
+    y     = 0
+    width = 12
+
+    metric {
+      title = "CPU"
+      view  = "timeSeries"
+      stat  = "Average"
+
+      metric_query {
+        id          = "m1"
+        namespace   = "AWS/EC2"
+        metric_name = "CPUUtilization"
+        visible     = false
+
+        dimensions = {
+          InstanceId = "i-abcd1234"
+        }
+      }
+
+      metric_query {
+        expression = "m1 * 2"
+        label      = "Doubled"
+      }
+    }
+  }
+
+  widget {
+    x     = 12
+    y     = 0
+    width = 12
+
+    log {
+      log_group_names = [aws_cloudwatch_log_group.test.name]
+      query           = "fields @timestamp, @message | sort @timestamp desc | limit 20"
+      view            = "table"
+    }
+  }
+
+  widget {
+    x     = 0
+    y     = 6
+    width = 24
+
+    alarm {
+      alarms = [aws_cloudwatch_metric_alarm.test.arn]
+      title  = "Alarms"
+    }
+  }
+}
+`, rInt)
+}
+
 func testAccCheckDashboardBodyIsExpected(resourceName, expected string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -0,0 +1,494 @@
+package cloudwatch
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	dashboardWidgetTypeAlarm  = "alarm"
+	dashboardWidgetTypeLog    = "log"
+	dashboardWidgetTypeMetric = "metric"
+	dashboardWidgetTypeText   = "text"
+)
+
+func dashboardWidgetTypes() []string {
+	return []string{
+		dashboardWidgetTypeAlarm,
+		dashboardWidgetTypeLog,
+		dashboardWidgetTypeMetric,
+		dashboardWidgetTypeText,
+	}
+}
+
+func dashboardWidgetSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeList,
+		Optional:     true,
+		ExactlyOneOf: []string{"dashboard_body", "widget"},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				dashboardWidgetTypeAlarm: {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"alarms": {
+								Type:     schema.TypeList,
+								Required: true,
+								MinItems: 1,
+								Elem: &schema.Schema{
+									Type:         schema.TypeString,
+									ValidateFunc: verify.ValidARN,
+								},
+							},
+							"sort_by": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringInSlice([]string{"default", "stateUpdatedTimestamp", "timestamp"}, false),
+							},
+							"states": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Elem: &schema.Schema{
+									Type:         schema.TypeString,
+									ValidateFunc: validation.StringInSlice([]string{"ALARM", "INSUFFICIENT_DATA", "OK"}, false),
+								},
+							},
+							"title": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+						},
+					},
+				},
+				"height": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      6,
+					ValidateFunc: validation.IntBetween(1, 1000),
+				},
+				dashboardWidgetTypeLog: {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"log_group_names": {
+								Type:     schema.TypeList,
+								Required: true,
+								MinItems: 1,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"query": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"region": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"stacked": {
+								Type:     schema.TypeBool,
+								Optional: true,
+							},
+							"title": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"view": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringInSlice([]string{"bar", "pie", "table", "timeSeries"}, false),
+							},
+						},
+					},
+				},
+				dashboardWidgetTypeMetric: {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"metric_query": {
+								Type:     schema.TypeList,
+								Required: true,
+								MinItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"dimensions": {
+											Type:     schema.TypeMap,
+											Optional: true,
+											Elem:     &schema.Schema{Type: schema.TypeString},
+										},
+										"expression": {
+											Type:     schema.TypeString,
+											Optional: true,
+										},
+										"id": {
+											Type:     schema.TypeString,
+											Optional: true,
+										},
+										"label": {
+											Type:     schema.TypeString,
+											Optional: true,
+										},
+										"metric_name": {
+											Type:     schema.TypeString,
+											Optional: true,
+										},
+										"namespace": {
+											Type:     schema.TypeString,
+											Optional: true,
+										},
+										"period": {
+											Type:         schema.TypeInt,
+											Optional:     true,
+											ValidateFunc: validation.IntAtLeast(1),
+										},
+										"stat": {
+											Type:     schema.TypeString,
+											Optional: true,
+										},
+										"visible": {
+											Type:     schema.TypeBool,
+											Optional: true,
+											Default:  true,
+										},
+									},
+								},
+							},
+							"period": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ValidateFunc: validation.IntAtLeast(1),
+							},
+							"region": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"stacked": {
+								Type:     schema.TypeBool,
+								Optional: true,
+							},
+							"stat": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"title": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"view": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringInSlice([]string{"bar", "gauge", "pie", "singleValue", "timeSeries"}, false),
+							},
+						},
+					},
+				},
+				dashboardWidgetTypeText: {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"background": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringInSlice([]string{"solid", "transparent"}, false),
+							},
+							"markdown": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+						},
+					},
+				},
+				"width": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      6,
+					ValidateFunc: validation.IntBetween(1, 24),
+				},
+				"x": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntBetween(0, 23),
+				},
+				"y": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+			},
+		},
+	}
+}
+
+type dashboardBody struct {
+	Widgets []dashboardWidget `json:"widgets"`
+}
+
+type dashboardWidget struct {
+	Type       string                 `json:"type"`
+	X          int                    `json:"x"`
+	Y          int                    `json:"y"`
+	Width      int                    `json:"width"`
+	Height     int                    `json:"height"`
+	Properties map[string]interface{} `json:"properties"`
+}
+
+// expandDashboardBody renders the configured widget blocks to dashboard body JSON.
+// Metric and log widgets that don't specify a region use the provider's region.
+func expandDashboardBody(tfList []interface{}, region string) (string, error) {
+	body := dashboardBody{
+		Widgets: []dashboardWidget{},
+	}
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		widget := dashboardWidget{
+			X:      tfMap["x"].(int),
+			Y:      tfMap["y"].(int),
+			Width:  tfMap["width"].(int),
+			Height: tfMap["height"].(int),
+		}
+
+		var widgetTypes []string
+
+		for _, widgetType := range dashboardWidgetTypes() {
+			if v, ok := tfMap[widgetType].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				widgetTypes = append(widgetTypes, widgetType)
+			}
+		}
+
+		if len(widgetTypes) != 1 {
+			return "", fmt.Errorf("widget.%d: exactly one of %s must be specified", i, strings.Join(dashboardWidgetTypes(), ", "))
+		}
+
+		widget.Type = widgetTypes[0]
+		properties := tfMap[widget.Type].([]interface{})[0].(map[string]interface{})
+
+		var err error
+
+		switch widget.Type {
+		case dashboardWidgetTypeAlarm:
+			widget.Properties = expandDashboardAlarmWidgetProperties(properties)
+		case dashboardWidgetTypeLog:
+			widget.Properties = expandDashboardLogWidgetProperties(properties, region)
+		case dashboardWidgetTypeMetric:
+			widget.Properties, err = expandDashboardMetricWidgetProperties(properties, region)
+		case dashboardWidgetTypeText:
+			widget.Properties = expandDashboardTextWidgetProperties(properties)
+		}
+
+		if err != nil {
+			return "", fmt.Errorf("widget.%d: %w", i, err)
+		}
+
+		body.Widgets = append(body.Widgets, widget)
+	}
+
+	b, err := json.Marshal(body)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+func expandDashboardAlarmWidgetProperties(tfMap map[string]interface{}) map[string]interface{} {
+	properties := map[string]interface{}{
+		"alarms": flex.ExpandStringValueList(tfMap["alarms"].([]interface{})),
+	}
+
+	if v, ok := tfMap["sort_by"].(string); ok && v != "" {
+		properties["sortBy"] = v
+	}
+
+	if v, ok := tfMap["states"].([]interface{}); ok && len(v) > 0 {
+		properties["states"] = flex.ExpandStringValueList(v)
+	}
+
+	if v, ok := tfMap["title"].(string); ok && v != "" {
+		properties["title"] = v
+	}
+
+	return properties
+}
+
+func expandDashboardLogWidgetProperties(tfMap map[string]interface{}, region string) map[string]interface{} {
+	var sources []string
+
+	for _, v := range flex.ExpandStringValueList(tfMap["log_group_names"].([]interface{})) {
+		sources = append(sources, fmt.Sprintf("SOURCE '%s'", v))
+	}
+
+	properties := map[string]interface{}{
+		"query":  strings.Join(append(sources, tfMap["query"].(string)), " | "),
+		"region": region,
+	}
+
+	if v, ok := tfMap["region"].(string); ok && v != "" {
+		properties["region"] = v
+	}
+
+	if v, ok := tfMap["stacked"].(bool); ok && v {
+		properties["stacked"] = v
+	}
+
+	if v, ok := tfMap["title"].(string); ok && v != "" {
+		properties["title"] = v
+	}
+
+	if v, ok := tfMap["view"].(string); ok && v != "" {
+		properties["view"] = v
+	}
+
+	return properties
+}
+
+func expandDashboardMetricWidgetProperties(tfMap map[string]interface{}, region string) (map[string]interface{}, error) {
+	var metrics []interface{}
+
+	for i, tfMapRaw := range tfMap["metric_query"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		metric, err := expandDashboardMetricWidgetQuery(tfMap)
+
+		if err != nil {
+			return nil, fmt.Errorf("metric.0.metric_query.%d: %w", i, err)
+		}
+
+		metrics = append(metrics, metric)
+	}
+
+	properties := map[string]interface{}{
+		"metrics": metrics,
+		"region":  region,
+	}
+
+	if v, ok := tfMap["period"].(int); ok && v != 0 {
+		properties["period"] = v
+	}
+
+	if v, ok := tfMap["region"].(string); ok && v != "" {
+		properties["region"] = v
+	}
+
+	if v, ok := tfMap["stacked"].(bool); ok && v {
+		properties["stacked"] = v
+	}
+
+	if v, ok := tfMap["stat"].(string); ok && v != "" {
+		properties["stat"] = v
+	}
+
+	if v, ok := tfMap["title"].(string); ok && v != "" {
+		properties["title"] = v
+	}
+
+	if v, ok := tfMap["view"].(string); ok && v != "" {
+		properties["view"] = v
+	}
+
+	return properties, nil
+}
+
+// expandDashboardMetricWidgetQuery renders a single entry of a metric widget's
+// "metrics" array: either [namespace, metric name, dimension name, dimension value, ..., {options}]
+// or [{expression, options}].
+func expandDashboardMetricWidgetQuery(tfMap map[string]interface{}) ([]interface{}, error) {
+	expression, _ := tfMap["expression"].(string)
+	metricName, _ := tfMap["metric_name"].(string)
+	namespace, _ := tfMap["namespace"].(string)
+
+	options := map[string]interface{}{}
+
+	if v, ok := tfMap["id"].(string); ok && v != "" {
+		options["id"] = v
+	}
+
+	if v, ok := tfMap["label"].(string); ok && v != "" {
+		options["label"] = v
+	}
+
+	if v, ok := tfMap["period"].(int); ok && v != 0 {
+		options["period"] = v
+	}
+
+	if v, ok := tfMap["visible"].(bool); ok && !v {
+		options["visible"] = v
+	}
+
+	if expression != "" {
+		if metricName != "" || namespace != "" {
+			return nil, fmt.Errorf("expression conflicts with namespace and metric_name")
+		}
+
+		options["expression"] = expression
+
+		return []interface{}{options}, nil
+	}
+
+	if metricName == "" || namespace == "" {
+		return nil, fmt.Errorf("one of expression or namespace and metric_name must be specified")
+	}
+
+	if v, ok := tfMap["stat"].(string); ok && v != "" {
+		options["stat"] = v
+	}
+
+	metric := []interface{}{namespace, metricName}
+
+	if v, ok := tfMap["dimensions"].(map[string]interface{}); ok && len(v) > 0 {
+		keys := make([]string, 0, len(v))
+
+		for k := range v {
+			keys = append(keys, k)
+		}
+
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			metric = append(metric, k, v[k].(string))
+		}
+	}
+
+	if len(options) > 0 {
+		metric = append(metric, options)
+	}
+
+	return metric, nil
+}
+
+func expandDashboardTextWidgetProperties(tfMap map[string]interface{}) map[string]interface{} {
+	properties := map[string]interface{}{
+		"markdown": tfMap["markdown"].(string),
+	}
+
+	if v, ok := tfMap["background"].(string); ok && v != "" {
+		properties["background"] = v
+	}
+
+	return properties
+}
@@ -0,0 +1,164 @@
+package cloudwatch
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func TestExpandDashboardBody(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Input         []interface{}
+		ExpectedBody  string
+		ExpectedError bool
+	}{
+		{
+			Name:         "empty",
+			Input:        []interface{}{},
+			ExpectedBody: `{"widgets":[]}`,
+		},
+		{
+			Name: "text",
+			Input: []interface{}{
+				testDashboardWidget(0, 0, dashboardWidgetTypeText, map[string]interface{}{
+					"background": "transparent",
+					"markdown":   "# Hello",
+				}),
+			},
+			ExpectedBody: `{"widgets":[{"type":"text","x":0,"y":0,"width":6,"height":6,"properties":{"background":"transparent","markdown":"# Hello"}}]}`,
+		},
+		{
+			Name: "metric",
+			Input: []interface{}{
+				testDashboardWidget(6, 0, dashboardWidgetTypeMetric, map[string]interface{}{
+					"metric_query": []interface{}{
+						map[string]interface{}{
+							"dimensions":  map[string]interface{}{"InstanceId": "i-abcd1234", "AutoScalingGroupName": "asg"},
+							"expression":  "",
+							"id":          "m1",
+							"label":       "",
+							"metric_name": "CPUUtilization",
+							"namespace":   "AWS/EC2",
+							"period":      0,
+							"stat":        "Maximum",
+							"visible":     false,
+						},
+						map[string]interface{}{
+							"dimensions":  map[string]interface{}{},
+							"expression":  "m1 * 2",
+							"id":          "",
+							"label":       "Doubled",
+							"metric_name": "",
+							"namespace":   "",
+							"period":      0,
+							"stat":        "",
+							"visible":     true,
+						},
+					},
+					"period":  300,
+					"region":  "",
+					"stacked": false,
+					"stat":    "Average",
+					"title":   "CPU",
+					"view":    "timeSeries",
+				}),
+			},
+			ExpectedBody: `{"widgets":[{"type":"metric","x":6,"y":0,"width":6,"height":6,"properties":{
+"metrics":[["AWS/EC2","CPUUtilization","AutoScalingGroupName","asg","InstanceId","i-abcd1234",{"id":"m1","stat":"Maximum","visible":false}],[{"expression":"m1 * 2","label":"Doubled"}]],
+"period":300,"region":"us-west-2","stat":"Average","title":"CPU","view":"timeSeries"}}]}`,
+		},
+		{
+			Name: "metric query without metric or expression",
+			Input: []interface{}{
+				testDashboardWidget(0, 0, dashboardWidgetTypeMetric, map[string]interface{}{
+					"metric_query": []interface{}{
+						map[string]interface{}{
+							"expression":  "",
+							"metric_name": "",
+							"namespace":   "",
+							"visible":     true,
+						},
+					},
+				}),
+			},
+			ExpectedError: true,
+		},
+		{
+			Name: "log",
+			Input: []interface{}{
+				testDashboardWidget(0, 6, dashboardWidgetTypeLog, map[string]interface{}{
+					"log_group_names": []interface{}{"lg1", "lg2"},
+					"query":           "fields @message",
+					"region":          "eu-west-1",
+					"stacked":         false,
+					"title":           "",
+					"view":            "table",
+				}),
+			},
+			ExpectedBody: `{"widgets":[{"type":"log","x":0,"y":6,"width":6,"height":6,"properties":{"query":"SOURCE 'lg1' | SOURCE 'lg2' | fields @message","region":"eu-west-1","view":"table"}}]}`,
+		},
+		{
+			Name: "alarm",
+			Input: []interface{}{
+				testDashboardWidget(0, 12, dashboardWidgetTypeAlarm, map[string]interface{}{
+					"alarms":  []interface{}{"arn:aws:cloudwatch:us-west-2:123456789012:alarm:test"}, //lintignore:AWSAT003,AWSAT005
+					"sort_by": "stateUpdatedTimestamp",
+					"states":  []interface{}{"ALARM"},
+					"title":   "Alarms",
+				}),
+			},
+			ExpectedBody: `{"widgets":[{"type":"alarm","x":0,"y":12,"width":6,"height":6,"properties":{"alarms":["arn:aws:cloudwatch:us-west-2:123456789012:alarm:test"],"sortBy":"stateUpdatedTimestamp","states":["ALARM"],"title":"Alarms"}}]}`, //lintignore:AWSAT003,AWSAT005
+		},
+		{
+			Name: "no widget type",
+			Input: []interface{}{
+				testDashboardWidget(0, 0, "", nil),
+			},
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := expandDashboardBody(testCase.Input, "us-west-2") //lintignore:AWSAT003
+
+			if testCase.ExpectedError {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !verify.JSONBytesEqual([]byte(got), []byte(testCase.ExpectedBody)) {
+				t.Errorf("got %s, expected %s", got, testCase.ExpectedBody)
+			}
+		})
+	}
+}
+
+func testDashboardWidget(x, y int, widgetType string, properties map[string]interface{}) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"height":                  6,
+		"width":                   6,
+		"x":                       x,
+		"y":                       y,
+		dashboardWidgetTypeAlarm:  []interface{}{},
+		dashboardWidgetTypeLog:    []interface{}{},
+		dashboardWidgetTypeMetric: []interface{}{},
+		dashboardWidgetTypeText:   []interface{}{},
+	}
+
+	if widgetType != "" {
+		tfMap[widgetType] = []interface{}{properties}
+	}
+
+	return tfMap
+}
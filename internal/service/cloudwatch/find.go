@@ -5,6 +5,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
 func FindCompositeAlarmByName(ctx context.Context, conn *cloudwatch.CloudWatch, name string) (*cloudwatch.CompositeAlarm, error) {
@@ -42,3 +43,56 @@ func FindMetricAlarmByName(conn *cloudwatch.CloudWatch, name string) (*cloudwatc
 
 	return output.MetricAlarms[0], nil
 }
+
+func FindAnomalyDetector(ctx context.Context, conn *cloudwatch.CloudWatch, singleMetric *cloudwatch.SingleMetricAnomalyDetector, metricMath *cloudwatch.MetricMathAnomalyDetector) (*cloudwatch.AnomalyDetector, error) {
+	input := &cloudwatch.DescribeAnomalyDetectorsInput{}
+
+	if singleMetric != nil {
+		input.AnomalyDetectorTypes = aws.StringSlice([]string{cloudwatch.AnomalyDetectorTypeSingleMetric})
+		input.Dimensions = singleMetric.Dimensions
+		input.MetricName = singleMetric.MetricName
+		input.Namespace = singleMetric.Namespace
+	} else {
+		input.AnomalyDetectorTypes = aws.StringSlice([]string{cloudwatch.AnomalyDetectorTypeMetricMath})
+	}
+
+	var output *cloudwatch.AnomalyDetector
+
+	err := conn.DescribeAnomalyDetectorsPagesWithContext(ctx, input, func(page *cloudwatch.DescribeAnomalyDetectorsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.AnomalyDetectors {
+			if v == nil {
+				continue
+			}
+
+			if singleMetric != nil && singleMetricAnomalyDetectorsEqual(singleMetric, v.SingleMetricAnomalyDetector) {
+				output = v
+
+				return false
+			}
+
+			if metricMath != nil && metricMathAnomalyDetectorsEqual(metricMath, v.MetricMathAnomalyDetector) {
+				output = v
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
@@ -24,7 +24,7 @@ func ResourceMetricStream() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceMetricStreamCreate,
 		ReadContext:   resourceMetricStreamRead,
-		UpdateContext: resourceMetricStreamCreate,
+		UpdateContext: resourceMetricStreamUpdate,
 		DeleteContext: resourceMetricStreamDelete,
 
 		Importer: &schema.ResourceImporter{
@@ -53,6 +53,14 @@ func ResourceMetricStream() *schema.Resource {
 				ConflictsWith: []string{"include_filter"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"metric_names": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(1, 255),
+							},
+						},
 						"namespace": {
 							Type:         schema.TypeString,
 							Required:     true,
@@ -72,6 +80,14 @@ func ResourceMetricStream() *schema.Resource {
 				ConflictsWith: []string{"exclude_filter"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"metric_names": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(1, 255),
+							},
+						},
 						"namespace": {
 							Type:         schema.TypeString,
 							Required:     true,
@@ -235,6 +251,54 @@ func resourceMetricStreamCreate(ctx context.Context, d *schema.ResourceData, met
 	return resourceMetricStreamRead(ctx, d, meta)
 }
 
+func resourceMetricStreamUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CloudWatchConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		params := cloudwatch.PutMetricStreamInput{
+			Name:         aws.String(d.Id()),
+			FirehoseArn:  aws.String(d.Get("firehose_arn").(string)),
+			RoleArn:      aws.String(d.Get("role_arn").(string)),
+			OutputFormat: aws.String(d.Get("output_format").(string)),
+		}
+
+		if v, ok := d.GetOk("include_filter"); ok && v.(*schema.Set).Len() > 0 {
+			params.IncludeFilters = expandMetricStreamFilters(v.(*schema.Set))
+		}
+
+		if v, ok := d.GetOk("exclude_filter"); ok && v.(*schema.Set).Len() > 0 {
+			params.ExcludeFilters = expandMetricStreamFilters(v.(*schema.Set))
+		}
+
+		if v, ok := d.GetOk("statistics_configuration"); ok && v.(*schema.Set).Len() > 0 {
+			params.StatisticsConfigurations = expandMetricStreamStatisticsConfigurations(v.(*schema.Set))
+		}
+
+		log.Printf("[DEBUG] Updating CloudWatch Metric Stream: %#v", params)
+		if _, err := conn.PutMetricStreamWithContext(ctx, &params); err != nil {
+			return diag.Errorf("failed updating CloudWatch Metric Stream (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		err := UpdateTags(conn, d.Get("arn").(string), o, n)
+
+		// Some partitions (i.e., ISO) may not support tagging, giving error
+		if verify.CheckISOErrorTagsUnsupported(conn.PartitionID, err) {
+			log.Printf("[WARN] failed updating tags for CloudWatch Metric Stream (%s): %s", d.Id(), err)
+			return resourceMetricStreamRead(ctx, d, meta)
+		}
+
+		if err != nil {
+			return diag.Errorf("failed updating tags for CloudWatch Metric Stream (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceMetricStreamRead(ctx, d, meta)
+}
+
 func resourceMetricStreamRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).CloudWatchConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
@@ -257,9 +321,9 @@ func resourceMetricStreamRead(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	d.Set("arn", output.Arn)
-	d.Set("creation_date", output.CreationDate.Format(time.RFC3339))
+	d.Set("creation_date", aws.TimeValue(output.CreationDate).Format(time.RFC3339))
 	d.Set("firehose_arn", output.FirehoseArn)
-	d.Set("last_update_date", output.CreationDate.Format(time.RFC3339))
+	d.Set("last_update_date", aws.TimeValue(output.LastUpdateDate).Format(time.RFC3339))
 	d.Set("name", output.Name)
 	d.Set("name_prefix", create.NamePrefixFromName(aws.StringValue(output.Name)))
 	d.Set("output_format", output.OutputFormat)
@@ -344,6 +408,10 @@ func expandMetricStreamFilters(s *schema.Set) []*cloudwatch.MetricStreamFilter {
 		filter := &cloudwatch.MetricStreamFilter{}
 		mFilter := filterRaw.(map[string]interface{})
 
+		if v, ok := mFilter["metric_names"].(*schema.Set); ok && v.Len() > 0 {
+			filter.MetricNames = flex.ExpandStringSet(v)
+		}
+
 		if v, ok := mFilter["namespace"].(string); ok && v != "" {
 			filter.Namespace = aws.String(v)
 		}
@@ -360,6 +428,7 @@ func flattenMetricStreamFilters(s []*cloudwatch.MetricStreamFilter) []map[string
 	for _, bd := range s {
 		if bd.Namespace != nil {
 			stage := make(map[string]interface{})
+			stage["metric_names"] = flex.FlattenStringSet(bd.MetricNames)
 			stage["namespace"] = aws.StringValue(bd.Namespace)
 
 			filters = append(filters, stage)
@@ -137,6 +137,49 @@ func TestAccCloudWatchMetricStream_includeFilters(t *testing.T) {
 	})
 }
 
+func TestAccCloudWatchMetricStream_includeFiltersWithMetricNames(t *testing.T) {
+	resourceName := "aws_cloudwatch_metric_stream.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudwatch.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMetricStreamDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMetricStreamConfig_includeFiltersWithMetricNames(rName, `"CPUUtilization"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMetricStreamExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "include_filter.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "include_filter.*", map[string]string{
+						"namespace":      "AWS/EC2",
+						"metric_names.#": "1",
+					}),
+					resource.TestCheckTypeSetElemAttr(resourceName, "include_filter.*.metric_names.*", "CPUUtilization"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMetricStreamConfig_includeFiltersWithMetricNames(rName, `"CPUUtilization", "NetworkOut"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMetricStreamExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "include_filter.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "include_filter.*", map[string]string{
+						"namespace":      "AWS/EC2",
+						"metric_names.#": "2",
+					}),
+					resource.TestCheckTypeSetElemAttr(resourceName, "include_filter.*.metric_names.*", "NetworkOut"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccCloudWatchMetricStream_excludeFilters(t *testing.T) {
 	resourceName := "aws_cloudwatch_metric_stream.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -252,6 +295,14 @@ func TestAccCloudWatchMetricStream_tags(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				Config: testAccMetricStreamConfig_tagsUpdated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMetricStreamExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Mercedes", "Lewis"),
+				),
+			},
 		},
 	})
 }
@@ -569,6 +620,30 @@ resource "aws_cloudwatch_metric_stream" "test" {
 `, rName)
 }
 
+func testAccMetricStreamConfig_includeFiltersWithMetricNames(rName, metricNames string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+data "aws_region" "current" {}
+data "aws_caller_identity" "current" {}
+
+resource "aws_cloudwatch_metric_stream" "test" {
+  name          = %[1]q
+  role_arn      = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:role/MyRole"
+  firehose_arn  = "arn:${data.aws_partition.current.partition}:firehose:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:deliverystream/MyFirehose"
+  output_format = "json"
+
+  include_filter {
+    namespace    = "AWS/EC2"
+    metric_names = [%[2]s]
+  }
+
+  include_filter {
+    namespace = "AWS/EBS"
+  }
+}
+`, rName, metricNames)
+}
+
 func testAccMetricStreamConfig_noName() string {
 	return `
 data "aws_partition" "current" {}
@@ -641,6 +716,25 @@ resource "aws_cloudwatch_metric_stream" "test" {
 `, rName)
 }
 
+func testAccMetricStreamConfig_tagsUpdated(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+data "aws_region" "current" {}
+data "aws_caller_identity" "current" {}
+
+resource "aws_cloudwatch_metric_stream" "test" {
+  name          = %[1]q
+  role_arn      = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:role/MyRole"
+  firehose_arn  = "arn:${data.aws_partition.current.partition}:firehose:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:deliverystream/MyFirehose"
+  output_format = "json"
+
+  tags = {
+    Mercedes = "Lewis"
+  }
+}
+`, rName)
+}
+
 func testAccMetricStreamConfig_additionalStatistics(rName string, stat string) string {
 	return fmt.Sprintf(`
 data "aws_partition" "current" {}
@@ -35,11 +35,15 @@ func TestAccConfigService_serial(t *testing.T) {
 			"S3Delivery":                testAccConformancePack_S3Delivery,
 			"S3Template":                testAccConformancePack_S3Template,
 			"S3TemplateAndTemplateBody": testAccConformancePack_S3TemplateAndTemplateBody,
+			"templateDirectory":         testAccConformancePack_templateDirectory,
 			"updateInputParameters":     testAccConformancePack_updateInputParameters,
 			"updateS3Delivery":          testAccConformancePack_updateS3Delivery,
 			"updateS3Template":          testAccConformancePack_updateS3Template,
 			"updateTemplateBody":        testAccConformancePack_updateTemplateBody,
 		},
+		"ConformancePackComplianceDataSource": {
+			"basic": testAccConformancePackComplianceDataSource_basic,
+		},
 		"DeliveryChannel": {
 			"basic":       testAccDeliveryChannel_basic,
 			"allParams":   testAccDeliveryChannel_allParams,
@@ -53,6 +57,7 @@ func TestAccConfigService_serial(t *testing.T) {
 			"inputParameters":       testAccOrganizationConformancePack_inputParameters,
 			"S3Delivery":            testAccOrganizationConformancePack_S3Delivery,
 			"S3Template":            testAccOrganizationConformancePack_S3Template,
+			"templateDirectory":     testAccOrganizationConformancePack_templateDirectory,
 			"updateInputParameters": testAccOrganizationConformancePack_updateInputParameters,
 			"updateS3Delivery":      testAccOrganizationConformancePack_updateS3Delivery,
 			"updateS3Template":      testAccOrganizationConformancePack_updateS3Template,
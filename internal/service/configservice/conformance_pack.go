@@ -78,7 +78,19 @@ func ResourceConformancePack() *schema.Resource {
 					validation.StringLenBetween(1, 51200),
 					verify.ValidStringIsJSONOrYAML,
 				),
-				AtLeastOneOf: []string{"template_body", "template_s3_uri"},
+				AtLeastOneOf:  []string{"template_body", "template_directory", "template_s3_uri"},
+				ConflictsWith: []string{"template_directory"},
+			},
+			"template_directory": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				AtLeastOneOf:  []string{"template_body", "template_directory", "template_s3_uri"},
+				ConflictsWith: []string{"template_body", "template_s3_uri"},
+				RequiredWith:  []string{"delivery_s3_bucket"},
+			},
+			"template_directory_hash": {
+				Type:     schema.TypeString,
+				Computed: true,
 			},
 			"template_s3_uri": {
 				Type:     schema.TypeString,
@@ -87,9 +99,12 @@ func ResourceConformancePack() *schema.Resource {
 					validation.StringLenBetween(1, 1024),
 					validation.StringMatch(regexp.MustCompile(`^s3://`), "must begin with s3://"),
 				),
-				AtLeastOneOf: []string{"template_s3_uri", "template_body"},
+				AtLeastOneOf:  []string{"template_body", "template_directory", "template_s3_uri"},
+				ConflictsWith: []string{"template_directory"},
 			},
 		},
+
+		CustomizeDiff: conformancePackTemplateDirectoryCustomizeDiff,
 	}
 }
 
@@ -122,6 +137,16 @@ func resourceConformancePackPut(d *schema.ResourceData, meta interface{}) error
 		input.TemplateS3Uri = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("template_directory"); ok {
+		uri, err := putConformancePackTemplateDirectory(meta.(*conns.AWSClient).S3Conn, v.(string), d.Get("delivery_s3_bucket").(string), d.Get("delivery_s3_key_prefix").(string), name)
+
+		if err != nil {
+			return fmt.Errorf("error packaging Config Conformance Pack (%s) template directory: %w", name, err)
+		}
+
+		input.TemplateS3Uri = aws.String(uri)
+	}
+
 	_, err := conn.PutConformancePack(&input)
 	if err != nil {
 		return fmt.Errorf("error creating Config Conformance Pack (%s): %w", name, err)
@@ -133,6 +158,10 @@ func resourceConformancePackPut(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("error waiting for Config Conformance Pack (%s) to be created: %w", d.Id(), err)
 	}
 
+	if err := deleteReplacedConformancePackTemplateObject(meta.(*conns.AWSClient).S3Conn, d, d.Id()); err != nil {
+		return fmt.Errorf("error deleting Config Conformance Pack (%s) template: %w", d.Id(), err)
+	}
+
 	return resourceConformancePackRead(d, meta)
 }
 
@@ -210,6 +239,12 @@ func resourceConformancePackDelete(d *schema.ResourceData, meta interface{}) err
 		return fmt.Errorf("error waiting for Config Conformance Pack (%s) to be deleted: %w", d.Id(), err)
 	}
 
+	if _, ok := d.GetOk("template_directory"); ok {
+		if err := deleteConformancePackTemplateObject(meta.(*conns.AWSClient).S3Conn, d.Get("delivery_s3_bucket").(string), d.Get("delivery_s3_key_prefix").(string), d.Id()); err != nil {
+			return fmt.Errorf("error deleting Config Conformance Pack (%s) template: %w", d.Id(), err)
+		}
+	}
+
 	return nil
 }
 
@@ -0,0 +1,120 @@
+package configservice
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+func DataSourceConformancePackCompliance() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceConformancePackComplianceRead,
+
+		Schema: map[string]*schema.Schema{
+			"compliance_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"compliance_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(configservice.ConformancePackComplianceType_Values(), false),
+			},
+			"config_rule_names": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 10,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"conformance_pack_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"rule_compliance": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"compliance_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"config_rule_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"controls": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceConformancePackComplianceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConfigServiceConn
+
+	name := d.Get("conformance_pack_name").(string)
+	summary, err := FindConformancePackComplianceSummaryByName(ctx, conn, name)
+
+	if err != nil {
+		return diag.Errorf("reading Config Conformance Pack (%s) compliance summary: %s", name, err)
+	}
+
+	filters := &configservice.ConformancePackComplianceFilters{}
+
+	if v, ok := d.GetOk("compliance_type"); ok {
+		filters.ComplianceType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("config_rule_names"); ok && v.(*schema.Set).Len() > 0 {
+		filters.ConfigRuleNames = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	rules, err := FindConformancePackRuleCompliances(ctx, conn, name, filters)
+
+	if err != nil {
+		return diag.Errorf("reading Config Conformance Pack (%s) rule compliance: %s", name, err)
+	}
+
+	d.SetId(name)
+	d.Set("compliance_status", summary.ConformancePackComplianceStatus)
+	d.Set("conformance_pack_name", summary.ConformancePackName)
+	if err := d.Set("rule_compliance", flattenConformancePackRuleCompliances(rules)); err != nil {
+		return diag.Errorf("setting rule_compliance: %s", err)
+	}
+
+	return nil
+}
+
+func flattenConformancePackRuleCompliances(apiObjects []*configservice.ConformancePackRuleCompliance) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"compliance_type":  aws.StringValue(apiObject.ComplianceType),
+			"config_rule_name": aws.StringValue(apiObject.ConfigRuleName),
+			"controls":         aws.StringValueSlice(apiObject.Controls),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,44 @@
+package configservice_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/configservice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func testAccConformancePackComplianceDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_config_conformance_pack_compliance.test"
+	resourceName := "aws_config_conformance_pack.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, configservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConformancePackDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConformancePackComplianceDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "conformance_pack_name", resourceName, "name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "compliance_status"),
+					resource.TestCheckResourceAttr(dataSourceName, "rule_compliance.#", "1"),
+					resource.TestMatchResourceAttr(dataSourceName, "rule_compliance.0.config_rule_name", regexp.MustCompile(`^IAMPasswordPolicy`)),
+					resource.TestCheckResourceAttrSet(dataSourceName, "rule_compliance.0.compliance_type"),
+				),
+			},
+		},
+	})
+}
+
+func testAccConformancePackComplianceDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccConformancePackConfig_basic(rName), `
+data "aws_config_conformance_pack_compliance" "test" {
+  conformance_pack_name = aws_config_conformance_pack.test.name
+}
+`)
+}
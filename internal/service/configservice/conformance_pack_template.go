@@ -0,0 +1,252 @@
+package configservice
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	conformancePackTemplateKeyPrefix = "conformance-pack-templates"
+)
+
+var (
+	// Template sections whose entries are merged across the files of a template directory.
+	conformancePackTemplateMergedSections = map[string]bool{
+		"Conditions": true,
+		"Mappings":   true,
+		"Outputs":    true,
+		"Parameters": true,
+		"Resources":  true,
+	}
+)
+
+// packageConformancePackTemplateDirectory packages the JSON and YAML templates in a local directory
+// into a single conformance pack template.
+// A directory containing a single template is packaged as-is. Otherwise files are read in lexical
+// order, the entries of the Conditions, Mappings, Outputs, Parameters and Resources sections are
+// merged, and any other top-level key is taken from the first file that declares it. Templates are
+// merged as YAML nodes so that CloudFormation short-form intrinsic functions such as !Ref are preserved.
+func packageConformancePackTemplateDirectory(dir string) (string, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*"))
+
+	if err != nil {
+		return "", err
+	}
+
+	var templates []string
+
+	for _, file := range files {
+		switch strings.ToLower(filepath.Ext(file)) {
+		case ".json", ".yaml", ".yml":
+			templates = append(templates, file)
+		}
+	}
+
+	if len(templates) == 0 {
+		return "", fmt.Errorf("template directory (%s) contains no .json, .yaml or .yml files", dir)
+	}
+
+	sort.Strings(templates)
+
+	if len(templates) == 1 {
+		b, err := os.ReadFile(templates[0])
+
+		if err != nil {
+			return "", fmt.Errorf("reading template file (%s): %w", templates[0], err)
+		}
+
+		return string(b), nil
+	}
+
+	merged := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+	sections := make(map[string]*yaml.Node)
+	sources := make(map[string]map[string]string)
+
+	for _, file := range templates {
+		b, err := os.ReadFile(file)
+
+		if err != nil {
+			return "", fmt.Errorf("reading template file (%s): %w", file, err)
+		}
+
+		var document yaml.Node
+
+		if err := yaml.Unmarshal(b, &document); err != nil {
+			return "", fmt.Errorf("parsing template file (%s): %w", file, err)
+		}
+
+		if document.Kind != yaml.DocumentNode || len(document.Content) != 1 || document.Content[0].Kind != yaml.MappingNode {
+			return "", fmt.Errorf("parsing template file (%s): template must be a mapping", file)
+		}
+
+		root := document.Content[0]
+
+		for i := 0; i+1 < len(root.Content); i += 2 {
+			keyNode, valueNode := root.Content[i], root.Content[i+1]
+			key := keyNode.Value
+			section, ok := sections[key]
+
+			if !conformancePackTemplateMergedSections[key] {
+				if !ok {
+					sections[key] = valueNode
+					merged.Content = append(merged.Content, keyNode, valueNode)
+				}
+
+				continue
+			}
+
+			if valueNode.Kind != yaml.MappingNode {
+				return "", fmt.Errorf("parsing template file (%s): section %s must be a mapping", file, key)
+			}
+
+			if !ok {
+				section = &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+				sections[key] = section
+				sources[key] = make(map[string]string)
+				merged.Content = append(merged.Content, keyNode, section)
+			}
+
+			for j := 0; j+1 < len(valueNode.Content); j += 2 {
+				entry := valueNode.Content[j].Value
+
+				if source, ok := sources[key][entry]; ok {
+					return "", fmt.Errorf("duplicate %s entry %s in template files (%s) and (%s)", key, entry, source, file)
+				}
+
+				sources[key][entry] = file
+				section.Content = append(section.Content, valueNode.Content[j], valueNode.Content[j+1])
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+
+	if err := encoder.Encode(merged); err != nil {
+		return "", fmt.Errorf("encoding template: %w", err)
+	}
+
+	if err := encoder.Close(); err != nil {
+		return "", fmt.Errorf("encoding template: %w", err)
+	}
+
+	return buf.String(), nil
+}
+
+func conformancePackTemplateHash(template string) string {
+	hash := sha256.Sum256([]byte(template))
+
+	return hex.EncodeToString(hash[:])
+}
+
+// conformancePackTemplateDirectoryCustomizeDiff packages the template directory at plan time
+// so that changes to the files in the directory are detected and invalid templates fail early.
+func conformancePackTemplateDirectoryCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("template_directory") {
+		return nil
+	}
+
+	v, ok := d.GetOk("template_directory")
+
+	if !ok {
+		if d.Get("template_directory_hash").(string) != "" {
+			return d.SetNew("template_directory_hash", "")
+		}
+
+		return nil
+	}
+
+	template, err := packageConformancePackTemplateDirectory(v.(string))
+
+	if err != nil {
+		return err
+	}
+
+	if hash := conformancePackTemplateHash(template); d.Get("template_directory_hash").(string) != hash {
+		return d.SetNew("template_directory_hash", hash)
+	}
+
+	return nil
+}
+
+func conformancePackTemplateObjectKey(keyPrefix, name string) string {
+	return path.Join(keyPrefix, conformancePackTemplateKeyPrefix, name+".yaml")
+}
+
+// putConformancePackTemplateDirectory packages the template directory and uploads it to the
+// delivery S3 bucket, returning the S3 URI of the uploaded template.
+func putConformancePackTemplateDirectory(conn *s3.S3, dir, bucket, keyPrefix, name string) (string, error) {
+	template, err := packageConformancePackTemplateDirectory(dir)
+
+	if err != nil {
+		return "", err
+	}
+
+	key := conformancePackTemplateObjectKey(keyPrefix, name)
+
+	_, err = conn.PutObject(&s3.PutObjectInput{
+		Body:        bytes.NewReader([]byte(template)),
+		Bucket:      aws.String(bucket),
+		ContentType: aws.String("application/x-yaml"),
+		Key:         aws.String(key),
+	})
+
+	if err != nil {
+		return "", fmt.Errorf("uploading template to S3 Bucket (%s) Object (%s): %w", bucket, key, err)
+	}
+
+	return fmt.Sprintf("s3://%s/%s", bucket, key), nil
+}
+
+func deleteConformancePackTemplateObject(conn *s3.S3, bucket, keyPrefix, name string) error {
+	key := conformancePackTemplateObjectKey(keyPrefix, name)
+
+	_, err := conn.DeleteObject(&s3.DeleteObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket, s3.ErrCodeNoSuchKey) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deleting template S3 Bucket (%s) Object (%s): %w", bucket, key, err)
+	}
+
+	return nil
+}
+
+// deleteReplacedConformancePackTemplateObject deletes the previously uploaded template once
+// template_directory is removed or the template is uploaded to a different location.
+func deleteReplacedConformancePackTemplateObject(conn *s3.S3, d *schema.ResourceData, name string) error {
+	oDir, nDir := d.GetChange("template_directory")
+
+	if oDir.(string) == "" {
+		return nil
+	}
+
+	oBucket, nBucket := d.GetChange("delivery_s3_bucket")
+	oKeyPrefix, nKeyPrefix := d.GetChange("delivery_s3_key_prefix")
+
+	if nDir.(string) != "" && oBucket.(string) == nBucket.(string) && oKeyPrefix.(string) == nKeyPrefix.(string) {
+		return nil
+	}
+
+	return deleteConformancePackTemplateObject(conn, oBucket.(string), oKeyPrefix.(string), name)
+}
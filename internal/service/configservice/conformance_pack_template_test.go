@@ -0,0 +1,147 @@
+package configservice
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestPackageConformancePackTemplateDirectory(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		files       map[string]string
+		expected    string
+		expectError string
+	}{
+		"single file": {
+			files: map[string]string{
+				"rules.yaml": `Resources:
+    IAMPasswordPolicy:
+        Type: AWS::Config::ConfigRule
+`,
+			},
+			expected: `Resources:
+    IAMPasswordPolicy:
+        Type: AWS::Config::ConfigRule
+`,
+		},
+		"merged files": {
+			files: map[string]string{
+				"a.yaml": `---
+# Password policy.
+Description: First description
+Parameters:
+  MaxPasswordAge:
+    Type: String
+Resources:
+  IAMPasswordPolicy:
+    Properties:
+      InputParameters:
+        MaxPasswordAge: !Ref MaxPasswordAge
+    Type: AWS::Config::ConfigRule
+`,
+				"b.yml": `Description: Second description
+Resources:
+    S3BucketVersioningEnabled:
+        Properties:
+            Source:
+                Owner: AWS
+        Type: AWS::Config::ConfigRule
+Conditions: {IsProduction: !Equals [!Ref Environment, production]}
+`,
+				"c.json": `{
+  "Resources": {
+    "RootAccountMFAEnabled": {
+      "Type": "AWS::Config::ConfigRule"
+    }
+  }
+}
+`,
+				"README.md": "not a template",
+			},
+			expected: `# Password policy.
+Description: First description
+Parameters:
+  MaxPasswordAge:
+    Type: String
+Resources:
+  IAMPasswordPolicy:
+    Properties:
+      InputParameters:
+        MaxPasswordAge: !Ref MaxPasswordAge
+    Type: AWS::Config::ConfigRule
+  S3BucketVersioningEnabled:
+    Properties:
+      Source:
+        Owner: AWS
+    Type: AWS::Config::ConfigRule
+  "RootAccountMFAEnabled": {"Type": "AWS::Config::ConfigRule"}
+Conditions:
+  IsProduction: !Equals [!Ref Environment, production]
+`,
+		},
+		"no templates": {
+			files: map[string]string{
+				"README.md": "not a template",
+			},
+			expectError: "contains no .json, .yaml or .yml files",
+		},
+		"duplicate entry": {
+			files: map[string]string{
+				"a.yaml": "Resources:\n  IAMPasswordPolicy:\n    Type: AWS::Config::ConfigRule\n",
+				"b.yaml": "Resources:\n  IAMPasswordPolicy:\n    Type: AWS::Config::ConfigRule\n",
+			},
+			expectError: "duplicate Resources entry IAMPasswordPolicy",
+		},
+		"section not a mapping": {
+			files: map[string]string{
+				"a.yaml": "Resources:\n  IAMPasswordPolicy:\n    Type: AWS::Config::ConfigRule\n",
+				"b.yaml": "Resources: []\n",
+			},
+			expectError: "section Resources must be a mapping",
+		},
+		"invalid YAML": {
+			files: map[string]string{
+				"a.yaml": "Resources:\n  IAMPasswordPolicy:\n    Type: AWS::Config::ConfigRule\n",
+				"b.yaml": "Resources: [\n",
+			},
+			expectError: "parsing template file",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			dir := t.TempDir()
+
+			for file, content := range testCase.files {
+				if err := os.WriteFile(filepath.Join(dir, file), []byte(content), 0600); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			got, err := packageConformancePackTemplateDirectory(dir)
+
+			if testCase.expectError != "" {
+				if err == nil || !strings.Contains(err.Error(), testCase.expectError) {
+					t.Fatalf("expected error containing %q, got: %v", testCase.expectError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("got:\n%s\nexpected:\n%s", got, testCase.expected)
+			}
+		})
+	}
+}
@@ -215,6 +215,37 @@ func testAccConformancePack_S3Template(t *testing.T) {
 	})
 }
 
+func testAccConformancePack_templateDirectory(t *testing.T) {
+	var pack configservice.ConformancePackDetail
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_config_conformance_pack.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, configservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConformancePackDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConformancePackConfig_templateDirectory(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConformancePackExists(resourceName, &pack),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "delivery_s3_bucket", rName),
+					resource.TestCheckResourceAttr(resourceName, "template_directory", "test-fixtures/conformance-pack-templates"),
+					resource.TestCheckResourceAttrSet(resourceName, "template_directory_hash"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"template_directory", "template_directory_hash"},
+			},
+		},
+	})
+}
+
 func testAccConformancePack_updateInputParameters(t *testing.T) {
 	var pack configservice.ConformancePackDetail
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -733,3 +764,25 @@ EOT
 }
 `, rName))
 }
+
+func testAccConformancePackConfig_templateDirectory(rName string) string {
+	return acctest.ConfigCompose(testAccConformancePackConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_acl" "test" {
+  bucket = aws_s3_bucket.test.id
+  acl    = "private"
+}
+
+resource "aws_config_conformance_pack" "test" {
+  depends_on         = [aws_config_configuration_recorder.test]
+  name               = %[1]q
+  delivery_s3_bucket = aws_s3_bucket.test.bucket
+  template_directory = "test-fixtures/conformance-pack-templates"
+}
+`, rName))
+}
@@ -1,6 +1,8 @@
 package configservice
 
 import (
+	"context"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/configservice"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
@@ -31,3 +33,67 @@ func FindConfigRule(conn *configservice.ConfigService, name string) (*configserv
 
 	return output.ConfigRules[0], nil
 }
+
+func FindConformancePackComplianceSummaryByName(ctx context.Context, conn *configservice.ConfigService, name string) (*configservice.ConformancePackComplianceSummary, error) {
+	input := &configservice.GetConformancePackComplianceSummaryInput{
+		ConformancePackNames: aws.StringSlice([]string{name}),
+	}
+
+	output, err := conn.GetConformancePackComplianceSummaryWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, configservice.ErrCodeNoSuchConformancePackException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.ConformancePackComplianceSummaryList) == 0 || output.ConformancePackComplianceSummaryList[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.ConformancePackComplianceSummaryList); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.ConformancePackComplianceSummaryList[0], nil
+}
+
+func FindConformancePackRuleCompliances(ctx context.Context, conn *configservice.ConfigService, name string, filters *configservice.ConformancePackComplianceFilters) ([]*configservice.ConformancePackRuleCompliance, error) {
+	input := &configservice.DescribeConformancePackComplianceInput{
+		ConformancePackName: aws.String(name),
+		Filters:             filters,
+	}
+	var output []*configservice.ConformancePackRuleCompliance
+
+	err := conn.DescribeConformancePackCompliancePagesWithContext(ctx, input, func(page *configservice.DescribeConformancePackComplianceOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ConformancePackRuleComplianceList {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, configservice.ErrCodeNoSuchConformancePackException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
@@ -95,7 +95,17 @@ func ResourceOrganizationConformancePack() *schema.Resource {
 					validation.StringLenBetween(1, 51200),
 					verify.ValidStringIsJSONOrYAML,
 				),
-				ConflictsWith: []string{"template_s3_uri"},
+				ConflictsWith: []string{"template_directory", "template_s3_uri"},
+			},
+			"template_directory": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"template_body", "template_s3_uri"},
+				RequiredWith:  []string{"delivery_s3_bucket"},
+			},
+			"template_directory_hash": {
+				Type:     schema.TypeString,
+				Computed: true,
 			},
 			"template_s3_uri": {
 				Type:     schema.TypeString,
@@ -104,9 +114,11 @@ func ResourceOrganizationConformancePack() *schema.Resource {
 					validation.StringLenBetween(1, 1024),
 					validation.StringMatch(regexp.MustCompile(`^s3://`), "must begin with s3://"),
 				),
-				ConflictsWith: []string{"template_body"},
+				ConflictsWith: []string{"template_body", "template_directory"},
 			},
 		},
+
+		CustomizeDiff: conformancePackTemplateDirectoryCustomizeDiff,
 	}
 }
 
@@ -143,6 +155,16 @@ func resourceOrganizationConformancePackCreate(d *schema.ResourceData, meta inte
 		input.TemplateS3Uri = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("template_directory"); ok {
+		uri, err := putConformancePackTemplateDirectory(meta.(*conns.AWSClient).S3Conn, v.(string), d.Get("delivery_s3_bucket").(string), d.Get("delivery_s3_key_prefix").(string), name)
+
+		if err != nil {
+			return fmt.Errorf("error packaging Config Organization Conformance Pack (%s) template directory: %w", name, err)
+		}
+
+		input.TemplateS3Uri = aws.String(uri)
+	}
+
 	_, err := conn.PutOrganizationConformancePack(input)
 
 	if err != nil {
@@ -230,6 +252,16 @@ func resourceOrganizationConformancePackUpdate(d *schema.ResourceData, meta inte
 		input.TemplateS3Uri = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("template_directory"); ok {
+		uri, err := putConformancePackTemplateDirectory(meta.(*conns.AWSClient).S3Conn, v.(string), d.Get("delivery_s3_bucket").(string), d.Get("delivery_s3_key_prefix").(string), d.Id())
+
+		if err != nil {
+			return fmt.Errorf("error packaging Config Organization Conformance Pack (%s) template directory: %w", d.Id(), err)
+		}
+
+		input.TemplateS3Uri = aws.String(uri)
+	}
+
 	_, err := conn.PutOrganizationConformancePack(input)
 
 	if err != nil {
@@ -240,6 +272,10 @@ func resourceOrganizationConformancePackUpdate(d *schema.ResourceData, meta inte
 		return fmt.Errorf("error waiting for Config Organization Conformance Pack (%s) to be updated: %w", d.Id(), err)
 	}
 
+	if err := deleteReplacedConformancePackTemplateObject(meta.(*conns.AWSClient).S3Conn, d, d.Id()); err != nil {
+		return fmt.Errorf("error deleting Config Organization Conformance Pack (%s) template: %w", d.Id(), err)
+	}
+
 	return resourceOrganizationConformancePackRead(d, meta)
 }
 
@@ -267,5 +303,11 @@ func resourceOrganizationConformancePackDelete(d *schema.ResourceData, meta inte
 		return fmt.Errorf("error waiting for Config Organization Conformance Pack (%s) to be deleted: %w", d.Id(), err)
 	}
 
+	if _, ok := d.GetOk("template_directory"); ok {
+		if err := deleteConformancePackTemplateObject(meta.(*conns.AWSClient).S3Conn, d.Get("delivery_s3_bucket").(string), d.Get("delivery_s3_key_prefix").(string), d.Id()); err != nil {
+			return fmt.Errorf("error deleting Config Organization Conformance Pack (%s) template: %w", d.Id(), err)
+		}
+	}
+
 	return nil
 }
@@ -225,6 +225,37 @@ func testAccOrganizationConformancePack_S3Delivery(t *testing.T) {
 	})
 }
 
+func testAccOrganizationConformancePack_templateDirectory(t *testing.T) {
+	var pack configservice.OrganizationConformancePack
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	bucketName := sdkacctest.RandomWithPrefix("awsconfigconforms")
+	resourceName := "aws_config_organization_conformance_pack.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckOrganizationsAccount(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, configservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOrganizationConformancePackDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationConformancePackConfig_templateDirectory(rName, bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrganizationConformancePackExists(resourceName, &pack),
+					resource.TestCheckResourceAttr(resourceName, "delivery_s3_bucket", bucketName),
+					resource.TestCheckResourceAttr(resourceName, "template_directory", "test-fixtures/conformance-pack-templates"),
+					resource.TestCheckResourceAttrSet(resourceName, "template_directory_hash"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"template_directory", "template_directory_hash"},
+			},
+		},
+	})
+}
+
 func testAccOrganizationConformancePack_S3Template(t *testing.T) {
 	var pack configservice.OrganizationConformancePack
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -746,3 +777,26 @@ EOT
 }
 `, rName))
 }
+
+func testAccOrganizationConformancePackConfig_templateDirectory(rName, bName string) string {
+	return acctest.ConfigCompose(
+		testAccOrganizationConformancePackBase(rName),
+		fmt.Sprintf(`
+resource "aws_config_organization_conformance_pack" "test" {
+  depends_on         = [aws_config_configuration_recorder.test, aws_organizations_organization.test]
+  name               = %[1]q
+  delivery_s3_bucket = aws_s3_bucket.test.id
+  template_directory = "test-fixtures/conformance-pack-templates"
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[2]q
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_acl" "test" {
+  bucket = aws_s3_bucket.test.id
+  acl    = "private"
+}
+`, rName, bName))
+}
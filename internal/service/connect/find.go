@@ -105,3 +105,29 @@ func FindLambdaFunctionAssociationByARNWithContext(ctx context.Context, conn *co
 
 	return result, nil
 }
+
+func FindPredefinedAttributeByTwoPartKey(ctx context.Context, conn *connect.Connect, instanceID, name string) (*connect.PredefinedAttribute, error) {
+	input := &connect.DescribePredefinedAttributeInput{
+		InstanceId: aws.String(instanceID),
+		Name:       aws.String(name),
+	}
+
+	output, err := conn.DescribePredefinedAttributeWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.PredefinedAttribute == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.PredefinedAttribute, nil
+}
@@ -7,6 +7,7 @@ import (
 
 const botV1AssociationIDSeparator = ":"
 const lambdaFunctionAssociationIDSeparator = ","
+const predefinedAttributeIDSeparator = ":"
 
 func BotV1AssociationParseResourceID(id string) (string, string, string, error) {
 	parts := strings.SplitN(id, botV1AssociationIDSeparator, 3)
@@ -42,3 +43,20 @@ func LambdaFunctionAssociationCreateResourceID(instanceID string, functionArn st
 
 	return id
 }
+
+func PredefinedAttributeParseResourceID(id string) (string, string, error) {
+	parts := strings.SplitN(id, predefinedAttributeIDSeparator, 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of Connect Predefined Attribute ID (%s), expected instanceID:name", id)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func PredefinedAttributeCreateResourceID(instanceID string, name string) string {
+	parts := []string{instanceID, name}
+	id := strings.Join(parts, predefinedAttributeIDSeparator)
+
+	return id
+}
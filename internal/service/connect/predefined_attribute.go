@@ -0,0 +1,183 @@
+package connect
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourcePredefinedAttribute() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePredefinedAttributeCreate,
+		ReadContext:   resourcePredefinedAttributeRead,
+		UpdateContext: resourcePredefinedAttributeUpdate,
+		DeleteContext: resourcePredefinedAttributeDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"last_modified_region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_modified_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"values": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 128,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 64),
+				},
+			},
+		},
+	}
+}
+
+func resourcePredefinedAttributeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectConn
+
+	instanceID := d.Get("instance_id").(string)
+	name := d.Get("name").(string)
+
+	input := &connect.CreatePredefinedAttributeInput{
+		InstanceId: aws.String(instanceID),
+		Name:       aws.String(name),
+		Values: &connect.PredefinedAttributeValues{
+			StringList: flex.ExpandStringSet(d.Get("values").(*schema.Set)),
+		},
+	}
+
+	log.Printf("[DEBUG] Creating Connect Predefined Attribute %s", input)
+	_, err := conn.CreatePredefinedAttributeWithContext(ctx, input)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Connect Predefined Attribute (%s): %w", name, err))
+	}
+
+	d.SetId(PredefinedAttributeCreateResourceID(instanceID, name))
+
+	return resourcePredefinedAttributeRead(ctx, d, meta)
+}
+
+func resourcePredefinedAttributeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectConn
+
+	instanceID, name, err := PredefinedAttributeParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	predefinedAttribute, err := FindPredefinedAttributeByTwoPartKey(ctx, conn, instanceID, name)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Connect Predefined Attribute (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error getting Connect Predefined Attribute (%s): %w", d.Id(), err))
+	}
+
+	d.Set("instance_id", instanceID)
+	d.Set("last_modified_region", predefinedAttribute.LastModifiedRegion)
+	if predefinedAttribute.LastModifiedTime != nil {
+		d.Set("last_modified_time", predefinedAttribute.LastModifiedTime.Format(time.RFC3339))
+	} else {
+		d.Set("last_modified_time", nil)
+	}
+	d.Set("name", predefinedAttribute.Name)
+	if predefinedAttribute.Values != nil {
+		d.Set("values", aws.StringValueSlice(predefinedAttribute.Values.StringList))
+	} else {
+		d.Set("values", nil)
+	}
+
+	return nil
+}
+
+func resourcePredefinedAttributeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectConn
+
+	instanceID, name, err := PredefinedAttributeParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("values") {
+		input := &connect.UpdatePredefinedAttributeInput{
+			InstanceId: aws.String(instanceID),
+			Name:       aws.String(name),
+			Values: &connect.PredefinedAttributeValues{
+				StringList: flex.ExpandStringSet(d.Get("values").(*schema.Set)),
+			},
+		}
+
+		log.Printf("[DEBUG] Updating Connect Predefined Attribute %s", input)
+		_, err = conn.UpdatePredefinedAttributeWithContext(ctx, input)
+
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error updating Connect Predefined Attribute (%s): %w", d.Id(), err))
+		}
+	}
+
+	return resourcePredefinedAttributeRead(ctx, d, meta)
+}
+
+func resourcePredefinedAttributeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectConn
+
+	instanceID, name, err := PredefinedAttributeParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting Connect Predefined Attribute: %s", d.Id())
+	_, err = conn.DeletePredefinedAttributeWithContext(ctx, &connect.DeletePredefinedAttributeInput{
+		InstanceId: aws.String(instanceID),
+		Name:       aws.String(name),
+	})
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting Connect Predefined Attribute (%s): %w", d.Id(), err))
+	}
+
+	return nil
+}
@@ -0,0 +1,227 @@
+package connect_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/connect"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfconnect "github.com/hashicorp/terraform-provider-aws/internal/service/connect"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// Serialized acceptance tests due to Connect account limits (max 2 parallel tests)
+func TestAccConnectPredefinedAttribute_serial(t *testing.T) {
+	testCases := map[string]func(t *testing.T){
+		"basic":        testAccPredefinedAttribute_basic,
+		"disappears":   testAccPredefinedAttribute_disappears,
+		"updateValues": testAccPredefinedAttribute_updateValues,
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			tc(t)
+		})
+	}
+}
+
+func testAccPredefinedAttribute_basic(t *testing.T) {
+	var v connect.PredefinedAttribute
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+
+	resourceName := "aws_connect_predefined_attribute.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, connect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPredefinedAttributeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPredefinedAttributeConfig_basic(rName, rName2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPredefinedAttributeExists(resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_id", "aws_connect_instance.test", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_modified_region"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_modified_time"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName2),
+					resource.TestCheckResourceAttr(resourceName, "values.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "values.*", "English"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "values.*", "Spanish"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccPredefinedAttribute_disappears(t *testing.T) {
+	var v connect.PredefinedAttribute
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+
+	resourceName := "aws_connect_predefined_attribute.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, connect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPredefinedAttributeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPredefinedAttributeConfig_basic(rName, rName2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPredefinedAttributeExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfconnect.ResourcePredefinedAttribute(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccPredefinedAttribute_updateValues(t *testing.T) {
+	var v connect.PredefinedAttribute
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+
+	resourceName := "aws_connect_predefined_attribute.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, connect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPredefinedAttributeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPredefinedAttributeConfig_basic(rName, rName2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPredefinedAttributeExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "values.#", "2"),
+				),
+			},
+			{
+				Config: testAccPredefinedAttributeConfig_valuesUpdated(rName, rName2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPredefinedAttributeExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "values.#", "3"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "values.*", "English"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "values.*", "French"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "values.*", "German"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPredefinedAttributeExists(resourceName string, v *connect.PredefinedAttribute) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Connect Predefined Attribute not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Connect Predefined Attribute ID not set")
+		}
+
+		instanceID, name, err := tfconnect.PredefinedAttributeParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ConnectConn
+
+		output, err := tfconnect.FindPredefinedAttributeByTwoPartKey(context.Background(), conn, instanceID, name)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckPredefinedAttributeDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ConnectConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_connect_predefined_attribute" {
+			continue
+		}
+
+		instanceID, name, err := tfconnect.PredefinedAttributeParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfconnect.FindPredefinedAttributeByTwoPartKey(context.Background(), conn, instanceID, name)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Connect Predefined Attribute %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccPredefinedAttributeConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_connect_instance" "test" {
+  identity_management_type = "CONNECT_MANAGED"
+  inbound_calls_enabled    = true
+  instance_alias           = %[1]q
+  outbound_calls_enabled   = true
+}
+`, rName)
+}
+
+func testAccPredefinedAttributeConfig_basic(rName, rName2 string) string {
+	return acctest.ConfigCompose(
+		testAccPredefinedAttributeConfig_base(rName),
+		fmt.Sprintf(`
+resource "aws_connect_predefined_attribute" "test" {
+  instance_id = aws_connect_instance.test.id
+  name        = %[1]q
+  values      = ["English", "Spanish"]
+}
+`, rName2))
+}
+
+func testAccPredefinedAttributeConfig_valuesUpdated(rName, rName2 string) string {
+	return acctest.ConfigCompose(
+		testAccPredefinedAttributeConfig_base(rName),
+		fmt.Sprintf(`
+resource "aws_connect_predefined_attribute" "test" {
+  instance_id = aws_connect_instance.test.id
+  name        = %[1]q
+  values      = ["English", "French", "German"]
+}
+`, rName2))
+}
@@ -0,0 +1,282 @@
+package controltower
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/controltower"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceControl() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceControlCreate,
+		ReadWithoutTimeout:   resourceControlRead,
+		UpdateWithoutTimeout: resourceControlUpdate,
+		DeleteWithoutTimeout: resourceControlDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"control_identifier": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"parameters": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"value": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsJSON,
+						},
+					},
+				},
+			},
+			"target_identifier": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func resourceControlCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ControlTowerConn
+
+	controlIdentifier := d.Get("control_identifier").(string)
+	targetIdentifier := d.Get("target_identifier").(string)
+	id := ControlCreateResourceID(targetIdentifier, controlIdentifier)
+	input := &enableControlInput{
+		ControlIdentifier: aws.String(controlIdentifier),
+		TargetIdentifier:  aws.String(targetIdentifier),
+	}
+
+	if v, ok := d.GetOk("parameters"); ok && v.(*schema.Set).Len() > 0 {
+		parameters, err := expandEnabledControlParameters(v.(*schema.Set).List())
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		input.Parameters = parameters
+	}
+
+	log.Printf("[DEBUG] Enabling Control Tower Control: %s", id)
+	output, err := enableControlWithContext(ctx, conn, input)
+
+	if err != nil {
+		return diag.Errorf("enabling Control Tower Control (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waitControlOperationSucceeded(ctx, conn, aws.StringValue(output.OperationIdentifier), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for Control Tower Control (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceControlRead(ctx, d, meta)
+}
+
+func resourceControlRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ControlTowerConn
+
+	targetIdentifier, controlIdentifier, err := ControlParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	summary, err := FindEnabledControlByTwoPartKey(ctx, conn, targetIdentifier, controlIdentifier)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Control Tower Control (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Control Tower Control (%s): %s", d.Id(), err)
+	}
+
+	control, err := FindEnabledControlByARN(ctx, conn, aws.StringValue(summary.Arn))
+
+	if err != nil {
+		return diag.Errorf("reading Control Tower Control (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", control.Arn)
+	d.Set("control_identifier", control.ControlIdentifier)
+	d.Set("target_identifier", control.TargetIdentifier)
+
+	parameters, err := flattenEnabledControlParameters(control.Parameters)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("parameters", parameters); err != nil {
+		return diag.Errorf("setting parameters: %s", err)
+	}
+
+	return nil
+}
+
+func resourceControlUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ControlTowerConn
+
+	if d.HasChange("parameters") {
+		parameters, err := expandEnabledControlParameters(d.Get("parameters").(*schema.Set).List())
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		input := &updateEnabledControlInput{
+			EnabledControlIdentifier: aws.String(d.Get("arn").(string)),
+			Parameters:               parameters,
+		}
+
+		log.Printf("[DEBUG] Updating Control Tower Control: %s", d.Id())
+		output, err := updateEnabledControlWithContext(ctx, conn, input)
+
+		if err != nil {
+			return diag.Errorf("updating Control Tower Control (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitControlOperationSucceeded(ctx, conn, aws.StringValue(output.OperationIdentifier), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("waiting for Control Tower Control (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return resourceControlRead(ctx, d, meta)
+}
+
+func resourceControlDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ControlTowerConn
+
+	targetIdentifier, controlIdentifier, err := ControlParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Disabling Control Tower Control: %s", d.Id())
+	output, err := conn.DisableControlWithContext(ctx, &controltower.DisableControlInput{
+		ControlIdentifier: aws.String(controlIdentifier),
+		TargetIdentifier:  aws.String(targetIdentifier),
+	})
+
+	if tfawserr.ErrCodeEquals(err, controltower.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("disabling Control Tower Control (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitControlOperationSucceeded(ctx, conn, aws.StringValue(output.OperationIdentifier), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for Control Tower Control (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+const controlResourceIDSeparator = ","
+
+func ControlCreateResourceID(targetIdentifier, controlIdentifier string) string {
+	parts := []string{targetIdentifier, controlIdentifier}
+	id := strings.Join(parts, controlResourceIDSeparator)
+
+	return id
+}
+
+func ControlParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, controlResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected TargetIdentifier%[2]sControlIdentifier", id, controlResourceIDSeparator)
+}
+
+func expandEnabledControlParameters(tfList []interface{}) ([]*enabledControlParameter, error) {
+	apiObjects := make([]*enabledControlParameter, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &enabledControlParameter{
+			Key: aws.String(tfMap["key"].(string)),
+		}
+
+		if err := json.Unmarshal([]byte(tfMap["value"].(string)), &apiObject.Value); err != nil {
+			return nil, fmt.Errorf("decoding Control Tower Control parameter (%s) value: %w", tfMap["key"].(string), err)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects, nil
+}
+
+func flattenEnabledControlParameters(apiObjects []*enabledControlParameter) ([]interface{}, error) {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		value, err := json.Marshal(apiObject.Value)
+
+		if err != nil {
+			return nil, fmt.Errorf("encoding Control Tower Control parameter (%s) value: %w", aws.StringValue(apiObject.Key), err)
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"key":   aws.StringValue(apiObject.Key),
+			"value": string(value),
+		})
+	}
+
+	return tfList, nil
+}
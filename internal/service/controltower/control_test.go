@@ -0,0 +1,224 @@
+package controltower_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/controltower"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcontroltower "github.com/hashicorp/terraform-provider-aws/internal/service/controltower"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const testAccControlOrganizationalUnitName = "Security"
+
+func testAccControl_basic(t *testing.T) {
+	var control controltower.EnabledControlSummary
+	resourceName := "aws_controltower_control.test"
+	controlName := "AWS-GR_EC2_VOLUME_INUSE_CHECK"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckOrganizationManagementAccount(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, controltower.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckControlDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccControlConfig_basic(controlName, testAccControlOrganizationalUnitName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckControlExists(resourceName, &control),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "control_identifier"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "target_identifier"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccControl_disappears(t *testing.T) {
+	var control controltower.EnabledControlSummary
+	resourceName := "aws_controltower_control.test"
+	controlName := "AWS-GR_EC2_VOLUME_INUSE_CHECK"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckOrganizationManagementAccount(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, controltower.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckControlDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccControlConfig_basic(controlName, testAccControlOrganizationalUnitName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckControlExists(resourceName, &control),
+					acctest.CheckResourceDisappears(acctest.Provider, tfcontroltower.ResourceControl(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccControl_parameters(t *testing.T) {
+	controlIdentifier := os.Getenv("CONTROLTOWER_REGION_DENY_CONTROL_IDENTIFIER")
+	if controlIdentifier == "" {
+		t.Skip("Environment variable CONTROLTOWER_REGION_DENY_CONTROL_IDENTIFIER is not set")
+	}
+
+	var control controltower.EnabledControlSummary
+	resourceName := "aws_controltower_control.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckOrganizationManagementAccount(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, controltower.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckControlDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccControlConfig_parameters(controlIdentifier, testAccControlOrganizationalUnitName, acctest.Region()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckControlExists(resourceName, &control),
+					resource.TestCheckResourceAttr(resourceName, "parameters.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameters.*", map[string]string{
+						"key":   "AllowedRegions",
+						"value": fmt.Sprintf("[%q]", acctest.Region()),
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccControlConfig_parameters(controlIdentifier, testAccControlOrganizationalUnitName, acctest.AlternateRegion()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckControlExists(resourceName, &control),
+					resource.TestCheckResourceAttr(resourceName, "parameters.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameters.*", map[string]string{
+						"key":   "AllowedRegions",
+						"value": fmt.Sprintf("[%q]", acctest.AlternateRegion()),
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckControlExists(n string, v *controltower.EnabledControlSummary) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Control Tower Control ID is set")
+		}
+
+		targetIdentifier, controlIdentifier, err := tfcontroltower.ControlParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ControlTowerConn
+
+		output, err := tfcontroltower.FindEnabledControlByTwoPartKey(context.Background(), conn, targetIdentifier, controlIdentifier)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckControlDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ControlTowerConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_controltower_control" {
+			continue
+		}
+
+		targetIdentifier, controlIdentifier, err := tfcontroltower.ControlParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfcontroltower.FindEnabledControlByTwoPartKey(context.Background(), conn, targetIdentifier, controlIdentifier)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Control Tower Control %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccControlConfig_organizationalUnit(ouName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+data "aws_organizations_organization" "current" {}
+
+data "aws_organizations_organizational_units" "test" {
+  parent_id = data.aws_organizations_organization.current.roots[0].id
+}
+
+locals {
+  target_identifier = [for x in data.aws_organizations_organizational_units.test.children : x.arn if x.name == %[1]q][0]
+}
+`, ouName)
+}
+
+func testAccControlConfig_basic(controlName, ouName string) string {
+	return acctest.ConfigCompose(testAccControlConfig_organizationalUnit(ouName), fmt.Sprintf(`
+resource "aws_controltower_control" "test" {
+  control_identifier = "arn:${data.aws_partition.current.partition}:controltower:${data.aws_region.current.name}::control/%[1]s"
+  target_identifier  = local.target_identifier
+}
+`, controlName))
+}
+
+func testAccControlConfig_parameters(controlIdentifier, ouName, allowedRegion string) string {
+	return acctest.ConfigCompose(testAccControlConfig_organizationalUnit(ouName), fmt.Sprintf(`
+resource "aws_controltower_control" "test" {
+  control_identifier = %[1]q
+  target_identifier  = local.target_identifier
+
+  parameters {
+    key   = "AllowedRegions"
+    value = jsonencode([%[2]q])
+  }
+
+  parameters {
+    key   = "ExemptedPrincipalArns"
+    value = jsonencode([])
+  }
+}
+`, controlIdentifier, allowedRegion))
+}
@@ -0,0 +1,36 @@
+package controltower_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/controltower"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func testAccControlsDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_controltower_controls.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckOrganizationManagementAccount(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, controltower.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccControlsDataSourceConfig_basic(testAccControlOrganizationalUnitName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "target_identifier", "aws_controltower_control.test", "target_identifier"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "enabled_controls.*", "aws_controltower_control.test", "control_identifier"),
+				),
+			},
+		},
+	})
+}
+
+func testAccControlsDataSourceConfig_basic(ouName string) string {
+	return acctest.ConfigCompose(testAccControlConfig_basic("AWS-GR_EC2_VOLUME_INUSE_CHECK", ouName), `
+data "aws_controltower_controls" "test" {
+  target_identifier = aws_controltower_control.test.target_identifier
+}
+`)
+}
@@ -0,0 +1,35 @@
+package controltower_test
+
+import (
+	"testing"
+)
+
+func TestAccControlTower_serial(t *testing.T) {
+	testCases := map[string]map[string]func(t *testing.T){
+		"Control": {
+			"basic":      testAccControl_basic,
+			"disappears": testAccControl_disappears,
+			"parameters": testAccControl_parameters,
+		},
+		"Controls": {
+			"DataSource": testAccControlsDataSource_basic,
+		},
+		"LandingZone": {
+			"basic":      testAccLandingZone_basic,
+			"disappears": testAccLandingZone_disappears,
+			"tags":       testAccLandingZone_tags,
+		},
+	}
+
+	for group, m := range testCases {
+		m := m
+		t.Run(group, func(t *testing.T) {
+			for name, tc := range m {
+				tc := tc
+				t.Run(name, func(t *testing.T) {
+					tc(t)
+				})
+			}
+		})
+	}
+}
@@ -0,0 +1,155 @@
+package controltower
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/controltower"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindEnabledControlByTwoPartKey(ctx context.Context, conn *controltower.ControlTower, targetIdentifier, controlIdentifier string) (*controltower.EnabledControlSummary, error) {
+	input := &controltower.ListEnabledControlsInput{
+		TargetIdentifier: aws.String(targetIdentifier),
+	}
+	var output *controltower.EnabledControlSummary
+
+	err := conn.ListEnabledControlsPagesWithContext(ctx, input, func(page *controltower.ListEnabledControlsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.EnabledControls {
+			if v == nil {
+				continue
+			}
+
+			if aws.StringValue(v.ControlIdentifier) == controlIdentifier {
+				output = v
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, controltower.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindEnabledControlByARN(ctx context.Context, conn *controltower.ControlTower, arn string) (*enabledControlDetails, error) {
+	input := &getEnabledControlInput{
+		EnabledControlIdentifier: aws.String(arn),
+	}
+
+	output, err := getEnabledControlWithContext(ctx, conn, input)
+
+	if tfawserr.ErrCodeEquals(err, controltower.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.EnabledControlDetails == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.EnabledControlDetails, nil
+}
+
+func FindControlOperationByID(ctx context.Context, conn *controltower.ControlTower, id string) (*controltower.ControlOperation, error) {
+	input := &controltower.GetControlOperationInput{
+		OperationIdentifier: aws.String(id),
+	}
+
+	output, err := conn.GetControlOperationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, controltower.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ControlOperation == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ControlOperation, nil
+}
+
+func FindLandingZoneByID(ctx context.Context, conn *controltower.ControlTower, id string) (*landingZoneDetail, error) {
+	input := &getLandingZoneInput{
+		LandingZoneIdentifier: aws.String(id),
+	}
+
+	output, err := getLandingZoneWithContext(ctx, conn, input)
+
+	if tfawserr.ErrCodeEquals(err, controltower.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.LandingZone == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.LandingZone, nil
+}
+
+func FindLandingZoneOperationByID(ctx context.Context, conn *controltower.ControlTower, id string) (*controltower.LandingZoneOperationDetail, error) {
+	input := &controltower.GetLandingZoneOperationInput{
+		OperationIdentifier: aws.String(id),
+	}
+
+	output, err := conn.GetLandingZoneOperationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, controltower.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.OperationDetails == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.OperationDetails, nil
+}
@@ -0,0 +1,261 @@
+package controltower
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/controltower"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceLandingZone() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceLandingZoneCreate,
+		ReadWithoutTimeout:   resourceLandingZoneRead,
+		UpdateWithoutTimeout: resourceLandingZoneUpdate,
+		DeleteWithoutTimeout: resourceLandingZoneDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(120 * time.Minute),
+			Update: schema.DefaultTimeout(120 * time.Minute),
+			Delete: schema.DefaultTimeout(120 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"drift_status": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"latest_available_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"manifest_json": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"version": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceLandingZoneCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ControlTowerConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &createLandingZoneInput{
+		Version: aws.String(d.Get("version").(string)),
+	}
+
+	if err := json.Unmarshal([]byte(d.Get("manifest_json").(string)), &input.Manifest); err != nil {
+		return diag.Errorf("decoding manifest_json: %s", err)
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Control Tower Landing Zone (version %s)", aws.StringValue(input.Version))
+	output, err := createLandingZoneWithContext(ctx, conn, input)
+
+	if err != nil {
+		return diag.Errorf("creating Control Tower Landing Zone: %s", err)
+	}
+
+	id, err := landingZoneIDFromARN(aws.StringValue(output.Arn))
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waitLandingZoneOperationSucceeded(ctx, conn, aws.StringValue(output.OperationIdentifier), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for Control Tower Landing Zone (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceLandingZoneRead(ctx, d, meta)
+}
+
+func resourceLandingZoneRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ControlTowerConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	landingZone, err := FindLandingZoneByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Control Tower Landing Zone (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Control Tower Landing Zone (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", landingZone.Arn)
+	if landingZone.DriftStatus != nil {
+		if err := d.Set("drift_status", []interface{}{map[string]interface{}{
+			"status": aws.StringValue(landingZone.DriftStatus.Status),
+		}}); err != nil {
+			return diag.Errorf("setting drift_status: %s", err)
+		}
+	} else {
+		d.Set("drift_status", nil)
+	}
+	d.Set("latest_available_version", landingZone.LatestAvailableVersion)
+	d.Set("version", landingZone.Version)
+
+	manifest, err := json.Marshal(landingZone.Manifest)
+
+	if err != nil {
+		return diag.Errorf("encoding Control Tower Landing Zone (%s) manifest: %s", d.Id(), err)
+	}
+
+	manifestToSet, err := verify.SecondJSONUnlessEquivalent(d.Get("manifest_json").(string), string(manifest))
+
+	if err != nil {
+		return diag.Errorf("while setting manifest_json (%s), encountered: %s", manifest, err)
+	}
+
+	d.Set("manifest_json", manifestToSet)
+
+	tags, err := ListTagsWithContext(ctx, conn, aws.StringValue(landingZone.Arn))
+
+	if err != nil {
+		return diag.Errorf("listing tags for Control Tower Landing Zone (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceLandingZoneUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ControlTowerConn
+
+	if d.HasChanges("manifest_json", "version") {
+		input := &updateLandingZoneInput{
+			LandingZoneIdentifier: aws.String(d.Id()),
+			Version:               aws.String(d.Get("version").(string)),
+		}
+
+		if err := json.Unmarshal([]byte(d.Get("manifest_json").(string)), &input.Manifest); err != nil {
+			return diag.Errorf("decoding manifest_json: %s", err)
+		}
+
+		log.Printf("[DEBUG] Updating Control Tower Landing Zone: %s", d.Id())
+		output, err := updateLandingZoneWithContext(ctx, conn, input)
+
+		if err != nil {
+			return diag.Errorf("updating Control Tower Landing Zone (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitLandingZoneOperationSucceeded(ctx, conn, aws.StringValue(output.OperationIdentifier), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("waiting for Control Tower Landing Zone (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating Control Tower Landing Zone (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceLandingZoneRead(ctx, d, meta)
+}
+
+func resourceLandingZoneDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ControlTowerConn
+
+	log.Printf("[DEBUG] Deleting Control Tower Landing Zone: %s", d.Id())
+	output, err := conn.DeleteLandingZoneWithContext(ctx, &controltower.DeleteLandingZoneInput{
+		LandingZoneIdentifier: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, controltower.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Control Tower Landing Zone (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitLandingZoneOperationSucceeded(ctx, conn, aws.StringValue(output.OperationIdentifier), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for Control Tower Landing Zone (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// landingZoneIDFromARN returns the landing zone identifier from its ARN.
+// e.g. arn:aws:controltower:us-west-2:123456789012:landingzone/1A2B3C4D5E6F7G8H => 1A2B3C4D5E6F7G8H.
+func landingZoneIDFromARN(arnString string) (string, error) {
+	v, err := arn.Parse(arnString)
+
+	if err != nil {
+		return "", err
+	}
+
+	if id := strings.TrimPrefix(v.Resource, "landingzone/"); id != v.Resource && id != "" {
+		return id, nil
+	}
+
+	return "", fmt.Errorf("unexpected format for Control Tower Landing Zone ARN (%s)", arnString)
+}
@@ -0,0 +1,248 @@
+package controltower_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/controltower"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcontroltower "github.com/hashicorp/terraform-provider-aws/internal/service/controltower"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// Creating a landing zone requires log archive and audit accounts that are
+// members of the organization but not yet governed by Control Tower.
+func testAccPreCheckLandingZone(t *testing.T) (string, string) {
+	loggingAccountID := os.Getenv("CONTROLTOWER_LOGGING_ACCOUNT_ID")
+	securityAccountID := os.Getenv("CONTROLTOWER_SECURITY_ACCOUNT_ID")
+
+	if loggingAccountID == "" || securityAccountID == "" {
+		t.Skip("Environment variable CONTROLTOWER_LOGGING_ACCOUNT_ID or CONTROLTOWER_SECURITY_ACCOUNT_ID is not set")
+	}
+
+	return loggingAccountID, securityAccountID
+}
+
+func testAccLandingZone_basic(t *testing.T) {
+	loggingAccountID, securityAccountID := testAccPreCheckLandingZone(t)
+	resourceName := "aws_controltower_landing_zone.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckOrganizationManagementAccount(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, controltower.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLandingZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLandingZoneConfig_basic(loggingAccountID, securityAccountID, 365),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLandingZoneExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "controltower", regexp.MustCompile(`landingzone/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "drift_status.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "latest_available_version"),
+					resource.TestCheckResourceAttrSet(resourceName, "manifest_json"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "version", "3.3"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccLandingZoneConfig_basic(loggingAccountID, securityAccountID, 730),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLandingZoneExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "version", "3.3"),
+				),
+			},
+		},
+	})
+}
+
+func testAccLandingZone_disappears(t *testing.T) {
+	loggingAccountID, securityAccountID := testAccPreCheckLandingZone(t)
+	resourceName := "aws_controltower_landing_zone.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckOrganizationManagementAccount(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, controltower.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLandingZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLandingZoneConfig_basic(loggingAccountID, securityAccountID, 365),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLandingZoneExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfcontroltower.ResourceLandingZone(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccLandingZone_tags(t *testing.T) {
+	loggingAccountID, securityAccountID := testAccPreCheckLandingZone(t)
+	resourceName := "aws_controltower_landing_zone.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckOrganizationManagementAccount(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, controltower.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLandingZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLandingZoneConfig_tags1(loggingAccountID, securityAccountID, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLandingZoneExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccLandingZoneConfig_tags2(loggingAccountID, securityAccountID, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLandingZoneExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccLandingZoneConfig_tags1(loggingAccountID, securityAccountID, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLandingZoneExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLandingZoneExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Control Tower Landing Zone ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ControlTowerConn
+
+		_, err := tfcontroltower.FindLandingZoneByID(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckLandingZoneDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ControlTowerConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_controltower_landing_zone" {
+			continue
+		}
+
+		_, err := tfcontroltower.FindLandingZoneByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Control Tower Landing Zone %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccLandingZoneConfig_manifest(loggingAccountID, securityAccountID string, retentionDays int) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+locals {
+  manifest = jsonencode({
+    governedRegions = [data.aws_region.current.name]
+    organizationStructure = {
+      security = {
+        name = "Security"
+      }
+    }
+    centralizedLogging = {
+      accountId = %[1]q
+      configurations = {
+        loggingBucket = {
+          retentionDays = %[3]d
+        }
+        accessLoggingBucket = {
+          retentionDays = 3650
+        }
+      }
+      enabled = true
+    }
+    securityRoles = {
+      accountId = %[2]q
+    }
+    accessManagement = {
+      enabled = true
+    }
+  })
+}
+`, loggingAccountID, securityAccountID, retentionDays)
+}
+
+func testAccLandingZoneConfig_basic(loggingAccountID, securityAccountID string, retentionDays int) string {
+	return acctest.ConfigCompose(testAccLandingZoneConfig_manifest(loggingAccountID, securityAccountID, retentionDays), `
+resource "aws_controltower_landing_zone" "test" {
+  manifest_json = local.manifest
+  version       = "3.3"
+}
+`)
+}
+
+func testAccLandingZoneConfig_tags1(loggingAccountID, securityAccountID, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccLandingZoneConfig_manifest(loggingAccountID, securityAccountID, 365), fmt.Sprintf(`
+resource "aws_controltower_landing_zone" "test" {
+  manifest_json = local.manifest
+  version       = "3.3"
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1))
+}
+
+func testAccLandingZoneConfig_tags2(loggingAccountID, securityAccountID, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccLandingZoneConfig_manifest(loggingAccountID, securityAccountID, 365), fmt.Sprintf(`
+resource "aws_controltower_landing_zone" "test" {
+  manifest_json = local.manifest
+  version       = "3.3"
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
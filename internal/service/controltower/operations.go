@@ -0,0 +1,183 @@
+package controltower
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/private/protocol/restjson"
+	"github.com/aws/aws-sdk-go/service/controltower"
+)
+
+// The AWS SDK for Go v1 does not generate operations whose shapes contain
+// document types, which excludes the landing zone APIs and enabled control
+// parameters. The operations below are sent through the SDK client, so they
+// are signed, retried and have errors unmarshaled as usual, but their bodies
+// are encoded with encoding/json.
+
+type enabledControlParameter struct {
+	Key   *string     `json:"key"`
+	Value interface{} `json:"value"`
+}
+
+type enablementStatusSummary struct {
+	LastOperationIdentifier *string `json:"lastOperationIdentifier"`
+	Status                  *string `json:"status"`
+}
+
+type enabledControlDetails struct {
+	Arn               *string                    `json:"arn"`
+	ControlIdentifier *string                    `json:"controlIdentifier"`
+	Parameters        []*enabledControlParameter `json:"parameters"`
+	StatusSummary     *enablementStatusSummary   `json:"statusSummary"`
+	TargetIdentifier  *string                    `json:"targetIdentifier"`
+}
+
+type enableControlInput struct {
+	ControlIdentifier *string                    `json:"controlIdentifier"`
+	Parameters        []*enabledControlParameter `json:"parameters,omitempty"`
+	TargetIdentifier  *string                    `json:"targetIdentifier"`
+}
+
+type enableControlOutput struct {
+	Arn                 *string `json:"arn"`
+	OperationIdentifier *string `json:"operationIdentifier"`
+}
+
+type getEnabledControlInput struct {
+	EnabledControlIdentifier *string `json:"enabledControlIdentifier"`
+}
+
+type getEnabledControlOutput struct {
+	EnabledControlDetails *enabledControlDetails `json:"enabledControlDetails"`
+}
+
+type updateEnabledControlInput struct {
+	EnabledControlIdentifier *string                    `json:"enabledControlIdentifier"`
+	Parameters               []*enabledControlParameter `json:"parameters"`
+}
+
+type updateEnabledControlOutput struct {
+	OperationIdentifier *string `json:"operationIdentifier"`
+}
+
+type landingZoneDriftStatusSummary struct {
+	Status *string `json:"status"`
+}
+
+type landingZoneDetail struct {
+	Arn                    *string                        `json:"arn"`
+	DriftStatus            *landingZoneDriftStatusSummary `json:"driftStatus"`
+	LatestAvailableVersion *string                        `json:"latestAvailableVersion"`
+	Manifest               interface{}                    `json:"manifest"`
+	Status                 *string                        `json:"status"`
+	Version                *string                        `json:"version"`
+}
+
+type createLandingZoneInput struct {
+	Manifest interface{}        `json:"manifest"`
+	Tags     map[string]*string `json:"tags,omitempty"`
+	Version  *string            `json:"version"`
+}
+
+type createLandingZoneOutput struct {
+	Arn                 *string `json:"arn"`
+	OperationIdentifier *string `json:"operationIdentifier"`
+}
+
+type getLandingZoneInput struct {
+	LandingZoneIdentifier *string `json:"landingZoneIdentifier"`
+}
+
+type getLandingZoneOutput struct {
+	LandingZone *landingZoneDetail `json:"landingZone"`
+}
+
+type updateLandingZoneInput struct {
+	LandingZoneIdentifier *string     `json:"landingZoneIdentifier"`
+	Manifest              interface{} `json:"manifest"`
+	Version               *string     `json:"version"`
+}
+
+type updateLandingZoneOutput struct {
+	OperationIdentifier *string `json:"operationIdentifier"`
+}
+
+func enableControlWithContext(ctx context.Context, conn *controltower.ControlTower, input *enableControlInput) (*enableControlOutput, error) {
+	output := &enableControlOutput{}
+
+	return output, sendDocumentRequest(ctx, conn, "EnableControl", "/enable-control", input, output)
+}
+
+func getEnabledControlWithContext(ctx context.Context, conn *controltower.ControlTower, input *getEnabledControlInput) (*getEnabledControlOutput, error) {
+	output := &getEnabledControlOutput{}
+
+	return output, sendDocumentRequest(ctx, conn, "GetEnabledControl", "/get-enabled-control", input, output)
+}
+
+func updateEnabledControlWithContext(ctx context.Context, conn *controltower.ControlTower, input *updateEnabledControlInput) (*updateEnabledControlOutput, error) {
+	output := &updateEnabledControlOutput{}
+
+	return output, sendDocumentRequest(ctx, conn, "UpdateEnabledControl", "/update-enabled-control", input, output)
+}
+
+func createLandingZoneWithContext(ctx context.Context, conn *controltower.ControlTower, input *createLandingZoneInput) (*createLandingZoneOutput, error) {
+	output := &createLandingZoneOutput{}
+
+	return output, sendDocumentRequest(ctx, conn, "CreateLandingZone", "/create-landingzone", input, output)
+}
+
+func getLandingZoneWithContext(ctx context.Context, conn *controltower.ControlTower, input *getLandingZoneInput) (*getLandingZoneOutput, error) {
+	output := &getLandingZoneOutput{}
+
+	return output, sendDocumentRequest(ctx, conn, "GetLandingZone", "/get-landingzone", input, output)
+}
+
+func updateLandingZoneWithContext(ctx context.Context, conn *controltower.ControlTower, input *updateLandingZoneInput) (*updateLandingZoneOutput, error) {
+	output := &updateLandingZoneOutput{}
+
+	return output, sendDocumentRequest(ctx, conn, "UpdateLandingZone", "/update-landingzone", input, output)
+}
+
+func sendDocumentRequest(ctx context.Context, conn *controltower.ControlTower, name, path string, input, output interface{}) error {
+	req := conn.NewRequest(&request.Operation{
+		Name:       name,
+		HTTPMethod: http.MethodPost,
+		HTTPPath:   path,
+	}, input, output)
+
+	req.Handlers.Build.Swap(restjson.BuildHandler.Name, request.NamedHandler{
+		Name: "tfcontroltower.BuildDocument",
+		Fn:   buildDocumentRequest,
+	})
+	req.Handlers.Unmarshal.Swap(restjson.UnmarshalHandler.Name, request.NamedHandler{
+		Name: "tfcontroltower.UnmarshalDocument",
+		Fn:   unmarshalDocumentResponse,
+	})
+	req.SetContext(ctx)
+
+	return req.Send()
+}
+
+func buildDocumentRequest(r *request.Request) {
+	body, err := json.Marshal(r.Params)
+
+	if err != nil {
+		r.Error = awserr.New(request.ErrCodeSerialization, "failed encoding request body", err)
+		return
+	}
+
+	r.SetBufferBody(body)
+	r.HTTPRequest.Header.Set("Content-Type", "application/json")
+}
+
+func unmarshalDocumentResponse(r *request.Request) {
+	defer r.HTTPResponse.Body.Close()
+
+	if err := json.NewDecoder(r.HTTPResponse.Body).Decode(r.Data); err != nil && err != io.EOF {
+		r.Error = awserr.New(request.ErrCodeSerialization, "failed decoding response body", err)
+	}
+}
@@ -0,0 +1,170 @@
+package controltower
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/controltower"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+)
+
+func testOperationsConn(t *testing.T, handler http.HandlerFunc) *controltower.ControlTower {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("AKID", "SECRET", ""),
+		Endpoint:    aws.String(server.URL),
+		MaxRetries:  aws.Int(0),
+		Region:      aws.String("us-west-2"), //lintignore:AWSAT003
+	})
+
+	if err != nil {
+		t.Fatalf("creating session: %s", err)
+	}
+
+	return controltower.New(sess)
+}
+
+func TestEnableControlWithContext(t *testing.T) {
+	var gotPath string
+	var gotBody map[string]interface{}
+
+	conn := testOperationsConn(t, func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		body, _ := io.ReadAll(r.Body)
+		json.Unmarshal(body, &gotBody)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"arn":"arn:aws:controltower:us-west-2:123456789012:enabledcontrol/ABCDEFGHIJ","operationIdentifier":"0123456789abcdef0123456789abcdef0123"}`)) //lintignore:AWSAT003,AWSAT005
+	})
+
+	output, err := enableControlWithContext(context.Background(), conn, &enableControlInput{
+		ControlIdentifier: aws.String("arn:aws:controltower:us-west-2::control/AWS-GR_EC2_VOLUME_INUSE_CHECK"), //lintignore:AWSAT003,AWSAT005
+		Parameters: []*enabledControlParameter{{
+			Key:   aws.String("AllowedRegions"),
+			Value: []interface{}{"us-west-2"}, //lintignore:AWSAT003
+		}},
+		TargetIdentifier: aws.String("arn:aws:organizations::123456789012:ou/o-abcdefghij/ou-abcd-efghijkl"), //lintignore:AWSAT005
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := gotPath, "/enable-control"; got != want {
+		t.Errorf("path = %q, want %q", got, want)
+	}
+
+	wantParameters := []interface{}{
+		map[string]interface{}{
+			"key":   "AllowedRegions",
+			"value": []interface{}{"us-west-2"}, //lintignore:AWSAT003
+		},
+	}
+
+	if got := gotBody["parameters"]; !reflect.DeepEqual(got, wantParameters) {
+		t.Errorf("parameters = %#v, want %#v", got, wantParameters)
+	}
+
+	if got, want := aws.StringValue(output.OperationIdentifier), "0123456789abcdef0123456789abcdef0123"; got != want {
+		t.Errorf("operation identifier = %q, want %q", got, want)
+	}
+}
+
+func TestGetLandingZoneWithContext(t *testing.T) {
+	conn := testOperationsConn(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"landingZone":{"arn":"arn:aws:controltower:us-west-2:123456789012:landingzone/1A2B3C4D5E6F7G8H","manifest":{"governedRegions":["us-west-2"]},"status":"ACTIVE","version":"3.3"}}`)) //lintignore:AWSAT003,AWSAT005
+	})
+
+	output, err := getLandingZoneWithContext(context.Background(), conn, &getLandingZoneInput{
+		LandingZoneIdentifier: aws.String("1A2B3C4D5E6F7G8H"),
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	wantManifest := map[string]interface{}{
+		"governedRegions": []interface{}{"us-west-2"}, //lintignore:AWSAT003
+	}
+
+	if got := output.LandingZone.Manifest; !reflect.DeepEqual(got, wantManifest) {
+		t.Errorf("manifest = %#v, want %#v", got, wantManifest)
+	}
+
+	if got, want := aws.StringValue(output.LandingZone.Version), "3.3"; got != want {
+		t.Errorf("version = %q, want %q", got, want)
+	}
+}
+
+func TestGetLandingZoneWithContext_error(t *testing.T) {
+	conn := testOperationsConn(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Amzn-Errortype", "ResourceNotFoundException")
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"message":"Landing zone not found"}`))
+	})
+
+	_, err := getLandingZoneWithContext(context.Background(), conn, &getLandingZoneInput{
+		LandingZoneIdentifier: aws.String("1A2B3C4D5E6F7G8H"),
+	})
+
+	if !tfawserr.ErrCodeEquals(err, controltower.ErrCodeResourceNotFoundException) {
+		t.Fatalf("expected %s error, got: %v", controltower.ErrCodeResourceNotFoundException, err)
+	}
+}
+
+func TestLandingZoneIDFromARN(t *testing.T) {
+	testCases := []struct {
+		name        string
+		arn         string
+		expectedID  string
+		expectError bool
+	}{
+		{
+			name:        "empty",
+			arn:         "",
+			expectError: true,
+		},
+		{
+			name:        "wrong resource type",
+			arn:         "arn:aws:controltower:us-west-2:123456789012:enabledcontrol/ABCDEFGHIJ", //lintignore:AWSAT003,AWSAT005
+			expectError: true,
+		},
+		{
+			name:       "landing zone",
+			arn:        "arn:aws:controltower:us-west-2:123456789012:landingzone/1A2B3C4D5E6F7G8H", //lintignore:AWSAT003,AWSAT005
+			expectedID: "1A2B3C4D5E6F7G8H",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got, err := landingZoneIDFromARN(testCase.arn)
+
+			if err == nil && testCase.expectError {
+				t.Fatal("expected error, got none")
+			}
+
+			if err != nil && !testCase.expectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.expectedID {
+				t.Errorf("got %q, want %q", got, testCase.expectedID)
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package controltower
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/controltower"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusControlOperation(ctx context.Context, conn *controltower.ControlTower, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindControlOperationByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func statusLandingZoneOperation(ctx context.Context, conn *controltower.ControlTower, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindLandingZoneOperationByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
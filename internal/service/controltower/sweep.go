@@ -0,0 +1,67 @@
+//go:build sweep
+// +build sweep
+
+package controltower
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/controltower"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	// aws_controltower_landing_zone is not swept: a landing zone is account-wide and deleting it
+	// decommissions Control Tower for every test sharing the account.
+	resource.AddTestSweepers("aws_controltower_control", &resource.Sweeper{
+		Name: "aws_controltower_control",
+		F:    sweepControls,
+	})
+}
+
+func sweepControls(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).ControlTowerConn
+	input := &controltower.ListEnabledControlsInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListEnabledControlsPages(input, func(page *controltower.ListEnabledControlsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.EnabledControls {
+			r := ResourceControl()
+			d := r.Data(nil)
+			d.SetId(ControlCreateResourceID(aws.StringValue(v.TargetIdentifier), aws.StringValue(v.ControlIdentifier)))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Control Tower Control sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Control Tower Controls (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Control Tower Controls (%s): %w", region, err)
+	}
+
+	return nil
+}
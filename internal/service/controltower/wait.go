@@ -0,0 +1,54 @@
+package controltower
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/controltower"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func waitControlOperationSucceeded(ctx context.Context, conn *controltower.ControlTower, id string, timeout time.Duration) (*controltower.ControlOperation, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{controltower.ControlOperationStatusInProgress},
+		Target:  []string{controltower.ControlOperationStatusSucceeded},
+		Refresh: statusControlOperation(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*controltower.ControlOperation); ok {
+		if status := aws.StringValue(output.Status); status == controltower.ControlOperationStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitLandingZoneOperationSucceeded(ctx context.Context, conn *controltower.ControlTower, id string, timeout time.Duration) (*controltower.LandingZoneOperationDetail, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{controltower.LandingZoneOperationStatusInProgress},
+		Target:  []string{controltower.LandingZoneOperationStatusSucceeded},
+		Refresh: statusLandingZoneOperation(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*controltower.LandingZoneOperationDetail); ok {
+		if status := aws.StringValue(output.Status); status == controltower.LandingZoneOperationStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
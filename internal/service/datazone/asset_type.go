@@ -0,0 +1,274 @@
+package datazone
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/datazone"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceAssetType() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAssetTypeCreate,
+		ReadWithoutTimeout:   resourceAssetTypeRead,
+		DeleteWithoutTimeout: resourceAssetTypeDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 2048),
+			},
+			"domain_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"forms_input": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"map_block_key": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"required": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+						"type_identifier": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"type_revision": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"owning_project_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"revision": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAssetTypeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DataZoneConn
+
+	domainID := d.Get("domain_identifier").(string)
+	name := d.Get("name").(string)
+	input := &datazone.CreateAssetTypeInput{
+		DomainIdentifier:        aws.String(domainID),
+		FormsInput:              map[string]*datazone.FormEntryInput_{},
+		Name:                    aws.String(name),
+		OwningProjectIdentifier: aws.String(d.Get("owning_project_identifier").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("forms_input"); ok && v.(*schema.Set).Len() > 0 {
+		input.FormsInput = expandFormEntryInputs(v.(*schema.Set).List())
+	}
+
+	output, err := conn.CreateAssetTypeWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating DataZone Asset Type (%s): %s", name, err)
+	}
+
+	d.SetId(createResourceID(domainID, aws.StringValue(output.Name)))
+
+	return resourceAssetTypeRead(ctx, d, meta)
+}
+
+func resourceAssetTypeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DataZoneConn
+
+	domainID, name, err := parseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, err := FindAssetTypeByTwoPartKey(ctx, conn, domainID, name)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] DataZone Asset Type (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading DataZone Asset Type (%s): %s", d.Id(), err)
+	}
+
+	if output.CreatedAt != nil {
+		d.Set("created_at", aws.TimeValue(output.CreatedAt).Format(time.RFC3339))
+	} else {
+		d.Set("created_at", nil)
+	}
+	d.Set("created_by", output.CreatedBy)
+	d.Set("description", output.Description)
+	d.Set("domain_identifier", output.DomainId)
+	if err := d.Set("forms_input", flattenFormEntryOutputs(output.FormsOutput, d.Get("forms_input").(*schema.Set).List())); err != nil {
+		return diag.Errorf("setting forms_input: %s", err)
+	}
+	d.Set("name", output.Name)
+	d.Set("owning_project_identifier", output.OwningProjectId)
+	d.Set("revision", output.Revision)
+
+	return nil
+}
+
+func resourceAssetTypeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DataZoneConn
+
+	domainID, name, err := parseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting DataZone Asset Type: %s", d.Id())
+	_, err = conn.DeleteAssetTypeWithContext(ctx, &datazone.DeleteAssetTypeInput{
+		DomainIdentifier: aws.String(domainID),
+		Identifier:       aws.String(name),
+	})
+
+	if tfawserr.ErrCodeEquals(err, datazone.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting DataZone Asset Type (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandFormEntryInputs(tfList []interface{}) map[string]*datazone.FormEntryInput_ {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObjects := make(map[string]*datazone.FormEntryInput_)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		key, ok := tfMap["map_block_key"].(string)
+
+		if !ok || key == "" {
+			continue
+		}
+
+		apiObject := &datazone.FormEntryInput_{}
+
+		if v, ok := tfMap["required"].(bool); ok {
+			apiObject.Required = aws.Bool(v)
+		}
+
+		if v, ok := tfMap["type_identifier"].(string); ok && v != "" {
+			apiObject.TypeIdentifier = aws.String(v)
+		}
+
+		if v, ok := tfMap["type_revision"].(string); ok && v != "" {
+			apiObject.TypeRevision = aws.String(v)
+		}
+
+		apiObjects[key] = apiObject
+	}
+
+	return apiObjects
+}
+
+// flattenFormEntryOutputs returns the forms of the asset type.
+// The API reports the form type's name rather than the identifier used on
+// create, so the configured identifier is preserved where the form is known.
+func flattenFormEntryOutputs(apiObjects map[string]*datazone.FormEntryOutput_, configured []interface{}) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	typeIdentifiers := make(map[string]string)
+
+	for _, tfMapRaw := range configured {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		typeIdentifiers[tfMap["map_block_key"].(string)] = tfMap["type_identifier"].(string)
+	}
+
+	var tfList []interface{}
+
+	for key, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		typeIdentifier, ok := typeIdentifiers[key]
+
+		if !ok {
+			typeIdentifier = aws.StringValue(apiObject.TypeName)
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"map_block_key":   key,
+			"required":        aws.BoolValue(apiObject.Required),
+			"type_identifier": typeIdentifier,
+			"type_revision":   aws.StringValue(apiObject.TypeRevision),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,132 @@
+package datazone_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/datazone"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdatazone "github.com/hashicorp/terraform-provider-aws/internal/service/datazone"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccDataZoneAssetType_basic(t *testing.T) {
+	var v datazone.GetAssetTypeOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_datazone_asset_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, datazone.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAssetTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssetTypeConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssetTypeExists(resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_by"),
+					resource.TestCheckResourceAttr(resourceName, "description", "desc"),
+					resource.TestCheckResourceAttr(resourceName, "forms_input.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "owning_project_identifier", "aws_datazone_project.test", "project_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "revision"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDataZoneAssetType_disappears(t *testing.T) {
+	var v datazone.GetAssetTypeOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_datazone_asset_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, datazone.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAssetTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssetTypeConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssetTypeExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfdatazone.ResourceAssetType(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAssetTypeDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).DataZoneConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_datazone_asset_type" {
+			continue
+		}
+
+		_, err := tfdatazone.FindAssetTypeByTwoPartKey(context.Background(), conn, rs.Primary.Attributes["domain_identifier"], rs.Primary.Attributes["name"])
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("DataZone Asset Type %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAssetTypeExists(n string, v *datazone.GetAssetTypeOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No DataZone Asset Type ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataZoneConn
+
+		output, err := tfdatazone.FindAssetTypeByTwoPartKey(context.Background(), conn, rs.Primary.Attributes["domain_identifier"], rs.Primary.Attributes["name"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAssetTypeConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccProjectConfig_basic(rName, rName), fmt.Sprintf(`
+resource "aws_datazone_asset_type" "test" {
+  domain_identifier         = aws_datazone_domain.test.id
+  owning_project_identifier = aws_datazone_project.test.project_id
+  name                      = %[1]q
+  description               = "desc"
+}
+`, rName))
+}
@@ -0,0 +1,294 @@
+package datazone
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/datazone"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceDomain() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDomainCreate,
+		ReadWithoutTimeout:   resourceDomainRead,
+		UpdateWithoutTimeout: resourceDomainUpdate,
+		DeleteWithoutTimeout: resourceDomainDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"domain_execution_role": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"kms_key_identifier": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"portal_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"single_sign_on": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(datazone.AuthType_Values(), false),
+						},
+						"user_assignment": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(datazone.UserAssignment_Values(), false),
+						},
+					},
+				},
+			},
+			"skip_deletion_check": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceDomainCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DataZoneConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &datazone.CreateDomainInput{
+		DomainExecutionRole: aws.String(d.Get("domain_execution_role").(string)),
+		Name:                aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kms_key_identifier"); ok {
+		input.KmsKeyIdentifier = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("single_sign_on"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.SingleSignOn = expandSingleSignOn(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating DataZone Domain: %s", input)
+	output, err := conn.CreateDomainWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating DataZone Domain (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Id))
+
+	if _, err := waitDomainCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for DataZone Domain (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceDomainRead(ctx, d, meta)
+}
+
+func resourceDomainRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DataZoneConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindDomainByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] DataZone Domain (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading DataZone Domain (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.Arn)
+	d.Set("description", output.Description)
+	d.Set("domain_execution_role", output.DomainExecutionRole)
+	d.Set("kms_key_identifier", output.KmsKeyIdentifier)
+	d.Set("name", output.Name)
+	d.Set("portal_url", output.PortalUrl)
+	if output.SingleSignOn != nil {
+		if err := d.Set("single_sign_on", []interface{}{flattenSingleSignOn(output.SingleSignOn)}); err != nil {
+			return diag.Errorf("setting single_sign_on: %s", err)
+		}
+	} else {
+		d.Set("single_sign_on", nil)
+	}
+
+	tags := KeyValueTags(output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceDomainUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DataZoneConn
+
+	if d.HasChanges("description", "domain_execution_role", "name", "single_sign_on") {
+		input := &datazone.UpdateDomainInput{
+			Identifier: aws.String(d.Id()),
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("domain_execution_role") {
+			input.DomainExecutionRole = aws.String(d.Get("domain_execution_role").(string))
+		}
+
+		if d.HasChange("name") {
+			input.Name = aws.String(d.Get("name").(string))
+		}
+
+		if d.HasChange("single_sign_on") {
+			if v, ok := d.GetOk("single_sign_on"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.SingleSignOn = expandSingleSignOn(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		log.Printf("[DEBUG] Updating DataZone Domain: %s", input)
+		_, err := conn.UpdateDomainWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating DataZone Domain (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating DataZone Domain (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceDomainRead(ctx, d, meta)
+}
+
+func resourceDomainDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DataZoneConn
+
+	input := &datazone.DeleteDomainInput{
+		Identifier: aws.String(d.Id()),
+	}
+
+	if v, ok := d.GetOk("skip_deletion_check"); ok {
+		input.SkipDeletionCheck = aws.Bool(v.(bool))
+	}
+
+	log.Printf("[DEBUG] Deleting DataZone Domain: %s", d.Id())
+	_, err := conn.DeleteDomainWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, datazone.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting DataZone Domain (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitDomainDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for DataZone Domain (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandSingleSignOn(tfMap map[string]interface{}) *datazone.SingleSignOn {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &datazone.SingleSignOn{}
+
+	if v, ok := tfMap["type"].(string); ok && v != "" {
+		apiObject.Type = aws.String(v)
+	}
+
+	if v, ok := tfMap["user_assignment"].(string); ok && v != "" {
+		apiObject.UserAssignment = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenSingleSignOn(apiObject *datazone.SingleSignOn) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Type; v != nil {
+		tfMap["type"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.UserAssignment; v != nil {
+		tfMap["user_assignment"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
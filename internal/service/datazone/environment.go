@@ -0,0 +1,371 @@
+package datazone
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/datazone"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceEnvironment() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceEnvironmentCreate,
+		ReadWithoutTimeout:   resourceEnvironmentRead,
+		UpdateWithoutTimeout: resourceEnvironmentUpdate,
+		DeleteWithoutTimeout: resourceEnvironmentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_identifier": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"account_region": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidRegionName,
+			},
+			"blueprint_identifier": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"domain_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"environment_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"glossary_terms": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MinItems: 1,
+				MaxItems: 20,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"profile_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"project_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"provider_environment": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"provisioned_resources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"provider": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"user_parameters": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceEnvironmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DataZoneConn
+
+	domainID := d.Get("domain_identifier").(string)
+	name := d.Get("name").(string)
+	input := &datazone.CreateEnvironmentInput{
+		DomainIdentifier:             aws.String(domainID),
+		EnvironmentProfileIdentifier: aws.String(d.Get("profile_identifier").(string)),
+		Name:                         aws.String(name),
+		ProjectIdentifier:            aws.String(d.Get("project_identifier").(string)),
+	}
+
+	if v, ok := d.GetOk("account_identifier"); ok {
+		input.EnvironmentAccountIdentifier = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("account_region"); ok {
+		input.EnvironmentAccountRegion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("blueprint_identifier"); ok {
+		input.EnvironmentBlueprintIdentifier = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("glossary_terms"); ok && len(v.([]interface{})) > 0 {
+		input.GlossaryTerms = flex.ExpandStringList(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("user_parameters"); ok && len(v.([]interface{})) > 0 {
+		input.UserParameters = expandEnvironmentParameters(v.([]interface{}))
+	}
+
+	output, err := conn.CreateEnvironmentWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating DataZone Environment (%s): %s", name, err)
+	}
+
+	environmentID := aws.StringValue(output.Id)
+	d.SetId(createResourceID(domainID, environmentID))
+
+	if _, err := waitEnvironmentCreated(ctx, conn, domainID, environmentID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for DataZone Environment (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceEnvironmentRead(ctx, d, meta)
+}
+
+func resourceEnvironmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DataZoneConn
+
+	domainID, environmentID, err := parseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, err := FindEnvironmentByTwoPartKey(ctx, conn, domainID, environmentID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] DataZone Environment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading DataZone Environment (%s): %s", d.Id(), err)
+	}
+
+	d.Set("account_identifier", output.AwsAccountId)
+	d.Set("account_region", output.AwsAccountRegion)
+	d.Set("blueprint_identifier", output.EnvironmentBlueprintId)
+	if output.CreatedAt != nil {
+		d.Set("created_at", aws.TimeValue(output.CreatedAt).Format(time.RFC3339))
+	} else {
+		d.Set("created_at", nil)
+	}
+	d.Set("created_by", output.CreatedBy)
+	d.Set("description", output.Description)
+	d.Set("domain_identifier", output.DomainId)
+	d.Set("environment_id", output.Id)
+	d.Set("glossary_terms", aws.StringValueSlice(output.GlossaryTerms))
+	d.Set("name", output.Name)
+	d.Set("profile_identifier", output.EnvironmentProfileId)
+	d.Set("project_identifier", output.ProjectId)
+	d.Set("provider_environment", output.Provider)
+	if err := d.Set("provisioned_resources", flattenResources(output.ProvisionedResources)); err != nil {
+		return diag.Errorf("setting provisioned_resources: %s", err)
+	}
+
+	return nil
+}
+
+func resourceEnvironmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DataZoneConn
+
+	domainID, environmentID, err := parseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("description", "glossary_terms", "name") {
+		input := &datazone.UpdateEnvironmentInput{
+			DomainIdentifier: aws.String(domainID),
+			Identifier:       aws.String(environmentID),
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("glossary_terms") {
+			input.GlossaryTerms = flex.ExpandStringList(d.Get("glossary_terms").([]interface{}))
+		}
+
+		if d.HasChange("name") {
+			input.Name = aws.String(d.Get("name").(string))
+		}
+
+		_, err := conn.UpdateEnvironmentWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating DataZone Environment (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitEnvironmentUpdated(ctx, conn, domainID, environmentID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("waiting for DataZone Environment (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return resourceEnvironmentRead(ctx, d, meta)
+}
+
+func resourceEnvironmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DataZoneConn
+
+	domainID, environmentID, err := parseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting DataZone Environment: %s", d.Id())
+	_, err = conn.DeleteEnvironmentWithContext(ctx, &datazone.DeleteEnvironmentInput{
+		DomainIdentifier: aws.String(domainID),
+		Identifier:       aws.String(environmentID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, datazone.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting DataZone Environment (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitEnvironmentDeleted(ctx, conn, domainID, environmentID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for DataZone Environment (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandEnvironmentParameters(tfList []interface{}) []*datazone.EnvironmentParameter {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*datazone.EnvironmentParameter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &datazone.EnvironmentParameter{}
+
+		if v, ok := tfMap["name"].(string); ok && v != "" {
+			apiObject.Name = aws.String(v)
+		}
+
+		if v, ok := tfMap["value"].(string); ok {
+			apiObject.Value = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenResources(apiObjects []*datazone.Resource) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"name":     aws.StringValue(apiObject.Name),
+			"provider": aws.StringValue(apiObject.Provider),
+			"type":     aws.StringValue(apiObject.Type),
+			"value":    aws.StringValue(apiObject.Value),
+		})
+	}
+
+	return tfList
+}
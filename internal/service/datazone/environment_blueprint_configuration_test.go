@@ -0,0 +1,171 @@
+package datazone_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/datazone"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdatazone "github.com/hashicorp/terraform-provider-aws/internal/service/datazone"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccDataZoneEnvironmentBlueprintConfiguration_basic(t *testing.T) {
+	var v datazone.GetEnvironmentBlueprintConfigurationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_datazone_environment_blueprint_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, datazone.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentBlueprintConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentBlueprintConfigurationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentBlueprintConfigurationExists(resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "domain_id", "aws_datazone_domain.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "enabled_regions.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "enabled_regions.*", acctest.Region()),
+					resource.TestCheckResourceAttrPair(resourceName, "environment_blueprint_id", "data.aws_datazone_environment_blueprint.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "regional_parameters.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEnvironmentBlueprintConfigurationConfig_regionalParameters(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentBlueprintConfigurationExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "regional_parameters.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "regional_parameters.*", map[string]string{
+						"region":                acctest.Region(),
+						"parameters.%":          "1",
+						"parameters.S3Location": "s3://" + rName,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataZoneEnvironmentBlueprintConfiguration_disappears(t *testing.T) {
+	var v datazone.GetEnvironmentBlueprintConfigurationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_datazone_environment_blueprint_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, datazone.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentBlueprintConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentBlueprintConfigurationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentBlueprintConfigurationExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfdatazone.ResourceEnvironmentBlueprintConfiguration(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckEnvironmentBlueprintConfigurationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).DataZoneConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_datazone_environment_blueprint_configuration" {
+			continue
+		}
+
+		_, err := tfdatazone.FindEnvironmentBlueprintConfigurationByTwoPartKey(context.Background(), conn, rs.Primary.Attributes["domain_id"], rs.Primary.Attributes["environment_blueprint_id"])
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("DataZone Environment Blueprint Configuration %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckEnvironmentBlueprintConfigurationExists(n string, v *datazone.GetEnvironmentBlueprintConfigurationOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No DataZone Environment Blueprint Configuration ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataZoneConn
+
+		output, err := tfdatazone.FindEnvironmentBlueprintConfigurationByTwoPartKey(context.Background(), conn, rs.Primary.Attributes["domain_id"], rs.Primary.Attributes["environment_blueprint_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccEnvironmentBlueprintConfigurationConfig_base(rName string) string {
+	return acctest.ConfigCompose(testAccDomainConfig_basic(rName), `
+data "aws_region" "current" {}
+
+data "aws_datazone_environment_blueprint" "test" {
+  domain_id = aws_datazone_domain.test.id
+  name      = "DefaultDataLake"
+  managed   = true
+}
+`)
+}
+
+func testAccEnvironmentBlueprintConfigurationConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccEnvironmentBlueprintConfigurationConfig_base(rName), `
+resource "aws_datazone_environment_blueprint_configuration" "test" {
+  domain_id                = aws_datazone_domain.test.id
+  environment_blueprint_id = data.aws_datazone_environment_blueprint.test.id
+  enabled_regions          = [data.aws_region.current.name]
+}
+`)
+}
+
+func testAccEnvironmentBlueprintConfigurationConfig_regionalParameters(rName string) string {
+	return acctest.ConfigCompose(testAccEnvironmentBlueprintConfigurationConfig_base(rName), fmt.Sprintf(`
+resource "aws_datazone_environment_blueprint_configuration" "test" {
+  domain_id                = aws_datazone_domain.test.id
+  environment_blueprint_id = data.aws_datazone_environment_blueprint.test.id
+  enabled_regions          = [data.aws_region.current.name]
+
+  regional_parameters {
+    region = data.aws_region.current.name
+
+    parameters = {
+      "S3Location" = "s3://%[1]s"
+    }
+  }
+}
+`, rName))
+}
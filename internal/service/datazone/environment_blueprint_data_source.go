@@ -0,0 +1,99 @@
+package datazone
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/datazone"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func DataSourceEnvironmentBlueprint() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceEnvironmentBlueprintRead,
+
+		Schema: map[string]*schema.Schema{
+			"blueprint_provider": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"domain_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"managed": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceEnvironmentBlueprintRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DataZoneConn
+
+	domainID := d.Get("domain_id").(string)
+	name := d.Get("name").(string)
+	input := &datazone.ListEnvironmentBlueprintsInput{
+		DomainIdentifier: aws.String(domainID),
+		Managed:          aws.Bool(d.Get("managed").(bool)),
+		Name:             aws.String(name),
+	}
+
+	blueprint, err := findEnvironmentBlueprint(ctx, conn, input, name)
+
+	if err != nil {
+		return diag.FromErr(tfresource.SingularDataSourceFindError("DataZone Environment Blueprint", err))
+	}
+
+	d.SetId(aws.StringValue(blueprint.Id))
+	d.Set("blueprint_provider", blueprint.Provider)
+	d.Set("description", blueprint.Description)
+	d.Set("name", blueprint.Name)
+
+	return nil
+}
+
+func findEnvironmentBlueprint(ctx context.Context, conn *datazone.DataZone, input *datazone.ListEnvironmentBlueprintsInput, name string) (*datazone.EnvironmentBlueprintSummary, error) {
+	var output []*datazone.EnvironmentBlueprintSummary
+
+	err := conn.ListEnvironmentBlueprintsPagesWithContext(ctx, input, func(page *datazone.ListEnvironmentBlueprintsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Items {
+			// The name filter is a prefix match.
+			if v != nil && aws.StringValue(v.Name) == name {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 || output[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
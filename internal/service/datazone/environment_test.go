@@ -0,0 +1,159 @@
+package datazone_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/datazone"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdatazone "github.com/hashicorp/terraform-provider-aws/internal/service/datazone"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// Environment profiles cannot yet be managed by the provider, so an existing
+// domain, project and environment profile must be supplied.
+func testAccEnvironmentPreCheck(t *testing.T) (string, string, string) {
+	domainID := os.Getenv("DATAZONE_DOMAIN_ID")
+	projectID := os.Getenv("DATAZONE_PROJECT_ID")
+	profileID := os.Getenv("DATAZONE_ENVIRONMENT_PROFILE_ID")
+
+	if domainID == "" || projectID == "" || profileID == "" {
+		t.Skip("DATAZONE_DOMAIN_ID, DATAZONE_PROJECT_ID and DATAZONE_ENVIRONMENT_PROFILE_ID must be set for DataZone Environment acceptance tests")
+	}
+
+	return domainID, projectID, profileID
+}
+
+func TestAccDataZoneEnvironment_basic(t *testing.T) {
+	var v datazone.GetEnvironmentOutput
+	domainID, projectID, profileID := testAccEnvironmentPreCheck(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_datazone_environment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, datazone.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentConfig_basic(rName, domainID, projectID, profileID, "desc"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentExists(resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "account_identifier"),
+					resource.TestCheckResourceAttrSet(resourceName, "account_region"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+					resource.TestCheckResourceAttr(resourceName, "description", "desc"),
+					resource.TestCheckResourceAttr(resourceName, "domain_identifier", domainID),
+					resource.TestCheckResourceAttrSet(resourceName, "environment_id"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "profile_identifier", profileID),
+					resource.TestCheckResourceAttr(resourceName, "project_identifier", projectID),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEnvironmentConfig_basic(rName, domainID, projectID, profileID, "updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataZoneEnvironment_disappears(t *testing.T) {
+	var v datazone.GetEnvironmentOutput
+	domainID, projectID, profileID := testAccEnvironmentPreCheck(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_datazone_environment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, datazone.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentConfig_basic(rName, domainID, projectID, profileID, "desc"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfdatazone.ResourceEnvironment(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckEnvironmentDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).DataZoneConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_datazone_environment" {
+			continue
+		}
+
+		_, err := tfdatazone.FindEnvironmentByTwoPartKey(context.Background(), conn, rs.Primary.Attributes["domain_identifier"], rs.Primary.Attributes["environment_id"])
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("DataZone Environment %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckEnvironmentExists(n string, v *datazone.GetEnvironmentOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No DataZone Environment ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataZoneConn
+
+		output, err := tfdatazone.FindEnvironmentByTwoPartKey(context.Background(), conn, rs.Primary.Attributes["domain_identifier"], rs.Primary.Attributes["environment_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccEnvironmentConfig_basic(rName, domainID, projectID, profileID, description string) string {
+	return fmt.Sprintf(`
+resource "aws_datazone_environment" "test" {
+  domain_identifier  = %[2]q
+  project_identifier = %[3]q
+  profile_identifier = %[4]q
+  name               = %[1]q
+  description        = %[5]q
+}
+`, rName, domainID, projectID, profileID, description)
+}
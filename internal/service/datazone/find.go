@@ -0,0 +1,180 @@
+package datazone
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/datazone"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindDomainByID(ctx context.Context, conn *datazone.DataZone, id string) (*datazone.GetDomainOutput, error) {
+	input := &datazone.GetDomainInput{
+		Identifier: aws.String(id),
+	}
+
+	output, err := conn.GetDomainWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, datazone.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if status := aws.StringValue(output.Status); status == datazone.DomainStatusDeleted {
+		return nil, &resource.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func FindProjectByTwoPartKey(ctx context.Context, conn *datazone.DataZone, domainID, id string) (*datazone.GetProjectOutput, error) {
+	input := &datazone.GetProjectInput{
+		DomainIdentifier: aws.String(domainID),
+		Identifier:       aws.String(id),
+	}
+
+	output, err := conn.GetProjectWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, datazone.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindEnvironmentByTwoPartKey(ctx context.Context, conn *datazone.DataZone, domainID, id string) (*datazone.GetEnvironmentOutput, error) {
+	input := &datazone.GetEnvironmentInput{
+		DomainIdentifier: aws.String(domainID),
+		Identifier:       aws.String(id),
+	}
+
+	output, err := conn.GetEnvironmentWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, datazone.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if status := aws.StringValue(output.Status); status == datazone.EnvironmentStatusDeleted {
+		return nil, &resource.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func FindEnvironmentBlueprintConfigurationByTwoPartKey(ctx context.Context, conn *datazone.DataZone, domainID, environmentBlueprintID string) (*datazone.GetEnvironmentBlueprintConfigurationOutput, error) {
+	input := &datazone.GetEnvironmentBlueprintConfigurationInput{
+		DomainIdentifier:               aws.String(domainID),
+		EnvironmentBlueprintIdentifier: aws.String(environmentBlueprintID),
+	}
+
+	output, err := conn.GetEnvironmentBlueprintConfigurationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, datazone.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindGlossaryByTwoPartKey(ctx context.Context, conn *datazone.DataZone, domainID, id string) (*datazone.GetGlossaryOutput, error) {
+	input := &datazone.GetGlossaryInput{
+		DomainIdentifier: aws.String(domainID),
+		Identifier:       aws.String(id),
+	}
+
+	output, err := conn.GetGlossaryWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, datazone.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindAssetTypeByTwoPartKey(ctx context.Context, conn *datazone.DataZone, domainID, name string) (*datazone.GetAssetTypeOutput, error) {
+	input := &datazone.GetAssetTypeInput{
+		DomainIdentifier: aws.String(domainID),
+		Identifier:       aws.String(name),
+	}
+
+	output, err := conn.GetAssetTypeWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, datazone.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
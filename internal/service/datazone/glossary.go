@@ -0,0 +1,200 @@
+package datazone
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/datazone"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceGlossary() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceGlossaryCreate,
+		ReadWithoutTimeout:   resourceGlossaryRead,
+		UpdateWithoutTimeout: resourceGlossaryUpdate,
+		DeleteWithoutTimeout: resourceGlossaryDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 4096),
+			},
+			"domain_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"glossary_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"owning_project_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(datazone.GlossaryStatus_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceGlossaryCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DataZoneConn
+
+	domainID := d.Get("domain_identifier").(string)
+	name := d.Get("name").(string)
+	input := &datazone.CreateGlossaryInput{
+		DomainIdentifier:        aws.String(domainID),
+		Name:                    aws.String(name),
+		OwningProjectIdentifier: aws.String(d.Get("owning_project_identifier").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("status"); ok {
+		input.Status = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateGlossaryWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating DataZone Glossary (%s): %s", name, err)
+	}
+
+	d.SetId(createResourceID(domainID, aws.StringValue(output.Id)))
+
+	return resourceGlossaryRead(ctx, d, meta)
+}
+
+func resourceGlossaryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DataZoneConn
+
+	domainID, glossaryID, err := parseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, err := FindGlossaryByTwoPartKey(ctx, conn, domainID, glossaryID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] DataZone Glossary (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading DataZone Glossary (%s): %s", d.Id(), err)
+	}
+
+	d.Set("description", output.Description)
+	d.Set("domain_identifier", output.DomainId)
+	d.Set("glossary_id", output.Id)
+	d.Set("name", output.Name)
+	d.Set("owning_project_identifier", output.OwningProjectId)
+	d.Set("status", output.Status)
+
+	return nil
+}
+
+func resourceGlossaryUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DataZoneConn
+
+	domainID, glossaryID, err := parseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	input := &datazone.UpdateGlossaryInput{
+		DomainIdentifier: aws.String(domainID),
+		Identifier:       aws.String(glossaryID),
+	}
+
+	if d.HasChange("description") {
+		input.Description = aws.String(d.Get("description").(string))
+	}
+
+	if d.HasChange("name") {
+		input.Name = aws.String(d.Get("name").(string))
+	}
+
+	if d.HasChange("status") {
+		input.Status = aws.String(d.Get("status").(string))
+	}
+
+	_, err = conn.UpdateGlossaryWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("updating DataZone Glossary (%s): %s", d.Id(), err)
+	}
+
+	return resourceGlossaryRead(ctx, d, meta)
+}
+
+func resourceGlossaryDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DataZoneConn
+
+	domainID, glossaryID, err := parseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Enabled glossaries cannot be deleted.
+	if d.Get("status").(string) == datazone.GlossaryStatusEnabled {
+		_, err := conn.UpdateGlossaryWithContext(ctx, &datazone.UpdateGlossaryInput{
+			DomainIdentifier: aws.String(domainID),
+			Identifier:       aws.String(glossaryID),
+			Status:           aws.String(datazone.GlossaryStatusDisabled),
+		})
+
+		if tfawserr.ErrCodeEquals(err, datazone.ErrCodeResourceNotFoundException) {
+			return nil
+		}
+
+		if err != nil {
+			return diag.Errorf("disabling DataZone Glossary (%s): %s", d.Id(), err)
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting DataZone Glossary: %s", d.Id())
+	_, err = conn.DeleteGlossaryWithContext(ctx, &datazone.DeleteGlossaryInput{
+		DomainIdentifier: aws.String(domainID),
+		Identifier:       aws.String(glossaryID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, datazone.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting DataZone Glossary (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
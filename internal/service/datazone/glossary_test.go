@@ -0,0 +1,139 @@
+package datazone_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/datazone"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdatazone "github.com/hashicorp/terraform-provider-aws/internal/service/datazone"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccDataZoneGlossary_basic(t *testing.T) {
+	var v datazone.GetGlossaryOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_datazone_glossary.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, datazone.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGlossaryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlossaryConfig_basic(rName, "desc", datazone.GlossaryStatusEnabled),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlossaryExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "desc"),
+					resource.TestCheckResourceAttrSet(resourceName, "glossary_id"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "owning_project_identifier", "aws_datazone_project.test", "project_id"),
+					resource.TestCheckResourceAttr(resourceName, "status", datazone.GlossaryStatusEnabled),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccGlossaryConfig_basic(rName, "updated", datazone.GlossaryStatusDisabled),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlossaryExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+					resource.TestCheckResourceAttr(resourceName, "status", datazone.GlossaryStatusDisabled),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataZoneGlossary_disappears(t *testing.T) {
+	var v datazone.GetGlossaryOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_datazone_glossary.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, datazone.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGlossaryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlossaryConfig_basic(rName, "desc", datazone.GlossaryStatusDisabled),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlossaryExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfdatazone.ResourceGlossary(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckGlossaryDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).DataZoneConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_datazone_glossary" {
+			continue
+		}
+
+		_, err := tfdatazone.FindGlossaryByTwoPartKey(context.Background(), conn, rs.Primary.Attributes["domain_identifier"], rs.Primary.Attributes["glossary_id"])
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("DataZone Glossary %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckGlossaryExists(n string, v *datazone.GetGlossaryOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No DataZone Glossary ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataZoneConn
+
+		output, err := tfdatazone.FindGlossaryByTwoPartKey(context.Background(), conn, rs.Primary.Attributes["domain_identifier"], rs.Primary.Attributes["glossary_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccGlossaryConfig_basic(rName, description, status string) string {
+	return acctest.ConfigCompose(testAccProjectConfig_basic(rName, rName), fmt.Sprintf(`
+resource "aws_datazone_glossary" "test" {
+  domain_identifier         = aws_datazone_domain.test.id
+  owning_project_identifier = aws_datazone_project.test.project_id
+  name                      = %[1]q
+  description               = %[2]q
+  status                    = %[3]q
+}
+`, rName, description, status))
+}
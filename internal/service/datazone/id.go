@@ -0,0 +1,25 @@
+package datazone
+
+import (
+	"fmt"
+	"strings"
+)
+
+const resourceIDSeparator = ","
+
+func createResourceID(domainID, id string) string {
+	parts := []string{domainID, id}
+	id = strings.Join(parts, resourceIDSeparator)
+
+	return id
+}
+
+func parseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, resourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected DOMAIN-ID%[2]sRESOURCE-ID", id, resourceIDSeparator)
+}
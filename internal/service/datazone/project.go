@@ -0,0 +1,213 @@
+package datazone
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/datazone"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceProject() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceProjectCreate,
+		ReadWithoutTimeout:   resourceProjectRead,
+		UpdateWithoutTimeout: resourceProjectUpdate,
+		DeleteWithoutTimeout: resourceProjectDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 2048),
+			},
+			"domain_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"glossary_terms": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MinItems: 1,
+				MaxItems: 20,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"project_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"skip_deletion_check": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceProjectCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DataZoneConn
+
+	domainID := d.Get("domain_identifier").(string)
+	name := d.Get("name").(string)
+	input := &datazone.CreateProjectInput{
+		DomainIdentifier: aws.String(domainID),
+		Name:             aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("glossary_terms"); ok && len(v.([]interface{})) > 0 {
+		input.GlossaryTerms = flex.ExpandStringList(v.([]interface{}))
+	}
+
+	output, err := conn.CreateProjectWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating DataZone Project (%s): %s", name, err)
+	}
+
+	d.SetId(createResourceID(domainID, aws.StringValue(output.Id)))
+
+	return resourceProjectRead(ctx, d, meta)
+}
+
+func resourceProjectRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DataZoneConn
+
+	domainID, projectID, err := parseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, err := FindProjectByTwoPartKey(ctx, conn, domainID, projectID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] DataZone Project (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading DataZone Project (%s): %s", d.Id(), err)
+	}
+
+	if output.CreatedAt != nil {
+		d.Set("created_at", aws.TimeValue(output.CreatedAt).Format(time.RFC3339))
+	} else {
+		d.Set("created_at", nil)
+	}
+	d.Set("created_by", output.CreatedBy)
+	d.Set("description", output.Description)
+	d.Set("domain_identifier", output.DomainId)
+	d.Set("glossary_terms", aws.StringValueSlice(output.GlossaryTerms))
+	d.Set("name", output.Name)
+	d.Set("project_id", output.Id)
+
+	return nil
+}
+
+func resourceProjectUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DataZoneConn
+
+	domainID, projectID, err := parseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("description", "glossary_terms", "name") {
+		input := &datazone.UpdateProjectInput{
+			DomainIdentifier: aws.String(domainID),
+			Identifier:       aws.String(projectID),
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("glossary_terms") {
+			input.GlossaryTerms = flex.ExpandStringList(d.Get("glossary_terms").([]interface{}))
+		}
+
+		if d.HasChange("name") {
+			input.Name = aws.String(d.Get("name").(string))
+		}
+
+		_, err := conn.UpdateProjectWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating DataZone Project (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceProjectRead(ctx, d, meta)
+}
+
+func resourceProjectDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DataZoneConn
+
+	domainID, projectID, err := parseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	input := &datazone.DeleteProjectInput{
+		DomainIdentifier: aws.String(domainID),
+		Identifier:       aws.String(projectID),
+	}
+
+	if v, ok := d.GetOk("skip_deletion_check"); ok {
+		input.SkipDeletionCheck = aws.Bool(v.(bool))
+	}
+
+	log.Printf("[DEBUG] Deleting DataZone Project: %s", d.Id())
+	_, err = conn.DeleteProjectWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, datazone.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting DataZone Project (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitProjectDeleted(ctx, conn, domainID, projectID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for DataZone Project (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
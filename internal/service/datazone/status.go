@@ -0,0 +1,58 @@
+package datazone
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/datazone"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusDomain(ctx context.Context, conn *datazone.DataZone, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindDomainByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func statusProject(ctx context.Context, conn *datazone.DataZone, domainID, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindProjectByTwoPartKey(ctx, conn, domainID, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.ProjectStatus), nil
+	}
+}
+
+func statusEnvironment(ctx context.Context, conn *datazone.DataZone, domainID, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindEnvironmentByTwoPartKey(ctx, conn, domainID, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
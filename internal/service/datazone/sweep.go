@@ -0,0 +1,66 @@
+//go:build sweep
+// +build sweep
+
+package datazone
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/datazone"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_datazone_domain", &resource.Sweeper{
+		Name: "aws_datazone_domain",
+		F:    sweepDomains,
+	})
+}
+
+func sweepDomains(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).DataZoneConn
+	input := &datazone.ListDomainsInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListDomainsPages(input, func(page *datazone.ListDomainsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Items {
+			r := ResourceDomain()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Id))
+			d.Set("skip_deletion_check", true)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping DataZone Domain sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing DataZone Domains (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping DataZone Domains (%s): %w", region, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,125 @@
+package datazone
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/datazone"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func waitDomainCreated(ctx context.Context, conn *datazone.DataZone, id string, timeout time.Duration) (*datazone.GetDomainOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{datazone.DomainStatusCreating},
+		Target:  []string{datazone.DomainStatusAvailable},
+		Refresh: statusDomain(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*datazone.GetDomainOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDomainDeleted(ctx context.Context, conn *datazone.DataZone, id string, timeout time.Duration) (*datazone.GetDomainOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{datazone.DomainStatusAvailable, datazone.DomainStatusDeleting},
+		Target:  []string{},
+		Refresh: statusDomain(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*datazone.GetDomainOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitProjectDeleted(ctx context.Context, conn *datazone.DataZone, domainID, id string, timeout time.Duration) (*datazone.GetProjectOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{datazone.ProjectStatusActive, datazone.ProjectStatusDeleting},
+		Target:  []string{},
+		Refresh: statusProject(ctx, conn, domainID, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*datazone.GetProjectOutput); ok {
+		if reasons := output.FailureReasons; len(reasons) > 0 {
+			var errs *multierror.Error
+
+			for _, reason := range reasons {
+				errs = multierror.Append(errs, fmt.Errorf("%s: %s", aws.StringValue(reason.Code), aws.StringValue(reason.Message)))
+			}
+
+			tfresource.SetLastError(err, errs.ErrorOrNil())
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitEnvironmentCreated(ctx context.Context, conn *datazone.DataZone, domainID, id string, timeout time.Duration) (*datazone.GetEnvironmentOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{datazone.EnvironmentStatusCreating},
+		Target:  []string{datazone.EnvironmentStatusActive},
+		Refresh: statusEnvironment(ctx, conn, domainID, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*datazone.GetEnvironmentOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitEnvironmentUpdated(ctx context.Context, conn *datazone.DataZone, domainID, id string, timeout time.Duration) (*datazone.GetEnvironmentOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{datazone.EnvironmentStatusUpdating},
+		Target:  []string{datazone.EnvironmentStatusActive},
+		Refresh: statusEnvironment(ctx, conn, domainID, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*datazone.GetEnvironmentOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitEnvironmentDeleted(ctx context.Context, conn *datazone.DataZone, domainID, id string, timeout time.Duration) (*datazone.GetEnvironmentOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{datazone.EnvironmentStatusActive, datazone.EnvironmentStatusDeleting},
+		Target:  []string{},
+		Refresh: statusEnvironment(ctx, conn, domainID, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*datazone.GetEnvironmentOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
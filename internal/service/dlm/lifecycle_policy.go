@@ -33,6 +33,12 @@ func ResourceLifecyclePolicy() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"default_policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(dlm.DefaultPolicyTypeValues_Values(), false),
+			},
 			"description": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -124,6 +130,30 @@ func ResourceLifecyclePolicy() *schema.Resource {
 								},
 							},
 						},
+						"copy_tags": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"create_interval": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 7),
+						},
+						"cross_region_copy_target": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MaxItems: 3,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"target_region": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[\w:\-\/\*]+$`), ""),
+									},
+								},
+							},
+						},
 						"event_source": {
 							Type:     schema.TypeList,
 							Optional: true,
@@ -166,6 +196,41 @@ func ResourceLifecyclePolicy() *schema.Resource {
 								},
 							},
 						},
+						"exclusions": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"exclude_boot_volumes": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"exclude_tags": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"exclude_volume_types": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 6,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"extend_deletion": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"resource_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(dlm.ResourceTypeValues_Values(), false),
+						},
 						"resource_types": {
 							Type:     schema.TypeList,
 							Optional: true,
@@ -202,12 +267,23 @@ func ResourceLifecyclePolicy() *schema.Resource {
 								},
 							},
 						},
+						"policy_language": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(dlm.PolicyLanguageValues_Values(), false),
+						},
 						"policy_type": {
 							Type:         schema.TypeString,
 							Optional:     true,
 							Default:      dlm.PolicyTypeValuesEbsSnapshotManagement,
 							ValidateFunc: validation.StringInSlice(dlm.PolicyTypeValues_Values(), false),
 						},
+						"retain_interval": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(2, 14),
+						},
 						"schedule": {
 							Type:     schema.TypeList,
 							Optional: true,
@@ -215,6 +291,51 @@ func ResourceLifecyclePolicy() *schema.Resource {
 							MaxItems: 4,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
+									"archive_rule": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"archive_retain_rule": {
+													Type:     schema.TypeList,
+													Required: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"retention_archive_tier": {
+																Type:     schema.TypeList,
+																Required: true,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"count": {
+																			Type:         schema.TypeInt,
+																			Optional:     true,
+																			ValidateFunc: validation.IntBetween(1, 1000),
+																		},
+																		"interval": {
+																			Type:         schema.TypeInt,
+																			Optional:     true,
+																			ValidateFunc: validation.IntAtLeast(1),
+																		},
+																		"interval_unit": {
+																			Type:     schema.TypeString,
+																			Optional: true,
+																			ValidateFunc: validation.StringInSlice(
+																				dlm.RetentionIntervalUnitValues_Values(),
+																				false,
+																			),
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
 									"copy_tags": {
 										Type:     schema.TypeBool,
 										Optional: true,
@@ -504,6 +625,10 @@ func resourceLifecyclePolicyCreate(d *schema.ResourceData, meta interface{}) err
 		State:            aws.String(d.Get("state").(string)),
 	}
 
+	if v, ok := d.GetOk("default_policy"); ok {
+		input.DefaultPolicy = aws.String(v.(string))
+	}
+
 	if len(tags) > 0 {
 		input.Tags = Tags(tags.IgnoreAWS())
 	}
@@ -543,6 +668,12 @@ func resourceLifecyclePolicyRead(d *schema.ResourceData, meta interface{}) error
 	}
 
 	d.Set("arn", out.Policy.PolicyArn)
+	// DLM reports whether a policy is a default policy; the default policy type is its resource type.
+	if aws.BoolValue(out.Policy.DefaultPolicy) && out.Policy.PolicyDetails != nil {
+		d.Set("default_policy", out.Policy.PolicyDetails.ResourceType)
+	} else {
+		d.Set("default_policy", "")
+	}
 	d.Set("description", out.Policy.Description)
 	d.Set("execution_role_arn", out.Policy.ExecutionRoleArn)
 	d.Set("state", out.Policy.State)
@@ -650,6 +781,30 @@ func expandPolicyDetails(cfg []interface{}) *dlm.PolicyDetails {
 	if v, ok := m["parameters"].([]interface{}); ok && len(v) > 0 {
 		policyDetails.Parameters = expandParameters(v, policyType)
 	}
+	if v, ok := m["policy_language"].(string); ok && v != "" {
+		policyDetails.PolicyLanguage = aws.String(v)
+	}
+	if v, ok := m["resource_type"].(string); ok && v != "" {
+		policyDetails.ResourceType = aws.String(v)
+	}
+	if v, ok := m["copy_tags"].(bool); ok && v {
+		policyDetails.CopyTags = aws.Bool(v)
+	}
+	if v, ok := m["create_interval"].(int); ok && v > 0 {
+		policyDetails.CreateInterval = aws.Int64(int64(v))
+	}
+	if v, ok := m["cross_region_copy_target"].(*schema.Set); ok && v.Len() > 0 {
+		policyDetails.CrossRegionCopyTargets = expandCrossRegionCopyTargets(v.List())
+	}
+	if v, ok := m["exclusions"].([]interface{}); ok && len(v) > 0 {
+		policyDetails.Exclusions = expandExclusions(v)
+	}
+	if v, ok := m["extend_deletion"].(bool); ok && v {
+		policyDetails.ExtendDeletion = aws.Bool(v)
+	}
+	if v, ok := m["retain_interval"].(int); ok && v > 0 {
+		policyDetails.RetainInterval = aws.Int64(int64(v))
+	}
 
 	return policyDetails
 }
@@ -663,6 +818,17 @@ func flattenPolicyDetails(policyDetails *dlm.PolicyDetails) []map[string]interfa
 	result["schedule"] = flattenSchedules(policyDetails.Schedules)
 	result["target_tags"] = flattenTags(policyDetails.TargetTags)
 	result["policy_type"] = aws.StringValue(policyDetails.PolicyType)
+	result["policy_language"] = aws.StringValue(policyDetails.PolicyLanguage)
+	result["resource_type"] = aws.StringValue(policyDetails.ResourceType)
+	result["copy_tags"] = aws.BoolValue(policyDetails.CopyTags)
+	result["create_interval"] = aws.Int64Value(policyDetails.CreateInterval)
+	result["cross_region_copy_target"] = flattenCrossRegionCopyTargets(policyDetails.CrossRegionCopyTargets)
+	result["extend_deletion"] = aws.BoolValue(policyDetails.ExtendDeletion)
+	result["retain_interval"] = aws.Int64Value(policyDetails.RetainInterval)
+
+	if policyDetails.Exclusions != nil {
+		result["exclusions"] = flattenExclusions(policyDetails.Exclusions)
+	}
 
 	if policyDetails.Parameters != nil {
 		result["parameters"] = flattenParameters(policyDetails.Parameters)
@@ -676,6 +842,9 @@ func expandSchedules(cfg []interface{}) []*dlm.Schedule {
 	for i, c := range cfg {
 		schedule := &dlm.Schedule{}
 		m := c.(map[string]interface{})
+		if v, ok := m["archive_rule"].([]interface{}); ok && len(v) > 0 {
+			schedule.ArchiveRule = expandArchiveRule(v)
+		}
 		if v, ok := m["copy_tags"]; ok {
 			schedule.CopyTags = aws.Bool(v.(bool))
 		}
@@ -725,6 +894,10 @@ func flattenSchedules(schedules []*dlm.Schedule) []map[string]interface{} {
 		m["tags_to_add"] = flattenTags(s.TagsToAdd)
 		m["variable_tags"] = flattenTags(s.VariableTags)
 
+		if s.ArchiveRule != nil {
+			m["archive_rule"] = flattenArchiveRule(s.ArchiveRule)
+		}
+
 		if s.DeprecateRule != nil {
 			m["deprecate_rule"] = flattenDeprecateRule(s.DeprecateRule)
 		}
@@ -1304,3 +1477,143 @@ func flattenParameters(parameters *dlm.Parameters) []map[string]interface{} {
 
 	return []map[string]interface{}{result}
 }
+
+func expandArchiveRule(cfg []interface{}) *dlm.ArchiveRule {
+	if len(cfg) == 0 || cfg[0] == nil {
+		return nil
+	}
+	m := cfg[0].(map[string]interface{})
+
+	return &dlm.ArchiveRule{
+		RetainRule: expandArchiveRetainRule(m["archive_retain_rule"].([]interface{})),
+	}
+}
+
+func flattenArchiveRule(rule *dlm.ArchiveRule) []map[string]interface{} {
+	result := make(map[string]interface{})
+	result["archive_retain_rule"] = flattenArchiveRetainRule(rule.RetainRule)
+
+	return []map[string]interface{}{result}
+}
+
+func expandArchiveRetainRule(cfg []interface{}) *dlm.ArchiveRetainRule {
+	if len(cfg) == 0 || cfg[0] == nil {
+		return nil
+	}
+	m := cfg[0].(map[string]interface{})
+
+	return &dlm.ArchiveRetainRule{
+		RetentionArchiveTier: expandRetentionArchiveTier(m["retention_archive_tier"].([]interface{})),
+	}
+}
+
+func flattenArchiveRetainRule(rule *dlm.ArchiveRetainRule) []map[string]interface{} {
+	if rule == nil {
+		return nil
+	}
+
+	result := make(map[string]interface{})
+	result["retention_archive_tier"] = flattenRetentionArchiveTier(rule.RetentionArchiveTier)
+
+	return []map[string]interface{}{result}
+}
+
+func expandRetentionArchiveTier(cfg []interface{}) *dlm.RetentionArchiveTier {
+	if len(cfg) == 0 || cfg[0] == nil {
+		return nil
+	}
+	m := cfg[0].(map[string]interface{})
+	tier := &dlm.RetentionArchiveTier{}
+
+	if v, ok := m["count"].(int); ok && v > 0 {
+		tier.Count = aws.Int64(int64(v))
+	}
+
+	if v, ok := m["interval"].(int); ok && v > 0 {
+		tier.Interval = aws.Int64(int64(v))
+	}
+
+	if v, ok := m["interval_unit"].(string); ok && v != "" {
+		tier.IntervalUnit = aws.String(v)
+	}
+
+	return tier
+}
+
+func flattenRetentionArchiveTier(tier *dlm.RetentionArchiveTier) []map[string]interface{} {
+	if tier == nil {
+		return nil
+	}
+
+	result := make(map[string]interface{})
+	result["count"] = aws.Int64Value(tier.Count)
+	result["interval"] = aws.Int64Value(tier.Interval)
+	result["interval_unit"] = aws.StringValue(tier.IntervalUnit)
+
+	return []map[string]interface{}{result}
+}
+
+func expandCrossRegionCopyTargets(l []interface{}) []*dlm.CrossRegionCopyTarget {
+	var targets []*dlm.CrossRegionCopyTarget
+
+	for _, tfMapRaw := range l {
+		m, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		targets = append(targets, &dlm.CrossRegionCopyTarget{
+			TargetRegion: aws.String(m["target_region"].(string)),
+		})
+	}
+
+	return targets
+}
+
+func flattenCrossRegionCopyTargets(targets []*dlm.CrossRegionCopyTarget) []interface{} {
+	var result []interface{}
+
+	for _, target := range targets {
+		if target == nil {
+			continue
+		}
+
+		result = append(result, map[string]interface{}{
+			"target_region": aws.StringValue(target.TargetRegion),
+		})
+	}
+
+	return result
+}
+
+func expandExclusions(cfg []interface{}) *dlm.Exclusions {
+	if len(cfg) == 0 || cfg[0] == nil {
+		return nil
+	}
+	m := cfg[0].(map[string]interface{})
+	exclusions := &dlm.Exclusions{}
+
+	if v, ok := m["exclude_boot_volumes"].(bool); ok {
+		exclusions.ExcludeBootVolumes = aws.Bool(v)
+	}
+
+	if v, ok := m["exclude_tags"].(map[string]interface{}); ok && len(v) > 0 {
+		exclusions.ExcludeTags = expandTags(v)
+	}
+
+	if v, ok := m["exclude_volume_types"].([]interface{}); ok && len(v) > 0 {
+		exclusions.ExcludeVolumeTypes = flex.ExpandStringList(v)
+	}
+
+	return exclusions
+}
+
+func flattenExclusions(exclusions *dlm.Exclusions) []map[string]interface{} {
+	result := make(map[string]interface{})
+	result["exclude_boot_volumes"] = aws.BoolValue(exclusions.ExcludeBootVolumes)
+	result["exclude_tags"] = flattenTags(exclusions.ExcludeTags)
+	result["exclude_volume_types"] = flex.FlattenStringList(exclusions.ExcludeVolumeTypes)
+
+	return []map[string]interface{}{result}
+}
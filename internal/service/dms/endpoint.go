@@ -173,6 +173,12 @@ func ResourceEndpoint() *schema.Resource {
 							Optional: true,
 							Default:  false,
 						},
+						"sasl_mechanism": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(dms.KafkaSaslMechanism_Values(), false),
+						},
 						"sasl_password": {
 							Type:      schema.TypeString,
 							Optional:  true,
@@ -207,6 +213,12 @@ func ResourceEndpoint() *schema.Resource {
 							Optional:  true,
 							Sensitive: true,
 						},
+						"ssl_endpoint_identification_algorithm": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(dms.KafkaSslEndpointIdentificationAlgorithm_Values(), false),
+						},
 						"topic": {
 							Type:     schema.TypeString,
 							Optional: true,
@@ -333,6 +345,138 @@ func ResourceEndpoint() *schema.Resource {
 				Optional:      true,
 				ConflictsWith: []string{"secrets_manager_access_role_arn", "secrets_manager_arn"},
 			},
+			"postgres_settings": {
+				Type:             schema.TypeList,
+				Optional:         true,
+				MaxItems:         1,
+				DiffSuppressFunc: verify.SuppressMissingOptionalConfigurationBlock,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"after_connect_script": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"babelfish_database_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"capture_ddls": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"database_mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(dms.DatabaseMode_Values(), false),
+						},
+						"ddl_artifacts_schema": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"execute_timeout": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"fail_tasks_on_lob_truncation": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"heartbeat_enable": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"heartbeat_frequency": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"heartbeat_schema": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"map_boolean_as_boolean": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"map_jsonb_as_clob": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"map_long_varchar_as": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(dms.LongVarcharMappingType_Values(), false),
+						},
+						"max_file_size": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"plugin_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(dms.PluginNameValue_Values(), false),
+						},
+						"slot_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"redis_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"auth_password": {
+							Type:      schema.TypeString,
+							Optional:  true,
+							Sensitive: true,
+						},
+						"auth_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(dms.RedisAuthTypeValue_Values(), false),
+						},
+						"auth_user_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"port": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"server_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"ssl_ca_certificate_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"ssl_security_protocol": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      dms.SslSecurityProtocolValueSslEncryption,
+							ValidateFunc: validation.StringInSlice(dms.SslSecurityProtocolValue_Values(), false),
+						},
+					},
+				},
+			},
 			"redshift_settings": {
 				Type:             schema.TypeList,
 				Optional:         true,
@@ -697,6 +841,10 @@ func resourceEndpointCreate(d *schema.ResourceData, meta interface{}) error {
 			// Set connection info in top-level namespace as well
 			expandTopLevelConnectionInfo(d, input)
 		}
+
+		if v, ok := d.GetOk("postgres_settings"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			expandPostgreSQLSettings(v.([]interface{})[0].(map[string]interface{}), input.PostgreSQLSettings)
+		}
 	case engineNameDynamoDB:
 		input.DynamoDbSettings = &dms.DynamoDbSettings{
 			ServiceAccessRoleArn: aws.String(d.Get("service_access_role").(string)),
@@ -757,6 +905,8 @@ func resourceEndpointCreate(d *schema.ResourceData, meta interface{}) error {
 			// Set connection info in top-level namespace as well
 			expandTopLevelConnectionInfo(d, input)
 		}
+	case engineNameRedis:
+		input.RedisSettings = expandRedisSettings(d.Get("redis_settings").([]interface{})[0].(map[string]interface{}))
 	case engineNameRedshift:
 		var settings = &dms.RedshiftSettings{
 			DatabaseName: aws.String(d.Get("database_name").(string)),
@@ -944,8 +1094,8 @@ func resourceEndpointUpdate(d *schema.ResourceData, meta interface{}) error {
 			}
 		case engineNameAuroraPostgresql, engineNamePostgres:
 			if d.HasChanges(
-				"username", "password", "server_name", "port", "database_name", "secrets_manager_access_role_arn",
-				"secrets_manager_arn") {
+				"username", "password", "server_name", "port", "database_name", "postgres_settings",
+				"secrets_manager_access_role_arn", "secrets_manager_arn") {
 				if _, ok := d.GetOk("secrets_manager_arn"); ok {
 					input.PostgreSQLSettings = &dms.PostgreSQLSettings{
 						DatabaseName:                aws.String(d.Get("database_name").(string)),
@@ -965,6 +1115,10 @@ func resourceEndpointUpdate(d *schema.ResourceData, meta interface{}) error {
 					// Update connection info in top-level namespace as well
 					expandTopLevelConnectionInfoModify(d, input)
 				}
+
+				if v, ok := d.GetOk("postgres_settings"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+					expandPostgreSQLSettings(v.([]interface{})[0].(map[string]interface{}), input.PostgreSQLSettings)
+				}
 			}
 		case engineNameDynamoDB:
 			if d.HasChange("service_access_role") {
@@ -1062,6 +1216,11 @@ func resourceEndpointUpdate(d *schema.ResourceData, meta interface{}) error {
 					expandTopLevelConnectionInfoModify(d, input)
 				}
 			}
+		case engineNameRedis:
+			if d.HasChanges("redis_settings") {
+				input.RedisSettings = expandRedisSettings(d.Get("redis_settings").([]interface{})[0].(map[string]interface{}))
+				input.EngineName = aws.String(engineName)
+			}
 		case engineNameRedshift:
 			if d.HasChanges(
 				"username", "password", "server_name", "port", "database_name",
@@ -1223,6 +1382,10 @@ func resourceEndpointCustomizeDiff(_ context.Context, diff *schema.ResourceDiff,
 		if v, ok := diff.GetOk("mongodb_settings"); !ok || len(v.([]interface{})) == 0 || v.([]interface{})[0] == nil {
 			return fmt.Errorf("mongodb_settings must be set when engine_name = %q", engineName)
 		}
+	case engineNameRedis:
+		if v, ok := diff.GetOk("redis_settings"); !ok || len(v.([]interface{})) == 0 || v.([]interface{})[0] == nil {
+			return fmt.Errorf("redis_settings must be set when engine_name = %q", engineName)
+		}
 	case engineNameS3:
 		if v, ok := diff.GetOk("s3_settings"); !ok || len(v.([]interface{})) == 0 || v.([]interface{})[0] == nil {
 			return fmt.Errorf("s3_settings must be set when engine_name = %q", engineName)
@@ -1266,6 +1429,9 @@ func resourceEndpointSetState(d *schema.ResourceData, endpoint *dms.Endpoint) er
 		} else {
 			flattenTopLevelConnectionInfo(d, endpoint)
 		}
+		if err := d.Set("postgres_settings", flattenPostgreSQLSettings(endpoint.PostgreSQLSettings)); err != nil {
+			return fmt.Errorf("setting postgres_settings: %w", err)
+		}
 	case engineNameDynamoDB:
 		if endpoint.DynamoDbSettings != nil {
 			d.Set("service_access_role", endpoint.DynamoDbSettings.ServiceAccessRoleArn)
@@ -1278,9 +1444,10 @@ func resourceEndpointSetState(d *schema.ResourceData, endpoint *dms.Endpoint) er
 		}
 	case engineNameKafka:
 		if endpoint.KafkaSettings != nil {
-			// SASL password isn't returned in API. Propagate state value.
+			// SASL password and SSL client key password aren't returned in API. Propagate state values.
 			tfMap := flattenKafkaSettings(endpoint.KafkaSettings)
 			tfMap["sasl_password"] = d.Get("kafka_settings.0.sasl_password").(string)
+			tfMap["ssl_client_key_password"] = d.Get("kafka_settings.0.ssl_client_key_password").(string)
 
 			if err := d.Set("kafka_settings", []interface{}{tfMap}); err != nil {
 				return fmt.Errorf("setting kafka_settings: %w", err)
@@ -1317,6 +1484,18 @@ func resourceEndpointSetState(d *schema.ResourceData, endpoint *dms.Endpoint) er
 		} else {
 			flattenTopLevelConnectionInfo(d, endpoint)
 		}
+	case engineNameRedis:
+		if endpoint.RedisSettings != nil {
+			// Auth password isn't returned in API. Propagate state value.
+			tfMap := flattenRedisSettings(endpoint.RedisSettings)
+			tfMap["auth_password"] = d.Get("redis_settings.0.auth_password").(string)
+
+			if err := d.Set("redis_settings", []interface{}{tfMap}); err != nil {
+				return fmt.Errorf("setting redis_settings: %w", err)
+			}
+		} else {
+			d.Set("redis_settings", nil)
+		}
 	case engineNameRedshift:
 		if endpoint.RedshiftSettings != nil {
 			d.Set("username", endpoint.RedshiftSettings.Username)
@@ -1354,6 +1533,15 @@ func resourceEndpointSetState(d *schema.ResourceData, endpoint *dms.Endpoint) er
 		d.Set("username", endpoint.Username)
 	}
 
+	// When credentials are stored in Secrets Manager the connection details are resolved from the secret,
+	// which may be rotated. They can't be configured alongside the secret, so don't track them.
+	if d.Get("secrets_manager_arn").(string) != "" {
+		d.Set("password", nil)
+		d.Set("port", nil)
+		d.Set("server_name", nil)
+		d.Set("username", nil)
+	}
+
 	d.Set("kms_key_arn", endpoint.KmsKeyId)
 	d.Set("ssl_mode", endpoint.SslMode)
 
@@ -1422,6 +1610,10 @@ func expandKafkaSettings(tfMap map[string]interface{}) *dms.KafkaSettings {
 		apiObject.PartitionIncludeSchemaTable = aws.Bool(v)
 	}
 
+	if v, ok := tfMap["sasl_mechanism"].(string); ok && v != "" {
+		apiObject.SaslMechanism = aws.String(v)
+	}
+
 	if v, ok := tfMap["sasl_password"].(string); ok && v != "" {
 		apiObject.SaslPassword = aws.String(v)
 	}
@@ -1450,6 +1642,10 @@ func expandKafkaSettings(tfMap map[string]interface{}) *dms.KafkaSettings {
 		apiObject.SslClientKeyPassword = aws.String(v)
 	}
 
+	if v, ok := tfMap["ssl_endpoint_identification_algorithm"].(string); ok && v != "" {
+		apiObject.SslEndpointIdentificationAlgorithm = aws.String(v)
+	}
+
 	if v, ok := tfMap["topic"].(string); ok && v != "" {
 		apiObject.Topic = aws.String(v)
 	}
@@ -1504,6 +1700,10 @@ func flattenKafkaSettings(apiObject *dms.KafkaSettings) map[string]interface{} {
 		tfMap["partition_include_schema_table"] = aws.BoolValue(v)
 	}
 
+	if v := apiObject.SaslMechanism; v != nil {
+		tfMap["sasl_mechanism"] = aws.StringValue(v)
+	}
+
 	if v := apiObject.SaslPassword; v != nil {
 		tfMap["sasl_password"] = aws.StringValue(v)
 	}
@@ -1532,6 +1732,10 @@ func flattenKafkaSettings(apiObject *dms.KafkaSettings) map[string]interface{} {
 		tfMap["ssl_client_key_password"] = aws.StringValue(v)
 	}
 
+	if v := apiObject.SslEndpointIdentificationAlgorithm; v != nil {
+		tfMap["ssl_endpoint_identification_algorithm"] = aws.StringValue(v)
+	}
+
 	if v := apiObject.Topic; v != nil {
 		tfMap["topic"] = aws.StringValue(v)
 	}
@@ -1648,6 +1852,181 @@ func flattenMongoDBSettings(settings *dms.MongoDbSettings) []map[string]interfac
 	return []map[string]interface{}{m}
 }
 
+// expandPostgreSQLSettings sets the engine-specific PostgreSQL settings on an existing API object
+// that already holds the connection information.
+func expandPostgreSQLSettings(tfMap map[string]interface{}, apiObject *dms.PostgreSQLSettings) {
+	if tfMap == nil || apiObject == nil {
+		return
+	}
+
+	if v, ok := tfMap["after_connect_script"].(string); ok && v != "" {
+		apiObject.AfterConnectScript = aws.String(v)
+	}
+
+	if v, ok := tfMap["babelfish_database_name"].(string); ok && v != "" {
+		apiObject.BabelfishDatabaseName = aws.String(v)
+	}
+
+	if v, ok := tfMap["capture_ddls"].(bool); ok {
+		apiObject.CaptureDdls = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["database_mode"].(string); ok && v != "" {
+		apiObject.DatabaseMode = aws.String(v)
+	}
+
+	if v, ok := tfMap["ddl_artifacts_schema"].(string); ok && v != "" {
+		apiObject.DdlArtifactsSchema = aws.String(v)
+	}
+
+	if v, ok := tfMap["execute_timeout"].(int); ok && v != 0 {
+		apiObject.ExecuteTimeout = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["fail_tasks_on_lob_truncation"].(bool); ok {
+		apiObject.FailTasksOnLobTruncation = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["heartbeat_enable"].(bool); ok {
+		apiObject.HeartbeatEnable = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["heartbeat_frequency"].(int); ok && v != 0 {
+		apiObject.HeartbeatFrequency = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["heartbeat_schema"].(string); ok && v != "" {
+		apiObject.HeartbeatSchema = aws.String(v)
+	}
+
+	if v, ok := tfMap["map_boolean_as_boolean"].(bool); ok {
+		apiObject.MapBooleanAsBoolean = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["map_jsonb_as_clob"].(bool); ok {
+		apiObject.MapJsonbAsClob = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["map_long_varchar_as"].(string); ok && v != "" {
+		apiObject.MapLongVarcharAs = aws.String(v)
+	}
+
+	if v, ok := tfMap["max_file_size"].(int); ok && v != 0 {
+		apiObject.MaxFileSize = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["plugin_name"].(string); ok && v != "" {
+		apiObject.PluginName = aws.String(v)
+	}
+
+	if v, ok := tfMap["slot_name"].(string); ok && v != "" {
+		apiObject.SlotName = aws.String(v)
+	}
+}
+
+func flattenPostgreSQLSettings(apiObject *dms.PostgreSQLSettings) []map[string]interface{} {
+	if apiObject == nil {
+		return []map[string]interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"after_connect_script":         aws.StringValue(apiObject.AfterConnectScript),
+		"babelfish_database_name":      aws.StringValue(apiObject.BabelfishDatabaseName),
+		"capture_ddls":                 aws.BoolValue(apiObject.CaptureDdls),
+		"database_mode":                aws.StringValue(apiObject.DatabaseMode),
+		"ddl_artifacts_schema":         aws.StringValue(apiObject.DdlArtifactsSchema),
+		"execute_timeout":              aws.Int64Value(apiObject.ExecuteTimeout),
+		"fail_tasks_on_lob_truncation": aws.BoolValue(apiObject.FailTasksOnLobTruncation),
+		"heartbeat_enable":             aws.BoolValue(apiObject.HeartbeatEnable),
+		"heartbeat_frequency":          aws.Int64Value(apiObject.HeartbeatFrequency),
+		"heartbeat_schema":             aws.StringValue(apiObject.HeartbeatSchema),
+		"map_boolean_as_boolean":       aws.BoolValue(apiObject.MapBooleanAsBoolean),
+		"map_jsonb_as_clob":            aws.BoolValue(apiObject.MapJsonbAsClob),
+		"map_long_varchar_as":          aws.StringValue(apiObject.MapLongVarcharAs),
+		"max_file_size":                aws.Int64Value(apiObject.MaxFileSize),
+		"plugin_name":                  aws.StringValue(apiObject.PluginName),
+		"slot_name":                    aws.StringValue(apiObject.SlotName),
+	}
+
+	return []map[string]interface{}{tfMap}
+}
+
+func expandRedisSettings(tfMap map[string]interface{}) *dms.RedisSettings {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &dms.RedisSettings{}
+
+	if v, ok := tfMap["auth_password"].(string); ok && v != "" {
+		apiObject.AuthPassword = aws.String(v)
+	}
+
+	if v, ok := tfMap["auth_type"].(string); ok && v != "" {
+		apiObject.AuthType = aws.String(v)
+	}
+
+	if v, ok := tfMap["auth_user_name"].(string); ok && v != "" {
+		apiObject.AuthUserName = aws.String(v)
+	}
+
+	if v, ok := tfMap["port"].(int); ok {
+		apiObject.Port = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["server_name"].(string); ok && v != "" {
+		apiObject.ServerName = aws.String(v)
+	}
+
+	if v, ok := tfMap["ssl_ca_certificate_arn"].(string); ok && v != "" {
+		apiObject.SslCaCertificateArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["ssl_security_protocol"].(string); ok && v != "" {
+		apiObject.SslSecurityProtocol = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenRedisSettings(apiObject *dms.RedisSettings) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AuthPassword; v != nil {
+		tfMap["auth_password"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.AuthType; v != nil {
+		tfMap["auth_type"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.AuthUserName; v != nil {
+		tfMap["auth_user_name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Port; v != nil {
+		tfMap["port"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.ServerName; v != nil {
+		tfMap["server_name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.SslCaCertificateArn; v != nil {
+		tfMap["ssl_ca_certificate_arn"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.SslSecurityProtocol; v != nil {
+		tfMap["ssl_security_protocol"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
 func flattenRedshiftSettings(settings *dms.RedshiftSettings) []map[string]interface{} {
 	if settings == nil {
 		return []map[string]interface{}{}
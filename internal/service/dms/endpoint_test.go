@@ -550,6 +550,7 @@ func TestAccDMSEndpoint_kafka(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "kafka_settings.0.message_max_bytes", "500000"),
 					resource.TestCheckResourceAttr(resourceName, "kafka_settings.0.no_hex_prefix", "true"),
 					resource.TestCheckResourceAttr(resourceName, "kafka_settings.0.partition_include_schema_table", "true"),
+					resource.TestCheckResourceAttr(resourceName, "kafka_settings.0.sasl_mechanism", "scram-sha-512"),
 					resource.TestCheckResourceAttr(resourceName, "kafka_settings.0.sasl_password", "tftest-new"),
 					resource.TestCheckResourceAttr(resourceName, "kafka_settings.0.sasl_username", "tftest-new"),
 					resource.TestCheckResourceAttr(resourceName, "kafka_settings.0.security_protocol", "sasl-ssl"),
@@ -557,6 +558,7 @@ func TestAccDMSEndpoint_kafka(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "kafka_settings.0.ssl_client_certificate_arn", ""),
 					resource.TestCheckResourceAttr(resourceName, "kafka_settings.0.ssl_client_key_arn", ""),
 					resource.TestCheckResourceAttr(resourceName, "kafka_settings.0.ssl_client_key_password", ""),
+					resource.TestCheckResourceAttr(resourceName, "kafka_settings.0.ssl_endpoint_identification_algorithm", "https"),
 					resource.TestCheckResourceAttr(resourceName, "kafka_settings.0.topic", "topic1"),
 				),
 			},
@@ -1042,6 +1044,9 @@ func TestAccDMSEndpoint_PostgreSQL_secretID(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckEndpointExists(resourceName),
 					resource.TestCheckResourceAttrSet(resourceName, "endpoint_arn"),
+					resource.TestCheckResourceAttr(resourceName, "port", "0"),
+					resource.TestCheckResourceAttr(resourceName, "server_name", ""),
+					resource.TestCheckResourceAttr(resourceName, "username", ""),
 				),
 			},
 			{
@@ -1093,6 +1098,108 @@ func TestAccDMSEndpoint_PostgreSQL_update(t *testing.T) {
 	})
 }
 
+func TestAccDMSEndpoint_PostgreSQL_settings(t *testing.T) {
+	resourceName := "aws_dms_endpoint.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, dms.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEndpointConfig_postgreSQLSettings(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEndpointExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "postgres_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "postgres_settings.0.after_connect_script", "SET search_path TO pg_catalog,public;"),
+					resource.TestCheckResourceAttr(resourceName, "postgres_settings.0.capture_ddls", "true"),
+					resource.TestCheckResourceAttr(resourceName, "postgres_settings.0.heartbeat_enable", "true"),
+					resource.TestCheckResourceAttr(resourceName, "postgres_settings.0.heartbeat_frequency", "5"),
+					resource.TestCheckResourceAttr(resourceName, "postgres_settings.0.map_boolean_as_boolean", "true"),
+					resource.TestCheckResourceAttr(resourceName, "postgres_settings.0.plugin_name", "pglogical"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+		},
+	})
+}
+
+func TestAccDMSEndpoint_AuroraPostgreSQL_babelfish(t *testing.T) {
+	resourceName := "aws_dms_endpoint.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, dms.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEndpointConfig_auroraPostgreSQLBabelfish(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEndpointExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "postgres_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "postgres_settings.0.babelfish_database_name", "babelfish_db"),
+					resource.TestCheckResourceAttr(resourceName, "postgres_settings.0.database_mode", "babelfish"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+		},
+	})
+}
+
+func TestAccDMSEndpoint_redis(t *testing.T) {
+	resourceName := "aws_dms_endpoint.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, dms.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEndpointConfig_redis(rName, "none"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEndpointExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "redis_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "redis_settings.0.auth_type", "none"),
+					resource.TestCheckResourceAttr(resourceName, "redis_settings.0.port", "6379"),
+					resource.TestCheckResourceAttr(resourceName, "redis_settings.0.server_name", "redis.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "redis_settings.0.ssl_security_protocol", "plaintext"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"redis_settings.0.auth_password"},
+			},
+			{
+				Config: testAccEndpointConfig_redis(rName, "auth-token"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEndpointExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "redis_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "redis_settings.0.auth_password", "avoid-plaintext-passwords"),
+					resource.TestCheckResourceAttr(resourceName, "redis_settings.0.auth_type", "auth-token"),
+				),
+			},
+		},
+	})
+}
+
 // https://github.com/hashicorp/terraform-provider-aws/issues/23143
 func TestAccDMSEndpoint_PostgreSQL_kmsKey(t *testing.T) {
 	resourceName := "aws_dms_endpoint.test"
@@ -2234,9 +2341,12 @@ resource "aws_dms_endpoint" "test" {
     message_max_bytes              = 500000
     include_null_and_empty         = true
     security_protocol              = "sasl-ssl"
+    sasl_mechanism                 = "scram-sha-512"
     sasl_username                  = "tftest-new"
     sasl_password                  = "tftest-new"
     no_hex_prefix                  = true
+
+    ssl_endpoint_identification_algorithm = "https"
   }
 }
 `, rName, domainName)
@@ -2704,6 +2814,71 @@ resource "aws_dms_endpoint" "test" {
 `, rName)
 }
 
+func testAccEndpointConfig_postgreSQLSettings(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_dms_endpoint" "test" {
+  endpoint_id   = %[1]q
+  endpoint_type = "source"
+  engine_name   = "postgres"
+  server_name   = "tftest"
+  port          = 5432
+  username      = "tftest"
+  password      = "tftest"
+  database_name = "tftest"
+  ssl_mode      = "none"
+
+  postgres_settings {
+    after_connect_script   = "SET search_path TO pg_catalog,public;"
+    capture_ddls           = true
+    heartbeat_enable       = true
+    heartbeat_frequency    = 5
+    map_boolean_as_boolean = true
+    plugin_name            = "pglogical"
+  }
+}
+`, rName)
+}
+
+func testAccEndpointConfig_auroraPostgreSQLBabelfish(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_dms_endpoint" "test" {
+  endpoint_id   = %[1]q
+  endpoint_type = "target"
+  engine_name   = "aurora-postgresql"
+  server_name   = "tftest"
+  port          = 5432
+  username      = "tftest"
+  password      = "tftest"
+  database_name = "babelfish_db"
+  ssl_mode      = "none"
+
+  postgres_settings {
+    babelfish_database_name = "babelfish_db"
+    database_mode           = "babelfish"
+  }
+}
+`, rName)
+}
+
+func testAccEndpointConfig_redis(rName, authType string) string {
+	return fmt.Sprintf(`
+resource "aws_dms_endpoint" "test" {
+  endpoint_id   = %[1]q
+  endpoint_type = "target"
+  engine_name   = "redis"
+  ssl_mode      = "none"
+
+  redis_settings {
+    auth_password         = %[2]q == "none" ? null : "avoid-plaintext-passwords"
+    auth_type             = %[2]q
+    port                  = 6379
+    server_name           = "redis.example.com"
+    ssl_security_protocol = "plaintext"
+  }
+}
+`, rName, authType)
+}
+
 func testAccEndpointConfig_sqlServer(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_dms_endpoint" "test" {
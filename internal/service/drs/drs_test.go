@@ -0,0 +1,24 @@
+package drs_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/drs"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func testAccPreCheck(t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).DRSConn
+
+	_, err := conn.DescribeReplicationConfigurationTemplates(&drs.DescribeReplicationConfigurationTemplatesInput{})
+
+	if acctest.PreCheckSkipError(err) || tfawserr.ErrCodeEquals(err, drs.ErrCodeUninitializedAccountException) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
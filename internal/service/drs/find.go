@@ -0,0 +1,100 @@
+package drs
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/drs"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindLaunchConfigurationTemplateByID(ctx context.Context, conn *drs.Drs, id string) (*drs.LaunchConfigurationTemplate, error) {
+	input := &drs.DescribeLaunchConfigurationTemplatesInput{
+		LaunchConfigurationTemplateIDs: aws.StringSlice([]string{id}),
+	}
+
+	output, err := conn.DescribeLaunchConfigurationTemplatesWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, drs.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Items) == 0 || output.Items[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.Items); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.Items[0], nil
+}
+
+func FindReplicationConfigurationTemplateByID(ctx context.Context, conn *drs.Drs, id string) (*drs.ReplicationConfigurationTemplate, error) {
+	input := &drs.DescribeReplicationConfigurationTemplatesInput{
+		ReplicationConfigurationTemplateIDs: aws.StringSlice([]string{id}),
+	}
+
+	output, err := conn.DescribeReplicationConfigurationTemplatesWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, drs.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Items) == 0 || output.Items[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.Items); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.Items[0], nil
+}
+
+func FindSourceNetworkByID(ctx context.Context, conn *drs.Drs, id string) (*drs.SourceNetwork, error) {
+	input := &drs.DescribeSourceNetworksInput{
+		Filters: &drs.DescribeSourceNetworksRequestFilters{
+			SourceNetworkIDs: aws.StringSlice([]string{id}),
+		},
+	}
+
+	output, err := conn.DescribeSourceNetworksWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, drs.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Items) == 0 || output.Items[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.Items); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.Items[0], nil
+}
@@ -0,0 +1,297 @@
+package drs
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/drs"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceLaunchConfigurationTemplate() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceLaunchConfigurationTemplateCreate,
+		ReadWithoutTimeout:   resourceLaunchConfigurationTemplateRead,
+		UpdateWithoutTimeout: resourceLaunchConfigurationTemplateUpdate,
+		DeleteWithoutTimeout: resourceLaunchConfigurationTemplateDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"copy_private_ip": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"copy_tags": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"export_bucket_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"launch_disposition": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(drs.LaunchDisposition_Values(), false),
+			},
+			"launch_into_source_instance": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"licensing": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"os_byol": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"post_launch_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"target_instance_type_right_sizing_method": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(drs.TargetInstanceTypeRightSizingMethod_Values(), false),
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceLaunchConfigurationTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DRSConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &drs.CreateLaunchConfigurationTemplateInput{}
+
+	if v, ok := d.GetOkExists("copy_private_ip"); ok {
+		input.CopyPrivateIp = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOkExists("copy_tags"); ok {
+		input.CopyTags = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("export_bucket_arn"); ok {
+		input.ExportBucketArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("launch_disposition"); ok {
+		input.LaunchDisposition = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOkExists("launch_into_source_instance"); ok {
+		input.LaunchIntoSourceInstance = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("licensing"); ok && len(v.([]interface{})) > 0 {
+		input.Licensing = expandLicensing(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOkExists("post_launch_enabled"); ok {
+		input.PostLaunchEnabled = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("target_instance_type_right_sizing_method"); ok {
+		input.TargetInstanceTypeRightSizingMethod = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateLaunchConfigurationTemplateWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating DRS Launch Configuration Template: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.LaunchConfigurationTemplate.LaunchConfigurationTemplateID))
+
+	return resourceLaunchConfigurationTemplateRead(ctx, d, meta)
+}
+
+func resourceLaunchConfigurationTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DRSConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	template, err := FindLaunchConfigurationTemplateByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] DRS Launch Configuration Template (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading DRS Launch Configuration Template (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", template.Arn)
+	d.Set("copy_private_ip", template.CopyPrivateIp)
+	d.Set("copy_tags", template.CopyTags)
+	d.Set("export_bucket_arn", template.ExportBucketArn)
+	d.Set("launch_disposition", template.LaunchDisposition)
+	d.Set("launch_into_source_instance", template.LaunchIntoSourceInstance)
+	if template.Licensing != nil {
+		if err := d.Set("licensing", []interface{}{flattenLicensing(template.Licensing)}); err != nil {
+			return diag.Errorf("setting licensing: %s", err)
+		}
+	} else {
+		d.Set("licensing", nil)
+	}
+	d.Set("post_launch_enabled", template.PostLaunchEnabled)
+	d.Set("target_instance_type_right_sizing_method", template.TargetInstanceTypeRightSizingMethod)
+
+	tags := KeyValueTags(template.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceLaunchConfigurationTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DRSConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &drs.UpdateLaunchConfigurationTemplateInput{
+			LaunchConfigurationTemplateID: aws.String(d.Id()),
+		}
+
+		if d.HasChange("copy_private_ip") {
+			input.CopyPrivateIp = aws.Bool(d.Get("copy_private_ip").(bool))
+		}
+
+		if d.HasChange("copy_tags") {
+			input.CopyTags = aws.Bool(d.Get("copy_tags").(bool))
+		}
+
+		if d.HasChange("export_bucket_arn") {
+			input.ExportBucketArn = aws.String(d.Get("export_bucket_arn").(string))
+		}
+
+		if d.HasChange("launch_disposition") {
+			input.LaunchDisposition = aws.String(d.Get("launch_disposition").(string))
+		}
+
+		if d.HasChange("launch_into_source_instance") {
+			input.LaunchIntoSourceInstance = aws.Bool(d.Get("launch_into_source_instance").(bool))
+		}
+
+		if d.HasChange("licensing") {
+			if v, ok := d.GetOk("licensing"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.Licensing = expandLicensing(v.([]interface{})[0].(map[string]interface{}))
+			} else {
+				input.Licensing = &drs.Licensing{OsByol: aws.Bool(false)}
+			}
+		}
+
+		if d.HasChange("post_launch_enabled") {
+			input.PostLaunchEnabled = aws.Bool(d.Get("post_launch_enabled").(bool))
+		}
+
+		if d.HasChange("target_instance_type_right_sizing_method") {
+			input.TargetInstanceTypeRightSizingMethod = aws.String(d.Get("target_instance_type_right_sizing_method").(string))
+		}
+
+		_, err := conn.UpdateLaunchConfigurationTemplateWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating DRS Launch Configuration Template (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+		if err := UpdateTagsWithContext(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating DRS Launch Configuration Template (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceLaunchConfigurationTemplateRead(ctx, d, meta)
+}
+
+func resourceLaunchConfigurationTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DRSConn
+
+	log.Printf("[DEBUG] Deleting DRS Launch Configuration Template: %s", d.Id())
+	_, err := conn.DeleteLaunchConfigurationTemplateWithContext(ctx, &drs.DeleteLaunchConfigurationTemplateInput{
+		LaunchConfigurationTemplateID: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, drs.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting DRS Launch Configuration Template (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandLicensing(tfMap map[string]interface{}) *drs.Licensing {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &drs.Licensing{}
+
+	if v, ok := tfMap["os_byol"].(bool); ok {
+		apiObject.OsByol = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func flattenLicensing(apiObject *drs.Licensing) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"os_byol": aws.BoolValue(apiObject.OsByol),
+	}
+}
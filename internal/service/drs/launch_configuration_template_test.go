@@ -0,0 +1,247 @@
+package drs_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/drs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdrs "github.com/hashicorp/terraform-provider-aws/internal/service/drs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccDRSLaunchConfigurationTemplate_basic(t *testing.T) {
+	var v drs.LaunchConfigurationTemplate
+	resourceName := "aws_drs_launch_configuration_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, drs.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLaunchConfigurationTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLaunchConfigurationTemplateConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLaunchConfigurationTemplateExists(resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "drs", regexp.MustCompile(`launch-configuration-template/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "copy_private_ip"),
+					resource.TestCheckResourceAttrSet(resourceName, "copy_tags"),
+					resource.TestCheckResourceAttrSet(resourceName, "launch_disposition"),
+					resource.TestCheckResourceAttrSet(resourceName, "target_instance_type_right_sizing_method"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDRSLaunchConfigurationTemplate_disappears(t *testing.T) {
+	var v drs.LaunchConfigurationTemplate
+	resourceName := "aws_drs_launch_configuration_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, drs.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLaunchConfigurationTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLaunchConfigurationTemplateConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLaunchConfigurationTemplateExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfdrs.ResourceLaunchConfigurationTemplate(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccDRSLaunchConfigurationTemplate_update(t *testing.T) {
+	var v drs.LaunchConfigurationTemplate
+	resourceName := "aws_drs_launch_configuration_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, drs.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLaunchConfigurationTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLaunchConfigurationTemplateConfig_settings(true, "STARTED", "BASIC", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLaunchConfigurationTemplateExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "copy_private_ip", "true"),
+					resource.TestCheckResourceAttr(resourceName, "copy_tags", "true"),
+					resource.TestCheckResourceAttr(resourceName, "launch_disposition", "STARTED"),
+					resource.TestCheckResourceAttr(resourceName, "licensing.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "licensing.0.os_byol", "true"),
+					resource.TestCheckResourceAttr(resourceName, "target_instance_type_right_sizing_method", "BASIC"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccLaunchConfigurationTemplateConfig_settings(false, "STOPPED", "NONE", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLaunchConfigurationTemplateExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "copy_private_ip", "false"),
+					resource.TestCheckResourceAttr(resourceName, "copy_tags", "false"),
+					resource.TestCheckResourceAttr(resourceName, "launch_disposition", "STOPPED"),
+					resource.TestCheckResourceAttr(resourceName, "licensing.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "licensing.0.os_byol", "false"),
+					resource.TestCheckResourceAttr(resourceName, "target_instance_type_right_sizing_method", "NONE"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDRSLaunchConfigurationTemplate_tags(t *testing.T) {
+	var v drs.LaunchConfigurationTemplate
+	resourceName := "aws_drs_launch_configuration_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, drs.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLaunchConfigurationTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLaunchConfigurationTemplateConfig_tags1("key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLaunchConfigurationTemplateExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccLaunchConfigurationTemplateConfig_tags2("key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLaunchConfigurationTemplateExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccLaunchConfigurationTemplateConfig_tags1("key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLaunchConfigurationTemplateExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLaunchConfigurationTemplateExists(n string, v *drs.LaunchConfigurationTemplate) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No DRS Launch Configuration Template ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DRSConn
+
+		output, err := tfdrs.FindLaunchConfigurationTemplateByID(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckLaunchConfigurationTemplateDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).DRSConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_drs_launch_configuration_template" {
+			continue
+		}
+
+		_, err := tfdrs.FindLaunchConfigurationTemplateByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("DRS Launch Configuration Template %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccLaunchConfigurationTemplateConfig_basic() string {
+	return `
+resource "aws_drs_launch_configuration_template" "test" {}
+`
+}
+
+func testAccLaunchConfigurationTemplateConfig_settings(copy bool, launchDisposition, rightSizingMethod string, osByol bool) string {
+	return fmt.Sprintf(`
+resource "aws_drs_launch_configuration_template" "test" {
+  copy_private_ip                          = %[1]t
+  copy_tags                                = %[1]t
+  launch_disposition                       = %[2]q
+  target_instance_type_right_sizing_method = %[3]q
+
+  licensing {
+    os_byol = %[4]t
+  }
+}
+`, copy, launchDisposition, rightSizingMethod, osByol)
+}
+
+func testAccLaunchConfigurationTemplateConfig_tags1(tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_drs_launch_configuration_template" "test" {
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1)
+}
+
+func testAccLaunchConfigurationTemplateConfig_tags2(tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_drs_launch_configuration_template" "test" {
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,342 @@
+package drs
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/drs"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceReplicationConfigurationTemplate() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceReplicationConfigurationTemplateCreate,
+		ReadWithoutTimeout:   resourceReplicationConfigurationTemplateRead,
+		UpdateWithoutTimeout: resourceReplicationConfigurationTemplateUpdate,
+		DeleteWithoutTimeout: resourceReplicationConfigurationTemplateDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"associate_default_security_group": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"auto_replicate_new_disks": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"bandwidth_throttling": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"create_public_ip": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"data_plane_routing": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(drs.ReplicationConfigurationDataPlaneRouting_Values(), false),
+			},
+			"default_large_staging_disk_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(drs.ReplicationConfigurationDefaultLargeStagingDiskType_Values(), false),
+			},
+			"ebs_encryption": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(drs.ReplicationConfigurationEbsEncryption_Values(), false),
+			},
+			"ebs_encryption_key_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"pit_policy": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"interval": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"retention_duration": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"rule_id": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"units": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(drs.PITPolicyRuleUnits_Values(), false),
+						},
+					},
+				},
+			},
+			"replication_server_instance_type": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"replication_servers_security_groups_ids": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"staging_area_subnet_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"staging_area_tags": tftags.TagsSchema(),
+			"tags":              tftags.TagsSchema(),
+			"tags_all":          tftags.TagsSchemaComputed(),
+			"use_dedicated_replication_server": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceReplicationConfigurationTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DRSConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &drs.CreateReplicationConfigurationTemplateInput{
+		AssociateDefaultSecurityGroup:       aws.Bool(d.Get("associate_default_security_group").(bool)),
+		BandwidthThrottling:                 aws.Int64(int64(d.Get("bandwidth_throttling").(int))),
+		CreatePublicIP:                      aws.Bool(d.Get("create_public_ip").(bool)),
+		DataPlaneRouting:                    aws.String(d.Get("data_plane_routing").(string)),
+		DefaultLargeStagingDiskType:         aws.String(d.Get("default_large_staging_disk_type").(string)),
+		EbsEncryption:                       aws.String(d.Get("ebs_encryption").(string)),
+		PitPolicy:                           expandPITPolicyRules(d.Get("pit_policy").([]interface{})),
+		ReplicationServerInstanceType:       aws.String(d.Get("replication_server_instance_type").(string)),
+		ReplicationServersSecurityGroupsIDs: flex.ExpandStringList(d.Get("replication_servers_security_groups_ids").([]interface{})),
+		StagingAreaSubnetId:                 aws.String(d.Get("staging_area_subnet_id").(string)),
+		StagingAreaTags:                     Tags(tftags.New(d.Get("staging_area_tags").(map[string]interface{})).IgnoreAWS()),
+		UseDedicatedReplicationServer:       aws.Bool(d.Get("use_dedicated_replication_server").(bool)),
+	}
+
+	if v, ok := d.GetOkExists("auto_replicate_new_disks"); ok {
+		input.AutoReplicateNewDisks = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("ebs_encryption_key_arn"); ok {
+		input.EbsEncryptionKeyArn = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateReplicationConfigurationTemplateWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating DRS Replication Configuration Template: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.ReplicationConfigurationTemplateID))
+
+	return resourceReplicationConfigurationTemplateRead(ctx, d, meta)
+}
+
+func resourceReplicationConfigurationTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DRSConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	template, err := FindReplicationConfigurationTemplateByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] DRS Replication Configuration Template (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading DRS Replication Configuration Template (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", template.Arn)
+	d.Set("associate_default_security_group", template.AssociateDefaultSecurityGroup)
+	d.Set("auto_replicate_new_disks", template.AutoReplicateNewDisks)
+	d.Set("bandwidth_throttling", template.BandwidthThrottling)
+	d.Set("create_public_ip", template.CreatePublicIP)
+	d.Set("data_plane_routing", template.DataPlaneRouting)
+	d.Set("default_large_staging_disk_type", template.DefaultLargeStagingDiskType)
+	d.Set("ebs_encryption", template.EbsEncryption)
+	d.Set("ebs_encryption_key_arn", template.EbsEncryptionKeyArn)
+	if err := d.Set("pit_policy", flattenPITPolicyRules(template.PitPolicy)); err != nil {
+		return diag.Errorf("setting pit_policy: %s", err)
+	}
+	d.Set("replication_server_instance_type", template.ReplicationServerInstanceType)
+	d.Set("replication_servers_security_groups_ids", aws.StringValueSlice(template.ReplicationServersSecurityGroupsIDs))
+	d.Set("staging_area_subnet_id", template.StagingAreaSubnetId)
+	if err := d.Set("staging_area_tags", KeyValueTags(template.StagingAreaTags).IgnoreAWS().Map()); err != nil {
+		return diag.Errorf("setting staging_area_tags: %s", err)
+	}
+	d.Set("use_dedicated_replication_server", template.UseDedicatedReplicationServer)
+
+	tags := KeyValueTags(template.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceReplicationConfigurationTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DRSConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &drs.UpdateReplicationConfigurationTemplateInput{
+			AssociateDefaultSecurityGroup:       aws.Bool(d.Get("associate_default_security_group").(bool)),
+			AutoReplicateNewDisks:               aws.Bool(d.Get("auto_replicate_new_disks").(bool)),
+			BandwidthThrottling:                 aws.Int64(int64(d.Get("bandwidth_throttling").(int))),
+			CreatePublicIP:                      aws.Bool(d.Get("create_public_ip").(bool)),
+			DataPlaneRouting:                    aws.String(d.Get("data_plane_routing").(string)),
+			DefaultLargeStagingDiskType:         aws.String(d.Get("default_large_staging_disk_type").(string)),
+			EbsEncryption:                       aws.String(d.Get("ebs_encryption").(string)),
+			PitPolicy:                           expandPITPolicyRules(d.Get("pit_policy").([]interface{})),
+			ReplicationConfigurationTemplateID:  aws.String(d.Id()),
+			ReplicationServerInstanceType:       aws.String(d.Get("replication_server_instance_type").(string)),
+			ReplicationServersSecurityGroupsIDs: flex.ExpandStringList(d.Get("replication_servers_security_groups_ids").([]interface{})),
+			StagingAreaSubnetId:                 aws.String(d.Get("staging_area_subnet_id").(string)),
+			StagingAreaTags:                     Tags(tftags.New(d.Get("staging_area_tags").(map[string]interface{})).IgnoreAWS()),
+			UseDedicatedReplicationServer:       aws.Bool(d.Get("use_dedicated_replication_server").(bool)),
+		}
+
+		if v, ok := d.GetOk("ebs_encryption_key_arn"); ok {
+			input.EbsEncryptionKeyArn = aws.String(v.(string))
+		}
+
+		_, err := conn.UpdateReplicationConfigurationTemplateWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating DRS Replication Configuration Template (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+		if err := UpdateTagsWithContext(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating DRS Replication Configuration Template (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceReplicationConfigurationTemplateRead(ctx, d, meta)
+}
+
+func resourceReplicationConfigurationTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DRSConn
+
+	log.Printf("[DEBUG] Deleting DRS Replication Configuration Template: %s", d.Id())
+	_, err := conn.DeleteReplicationConfigurationTemplateWithContext(ctx, &drs.DeleteReplicationConfigurationTemplateInput{
+		ReplicationConfigurationTemplateID: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, drs.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting DRS Replication Configuration Template (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandPITPolicyRules(tfList []interface{}) []*drs.PITPolicyRule {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*drs.PITPolicyRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &drs.PITPolicyRule{
+			Enabled:           aws.Bool(tfMap["enabled"].(bool)),
+			Interval:          aws.Int64(int64(tfMap["interval"].(int))),
+			RetentionDuration: aws.Int64(int64(tfMap["retention_duration"].(int))),
+			Units:             aws.String(tfMap["units"].(string)),
+		}
+
+		if v, ok := tfMap["rule_id"].(int); ok && v != 0 {
+			apiObject.RuleID = aws.Int64(int64(v))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenPITPolicyRules(apiObjects []*drs.PITPolicyRule) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"enabled":            aws.BoolValue(apiObject.Enabled),
+			"interval":           aws.Int64Value(apiObject.Interval),
+			"retention_duration": aws.Int64Value(apiObject.RetentionDuration),
+			"rule_id":            aws.Int64Value(apiObject.RuleID),
+			"units":              aws.StringValue(apiObject.Units),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,267 @@
+package drs_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/drs"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdrs "github.com/hashicorp/terraform-provider-aws/internal/service/drs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccDRSReplicationConfigurationTemplate_basic(t *testing.T) {
+	var v drs.ReplicationConfigurationTemplate
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_drs_replication_configuration_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, drs.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckReplicationConfigurationTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReplicationConfigurationTemplateConfig_basic(rName, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplicationConfigurationTemplateExists(resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "drs", regexp.MustCompile(`replication-configuration-template/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "associate_default_security_group", "false"),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth_throttling", "0"),
+					resource.TestCheckResourceAttr(resourceName, "create_public_ip", "false"),
+					resource.TestCheckResourceAttr(resourceName, "data_plane_routing", "PRIVATE_IP"),
+					resource.TestCheckResourceAttr(resourceName, "default_large_staging_disk_type", "GP3"),
+					resource.TestCheckResourceAttr(resourceName, "ebs_encryption", "DEFAULT"),
+					resource.TestCheckResourceAttr(resourceName, "pit_policy.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "pit_policy.0.interval", "10"),
+					resource.TestCheckResourceAttr(resourceName, "pit_policy.0.retention_duration", "60"),
+					resource.TestCheckResourceAttr(resourceName, "pit_policy.0.units", "MINUTE"),
+					resource.TestCheckResourceAttr(resourceName, "pit_policy.1.interval", "1"),
+					resource.TestCheckResourceAttr(resourceName, "pit_policy.1.retention_duration", "7"),
+					resource.TestCheckResourceAttr(resourceName, "pit_policy.1.units", "DAY"),
+					resource.TestCheckResourceAttr(resourceName, "replication_server_instance_type", "t3.small"),
+					resource.TestCheckResourceAttr(resourceName, "replication_servers_security_groups_ids.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "staging_area_subnet_id", "aws_subnet.test.0", "id"),
+					resource.TestCheckResourceAttr(resourceName, "staging_area_tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "staging_area_tags.Name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "use_dedicated_replication_server", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccReplicationConfigurationTemplateConfig_basic(rName, 12800),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplicationConfigurationTemplateExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth_throttling", "12800"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDRSReplicationConfigurationTemplate_disappears(t *testing.T) {
+	var v drs.ReplicationConfigurationTemplate
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_drs_replication_configuration_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, drs.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckReplicationConfigurationTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReplicationConfigurationTemplateConfig_basic(rName, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplicationConfigurationTemplateExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfdrs.ResourceReplicationConfigurationTemplate(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccDRSReplicationConfigurationTemplate_tags(t *testing.T) {
+	var v drs.ReplicationConfigurationTemplate
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_drs_replication_configuration_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, drs.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckReplicationConfigurationTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReplicationConfigurationTemplateConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplicationConfigurationTemplateExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccReplicationConfigurationTemplateConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplicationConfigurationTemplateExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccReplicationConfigurationTemplateConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplicationConfigurationTemplateExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckReplicationConfigurationTemplateExists(n string, v *drs.ReplicationConfigurationTemplate) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No DRS Replication Configuration Template ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DRSConn
+
+		output, err := tfdrs.FindReplicationConfigurationTemplateByID(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckReplicationConfigurationTemplateDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).DRSConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_drs_replication_configuration_template" {
+			continue
+		}
+
+		_, err := tfdrs.FindReplicationConfigurationTemplateByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("DRS Replication Configuration Template %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccReplicationConfigurationTemplateConfig_base(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 1), fmt.Sprintf(`
+resource "aws_security_group" "test" {
+  name   = %[1]q
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
+func testAccReplicationConfigurationTemplateConfig_resource(rName string, bandwidthThrottling int, tags string) string {
+	return fmt.Sprintf(`
+resource "aws_drs_replication_configuration_template" "test" {
+  associate_default_security_group        = false
+  bandwidth_throttling                    = %[2]d
+  create_public_ip                        = false
+  data_plane_routing                      = "PRIVATE_IP"
+  default_large_staging_disk_type         = "GP3"
+  ebs_encryption                          = "DEFAULT"
+  replication_server_instance_type        = "t3.small"
+  replication_servers_security_groups_ids = [aws_security_group.test.id]
+  staging_area_subnet_id                  = aws_subnet.test[0].id
+  use_dedicated_replication_server        = false
+
+  pit_policy {
+    enabled            = true
+    interval           = 10
+    retention_duration = 60
+    units              = "MINUTE"
+  }
+
+  pit_policy {
+    enabled            = true
+    interval           = 1
+    retention_duration = 7
+    units              = "DAY"
+  }
+
+  staging_area_tags = {
+    Name = %[1]q
+  }
+%[3]s
+}
+`, rName, bandwidthThrottling, tags)
+}
+
+func testAccReplicationConfigurationTemplateConfig_basic(rName string, bandwidthThrottling int) string {
+	return acctest.ConfigCompose(
+		testAccReplicationConfigurationTemplateConfig_base(rName),
+		testAccReplicationConfigurationTemplateConfig_resource(rName, bandwidthThrottling, ""),
+	)
+}
+
+func testAccReplicationConfigurationTemplateConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(
+		testAccReplicationConfigurationTemplateConfig_base(rName),
+		testAccReplicationConfigurationTemplateConfig_resource(rName, 0, fmt.Sprintf(`
+  tags = {
+    %[1]q = %[2]q
+  }
+`, tagKey1, tagValue1)),
+	)
+}
+
+func testAccReplicationConfigurationTemplateConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(
+		testAccReplicationConfigurationTemplateConfig_base(rName),
+		testAccReplicationConfigurationTemplateConfig_resource(rName, 0, fmt.Sprintf(`
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+`, tagKey1, tagValue1, tagKey2, tagValue2)),
+	)
+}
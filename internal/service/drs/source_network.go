@@ -0,0 +1,166 @@
+package drs
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/drs"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceSourceNetwork() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSourceNetworkCreate,
+		ReadWithoutTimeout:   resourceSourceNetworkRead,
+		UpdateWithoutTimeout: resourceSourceNetworkUpdate,
+		DeleteWithoutTimeout: resourceSourceNetworkDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cfn_stack_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"launched_vpc_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"origin_account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"origin_region": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"replication_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceSourceNetworkCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DRSConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	vpcID := d.Get("vpc_id").(string)
+	input := &drs.CreateSourceNetworkInput{
+		OriginAccountID: aws.String(d.Get("origin_account_id").(string)),
+		OriginRegion:    aws.String(d.Get("origin_region").(string)),
+		VpcID:           aws.String(vpcID),
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateSourceNetworkWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating DRS Source Network (%s): %s", vpcID, err)
+	}
+
+	d.SetId(aws.StringValue(output.SourceNetworkID))
+
+	return resourceSourceNetworkRead(ctx, d, meta)
+}
+
+func resourceSourceNetworkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DRSConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	sourceNetwork, err := FindSourceNetworkByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] DRS Source Network (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading DRS Source Network (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", sourceNetwork.Arn)
+	d.Set("cfn_stack_name", sourceNetwork.CfnStackName)
+	d.Set("launched_vpc_id", sourceNetwork.LaunchedVpcID)
+	d.Set("origin_account_id", sourceNetwork.SourceAccountID)
+	d.Set("origin_region", sourceNetwork.SourceRegion)
+	d.Set("replication_status", sourceNetwork.ReplicationStatus)
+	d.Set("vpc_id", sourceNetwork.SourceVpcID)
+
+	tags := KeyValueTags(sourceNetwork.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceSourceNetworkUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DRSConn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+		if err := UpdateTagsWithContext(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating DRS Source Network (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceSourceNetworkRead(ctx, d, meta)
+}
+
+func resourceSourceNetworkDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DRSConn
+
+	log.Printf("[DEBUG] Deleting DRS Source Network: %s", d.Id())
+	_, err := conn.DeleteSourceNetworkWithContext(ctx, &drs.DeleteSourceNetworkInput{
+		SourceNetworkID: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, drs.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting DRS Source Network (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
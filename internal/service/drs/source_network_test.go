@@ -0,0 +1,221 @@
+package drs_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/drs"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdrs "github.com/hashicorp/terraform-provider-aws/internal/service/drs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccDRSSourceNetwork_basic(t *testing.T) {
+	var v drs.SourceNetwork
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_drs_source_network.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, drs.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSourceNetworkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSourceNetworkConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSourceNetworkExists(resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "drs", regexp.MustCompile(`source-network/.+`)),
+					acctest.CheckResourceAttrAccountID(resourceName, "origin_account_id"),
+					resource.TestCheckResourceAttr(resourceName, "origin_region", acctest.Region()),
+					resource.TestCheckResourceAttrSet(resourceName, "replication_status"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "vpc_id", "aws_vpc.test", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDRSSourceNetwork_disappears(t *testing.T) {
+	var v drs.SourceNetwork
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_drs_source_network.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, drs.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSourceNetworkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSourceNetworkConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSourceNetworkExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfdrs.ResourceSourceNetwork(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccDRSSourceNetwork_tags(t *testing.T) {
+	var v drs.SourceNetwork
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_drs_source_network.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, drs.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSourceNetworkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSourceNetworkConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSourceNetworkExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSourceNetworkConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSourceNetworkExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccSourceNetworkConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSourceNetworkExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSourceNetworkExists(n string, v *drs.SourceNetwork) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No DRS Source Network ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DRSConn
+
+		output, err := tfdrs.FindSourceNetworkByID(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckSourceNetworkDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).DRSConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_drs_source_network" {
+			continue
+		}
+
+		_, err := tfdrs.FindSourceNetworkByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("DRS Source Network %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccSourceNetworkConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName)
+}
+
+func testAccSourceNetworkConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccSourceNetworkConfig_base(rName), `
+resource "aws_drs_source_network" "test" {
+  origin_account_id = data.aws_caller_identity.current.account_id
+  origin_region     = data.aws_region.current.name
+  vpc_id            = aws_vpc.test.id
+}
+`)
+}
+
+func testAccSourceNetworkConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccSourceNetworkConfig_base(rName), fmt.Sprintf(`
+resource "aws_drs_source_network" "test" {
+  origin_account_id = data.aws_caller_identity.current.account_id
+  origin_region     = data.aws_region.current.name
+  vpc_id            = aws_vpc.test.id
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1))
+}
+
+func testAccSourceNetworkConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccSourceNetworkConfig_base(rName), fmt.Sprintf(`
+resource "aws_drs_source_network" "test" {
+  origin_account_id = data.aws_caller_identity.current.account_id
+  origin_region     = data.aws_region.current.name
+  vpc_id            = aws_vpc.test.id
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -1,6 +1,7 @@
 package ds
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -9,21 +10,23 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/directoryservice"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func ResourceConditionalForwarder() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceConditionalForwarderCreate,
-		Read:   resourceConditionalForwarderRead,
-		Update: resourceConditionalForwarderUpdate,
-		Delete: resourceConditionalForwarderDelete,
+		CreateWithoutTimeout: resourceConditionalForwarderCreate,
+		ReadWithoutTimeout:   resourceConditionalForwarderRead,
+		UpdateWithoutTimeout: resourceConditionalForwarderUpdate,
+		DeleteWithoutTimeout: resourceConditionalForwarderDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -48,117 +51,132 @@ func ResourceConditionalForwarder() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^([a-zA-Z0-9]+[\.-])+([a-zA-Z0-9])+[.]?$`), "invalid value, see the RemoteDomainName attribute documentation: https://docs.aws.amazon.com/directoryservice/latest/devguide/API_ConditionalForwarder.html"),
 			},
+
+			"replication_scope": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
 
-func resourceConditionalForwarderCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceConditionalForwarderCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).DSConn
 
-	dnsIps := flex.ExpandStringList(d.Get("dns_ips").([]interface{}))
-
-	directoryId := d.Get("directory_id").(string)
+	directoryID := d.Get("directory_id").(string)
 	domainName := d.Get("remote_domain_name").(string)
-
-	_, err := conn.CreateConditionalForwarder(&directoryservice.CreateConditionalForwarderInput{
-		DirectoryId:      aws.String(directoryId),
-		DnsIpAddrs:       dnsIps,
+	id := ConditionalForwarderCreateResourceID(directoryID, domainName)
+	input := &directoryservice.CreateConditionalForwarderInput{
+		DirectoryId:      aws.String(directoryID),
+		DnsIpAddrs:       flex.ExpandStringList(d.Get("dns_ips").([]interface{})),
 		RemoteDomainName: aws.String(domainName),
-	})
+	}
+
+	log.Printf("[DEBUG] Creating Directory Service Conditional Forwarder: %s", input)
+	_, err := conn.CreateConditionalForwarderWithContext(ctx, input)
 
 	if err != nil {
-		return err
+		return diag.Errorf("creating Directory Service Conditional Forwarder (%s): %s", id, err)
 	}
 
-	d.SetId(fmt.Sprintf("%s:%s", directoryId, domainName))
+	d.SetId(id)
 
-	return nil
+	return resourceConditionalForwarderRead(ctx, d, meta)
 }
 
-func resourceConditionalForwarderRead(d *schema.ResourceData, meta interface{}) error {
+func resourceConditionalForwarderRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).DSConn
 
-	directoryId, domainName, err := ParseConditionalForwarderID(d.Id())
-	if err != nil {
-		return err
-	}
-
-	res, err := conn.DescribeConditionalForwarders(&directoryservice.DescribeConditionalForwardersInput{
-		DirectoryId:       aws.String(directoryId),
-		RemoteDomainNames: []*string{aws.String(domainName)},
-	})
+	directoryID, domainName, err := ParseConditionalForwarderID(d.Id())
 
 	if err != nil {
-		if tfawserr.ErrCodeEquals(err, directoryservice.ErrCodeEntityDoesNotExistException) {
-			log.Printf("[WARN] Directory Service Conditional Forwarder (%s) not found, removing from state", d.Id())
-			d.SetId("")
-			return nil
-		}
-		return err
+		return diag.FromErr(err)
 	}
 
-	if len(res.ConditionalForwarders) == 0 {
+	cfd, err := FindConditionalForwarderByTwoPartKey(ctx, conn, directoryID, domainName)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] Directory Service Conditional Forwarder (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
 	}
 
-	cfd := res.ConditionalForwarders[0]
+	if err != nil {
+		return diag.Errorf("reading Directory Service Conditional Forwarder (%s): %s", d.Id(), err)
+	}
 
+	d.Set("directory_id", directoryID)
 	d.Set("dns_ips", flex.FlattenStringList(cfd.DnsIpAddrs))
-	d.Set("directory_id", directoryId)
 	d.Set("remote_domain_name", cfd.RemoteDomainName)
+	d.Set("replication_scope", cfd.ReplicationScope)
 
 	return nil
 }
 
-func resourceConditionalForwarderUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceConditionalForwarderUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).DSConn
 
-	directoryId, domainName, err := ParseConditionalForwarderID(d.Id())
+	directoryID, domainName, err := ParseConditionalForwarderID(d.Id())
+
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	dnsIps := flex.ExpandStringList(d.Get("dns_ips").([]interface{}))
-
-	_, err = conn.UpdateConditionalForwarder(&directoryservice.UpdateConditionalForwarderInput{
-		DirectoryId:      aws.String(directoryId),
-		DnsIpAddrs:       dnsIps,
+	input := &directoryservice.UpdateConditionalForwarderInput{
+		DirectoryId:      aws.String(directoryID),
+		DnsIpAddrs:       flex.ExpandStringList(d.Get("dns_ips").([]interface{})),
 		RemoteDomainName: aws.String(domainName),
-	})
+	}
+
+	log.Printf("[DEBUG] Updating Directory Service Conditional Forwarder: %s", input)
+	_, err = conn.UpdateConditionalForwarderWithContext(ctx, input)
 
 	if err != nil {
-		return err
+		return diag.Errorf("updating Directory Service Conditional Forwarder (%s): %s", d.Id(), err)
 	}
 
-	return resourceConditionalForwarderRead(d, meta)
+	return resourceConditionalForwarderRead(ctx, d, meta)
 }
 
-func resourceConditionalForwarderDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceConditionalForwarderDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).DSConn
 
-	directoryId, domainName, err := ParseConditionalForwarderID(d.Id())
+	directoryID, domainName, err := ParseConditionalForwarderID(d.Id())
+
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	_, err = conn.DeleteConditionalForwarder(&directoryservice.DeleteConditionalForwarderInput{
-		DirectoryId:      aws.String(directoryId),
+	log.Printf("[DEBUG] Deleting Directory Service Conditional Forwarder: %s", d.Id())
+	_, err = conn.DeleteConditionalForwarderWithContext(ctx, &directoryservice.DeleteConditionalForwarderInput{
+		DirectoryId:      aws.String(directoryID),
 		RemoteDomainName: aws.String(domainName),
 	})
 
-	if err != nil && !tfawserr.ErrCodeEquals(err, directoryservice.ErrCodeEntityDoesNotExistException) {
-		return err
+	if tfawserr.ErrCodeEquals(err, directoryservice.ErrCodeEntityDoesNotExistException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Directory Service Conditional Forwarder (%s): %s", d.Id(), err)
 	}
 
 	return nil
 }
 
+const conditionalForwarderResourceIDSeparator = ":"
+
+func ConditionalForwarderCreateResourceID(directoryID, domainName string) string {
+	parts := []string{directoryID, domainName}
+	id := strings.Join(parts, conditionalForwarderResourceIDSeparator)
+
+	return id
+}
+
 func ParseConditionalForwarderID(id string) (directoryId, domainName string, err error) {
-	parts := strings.SplitN(id, ":", 2)
+	parts := strings.SplitN(id, conditionalForwarderResourceIDSeparator, 2)
 
-	if len(parts) != 2 {
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
 		return "", "", fmt.Errorf("please make sure ID is in format DIRECTORY_ID:DOMAIN_NAME")
 	}
 
@@ -1,18 +1,18 @@
 package ds_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
-	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/directoryservice"
-	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfds "github.com/hashicorp/terraform-provider-aws/internal/service/ds"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func TestAccDSConditionalForwarder_Condition_basic(t *testing.T) {
@@ -35,6 +35,7 @@ func TestAccDSConditionalForwarder_Condition_basic(t *testing.T) {
 						resourceName,
 						[]string{ip1, ip2},
 					),
+					resource.TestCheckResourceAttrSet(resourceName, "replication_scope"),
 				),
 			},
 			// test update
@@ -57,6 +58,30 @@ func TestAccDSConditionalForwarder_Condition_basic(t *testing.T) {
 	})
 }
 
+func TestAccDSConditionalForwarder_disappears(t *testing.T) {
+	resourceName := "aws_directory_service_conditional_forwarder.fwd"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domainName := acctest.RandomDomainName()
+	ip1, ip2 := "8.8.8.8", "1.1.1.1"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckDirectoryService(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, directoryservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConditionalForwarderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConditionalForwarderConfig_basic(rName, domainName, ip1, ip2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConditionalForwarderExists(resourceName, []string{ip1, ip2}),
+					acctest.CheckResourceDisappears(acctest.Provider, tfds.ResourceConditionalForwarder(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
 func testAccCheckConditionalForwarderDestroy(s *terraform.State) error {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).DSConn
 
@@ -65,17 +90,15 @@ func testAccCheckConditionalForwarderDestroy(s *terraform.State) error {
 			continue
 		}
 
-		directoryId, domainName, err := tfds.ParseConditionalForwarderID(rs.Primary.ID)
+		directoryID, domainName, err := tfds.ParseConditionalForwarderID(rs.Primary.ID)
+
 		if err != nil {
 			return err
 		}
 
-		res, err := conn.DescribeConditionalForwarders(&directoryservice.DescribeConditionalForwardersInput{
-			DirectoryId:       aws.String(directoryId),
-			RemoteDomainNames: []*string{aws.String(domainName)},
-		})
+		_, err = tfds.FindConditionalForwarderByTwoPartKey(context.Background(), conn, directoryID, domainName)
 
-		if tfawserr.ErrCodeEquals(err, directoryservice.ErrCodeEntityDoesNotExistException) {
+		if tfresource.NotFound(err) {
 			continue
 		}
 
@@ -83,9 +106,7 @@ func testAccCheckConditionalForwarderDestroy(s *terraform.State) error {
 			return err
 		}
 
-		if len(res.ConditionalForwarders) > 0 {
-			return fmt.Errorf("Expected AWS Directory Service Conditional Forwarder to be gone, but was still found")
-		}
+		return fmt.Errorf("Directory Service Conditional Forwarder %s still exists", rs.Primary.ID)
 	}
 
 	return nil
@@ -102,28 +123,20 @@ func testAccCheckConditionalForwarderExists(name string, dnsIps []string) resour
 			return fmt.Errorf("No ID is set")
 		}
 
-		directoryId, domainName, err := tfds.ParseConditionalForwarderID(rs.Primary.ID)
+		directoryID, domainName, err := tfds.ParseConditionalForwarderID(rs.Primary.ID)
+
 		if err != nil {
 			return err
 		}
 
 		conn := acctest.Provider.Meta().(*conns.AWSClient).DSConn
 
-		res, err := conn.DescribeConditionalForwarders(&directoryservice.DescribeConditionalForwardersInput{
-			DirectoryId:       aws.String(directoryId),
-			RemoteDomainNames: []*string{aws.String(domainName)},
-		})
+		cfd, err := tfds.FindConditionalForwarderByTwoPartKey(context.Background(), conn, directoryID, domainName)
 
 		if err != nil {
 			return err
 		}
 
-		if len(res.ConditionalForwarders) == 0 {
-			return fmt.Errorf("No Conditional Fowrwarder found")
-		}
-
-		cfd := res.ConditionalForwarders[0]
-
 		if dnsIps != nil {
 			if len(dnsIps) != len(cfd.DnsIpAddrs) {
 				return fmt.Errorf("DnsIpAddrs length mismatch")
@@ -1,6 +1,7 @@
 package ds
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -8,6 +9,8 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/directoryservice"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -24,12 +27,16 @@ const (
 
 func ResourceDirectory() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceDirectoryCreate,
-		Read:   resourceDirectoryRead,
-		Update: resourceDirectoryUpdate,
-		Delete: resourceDirectoryDelete,
+		CreateWithoutTimeout: resourceDirectoryCreate,
+		ReadWithoutTimeout:   resourceDirectoryRead,
+		UpdateWithoutTimeout: resourceDirectoryUpdate,
+		DeleteWithoutTimeout: resourceDirectoryDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: resourceDirectoryImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(directorySettingsUpdatedTimeout),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -65,6 +72,23 @@ func ResourceDirectory() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"setting": {
+				Type:             schema.TypeSet,
+				Optional:         true,
+				DiffSuppressFunc: suppressUnconfiguredDirectorySettingDiffs,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
 			"short_name": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -191,8 +215,21 @@ func ResourceDirectory() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			resourceDirectoryCustomizeDiff,
+		),
+	}
+}
+
+func resourceDirectoryCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if v, ok := d.GetOk("setting"); ok && v.(*schema.Set).Len() > 0 {
+		if directoryType := d.Get("type").(string); directoryType != directoryservice.DirectoryTypeMicrosoftAd {
+			return fmt.Errorf("setting is only supported for type = %s, got %s", directoryservice.DirectoryTypeMicrosoftAd, directoryType)
+		}
 	}
+
+	return nil
 }
 
 func buildVPCSettings(d *schema.ResourceData) (vpcSettings *directoryservice.DirectoryVpcSettings, err error) {
@@ -374,7 +411,7 @@ func enableSSO(conn *directoryservice.DirectoryService, d *schema.ResourceData)
 	return nil
 }
 
-func resourceDirectoryCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceDirectoryCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).DSConn
 
 	var directoryId string
@@ -390,14 +427,14 @@ func resourceDirectoryCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(directoryId)
 
 	err = waitDirectoryCreated(conn, d.Id())
 	if err != nil {
-		return fmt.Errorf("error waiting for Directory Service Directory (%s) to create: %w", d.Id(), err)
+		return diag.Errorf("error waiting for Directory Service Directory (%s) to create: %s", d.Id(), err)
 	}
 
 	if v, ok := d.GetOk("alias"); ok {
@@ -408,9 +445,9 @@ func resourceDirectoryCreate(d *schema.ResourceData, meta interface{}) error {
 
 		log.Printf("[DEBUG] Assigning alias %q to DS directory %q",
 			v.(string), d.Id())
-		out, err := conn.CreateAlias(&input)
+		out, err := conn.CreateAliasWithContext(ctx, &input)
 		if err != nil {
-			return err
+			return diag.Errorf("error creating Directory Service Directory (%s) alias: %s", d.Id(), err)
 		}
 		log.Printf("[DEBUG] Alias %q assigned to DS directory %q",
 			*out.Alias, *out.DirectoryId)
@@ -418,34 +455,48 @@ func resourceDirectoryCreate(d *schema.ResourceData, meta interface{}) error {
 
 	if d.HasChange("enable_sso") {
 		if err := enableSSO(conn, d); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceDirectoryRead(d, meta)
+	if v, ok := d.GetOk("setting"); ok && v.(*schema.Set).Len() > 0 {
+		if err := updateDirectorySettings(ctx, conn, d.Id(), nil, v.(*schema.Set), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceDirectoryRead(ctx, d, meta)
 }
 
-func resourceDirectoryUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceDirectoryUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).DSConn
 
 	if d.HasChange("enable_sso") {
 		if err := enableSSO(conn, d); err != nil {
-			return err
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("setting") {
+		o, n := d.GetChange("setting")
+
+		if err := updateDirectorySettings(ctx, conn, d.Id(), o.(*schema.Set), n.(*schema.Set), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 
-		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
-			return fmt.Errorf("error updating Directory Service Directory (%s) tags: %s", d.Id(), err)
+		if err := UpdateTagsWithContext(ctx, conn, d.Id(), o, n); err != nil {
+			return diag.Errorf("error updating Directory Service Directory (%s) tags: %s", d.Id(), err)
 		}
 	}
 
-	return resourceDirectoryRead(d, meta)
+	return resourceDirectoryRead(ctx, d, meta)
 }
 
-func resourceDirectoryRead(d *schema.ResourceData, meta interface{}) error {
+func resourceDirectoryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).DSConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
@@ -459,7 +510,7 @@ func resourceDirectoryRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if err != nil {
-		return fmt.Errorf("error reading Directory Service Directory (%s): %w", d.Id(), err)
+		return diag.Errorf("error reading Directory Service Directory (%s): %s", d.Id(), err)
 	}
 
 	log.Printf("[DEBUG] Received DS directory: %s", dir)
@@ -480,42 +531,57 @@ func resourceDirectoryRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("type", dir.Type)
 
 	if err := d.Set("vpc_settings", flattenVPCSettings(dir.VpcSettings)); err != nil {
-		return fmt.Errorf("error setting VPC settings: %s", err)
+		return diag.Errorf("error setting VPC settings: %s", err)
 	}
 
 	if err := d.Set("connect_settings", flattenConnectSettings(dir.DnsIpAddrs, dir.ConnectSettings)); err != nil {
-		return fmt.Errorf("error setting connect settings: %s", err)
+		return diag.Errorf("error setting connect settings: %s", err)
 	}
 
 	d.Set("enable_sso", dir.SsoEnabled)
 
+	// Settings are only read when configured (or imported), as DescribeSettings lists every setting supported by the directory.
+	if aws.StringValue(dir.Type) != directoryservice.DirectoryTypeMicrosoftAd {
+		d.Set("setting", nil)
+	} else if d.Get("setting").(*schema.Set).Len() > 0 {
+		settings, err := findDirectorySettings(ctx, conn, d.Id())
+
+		if err != nil {
+			return diag.Errorf("error reading Directory Service Directory (%s) settings: %s", d.Id(), err)
+		}
+
+		if err := d.Set("setting", flattenSettingEntries(settings)); err != nil {
+			return diag.Errorf("error setting setting: %s", err)
+		}
+	}
+
 	if aws.StringValue(dir.Type) == directoryservice.DirectoryTypeAdconnector {
 		d.Set("security_group_id", dir.ConnectSettings.SecurityGroupId)
 	} else {
 		d.Set("security_group_id", dir.VpcSettings.SecurityGroupId)
 	}
 
-	tags, err := ListTags(conn, d.Id())
+	tags, err := ListTagsWithContext(ctx, conn, d.Id())
 
 	if err != nil {
-		return fmt.Errorf("error listing tags for Directory Service Directory (%s): %s", d.Id(), err)
+		return diag.Errorf("error listing tags for Directory Service Directory (%s): %s", d.Id(), err)
 	}
 
 	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
 	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
-		return fmt.Errorf("error setting tags: %w", err)
+		return diag.Errorf("error setting tags: %s", err)
 	}
 
 	if err := d.Set("tags_all", tags.Map()); err != nil {
-		return fmt.Errorf("error setting tags_all: %w", err)
+		return diag.Errorf("error setting tags_all: %s", err)
 	}
 
 	return nil
 }
 
-func resourceDirectoryDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceDirectoryDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).DSConn
 
 	input := &directoryservice.DeleteDirectoryInput{
@@ -524,7 +590,7 @@ func resourceDirectoryDelete(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[DEBUG] Deleting Directory Service Directory: (%s)", d.Id())
 	err := resource.Retry(directoryApplicationDeauthorizedPropagationTimeout, func() *resource.RetryError {
-		_, err := conn.DeleteDirectory(input)
+		_, err := conn.DeleteDirectoryWithContext(ctx, input)
 
 		if tfawserr.ErrCodeEquals(err, directoryservice.ErrCodeEntityDoesNotExistException) {
 			return nil
@@ -539,18 +605,110 @@ func resourceDirectoryDelete(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	})
 	if tfresource.TimedOut(err) {
-		_, err = conn.DeleteDirectory(input)
+		_, err = conn.DeleteDirectoryWithContext(ctx, input)
 	}
 
 	if err != nil {
-		return fmt.Errorf("error deleting Directory Service Directory (%s): %w", d.Id(), err)
+		return diag.Errorf("error deleting Directory Service Directory (%s): %s", d.Id(), err)
 	}
 
 	err = waitDirectoryDeleted(conn, d.Id())
 
 	if err != nil {
-		return fmt.Errorf("error waiting for Directory Service Directory (%s) to delete: %w", d.Id(), err)
+		return diag.Errorf("error waiting for Directory Service Directory (%s) to delete: %s", d.Id(), err)
 	}
 
 	return nil
 }
+
+// updateDirectorySettings applies any new or changed settings and waits for them to take effect.
+// Settings removed from configuration are left at their current value.
+func updateDirectorySettings(ctx context.Context, conn *directoryservice.DirectoryService, directoryID string, o, n *schema.Set, timeout time.Duration) error {
+	var settings []*directoryservice.Setting
+	var names []string
+
+	for _, tfMapRaw := range n.List() {
+		if o != nil && o.Contains(tfMapRaw) {
+			continue
+		}
+
+		tfMap := tfMapRaw.(map[string]interface{})
+		name := tfMap["name"].(string)
+
+		settings = append(settings, &directoryservice.Setting{
+			Name:  aws.String(name),
+			Value: aws.String(tfMap["value"].(string)),
+		})
+		names = append(names, name)
+	}
+
+	if len(settings) == 0 {
+		return nil
+	}
+
+	input := &directoryservice.UpdateSettingsInput{
+		DirectoryId: aws.String(directoryID),
+		Settings:    settings,
+	}
+
+	log.Printf("[DEBUG] Updating Directory Service Directory settings: %s", input)
+	if _, err := conn.UpdateSettingsWithContext(ctx, input); err != nil {
+		return fmt.Errorf("error updating Directory Service Directory (%s) settings: %w", directoryID, err)
+	}
+
+	if err := waitDirectorySettingsUpdated(ctx, conn, directoryID, names, timeout); err != nil {
+		return fmt.Errorf("error waiting for Directory Service Directory (%s) settings update: %w", directoryID, err)
+	}
+
+	return nil
+}
+
+func resourceDirectoryImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*conns.AWSClient).DSConn
+
+	dir, err := findDirectoryByID(conn, d.Id())
+
+	if err != nil {
+		return nil, err
+	}
+
+	if aws.StringValue(dir.Type) == directoryservice.DirectoryTypeMicrosoftAd {
+		settings, err := findDirectorySettings(ctx, conn, d.Id())
+
+		if err != nil {
+			return nil, fmt.Errorf("error reading Directory Service Directory (%s) settings: %w", d.Id(), err)
+		}
+
+		if err := d.Set("setting", flattenSettingEntries(settings)); err != nil {
+			return nil, fmt.Errorf("error setting setting: %w", err)
+		}
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// suppressUnconfiguredDirectorySettingDiffs suppresses the removal of settings that are not in configuration,
+// as DescribeSettings returns every setting supported by the directory and removed settings are left at their current value.
+func suppressUnconfiguredDirectorySettingDiffs(k, old, new string, d *schema.ResourceData) bool {
+	o, n := d.GetChange("setting")
+
+	return n.(*schema.Set).Difference(o.(*schema.Set)).Len() == 0
+}
+
+// flattenSettingEntries returns the applied value of every setting supported by the directory.
+func flattenSettingEntries(apiObjects []*directoryservice.SettingEntry) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"name":  aws.StringValue(apiObject.Name),
+			"value": aws.StringValue(apiObject.AppliedValue),
+		})
+	}
+
+	return tfList
+}
@@ -79,3 +79,130 @@ func findSharedDirectoryByIDs(ctx context.Context, conn *directoryservice.Direct
 
 	return sharedDirectory, nil
 }
+
+func FindConditionalForwarderByTwoPartKey(ctx context.Context, conn *directoryservice.DirectoryService, directoryID, domainName string) (*directoryservice.ConditionalForwarder, error) {
+	input := &directoryservice.DescribeConditionalForwardersInput{
+		DirectoryId:       aws.String(directoryID),
+		RemoteDomainNames: aws.StringSlice([]string{domainName}),
+	}
+
+	output, err := conn.DescribeConditionalForwardersWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, directoryservice.ErrCodeEntityDoesNotExistException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.ConditionalForwarders) == 0 || output.ConditionalForwarders[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.ConditionalForwarders); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.ConditionalForwarders[0], nil
+}
+
+func FindSchemaExtensionByTwoPartKey(ctx context.Context, conn *directoryservice.DirectoryService, directoryID, schemaExtensionID string) (*directoryservice.SchemaExtensionInfo, error) {
+	output, err := findSchemaExtension(ctx, conn, directoryID, schemaExtensionID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	switch status := aws.StringValue(output.SchemaExtensionStatus); status {
+	case directoryservice.SchemaExtensionStatusCancelled, directoryservice.SchemaExtensionStatusFailed:
+		return nil, &resource.NotFoundError{
+			Message: status,
+		}
+	}
+
+	return output, nil
+}
+
+func findSchemaExtension(ctx context.Context, conn *directoryservice.DirectoryService, directoryID, schemaExtensionID string) (*directoryservice.SchemaExtensionInfo, error) {
+	input := &directoryservice.ListSchemaExtensionsInput{
+		DirectoryId: aws.String(directoryID),
+	}
+	var output *directoryservice.SchemaExtensionInfo
+
+	err := conn.ListSchemaExtensionsPagesWithContext(ctx, input, func(page *directoryservice.ListSchemaExtensionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.SchemaExtensionsInfo {
+			if v != nil && aws.StringValue(v.SchemaExtensionId) == schemaExtensionID {
+				output = v
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, directoryservice.ErrCodeEntityDoesNotExistException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func findDirectorySettings(ctx context.Context, conn *directoryservice.DirectoryService, directoryID string) ([]*directoryservice.SettingEntry, error) {
+	input := &directoryservice.DescribeSettingsInput{
+		DirectoryId: aws.String(directoryID),
+	}
+	var output []*directoryservice.SettingEntry
+
+	for {
+		page, err := conn.DescribeSettingsWithContext(ctx, input)
+
+		if tfawserr.ErrCodeEquals(err, directoryservice.ErrCodeEntityDoesNotExistException) {
+			return nil, &resource.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if page == nil {
+			break
+		}
+
+		for _, v := range page.SettingEntries {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		if aws.StringValue(page.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = page.NextToken
+	}
+
+	return output, nil
+}
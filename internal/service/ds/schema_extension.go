@@ -0,0 +1,211 @@
+package ds
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/directoryservice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceSchemaExtension() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSchemaExtensionCreate,
+		ReadWithoutTimeout:   resourceSchemaExtensionRead,
+		DeleteWithoutTimeout: resourceSchemaExtensionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"create_snapshot_before_schema_extension": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"directory_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"end_date_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ldif_content": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 500000),
+			},
+			"schema_extension_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"start_date_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSchemaExtensionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DSConn
+
+	directoryID := d.Get("directory_id").(string)
+	input := &directoryservice.StartSchemaExtensionInput{
+		CreateSnapshotBeforeSchemaExtension: aws.Bool(d.Get("create_snapshot_before_schema_extension").(bool)),
+		Description:                         aws.String(d.Get("description").(string)),
+		DirectoryId:                         aws.String(directoryID),
+		LdifContent:                         aws.String(d.Get("ldif_content").(string)),
+	}
+
+	log.Printf("[DEBUG] Starting Directory Service Schema Extension: %s", input)
+	output, err := conn.StartSchemaExtensionWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("starting Directory Service Directory (%s) Schema Extension: %s", directoryID, err)
+	}
+
+	d.SetId(SchemaExtensionCreateResourceID(directoryID, aws.StringValue(output.SchemaExtensionId)))
+
+	if _, err := waitSchemaExtensionCreated(ctx, conn, directoryID, aws.StringValue(output.SchemaExtensionId), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for Directory Service Schema Extension (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceSchemaExtensionRead(ctx, d, meta)
+}
+
+func resourceSchemaExtensionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DSConn
+
+	directoryID, schemaExtensionID, err := SchemaExtensionParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, err := FindSchemaExtensionByTwoPartKey(ctx, conn, directoryID, schemaExtensionID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Directory Service Schema Extension (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Directory Service Schema Extension (%s): %s", d.Id(), err)
+	}
+
+	d.Set("description", output.Description)
+	d.Set("directory_id", output.DirectoryId)
+	if output.EndDateTime != nil {
+		d.Set("end_date_time", aws.TimeValue(output.EndDateTime).Format(time.RFC3339))
+	} else {
+		d.Set("end_date_time", nil)
+	}
+	d.Set("schema_extension_id", output.SchemaExtensionId)
+	if output.StartDateTime != nil {
+		d.Set("start_date_time", aws.TimeValue(output.StartDateTime).Format(time.RFC3339))
+	} else {
+		d.Set("start_date_time", nil)
+	}
+	d.Set("status", output.SchemaExtensionStatus)
+
+	return nil
+}
+
+func resourceSchemaExtensionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DSConn
+
+	directoryID, schemaExtensionID, err := SchemaExtensionParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, err := FindSchemaExtensionByTwoPartKey(ctx, conn, directoryID, schemaExtensionID)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Directory Service Schema Extension (%s): %s", d.Id(), err)
+	}
+
+	// Only an in-progress schema extension can be cancelled. Completed extensions are permanent.
+	switch status := aws.StringValue(output.SchemaExtensionStatus); status {
+	case directoryservice.SchemaExtensionStatusInitializing,
+		directoryservice.SchemaExtensionStatusCreatingSnapshot,
+		directoryservice.SchemaExtensionStatusUpdatingSchema:
+	default:
+		log.Printf("[WARN] Directory Service Schema Extension (%s) is %s and cannot be cancelled, removing from state", d.Id(), status)
+		return nil
+	}
+
+	log.Printf("[DEBUG] Cancelling Directory Service Schema Extension: %s", d.Id())
+	_, err = conn.CancelSchemaExtensionWithContext(ctx, &directoryservice.CancelSchemaExtensionInput{
+		DirectoryId:       aws.String(directoryID),
+		SchemaExtensionId: aws.String(schemaExtensionID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, directoryservice.ErrCodeEntityDoesNotExistException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("cancelling Directory Service Schema Extension (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitSchemaExtensionCancelled(ctx, conn, directoryID, schemaExtensionID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for Directory Service Schema Extension (%s) cancel: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+const schemaExtensionResourceIDSeparator = "/"
+
+func SchemaExtensionCreateResourceID(directoryID, schemaExtensionID string) string {
+	parts := []string{directoryID, schemaExtensionID}
+	id := strings.Join(parts, schemaExtensionResourceIDSeparator)
+
+	return id
+}
+
+func SchemaExtensionParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, schemaExtensionResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected DIRECTORY-ID%[2]sSCHEMA-EXTENSION-ID", id, schemaExtensionResourceIDSeparator)
+}
@@ -0,0 +1,132 @@
+package ds_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/directoryservice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfds "github.com/hashicorp/terraform-provider-aws/internal/service/ds"
+)
+
+func TestAccDSSchemaExtension_basic(t *testing.T) {
+	var v directoryservice.SchemaExtensionInfo
+	resourceName := "aws_directory_service_schema_extension.test"
+	directoryResourceName := "aws_directory_service_directory.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domainName := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckDirectoryService(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, directoryservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		// Completed schema extensions cannot be removed, only the directory can be destroyed.
+		CheckDestroy: testAccCheckDirectoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSchemaExtensionConfig_basic(rName, domainName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSchemaExtensionExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "create_snapshot_before_schema_extension", "false"),
+					resource.TestCheckResourceAttr(resourceName, "description", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "directory_id", directoryResourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "end_date_time"),
+					resource.TestCheckResourceAttrSet(resourceName, "schema_extension_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "start_date_time"),
+					resource.TestCheckResourceAttr(resourceName, "status", directoryservice.SchemaExtensionStatusCompleted),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"create_snapshot_before_schema_extension",
+					"ldif_content",
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckSchemaExtensionExists(n string, v *directoryservice.SchemaExtensionInfo) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Directory Service Schema Extension ID is set")
+		}
+
+		directoryID, schemaExtensionID, err := tfds.SchemaExtensionParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DSConn
+
+		output, err := tfds.FindSchemaExtensionByTwoPartKey(context.Background(), conn, directoryID, schemaExtensionID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccSchemaExtensionConfig_basic(rName, domain string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigVPCWithSubnets(rName, 2),
+		fmt.Sprintf(`
+resource "aws_directory_service_directory" "test" {
+  name     = %[2]q
+  password = "SuperSecretPassw0rd"
+  type     = "MicrosoftAD"
+  edition  = "Standard"
+
+  vpc_settings {
+    vpc_id     = aws_vpc.test.id
+    subnet_ids = aws_subnet.test[*].id
+  }
+}
+
+resource "aws_directory_service_schema_extension" "test" {
+  directory_id = aws_directory_service_directory.test.id
+  description  = %[1]q
+
+  create_snapshot_before_schema_extension = false
+
+  ldif_content = <<EOT
+dn: CN=tfTestAttribute,CN=Schema,CN=Configuration,DC=domain,DC=com
+changetype: add
+objectClass: top
+objectClass: attributeSchema
+attributeID: 1.2.840.113556.1.8000.2554.50000.1
+cn: tfTestAttribute
+attributeSyntax: 2.5.5.12
+oMSyntax: 64
+isSingleValued: TRUE
+lDAPDisplayName: tfTestAttribute
+adminDisplayName: tfTestAttribute
+adminDescription: Terraform acceptance test attribute
+
+dn:
+changetype: modify
+add: schemaUpdateNow
+schemaUpdateNow: 1
+-
+EOT
+}
+`, rName, domain))
+}
@@ -56,3 +56,57 @@ func statusSharedDirectory(ctx context.Context, conn *directoryservice.Directory
 		return output, aws.StringValue(output.ShareStatus), nil
 	}
 }
+
+func statusSchemaExtension(ctx context.Context, conn *directoryservice.DirectoryService, directoryID, schemaExtensionID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		// Cancelled and Failed extensions are terminal states here, not "not found".
+		output, err := findSchemaExtension(ctx, conn, directoryID, schemaExtensionID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.SchemaExtensionStatus), nil
+	}
+}
+
+// statusDirectorySettings returns the aggregate request status of the named directory settings.
+func statusDirectorySettings(ctx context.Context, conn *directoryservice.DirectoryService, directoryID string, names []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findDirectorySettings(ctx, conn, directoryID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		status := directoryservice.DirectoryConfigurationStatusUpdated
+		requested := make(map[string]struct{}, len(names))
+
+		for _, v := range names {
+			requested[v] = struct{}{}
+		}
+
+		for _, v := range output {
+			if _, ok := requested[aws.StringValue(v.Name)]; !ok {
+				continue
+			}
+
+			switch s := aws.StringValue(v.RequestStatus); s {
+			case directoryservice.DirectoryConfigurationStatusFailed:
+				return v, s, nil
+			case directoryservice.DirectoryConfigurationStatusRequested, directoryservice.DirectoryConfigurationStatusUpdating:
+				status = directoryservice.DirectoryConfigurationStatusUpdating
+			}
+		}
+
+		return output, status, nil
+	}
+}
@@ -3,6 +3,7 @@ package ds
 import (
 	"context"
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -12,9 +13,10 @@ import (
 )
 
 const (
-	directoryCreatedTimeout       = 60 * time.Minute
-	directoryDeletedTimeout       = 60 * time.Minute
-	sharedDirectoryDeletedTimeout = 60 * time.Minute
+	directoryCreatedTimeout         = 60 * time.Minute
+	directoryDeletedTimeout         = 60 * time.Minute
+	sharedDirectoryDeletedTimeout   = 60 * time.Minute
+	directorySettingsUpdatedTimeout = 60 * time.Minute
 )
 
 func waitDirectoryCreated(conn *directoryservice.DirectoryService, id string) error {
@@ -93,3 +95,75 @@ func waitDirectoryShared(ctx context.Context, conn *directoryservice.DirectorySe
 
 	return nil, err
 }
+
+func waitDirectorySettingsUpdated(ctx context.Context, conn *directoryservice.DirectoryService, directoryID string, names []string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{directoryservice.DirectoryConfigurationStatusRequested, directoryservice.DirectoryConfigurationStatusUpdating},
+		Target:  []string{directoryservice.DirectoryConfigurationStatusUpdated},
+		Refresh: statusDirectorySettings(ctx, conn, directoryID, names),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*directoryservice.SettingEntry); ok {
+		tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(output.Name), aws.StringValue(output.RequestStatusMessage)))
+	}
+
+	return err
+}
+
+func waitSchemaExtensionCreated(ctx context.Context, conn *directoryservice.DirectoryService, directoryID, schemaExtensionID string, timeout time.Duration) (*directoryservice.SchemaExtensionInfo, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			directoryservice.SchemaExtensionStatusInitializing,
+			directoryservice.SchemaExtensionStatusCreatingSnapshot,
+			directoryservice.SchemaExtensionStatusUpdatingSchema,
+			directoryservice.SchemaExtensionStatusReplicating,
+		},
+		Target:     []string{directoryservice.SchemaExtensionStatusCompleted},
+		Refresh:    statusSchemaExtension(ctx, conn, directoryID, schemaExtensionID),
+		Timeout:    timeout,
+		MinTimeout: 30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*directoryservice.SchemaExtensionInfo); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.SchemaExtensionStatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitSchemaExtensionCancelled(ctx context.Context, conn *directoryservice.DirectoryService, directoryID, schemaExtensionID string, timeout time.Duration) (*directoryservice.SchemaExtensionInfo, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			directoryservice.SchemaExtensionStatusInitializing,
+			directoryservice.SchemaExtensionStatusCreatingSnapshot,
+			directoryservice.SchemaExtensionStatusUpdatingSchema,
+			directoryservice.SchemaExtensionStatusCancelInProgress,
+			directoryservice.SchemaExtensionStatusRollbackInProgress,
+		},
+		Target: []string{
+			directoryservice.SchemaExtensionStatusCancelled,
+			directoryservice.SchemaExtensionStatusCompleted,
+			directoryservice.SchemaExtensionStatusFailed,
+		},
+		Refresh:    statusSchemaExtension(ctx, conn, directoryID, schemaExtensionID),
+		Timeout:    timeout,
+		MinTimeout: 30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*directoryservice.SchemaExtensionInfo); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.SchemaExtensionStatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
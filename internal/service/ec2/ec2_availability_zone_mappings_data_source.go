@@ -0,0 +1,215 @@
+package ec2
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+func DataSourceAvailabilityZoneMappings() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAvailabilityZoneMappingsRead,
+
+		Schema: map[string]*schema.Schema{
+			"exclude_zone_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"mappings": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"preferred_zone_ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"selected_names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"selected_zone_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					ec2.AvailabilityZoneStateAvailable,
+					ec2.AvailabilityZoneStateInformation,
+					ec2.AvailabilityZoneStateImpaired,
+					ec2.AvailabilityZoneStateUnavailable,
+				}, false),
+			},
+			"zone_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"zone_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceAvailabilityZoneMappingsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	// Only Availability Zones are returned. Local Zones and Wavelength Zones
+	// are not available in every account and so can't be mapped consistently.
+	input := &ec2.DescribeAvailabilityZonesInput{
+		Filters: BuildAttributeFilterList(
+			map[string]string{
+				"state":     d.Get("state").(string),
+				"zone-type": "availability-zone",
+			},
+		),
+	}
+
+	azs, err := FindAvailabilityZones(conn, input)
+
+	if err != nil {
+		return fmt.Errorf("reading EC2 Availability Zones: %w", err)
+	}
+
+	excludeZoneIDs := d.Get("exclude_zone_ids").(*schema.Set)
+	mappings := make(map[string]string)
+	var zoneIDs []string
+
+	for _, v := range azs {
+		zoneID := aws.StringValue(v.ZoneId)
+
+		if excludeZoneIDs.Contains(zoneID) {
+			continue
+		}
+
+		mappings[zoneID] = aws.StringValue(v.ZoneName)
+		zoneIDs = append(zoneIDs, zoneID)
+	}
+
+	// AZ IDs identify the same physical location in every account, whereas AZ names
+	// are mapped independently for each account, so order by AZ ID.
+	sortZoneIDs(zoneIDs)
+
+	selectedZoneIDs, err := selectZoneIDs(zoneIDs, flex.ExpandStringValueList(d.Get("preferred_zone_ids").([]interface{})), d.Get("zone_count").(int))
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set("mappings", mappings)
+	d.Set("names", zoneNames(zoneIDs, mappings))
+	d.Set("selected_names", zoneNames(selectedZoneIDs, mappings))
+	d.Set("selected_zone_ids", selectedZoneIDs)
+	d.Set("zone_ids", zoneIDs)
+
+	return nil
+}
+
+// selectZoneIDs returns count zone IDs (or all zone IDs if count is 0), taking the
+// preferred zone IDs first and then the remaining zone IDs in order.
+func selectZoneIDs(zoneIDs, preferredZoneIDs []string, count int) ([]string, error) {
+	available := make(map[string]bool, len(zoneIDs))
+
+	for _, v := range zoneIDs {
+		available[v] = true
+	}
+
+	selected := make([]string, 0, len(zoneIDs))
+	seen := make(map[string]bool, len(zoneIDs))
+
+	for _, v := range preferredZoneIDs {
+		if !available[v] {
+			return nil, fmt.Errorf("preferred Availability Zone ID (%s) is not available", v)
+		}
+
+		if !seen[v] {
+			selected = append(selected, v)
+			seen[v] = true
+		}
+	}
+
+	for _, v := range zoneIDs {
+		if !seen[v] {
+			selected = append(selected, v)
+			seen[v] = true
+		}
+	}
+
+	if count == 0 {
+		return selected, nil
+	}
+
+	if count > len(selected) {
+		return nil, fmt.Errorf("zone_count (%d) is greater than the number of available Availability Zones (%d)", count, len(selected))
+	}
+
+	return selected[:count], nil
+}
+
+// sortZoneIDs sorts zone IDs such as use1-az1 and use1-az10 by prefix and then by
+// the numeric suffix.
+func sortZoneIDs(zoneIDs []string) {
+	split := func(s string) (string, int) {
+		i := strings.LastIndex(s, "-az")
+
+		if i == -1 {
+			return s, 0
+		}
+
+		n, err := strconv.Atoi(s[i+len("-az"):])
+
+		if err != nil {
+			return s, 0
+		}
+
+		return s[:i], n
+	}
+
+	sort.Slice(zoneIDs, func(i, j int) bool {
+		pi, ni := split(zoneIDs[i])
+		pj, nj := split(zoneIDs[j])
+
+		if pi != pj {
+			return pi < pj
+		}
+
+		if ni != nj {
+			return ni < nj
+		}
+
+		return zoneIDs[i] < zoneIDs[j]
+	})
+}
+
+func zoneNames(zoneIDs []string, mappings map[string]string) []string {
+	names := make([]string, 0, len(zoneIDs))
+
+	for _, v := range zoneIDs {
+		names = append(names, mappings[v])
+	}
+
+	return names
+}
@@ -0,0 +1,122 @@
+package ec2_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccEC2AvailabilityZoneMappingsDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_availability_zone_mappings.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAvailabilityZoneMappingsDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "mappings.%"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "names.0"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "selected_names.#", dataSourceName, "names.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "selected_zone_ids.#", dataSourceName, "zone_ids.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "zone_ids.#", dataSourceName, "names.#"),
+					resource.TestMatchResourceAttr(dataSourceName, "zone_ids.0", regexp.MustCompile(`-az\d+$`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2AvailabilityZoneMappingsDataSource_preferredZoneIDs(t *testing.T) {
+	dataSourceName := "data.aws_availability_zone_mappings.test"
+	azsDataSourceName := "data.aws_availability_zones.available"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAvailabilityZoneMappingsDataSourceConfig_preferredZoneIDs(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "selected_names.#", "2"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "selected_names.0", azsDataSourceName, "names.1"),
+					resource.TestCheckResourceAttr(dataSourceName, "selected_zone_ids.#", "2"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "selected_zone_ids.0", azsDataSourceName, "zone_ids.1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2AvailabilityZoneMappingsDataSource_excludeZoneIDs(t *testing.T) {
+	dataSourceName := "data.aws_availability_zone_mappings.test"
+	azsDataSourceName := "data.aws_availability_zones.available"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAvailabilityZoneMappingsDataSourceConfig_excludeZoneIDs(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "selected_zone_ids.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "selected_zone_ids.0", azsDataSourceName, "zone_ids.1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2AvailabilityZoneMappingsDataSource_zoneCountTooLarge(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAvailabilityZoneMappingsDataSourceConfig_zoneCount(100),
+				ExpectError: regexp.MustCompile(`zone_count \(100\) is greater than the number of available Availability Zones`),
+			},
+		},
+	})
+}
+
+const testAccAvailabilityZoneMappingsDataSourceConfig_basic = `
+data "aws_availability_zone_mappings" "test" {}
+`
+
+func testAccAvailabilityZoneMappingsDataSourceConfig_preferredZoneIDs() string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), `
+data "aws_availability_zone_mappings" "test" {
+  preferred_zone_ids = [data.aws_availability_zones.available.zone_ids[1]]
+  state              = "available"
+  zone_count         = 2
+}
+`)
+}
+
+func testAccAvailabilityZoneMappingsDataSourceConfig_excludeZoneIDs() string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), `
+data "aws_availability_zone_mappings" "test" {
+  exclude_zone_ids   = [data.aws_availability_zones.available.zone_ids[0]]
+  preferred_zone_ids = [data.aws_availability_zones.available.zone_ids[1]]
+  zone_count         = 1
+}
+`)
+}
+
+func testAccAvailabilityZoneMappingsDataSourceConfig_zoneCount(zoneCount int) string {
+	return fmt.Sprintf(`
+data "aws_availability_zone_mappings" "test" {
+  zone_count = %[1]d
+}
+`, zoneCount)
+}
@@ -0,0 +1,405 @@
+package ec2
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	// The only allocation strategy currently supported by the API.
+	capacityReservationFleetAllocationStrategyPrioritized = "prioritized"
+)
+
+func ResourceCapacityReservationFleet() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCapacityReservationFleetCreate,
+		ReadWithoutTimeout:   resourceCapacityReservationFleetRead,
+		UpdateWithoutTimeout: resourceCapacityReservationFleetUpdate,
+		DeleteWithoutTimeout: resourceCapacityReservationFleetDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"allocation_strategy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      capacityReservationFleetAllocationStrategyPrioritized,
+				ValidateFunc: validation.StringInSlice([]string{capacityReservationFleetAllocationStrategyPrioritized}, false),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"end_date": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"instance_match_criteria": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      ec2.FleetInstanceMatchCriteriaOpen,
+				ValidateFunc: validation.StringInSlice(ec2.FleetInstanceMatchCriteria_Values(), false),
+			},
+			"instance_type_specification": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				MaxItems: 50,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"availability_zone": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"ebs_optimized": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+							Default:  false,
+						},
+						"instance_platform": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(ec2.CapacityReservationInstancePlatform_Values(), false),
+						},
+						"instance_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"priority": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntBetween(0, 999),
+						},
+						"weight": {
+							Type:         schema.TypeFloat,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.FloatBetween(0.001, 99.999),
+						},
+					},
+				},
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"tenancy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      ec2.FleetCapacityReservationTenancyDefault,
+				ValidateFunc: validation.StringInSlice(ec2.FleetCapacityReservationTenancy_Values(), false),
+			},
+			"total_fulfilled_capacity": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"total_target_capacity": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+		},
+	}
+}
+
+func resourceCapacityReservationFleetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &ec2.CreateCapacityReservationFleetInput{
+		AllocationStrategy:         aws.String(d.Get("allocation_strategy").(string)),
+		InstanceMatchCriteria:      aws.String(d.Get("instance_match_criteria").(string)),
+		InstanceTypeSpecifications: expandReservationFleetInstanceSpecifications(d.Get("instance_type_specification").(*schema.Set).List()),
+		TagSpecifications:          tagSpecificationsFromKeyValueTags(tags, ec2.ResourceTypeCapacityReservationFleet),
+		Tenancy:                    aws.String(d.Get("tenancy").(string)),
+		TotalTargetCapacity:        aws.Int64(int64(d.Get("total_target_capacity").(int))),
+	}
+
+	if v, ok := d.GetOk("end_date"); ok {
+		v, _ := time.Parse(time.RFC3339, v.(string))
+
+		input.EndDate = aws.Time(v)
+	}
+
+	log.Printf("[DEBUG] Creating EC2 Capacity Reservation Fleet: %s", input)
+	output, err := conn.CreateCapacityReservationFleetWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating EC2 Capacity Reservation Fleet: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.CapacityReservationFleetId))
+
+	if _, err := WaitCapacityReservationFleetCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for EC2 Capacity Reservation Fleet (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceCapacityReservationFleetRead(ctx, d, meta)
+}
+
+func resourceCapacityReservationFleetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	fleet, err := FindCapacityReservationFleetByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Capacity Reservation Fleet %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading EC2 Capacity Reservation Fleet (%s): %s", d.Id(), err)
+	}
+
+	d.Set("allocation_strategy", fleet.AllocationStrategy)
+	d.Set("arn", fleet.CapacityReservationFleetArn)
+	if fleet.CreateTime != nil {
+		d.Set("create_time", aws.TimeValue(fleet.CreateTime).Format(time.RFC3339))
+	} else {
+		d.Set("create_time", nil)
+	}
+	if fleet.EndDate != nil {
+		d.Set("end_date", aws.TimeValue(fleet.EndDate).Format(time.RFC3339))
+	} else {
+		d.Set("end_date", nil)
+	}
+	d.Set("instance_match_criteria", fleet.InstanceMatchCriteria)
+	if err := d.Set("instance_type_specification", flattenFleetCapacityReservations(fleet.InstanceTypeSpecifications)); err != nil {
+		return diag.Errorf("setting instance_type_specification: %s", err)
+	}
+	d.Set("state", fleet.State)
+	d.Set("tenancy", fleet.Tenancy)
+	d.Set("total_fulfilled_capacity", fleet.TotalFulfilledCapacity)
+	d.Set("total_target_capacity", fleet.TotalTargetCapacity)
+
+	tags := KeyValueTags(fleet.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceCapacityReservationFleetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	if d.HasChanges("end_date", "total_target_capacity") {
+		input := &ec2.ModifyCapacityReservationFleetInput{
+			CapacityReservationFleetId: aws.String(d.Id()),
+		}
+
+		if d.HasChange("end_date") {
+			if v, ok := d.GetOk("end_date"); ok {
+				v, _ := time.Parse(time.RFC3339, v.(string))
+
+				input.EndDate = aws.Time(v)
+			} else {
+				input.RemoveEndDate = aws.Bool(true)
+			}
+		}
+
+		if d.HasChange("total_target_capacity") {
+			input.TotalTargetCapacity = aws.Int64(int64(d.Get("total_target_capacity").(int)))
+		}
+
+		log.Printf("[DEBUG] Updating EC2 Capacity Reservation Fleet: %s", input)
+		_, err := conn.ModifyCapacityReservationFleetWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating EC2 Capacity Reservation Fleet (%s): %s", d.Id(), err)
+		}
+
+		if _, err := WaitCapacityReservationFleetUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("waiting for EC2 Capacity Reservation Fleet (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Id(), o, n); err != nil {
+			return diag.Errorf("updating EC2 Capacity Reservation Fleet (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceCapacityReservationFleetRead(ctx, d, meta)
+}
+
+func resourceCapacityReservationFleetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	log.Printf("[DEBUG] Deleting EC2 Capacity Reservation Fleet: %s", d.Id())
+	output, err := conn.CancelCapacityReservationFleetsWithContext(ctx, &ec2.CancelCapacityReservationFleetsInput{
+		CapacityReservationFleetIds: aws.StringSlice([]string{d.Id()}),
+	})
+
+	if err == nil && output != nil {
+		err = CancelCapacityReservationFleetsError(output.FailedFleetCancellations)
+	}
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidCapacityReservationFleetIdNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting EC2 Capacity Reservation Fleet (%s): %s", d.Id(), err)
+	}
+
+	if _, err := WaitCapacityReservationFleetDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for EC2 Capacity Reservation Fleet (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandReservationFleetInstanceSpecification(tfMap map[string]interface{}) *ec2.ReservationFleetInstanceSpecification {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ec2.ReservationFleetInstanceSpecification{}
+
+	if v, ok := tfMap["availability_zone"].(string); ok && v != "" {
+		apiObject.AvailabilityZone = aws.String(v)
+	}
+
+	if v, ok := tfMap["ebs_optimized"].(bool); ok {
+		apiObject.EbsOptimized = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["instance_platform"].(string); ok && v != "" {
+		apiObject.InstancePlatform = aws.String(v)
+	}
+
+	if v, ok := tfMap["instance_type"].(string); ok && v != "" {
+		apiObject.InstanceType = aws.String(v)
+	}
+
+	if v, ok := tfMap["priority"].(int); ok {
+		apiObject.Priority = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["weight"].(float64); ok && v != 0 {
+		apiObject.Weight = aws.Float64(v)
+	}
+
+	return apiObject
+}
+
+func expandReservationFleetInstanceSpecifications(tfList []interface{}) []*ec2.ReservationFleetInstanceSpecification {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*ec2.ReservationFleetInstanceSpecification
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandReservationFleetInstanceSpecification(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenFleetCapacityReservation(apiObject *ec2.FleetCapacityReservation) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"ebs_optimized": aws.BoolValue(apiObject.EbsOptimized),
+		"priority":      aws.Int64Value(apiObject.Priority),
+		"weight":        aws.Float64Value(apiObject.Weight),
+	}
+
+	if v := apiObject.AvailabilityZone; v != nil {
+		tfMap["availability_zone"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.InstancePlatform; v != nil {
+		tfMap["instance_platform"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.InstanceType; v != nil {
+		tfMap["instance_type"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenFleetCapacityReservations(apiObjects []*ec2.FleetCapacityReservation) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenFleetCapacityReservation(apiObject))
+	}
+
+	return tfList
+}
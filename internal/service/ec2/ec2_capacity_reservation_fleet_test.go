@@ -0,0 +1,309 @@
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccEC2CapacityReservationFleet_basic(t *testing.T) {
+	var v ec2.CapacityReservationFleet
+	resourceName := "aws_ec2_capacity_reservation_fleet.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckCapacityReservationFleet(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCapacityReservationFleetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCapacityReservationFleetConfig_basic(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCapacityReservationFleetExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "allocation_strategy", "prioritized"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "ec2", regexp.MustCompile(`capacity-reservation-fleet/crf-.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "create_time"),
+					resource.TestCheckResourceAttr(resourceName, "end_date", ""),
+					resource.TestCheckResourceAttr(resourceName, "instance_match_criteria", "open"),
+					resource.TestCheckResourceAttr(resourceName, "instance_type_specification.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "instance_type_specification.*", map[string]string{
+						"ebs_optimized":     "false",
+						"instance_platform": "Linux/UNIX",
+						"instance_type":     "t3.micro",
+						"priority":          "1",
+						"weight":            "1",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tenancy", "default"),
+					resource.TestCheckResourceAttr(resourceName, "total_target_capacity", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCapacityReservationFleetConfig_basic(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCapacityReservationFleetExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "total_target_capacity", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2CapacityReservationFleet_disappears(t *testing.T) {
+	var v ec2.CapacityReservationFleet
+	resourceName := "aws_ec2_capacity_reservation_fleet.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckCapacityReservationFleet(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCapacityReservationFleetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCapacityReservationFleetConfig_basic(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCapacityReservationFleetExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfec2.ResourceCapacityReservationFleet(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2CapacityReservationFleet_endDate(t *testing.T) {
+	var v ec2.CapacityReservationFleet
+	endDate1 := time.Now().UTC().Add(1 * time.Hour).Format(time.RFC3339)
+	endDate2 := time.Now().UTC().Add(2 * time.Hour).Format(time.RFC3339)
+	resourceName := "aws_ec2_capacity_reservation_fleet.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckCapacityReservationFleet(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCapacityReservationFleetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCapacityReservationFleetConfig_endDate(rName, endDate1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCapacityReservationFleetExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "end_date", endDate1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCapacityReservationFleetConfig_endDate(rName, endDate2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCapacityReservationFleetExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "end_date", endDate2),
+				),
+			},
+			{
+				Config: testAccCapacityReservationFleetConfig_basic(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCapacityReservationFleetExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "end_date", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2CapacityReservationFleet_weighted(t *testing.T) {
+	var v ec2.CapacityReservationFleet
+	resourceName := "aws_ec2_capacity_reservation_fleet.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckCapacityReservationFleet(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCapacityReservationFleetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCapacityReservationFleetConfig_weighted(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCapacityReservationFleetExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "instance_type_specification.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "instance_type_specification.*", map[string]string{
+						"instance_type": "t3.micro",
+						"priority":      "1",
+						"weight":        "1",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "instance_type_specification.*", map[string]string{
+						"instance_type": "t3.small",
+						"priority":      "2",
+						"weight":        "2",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "total_target_capacity", "4"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCapacityReservationFleetExists(n string, v *ec2.CapacityReservationFleet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Capacity Reservation Fleet ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+		output, err := tfec2.FindCapacityReservationFleetByID(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckCapacityReservationFleetDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ec2_capacity_reservation_fleet" {
+			continue
+		}
+
+		_, err := tfec2.FindCapacityReservationFleetByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("EC2 Capacity Reservation Fleet %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccPreCheckCapacityReservationFleet(t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+	input := &ec2.DescribeCapacityReservationFleetsInput{
+		MaxResults: aws.Int64(1),
+	}
+
+	_, err := conn.DescribeCapacityReservationFleets(input)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccCapacityReservationFleetConfig_basic(rName string, totalTargetCapacity int) string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
+resource "aws_ec2_capacity_reservation_fleet" "test" {
+  total_target_capacity = %[2]d
+
+  instance_type_specification {
+    availability_zone = data.aws_availability_zones.available.names[0]
+    instance_platform = "Linux/UNIX"
+    instance_type     = "t3.micro"
+    priority          = 1
+    weight            = 1
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, totalTargetCapacity))
+}
+
+func testAccCapacityReservationFleetConfig_endDate(rName, endDate string) string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
+resource "aws_ec2_capacity_reservation_fleet" "test" {
+  end_date              = %[2]q
+  total_target_capacity = 1
+
+  instance_type_specification {
+    availability_zone = data.aws_availability_zones.available.names[0]
+    instance_platform = "Linux/UNIX"
+    instance_type     = "t3.micro"
+    priority          = 1
+    weight            = 1
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, endDate))
+}
+
+func testAccCapacityReservationFleetConfig_weighted(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
+resource "aws_ec2_capacity_reservation_fleet" "test" {
+  total_target_capacity = 4
+
+  instance_type_specification {
+    availability_zone = data.aws_availability_zones.available.names[0]
+    instance_platform = "Linux/UNIX"
+    instance_type     = "t3.micro"
+    priority          = 1
+    weight            = 1
+  }
+
+  instance_type_specification {
+    availability_zone = data.aws_availability_zones.available.names[0]
+    instance_platform = "Linux/UNIX"
+    instance_type     = "t3.small"
+    priority          = 2
+    weight            = 2
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
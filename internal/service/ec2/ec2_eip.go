@@ -1,6 +1,7 @@
 package ec2
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net"
@@ -11,9 +12,11 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfservicequotas "github.com/hashicorp/terraform-provider-aws/internal/service/servicequotas"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -34,7 +37,15 @@ func ResourceEIP() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			tfservicequotas.Precheck{
+				ServiceCode: "ec2",
+				QuotaCode:   "L-0263D0A3",
+				QuotaName:   "EC2-VPC Elastic IPs",
+				Usage:       eipsInUse,
+			}.CustomizeDiff("aws_eip"),
+		),
 
 		Timeouts: &schema.ResourceTimeout{
 			Read:   schema.DefaultTimeout(15 * time.Minute),
@@ -578,3 +589,16 @@ func waitForAddressAssociationClassic(conn *ec2.EC2, publicIP string, instanceID
 func ConvertIPToDashIP(ip string) string {
 	return strings.Replace(ip, ".", "-", -1)
 }
+
+// eipsInUse returns the number of Elastic IPs counting towards the EC2-VPC Elastic IPs quota.
+func eipsInUse(_ context.Context, meta interface{}) (int, error) {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	addresses, err := FindEIPs(conn, &ec2.DescribeAddressesInput{
+		Filters: BuildAttributeFilterList(map[string]string{
+			"domain": ec2.DomainTypeVpc,
+		}),
+	})
+
+	return len(addresses), err
+}
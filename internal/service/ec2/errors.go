@@ -22,6 +22,7 @@ const (
 	errCodeInvalidAllocationIDNotFound                    = "InvalidAllocationID.NotFound"
 	errCodeInvalidAssociationIDNotFound                   = "InvalidAssociationID.NotFound"
 	errCodeInvalidAttachmentIDNotFound                    = "InvalidAttachmentID.NotFound"
+	errCodeInvalidCapacityReservationFleetIdNotFound      = "InvalidCapacityReservationFleetId.NotFound"
 	errCodeInvalidCapacityReservationIdNotFound           = "InvalidCapacityReservationId.NotFound'"
 	ErrCodeInvalidCarrierGatewayIDNotFound                = "InvalidCarrierGatewayID.NotFound"
 	errCodeInvalidClientVPNActiveAssociationNotFound      = "InvalidClientVpnActiveAssociationNotFound"
@@ -77,6 +78,10 @@ const (
 	errCodeInvalidTransitGatewayConnectPeerIDNotFound     = "InvalidTransitGatewayConnectPeerID.NotFound"
 	errCodeInvalidTransitGatewayIDNotFound                = "InvalidTransitGatewayID.NotFound"
 	errCodeInvalidTransitGatewayMulticastDomainIdNotFound = "InvalidTransitGatewayMulticastDomainId.NotFound"
+	errCodeInvalidVerifiedAccessEndpointIdNotFound        = "InvalidVerifiedAccessEndpointId.NotFound"
+	errCodeInvalidVerifiedAccessGroupIdNotFound           = "InvalidVerifiedAccessGroupId.NotFound"
+	errCodeInvalidVerifiedAccessInstanceIdNotFound        = "InvalidVerifiedAccessInstanceId.NotFound"
+	errCodeInvalidVerifiedAccessTrustProviderIdNotFound   = "InvalidVerifiedAccessTrustProviderId.NotFound"
 	errCodeInvalidVolumeNotFound                          = "InvalidVolume.NotFound"
 	errCodeInvalidVPCCIDRBlockAssociationIDNotFound       = "InvalidVpcCidrBlockAssociationID.NotFound"
 	errCodeInvalidVPCEndpointIdNotFound                   = "InvalidVpcEndpointId.NotFound"
@@ -102,6 +107,26 @@ func CancelSpotFleetRequestError(apiObject *ec2.CancelSpotFleetRequestsErrorItem
 	return awserr.New(aws.StringValue(apiObject.Error.Code), aws.StringValue(apiObject.Error.Message), nil)
 }
 
+func CancelCapacityReservationFleetError(apiObject *ec2.FailedCapacityReservationFleetCancellationResult) error {
+	if apiObject == nil || apiObject.CancelCapacityReservationFleetError == nil {
+		return nil
+	}
+
+	return awserr.New(aws.StringValue(apiObject.CancelCapacityReservationFleetError.Code), aws.StringValue(apiObject.CancelCapacityReservationFleetError.Message), nil)
+}
+
+func CancelCapacityReservationFleetsError(apiObjects []*ec2.FailedCapacityReservationFleetCancellationResult) error {
+	var errors *multierror.Error
+
+	for _, apiObject := range apiObjects {
+		if err := CancelCapacityReservationFleetError(apiObject); err != nil {
+			errors = multierror.Append(errors, fmt.Errorf("%s: %w", aws.StringValue(apiObject.CapacityReservationFleetId), err))
+		}
+	}
+
+	return errors.ErrorOrNil()
+}
+
 func CancelSpotFleetRequestsError(apiObjects []*ec2.CancelSpotFleetRequestsErrorItem) error {
 	var errors *multierror.Error
 
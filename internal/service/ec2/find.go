@@ -154,6 +154,83 @@ func FindCapacityReservationByID(conn *ec2.EC2, id string) (*ec2.CapacityReserva
 	return output, nil
 }
 
+func FindCapacityReservationFleet(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeCapacityReservationFleetsInput) (*ec2.CapacityReservationFleet, error) {
+	output, err := FindCapacityReservationFleets(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 || output[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
+
+func FindCapacityReservationFleets(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeCapacityReservationFleetsInput) ([]*ec2.CapacityReservationFleet, error) {
+	var output []*ec2.CapacityReservationFleet
+
+	err := conn.DescribeCapacityReservationFleetsPagesWithContext(ctx, input, func(page *ec2.DescribeCapacityReservationFleetsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.CapacityReservationFleets {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidCapacityReservationFleetIdNotFound) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func FindCapacityReservationFleetByID(ctx context.Context, conn *ec2.EC2, id string) (*ec2.CapacityReservationFleet, error) {
+	input := &ec2.DescribeCapacityReservationFleetsInput{
+		CapacityReservationFleetIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := FindCapacityReservationFleet(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if state := aws.StringValue(output.State); state == ec2.CapacityReservationFleetStateCancelled || state == ec2.CapacityReservationFleetStateExpired {
+		return nil, &resource.NotFoundError{
+			Message:     state,
+			LastRequest: input,
+		}
+	}
+
+	// Eventual consistency check.
+	if aws.StringValue(output.CapacityReservationFleetId) != id {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
 // FindCarrierGatewayByID returns the carrier gateway corresponding to the specified identifier.
 // Returns nil and potentially an error if no carrier gateway is found.
 func FindCarrierGatewayByID(conn *ec2.EC2, id string) (*ec2.CarrierGateway, error) {
@@ -4584,6 +4661,43 @@ func FindManagedPrefixListEntriesByID(conn *ec2.EC2, id string) ([]*ec2.PrefixLi
 	return prefixListEntries, nil
 }
 
+func FindManagedPrefixListAssociationsByID(conn *ec2.EC2, id string) ([]*ec2.PrefixListAssociation, error) {
+	input := &ec2.GetManagedPrefixListAssociationsInput{
+		PrefixListId: aws.String(id),
+	}
+
+	var prefixListAssociations []*ec2.PrefixListAssociation
+
+	err := conn.GetManagedPrefixListAssociationsPages(input, func(page *ec2.GetManagedPrefixListAssociationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, association := range page.PrefixListAssociations {
+			if association == nil {
+				continue
+			}
+
+			prefixListAssociations = append(prefixListAssociations, association)
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidPrefixListIDNotFound) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return prefixListAssociations, nil
+}
+
 func FindManagedPrefixListEntryByIDAndCIDR(conn *ec2.EC2, id, cidr string) (*ec2.PrefixListEntry, error) {
 	prefixListEntries, err := FindManagedPrefixListEntriesByID(conn, id)
 
@@ -5065,3 +5179,353 @@ func FindSnapshotTierStatusBySnapshotID(conn *ec2.EC2, id string) (*ec2.Snapshot
 
 	return output, nil
 }
+
+func FindVerifiedAccessEndpoint(conn *ec2.EC2, input *ec2.DescribeVerifiedAccessEndpointsInput) (*ec2.VerifiedAccessEndpoint, error) {
+	output, err := FindVerifiedAccessEndpoints(conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 || output[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
+
+func FindVerifiedAccessEndpoints(conn *ec2.EC2, input *ec2.DescribeVerifiedAccessEndpointsInput) ([]*ec2.VerifiedAccessEndpoint, error) {
+	var output []*ec2.VerifiedAccessEndpoint
+
+	err := conn.DescribeVerifiedAccessEndpointsPages(input, func(page *ec2.DescribeVerifiedAccessEndpointsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.VerifiedAccessEndpoints {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidVerifiedAccessEndpointIdNotFound) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func FindVerifiedAccessEndpointByID(conn *ec2.EC2, id string) (*ec2.VerifiedAccessEndpoint, error) {
+	input := &ec2.DescribeVerifiedAccessEndpointsInput{
+		VerifiedAccessEndpointIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := FindVerifiedAccessEndpoint(conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output.Status != nil {
+		if code := aws.StringValue(output.Status.Code); code == ec2.VerifiedAccessEndpointStatusCodeDeleted {
+			return nil, &resource.NotFoundError{
+				Message:     code,
+				LastRequest: input,
+			}
+		}
+	}
+
+	// Eventual consistency check.
+	if aws.StringValue(output.VerifiedAccessEndpointId) != id {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func FindVerifiedAccessGroup(conn *ec2.EC2, input *ec2.DescribeVerifiedAccessGroupsInput) (*ec2.VerifiedAccessGroup, error) {
+	output, err := FindVerifiedAccessGroups(conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 || output[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
+
+func FindVerifiedAccessGroups(conn *ec2.EC2, input *ec2.DescribeVerifiedAccessGroupsInput) ([]*ec2.VerifiedAccessGroup, error) {
+	var output []*ec2.VerifiedAccessGroup
+
+	err := conn.DescribeVerifiedAccessGroupsPages(input, func(page *ec2.DescribeVerifiedAccessGroupsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.VerifiedAccessGroups {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidVerifiedAccessGroupIdNotFound) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func FindVerifiedAccessGroupByID(conn *ec2.EC2, id string) (*ec2.VerifiedAccessGroup, error) {
+	input := &ec2.DescribeVerifiedAccessGroupsInput{
+		VerifiedAccessGroupIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := FindVerifiedAccessGroup(conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Eventual consistency check.
+	if aws.StringValue(output.VerifiedAccessGroupId) != id {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func FindVerifiedAccessInstance(conn *ec2.EC2, input *ec2.DescribeVerifiedAccessInstancesInput) (*ec2.VerifiedAccessInstance, error) {
+	output, err := FindVerifiedAccessInstances(conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 || output[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
+
+func FindVerifiedAccessInstances(conn *ec2.EC2, input *ec2.DescribeVerifiedAccessInstancesInput) ([]*ec2.VerifiedAccessInstance, error) {
+	var output []*ec2.VerifiedAccessInstance
+
+	err := conn.DescribeVerifiedAccessInstancesPages(input, func(page *ec2.DescribeVerifiedAccessInstancesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.VerifiedAccessInstances {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidVerifiedAccessInstanceIdNotFound) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func FindVerifiedAccessInstanceByID(conn *ec2.EC2, id string) (*ec2.VerifiedAccessInstance, error) {
+	input := &ec2.DescribeVerifiedAccessInstancesInput{
+		VerifiedAccessInstanceIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := FindVerifiedAccessInstance(conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Eventual consistency check.
+	if aws.StringValue(output.VerifiedAccessInstanceId) != id {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func FindVerifiedAccessTrustProvider(conn *ec2.EC2, input *ec2.DescribeVerifiedAccessTrustProvidersInput) (*ec2.VerifiedAccessTrustProvider, error) {
+	output, err := FindVerifiedAccessTrustProviders(conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 || output[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
+
+func FindVerifiedAccessTrustProviders(conn *ec2.EC2, input *ec2.DescribeVerifiedAccessTrustProvidersInput) ([]*ec2.VerifiedAccessTrustProvider, error) {
+	var output []*ec2.VerifiedAccessTrustProvider
+
+	err := conn.DescribeVerifiedAccessTrustProvidersPages(input, func(page *ec2.DescribeVerifiedAccessTrustProvidersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.VerifiedAccessTrustProviders {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidVerifiedAccessTrustProviderIdNotFound) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func FindVerifiedAccessTrustProviderByID(conn *ec2.EC2, id string) (*ec2.VerifiedAccessTrustProvider, error) {
+	input := &ec2.DescribeVerifiedAccessTrustProvidersInput{
+		VerifiedAccessTrustProviderIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := FindVerifiedAccessTrustProvider(conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Eventual consistency check.
+	if aws.StringValue(output.VerifiedAccessTrustProviderId) != id {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func FindVerifiedAccessInstanceLoggingConfigurationByInstanceID(conn *ec2.EC2, id string) (*ec2.VerifiedAccessInstanceLoggingConfiguration, error) {
+	input := &ec2.DescribeVerifiedAccessInstanceLoggingConfigurationsInput{
+		VerifiedAccessInstanceIds: aws.StringSlice([]string{id}),
+	}
+
+	var output []*ec2.VerifiedAccessInstanceLoggingConfiguration
+
+	err := conn.DescribeVerifiedAccessInstanceLoggingConfigurationsPages(input, func(page *ec2.DescribeVerifiedAccessInstanceLoggingConfigurationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.LoggingConfigurations {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidVerifiedAccessInstanceIdNotFound) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 || output[0] == nil || output[0].AccessLogs == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
+
+func FindVerifiedAccessInstanceTrustProviderAttachmentExists(conn *ec2.EC2, instanceID, trustProviderID string) error {
+	output, err := FindVerifiedAccessInstanceByID(conn, instanceID)
+
+	if err != nil {
+		return err
+	}
+
+	for _, v := range output.VerifiedAccessTrustProviders {
+		if aws.StringValue(v.VerifiedAccessTrustProviderId) == trustProviderID {
+			return nil
+		}
+	}
+
+	return &resource.NotFoundError{
+		LastError: fmt.Errorf("Verified Access Instance (%s) Trust Provider (%s) attachment not found", instanceID, trustProviderID),
+	}
+}
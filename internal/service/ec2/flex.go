@@ -180,3 +180,39 @@ func FlattenSecurityGroups(list []*ec2.UserIdGroupPair, ownerId *string) []*Grou
 	}
 	return result
 }
+
+func expandVerifiedAccessSseSpecificationRequest(tfMap map[string]interface{}) *ec2.VerifiedAccessSseSpecificationRequest {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ec2.VerifiedAccessSseSpecificationRequest{}
+
+	if v, ok := tfMap["customer_managed_key_enabled"].(bool); ok {
+		apiObject.CustomerManagedKeyEnabled = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["kms_key_arn"].(string); ok && v != "" {
+		apiObject.KmsKeyArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenVerifiedAccessSseSpecificationResponse(apiObject *ec2.VerifiedAccessSseSpecificationResponse) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.CustomerManagedKeyEnabled; v != nil {
+		tfMap["customer_managed_key_enabled"] = aws.BoolValue(v)
+	}
+
+	if v := apiObject.KmsKeyArn; v != nil {
+		tfMap["kms_key_arn"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
@@ -1,6 +1,7 @@
 package ec2
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 
@@ -44,6 +45,22 @@ func StatusCapacityReservationState(conn *ec2.EC2, id string) resource.StateRefr
 	}
 }
 
+func StatusCapacityReservationFleetState(ctx context.Context, conn *ec2.EC2, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindCapacityReservationFleetByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
+
 const (
 	carrierGatewayStateNotFound = "NotFound"
 	carrierGatewayStateUnknown  = "Unknown"
@@ -1205,3 +1222,23 @@ func StatusSnapshotStorageTier(conn *ec2.EC2, id string) resource.StateRefreshFu
 		return output, aws.StringValue(output.StorageTier), nil
 	}
 }
+
+func StatusVerifiedAccessEndpoint(conn *ec2.EC2, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindVerifiedAccessEndpointByID(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output.Status == nil {
+			return output, "", nil
+		}
+
+		return output, aws.StringValue(output.Status.Code), nil
+	}
+}
@@ -0,0 +1,191 @@
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccVerifiedAccessEndpoint_networkInterface(t *testing.T) {
+	var v ec2.VerifiedAccessEndpoint
+	resourceName := "aws_verifiedaccess_endpoint.test"
+	groupResourceName := "aws_verifiedaccess_group.test"
+	networkInterfaceResourceName := "aws_network_interface.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	policyReferenceName := sdkacctest.RandStringFromCharSet(5, sdkacctest.CharSetAlpha)
+	key := acctest.TLSRSAPrivateKeyPEM(2048)
+	certificate := acctest.TLSRSAX509SelfSignedCertificatePEM(key, "example.com")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckVerifiedAccess(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVerifiedAccessEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVerifiedAccessEndpointConfig_networkInterface(rName, policyReferenceName, acctest.TLSPEMEscapeNewlines(key), acctest.TLSPEMEscapeNewlines(certificate), "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVerifiedAccessEndpointExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "application_domain", "example.com"),
+					resource.TestCheckResourceAttr(resourceName, "attachment_type", ec2.VerifiedAccessEndpointAttachmentTypeVpc),
+					resource.TestCheckResourceAttr(resourceName, "description", "first"),
+					resource.TestCheckResourceAttrSet(resourceName, "endpoint_domain"),
+					resource.TestCheckResourceAttr(resourceName, "endpoint_type", ec2.VerifiedAccessEndpointTypeNetworkInterface),
+					resource.TestCheckResourceAttr(resourceName, "network_interface_options.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "network_interface_options.0.network_interface_id", networkInterfaceResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "network_interface_options.0.port", "443"),
+					resource.TestCheckResourceAttr(resourceName, "network_interface_options.0.protocol", ec2.VerifiedAccessEndpointProtocolHttps),
+					resource.TestCheckResourceAttr(resourceName, "security_group_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "verified_access_group_id", groupResourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "verified_access_instance_id"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"endpoint_domain_prefix"},
+			},
+			{
+				Config: testAccVerifiedAccessEndpointConfig_networkInterface(rName, policyReferenceName, acctest.TLSPEMEscapeNewlines(key), acctest.TLSPEMEscapeNewlines(certificate), "second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVerifiedAccessEndpointExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "second"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVerifiedAccessEndpoint_disappears(t *testing.T) {
+	var v ec2.VerifiedAccessEndpoint
+	resourceName := "aws_verifiedaccess_endpoint.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	policyReferenceName := sdkacctest.RandStringFromCharSet(5, sdkacctest.CharSetAlpha)
+	key := acctest.TLSRSAPrivateKeyPEM(2048)
+	certificate := acctest.TLSRSAX509SelfSignedCertificatePEM(key, "example.com")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckVerifiedAccess(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVerifiedAccessEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVerifiedAccessEndpointConfig_networkInterface(rName, policyReferenceName, acctest.TLSPEMEscapeNewlines(key), acctest.TLSPEMEscapeNewlines(certificate), "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVerifiedAccessEndpointExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfec2.ResourceVerifiedAccessEndpoint(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckVerifiedAccessEndpointExists(n string, v *ec2.VerifiedAccessEndpoint) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Verified Access Endpoint ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+		output, err := tfec2.FindVerifiedAccessEndpointByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckVerifiedAccessEndpointDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_verifiedaccess_endpoint" {
+			continue
+		}
+
+		_, err := tfec2.FindVerifiedAccessEndpointByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Verified Access Endpoint %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccVerifiedAccessEndpointConfig_networkInterface(rName, policyReferenceName, key, certificate, description string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigVPCWithSubnets(rName, 1),
+		testAccVerifiedAccessGroupConfig_basic(policyReferenceName),
+		fmt.Sprintf(`
+resource "aws_security_group" "test" {
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_network_interface" "test" {
+  subnet_id = aws_subnet.test[0].id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_acm_certificate" "test" {
+  certificate_body = "%[2]s"
+  private_key      = "%[3]s"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_verifiedaccess_endpoint" "test" {
+  application_domain       = "example.com"
+  attachment_type          = "vpc"
+  description              = %[4]q
+  domain_certificate_arn   = aws_acm_certificate.test.arn
+  endpoint_domain_prefix   = "example"
+  endpoint_type            = "network-interface"
+  security_group_ids       = [aws_security_group.test.id]
+  verified_access_group_id = aws_verifiedaccess_group.test.id
+
+  network_interface_options {
+    network_interface_id = aws_network_interface.test.id
+    port                 = 443
+    protocol             = "https"
+  }
+}
+`, rName, certificate, key, description))
+}
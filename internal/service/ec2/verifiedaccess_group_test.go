@@ -0,0 +1,178 @@
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccVerifiedAccessGroup_basic(t *testing.T) {
+	var v ec2.VerifiedAccessGroup
+	resourceName := "aws_verifiedaccess_group.test"
+	instanceResourceName := "aws_verifiedaccess_instance.test"
+	policyReferenceName := sdkacctest.RandStringFromCharSet(5, sdkacctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckVerifiedAccess(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVerifiedAccessGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVerifiedAccessGroupConfig_basic(policyReferenceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVerifiedAccessGroupExists(resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_time"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "owner"),
+					resource.TestCheckResourceAttr(resourceName, "policy_document", ""),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "verified_access_group_arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "verified_access_instance_id", instanceResourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccVerifiedAccessGroup_policy(t *testing.T) {
+	var v ec2.VerifiedAccessGroup
+	resourceName := "aws_verifiedaccess_group.test"
+	policyReferenceName := sdkacctest.RandStringFromCharSet(5, sdkacctest.CharSetAlpha)
+	policyDocument1 := "permit(principal, action, resource) \nwhen {\ncontext.http_request.method == \"GET\"\n};"
+	policyDocument2 := "permit(principal, action, resource) \nwhen {\ncontext.http_request.method == \"POST\"\n};"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckVerifiedAccess(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVerifiedAccessGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVerifiedAccessGroupConfig_policy(policyReferenceName, policyDocument1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVerifiedAccessGroupExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "policy_document", policyDocument1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccVerifiedAccessGroupConfig_policy(policyReferenceName, policyDocument2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVerifiedAccessGroupExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "policy_document", policyDocument2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVerifiedAccessGroup_disappears(t *testing.T) {
+	var v ec2.VerifiedAccessGroup
+	resourceName := "aws_verifiedaccess_group.test"
+	policyReferenceName := sdkacctest.RandStringFromCharSet(5, sdkacctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckVerifiedAccess(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVerifiedAccessGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVerifiedAccessGroupConfig_basic(policyReferenceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVerifiedAccessGroupExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfec2.ResourceVerifiedAccessGroup(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckVerifiedAccessGroupExists(n string, v *ec2.VerifiedAccessGroup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Verified Access Group ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+		output, err := tfec2.FindVerifiedAccessGroupByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckVerifiedAccessGroupDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_verifiedaccess_group" {
+			continue
+		}
+
+		_, err := tfec2.FindVerifiedAccessGroupByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Verified Access Group %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccVerifiedAccessGroupConfig_base(policyReferenceName string) string {
+	return testAccVerifiedAccessInstanceTrustProviderAttachmentConfig_basic(policyReferenceName)
+}
+
+func testAccVerifiedAccessGroupConfig_basic(policyReferenceName string) string {
+	return acctest.ConfigCompose(testAccVerifiedAccessGroupConfig_base(policyReferenceName), `
+resource "aws_verifiedaccess_group" "test" {
+  verified_access_instance_id = aws_verifiedaccess_instance_trust_provider_attachment.test.verified_access_instance_id
+}
+`)
+}
+
+func testAccVerifiedAccessGroupConfig_policy(policyReferenceName, policyDocument string) string {
+	return acctest.ConfigCompose(testAccVerifiedAccessGroupConfig_base(policyReferenceName), fmt.Sprintf(`
+resource "aws_verifiedaccess_group" "test" {
+  verified_access_instance_id = aws_verifiedaccess_instance_trust_provider_attachment.test.verified_access_instance_id
+  policy_document             = %[1]q
+}
+`, policyDocument))
+}
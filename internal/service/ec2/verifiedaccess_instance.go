@@ -0,0 +1,221 @@
+package ec2
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceVerifiedAccessInstance() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceVerifiedAccessInstanceCreate,
+		ReadWithoutTimeout:   resourceVerifiedAccessInstanceRead,
+		UpdateWithoutTimeout: resourceVerifiedAccessInstanceUpdate,
+		DeleteWithoutTimeout: resourceVerifiedAccessInstanceDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"creation_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"fips_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"last_updated_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"verified_access_trust_providers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"device_trust_provider_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"trust_provider_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"user_trust_provider_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"verified_access_trust_provider_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceVerifiedAccessInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &ec2.CreateVerifiedAccessInstanceInput{
+		TagSpecifications: tagSpecificationsFromKeyValueTags(tags, ec2.ResourceTypeVerifiedAccessInstance),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("fips_enabled"); ok {
+		input.FIPSEnabled = aws.Bool(v.(bool))
+	}
+
+	log.Printf("[DEBUG] Creating Verified Access Instance: %s", input)
+	output, err := conn.CreateVerifiedAccessInstanceWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Verified Access Instance: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.VerifiedAccessInstance.VerifiedAccessInstanceId))
+
+	return resourceVerifiedAccessInstanceRead(ctx, d, meta)
+}
+
+func resourceVerifiedAccessInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	instance, err := FindVerifiedAccessInstanceByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Verified Access Instance %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Verified Access Instance (%s): %s", d.Id(), err)
+	}
+
+	d.Set("creation_time", instance.CreationTime)
+	d.Set("description", instance.Description)
+	d.Set("fips_enabled", instance.FipsEnabled)
+	d.Set("last_updated_time", instance.LastUpdatedTime)
+
+	if err := d.Set("verified_access_trust_providers", flattenVerifiedAccessTrustProvidersCondensed(instance.VerifiedAccessTrustProviders)); err != nil {
+		return diag.Errorf("error setting verified_access_trust_providers: %s", err)
+	}
+
+	tags := KeyValueTags(instance.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceVerifiedAccessInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	if d.HasChange("description") {
+		input := &ec2.ModifyVerifiedAccessInstanceInput{
+			Description:              aws.String(d.Get("description").(string)),
+			VerifiedAccessInstanceId: aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating Verified Access Instance: %s", input)
+		_, err := conn.ModifyVerifiedAccessInstanceWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating Verified Access Instance (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
+			return diag.Errorf("error updating Verified Access Instance (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceVerifiedAccessInstanceRead(ctx, d, meta)
+}
+
+func resourceVerifiedAccessInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	log.Printf("[DEBUG] Deleting Verified Access Instance: %s", d.Id())
+	_, err := conn.DeleteVerifiedAccessInstanceWithContext(ctx, &ec2.DeleteVerifiedAccessInstanceInput{
+		VerifiedAccessInstanceId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidVerifiedAccessInstanceIdNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Verified Access Instance (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func flattenVerifiedAccessTrustProvidersCondensed(apiObjects []*ec2.VerifiedAccessTrustProviderCondensed) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"description":                       aws.StringValue(apiObject.Description),
+			"device_trust_provider_type":        aws.StringValue(apiObject.DeviceTrustProviderType),
+			"trust_provider_type":               aws.StringValue(apiObject.TrustProviderType),
+			"user_trust_provider_type":          aws.StringValue(apiObject.UserTrustProviderType),
+			"verified_access_trust_provider_id": aws.StringValue(apiObject.VerifiedAccessTrustProviderId),
+		})
+	}
+
+	return tfList
+}
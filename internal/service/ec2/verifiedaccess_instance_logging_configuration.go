@@ -0,0 +1,326 @@
+package ec2
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceVerifiedAccessInstanceLoggingConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceVerifiedAccessInstanceLoggingConfigurationCreate,
+		ReadWithoutTimeout:   resourceVerifiedAccessInstanceLoggingConfigurationRead,
+		UpdateWithoutTimeout: resourceVerifiedAccessInstanceLoggingConfigurationUpdate,
+		DeleteWithoutTimeout: resourceVerifiedAccessInstanceLoggingConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"access_logs": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cloudwatch_logs": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enabled": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+									"log_group": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"include_trust_context": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"kinesis_data_firehose": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"delivery_stream": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"enabled": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+								},
+							},
+						},
+						"log_version": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"ocsf-0.1",
+								"ocsf-1.0.0-rc.2",
+							}, false),
+						},
+						"s3": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bucket_name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"bucket_owner": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: verify.ValidAccountID,
+									},
+									"enabled": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+									"prefix": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"verified_access_instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceVerifiedAccessInstanceLoggingConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	instanceID := d.Get("verified_access_instance_id").(string)
+	input := &ec2.ModifyVerifiedAccessInstanceLoggingConfigurationInput{
+		AccessLogs:               expandVerifiedAccessLogOptions(d.Get("access_logs").([]interface{})[0].(map[string]interface{})),
+		VerifiedAccessInstanceId: aws.String(instanceID),
+	}
+
+	log.Printf("[DEBUG] Creating Verified Access Instance Logging Configuration: %s", input)
+	_, err := conn.ModifyVerifiedAccessInstanceLoggingConfigurationWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Verified Access Instance (%s) Logging Configuration: %s", instanceID, err)
+	}
+
+	d.SetId(instanceID)
+
+	return resourceVerifiedAccessInstanceLoggingConfigurationRead(ctx, d, meta)
+}
+
+func resourceVerifiedAccessInstanceLoggingConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	output, err := FindVerifiedAccessInstanceLoggingConfigurationByInstanceID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Verified Access Instance Logging Configuration %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Verified Access Instance (%s) Logging Configuration: %s", d.Id(), err)
+	}
+
+	if err := d.Set("access_logs", []interface{}{flattenVerifiedAccessLogs(output.AccessLogs)}); err != nil {
+		return diag.Errorf("error setting access_logs: %s", err)
+	}
+
+	d.Set("verified_access_instance_id", output.VerifiedAccessInstanceId)
+
+	return nil
+}
+
+func resourceVerifiedAccessInstanceLoggingConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	if d.HasChange("access_logs") {
+		input := &ec2.ModifyVerifiedAccessInstanceLoggingConfigurationInput{
+			AccessLogs:               expandVerifiedAccessLogOptions(d.Get("access_logs").([]interface{})[0].(map[string]interface{})),
+			VerifiedAccessInstanceId: aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating Verified Access Instance Logging Configuration: %s", input)
+		_, err := conn.ModifyVerifiedAccessInstanceLoggingConfigurationWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating Verified Access Instance (%s) Logging Configuration: %s", d.Id(), err)
+		}
+	}
+
+	return resourceVerifiedAccessInstanceLoggingConfigurationRead(ctx, d, meta)
+}
+
+func resourceVerifiedAccessInstanceLoggingConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	// There is no API to delete the logging configuration; disable every destination instead.
+	input := &ec2.ModifyVerifiedAccessInstanceLoggingConfigurationInput{
+		AccessLogs: &ec2.VerifiedAccessLogOptions{
+			CloudWatchLogs: &ec2.VerifiedAccessLogCloudWatchLogsDestinationOptions{
+				Enabled: aws.Bool(false),
+			},
+			IncludeTrustContext: aws.Bool(false),
+			KinesisDataFirehose: &ec2.VerifiedAccessLogKinesisDataFirehoseDestinationOptions{
+				Enabled: aws.Bool(false),
+			},
+			S3: &ec2.VerifiedAccessLogS3DestinationOptions{
+				Enabled: aws.Bool(false),
+			},
+		},
+		VerifiedAccessInstanceId: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting Verified Access Instance Logging Configuration: %s", d.Id())
+	_, err := conn.ModifyVerifiedAccessInstanceLoggingConfigurationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidVerifiedAccessInstanceIdNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Verified Access Instance (%s) Logging Configuration: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandVerifiedAccessLogOptions(tfMap map[string]interface{}) *ec2.VerifiedAccessLogOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	// Destinations that are not configured are explicitly disabled so that removing a block turns logging off.
+	apiObject := &ec2.VerifiedAccessLogOptions{
+		CloudWatchLogs: &ec2.VerifiedAccessLogCloudWatchLogsDestinationOptions{
+			Enabled: aws.Bool(false),
+		},
+		KinesisDataFirehose: &ec2.VerifiedAccessLogKinesisDataFirehoseDestinationOptions{
+			Enabled: aws.Bool(false),
+		},
+		S3: &ec2.VerifiedAccessLogS3DestinationOptions{
+			Enabled: aws.Bool(false),
+		},
+	}
+
+	if v, ok := tfMap["cloudwatch_logs"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.CloudWatchLogs = &ec2.VerifiedAccessLogCloudWatchLogsDestinationOptions{
+			Enabled: aws.Bool(tfMap["enabled"].(bool)),
+		}
+
+		if v, ok := tfMap["log_group"].(string); ok && v != "" {
+			apiObject.CloudWatchLogs.LogGroup = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["include_trust_context"].(bool); ok {
+		apiObject.IncludeTrustContext = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["kinesis_data_firehose"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.KinesisDataFirehose = &ec2.VerifiedAccessLogKinesisDataFirehoseDestinationOptions{
+			Enabled: aws.Bool(tfMap["enabled"].(bool)),
+		}
+
+		if v, ok := tfMap["delivery_stream"].(string); ok && v != "" {
+			apiObject.KinesisDataFirehose.DeliveryStream = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["log_version"].(string); ok && v != "" {
+		apiObject.LogVersion = aws.String(v)
+	}
+
+	if v, ok := tfMap["s3"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.S3 = &ec2.VerifiedAccessLogS3DestinationOptions{
+			Enabled: aws.Bool(tfMap["enabled"].(bool)),
+		}
+
+		if v, ok := tfMap["bucket_name"].(string); ok && v != "" {
+			apiObject.S3.BucketName = aws.String(v)
+		}
+
+		if v, ok := tfMap["bucket_owner"].(string); ok && v != "" {
+			apiObject.S3.BucketOwner = aws.String(v)
+		}
+
+		if v, ok := tfMap["prefix"].(string); ok && v != "" {
+			apiObject.S3.Prefix = aws.String(v)
+		}
+	}
+
+	return apiObject
+}
+
+func flattenVerifiedAccessLogs(apiObject *ec2.VerifiedAccessLogs) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"include_trust_context": aws.BoolValue(apiObject.IncludeTrustContext),
+		"log_version":           aws.StringValue(apiObject.LogVersion),
+	}
+
+	if v := apiObject.CloudWatchLogs; v != nil && aws.BoolValue(v.Enabled) {
+		tfMap["cloudwatch_logs"] = []interface{}{map[string]interface{}{
+			"enabled":   aws.BoolValue(v.Enabled),
+			"log_group": aws.StringValue(v.LogGroup),
+		}}
+	}
+
+	if v := apiObject.KinesisDataFirehose; v != nil && aws.BoolValue(v.Enabled) {
+		tfMap["kinesis_data_firehose"] = []interface{}{map[string]interface{}{
+			"delivery_stream": aws.StringValue(v.DeliveryStream),
+			"enabled":         aws.BoolValue(v.Enabled),
+		}}
+	}
+
+	if v := apiObject.S3; v != nil && aws.BoolValue(v.Enabled) {
+		tfMap["s3"] = []interface{}{map[string]interface{}{
+			"bucket_name":  aws.StringValue(v.BucketName),
+			"bucket_owner": aws.StringValue(v.BucketOwner),
+			"enabled":      aws.BoolValue(v.Enabled),
+			"prefix":       aws.StringValue(v.Prefix),
+		}}
+	}
+
+	return tfMap
+}
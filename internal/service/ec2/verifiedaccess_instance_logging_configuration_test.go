@@ -0,0 +1,129 @@
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+)
+
+func TestAccVerifiedAccessInstanceLoggingConfiguration_basic(t *testing.T) {
+	resourceName := "aws_verifiedaccess_instance_logging_configuration.test"
+	instanceResourceName := "aws_verifiedaccess_instance.test"
+	logGroupResourceName := "aws_cloudwatch_log_group.test"
+	bucketResourceName := "aws_s3_bucket.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckVerifiedAccess(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVerifiedAccessInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVerifiedAccessInstanceLoggingConfigurationConfig_cloudWatchLogs(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVerifiedAccessInstanceLoggingConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "access_logs.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "access_logs.0.cloudwatch_logs.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "access_logs.0.cloudwatch_logs.0.enabled", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "access_logs.0.cloudwatch_logs.0.log_group", logGroupResourceName, "name"),
+					resource.TestCheckResourceAttr(resourceName, "access_logs.0.s3.#", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "verified_access_instance_id", instanceResourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccVerifiedAccessInstanceLoggingConfigurationConfig_s3(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVerifiedAccessInstanceLoggingConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "access_logs.0.cloudwatch_logs.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "access_logs.0.include_trust_context", "true"),
+					resource.TestCheckResourceAttr(resourceName, "access_logs.0.s3.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "access_logs.0.s3.0.bucket_name", bucketResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "access_logs.0.s3.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "access_logs.0.s3.0.prefix", "logs/"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckVerifiedAccessInstanceLoggingConfigurationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Verified Access Instance Logging Configuration ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+		_, err := tfec2.FindVerifiedAccessInstanceLoggingConfigurationByInstanceID(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccVerifiedAccessInstanceLoggingConfigurationConfig_cloudWatchLogs(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_verifiedaccess_instance" "test" {}
+
+resource "aws_cloudwatch_log_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_verifiedaccess_instance_logging_configuration" "test" {
+  verified_access_instance_id = aws_verifiedaccess_instance.test.id
+
+  access_logs {
+    cloudwatch_logs {
+      enabled   = true
+      log_group = aws_cloudwatch_log_group.test.name
+    }
+  }
+}
+`, rName)
+}
+
+func testAccVerifiedAccessInstanceLoggingConfigurationConfig_s3(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_verifiedaccess_instance" "test" {}
+
+resource "aws_cloudwatch_log_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_verifiedaccess_instance_logging_configuration" "test" {
+  verified_access_instance_id = aws_verifiedaccess_instance.test.id
+
+  access_logs {
+    include_trust_context = true
+
+    s3 {
+      enabled     = true
+      bucket_name = aws_s3_bucket.test.id
+      prefix      = "logs/"
+    }
+  }
+}
+`, rName)
+}
@@ -0,0 +1,490 @@
+package ec2
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceVerifiedAccessTrustProvider() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceVerifiedAccessTrustProviderCreate,
+		ReadWithoutTimeout:   resourceVerifiedAccessTrustProviderRead,
+		UpdateWithoutTimeout: resourceVerifiedAccessTrustProviderUpdate,
+		DeleteWithoutTimeout: resourceVerifiedAccessTrustProviderDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"device_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"public_signing_key_url": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsURLWithHTTPS,
+						},
+						"tenant_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"device_trust_provider_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(ec2.DeviceTrustProviderType_Values(), false),
+			},
+			"oidc_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"authorization_endpoint": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsURLWithHTTPS,
+						},
+						"client_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"client_secret": {
+							Type:      schema.TypeString,
+							Required:  true,
+							Sensitive: true,
+						},
+						"issuer": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsURLWithHTTPS,
+						},
+						"scope": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"token_endpoint": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsURLWithHTTPS,
+						},
+						"user_info_endpoint": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsURLWithHTTPS,
+						},
+					},
+				},
+			},
+			"policy_reference_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"sse_specification": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"customer_managed_key_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"kms_key_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"trust_provider_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(ec2.TrustProviderType_Values(), false),
+			},
+			"user_trust_provider_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(ec2.UserTrustProviderType_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceVerifiedAccessTrustProviderCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &ec2.CreateVerifiedAccessTrustProviderInput{
+		PolicyReferenceName: aws.String(d.Get("policy_reference_name").(string)),
+		TagSpecifications:   tagSpecificationsFromKeyValueTags(tags, ec2.ResourceTypeVerifiedAccessTrustProvider),
+		TrustProviderType:   aws.String(d.Get("trust_provider_type").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("device_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.DeviceOptions = expandCreateVerifiedAccessTrustProviderDeviceOptions(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("device_trust_provider_type"); ok {
+		input.DeviceTrustProviderType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("oidc_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.OidcOptions = expandCreateVerifiedAccessTrustProviderOidcOptions(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("sse_specification"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.SseSpecification = expandVerifiedAccessSseSpecificationRequest(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("user_trust_provider_type"); ok {
+		input.UserTrustProviderType = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Verified Access Trust Provider: %s", input)
+	output, err := conn.CreateVerifiedAccessTrustProviderWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Verified Access Trust Provider: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.VerifiedAccessTrustProvider.VerifiedAccessTrustProviderId))
+
+	return resourceVerifiedAccessTrustProviderRead(ctx, d, meta)
+}
+
+func resourceVerifiedAccessTrustProviderRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	trustProvider, err := FindVerifiedAccessTrustProviderByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Verified Access Trust Provider %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Verified Access Trust Provider (%s): %s", d.Id(), err)
+	}
+
+	d.Set("description", trustProvider.Description)
+
+	if v := trustProvider.DeviceOptions; v != nil {
+		if err := d.Set("device_options", []interface{}{flattenDeviceOptions(v)}); err != nil {
+			return diag.Errorf("error setting device_options: %s", err)
+		}
+	} else {
+		d.Set("device_options", nil)
+	}
+
+	d.Set("device_trust_provider_type", trustProvider.DeviceTrustProviderType)
+
+	if v := trustProvider.OidcOptions; v != nil {
+		tfMap := flattenOIDCOptions(v)
+
+		// The client secret is not returned by the API.
+		if v, ok := d.GetOk("oidc_options.0.client_secret"); ok {
+			tfMap["client_secret"] = v.(string)
+		}
+
+		if err := d.Set("oidc_options", []interface{}{tfMap}); err != nil {
+			return diag.Errorf("error setting oidc_options: %s", err)
+		}
+	} else {
+		d.Set("oidc_options", nil)
+	}
+
+	d.Set("policy_reference_name", trustProvider.PolicyReferenceName)
+
+	if v := trustProvider.SseSpecification; v != nil {
+		if err := d.Set("sse_specification", []interface{}{flattenVerifiedAccessSseSpecificationResponse(v)}); err != nil {
+			return diag.Errorf("error setting sse_specification: %s", err)
+		}
+	} else {
+		d.Set("sse_specification", nil)
+	}
+
+	d.Set("trust_provider_type", trustProvider.TrustProviderType)
+	d.Set("user_trust_provider_type", trustProvider.UserTrustProviderType)
+
+	tags := KeyValueTags(trustProvider.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceVerifiedAccessTrustProviderUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &ec2.ModifyVerifiedAccessTrustProviderInput{
+			VerifiedAccessTrustProviderId: aws.String(d.Id()),
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("device_options") {
+			if v, ok := d.GetOk("device_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.DeviceOptions = expandModifyVerifiedAccessTrustProviderDeviceOptions(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("oidc_options") {
+			if v, ok := d.GetOk("oidc_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.OidcOptions = expandModifyVerifiedAccessTrustProviderOidcOptions(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("sse_specification") {
+			if v, ok := d.GetOk("sse_specification"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.SseSpecification = expandVerifiedAccessSseSpecificationRequest(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		log.Printf("[DEBUG] Updating Verified Access Trust Provider: %s", input)
+		_, err := conn.ModifyVerifiedAccessTrustProviderWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating Verified Access Trust Provider (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
+			return diag.Errorf("error updating Verified Access Trust Provider (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceVerifiedAccessTrustProviderRead(ctx, d, meta)
+}
+
+func resourceVerifiedAccessTrustProviderDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	log.Printf("[DEBUG] Deleting Verified Access Trust Provider: %s", d.Id())
+	_, err := conn.DeleteVerifiedAccessTrustProviderWithContext(ctx, &ec2.DeleteVerifiedAccessTrustProviderInput{
+		VerifiedAccessTrustProviderId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidVerifiedAccessTrustProviderIdNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Verified Access Trust Provider (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandCreateVerifiedAccessTrustProviderDeviceOptions(tfMap map[string]interface{}) *ec2.CreateVerifiedAccessTrustProviderDeviceOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ec2.CreateVerifiedAccessTrustProviderDeviceOptions{}
+
+	if v, ok := tfMap["public_signing_key_url"].(string); ok && v != "" {
+		apiObject.PublicSigningKeyUrl = aws.String(v)
+	}
+
+	if v, ok := tfMap["tenant_id"].(string); ok && v != "" {
+		apiObject.TenantId = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandModifyVerifiedAccessTrustProviderDeviceOptions(tfMap map[string]interface{}) *ec2.ModifyVerifiedAccessTrustProviderDeviceOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ec2.ModifyVerifiedAccessTrustProviderDeviceOptions{}
+
+	if v, ok := tfMap["public_signing_key_url"].(string); ok && v != "" {
+		apiObject.PublicSigningKeyUrl = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandCreateVerifiedAccessTrustProviderOidcOptions(tfMap map[string]interface{}) *ec2.CreateVerifiedAccessTrustProviderOidcOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ec2.CreateVerifiedAccessTrustProviderOidcOptions{}
+
+	if v, ok := tfMap["authorization_endpoint"].(string); ok && v != "" {
+		apiObject.AuthorizationEndpoint = aws.String(v)
+	}
+
+	if v, ok := tfMap["client_id"].(string); ok && v != "" {
+		apiObject.ClientId = aws.String(v)
+	}
+
+	if v, ok := tfMap["client_secret"].(string); ok && v != "" {
+		apiObject.ClientSecret = aws.String(v)
+	}
+
+	if v, ok := tfMap["issuer"].(string); ok && v != "" {
+		apiObject.Issuer = aws.String(v)
+	}
+
+	if v, ok := tfMap["scope"].(string); ok && v != "" {
+		apiObject.Scope = aws.String(v)
+	}
+
+	if v, ok := tfMap["token_endpoint"].(string); ok && v != "" {
+		apiObject.TokenEndpoint = aws.String(v)
+	}
+
+	if v, ok := tfMap["user_info_endpoint"].(string); ok && v != "" {
+		apiObject.UserInfoEndpoint = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandModifyVerifiedAccessTrustProviderOidcOptions(tfMap map[string]interface{}) *ec2.ModifyVerifiedAccessTrustProviderOidcOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ec2.ModifyVerifiedAccessTrustProviderOidcOptions{}
+
+	if v, ok := tfMap["authorization_endpoint"].(string); ok && v != "" {
+		apiObject.AuthorizationEndpoint = aws.String(v)
+	}
+
+	if v, ok := tfMap["client_id"].(string); ok && v != "" {
+		apiObject.ClientId = aws.String(v)
+	}
+
+	if v, ok := tfMap["client_secret"].(string); ok && v != "" {
+		apiObject.ClientSecret = aws.String(v)
+	}
+
+	if v, ok := tfMap["issuer"].(string); ok && v != "" {
+		apiObject.Issuer = aws.String(v)
+	}
+
+	if v, ok := tfMap["scope"].(string); ok && v != "" {
+		apiObject.Scope = aws.String(v)
+	}
+
+	if v, ok := tfMap["token_endpoint"].(string); ok && v != "" {
+		apiObject.TokenEndpoint = aws.String(v)
+	}
+
+	if v, ok := tfMap["user_info_endpoint"].(string); ok && v != "" {
+		apiObject.UserInfoEndpoint = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenDeviceOptions(apiObject *ec2.DeviceOptions) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.PublicSigningKeyUrl; v != nil {
+		tfMap["public_signing_key_url"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.TenantId; v != nil {
+		tfMap["tenant_id"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenOIDCOptions(apiObject *ec2.OidcOptions) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AuthorizationEndpoint; v != nil {
+		tfMap["authorization_endpoint"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.ClientId; v != nil {
+		tfMap["client_id"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Issuer; v != nil {
+		tfMap["issuer"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Scope; v != nil {
+		tfMap["scope"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.TokenEndpoint; v != nil {
+		tfMap["token_endpoint"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.UserInfoEndpoint; v != nil {
+		tfMap["user_info_endpoint"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
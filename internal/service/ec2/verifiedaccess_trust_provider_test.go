@@ -0,0 +1,192 @@
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccVerifiedAccessTrustProvider_deviceOptions(t *testing.T) {
+	var v ec2.VerifiedAccessTrustProvider
+	resourceName := "aws_verifiedaccess_trust_provider.test"
+	policyReferenceName := sdkacctest.RandStringFromCharSet(5, sdkacctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckVerifiedAccess(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVerifiedAccessTrustProviderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVerifiedAccessTrustProviderConfig_deviceOptions(policyReferenceName, "tenant-1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVerifiedAccessTrustProviderExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "device_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "device_options.0.tenant_id", "tenant-1"),
+					resource.TestCheckResourceAttr(resourceName, "device_trust_provider_type", ec2.DeviceTrustProviderTypeJamf),
+					resource.TestCheckResourceAttr(resourceName, "policy_reference_name", policyReferenceName),
+					resource.TestCheckResourceAttr(resourceName, "trust_provider_type", ec2.TrustProviderTypeDevice),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccVerifiedAccessTrustProvider_oidcOptions(t *testing.T) {
+	var v ec2.VerifiedAccessTrustProvider
+	resourceName := "aws_verifiedaccess_trust_provider.test"
+	policyReferenceName := sdkacctest.RandStringFromCharSet(5, sdkacctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckVerifiedAccess(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVerifiedAccessTrustProviderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVerifiedAccessTrustProviderConfig_oidcOptions(policyReferenceName, "openid"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVerifiedAccessTrustProviderExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "oidc_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "oidc_options.0.issuer", "https://example.com"),
+					resource.TestCheckResourceAttr(resourceName, "oidc_options.0.scope", "openid"),
+					resource.TestCheckResourceAttr(resourceName, "policy_reference_name", policyReferenceName),
+					resource.TestCheckResourceAttr(resourceName, "trust_provider_type", ec2.TrustProviderTypeUser),
+					resource.TestCheckResourceAttr(resourceName, "user_trust_provider_type", ec2.UserTrustProviderTypeOidc),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"oidc_options.0.client_secret"},
+			},
+			{
+				Config: testAccVerifiedAccessTrustProviderConfig_oidcOptions(policyReferenceName, "openid profile"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVerifiedAccessTrustProviderExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "oidc_options.0.scope", "openid profile"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVerifiedAccessTrustProvider_disappears(t *testing.T) {
+	var v ec2.VerifiedAccessTrustProvider
+	resourceName := "aws_verifiedaccess_trust_provider.test"
+	policyReferenceName := sdkacctest.RandStringFromCharSet(5, sdkacctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckVerifiedAccess(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVerifiedAccessTrustProviderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVerifiedAccessTrustProviderConfig_deviceOptions(policyReferenceName, "tenant-1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVerifiedAccessTrustProviderExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfec2.ResourceVerifiedAccessTrustProvider(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckVerifiedAccessTrustProviderExists(n string, v *ec2.VerifiedAccessTrustProvider) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Verified Access Trust Provider ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+		output, err := tfec2.FindVerifiedAccessTrustProviderByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckVerifiedAccessTrustProviderDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_verifiedaccess_trust_provider" {
+			continue
+		}
+
+		_, err := tfec2.FindVerifiedAccessTrustProviderByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Verified Access Trust Provider %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccVerifiedAccessTrustProviderConfig_deviceOptions(policyReferenceName, tenantID string) string {
+	return fmt.Sprintf(`
+resource "aws_verifiedaccess_trust_provider" "test" {
+  policy_reference_name      = %[1]q
+  trust_provider_type        = "device"
+  device_trust_provider_type = "jamf"
+
+  device_options {
+    tenant_id = %[2]q
+  }
+}
+`, policyReferenceName, tenantID)
+}
+
+func testAccVerifiedAccessTrustProviderConfig_oidcOptions(policyReferenceName, scope string) string {
+	return fmt.Sprintf(`
+resource "aws_verifiedaccess_trust_provider" "test" {
+  policy_reference_name    = %[1]q
+  trust_provider_type      = "user"
+  user_trust_provider_type = "oidc"
+
+  oidc_options {
+    authorization_endpoint = "https://example.com/authorization_endpoint"
+    client_id              = "s6BhdRkqt3"
+    client_secret          = "7Fjfp0ZBr1KtDRbnfVdmIw"
+    issuer                 = "https://example.com"
+    scope                  = %[2]q
+    token_endpoint         = "https://example.com/token_endpoint"
+    user_info_endpoint     = "https://example.com/user_info_endpoint"
+  }
+}
+`, policyReferenceName, scope)
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfservicequotas "github.com/hashicorp/terraform-provider-aws/internal/service/servicequotas"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -40,6 +41,12 @@ func ResourceVPC() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			resourceVPCCustomizeDiff,
 			verify.SetTagsDiff,
+			tfservicequotas.Precheck{
+				ServiceCode: "vpc",
+				QuotaCode:   "L-F678F1CE",
+				QuotaName:   "VPCs per Region",
+				Usage:       vpcsInUse,
+			}.CustomizeDiff("aws_vpc"),
 		),
 
 		SchemaVersion: 1,
@@ -747,3 +754,12 @@ func modifyVPCTenancy(conn *ec2.EC2, vpcID string, v string) error {
 
 	return nil
 }
+
+// vpcsInUse returns the number of VPCs counting towards the VPCs per Region quota.
+func vpcsInUse(_ context.Context, meta interface{}) (int, error) {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	vpcs, err := FindVPCs(conn, &ec2.DescribeVpcsInput{})
+
+	return len(vpcs), err
+}
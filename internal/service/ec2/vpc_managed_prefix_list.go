@@ -4,10 +4,14 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strconv"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/servicequotas"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -19,10 +23,10 @@ import (
 
 func ResourceManagedPrefixList() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceManagedPrefixListCreate,
-		Read:   resourceManagedPrefixListRead,
-		Update: resourceManagedPrefixListUpdate,
-		Delete: resourceManagedPrefixListDelete,
+		CreateWithoutTimeout: resourceManagedPrefixListCreate,
+		ReadWithoutTimeout:   resourceManagedPrefixListRead,
+		UpdateWithoutTimeout: resourceManagedPrefixListUpdate,
+		DeleteWithoutTimeout: resourceManagedPrefixListDelete,
 
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -48,6 +52,11 @@ func ResourceManagedPrefixList() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"auto_increase_max_entries": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"entry": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -71,6 +80,17 @@ func ResourceManagedPrefixList() *schema.Resource {
 				Type:         schema.TypeInt,
 				Required:     true,
 				ValidateFunc: validation.IntAtLeast(1),
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					// Keep an automatically increased value for as long as the entries need it.
+					if !d.Get("auto_increase_max_entries").(bool) {
+						return false
+					}
+
+					o, _ := strconv.Atoi(old)
+					n, _ := strconv.Atoi(new)
+
+					return o > n && d.Get("entry").(*schema.Set).Len() > n
+				},
 			},
 			"name": {
 				Type:         schema.TypeString,
@@ -91,7 +111,7 @@ func ResourceManagedPrefixList() *schema.Resource {
 	}
 }
 
-func resourceManagedPrefixListCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceManagedPrefixListCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).EC2Conn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
@@ -110,6 +130,10 @@ func resourceManagedPrefixListCreate(d *schema.ResourceData, meta interface{}) e
 		input.MaxEntries = aws.Int64(int64(v.(int)))
 	}
 
+	if n := int64(len(input.Entries)); d.Get("auto_increase_max_entries").(bool) && n > aws.Int64Value(input.MaxEntries) {
+		input.MaxEntries = aws.Int64(n)
+	}
+
 	if v, ok := d.GetOk("name"); ok {
 		input.PrefixListName = aws.String(v.(string))
 	}
@@ -122,19 +146,19 @@ func resourceManagedPrefixListCreate(d *schema.ResourceData, meta interface{}) e
 	output, err := conn.CreateManagedPrefixList(input)
 
 	if err != nil {
-		return fmt.Errorf("error creating EC2 Managed Prefix List: %w", err)
+		return diag.Errorf("error creating EC2 Managed Prefix List: %s", err)
 	}
 
 	d.SetId(aws.StringValue(output.PrefixList.PrefixListId))
 
 	if _, err := WaitManagedPrefixListCreated(conn, d.Id()); err != nil {
-		return fmt.Errorf("error waiting for EC2 Managed Prefix List (%s) create: %w", d.Id(), err)
+		return diag.Errorf("error waiting for EC2 Managed Prefix List (%s) create: %s", d.Id(), err)
 	}
 
-	return resourceManagedPrefixListRead(d, meta)
+	return resourceManagedPrefixListRead(ctx, d, meta)
 }
 
-func resourceManagedPrefixListRead(d *schema.ResourceData, meta interface{}) error {
+func resourceManagedPrefixListRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).EC2Conn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
@@ -148,20 +172,26 @@ func resourceManagedPrefixListRead(d *schema.ResourceData, meta interface{}) err
 	}
 
 	if err != nil {
-		return fmt.Errorf("error reading EC2 Managed Prefix List (%s): %w", d.Id(), err)
+		return diag.Errorf("error reading EC2 Managed Prefix List (%s): %s", d.Id(), err)
 	}
 
 	prefixListEntries, err := FindManagedPrefixListEntriesByID(conn, d.Id())
 
 	if err != nil {
-		return fmt.Errorf("error reading EC2 Managed Prefix List (%s) Entries: %w", d.Id(), err)
+		return diag.Errorf("error reading EC2 Managed Prefix List (%s) Entries: %s", d.Id(), err)
 	}
 
 	d.Set("address_family", pl.AddressFamily)
 	d.Set("arn", pl.PrefixListArn)
 
+	if v, ok := d.GetOk("auto_increase_max_entries"); ok {
+		d.Set("auto_increase_max_entries", v.(bool))
+	} else {
+		d.Set("auto_increase_max_entries", false)
+	}
+
 	if err := d.Set("entry", flattenPrefixListEntries(prefixListEntries)); err != nil {
-		return fmt.Errorf("error setting entry: %w", err)
+		return diag.Errorf("error setting entry: %s", err)
 	}
 
 	d.Set("max_entries", pl.MaxEntries)
@@ -172,11 +202,11 @@ func resourceManagedPrefixListRead(d *schema.ResourceData, meta interface{}) err
 
 	//lintignore:AWSR002
 	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
-		return fmt.Errorf("error setting tags: %w", err)
+		return diag.Errorf("error setting tags: %s", err)
 	}
 
 	if err := d.Set("tags_all", tags.Map()); err != nil {
-		return fmt.Errorf("error setting tags_all: %w", err)
+		return diag.Errorf("error setting tags_all: %s", err)
 	}
 
 	d.Set("version", pl.Version)
@@ -184,49 +214,73 @@ func resourceManagedPrefixListRead(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
-func resourceManagedPrefixListUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceManagedPrefixListUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Conn
 
-	if d.HasChangesExcept("tags", "tags_all") {
+	if d.HasChange("name") {
 		input := &ec2.ModifyManagedPrefixListInput{
-			PrefixListId: aws.String(d.Id()),
+			PrefixListId:   aws.String(d.Id()),
+			PrefixListName: aws.String(d.Get("name").(string)),
+		}
+
+		_, err := conn.ModifyManagedPrefixList(input)
+
+		if err != nil {
+			return diag.Errorf("error updating EC2 Managed Prefix List (%s): %s", d.Id(), err)
 		}
+	}
 
-		input.PrefixListName = aws.String(d.Get("name").(string))
+	if d.HasChanges("entry", "max_entries", "auto_increase_max_entries") {
 		currentVersion := int64(d.Get("version").(int))
-		wait := false
 
 		oldAttr, newAttr := d.GetChange("entry")
 		os := oldAttr.(*schema.Set)
 		ns := newAttr.(*schema.Set)
 
-		if addEntries := ns.Difference(os); addEntries.Len() > 0 {
-			input.AddEntries = expandAddPrefixListEntries(addEntries.List())
-			input.CurrentVersion = aws.Int64(currentVersion)
-			wait = true
+		o, n := d.GetChange("max_entries")
+		oldMaxEntries, newMaxEntries := int64(o.(int)), int64(n.(int))
+
+		if d.Get("auto_increase_max_entries").(bool) && int64(ns.Len()) > newMaxEntries {
+			newMaxEntries = int64(ns.Len())
 		}
 
-		if removeEntries := os.Difference(ns); removeEntries.Len() > 0 {
-			input.RemoveEntries = expandRemovePrefixListEntries(removeEntries.List())
-			input.CurrentVersion = aws.Int64(currentVersion)
-			wait = true
+		// A prefix list cannot be resized and have its entries modified at the same time.
+		// Grow it before adding entries and shrink it after removing them.
+		if newMaxEntries > oldMaxEntries {
+			diags = append(diags, checkManagedPrefixListReferencesQuota(conn, meta.(*conns.AWSClient).ServiceQuotasConn, d.Id(), meta.(*conns.AWSClient).AccountID, newMaxEntries)...)
+
+			if diags.HasError() {
+				return diags
+			}
+
+			managedPrefixList, err := modifyManagedPrefixListMaxEntries(conn, d.Id(), newMaxEntries)
+
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			currentVersion = aws.Int64Value(managedPrefixList.Version)
 		}
 
+		addEntries := expandAddPrefixListEntries(ns.Difference(os).List())
+		removeEntries := expandRemovePrefixListEntries(os.Difference(ns).List())
+
 		// Prevent the following error on description-only updates:
 		//   InvalidParameterValue: Request cannot contain Cidr #.#.#.#/# in both AddPrefixListEntries and RemovePrefixListEntries
 		// Attempting to just delete the RemoveEntries item causes:
 		//   InvalidRequest: The request received was invalid.
-		// Therefore it seems we must issue two ModifyManagedPrefixList calls,
+		// Therefore it seems we must issue two rounds of ModifyManagedPrefixList calls,
 		// one with a collection of all description-only removals and the
 		// second one will add them all back.
-		if len(input.AddEntries) > 0 && len(input.RemoveEntries) > 0 {
+		if len(addEntries) > 0 && len(removeEntries) > 0 {
 			descriptionOnlyRemovals := []*ec2.RemovePrefixListEntry{}
 			removals := []*ec2.RemovePrefixListEntry{}
 
-			for _, removeEntry := range input.RemoveEntries {
+			for _, removeEntry := range removeEntries {
 				inAddAndRemove := false
 
-				for _, addEntry := range input.AddEntries {
+				for _, addEntry := range addEntries {
 					if aws.StringValue(addEntry.Cidr) == aws.StringValue(removeEntry.Cidr) {
 						inAddAndRemove = true
 						break
@@ -241,52 +295,27 @@ func resourceManagedPrefixListUpdate(d *schema.ResourceData, meta interface{}) e
 			}
 
 			if len(descriptionOnlyRemovals) > 0 {
-				_, err := conn.ModifyManagedPrefixList(&ec2.ModifyManagedPrefixListInput{
-					CurrentVersion: input.CurrentVersion,
-					PrefixListId:   aws.String(d.Id()),
-					RemoveEntries:  descriptionOnlyRemovals,
-				})
+				version, err := modifyManagedPrefixListEntries(conn, d.Id(), currentVersion, nil, descriptionOnlyRemovals)
 
 				if err != nil {
-					return fmt.Errorf("error updating EC2 Managed Prefix List (%s): %w", d.Id(), err)
+					return diag.FromErr(err)
 				}
 
-				managedPrefixList, err := WaitManagedPrefixListModified(conn, d.Id())
-
-				if err != nil {
-					return fmt.Errorf("error waiting for EC2 Managed Prefix List (%s) update: %w", d.Id(), err)
-				}
-
-				if managedPrefixList == nil {
-					return fmt.Errorf("error waiting for EC2 Managed Prefix List (%s) update: empty response", d.Id())
-				}
-
-				input.CurrentVersion = managedPrefixList.Version
+				currentVersion = version
 			}
 
-			if len(removals) > 0 {
-				input.RemoveEntries = removals
-			} else {
-				// Prevent this error if RemoveEntries is list with no elements after removals:
-				//   InvalidRequest: The request received was invalid.
-				input.RemoveEntries = nil
-			}
-		}
-
-		if d.HasChange("max_entries") {
-			input.MaxEntries = aws.Int64(int64(d.Get("max_entries").(int)))
-			wait = true
+			removeEntries = removals
 		}
 
-		_, err := conn.ModifyManagedPrefixList(input)
-
-		if err != nil {
-			return fmt.Errorf("error updating EC2 Managed Prefix List (%s): %w", d.Id(), err)
+		if len(addEntries) > 0 || len(removeEntries) > 0 {
+			if _, err := modifyManagedPrefixListEntries(conn, d.Id(), currentVersion, addEntries, removeEntries); err != nil {
+				return diag.FromErr(err)
+			}
 		}
 
-		if wait {
-			if _, err := WaitManagedPrefixListModified(conn, d.Id()); err != nil {
-				return fmt.Errorf("error waiting for EC2 Managed Prefix List (%s) update: %w", d.Id(), err)
+		if newMaxEntries < oldMaxEntries {
+			if _, err := modifyManagedPrefixListMaxEntries(conn, d.Id(), newMaxEntries); err != nil {
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -294,14 +323,14 @@ func resourceManagedPrefixListUpdate(d *schema.ResourceData, meta interface{}) e
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
-			return fmt.Errorf("error updating EC2 Managed Prefix List (%s) tags: %w", d.Id(), err)
+			return diag.Errorf("error updating EC2 Managed Prefix List (%s) tags: %s", d.Id(), err)
 		}
 	}
 
-	return resourceManagedPrefixListRead(d, meta)
+	return append(diags, resourceManagedPrefixListRead(ctx, d, meta)...)
 }
 
-func resourceManagedPrefixListDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceManagedPrefixListDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).EC2Conn
 
 	log.Printf("[INFO] Deleting EC2 Managed Prefix List: %s", d.Id())
@@ -314,16 +343,213 @@ func resourceManagedPrefixListDelete(d *schema.ResourceData, meta interface{}) e
 	}
 
 	if err != nil {
-		return fmt.Errorf("error deleting EC2 Managed Prefix List (%s): %w", d.Id(), err)
+		return diag.Errorf("error deleting EC2 Managed Prefix List (%s): %s", d.Id(), err)
 	}
 
 	if _, err := WaitManagedPrefixListDeleted(conn, d.Id()); err != nil {
-		return fmt.Errorf("error waiting for EC2 Managed Prefix List (%s) delete: %w", d.Id(), err)
+		return diag.Errorf("error waiting for EC2 Managed Prefix List (%s) delete: %s", d.Id(), err)
 	}
 
 	return nil
 }
 
+// managedPrefixListEntriesBatchSize is the maximum number of entries that can be added or removed in a single request.
+const managedPrefixListEntriesBatchSize = 100
+
+// modifyManagedPrefixListEntries applies the specified entry changes in as few versions as possible.
+// Each batch pairs additions with removals so that the number of entries changes monotonically.
+// The latest version of the prefix list is returned.
+func modifyManagedPrefixListEntries(conn *ec2.EC2, id string, currentVersion int64, addEntries []*ec2.AddPrefixListEntry, removeEntries []*ec2.RemovePrefixListEntry) (int64, error) {
+	for len(addEntries) > 0 || len(removeEntries) > 0 {
+		input := &ec2.ModifyManagedPrefixListInput{
+			CurrentVersion: aws.Int64(currentVersion),
+			PrefixListId:   aws.String(id),
+		}
+
+		if n := len(addEntries); n > 0 {
+			if n > managedPrefixListEntriesBatchSize {
+				n = managedPrefixListEntriesBatchSize
+			}
+
+			input.AddEntries, addEntries = addEntries[:n], addEntries[n:]
+		}
+
+		if n := len(removeEntries); n > 0 {
+			if n > managedPrefixListEntriesBatchSize {
+				n = managedPrefixListEntriesBatchSize
+			}
+
+			input.RemoveEntries, removeEntries = removeEntries[:n], removeEntries[n:]
+		}
+
+		_, err := conn.ModifyManagedPrefixList(input)
+
+		if err != nil {
+			return 0, fmt.Errorf("error updating EC2 Managed Prefix List (%s) entries: %w", id, err)
+		}
+
+		managedPrefixList, err := WaitManagedPrefixListModified(conn, id)
+
+		if err != nil {
+			return 0, fmt.Errorf("error waiting for EC2 Managed Prefix List (%s) update: %w", id, err)
+		}
+
+		if managedPrefixList == nil {
+			return 0, fmt.Errorf("error waiting for EC2 Managed Prefix List (%s) update: empty response", id)
+		}
+
+		currentVersion = aws.Int64Value(managedPrefixList.Version)
+	}
+
+	return currentVersion, nil
+}
+
+func modifyManagedPrefixListMaxEntries(conn *ec2.EC2, id string, maxEntries int64) (*ec2.ManagedPrefixList, error) {
+	input := &ec2.ModifyManagedPrefixListInput{
+		MaxEntries:   aws.Int64(maxEntries),
+		PrefixListId: aws.String(id),
+	}
+
+	_, err := conn.ModifyManagedPrefixList(input)
+
+	if err != nil {
+		return nil, fmt.Errorf("error updating EC2 Managed Prefix List (%s) max entries: %w", id, err)
+	}
+
+	managedPrefixList, err := WaitManagedPrefixListModified(conn, id)
+
+	if err != nil {
+		return nil, fmt.Errorf("error waiting for EC2 Managed Prefix List (%s) update: %w", id, err)
+	}
+
+	if managedPrefixList == nil {
+		return nil, fmt.Errorf("error waiting for EC2 Managed Prefix List (%s) update: empty response", id)
+	}
+
+	return managedPrefixList, nil
+}
+
+const (
+	// Inbound or outbound rules per security group.
+	securityGroupRulesQuotaCode        = "L-0EA8095F"
+	securityGroupRulesQuotaServiceCode = "vpc"
+)
+
+// checkManagedPrefixListReferencesQuota verifies that resizing the prefix list to maxEntries
+// does not push any security group referencing it over the rules per security group quota.
+// A referenced prefix list counts as its maximum number of entries towards that quota.
+// The check is skipped with a warning if the quota cannot be read.
+func checkManagedPrefixListReferencesQuota(conn *ec2.EC2, quotasConn *servicequotas.ServiceQuotas, id, accountID string, maxEntries int64) diag.Diagnostics {
+	associations, err := FindManagedPrefixListAssociationsByID(conn, id)
+
+	if err != nil {
+		return diag.Errorf("error reading EC2 Managed Prefix List (%s) associations: %s", id, err)
+	}
+
+	var quota int64
+	prefixListMaxEntries := map[string]int64{id: maxEntries}
+
+	for _, association := range associations {
+		securityGroupID := aws.StringValue(association.ResourceId)
+
+		// Security groups in other accounts cannot be inspected.
+		if !strings.HasPrefix(securityGroupID, "sg-") || aws.StringValue(association.ResourceOwner) != accountID {
+			continue
+		}
+
+		if quota == 0 {
+			quota, err = findSecurityGroupRulesQuota(quotasConn)
+
+			if err != nil {
+				return diag.Diagnostics{
+					diag.Diagnostic{
+						Severity: diag.Warning,
+						Summary:  "Unable to check the rules per security group quota",
+						Detail:   fmt.Sprintf("EC2 Managed Prefix List (%s) max entries are increased without checking the security groups that reference it: %s", id, err),
+					},
+				}
+			}
+		}
+
+		securityGroup, err := FindSecurityGroupByID(conn, securityGroupID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return diag.Errorf("error reading EC2 Security Group (%s): %s", securityGroupID, err)
+		}
+
+		for _, v := range []struct {
+			direction   string
+			permissions []*ec2.IpPermission
+		}{
+			{"inbound", securityGroup.IpPermissions},
+			{"outbound", securityGroup.IpPermissionsEgress},
+		} {
+			count, err := securityGroupRuleCount(conn, v.permissions, prefixListMaxEntries)
+
+			if err != nil {
+				return diag.Errorf("error counting EC2 Security Group (%s) %s rules: %s", securityGroupID, v.direction, err)
+			}
+
+			if count > quota {
+				return diag.Errorf("increasing EC2 Managed Prefix List (%s) max entries to %d would exceed the quota of %d %s rules for referencing EC2 Security Group (%s)", id, maxEntries, quota, v.direction, securityGroupID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func securityGroupRuleCount(conn *ec2.EC2, permissions []*ec2.IpPermission, prefixListMaxEntries map[string]int64) (int64, error) {
+	var count int64
+
+	for _, permission := range permissions {
+		count += int64(len(permission.IpRanges) + len(permission.Ipv6Ranges) + len(permission.UserIdGroupPairs))
+
+		for _, v := range permission.PrefixListIds {
+			prefixListID := aws.StringValue(v.PrefixListId)
+			n, ok := prefixListMaxEntries[prefixListID]
+
+			if !ok {
+				prefixList, err := FindManagedPrefixListByID(conn, prefixListID)
+
+				if err != nil {
+					return 0, err
+				}
+
+				n = aws.Int64Value(prefixList.MaxEntries)
+				prefixListMaxEntries[prefixListID] = n
+			}
+
+			count += n
+		}
+	}
+
+	return count, nil
+}
+
+func findSecurityGroupRulesQuota(conn *servicequotas.ServiceQuotas) (int64, error) {
+	input := &servicequotas.GetServiceQuotaInput{
+		QuotaCode:   aws.String(securityGroupRulesQuotaCode),
+		ServiceCode: aws.String(securityGroupRulesQuotaServiceCode),
+	}
+
+	output, err := conn.GetServiceQuota(input)
+
+	if err != nil {
+		return 0, err
+	}
+
+	if output == nil || output.Quota == nil || output.Quota.Value == nil {
+		return 0, tfresource.NewEmptyResultError(input)
+	}
+
+	return int64(aws.Float64Value(output.Quota.Value)), nil
+}
+
 func expandAddPrefixListEntry(tfMap map[string]interface{}) *ec2.AddPrefixListEntry {
 	if tfMap == nil {
 		return nil
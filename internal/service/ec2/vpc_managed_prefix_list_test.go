@@ -217,6 +217,103 @@ func TestAccVPCManagedPrefixList_Entry_description(t *testing.T) {
 	})
 }
 
+func TestAccVPCManagedPrefixList_Entry_batched(t *testing.T) {
+	resourceName := "aws_ec2_managed_prefix_list.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckManagedPrefixList(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckManagedPrefixListDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCManagedPrefixListConfig_entryCount(rName, 250, false, 150),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccManagedPrefixListExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "entry.#", "150"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccVPCManagedPrefixListConfig_entryCount(rName, 250, false, 220),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccManagedPrefixListExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "entry.#", "220"),
+					resource.TestCheckResourceAttr(resourceName, "version", "2"),
+				),
+			},
+			{
+				Config: testAccVPCManagedPrefixListConfig_entryCount(rName, 250, false, 10),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccManagedPrefixListExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "entry.#", "10"),
+					resource.TestCheckResourceAttr(resourceName, "version", "5"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCManagedPrefixList_autoIncreaseMaxEntries(t *testing.T) {
+	resourceName := "aws_ec2_managed_prefix_list.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckManagedPrefixList(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckManagedPrefixListDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCManagedPrefixListConfig_entryCount(rName, 1, true, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccManagedPrefixListExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "auto_increase_max_entries", "true"),
+					resource.TestCheckResourceAttr(resourceName, "entry.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "max_entries", "2"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"auto_increase_max_entries"},
+			},
+			{
+				Config: testAccVPCManagedPrefixListConfig_entryCount(rName, 1, true, 150),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccManagedPrefixListExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "entry.#", "150"),
+					resource.TestCheckResourceAttr(resourceName, "max_entries", "150"),
+				),
+			},
+			{
+				Config: testAccVPCManagedPrefixListConfig_entryCount(rName, 1, true, 10),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccManagedPrefixListExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "entry.#", "10"),
+					resource.TestCheckResourceAttr(resourceName, "max_entries", "150"),
+				),
+			},
+			{
+				Config: testAccVPCManagedPrefixListConfig_entryCount(rName, 20, false, 10),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccManagedPrefixListExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "auto_increase_max_entries", "false"),
+					resource.TestCheckResourceAttr(resourceName, "entry.#", "10"),
+					resource.TestCheckResourceAttr(resourceName, "max_entries", "20"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccVPCManagedPrefixList_name(t *testing.T) {
 	resourceName := "aws_ec2_managed_prefix_list.test"
 	rName1 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -435,6 +532,26 @@ resource "aws_ec2_managed_prefix_list" "test" {
 `, rName, description)
 }
 
+func testAccVPCManagedPrefixListConfig_entryCount(rName string, maxEntries int, autoIncreaseMaxEntries bool, entryCount int) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_managed_prefix_list" "test" {
+  address_family            = "IPv4"
+  auto_increase_max_entries = %[3]t
+  max_entries               = %[2]d
+  name                      = %[1]q
+
+  dynamic "entry" {
+    for_each = range(%[4]d)
+
+    content {
+      cidr        = "10.${entry.value}.0.0/16"
+      description = "Entry ${entry.value}"
+    }
+  }
+}
+`, rName, maxEntries, autoIncreaseMaxEntries, entryCount)
+}
+
 func testAccVPCManagedPrefixListConfig_name(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_ec2_managed_prefix_list" "test" {
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfservicequotas "github.com/hashicorp/terraform-provider-aws/internal/service/servicequotas"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -195,6 +196,12 @@ func ResourceNetworkInterface() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			verify.SetTagsDiff,
+			tfservicequotas.Precheck{
+				ServiceCode: "vpc",
+				QuotaCode:   "L-DF5E4CA3",
+				QuotaName:   "Network interfaces per Region",
+				Usage:       networkInterfacesInUse,
+			}.CustomizeDiff("aws_network_interface"),
 			customdiff.ForceNewIf("private_ips", func(_ context.Context, d *schema.ResourceDiff, meta interface{}) bool {
 				privateIPListEnabled := d.Get("private_ip_list_enabled").(bool)
 				if privateIPListEnabled {
@@ -1505,3 +1512,12 @@ func flattenIPv6PrefixSpecifications(apiObjects []*ec2.Ipv6PrefixSpecification)
 
 	return tfList
 }
+
+// networkInterfacesInUse returns the number of network interfaces counting towards the Network interfaces per Region quota.
+func networkInterfacesInUse(_ context.Context, meta interface{}) (int, error) {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	networkInterfaces, err := FindNetworkInterfaces(conn, &ec2.DescribeNetworkInterfacesInput{})
+
+	return len(networkInterfaces), err
+}
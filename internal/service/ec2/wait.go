@@ -1,6 +1,7 @@
 package ec2
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strconv"
@@ -106,6 +107,63 @@ func WaitCapacityReservationDeleted(conn *ec2.EC2, id string) (*ec2.CapacityRese
 	return nil, err
 }
 
+func WaitCapacityReservationFleetCreated(ctx context.Context, conn *ec2.EC2, id string, timeout time.Duration) (*ec2.CapacityReservationFleet, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.CapacityReservationFleetStateSubmitted},
+		Target:  []string{ec2.CapacityReservationFleetStateActive, ec2.CapacityReservationFleetStatePartiallyFulfilled},
+		Refresh: StatusCapacityReservationFleetState(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ec2.CapacityReservationFleet); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitCapacityReservationFleetUpdated(ctx context.Context, conn *ec2.EC2, id string, timeout time.Duration) (*ec2.CapacityReservationFleet, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.CapacityReservationFleetStateModifying},
+		Target:  []string{ec2.CapacityReservationFleetStateActive, ec2.CapacityReservationFleetStatePartiallyFulfilled},
+		Refresh: StatusCapacityReservationFleetState(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ec2.CapacityReservationFleet); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitCapacityReservationFleetDeleted(ctx context.Context, conn *ec2.EC2, id string, timeout time.Duration) (*ec2.CapacityReservationFleet, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			ec2.CapacityReservationFleetStateActive,
+			ec2.CapacityReservationFleetStateCancelling,
+			ec2.CapacityReservationFleetStateModifying,
+			ec2.CapacityReservationFleetStatePartiallyFulfilled,
+			ec2.CapacityReservationFleetStateSubmitted,
+		},
+		Target:  []string{},
+		Refresh: StatusCapacityReservationFleetState(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ec2.CapacityReservationFleet); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 const (
 	CarrierGatewayAvailableTimeout = 5 * time.Minute
 
@@ -2417,3 +2475,66 @@ func waitEBSSnapshotTierArchive(conn *ec2.EC2, id string, timeout time.Duration)
 
 	return nil, err
 }
+
+func WaitVerifiedAccessEndpointCreated(conn *ec2.EC2, id string, timeout time.Duration) (*ec2.VerifiedAccessEndpoint, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.VerifiedAccessEndpointStatusCodePending},
+		Target:  []string{ec2.VerifiedAccessEndpointStatusCodeActive},
+		Refresh: StatusVerifiedAccessEndpoint(conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.VerifiedAccessEndpoint); ok {
+		if output.Status != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.Status.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitVerifiedAccessEndpointUpdated(conn *ec2.EC2, id string, timeout time.Duration) (*ec2.VerifiedAccessEndpoint, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.VerifiedAccessEndpointStatusCodeUpdating},
+		Target:  []string{ec2.VerifiedAccessEndpointStatusCodeActive},
+		Refresh: StatusVerifiedAccessEndpoint(conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.VerifiedAccessEndpoint); ok {
+		if output.Status != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.Status.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitVerifiedAccessEndpointDeleted(conn *ec2.EC2, id string, timeout time.Duration) (*ec2.VerifiedAccessEndpoint, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.VerifiedAccessEndpointStatusCodeActive, ec2.VerifiedAccessEndpointStatusCodeDeleting},
+		Target:  []string{},
+		Refresh: StatusVerifiedAccessEndpoint(conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.VerifiedAccessEndpoint); ok {
+		if output.Status != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.Status.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
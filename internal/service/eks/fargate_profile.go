@@ -63,19 +63,21 @@ func ResourceFargateProfile() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 				MinItems: 1,
+				MaxItems: 5,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"labels": {
-							Type:     schema.TypeMap,
-							Optional: true,
-							ForceNew: true,
-							Elem:     &schema.Schema{Type: schema.TypeString},
+							Type:         schema.TypeMap,
+							Optional:     true,
+							ForceNew:     true,
+							Elem:         &schema.Schema{Type: schema.TypeString},
+							ValidateFunc: validFargateProfileSelectorLabels,
 						},
 						"namespace": {
 							Type:         schema.TypeString,
 							Required:     true,
 							ForceNew:     true,
-							ValidateFunc: validation.NoZeroValues,
+							ValidateFunc: validFargateProfileSelectorNamespace,
 						},
 					},
 				},
@@ -124,7 +126,10 @@ func resourceFargateProfileCreate(d *schema.ResourceData, meta interface{}) erro
 	conns.GlobalMutexKV.Lock(mutexKey)
 	defer conns.GlobalMutexKV.Unlock(mutexKey)
 
-	err := resource.Retry(propagationTimeout, func() *resource.RetryError {
+	// Waiting for other mutations and for the new profile to become active share the create timeout.
+	deadline := time.Now().Add(d.Timeout(schema.TimeoutCreate))
+
+	err := resource.Retry(time.Until(deadline), func() *resource.RetryError {
 		_, err := conn.CreateFargateProfile(input)
 
 		// Retry for IAM eventual consistency on error:
@@ -133,6 +138,12 @@ func resourceFargateProfileCreate(d *schema.ResourceData, meta interface{}) erro
 			return resource.RetryableError(err)
 		}
 
+		// Queue behind mutations made outside of this provider instance, e.g. by another configuration.
+		if tfawserr.ErrMessageContains(err, eks.ErrCodeResourceInUseException, fargateProfileMutationInProgressMessage) {
+			log.Printf("[DEBUG] EKS Cluster (%s) Fargate Profile mutation in progress: %s", clusterName, err)
+			return resource.RetryableError(err)
+		}
+
 		if err != nil {
 			return resource.NonRetryableError(err)
 		}
@@ -150,7 +161,7 @@ func resourceFargateProfileCreate(d *schema.ResourceData, meta interface{}) erro
 
 	d.SetId(id)
 
-	_, err = waitFargateProfileCreated(conn, clusterName, fargateProfileName, d.Timeout(schema.TimeoutCreate))
+	_, err = waitFargateProfileCreated(conn, clusterName, fargateProfileName, time.Until(deadline))
 
 	if err != nil {
 		return fmt.Errorf("error waiting for EKS Fargate Profile (%s) to create: %w", d.Id(), err)
@@ -238,12 +249,35 @@ func resourceFargateProfileDelete(d *schema.ResourceData, meta interface{}) erro
 	conns.GlobalMutexKV.Lock(mutexKey)
 	defer conns.GlobalMutexKV.Unlock(mutexKey)
 
-	log.Printf("[DEBUG] Deleting EKS Fargate Profile: %s", d.Id())
-	_, err = conn.DeleteFargateProfile(&eks.DeleteFargateProfileInput{
+	input := &eks.DeleteFargateProfileInput{
 		ClusterName:        aws.String(clusterName),
 		FargateProfileName: aws.String(fargateProfileName),
+	}
+
+	// Waiting for other mutations and for the profile to be deleted share the delete timeout.
+	deadline := time.Now().Add(d.Timeout(schema.TimeoutDelete))
+
+	log.Printf("[DEBUG] Deleting EKS Fargate Profile: %s", d.Id())
+	err = resource.Retry(time.Until(deadline), func() *resource.RetryError {
+		_, err := conn.DeleteFargateProfile(input)
+
+		// Queue behind mutations made outside of this provider instance, e.g. by another configuration.
+		if tfawserr.ErrMessageContains(err, eks.ErrCodeResourceInUseException, fargateProfileMutationInProgressMessage) {
+			log.Printf("[DEBUG] EKS Cluster (%s) Fargate Profile mutation in progress: %s", clusterName, err)
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
 	})
 
+	if tfresource.TimedOut(err) {
+		_, err = conn.DeleteFargateProfile(input)
+	}
+
 	if tfawserr.ErrCodeEquals(err, eks.ErrCodeResourceNotFoundException) {
 		return nil
 	}
@@ -252,7 +286,7 @@ func resourceFargateProfileDelete(d *schema.ResourceData, meta interface{}) erro
 		return fmt.Errorf("error deleting EKS Fargate Profile (%s): %w", d.Id(), err)
 	}
 
-	_, err = waitFargateProfileDeleted(conn, clusterName, fargateProfileName, d.Timeout(schema.TimeoutDelete))
+	_, err = waitFargateProfileDeleted(conn, clusterName, fargateProfileName, time.Until(deadline))
 
 	if err != nil {
 		return fmt.Errorf("error waiting for EKS Fargate Profile (%s) to delete: %w", d.Id(), err)
@@ -261,6 +295,11 @@ func resourceFargateProfileDelete(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
+// fargateProfileMutationInProgressMessage is part of the error message returned when another
+// Fargate Profile in the cluster is being created or deleted, e.g.
+// ResourceInUseException: Cannot delete Fargate Profile example because cluster example currently has Fargate profile other in status CREATING
+const fargateProfileMutationInProgressMessage = "currently has Fargate profile"
+
 func expandFargateProfileSelectors(l []interface{}) []*eks.FargateProfileSelector {
 	if len(l) == 0 {
 		return nil
@@ -3,6 +3,7 @@ package eks
 import (
 	"fmt"
 	"regexp"
+	"strings"
 )
 
 func validClusterName(v interface{}, k string) (ws []string, errors []error) {
@@ -22,3 +23,55 @@ func validClusterName(v interface{}, k string) (ws []string, errors []error) {
 
 	return
 }
+
+// validFargateProfileSelectorNamespace validates a Kubernetes namespace which may contain the * and ? wildcards.
+func validFargateProfileSelectorNamespace(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if len(value) < 1 || len(value) > 63 {
+		errors = append(errors, fmt.Errorf(
+			"%q length must be between 1-63 characters: %q", k, value))
+	}
+
+	// https://docs.aws.amazon.com/eks/latest/userguide/fargate-profile.html#fargate-profile-wildcards
+	pattern := `^[a-z0-9*?]([-a-z0-9*?]*[a-z0-9*?])?$`
+	if !regexp.MustCompile(pattern).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q doesn't comply with restrictions (%q): %q",
+			k, pattern, value))
+	}
+
+	return
+}
+
+// validFargateProfileSelectorLabels validates Kubernetes labels whose values may contain the * and ? wildcards.
+func validFargateProfileSelectorLabels(v interface{}, k string) (ws []string, errors []error) {
+	keyNamePattern := regexp.MustCompile(`^[A-Za-z0-9]([-A-Za-z0-9_.]*[A-Za-z0-9])?$`)
+	keyPrefixPattern := regexp.MustCompile(`^[a-z0-9]([-a-z0-9.]*[a-z0-9])?$`)
+	valuePattern := regexp.MustCompile(`^([A-Za-z0-9*?]([-A-Za-z0-9_.*?]*[A-Za-z0-9*?])?)?$`)
+
+	for key, value := range v.(map[string]interface{}) {
+		name := key
+
+		if i := strings.LastIndex(key, "/"); i >= 0 {
+			prefix := key[:i]
+			name = key[i+1:]
+
+			if len(prefix) > 253 || !keyPrefixPattern.MatchString(prefix) {
+				errors = append(errors, fmt.Errorf(
+					"%q label key prefix must be a DNS subdomain of at most 253 characters: %q", k, key))
+			}
+		}
+
+		if len(name) > 63 || !keyNamePattern.MatchString(name) {
+			errors = append(errors, fmt.Errorf(
+				"%q label key name must be at most 63 alphanumeric, '-', '_' or '.' characters, starting and ending with an alphanumeric character: %q", k, key))
+		}
+
+		if value, ok := value.(string); !ok || len(value) > 63 || !valuePattern.MatchString(value) {
+			errors = append(errors, fmt.Errorf(
+				"%q label value must be at most 63 alphanumeric, '-', '_', '.', '*' or '?' characters, starting and ending with an alphanumeric or wildcard character: %q=%q", k, key, value))
+		}
+	}
+
+	return
+}
@@ -57,3 +57,103 @@ func TestValidClusterName(t *testing.T) {
 		}
 	}
 }
+
+func TestValidFargateProfileSelectorNamespace(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "default",
+			ErrCount: 0,
+		},
+		{
+			Value:    "prod-*",
+			ErrCount: 0,
+		},
+		{
+			Value:    "*",
+			ErrCount: 0,
+		},
+		{
+			Value:    "team-?",
+			ErrCount: 0,
+		},
+		{
+			Value:    "Invalid",
+			ErrCount: 1,
+		},
+		{
+			Value:    "-invalid",
+			ErrCount: 1,
+		},
+		{
+			Value:    "invalid_",
+			ErrCount: 1,
+		},
+		{
+			Value:    ``,
+			ErrCount: 2,
+		},
+		{
+			Value:    sdkacctest.RandStringFromCharSet(64, "abcdefghijklmnopqrstuvwxyz"),
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validFargateProfileSelectorNamespace(tc.Value, "namespace")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the EKS Fargate Profile selector namespace %q to trigger %d validation errors, got %d", tc.Value, tc.ErrCount, len(errors))
+		}
+	}
+}
+
+func TestValidFargateProfileSelectorLabels(t *testing.T) {
+	cases := []struct {
+		Value    map[string]interface{}
+		ErrCount int
+	}{
+		{
+			Value:    map[string]interface{}{"app": "web"},
+			ErrCount: 0,
+		},
+		{
+			Value:    map[string]interface{}{"app.kubernetes.io/name": "web-*"},
+			ErrCount: 0,
+		},
+		{
+			Value:    map[string]interface{}{"tier": "?"},
+			ErrCount: 0,
+		},
+		{
+			Value:    map[string]interface{}{"empty": ""},
+			ErrCount: 0,
+		},
+		{
+			Value:    map[string]interface{}{"app*": "web"},
+			ErrCount: 1,
+		},
+		{
+			Value:    map[string]interface{}{"Example.com/app": "web"},
+			ErrCount: 1,
+		},
+		{
+			Value:    map[string]interface{}{"app": "web app"},
+			ErrCount: 1,
+		},
+		{
+			Value:    map[string]interface{}{"app": "-web"},
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validFargateProfileSelectorLabels(tc.Value, "labels")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the EKS Fargate Profile selector labels %v to trigger %d validation errors, got %d", tc.Value, tc.ErrCount, len(errors))
+		}
+	}
+}
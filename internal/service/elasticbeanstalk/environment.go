@@ -723,6 +723,29 @@ func resourceEnvironmentSettingsRead(d *schema.ResourceData, meta interface{}) e
 
 	log.Printf("[DEBUG] Elastic Beanstalk updatedSettingsKeySet: %s", updatedSettingsKeySet.GoString())
 
+	// preserve the configured value for settings the API echoes back in a
+	// different but equivalent form to avoid perpetual differences
+	configuredSettings := make(map[int]map[string]interface{})
+	for _, v := range settings.List() {
+		configuredSettings[optionSettingKeyHash(v)] = v.(map[string]interface{})
+	}
+
+	for _, v := range updatedSettingsKeySet.List() {
+		m := v.(map[string]interface{})
+		configured, ok := configuredSettings[optionSettingKeyHash(m)]
+		if !ok {
+			continue
+		}
+
+		namespace, optionName := m["namespace"].(string), m["name"].(string)
+		apiValue, _ := m["value"].(string)
+		configuredValue, _ := configured["value"].(string)
+
+		if normalizeOptionSettingValue(namespace, optionName, apiValue) == normalizeOptionSettingValue(namespace, optionName, configuredValue) {
+			m["value"] = configuredValue
+		}
+	}
+
 	updatedSettings := schema.NewSet(optionSettingValueHash, updatedSettingsKeySet.List())
 
 	log.Printf("[DEBUG] Elastic Beanstalk updatedSettings: %s", updatedSettings.GoString())
@@ -917,6 +940,7 @@ func optionSettingValueHash(v interface{}) int {
 		resourceName = v
 	}
 	value, _ := rd["value"].(string)
+	value = normalizeOptionSettingValue(namespace, optionName, value)
 	value, _ = structure.NormalizeJsonString(value)
 	hk := fmt.Sprintf("%s:%s%s=%s", namespace, optionName, resourceName, sortValues(value))
 	log.Printf("[DEBUG] Elastic Beanstalk optionSettingValueHash(%#v): %s: hk=%s,hc=%d", v, optionName, hk, create.StringHashcode(hk))
@@ -936,6 +960,21 @@ func optionSettingKeyHash(v interface{}) int {
 	return create.StringHashcode(hk)
 }
 
+// normalizeOptionSettingValue returns the canonical form of option setting
+// values that the Elastic Beanstalk API echoes back in a different case from
+// the one configured, e.g. managed action windows ("Sun:10:00" vs. "sun:10:00")
+// and the shared load balancer flag.
+func normalizeOptionSettingValue(namespace, optionName, value string) string {
+	switch {
+	case namespace == "aws:elasticbeanstalk:managedactions" && optionName == "PreferredStartTime",
+		namespace == "aws:elasticbeanstalk:managedactions:platformupdate" && optionName == "UpdateLevel",
+		namespace == "aws:elasticbeanstalk:environment" && optionName == "LoadBalancerIsShared":
+		return strings.ToLower(value)
+	}
+
+	return value
+}
+
 func sortValues(v string) string {
 	values := strings.Split(v, ",")
 	sort.Strings(values)
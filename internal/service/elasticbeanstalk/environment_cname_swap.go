@@ -0,0 +1,147 @@
+package elasticbeanstalk
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+const environmentCNAMESwapIDSeparator = ":"
+
+// ResourceEnvironmentCNAMESwap swaps the CNAMEs of two environments, e.g. to
+// promote a green environment in a blue/green release. The swap is performed
+// on create; destroying the resource does not swap the CNAMEs back.
+func ResourceEnvironmentCNAMESwap() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceEnvironmentCNAMESwapCreate,
+		Read:   resourceEnvironmentCNAMESwapRead,
+		Delete: schema.Noop,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"destination_cname": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"destination_environment_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"source_cname": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"source_environment_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceEnvironmentCNAMESwapCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ElasticBeanstalkConn
+
+	sourceID := d.Get("source_environment_id").(string)
+	destinationID := d.Get("destination_environment_id").(string)
+	input := &elasticbeanstalk.SwapEnvironmentCNAMEsInput{
+		DestinationEnvironmentId: aws.String(destinationID),
+		SourceEnvironmentId:      aws.String(sourceID),
+	}
+
+	t := time.Now()
+	log.Printf("[DEBUG] Swapping Elastic Beanstalk Environment CNAMEs: %s", input)
+	_, err := conn.SwapEnvironmentCNAMEs(input)
+
+	if err != nil {
+		return fmt.Errorf("error swapping Elastic Beanstalk Environment (%s, %s) CNAMEs: %w", sourceID, destinationID, err)
+	}
+
+	d.SetId(environmentCNAMESwapCreateResourceID(sourceID, destinationID))
+
+	for _, id := range []string{sourceID, destinationID} {
+		if err := waitForEnvironmentReady(conn, id, d.Timeout(schema.TimeoutCreate), 0, t); err != nil {
+			return fmt.Errorf("error waiting for Elastic Beanstalk Environment (%s) to become ready after CNAME swap: %w", id, err)
+		}
+	}
+
+	return resourceEnvironmentCNAMESwapRead(d, meta)
+}
+
+func resourceEnvironmentCNAMESwapRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ElasticBeanstalkConn
+
+	sourceID, destinationID, err := environmentCNAMESwapParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	output, err := conn.DescribeEnvironments(&elasticbeanstalk.DescribeEnvironmentsInput{
+		EnvironmentIds: aws.StringSlice([]string{sourceID, destinationID}),
+		IncludeDeleted: aws.Bool(false),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading Elastic Beanstalk Environment CNAME Swap (%s): %w", d.Id(), err)
+	}
+
+	cnames := make(map[string]string)
+	for _, env := range output.Environments {
+		if aws.StringValue(env.Status) == elasticbeanstalk.EnvironmentStatusTerminated {
+			continue
+		}
+
+		cnames[aws.StringValue(env.EnvironmentId)] = aws.StringValue(env.CNAME)
+	}
+
+	sourceCNAME, sourceOK := cnames[sourceID]
+	destinationCNAME, destinationOK := cnames[destinationID]
+
+	if !d.IsNewResource() && (!sourceOK || !destinationOK) {
+		log.Printf("[WARN] Elastic Beanstalk Environment CNAME Swap (%s) environment not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("destination_cname", destinationCNAME)
+	d.Set("destination_environment_id", destinationID)
+	d.Set("source_cname", sourceCNAME)
+	d.Set("source_environment_id", sourceID)
+
+	return nil
+}
+
+func environmentCNAMESwapCreateResourceID(sourceID, destinationID string) string {
+	parts := []string{sourceID, destinationID}
+	id := strings.Join(parts, environmentCNAMESwapIDSeparator)
+
+	return id
+}
+
+func environmentCNAMESwapParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, environmentCNAMESwapIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected SOURCE_ENVIRONMENT_ID%[2]sDESTINATION_ENVIRONMENT_ID", id, environmentCNAMESwapIDSeparator)
+}
@@ -0,0 +1,91 @@
+package elasticbeanstalk_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccElasticBeanstalkEnvironmentCNAMESwap_basic(t *testing.T) {
+	var blue, green elasticbeanstalk.EnvironmentDescription
+
+	resourceName := "aws_elastic_beanstalk_environment_cname_swap.test"
+	blueResourceName := "aws_elastic_beanstalk_environment.test"
+	greenResourceName := "aws_elastic_beanstalk_environment.green"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, elasticbeanstalk.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentCNAMESwapConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentExists(blueResourceName, &blue),
+					testAccCheckEnvironmentExists(greenResourceName, &green),
+					resource.TestCheckResourceAttrPair(resourceName, "source_environment_id", blueResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "destination_environment_id", greenResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "source_cname", greenResourceName, "cname"),
+					resource.TestCheckResourceAttrPair(resourceName, "destination_cname", blueResourceName, "cname"),
+				),
+			},
+		},
+	})
+}
+
+func testAccEnvironmentCNAMESwapConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccEnvironmentConfig_basic(rName), fmt.Sprintf(`
+resource "aws_elastic_beanstalk_environment" "green" {
+  application         = aws_elastic_beanstalk_application.test.name
+  name                = "%[1]s-green"
+  solution_stack_name = data.aws_elastic_beanstalk_solution_stack.test.name
+
+  setting {
+    namespace = "aws:ec2:vpc"
+    name      = "VPCId"
+    value     = aws_vpc.test.id
+  }
+
+  setting {
+    namespace = "aws:ec2:vpc"
+    name      = "Subnets"
+    value     = aws_subnet.test.id
+  }
+
+  setting {
+    namespace = "aws:ec2:vpc"
+    name      = "AssociatePublicIpAddress"
+    value     = "true"
+  }
+
+  setting {
+    namespace = "aws:autoscaling:launchconfiguration"
+    name      = "SecurityGroups"
+    value     = aws_security_group.test.id
+  }
+
+  setting {
+    namespace = "aws:autoscaling:launchconfiguration"
+    name      = "IamInstanceProfile"
+    value     = aws_iam_instance_profile.test.name
+  }
+
+  setting {
+    namespace = "aws:elasticbeanstalk:environment"
+    name      = "ServiceRole"
+    value     = aws_iam_role.service_role.name
+  }
+}
+
+resource "aws_elastic_beanstalk_environment_cname_swap" "test" {
+  source_environment_id      = aws_elastic_beanstalk_environment.test.id
+  destination_environment_id = aws_elastic_beanstalk_environment.green.id
+}
+`, rName))
+}
@@ -663,6 +663,34 @@ func instanceFleetConfigSchema() *schema.Resource {
 										ForceNew:     true,
 										ValidateFunc: validation.StringInSlice(emr.OnDemandProvisioningAllocationStrategy_Values(), false),
 									},
+									"capacity_reservation_options": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"capacity_reservation_preference": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.StringInSlice(emr.OnDemandCapacityReservationPreference_Values(), false),
+												},
+												"capacity_reservation_resource_group_arn": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ForceNew:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+												"usage_strategy": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.StringInSlice(emr.OnDemandCapacityReservationUsageStrategy_Values(), false),
+												},
+											},
+										},
+									},
 								},
 							},
 						},
@@ -718,13 +746,11 @@ func instanceFleetConfigSchema() *schema.Resource {
 			"target_on_demand_capacity": {
 				Type:     schema.TypeInt,
 				Optional: true,
-				ForceNew: true,
 				Default:  0,
 			},
 			"target_spot_capacity": {
 				Type:     schema.TypeInt,
 				Optional: true,
-				ForceNew: true,
 				Default:  0,
 			},
 		},
@@ -1337,6 +1363,40 @@ func resourceClusterUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	for _, k := range []string{"core_instance_fleet", "master_instance_fleet"} {
+		if !d.HasChanges(k+".0.target_on_demand_capacity", k+".0.target_spot_capacity") {
+			continue
+		}
+
+		instanceFleetID := d.Get(k + ".0.id").(string)
+
+		input := &emr.ModifyInstanceFleetInput{
+			ClusterId: aws.String(d.Id()),
+			InstanceFleet: &emr.InstanceFleetModifyConfig{
+				InstanceFleetId:        aws.String(instanceFleetID),
+				TargetOnDemandCapacity: aws.Int64(int64(d.Get(k + ".0.target_on_demand_capacity").(int))),
+				TargetSpotCapacity:     aws.Int64(int64(d.Get(k + ".0.target_spot_capacity").(int))),
+			},
+		}
+
+		if _, err := conn.ModifyInstanceFleet(input); err != nil {
+			return fmt.Errorf("error modifying EMR Cluster (%s) Instance Fleet (%s): %w", d.Id(), instanceFleetID, err)
+		}
+
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{emr.InstanceFleetStateProvisioning, emr.InstanceFleetStateBootstrapping, emr.InstanceFleetStateResizing},
+			Target:     []string{emr.InstanceFleetStateRunning},
+			Refresh:    instanceFleetStateRefresh(conn, d.Id(), instanceFleetID),
+			Timeout:    75 * time.Minute,
+			Delay:      10 * time.Second,
+			MinTimeout: 30 * time.Second,
+		}
+
+		if _, err := stateConf.WaitForState(); err != nil {
+			return fmt.Errorf("error waiting for EMR Cluster (%s) Instance Fleet (%s) modification: %w", d.Id(), instanceFleetID, err)
+		}
+	}
+
 	if d.HasChange("instance_group") {
 		o, n := d.GetChange("instance_group")
 		oSet := o.(*schema.Set).List()
@@ -2072,13 +2132,37 @@ func flattenOnDemandSpecification(onDemandSpecification *emr.OnDemandProvisionin
 		return []interface{}{}
 	}
 	m := map[string]interface{}{
-		// The return value from api is wrong. it return "LOWEST_PRICE" instead of "lowest-price"
-		// "allocation_strategy": aws.StringValue(onDemandSpecification.AllocationStrategy),
-		"allocation_strategy": emr.OnDemandProvisioningAllocationStrategyLowestPrice,
+		"allocation_strategy": normalizeProvisioningEnumValue(aws.StringValue(onDemandSpecification.AllocationStrategy)),
+	}
+	if v := onDemandSpecification.CapacityReservationOptions; v != nil {
+		m["capacity_reservation_options"] = flattenOnDemandCapacityReservationOptions(v)
 	}
 	return []interface{}{m}
 }
 
+func flattenOnDemandCapacityReservationOptions(options *emr.OnDemandCapacityReservationOptions) []interface{} {
+	if options == nil {
+		return []interface{}{}
+	}
+	m := map[string]interface{}{}
+	if v := options.CapacityReservationPreference; v != nil {
+		m["capacity_reservation_preference"] = normalizeProvisioningEnumValue(aws.StringValue(v))
+	}
+	if v := options.CapacityReservationResourceGroupArn; v != nil {
+		m["capacity_reservation_resource_group_arn"] = aws.StringValue(v)
+	}
+	if v := options.UsageStrategy; v != nil {
+		m["usage_strategy"] = normalizeProvisioningEnumValue(aws.StringValue(v))
+	}
+	return []interface{}{m}
+}
+
+// normalizeProvisioningEnumValue converts the enumeration values returned by the API for instance fleet
+// launch specifications, e.g. "LOWEST_PRICE", to the values accepted by the API, e.g. "lowest-price".
+func normalizeProvisioningEnumValue(v string) string {
+	return strings.ReplaceAll(strings.ToLower(v), "_", "-")
+}
+
 func flattenSpotSpecification(spotSpecification *emr.SpotProvisioningSpecification) []interface{} {
 	if spotSpecification == nil {
 		return []interface{}{}
@@ -2091,9 +2175,7 @@ func flattenSpotSpecification(spotSpecification *emr.SpotProvisioningSpecificati
 		m["block_duration_minutes"] = aws.Int64Value(spotSpecification.BlockDurationMinutes)
 	}
 	if spotSpecification.AllocationStrategy != nil {
-		// The return value from api is wrong. It return "CAPACITY_OPTIMIZED" instead of "capacity-optimized"
-		// m["allocation_strategy"] = aws.StringValue(spotSpecification.AllocationStrategy)
-		m["allocation_strategy"] = emr.SpotProvisioningAllocationStrategyCapacityOptimized
+		m["allocation_strategy"] = normalizeProvisioningEnumValue(aws.StringValue(spotSpecification.AllocationStrategy))
 	}
 
 	return []interface{}{m}
@@ -2168,9 +2250,15 @@ func expandLaunchSpecification(launchSpecification map[string]interface{}) *emr.
 	fleetSpecification := &emr.InstanceFleetProvisioningSpecifications{}
 
 	if len(onDemandSpecification) > 0 {
-		fleetSpecification.OnDemandSpecification = &emr.OnDemandProvisioningSpecification{
-			AllocationStrategy: aws.String(onDemandSpecification[0].(map[string]interface{})["allocation_strategy"].(string)),
+		configAttributes := onDemandSpecification[0].(map[string]interface{})
+		onDemandProvisioning := &emr.OnDemandProvisioningSpecification{
+			AllocationStrategy: aws.String(configAttributes["allocation_strategy"].(string)),
+		}
+		if v, ok := configAttributes["capacity_reservation_options"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			onDemandProvisioning.CapacityReservationOptions = expandOnDemandCapacityReservationOptions(v[0].(map[string]interface{}))
 		}
+
+		fleetSpecification.OnDemandSpecification = onDemandProvisioning
 	}
 
 	if len(spotSpecification) > 0 {
@@ -2193,6 +2281,22 @@ func expandLaunchSpecification(launchSpecification map[string]interface{}) *emr.
 	return fleetSpecification
 }
 
+func expandOnDemandCapacityReservationOptions(configAttributes map[string]interface{}) *emr.OnDemandCapacityReservationOptions {
+	options := &emr.OnDemandCapacityReservationOptions{}
+
+	if v, ok := configAttributes["capacity_reservation_preference"].(string); ok && v != "" {
+		options.CapacityReservationPreference = aws.String(v)
+	}
+	if v, ok := configAttributes["capacity_reservation_resource_group_arn"].(string); ok && v != "" {
+		options.CapacityReservationResourceGroupArn = aws.String(v)
+	}
+	if v, ok := configAttributes["usage_strategy"].(string); ok && v != "" {
+		options.UsageStrategy = aws.String(v)
+	}
+
+	return options
+}
+
 func expandConfigurations(configurations []interface{}) []*emr.Configuration {
 	configsOut := []*emr.Configuration{}
 
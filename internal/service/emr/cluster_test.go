@@ -1582,6 +1582,54 @@ func TestAccEMRCluster_InstanceFleetMaster_only(t *testing.T) {
 	})
 }
 
+func TestAccEMRCluster_InstanceFleet_targetCapacity(t *testing.T) {
+	var cluster1, cluster2 emr.Cluster
+
+	resourceName := "aws_emr_cluster.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, emr.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_instanceFleetsCapacity(rName, 1, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(resourceName, &cluster1),
+					resource.TestCheckResourceAttr(resourceName, "core_instance_fleet.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "core_instance_fleet.0.launch_specifications.0.on_demand_specification.0.allocation_strategy", "lowest-price"),
+					resource.TestCheckResourceAttr(resourceName, "core_instance_fleet.0.launch_specifications.0.on_demand_specification.0.capacity_reservation_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "core_instance_fleet.0.launch_specifications.0.on_demand_specification.0.capacity_reservation_options.0.capacity_reservation_preference", "open"),
+					resource.TestCheckResourceAttr(resourceName, "core_instance_fleet.0.launch_specifications.0.spot_specification.0.allocation_strategy", "capacity-optimized"),
+					resource.TestCheckResourceAttr(resourceName, "core_instance_fleet.0.target_on_demand_capacity", "1"),
+					resource.TestCheckResourceAttr(resourceName, "core_instance_fleet.0.target_spot_capacity", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"cluster_state", // Ignore RUNNING versus WAITING changes
+					"configurations",
+					"keep_job_flow_alive_when_no_steps",
+				},
+			},
+			{
+				Config: testAccClusterConfig_instanceFleetsCapacity(rName, 2, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(resourceName, &cluster2),
+					testAccCheckClusterNotRecreated(&cluster1, &cluster2),
+					resource.TestCheckResourceAttr(resourceName, "core_instance_fleet.0.target_on_demand_capacity", "2"),
+					resource.TestCheckResourceAttr(resourceName, "core_instance_fleet.0.target_spot_capacity", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckDestroy(s *terraform.State) error {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).EMRConn
 
@@ -3755,6 +3803,70 @@ resource "aws_emr_cluster" "test" {
 `, rName))
 }
 
+func testAccClusterConfig_instanceFleetsCapacity(rName string, onDemandCapacity, spotCapacity int) string {
+	return acctest.ConfigCompose(
+		testAccClusterBaseVPCConfig(rName, false),
+		testAccClusterIAMServiceRoleBaseConfig(rName),
+		testAccClusterIAMInstanceProfileBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_emr_cluster" "test" {
+  name          = %[1]q
+  release_label = "emr-5.30.1"
+  applications  = ["Hadoop", "Hive"]
+
+  master_instance_fleet {
+    instance_type_configs {
+      instance_type = "m4.xlarge"
+    }
+
+    target_on_demand_capacity = 1
+  }
+
+  core_instance_fleet {
+    instance_type_configs {
+      bid_price_as_percentage_of_on_demand_price = 100
+      instance_type                              = "m4.xlarge"
+      weighted_capacity                          = 1
+    }
+
+    launch_specifications {
+      on_demand_specification {
+        allocation_strategy = "lowest-price"
+
+        capacity_reservation_options {
+          capacity_reservation_preference = "open"
+        }
+      }
+
+      spot_specification {
+        allocation_strategy      = "capacity-optimized"
+        timeout_action           = "SWITCH_TO_ON_DEMAND"
+        timeout_duration_minutes = 10
+      }
+    }
+
+    name                      = "core fleet"
+    target_on_demand_capacity = %[2]d
+    target_spot_capacity      = %[3]d
+  }
+
+  service_role = aws_iam_role.emr_service.arn
+  depends_on = [
+    aws_route_table_association.test,
+    aws_iam_role_policy_attachment.emr_service,
+    aws_iam_role_policy_attachment.emr_instance_profile,
+  ]
+
+  ec2_attributes {
+    subnet_id                         = aws_subnet.test.id
+    emr_managed_master_security_group = aws_security_group.test.id
+    emr_managed_slave_security_group  = aws_security_group.test.id
+    instance_profile                  = aws_iam_instance_profile.emr_instance_profile.arn
+  }
+}
+`, rName, onDemandCapacity, spotCapacity))
+}
+
 func testAccClusterConfig_instanceFleetMultipleSubnets(rName string) string {
 	return acctest.ConfigCompose(
 		testAccClusterBaseVPCConfig(rName, false),
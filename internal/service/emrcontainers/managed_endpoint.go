@@ -0,0 +1,711 @@
+package emrcontainers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/emrcontainers"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceManagedEndpoint() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceManagedEndpointCreate,
+		ReadWithoutTimeout:   resourceManagedEndpointRead,
+		UpdateWithoutTimeout: resourceManagedEndpointUpdate,
+		DeleteWithoutTimeout: resourceManagedEndpointDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"certificate_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"certificate_authority": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"certificate_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"certificate_data": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"configuration_overrides": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"application_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 100,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"classification": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringLenBetween(1, 1024),
+									},
+									"configurations": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 100,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"classification": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.StringLenBetween(1, 1024),
+												},
+												"properties": {
+													Type:      schema.TypeMap,
+													Optional:  true,
+													ForceNew:  true,
+													Sensitive: true,
+													Elem:      &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+									"properties": {
+										Type:      schema.TypeMap,
+										Optional:  true,
+										ForceNew:  true,
+										Sensitive: true,
+										Elem:      &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"monitoring_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"cloud_watch_monitoring_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"log_group_name": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.StringLenBetween(1, 512),
+												},
+												"log_stream_name_prefix": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.StringLenBetween(1, 256),
+												},
+											},
+										},
+									},
+									"container_log_rotation_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"max_files_to_keep": {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.IntBetween(1, 50),
+												},
+												"rotation_size": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.StringLenBetween(3, 12),
+												},
+											},
+										},
+									},
+									"persistent_app_ui": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(emrcontainers.PersistentAppUI_Values(), false),
+									},
+									"s3_monitoring_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"log_uri": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.StringLenBetween(1, 10280),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"execution_role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"release_label": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"security_group": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"server_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"subnet_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"virtual_cluster_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceManagedEndpointCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EMRContainersConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &emrcontainers.CreateManagedEndpointInput{
+		ClientToken:      aws.String(resource.UniqueId()),
+		ExecutionRoleArn: aws.String(d.Get("execution_role_arn").(string)),
+		Name:             aws.String(name),
+		ReleaseLabel:     aws.String(d.Get("release_label").(string)),
+		Type:             aws.String(d.Get("type").(string)),
+		VirtualClusterId: aws.String(d.Get("virtual_cluster_id").(string)),
+	}
+
+	if v, ok := d.GetOk("certificate_arn"); ok {
+		input.CertificateArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("configuration_overrides"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ConfigurationOverrides = expandConfigurationOverrides(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[INFO] Creating EMR Containers Managed Endpoint: %s", input)
+	output, err := conn.CreateManagedEndpointWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating EMR Containers Managed Endpoint (%s): %s", name, err)
+	}
+
+	d.SetId(ManagedEndpointCreateResourceID(aws.StringValue(output.VirtualClusterId), aws.StringValue(output.Id)))
+
+	if _, err := waitManagedEndpointCreated(ctx, conn, aws.StringValue(output.VirtualClusterId), aws.StringValue(output.Id), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for EMR Containers Managed Endpoint (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceManagedEndpointRead(ctx, d, meta)
+}
+
+func resourceManagedEndpointRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EMRContainersConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	virtualClusterID, endpointID, err := ManagedEndpointParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	endpoint, err := FindManagedEndpointByTwoPartKey(ctx, conn, virtualClusterID, endpointID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EMR Containers Managed Endpoint %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading EMR Containers Managed Endpoint (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", endpoint.Arn)
+	d.Set("certificate_arn", endpoint.CertificateArn)
+	if endpoint.CertificateAuthority != nil {
+		if err := d.Set("certificate_authority", []interface{}{flattenCertificate(endpoint.CertificateAuthority)}); err != nil {
+			return diag.Errorf("setting certificate_authority: %s", err)
+		}
+	} else {
+		d.Set("certificate_authority", nil)
+	}
+	if endpoint.ConfigurationOverrides != nil {
+		if err := d.Set("configuration_overrides", []interface{}{flattenConfigurationOverrides(endpoint.ConfigurationOverrides)}); err != nil {
+			return diag.Errorf("setting configuration_overrides: %s", err)
+		}
+	} else {
+		d.Set("configuration_overrides", nil)
+	}
+	d.Set("execution_role_arn", endpoint.ExecutionRoleArn)
+	d.Set("name", endpoint.Name)
+	d.Set("release_label", endpoint.ReleaseLabel)
+	d.Set("security_group", endpoint.SecurityGroup)
+	d.Set("server_url", endpoint.ServerUrl)
+	d.Set("state", endpoint.State)
+	d.Set("subnet_ids", aws.StringValueSlice(endpoint.SubnetIds))
+	d.Set("type", endpoint.Type)
+	d.Set("virtual_cluster_id", endpoint.VirtualClusterId)
+
+	tags := KeyValueTags(endpoint.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceManagedEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EMRContainersConn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating EMR Containers Managed Endpoint (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceManagedEndpointRead(ctx, d, meta)
+}
+
+func resourceManagedEndpointDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EMRContainersConn
+
+	virtualClusterID, endpointID, err := ManagedEndpointParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Deleting EMR Containers Managed Endpoint: %s", d.Id())
+	_, err = conn.DeleteManagedEndpointWithContext(ctx, &emrcontainers.DeleteManagedEndpointInput{
+		Id:               aws.String(endpointID),
+		VirtualClusterId: aws.String(virtualClusterID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, emrcontainers.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting EMR Containers Managed Endpoint (%s): %s", d.Id(), err)
+	}
+
+	if _, err = waitManagedEndpointDeleted(ctx, conn, virtualClusterID, endpointID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for EMR Containers Managed Endpoint (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+const managedEndpointResourceIDSeparator = ","
+
+func ManagedEndpointCreateResourceID(virtualClusterID, endpointID string) string {
+	parts := []string{virtualClusterID, endpointID}
+	id := strings.Join(parts, managedEndpointResourceIDSeparator)
+
+	return id
+}
+
+func ManagedEndpointParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, managedEndpointResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected VIRTUAL-CLUSTER-ID%[2]sENDPOINT-ID", id, managedEndpointResourceIDSeparator)
+}
+
+func expandConfigurationOverrides(tfMap map[string]interface{}) *emrcontainers.ConfigurationOverrides {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &emrcontainers.ConfigurationOverrides{}
+
+	if v, ok := tfMap["application_configuration"].([]interface{}); ok && len(v) > 0 {
+		apiObject.ApplicationConfiguration = expandConfigurations(v)
+	}
+
+	if v, ok := tfMap["monitoring_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.MonitoringConfiguration = expandMonitoringConfiguration(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandConfigurations(tfList []interface{}) []*emrcontainers.Configuration {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*emrcontainers.Configuration
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &emrcontainers.Configuration{}
+
+		if v, ok := tfMap["classification"].(string); ok && v != "" {
+			apiObject.Classification = aws.String(v)
+		}
+
+		if v, ok := tfMap["configurations"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Configurations = expandConfigurations(v)
+		}
+
+		if v, ok := tfMap["properties"].(map[string]interface{}); ok && len(v) > 0 {
+			apiObject.Properties = flex.ExpandStringMap(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandMonitoringConfiguration(tfMap map[string]interface{}) *emrcontainers.MonitoringConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &emrcontainers.MonitoringConfiguration{}
+
+	if v, ok := tfMap["cloud_watch_monitoring_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		cloudWatch := &emrcontainers.CloudWatchMonitoringConfiguration{
+			LogGroupName: aws.String(tfMap["log_group_name"].(string)),
+		}
+
+		if v, ok := tfMap["log_stream_name_prefix"].(string); ok && v != "" {
+			cloudWatch.LogStreamNamePrefix = aws.String(v)
+		}
+
+		apiObject.CloudWatchMonitoringConfiguration = cloudWatch
+	}
+
+	if v, ok := tfMap["container_log_rotation_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.ContainerLogRotationConfiguration = &emrcontainers.ContainerLogRotationConfiguration{
+			MaxFilesToKeep: aws.Int64(int64(tfMap["max_files_to_keep"].(int))),
+			RotationSize:   aws.String(tfMap["rotation_size"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["persistent_app_ui"].(string); ok && v != "" {
+		apiObject.PersistentAppUI = aws.String(v)
+	}
+
+	if v, ok := tfMap["s3_monitoring_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.S3MonitoringConfiguration = &emrcontainers.S3MonitoringConfiguration{
+			LogUri: aws.String(tfMap["log_uri"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func flattenCertificate(apiObject *emrcontainers.Certificate) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.CertificateArn; v != nil {
+		tfMap["certificate_arn"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.CertificateData; v != nil {
+		tfMap["certificate_data"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenConfigurationOverrides(apiObject *emrcontainers.ConfigurationOverrides) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ApplicationConfiguration; v != nil {
+		tfMap["application_configuration"] = flattenConfigurations(v)
+	}
+
+	if v := apiObject.MonitoringConfiguration; v != nil {
+		tfMap["monitoring_configuration"] = []interface{}{flattenMonitoringConfiguration(v)}
+	}
+
+	return tfMap
+}
+
+func flattenConfigurations(apiObjects []*emrcontainers.Configuration) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"classification": aws.StringValue(apiObject.Classification),
+			"properties":     aws.StringValueMap(apiObject.Properties),
+		}
+
+		if v := apiObject.Configurations; v != nil {
+			tfMap["configurations"] = flattenConfigurations(v)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenMonitoringConfiguration(apiObject *emrcontainers.MonitoringConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"persistent_app_ui": aws.StringValue(apiObject.PersistentAppUI),
+	}
+
+	if v := apiObject.CloudWatchMonitoringConfiguration; v != nil {
+		tfMap["cloud_watch_monitoring_configuration"] = []interface{}{map[string]interface{}{
+			"log_group_name":         aws.StringValue(v.LogGroupName),
+			"log_stream_name_prefix": aws.StringValue(v.LogStreamNamePrefix),
+		}}
+	}
+
+	if v := apiObject.ContainerLogRotationConfiguration; v != nil {
+		tfMap["container_log_rotation_configuration"] = []interface{}{map[string]interface{}{
+			"max_files_to_keep": aws.Int64Value(v.MaxFilesToKeep),
+			"rotation_size":     aws.StringValue(v.RotationSize),
+		}}
+	}
+
+	if v := apiObject.S3MonitoringConfiguration; v != nil {
+		tfMap["s3_monitoring_configuration"] = []interface{}{map[string]interface{}{
+			"log_uri": aws.StringValue(v.LogUri),
+		}}
+	}
+
+	return tfMap
+}
+
+func findManagedEndpoint(ctx context.Context, conn *emrcontainers.EMRContainers, input *emrcontainers.DescribeManagedEndpointInput) (*emrcontainers.Endpoint, error) {
+	output, err := conn.DescribeManagedEndpointWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, emrcontainers.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Endpoint == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Endpoint, nil
+}
+
+func FindManagedEndpointByTwoPartKey(ctx context.Context, conn *emrcontainers.EMRContainers, virtualClusterID, endpointID string) (*emrcontainers.Endpoint, error) {
+	input := &emrcontainers.DescribeManagedEndpointInput{
+		Id:               aws.String(endpointID),
+		VirtualClusterId: aws.String(virtualClusterID),
+	}
+
+	output, err := findManagedEndpoint(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if state := aws.StringValue(output.State); state == emrcontainers.EndpointStateTerminated {
+		return nil, &resource.NotFoundError{
+			Message:     state,
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func statusManagedEndpoint(ctx context.Context, conn *emrcontainers.EMRContainers, virtualClusterID, endpointID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindManagedEndpointByTwoPartKey(ctx, conn, virtualClusterID, endpointID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
+
+func waitManagedEndpointCreated(ctx context.Context, conn *emrcontainers.EMRContainers, virtualClusterID, endpointID string, timeout time.Duration) (*emrcontainers.Endpoint, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{emrcontainers.EndpointStateCreating},
+		Target:  []string{emrcontainers.EndpointStateActive},
+		Refresh: statusManagedEndpoint(ctx, conn, virtualClusterID, endpointID),
+		Timeout: timeout,
+		Delay:   1 * time.Minute,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if v, ok := outputRaw.(*emrcontainers.Endpoint); ok {
+		if state := aws.StringValue(v.State); state == emrcontainers.EndpointStateTerminatedWithErrors {
+			tfresource.SetLastError(err, errors.New(strings.Join([]string{aws.StringValue(v.FailureReason), aws.StringValue(v.StateDetails)}, ": ")))
+		}
+
+		return v, err
+	}
+
+	return nil, err
+}
+
+func waitManagedEndpointDeleted(ctx context.Context, conn *emrcontainers.EMRContainers, virtualClusterID, endpointID string, timeout time.Duration) (*emrcontainers.Endpoint, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{emrcontainers.EndpointStateActive, emrcontainers.EndpointStateTerminating},
+		Target:  []string{},
+		Refresh: statusManagedEndpoint(ctx, conn, virtualClusterID, endpointID),
+		Timeout: timeout,
+		Delay:   1 * time.Minute,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if v, ok := outputRaw.(*emrcontainers.Endpoint); ok {
+		return v, err
+	}
+
+	return nil, err
+}
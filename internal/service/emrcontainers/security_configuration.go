@@ -0,0 +1,537 @@
+package emrcontainers
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/emrcontainers"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceSecurityConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSecurityConfigurationCreate,
+		ReadWithoutTimeout:   resourceSecurityConfigurationRead,
+		UpdateWithoutTimeout: resourceSecurityConfigurationUpdate,
+		DeleteWithoutTimeout: resourceSecurityConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"security_configuration_data": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"authorization_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"encryption_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"in_transit_encryption_configuration": {
+													Type:     schema.TypeList,
+													Optional: true,
+													ForceNew: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"tls_certificate_configuration": {
+																Type:     schema.TypeList,
+																Optional: true,
+																ForceNew: true,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"certificate_provider_type": {
+																			Type:         schema.TypeString,
+																			Optional:     true,
+																			ForceNew:     true,
+																			ValidateFunc: validation.StringInSlice(emrcontainers.CertificateProviderType_Values(), false),
+																		},
+																		"private_certificate_secret_arn": {
+																			Type:         schema.TypeString,
+																			Optional:     true,
+																			ForceNew:     true,
+																			ValidateFunc: verify.ValidARN,
+																		},
+																		"public_certificate_secret_arn": {
+																			Type:         schema.TypeString,
+																			Optional:     true,
+																			ForceNew:     true,
+																			ValidateFunc: verify.ValidARN,
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									"lake_formation_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"authorized_session_tag_value": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													Computed:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.StringLenBetween(1, 512),
+												},
+												"query_engine_role_arn": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ForceNew:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+												"secure_namespace_info": {
+													Type:     schema.TypeList,
+													Optional: true,
+													ForceNew: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"cluster_id": {
+																Type:         schema.TypeString,
+																Optional:     true,
+																ForceNew:     true,
+																ValidateFunc: validation.StringLenBetween(1, 100),
+															},
+															"namespace": {
+																Type:         schema.TypeString,
+																Optional:     true,
+																ForceNew:     true,
+																ValidateFunc: validation.StringLenBetween(1, 63),
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceSecurityConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EMRContainersConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &emrcontainers.CreateSecurityConfigurationInput{
+		ClientToken: aws.String(resource.UniqueId()),
+		Name:        aws.String(name),
+	}
+
+	if v, ok := d.GetOk("security_configuration_data"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.SecurityConfigurationData = expandSecurityConfigurationData(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[INFO] Creating EMR Containers Security Configuration: %s", input)
+	output, err := conn.CreateSecurityConfigurationWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating EMR Containers Security Configuration (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Id))
+
+	return resourceSecurityConfigurationRead(ctx, d, meta)
+}
+
+func resourceSecurityConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EMRContainersConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	sc, err := FindSecurityConfigurationByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EMR Containers Security Configuration %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading EMR Containers Security Configuration (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", sc.Arn)
+	if sc.CreatedAt != nil {
+		d.Set("created_at", aws.TimeValue(sc.CreatedAt).Format(time.RFC3339))
+	} else {
+		d.Set("created_at", nil)
+	}
+	d.Set("name", sc.Name)
+	if sc.SecurityConfigurationData != nil {
+		if err := d.Set("security_configuration_data", []interface{}{flattenSecurityConfigurationData(sc.SecurityConfigurationData)}); err != nil {
+			return diag.Errorf("setting security_configuration_data: %s", err)
+		}
+	} else {
+		d.Set("security_configuration_data", nil)
+	}
+
+	tags := KeyValueTags(sc.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceSecurityConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EMRContainersConn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating EMR Containers Security Configuration (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceSecurityConfigurationRead(ctx, d, meta)
+}
+
+func resourceSecurityConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// EMR on EKS security configurations are immutable and there is no API to delete them.
+	log.Printf("[WARN] EMR Containers Security Configuration (%s) cannot be deleted, removing from state", d.Id())
+
+	return nil
+}
+
+func expandSecurityConfigurationData(tfMap map[string]interface{}) *emrcontainers.SecurityConfigurationData {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &emrcontainers.SecurityConfigurationData{}
+
+	if v, ok := tfMap["authorization_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.AuthorizationConfiguration = expandAuthorizationConfiguration(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandAuthorizationConfiguration(tfMap map[string]interface{}) *emrcontainers.AuthorizationConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &emrcontainers.AuthorizationConfiguration{}
+
+	if v, ok := tfMap["encryption_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.EncryptionConfiguration = expandEncryptionConfiguration(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["lake_formation_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.LakeFormationConfiguration = expandLakeFormationConfiguration(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandEncryptionConfiguration(tfMap map[string]interface{}) *emrcontainers.EncryptionConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &emrcontainers.EncryptionConfiguration{}
+
+	if v, ok := tfMap["in_transit_encryption_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.InTransitEncryptionConfiguration = expandInTransitEncryptionConfiguration(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandInTransitEncryptionConfiguration(tfMap map[string]interface{}) *emrcontainers.InTransitEncryptionConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &emrcontainers.InTransitEncryptionConfiguration{}
+
+	if v, ok := tfMap["tls_certificate_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.TlsCertificateConfiguration = expandTLSCertificateConfiguration(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandTLSCertificateConfiguration(tfMap map[string]interface{}) *emrcontainers.TLSCertificateConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &emrcontainers.TLSCertificateConfiguration{}
+
+	if v, ok := tfMap["certificate_provider_type"].(string); ok && v != "" {
+		apiObject.CertificateProviderType = aws.String(v)
+	}
+
+	if v, ok := tfMap["private_certificate_secret_arn"].(string); ok && v != "" {
+		apiObject.PrivateCertificateSecretArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["public_certificate_secret_arn"].(string); ok && v != "" {
+		apiObject.PublicCertificateSecretArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandLakeFormationConfiguration(tfMap map[string]interface{}) *emrcontainers.LakeFormationConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &emrcontainers.LakeFormationConfiguration{}
+
+	if v, ok := tfMap["authorized_session_tag_value"].(string); ok && v != "" {
+		apiObject.AuthorizedSessionTagValue = aws.String(v)
+	}
+
+	if v, ok := tfMap["query_engine_role_arn"].(string); ok && v != "" {
+		apiObject.QueryEngineRoleArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["secure_namespace_info"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SecureNamespaceInfo = expandSecureNamespaceInfo(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandSecureNamespaceInfo(tfMap map[string]interface{}) *emrcontainers.SecureNamespaceInfo {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &emrcontainers.SecureNamespaceInfo{}
+
+	if v, ok := tfMap["cluster_id"].(string); ok && v != "" {
+		apiObject.ClusterId = aws.String(v)
+	}
+
+	if v, ok := tfMap["namespace"].(string); ok && v != "" {
+		apiObject.Namespace = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenSecurityConfigurationData(apiObject *emrcontainers.SecurityConfigurationData) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AuthorizationConfiguration; v != nil {
+		tfMap["authorization_configuration"] = []interface{}{flattenAuthorizationConfiguration(v)}
+	}
+
+	return tfMap
+}
+
+func flattenAuthorizationConfiguration(apiObject *emrcontainers.AuthorizationConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.EncryptionConfiguration; v != nil {
+		tfMap["encryption_configuration"] = []interface{}{flattenEncryptionConfiguration(v)}
+	}
+
+	if v := apiObject.LakeFormationConfiguration; v != nil {
+		tfMap["lake_formation_configuration"] = []interface{}{flattenLakeFormationConfiguration(v)}
+	}
+
+	return tfMap
+}
+
+func flattenEncryptionConfiguration(apiObject *emrcontainers.EncryptionConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.InTransitEncryptionConfiguration; v != nil {
+		tfMap["in_transit_encryption_configuration"] = []interface{}{flattenInTransitEncryptionConfiguration(v)}
+	}
+
+	return tfMap
+}
+
+func flattenInTransitEncryptionConfiguration(apiObject *emrcontainers.InTransitEncryptionConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.TlsCertificateConfiguration; v != nil {
+		tfMap["tls_certificate_configuration"] = []interface{}{flattenTLSCertificateConfiguration(v)}
+	}
+
+	return tfMap
+}
+
+func flattenTLSCertificateConfiguration(apiObject *emrcontainers.TLSCertificateConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.CertificateProviderType; v != nil {
+		tfMap["certificate_provider_type"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.PrivateCertificateSecretArn; v != nil {
+		tfMap["private_certificate_secret_arn"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.PublicCertificateSecretArn; v != nil {
+		tfMap["public_certificate_secret_arn"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenLakeFormationConfiguration(apiObject *emrcontainers.LakeFormationConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AuthorizedSessionTagValue; v != nil {
+		tfMap["authorized_session_tag_value"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.QueryEngineRoleArn; v != nil {
+		tfMap["query_engine_role_arn"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.SecureNamespaceInfo; v != nil {
+		tfMap["secure_namespace_info"] = []interface{}{flattenSecureNamespaceInfo(v)}
+	}
+
+	return tfMap
+}
+
+func flattenSecureNamespaceInfo(apiObject *emrcontainers.SecureNamespaceInfo) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ClusterId; v != nil {
+		tfMap["cluster_id"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Namespace; v != nil {
+		tfMap["namespace"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func findSecurityConfiguration(ctx context.Context, conn *emrcontainers.EMRContainers, input *emrcontainers.DescribeSecurityConfigurationInput) (*emrcontainers.SecurityConfiguration, error) {
+	output, err := conn.DescribeSecurityConfigurationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, emrcontainers.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.SecurityConfiguration == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.SecurityConfiguration, nil
+}
+
+func FindSecurityConfigurationByID(ctx context.Context, conn *emrcontainers.EMRContainers, id string) (*emrcontainers.SecurityConfiguration, error) {
+	input := &emrcontainers.DescribeSecurityConfigurationInput{
+		Id: aws.String(id),
+	}
+
+	return findSecurityConfiguration(ctx, conn, input)
+}
@@ -0,0 +1,187 @@
+package emrcontainers_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/emrcontainers"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfemrcontainers "github.com/hashicorp/terraform-provider-aws/internal/service/emrcontainers"
+)
+
+func TestAccEMRContainersSecurityConfiguration_basic(t *testing.T) {
+	var v emrcontainers.SecurityConfiguration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_emrcontainers_security_configuration.test"
+	key := acctest.TLSRSAPrivateKeyPEM(2048)
+	certificate := acctest.TLSRSAX509SelfSignedCertificatePEM(key, "example.com")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, emrcontainers.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		// Security configurations cannot be deleted.
+		CheckDestroy: acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecurityConfigurationConfig_basic(rName, key, certificate),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecurityConfigurationExists(resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "security_configuration_data.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "security_configuration_data.0.authorization_configuration.0.encryption_configuration.0.in_transit_encryption_configuration.0.tls_certificate_configuration.0.certificate_provider_type", "PEM"),
+					resource.TestCheckResourceAttrPair(resourceName, "security_configuration_data.0.authorization_configuration.0.encryption_configuration.0.in_transit_encryption_configuration.0.tls_certificate_configuration.0.private_certificate_secret_arn", "aws_secretsmanager_secret.private", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "security_configuration_data.0.authorization_configuration.0.encryption_configuration.0.in_transit_encryption_configuration.0.tls_certificate_configuration.0.public_certificate_secret_arn", "aws_secretsmanager_secret.public", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEMRContainersSecurityConfiguration_tags(t *testing.T) {
+	var v emrcontainers.SecurityConfiguration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_emrcontainers_security_configuration.test"
+	key := acctest.TLSRSAPrivateKeyPEM(2048)
+	certificate := acctest.TLSRSAX509SelfSignedCertificatePEM(key, "example.com")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, emrcontainers.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecurityConfigurationConfig_tags1(rName, key, certificate, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecurityConfigurationExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSecurityConfigurationConfig_tags1(rName, key, certificate, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecurityConfigurationExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSecurityConfigurationExists(n string, v *emrcontainers.SecurityConfiguration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EMR Containers Security Configuration ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EMRContainersConn
+
+		output, err := tfemrcontainers.FindSecurityConfigurationByID(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccSecurityConfigurationConfig_base(rName, key, certificate string) string {
+	return fmt.Sprintf(`
+resource "aws_secretsmanager_secret" "private" {
+  name                    = "%[1]s-private"
+  recovery_window_in_days = 0
+}
+
+resource "aws_secretsmanager_secret_version" "private" {
+  secret_id     = aws_secretsmanager_secret.private.id
+  secret_string = %[2]q
+}
+
+resource "aws_secretsmanager_secret" "public" {
+  name                    = "%[1]s-public"
+  recovery_window_in_days = 0
+}
+
+resource "aws_secretsmanager_secret_version" "public" {
+  secret_id     = aws_secretsmanager_secret.public.id
+  secret_string = %[3]q
+}
+`, rName, key, certificate)
+}
+
+func testAccSecurityConfigurationConfig_basic(rName, key, certificate string) string {
+	return acctest.ConfigCompose(testAccSecurityConfigurationConfig_base(rName, key, certificate), fmt.Sprintf(`
+resource "aws_emrcontainers_security_configuration" "test" {
+  name = %[1]q
+
+  security_configuration_data {
+    authorization_configuration {
+      encryption_configuration {
+        in_transit_encryption_configuration {
+          tls_certificate_configuration {
+            certificate_provider_type      = "PEM"
+            private_certificate_secret_arn = aws_secretsmanager_secret_version.private.arn
+            public_certificate_secret_arn  = aws_secretsmanager_secret_version.public.arn
+          }
+        }
+      }
+    }
+  }
+}
+`, rName))
+}
+
+func testAccSecurityConfigurationConfig_tags1(rName, key, certificate, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccSecurityConfigurationConfig_base(rName, key, certificate), fmt.Sprintf(`
+resource "aws_emrcontainers_security_configuration" "test" {
+  name = %[1]q
+
+  security_configuration_data {
+    authorization_configuration {
+      encryption_configuration {
+        in_transit_encryption_configuration {
+          tls_certificate_configuration {
+            certificate_provider_type      = "PEM"
+            private_certificate_secret_arn = aws_secretsmanager_secret_version.private.arn
+            public_certificate_secret_arn  = aws_secretsmanager_secret_version.public.arn
+          }
+        }
+      }
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
@@ -9,18 +9,96 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/emrcontainers"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
+	resource.AddTestSweepers("aws_emrcontainers_managed_endpoint", &resource.Sweeper{
+		Name: "aws_emrcontainers_managed_endpoint",
+		F:    sweepManagedEndpoints,
+	})
+
 	resource.AddTestSweepers("aws_emrcontainers_virtual_cluster", &resource.Sweeper{
 		Name: "aws_emrcontainers_virtual_cluster",
 		F:    sweepVirtualClusters,
+		Dependencies: []string{
+			"aws_emrcontainers_managed_endpoint",
+		},
 	})
 }
 
+func sweepManagedEndpoints(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).EMRContainersConn
+	input := &emrcontainers.ListVirtualClustersInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
+
+	err = conn.ListVirtualClustersPages(input, func(page *emrcontainers.ListVirtualClustersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.VirtualClusters {
+			if aws.StringValue(v.State) == emrcontainers.VirtualClusterStateTerminated {
+				continue
+			}
+
+			virtualClusterID := aws.StringValue(v.Id)
+			input := &emrcontainers.ListManagedEndpointsInput{
+				VirtualClusterId: aws.String(virtualClusterID),
+			}
+
+			err := conn.ListManagedEndpointsPages(input, func(page *emrcontainers.ListManagedEndpointsOutput, lastPage bool) bool {
+				if page == nil {
+					return !lastPage
+				}
+
+				for _, v := range page.Endpoints {
+					if aws.StringValue(v.State) == emrcontainers.EndpointStateTerminated {
+						continue
+					}
+
+					r := ResourceManagedEndpoint()
+					d := r.Data(nil)
+					d.SetId(ManagedEndpointCreateResourceID(virtualClusterID, aws.StringValue(v.Id)))
+
+					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+				}
+
+				return !lastPage
+			})
+
+			if err != nil {
+				errs = multierror.Append(errs, fmt.Errorf("error listing EMR Containers Managed Endpoints (%s): %w", virtualClusterID, err))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping EMR Containers Managed Endpoint sweep for %s: %s", region, err)
+		return errs.ErrorOrNil()
+	}
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing EMR Containers Virtual Clusters (%s): %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping EMR Containers Managed Endpoints (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
+
 func sweepVirtualClusters(region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
@@ -96,6 +96,11 @@ func ResourceVirtualCluster() *schema.Resource {
 					validation.StringMatch(regexp.MustCompile(`[.\-_/#A-Za-z0-9]+`), "must contain only alphanumeric, hyphen, underscore, dot and # characters"),
 				),
 			},
+			"security_configuration_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
@@ -118,6 +123,10 @@ func resourceVirtualClusterCreate(ctx context.Context, d *schema.ResourceData, m
 		input.ContainerProvider = expandContainerProvider(v.([]interface{})[0].(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("security_configuration_id"); ok {
+		input.SecurityConfigurationId = aws.String(v.(string))
+	}
+
 	if len(tags) > 0 {
 		input.Tags = Tags(tags.IgnoreAWS())
 	}
@@ -160,6 +169,7 @@ func resourceVirtualClusterRead(ctx context.Context, d *schema.ResourceData, met
 		d.Set("container_provider", nil)
 	}
 	d.Set("name", vc.Name)
+	d.Set("security_configuration_id", vc.SecurityConfigurationId)
 
 	tags := KeyValueTags(vc.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
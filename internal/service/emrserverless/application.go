@@ -121,6 +121,26 @@ func ResourceApplication() *schema.Resource {
 					},
 				},
 			},
+			"interactive_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"livy_endpoint_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"studio_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"maximum_capacity": {
 				Type:             schema.TypeList,
 				Optional:         true,
@@ -143,6 +163,114 @@ func ResourceApplication() *schema.Resource {
 					},
 				},
 			},
+			"monitoring_configuration": {
+				Type:             schema.TypeList,
+				Optional:         true,
+				DiffSuppressFunc: verify.SuppressMissingOptionalConfigurationBlock,
+				MaxItems:         1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cloudwatch_logging_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enabled": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+									"encryption_key_arn": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"log_group_name": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 512),
+									},
+									"log_stream_name_prefix": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 512),
+									},
+									"log_types": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"name": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"values": {
+													Type:     schema.TypeSet,
+													Required: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"managed_persistence_monitoring_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  true,
+									},
+									"encryption_key_arn": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						"prometheus_monitoring_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"remote_write_url": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 10280),
+									},
+								},
+							},
+						},
+						"s3_monitoring_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"encryption_key_arn": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"log_uri": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 10280),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"name": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -215,10 +343,18 @@ func resourceApplicationCreate(d *schema.ResourceData, meta interface{}) error {
 		input.InitialCapacity = expandInitialCapacity(v.(*schema.Set))
 	}
 
+	if v, ok := d.GetOk("interactive_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.InteractiveConfiguration = expandInteractiveConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("maximum_capacity"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		input.MaximumCapacity = expandMaximumCapacity(v.([]interface{})[0].(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("monitoring_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.MonitoringConfiguration = expandMonitoringConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("network_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		input.NetworkConfiguration = expandNetworkConfiguration(v.([]interface{})[0].(map[string]interface{}))
 	}
@@ -277,10 +413,18 @@ func resourceApplicationRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("setting initial_capacity: %w", err)
 	}
 
+	if err := d.Set("interactive_configuration", []interface{}{flattenInteractiveConfiguration(application.InteractiveConfiguration)}); err != nil {
+		return fmt.Errorf("setting interactive_configuration: %w", err)
+	}
+
 	if err := d.Set("maximum_capacity", []interface{}{flattenMaximumCapacity(application.MaximumCapacity)}); err != nil {
 		return fmt.Errorf("setting maximum_capacity: %w", err)
 	}
 
+	if err := d.Set("monitoring_configuration", []interface{}{flattenMonitoringConfiguration(application.MonitoringConfiguration)}); err != nil {
+		return fmt.Errorf("setting monitoring_configuration: %w", err)
+	}
+
 	if err := d.Set("network_configuration", []interface{}{flattenNetworkConfiguration(application.NetworkConfiguration)}); err != nil {
 		return fmt.Errorf("setting network_configuration: %w", err)
 	}
@@ -320,10 +464,18 @@ func resourceApplicationUpdate(d *schema.ResourceData, meta interface{}) error {
 			input.InitialCapacity = expandInitialCapacity(v.(*schema.Set))
 		}
 
+		if v, ok := d.GetOk("interactive_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.InteractiveConfiguration = expandInteractiveConfiguration(v.([]interface{})[0].(map[string]interface{}))
+		}
+
 		if v, ok := d.GetOk("maximum_capacity"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 			input.MaximumCapacity = expandMaximumCapacity(v.([]interface{})[0].(map[string]interface{}))
 		}
 
+		if v, ok := d.GetOk("monitoring_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.MonitoringConfiguration = expandMonitoringConfiguration(v.([]interface{})[0].(map[string]interface{}))
+		}
+
 		if v, ok := d.GetOk("network_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 			input.NetworkConfiguration = expandNetworkConfiguration(v.([]interface{})[0].(map[string]interface{}))
 		}
@@ -514,6 +666,293 @@ func flattenNetworkConfiguration(apiObject *emrserverless.NetworkConfiguration)
 	return tfMap
 }
 
+func expandInteractiveConfiguration(tfMap map[string]interface{}) *emrserverless.InteractiveConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &emrserverless.InteractiveConfiguration{}
+
+	if v, ok := tfMap["livy_endpoint_enabled"].(bool); ok {
+		apiObject.LivyEndpointEnabled = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["studio_enabled"].(bool); ok {
+		apiObject.StudioEnabled = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func flattenInteractiveConfiguration(apiObject *emrserverless.InteractiveConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.LivyEndpointEnabled; v != nil {
+		tfMap["livy_endpoint_enabled"] = aws.BoolValue(v)
+	}
+
+	if v := apiObject.StudioEnabled; v != nil {
+		tfMap["studio_enabled"] = aws.BoolValue(v)
+	}
+
+	return tfMap
+}
+
+func expandMonitoringConfiguration(tfMap map[string]interface{}) *emrserverless.MonitoringConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &emrserverless.MonitoringConfiguration{}
+
+	if v, ok := tfMap["cloudwatch_logging_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.CloudWatchLoggingConfiguration = expandCloudWatchLoggingConfiguration(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["managed_persistence_monitoring_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ManagedPersistenceMonitoringConfiguration = expandManagedPersistenceMonitoringConfiguration(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["prometheus_monitoring_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.PrometheusMonitoringConfiguration = expandPrometheusMonitoringConfiguration(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["s3_monitoring_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.S3MonitoringConfiguration = expandS3MonitoringConfiguration(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func flattenMonitoringConfiguration(apiObject *emrserverless.MonitoringConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.CloudWatchLoggingConfiguration; v != nil {
+		tfMap["cloudwatch_logging_configuration"] = []interface{}{flattenCloudWatchLoggingConfiguration(v)}
+	}
+
+	if v := apiObject.ManagedPersistenceMonitoringConfiguration; v != nil {
+		tfMap["managed_persistence_monitoring_configuration"] = []interface{}{flattenManagedPersistenceMonitoringConfiguration(v)}
+	}
+
+	if v := apiObject.PrometheusMonitoringConfiguration; v != nil {
+		tfMap["prometheus_monitoring_configuration"] = []interface{}{flattenPrometheusMonitoringConfiguration(v)}
+	}
+
+	if v := apiObject.S3MonitoringConfiguration; v != nil {
+		tfMap["s3_monitoring_configuration"] = []interface{}{flattenS3MonitoringConfiguration(v)}
+	}
+
+	return tfMap
+}
+
+func expandCloudWatchLoggingConfiguration(tfMap map[string]interface{}) *emrserverless.CloudWatchLoggingConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &emrserverless.CloudWatchLoggingConfiguration{}
+
+	if v, ok := tfMap["enabled"].(bool); ok {
+		apiObject.Enabled = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["encryption_key_arn"].(string); ok && v != "" {
+		apiObject.EncryptionKeyArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["log_group_name"].(string); ok && v != "" {
+		apiObject.LogGroupName = aws.String(v)
+	}
+
+	if v, ok := tfMap["log_stream_name_prefix"].(string); ok && v != "" {
+		apiObject.LogStreamNamePrefix = aws.String(v)
+	}
+
+	if v, ok := tfMap["log_types"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.LogTypes = expandLogTypes(v.List())
+	}
+
+	return apiObject
+}
+
+func flattenCloudWatchLoggingConfiguration(apiObject *emrserverless.CloudWatchLoggingConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Enabled; v != nil {
+		tfMap["enabled"] = aws.BoolValue(v)
+	}
+
+	if v := apiObject.EncryptionKeyArn; v != nil {
+		tfMap["encryption_key_arn"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.LogGroupName; v != nil {
+		tfMap["log_group_name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.LogStreamNamePrefix; v != nil {
+		tfMap["log_stream_name_prefix"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.LogTypes; v != nil {
+		tfMap["log_types"] = flattenLogTypes(v)
+	}
+
+	return tfMap
+}
+
+func expandLogTypes(tfList []interface{}) map[string][]*string {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObject := make(map[string][]*string)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		if v, ok := tfMap["name"].(string); ok && v != "" {
+			apiObject[v] = flex.ExpandStringSet(tfMap["values"].(*schema.Set))
+		}
+	}
+
+	return apiObject
+}
+
+func flattenLogTypes(apiObject map[string][]*string) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for name, values := range apiObject {
+		tfList = append(tfList, map[string]interface{}{
+			"name":   name,
+			"values": flex.FlattenStringSet(values),
+		})
+	}
+
+	return tfList
+}
+
+func expandManagedPersistenceMonitoringConfiguration(tfMap map[string]interface{}) *emrserverless.ManagedPersistenceMonitoringConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &emrserverless.ManagedPersistenceMonitoringConfiguration{}
+
+	if v, ok := tfMap["enabled"].(bool); ok {
+		apiObject.Enabled = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["encryption_key_arn"].(string); ok && v != "" {
+		apiObject.EncryptionKeyArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenManagedPersistenceMonitoringConfiguration(apiObject *emrserverless.ManagedPersistenceMonitoringConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Enabled; v != nil {
+		tfMap["enabled"] = aws.BoolValue(v)
+	}
+
+	if v := apiObject.EncryptionKeyArn; v != nil {
+		tfMap["encryption_key_arn"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func expandPrometheusMonitoringConfiguration(tfMap map[string]interface{}) *emrserverless.PrometheusMonitoringConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &emrserverless.PrometheusMonitoringConfiguration{}
+
+	if v, ok := tfMap["remote_write_url"].(string); ok && v != "" {
+		apiObject.RemoteWriteUrl = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenPrometheusMonitoringConfiguration(apiObject *emrserverless.PrometheusMonitoringConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.RemoteWriteUrl; v != nil {
+		tfMap["remote_write_url"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func expandS3MonitoringConfiguration(tfMap map[string]interface{}) *emrserverless.S3MonitoringConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &emrserverless.S3MonitoringConfiguration{}
+
+	if v, ok := tfMap["encryption_key_arn"].(string); ok && v != "" {
+		apiObject.EncryptionKeyArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["log_uri"].(string); ok && v != "" {
+		apiObject.LogUri = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenS3MonitoringConfiguration(apiObject *emrserverless.S3MonitoringConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.EncryptionKeyArn; v != nil {
+		tfMap["encryption_key_arn"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.LogUri; v != nil {
+		tfMap["log_uri"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
 func expandInitialCapacity(tfMap *schema.Set) map[string]*emrserverless.InitialCapacityConfig {
 	if tfMap == nil {
 		return nil
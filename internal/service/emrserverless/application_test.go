@@ -135,6 +135,84 @@ func TestAccEMRServerlessApplication_maxCapacity(t *testing.T) {
 	})
 }
 
+func TestAccEMRServerlessApplication_interactiveConfiguration(t *testing.T) {
+	var application emrserverless.Application
+	resourceName := "aws_emrserverless_application.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, emrserverless.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationConfig_interactiveConfiguration(rName, true, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "interactive_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "interactive_configuration.0.livy_endpoint_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "interactive_configuration.0.studio_enabled", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccApplicationConfig_interactiveConfiguration(rName, false, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "interactive_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "interactive_configuration.0.livy_endpoint_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "interactive_configuration.0.studio_enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEMRServerlessApplication_monitoringConfiguration(t *testing.T) {
+	var application emrserverless.Application
+	resourceName := "aws_emrserverless_application.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, emrserverless.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationConfig_monitoringConfiguration(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "monitoring_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "monitoring_configuration.0.cloudwatch_logging_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "monitoring_configuration.0.cloudwatch_logging_configuration.0.enabled", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "monitoring_configuration.0.cloudwatch_logging_configuration.0.log_group_name", "aws_cloudwatch_log_group.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "monitoring_configuration.0.cloudwatch_logging_configuration.0.log_stream_name_prefix", "prefix"),
+					resource.TestCheckResourceAttr(resourceName, "monitoring_configuration.0.cloudwatch_logging_configuration.0.log_types.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "monitoring_configuration.0.cloudwatch_logging_configuration.0.log_types.*", map[string]string{
+						"name":     "SPARK_DRIVER",
+						"values.#": "2",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "monitoring_configuration.0.managed_persistence_monitoring_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "monitoring_configuration.0.managed_persistence_monitoring_configuration.0.enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "monitoring_configuration.0.s3_monitoring_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "monitoring_configuration.0.s3_monitoring_configuration.0.log_uri", fmt.Sprintf("s3://%s/logs/", rName)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccEMRServerlessApplication_network(t *testing.T) {
 	var application emrserverless.Application
 	resourceName := "aws_emrserverless_application.test"
@@ -326,6 +404,61 @@ resource "aws_emrserverless_application" "test" {
 `, rName, cpu)
 }
 
+func testAccApplicationConfig_interactiveConfiguration(rName string, livyEndpointEnabled, studioEnabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_emrserverless_application" "test" {
+  name          = %[1]q
+  release_label = "emr-6.14.0"
+  type          = "spark"
+
+  interactive_configuration {
+    livy_endpoint_enabled = %[2]t
+    studio_enabled        = %[3]t
+  }
+}
+`, rName, livyEndpointEnabled, studioEnabled)
+}
+
+func testAccApplicationConfig_monitoringConfiguration(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_log_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_emrserverless_application" "test" {
+  name          = %[1]q
+  release_label = "emr-6.14.0"
+  type          = "spark"
+
+  monitoring_configuration {
+    cloudwatch_logging_configuration {
+      enabled                = true
+      log_group_name         = aws_cloudwatch_log_group.test.name
+      log_stream_name_prefix = "prefix"
+
+      log_types {
+        name   = "SPARK_DRIVER"
+        values = ["STDOUT", "STDERR"]
+      }
+    }
+
+    managed_persistence_monitoring_configuration {
+      enabled = false
+    }
+
+    s3_monitoring_configuration {
+      log_uri = "s3://${aws_s3_bucket.test.bucket}/logs/"
+    }
+  }
+}
+`, rName)
+}
+
 func testAccApplicationConfig_network(rName string) string {
 	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 2), fmt.Sprintf(`
 resource "aws_security_group" "test" {
@@ -0,0 +1,111 @@
+package entityresolution
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/entityresolution"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindSchemaMappingByName(ctx context.Context, conn *entityresolution.EntityResolution, name string) (*entityresolution.GetSchemaMappingOutput, error) {
+	input := &entityresolution.GetSchemaMappingInput{
+		SchemaName: aws.String(name),
+	}
+
+	output, err := conn.GetSchemaMappingWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, entityresolution.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindMatchingWorkflowByName(ctx context.Context, conn *entityresolution.EntityResolution, name string) (*entityresolution.GetMatchingWorkflowOutput, error) {
+	input := &entityresolution.GetMatchingWorkflowInput{
+		WorkflowName: aws.String(name),
+	}
+
+	output, err := conn.GetMatchingWorkflowWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, entityresolution.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindIDNamespaceByName(ctx context.Context, conn *entityresolution.EntityResolution, name string) (*entityresolution.GetIdNamespaceOutput, error) {
+	input := &entityresolution.GetIdNamespaceInput{
+		IdNamespaceName: aws.String(name),
+	}
+
+	output, err := conn.GetIdNamespaceWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, entityresolution.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindIDMappingWorkflowByName(ctx context.Context, conn *entityresolution.EntityResolution, name string) (*entityresolution.GetIdMappingWorkflowOutput, error) {
+	input := &entityresolution.GetIdMappingWorkflowInput{
+		WorkflowName: aws.String(name),
+	}
+
+	output, err := conn.GetIdMappingWorkflowWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, entityresolution.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
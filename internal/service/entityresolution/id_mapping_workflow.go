@@ -0,0 +1,496 @@
+package entityresolution
+
+import (
+	"context"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/entityresolution"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceIDMappingWorkflow() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceIDMappingWorkflowCreate,
+		ReadWithoutTimeout:   resourceIDMappingWorkflowRead,
+		UpdateWithoutTimeout: resourceIDMappingWorkflowUpdate,
+		DeleteWithoutTimeout: resourceIDMappingWorkflowDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"id_mapping_techniques": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id_mapping_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(entityresolution.IdMappingType_Values(), false),
+						},
+						"provider_properties": {
+							Type:          schema.TypeList,
+							Optional:      true,
+							MaxItems:      1,
+							ConflictsWith: []string{"id_mapping_techniques.0.rule_based_properties"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"intermediate_s3_path": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"provider_service_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						"rule_based_properties": {
+							Type:          schema.TypeList,
+							Optional:      true,
+							MaxItems:      1,
+							ConflictsWith: []string{"id_mapping_techniques.0.provider_properties"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"attribute_matching_model": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(entityresolution.AttributeMatchingModel_Values(), false),
+									},
+									"record_matching_model": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(entityresolution.RecordMatchingModel_Values(), false),
+									},
+									"rule": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 25,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"matching_keys": {
+													Type:     schema.TypeList,
+													Required: true,
+													MinItems: 1,
+													MaxItems: 15,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+												"rule_name": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 255),
+												},
+											},
+										},
+									},
+									"rule_definition_type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(entityresolution.IdMappingWorkflowRuleDefinitionType_Values(), false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"input_source_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 20,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"input_source_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"schema_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(entityresolution.IdNamespaceType_Values(), false),
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 255),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z_0-9-]*$`), "must contain only alphanumeric characters, hyphens and underscores"),
+				),
+			},
+			"output_source_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kms_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"output_s3_path": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceIDMappingWorkflowCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EntityResolutionConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &entityresolution.CreateIdMappingWorkflowInput{
+		InputSourceConfig: expandIDMappingWorkflowInputSources(d.Get("input_source_config").([]interface{})),
+		WorkflowName:      aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("id_mapping_techniques"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.IdMappingTechniques = expandIDMappingTechniques(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("output_source_config"); ok && len(v.([]interface{})) > 0 {
+		input.OutputSourceConfig = expandIDMappingWorkflowOutputSources(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("role_arn"); ok {
+		input.RoleArn = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Entity Resolution ID Mapping Workflow: %s", input)
+	output, err := conn.CreateIdMappingWorkflowWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Entity Resolution ID Mapping Workflow (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.WorkflowName))
+
+	return resourceIDMappingWorkflowRead(ctx, d, meta)
+}
+
+func resourceIDMappingWorkflowRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EntityResolutionConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindIDMappingWorkflowByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Entity Resolution ID Mapping Workflow (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Entity Resolution ID Mapping Workflow (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.WorkflowArn)
+	d.Set("created_at", aws.TimeValue(output.CreatedAt).Format(time.RFC3339))
+	d.Set("description", output.Description)
+	if output.IdMappingTechniques != nil {
+		if err := d.Set("id_mapping_techniques", []interface{}{flattenIDMappingTechniques(output.IdMappingTechniques)}); err != nil {
+			return diag.Errorf("setting id_mapping_techniques: %s", err)
+		}
+	} else {
+		d.Set("id_mapping_techniques", nil)
+	}
+	if err := d.Set("input_source_config", flattenIDMappingWorkflowInputSources(output.InputSourceConfig)); err != nil {
+		return diag.Errorf("setting input_source_config: %s", err)
+	}
+	d.Set("name", output.WorkflowName)
+	if err := d.Set("output_source_config", flattenIDMappingWorkflowOutputSources(output.OutputSourceConfig)); err != nil {
+		return diag.Errorf("setting output_source_config: %s", err)
+	}
+	d.Set("role_arn", output.RoleArn)
+	d.Set("updated_at", aws.TimeValue(output.UpdatedAt).Format(time.RFC3339))
+
+	tags := KeyValueTags(output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceIDMappingWorkflowUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EntityResolutionConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &entityresolution.UpdateIdMappingWorkflowInput{
+			Description:       aws.String(d.Get("description").(string)),
+			InputSourceConfig: expandIDMappingWorkflowInputSources(d.Get("input_source_config").([]interface{})),
+			WorkflowName:      aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("id_mapping_techniques"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.IdMappingTechniques = expandIDMappingTechniques(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if v, ok := d.GetOk("output_source_config"); ok && len(v.([]interface{})) > 0 {
+			input.OutputSourceConfig = expandIDMappingWorkflowOutputSources(v.([]interface{}))
+		}
+
+		if v, ok := d.GetOk("role_arn"); ok {
+			input.RoleArn = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Updating Entity Resolution ID Mapping Workflow: %s", input)
+		_, err := conn.UpdateIdMappingWorkflowWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating Entity Resolution ID Mapping Workflow (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating Entity Resolution ID Mapping Workflow (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceIDMappingWorkflowRead(ctx, d, meta)
+}
+
+func resourceIDMappingWorkflowDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EntityResolutionConn
+
+	log.Printf("[DEBUG] Deleting Entity Resolution ID Mapping Workflow: %s", d.Id())
+	_, err := conn.DeleteIdMappingWorkflowWithContext(ctx, &entityresolution.DeleteIdMappingWorkflowInput{
+		WorkflowName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, entityresolution.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Entity Resolution ID Mapping Workflow (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandIDMappingTechniques(tfMap map[string]interface{}) *entityresolution.IdMappingTechniques {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &entityresolution.IdMappingTechniques{
+		IdMappingType: aws.String(tfMap["id_mapping_type"].(string)),
+	}
+
+	if v, ok := tfMap["provider_properties"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ProviderProperties = expandProviderProperties(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["rule_based_properties"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.RuleBasedProperties = expandIDMappingRuleBasedProperties(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandIDMappingRuleBasedProperties(tfMap map[string]interface{}) *entityresolution.IdMappingRuleBasedProperties {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &entityresolution.IdMappingRuleBasedProperties{
+		AttributeMatchingModel: aws.String(tfMap["attribute_matching_model"].(string)),
+		RecordMatchingModel:    aws.String(tfMap["record_matching_model"].(string)),
+		RuleDefinitionType:     aws.String(tfMap["rule_definition_type"].(string)),
+	}
+
+	if v, ok := tfMap["rule"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Rules = expandRules(v)
+	}
+
+	return apiObject
+}
+
+func expandIDMappingWorkflowInputSources(tfList []interface{}) []*entityresolution.IdMappingWorkflowInputSource {
+	var apiObjects []*entityresolution.IdMappingWorkflowInputSource
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &entityresolution.IdMappingWorkflowInputSource{
+			InputSourceARN: aws.String(tfMap["input_source_arn"].(string)),
+		}
+
+		if v, ok := tfMap["schema_name"].(string); ok && v != "" {
+			apiObject.SchemaName = aws.String(v)
+		}
+
+		if v, ok := tfMap["type"].(string); ok && v != "" {
+			apiObject.Type = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandIDMappingWorkflowOutputSources(tfList []interface{}) []*entityresolution.IdMappingWorkflowOutputSource {
+	var apiObjects []*entityresolution.IdMappingWorkflowOutputSource
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &entityresolution.IdMappingWorkflowOutputSource{
+			OutputS3Path: aws.String(tfMap["output_s3_path"].(string)),
+		}
+
+		if v, ok := tfMap["kms_arn"].(string); ok && v != "" {
+			apiObject.KMSArn = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenIDMappingTechniques(apiObject *entityresolution.IdMappingTechniques) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"id_mapping_type": aws.StringValue(apiObject.IdMappingType),
+	}
+
+	if v := apiObject.ProviderProperties; v != nil {
+		tfMap["provider_properties"] = []interface{}{flattenProviderProperties(v)}
+	}
+
+	if v := apiObject.RuleBasedProperties; v != nil {
+		tfMap["rule_based_properties"] = []interface{}{map[string]interface{}{
+			"attribute_matching_model": aws.StringValue(v.AttributeMatchingModel),
+			"record_matching_model":    aws.StringValue(v.RecordMatchingModel),
+			"rule":                     flattenRules(v.Rules),
+			"rule_definition_type":     aws.StringValue(v.RuleDefinitionType),
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenIDMappingWorkflowInputSources(apiObjects []*entityresolution.IdMappingWorkflowInputSource) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"input_source_arn": aws.StringValue(apiObject.InputSourceARN),
+			"schema_name":      aws.StringValue(apiObject.SchemaName),
+			"type":             aws.StringValue(apiObject.Type),
+		})
+	}
+
+	return tfList
+}
+
+func flattenIDMappingWorkflowOutputSources(apiObjects []*entityresolution.IdMappingWorkflowOutputSource) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"kms_arn":        aws.StringValue(apiObject.KMSArn),
+			"output_s3_path": aws.StringValue(apiObject.OutputS3Path),
+		})
+	}
+
+	return tfList
+}
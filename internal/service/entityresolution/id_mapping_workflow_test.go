@@ -0,0 +1,262 @@
+package entityresolution_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/entityresolution"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfentityresolution "github.com/hashicorp/terraform-provider-aws/internal/service/entityresolution"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccEntityResolutionIDMappingWorkflow_basic(t *testing.T) {
+	var v entityresolution.GetIdMappingWorkflowOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_entityresolution_id_mapping_workflow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, entityresolution.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIDMappingWorkflowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIDMappingWorkflowConfig_basic(rName, "desc"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDMappingWorkflowExists(resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "entityresolution", regexp.MustCompile(`idmappingworkflow/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+					resource.TestCheckResourceAttr(resourceName, "description", "desc"),
+					resource.TestCheckResourceAttr(resourceName, "id_mapping_techniques.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "id_mapping_techniques.0.id_mapping_type", "RULE_BASED"),
+					resource.TestCheckResourceAttr(resourceName, "id_mapping_techniques.0.rule_based_properties.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "id_mapping_techniques.0.rule_based_properties.0.attribute_matching_model", "ONE_TO_ONE"),
+					resource.TestCheckResourceAttr(resourceName, "id_mapping_techniques.0.rule_based_properties.0.record_matching_model", "ONE_SOURCE_TO_ONE_TARGET"),
+					resource.TestCheckResourceAttr(resourceName, "id_mapping_techniques.0.rule_based_properties.0.rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "id_mapping_techniques.0.rule_based_properties.0.rule_definition_type", "SOURCE"),
+					resource.TestCheckResourceAttr(resourceName, "input_source_config.#", "2"),
+					resource.TestCheckResourceAttrPair(resourceName, "input_source_config.0.input_source_arn", "aws_glue_catalog_table.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "input_source_config.0.type", "SOURCE"),
+					resource.TestCheckResourceAttr(resourceName, "input_source_config.1.type", "TARGET"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "output_source_config.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccIDMappingWorkflowConfig_basic(rName, "updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDMappingWorkflowExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEntityResolutionIDMappingWorkflow_disappears(t *testing.T) {
+	var v entityresolution.GetIdMappingWorkflowOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_entityresolution_id_mapping_workflow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, entityresolution.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIDMappingWorkflowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIDMappingWorkflowConfig_basic(rName, "desc"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDMappingWorkflowExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfentityresolution.ResourceIDMappingWorkflow(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccEntityResolutionIDMappingWorkflow_providerProperties(t *testing.T) {
+	key := "ENTITY_RESOLUTION_PROVIDER_SERVICE_ARN"
+	providerServiceARN := os.Getenv(key)
+	if providerServiceARN == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+
+	var v entityresolution.GetIdMappingWorkflowOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_entityresolution_id_mapping_workflow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, entityresolution.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIDMappingWorkflowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIDMappingWorkflowConfig_providerProperties(rName, providerServiceARN),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDMappingWorkflowExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "id_mapping_techniques.0.id_mapping_type", "PROVIDER"),
+					resource.TestCheckResourceAttr(resourceName, "id_mapping_techniques.0.provider_properties.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "id_mapping_techniques.0.provider_properties.0.provider_service_arn", providerServiceARN),
+					resource.TestCheckResourceAttrSet(resourceName, "id_mapping_techniques.0.provider_properties.0.intermediate_s3_path"),
+					resource.TestCheckResourceAttr(resourceName, "id_mapping_techniques.0.rule_based_properties.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIDMappingWorkflowDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EntityResolutionConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_entityresolution_id_mapping_workflow" {
+			continue
+		}
+
+		_, err := tfentityresolution.FindIDMappingWorkflowByName(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Entity Resolution ID Mapping Workflow %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckIDMappingWorkflowExists(n string, v *entityresolution.GetIdMappingWorkflowOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Entity Resolution ID Mapping Workflow ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EntityResolutionConn
+
+		output, err := tfentityresolution.FindIDMappingWorkflowByName(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccIDMappingWorkflowConfig_basic(rName, description string) string {
+	return acctest.ConfigCompose(testAccMatchingWorkflowConfig_base(rName), fmt.Sprintf(`
+resource "aws_entityresolution_id_namespace" "test" {
+  name     = "%[1]s-target"
+  type     = "TARGET"
+  role_arn = aws_iam_role.test.arn
+
+  input_source_config {
+    input_source_arn = aws_glue_catalog_table.test.arn
+    schema_name      = aws_entityresolution_schema_mapping.test.name
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+
+resource "aws_entityresolution_id_mapping_workflow" "test" {
+  name        = %[1]q
+  description = %[2]q
+  role_arn    = aws_iam_role.test.arn
+
+  input_source_config {
+    input_source_arn = aws_glue_catalog_table.test.arn
+    schema_name      = aws_entityresolution_schema_mapping.test.name
+    type             = "SOURCE"
+  }
+
+  input_source_config {
+    input_source_arn = aws_entityresolution_id_namespace.test.arn
+    type             = "TARGET"
+  }
+
+  output_source_config {
+    output_s3_path = "s3://${aws_s3_bucket.test.bucket}/output/"
+  }
+
+  id_mapping_techniques {
+    id_mapping_type = "RULE_BASED"
+
+    rule_based_properties {
+      attribute_matching_model = "ONE_TO_ONE"
+      record_matching_model    = "ONE_SOURCE_TO_ONE_TARGET"
+      rule_definition_type     = "SOURCE"
+
+      rule {
+        rule_name     = "email"
+        matching_keys = ["email"]
+      }
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, description))
+}
+
+func testAccIDMappingWorkflowConfig_providerProperties(rName, providerServiceARN string) string {
+	return acctest.ConfigCompose(testAccMatchingWorkflowConfig_base(rName), fmt.Sprintf(`
+resource "aws_entityresolution_id_mapping_workflow" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+
+  input_source_config {
+    input_source_arn = aws_glue_catalog_table.test.arn
+    schema_name      = aws_entityresolution_schema_mapping.test.name
+  }
+
+  output_source_config {
+    output_s3_path = "s3://${aws_s3_bucket.test.bucket}/output/"
+  }
+
+  id_mapping_techniques {
+    id_mapping_type = "PROVIDER"
+
+    provider_properties {
+      provider_service_arn = %[2]q
+      intermediate_s3_path = "s3://${aws_s3_bucket.test.bucket}/intermediate/"
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, providerServiceARN))
+}
@@ -0,0 +1,445 @@
+package entityresolution
+
+import (
+	"context"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/entityresolution"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceIDNamespace() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceIDNamespaceCreate,
+		ReadWithoutTimeout:   resourceIDNamespaceRead,
+		UpdateWithoutTimeout: resourceIDNamespaceUpdate,
+		DeleteWithoutTimeout: resourceIDNamespaceDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"id_mapping_workflow_properties": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id_mapping_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(entityresolution.IdMappingType_Values(), false),
+						},
+						"provider_properties": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"provider_service_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						"rule_based_properties": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"attribute_matching_model": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(entityresolution.AttributeMatchingModel_Values(), false),
+									},
+									"record_matching_models": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringInSlice(entityresolution.RecordMatchingModel_Values(), false),
+										},
+									},
+									"rule": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 25,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"matching_keys": {
+													Type:     schema.TypeList,
+													Required: true,
+													MinItems: 1,
+													MaxItems: 15,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+												"rule_name": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 255),
+												},
+											},
+										},
+									},
+									"rule_definition_types": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringInSlice(entityresolution.IdMappingWorkflowRuleDefinitionType_Values(), false),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"input_source_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 20,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"input_source_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"schema_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 255),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z_0-9-]*$`), "must contain only alphanumeric characters, hyphens and underscores"),
+				),
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(entityresolution.IdNamespaceType_Values(), false),
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceIDNamespaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EntityResolutionConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &entityresolution.CreateIdNamespaceInput{
+		IdNamespaceName: aws.String(name),
+		Type:            aws.String(d.Get("type").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("id_mapping_workflow_properties"); ok && len(v.([]interface{})) > 0 {
+		input.IdMappingWorkflowProperties = expandIDNamespaceIDMappingWorkflowProperties(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("input_source_config"); ok && len(v.([]interface{})) > 0 {
+		input.InputSourceConfig = expandIDNamespaceInputSources(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("role_arn"); ok {
+		input.RoleArn = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Entity Resolution ID Namespace: %s", input)
+	output, err := conn.CreateIdNamespaceWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Entity Resolution ID Namespace (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.IdNamespaceName))
+
+	return resourceIDNamespaceRead(ctx, d, meta)
+}
+
+func resourceIDNamespaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EntityResolutionConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindIDNamespaceByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Entity Resolution ID Namespace (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Entity Resolution ID Namespace (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.IdNamespaceArn)
+	d.Set("created_at", aws.TimeValue(output.CreatedAt).Format(time.RFC3339))
+	d.Set("description", output.Description)
+	if err := d.Set("id_mapping_workflow_properties", flattenIDNamespaceIDMappingWorkflowProperties(output.IdMappingWorkflowProperties)); err != nil {
+		return diag.Errorf("setting id_mapping_workflow_properties: %s", err)
+	}
+	if err := d.Set("input_source_config", flattenIDNamespaceInputSources(output.InputSourceConfig)); err != nil {
+		return diag.Errorf("setting input_source_config: %s", err)
+	}
+	d.Set("name", output.IdNamespaceName)
+	d.Set("role_arn", output.RoleArn)
+	d.Set("type", output.Type)
+	d.Set("updated_at", aws.TimeValue(output.UpdatedAt).Format(time.RFC3339))
+
+	tags := KeyValueTags(output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceIDNamespaceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EntityResolutionConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &entityresolution.UpdateIdNamespaceInput{
+			Description:                 aws.String(d.Get("description").(string)),
+			IdMappingWorkflowProperties: expandIDNamespaceIDMappingWorkflowProperties(d.Get("id_mapping_workflow_properties").([]interface{})),
+			IdNamespaceName:             aws.String(d.Id()),
+			InputSourceConfig:           expandIDNamespaceInputSources(d.Get("input_source_config").([]interface{})),
+		}
+
+		if v, ok := d.GetOk("role_arn"); ok {
+			input.RoleArn = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Updating Entity Resolution ID Namespace: %s", input)
+		_, err := conn.UpdateIdNamespaceWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating Entity Resolution ID Namespace (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating Entity Resolution ID Namespace (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceIDNamespaceRead(ctx, d, meta)
+}
+
+func resourceIDNamespaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EntityResolutionConn
+
+	log.Printf("[DEBUG] Deleting Entity Resolution ID Namespace: %s", d.Id())
+	_, err := conn.DeleteIdNamespaceWithContext(ctx, &entityresolution.DeleteIdNamespaceInput{
+		IdNamespaceName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, entityresolution.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Entity Resolution ID Namespace (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandIDNamespaceIDMappingWorkflowProperties(tfList []interface{}) []*entityresolution.IdNamespaceIdMappingWorkflowProperties {
+	var apiObjects []*entityresolution.IdNamespaceIdMappingWorkflowProperties
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &entityresolution.IdNamespaceIdMappingWorkflowProperties{
+			IdMappingType: aws.String(tfMap["id_mapping_type"].(string)),
+		}
+
+		if v, ok := tfMap["provider_properties"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.ProviderProperties = &entityresolution.NamespaceProviderProperties{
+				ProviderServiceArn: aws.String(v[0].(map[string]interface{})["provider_service_arn"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["rule_based_properties"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.RuleBasedProperties = expandNamespaceRuleBasedProperties(v[0].(map[string]interface{}))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandNamespaceRuleBasedProperties(tfMap map[string]interface{}) *entityresolution.NamespaceRuleBasedProperties {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &entityresolution.NamespaceRuleBasedProperties{}
+
+	if v, ok := tfMap["attribute_matching_model"].(string); ok && v != "" {
+		apiObject.AttributeMatchingModel = aws.String(v)
+	}
+
+	if v, ok := tfMap["record_matching_models"].([]interface{}); ok && len(v) > 0 {
+		apiObject.RecordMatchingModels = flex.ExpandStringList(v)
+	}
+
+	if v, ok := tfMap["rule"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Rules = expandRules(v)
+	}
+
+	if v, ok := tfMap["rule_definition_types"].([]interface{}); ok && len(v) > 0 {
+		apiObject.RuleDefinitionTypes = flex.ExpandStringList(v)
+	}
+
+	return apiObject
+}
+
+func expandIDNamespaceInputSources(tfList []interface{}) []*entityresolution.IdNamespaceInputSource {
+	var apiObjects []*entityresolution.IdNamespaceInputSource
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &entityresolution.IdNamespaceInputSource{
+			InputSourceARN: aws.String(tfMap["input_source_arn"].(string)),
+		}
+
+		if v, ok := tfMap["schema_name"].(string); ok && v != "" {
+			apiObject.SchemaName = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenIDNamespaceIDMappingWorkflowProperties(apiObjects []*entityresolution.IdNamespaceIdMappingWorkflowProperties) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"id_mapping_type": aws.StringValue(apiObject.IdMappingType),
+		}
+
+		if v := apiObject.ProviderProperties; v != nil {
+			tfMap["provider_properties"] = []interface{}{map[string]interface{}{
+				"provider_service_arn": aws.StringValue(v.ProviderServiceArn),
+			}}
+		}
+
+		if v := apiObject.RuleBasedProperties; v != nil {
+			tfMap["rule_based_properties"] = []interface{}{map[string]interface{}{
+				"attribute_matching_model": aws.StringValue(v.AttributeMatchingModel),
+				"record_matching_models":   aws.StringValueSlice(v.RecordMatchingModels),
+				"rule":                     flattenRules(v.Rules),
+				"rule_definition_types":    aws.StringValueSlice(v.RuleDefinitionTypes),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenIDNamespaceInputSources(apiObjects []*entityresolution.IdNamespaceInputSource) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"input_source_arn": aws.StringValue(apiObject.InputSourceARN),
+			"schema_name":      aws.StringValue(apiObject.SchemaName),
+		})
+	}
+
+	return tfList
+}
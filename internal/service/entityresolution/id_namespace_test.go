@@ -0,0 +1,204 @@
+package entityresolution_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/entityresolution"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfentityresolution "github.com/hashicorp/terraform-provider-aws/internal/service/entityresolution"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccEntityResolutionIDNamespace_basic(t *testing.T) {
+	var v entityresolution.GetIdNamespaceOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_entityresolution_id_namespace.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, entityresolution.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIDNamespaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIDNamespaceConfig_basic(rName, "desc"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDNamespaceExists(resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "entityresolution", regexp.MustCompile(`idnamespace/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+					resource.TestCheckResourceAttr(resourceName, "description", "desc"),
+					resource.TestCheckResourceAttr(resourceName, "input_source_config.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "input_source_config.0.input_source_arn", "aws_glue_catalog_table.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "input_source_config.0.schema_name", "aws_entityresolution_schema_mapping.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "type", "SOURCE"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccIDNamespaceConfig_basic(rName, "updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDNamespaceExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEntityResolutionIDNamespace_disappears(t *testing.T) {
+	var v entityresolution.GetIdNamespaceOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_entityresolution_id_namespace.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, entityresolution.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIDNamespaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIDNamespaceConfig_basic(rName, "desc"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDNamespaceExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfentityresolution.ResourceIDNamespace(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccEntityResolutionIDNamespace_providerProperties(t *testing.T) {
+	key := "ENTITY_RESOLUTION_PROVIDER_SERVICE_ARN"
+	providerServiceARN := os.Getenv(key)
+	if providerServiceARN == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+
+	var v entityresolution.GetIdNamespaceOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_entityresolution_id_namespace.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, entityresolution.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIDNamespaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIDNamespaceConfig_providerProperties(rName, providerServiceARN),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDNamespaceExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "id_mapping_workflow_properties.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "id_mapping_workflow_properties.0.id_mapping_type", "PROVIDER"),
+					resource.TestCheckResourceAttr(resourceName, "id_mapping_workflow_properties.0.provider_properties.0.provider_service_arn", providerServiceARN),
+					resource.TestCheckResourceAttr(resourceName, "type", "TARGET"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIDNamespaceDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EntityResolutionConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_entityresolution_id_namespace" {
+			continue
+		}
+
+		_, err := tfentityresolution.FindIDNamespaceByName(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Entity Resolution ID Namespace %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckIDNamespaceExists(n string, v *entityresolution.GetIdNamespaceOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Entity Resolution ID Namespace ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EntityResolutionConn
+
+		output, err := tfentityresolution.FindIDNamespaceByName(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccIDNamespaceConfig_basic(rName, description string) string {
+	return acctest.ConfigCompose(testAccMatchingWorkflowConfig_base(rName), fmt.Sprintf(`
+resource "aws_entityresolution_id_namespace" "test" {
+  name        = %[1]q
+  description = %[2]q
+  type        = "SOURCE"
+  role_arn    = aws_iam_role.test.arn
+
+  input_source_config {
+    input_source_arn = aws_glue_catalog_table.test.arn
+    schema_name      = aws_entityresolution_schema_mapping.test.name
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, description))
+}
+
+func testAccIDNamespaceConfig_providerProperties(rName, providerServiceARN string) string {
+	return fmt.Sprintf(`
+resource "aws_entityresolution_id_namespace" "test" {
+  name = %[1]q
+  type = "TARGET"
+
+  id_mapping_workflow_properties {
+    id_mapping_type = "PROVIDER"
+
+    provider_properties {
+      provider_service_arn = %[2]q
+    }
+  }
+}
+`, rName, providerServiceARN)
+}
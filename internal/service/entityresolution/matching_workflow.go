@@ -0,0 +1,687 @@
+package entityresolution
+
+import (
+	"context"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/entityresolution"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceMatchingWorkflow() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceMatchingWorkflowCreate,
+		ReadWithoutTimeout:   resourceMatchingWorkflowRead,
+		UpdateWithoutTimeout: resourceMatchingWorkflowUpdate,
+		DeleteWithoutTimeout: resourceMatchingWorkflowDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"incremental_run_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"incremental_run_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(entityresolution.IncrementalRunType_Values(), false),
+						},
+					},
+				},
+			},
+			"input_source_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 20,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"apply_normalization": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"input_source_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"schema_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 255),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z_0-9-]*$`), "must contain only alphanumeric characters, hyphens and underscores"),
+				),
+			},
+			"output_source_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"apply_normalization": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"kms_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"output": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 750,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"hashed": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"output_s3_path": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"resolution_techniques": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"provider_properties": {
+							Type:          schema.TypeList,
+							Optional:      true,
+							MaxItems:      1,
+							ConflictsWith: []string{"resolution_techniques.0.rule_based_properties"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"intermediate_s3_path": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"provider_service_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						"resolution_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(entityresolution.ResolutionType_Values(), false),
+						},
+						"rule_based_properties": {
+							Type:          schema.TypeList,
+							Optional:      true,
+							MaxItems:      1,
+							ConflictsWith: []string{"resolution_techniques.0.provider_properties"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"attribute_matching_model": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(entityresolution.AttributeMatchingModel_Values(), false),
+									},
+									"match_purpose": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.StringInSlice(entityresolution.MatchPurpose_Values(), false),
+									},
+									"rule": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										MaxItems: 25,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"matching_keys": {
+													Type:     schema.TypeList,
+													Required: true,
+													MinItems: 1,
+													MaxItems: 15,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+												"rule_name": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 255),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceMatchingWorkflowCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EntityResolutionConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &entityresolution.CreateMatchingWorkflowInput{
+		InputSourceConfig:  expandInputSources(d.Get("input_source_config").([]interface{})),
+		OutputSourceConfig: expandOutputSources(d.Get("output_source_config").([]interface{})),
+		RoleArn:            aws.String(d.Get("role_arn").(string)),
+		WorkflowName:       aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("incremental_run_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.IncrementalRunConfig = expandIncrementalRunConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("resolution_techniques"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ResolutionTechniques = expandResolutionTechniques(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Entity Resolution Matching Workflow: %s", input)
+	output, err := conn.CreateMatchingWorkflowWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Entity Resolution Matching Workflow (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.WorkflowName))
+
+	return resourceMatchingWorkflowRead(ctx, d, meta)
+}
+
+func resourceMatchingWorkflowRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EntityResolutionConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindMatchingWorkflowByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Entity Resolution Matching Workflow (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Entity Resolution Matching Workflow (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.WorkflowArn)
+	d.Set("created_at", aws.TimeValue(output.CreatedAt).Format(time.RFC3339))
+	d.Set("description", output.Description)
+	if output.IncrementalRunConfig != nil {
+		if err := d.Set("incremental_run_config", []interface{}{flattenIncrementalRunConfig(output.IncrementalRunConfig)}); err != nil {
+			return diag.Errorf("setting incremental_run_config: %s", err)
+		}
+	} else {
+		d.Set("incremental_run_config", nil)
+	}
+	if err := d.Set("input_source_config", flattenInputSources(output.InputSourceConfig)); err != nil {
+		return diag.Errorf("setting input_source_config: %s", err)
+	}
+	d.Set("name", output.WorkflowName)
+	if err := d.Set("output_source_config", flattenOutputSources(output.OutputSourceConfig)); err != nil {
+		return diag.Errorf("setting output_source_config: %s", err)
+	}
+	if output.ResolutionTechniques != nil {
+		if err := d.Set("resolution_techniques", []interface{}{flattenResolutionTechniques(output.ResolutionTechniques)}); err != nil {
+			return diag.Errorf("setting resolution_techniques: %s", err)
+		}
+	} else {
+		d.Set("resolution_techniques", nil)
+	}
+	d.Set("role_arn", output.RoleArn)
+	d.Set("updated_at", aws.TimeValue(output.UpdatedAt).Format(time.RFC3339))
+
+	tags := KeyValueTags(output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceMatchingWorkflowUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EntityResolutionConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &entityresolution.UpdateMatchingWorkflowInput{
+			Description:        aws.String(d.Get("description").(string)),
+			InputSourceConfig:  expandInputSources(d.Get("input_source_config").([]interface{})),
+			OutputSourceConfig: expandOutputSources(d.Get("output_source_config").([]interface{})),
+			RoleArn:            aws.String(d.Get("role_arn").(string)),
+			WorkflowName:       aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("incremental_run_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.IncrementalRunConfig = expandIncrementalRunConfig(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if v, ok := d.GetOk("resolution_techniques"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.ResolutionTechniques = expandResolutionTechniques(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		log.Printf("[DEBUG] Updating Entity Resolution Matching Workflow: %s", input)
+		_, err := conn.UpdateMatchingWorkflowWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating Entity Resolution Matching Workflow (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating Entity Resolution Matching Workflow (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceMatchingWorkflowRead(ctx, d, meta)
+}
+
+func resourceMatchingWorkflowDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EntityResolutionConn
+
+	log.Printf("[DEBUG] Deleting Entity Resolution Matching Workflow: %s", d.Id())
+	_, err := conn.DeleteMatchingWorkflowWithContext(ctx, &entityresolution.DeleteMatchingWorkflowInput{
+		WorkflowName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, entityresolution.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Entity Resolution Matching Workflow (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandIncrementalRunConfig(tfMap map[string]interface{}) *entityresolution.IncrementalRunConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &entityresolution.IncrementalRunConfig{}
+
+	if v, ok := tfMap["incremental_run_type"].(string); ok && v != "" {
+		apiObject.IncrementalRunType = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandInputSources(tfList []interface{}) []*entityresolution.InputSource {
+	var apiObjects []*entityresolution.InputSource
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &entityresolution.InputSource{
+			InputSourceARN: aws.String(tfMap["input_source_arn"].(string)),
+			SchemaName:     aws.String(tfMap["schema_name"].(string)),
+		}
+
+		if v, ok := tfMap["apply_normalization"].(bool); ok {
+			apiObject.ApplyNormalization = aws.Bool(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandOutputSources(tfList []interface{}) []*entityresolution.OutputSource {
+	var apiObjects []*entityresolution.OutputSource
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &entityresolution.OutputSource{
+			OutputS3Path: aws.String(tfMap["output_s3_path"].(string)),
+		}
+
+		if v, ok := tfMap["apply_normalization"].(bool); ok {
+			apiObject.ApplyNormalization = aws.Bool(v)
+		}
+
+		if v, ok := tfMap["kms_arn"].(string); ok && v != "" {
+			apiObject.KMSArn = aws.String(v)
+		}
+
+		if v, ok := tfMap["output"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Output = expandOutputAttributes(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandOutputAttributes(tfList []interface{}) []*entityresolution.OutputAttribute {
+	var apiObjects []*entityresolution.OutputAttribute
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &entityresolution.OutputAttribute{
+			Name: aws.String(tfMap["name"].(string)),
+		}
+
+		if v, ok := tfMap["hashed"].(bool); ok && v {
+			apiObject.Hashed = aws.Bool(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandResolutionTechniques(tfMap map[string]interface{}) *entityresolution.ResolutionTechniques {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &entityresolution.ResolutionTechniques{
+		ResolutionType: aws.String(tfMap["resolution_type"].(string)),
+	}
+
+	if v, ok := tfMap["provider_properties"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ProviderProperties = expandProviderProperties(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["rule_based_properties"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.RuleBasedProperties = expandRuleBasedProperties(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandProviderProperties(tfMap map[string]interface{}) *entityresolution.ProviderProperties {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &entityresolution.ProviderProperties{
+		ProviderServiceArn: aws.String(tfMap["provider_service_arn"].(string)),
+	}
+
+	if v, ok := tfMap["intermediate_s3_path"].(string); ok && v != "" {
+		apiObject.IntermediateSourceConfiguration = &entityresolution.IntermediateSourceConfiguration{
+			IntermediateS3Path: aws.String(v),
+		}
+	}
+
+	return apiObject
+}
+
+func expandRuleBasedProperties(tfMap map[string]interface{}) *entityresolution.RuleBasedProperties {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &entityresolution.RuleBasedProperties{
+		AttributeMatchingModel: aws.String(tfMap["attribute_matching_model"].(string)),
+	}
+
+	if v, ok := tfMap["match_purpose"].(string); ok && v != "" {
+		apiObject.MatchPurpose = aws.String(v)
+	}
+
+	if v, ok := tfMap["rule"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Rules = expandRules(v)
+	}
+
+	return apiObject
+}
+
+func expandRules(tfList []interface{}) []*entityresolution.Rule {
+	var apiObjects []*entityresolution.Rule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &entityresolution.Rule{
+			MatchingKeys: flex.ExpandStringList(tfMap["matching_keys"].([]interface{})),
+			RuleName:     aws.String(tfMap["rule_name"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenIncrementalRunConfig(apiObject *entityresolution.IncrementalRunConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"incremental_run_type": aws.StringValue(apiObject.IncrementalRunType),
+	}
+
+	return tfMap
+}
+
+func flattenInputSources(apiObjects []*entityresolution.InputSource) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"apply_normalization": aws.BoolValue(apiObject.ApplyNormalization),
+			"input_source_arn":    aws.StringValue(apiObject.InputSourceARN),
+			"schema_name":         aws.StringValue(apiObject.SchemaName),
+		})
+	}
+
+	return tfList
+}
+
+func flattenOutputSources(apiObjects []*entityresolution.OutputSource) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"apply_normalization": aws.BoolValue(apiObject.ApplyNormalization),
+			"kms_arn":             aws.StringValue(apiObject.KMSArn),
+			"output":              flattenOutputAttributes(apiObject.Output),
+			"output_s3_path":      aws.StringValue(apiObject.OutputS3Path),
+		})
+	}
+
+	return tfList
+}
+
+func flattenOutputAttributes(apiObjects []*entityresolution.OutputAttribute) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"hashed": aws.BoolValue(apiObject.Hashed),
+			"name":   aws.StringValue(apiObject.Name),
+		})
+	}
+
+	return tfList
+}
+
+func flattenResolutionTechniques(apiObject *entityresolution.ResolutionTechniques) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"resolution_type": aws.StringValue(apiObject.ResolutionType),
+	}
+
+	if v := apiObject.ProviderProperties; v != nil {
+		tfMap["provider_properties"] = []interface{}{flattenProviderProperties(v)}
+	}
+
+	if v := apiObject.RuleBasedProperties; v != nil {
+		tfMap["rule_based_properties"] = []interface{}{flattenRuleBasedProperties(v)}
+	}
+
+	return tfMap
+}
+
+func flattenProviderProperties(apiObject *entityresolution.ProviderProperties) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"provider_service_arn": aws.StringValue(apiObject.ProviderServiceArn),
+	}
+
+	if v := apiObject.IntermediateSourceConfiguration; v != nil {
+		tfMap["intermediate_s3_path"] = aws.StringValue(v.IntermediateS3Path)
+	}
+
+	return tfMap
+}
+
+func flattenRuleBasedProperties(apiObject *entityresolution.RuleBasedProperties) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"attribute_matching_model": aws.StringValue(apiObject.AttributeMatchingModel),
+		"match_purpose":            aws.StringValue(apiObject.MatchPurpose),
+		"rule":                     flattenRules(apiObject.Rules),
+	}
+
+	return tfMap
+}
+
+func flattenRules(apiObjects []*entityresolution.Rule) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"matching_keys": aws.StringValueSlice(apiObject.MatchingKeys),
+			"rule_name":     aws.StringValue(apiObject.RuleName),
+		})
+	}
+
+	return tfList
+}
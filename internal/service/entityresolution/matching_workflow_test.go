@@ -0,0 +1,448 @@
+package entityresolution_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/entityresolution"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfentityresolution "github.com/hashicorp/terraform-provider-aws/internal/service/entityresolution"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccEntityResolutionMatchingWorkflow_basic(t *testing.T) {
+	var v entityresolution.GetMatchingWorkflowOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_entityresolution_matching_workflow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, entityresolution.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMatchingWorkflowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMatchingWorkflowConfig_basic(rName, "desc"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMatchingWorkflowExists(resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "entityresolution", regexp.MustCompile(`matchingworkflow/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+					resource.TestCheckResourceAttr(resourceName, "description", "desc"),
+					resource.TestCheckResourceAttr(resourceName, "incremental_run_config.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "input_source_config.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "input_source_config.0.input_source_arn", "aws_glue_catalog_table.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "input_source_config.0.schema_name", "aws_entityresolution_schema_mapping.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "output_source_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "output_source_config.0.output.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "resolution_techniques.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "resolution_techniques.0.resolution_type", "RULE_MATCHING"),
+					resource.TestCheckResourceAttr(resourceName, "resolution_techniques.0.rule_based_properties.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "resolution_techniques.0.rule_based_properties.0.attribute_matching_model", "ONE_TO_ONE"),
+					resource.TestCheckResourceAttr(resourceName, "resolution_techniques.0.rule_based_properties.0.rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "resolution_techniques.0.rule_based_properties.0.rule.0.matching_keys.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "resolution_techniques.0.rule_based_properties.0.rule.0.rule_name", "email"),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMatchingWorkflowConfig_basic(rName, "updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMatchingWorkflowExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEntityResolutionMatchingWorkflow_disappears(t *testing.T) {
+	var v entityresolution.GetMatchingWorkflowOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_entityresolution_matching_workflow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, entityresolution.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMatchingWorkflowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMatchingWorkflowConfig_basic(rName, "desc"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMatchingWorkflowExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfentityresolution.ResourceMatchingWorkflow(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccEntityResolutionMatchingWorkflow_incrementalRunConfig(t *testing.T) {
+	var v entityresolution.GetMatchingWorkflowOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_entityresolution_matching_workflow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, entityresolution.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMatchingWorkflowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMatchingWorkflowConfig_incrementalRunConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMatchingWorkflowExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "incremental_run_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "incremental_run_config.0.incremental_run_type", "IMMEDIATE"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMatchingWorkflowConfig_basic(rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMatchingWorkflowExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "incremental_run_config.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEntityResolutionMatchingWorkflow_providerProperties(t *testing.T) {
+	key := "ENTITY_RESOLUTION_PROVIDER_SERVICE_ARN"
+	providerServiceARN := os.Getenv(key)
+	if providerServiceARN == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+
+	var v entityresolution.GetMatchingWorkflowOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_entityresolution_matching_workflow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, entityresolution.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMatchingWorkflowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMatchingWorkflowConfig_providerProperties(rName, providerServiceARN),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMatchingWorkflowExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "resolution_techniques.0.resolution_type", "PROVIDER"),
+					resource.TestCheckResourceAttr(resourceName, "resolution_techniques.0.provider_properties.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "resolution_techniques.0.provider_properties.0.provider_service_arn", providerServiceARN),
+					resource.TestCheckResourceAttrSet(resourceName, "resolution_techniques.0.provider_properties.0.intermediate_s3_path"),
+					resource.TestCheckResourceAttr(resourceName, "resolution_techniques.0.rule_based_properties.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckMatchingWorkflowDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EntityResolutionConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_entityresolution_matching_workflow" {
+			continue
+		}
+
+		_, err := tfentityresolution.FindMatchingWorkflowByName(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Entity Resolution Matching Workflow %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckMatchingWorkflowExists(n string, v *entityresolution.GetMatchingWorkflowOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Entity Resolution Matching Workflow ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EntityResolutionConn
+
+		output, err := tfentityresolution.FindMatchingWorkflowByName(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccMatchingWorkflowConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_glue_catalog_database" "test" {
+  name = replace(%[1]q, "-", "_")
+}
+
+resource "aws_glue_catalog_table" "test" {
+  name          = replace(%[1]q, "-", "_")
+  database_name = aws_glue_catalog_database.test.name
+  table_type    = "EXTERNAL_TABLE"
+
+  storage_descriptor {
+    location      = "s3://${aws_s3_bucket.test.bucket}/input/"
+    input_format  = "org.apache.hadoop.mapred.TextInputFormat"
+    output_format = "org.apache.hadoop.hive.ql.io.HiveIgnoreKeyTextOutputFormat"
+
+    ser_de_info {
+      serialization_library = "org.apache.hadoop.hive.serde2.lazy.LazySimpleSerDe"
+
+      parameters = {
+        "field.delim" = ","
+      }
+    }
+
+    columns {
+      name = "id"
+      type = "string"
+    }
+
+    columns {
+      name = "name"
+      type = "string"
+    }
+
+    columns {
+      name = "email"
+      type = "string"
+    }
+  }
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action    = "sts:AssumeRole"
+      Effect    = "Allow"
+      Principal = { Service = "entityresolution.${data.aws_partition.current.dns_suffix}" }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = [
+        "glue:GetDatabase",
+        "glue:GetTable",
+        "glue:GetPartition",
+        "glue:GetPartitions",
+        "glue:GetSchema",
+        "glue:GetSchemaVersion",
+        "glue:BatchGetPartition",
+        "s3:GetObject",
+        "s3:ListBucket",
+        "s3:PutObject",
+      ]
+      Effect   = "Allow"
+      Resource = "*"
+    }]
+  })
+}
+
+resource "aws_entityresolution_schema_mapping" "test" {
+  name = %[1]q
+
+  mapped_input_field {
+    field_name = "id"
+    type       = "UNIQUE_ID"
+  }
+
+  mapped_input_field {
+    field_name = "name"
+    match_key  = "name"
+    type       = "NAME"
+  }
+
+  mapped_input_field {
+    field_name = "email"
+    match_key  = "email"
+    type       = "EMAIL_ADDRESS"
+  }
+}
+`, rName)
+}
+
+func testAccMatchingWorkflowConfig_basic(rName, description string) string {
+	return acctest.ConfigCompose(testAccMatchingWorkflowConfig_base(rName), fmt.Sprintf(`
+resource "aws_entityresolution_matching_workflow" "test" {
+  name        = %[1]q
+  description = %[2]q
+  role_arn    = aws_iam_role.test.arn
+
+  input_source_config {
+    input_source_arn = aws_glue_catalog_table.test.arn
+    schema_name      = aws_entityresolution_schema_mapping.test.name
+  }
+
+  output_source_config {
+    output_s3_path = "s3://${aws_s3_bucket.test.bucket}/output/"
+
+    output {
+      name = "name"
+    }
+
+    output {
+      name = "email"
+    }
+  }
+
+  resolution_techniques {
+    resolution_type = "RULE_MATCHING"
+
+    rule_based_properties {
+      attribute_matching_model = "ONE_TO_ONE"
+
+      rule {
+        rule_name     = "email"
+        matching_keys = ["email"]
+      }
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, description))
+}
+
+func testAccMatchingWorkflowConfig_incrementalRunConfig(rName string) string {
+	return acctest.ConfigCompose(testAccMatchingWorkflowConfig_base(rName), fmt.Sprintf(`
+resource "aws_entityresolution_matching_workflow" "test" {
+  name        = %[1]q
+  description = %[1]q
+  role_arn    = aws_iam_role.test.arn
+
+  incremental_run_config {
+    incremental_run_type = "IMMEDIATE"
+  }
+
+  input_source_config {
+    input_source_arn = aws_glue_catalog_table.test.arn
+    schema_name      = aws_entityresolution_schema_mapping.test.name
+  }
+
+  output_source_config {
+    output_s3_path = "s3://${aws_s3_bucket.test.bucket}/output/"
+
+    output {
+      name = "name"
+    }
+
+    output {
+      name = "email"
+    }
+  }
+
+  resolution_techniques {
+    resolution_type = "RULE_MATCHING"
+
+    rule_based_properties {
+      attribute_matching_model = "ONE_TO_ONE"
+
+      rule {
+        rule_name     = "email"
+        matching_keys = ["email"]
+      }
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName))
+}
+
+func testAccMatchingWorkflowConfig_providerProperties(rName, providerServiceARN string) string {
+	return acctest.ConfigCompose(testAccMatchingWorkflowConfig_base(rName), fmt.Sprintf(`
+resource "aws_entityresolution_matching_workflow" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+
+  input_source_config {
+    input_source_arn = aws_glue_catalog_table.test.arn
+    schema_name      = aws_entityresolution_schema_mapping.test.name
+  }
+
+  output_source_config {
+    output_s3_path = "s3://${aws_s3_bucket.test.bucket}/output/"
+
+    output {
+      name = "name"
+    }
+
+    output {
+      name = "email"
+    }
+  }
+
+  resolution_techniques {
+    resolution_type = "PROVIDER"
+
+    provider_properties {
+      provider_service_arn = %[2]q
+      intermediate_s3_path = "s3://${aws_s3_bucket.test.bucket}/intermediate/"
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, providerServiceARN))
+}
@@ -0,0 +1,286 @@
+package entityresolution
+
+import (
+	"context"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/entityresolution"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceSchemaMapping() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSchemaMappingCreate,
+		ReadWithoutTimeout:   resourceSchemaMappingRead,
+		UpdateWithoutTimeout: resourceSchemaMappingUpdate,
+		DeleteWithoutTimeout: resourceSchemaMappingDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"has_workflows": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"mapped_input_field": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 2,
+				MaxItems: 25,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"field_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+						"group_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+						"hashed": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"match_key": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+						"sub_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(entityresolution.SchemaAttributeType_Values(), false),
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 255),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z_0-9-]*$`), "must contain only alphanumeric characters, hyphens and underscores"),
+				),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSchemaMappingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EntityResolutionConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &entityresolution.CreateSchemaMappingInput{
+		MappedInputFields: expandSchemaInputAttributes(d.Get("mapped_input_field").([]interface{})),
+		SchemaName:        aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Entity Resolution Schema Mapping: %s", input)
+	output, err := conn.CreateSchemaMappingWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Entity Resolution Schema Mapping (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.SchemaName))
+
+	return resourceSchemaMappingRead(ctx, d, meta)
+}
+
+func resourceSchemaMappingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EntityResolutionConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindSchemaMappingByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Entity Resolution Schema Mapping (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Entity Resolution Schema Mapping (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.SchemaArn)
+	d.Set("created_at", aws.TimeValue(output.CreatedAt).Format(time.RFC3339))
+	d.Set("description", output.Description)
+	d.Set("has_workflows", output.HasWorkflows)
+	if err := d.Set("mapped_input_field", flattenSchemaInputAttributes(output.MappedInputFields)); err != nil {
+		return diag.Errorf("setting mapped_input_field: %s", err)
+	}
+	d.Set("name", output.SchemaName)
+	d.Set("updated_at", aws.TimeValue(output.UpdatedAt).Format(time.RFC3339))
+
+	tags := KeyValueTags(output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceSchemaMappingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EntityResolutionConn
+
+	if d.HasChanges("description", "mapped_input_field") {
+		input := &entityresolution.UpdateSchemaMappingInput{
+			Description:       aws.String(d.Get("description").(string)),
+			MappedInputFields: expandSchemaInputAttributes(d.Get("mapped_input_field").([]interface{})),
+			SchemaName:        aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating Entity Resolution Schema Mapping: %s", input)
+		_, err := conn.UpdateSchemaMappingWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating Entity Resolution Schema Mapping (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating Entity Resolution Schema Mapping (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceSchemaMappingRead(ctx, d, meta)
+}
+
+func resourceSchemaMappingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EntityResolutionConn
+
+	log.Printf("[DEBUG] Deleting Entity Resolution Schema Mapping: %s", d.Id())
+	_, err := conn.DeleteSchemaMappingWithContext(ctx, &entityresolution.DeleteSchemaMappingInput{
+		SchemaName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, entityresolution.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Entity Resolution Schema Mapping (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandSchemaInputAttributes(tfList []interface{}) []*entityresolution.SchemaInputAttribute {
+	var apiObjects []*entityresolution.SchemaInputAttribute
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &entityresolution.SchemaInputAttribute{
+			FieldName: aws.String(tfMap["field_name"].(string)),
+			Type:      aws.String(tfMap["type"].(string)),
+		}
+
+		if v, ok := tfMap["group_name"].(string); ok && v != "" {
+			apiObject.GroupName = aws.String(v)
+		}
+
+		if v, ok := tfMap["hashed"].(bool); ok && v {
+			apiObject.Hashed = aws.Bool(v)
+		}
+
+		if v, ok := tfMap["match_key"].(string); ok && v != "" {
+			apiObject.MatchKey = aws.String(v)
+		}
+
+		if v, ok := tfMap["sub_type"].(string); ok && v != "" {
+			apiObject.SubType = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenSchemaInputAttributes(apiObjects []*entityresolution.SchemaInputAttribute) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"field_name": aws.StringValue(apiObject.FieldName),
+			"group_name": aws.StringValue(apiObject.GroupName),
+			"hashed":     aws.BoolValue(apiObject.Hashed),
+			"match_key":  aws.StringValue(apiObject.MatchKey),
+			"sub_type":   aws.StringValue(apiObject.SubType),
+			"type":       aws.StringValue(apiObject.Type),
+		})
+	}
+
+	return tfList
+}
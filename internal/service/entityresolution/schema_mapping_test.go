@@ -0,0 +1,269 @@
+package entityresolution_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/entityresolution"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfentityresolution "github.com/hashicorp/terraform-provider-aws/internal/service/entityresolution"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccEntityResolutionSchemaMapping_basic(t *testing.T) {
+	var v entityresolution.GetSchemaMappingOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_entityresolution_schema_mapping.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, entityresolution.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSchemaMappingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSchemaMappingConfig_basic(rName, "desc"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSchemaMappingExists(resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "entityresolution", regexp.MustCompile(`schemamapping/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+					resource.TestCheckResourceAttr(resourceName, "description", "desc"),
+					resource.TestCheckResourceAttr(resourceName, "has_workflows", "false"),
+					resource.TestCheckResourceAttr(resourceName, "mapped_input_field.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "mapped_input_field.0.field_name", "id"),
+					resource.TestCheckResourceAttr(resourceName, "mapped_input_field.0.type", "UNIQUE_ID"),
+					resource.TestCheckResourceAttr(resourceName, "mapped_input_field.1.field_name", "name"),
+					resource.TestCheckResourceAttr(resourceName, "mapped_input_field.1.match_key", "name"),
+					resource.TestCheckResourceAttr(resourceName, "mapped_input_field.1.type", "NAME"),
+					resource.TestCheckResourceAttr(resourceName, "mapped_input_field.2.field_name", "email"),
+					resource.TestCheckResourceAttr(resourceName, "mapped_input_field.2.match_key", "email"),
+					resource.TestCheckResourceAttr(resourceName, "mapped_input_field.2.type", "EMAIL_ADDRESS"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSchemaMappingConfig_basic(rName, "updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSchemaMappingExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEntityResolutionSchemaMapping_disappears(t *testing.T) {
+	var v entityresolution.GetSchemaMappingOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_entityresolution_schema_mapping.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, entityresolution.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSchemaMappingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSchemaMappingConfig_basic(rName, "desc"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSchemaMappingExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfentityresolution.ResourceSchemaMapping(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccEntityResolutionSchemaMapping_tags(t *testing.T) {
+	var v entityresolution.GetSchemaMappingOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_entityresolution_schema_mapping.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, entityresolution.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSchemaMappingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSchemaMappingConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSchemaMappingExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSchemaMappingConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSchemaMappingExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccSchemaMappingConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSchemaMappingExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSchemaMappingDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EntityResolutionConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_entityresolution_schema_mapping" {
+			continue
+		}
+
+		_, err := tfentityresolution.FindSchemaMappingByName(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Entity Resolution Schema Mapping %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckSchemaMappingExists(n string, v *entityresolution.GetSchemaMappingOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Entity Resolution Schema Mapping ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EntityResolutionConn
+
+		output, err := tfentityresolution.FindSchemaMappingByName(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccPreCheck(t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EntityResolutionConn
+
+	_, err := conn.ListMatchingWorkflowsWithContext(context.Background(), &entityresolution.ListMatchingWorkflowsInput{})
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccSchemaMappingConfig_basic(rName, description string) string {
+	return fmt.Sprintf(`
+resource "aws_entityresolution_schema_mapping" "test" {
+  name        = %[1]q
+  description = %[2]q
+
+  mapped_input_field {
+    field_name = "id"
+    type       = "UNIQUE_ID"
+  }
+
+  mapped_input_field {
+    field_name = "name"
+    match_key  = "name"
+    type       = "NAME"
+  }
+
+  mapped_input_field {
+    field_name = "email"
+    match_key  = "email"
+    type       = "EMAIL_ADDRESS"
+  }
+}
+`, rName, description)
+}
+
+func testAccSchemaMappingConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_entityresolution_schema_mapping" "test" {
+  name = %[1]q
+
+  mapped_input_field {
+    field_name = "id"
+    type       = "UNIQUE_ID"
+  }
+
+  mapped_input_field {
+    field_name = "email"
+    match_key  = "email"
+    type       = "EMAIL_ADDRESS"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccSchemaMappingConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_entityresolution_schema_mapping" "test" {
+  name = %[1]q
+
+  mapped_input_field {
+    field_name = "id"
+    type       = "UNIQUE_ID"
+  }
+
+  mapped_input_field {
+    field_name = "email"
+    match_key  = "email"
+    type       = "EMAIL_ADDRESS"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,216 @@
+//go:build sweep
+// +build sweep
+
+package entityresolution
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/entityresolution"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_entityresolution_id_mapping_workflow", &resource.Sweeper{
+		Name: "aws_entityresolution_id_mapping_workflow",
+		F:    sweepIDMappingWorkflows,
+	})
+
+	resource.AddTestSweepers("aws_entityresolution_id_namespace", &resource.Sweeper{
+		Name: "aws_entityresolution_id_namespace",
+		F:    sweepIDNamespaces,
+		Dependencies: []string{
+			"aws_entityresolution_id_mapping_workflow",
+		},
+	})
+
+	resource.AddTestSweepers("aws_entityresolution_matching_workflow", &resource.Sweeper{
+		Name: "aws_entityresolution_matching_workflow",
+		F:    sweepMatchingWorkflows,
+	})
+
+	resource.AddTestSweepers("aws_entityresolution_schema_mapping", &resource.Sweeper{
+		Name: "aws_entityresolution_schema_mapping",
+		F:    sweepSchemaMappings,
+		Dependencies: []string{
+			"aws_entityresolution_id_mapping_workflow",
+			"aws_entityresolution_matching_workflow",
+		},
+	})
+}
+
+func sweepIDMappingWorkflows(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).EntityResolutionConn
+	input := &entityresolution.ListIdMappingWorkflowsInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListIdMappingWorkflowsPages(input, func(page *entityresolution.ListIdMappingWorkflowsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.WorkflowSummaries {
+			r := ResourceIDMappingWorkflow()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.WorkflowName))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Entity Resolution ID Mapping Workflow sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Entity Resolution ID Mapping Workflows (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Entity Resolution ID Mapping Workflows (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepIDNamespaces(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).EntityResolutionConn
+	input := &entityresolution.ListIdNamespacesInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListIdNamespacesPages(input, func(page *entityresolution.ListIdNamespacesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.IdNamespaceSummaries {
+			r := ResourceIDNamespace()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.IdNamespaceName))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Entity Resolution ID Namespace sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Entity Resolution ID Namespaces (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Entity Resolution ID Namespaces (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepMatchingWorkflows(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).EntityResolutionConn
+	input := &entityresolution.ListMatchingWorkflowsInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListMatchingWorkflowsPages(input, func(page *entityresolution.ListMatchingWorkflowsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.WorkflowSummaries {
+			r := ResourceMatchingWorkflow()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.WorkflowName))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Entity Resolution Matching Workflow sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Entity Resolution Matching Workflows (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Entity Resolution Matching Workflows (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepSchemaMappings(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).EntityResolutionConn
+	input := &entityresolution.ListSchemaMappingsInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListSchemaMappingsPages(input, func(page *entityresolution.ListSchemaMappingsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.SchemaList {
+			r := ResourceSchemaMapping()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.SchemaName))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Entity Resolution Schema Mapping sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Entity Resolution Schema Mappings (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Entity Resolution Schema Mappings (%s): %w", region, err)
+	}
+
+	return nil
+}
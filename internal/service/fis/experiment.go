@@ -0,0 +1,256 @@
+package fis
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/fis"
+	"github.com/aws/aws-sdk-go-v2/service/fis/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ResNameExperiment = "Experiment"
+)
+
+// ResourceExperiment starts an experiment from an experiment template.
+// Experiments can't be deleted, so destroying the resource stops the experiment
+// if it is still running and removes it from state.
+func ResourceExperiment() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceExperimentCreate,
+		ReadWithoutTimeout:   resourceExperimentRead,
+		UpdateWithoutTimeout: resourceExperimentUpdate,
+		DeleteWithoutTimeout: resourceExperimentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceExperimentImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"end_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"experiment_options": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_targeting": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"empty_target_resolution_mode": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"experiment_template_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"role_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"start_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchemaForceNew(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"wait_for_completion": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func resourceExperimentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).FISConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	templateID := d.Get("experiment_template_id").(string)
+	input := &fis.StartExperimentInput{
+		ClientToken:          aws.String(resource.UniqueId()),
+		ExperimentTemplateId: aws.String(templateID),
+	}
+
+	if len(Tags(tags.IgnoreAWS())) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.StartExperiment(ctx, input)
+
+	if err != nil {
+		return names.DiagError(names.FIS, names.ErrActionCreating, ResNameExperiment, templateID, err)
+	}
+
+	d.SetId(aws.ToString(output.Experiment.Id))
+
+	if d.Get("wait_for_completion").(bool) {
+		if _, err := waitExperimentCompleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return names.DiagError(names.FIS, names.ErrActionWaitingForCreation, ResNameExperiment, d.Id(), err)
+		}
+	} else {
+		if _, err := waitExperimentStarted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return names.DiagError(names.FIS, names.ErrActionWaitingForCreation, ResNameExperiment, d.Id(), err)
+		}
+	}
+
+	return resourceExperimentRead(ctx, d, meta)
+}
+
+func resourceExperimentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).FISConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	experiment, err := FindExperimentByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		names.LogNotFoundRemoveState(names.FIS, names.ErrActionReading, ResNameExperiment, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return names.DiagError(names.FIS, names.ErrActionReading, ResNameExperiment, d.Id(), err)
+	}
+
+	if v := experiment.EndTime; v != nil {
+		d.Set("end_time", aws.ToTime(v).Format(time.RFC3339))
+	} else {
+		d.Set("end_time", nil)
+	}
+	if err := d.Set("experiment_options", flattenExperimentOptions(experiment.ExperimentOptions)); err != nil {
+		return names.DiagErrorSetting(names.FIS, ResNameExperiment, d.Id(), "experiment_options", err)
+	}
+	d.Set("experiment_template_id", experiment.ExperimentTemplateId)
+	d.Set("role_arn", experiment.RoleArn)
+	if v := experiment.StartTime; v != nil {
+		d.Set("start_time", aws.ToTime(v).Format(time.RFC3339))
+	} else {
+		d.Set("start_time", nil)
+	}
+	if v := experiment.State; v != nil {
+		d.Set("status", v.Status)
+		d.Set("status_reason", v.Reason)
+	} else {
+		d.Set("status", nil)
+		d.Set("status_reason", nil)
+	}
+
+	tags := KeyValueTags(experiment.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return names.DiagErrorSetting(names.FIS, ResNameExperiment, d.Id(), "tags", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return names.DiagErrorSetting(names.FIS, ResNameExperiment, d.Id(), "tags_all", err)
+	}
+
+	return nil
+}
+
+func resourceExperimentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Only wait_for_completion can be updated, and it only affects resource creation.
+	return resourceExperimentRead(ctx, d, meta)
+}
+
+func resourceExperimentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).FISConn
+
+	experiment, err := FindExperimentByID(ctx, conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return names.DiagError(names.FIS, names.ErrActionReading, ResNameExperiment, d.Id(), err)
+	}
+
+	if experiment.State == nil {
+		return nil
+	}
+
+	switch experiment.State.Status {
+	case types.ExperimentStatusPending, types.ExperimentStatusInitiating, types.ExperimentStatusRunning:
+	case types.ExperimentStatusStopping:
+		if _, err := waitExperimentStopped(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+			return names.DiagError(names.FIS, names.ErrActionWaitingForDeletion, ResNameExperiment, d.Id(), err)
+		}
+
+		return nil
+	default:
+		return nil
+	}
+
+	log.Printf("[DEBUG] Stopping FIS Experiment: %s", d.Id())
+	_, err = conn.StopExperiment(ctx, &fis.StopExperimentInput{
+		Id: aws.String(d.Id()),
+	})
+
+	if err != nil {
+		return names.DiagError(names.FIS, names.ErrActionDeleting, ResNameExperiment, d.Id(), err)
+	}
+
+	if _, err := waitExperimentStopped(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return names.DiagError(names.FIS, names.ErrActionWaitingForDeletion, ResNameExperiment, d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceExperimentImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("wait_for_completion", true)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenExperimentOptions(apiObject *types.ExperimentOptions) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"account_targeting":            string(apiObject.AccountTargeting),
+		"empty_target_resolution_mode": string(apiObject.EmptyTargetResolutionMode),
+	}
+
+	return []interface{}{tfMap}
+}
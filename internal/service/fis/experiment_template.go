@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -123,6 +124,29 @@ func ResourceExperimentTemplate() *schema.Resource {
 				Required:     true,
 				ValidateFunc: validation.StringLenBetween(0, 512),
 			},
+			"experiment_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_targeting": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: enum.Validate[types.AccountTargeting](),
+						},
+						"empty_target_resolution_mode": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateDiagFunc: enum.Validate[types.EmptyTargetResolutionMode](),
+						},
+					},
+				},
+			},
 			"role_arn": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -242,6 +266,10 @@ func resourceExperimentTemplateCreate(ctx context.Context, d *schema.ResourceDat
 		StopConditions: expandExperimentTemplateStopConditions(d.Get("stop_condition").(*schema.Set)),
 	}
 
+	if v, ok := d.GetOk("experiment_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ExperimentOptions = expandExperimentTemplateExperimentOptions(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if len(Tags(tags.IgnoreAWS())) > 0 {
 		input.Tags = Tags(tags.IgnoreAWS())
 	}
@@ -300,6 +328,10 @@ func resourceExperimentTemplateRead(ctx context.Context, d *schema.ResourceData,
 		return names.DiagErrorSetting(names.FIS, ResNameExperimentTemplate, d.Id(), "action", err)
 	}
 
+	if err := d.Set("experiment_options", flattenExperimentTemplateExperimentOptions(experimentTemplate.ExperimentOptions)); err != nil {
+		return names.DiagErrorSetting(names.FIS, ResNameExperimentTemplate, d.Id(), "experiment_options", err)
+	}
+
 	if err := d.Set("stop_condition", flattenExperimentTemplateStopConditions(experimentTemplate.StopConditions)); err != nil {
 		return names.DiagErrorSetting(names.FIS, ResNameExperimentTemplate, d.Id(), "stop_condition", err)
 	}
@@ -337,6 +369,15 @@ func resourceExperimentTemplateUpdate(ctx context.Context, d *schema.ResourceDat
 		input.Description = aws.String(d.Get("description").(string))
 	}
 
+	if d.HasChange("experiment_options") {
+		// Account targeting can't be updated, so only the empty target resolution mode is sent.
+		if v, ok := d.GetOk("experiment_options.0.empty_target_resolution_mode"); ok {
+			input.ExperimentOptions = &types.UpdateExperimentTemplateExperimentOptionsInput{
+				EmptyTargetResolutionMode: types.EmptyTargetResolutionMode(v.(string)),
+			}
+		}
+	}
+
 	if d.HasChange("role_arn") {
 		input.RoleArn = aws.String(d.Get("role_arn").(string))
 	}
@@ -616,6 +657,24 @@ func expandExperimentTemplateTargetsForUpdate(l *schema.Set) (map[string]types.U
 	return attrs, nil
 }
 
+func expandExperimentTemplateExperimentOptions(tfMap map[string]interface{}) *types.CreateExperimentTemplateExperimentOptionsInput {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.CreateExperimentTemplateExperimentOptionsInput{}
+
+	if v, ok := tfMap["account_targeting"].(string); ok && v != "" {
+		apiObject.AccountTargeting = types.AccountTargeting(v)
+	}
+
+	if v, ok := tfMap["empty_target_resolution_mode"].(string); ok && v != "" {
+		apiObject.EmptyTargetResolutionMode = types.EmptyTargetResolutionMode(v)
+	}
+
+	return apiObject
+}
+
 func expandExperimentTemplateActionParameteres(l *schema.Set) map[string]string {
 	if l.Len() == 0 {
 		return nil
@@ -711,6 +770,19 @@ func flattenExperimentTemplateActions(configured map[string]types.ExperimentTemp
 	return dataResources
 }
 
+func flattenExperimentTemplateExperimentOptions(apiObject *types.ExperimentTemplateExperimentOptions) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"account_targeting":            string(apiObject.AccountTargeting),
+		"empty_target_resolution_mode": string(apiObject.EmptyTargetResolutionMode),
+	}
+
+	return []interface{}{tfMap}
+}
+
 func flattenExperimentTemplateStopConditions(configured []types.ExperimentTemplateStopCondition) []map[string]interface{} {
 	dataResources := make([]map[string]interface{}, 0, len(configured))
 
@@ -159,6 +159,43 @@ func TestAccFISExperimentTemplate_update(t *testing.T) {
 	})
 }
 
+func TestAccFISExperimentTemplate_experimentOptions(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_fis_experiment_template.test"
+	var conf types.ExperimentTemplate
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, fis.ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccExperimentTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExperimentTemplateConfig_experimentOptions(rName, "skip"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccExperimentTemplateExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "experiment_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "experiment_options.0.account_targeting", "single-account"),
+					resource.TestCheckResourceAttr(resourceName, "experiment_options.0.empty_target_resolution_mode", "skip"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccExperimentTemplateConfig_experimentOptions(rName, "fail"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccExperimentTemplateExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "experiment_options.0.account_targeting", "single-account"),
+					resource.TestCheckResourceAttr(resourceName, "experiment_options.0.empty_target_resolution_mode", "fail"),
+				),
+			},
+		},
+	})
+}
+
 func testAccExperimentTemplateExists(resourceName string, config *types.ExperimentTemplate) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -258,3 +295,58 @@ resource "aws_fis_experiment_template" "test" {
 }
 `, rName, desc, actionName, actionDesc, actionID, actionTargetK, actionTargetV, targetResType, targetSelectMode, targetResTagK, targetResTagV)
 }
+
+func testAccExperimentTemplateConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = [
+          "fis.${data.aws_partition.current.dns_suffix}",
+        ]
+      }
+    }]
+    Version = "2012-10-17"
+  })
+}
+`, rName)
+}
+
+func testAccExperimentTemplateConfig_experimentOptions(rName, emptyTargetResolutionMode string) string {
+	return acctest.ConfigCompose(testAccExperimentTemplateConfig_base(rName), fmt.Sprintf(`
+resource "aws_fis_experiment_template" "test" {
+  description = %[1]q
+  role_arn    = aws_iam_role.test.arn
+
+  stop_condition {
+    source = "none"
+  }
+
+  action {
+    name      = "wait"
+    action_id = "aws:fis:wait"
+
+    parameter {
+      key   = "duration"
+      value = "PT1M"
+    }
+  }
+
+  experiment_options {
+    account_targeting            = "single-account"
+    empty_target_resolution_mode = %[2]q
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, emptyTargetResolutionMode))
+}
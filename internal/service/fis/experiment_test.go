@@ -0,0 +1,147 @@
+package fis_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/fis"
+	"github.com/aws/aws-sdk-go-v2/service/fis/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tffis "github.com/hashicorp/terraform-provider-aws/internal/service/fis"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccFISExperiment_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_fis_experiment.test"
+	templateResourceName := "aws_fis_experiment_template.test"
+	var v types.Experiment
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, fis.ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckExperimentStopped,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExperimentConfig_basic(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExperimentExists(resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "end_time"),
+					resource.TestCheckResourceAttr(resourceName, "experiment_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "experiment_options.0.empty_target_resolution_mode", "skip"),
+					resource.TestCheckResourceAttrPair(resourceName, "experiment_template_id", templateResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "start_time"),
+					resource.TestCheckResourceAttr(resourceName, "status", "completed"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", rName),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_completion", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccFISExperiment_noWait(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_fis_experiment.test"
+	var v types.Experiment
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, fis.ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckExperimentStopped,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExperimentConfig_basic(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExperimentExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_completion", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckExperimentExists(n string, v *types.Experiment) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No FIS Experiment ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).FISConn
+
+		output, err := tffis.FindExperimentByID(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+// testAccCheckExperimentStopped verifies that destroyed experiments are no longer running.
+// Experiments can't be deleted, so they remain visible after destroy.
+func testAccCheckExperimentStopped(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).FISConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_fis_experiment" {
+			continue
+		}
+
+		output, err := tffis.FindExperimentByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output.State == nil {
+			continue
+		}
+
+		switch output.State.Status {
+		case types.ExperimentStatusPending, types.ExperimentStatusInitiating, types.ExperimentStatusRunning, types.ExperimentStatusStopping:
+			return fmt.Errorf("FIS Experiment %s is still %s", rs.Primary.ID, output.State.Status)
+		}
+	}
+
+	return nil
+}
+
+func testAccExperimentConfig_basic(rName string, waitForCompletion bool) string {
+	return acctest.ConfigCompose(testAccExperimentTemplateConfig_experimentOptions(rName, "skip"), fmt.Sprintf(`
+resource "aws_fis_experiment" "test" {
+  experiment_template_id = aws_fis_experiment_template.test.id
+  wait_for_completion    = %[2]t
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, waitForCompletion))
+}
@@ -0,0 +1,38 @@
+package fis
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/fis"
+	"github.com/aws/aws-sdk-go-v2/service/fis/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindExperimentByID(ctx context.Context, conn *fis.Client, id string) (*types.Experiment, error) {
+	input := &fis.GetExperimentInput{
+		Id: aws.String(id),
+	}
+
+	output, err := conn.GetExperiment(ctx, input)
+
+	var nf *types.ResourceNotFoundException
+	if errors.As(err, &nf) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Experiment == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Experiment, nil
+}
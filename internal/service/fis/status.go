@@ -0,0 +1,29 @@
+package fis
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/fis"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusExperiment(ctx context.Context, conn *fis.Client, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindExperimentByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output.State == nil {
+			return output, "", nil
+		}
+
+		return output, string(output.State.Status), nil
+	}
+}
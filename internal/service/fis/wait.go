@@ -0,0 +1,72 @@
+package fis
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/fis"
+	"github.com/aws/aws-sdk-go-v2/service/fis/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func waitExperimentStarted(ctx context.Context, conn *fis.Client, id string, timeout time.Duration) (*types.Experiment, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{string(types.ExperimentStatusPending), string(types.ExperimentStatusInitiating)},
+		Target:  []string{string(types.ExperimentStatusRunning), string(types.ExperimentStatusCompleted)},
+		Refresh: statusExperiment(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.Experiment); ok {
+		if output.State != nil && output.State.Reason != nil {
+			tfresource.SetLastError(err, errors.New(aws.ToString(output.State.Reason)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitExperimentCompleted(ctx context.Context, conn *fis.Client, id string, timeout time.Duration) (*types.Experiment, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{string(types.ExperimentStatusPending), string(types.ExperimentStatusInitiating), string(types.ExperimentStatusRunning)},
+		Target:  []string{string(types.ExperimentStatusCompleted)},
+		Refresh: statusExperiment(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.Experiment); ok {
+		if output.State != nil && output.State.Reason != nil {
+			tfresource.SetLastError(err, errors.New(aws.ToString(output.State.Reason)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitExperimentStopped(ctx context.Context, conn *fis.Client, id string, timeout time.Duration) (*types.Experiment, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{string(types.ExperimentStatusPending), string(types.ExperimentStatusInitiating), string(types.ExperimentStatusRunning), string(types.ExperimentStatusStopping)},
+		Target:  []string{string(types.ExperimentStatusStopped), string(types.ExperimentStatusCompleted), string(types.ExperimentStatusFailed)},
+		Refresh: statusExperiment(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.Experiment); ok {
+		return output, err
+	}
+
+	return nil, err
+}
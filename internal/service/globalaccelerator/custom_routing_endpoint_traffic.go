@@ -0,0 +1,267 @@
+package globalaccelerator
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/globalaccelerator"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceCustomRoutingEndpointTraffic() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCustomRoutingEndpointTrafficCreate,
+		Read:   resourceCustomRoutingEndpointTrafficRead,
+		Delete: resourceCustomRoutingEndpointTrafficDelete,
+
+		Schema: map[string]*schema.Schema{
+			"allow_all_traffic_to_endpoint": {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"allow_all_traffic_to_endpoint", "destination_addresses"},
+			},
+
+			"destination_addresses": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     100,
+				ExactlyOneOf: []string{"allow_all_traffic_to_endpoint", "destination_addresses"},
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsIPv4Address,
+				},
+			},
+
+			"destination_ports": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				ForceNew:      true,
+				MaxItems:      100,
+				ConflictsWith: []string{"allow_all_traffic_to_endpoint"},
+				Elem: &schema.Schema{
+					Type:         schema.TypeInt,
+					ValidateFunc: validation.IsPortNumber,
+				},
+			},
+
+			"endpoint_group_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+
+			"endpoint_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+		},
+	}
+}
+
+func resourceCustomRoutingEndpointTrafficCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).GlobalAcceleratorConn
+
+	endpointGroupARN := d.Get("endpoint_group_arn").(string)
+	endpointID := d.Get("endpoint_id").(string)
+	id := CustomRoutingEndpointTrafficCreateResourceID(endpointGroupARN, endpointID)
+	input := &globalaccelerator.AllowCustomRoutingTrafficInput{
+		EndpointGroupArn: aws.String(endpointGroupARN),
+		EndpointId:       aws.String(endpointID),
+	}
+
+	if v, ok := d.GetOk("allow_all_traffic_to_endpoint"); ok {
+		input.AllowAllTrafficToEndpoint = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("destination_addresses"); ok && v.(*schema.Set).Len() > 0 {
+		input.DestinationAddresses = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("destination_ports"); ok && v.(*schema.Set).Len() > 0 {
+		input.DestinationPorts = flex.ExpandInt64Set(v.(*schema.Set))
+	}
+
+	log.Printf("[DEBUG] Allowing Global Accelerator custom routing traffic: %s", input)
+	_, err := conn.AllowCustomRoutingTraffic(input)
+
+	if err != nil {
+		return fmt.Errorf("error allowing Global Accelerator custom routing traffic (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceCustomRoutingEndpointTrafficRead(d, meta)
+}
+
+func resourceCustomRoutingEndpointTrafficRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).GlobalAcceleratorConn
+
+	endpointGroupARN, endpointID, err := CustomRoutingEndpointTrafficParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	_, err = FindCustomRoutingEndpoint(conn, endpointGroupARN, endpointID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Global Accelerator custom routing traffic (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Global Accelerator custom routing traffic (%s): %w", d.Id(), err)
+	}
+
+	d.Set("endpoint_group_arn", endpointGroupARN)
+	d.Set("endpoint_id", endpointID)
+
+	// Traffic that has since been denied outside of Terraform, e.g. after the endpoint was
+	// removed from and re-added to the endpoint group, is reported as drift so that it is allowed again.
+	if d.Get("allow_all_traffic_to_endpoint").(bool) {
+		acceleratorARN, err := ListenerOrEndpointGroupARNToAcceleratorARN(endpointGroupARN)
+
+		if err != nil {
+			return err
+		}
+
+		portMappings, err := FindCustomRoutingPortMappings(conn, acceleratorARN, endpointGroupARN, endpointID)
+
+		if err != nil {
+			return fmt.Errorf("error reading Global Accelerator custom routing traffic (%s) port mappings: %w", d.Id(), err)
+		}
+
+		allowed := true
+
+		for _, v := range portMappings {
+			if aws.StringValue(v.DestinationTrafficState) != globalaccelerator.CustomRoutingDestinationTrafficStateAllow {
+				allowed = false
+				break
+			}
+		}
+
+		d.Set("allow_all_traffic_to_endpoint", allowed)
+
+		return nil
+	}
+
+	ports := map[int64]bool{}
+	for _, v := range d.Get("destination_ports").(*schema.Set).List() {
+		ports[int64(v.(int))] = true
+	}
+
+	var destinationAddresses []string
+
+	for _, v := range d.Get("destination_addresses").(*schema.Set).List() {
+		destinationAddress := v.(string)
+
+		portMappings, err := FindCustomRoutingPortMappingsByDestination(conn, endpointGroupARN, endpointID, destinationAddress)
+
+		if err != nil {
+			return fmt.Errorf("error reading Global Accelerator custom routing traffic (%s) port mappings for destination (%s): %w", d.Id(), destinationAddress, err)
+		}
+
+		allowed := len(portMappings) > 0
+
+		for _, v := range portMappings {
+			if v.DestinationSocketAddress == nil {
+				continue
+			}
+
+			if len(ports) > 0 && !ports[aws.Int64Value(v.DestinationSocketAddress.Port)] {
+				continue
+			}
+
+			if aws.StringValue(v.DestinationTrafficState) != globalaccelerator.CustomRoutingDestinationTrafficStateAllow {
+				allowed = false
+				break
+			}
+		}
+
+		if allowed {
+			destinationAddresses = append(destinationAddresses, destinationAddress)
+		}
+	}
+
+	d.Set("destination_addresses", destinationAddresses)
+
+	return nil
+}
+
+func resourceCustomRoutingEndpointTrafficDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).GlobalAcceleratorConn
+
+	endpointGroupARN, endpointID, err := CustomRoutingEndpointTrafficParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &globalaccelerator.DenyCustomRoutingTrafficInput{
+		EndpointGroupArn: aws.String(endpointGroupARN),
+		EndpointId:       aws.String(endpointID),
+	}
+
+	if v, ok := d.GetOk("allow_all_traffic_to_endpoint"); ok {
+		input.DenyAllTrafficToEndpoint = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("destination_addresses"); ok && v.(*schema.Set).Len() > 0 {
+		input.DestinationAddresses = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("destination_ports"); ok && v.(*schema.Set).Len() > 0 {
+		input.DestinationPorts = flex.ExpandInt64Set(v.(*schema.Set))
+	}
+
+	// Nothing to deny if every previously allowed destination has already been denied.
+	if !aws.BoolValue(input.DenyAllTrafficToEndpoint) && len(input.DestinationAddresses) == 0 {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Denying Global Accelerator custom routing traffic: %s", input)
+	_, err = conn.DenyCustomRoutingTraffic(input)
+
+	if tfawserr.ErrCodeEquals(err, globalaccelerator.ErrCodeEndpointGroupNotFoundException, globalaccelerator.ErrCodeEndpointNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error denying Global Accelerator custom routing traffic (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+const customRoutingEndpointTrafficIDSeparator = ","
+
+func CustomRoutingEndpointTrafficCreateResourceID(endpointGroupARN, endpointID string) string {
+	parts := []string{endpointGroupARN, endpointID}
+	id := strings.Join(parts, customRoutingEndpointTrafficIDSeparator)
+
+	return id
+}
+
+func CustomRoutingEndpointTrafficParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, customRoutingEndpointTrafficIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected ENDPOINT-GROUP-ARN%[2]sENDPOINT-ID", id, customRoutingEndpointTrafficIDSeparator)
+}
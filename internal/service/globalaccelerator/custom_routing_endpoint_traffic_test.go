@@ -0,0 +1,247 @@
+package globalaccelerator_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/globalaccelerator"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfglobalaccelerator "github.com/hashicorp/terraform-provider-aws/internal/service/globalaccelerator"
+)
+
+func TestCustomRoutingEndpointTrafficParseResourceID(t *testing.T) {
+	testCases := []struct {
+		TestName                 string
+		InputID                  string
+		ExpectedError            *regexp.Regexp
+		ExpectedEndpointGroupARN string
+		ExpectedEndpointID       string
+	}{
+		{
+			TestName:      "empty ID",
+			InputID:       "",
+			ExpectedError: regexp.MustCompile(`unexpected format for ID`),
+		},
+		{
+			TestName:      "missing endpoint ID",
+			InputID:       "arn:aws:globalaccelerator::123456789012:accelerator/a-123/listener/l-456/endpoint-group/eg-789", //lintignore:AWSAT005
+			ExpectedError: regexp.MustCompile(`unexpected format for ID`),
+		},
+		{
+			TestName:      "empty endpoint ID",
+			InputID:       "arn:aws:globalaccelerator::123456789012:accelerator/a-123/listener/l-456/endpoint-group/eg-789,", //lintignore:AWSAT005
+			ExpectedError: regexp.MustCompile(`unexpected format for ID`),
+		},
+		{
+			TestName:                 "valid ID",
+			InputID:                  "arn:aws:globalaccelerator::123456789012:accelerator/a-123/listener/l-456/endpoint-group/eg-789,subnet-12345678", //lintignore:AWSAT005
+			ExpectedEndpointGroupARN: "arn:aws:globalaccelerator::123456789012:accelerator/a-123/listener/l-456/endpoint-group/eg-789",                 //lintignore:AWSAT005
+			ExpectedEndpointID:       "subnet-12345678",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotEndpointGroupARN, gotEndpointID, err := tfglobalaccelerator.CustomRoutingEndpointTrafficParseResourceID(testCase.InputID)
+
+			if err == nil && testCase.ExpectedError != nil {
+				t.Fatalf("expected error %s, got no error", testCase.ExpectedError.String())
+			}
+
+			if err != nil && testCase.ExpectedError == nil {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if err != nil && !testCase.ExpectedError.MatchString(err.Error()) {
+				t.Fatalf("expected error %s, got: %s", testCase.ExpectedError.String(), err)
+			}
+
+			if gotEndpointGroupARN != testCase.ExpectedEndpointGroupARN {
+				t.Errorf("got endpoint group ARN %s, expected %s", gotEndpointGroupARN, testCase.ExpectedEndpointGroupARN)
+			}
+
+			if gotEndpointID != testCase.ExpectedEndpointID {
+				t.Errorf("got endpoint ID %s, expected %s", gotEndpointID, testCase.ExpectedEndpointID)
+			}
+		})
+	}
+}
+
+func TestAccGlobalAcceleratorCustomRoutingEndpointTraffic_allowAll(t *testing.T) {
+	endpointGroupARN, endpointID := testAccCustomRoutingEndpointFromEnv(t)
+	resourceName := "aws_globalaccelerator_custom_routing_endpoint_traffic.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, globalaccelerator.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCustomRoutingEndpointTrafficDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomRoutingEndpointTrafficConfig_allowAll(endpointGroupARN, endpointID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomRoutingEndpointTrafficAllowed(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "allow_all_traffic_to_endpoint", "true"),
+					resource.TestCheckResourceAttr(resourceName, "endpoint_group_arn", endpointGroupARN),
+					resource.TestCheckResourceAttr(resourceName, "endpoint_id", endpointID),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGlobalAcceleratorCustomRoutingEndpointTraffic_destinations(t *testing.T) {
+	endpointGroupARN, endpointID := testAccCustomRoutingEndpointFromEnv(t)
+	destinationAddress := os.Getenv("GLOBALACCELERATOR_CUSTOM_ROUTING_DESTINATION_ADDRESS")
+	if destinationAddress == "" {
+		t.Skip("Environment variable GLOBALACCELERATOR_CUSTOM_ROUTING_DESTINATION_ADDRESS is not set")
+	}
+	resourceName := "aws_globalaccelerator_custom_routing_endpoint_traffic.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, globalaccelerator.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCustomRoutingEndpointTrafficDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomRoutingEndpointTrafficConfig_destinations(endpointGroupARN, endpointID, destinationAddress, 80),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomRoutingEndpointTrafficAllowed(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "destination_addresses.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "destination_addresses.*", destinationAddress),
+					resource.TestCheckResourceAttr(resourceName, "destination_ports.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "destination_ports.*", "80"),
+				),
+			},
+			{
+				Config: testAccCustomRoutingEndpointTrafficConfig_destinations(endpointGroupARN, endpointID, destinationAddress, 443),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomRoutingEndpointTrafficAllowed(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "destination_ports.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "destination_ports.*", "443"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCustomRoutingEndpointFromEnv returns a pre-existing custom routing endpoint group and subnet endpoint.
+// Custom routing accelerators, listeners and endpoint groups cannot yet be managed by this provider.
+func testAccCustomRoutingEndpointFromEnv(t *testing.T) (string, string) {
+	endpointGroupARN := os.Getenv("GLOBALACCELERATOR_CUSTOM_ROUTING_ENDPOINT_GROUP_ARN")
+	if endpointGroupARN == "" {
+		t.Skip("Environment variable GLOBALACCELERATOR_CUSTOM_ROUTING_ENDPOINT_GROUP_ARN is not set")
+	}
+
+	endpointID := os.Getenv("GLOBALACCELERATOR_CUSTOM_ROUTING_ENDPOINT_ID")
+	if endpointID == "" {
+		t.Skip("Environment variable GLOBALACCELERATOR_CUSTOM_ROUTING_ENDPOINT_ID is not set")
+	}
+
+	return endpointGroupARN, endpointID
+}
+
+func testAccCheckCustomRoutingEndpointTrafficAllowed(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Global Accelerator custom routing traffic ID is set")
+		}
+
+		endpointGroupARN, endpointID, err := tfglobalaccelerator.CustomRoutingEndpointTrafficParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		acceleratorARN, err := tfglobalaccelerator.ListenerOrEndpointGroupARNToAcceleratorARN(endpointGroupARN)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GlobalAcceleratorConn
+
+		portMappings, err := tfglobalaccelerator.FindCustomRoutingPortMappings(conn, acceleratorARN, endpointGroupARN, endpointID)
+
+		if err != nil {
+			return err
+		}
+
+		for _, v := range portMappings {
+			if aws.StringValue(v.DestinationTrafficState) == globalaccelerator.CustomRoutingDestinationTrafficStateAllow {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("Global Accelerator custom routing traffic %s has no allowed destinations", rs.Primary.ID)
+	}
+}
+
+func testAccCheckCustomRoutingEndpointTrafficDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).GlobalAcceleratorConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_globalaccelerator_custom_routing_endpoint_traffic" {
+			continue
+		}
+
+		endpointGroupARN, endpointID, err := tfglobalaccelerator.CustomRoutingEndpointTrafficParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		acceleratorARN, err := tfglobalaccelerator.ListenerOrEndpointGroupARNToAcceleratorARN(endpointGroupARN)
+
+		if err != nil {
+			return err
+		}
+
+		portMappings, err := tfglobalaccelerator.FindCustomRoutingPortMappings(conn, acceleratorARN, endpointGroupARN, endpointID)
+
+		if err != nil {
+			return err
+		}
+
+		for _, v := range portMappings {
+			if aws.StringValue(v.DestinationTrafficState) == globalaccelerator.CustomRoutingDestinationTrafficStateAllow {
+				return fmt.Errorf("Global Accelerator custom routing traffic %s still allowed", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCustomRoutingEndpointTrafficConfig_allowAll(endpointGroupARN, endpointID string) string {
+	return fmt.Sprintf(`
+resource "aws_globalaccelerator_custom_routing_endpoint_traffic" "test" {
+  endpoint_group_arn            = %[1]q
+  endpoint_id                   = %[2]q
+  allow_all_traffic_to_endpoint = true
+}
+`, endpointGroupARN, endpointID)
+}
+
+func testAccCustomRoutingEndpointTrafficConfig_destinations(endpointGroupARN, endpointID, destinationAddress string, destinationPort int) string {
+	return fmt.Sprintf(`
+resource "aws_globalaccelerator_custom_routing_endpoint_traffic" "test" {
+  endpoint_group_arn    = %[1]q
+  endpoint_id           = %[2]q
+  destination_addresses = [%[3]q]
+  destination_ports     = [%[4]d]
+}
+`, endpointGroupARN, endpointID, destinationAddress, destinationPort)
+}
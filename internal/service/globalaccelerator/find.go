@@ -1,6 +1,8 @@
 package globalaccelerator
 
 import (
+	"fmt"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/globalaccelerator"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
@@ -150,3 +152,111 @@ func FindListener(conn *globalaccelerator.GlobalAccelerator, input *globalaccele
 
 	return output.Listener, nil
 }
+
+// FindCustomRoutingEndpointGroupByARN returns the custom routing endpoint group corresponding to the specified ARN.
+// Returns NotFoundError if no endpoint group is found.
+func FindCustomRoutingEndpointGroupByARN(conn *globalaccelerator.GlobalAccelerator, arn string) (*globalaccelerator.CustomRoutingEndpointGroup, error) {
+	input := &globalaccelerator.DescribeCustomRoutingEndpointGroupInput{
+		EndpointGroupArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeCustomRoutingEndpointGroup(input)
+
+	if tfawserr.ErrCodeEquals(err, globalaccelerator.ErrCodeEndpointGroupNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.EndpointGroup == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.EndpointGroup, nil
+}
+
+// FindCustomRoutingEndpoint returns the description of the specified endpoint within a custom routing endpoint group.
+// Returns NotFoundError if no endpoint group or endpoint is found.
+func FindCustomRoutingEndpoint(conn *globalaccelerator.GlobalAccelerator, endpointGroupARN, endpointID string) (*globalaccelerator.CustomRoutingEndpointDescription, error) {
+	endpointGroup, err := FindCustomRoutingEndpointGroupByARN(conn, endpointGroupARN)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range endpointGroup.EndpointDescriptions {
+		if aws.StringValue(v.EndpointId) == endpointID {
+			return v, nil
+		}
+	}
+
+	return nil, &resource.NotFoundError{
+		Message: fmt.Sprintf("endpoint (%s) not found in endpoint group (%s)", endpointID, endpointGroupARN),
+	}
+}
+
+// FindCustomRoutingPortMappings returns the port mappings for the specified endpoint within a custom routing endpoint group.
+func FindCustomRoutingPortMappings(conn *globalaccelerator.GlobalAccelerator, acceleratorARN, endpointGroupARN, endpointID string) ([]*globalaccelerator.PortMapping, error) {
+	input := &globalaccelerator.ListCustomRoutingPortMappingsInput{
+		AcceleratorArn:   aws.String(acceleratorARN),
+		EndpointGroupArn: aws.String(endpointGroupARN),
+	}
+	var output []*globalaccelerator.PortMapping
+
+	err := conn.ListCustomRoutingPortMappingsPages(input, func(page *globalaccelerator.ListCustomRoutingPortMappingsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.PortMappings {
+			if v != nil && aws.StringValue(v.EndpointId) == endpointID {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// FindCustomRoutingPortMappingsByDestination returns the port mappings for the specified destination address within a custom routing endpoint group.
+func FindCustomRoutingPortMappingsByDestination(conn *globalaccelerator.GlobalAccelerator, endpointGroupARN, endpointID, destinationAddress string) ([]*globalaccelerator.DestinationPortMapping, error) {
+	input := &globalaccelerator.ListCustomRoutingPortMappingsByDestinationInput{
+		DestinationAddress: aws.String(destinationAddress),
+		EndpointId:         aws.String(endpointID),
+	}
+	var output []*globalaccelerator.DestinationPortMapping
+
+	err := conn.ListCustomRoutingPortMappingsByDestinationPages(input, func(page *globalaccelerator.ListCustomRoutingPortMappingsByDestinationOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.DestinationPortMappings {
+			if v != nil && aws.StringValue(v.EndpointGroupArn) == endpointGroupARN {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
@@ -0,0 +1,184 @@
+package glue
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceDataQualityRuleRecommendationRun() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceDataQualityRuleRecommendationRunRead,
+
+		Schema: map[string]*schema.Schema{
+			"completed_on": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_ruleset_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"data_source": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"glue_table": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"catalog_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"connection_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"database_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"table_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"error_string": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"execution_time": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"number_of_workers": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"recommended_ruleset": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"role": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"run_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"started_on": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"timeout": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceDataQualityRuleRecommendationRunRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GlueConn
+
+	runID := d.Get("run_id").(string)
+	output, err := FindDataQualityRuleRecommendationRunByID(ctx, conn, runID)
+
+	if err != nil {
+		return diag.Errorf("reading Glue Data Quality Rule Recommendation Run (%s): %s", runID, err)
+	}
+
+	if status := aws.StringValue(output.Status); status != glue.TaskStatusTypeSucceeded {
+		return diag.Errorf("Glue Data Quality Rule Recommendation Run (%s) has not completed successfully: %s", runID, status)
+	}
+
+	d.SetId(aws.StringValue(output.RunId))
+	if output.CompletedOn != nil {
+		d.Set("completed_on", aws.TimeValue(output.CompletedOn).Format(time.RFC3339))
+	} else {
+		d.Set("completed_on", nil)
+	}
+	d.Set("created_ruleset_name", output.CreatedRulesetName)
+	if output.DataSource != nil {
+		if err := d.Set("data_source", []interface{}{flattenDataQualityDataSource(output.DataSource)}); err != nil {
+			return diag.Errorf("setting data_source: %s", err)
+		}
+	} else {
+		d.Set("data_source", nil)
+	}
+	d.Set("error_string", output.ErrorString)
+	d.Set("execution_time", output.ExecutionTime)
+	d.Set("number_of_workers", output.NumberOfWorkers)
+	d.Set("recommended_ruleset", output.RecommendedRuleset)
+	d.Set("role", output.Role)
+	d.Set("run_id", output.RunId)
+	if output.StartedOn != nil {
+		d.Set("started_on", aws.TimeValue(output.StartedOn).Format(time.RFC3339))
+	} else {
+		d.Set("started_on", nil)
+	}
+	d.Set("status", output.Status)
+	d.Set("timeout", output.Timeout)
+
+	return nil
+}
+
+func flattenDataQualityDataSource(apiObject *glue.DataSource) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.GlueTable; v != nil {
+		tfMap["glue_table"] = []interface{}{flattenDataQualityGlueTable(v)}
+	}
+
+	return tfMap
+}
+
+func flattenDataQualityGlueTable(apiObject *glue.Table) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.CatalogId; v != nil {
+		tfMap["catalog_id"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.ConnectionName; v != nil {
+		tfMap["connection_name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.DatabaseName; v != nil {
+		tfMap["database_name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.TableName; v != nil {
+		tfMap["table_name"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
@@ -0,0 +1,49 @@
+package glue_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccGlueDataQualityRuleRecommendationRunDataSource_basic(t *testing.T) {
+	// Recommendation runs are started asynchronously against existing data and cannot be created by Terraform.
+	key := "GLUE_DATA_QUALITY_RECOMMENDATION_RUN_ID"
+	runID := os.Getenv(key)
+	if runID == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+
+	dataSourceName := "data.aws_glue_data_quality_rule_recommendation_run.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, glue.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataQualityRuleRecommendationRunDataSourceConfig_basic(runID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "run_id", runID),
+					resource.TestCheckResourceAttrSet(dataSourceName, "completed_on"),
+					resource.TestCheckResourceAttr(dataSourceName, "data_source.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "data_source.0.glue_table.#", "1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "recommended_ruleset"),
+					resource.TestCheckResourceAttr(dataSourceName, "status", glue.TaskStatusTypeSucceeded),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataQualityRuleRecommendationRunDataSourceConfig_basic(runID string) string {
+	return fmt.Sprintf(`
+data "aws_glue_data_quality_rule_recommendation_run" "test" {
+  run_id = %[1]q
+}
+`, runID)
+}
@@ -0,0 +1,299 @@
+package glue
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceDataQualityRuleset() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDataQualityRulesetCreate,
+		ReadWithoutTimeout:   resourceDataQualityRulesetRead,
+		UpdateWithoutTimeout: resourceDataQualityRulesetUpdate,
+		DeleteWithoutTimeout: resourceDataQualityRulesetDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_on": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 2048),
+			},
+			"last_modified_on": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"recommendation_run_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ruleset": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 65536),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"target_table": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"catalog_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"database_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+						"table_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceDataQualityRulesetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GlueConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &glue.CreateDataQualityRulesetInput{
+		Name:    aws.String(name),
+		Ruleset: aws.String(d.Get("ruleset").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("target_table"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.TargetTable = expandDataQualityTargetTable(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Glue Data Quality Ruleset: %s", input)
+	_, err := conn.CreateDataQualityRulesetWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Glue Data Quality Ruleset (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceDataQualityRulesetRead(ctx, d, meta)
+}
+
+func resourceDataQualityRulesetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GlueConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindDataQualityRulesetByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Glue Data Quality Ruleset (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Glue Data Quality Ruleset (%s): %s", d.Id(), err)
+	}
+
+	rulesetARN := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Service:   "glue",
+		Region:    meta.(*conns.AWSClient).Region,
+		AccountID: meta.(*conns.AWSClient).AccountID,
+		Resource:  fmt.Sprintf("dataQualityRuleset/%s", d.Id()),
+	}.String()
+	d.Set("arn", rulesetARN)
+	if output.CreatedOn != nil {
+		d.Set("created_on", aws.TimeValue(output.CreatedOn).Format(time.RFC3339))
+	} else {
+		d.Set("created_on", nil)
+	}
+	d.Set("description", output.Description)
+	if output.LastModifiedOn != nil {
+		d.Set("last_modified_on", aws.TimeValue(output.LastModifiedOn).Format(time.RFC3339))
+	} else {
+		d.Set("last_modified_on", nil)
+	}
+	d.Set("name", output.Name)
+	d.Set("recommendation_run_id", output.RecommendationRunId)
+	d.Set("ruleset", output.Ruleset)
+	if output.TargetTable != nil {
+		if err := d.Set("target_table", []interface{}{flattenDataQualityTargetTable(output.TargetTable)}); err != nil {
+			return diag.Errorf("setting target_table: %s", err)
+		}
+	} else {
+		d.Set("target_table", nil)
+	}
+
+	tags, err := ListTagsWithContext(ctx, conn, rulesetARN)
+
+	if err != nil {
+		return diag.Errorf("listing tags for Glue Data Quality Ruleset (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceDataQualityRulesetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GlueConn
+
+	if d.HasChanges("description", "ruleset") {
+		input := &glue.UpdateDataQualityRulesetInput{
+			Name: aws.String(d.Id()),
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("ruleset") {
+			input.Ruleset = aws.String(d.Get("ruleset").(string))
+		}
+
+		log.Printf("[DEBUG] Updating Glue Data Quality Ruleset: %s", input)
+		_, err := conn.UpdateDataQualityRulesetWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating Glue Data Quality Ruleset (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating Glue Data Quality Ruleset (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceDataQualityRulesetRead(ctx, d, meta)
+}
+
+func resourceDataQualityRulesetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GlueConn
+
+	log.Printf("[DEBUG] Deleting Glue Data Quality Ruleset: %s", d.Id())
+	_, err := conn.DeleteDataQualityRulesetWithContext(ctx, &glue.DeleteDataQualityRulesetInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, glue.ErrCodeEntityNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Glue Data Quality Ruleset (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandDataQualityTargetTable(tfMap map[string]interface{}) *glue.DataQualityTargetTable {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &glue.DataQualityTargetTable{}
+
+	if v, ok := tfMap["catalog_id"].(string); ok && v != "" {
+		apiObject.CatalogId = aws.String(v)
+	}
+
+	if v, ok := tfMap["database_name"].(string); ok && v != "" {
+		apiObject.DatabaseName = aws.String(v)
+	}
+
+	if v, ok := tfMap["table_name"].(string); ok && v != "" {
+		apiObject.TableName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenDataQualityTargetTable(apiObject *glue.DataQualityTargetTable) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.CatalogId; v != nil {
+		tfMap["catalog_id"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.DatabaseName; v != nil {
+		tfMap["database_name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.TableName; v != nil {
+		tfMap["table_name"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
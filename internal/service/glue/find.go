@@ -1,6 +1,8 @@
 package glue
 
 import (
+	"context"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/glue"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
@@ -217,3 +219,53 @@ func FindPartitionIndexByName(conn *glue.Glue, id string) (*glue.PartitionIndexD
 
 	return result, nil
 }
+
+func FindDataQualityRulesetByName(ctx context.Context, conn *glue.Glue, name string) (*glue.GetDataQualityRulesetOutput, error) {
+	input := &glue.GetDataQualityRulesetInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetDataQualityRulesetWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, glue.ErrCodeEntityNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindDataQualityRuleRecommendationRunByID(ctx context.Context, conn *glue.Glue, id string) (*glue.GetDataQualityRuleRecommendationRunOutput, error) {
+	input := &glue.GetDataQualityRuleRecommendationRunInput{
+		RunId: aws.String(id),
+	}
+
+	output, err := conn.GetDataQualityRuleRecommendationRunWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, glue.ErrCodeEntityNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -1,6 +1,7 @@
 package glue
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -8,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/glue"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -16,6 +18,11 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+const (
+	jobCommandNameETL = "glueetl"
+	jobCommandNameRay = "glueray"
+)
+
 func ResourceJob() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceJobCreate,
@@ -26,7 +33,10 @@ func ResourceJob() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			resourceJobCustomizeDiff,
+		),
 
 		Schema: map[string]*schema.Schema{
 			"arn": {
@@ -42,7 +52,7 @@ func ResourceJob() *schema.Resource {
 						"name": {
 							Type:     schema.TypeString,
 							Optional: true,
-							Default:  "glueetl",
+							Default:  jobCommandNameETL,
 						},
 						"script_location": {
 							Type:     schema.TypeString,
@@ -52,7 +62,12 @@ func ResourceJob() *schema.Resource {
 							Type:         schema.TypeString,
 							Optional:     true,
 							Computed:     true,
-							ValidateFunc: validation.StringInSlice([]string{"2", "3"}, true),
+							ValidateFunc: validation.StringInSlice([]string{"2", "3", "3.9"}, true),
+						},
+						"runtime": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 64),
 						},
 					},
 				},
@@ -76,6 +91,12 @@ func ResourceJob() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"execution_class": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(glue.ExecutionClass_Values(), false),
+			},
 			"execution_property": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -162,6 +183,53 @@ func ResourceJob() *schema.Resource {
 	}
 }
 
+// resourceJobCustomizeDiff rejects job type, worker type and execution class combinations that the Glue API does not support.
+func resourceJobCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("command") || !diff.NewValueKnown("execution_class") || !diff.NewValueKnown("worker_type") {
+		return nil
+	}
+
+	var commandName, runtime string
+
+	if v, ok := diff.GetOk("command"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+		commandName = tfMap["name"].(string)
+		runtime = tfMap["runtime"].(string)
+	}
+
+	workerType := diff.Get("worker_type").(string)
+
+	if commandName == jobCommandNameRay {
+		if workerType != glue.WorkerTypeZ2x {
+			return fmt.Errorf("command name %q requires worker_type = %q", jobCommandNameRay, glue.WorkerTypeZ2x)
+		}
+
+		if runtime == "" {
+			return fmt.Errorf("command name %q requires command.runtime", jobCommandNameRay)
+		}
+	} else {
+		if workerType == glue.WorkerTypeZ2x {
+			return fmt.Errorf("worker_type %q is only supported with command name %q", glue.WorkerTypeZ2x, jobCommandNameRay)
+		}
+
+		if runtime != "" {
+			return fmt.Errorf("command.runtime is only supported with command name %q", jobCommandNameRay)
+		}
+	}
+
+	if executionClass := diff.Get("execution_class").(string); executionClass == glue.ExecutionClassFlex {
+		if commandName != jobCommandNameETL {
+			return fmt.Errorf("execution_class %q is only supported with command name %q", glue.ExecutionClassFlex, jobCommandNameETL)
+		}
+
+		if workerType != "" && workerType != glue.WorkerTypeG1x && workerType != glue.WorkerTypeG2x {
+			return fmt.Errorf("execution_class %q is only supported with worker_type %q or %q", glue.ExecutionClassFlex, glue.WorkerTypeG1x, glue.WorkerTypeG2x)
+		}
+	}
+
+	return nil
+}
+
 func resourceJobCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).GlueConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
@@ -205,6 +273,10 @@ func resourceJobCreate(d *schema.ResourceData, meta interface{}) error {
 		input.GlueVersion = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("execution_class"); ok {
+		input.ExecutionClass = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("execution_property"); ok {
 		input.ExecutionProperty = expandExecutionProperty(v.([]interface{}))
 	}
@@ -290,6 +362,7 @@ func resourceJobRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.Set("description", job.Description)
 	d.Set("glue_version", job.GlueVersion)
+	d.Set("execution_class", job.ExecutionClass)
 	if err := d.Set("execution_property", flattenExecutionProperty(job.ExecutionProperty)); err != nil {
 		return fmt.Errorf("error setting execution_property: %s", err)
 	}
@@ -333,7 +406,7 @@ func resourceJobUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).GlueConn
 
 	if d.HasChanges("command", "connections", "default_arguments", "description",
-		"execution_property", "glue_version", "max_capacity", "max_retries", "notification_property", "number_of_workers",
+		"execution_class", "execution_property", "glue_version", "max_capacity", "max_retries", "notification_property", "number_of_workers",
 		"role_arn", "security_configuration", "timeout", "worker_type", "non_overridable_arguments") {
 		jobUpdate := &glue.JobUpdate{
 			Command: expandJobCommand(d.Get("command").([]interface{})),
@@ -374,6 +447,10 @@ func resourceJobUpdate(d *schema.ResourceData, meta interface{}) error {
 			jobUpdate.GlueVersion = aws.String(v.(string))
 		}
 
+		if v, ok := d.GetOk("execution_class"); ok {
+			jobUpdate.ExecutionClass = aws.String(v.(string))
+		}
+
 		if v, ok := d.GetOk("execution_property"); ok {
 			jobUpdate.ExecutionProperty = expandExecutionProperty(v.([]interface{}))
 		}
@@ -466,6 +543,10 @@ func expandJobCommand(l []interface{}) *glue.JobCommand {
 		jobCommand.PythonVersion = aws.String(v)
 	}
 
+	if v, ok := m["runtime"].(string); ok && v != "" {
+		jobCommand.Runtime = aws.String(v)
+	}
+
 	return jobCommand
 }
 
@@ -508,6 +589,7 @@ func flattenJobCommand(jobCommand *glue.JobCommand) []map[string]interface{} {
 		"name":            aws.StringValue(jobCommand.Name),
 		"script_location": aws.StringValue(jobCommand.ScriptLocation),
 		"python_version":  aws.StringValue(jobCommand.PythonVersion),
+		"runtime":         aws.StringValue(jobCommand.Runtime),
 	}
 
 	return []map[string]interface{}{m}
@@ -595,6 +595,111 @@ func TestAccGlueJob_workerType(t *testing.T) {
 	})
 }
 
+func TestAccGlueJob_executionClass(t *testing.T) {
+	var job glue.Job
+
+	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(5))
+	resourceName := "aws_glue_job.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, glue.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobConfig_executionClass(rName, "FLEX"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobExists(resourceName, &job),
+					resource.TestCheckResourceAttr(resourceName, "execution_class", "FLEX"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccJobConfig_executionClass(rName, "STANDARD"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobExists(resourceName, &job),
+					resource.TestCheckResourceAttr(resourceName, "execution_class", "STANDARD"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGlueJob_executionClassInvalid(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(5))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, glue.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccJobConfig_executionClassPythonShell(rName),
+				ExpectError: regexp.MustCompile(`execution_class "FLEX" is only supported with command name "glueetl"`),
+			},
+			{
+				Config:      testAccJobConfig_executionClassWorkerType(rName, "FLEX", "G.4X"),
+				ExpectError: regexp.MustCompile(`execution_class "FLEX" is only supported with worker_type "G.1X" or "G.2X"`),
+			},
+		},
+	})
+}
+
+func TestAccGlueJob_ray(t *testing.T) {
+	var job glue.Job
+
+	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(5))
+	resourceName := "aws_glue_job.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, glue.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobConfig_ray(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobExists(resourceName, &job),
+					resource.TestCheckResourceAttr(resourceName, "command.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "command.0.name", "glueray"),
+					resource.TestCheckResourceAttr(resourceName, "command.0.python_version", "3.9"),
+					resource.TestCheckResourceAttr(resourceName, "command.0.runtime", "Ray2.4"),
+					resource.TestCheckResourceAttr(resourceName, "worker_type", "Z.2X"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGlueJob_rayInvalidWorkerType(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(5))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, glue.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccJobConfig_workerType(rName, "Z.2X"),
+				ExpectError: regexp.MustCompile(`worker_type "Z.2X" is only supported with command name "glueray"`),
+			},
+		},
+	})
+}
+
 func TestAccGlueJob_pythonShell(t *testing.T) {
 	var job glue.Job
 
@@ -1133,6 +1238,91 @@ resource "aws_glue_job" "test" {
 `, testAccJobConfig_Base(rName), rName, workerType)
 }
 
+func testAccJobConfig_executionClass(rName, executionClass string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "aws_glue_job" "test" {
+  name              = "%s"
+  role_arn          = aws_iam_role.test.arn
+  execution_class   = "%s"
+  glue_version      = "3.0"
+  worker_type       = "G.1X"
+  number_of_workers = 2
+
+  command {
+    script_location = "testscriptlocation"
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, testAccJobConfig_Base(rName), rName, executionClass)
+}
+
+func testAccJobConfig_executionClassWorkerType(rName, executionClass, workerType string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "aws_glue_job" "test" {
+  name              = "%s"
+  role_arn          = aws_iam_role.test.arn
+  execution_class   = "%s"
+  glue_version      = "3.0"
+  worker_type       = "%s"
+  number_of_workers = 2
+
+  command {
+    script_location = "testscriptlocation"
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, testAccJobConfig_Base(rName), rName, executionClass, workerType)
+}
+
+func testAccJobConfig_executionClassPythonShell(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "aws_glue_job" "test" {
+  name            = "%s"
+  role_arn        = aws_iam_role.test.arn
+  execution_class = "FLEX"
+  max_capacity    = 0.0625
+
+  command {
+    name            = "pythonshell"
+    script_location = "testscriptlocation"
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, testAccJobConfig_Base(rName), rName)
+}
+
+func testAccJobConfig_ray(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "aws_glue_job" "test" {
+  name              = "%s"
+  role_arn          = aws_iam_role.test.arn
+  glue_version      = "4.0"
+  worker_type       = "Z.2X"
+  number_of_workers = 10
+
+  command {
+    name            = "glueray"
+    python_version  = "3.9"
+    runtime         = "Ray2.4"
+    script_location = "testscriptlocation"
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, testAccJobConfig_Base(rName), rName)
+}
+
 func testAccJobConfig_pythonShell(rName string) string {
 	return fmt.Sprintf(`
 %s
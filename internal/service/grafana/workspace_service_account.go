@@ -0,0 +1,150 @@
+package grafana
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/managedgrafana"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceWorkspaceServiceAccount() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceWorkspaceServiceAccountCreate,
+		ReadWithoutTimeout:   resourceWorkspaceServiceAccountRead,
+		DeleteWithoutTimeout: resourceWorkspaceServiceAccountDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"grafana_role": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(managedgrafana.Role_Values(), false),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"service_account_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"workspace_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceWorkspaceServiceAccountCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GrafanaConn
+
+	name := d.Get("name").(string)
+	workspaceID := d.Get("workspace_id").(string)
+	input := &managedgrafana.CreateWorkspaceServiceAccountInput{
+		GrafanaRole: aws.String(d.Get("grafana_role").(string)),
+		Name:        aws.String(name),
+		WorkspaceId: aws.String(workspaceID),
+	}
+
+	log.Printf("[DEBUG] Creating Grafana Workspace Service Account: %s", input)
+	output, err := conn.CreateWorkspaceServiceAccountWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Grafana Workspace (%s) Service Account (%s): %s", workspaceID, name, err)
+	}
+
+	d.SetId(WorkspaceServiceAccountCreateResourceID(workspaceID, aws.StringValue(output.Id)))
+
+	return resourceWorkspaceServiceAccountRead(ctx, d, meta)
+}
+
+func resourceWorkspaceServiceAccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GrafanaConn
+
+	workspaceID, serviceAccountID, err := WorkspaceServiceAccountParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	serviceAccount, err := FindWorkspaceServiceAccountByTwoPartKey(ctx, conn, workspaceID, serviceAccountID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Grafana Workspace Service Account (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Grafana Workspace Service Account (%s): %s", d.Id(), err)
+	}
+
+	d.Set("grafana_role", serviceAccount.GrafanaRole)
+	d.Set("name", serviceAccount.Name)
+	d.Set("service_account_id", serviceAccount.Id)
+	d.Set("workspace_id", workspaceID)
+
+	return nil
+}
+
+func resourceWorkspaceServiceAccountDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GrafanaConn
+
+	workspaceID, serviceAccountID, err := WorkspaceServiceAccountParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting Grafana Workspace Service Account: %s", d.Id())
+	_, err = conn.DeleteWorkspaceServiceAccountWithContext(ctx, &managedgrafana.DeleteWorkspaceServiceAccountInput{
+		ServiceAccountId: aws.String(serviceAccountID),
+		WorkspaceId:      aws.String(workspaceID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, managedgrafana.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Grafana Workspace Service Account (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+const workspaceServiceAccountResourceIDSeparator = ","
+
+func WorkspaceServiceAccountCreateResourceID(workspaceID, serviceAccountID string) string {
+	parts := []string{workspaceID, serviceAccountID}
+	id := strings.Join(parts, workspaceServiceAccountResourceIDSeparator)
+
+	return id
+}
+
+func WorkspaceServiceAccountParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, workspaceServiceAccountResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected WORKSPACE-ID%[2]sSERVICE-ACCOUNT-ID", id, workspaceServiceAccountResourceIDSeparator)
+}
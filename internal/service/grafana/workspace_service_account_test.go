@@ -0,0 +1,140 @@
+package grafana_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/managedgrafana"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfgrafana "github.com/hashicorp/terraform-provider-aws/internal/service/grafana"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccWorkspaceServiceAccount_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_grafana_workspace_service_account.test"
+	workspaceResourceName := "aws_grafana_workspace.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(managedgrafana.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, managedgrafana.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWorkspaceServiceAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkspaceServiceAccountConfig_basic(rName, managedgrafana.RoleAdmin),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWorkspaceServiceAccountExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "grafana_role", managedgrafana.RoleAdmin),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "service_account_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "workspace_id", workspaceResourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccWorkspaceServiceAccountConfig_basic(rName, managedgrafana.RoleViewer),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWorkspaceServiceAccountExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "grafana_role", managedgrafana.RoleViewer),
+				),
+			},
+		},
+	})
+}
+
+func testAccWorkspaceServiceAccount_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_grafana_workspace_service_account.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(managedgrafana.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, managedgrafana.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWorkspaceServiceAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkspaceServiceAccountConfig_basic(rName, managedgrafana.RoleAdmin),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWorkspaceServiceAccountExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfgrafana.ResourceWorkspaceServiceAccount(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckWorkspaceServiceAccountExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Grafana Workspace Service Account ID is set")
+		}
+
+		workspaceID, serviceAccountID, err := tfgrafana.WorkspaceServiceAccountParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GrafanaConn
+
+		_, err = tfgrafana.FindWorkspaceServiceAccountByTwoPartKey(context.Background(), conn, workspaceID, serviceAccountID)
+
+		return err
+	}
+}
+
+func testAccCheckWorkspaceServiceAccountDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).GrafanaConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_grafana_workspace_service_account" {
+			continue
+		}
+
+		workspaceID, serviceAccountID, err := tfgrafana.WorkspaceServiceAccountParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfgrafana.FindWorkspaceServiceAccountByTwoPartKey(context.Background(), conn, workspaceID, serviceAccountID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Grafana Workspace Service Account %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccWorkspaceServiceAccountConfig_basic(rName, role string) string {
+	return acctest.ConfigCompose(testAccWorkspaceConfig_authenticationProvider(rName, "SAML"), fmt.Sprintf(`
+resource "aws_grafana_workspace_service_account" "test" {
+  name         = %[1]q
+  grafana_role = %[2]q
+  workspace_id = aws_grafana_workspace.test.id
+}
+`, rName, role))
+}
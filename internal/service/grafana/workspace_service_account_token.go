@@ -0,0 +1,172 @@
+package grafana
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/managedgrafana"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceWorkspaceServiceAccountToken() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceWorkspaceServiceAccountTokenCreate,
+		ReadWithoutTimeout:   resourceWorkspaceServiceAccountTokenRead,
+		DeleteWithoutTimeout: resourceWorkspaceServiceAccountTokenDelete,
+
+		Schema: map[string]*schema.Schema{
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"expires_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"seconds_to_live": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(1, 2592000),
+			},
+			"service_account_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"service_account_token_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"workspace_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceWorkspaceServiceAccountTokenCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GrafanaConn
+
+	name := d.Get("name").(string)
+	serviceAccountID := d.Get("service_account_id").(string)
+	workspaceID := d.Get("workspace_id").(string)
+	input := &managedgrafana.CreateWorkspaceServiceAccountTokenInput{
+		Name:             aws.String(name),
+		SecondsToLive:    aws.Int64(int64(d.Get("seconds_to_live").(int))),
+		ServiceAccountId: aws.String(serviceAccountID),
+		WorkspaceId:      aws.String(workspaceID),
+	}
+
+	log.Printf("[DEBUG] Creating Grafana Workspace Service Account Token: %s", name)
+	output, err := conn.CreateWorkspaceServiceAccountTokenWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Grafana Workspace (%s) Service Account (%s) Token (%s): %s", workspaceID, serviceAccountID, name, err)
+	}
+
+	d.SetId(WorkspaceServiceAccountTokenCreateResourceID(workspaceID, serviceAccountID, aws.StringValue(output.ServiceAccountToken.Id)))
+	// The token key is only returned on creation.
+	d.Set("key", output.ServiceAccountToken.Key)
+
+	return resourceWorkspaceServiceAccountTokenRead(ctx, d, meta)
+}
+
+func resourceWorkspaceServiceAccountTokenRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GrafanaConn
+
+	workspaceID, serviceAccountID, tokenID, err := WorkspaceServiceAccountTokenParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	token, err := FindWorkspaceServiceAccountTokenByThreePartKey(ctx, conn, workspaceID, serviceAccountID, tokenID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Grafana Workspace Service Account Token (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Grafana Workspace Service Account Token (%s): %s", d.Id(), err)
+	}
+
+	d.Set("created_at", aws.TimeValue(token.CreatedAt).Format(time.RFC3339))
+	d.Set("expires_at", aws.TimeValue(token.ExpiresAt).Format(time.RFC3339))
+	d.Set("name", token.Name)
+	d.Set("service_account_id", serviceAccountID)
+	d.Set("service_account_token_id", token.Id)
+	d.Set("workspace_id", workspaceID)
+
+	return nil
+}
+
+func resourceWorkspaceServiceAccountTokenDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GrafanaConn
+
+	workspaceID, serviceAccountID, tokenID, err := WorkspaceServiceAccountTokenParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting Grafana Workspace Service Account Token: %s", d.Id())
+	_, err = conn.DeleteWorkspaceServiceAccountTokenWithContext(ctx, &managedgrafana.DeleteWorkspaceServiceAccountTokenInput{
+		ServiceAccountId: aws.String(serviceAccountID),
+		TokenId:          aws.String(tokenID),
+		WorkspaceId:      aws.String(workspaceID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, managedgrafana.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Grafana Workspace Service Account Token (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+const workspaceServiceAccountTokenResourceIDSeparator = ","
+
+func WorkspaceServiceAccountTokenCreateResourceID(workspaceID, serviceAccountID, tokenID string) string {
+	parts := []string{workspaceID, serviceAccountID, tokenID}
+	id := strings.Join(parts, workspaceServiceAccountTokenResourceIDSeparator)
+
+	return id
+}
+
+func WorkspaceServiceAccountTokenParseResourceID(id string) (string, string, string, error) {
+	parts := strings.Split(id, workspaceServiceAccountTokenResourceIDSeparator)
+
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected WORKSPACE-ID%[2]sSERVICE-ACCOUNT-ID%[2]sTOKEN-ID", id, workspaceServiceAccountTokenResourceIDSeparator)
+}
@@ -0,0 +1,133 @@
+package grafana_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/managedgrafana"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfgrafana "github.com/hashicorp/terraform-provider-aws/internal/service/grafana"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccWorkspaceServiceAccountToken_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_grafana_workspace_service_account_token.test"
+	serviceAccountResourceName := "aws_grafana_workspace_service_account.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(managedgrafana.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, managedgrafana.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWorkspaceServiceAccountTokenDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkspaceServiceAccountTokenConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWorkspaceServiceAccountTokenExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+					resource.TestCheckResourceAttrSet(resourceName, "expires_at"),
+					resource.TestCheckResourceAttrSet(resourceName, "key"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "seconds_to_live", "3600"),
+					resource.TestCheckResourceAttrPair(resourceName, "service_account_id", serviceAccountResourceName, "service_account_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "service_account_token_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "workspace_id", serviceAccountResourceName, "workspace_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccWorkspaceServiceAccountToken_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_grafana_workspace_service_account_token.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(managedgrafana.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, managedgrafana.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWorkspaceServiceAccountTokenDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkspaceServiceAccountTokenConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWorkspaceServiceAccountTokenExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfgrafana.ResourceWorkspaceServiceAccountToken(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckWorkspaceServiceAccountTokenExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Grafana Workspace Service Account Token ID is set")
+		}
+
+		workspaceID, serviceAccountID, tokenID, err := tfgrafana.WorkspaceServiceAccountTokenParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GrafanaConn
+
+		_, err = tfgrafana.FindWorkspaceServiceAccountTokenByThreePartKey(context.Background(), conn, workspaceID, serviceAccountID, tokenID)
+
+		return err
+	}
+}
+
+func testAccCheckWorkspaceServiceAccountTokenDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).GrafanaConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_grafana_workspace_service_account_token" {
+			continue
+		}
+
+		workspaceID, serviceAccountID, tokenID, err := tfgrafana.WorkspaceServiceAccountTokenParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfgrafana.FindWorkspaceServiceAccountTokenByThreePartKey(context.Background(), conn, workspaceID, serviceAccountID, tokenID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Grafana Workspace Service Account Token %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccWorkspaceServiceAccountTokenConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccWorkspaceServiceAccountConfig_basic(rName, managedgrafana.RoleAdmin), fmt.Sprintf(`
+resource "aws_grafana_workspace_service_account_token" "test" {
+  name               = %[1]q
+  seconds_to_live    = 3600
+  service_account_id = aws_grafana_workspace_service_account.test.service_account_id
+  workspace_id       = aws_grafana_workspace_service_account.test.workspace_id
+}
+`, rName))
+}
@@ -46,6 +46,14 @@ func TestAccGrafana_serial(t *testing.T) {
 			"usersAndGroupsAdmin":  testAccRoleAssociation_usersAndGroupsAdmin,
 			"usersAndGroupsEditor": testAccRoleAssociation_usersAndGroupsEditor,
 		},
+		"ServiceAccount": {
+			"basic":      testAccWorkspaceServiceAccount_basic,
+			"disappears": testAccWorkspaceServiceAccount_disappears,
+		},
+		"ServiceAccountToken": {
+			"basic":      testAccWorkspaceServiceAccountToken_basic,
+			"disappears": testAccWorkspaceServiceAccountToken_disappears,
+		},
 	}
 
 	for group, m := range testCases {
@@ -14,6 +14,38 @@ func DataSourceDetector() *schema.Resource {
 		Read: dataSourceDetectorRead,
 
 		Schema: map[string]*schema.Schema{
+			"features": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"additional_configuration": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"status": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -72,9 +104,52 @@ func dataSourceDetectorRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.SetId(detectorId)
+	if err := d.Set("features", flattenDetectorFeatureConfigurationResults(getResp.Features)); err != nil {
+		return fmt.Errorf("error setting features: %w", err)
+	}
 	d.Set("status", getResp.Status)
 	d.Set("service_role_arn", getResp.ServiceRole)
 	d.Set("finding_publishing_frequency", getResp.FindingPublishingFrequency)
 
 	return nil
 }
+
+func flattenDetectorFeatureConfigurationResult(apiObject *guardduty.DetectorFeatureConfigurationResult) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AdditionalConfiguration; v != nil {
+		tfMap["additional_configuration"] = flattenDetectorAdditionalConfigurationResults(v)
+	}
+
+	if v := apiObject.Name; v != nil {
+		tfMap["name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Status; v != nil {
+		tfMap["status"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenDetectorFeatureConfigurationResults(apiObjects []*guardduty.DetectorFeatureConfigurationResult) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenDetectorFeatureConfigurationResult(apiObject))
+	}
+
+	return tfList
+}
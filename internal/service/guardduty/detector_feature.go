@@ -0,0 +1,317 @@
+package guardduty
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceDetectorFeature() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDetectorFeaturePut,
+		ReadWithoutTimeout:   resourceDetectorFeatureRead,
+		UpdateWithoutTimeout: resourceDetectorFeaturePut,
+		DeleteWithoutTimeout: resourceDetectorFeatureDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"additional_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(guardduty.FeatureAdditionalConfiguration_Values(), false),
+						},
+						"status": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(guardduty.FeatureStatus_Values(), false),
+						},
+					},
+				},
+			},
+			"detector_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(guardduty.DetectorFeature_Values(), false),
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(guardduty.FeatureStatus_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceDetectorFeaturePut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GuardDutyConn
+
+	detectorID, name := d.Get("detector_id").(string), d.Get("name").(string)
+	feature := &guardduty.DetectorFeatureConfiguration{
+		Name:   aws.String(name),
+		Status: aws.String(d.Get("status").(string)),
+	}
+
+	if v, ok := d.GetOk("additional_configuration"); ok && len(v.([]interface{})) > 0 {
+		feature.AdditionalConfiguration = expandDetectorAdditionalConfigurations(v.([]interface{}))
+	}
+
+	input := &guardduty.UpdateDetectorInput{
+		DetectorId: aws.String(detectorID),
+		Features:   []*guardduty.DetectorFeatureConfiguration{feature},
+	}
+
+	// Each feature resource updates the same detector.
+	// Serialize the calls so that concurrent feature updates don't conflict.
+	mutexKey := detectorFeatureMutexKey(detectorID)
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+	_, err := conn.UpdateDetectorWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("updating GuardDuty Detector (%s) Feature (%s): %s", detectorID, name, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(DetectorFeatureCreateResourceID(detectorID, name))
+	}
+
+	return resourceDetectorFeatureRead(ctx, d, meta)
+}
+
+func resourceDetectorFeatureRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GuardDutyConn
+
+	detectorID, name, err := DetectorFeatureParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	feature, err := FindDetectorFeatureByTwoPartKey(ctx, conn, detectorID, name)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] GuardDuty Detector Feature (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading GuardDuty Detector Feature (%s): %s", d.Id(), err)
+	}
+
+	// GuardDuty reports every additional configuration supported by the feature.
+	// Only reconcile the additional configurations that are managed here.
+	additionalConfigurations := filterDetectorAdditionalConfigurationResults(feature.AdditionalConfiguration, d.Get("additional_configuration").([]interface{}))
+
+	if err := d.Set("additional_configuration", flattenDetectorAdditionalConfigurationResults(additionalConfigurations)); err != nil {
+		return diag.Errorf("setting additional_configuration: %s", err)
+	}
+	d.Set("detector_id", detectorID)
+	d.Set("name", feature.Name)
+	d.Set("status", feature.Status)
+
+	return nil
+}
+
+func resourceDetectorFeatureDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GuardDutyConn
+
+	detectorID, name, err := DetectorFeatureParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	feature := &guardduty.DetectorFeatureConfiguration{
+		Name:   aws.String(name),
+		Status: aws.String(guardduty.FeatureStatusDisabled),
+	}
+
+	if v, ok := d.GetOk("additional_configuration"); ok && len(v.([]interface{})) > 0 {
+		for _, v := range expandDetectorAdditionalConfigurations(v.([]interface{})) {
+			v.Status = aws.String(guardduty.FeatureStatusDisabled)
+			feature.AdditionalConfiguration = append(feature.AdditionalConfiguration, v)
+		}
+	}
+
+	input := &guardduty.UpdateDetectorInput{
+		DetectorId: aws.String(detectorID),
+		Features:   []*guardduty.DetectorFeatureConfiguration{feature},
+	}
+
+	mutexKey := detectorFeatureMutexKey(detectorID)
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+	log.Printf("[DEBUG] Deleting GuardDuty Detector Feature: %s", d.Id())
+	_, err = conn.UpdateDetectorWithContext(ctx, input)
+
+	if tfawserr.ErrMessageContains(err, guardduty.ErrCodeBadRequestException, "The request is rejected because the input detectorId is not owned by the current account.") {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting GuardDuty Detector Feature (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+const detectorFeatureIDSeparator = ":"
+
+func DetectorFeatureCreateResourceID(detectorID, name string) string {
+	parts := []string{detectorID, name}
+	id := strings.Join(parts, detectorFeatureIDSeparator)
+
+	return id
+}
+
+func DetectorFeatureParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, detectorFeatureIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected DETECTOR-ID%[2]sFEATURE-NAME", id, detectorFeatureIDSeparator)
+}
+
+// detectorFeatureMutexKey returns the key used to serialize feature updates to a detector.
+func detectorFeatureMutexKey(detectorID string) string {
+	return fmt.Sprintf("guardduty-detector-feature-%s", detectorID)
+}
+
+// filterDetectorAdditionalConfigurationResults returns the additional configurations
+// named in the Terraform configuration, in configuration order.
+func filterDetectorAdditionalConfigurationResults(apiObjects []*guardduty.DetectorAdditionalConfigurationResult, tfList []interface{}) []*guardduty.DetectorAdditionalConfigurationResult {
+	byName := make(map[string]*guardduty.DetectorAdditionalConfigurationResult)
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		byName[aws.StringValue(apiObject.Name)] = apiObject
+	}
+
+	var filtered []*guardduty.DetectorAdditionalConfigurationResult
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		if v, ok := byName[tfMap["name"].(string)]; ok {
+			filtered = append(filtered, v)
+		}
+	}
+
+	return filtered
+}
+
+func expandDetectorAdditionalConfiguration(tfMap map[string]interface{}) *guardduty.DetectorAdditionalConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &guardduty.DetectorAdditionalConfiguration{}
+
+	if v, ok := tfMap["name"].(string); ok && v != "" {
+		apiObject.Name = aws.String(v)
+	}
+
+	if v, ok := tfMap["status"].(string); ok && v != "" {
+		apiObject.Status = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandDetectorAdditionalConfigurations(tfList []interface{}) []*guardduty.DetectorAdditionalConfiguration {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*guardduty.DetectorAdditionalConfiguration
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandDetectorAdditionalConfiguration(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenDetectorAdditionalConfigurationResult(apiObject *guardduty.DetectorAdditionalConfigurationResult) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Name; v != nil {
+		tfMap["name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Status; v != nil {
+		tfMap["status"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenDetectorAdditionalConfigurationResults(apiObjects []*guardduty.DetectorAdditionalConfigurationResult) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenDetectorAdditionalConfigurationResult(apiObject))
+	}
+
+	return tfList
+}
@@ -0,0 +1,226 @@
+package guardduty_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfguardduty "github.com/hashicorp/terraform-provider-aws/internal/service/guardduty"
+)
+
+func testAccDetectorFeature_basic(t *testing.T) {
+	resourceName := "aws_guardduty_detector_feature.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, guardduty.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		// GuardDuty Detector Features cannot be deleted separately.
+		// Ensure parent resource is destroyed instead.
+		CheckDestroy: testAccCheckDetectorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDetectorFeatureConfig_basic("RDS_LOGIN_EVENTS", "ENABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDetectorFeatureExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "additional_configuration.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "detector_id"),
+					resource.TestCheckResourceAttr(resourceName, "name", "RDS_LOGIN_EVENTS"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ENABLED"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDetectorFeatureConfig_basic("RDS_LOGIN_EVENTS", "DISABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDetectorFeatureExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", "RDS_LOGIN_EVENTS"),
+					resource.TestCheckResourceAttr(resourceName, "status", "DISABLED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDetectorFeature_runtimeMonitoring(t *testing.T) {
+	resourceName := "aws_guardduty_detector_feature.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, guardduty.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDetectorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDetectorFeatureConfig_runtimeMonitoring("ENABLED", "ENABLED", "DISABLED", "ENABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDetectorFeatureExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "additional_configuration.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "additional_configuration.0.name", "EKS_ADDON_MANAGEMENT"),
+					resource.TestCheckResourceAttr(resourceName, "additional_configuration.0.status", "ENABLED"),
+					resource.TestCheckResourceAttr(resourceName, "additional_configuration.1.name", "ECS_FARGATE_AGENT_MANAGEMENT"),
+					resource.TestCheckResourceAttr(resourceName, "additional_configuration.1.status", "DISABLED"),
+					resource.TestCheckResourceAttr(resourceName, "additional_configuration.2.name", "EC2_AGENT_MANAGEMENT"),
+					resource.TestCheckResourceAttr(resourceName, "additional_configuration.2.status", "ENABLED"),
+					resource.TestCheckResourceAttr(resourceName, "name", "RUNTIME_MONITORING"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ENABLED"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Only configured additional configurations are read back.
+				ImportStateVerifyIgnore: []string{"additional_configuration"},
+			},
+			{
+				Config: testAccDetectorFeatureConfig_runtimeMonitoring("ENABLED", "DISABLED", "ENABLED", "DISABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDetectorFeatureExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "additional_configuration.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "additional_configuration.0.status", "DISABLED"),
+					resource.TestCheckResourceAttr(resourceName, "additional_configuration.1.status", "ENABLED"),
+					resource.TestCheckResourceAttr(resourceName, "additional_configuration.2.status", "DISABLED"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ENABLED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDetectorFeature_multiple(t *testing.T) {
+	resource1Name := "aws_guardduty_detector_feature.test1"
+	resource2Name := "aws_guardduty_detector_feature.test2"
+	resource3Name := "aws_guardduty_detector_feature.test3"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, guardduty.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDetectorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDetectorFeatureConfig_multiple("ENABLED", "DISABLED", "ENABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDetectorFeatureExists(resource1Name),
+					resource.TestCheckResourceAttr(resource1Name, "name", "S3_DATA_EVENTS"),
+					resource.TestCheckResourceAttr(resource1Name, "status", "ENABLED"),
+					testAccCheckDetectorFeatureExists(resource2Name),
+					resource.TestCheckResourceAttr(resource2Name, "name", "EKS_AUDIT_LOGS"),
+					resource.TestCheckResourceAttr(resource2Name, "status", "DISABLED"),
+					testAccCheckDetectorFeatureExists(resource3Name),
+					resource.TestCheckResourceAttr(resource3Name, "name", "EBS_MALWARE_PROTECTION"),
+					resource.TestCheckResourceAttr(resource3Name, "status", "ENABLED"),
+				),
+			},
+			{
+				Config: testAccDetectorFeatureConfig_multiple("DISABLED", "ENABLED", "DISABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDetectorFeatureExists(resource1Name),
+					resource.TestCheckResourceAttr(resource1Name, "status", "DISABLED"),
+					testAccCheckDetectorFeatureExists(resource2Name),
+					resource.TestCheckResourceAttr(resource2Name, "status", "ENABLED"),
+					testAccCheckDetectorFeatureExists(resource3Name),
+					resource.TestCheckResourceAttr(resource3Name, "status", "DISABLED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDetectorFeatureExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No GuardDuty Detector Feature ID is set")
+		}
+
+		detectorID, name, err := tfguardduty.DetectorFeatureParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GuardDutyConn
+
+		_, err = tfguardduty.FindDetectorFeatureByTwoPartKey(context.Background(), conn, detectorID, name)
+
+		return err
+	}
+}
+
+func testAccDetectorFeatureConfig_basic(name, status string) string {
+	return fmt.Sprintf(`
+resource "aws_guardduty_detector" "test" {}
+
+resource "aws_guardduty_detector_feature" "test" {
+  detector_id = aws_guardduty_detector.test.id
+  name        = %[1]q
+  status      = %[2]q
+}
+`, name, status)
+}
+
+func testAccDetectorFeatureConfig_runtimeMonitoring(status, eksStatus, ecsStatus, ec2Status string) string {
+	return fmt.Sprintf(`
+resource "aws_guardduty_detector" "test" {}
+
+resource "aws_guardduty_detector_feature" "test" {
+  detector_id = aws_guardduty_detector.test.id
+  name        = "RUNTIME_MONITORING"
+  status      = %[1]q
+
+  additional_configuration {
+    name   = "EKS_ADDON_MANAGEMENT"
+    status = %[2]q
+  }
+
+  additional_configuration {
+    name   = "ECS_FARGATE_AGENT_MANAGEMENT"
+    status = %[3]q
+  }
+
+  additional_configuration {
+    name   = "EC2_AGENT_MANAGEMENT"
+    status = %[4]q
+  }
+}
+`, status, eksStatus, ecsStatus, ec2Status)
+}
+
+func testAccDetectorFeatureConfig_multiple(status1, status2, status3 string) string {
+	return fmt.Sprintf(`
+resource "aws_guardduty_detector" "test" {}
+
+resource "aws_guardduty_detector_feature" "test1" {
+  detector_id = aws_guardduty_detector.test.id
+  name        = "S3_DATA_EVENTS"
+  status      = %[1]q
+}
+
+resource "aws_guardduty_detector_feature" "test2" {
+  detector_id = aws_guardduty_detector.test.id
+  name        = "EKS_AUDIT_LOGS"
+  status      = %[2]q
+}
+
+resource "aws_guardduty_detector_feature" "test3" {
+  detector_id = aws_guardduty_detector.test.id
+  name        = "EBS_MALWARE_PROTECTION"
+  status      = %[3]q
+}
+`, status1, status2, status3)
+}
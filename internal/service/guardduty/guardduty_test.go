@@ -17,6 +17,11 @@ func TestAccGuardDuty_serial(t *testing.T) {
 			"datasource_basic":                  testAccDetectorDataSource_basic,
 			"datasource_id":                     testAccDetectorDataSource_ID,
 		},
+		"DetectorFeature": {
+			"basic":             testAccDetectorFeature_basic,
+			"runtimeMonitoring": testAccDetectorFeature_runtimeMonitoring,
+			"multiple":          testAccDetectorFeature_multiple,
+		},
 		"Filter": {
 			"basic":      testAccFilter_basic,
 			"update":     testAccFilter_update,
@@ -39,6 +44,11 @@ func TestAccGuardDuty_serial(t *testing.T) {
 			"kubernetes":        testAccOrganizationConfiguration_kubernetes,
 			"malwareProtection": testAccOrganizationConfiguration_malwareprotection,
 		},
+		"OrganizationConfigurationFeature": {
+			"basic":                   testAccOrganizationConfigurationFeature_basic,
+			"additionalConfiguration": testAccOrganizationConfigurationFeature_additionalConfiguration,
+			"multiple":                testAccOrganizationConfigurationFeature_multiple,
+		},
 		"ThreatIntelSet": {
 			"basic": testAccThreatintelset_basic,
 			"tags":  testAccThreatintelset_tags,
@@ -0,0 +1,348 @@
+package guardduty
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceMalwareProtectionPlan() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceMalwareProtectionPlanCreate,
+		ReadWithoutTimeout:   resourceMalwareProtectionPlanRead,
+		UpdateWithoutTimeout: resourceMalwareProtectionPlanUpdate,
+		DeleteWithoutTimeout: resourceMalwareProtectionPlanDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"actions": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tagging": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"status": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(guardduty.MalwareProtectionPlanTaggingActionStatus_Values(), false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"protected_resource": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"s3_bucket": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bucket_name": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"object_prefixes": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										MaxItems: 5,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"role": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceMalwareProtectionPlanCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GuardDutyConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &guardduty.CreateMalwareProtectionPlanInput{
+		ProtectedResource: expandCreateProtectedResource(d.Get("protected_resource").([]interface{})),
+		Role:              aws.String(d.Get("role").(string)),
+	}
+
+	if v, ok := d.GetOk("actions"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Actions = expandMalwareProtectionPlanActions(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	// The service role may not be usable by GuardDuty until IAM changes have propagated.
+	outputRaw, err := tfresource.RetryWhenAWSErrMessageContainsContext(ctx, propagationTimeout, func() (interface{}, error) {
+		return conn.CreateMalwareProtectionPlanWithContext(ctx, input)
+	}, guardduty.ErrCodeBadRequestException, "iam:PassRole")
+
+	if err != nil {
+		return diag.Errorf("creating GuardDuty Malware Protection Plan: %s", err)
+	}
+
+	d.SetId(aws.StringValue(outputRaw.(*guardduty.CreateMalwareProtectionPlanOutput).MalwareProtectionPlanId))
+
+	return resourceMalwareProtectionPlanRead(ctx, d, meta)
+}
+
+func resourceMalwareProtectionPlanRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GuardDutyConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindMalwareProtectionPlanByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] GuardDuty Malware Protection Plan (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading GuardDuty Malware Protection Plan (%s): %s", d.Id(), err)
+	}
+
+	if output.Actions != nil {
+		if err := d.Set("actions", []interface{}{flattenMalwareProtectionPlanActions(output.Actions)}); err != nil {
+			return diag.Errorf("setting actions: %s", err)
+		}
+	} else {
+		d.Set("actions", nil)
+	}
+	d.Set("arn", output.Arn)
+	if output.CreatedAt != nil {
+		d.Set("created_at", aws.TimeValue(output.CreatedAt).Format(time.RFC3339))
+	} else {
+		d.Set("created_at", nil)
+	}
+	if err := d.Set("protected_resource", flattenCreateProtectedResource(output.ProtectedResource)); err != nil {
+		return diag.Errorf("setting protected_resource: %s", err)
+	}
+	d.Set("role", output.Role)
+	d.Set("status", output.Status)
+
+	tags := KeyValueTags(output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceMalwareProtectionPlanUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GuardDutyConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &guardduty.UpdateMalwareProtectionPlanInput{
+			MalwareProtectionPlanId: aws.String(d.Id()),
+			Role:                    aws.String(d.Get("role").(string)),
+		}
+
+		if d.HasChange("actions") {
+			if v, ok := d.GetOk("actions"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.Actions = expandMalwareProtectionPlanActions(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("protected_resource") {
+			input.ProtectedResource = expandUpdateProtectedResource(d.Get("protected_resource").([]interface{}))
+		}
+
+		_, err := tfresource.RetryWhenAWSErrMessageContainsContext(ctx, propagationTimeout, func() (interface{}, error) {
+			return conn.UpdateMalwareProtectionPlanWithContext(ctx, input)
+		}, guardduty.ErrCodeBadRequestException, "iam:PassRole")
+
+		if err != nil {
+			return diag.Errorf("updating GuardDuty Malware Protection Plan (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating GuardDuty Malware Protection Plan (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceMalwareProtectionPlanRead(ctx, d, meta)
+}
+
+func resourceMalwareProtectionPlanDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GuardDutyConn
+
+	log.Printf("[DEBUG] Deleting GuardDuty Malware Protection Plan: %s", d.Id())
+	_, err := conn.DeleteMalwareProtectionPlanWithContext(ctx, &guardduty.DeleteMalwareProtectionPlanInput{
+		MalwareProtectionPlanId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, guardduty.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting GuardDuty Malware Protection Plan (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandMalwareProtectionPlanActions(tfMap map[string]interface{}) *guardduty.MalwareProtectionPlanActions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &guardduty.MalwareProtectionPlanActions{}
+
+	if v, ok := tfMap["tagging"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Tagging = &guardduty.MalwareProtectionPlanTaggingAction{}
+
+		if v, ok := tfMap["status"].(string); ok && v != "" {
+			apiObject.Tagging.Status = aws.String(v)
+		}
+	}
+
+	return apiObject
+}
+
+func expandCreateProtectedResource(tfList []interface{}) *guardduty.CreateProtectedResource {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &guardduty.CreateProtectedResource{}
+
+	if v, ok := tfMap["s3_bucket"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.S3Bucket = &guardduty.CreateS3BucketResource{}
+
+		if v, ok := tfMap["bucket_name"].(string); ok && v != "" {
+			apiObject.S3Bucket.BucketName = aws.String(v)
+		}
+
+		if v, ok := tfMap["object_prefixes"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.S3Bucket.ObjectPrefixes = flex.ExpandStringSet(v)
+		}
+	}
+
+	return apiObject
+}
+
+func expandUpdateProtectedResource(tfList []interface{}) *guardduty.UpdateProtectedResource {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &guardduty.UpdateProtectedResource{}
+
+	if v, ok := tfMap["s3_bucket"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		// Sending an empty list removes all object prefixes.
+		apiObject.S3Bucket = &guardduty.UpdateS3BucketResource{
+			ObjectPrefixes: aws.StringSlice([]string{}),
+		}
+
+		if v, ok := tfMap["object_prefixes"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.S3Bucket.ObjectPrefixes = flex.ExpandStringSet(v)
+		}
+	}
+
+	return apiObject
+}
+
+func flattenMalwareProtectionPlanActions(apiObject *guardduty.MalwareProtectionPlanActions) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Tagging; v != nil {
+		tfMap["tagging"] = []interface{}{map[string]interface{}{
+			"status": aws.StringValue(v.Status),
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenCreateProtectedResource(apiObject *guardduty.CreateProtectedResource) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.S3Bucket; v != nil {
+		tfMap["s3_bucket"] = []interface{}{map[string]interface{}{
+			"bucket_name":     aws.StringValue(v.BucketName),
+			"object_prefixes": aws.StringValueSlice(v.ObjectPrefixes),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,397 @@
+package guardduty_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/guardduty"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfguardduty "github.com/hashicorp/terraform-provider-aws/internal/service/guardduty"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccGuardDutyMalwareProtectionPlan_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_guardduty_malware_protection_plan.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, guardduty.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMalwareProtectionPlanDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMalwareProtectionPlanConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMalwareProtectionPlanExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "actions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "actions.0.tagging.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "actions.0.tagging.0.status", "DISABLED"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "guardduty", regexp.MustCompile(`malware-protection-plan/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+					resource.TestCheckResourceAttr(resourceName, "protected_resource.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "protected_resource.0.s3_bucket.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "protected_resource.0.s3_bucket.0.bucket_name", "aws_s3_bucket.test", "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "protected_resource.0.s3_bucket.0.object_prefixes.#", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "role", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGuardDutyMalwareProtectionPlan_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_guardduty_malware_protection_plan.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, guardduty.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMalwareProtectionPlanDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMalwareProtectionPlanConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMalwareProtectionPlanExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfguardduty.ResourceMalwareProtectionPlan(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccGuardDutyMalwareProtectionPlan_actionsAndObjectPrefixes(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_guardduty_malware_protection_plan.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, guardduty.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMalwareProtectionPlanDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMalwareProtectionPlanConfig_actionsAndObjectPrefixes(rName, "ENABLED", `"incoming/"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMalwareProtectionPlanExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "actions.0.tagging.0.status", "ENABLED"),
+					resource.TestCheckResourceAttr(resourceName, "protected_resource.0.s3_bucket.0.object_prefixes.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "protected_resource.0.s3_bucket.0.object_prefixes.*", "incoming/"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMalwareProtectionPlanConfig_actionsAndObjectPrefixes(rName, "DISABLED", `"incoming/", "uploads/"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMalwareProtectionPlanExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "actions.0.tagging.0.status", "DISABLED"),
+					resource.TestCheckResourceAttr(resourceName, "protected_resource.0.s3_bucket.0.object_prefixes.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "protected_resource.0.s3_bucket.0.object_prefixes.*", "incoming/"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "protected_resource.0.s3_bucket.0.object_prefixes.*", "uploads/"),
+				),
+			},
+			{
+				Config: testAccMalwareProtectionPlanConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMalwareProtectionPlanExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "protected_resource.0.s3_bucket.0.object_prefixes.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGuardDutyMalwareProtectionPlan_tags(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_guardduty_malware_protection_plan.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, guardduty.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMalwareProtectionPlanDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMalwareProtectionPlanConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMalwareProtectionPlanExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMalwareProtectionPlanConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMalwareProtectionPlanExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccMalwareProtectionPlanConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMalwareProtectionPlanExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckMalwareProtectionPlanExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No GuardDuty Malware Protection Plan ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GuardDutyConn
+
+		_, err := tfguardduty.FindMalwareProtectionPlanByID(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckMalwareProtectionPlanDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).GuardDutyConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_guardduty_malware_protection_plan" {
+			continue
+		}
+
+		_, err := tfguardduty.FindMalwareProtectionPlanByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("GuardDuty Malware Protection Plan %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccMalwareProtectionPlanConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "malware-protection-plan.guardduty.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      {
+        Sid    = "AllowManagedRuleToSendS3EventsToGuardDuty"
+        Effect = "Allow"
+        Action = [
+          "events:PutRule",
+          "events:DeleteRule",
+          "events:PutTargets",
+          "events:RemoveTargets",
+        ]
+        Resource = "arn:${data.aws_partition.current.partition}:events:*:${data.aws_caller_identity.current.account_id}:rule/DO-NOT-DELETE-AmazonGuardDutyMalwareProtectionS3*"
+        Condition = {
+          StringLike = {
+            "events:ManagedBy" = "malware-protection-plan.guardduty.${data.aws_partition.current.dns_suffix}"
+          }
+        }
+      },
+      {
+        Sid    = "AllowGuardDutyToMonitorEventBridgeManagedRule"
+        Effect = "Allow"
+        Action = [
+          "events:DescribeRule",
+          "events:ListTargetsByRule",
+        ]
+        Resource = "arn:${data.aws_partition.current.partition}:events:*:${data.aws_caller_identity.current.account_id}:rule/DO-NOT-DELETE-AmazonGuardDutyMalwareProtectionS3*"
+      },
+      {
+        Sid    = "AllowPostScanTag"
+        Effect = "Allow"
+        Action = [
+          "s3:PutObjectTagging",
+          "s3:GetObjectTagging",
+          "s3:PutObjectVersionTagging",
+          "s3:GetObjectVersionTagging",
+        ]
+        Resource = "${aws_s3_bucket.test.arn}/*"
+      },
+      {
+        Sid    = "AllowEnableS3EventBridgeEvents"
+        Effect = "Allow"
+        Action = [
+          "s3:PutBucketNotification",
+          "s3:GetBucketNotification",
+        ]
+        Resource = aws_s3_bucket.test.arn
+      },
+      {
+        Sid    = "AllowPutValidationObject"
+        Effect = "Allow"
+        Action = [
+          "s3:PutObject",
+        ]
+        Resource = "${aws_s3_bucket.test.arn}/malware-protection-resource-validation-object"
+      },
+      {
+        Sid    = "AllowCheckBucketOwnership"
+        Effect = "Allow"
+        Action = [
+          "s3:ListBucket",
+        ]
+        Resource = aws_s3_bucket.test.arn
+      },
+      {
+        Sid    = "AllowMalwareScan"
+        Effect = "Allow"
+        Action = [
+          "s3:GetObject",
+          "s3:GetObjectVersion",
+        ]
+        Resource = "${aws_s3_bucket.test.arn}/*"
+      },
+    ]
+  })
+}
+`, rName)
+}
+
+func testAccMalwareProtectionPlanConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccMalwareProtectionPlanConfig_base(rName), `
+resource "aws_guardduty_malware_protection_plan" "test" {
+  role = aws_iam_role.test.arn
+
+  protected_resource {
+    s3_bucket {
+      bucket_name = aws_s3_bucket.test.bucket
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`)
+}
+
+func testAccMalwareProtectionPlanConfig_actionsAndObjectPrefixes(rName, taggingStatus, objectPrefixes string) string {
+	return acctest.ConfigCompose(testAccMalwareProtectionPlanConfig_base(rName), fmt.Sprintf(`
+resource "aws_guardduty_malware_protection_plan" "test" {
+  role = aws_iam_role.test.arn
+
+  protected_resource {
+    s3_bucket {
+      bucket_name     = aws_s3_bucket.test.bucket
+      object_prefixes = [%[2]s]
+    }
+  }
+
+  actions {
+    tagging {
+      status = %[1]q
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, taggingStatus, objectPrefixes))
+}
+
+func testAccMalwareProtectionPlanConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccMalwareProtectionPlanConfig_base(rName), fmt.Sprintf(`
+resource "aws_guardduty_malware_protection_plan" "test" {
+  role = aws_iam_role.test.arn
+
+  protected_resource {
+    s3_bucket {
+      bucket_name = aws_s3_bucket.test.bucket
+    }
+  }
+
+  tags = {
+    %[1]q = %[2]q
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, tagKey1, tagValue1))
+}
+
+func testAccMalwareProtectionPlanConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccMalwareProtectionPlanConfig_base(rName), fmt.Sprintf(`
+resource "aws_guardduty_malware_protection_plan" "test" {
+  role = aws_iam_role.test.arn
+
+  protected_resource {
+    s3_bucket {
+      bucket_name = aws_s3_bucket.test.bucket
+    }
+  }
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -133,6 +133,10 @@ func resourceOrganizationConfigurationUpdate(d *schema.ResourceData, meta interf
 		input.DataSources = expandOrganizationDataSourceConfigurations(v.([]interface{})[0].(map[string]interface{}))
 	}
 
+	mutexKey := organizationConfigurationMutexKey(detectorID)
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
 	_, err := conn.UpdateOrganizationConfiguration(input)
 
 	if err != nil {
@@ -0,0 +1,329 @@
+package guardduty
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceOrganizationConfigurationFeature() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceOrganizationConfigurationFeaturePut,
+		ReadWithoutTimeout:   resourceOrganizationConfigurationFeatureRead,
+		UpdateWithoutTimeout: resourceOrganizationConfigurationFeaturePut,
+		DeleteWithoutTimeout: resourceOrganizationConfigurationFeatureDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"additional_configuration": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"auto_enable": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(guardduty.OrgFeatureStatus_Values(), false),
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(guardduty.OrgFeatureAdditionalConfiguration_Values(), false),
+						},
+					},
+				},
+			},
+			"auto_enable": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(guardduty.OrgFeatureStatus_Values(), false),
+			},
+			"detector_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(guardduty.OrgFeature_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceOrganizationConfigurationFeaturePut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GuardDutyConn
+
+	detectorID, name := d.Get("detector_id").(string), d.Get("name").(string)
+	feature := &guardduty.OrganizationFeatureConfiguration{
+		AutoEnable: aws.String(d.Get("auto_enable").(string)),
+		Name:       aws.String(name),
+	}
+
+	o, n := d.GetChange("additional_configuration")
+	os, ns := o.(*schema.Set), n.(*schema.Set)
+
+	feature.AdditionalConfiguration = expandOrganizationAdditionalConfigurations(ns.List())
+
+	// Additional configurations removed from the configuration are disabled.
+	configured := organizationAdditionalConfigurationNames(ns)
+
+	for _, v := range expandOrganizationAdditionalConfigurations(os.List()) {
+		if configured[aws.StringValue(v.Name)] {
+			continue
+		}
+
+		v.AutoEnable = aws.String(guardduty.OrgFeatureStatusNone)
+		feature.AdditionalConfiguration = append(feature.AdditionalConfiguration, v)
+	}
+
+	input := &guardduty.UpdateOrganizationConfigurationInput{
+		DetectorId: aws.String(detectorID),
+		Features:   []*guardduty.OrganizationFeatureConfiguration{feature},
+	}
+
+	// Each feature resource updates the same organization configuration.
+	// Serialize the calls so that concurrent feature updates don't conflict.
+	mutexKey := organizationConfigurationMutexKey(detectorID)
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+	_, err := conn.UpdateOrganizationConfigurationWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("updating GuardDuty Organization Configuration (%s) Feature (%s): %s", detectorID, name, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(OrganizationConfigurationFeatureCreateResourceID(detectorID, name))
+	}
+
+	return resourceOrganizationConfigurationFeatureRead(ctx, d, meta)
+}
+
+func resourceOrganizationConfigurationFeatureRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GuardDutyConn
+
+	detectorID, name, err := OrganizationConfigurationFeatureParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	feature, err := FindOrganizationConfigurationFeatureByTwoPartKey(ctx, conn, detectorID, name)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] GuardDuty Organization Configuration Feature (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading GuardDuty Organization Configuration Feature (%s): %s", d.Id(), err)
+	}
+
+	// GuardDuty reports every additional configuration supported by the feature.
+	// Disabled ones are only recorded when they are configured, so that the
+	// unconfigured defaults don't show up as drift.
+	configured := organizationAdditionalConfigurationNames(d.Get("additional_configuration").(*schema.Set))
+	var additionalConfigurations []*guardduty.OrganizationAdditionalConfigurationResult
+
+	for _, v := range feature.AdditionalConfiguration {
+		if v == nil {
+			continue
+		}
+
+		if !configured[aws.StringValue(v.Name)] && aws.StringValue(v.AutoEnable) == guardduty.OrgFeatureStatusNone {
+			continue
+		}
+
+		additionalConfigurations = append(additionalConfigurations, v)
+	}
+
+	if err := d.Set("additional_configuration", flattenOrganizationAdditionalConfigurationResults(additionalConfigurations)); err != nil {
+		return diag.Errorf("setting additional_configuration: %s", err)
+	}
+	d.Set("auto_enable", feature.AutoEnable)
+	d.Set("detector_id", detectorID)
+	d.Set("name", feature.Name)
+
+	return nil
+}
+
+func resourceOrganizationConfigurationFeatureDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GuardDutyConn
+
+	detectorID, name, err := OrganizationConfigurationFeatureParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	feature := &guardduty.OrganizationFeatureConfiguration{
+		AutoEnable: aws.String(guardduty.OrgFeatureStatusNone),
+		Name:       aws.String(name),
+	}
+
+	if v, ok := d.GetOk("additional_configuration"); ok && v.(*schema.Set).Len() > 0 {
+		for _, v := range expandOrganizationAdditionalConfigurations(v.(*schema.Set).List()) {
+			v.AutoEnable = aws.String(guardduty.OrgFeatureStatusNone)
+			feature.AdditionalConfiguration = append(feature.AdditionalConfiguration, v)
+		}
+	}
+
+	input := &guardduty.UpdateOrganizationConfigurationInput{
+		DetectorId: aws.String(detectorID),
+		Features:   []*guardduty.OrganizationFeatureConfiguration{feature},
+	}
+
+	mutexKey := organizationConfigurationMutexKey(detectorID)
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+	log.Printf("[DEBUG] Deleting GuardDuty Organization Configuration Feature: %s", d.Id())
+	_, err = conn.UpdateOrganizationConfigurationWithContext(ctx, input)
+
+	if tfawserr.ErrMessageContains(err, guardduty.ErrCodeBadRequestException, "The request is rejected because the input detectorId is not owned by the current account.") {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting GuardDuty Organization Configuration Feature (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+const organizationConfigurationFeatureIDSeparator = ":"
+
+func OrganizationConfigurationFeatureCreateResourceID(detectorID, name string) string {
+	parts := []string{detectorID, name}
+	id := strings.Join(parts, organizationConfigurationFeatureIDSeparator)
+
+	return id
+}
+
+func OrganizationConfigurationFeatureParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, organizationConfigurationFeatureIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected DETECTOR-ID%[2]sFEATURE-NAME", id, organizationConfigurationFeatureIDSeparator)
+}
+
+// organizationConfigurationMutexKey returns the key used to serialize updates
+// to a detector's organization configuration.
+func organizationConfigurationMutexKey(detectorID string) string {
+	return fmt.Sprintf("guardduty-organization-configuration-%s", detectorID)
+}
+
+// organizationAdditionalConfigurationNames returns the names of the additional
+// configurations in the specified set.
+func organizationAdditionalConfigurationNames(s *schema.Set) map[string]bool {
+	names := make(map[string]bool, s.Len())
+
+	for _, tfMapRaw := range s.List() {
+		if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+			names[tfMap["name"].(string)] = true
+		}
+	}
+
+	return names
+}
+
+func expandOrganizationAdditionalConfiguration(tfMap map[string]interface{}) *guardduty.OrganizationAdditionalConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &guardduty.OrganizationAdditionalConfiguration{}
+
+	if v, ok := tfMap["auto_enable"].(string); ok && v != "" {
+		apiObject.AutoEnable = aws.String(v)
+	}
+
+	if v, ok := tfMap["name"].(string); ok && v != "" {
+		apiObject.Name = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandOrganizationAdditionalConfigurations(tfList []interface{}) []*guardduty.OrganizationAdditionalConfiguration {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*guardduty.OrganizationAdditionalConfiguration
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandOrganizationAdditionalConfiguration(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenOrganizationAdditionalConfigurationResult(apiObject *guardduty.OrganizationAdditionalConfigurationResult) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AutoEnable; v != nil {
+		tfMap["auto_enable"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Name; v != nil {
+		tfMap["name"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenOrganizationAdditionalConfigurationResults(apiObjects []*guardduty.OrganizationAdditionalConfigurationResult) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenOrganizationAdditionalConfigurationResult(apiObject))
+	}
+
+	return tfList
+}
@@ -0,0 +1,268 @@
+package guardduty_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfguardduty "github.com/hashicorp/terraform-provider-aws/internal/service/guardduty"
+)
+
+func testAccOrganizationConfigurationFeature_basic(t *testing.T) {
+	resourceName := "aws_guardduty_organization_configuration_feature.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckOrganizationsAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, guardduty.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		// GuardDuty Organization Configuration cannot be deleted separately.
+		// Ensure parent resource is destroyed instead.
+		CheckDestroy: testAccCheckDetectorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationConfigurationFeatureConfig_basic("RDS_LOGIN_EVENTS", "ALL"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrganizationConfigurationFeatureExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "additional_configuration.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable", "ALL"),
+					resource.TestCheckResourceAttrSet(resourceName, "detector_id"),
+					resource.TestCheckResourceAttr(resourceName, "name", "RDS_LOGIN_EVENTS"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccOrganizationConfigurationFeatureConfig_basic("RDS_LOGIN_EVENTS", "NEW"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrganizationConfigurationFeatureExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable", "NEW"),
+					resource.TestCheckResourceAttr(resourceName, "name", "RDS_LOGIN_EVENTS"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOrganizationConfigurationFeature_additionalConfiguration(t *testing.T) {
+	resourceName := "aws_guardduty_organization_configuration_feature.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckOrganizationsAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, guardduty.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDetectorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationConfigurationFeatureConfig_additionalConfiguration("NEW", "ALL"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrganizationConfigurationFeatureExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "additional_configuration.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "additional_configuration.*", map[string]string{
+						"auto_enable": "ALL",
+						"name":        "EKS_ADDON_MANAGEMENT",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable", "NEW"),
+					resource.TestCheckResourceAttr(resourceName, "name", "EKS_RUNTIME_MONITORING"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccOrganizationConfigurationFeatureConfig_additionalConfiguration("ALL", "NONE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrganizationConfigurationFeatureExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "additional_configuration.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "additional_configuration.*", map[string]string{
+						"auto_enable": "NONE",
+						"name":        "EKS_ADDON_MANAGEMENT",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable", "ALL"),
+					resource.TestCheckResourceAttr(resourceName, "name", "EKS_RUNTIME_MONITORING"),
+				),
+			},
+			{
+				Config: testAccOrganizationConfigurationFeatureConfig_additionalConfiguration("ALL", "NEW"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrganizationConfigurationFeatureExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "additional_configuration.#", "1"),
+				),
+			},
+			{
+				Config: testAccOrganizationConfigurationFeatureConfig_basic("EKS_RUNTIME_MONITORING", "ALL"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrganizationConfigurationFeatureExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "additional_configuration.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable", "ALL"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOrganizationConfigurationFeature_multiple(t *testing.T) {
+	resource1Name := "aws_guardduty_organization_configuration_feature.test1"
+	resource2Name := "aws_guardduty_organization_configuration_feature.test2"
+	resource3Name := "aws_guardduty_organization_configuration_feature.test3"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckOrganizationsAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, guardduty.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDetectorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationConfigurationFeatureConfig_multiple("ALL", "NEW", "NONE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrganizationConfigurationFeatureExists(resource1Name),
+					resource.TestCheckResourceAttr(resource1Name, "auto_enable", "ALL"),
+					resource.TestCheckResourceAttr(resource1Name, "name", "S3_DATA_EVENTS"),
+					testAccCheckOrganizationConfigurationFeatureExists(resource2Name),
+					resource.TestCheckResourceAttr(resource2Name, "auto_enable", "NEW"),
+					resource.TestCheckResourceAttr(resource2Name, "name", "EKS_AUDIT_LOGS"),
+					testAccCheckOrganizationConfigurationFeatureExists(resource3Name),
+					resource.TestCheckResourceAttr(resource3Name, "auto_enable", "NONE"),
+					resource.TestCheckResourceAttr(resource3Name, "name", "EBS_MALWARE_PROTECTION"),
+				),
+			},
+			{
+				Config: testAccOrganizationConfigurationFeatureConfig_multiple("NEW", "NONE", "ALL"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrganizationConfigurationFeatureExists(resource1Name),
+					resource.TestCheckResourceAttr(resource1Name, "auto_enable", "NEW"),
+					testAccCheckOrganizationConfigurationFeatureExists(resource2Name),
+					resource.TestCheckResourceAttr(resource2Name, "auto_enable", "NONE"),
+					testAccCheckOrganizationConfigurationFeatureExists(resource3Name),
+					resource.TestCheckResourceAttr(resource3Name, "auto_enable", "ALL"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckOrganizationConfigurationFeatureExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No GuardDuty Organization Configuration Feature ID is set")
+		}
+
+		detectorID, name, err := tfguardduty.OrganizationConfigurationFeatureParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GuardDutyConn
+
+		_, err = tfguardduty.FindOrganizationConfigurationFeatureByTwoPartKey(context.Background(), conn, detectorID, name)
+
+		return err
+	}
+}
+
+const testAccOrganizationConfigurationFeatureConfig_base = `
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_organizations_organization" "test" {
+  aws_service_access_principals = ["guardduty.${data.aws_partition.current.dns_suffix}", "malware-protection.guardduty.${data.aws_partition.current.dns_suffix}"]
+  feature_set                   = "ALL"
+}
+
+resource "aws_guardduty_detector" "test" {}
+
+resource "aws_guardduty_organization_admin_account" "test" {
+  depends_on = [aws_organizations_organization.test]
+
+  admin_account_id = data.aws_caller_identity.current.account_id
+}
+
+resource "aws_guardduty_organization_configuration" "test" {
+  depends_on = [aws_guardduty_organization_admin_account.test]
+
+  auto_enable = true
+  detector_id = aws_guardduty_detector.test.id
+}
+`
+
+func testAccOrganizationConfigurationFeatureConfig_basic(name, autoEnable string) string {
+	return acctest.ConfigCompose(testAccOrganizationConfigurationFeatureConfig_base, fmt.Sprintf(`
+resource "aws_guardduty_organization_configuration_feature" "test" {
+  depends_on = [aws_guardduty_organization_configuration.test]
+
+  detector_id = aws_guardduty_detector.test.id
+  name        = %[1]q
+  auto_enable = %[2]q
+}
+`, name, autoEnable))
+}
+
+func testAccOrganizationConfigurationFeatureConfig_additionalConfiguration(featureAutoEnable, additionalConfigurationAutoEnable string) string {
+	return acctest.ConfigCompose(testAccOrganizationConfigurationFeatureConfig_base, fmt.Sprintf(`
+resource "aws_guardduty_organization_configuration_feature" "test" {
+  depends_on = [aws_guardduty_organization_configuration.test]
+
+  detector_id = aws_guardduty_detector.test.id
+  name        = "EKS_RUNTIME_MONITORING"
+  auto_enable = %[1]q
+
+  additional_configuration {
+    name        = "EKS_ADDON_MANAGEMENT"
+    auto_enable = %[2]q
+  }
+}
+`, featureAutoEnable, additionalConfigurationAutoEnable))
+}
+
+func testAccOrganizationConfigurationFeatureConfig_multiple(autoEnable1, autoEnable2, autoEnable3 string) string {
+	return acctest.ConfigCompose(testAccOrganizationConfigurationFeatureConfig_base, fmt.Sprintf(`
+resource "aws_guardduty_organization_configuration_feature" "test1" {
+  depends_on = [aws_guardduty_organization_configuration.test]
+
+  detector_id = aws_guardduty_detector.test.id
+  name        = "S3_DATA_EVENTS"
+  auto_enable = %[1]q
+}
+
+resource "aws_guardduty_organization_configuration_feature" "test2" {
+  depends_on = [aws_guardduty_organization_configuration.test]
+
+  detector_id = aws_guardduty_detector.test.id
+  name        = "EKS_AUDIT_LOGS"
+  auto_enable = %[2]q
+}
+
+resource "aws_guardduty_organization_configuration_feature" "test3" {
+  depends_on = [aws_guardduty_organization_configuration.test]
+
+  detector_id = aws_guardduty_detector.test.id
+  name        = "EBS_MALWARE_PROTECTION"
+  auto_enable = %[3]q
+}
+`, autoEnable1, autoEnable2, autoEnable3))
+}
@@ -0,0 +1,102 @@
+package iam
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceGroupPoliciesExclusive() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceGroupPoliciesExclusivePut,
+		ReadWithoutTimeout:   resourceGroupPoliciesExclusiveRead,
+		UpdateWithoutTimeout: resourceGroupPoliciesExclusivePut,
+		DeleteWithoutTimeout: resourceGroupPoliciesExclusiveDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy_names": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceGroupPoliciesExclusivePut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	groupName := d.Get("group_name").(string)
+	have, err := FindGroupPolicyNames(ctx, conn, groupName)
+
+	if err != nil {
+		return diag.Errorf("reading IAM Group (%s) inline policies: %s", groupName, err)
+	}
+
+	want := d.Get("policy_names").(*schema.Set)
+
+	for _, policyName := range flex.FlattenStringSet(aws.StringSlice(have)).Difference(want).List() {
+		log.Printf("[DEBUG] Deleting IAM Group (%s) inline policy: %s", groupName, policyName)
+		_, err := conn.DeleteGroupPolicyWithContext(ctx, &iam.DeleteGroupPolicyInput{
+			PolicyName: aws.String(policyName.(string)),
+			GroupName:  aws.String(groupName),
+		})
+
+		if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+			continue
+		}
+
+		if err != nil {
+			return diag.Errorf("deleting IAM Group (%s) inline policy (%s): %s", groupName, policyName, err)
+		}
+	}
+
+	d.SetId(groupName)
+
+	return resourceGroupPoliciesExclusiveRead(ctx, d, meta)
+}
+
+func resourceGroupPoliciesExclusiveRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	policyNames, err := FindGroupPolicyNames(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IAM Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading IAM Group (%s) inline policies: %s", d.Id(), err)
+	}
+
+	d.Set("policy_names", policyNames)
+	d.Set("group_name", d.Id())
+
+	return nil
+}
+
+func resourceGroupPoliciesExclusiveDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The inline policies themselves are left in place.
+	log.Printf("[DEBUG] Removing IAM Group (%s) inline policies exclusive management from state", d.Id())
+
+	return nil
+}
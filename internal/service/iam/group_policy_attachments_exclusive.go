@@ -0,0 +1,110 @@
+package iam
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceGroupPolicyAttachmentsExclusive() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceGroupPolicyAttachmentsExclusivePut,
+		ReadWithoutTimeout:   resourceGroupPolicyAttachmentsExclusiveRead,
+		UpdateWithoutTimeout: resourceGroupPolicyAttachmentsExclusivePut,
+		DeleteWithoutTimeout: resourceGroupPolicyAttachmentsExclusiveDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy_arns": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceGroupPolicyAttachmentsExclusivePut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	groupName := d.Get("group_name").(string)
+	have, err := FindGroupAttachedPolicyARNs(ctx, conn, groupName)
+
+	if err != nil {
+		return diag.Errorf("reading IAM Group (%s) policy attachments: %s", groupName, err)
+	}
+
+	o, n := flex.FlattenStringSet(aws.StringSlice(have)), d.Get("policy_arns").(*schema.Set)
+
+	for _, policyARN := range n.Difference(o).List() {
+		log.Printf("[DEBUG] Attaching IAM Policy (%s) to IAM Group (%s)", policyARN, groupName)
+		if err := attachPolicyToGroup(conn, groupName, policyARN.(string)); err != nil {
+			return diag.Errorf("attaching IAM Policy (%s) to IAM Group (%s): %s", policyARN, groupName, err)
+		}
+	}
+
+	for _, policyARN := range o.Difference(n).List() {
+		log.Printf("[DEBUG] Detaching IAM Policy (%s) from IAM Group (%s)", policyARN, groupName)
+		err := detachPolicyFromGroup(conn, groupName, policyARN.(string))
+
+		if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+			continue
+		}
+
+		if err != nil {
+			return diag.Errorf("detaching IAM Policy (%s) from IAM Group (%s): %s", policyARN, groupName, err)
+		}
+	}
+
+	d.SetId(groupName)
+
+	return resourceGroupPolicyAttachmentsExclusiveRead(ctx, d, meta)
+}
+
+func resourceGroupPolicyAttachmentsExclusiveRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	policyARNs, err := FindGroupAttachedPolicyARNs(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IAM Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading IAM Group (%s) policy attachments: %s", d.Id(), err)
+	}
+
+	d.Set("policy_arns", policyARNs)
+	d.Set("group_name", d.Id())
+
+	return nil
+}
+
+func resourceGroupPolicyAttachmentsExclusiveDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The policy attachments themselves are left in place.
+	log.Printf("[DEBUG] Removing IAM Group (%s) policy attachments exclusive management from state", d.Id())
+
+	return nil
+}
@@ -0,0 +1,166 @@
+package iam_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+)
+
+func TestAccIAMGroupPolicyAttachmentsExclusive_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_group_policy_attachments_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupPolicyAttachmentsExclusiveConfig_basic(rName, "[aws_iam_policy.test1.arn]"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupPolicyAttachmentsExclusiveCount(resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "group_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "policy_arns.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "policy_arns.*", "aws_iam_policy.test1", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccGroupPolicyAttachmentsExclusiveConfig_basic(rName, "[aws_iam_policy.test2.arn]"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupPolicyAttachmentsExclusiveCount(resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "policy_arns.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "policy_arns.*", "aws_iam_policy.test2", "arn"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMGroupPolicyAttachmentsExclusive_outOfBandAddition(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_group_policy_attachments_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupPolicyAttachmentsExclusiveConfig_basic(rName, "[aws_iam_policy.test1.arn]"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupPolicyAttachmentsExclusiveCount(resourceName, 1),
+					testAccCheckGroupPolicyAttachmentsExclusiveAttachOutOfBand(resourceName, "aws_iam_policy.test2"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccGroupPolicyAttachmentsExclusiveConfig_basic(rName, "[aws_iam_policy.test1.arn]"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupPolicyAttachmentsExclusiveCount(resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "policy_arns.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGroupPolicyAttachmentsExclusiveCount(n string, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn
+
+		policyARNs, err := tfiam.FindGroupAttachedPolicyARNs(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if got := len(policyARNs); got != want {
+			return fmt.Errorf("IAM Group (%s) has %d attached policies, want %d", rs.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckGroupPolicyAttachmentsExclusiveAttachOutOfBand(n, policyResourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		policy, ok := s.RootModule().Resources[policyResourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", policyResourceName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn
+
+		_, err := conn.AttachGroupPolicy(&iam.AttachGroupPolicyInput{
+			PolicyArn: aws.String(policy.Primary.Attributes["arn"]),
+			GroupName: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccGroupPolicyAttachmentsExclusiveConfig_basic(rName, policyARNs string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_iam_policy" "test1" {
+  name = "%[1]s-1"
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = "s3:ListBucket"
+      Effect   = "Allow"
+      Resource = "*"
+    }]
+  })
+}
+
+resource "aws_iam_policy" "test2" {
+  name = "%[1]s-2"
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = "s3:ListBucket"
+      Effect   = "Allow"
+      Resource = "*"
+    }]
+  })
+}
+
+resource "aws_iam_group_policy_attachments_exclusive" "test" {
+  group_name  = aws_iam_group.test.name
+  policy_arns = %[2]s
+}
+`, rName, policyARNs)
+}
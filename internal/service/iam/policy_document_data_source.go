@@ -4,12 +4,17 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
+	"unicode"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/accessanalyzer"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 )
 
@@ -28,6 +33,10 @@ func DataSourcePolicyDocument() *schema.Resource {
 		Read: dataSourcePolicyDocumentRead,
 
 		Schema: map[string]*schema.Schema{
+			"detect_sid_conflicts": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			"json": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -46,6 +55,15 @@ func DataSourcePolicyDocument() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"size_limit_target": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(policySizeLimitTargets(), false),
+			},
 			"source_json": {
 				Type:       schema.TypeString,
 				Optional:   true,
@@ -103,6 +121,10 @@ func DataSourcePolicyDocument() *schema.Resource {
 					},
 				},
 			},
+			"validate_actions": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			"version": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -243,18 +265,32 @@ func dataSourcePolicyDocumentRead(d *schema.ResourceData, meta interface{}) erro
 
 	}
 
+	detectSidConflicts := d.Get("detect_sid_conflicts").(bool)
+
 	// merge our current document into mergedDoc
-	mergedDoc.Merge(doc)
+	if detectSidConflicts {
+		if err := mergedDoc.MergeWithoutConflicts(doc); err != nil {
+			return fmt.Errorf("merging statement: %w", err)
+		}
+	} else {
+		mergedDoc.Merge(doc)
+	}
 
 	// merge override_policy_documents policies into mergedDoc in order specified
 	if v, ok := d.GetOk("override_policy_documents"); ok && len(v.([]interface{})) > 0 {
-		for _, overrideJSON := range v.([]interface{}) {
+		for overrideJSONIndex, overrideJSON := range v.([]interface{}) {
 			overrideDoc := &IAMPolicyDoc{}
 			if err := json.Unmarshal([]byte(overrideJSON.(string)), overrideDoc); err != nil {
 				return err
 			}
 
-			mergedDoc.Merge(overrideDoc)
+			if detectSidConflicts {
+				if err := mergedDoc.MergeWithoutConflicts(overrideDoc); err != nil {
+					return fmt.Errorf("merging override_policy_documents (item %d): %w", overrideJSONIndex, err)
+				}
+			} else {
+				mergedDoc.Merge(overrideDoc)
+			}
 		}
 
 	}
@@ -276,12 +312,139 @@ func dataSourcePolicyDocumentRead(d *schema.ResourceData, meta interface{}) erro
 	}
 	jsonString := string(jsonDoc)
 
+	size := policySize(jsonString)
+
+	if v, ok := d.GetOk("size_limit_target"); ok {
+		if limit := policySizeLimits[v.(string)]; size > limit {
+			return fmt.Errorf("policy document size (%d) exceeds the %s quota (%d)", size, v.(string), limit)
+		}
+	}
+
+	if d.Get("validate_actions").(bool) {
+		if err := validatePolicyDocumentActions(d.Get("statement").([]interface{})); err != nil {
+			return err
+		}
+
+		if err := validatePolicyDocument(meta.(*conns.AWSClient).AccessAnalyzerConn, jsonString, d.Get("size_limit_target").(string)); err != nil {
+			return err
+		}
+	}
+
 	d.Set("json", jsonString)
+	d.Set("size", size)
 	d.SetId(strconv.Itoa(create.StringHashcode(jsonString)))
 
 	return nil
 }
 
+// policySizeLimits are the IAM quotas, in characters, for each type of policy.
+// Inline policy quotas apply to the total size of all inline policies of a role, user or group.
+var policySizeLimits = map[string]int{
+	"group_inline_policy": 5120,
+	"managed_policy":      6144,
+	"role_inline_policy":  10240,
+	"trust_policy":        2048,
+	"user_inline_policy":  2048,
+}
+
+func policySizeLimitTargets() []string {
+	targets := make([]string, 0, len(policySizeLimits))
+
+	for k := range policySizeLimits {
+		targets = append(targets, k)
+	}
+
+	sort.Strings(targets)
+
+	return targets
+}
+
+// policySize returns the size of a policy document as counted by IAM, which ignores white space.
+func policySize(document string) int {
+	size := 0
+
+	for _, r := range document {
+		if !unicode.IsSpace(r) {
+			size++
+		}
+	}
+
+	return size
+}
+
+// validatePolicyDocument checks a policy document with IAM Access Analyzer, which validates
+// action names against the service authorization reference, and returns any errors found.
+// validatePolicyDocumentActions checks the syntax of the actions and not_actions of every configured statement.
+func validatePolicyDocumentActions(tfList []interface{}) error {
+	var errs *multierror.Error
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		for _, k := range []string{"actions", "not_actions"} {
+			v, ok := tfMap[k].(*schema.Set)
+
+			if !ok {
+				continue
+			}
+
+			for _, action := range v.List() {
+				_, es := validPolicyAction(action, fmt.Sprintf("statement.%d.%s", i, k))
+
+				for _, err := range es {
+					errs = multierror.Append(errs, err)
+				}
+			}
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func validatePolicyDocument(conn *accessanalyzer.AccessAnalyzer, document, sizeLimitTarget string) error {
+	input := &accessanalyzer.ValidatePolicyInput{
+		PolicyDocument: aws.String(document),
+		PolicyType:     aws.String(accessanalyzer.PolicyTypeIdentityPolicy),
+	}
+
+	if sizeLimitTarget == "trust_policy" {
+		input.PolicyType = aws.String(accessanalyzer.PolicyTypeResourcePolicy)
+		input.ValidatePolicyResourceType = aws.String(accessanalyzer.ValidatePolicyResourceTypeAwsIamAssumeRolePolicyDocument)
+	}
+
+	var errs []string
+
+	err := conn.ValidatePolicyPages(input, func(page *accessanalyzer.ValidatePolicyOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Findings {
+			if v == nil || aws.StringValue(v.FindingType) != accessanalyzer.ValidatePolicyFindingTypeError {
+				continue
+			}
+
+			errs = append(errs, fmt.Sprintf("%s: %s", aws.StringValue(v.IssueCode), aws.StringValue(v.FindingDetails)))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("validating policy document: %w", err)
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid policy document:\n%s", strings.Join(errs, "\n"))
+	}
+
+	return nil
+}
+
 func dataSourcePolicyDocumentReplaceVarsInList(in interface{}, version string) (interface{}, error) {
 	switch v := in.(type) {
 	case string:
@@ -315,6 +315,92 @@ func TestAccIAMPolicyDocumentDataSource_version20081017(t *testing.T) {
 	})
 }
 
+func TestAccIAMPolicyDocumentDataSource_invalidAction(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPolicyDocumentDataSourceConfig_validateActions("s3GetObject"),
+				ExpectError: regexp.MustCompile(`must be "\*" or of the form service-prefix:action`),
+			},
+			{
+				Config: testAccPolicyDocumentDataSourceConfig_action("s3GetObject"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.aws_iam_policy_document.test", "json"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMPolicyDocumentDataSource_validateActions(t *testing.T) {
+	dataSourceName := "data.aws_iam_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPolicyDocumentDataSourceConfig_validateActions("s3:GetObjectTypo"),
+				ExpectError: regexp.MustCompile(`invalid policy document`),
+			},
+			{
+				Config: testAccPolicyDocumentDataSourceConfig_validateActions("s3:GetObject"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "json"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMPolicyDocumentDataSource_sizeLimitTarget(t *testing.T) {
+	dataSourceName := "data.aws_iam_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPolicyDocumentDataSourceConfig_sizeLimitTarget("user_inline_policy"),
+				ExpectError: regexp.MustCompile(`policy document size \(\d+\) exceeds the user_inline_policy quota \(2048\)`),
+			},
+			{
+				Config: testAccPolicyDocumentDataSourceConfig_sizeLimitTarget("managed_policy"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "size"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMPolicyDocumentDataSource_detectSidConflicts(t *testing.T) {
+	dataSourceName := "data.aws_iam_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPolicyDocumentDataSourceConfig_detectSidConflicts("s3:PutObject"),
+				ExpectError: regexp.MustCompile(`conflicting statements with Sid \(SidToOverride\)`),
+			},
+			{
+				Config: testAccPolicyDocumentDataSourceConfig_detectSidConflicts("s3:GetObject"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "json"),
+				),
+			},
+		},
+	})
+}
+
 var testAccPolicyDocumentDataSourceConfig_basic = `
 data "aws_partition" "current" {}
 
@@ -1320,3 +1406,63 @@ func testAccPolicyDocumentExpectedJSONStatementPrincipalIdentifiersMultiplePrinc
   ]
 }`, acctest.Partition())
 }
+
+func testAccPolicyDocumentDataSourceConfig_action(action string) string {
+	return fmt.Sprintf(`
+data "aws_iam_policy_document" "test" {
+  statement {
+    actions   = [%[1]q]
+    resources = ["*"]
+  }
+}
+`, action)
+}
+
+func testAccPolicyDocumentDataSourceConfig_validateActions(action string) string {
+	return fmt.Sprintf(`
+data "aws_iam_policy_document" "test" {
+  validate_actions = true
+
+  statement {
+    actions   = [%[1]q]
+    resources = ["*"]
+  }
+}
+`, action)
+}
+
+func testAccPolicyDocumentDataSourceConfig_sizeLimitTarget(sizeLimitTarget string) string {
+	return fmt.Sprintf(`
+data "aws_iam_policy_document" "test" {
+  size_limit_target = %[1]q
+
+  statement {
+    actions   = ["s3:GetObject"]
+    resources = [for i in range(100) : "arn:aws:s3:::example-bucket-${i}/*"]
+  }
+}
+`, sizeLimitTarget)
+}
+
+func testAccPolicyDocumentDataSourceConfig_detectSidConflicts(action string) string {
+	return fmt.Sprintf(`
+data "aws_iam_policy_document" "source" {
+  statement {
+    sid       = "SidToOverride"
+    actions   = ["s3:GetObject"]
+    resources = ["*"]
+  }
+}
+
+data "aws_iam_policy_document" "test" {
+  detect_sid_conflicts    = true
+  source_policy_documents = [data.aws_iam_policy_document.source.json]
+
+  statement {
+    sid       = "SidToOverride"
+    actions   = [%[1]q]
+    resources = ["*"]
+  }
+}
+`, action)
+}
@@ -74,6 +74,46 @@ func (s *IAMPolicyDoc) Merge(newDoc *IAMPolicyDoc) {
 	}
 }
 
+// MergeWithoutConflicts merges newDoc into s as Merge does but returns an error,
+// without modifying s, if a statement in newDoc would overwrite a different
+// statement with the same Sid.
+func (s *IAMPolicyDoc) MergeWithoutConflicts(newDoc *IAMPolicyDoc) error {
+	for _, newStatement := range newDoc.Statements {
+		if len(newStatement.Sid) == 0 {
+			continue
+		}
+		for _, existingStatement := range s.Statements {
+			if existingStatement.Sid != newStatement.Sid {
+				continue
+			}
+			equal, err := existingStatement.Equal(newStatement)
+			if err != nil {
+				return err
+			}
+			if !equal {
+				return fmt.Errorf("conflicting statements with Sid (%s)", newStatement.Sid)
+			}
+		}
+	}
+
+	s.Merge(newDoc)
+
+	return nil
+}
+
+// Equal reports whether two statements render to the same JSON.
+func (s *IAMPolicyStatement) Equal(other *IAMPolicyStatement) (bool, error) {
+	a, err := json.Marshal(s)
+	if err != nil {
+		return false, err
+	}
+	b, err := json.Marshal(other)
+	if err != nil {
+		return false, err
+	}
+	return string(a) == string(b), nil
+}
+
 func (ps IAMPolicyStatementPrincipalSet) MarshalJSON() ([]byte, error) {
 	raw := map[string]interface{}{}
 
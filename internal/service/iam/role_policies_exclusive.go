@@ -0,0 +1,102 @@
+package iam
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceRolePoliciesExclusive() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRolePoliciesExclusivePut,
+		ReadWithoutTimeout:   resourceRolePoliciesExclusiveRead,
+		UpdateWithoutTimeout: resourceRolePoliciesExclusivePut,
+		DeleteWithoutTimeout: resourceRolePoliciesExclusiveDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy_names": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"role_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceRolePoliciesExclusivePut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	roleName := d.Get("role_name").(string)
+	have, err := FindRolePolicyNames(ctx, conn, roleName)
+
+	if err != nil {
+		return diag.Errorf("reading IAM Role (%s) inline policies: %s", roleName, err)
+	}
+
+	want := d.Get("policy_names").(*schema.Set)
+
+	for _, policyName := range flex.FlattenStringSet(aws.StringSlice(have)).Difference(want).List() {
+		log.Printf("[DEBUG] Deleting IAM Role (%s) inline policy: %s", roleName, policyName)
+		_, err := conn.DeleteRolePolicyWithContext(ctx, &iam.DeleteRolePolicyInput{
+			PolicyName: aws.String(policyName.(string)),
+			RoleName:   aws.String(roleName),
+		})
+
+		if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+			continue
+		}
+
+		if err != nil {
+			return diag.Errorf("deleting IAM Role (%s) inline policy (%s): %s", roleName, policyName, err)
+		}
+	}
+
+	d.SetId(roleName)
+
+	return resourceRolePoliciesExclusiveRead(ctx, d, meta)
+}
+
+func resourceRolePoliciesExclusiveRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	policyNames, err := FindRolePolicyNames(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IAM Role (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading IAM Role (%s) inline policies: %s", d.Id(), err)
+	}
+
+	d.Set("policy_names", policyNames)
+	d.Set("role_name", d.Id())
+
+	return nil
+}
+
+func resourceRolePoliciesExclusiveDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The inline policies themselves are left in place.
+	log.Printf("[DEBUG] Removing IAM Role (%s) inline policies exclusive management from state", d.Id())
+
+	return nil
+}
@@ -0,0 +1,110 @@
+package iam
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceRolePolicyAttachmentsExclusive() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRolePolicyAttachmentsExclusivePut,
+		ReadWithoutTimeout:   resourceRolePolicyAttachmentsExclusiveRead,
+		UpdateWithoutTimeout: resourceRolePolicyAttachmentsExclusivePut,
+		DeleteWithoutTimeout: resourceRolePolicyAttachmentsExclusiveDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy_arns": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"role_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceRolePolicyAttachmentsExclusivePut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	roleName := d.Get("role_name").(string)
+	have, err := FindRoleAttachedPolicyARNs(ctx, conn, roleName)
+
+	if err != nil {
+		return diag.Errorf("reading IAM Role (%s) policy attachments: %s", roleName, err)
+	}
+
+	o, n := flex.FlattenStringSet(aws.StringSlice(have)), d.Get("policy_arns").(*schema.Set)
+
+	for _, policyARN := range n.Difference(o).List() {
+		log.Printf("[DEBUG] Attaching IAM Policy (%s) to IAM Role (%s)", policyARN, roleName)
+		if err := attachPolicyToRole(conn, roleName, policyARN.(string)); err != nil {
+			return diag.Errorf("attaching IAM Policy (%s) to IAM Role (%s): %s", policyARN, roleName, err)
+		}
+	}
+
+	for _, policyARN := range o.Difference(n).List() {
+		log.Printf("[DEBUG] Detaching IAM Policy (%s) from IAM Role (%s)", policyARN, roleName)
+		err := DetachPolicyFromRole(conn, roleName, policyARN.(string))
+
+		if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+			continue
+		}
+
+		if err != nil {
+			return diag.Errorf("detaching IAM Policy (%s) from IAM Role (%s): %s", policyARN, roleName, err)
+		}
+	}
+
+	d.SetId(roleName)
+
+	return resourceRolePolicyAttachmentsExclusiveRead(ctx, d, meta)
+}
+
+func resourceRolePolicyAttachmentsExclusiveRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	policyARNs, err := FindRoleAttachedPolicyARNs(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IAM Role (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading IAM Role (%s) policy attachments: %s", d.Id(), err)
+	}
+
+	d.Set("policy_arns", policyARNs)
+	d.Set("role_name", d.Id())
+
+	return nil
+}
+
+func resourceRolePolicyAttachmentsExclusiveDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The policy attachments themselves are left in place.
+	log.Printf("[DEBUG] Removing IAM Role (%s) policy attachments exclusive management from state", d.Id())
+
+	return nil
+}
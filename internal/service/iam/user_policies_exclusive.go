@@ -0,0 +1,102 @@
+package iam
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceUserPoliciesExclusive() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceUserPoliciesExclusivePut,
+		ReadWithoutTimeout:   resourceUserPoliciesExclusiveRead,
+		UpdateWithoutTimeout: resourceUserPoliciesExclusivePut,
+		DeleteWithoutTimeout: resourceUserPoliciesExclusiveDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy_names": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"user_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceUserPoliciesExclusivePut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	userName := d.Get("user_name").(string)
+	have, err := FindUserPolicyNames(ctx, conn, userName)
+
+	if err != nil {
+		return diag.Errorf("reading IAM User (%s) inline policies: %s", userName, err)
+	}
+
+	want := d.Get("policy_names").(*schema.Set)
+
+	for _, policyName := range flex.FlattenStringSet(aws.StringSlice(have)).Difference(want).List() {
+		log.Printf("[DEBUG] Deleting IAM User (%s) inline policy: %s", userName, policyName)
+		_, err := conn.DeleteUserPolicyWithContext(ctx, &iam.DeleteUserPolicyInput{
+			PolicyName: aws.String(policyName.(string)),
+			UserName:   aws.String(userName),
+		})
+
+		if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+			continue
+		}
+
+		if err != nil {
+			return diag.Errorf("deleting IAM User (%s) inline policy (%s): %s", userName, policyName, err)
+		}
+	}
+
+	d.SetId(userName)
+
+	return resourceUserPoliciesExclusiveRead(ctx, d, meta)
+}
+
+func resourceUserPoliciesExclusiveRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	policyNames, err := FindUserPolicyNames(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IAM User (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading IAM User (%s) inline policies: %s", d.Id(), err)
+	}
+
+	d.Set("policy_names", policyNames)
+	d.Set("user_name", d.Id())
+
+	return nil
+}
+
+func resourceUserPoliciesExclusiveDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The inline policies themselves are left in place.
+	log.Printf("[DEBUG] Removing IAM User (%s) inline policies exclusive management from state", d.Id())
+
+	return nil
+}
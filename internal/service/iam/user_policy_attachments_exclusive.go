@@ -0,0 +1,110 @@
+package iam
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceUserPolicyAttachmentsExclusive() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceUserPolicyAttachmentsExclusivePut,
+		ReadWithoutTimeout:   resourceUserPolicyAttachmentsExclusiveRead,
+		UpdateWithoutTimeout: resourceUserPolicyAttachmentsExclusivePut,
+		DeleteWithoutTimeout: resourceUserPolicyAttachmentsExclusiveDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy_arns": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"user_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceUserPolicyAttachmentsExclusivePut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	userName := d.Get("user_name").(string)
+	have, err := FindUserAttachedPolicyARNs(ctx, conn, userName)
+
+	if err != nil {
+		return diag.Errorf("reading IAM User (%s) policy attachments: %s", userName, err)
+	}
+
+	o, n := flex.FlattenStringSet(aws.StringSlice(have)), d.Get("policy_arns").(*schema.Set)
+
+	for _, policyARN := range n.Difference(o).List() {
+		log.Printf("[DEBUG] Attaching IAM Policy (%s) to IAM User (%s)", policyARN, userName)
+		if err := attachPolicyToUser(conn, userName, policyARN.(string)); err != nil {
+			return diag.Errorf("attaching IAM Policy (%s) to IAM User (%s): %s", policyARN, userName, err)
+		}
+	}
+
+	for _, policyARN := range o.Difference(n).List() {
+		log.Printf("[DEBUG] Detaching IAM Policy (%s) from IAM User (%s)", policyARN, userName)
+		err := DetachPolicyFromUser(conn, userName, policyARN.(string))
+
+		if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+			continue
+		}
+
+		if err != nil {
+			return diag.Errorf("detaching IAM Policy (%s) from IAM User (%s): %s", policyARN, userName, err)
+		}
+	}
+
+	d.SetId(userName)
+
+	return resourceUserPolicyAttachmentsExclusiveRead(ctx, d, meta)
+}
+
+func resourceUserPolicyAttachmentsExclusiveRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	policyARNs, err := FindUserAttachedPolicyARNs(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IAM User (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading IAM User (%s) policy attachments: %s", d.Id(), err)
+	}
+
+	d.Set("policy_arns", policyARNs)
+	d.Set("user_name", d.Id())
+
+	return nil
+}
+
+func resourceUserPolicyAttachmentsExclusiveDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The policy attachments themselves are left in place.
+	log.Printf("[DEBUG] Removing IAM User (%s) policy attachments exclusive management from state", d.Id())
+
+	return nil
+}
@@ -49,3 +49,7 @@ var validOpenIDURL = validation.All(
 		return
 	},
 )
+
+// validPolicyAction checks the syntax of an action in an IAM policy statement,
+// e.g. "*", "s3:GetObject" or "ec2:Describe*".
+var validPolicyAction = validation.StringMatch(regexp.MustCompile(`^(\*|[a-zA-Z0-9*?-]+:[a-zA-Z0-9*?]+)$`), "must be \"*\" or of the form service-prefix:action, optionally containing * and ? wildcards")
@@ -92,3 +92,36 @@ func TestValidOpenIDURL(t *testing.T) {
 		}
 	}
 }
+
+func TestValidPolicyAction(t *testing.T) {
+	validActions := []string{
+		"*",
+		"s3:GetObject",
+		"ec2:Describe*",
+		"execute-api:Invoke",
+		"iam:Get?ccountSummary",
+	}
+
+	for _, s := range validActions {
+		_, errors := validPolicyAction(s, "actions")
+		if len(errors) > 0 {
+			t.Fatalf("%q should be a valid IAM policy action: %v", s, errors)
+		}
+	}
+
+	invalidActions := []string{
+		"",
+		"s3",
+		"s3:",
+		":GetObject",
+		"s3:Get Object",
+		"s3:GetObject:Extra",
+	}
+
+	for _, s := range invalidActions {
+		_, errors := validPolicyAction(s, "actions")
+		if len(errors) == 0 {
+			t.Fatalf("%q should not be a valid IAM policy action", s)
+		}
+	}
+}
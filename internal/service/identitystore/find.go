@@ -0,0 +1,48 @@
+package identitystore
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/identitystore"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// FindGroupMembershipsByGroupID returns the group's user memberships, keyed by user ID.
+func FindGroupMembershipsByGroupID(ctx context.Context, conn *identitystore.IdentityStore, identityStoreID, groupID string) (map[string]string, error) {
+	input := &identitystore.ListGroupMembershipsInput{
+		GroupId:         aws.String(groupID),
+		IdentityStoreId: aws.String(identityStoreID),
+	}
+	memberships := make(map[string]string)
+
+	err := conn.ListGroupMembershipsPagesWithContext(ctx, input, func(page *identitystore.ListGroupMembershipsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.GroupMemberships {
+			if v == nil || v.MemberId == nil || v.MemberId.UserId == nil {
+				continue
+			}
+
+			memberships[aws.StringValue(v.MemberId.UserId)] = aws.StringValue(v.MembershipId)
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, identitystore.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return memberships, nil
+}
@@ -21,6 +21,25 @@ func DataSourceGroup() *schema.Resource {
 				Computed: true,
 			},
 
+			"external_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:      schema.TypeString,
+							Computed:  true,
+							Sensitive: true,
+						},
+						"issuer": {
+							Type:      schema.TypeString,
+							Computed:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+
 			"filter": {
 				Type:     schema.TypeSet,
 				Required: true,
@@ -106,6 +125,11 @@ func dataSourceGroupRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(aws.StringValue(group.GroupId))
 	d.Set("display_name", group.DisplayName)
+
+	if err := d.Set("external_ids", flattenExternalIDs(group.ExternalIds)); err != nil {
+		return fmt.Errorf("error setting external_ids: %w", err)
+	}
+
 	d.Set("group_id", group.GroupId)
 
 	return nil
@@ -138,3 +162,20 @@ func expandFilters(l []interface{}) []*identitystore.Filter {
 
 	return filters
 }
+
+func flattenExternalIDs(apiObjects []*identitystore.ExternalId) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"id":     aws.StringValue(apiObject.Id),
+			"issuer": aws.StringValue(apiObject.Issuer),
+		})
+	}
+
+	return tfList
+}
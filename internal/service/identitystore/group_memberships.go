@@ -0,0 +1,250 @@
+package identitystore
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/identitystore"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceGroupMemberships() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceGroupMembershipsCreate,
+		ReadWithoutTimeout:   resourceGroupMembershipsRead,
+		UpdateWithoutTimeout: resourceGroupMembershipsUpdate,
+		DeleteWithoutTimeout: resourceGroupMembershipsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 47),
+					validation.StringMatch(regexp.MustCompile(`^([0-9a-f]{10}-|)[A-Fa-f0-9]{8}-[A-Fa-f0-9]{4}-[A-Fa-f0-9]{4}-[A-Fa-f0-9]{4}-[A-Fa-f0-9]{12}$`), "must match ([0-9a-f]{10}-|)[A-Fa-f0-9]{8}-[A-Fa-f0-9]{4}-[A-Fa-f0-9]{4}-[A-Fa-f0-9]{4}-[A-Fa-f0-9]{12}"),
+				),
+			},
+			"identity_store_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9-]*$`), "must match [a-zA-Z0-9-]"),
+				),
+			},
+			"member_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.All(
+						validation.StringLenBetween(1, 47),
+						validation.StringMatch(regexp.MustCompile(`^([0-9a-f]{10}-|)[A-Fa-f0-9]{8}-[A-Fa-f0-9]{4}-[A-Fa-f0-9]{4}-[A-Fa-f0-9]{4}-[A-Fa-f0-9]{12}$`), "must match ([0-9a-f]{10}-|)[A-Fa-f0-9]{8}-[A-Fa-f0-9]{4}-[A-Fa-f0-9]{4}-[A-Fa-f0-9]{4}-[A-Fa-f0-9]{12}"),
+					),
+				},
+			},
+		},
+	}
+}
+
+func resourceGroupMembershipsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IdentityStoreConn
+
+	identityStoreID := d.Get("identity_store_id").(string)
+	groupID := d.Get("group_id").(string)
+	id := GroupMembershipsCreateResourceID(identityStoreID, groupID)
+
+	if err := reconcileGroupMemberships(ctx, conn, identityStoreID, groupID, flex.ExpandStringValueSet(d.Get("member_ids").(*schema.Set))); err != nil {
+		return diag.Errorf("creating Identity Store Group Memberships (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceGroupMembershipsRead(ctx, d, meta)
+}
+
+func resourceGroupMembershipsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IdentityStoreConn
+
+	identityStoreID, groupID, err := GroupMembershipsParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	memberships, err := FindGroupMembershipsByGroupID(ctx, conn, identityStoreID, groupID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Identity Store Group Memberships (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Identity Store Group Memberships (%s): %s", d.Id(), err)
+	}
+
+	memberIDs := make([]string, 0, len(memberships))
+	for memberID := range memberships {
+		memberIDs = append(memberIDs, memberID)
+	}
+
+	d.Set("group_id", groupID)
+	d.Set("identity_store_id", identityStoreID)
+	d.Set("member_ids", memberIDs)
+
+	return nil
+}
+
+func resourceGroupMembershipsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IdentityStoreConn
+
+	if d.HasChange("member_ids") {
+		identityStoreID, groupID, err := GroupMembershipsParseResourceID(d.Id())
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err := reconcileGroupMemberships(ctx, conn, identityStoreID, groupID, flex.ExpandStringValueSet(d.Get("member_ids").(*schema.Set))); err != nil {
+			return diag.Errorf("updating Identity Store Group Memberships (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceGroupMembershipsRead(ctx, d, meta)
+}
+
+func resourceGroupMembershipsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IdentityStoreConn
+
+	identityStoreID, groupID, err := GroupMembershipsParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	memberships, err := FindGroupMembershipsByGroupID(ctx, conn, identityStoreID, groupID)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Identity Store Group Memberships (%s): %s", d.Id(), err)
+	}
+
+	// Only remove the members managed by this resource.
+	for _, memberID := range flex.ExpandStringValueSet(d.Get("member_ids").(*schema.Set)) {
+		membershipID, ok := memberships[memberID]
+
+		if !ok {
+			continue
+		}
+
+		if err := deleteGroupMembership(ctx, conn, identityStoreID, membershipID); err != nil {
+			return diag.Errorf("deleting Identity Store Group Memberships (%s): %s", d.Id(), err)
+		}
+	}
+
+	return nil
+}
+
+// reconcileGroupMemberships makes memberIDs the complete list of members of the group.
+// The current memberships are listed once and only the differences are applied.
+func reconcileGroupMemberships(ctx context.Context, conn *identitystore.IdentityStore, identityStoreID, groupID string, memberIDs []string) error {
+	memberships, err := FindGroupMembershipsByGroupID(ctx, conn, identityStoreID, groupID)
+
+	if err != nil {
+		return err
+	}
+
+	want := make(map[string]bool, len(memberIDs))
+
+	for _, memberID := range memberIDs {
+		want[memberID] = true
+
+		if _, ok := memberships[memberID]; ok {
+			continue
+		}
+
+		input := &identitystore.CreateGroupMembershipInput{
+			GroupId:         aws.String(groupID),
+			IdentityStoreId: aws.String(identityStoreID),
+			MemberId: &identitystore.MemberId{
+				UserId: aws.String(memberID),
+			},
+		}
+
+		log.Printf("[DEBUG] Creating Identity Store Group Membership: %s", input)
+		_, err := conn.CreateGroupMembershipWithContext(ctx, input)
+
+		if tfawserr.ErrCodeEquals(err, identitystore.ErrCodeConflictException) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("adding member (%s): %w", memberID, err)
+		}
+	}
+
+	for memberID, membershipID := range memberships {
+		if want[memberID] {
+			continue
+		}
+
+		if err := deleteGroupMembership(ctx, conn, identityStoreID, membershipID); err != nil {
+			return fmt.Errorf("removing member (%s): %w", memberID, err)
+		}
+	}
+
+	return nil
+}
+
+func deleteGroupMembership(ctx context.Context, conn *identitystore.IdentityStore, identityStoreID, membershipID string) error {
+	log.Printf("[DEBUG] Deleting Identity Store Group Membership: %s", membershipID)
+	_, err := conn.DeleteGroupMembershipWithContext(ctx, &identitystore.DeleteGroupMembershipInput{
+		IdentityStoreId: aws.String(identityStoreID),
+		MembershipId:    aws.String(membershipID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, identitystore.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	return err
+}
+
+const groupMembershipsResourceIDSeparator = ","
+
+func GroupMembershipsCreateResourceID(identityStoreID, groupID string) string {
+	parts := []string{identityStoreID, groupID}
+	id := strings.Join(parts, groupMembershipsResourceIDSeparator)
+
+	return id
+}
+
+func GroupMembershipsParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, groupMembershipsResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected IDENTITY-STORE-ID%[2]sGROUP-ID", id, groupMembershipsResourceIDSeparator)
+}
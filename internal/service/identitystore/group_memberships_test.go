@@ -0,0 +1,149 @@
+package identitystore_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/identitystore"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfidentitystore "github.com/hashicorp/terraform-provider-aws/internal/service/identitystore"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// The resource manages the complete membership list, so the group identified by
+// AWS_IDENTITY_STORE_GROUP_ID should have no other members.
+func TestAccIdentityStoreGroupMemberships_basic(t *testing.T) {
+	resourceName := "aws_identitystore_group_memberships.test"
+	groupID := os.Getenv("AWS_IDENTITY_STORE_GROUP_ID")
+	userID := os.Getenv("AWS_IDENTITY_STORE_USER_ID")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			testAccPreCheckSSOAdminInstances(t)
+			testAccPreCheckGroupID(t)
+			testAccPreCheckUserID(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, identitystore.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGroupMembershipsDestroy(userID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupMembershipsConfig_basic(groupID, userID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupMembershipsExists(resourceName, userID),
+					resource.TestCheckResourceAttr(resourceName, "group_id", groupID),
+					resource.TestCheckResourceAttr(resourceName, "member_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "member_ids.*", userID),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccGroupMembershipsConfig_empty(groupID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "member_ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGroupMembershipsDestroy(userID string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IdentityStoreConn
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_identitystore_group_memberships" {
+				continue
+			}
+
+			identityStoreID, groupID, err := tfidentitystore.GroupMembershipsParseResourceID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			memberships, err := tfidentitystore.FindGroupMembershipsByGroupID(context.Background(), conn, identityStoreID, groupID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if _, ok := memberships[userID]; ok {
+				return fmt.Errorf("Identity Store Group Memberships %s still contain member %s", rs.Primary.ID, userID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckGroupMembershipsExists(n, userID string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Identity Store Group Memberships ID is set")
+		}
+
+		identityStoreID, groupID, err := tfidentitystore.GroupMembershipsParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IdentityStoreConn
+
+		memberships, err := tfidentitystore.FindGroupMembershipsByGroupID(context.Background(), conn, identityStoreID, groupID)
+
+		if err != nil {
+			return err
+		}
+
+		if _, ok := memberships[userID]; !ok {
+			return fmt.Errorf("Identity Store Group Memberships %s do not contain member %s", rs.Primary.ID, userID)
+		}
+
+		return nil
+	}
+}
+
+func testAccGroupMembershipsConfig_basic(groupID, userID string) string {
+	return fmt.Sprintf(`
+data "aws_ssoadmin_instances" "test" {}
+
+resource "aws_identitystore_group_memberships" "test" {
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+  group_id          = %[1]q
+  member_ids        = [%[2]q]
+}
+`, groupID, userID)
+}
+
+func testAccGroupMembershipsConfig_empty(groupID string) string {
+	return fmt.Sprintf(`
+data "aws_ssoadmin_instances" "test" {}
+
+resource "aws_identitystore_group_memberships" "test" {
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+  group_id          = %[1]q
+  member_ids        = []
+}
+`, groupID)
+}
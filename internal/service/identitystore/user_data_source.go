@@ -16,6 +16,25 @@ func DataSourceUser() *schema.Resource {
 		Read: dataSourceUserRead,
 
 		Schema: map[string]*schema.Schema{
+			"external_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:      schema.TypeString,
+							Computed:  true,
+							Sensitive: true,
+						},
+						"issuer": {
+							Type:      schema.TypeString,
+							Computed:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+
 			"filter": {
 				Type:     schema.TypeSet,
 				Required: true,
@@ -105,6 +124,11 @@ func dataSourceUserRead(d *schema.ResourceData, meta interface{}) error {
 	user := results[0]
 
 	d.SetId(aws.StringValue(user.UserId))
+
+	if err := d.Set("external_ids", flattenExternalIDs(user.ExternalIds)); err != nil {
+		return fmt.Errorf("error setting external_ids: %w", err)
+	}
+
 	d.Set("user_id", user.UserId)
 	d.Set("user_name", user.UserName)
 
@@ -0,0 +1,1095 @@
+package inspector2
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/inspector2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceFilter() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceFilterCreate,
+		ReadWithoutTimeout:   resourceFilterRead,
+		UpdateWithoutTimeout: resourceFilterUpdate,
+		DeleteWithoutTimeout: resourceFilterDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"action": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(inspector2.FilterAction_Values(), false),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 512),
+			},
+			"filter_criteria": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"aws_account_id":                     stringFilterSchema(),
+						"code_vulnerability_detector_name":   stringFilterSchema(),
+						"code_vulnerability_detector_tags":   stringFilterSchema(),
+						"code_vulnerability_file_path":       stringFilterSchema(),
+						"component_id":                       stringFilterSchema(),
+						"component_type":                     stringFilterSchema(),
+						"ec2_instance_image_id":              stringFilterSchema(),
+						"ec2_instance_subnet_id":             stringFilterSchema(),
+						"ec2_instance_vpc_id":                stringFilterSchema(),
+						"ecr_image_architecture":             stringFilterSchema(),
+						"ecr_image_hash":                     stringFilterSchema(),
+						"ecr_image_pushed_at":                dateFilterSchema(),
+						"ecr_image_registry":                 stringFilterSchema(),
+						"ecr_image_repository_name":          stringFilterSchema(),
+						"ecr_image_tags":                     stringFilterSchema(),
+						"epss_score":                         numberFilterSchema(),
+						"exploit_available":                  stringFilterSchema(),
+						"finding_arn":                        stringFilterSchema(),
+						"finding_status":                     stringFilterSchema(),
+						"finding_type":                       stringFilterSchema(),
+						"first_observed_at":                  dateFilterSchema(),
+						"fix_available":                      stringFilterSchema(),
+						"inspector_score":                    numberFilterSchema(),
+						"lambda_function_execution_role_arn": stringFilterSchema(),
+						"lambda_function_last_modified_at":   dateFilterSchema(),
+						"lambda_function_layers":             stringFilterSchema(),
+						"lambda_function_name":               stringFilterSchema(),
+						"lambda_function_runtime":            stringFilterSchema(),
+						"last_observed_at":                   dateFilterSchema(),
+						"network_protocol":                   stringFilterSchema(),
+						"port_range":                         portRangeFilterSchema(),
+						"related_vulnerabilities":            stringFilterSchema(),
+						"resource_id":                        stringFilterSchema(),
+						"resource_tags":                      mapFilterSchema(),
+						"resource_type":                      stringFilterSchema(),
+						"severity":                           stringFilterSchema(),
+						"title":                              stringFilterSchema(),
+						"updated_at":                         dateFilterSchema(),
+						"vendor_severity":                    stringFilterSchema(),
+						"vulnerability_id":                   stringFilterSchema(),
+						"vulnerability_source":               stringFilterSchema(),
+						"vulnerable_packages":                packageFilterSchema()},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"reason": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 512),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func stringFilterSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"comparison": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(inspector2.StringComparison_Values(), false),
+				},
+				"value": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+func dateFilterSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"end_inclusive": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: verify.ValidUTCTimestamp,
+				},
+				"start_inclusive": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: verify.ValidUTCTimestamp,
+				},
+			},
+		},
+	}
+}
+
+func numberFilterSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"lower_inclusive": {
+					Type:     schema.TypeFloat,
+					Required: true,
+				},
+				"upper_inclusive": {
+					Type:     schema.TypeFloat,
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+func portRangeFilterSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"begin_inclusive": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IsPortNumberOrZero,
+				},
+				"end_inclusive": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IsPortNumberOrZero,
+				},
+			},
+		},
+	}
+}
+
+func mapFilterSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"comparison": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(inspector2.MapComparison_Values(), false),
+				},
+				"key": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"value": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func packageFilterSchema() *schema.Schema {
+	singleStringFilter := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem:     stringFilterSchema().Elem,
+		}
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"architecture": singleStringFilter(),
+				"epoch": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem:     numberFilterSchema().Elem,
+				},
+				"name":                    singleStringFilter(),
+				"release":                 singleStringFilter(),
+				"source_lambda_layer_arn": singleStringFilter(),
+				"source_layer_hash":       singleStringFilter(),
+				"version":                 singleStringFilter(),
+			},
+		},
+	}
+}
+
+func resourceFilterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Inspector2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &inspector2.CreateFilterInput{
+		Action:         aws.String(d.Get("action").(string)),
+		FilterCriteria: expandFilterCriteria(d.Get("filter_criteria").([]interface{})),
+		Name:           aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("reason"); ok {
+		input.Reason = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateFilterWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Inspector2 Filter (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Arn))
+
+	return resourceFilterRead(ctx, d, meta)
+}
+
+func resourceFilterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Inspector2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	filter, err := FindFilterByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Inspector2 Filter (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Inspector2 Filter (%s): %s", d.Id(), err)
+	}
+
+	d.Set("action", filter.Action)
+	d.Set("arn", filter.Arn)
+	d.Set("description", filter.Description)
+	if err := d.Set("filter_criteria", flattenFilterCriteria(filter.Criteria)); err != nil {
+		return diag.Errorf("setting filter_criteria: %s", err)
+	}
+	d.Set("name", filter.Name)
+	d.Set("reason", filter.Reason)
+
+	tags := KeyValueTags(filter.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceFilterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Inspector2Conn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &inspector2.UpdateFilterInput{
+			Action:         aws.String(d.Get("action").(string)),
+			FilterArn:      aws.String(d.Id()),
+			FilterCriteria: expandFilterCriteria(d.Get("filter_criteria").([]interface{})),
+			Name:           aws.String(d.Get("name").(string)),
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			input.Description = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("reason"); ok {
+			input.Reason = aws.String(v.(string))
+		}
+
+		_, err := conn.UpdateFilterWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating Inspector2 Filter (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Id(), o, n); err != nil {
+			return diag.Errorf("updating Inspector2 Filter (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceFilterRead(ctx, d, meta)
+}
+
+func resourceFilterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Inspector2Conn
+
+	log.Printf("[DEBUG] Deleting Inspector2 Filter: %s", d.Id())
+	_, err := conn.DeleteFilterWithContext(ctx, &inspector2.DeleteFilterInput{
+		Arn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, inspector2.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Inspector2 Filter (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandFilterCriteria(tfList []interface{}) *inspector2.FilterCriteria {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return &inspector2.FilterCriteria{}
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &inspector2.FilterCriteria{}
+
+	if v, ok := tfMap["aws_account_id"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AwsAccountId = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["code_vulnerability_detector_name"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.CodeVulnerabilityDetectorName = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["code_vulnerability_detector_tags"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.CodeVulnerabilityDetectorTags = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["code_vulnerability_file_path"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.CodeVulnerabilityFilePath = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["component_id"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ComponentId = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["component_type"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ComponentType = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["ec2_instance_image_id"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Ec2InstanceImageId = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["ec2_instance_subnet_id"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Ec2InstanceSubnetId = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["ec2_instance_vpc_id"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Ec2InstanceVpcId = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["ecr_image_architecture"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.EcrImageArchitecture = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["ecr_image_hash"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.EcrImageHash = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["ecr_image_pushed_at"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.EcrImagePushedAt = expandDateFilters(v.List())
+	}
+
+	if v, ok := tfMap["ecr_image_registry"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.EcrImageRegistry = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["ecr_image_repository_name"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.EcrImageRepositoryName = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["ecr_image_tags"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.EcrImageTags = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["epss_score"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.EpssScore = expandNumberFilters(v.List())
+	}
+
+	if v, ok := tfMap["exploit_available"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ExploitAvailable = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["finding_arn"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.FindingArn = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["finding_status"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.FindingStatus = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["finding_type"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.FindingType = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["first_observed_at"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.FirstObservedAt = expandDateFilters(v.List())
+	}
+
+	if v, ok := tfMap["fix_available"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.FixAvailable = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["inspector_score"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.InspectorScore = expandNumberFilters(v.List())
+	}
+
+	if v, ok := tfMap["lambda_function_execution_role_arn"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.LambdaFunctionExecutionRoleArn = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["lambda_function_last_modified_at"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.LambdaFunctionLastModifiedAt = expandDateFilters(v.List())
+	}
+
+	if v, ok := tfMap["lambda_function_layers"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.LambdaFunctionLayers = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["lambda_function_name"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.LambdaFunctionName = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["lambda_function_runtime"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.LambdaFunctionRuntime = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["last_observed_at"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.LastObservedAt = expandDateFilters(v.List())
+	}
+
+	if v, ok := tfMap["network_protocol"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.NetworkProtocol = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["port_range"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.PortRange = expandPortRangeFilters(v.List())
+	}
+
+	if v, ok := tfMap["related_vulnerabilities"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.RelatedVulnerabilities = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["resource_id"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ResourceId = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["resource_tags"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ResourceTags = expandMapFilters(v.List())
+	}
+
+	if v, ok := tfMap["resource_type"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ResourceType = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["severity"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Severity = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["title"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Title = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["updated_at"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.UpdatedAt = expandDateFilters(v.List())
+	}
+
+	if v, ok := tfMap["vendor_severity"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.VendorSeverity = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["vulnerability_id"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.VulnerabilityId = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["vulnerability_source"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.VulnerabilitySource = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["vulnerable_packages"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.VulnerablePackages = expandPackageFilters(v.List())
+	}
+	return apiObject
+}
+
+func expandStringFilter(tfMap map[string]interface{}) *inspector2.StringFilter {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &inspector2.StringFilter{}
+
+	if v, ok := tfMap["comparison"].(string); ok && v != "" {
+		apiObject.Comparison = aws.String(v)
+	}
+
+	if v, ok := tfMap["value"].(string); ok && v != "" {
+		apiObject.Value = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandStringFilters(tfList []interface{}) []*inspector2.StringFilter {
+	var apiObjects []*inspector2.StringFilter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, expandStringFilter(tfMap))
+	}
+
+	return apiObjects
+}
+
+func expandDateFilters(tfList []interface{}) []*inspector2.DateFilter {
+	var apiObjects []*inspector2.DateFilter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &inspector2.DateFilter{}
+
+		if v, ok := tfMap["end_inclusive"].(string); ok && v != "" {
+			t, _ := time.Parse(time.RFC3339, v)
+			apiObject.EndInclusive = aws.Time(t)
+		}
+
+		if v, ok := tfMap["start_inclusive"].(string); ok && v != "" {
+			t, _ := time.Parse(time.RFC3339, v)
+			apiObject.StartInclusive = aws.Time(t)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandNumberFilter(tfMap map[string]interface{}) *inspector2.NumberFilter {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &inspector2.NumberFilter{}
+
+	if v, ok := tfMap["lower_inclusive"].(float64); ok {
+		apiObject.LowerInclusive = aws.Float64(v)
+	}
+
+	if v, ok := tfMap["upper_inclusive"].(float64); ok {
+		apiObject.UpperInclusive = aws.Float64(v)
+	}
+
+	return apiObject
+}
+
+func expandNumberFilters(tfList []interface{}) []*inspector2.NumberFilter {
+	var apiObjects []*inspector2.NumberFilter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, expandNumberFilter(tfMap))
+	}
+
+	return apiObjects
+}
+
+func expandPortRangeFilters(tfList []interface{}) []*inspector2.PortRangeFilter {
+	var apiObjects []*inspector2.PortRangeFilter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &inspector2.PortRangeFilter{}
+
+		if v, ok := tfMap["begin_inclusive"].(int); ok {
+			apiObject.BeginInclusive = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["end_inclusive"].(int); ok {
+			apiObject.EndInclusive = aws.Int64(int64(v))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandMapFilters(tfList []interface{}) []*inspector2.MapFilter {
+	var apiObjects []*inspector2.MapFilter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &inspector2.MapFilter{}
+
+		if v, ok := tfMap["comparison"].(string); ok && v != "" {
+			apiObject.Comparison = aws.String(v)
+		}
+
+		if v, ok := tfMap["key"].(string); ok && v != "" {
+			apiObject.Key = aws.String(v)
+		}
+
+		if v, ok := tfMap["value"].(string); ok && v != "" {
+			apiObject.Value = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandPackageFilters(tfList []interface{}) []*inspector2.PackageFilter {
+	var apiObjects []*inspector2.PackageFilter
+
+	// expandSingle returns the first element of a MaxItems: 1 block.
+	expandSingle := func(v interface{}) map[string]interface{} {
+		if tfList, ok := v.([]interface{}); ok && len(tfList) > 0 && tfList[0] != nil {
+			return tfList[0].(map[string]interface{})
+		}
+
+		return nil
+	}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &inspector2.PackageFilter{}
+
+		if v := expandSingle(tfMap["architecture"]); v != nil {
+			apiObject.Architecture = expandStringFilter(v)
+		}
+
+		if v := expandSingle(tfMap["epoch"]); v != nil {
+			apiObject.Epoch = expandNumberFilter(v)
+		}
+
+		if v := expandSingle(tfMap["name"]); v != nil {
+			apiObject.Name = expandStringFilter(v)
+		}
+
+		if v := expandSingle(tfMap["release"]); v != nil {
+			apiObject.Release = expandStringFilter(v)
+		}
+
+		if v := expandSingle(tfMap["source_lambda_layer_arn"]); v != nil {
+			apiObject.SourceLambdaLayerArn = expandStringFilter(v)
+		}
+
+		if v := expandSingle(tfMap["source_layer_hash"]); v != nil {
+			apiObject.SourceLayerHash = expandStringFilter(v)
+		}
+
+		if v := expandSingle(tfMap["version"]); v != nil {
+			apiObject.Version = expandStringFilter(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenFilterCriteria(apiObject *inspector2.FilterCriteria) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AwsAccountId; v != nil {
+		tfMap["aws_account_id"] = flattenStringFilters(v)
+	}
+
+	if v := apiObject.CodeVulnerabilityDetectorName; v != nil {
+		tfMap["code_vulnerability_detector_name"] = flattenStringFilters(v)
+	}
+
+	if v := apiObject.CodeVulnerabilityDetectorTags; v != nil {
+		tfMap["code_vulnerability_detector_tags"] = flattenStringFilters(v)
+	}
+
+	if v := apiObject.CodeVulnerabilityFilePath; v != nil {
+		tfMap["code_vulnerability_file_path"] = flattenStringFilters(v)
+	}
+
+	if v := apiObject.ComponentId; v != nil {
+		tfMap["component_id"] = flattenStringFilters(v)
+	}
+
+	if v := apiObject.ComponentType; v != nil {
+		tfMap["component_type"] = flattenStringFilters(v)
+	}
+
+	if v := apiObject.Ec2InstanceImageId; v != nil {
+		tfMap["ec2_instance_image_id"] = flattenStringFilters(v)
+	}
+
+	if v := apiObject.Ec2InstanceSubnetId; v != nil {
+		tfMap["ec2_instance_subnet_id"] = flattenStringFilters(v)
+	}
+
+	if v := apiObject.Ec2InstanceVpcId; v != nil {
+		tfMap["ec2_instance_vpc_id"] = flattenStringFilters(v)
+	}
+
+	if v := apiObject.EcrImageArchitecture; v != nil {
+		tfMap["ecr_image_architecture"] = flattenStringFilters(v)
+	}
+
+	if v := apiObject.EcrImageHash; v != nil {
+		tfMap["ecr_image_hash"] = flattenStringFilters(v)
+	}
+
+	if v := apiObject.EcrImagePushedAt; v != nil {
+		tfMap["ecr_image_pushed_at"] = flattenDateFilters(v)
+	}
+
+	if v := apiObject.EcrImageRegistry; v != nil {
+		tfMap["ecr_image_registry"] = flattenStringFilters(v)
+	}
+
+	if v := apiObject.EcrImageRepositoryName; v != nil {
+		tfMap["ecr_image_repository_name"] = flattenStringFilters(v)
+	}
+
+	if v := apiObject.EcrImageTags; v != nil {
+		tfMap["ecr_image_tags"] = flattenStringFilters(v)
+	}
+
+	if v := apiObject.EpssScore; v != nil {
+		tfMap["epss_score"] = flattenNumberFilters(v)
+	}
+
+	if v := apiObject.ExploitAvailable; v != nil {
+		tfMap["exploit_available"] = flattenStringFilters(v)
+	}
+
+	if v := apiObject.FindingArn; v != nil {
+		tfMap["finding_arn"] = flattenStringFilters(v)
+	}
+
+	if v := apiObject.FindingStatus; v != nil {
+		tfMap["finding_status"] = flattenStringFilters(v)
+	}
+
+	if v := apiObject.FindingType; v != nil {
+		tfMap["finding_type"] = flattenStringFilters(v)
+	}
+
+	if v := apiObject.FirstObservedAt; v != nil {
+		tfMap["first_observed_at"] = flattenDateFilters(v)
+	}
+
+	if v := apiObject.FixAvailable; v != nil {
+		tfMap["fix_available"] = flattenStringFilters(v)
+	}
+
+	if v := apiObject.InspectorScore; v != nil {
+		tfMap["inspector_score"] = flattenNumberFilters(v)
+	}
+
+	if v := apiObject.LambdaFunctionExecutionRoleArn; v != nil {
+		tfMap["lambda_function_execution_role_arn"] = flattenStringFilters(v)
+	}
+
+	if v := apiObject.LambdaFunctionLastModifiedAt; v != nil {
+		tfMap["lambda_function_last_modified_at"] = flattenDateFilters(v)
+	}
+
+	if v := apiObject.LambdaFunctionLayers; v != nil {
+		tfMap["lambda_function_layers"] = flattenStringFilters(v)
+	}
+
+	if v := apiObject.LambdaFunctionName; v != nil {
+		tfMap["lambda_function_name"] = flattenStringFilters(v)
+	}
+
+	if v := apiObject.LambdaFunctionRuntime; v != nil {
+		tfMap["lambda_function_runtime"] = flattenStringFilters(v)
+	}
+
+	if v := apiObject.LastObservedAt; v != nil {
+		tfMap["last_observed_at"] = flattenDateFilters(v)
+	}
+
+	if v := apiObject.NetworkProtocol; v != nil {
+		tfMap["network_protocol"] = flattenStringFilters(v)
+	}
+
+	if v := apiObject.PortRange; v != nil {
+		tfMap["port_range"] = flattenPortRangeFilters(v)
+	}
+
+	if v := apiObject.RelatedVulnerabilities; v != nil {
+		tfMap["related_vulnerabilities"] = flattenStringFilters(v)
+	}
+
+	if v := apiObject.ResourceId; v != nil {
+		tfMap["resource_id"] = flattenStringFilters(v)
+	}
+
+	if v := apiObject.ResourceTags; v != nil {
+		tfMap["resource_tags"] = flattenMapFilters(v)
+	}
+
+	if v := apiObject.ResourceType; v != nil {
+		tfMap["resource_type"] = flattenStringFilters(v)
+	}
+
+	if v := apiObject.Severity; v != nil {
+		tfMap["severity"] = flattenStringFilters(v)
+	}
+
+	if v := apiObject.Title; v != nil {
+		tfMap["title"] = flattenStringFilters(v)
+	}
+
+	if v := apiObject.UpdatedAt; v != nil {
+		tfMap["updated_at"] = flattenDateFilters(v)
+	}
+
+	if v := apiObject.VendorSeverity; v != nil {
+		tfMap["vendor_severity"] = flattenStringFilters(v)
+	}
+
+	if v := apiObject.VulnerabilityId; v != nil {
+		tfMap["vulnerability_id"] = flattenStringFilters(v)
+	}
+
+	if v := apiObject.VulnerabilitySource; v != nil {
+		tfMap["vulnerability_source"] = flattenStringFilters(v)
+	}
+
+	if v := apiObject.VulnerablePackages; v != nil {
+		tfMap["vulnerable_packages"] = flattenPackageFilters(v)
+	}
+	return []interface{}{tfMap}
+}
+
+func flattenStringFilter(apiObject *inspector2.StringFilter) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"comparison": aws.StringValue(apiObject.Comparison),
+		"value":      aws.StringValue(apiObject.Value),
+	}
+}
+
+func flattenStringFilters(apiObjects []*inspector2.StringFilter) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenStringFilter(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenDateFilters(apiObjects []*inspector2.DateFilter) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.EndInclusive; v != nil {
+			tfMap["end_inclusive"] = aws.TimeValue(v).Format(time.RFC3339)
+		}
+
+		if v := apiObject.StartInclusive; v != nil {
+			tfMap["start_inclusive"] = aws.TimeValue(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenNumberFilter(apiObject *inspector2.NumberFilter) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"lower_inclusive": aws.Float64Value(apiObject.LowerInclusive),
+		"upper_inclusive": aws.Float64Value(apiObject.UpperInclusive),
+	}
+}
+
+func flattenNumberFilters(apiObjects []*inspector2.NumberFilter) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenNumberFilter(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenPortRangeFilters(apiObjects []*inspector2.PortRangeFilter) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"begin_inclusive": aws.Int64Value(apiObject.BeginInclusive),
+			"end_inclusive":   aws.Int64Value(apiObject.EndInclusive),
+		})
+	}
+
+	return tfList
+}
+
+func flattenMapFilters(apiObjects []*inspector2.MapFilter) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"comparison": aws.StringValue(apiObject.Comparison),
+			"key":        aws.StringValue(apiObject.Key),
+			"value":      aws.StringValue(apiObject.Value),
+		})
+	}
+
+	return tfList
+}
+
+func flattenPackageFilters(apiObjects []*inspector2.PackageFilter) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.Architecture; v != nil {
+			tfMap["architecture"] = []interface{}{flattenStringFilter(v)}
+		}
+
+		if v := apiObject.Epoch; v != nil {
+			tfMap["epoch"] = []interface{}{flattenNumberFilter(v)}
+		}
+
+		if v := apiObject.Name; v != nil {
+			tfMap["name"] = []interface{}{flattenStringFilter(v)}
+		}
+
+		if v := apiObject.Release; v != nil {
+			tfMap["release"] = []interface{}{flattenStringFilter(v)}
+		}
+
+		if v := apiObject.SourceLambdaLayerArn; v != nil {
+			tfMap["source_lambda_layer_arn"] = []interface{}{flattenStringFilter(v)}
+		}
+
+		if v := apiObject.SourceLayerHash; v != nil {
+			tfMap["source_layer_hash"] = []interface{}{flattenStringFilter(v)}
+		}
+
+		if v := apiObject.Version; v != nil {
+			tfMap["version"] = []interface{}{flattenStringFilter(v)}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
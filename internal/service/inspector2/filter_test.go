@@ -0,0 +1,345 @@
+package inspector2_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/inspector2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfinspector2 "github.com/hashicorp/terraform-provider-aws/internal/service/inspector2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccFilter_basic(t *testing.T) {
+	var v inspector2.Filter
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_inspector2_filter.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, inspector2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFilterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFilterConfig_basic(rName, "SUPPRESS", "description 1", "reason 1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFilterExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "action", "SUPPRESS"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "inspector2", regexp.MustCompile(`owner/\d{12}/filter/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "description", "description 1"),
+					resource.TestCheckResourceAttr(resourceName, "filter_criteria.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "filter_criteria.0.aws_account_id.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "filter_criteria.0.aws_account_id.*", map[string]string{
+						"comparison": "EQUALS",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "reason", "reason 1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFilterConfig_basic(rName, "NONE", "description 2", "reason 2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFilterExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "action", "NONE"),
+					resource.TestCheckResourceAttr(resourceName, "description", "description 2"),
+					resource.TestCheckResourceAttr(resourceName, "reason", "reason 2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFilter_disappears(t *testing.T) {
+	var v inspector2.Filter
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_inspector2_filter.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, inspector2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFilterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFilterConfig_basic(rName, "SUPPRESS", "description 1", "reason 1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFilterExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfinspector2.ResourceFilter(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccFilter_filterCriteria(t *testing.T) {
+	var v inspector2.Filter
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_inspector2_filter.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, inspector2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFilterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFilterConfig_filterCriteria(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFilterExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "filter_criteria.0.aws_account_id.#", "0"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "filter_criteria.0.severity.*", map[string]string{
+						"comparison": "EQUALS",
+						"value":      "LOW",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "filter_criteria.0.first_observed_at.*", map[string]string{
+						"start_inclusive": "2023-01-01T00:00:00Z",
+						"end_inclusive":   "2023-12-31T23:59:59Z",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "filter_criteria.0.inspector_score.*", map[string]string{
+						"lower_inclusive": "0",
+						"upper_inclusive": "5",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "filter_criteria.0.port_range.*", map[string]string{
+						"begin_inclusive": "80",
+						"end_inclusive":   "443",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "filter_criteria.0.resource_tags.*", map[string]string{
+						"comparison": "EQUALS",
+						"key":        "Environment",
+						"value":      "sandbox",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "filter_criteria.0.vulnerable_packages.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "filter_criteria.0.vulnerable_packages.*", map[string]string{
+						"name.#":            "1",
+						"name.0.comparison": "EQUALS",
+						"name.0.value":      "openssl",
+						"epoch.#":           "1",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccFilter_tags(t *testing.T) {
+	var v inspector2.Filter
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_inspector2_filter.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, inspector2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFilterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFilterConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFilterExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFilterConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFilterExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccFilterConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFilterExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFilterExists(n string, v *inspector2.Filter) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Inspector2 Filter ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Inspector2Conn
+
+		output, err := tfinspector2.FindFilterByARN(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckFilterDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).Inspector2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_inspector2_filter" {
+			continue
+		}
+
+		_, err := tfinspector2.FindFilterByARN(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Inspector2 Filter %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccFilterConfig_basic(rName, action, description, reason string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_inspector2_filter" "test" {
+  name        = %[1]q
+  action      = %[2]q
+  description = %[3]q
+  reason      = %[4]q
+
+  filter_criteria {
+    aws_account_id {
+      comparison = "EQUALS"
+      value      = data.aws_caller_identity.current.account_id
+    }
+  }
+}
+`, rName, action, description, reason)
+}
+
+func testAccFilterConfig_filterCriteria(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_inspector2_filter" "test" {
+  name   = %[1]q
+  action = "SUPPRESS"
+
+  filter_criteria {
+    severity {
+      comparison = "EQUALS"
+      value      = "LOW"
+    }
+
+    first_observed_at {
+      start_inclusive = "2023-01-01T00:00:00Z"
+      end_inclusive   = "2023-12-31T23:59:59Z"
+    }
+
+    inspector_score {
+      lower_inclusive = 0
+      upper_inclusive = 5
+    }
+
+    port_range {
+      begin_inclusive = 80
+      end_inclusive   = 443
+    }
+
+    resource_tags {
+      comparison = "EQUALS"
+      key        = "Environment"
+      value      = "sandbox"
+    }
+
+    vulnerable_packages {
+      name {
+        comparison = "EQUALS"
+        value      = "openssl"
+      }
+
+      epoch {
+        lower_inclusive = 0
+        upper_inclusive = 1
+      }
+    }
+  }
+}
+`, rName)
+}
+
+func testAccFilterConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_inspector2_filter" "test" {
+  name   = %[1]q
+  action = "NONE"
+
+  filter_criteria {
+    severity {
+      comparison = "EQUALS"
+      value      = "INFORMATIONAL"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccFilterConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_inspector2_filter" "test" {
+  name   = %[1]q
+  action = "NONE"
+
+  filter_criteria {
+    severity {
+      comparison = "EQUALS"
+      value      = "INFORMATIONAL"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
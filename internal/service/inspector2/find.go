@@ -0,0 +1,60 @@
+package inspector2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/inspector2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindFilterByARN(ctx context.Context, conn *inspector2.Inspector2, arn string) (*inspector2.Filter, error) {
+	input := &inspector2.ListFiltersInput{
+		Arns: aws.StringSlice([]string{arn}),
+	}
+	var output []*inspector2.Filter
+
+	err := conn.ListFiltersPagesWithContext(ctx, input, func(page *inspector2.ListFiltersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Filters {
+			if v != nil && aws.StringValue(v.Arn) == arn {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
+
+func FindOrganizationConfiguration(ctx context.Context, conn *inspector2.Inspector2) (*inspector2.DescribeOrganizationConfigurationOutput, error) {
+	input := &inspector2.DescribeOrganizationConfigurationInput{}
+
+	output, err := conn.DescribeOrganizationConfigurationWithContext(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.AutoEnable == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
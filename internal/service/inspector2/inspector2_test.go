@@ -0,0 +1,69 @@
+package inspector2_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/inspector2"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfinspector2 "github.com/hashicorp/terraform-provider-aws/internal/service/inspector2"
+)
+
+func TestAccInspector2_serial(t *testing.T) {
+	testCases := map[string]map[string]func(t *testing.T){
+		"Filter": {
+			"basic":          testAccFilter_basic,
+			"disappears":     testAccFilter_disappears,
+			"FilterCriteria": testAccFilter_filterCriteria,
+			"Tags":           testAccFilter_tags,
+		},
+		"OrganizationConfiguration": {
+			"basic":      testAccOrganizationConfiguration_basic,
+			"disappears": testAccOrganizationConfiguration_disappears,
+			"Lambda":     testAccOrganizationConfiguration_lambda,
+		},
+	}
+
+	for group, m := range testCases {
+		m := m
+		t.Run(group, func(t *testing.T) {
+			for name, tc := range m {
+				tc := tc
+				t.Run(name, func(t *testing.T) {
+					tc(t)
+				})
+			}
+		})
+	}
+}
+
+func testAccPreCheck(t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).Inspector2Conn
+
+	_, err := conn.ListFiltersWithContext(context.Background(), &inspector2.ListFiltersInput{})
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+// testAccPreCheckDelegatedAdministrator skips the test unless the current account
+// is the Inspector delegated administrator for its organization.
+func testAccPreCheckDelegatedAdministrator(t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).Inspector2Conn
+
+	_, err := tfinspector2.FindOrganizationConfiguration(context.Background(), conn)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
@@ -0,0 +1,179 @@
+package inspector2
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/inspector2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func ResourceOrganizationConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceOrganizationConfigurationPut,
+		ReadWithoutTimeout:   resourceOrganizationConfigurationRead,
+		UpdateWithoutTimeout: resourceOrganizationConfigurationPut,
+		DeleteWithoutTimeout: resourceOrganizationConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"auto_enable": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ec2": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"ecr": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"lambda": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"lambda_code": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+			"max_account_limit_reached": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceOrganizationConfigurationPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Inspector2Conn
+
+	autoEnable := expandAutoEnable(d.Get("auto_enable").([]interface{}))
+	input := &inspector2.UpdateOrganizationConfigurationInput{
+		AutoEnable: autoEnable,
+	}
+
+	_, err := conn.UpdateOrganizationConfigurationWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("updating Inspector2 Organization Configuration: %s", err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(meta.(*conns.AWSClient).AccountID)
+	}
+
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutCreate)
+	}
+
+	if _, err := waitOrganizationConfigurationUpdated(ctx, conn, autoEnable, timeout); err != nil {
+		return diag.Errorf("waiting for Inspector2 Organization Configuration (%s) update: %s", d.Id(), err)
+	}
+
+	return resourceOrganizationConfigurationRead(ctx, d, meta)
+}
+
+func resourceOrganizationConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Inspector2Conn
+
+	output, err := FindOrganizationConfiguration(ctx, conn)
+
+	if err != nil {
+		return diag.Errorf("reading Inspector2 Organization Configuration (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("auto_enable", []interface{}{flattenAutoEnable(output.AutoEnable)}); err != nil {
+		return diag.Errorf("setting auto_enable: %s", err)
+	}
+	d.Set("max_account_limit_reached", output.MaxAccountLimitReached)
+
+	return nil
+}
+
+func resourceOrganizationConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Inspector2Conn
+
+	// The organization configuration can't be deleted; turn off auto-enable instead.
+	autoEnable := &inspector2.AutoEnable{
+		Ec2:        aws.Bool(false),
+		Ecr:        aws.Bool(false),
+		Lambda:     aws.Bool(false),
+		LambdaCode: aws.Bool(false),
+	}
+	input := &inspector2.UpdateOrganizationConfigurationInput{
+		AutoEnable: autoEnable,
+	}
+
+	_, err := conn.UpdateOrganizationConfigurationWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("deleting Inspector2 Organization Configuration (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitOrganizationConfigurationUpdated(ctx, conn, autoEnable, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for Inspector2 Organization Configuration (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandAutoEnable(tfList []interface{}) *inspector2.AutoEnable {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &inspector2.AutoEnable{}
+
+	if v, ok := tfMap["ec2"].(bool); ok {
+		apiObject.Ec2 = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["ecr"].(bool); ok {
+		apiObject.Ecr = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["lambda"].(bool); ok {
+		apiObject.Lambda = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["lambda_code"].(bool); ok {
+		apiObject.LambdaCode = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func flattenAutoEnable(apiObject *inspector2.AutoEnable) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"ec2":         aws.BoolValue(apiObject.Ec2),
+		"ecr":         aws.BoolValue(apiObject.Ecr),
+		"lambda":      aws.BoolValue(apiObject.Lambda),
+		"lambda_code": aws.BoolValue(apiObject.LambdaCode),
+	}
+}
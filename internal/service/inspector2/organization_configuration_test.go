@@ -0,0 +1,173 @@
+package inspector2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/inspector2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfinspector2 "github.com/hashicorp/terraform-provider-aws/internal/service/inspector2"
+)
+
+func testAccOrganizationConfiguration_basic(t *testing.T) {
+	resourceName := "aws_inspector2_organization_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			testAccPreCheckDelegatedAdministrator(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, inspector2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOrganizationConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationConfigurationConfig_basic(true, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrganizationConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable.0.ec2", "true"),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable.0.ecr", "false"),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable.0.lambda", "false"),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable.0.lambda_code", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "max_account_limit_reached"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccOrganizationConfigurationConfig_basic(false, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrganizationConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable.0.ec2", "false"),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable.0.ecr", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOrganizationConfiguration_disappears(t *testing.T) {
+	resourceName := "aws_inspector2_organization_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			testAccPreCheckDelegatedAdministrator(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, inspector2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOrganizationConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationConfigurationConfig_basic(true, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrganizationConfigurationExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfinspector2.ResourceOrganizationConfiguration(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccOrganizationConfiguration_lambda(t *testing.T) {
+	resourceName := "aws_inspector2_organization_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			testAccPreCheckDelegatedAdministrator(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, inspector2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOrganizationConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationConfigurationConfig_lambda(true, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrganizationConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable.0.lambda", "true"),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable.0.lambda_code", "false"),
+				),
+			},
+			{
+				Config: testAccOrganizationConfigurationConfig_lambda(true, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrganizationConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable.0.lambda", "true"),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable.0.lambda_code", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckOrganizationConfigurationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Inspector2Conn
+
+		_, err := tfinspector2.FindOrganizationConfiguration(context.Background(), conn)
+
+		return err
+	}
+}
+
+func testAccCheckOrganizationConfigurationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).Inspector2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_inspector2_organization_configuration" {
+			continue
+		}
+
+		output, err := tfinspector2.FindOrganizationConfiguration(context.Background(), conn)
+
+		if err != nil {
+			return err
+		}
+
+		if v := output.AutoEnable; v != nil && (aws.BoolValue(v.Ec2) || aws.BoolValue(v.Ecr) || aws.BoolValue(v.Lambda) || aws.BoolValue(v.LambdaCode)) {
+			return fmt.Errorf("Inspector2 Organization Configuration %s still has auto-enable turned on", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccOrganizationConfigurationConfig_basic(ec2, ecr bool) string {
+	return fmt.Sprintf(`
+resource "aws_inspector2_organization_configuration" "test" {
+  auto_enable {
+    ec2 = %[1]t
+    ecr = %[2]t
+  }
+}
+`, ec2, ecr)
+}
+
+func testAccOrganizationConfigurationConfig_lambda(lambda, lambdaCode bool) string {
+	return fmt.Sprintf(`
+resource "aws_inspector2_organization_configuration" "test" {
+  auto_enable {
+    ec2         = false
+    ecr         = false
+    lambda      = %[1]t
+    lambda_code = %[2]t
+  }
+}
+`, lambda, lambdaCode)
+}
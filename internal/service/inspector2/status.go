@@ -0,0 +1,36 @@
+package inspector2
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/inspector2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// statusOrganizationConfigurationAutoEnable reports whether the organization's
+// auto-enable settings match the expected value.
+func statusOrganizationConfigurationAutoEnable(ctx context.Context, conn *inspector2.Inspector2, expected *inspector2.AutoEnable) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindOrganizationConfiguration(ctx, conn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, strconv.FormatBool(autoEnableEqual(output.AutoEnable, expected)), nil
+	}
+}
+
+func autoEnableEqual(a, b *inspector2.AutoEnable) bool {
+	return aws.BoolValue(a.Ec2) == aws.BoolValue(b.Ec2) &&
+		aws.BoolValue(a.Ecr) == aws.BoolValue(b.Ecr) &&
+		aws.BoolValue(a.Lambda) == aws.BoolValue(b.Lambda) &&
+		aws.BoolValue(a.LambdaCode) == aws.BoolValue(b.LambdaCode)
+}
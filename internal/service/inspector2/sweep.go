@@ -0,0 +1,65 @@
+//go:build sweep
+// +build sweep
+
+package inspector2
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/inspector2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_inspector2_filter", &resource.Sweeper{
+		Name: "aws_inspector2_filter",
+		F:    sweepFilters,
+	})
+}
+
+func sweepFilters(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).Inspector2Conn
+	input := &inspector2.ListFiltersInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListFiltersPages(input, func(page *inspector2.ListFiltersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Filters {
+			r := ResourceFilter()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Arn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Inspector2 Filter sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Inspector2 Filters (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Inspector2 Filters (%s): %w", region, err)
+	}
+
+	return nil
+}
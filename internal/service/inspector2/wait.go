@@ -0,0 +1,29 @@
+package inspector2
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/inspector2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func waitOrganizationConfigurationUpdated(ctx context.Context, conn *inspector2.Inspector2, expected *inspector2.AutoEnable, timeout time.Duration) (*inspector2.DescribeOrganizationConfigurationOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:                   []string{strconv.FormatBool(false)},
+		Target:                    []string{strconv.FormatBool(true)},
+		Refresh:                   statusOrganizationConfigurationAutoEnable(ctx, conn, expected),
+		Timeout:                   timeout,
+		Delay:                     10 * time.Second,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*inspector2.DescribeOrganizationConfigurationOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,244 @@
+package ivs
+
+import (
+	"context"
+	"errors"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ivsrealtime"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourceEncoderConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceEncoderConfigurationCreate,
+		ReadWithoutTimeout:   resourceEncoderConfigurationRead,
+		UpdateWithoutTimeout: resourceEncoderConfigurationUpdate,
+		DeleteWithoutTimeout: resourceEncoderConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(0, 128),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9-_]*$`), "must contain only alphanumeric characters, hyphens and underscores"),
+				),
+			},
+			"video": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bitrate": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntBetween(1, 8500000),
+						},
+						"framerate": {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.FloatBetween(1, 60),
+						},
+						"height": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntBetween(1, 1920),
+						},
+						"width": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntBetween(1, 1920),
+						},
+					},
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	ResNameEncoderConfiguration = "Encoder Configuration"
+)
+
+func resourceEncoderConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IVSRealTimeConn
+
+	in := &ivsrealtime.CreateEncoderConfigurationInput{}
+
+	if v, ok := d.GetOk("name"); ok {
+		in.Name = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("video"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		in.Video = expandVideo(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	if len(tags) > 0 {
+		in.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	out, err := conn.CreateEncoderConfigurationWithContext(ctx, in)
+	if err != nil {
+		return names.DiagError(names.IVS, names.ErrActionCreating, ResNameEncoderConfiguration, d.Get("name").(string), err)
+	}
+
+	if out == nil || out.EncoderConfiguration == nil {
+		return names.DiagError(names.IVS, names.ErrActionCreating, ResNameEncoderConfiguration, d.Get("name").(string), errors.New("empty output"))
+	}
+
+	d.SetId(aws.StringValue(out.EncoderConfiguration.Arn))
+
+	return resourceEncoderConfigurationRead(ctx, d, meta)
+}
+
+func resourceEncoderConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IVSRealTimeConn
+
+	out, err := FindEncoderConfigurationByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IVS Encoder Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return names.DiagError(names.IVS, names.ErrActionReading, ResNameEncoderConfiguration, d.Id(), err)
+	}
+
+	d.Set("arn", out.Arn)
+	d.Set("name", out.Name)
+
+	if err := d.Set("video", flattenVideo(out.Video)); err != nil {
+		return names.DiagError(names.IVS, names.ErrActionSetting, ResNameEncoderConfiguration, d.Id(), err)
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	tags := KeyValueTags(out.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return names.DiagError(names.IVS, names.ErrActionSetting, ResNameEncoderConfiguration, d.Id(), err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return names.DiagError(names.IVS, names.ErrActionSetting, ResNameEncoderConfiguration, d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceEncoderConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IVSRealTimeConn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := updateRealTimeTags(ctx, conn, d.Id(), o, n); err != nil {
+			return names.DiagError(names.IVS, names.ErrActionUpdating, ResNameEncoderConfiguration, d.Id(), err)
+		}
+	}
+
+	return resourceEncoderConfigurationRead(ctx, d, meta)
+}
+
+func resourceEncoderConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IVSRealTimeConn
+
+	log.Printf("[INFO] Deleting IVS Encoder Configuration %s", d.Id())
+
+	_, err := conn.DeleteEncoderConfigurationWithContext(ctx, &ivsrealtime.DeleteEncoderConfigurationInput{
+		Arn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ivsrealtime.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return names.DiagError(names.IVS, names.ErrActionDeleting, ResNameEncoderConfiguration, d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandVideo(tfMap map[string]interface{}) *ivsrealtime.Video {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ivsrealtime.Video{}
+
+	if v, ok := tfMap["bitrate"].(int); ok && v != 0 {
+		apiObject.Bitrate = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["framerate"].(float64); ok && v != 0 {
+		apiObject.Framerate = aws.Float64(v)
+	}
+
+	if v, ok := tfMap["height"].(int); ok && v != 0 {
+		apiObject.Height = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["width"].(int); ok && v != 0 {
+		apiObject.Width = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func flattenVideo(apiObject *ivsrealtime.Video) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"bitrate":   aws.Int64Value(apiObject.Bitrate),
+		"framerate": aws.Float64Value(apiObject.Framerate),
+		"height":    aws.Int64Value(apiObject.Height),
+		"width":     aws.Int64Value(apiObject.Width),
+	}
+
+	return []interface{}{tfMap}
+}
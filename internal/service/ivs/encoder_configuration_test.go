@@ -0,0 +1,245 @@
+package ivs_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ivsrealtime"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfivs "github.com/hashicorp/terraform-provider-aws/internal/service/ivs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccIVSEncoderConfiguration_basic(t *testing.T) {
+	var v ivsrealtime.EncoderConfiguration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ivs_encoder_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ivsrealtime.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEncoderConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEncoderConfigurationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEncoderConfigurationExists(resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "ivs", regexp.MustCompile(`encoder-configuration/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "video.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIVSEncoderConfiguration_disappears(t *testing.T) {
+	var v ivsrealtime.EncoderConfiguration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ivs_encoder_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ivsrealtime.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEncoderConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEncoderConfigurationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEncoderConfigurationExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfivs.ResourceEncoderConfiguration(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccIVSEncoderConfiguration_video(t *testing.T) {
+	var v ivsrealtime.EncoderConfiguration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ivs_encoder_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ivsrealtime.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEncoderConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEncoderConfigurationConfig_video(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEncoderConfigurationExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "video.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "video.0.bitrate", "2500000"),
+					resource.TestCheckResourceAttr(resourceName, "video.0.framerate", "30"),
+					resource.TestCheckResourceAttr(resourceName, "video.0.height", "720"),
+					resource.TestCheckResourceAttr(resourceName, "video.0.width", "1280"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIVSEncoderConfiguration_tags(t *testing.T) {
+	var v ivsrealtime.EncoderConfiguration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ivs_encoder_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ivsrealtime.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEncoderConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEncoderConfigurationConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEncoderConfigurationExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEncoderConfigurationConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEncoderConfigurationExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccEncoderConfigurationConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEncoderConfigurationExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEncoderConfigurationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).IVSRealTimeConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ivs_encoder_configuration" {
+			continue
+		}
+
+		_, err := tfivs.FindEncoderConfigurationByARN(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return names.Error(names.IVS, names.ErrActionCheckingDestroyed, tfivs.ResNameEncoderConfiguration, rs.Primary.ID, errors.New("not destroyed"))
+	}
+
+	return nil
+}
+
+func testAccCheckEncoderConfigurationExists(name string, v *ivsrealtime.EncoderConfiguration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return names.Error(names.IVS, names.ErrActionCheckingExistence, tfivs.ResNameEncoderConfiguration, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return names.Error(names.IVS, names.ErrActionCheckingExistence, tfivs.ResNameEncoderConfiguration, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IVSRealTimeConn
+
+		output, err := tfivs.FindEncoderConfigurationByARN(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return names.Error(names.IVS, names.ErrActionCheckingExistence, tfivs.ResNameEncoderConfiguration, rs.Primary.ID, err)
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccEncoderConfigurationConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ivs_encoder_configuration" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccEncoderConfigurationConfig_video(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ivs_encoder_configuration" "test" {
+  name = %[1]q
+
+  video {
+    bitrate   = 2500000
+    framerate = 30
+    height    = 720
+    width     = 1280
+  }
+}
+`, rName)
+}
+
+func testAccEncoderConfigurationConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_ivs_encoder_configuration" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccEncoderConfigurationConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_ivs_encoder_configuration" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
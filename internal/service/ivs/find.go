@@ -0,0 +1,86 @@
+package ivs
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ivsrealtime"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindStageByARN(ctx context.Context, conn *ivsrealtime.IVSRealTime, arn string) (*ivsrealtime.Stage, error) {
+	input := &ivsrealtime.GetStageInput{
+		Arn: aws.String(arn),
+	}
+
+	output, err := conn.GetStageWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, ivsrealtime.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Stage == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Stage, nil
+}
+
+func FindEncoderConfigurationByARN(ctx context.Context, conn *ivsrealtime.IVSRealTime, arn string) (*ivsrealtime.EncoderConfiguration, error) {
+	input := &ivsrealtime.GetEncoderConfigurationInput{
+		Arn: aws.String(arn),
+	}
+
+	output, err := conn.GetEncoderConfigurationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, ivsrealtime.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.EncoderConfiguration == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.EncoderConfiguration, nil
+}
+
+func FindStorageConfigurationByARN(ctx context.Context, conn *ivsrealtime.IVSRealTime, arn string) (*ivsrealtime.StorageConfiguration, error) {
+	input := &ivsrealtime.GetStorageConfigurationInput{
+		Arn: aws.String(arn),
+	}
+
+	output, err := conn.GetStorageConfigurationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, ivsrealtime.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.StorageConfiguration == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.StorageConfiguration, nil
+}
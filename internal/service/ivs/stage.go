@@ -0,0 +1,283 @@
+package ivs
+
+import (
+	"context"
+	"errors"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ivsrealtime"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourceStage() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceStageCreate,
+		ReadWithoutTimeout:   resourceStageRead,
+		UpdateWithoutTimeout: resourceStageUpdate,
+		DeleteWithoutTimeout: resourceStageDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"active_session_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"auto_participant_recording_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"media_types": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(ivsrealtime.ParticipantRecordingMediaType_Values(), false),
+							},
+						},
+						"storage_configuration_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+			"endpoints": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"events": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"whip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(0, 128),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9-_]*$`), "must contain only alphanumeric characters, hyphens and underscores"),
+				),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	ResNameStage = "Stage"
+)
+
+func resourceStageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IVSRealTimeConn
+
+	in := &ivsrealtime.CreateStageInput{}
+
+	if v, ok := d.GetOk("auto_participant_recording_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		in.AutoParticipantRecordingConfiguration = expandAutoParticipantRecordingConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("name"); ok {
+		in.Name = aws.String(v.(string))
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	if len(tags) > 0 {
+		in.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	out, err := conn.CreateStageWithContext(ctx, in)
+	if err != nil {
+		return names.DiagError(names.IVS, names.ErrActionCreating, ResNameStage, d.Get("name").(string), err)
+	}
+
+	if out == nil || out.Stage == nil {
+		return names.DiagError(names.IVS, names.ErrActionCreating, ResNameStage, d.Get("name").(string), errors.New("empty output"))
+	}
+
+	d.SetId(aws.StringValue(out.Stage.Arn))
+
+	return resourceStageRead(ctx, d, meta)
+}
+
+func resourceStageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IVSRealTimeConn
+
+	out, err := FindStageByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IVS Stage (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return names.DiagError(names.IVS, names.ErrActionReading, ResNameStage, d.Id(), err)
+	}
+
+	d.Set("active_session_id", out.ActiveSessionId)
+	d.Set("arn", out.Arn)
+	d.Set("name", out.Name)
+
+	if err := d.Set("auto_participant_recording_configuration", flattenAutoParticipantRecordingConfiguration(out.AutoParticipantRecordingConfiguration)); err != nil {
+		return names.DiagError(names.IVS, names.ErrActionSetting, ResNameStage, d.Id(), err)
+	}
+
+	if err := d.Set("endpoints", flattenStageEndpoints(out.Endpoints)); err != nil {
+		return names.DiagError(names.IVS, names.ErrActionSetting, ResNameStage, d.Id(), err)
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	tags := KeyValueTags(out.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return names.DiagError(names.IVS, names.ErrActionSetting, ResNameStage, d.Id(), err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return names.DiagError(names.IVS, names.ErrActionSetting, ResNameStage, d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceStageUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IVSRealTimeConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		in := &ivsrealtime.UpdateStageInput{
+			Arn: aws.String(d.Id()),
+		}
+
+		if d.HasChange("auto_participant_recording_configuration") {
+			if v, ok := d.GetOk("auto_participant_recording_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				in.AutoParticipantRecordingConfiguration = expandAutoParticipantRecordingConfiguration(v.([]interface{})[0].(map[string]interface{}))
+			} else {
+				// An empty storage configuration ARN disables automatic participant recording.
+				in.AutoParticipantRecordingConfiguration = &ivsrealtime.AutoParticipantRecordingConfiguration{
+					StorageConfigurationArn: aws.String(""),
+				}
+			}
+		}
+
+		if d.HasChange("name") {
+			in.Name = aws.String(d.Get("name").(string))
+		}
+
+		log.Printf("[DEBUG] Updating IVS Stage (%s): %s", d.Id(), in)
+		_, err := conn.UpdateStageWithContext(ctx, in)
+		if err != nil {
+			return names.DiagError(names.IVS, names.ErrActionUpdating, ResNameStage, d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := updateRealTimeTags(ctx, conn, d.Id(), o, n); err != nil {
+			return names.DiagError(names.IVS, names.ErrActionUpdating, ResNameStage, d.Id(), err)
+		}
+	}
+
+	return resourceStageRead(ctx, d, meta)
+}
+
+func resourceStageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IVSRealTimeConn
+
+	log.Printf("[INFO] Deleting IVS Stage %s", d.Id())
+
+	_, err := conn.DeleteStageWithContext(ctx, &ivsrealtime.DeleteStageInput{
+		Arn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ivsrealtime.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return names.DiagError(names.IVS, names.ErrActionDeleting, ResNameStage, d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandAutoParticipantRecordingConfiguration(tfMap map[string]interface{}) *ivsrealtime.AutoParticipantRecordingConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ivsrealtime.AutoParticipantRecordingConfiguration{}
+
+	if v, ok := tfMap["media_types"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.MediaTypes = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["storage_configuration_arn"].(string); ok && v != "" {
+		apiObject.StorageConfigurationArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenAutoParticipantRecordingConfiguration(apiObject *ivsrealtime.AutoParticipantRecordingConfiguration) []interface{} {
+	if apiObject == nil || aws.StringValue(apiObject.StorageConfigurationArn) == "" {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"media_types":               aws.StringValueSlice(apiObject.MediaTypes),
+		"storage_configuration_arn": aws.StringValue(apiObject.StorageConfigurationArn),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenStageEndpoints(apiObject *ivsrealtime.StageEndpoints) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"events": aws.StringValue(apiObject.Events),
+		"whip":   aws.StringValue(apiObject.Whip),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,260 @@
+package ivs_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ivsrealtime"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfivs "github.com/hashicorp/terraform-provider-aws/internal/service/ivs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccIVSStage_basic(t *testing.T) {
+	var stage ivsrealtime.Stage
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ivs_stage.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ivsrealtime.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStageConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStageExists(resourceName, &stage),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "ivs", regexp.MustCompile(`stage/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "auto_participant_recording_configuration.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "endpoints.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "endpoints.0.events"),
+					resource.TestCheckResourceAttrSet(resourceName, "endpoints.0.whip"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIVSStage_disappears(t *testing.T) {
+	var stage ivsrealtime.Stage
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ivs_stage.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ivsrealtime.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStageConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStageExists(resourceName, &stage),
+					acctest.CheckResourceDisappears(acctest.Provider, tfivs.ResourceStage(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccIVSStage_autoParticipantRecordingConfiguration(t *testing.T) {
+	var stage ivsrealtime.Stage
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ivs_stage.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ivsrealtime.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStageConfig_autoParticipantRecordingConfiguration(rName, "AUDIO_VIDEO"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStageExists(resourceName, &stage),
+					resource.TestCheckResourceAttr(resourceName, "auto_participant_recording_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "auto_participant_recording_configuration.0.media_types.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "auto_participant_recording_configuration.0.media_types.*", "AUDIO_VIDEO"),
+					resource.TestCheckResourceAttrPair(resourceName, "auto_participant_recording_configuration.0.storage_configuration_arn", "aws_ivs_storage_configuration.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccStageConfig_autoParticipantRecordingConfiguration(rName, "AUDIO_ONLY"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStageExists(resourceName, &stage),
+					resource.TestCheckResourceAttr(resourceName, "auto_participant_recording_configuration.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "auto_participant_recording_configuration.0.media_types.*", "AUDIO_ONLY"),
+				),
+			},
+			{
+				Config: testAccStageConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStageExists(resourceName, &stage),
+					resource.TestCheckResourceAttr(resourceName, "auto_participant_recording_configuration.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIVSStage_tags(t *testing.T) {
+	var stage ivsrealtime.Stage
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ivs_stage.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ivsrealtime.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStageConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStageExists(resourceName, &stage),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccStageConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStageExists(resourceName, &stage),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccStageConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStageExists(resourceName, &stage),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckStageDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).IVSRealTimeConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ivs_stage" {
+			continue
+		}
+
+		_, err := tfivs.FindStageByARN(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return names.Error(names.IVS, names.ErrActionCheckingDestroyed, tfivs.ResNameStage, rs.Primary.ID, errors.New("not destroyed"))
+	}
+
+	return nil
+}
+
+func testAccCheckStageExists(name string, v *ivsrealtime.Stage) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return names.Error(names.IVS, names.ErrActionCheckingExistence, tfivs.ResNameStage, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return names.Error(names.IVS, names.ErrActionCheckingExistence, tfivs.ResNameStage, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IVSRealTimeConn
+
+		output, err := tfivs.FindStageByARN(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return names.Error(names.IVS, names.ErrActionCheckingExistence, tfivs.ResNameStage, rs.Primary.ID, err)
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccStageConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ivs_stage" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccStageConfig_autoParticipantRecordingConfiguration(rName, mediaType string) string {
+	return acctest.ConfigCompose(testAccStorageConfigurationConfig_basic(rName), fmt.Sprintf(`
+resource "aws_ivs_stage" "test" {
+  name = %[1]q
+
+  auto_participant_recording_configuration {
+    media_types               = [%[2]q]
+    storage_configuration_arn = aws_ivs_storage_configuration.test.arn
+  }
+}
+`, rName, mediaType))
+}
+
+func testAccStageConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_ivs_stage" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccStageConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_ivs_stage" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,206 @@
+package ivs
+
+import (
+	"context"
+	"errors"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ivsrealtime"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourceStorageConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceStorageConfigurationCreate,
+		ReadWithoutTimeout:   resourceStorageConfigurationRead,
+		UpdateWithoutTimeout: resourceStorageConfigurationUpdate,
+		DeleteWithoutTimeout: resourceStorageConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(0, 128),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9-_]*$`), "must contain only alphanumeric characters, hyphens and underscores"),
+				),
+			},
+			"s3": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(3, 63),
+						},
+					},
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	ResNameStorageConfiguration = "Storage Configuration"
+)
+
+func resourceStorageConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IVSRealTimeConn
+
+	in := &ivsrealtime.CreateStorageConfigurationInput{}
+
+	if v, ok := d.GetOk("name"); ok {
+		in.Name = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("s3"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		in.S3 = expandS3StorageConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	if len(tags) > 0 {
+		in.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	out, err := conn.CreateStorageConfigurationWithContext(ctx, in)
+	if err != nil {
+		return names.DiagError(names.IVS, names.ErrActionCreating, ResNameStorageConfiguration, d.Get("name").(string), err)
+	}
+
+	if out == nil || out.StorageConfiguration == nil {
+		return names.DiagError(names.IVS, names.ErrActionCreating, ResNameStorageConfiguration, d.Get("name").(string), errors.New("empty output"))
+	}
+
+	d.SetId(aws.StringValue(out.StorageConfiguration.Arn))
+
+	return resourceStorageConfigurationRead(ctx, d, meta)
+}
+
+func resourceStorageConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IVSRealTimeConn
+
+	out, err := FindStorageConfigurationByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IVS Storage Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return names.DiagError(names.IVS, names.ErrActionReading, ResNameStorageConfiguration, d.Id(), err)
+	}
+
+	d.Set("arn", out.Arn)
+	d.Set("name", out.Name)
+
+	if err := d.Set("s3", flattenS3StorageConfiguration(out.S3)); err != nil {
+		return names.DiagError(names.IVS, names.ErrActionSetting, ResNameStorageConfiguration, d.Id(), err)
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	tags := KeyValueTags(out.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return names.DiagError(names.IVS, names.ErrActionSetting, ResNameStorageConfiguration, d.Id(), err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return names.DiagError(names.IVS, names.ErrActionSetting, ResNameStorageConfiguration, d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceStorageConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IVSRealTimeConn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := updateRealTimeTags(ctx, conn, d.Id(), o, n); err != nil {
+			return names.DiagError(names.IVS, names.ErrActionUpdating, ResNameStorageConfiguration, d.Id(), err)
+		}
+	}
+
+	return resourceStorageConfigurationRead(ctx, d, meta)
+}
+
+func resourceStorageConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IVSRealTimeConn
+
+	log.Printf("[INFO] Deleting IVS Storage Configuration %s", d.Id())
+
+	_, err := conn.DeleteStorageConfigurationWithContext(ctx, &ivsrealtime.DeleteStorageConfigurationInput{
+		Arn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ivsrealtime.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return names.DiagError(names.IVS, names.ErrActionDeleting, ResNameStorageConfiguration, d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandS3StorageConfiguration(tfMap map[string]interface{}) *ivsrealtime.S3StorageConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ivsrealtime.S3StorageConfiguration{}
+
+	if v, ok := tfMap["bucket_name"].(string); ok && v != "" {
+		apiObject.BucketName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenS3StorageConfiguration(apiObject *ivsrealtime.S3StorageConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"bucket_name": aws.StringValue(apiObject.BucketName),
+	}
+
+	return []interface{}{tfMap}
+}
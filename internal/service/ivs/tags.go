@@ -0,0 +1,51 @@
+//go:build !generate
+// +build !generate
+
+package ivs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ivsrealtime"
+	"github.com/aws/aws-sdk-go/service/ivsrealtime/ivsrealtimeiface"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+// Custom IVS real-time tag service update functions using the same format as generated code.
+
+// updateRealTimeTags updates IVS real-time resource tags.
+// The identifier is the resource ARN.
+func updateRealTimeTags(ctx context.Context, conn ivsrealtimeiface.IVSRealTimeAPI, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := tftags.New(oldTagsMap)
+	newTags := tftags.New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
+		input := &ivsrealtime.UntagResourceInput{
+			ResourceArn: aws.String(identifier),
+			TagKeys:     aws.StringSlice(removedTags.IgnoreAWS().Keys()),
+		}
+
+		_, err := conn.UntagResourceWithContext(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("untagging resource (%s): %w", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags); len(updatedTags) > 0 {
+		input := &ivsrealtime.TagResourceInput{
+			ResourceArn: aws.String(identifier),
+			Tags:        Tags(updatedTags.IgnoreAWS()),
+		}
+
+		_, err := conn.TagResourceWithContext(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("tagging resource (%s): %w", identifier, err)
+		}
+	}
+
+	return nil
+}
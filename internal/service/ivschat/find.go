@@ -0,0 +1,61 @@
+package ivschat
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ivschat"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindLoggingConfigurationByID(ctx context.Context, conn *ivschat.Ivschat, id string) (*ivschat.GetLoggingConfigurationOutput, error) {
+	input := &ivschat.GetLoggingConfigurationInput{
+		Identifier: aws.String(id),
+	}
+
+	output, err := conn.GetLoggingConfigurationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, ivschat.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindRoomByID(ctx context.Context, conn *ivschat.Ivschat, id string) (*ivschat.GetRoomOutput, error) {
+	input := &ivschat.GetRoomInput{
+		Identifier: aws.String(id),
+	}
+
+	output, err := conn.GetRoomWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, ivschat.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
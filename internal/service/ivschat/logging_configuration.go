@@ -0,0 +1,325 @@
+package ivschat
+
+import (
+	"context"
+	"errors"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ivschat"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourceLoggingConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceLoggingConfigurationCreate,
+		ReadWithoutTimeout:   resourceLoggingConfigurationRead,
+		UpdateWithoutTimeout: resourceLoggingConfigurationUpdate,
+		DeleteWithoutTimeout: resourceLoggingConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"destination_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cloudwatch_logs": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"destination_configuration.0.cloudwatch_logs", "destination_configuration.0.firehose", "destination_configuration.0.s3"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"log_group_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 512),
+									},
+								},
+							},
+						},
+						"firehose": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"destination_configuration.0.cloudwatch_logs", "destination_configuration.0.firehose", "destination_configuration.0.s3"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"delivery_stream_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 64),
+									},
+								},
+							},
+						},
+						"s3": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"destination_configuration.0.cloudwatch_logs", "destination_configuration.0.firehose", "destination_configuration.0.s3"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bucket_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(3, 63),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(0, 128),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9-_]*$`), "must contain only alphanumeric characters, hyphens and underscores"),
+				),
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	ResNameLoggingConfiguration = "Logging Configuration"
+)
+
+func resourceLoggingConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IVSChatConn
+
+	in := &ivschat.CreateLoggingConfigurationInput{}
+
+	if v, ok := d.GetOk("destination_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		in.DestinationConfiguration = expandDestinationConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("name"); ok {
+		in.Name = aws.String(v.(string))
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	if len(tags) > 0 {
+		in.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	out, err := conn.CreateLoggingConfigurationWithContext(ctx, in)
+	if err != nil {
+		return names.DiagError(names.IVSChat, names.ErrActionCreating, ResNameLoggingConfiguration, d.Get("name").(string), err)
+	}
+
+	if out == nil {
+		return names.DiagError(names.IVSChat, names.ErrActionCreating, ResNameLoggingConfiguration, d.Get("name").(string), errors.New("empty output"))
+	}
+
+	d.SetId(aws.StringValue(out.Arn))
+
+	if _, err := waitLoggingConfigurationCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return names.DiagError(names.IVSChat, names.ErrActionWaitingForCreation, ResNameLoggingConfiguration, d.Id(), err)
+	}
+
+	return resourceLoggingConfigurationRead(ctx, d, meta)
+}
+
+func resourceLoggingConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IVSChatConn
+
+	out, err := FindLoggingConfigurationByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IVS Chat Logging Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return names.DiagError(names.IVSChat, names.ErrActionReading, ResNameLoggingConfiguration, d.Id(), err)
+	}
+
+	d.Set("arn", out.Arn)
+	d.Set("name", out.Name)
+	d.Set("state", out.State)
+
+	if err := d.Set("destination_configuration", flattenDestinationConfiguration(out.DestinationConfiguration)); err != nil {
+		return names.DiagError(names.IVSChat, names.ErrActionSetting, ResNameLoggingConfiguration, d.Id(), err)
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	tags := KeyValueTags(out.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return names.DiagError(names.IVSChat, names.ErrActionSetting, ResNameLoggingConfiguration, d.Id(), err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return names.DiagError(names.IVSChat, names.ErrActionSetting, ResNameLoggingConfiguration, d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceLoggingConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IVSChatConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		in := &ivschat.UpdateLoggingConfigurationInput{
+			Identifier: aws.String(d.Id()),
+		}
+
+		if d.HasChange("destination_configuration") {
+			if v, ok := d.GetOk("destination_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				in.DestinationConfiguration = expandDestinationConfiguration(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("name") {
+			in.Name = aws.String(d.Get("name").(string))
+		}
+
+		log.Printf("[DEBUG] Updating IVS Chat Logging Configuration (%s): %s", d.Id(), in)
+		_, err := conn.UpdateLoggingConfigurationWithContext(ctx, in)
+		if err != nil {
+			return names.DiagError(names.IVSChat, names.ErrActionUpdating, ResNameLoggingConfiguration, d.Id(), err)
+		}
+
+		if _, err := waitLoggingConfigurationUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return names.DiagError(names.IVSChat, names.ErrActionWaitingForUpdate, ResNameLoggingConfiguration, d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Id(), o, n); err != nil {
+			return names.DiagError(names.IVSChat, names.ErrActionUpdating, ResNameLoggingConfiguration, d.Id(), err)
+		}
+	}
+
+	return resourceLoggingConfigurationRead(ctx, d, meta)
+}
+
+func resourceLoggingConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IVSChatConn
+
+	log.Printf("[INFO] Deleting IVS Chat Logging Configuration %s", d.Id())
+
+	_, err := conn.DeleteLoggingConfigurationWithContext(ctx, &ivschat.DeleteLoggingConfigurationInput{
+		Identifier: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ivschat.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return names.DiagError(names.IVSChat, names.ErrActionDeleting, ResNameLoggingConfiguration, d.Id(), err)
+	}
+
+	if _, err := waitLoggingConfigurationDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return names.DiagError(names.IVSChat, names.ErrActionWaitingForDeletion, ResNameLoggingConfiguration, d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandDestinationConfiguration(tfMap map[string]interface{}) *ivschat.DestinationConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ivschat.DestinationConfiguration{}
+
+	if v, ok := tfMap["cloudwatch_logs"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.CloudWatchLogs = &ivschat.CloudWatchLogsDestinationConfiguration{
+			LogGroupName: aws.String(v[0].(map[string]interface{})["log_group_name"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["firehose"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Firehose = &ivschat.FirehoseDestinationConfiguration{
+			DeliveryStreamName: aws.String(v[0].(map[string]interface{})["delivery_stream_name"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["s3"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.S3 = &ivschat.S3DestinationConfiguration{
+			BucketName: aws.String(v[0].(map[string]interface{})["bucket_name"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func flattenDestinationConfiguration(apiObject *ivschat.DestinationConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.CloudWatchLogs; v != nil {
+		tfMap["cloudwatch_logs"] = []interface{}{
+			map[string]interface{}{
+				"log_group_name": aws.StringValue(v.LogGroupName),
+			},
+		}
+	}
+
+	if v := apiObject.Firehose; v != nil {
+		tfMap["firehose"] = []interface{}{
+			map[string]interface{}{
+				"delivery_stream_name": aws.StringValue(v.DeliveryStreamName),
+			},
+		}
+	}
+
+	if v := apiObject.S3; v != nil {
+		tfMap["s3"] = []interface{}{
+			map[string]interface{}{
+				"bucket_name": aws.StringValue(v.BucketName),
+			},
+		}
+	}
+
+	return []interface{}{tfMap}
+}
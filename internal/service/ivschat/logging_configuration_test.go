@@ -0,0 +1,279 @@
+package ivschat_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ivschat"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfivschat "github.com/hashicorp/terraform-provider-aws/internal/service/ivschat"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccIVSChatLoggingConfiguration_basic(t *testing.T) {
+	var v ivschat.GetLoggingConfigurationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ivschat_logging_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ivschat.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLoggingConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLoggingConfigurationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLoggingConfigurationExists(resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "ivschat", regexp.MustCompile(`logging-configuration/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "destination_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "destination_configuration.0.cloudwatch_logs.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "destination_configuration.0.cloudwatch_logs.0.log_group_name", "aws_cloudwatch_log_group.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "state", "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIVSChatLoggingConfiguration_disappears(t *testing.T) {
+	var v ivschat.GetLoggingConfigurationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ivschat_logging_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ivschat.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLoggingConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLoggingConfigurationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLoggingConfigurationExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfivschat.ResourceLoggingConfiguration(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccIVSChatLoggingConfiguration_update(t *testing.T) {
+	var v ivschat.GetLoggingConfigurationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ivschat_logging_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ivschat.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLoggingConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLoggingConfigurationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLoggingConfigurationExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "destination_configuration.0.cloudwatch_logs.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "destination_configuration.0.s3.#", "0"),
+				),
+			},
+			{
+				Config: testAccLoggingConfigurationConfig_s3(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLoggingConfigurationExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "destination_configuration.0.cloudwatch_logs.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "destination_configuration.0.s3.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "destination_configuration.0.s3.0.bucket_name", "aws_s3_bucket.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIVSChatLoggingConfiguration_tags(t *testing.T) {
+	var v ivschat.GetLoggingConfigurationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ivschat_logging_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ivschat.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLoggingConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLoggingConfigurationConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLoggingConfigurationExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccLoggingConfigurationConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLoggingConfigurationExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccLoggingConfigurationConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLoggingConfigurationExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLoggingConfigurationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).IVSChatConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ivschat_logging_configuration" {
+			continue
+		}
+
+		_, err := tfivschat.FindLoggingConfigurationByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return names.Error(names.IVSChat, names.ErrActionCheckingDestroyed, tfivschat.ResNameLoggingConfiguration, rs.Primary.ID, errors.New("not destroyed"))
+	}
+
+	return nil
+}
+
+func testAccCheckLoggingConfigurationExists(name string, v *ivschat.GetLoggingConfigurationOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return names.Error(names.IVSChat, names.ErrActionCheckingExistence, tfivschat.ResNameLoggingConfiguration, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return names.Error(names.IVSChat, names.ErrActionCheckingExistence, tfivschat.ResNameLoggingConfiguration, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IVSChatConn
+
+		output, err := tfivschat.FindLoggingConfigurationByID(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return names.Error(names.IVSChat, names.ErrActionCheckingExistence, tfivschat.ResNameLoggingConfiguration, rs.Primary.ID, err)
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccLoggingConfigurationConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_log_group" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccLoggingConfigurationConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccLoggingConfigurationConfig_base(rName), fmt.Sprintf(`
+resource "aws_ivschat_logging_configuration" "test" {
+  name = %[1]q
+
+  destination_configuration {
+    cloudwatch_logs {
+      log_group_name = aws_cloudwatch_log_group.test.name
+    }
+  }
+}
+`, rName))
+}
+
+func testAccLoggingConfigurationConfig_s3(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_ivschat_logging_configuration" "test" {
+  name = %[1]q
+
+  destination_configuration {
+    s3 {
+      bucket_name = aws_s3_bucket.test.id
+    }
+  }
+}
+`, rName)
+}
+
+func testAccLoggingConfigurationConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccLoggingConfigurationConfig_base(rName), fmt.Sprintf(`
+resource "aws_ivschat_logging_configuration" "test" {
+  name = %[1]q
+
+  destination_configuration {
+    cloudwatch_logs {
+      log_group_name = aws_cloudwatch_log_group.test.name
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccLoggingConfigurationConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccLoggingConfigurationConfig_base(rName), fmt.Sprintf(`
+resource "aws_ivschat_logging_configuration" "test" {
+  name = %[1]q
+
+  destination_configuration {
+    cloudwatch_logs {
+      log_group_name = aws_cloudwatch_log_group.test.name
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
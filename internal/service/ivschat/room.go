@@ -0,0 +1,295 @@
+package ivschat
+
+import (
+	"context"
+	"errors"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ivschat"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourceRoom() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRoomCreate,
+		ReadWithoutTimeout:   resourceRoomRead,
+		UpdateWithoutTimeout: resourceRoomUpdate,
+		DeleteWithoutTimeout: resourceRoomDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"logging_configuration_identifiers": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 3,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"maximum_message_length": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 500),
+			},
+			"maximum_message_rate_per_second": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 10),
+			},
+			"message_review_handler": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"fallback_result": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(ivschat.FallbackResult_Values(), false),
+						},
+						"uri": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(0, 128),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9-_]*$`), "must contain only alphanumeric characters, hyphens and underscores"),
+				),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	ResNameRoom = "Room"
+)
+
+func resourceRoomCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IVSChatConn
+
+	in := &ivschat.CreateRoomInput{}
+
+	if v, ok := d.GetOk("logging_configuration_identifiers"); ok && len(v.([]interface{})) > 0 {
+		in.LoggingConfigurationIdentifiers = flex.ExpandStringList(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("maximum_message_length"); ok {
+		in.MaximumMessageLength = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("maximum_message_rate_per_second"); ok {
+		in.MaximumMessageRatePerSecond = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("message_review_handler"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		in.MessageReviewHandler = expandMessageReviewHandler(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("name"); ok {
+		in.Name = aws.String(v.(string))
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	if len(tags) > 0 {
+		in.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	out, err := conn.CreateRoomWithContext(ctx, in)
+	if err != nil {
+		return names.DiagError(names.IVSChat, names.ErrActionCreating, ResNameRoom, d.Get("name").(string), err)
+	}
+
+	if out == nil {
+		return names.DiagError(names.IVSChat, names.ErrActionCreating, ResNameRoom, d.Get("name").(string), errors.New("empty output"))
+	}
+
+	d.SetId(aws.StringValue(out.Arn))
+
+	return resourceRoomRead(ctx, d, meta)
+}
+
+func resourceRoomRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IVSChatConn
+
+	out, err := FindRoomByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IVS Chat Room (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return names.DiagError(names.IVSChat, names.ErrActionReading, ResNameRoom, d.Id(), err)
+	}
+
+	d.Set("arn", out.Arn)
+	d.Set("logging_configuration_identifiers", aws.StringValueSlice(out.LoggingConfigurationIdentifiers))
+	d.Set("maximum_message_length", out.MaximumMessageLength)
+	d.Set("maximum_message_rate_per_second", out.MaximumMessageRatePerSecond)
+	d.Set("name", out.Name)
+
+	if err := d.Set("message_review_handler", flattenMessageReviewHandler(out.MessageReviewHandler)); err != nil {
+		return names.DiagError(names.IVSChat, names.ErrActionSetting, ResNameRoom, d.Id(), err)
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	tags := KeyValueTags(out.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return names.DiagError(names.IVSChat, names.ErrActionSetting, ResNameRoom, d.Id(), err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return names.DiagError(names.IVSChat, names.ErrActionSetting, ResNameRoom, d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceRoomUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IVSChatConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		in := &ivschat.UpdateRoomInput{
+			Identifier: aws.String(d.Id()),
+		}
+
+		if d.HasChange("logging_configuration_identifiers") {
+			// An empty list removes all logging configurations from the room.
+			in.LoggingConfigurationIdentifiers = flex.ExpandStringList(d.Get("logging_configuration_identifiers").([]interface{}))
+
+			if in.LoggingConfigurationIdentifiers == nil {
+				in.LoggingConfigurationIdentifiers = []*string{}
+			}
+		}
+
+		if d.HasChange("maximum_message_length") {
+			in.MaximumMessageLength = aws.Int64(int64(d.Get("maximum_message_length").(int)))
+		}
+
+		if d.HasChange("maximum_message_rate_per_second") {
+			in.MaximumMessageRatePerSecond = aws.Int64(int64(d.Get("maximum_message_rate_per_second").(int)))
+		}
+
+		if d.HasChange("message_review_handler") {
+			if v, ok := d.GetOk("message_review_handler"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				in.MessageReviewHandler = expandMessageReviewHandler(v.([]interface{})[0].(map[string]interface{}))
+			} else {
+				// An empty URI disables message review.
+				in.MessageReviewHandler = &ivschat.MessageReviewHandler{
+					Uri: aws.String(""),
+				}
+			}
+		}
+
+		if d.HasChange("name") {
+			in.Name = aws.String(d.Get("name").(string))
+		}
+
+		log.Printf("[DEBUG] Updating IVS Chat Room (%s): %s", d.Id(), in)
+		_, err := conn.UpdateRoomWithContext(ctx, in)
+		if err != nil {
+			return names.DiagError(names.IVSChat, names.ErrActionUpdating, ResNameRoom, d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Id(), o, n); err != nil {
+			return names.DiagError(names.IVSChat, names.ErrActionUpdating, ResNameRoom, d.Id(), err)
+		}
+	}
+
+	return resourceRoomRead(ctx, d, meta)
+}
+
+func resourceRoomDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IVSChatConn
+
+	log.Printf("[INFO] Deleting IVS Chat Room %s", d.Id())
+
+	_, err := conn.DeleteRoomWithContext(ctx, &ivschat.DeleteRoomInput{
+		Identifier: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ivschat.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return names.DiagError(names.IVSChat, names.ErrActionDeleting, ResNameRoom, d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandMessageReviewHandler(tfMap map[string]interface{}) *ivschat.MessageReviewHandler {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ivschat.MessageReviewHandler{}
+
+	if v, ok := tfMap["fallback_result"].(string); ok && v != "" {
+		apiObject.FallbackResult = aws.String(v)
+	}
+
+	if v, ok := tfMap["uri"].(string); ok && v != "" {
+		apiObject.Uri = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenMessageReviewHandler(apiObject *ivschat.MessageReviewHandler) []interface{} {
+	if apiObject == nil || aws.StringValue(apiObject.Uri) == "" {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"fallback_result": aws.StringValue(apiObject.FallbackResult),
+		"uri":             aws.StringValue(apiObject.Uri),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,252 @@
+package ivschat_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ivschat"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfivschat "github.com/hashicorp/terraform-provider-aws/internal/service/ivschat"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccIVSChatRoom_basic(t *testing.T) {
+	var room ivschat.GetRoomOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ivschat_room.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ivschat.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRoomDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoomConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoomExists(resourceName, &room),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "ivschat", regexp.MustCompile(`room/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "logging_configuration_identifiers.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "maximum_message_length"),
+					resource.TestCheckResourceAttrSet(resourceName, "maximum_message_rate_per_second"),
+					resource.TestCheckResourceAttr(resourceName, "message_review_handler.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIVSChatRoom_disappears(t *testing.T) {
+	var room ivschat.GetRoomOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ivschat_room.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ivschat.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRoomDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoomConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoomExists(resourceName, &room),
+					acctest.CheckResourceDisappears(acctest.Provider, tfivschat.ResourceRoom(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccIVSChatRoom_update(t *testing.T) {
+	var room ivschat.GetRoomOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ivschat_room.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ivschat.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRoomDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoomConfig_update(rName, 100, 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoomExists(resourceName, &room),
+					resource.TestCheckResourceAttr(resourceName, "logging_configuration_identifiers.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "logging_configuration_identifiers.0", "aws_ivschat_logging_configuration.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "maximum_message_length", "100"),
+					resource.TestCheckResourceAttr(resourceName, "maximum_message_rate_per_second", "5"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRoomConfig_update(rName, 200, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoomExists(resourceName, &room),
+					resource.TestCheckResourceAttr(resourceName, "maximum_message_length", "200"),
+					resource.TestCheckResourceAttr(resourceName, "maximum_message_rate_per_second", "10"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIVSChatRoom_tags(t *testing.T) {
+	var room ivschat.GetRoomOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ivschat_room.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ivschat.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRoomDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoomConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoomExists(resourceName, &room),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRoomConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoomExists(resourceName, &room),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccRoomConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoomExists(resourceName, &room),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckRoomDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).IVSChatConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ivschat_room" {
+			continue
+		}
+
+		_, err := tfivschat.FindRoomByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return names.Error(names.IVSChat, names.ErrActionCheckingDestroyed, tfivschat.ResNameRoom, rs.Primary.ID, errors.New("not destroyed"))
+	}
+
+	return nil
+}
+
+func testAccCheckRoomExists(name string, v *ivschat.GetRoomOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return names.Error(names.IVSChat, names.ErrActionCheckingExistence, tfivschat.ResNameRoom, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return names.Error(names.IVSChat, names.ErrActionCheckingExistence, tfivschat.ResNameRoom, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IVSChatConn
+
+		output, err := tfivschat.FindRoomByID(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return names.Error(names.IVSChat, names.ErrActionCheckingExistence, tfivschat.ResNameRoom, rs.Primary.ID, err)
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccRoomConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ivschat_room" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccRoomConfig_update(rName string, maximumMessageLength, maximumMessageRatePerSecond int) string {
+	return acctest.ConfigCompose(testAccLoggingConfigurationConfig_basic(rName), fmt.Sprintf(`
+resource "aws_ivschat_room" "test" {
+  name = %[1]q
+
+  logging_configuration_identifiers = [aws_ivschat_logging_configuration.test.arn]
+  maximum_message_length            = %[2]d
+  maximum_message_rate_per_second   = %[3]d
+}
+`, rName, maximumMessageLength, maximumMessageRatePerSecond))
+}
+
+func testAccRoomConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_ivschat_room" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccRoomConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_ivschat_room" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
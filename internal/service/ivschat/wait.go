@@ -0,0 +1,72 @@
+package ivschat
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/ivschat"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func waitLoggingConfigurationCreated(ctx context.Context, conn *ivschat.Ivschat, id string, timeout time.Duration) (*ivschat.GetLoggingConfigurationOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			ivschat.LoggingConfigurationStateCreating,
+		},
+		Target: []string{
+			ivschat.LoggingConfigurationStateActive,
+		},
+		Refresh:        statusLoggingConfiguration(ctx, conn, id),
+		Timeout:        timeout,
+		NotFoundChecks: 20,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ivschat.GetLoggingConfigurationOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitLoggingConfigurationUpdated(ctx context.Context, conn *ivschat.Ivschat, id string, timeout time.Duration) (*ivschat.GetLoggingConfigurationOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			ivschat.LoggingConfigurationStateUpdating,
+		},
+		Target: []string{
+			ivschat.LoggingConfigurationStateActive,
+		},
+		Refresh: statusLoggingConfiguration(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ivschat.GetLoggingConfigurationOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitLoggingConfigurationDeleted(ctx context.Context, conn *ivschat.Ivschat, id string, timeout time.Duration) (*ivschat.GetLoggingConfigurationOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			ivschat.LoggingConfigurationStateActive,
+			ivschat.LoggingConfigurationStateDeleting,
+		},
+		Target:  []string{},
+		Refresh: statusLoggingConfiguration(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ivschat.GetLoggingConfigurationOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
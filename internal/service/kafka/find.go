@@ -102,3 +102,53 @@ func FindScramSecrets(conn *kafka.Kafka, clusterArn string) ([]*string, error) {
 
 	return scramSecrets, err
 }
+
+func FindReplicatorByARN(ctx context.Context, conn *kafka.Kafka, arn string) (*kafka.DescribeReplicatorOutput, error) {
+	input := &kafka.DescribeReplicatorInput{
+		ReplicatorArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeReplicatorWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, kafka.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindVPCConnectionByARN(ctx context.Context, conn *kafka.Kafka, arn string) (*kafka.DescribeVpcConnectionOutput, error) {
+	input := &kafka.DescribeVpcConnectionInput{
+		Arn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeVpcConnectionWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, kafka.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
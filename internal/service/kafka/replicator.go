@@ -0,0 +1,734 @@
+package kafka
+
+import (
+	"context"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kafka"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceReplicator() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceReplicatorCreate,
+		ReadWithoutTimeout:   resourceReplicatorRead,
+		UpdateWithoutTimeout: resourceReplicatorUpdate,
+		DeleteWithoutTimeout: resourceReplicatorDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(120 * time.Minute),
+			Update: schema.DefaultTimeout(120 * time.Minute),
+			Delete: schema.DefaultTimeout(120 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"current_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"kafka_cluster": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 2,
+				MaxItems: 2,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"amazon_msk_cluster": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"msk_cluster_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						"vpc_config": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"security_groups_ids": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"subnet_ids": {
+										Type:     schema.TypeSet,
+										Required: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"replication_info_list": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"consumer_group_replication": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"consumer_groups_to_exclude": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringLenBetween(1, 256),
+										},
+									},
+									"consumer_groups_to_replicate": {
+										Type:     schema.TypeSet,
+										Required: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringLenBetween(1, 256),
+										},
+									},
+									"detect_and_copy_new_consumer_groups": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  true,
+									},
+									"synchronise_consumer_group_offsets": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  true,
+									},
+								},
+							},
+						},
+						"source_kafka_cluster_alias": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source_kafka_cluster_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"target_compression_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(kafka.TargetCompressionType_Values(), false),
+						},
+						"target_kafka_cluster_alias": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"target_kafka_cluster_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"topic_replication": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"copy_access_control_lists_for_topics": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  true,
+									},
+									"copy_topic_configurations": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  true,
+									},
+									"detect_and_copy_new_topics": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  true,
+									},
+									"starting_position": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"type": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													Computed:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.StringInSlice(kafka.ReplicationStartingPositionType_Values(), false),
+												},
+											},
+										},
+									},
+									"topics_to_exclude": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringLenBetween(1, 249),
+										},
+									},
+									"topics_to_replicate": {
+										Type:     schema.TypeSet,
+										Required: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringLenBetween(1, 249),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"replicator_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 128),
+					validation.StringMatch(regexp.MustCompile(`^[0-9A-Za-z][0-9A-Za-z-]{0,}$`), "must only contain alphanumeric characters and hyphens"),
+				),
+			},
+			"service_execution_role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceReplicatorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).KafkaConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("replicator_name").(string)
+	input := &kafka.CreateReplicatorInput{
+		KafkaClusters:           expandKafkaClusters(d.Get("kafka_cluster").([]interface{})),
+		ReplicationInfoList:     expandReplicationInfoList(d.Get("replication_info_list").([]interface{})),
+		ReplicatorName:          aws.String(name),
+		ServiceExecutionRoleArn: aws.String(d.Get("service_execution_role_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating MSK Replicator: %s", input)
+	output, err := conn.CreateReplicatorWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating MSK Replicator (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.ReplicatorArn))
+
+	if _, err := waitReplicatorCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for MSK Replicator (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceReplicatorRead(ctx, d, meta)
+}
+
+func resourceReplicatorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).KafkaConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindReplicatorByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MSK Replicator (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading MSK Replicator (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.ReplicatorArn)
+	d.Set("current_version", output.CurrentVersion)
+	d.Set("description", output.ReplicatorDescription)
+	if err := d.Set("kafka_cluster", flattenKafkaClusterDescriptions(output.KafkaClusters)); err != nil {
+		return diag.Errorf("setting kafka_cluster: %s", err)
+	}
+	// DescribeReplicator only reports cluster aliases in the replication information.
+	clusterARNsByAlias := make(map[string]string)
+	for _, v := range output.KafkaClusters {
+		if v == nil || v.AmazonMskCluster == nil {
+			continue
+		}
+
+		clusterARNsByAlias[aws.StringValue(v.KafkaClusterAlias)] = aws.StringValue(v.AmazonMskCluster.MskClusterArn)
+	}
+	if err := d.Set("replication_info_list", flattenReplicationInfoDescriptions(output.ReplicationInfoList, clusterARNsByAlias)); err != nil {
+		return diag.Errorf("setting replication_info_list: %s", err)
+	}
+	d.Set("replicator_name", output.ReplicatorName)
+	d.Set("service_execution_role_arn", output.ServiceExecutionRoleArn)
+
+	tags := KeyValueTags(output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceReplicatorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).KafkaConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &kafka.UpdateReplicationInfoInput{
+			CurrentVersion:        aws.String(d.Get("current_version").(string)),
+			ReplicatorArn:         aws.String(d.Id()),
+			SourceKafkaClusterArn: aws.String(d.Get("replication_info_list.0.source_kafka_cluster_arn").(string)),
+			TargetKafkaClusterArn: aws.String(d.Get("replication_info_list.0.target_kafka_cluster_arn").(string)),
+		}
+
+		if d.HasChange("replication_info_list.0.consumer_group_replication") {
+			if v, ok := d.GetOk("replication_info_list.0.consumer_group_replication"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.ConsumerGroupReplication = expandConsumerGroupReplicationUpdate(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("replication_info_list.0.topic_replication") {
+			if v, ok := d.GetOk("replication_info_list.0.topic_replication"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.TopicReplication = expandTopicReplicationUpdate(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		log.Printf("[DEBUG] Updating MSK Replicator replication info: %s", input)
+		_, err := conn.UpdateReplicationInfoWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating MSK Replicator (%s) replication info: %s", d.Id(), err)
+		}
+
+		if _, err := waitReplicatorUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("waiting for MSK Replicator (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
+			return diag.Errorf("updating MSK Replicator (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceReplicatorRead(ctx, d, meta)
+}
+
+func resourceReplicatorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).KafkaConn
+
+	log.Printf("[DEBUG] Deleting MSK Replicator: %s", d.Id())
+	_, err := conn.DeleteReplicatorWithContext(ctx, &kafka.DeleteReplicatorInput{
+		ReplicatorArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, kafka.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting MSK Replicator (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitReplicatorDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for MSK Replicator (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandKafkaClusters(tfList []interface{}) []*kafka.KafkaCluster {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*kafka.KafkaCluster
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &kafka.KafkaCluster{}
+
+		if v, ok := tfMap["amazon_msk_cluster"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.AmazonMskCluster = &kafka.AmazonMskCluster{
+				MskClusterArn: aws.String(v[0].(map[string]interface{})["msk_cluster_arn"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["vpc_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.VpcConfig = expandKafkaClusterClientVPCConfig(v[0].(map[string]interface{}))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandKafkaClusterClientVPCConfig(tfMap map[string]interface{}) *kafka.KafkaClusterClientVpcConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &kafka.KafkaClusterClientVpcConfig{}
+
+	if v, ok := tfMap["security_groups_ids"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SecurityGroupIds = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["subnet_ids"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SubnetIds = flex.ExpandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func expandReplicationInfoList(tfList []interface{}) []*kafka.ReplicationInfo {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*kafka.ReplicationInfo
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &kafka.ReplicationInfo{}
+
+		if v, ok := tfMap["consumer_group_replication"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.ConsumerGroupReplication = expandConsumerGroupReplication(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["source_kafka_cluster_arn"].(string); ok && v != "" {
+			apiObject.SourceKafkaClusterArn = aws.String(v)
+		}
+
+		if v, ok := tfMap["target_compression_type"].(string); ok && v != "" {
+			apiObject.TargetCompressionType = aws.String(v)
+		}
+
+		if v, ok := tfMap["target_kafka_cluster_arn"].(string); ok && v != "" {
+			apiObject.TargetKafkaClusterArn = aws.String(v)
+		}
+
+		if v, ok := tfMap["topic_replication"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.TopicReplication = expandTopicReplication(v[0].(map[string]interface{}))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandConsumerGroupReplication(tfMap map[string]interface{}) *kafka.ConsumerGroupReplication {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &kafka.ConsumerGroupReplication{}
+
+	if v, ok := tfMap["consumer_groups_to_exclude"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ConsumerGroupsToExclude = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["consumer_groups_to_replicate"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ConsumerGroupsToReplicate = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["detect_and_copy_new_consumer_groups"].(bool); ok {
+		apiObject.DetectAndCopyNewConsumerGroups = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["synchronise_consumer_group_offsets"].(bool); ok {
+		apiObject.SynchroniseConsumerGroupOffsets = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func expandConsumerGroupReplicationUpdate(tfMap map[string]interface{}) *kafka.ConsumerGroupReplicationUpdate {
+	if tfMap == nil {
+		return nil
+	}
+
+	// All fields are required on update.
+	apiObject := &kafka.ConsumerGroupReplicationUpdate{
+		ConsumerGroupsToExclude:         aws.StringSlice([]string{}),
+		ConsumerGroupsToReplicate:       aws.StringSlice([]string{}),
+		DetectAndCopyNewConsumerGroups:  aws.Bool(tfMap["detect_and_copy_new_consumer_groups"].(bool)),
+		SynchroniseConsumerGroupOffsets: aws.Bool(tfMap["synchronise_consumer_group_offsets"].(bool)),
+	}
+
+	if v, ok := tfMap["consumer_groups_to_exclude"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ConsumerGroupsToExclude = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["consumer_groups_to_replicate"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ConsumerGroupsToReplicate = flex.ExpandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func expandTopicReplication(tfMap map[string]interface{}) *kafka.TopicReplication {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &kafka.TopicReplication{}
+
+	if v, ok := tfMap["copy_access_control_lists_for_topics"].(bool); ok {
+		apiObject.CopyAccessControlListsForTopics = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["copy_topic_configurations"].(bool); ok {
+		apiObject.CopyTopicConfigurations = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["detect_and_copy_new_topics"].(bool); ok {
+		apiObject.DetectAndCopyNewTopics = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["starting_position"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		if v, ok := v[0].(map[string]interface{})["type"].(string); ok && v != "" {
+			apiObject.StartingPosition = &kafka.ReplicationStartingPosition{
+				Type: aws.String(v),
+			}
+		}
+	}
+
+	if v, ok := tfMap["topics_to_exclude"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.TopicsToExclude = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["topics_to_replicate"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.TopicsToReplicate = flex.ExpandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func expandTopicReplicationUpdate(tfMap map[string]interface{}) *kafka.TopicReplicationUpdate {
+	if tfMap == nil {
+		return nil
+	}
+
+	// All fields are required on update.
+	apiObject := &kafka.TopicReplicationUpdate{
+		CopyAccessControlListsForTopics: aws.Bool(tfMap["copy_access_control_lists_for_topics"].(bool)),
+		CopyTopicConfigurations:         aws.Bool(tfMap["copy_topic_configurations"].(bool)),
+		DetectAndCopyNewTopics:          aws.Bool(tfMap["detect_and_copy_new_topics"].(bool)),
+		TopicsToExclude:                 aws.StringSlice([]string{}),
+		TopicsToReplicate:               aws.StringSlice([]string{}),
+	}
+
+	if v, ok := tfMap["topics_to_exclude"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.TopicsToExclude = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["topics_to_replicate"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.TopicsToReplicate = flex.ExpandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func flattenKafkaClusterDescriptions(apiObjects []*kafka.KafkaClusterDescription) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.AmazonMskCluster; v != nil {
+			tfMap["amazon_msk_cluster"] = []interface{}{map[string]interface{}{
+				"msk_cluster_arn": aws.StringValue(v.MskClusterArn),
+			}}
+		}
+
+		if v := apiObject.VpcConfig; v != nil {
+			tfMap["vpc_config"] = []interface{}{map[string]interface{}{
+				"security_groups_ids": aws.StringValueSlice(v.SecurityGroupIds),
+				"subnet_ids":          aws.StringValueSlice(v.SubnetIds),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenReplicationInfoDescriptions(apiObjects []*kafka.ReplicationInfoDescription, clusterARNsByAlias map[string]string) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"source_kafka_cluster_alias": aws.StringValue(apiObject.SourceKafkaClusterAlias),
+			"source_kafka_cluster_arn":   clusterARNsByAlias[aws.StringValue(apiObject.SourceKafkaClusterAlias)],
+			"target_compression_type":    aws.StringValue(apiObject.TargetCompressionType),
+			"target_kafka_cluster_alias": aws.StringValue(apiObject.TargetKafkaClusterAlias),
+			"target_kafka_cluster_arn":   clusterARNsByAlias[aws.StringValue(apiObject.TargetKafkaClusterAlias)],
+		}
+
+		if v := apiObject.ConsumerGroupReplication; v != nil {
+			tfMap["consumer_group_replication"] = []interface{}{flattenConsumerGroupReplication(v)}
+		}
+
+		if v := apiObject.TopicReplication; v != nil {
+			tfMap["topic_replication"] = []interface{}{flattenTopicReplication(v)}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenConsumerGroupReplication(apiObject *kafka.ConsumerGroupReplication) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"consumer_groups_to_exclude":   aws.StringValueSlice(apiObject.ConsumerGroupsToExclude),
+		"consumer_groups_to_replicate": aws.StringValueSlice(apiObject.ConsumerGroupsToReplicate),
+	}
+
+	if v := apiObject.DetectAndCopyNewConsumerGroups; v != nil {
+		tfMap["detect_and_copy_new_consumer_groups"] = aws.BoolValue(v)
+	}
+
+	if v := apiObject.SynchroniseConsumerGroupOffsets; v != nil {
+		tfMap["synchronise_consumer_group_offsets"] = aws.BoolValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenTopicReplication(apiObject *kafka.TopicReplication) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"topics_to_exclude":   aws.StringValueSlice(apiObject.TopicsToExclude),
+		"topics_to_replicate": aws.StringValueSlice(apiObject.TopicsToReplicate),
+	}
+
+	if v := apiObject.CopyAccessControlListsForTopics; v != nil {
+		tfMap["copy_access_control_lists_for_topics"] = aws.BoolValue(v)
+	}
+
+	if v := apiObject.CopyTopicConfigurations; v != nil {
+		tfMap["copy_topic_configurations"] = aws.BoolValue(v)
+	}
+
+	if v := apiObject.DetectAndCopyNewTopics; v != nil {
+		tfMap["detect_and_copy_new_topics"] = aws.BoolValue(v)
+	}
+
+	if v := apiObject.StartingPosition; v != nil {
+		tfMap["starting_position"] = []interface{}{map[string]interface{}{
+			"type": aws.StringValue(v.Type),
+		}}
+	}
+
+	return tfMap
+}
@@ -56,3 +56,35 @@ func statusConfigurationState(conn *kafka.Kafka, arn string) resource.StateRefre
 		return output, aws.StringValue(output.State), nil
 	}
 }
+
+func statusReplicatorState(ctx context.Context, conn *kafka.Kafka, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindReplicatorByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.ReplicatorState), nil
+	}
+}
+
+func statusVPCConnectionState(ctx context.Context, conn *kafka.Kafka, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindVPCConnectionByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
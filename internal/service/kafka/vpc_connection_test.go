@@ -0,0 +1,162 @@
+package kafka_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/kafka"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfkafka "github.com/hashicorp/terraform-provider-aws/internal/service/kafka"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccKafkaVPCConnection_basic(t *testing.T) {
+	var v kafka.DescribeVpcConnectionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_msk_vpc_connection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, kafka.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCConnectionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCConnectionExists(resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "kafka", regexp.MustCompile(`vpc-connection/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "authentication", "SASL_IAM"),
+					resource.TestCheckResourceAttr(resourceName, "client_subnets.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "security_groups.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "target_cluster_arn", "aws_msk_cluster.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "vpc_id", "aws_vpc.client", "id"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccKafkaVPCConnection_disappears(t *testing.T) {
+	var v kafka.DescribeVpcConnectionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_msk_vpc_connection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, kafka.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCConnectionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCConnectionExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfkafka.ResourceVPCConnection(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckVPCConnectionDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).KafkaConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_msk_vpc_connection" {
+			continue
+		}
+
+		_, err := tfkafka.FindVPCConnectionByARN(context.TODO(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("MSK VPC Connection %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckVPCConnectionExists(n string, v *kafka.DescribeVpcConnectionOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No MSK VPC Connection ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KafkaConn
+
+		output, err := tfkafka.FindVPCConnectionByARN(context.TODO(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccVPCConnectionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccClusterConfig_brokerNodeGroupInfoVPCConnectivity(rName, true), fmt.Sprintf(`
+resource "aws_vpc" "client" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "client" {
+  count = 3
+
+  vpc_id            = aws_vpc.client.id
+  cidr_block        = cidrsubnet(aws_vpc.client.cidr_block, 8, count.index)
+  availability_zone = data.aws_availability_zones.available.names[count.index]
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_security_group" "client" {
+  vpc_id = aws_vpc.client.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_msk_vpc_connection" "test" {
+  authentication     = "SASL_IAM"
+  target_cluster_arn = aws_msk_cluster.test.arn
+  vpc_id             = aws_vpc.client.id
+  client_subnets     = aws_subnet.client[*].id
+  security_groups    = [aws_security_group.client.id]
+}
+`, rName))
+}
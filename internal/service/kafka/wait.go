@@ -94,3 +94,100 @@ func waitConfigurationDeleted(conn *kafka.Kafka, arn string) (*kafka.DescribeCon
 
 	return nil, err
 }
+
+func waitReplicatorCreated(ctx context.Context, conn *kafka.Kafka, arn string, timeout time.Duration) (*kafka.DescribeReplicatorOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kafka.ReplicatorStateCreating},
+		Target:  []string{kafka.ReplicatorStateRunning},
+		Refresh: statusReplicatorState(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*kafka.DescribeReplicatorOutput); ok {
+		if state, stateInfo := aws.StringValue(output.ReplicatorState), output.StateInfo; state == kafka.ReplicatorStateFailed && stateInfo != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(stateInfo.Code), aws.StringValue(stateInfo.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitReplicatorUpdated(ctx context.Context, conn *kafka.Kafka, arn string, timeout time.Duration) (*kafka.DescribeReplicatorOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kafka.ReplicatorStateUpdating},
+		Target:  []string{kafka.ReplicatorStateRunning},
+		Refresh: statusReplicatorState(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*kafka.DescribeReplicatorOutput); ok {
+		if state, stateInfo := aws.StringValue(output.ReplicatorState), output.StateInfo; state == kafka.ReplicatorStateFailed && stateInfo != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(stateInfo.Code), aws.StringValue(stateInfo.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitReplicatorDeleted(ctx context.Context, conn *kafka.Kafka, arn string, timeout time.Duration) (*kafka.DescribeReplicatorOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kafka.ReplicatorStateRunning, kafka.ReplicatorStateDeleting},
+		Target:  []string{},
+		Refresh: statusReplicatorState(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*kafka.DescribeReplicatorOutput); ok {
+		if state, stateInfo := aws.StringValue(output.ReplicatorState), output.StateInfo; state == kafka.ReplicatorStateFailed && stateInfo != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(stateInfo.Code), aws.StringValue(stateInfo.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitVPCConnectionCreated(ctx context.Context, conn *kafka.Kafka, arn string, timeout time.Duration) (*kafka.DescribeVpcConnectionOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kafka.VpcConnectionStateCreating},
+		Target:  []string{kafka.VpcConnectionStateAvailable, kafka.VpcConnectionStateInactive},
+		Refresh: statusVPCConnectionState(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*kafka.DescribeVpcConnectionOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitVPCConnectionDeleted(ctx context.Context, conn *kafka.Kafka, arn string, timeout time.Duration) (*kafka.DescribeVpcConnectionOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kafka.VpcConnectionStateAvailable, kafka.VpcConnectionStateInactive, kafka.VpcConnectionStateDeactivating, kafka.VpcConnectionStateDeleting},
+		Target:  []string{},
+		Refresh: statusVPCConnectionState(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*kafka.DescribeVpcConnectionOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,203 @@
+package kinesis_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/kinesis"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfkinesis "github.com/hashicorp/terraform-provider-aws/internal/service/kinesis"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccKinesisResourcePolicy_basic(t *testing.T) {
+	resourceName := "aws_kinesis_resource_policy.test"
+	streamName := "aws_kinesis_stream.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, kinesis.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourcePolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourcePolicyConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourcePolicyExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "resource_arn", streamName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "policy"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccKinesisResourcePolicy_disappears(t *testing.T) {
+	resourceName := "aws_kinesis_resource_policy.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, kinesis.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourcePolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourcePolicyConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourcePolicyExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfkinesis.ResourceResourcePolicy(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccKinesisResourcePolicy_streamConsumer(t *testing.T) {
+	resourceName := "aws_kinesis_resource_policy.test"
+	consumerName := "aws_kinesis_stream_consumer.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, kinesis.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourcePolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourcePolicyConfig_streamConsumer(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourcePolicyExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "resource_arn", consumerName, "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckResourcePolicyDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).KinesisConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_kinesis_resource_policy" {
+			continue
+		}
+
+		_, err := tfkinesis.FindResourcePolicyByARN(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Kinesis Resource Policy %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckResourcePolicyExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Kinesis Resource Policy ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KinesisConn
+
+		_, err := tfkinesis.FindResourcePolicyByARN(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccResourcePolicyBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_kinesis_stream" "test" {
+  name        = %[1]q
+  shard_count = 2
+}
+`, rName)
+}
+
+func testAccResourcePolicyConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccResourcePolicyBaseConfig(rName), `
+resource "aws_kinesis_resource_policy" "test" {
+  resource_arn = aws_kinesis_stream.test.arn
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Sid    = "writestatement"
+      Effect = "Allow"
+      Principal = {
+        AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"
+      }
+      Action = [
+        "kinesis:DescribeStreamSummary",
+        "kinesis:ListShards",
+        "kinesis:PutRecord",
+        "kinesis:PutRecords",
+      ]
+      Resource = aws_kinesis_stream.test.arn
+    }]
+  })
+}
+`)
+}
+
+func testAccResourcePolicyConfig_streamConsumer(rName string) string {
+	return acctest.ConfigCompose(testAccResourcePolicyBaseConfig(rName), fmt.Sprintf(`
+resource "aws_kinesis_stream_consumer" "test" {
+  name       = %[1]q
+  stream_arn = aws_kinesis_stream.test.arn
+}
+
+resource "aws_kinesis_resource_policy" "test" {
+  resource_arn = aws_kinesis_stream_consumer.test.arn
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Sid    = "consumestatement"
+      Effect = "Allow"
+      Principal = {
+        AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"
+      }
+      Action = [
+        "kinesis:DescribeStreamConsumer",
+        "kinesis:SubscribeToShard",
+      ]
+      Resource = aws_kinesis_stream_consumer.test.arn
+    }]
+  })
+}
+`, rName))
+}
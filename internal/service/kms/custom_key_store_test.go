@@ -0,0 +1,179 @@
+package kms_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/kms"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfkms "github.com/hashicorp/terraform-provider-aws/internal/service/kms"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccKMSCustomKeyStore_cloudHSM(t *testing.T) {
+	clusterIDKey := "CLOUD_HSM_CLUSTER_ID"
+	clusterID := os.Getenv(clusterIDKey)
+	if clusterID == "" {
+		t.Skipf("Environment variable %s is not set", clusterIDKey)
+	}
+	trustAnchorCertificateKey := "TRUST_ANCHOR_CERTIFICATE"
+	trustAnchorCertificate := os.Getenv(trustAnchorCertificateKey)
+	if trustAnchorCertificate == "" {
+		t.Skipf("Environment variable %s is not set", trustAnchorCertificateKey)
+	}
+
+	var keyStore kms.CustomKeyStoresListEntry
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kms_custom_key_store.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, kms.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCustomKeyStoreDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomKeyStoreConfig_cloudHSM(rName, clusterID, trustAnchorCertificate),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomKeyStoreExists(resourceName, &keyStore),
+					resource.TestCheckResourceAttr(resourceName, "cloud_hsm_cluster_id", clusterID),
+					resource.TestCheckResourceAttr(resourceName, "connection_state", kms.ConnectionStateTypeDisconnected),
+					resource.TestCheckResourceAttr(resourceName, "custom_key_store_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "custom_key_store_type", kms.CustomKeyStoreTypeAwsCloudhsm),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"key_store_password"},
+			},
+		},
+	})
+}
+
+func TestAccKMSCustomKeyStore_externalKeyStore(t *testing.T) {
+	var keyStore kms.CustomKeyStoresListEntry
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameUpdated := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kms_custom_key_store.test"
+	accessKeyID := sdkacctest.RandStringFromCharSet(20, "ABCDEFGHIJKLMNOPQRSTUVWXYZ234567")
+	secretAccessKey := sdkacctest.RandString(43)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, kms.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCustomKeyStoreDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomKeyStoreConfig_externalKeyStore(rName, accessKeyID, secretAccessKey),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomKeyStoreExists(resourceName, &keyStore),
+					resource.TestCheckResourceAttr(resourceName, "connection_state", kms.ConnectionStateTypeDisconnected),
+					resource.TestCheckResourceAttr(resourceName, "custom_key_store_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "custom_key_store_type", kms.CustomKeyStoreTypeExternalKeyStore),
+					resource.TestCheckResourceAttr(resourceName, "xks_proxy_connectivity", kms.XksProxyConnectivityTypePublicEndpoint),
+					resource.TestCheckResourceAttr(resourceName, "xks_proxy_uri_endpoint", fmt.Sprintf("https://%s.example.com", rName)),
+					resource.TestCheckResourceAttr(resourceName, "xks_proxy_uri_path", "/kms/xks/v1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"xks_proxy_authentication_credential"},
+			},
+			{
+				Config: testAccCustomKeyStoreConfig_externalKeyStore(rNameUpdated, accessKeyID, secretAccessKey),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomKeyStoreExists(resourceName, &keyStore),
+					resource.TestCheckResourceAttr(resourceName, "custom_key_store_name", rNameUpdated),
+					resource.TestCheckResourceAttr(resourceName, "xks_proxy_uri_endpoint", fmt.Sprintf("https://%s.example.com", rNameUpdated)),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCustomKeyStoreDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).KMSConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_kms_custom_key_store" {
+			continue
+		}
+
+		_, err := tfkms.FindCustomKeyStoreByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("KMS Custom Key Store %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckCustomKeyStoreExists(name string, keyStore *kms.CustomKeyStoresListEntry) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No KMS Custom Key Store ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KMSConn
+
+		output, err := tfkms.FindCustomKeyStoreByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*keyStore = *output
+
+		return nil
+	}
+}
+
+func testAccCustomKeyStoreConfig_cloudHSM(rName, clusterID, trustAnchorCertificate string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_custom_key_store" "test" {
+  cloud_hsm_cluster_id     = %[2]q
+  custom_key_store_name    = %[1]q
+  key_store_password       = "noplaintextpasswords1"
+  trust_anchor_certificate = %[3]q
+}
+`, rName, clusterID, trustAnchorCertificate)
+}
+
+func testAccCustomKeyStoreConfig_externalKeyStore(rName, accessKeyID, secretAccessKey string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_custom_key_store" "test" {
+  custom_key_store_name  = %[1]q
+  custom_key_store_type  = "EXTERNAL_KEY_STORE"
+  xks_proxy_connectivity = "PUBLIC_ENDPOINT"
+  xks_proxy_uri_endpoint = "https://%[1]s.example.com"
+  xks_proxy_uri_path     = "/kms/xks/v1"
+
+  xks_proxy_authentication_credential {
+    access_key_id         = %[2]q
+    raw_secret_access_key = %[3]q
+  }
+}
+`, rName, accessKeyID, secretAccessKey)
+}
@@ -101,6 +101,16 @@ func FindKeyPolicyByKeyIDAndPolicyName(conn *kms.KMS, keyID, policyName string)
 }
 
 func FindKeyRotationEnabledByKeyID(conn *kms.KMS, keyID string) (*bool, error) {
+	output, err := FindKeyRotationStatusByKeyID(conn, keyID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output.KeyRotationEnabled, nil
+}
+
+func FindKeyRotationStatusByKeyID(conn *kms.KMS, keyID string) (*kms.GetKeyRotationStatusOutput, error) {
 	input := &kms.GetKeyRotationStatusInput{
 		KeyId: aws.String(keyID),
 	}
@@ -122,5 +132,34 @@ func FindKeyRotationEnabledByKeyID(conn *kms.KMS, keyID string) (*bool, error) {
 		return nil, tfresource.NewEmptyResultError(input)
 	}
 
-	return output.KeyRotationEnabled, nil
+	return output, nil
+}
+
+func FindCustomKeyStoreByID(conn *kms.KMS, id string) (*kms.CustomKeyStoresListEntry, error) {
+	input := &kms.DescribeCustomKeyStoresInput{
+		CustomKeyStoreId: aws.String(id),
+	}
+
+	output, err := conn.DescribeCustomKeyStores(input)
+
+	if tfawserr.ErrCodeEquals(err, kms.ErrCodeCustomKeyStoreNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.CustomKeyStores) == 0 || output.CustomKeyStores[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.CustomKeyStores); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.CustomKeyStores[0], nil
 }
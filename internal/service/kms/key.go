@@ -1,12 +1,15 @@
 package kms
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/kms"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -28,7 +31,10 @@ func ResourceKey() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			resourceKeyCustomizeDiff,
+		),
 
 		Schema: map[string]*schema.Schema{
 			"arn": {
@@ -40,6 +46,12 @@ func ResourceKey() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"custom_key_store_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
 			"customer_master_key_spec": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -85,6 +97,14 @@ func ResourceKey() *schema.Resource {
 				Computed: true,
 				ForceNew: true,
 			},
+			"next_rotation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"on_demand_rotation_start_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"policy": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -92,8 +112,21 @@ func ResourceKey() *schema.Resource {
 				DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
 				ValidateFunc:     validation.StringIsJSON,
 			},
+			"rotation_period_in_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(90, 2560),
+			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
+			"xks_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"custom_key_store_id"},
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
 		},
 	}
 }
@@ -109,6 +142,18 @@ func resourceKeyCreate(d *schema.ResourceData, meta interface{}) error {
 		KeyUsage:                       aws.String(d.Get("key_usage").(string)),
 	}
 
+	if v, ok := d.GetOk("custom_key_store_id"); ok {
+		input.CustomKeyStoreId = aws.String(v.(string))
+
+		// Keys in an external key store are backed by an existing key in the external key manager.
+		if v, ok := d.GetOk("xks_key_id"); ok {
+			input.Origin = aws.String(kms.OriginTypeExternalKeyStore)
+			input.XksKeyId = aws.String(v.(string))
+		} else {
+			input.Origin = aws.String(kms.OriginTypeAwsCloudhsm)
+		}
+	}
+
 	if v, ok := d.GetOk("description"); ok {
 		input.Description = aws.String(v.(string))
 	}
@@ -142,7 +187,7 @@ func resourceKeyCreate(d *schema.ResourceData, meta interface{}) error {
 	d.SetId(aws.StringValue(outputRaw.(*kms.CreateKeyOutput).KeyMetadata.KeyId))
 
 	if enableKeyRotation := d.Get("enable_key_rotation").(bool); enableKeyRotation {
-		if err := updateKeyRotationEnabled(conn, d.Id(), enableKeyRotation); err != nil {
+		if err := updateKeyRotationEnabled(conn, d.Id(), enableKeyRotation, d.Get("rotation_period_in_days").(int)); err != nil {
 			return err
 		}
 	}
@@ -192,6 +237,7 @@ func resourceKeyRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.Set("arn", key.metadata.Arn)
+	d.Set("custom_key_store_id", key.metadata.CustomKeyStoreId)
 	d.Set("customer_master_key_spec", key.metadata.CustomerMasterKeySpec)
 	d.Set("description", key.metadata.Description)
 	d.Set("enable_key_rotation", key.rotation)
@@ -199,6 +245,22 @@ func resourceKeyRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("key_id", key.metadata.KeyId)
 	d.Set("key_usage", key.metadata.KeyUsage)
 	d.Set("multi_region", key.metadata.MultiRegion)
+	if key.nextRotationDate != nil {
+		d.Set("next_rotation_date", aws.TimeValue(key.nextRotationDate).Format(time.RFC3339))
+	} else {
+		d.Set("next_rotation_date", nil)
+	}
+	if key.onDemandRotationStartDate != nil {
+		d.Set("on_demand_rotation_start_date", aws.TimeValue(key.onDemandRotationStartDate).Format(time.RFC3339))
+	} else {
+		d.Set("on_demand_rotation_start_date", nil)
+	}
+	d.Set("rotation_period_in_days", key.rotationPeriodInDays)
+	if key.metadata.XksKeyConfiguration != nil {
+		d.Set("xks_key_id", key.metadata.XksKeyConfiguration.Id)
+	} else {
+		d.Set("xks_key_id", nil)
+	}
 
 	policyToSet, err := verify.SecondJSONUnlessEquivalent(d.Get("policy").(string), key.policy)
 
@@ -232,8 +294,8 @@ func resourceKeyUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	if hasChange, enableKeyRotation := d.HasChange("enable_key_rotation"), d.Get("enable_key_rotation").(bool); hasChange {
-		if err := updateKeyRotationEnabled(conn, d.Id(), enableKeyRotation); err != nil {
+	if hasChange, enableKeyRotation := d.HasChanges("enable_key_rotation", "rotation_period_in_days"), d.Get("enable_key_rotation").(bool); hasChange {
+		if err := updateKeyRotationEnabled(conn, d.Id(), enableKeyRotation, d.Get("rotation_period_in_days").(int)); err != nil {
 			return err
 		}
 	}
@@ -305,11 +367,23 @@ func resourceKeyDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+func resourceKeyCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// The rotation period is only sent to KMS when rotation is enabled.
+	if v := diff.GetRawConfig().GetAttr("rotation_period_in_days"); v.IsKnown() && !v.IsNull() && !diff.Get("enable_key_rotation").(bool) {
+		return fmt.Errorf("rotation_period_in_days can only be set when enable_key_rotation is true")
+	}
+
+	return nil
+}
+
 type kmsKey struct {
-	metadata *kms.KeyMetadata
-	policy   string
-	rotation *bool
-	tags     tftags.KeyValueTags
+	metadata                  *kms.KeyMetadata
+	nextRotationDate          *time.Time
+	onDemandRotationStartDate *time.Time
+	policy                    string
+	rotation                  *bool
+	rotationPeriodInDays      *int64
+	tags                      tftags.KeyValueTags
 }
 
 func findKey(conn *kms.KMS, keyID string, isNewResource bool) (*kmsKey, error) {
@@ -337,11 +411,16 @@ func findKey(conn *kms.KMS, keyID string, isNewResource bool) (*kmsKey, error) {
 		}
 
 		if aws.StringValue(key.metadata.Origin) == kms.OriginTypeAwsKms {
-			key.rotation, err = FindKeyRotationEnabledByKeyID(conn, keyID)
+			rotationStatus, err := FindKeyRotationStatusByKeyID(conn, keyID)
 
 			if err != nil {
-				return nil, fmt.Errorf("error reading KMS Key (%s) rotation enabled: %w", keyID, err)
+				return nil, fmt.Errorf("error reading KMS Key (%s) rotation status: %w", keyID, err)
 			}
+
+			key.nextRotationDate = rotationStatus.NextRotationDate
+			key.onDemandRotationStartDate = rotationStatus.OnDemandRotationStartDate
+			key.rotation = rotationStatus.KeyRotationEnabled
+			key.rotationPeriodInDays = rotationStatus.RotationPeriodInDays
 		}
 
 		key.tags, err = ListTags(conn, keyID)
@@ -460,15 +539,21 @@ func updateKeyPolicy(conn *kms.KMS, keyID string, policy string, bypassPolicyLoc
 	return nil
 }
 
-func updateKeyRotationEnabled(conn *kms.KMS, keyID string, enabled bool) error {
+func updateKeyRotationEnabled(conn *kms.KMS, keyID string, enabled bool, rotationPeriodInDays int) error {
 	updateFunc := func() (interface{}, error) {
 		var err error
 
 		log.Printf("[DEBUG] Updating KMS Key (%s) key rotation enabled: %t", keyID, enabled)
 		if enabled {
-			_, err = conn.EnableKeyRotation(&kms.EnableKeyRotationInput{
+			input := &kms.EnableKeyRotationInput{
 				KeyId: aws.String(keyID),
-			})
+			}
+
+			if rotationPeriodInDays > 0 {
+				input.RotationPeriodInDays = aws.Int64(int64(rotationPeriodInDays))
+			}
+
+			_, err = conn.EnableKeyRotation(input)
 		} else {
 			_, err = conn.DisableKeyRotation(&kms.DisableKeyRotationInput{
 				KeyId: aws.String(keyID),
@@ -485,7 +570,7 @@ func updateKeyRotationEnabled(conn *kms.KMS, keyID string, enabled bool) error {
 	}
 
 	// Wait for propagation since KMS is eventually consistent.
-	err = WaitKeyRotationEnabledPropagated(conn, keyID, enabled)
+	err = WaitKeyRotationEnabledPropagated(conn, keyID, enabled, rotationPeriodInDays)
 
 	if err != nil {
 		return fmt.Errorf("error waiting for KMS Key (%s) key rotation propagation: %w", keyID, err)
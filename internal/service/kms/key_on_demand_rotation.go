@@ -0,0 +1,83 @@
+package kms
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceKeyOnDemandRotation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKeyOnDemandRotationCreate,
+		Read:   resourceKeyOnDemandRotationRead,
+		Delete: resourceKeyOnDemandRotationDelete,
+
+		Schema: map[string]*schema.Schema{
+			"key_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceKeyOnDemandRotationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KMSConn
+
+	keyID := d.Get("key_id").(string)
+	input := &kms.RotateKeyOnDemandInput{
+		KeyId: aws.String(keyID),
+	}
+
+	log.Printf("[DEBUG] Rotating KMS Key on demand: %s", input)
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(PropagationTimeout, func() (interface{}, error) {
+		return conn.RotateKeyOnDemand(input)
+	}, kms.ErrCodeNotFoundException)
+
+	if err != nil {
+		return fmt.Errorf("error rotating KMS Key (%s) on demand: %w", keyID, err)
+	}
+
+	d.SetId(keyID)
+
+	if err := WaitKeyRotatedOnDemand(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for KMS Key (%s) on-demand rotation: %w", d.Id(), err)
+	}
+
+	return resourceKeyOnDemandRotationRead(d, meta)
+}
+
+func resourceKeyOnDemandRotationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KMSConn
+
+	_, err := FindKeyByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] KMS Key (%s) not found, removing on-demand rotation from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading KMS Key (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceKeyOnDemandRotationDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] KMS Key (%s) on-demand rotation \"deleted\" by removing from state", d.Id())
+	return nil
+}
@@ -0,0 +1,59 @@
+package kms_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/kms"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccKMSKeyOnDemandRotation_basic(t *testing.T) {
+	var key kms.KeyMetadata
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	keyResourceName := "aws_kms_key.test"
+	resourceName := "aws_kms_key_on_demand_rotation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, kms.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyOnDemandRotationConfig_basic(rName, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyExists(keyResourceName, &key),
+					resource.TestCheckResourceAttrPair(resourceName, "key_id", keyResourceName, "key_id"),
+					resource.TestCheckResourceAttr(resourceName, "triggers.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "triggers.rotation", "1"),
+				),
+			},
+			{
+				Config: testAccKeyOnDemandRotationConfig_basic(rName, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "triggers.rotation", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKeyOnDemandRotationConfig_basic(rName, rotation string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+
+resource "aws_kms_key_on_demand_rotation" "test" {
+  key_id = aws_kms_key.test.key_id
+
+  triggers = {
+    rotation = %[2]q
+  }
+}
+`, rName, rotation)
+}
@@ -408,6 +408,49 @@ func TestAccKMSKey_isEnabled(t *testing.T) {
 	})
 }
 
+func TestAccKMSKey_rotationPeriod(t *testing.T) {
+	var key1, key2 kms.KeyMetadata
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kms_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, kms.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyConfig_rotationPeriod(rName, 91),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyExists(resourceName, &key1),
+					resource.TestCheckResourceAttr(resourceName, "enable_key_rotation", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "next_rotation_date"),
+					resource.TestCheckResourceAttr(resourceName, "on_demand_rotation_start_date", ""),
+					resource.TestCheckResourceAttr(resourceName, "rotation_period_in_days", "91"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_window_in_days", "bypass_policy_lockout_safety_check"},
+			},
+			{
+				Config: testAccKeyConfig_rotationPeriod(rName, 365),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyExists(resourceName, &key2),
+					resource.TestCheckResourceAttr(resourceName, "enable_key_rotation", "true"),
+					resource.TestCheckResourceAttr(resourceName, "rotation_period_in_days", "365"),
+				),
+			},
+			{
+				Config:      testAccKeyConfig_rotationPeriodRotationDisabled(rName, 365),
+				ExpectError: regexp.MustCompile(`rotation_period_in_days can only be set when enable_key_rotation is true`),
+			},
+		},
+	})
+}
+
 func TestAccKMSKey_tags(t *testing.T) {
 	var key kms.KeyMetadata
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -945,6 +988,28 @@ resource "aws_kms_key" "test" {
 `, rName)
 }
 
+func testAccKeyConfig_rotationPeriod(rName string, rotationPeriodInDays int) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+  enable_key_rotation     = true
+  rotation_period_in_days = %[2]d
+}
+`, rName, rotationPeriodInDays)
+}
+
+func testAccKeyConfig_rotationPeriodRotationDisabled(rName string, rotationPeriodInDays int) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+  enable_key_rotation     = false
+  rotation_period_in_days = %[2]d
+}
+`, rName, rotationPeriodInDays)
+}
+
 func testAccKeyConfig_disabled(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_kms_key" "test" {
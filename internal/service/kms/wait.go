@@ -20,6 +20,7 @@ const (
 	KeyDescriptionPropagationTimeout = 10 * time.Minute
 	KeyMaterialImportedTimeout       = 10 * time.Minute
 	KeyPolicyPropagationTimeout      = 5 * time.Minute
+	KeyRotatedOnDemandTimeout        = 30 * time.Minute
 	KeyRotationUpdatedTimeout        = 10 * time.Minute
 	KeyStatePropagationTimeout       = 20 * time.Minute
 	KeyTagsPropagationTimeout        = 10 * time.Minute
@@ -121,9 +122,27 @@ func WaitKeyPolicyPropagated(conn *kms.KMS, id, policy string) error {
 	return tfresource.WaitUntil(KeyPolicyPropagationTimeout, checkFunc, opts)
 }
 
-func WaitKeyRotationEnabledPropagated(conn *kms.KMS, id string, enabled bool) error {
+func WaitKeyRotatedOnDemand(conn *kms.KMS, id string) error {
 	checkFunc := func() (bool, error) {
-		output, err := FindKeyRotationEnabledByKeyID(conn, id)
+		output, err := FindKeyRotationStatusByKeyID(conn, id)
+
+		if err != nil {
+			return false, err
+		}
+
+		return output.OnDemandRotationStartDate == nil, nil
+	}
+	opts := tfresource.WaitOpts{
+		ContinuousTargetOccurence: 5,
+		MinTimeout:                10 * time.Second,
+	}
+
+	return tfresource.WaitUntil(KeyRotatedOnDemandTimeout, checkFunc, opts)
+}
+
+func WaitKeyRotationEnabledPropagated(conn *kms.KMS, id string, enabled bool, rotationPeriodInDays int) error {
+	checkFunc := func() (bool, error) {
+		output, err := FindKeyRotationStatusByKeyID(conn, id)
 
 		if tfresource.NotFound(err) {
 			return false, nil
@@ -133,7 +152,11 @@ func WaitKeyRotationEnabledPropagated(conn *kms.KMS, id string, enabled bool) er
 			return false, err
 		}
 
-		return aws.BoolValue(output) == enabled, nil
+		if rotationPeriodInDays > 0 && enabled && aws.Int64Value(output.RotationPeriodInDays) != int64(rotationPeriodInDays) {
+			return false, nil
+		}
+
+		return aws.BoolValue(output.KeyRotationEnabled) == enabled, nil
 	}
 	opts := tfresource.WaitOpts{
 		ContinuousTargetOccurence: 5,
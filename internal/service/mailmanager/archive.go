@@ -0,0 +1,206 @@
+package mailmanager
+
+import (
+	"context"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mailmanager"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceArchive() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceArchiveCreate,
+		ReadWithoutTimeout:   resourceArchiveRead,
+		UpdateWithoutTimeout: resourceArchiveUpdate,
+		DeleteWithoutTimeout: resourceArchiveDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"archive_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(3, 64),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_-]*[a-zA-Z0-9]$`), "must begin and end with a letter or number and contain only letters, numbers, underscores and hyphens"),
+				),
+			},
+			"archive_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"kms_key_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"retention_period": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(mailmanager.RetentionPeriod_Values(), false),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceArchiveCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MailManagerConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("archive_name").(string)
+	input := &mailmanager.CreateArchiveInput{
+		ArchiveName: aws.String(name),
+		ClientToken: aws.String(resource.UniqueId()),
+	}
+
+	if v, ok := d.GetOk("kms_key_arn"); ok {
+		input.KmsKeyArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("retention_period"); ok {
+		input.Retention = &mailmanager.ArchiveRetention{
+			RetentionPeriod: aws.String(v.(string)),
+		}
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateArchiveWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Mail Manager Archive (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.ArchiveId))
+
+	return resourceArchiveRead(ctx, d, meta)
+}
+
+func resourceArchiveRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MailManagerConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	archive, err := FindArchiveByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Mail Manager Archive (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Mail Manager Archive (%s): %s", d.Id(), err)
+	}
+
+	arn := aws.StringValue(archive.ArchiveArn)
+	d.Set("archive_name", archive.ArchiveName)
+	d.Set("archive_state", archive.ArchiveState)
+	d.Set("arn", arn)
+	d.Set("kms_key_arn", archive.KmsKeyArn)
+	if archive.Retention != nil {
+		d.Set("retention_period", archive.Retention.RetentionPeriod)
+	} else {
+		d.Set("retention_period", nil)
+	}
+
+	tags, err := ListTagsWithContext(ctx, conn, arn)
+
+	if err != nil {
+		return diag.Errorf("listing tags for Mail Manager Archive (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceArchiveUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MailManagerConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &mailmanager.UpdateArchiveInput{
+			ArchiveId: aws.String(d.Id()),
+		}
+
+		if d.HasChange("archive_name") {
+			input.ArchiveName = aws.String(d.Get("archive_name").(string))
+		}
+
+		if d.HasChange("retention_period") {
+			input.Retention = &mailmanager.ArchiveRetention{
+				RetentionPeriod: aws.String(d.Get("retention_period").(string)),
+			}
+		}
+
+		_, err := conn.UpdateArchiveWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating Mail Manager Archive (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating Mail Manager Archive (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceArchiveRead(ctx, d, meta)
+}
+
+func resourceArchiveDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MailManagerConn
+
+	log.Printf("[DEBUG] Deleting Mail Manager Archive: %s", d.Id())
+	_, err := conn.DeleteArchiveWithContext(ctx, &mailmanager.DeleteArchiveInput{
+		ArchiveId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, mailmanager.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Mail Manager Archive (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
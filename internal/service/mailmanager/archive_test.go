@@ -0,0 +1,205 @@
+package mailmanager_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/mailmanager"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmailmanager "github.com/hashicorp/terraform-provider-aws/internal/service/mailmanager"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccMailManagerArchive_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mailmanager_archive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mailmanager.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckArchiveDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArchiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckArchiveExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "archive_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "archive_state", mailmanager.ArchiveStateActive),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "ses", regexp.MustCompile(`mailmanager-archive/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "retention_period"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccArchiveConfig_retention(rName, mailmanager.RetentionPeriodOneYear),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckArchiveExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "retention_period", mailmanager.RetentionPeriodOneYear),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMailManagerArchive_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mailmanager_archive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mailmanager.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckArchiveDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArchiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckArchiveExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfmailmanager.ResourceArchive(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccMailManagerArchive_tags(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mailmanager_archive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mailmanager.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckArchiveDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArchiveConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckArchiveExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccArchiveConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckArchiveExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccArchiveConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckArchiveExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckArchiveExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Mail Manager Archive ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MailManagerConn
+
+		_, err := tfmailmanager.FindArchiveByID(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckArchiveDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).MailManagerConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_mailmanager_archive" {
+			continue
+		}
+
+		_, err := tfmailmanager.FindArchiveByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Mail Manager Archive %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccArchiveConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_mailmanager_archive" "test" {
+  archive_name = %[1]q
+}
+`, rName)
+}
+
+func testAccArchiveConfig_retention(rName, retentionPeriod string) string {
+	return fmt.Sprintf(`
+resource "aws_mailmanager_archive" "test" {
+  archive_name     = %[1]q
+  retention_period = %[2]q
+}
+`, rName, retentionPeriod)
+}
+
+func testAccArchiveConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_mailmanager_archive" "test" {
+  archive_name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccArchiveConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_mailmanager_archive" "test" {
+  archive_name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,119 @@
+package mailmanager
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mailmanager"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindArchiveByID(ctx context.Context, conn *mailmanager.MailManager, id string) (*mailmanager.GetArchiveOutput, error) {
+	input := &mailmanager.GetArchiveInput{
+		ArchiveId: aws.String(id),
+	}
+
+	output, err := conn.GetArchiveWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, mailmanager.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	// Deleted archives are retained in PENDING_DELETION state.
+	if state := aws.StringValue(output.ArchiveState); state == mailmanager.ArchiveStatePendingDeletion {
+		return nil, &resource.NotFoundError{
+			Message:     state,
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func FindIngressPointByID(ctx context.Context, conn *mailmanager.MailManager, id string) (*mailmanager.GetIngressPointOutput, error) {
+	input := &mailmanager.GetIngressPointInput{
+		IngressPointId: aws.String(id),
+	}
+
+	output, err := conn.GetIngressPointWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, mailmanager.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindRuleSetByID(ctx context.Context, conn *mailmanager.MailManager, id string) (*mailmanager.GetRuleSetOutput, error) {
+	input := &mailmanager.GetRuleSetInput{
+		RuleSetId: aws.String(id),
+	}
+
+	output, err := conn.GetRuleSetWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, mailmanager.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindTrafficPolicyByID(ctx context.Context, conn *mailmanager.MailManager, id string) (*mailmanager.GetTrafficPolicyOutput, error) {
+	input := &mailmanager.GetTrafficPolicyInput{
+		TrafficPolicyId: aws.String(id),
+	}
+
+	output, err := conn.GetTrafficPolicyWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, mailmanager.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,268 @@
+package mailmanager
+
+import (
+	"context"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mailmanager"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceIngressPoint() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceIngressPointCreate,
+		ReadWithoutTimeout:   resourceIngressPointRead,
+		UpdateWithoutTimeout: resourceIngressPointUpdate,
+		DeleteWithoutTimeout: resourceIngressPointDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"a_record": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ingress_point_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(3, 63),
+					validation.StringMatch(regexp.MustCompile(`^[A-Za-z0-9_\-]+$`), "must contain only letters, numbers, underscores and hyphens"),
+				),
+			},
+			"rule_set_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"secret_arn": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  verify.ValidARN,
+				ConflictsWith: []string{"smtp_password"},
+			},
+			"smtp_password": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ValidateFunc:  validation.StringLenBetween(8, 64),
+				ConflictsWith: []string{"secret_arn"},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"traffic_policy_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(mailmanager.IngressPointType_Values(), false),
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceIngressPointCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MailManagerConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("ingress_point_name").(string)
+	input := &mailmanager.CreateIngressPointInput{
+		ClientToken:      aws.String(resource.UniqueId()),
+		IngressPointName: aws.String(name),
+		RuleSetId:        aws.String(d.Get("rule_set_id").(string)),
+		TrafficPolicyId:  aws.String(d.Get("traffic_policy_id").(string)),
+		Type:             aws.String(d.Get("type").(string)),
+	}
+
+	if v, ok := d.GetOk("secret_arn"); ok {
+		input.IngressPointConfiguration = &mailmanager.IngressPointConfiguration{
+			SecretArn: aws.String(v.(string)),
+		}
+	}
+
+	if v, ok := d.GetOk("smtp_password"); ok {
+		input.IngressPointConfiguration = &mailmanager.IngressPointConfiguration{
+			SmtpPassword: aws.String(v.(string)),
+		}
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateIngressPointWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Mail Manager Ingress Point (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.IngressPointId))
+
+	if _, err := waitIngressPointCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for Mail Manager Ingress Point (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceIngressPointRead(ctx, d, meta)
+}
+
+func resourceIngressPointRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MailManagerConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	ingressPoint, err := FindIngressPointByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Mail Manager Ingress Point (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Mail Manager Ingress Point (%s): %s", d.Id(), err)
+	}
+
+	arn := aws.StringValue(ingressPoint.IngressPointArn)
+	d.Set("a_record", ingressPoint.ARecord)
+	d.Set("arn", arn)
+	d.Set("ingress_point_name", ingressPoint.IngressPointName)
+	d.Set("rule_set_id", ingressPoint.RuleSetId)
+	if v := ingressPoint.IngressPointAuthConfiguration; v != nil && v.SecretArn != nil {
+		d.Set("secret_arn", v.SecretArn)
+	}
+	d.Set("status", ingressPoint.Status)
+	d.Set("traffic_policy_id", ingressPoint.TrafficPolicyId)
+	d.Set("type", ingressPoint.Type)
+
+	tags, err := ListTagsWithContext(ctx, conn, arn)
+
+	if err != nil {
+		return diag.Errorf("listing tags for Mail Manager Ingress Point (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceIngressPointUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MailManagerConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &mailmanager.UpdateIngressPointInput{
+			IngressPointId: aws.String(d.Id()),
+		}
+
+		if d.HasChange("ingress_point_name") {
+			input.IngressPointName = aws.String(d.Get("ingress_point_name").(string))
+		}
+
+		if d.HasChange("rule_set_id") {
+			input.RuleSetId = aws.String(d.Get("rule_set_id").(string))
+		}
+
+		if d.HasChange("secret_arn") {
+			if v, ok := d.GetOk("secret_arn"); ok {
+				input.IngressPointConfiguration = &mailmanager.IngressPointConfiguration{
+					SecretArn: aws.String(v.(string)),
+				}
+			}
+		}
+
+		if d.HasChange("smtp_password") {
+			if v, ok := d.GetOk("smtp_password"); ok {
+				input.IngressPointConfiguration = &mailmanager.IngressPointConfiguration{
+					SmtpPassword: aws.String(v.(string)),
+				}
+			}
+		}
+
+		if d.HasChange("traffic_policy_id") {
+			input.TrafficPolicyId = aws.String(d.Get("traffic_policy_id").(string))
+		}
+
+		_, err := conn.UpdateIngressPointWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating Mail Manager Ingress Point (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitIngressPointUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("waiting for Mail Manager Ingress Point (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating Mail Manager Ingress Point (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceIngressPointRead(ctx, d, meta)
+}
+
+func resourceIngressPointDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MailManagerConn
+
+	log.Printf("[DEBUG] Deleting Mail Manager Ingress Point: %s", d.Id())
+	_, err := conn.DeleteIngressPointWithContext(ctx, &mailmanager.DeleteIngressPointInput{
+		IngressPointId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, mailmanager.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Mail Manager Ingress Point (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitIngressPointDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for Mail Manager Ingress Point (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,154 @@
+package mailmanager_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/mailmanager"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmailmanager "github.com/hashicorp/terraform-provider-aws/internal/service/mailmanager"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccMailManagerIngressPoint_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mailmanager_ingress_point.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mailmanager.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIngressPointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIngressPointConfig_basic(rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIngressPointExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "a_record"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "ses", regexp.MustCompile(`mailmanager-ingress-point/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "ingress_point_name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "rule_set_id", "aws_mailmanager_rule_set.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "status", mailmanager.IngressPointStatusActive),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "traffic_policy_id", "aws_mailmanager_traffic_policy.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "type", mailmanager.IngressPointTypeOpen),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccIngressPointConfig_basic(rName, rName+"-updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIngressPointExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "ingress_point_name", rName+"-updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMailManagerIngressPoint_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mailmanager_ingress_point.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mailmanager.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIngressPointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIngressPointConfig_basic(rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIngressPointExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfmailmanager.ResourceIngressPoint(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIngressPointExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Mail Manager Ingress Point ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MailManagerConn
+
+		_, err := tfmailmanager.FindIngressPointByID(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckIngressPointDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).MailManagerConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_mailmanager_ingress_point" {
+			continue
+		}
+
+		_, err := tfmailmanager.FindIngressPointByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Mail Manager Ingress Point %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccIngressPointConfig_basic(rName, ingressPointName string) string {
+	return fmt.Sprintf(`
+resource "aws_mailmanager_rule_set" "test" {
+  rule_set_name = %[1]q
+}
+
+resource "aws_mailmanager_traffic_policy" "test" {
+  default_action      = "DENY"
+  traffic_policy_name = %[1]q
+
+  policy_statement {
+    action = "ALLOW"
+
+    condition {
+      ip_expression {
+        attribute = "SENDER_IP"
+        operator  = "CIDR_MATCHES"
+        values    = ["10.0.0.0/8"]
+      }
+    }
+  }
+}
+
+resource "aws_mailmanager_ingress_point" "test" {
+  ingress_point_name = %[2]q
+  rule_set_id        = aws_mailmanager_rule_set.test.id
+  traffic_policy_id  = aws_mailmanager_traffic_policy.test.id
+  type               = "OPEN"
+}
+`, rName, ingressPointName)
+}
@@ -0,0 +1,750 @@
+package mailmanager
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mailmanager"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceRuleSet() *schema.Resource {
+	actionFailurePolicySchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice(mailmanager.ActionFailurePolicy_Values(), false),
+		}
+	}
+
+	ruleConditionSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"boolean_expression": {
+						Type:     schema.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"attribute": {
+									Type:         schema.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringInSlice(mailmanager.RuleBooleanEmailAttribute_Values(), false),
+								},
+								"operator": {
+									Type:         schema.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringInSlice(mailmanager.RuleBooleanOperator_Values(), false),
+								},
+							},
+						},
+					},
+					"ip_expression": {
+						Type:     schema.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"attribute": {
+									Type:         schema.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringInSlice(mailmanager.RuleIpEmailAttribute_Values(), false),
+								},
+								"operator": {
+									Type:         schema.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringInSlice(mailmanager.RuleIpOperator_Values(), false),
+								},
+								"values": {
+									Type:     schema.TypeList,
+									Required: true,
+									Elem: &schema.Schema{
+										Type:         schema.TypeString,
+										ValidateFunc: verify.ValidCIDRNetworkAddress,
+									},
+								},
+							},
+						},
+					},
+					"string_expression": {
+						Type:     schema.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"attribute": {
+									Type:         schema.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringInSlice(mailmanager.RuleStringEmailAttribute_Values(), false),
+								},
+								"operator": {
+									Type:         schema.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringInSlice(mailmanager.RuleStringOperator_Values(), false),
+								},
+								"values": {
+									Type:     schema.TypeList,
+									Required: true,
+									Elem:     &schema.Schema{Type: schema.TypeString},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRuleSetCreate,
+		ReadWithoutTimeout:   resourceRuleSetRead,
+		UpdateWithoutTimeout: resourceRuleSetUpdate,
+		DeleteWithoutTimeout: resourceRuleSetDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"add_header": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"header_name": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 64),
+												},
+												"header_value": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 128),
+												},
+											},
+										},
+									},
+									"archive": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"action_failure_policy": actionFailurePolicySchema(),
+												"target_archive": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+									"deliver_to_mailbox": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"action_failure_policy": actionFailurePolicySchema(),
+												"mailbox_arn": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+												"role_arn": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+											},
+										},
+									},
+									"drop": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"relay": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"action_failure_policy": actionFailurePolicySchema(),
+												"mail_from": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.StringInSlice(mailmanager.MailFrom_Values(), false),
+												},
+												"relay": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+									"replace_recipient": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"replace_with": {
+													Type:     schema.TypeList,
+													Optional: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+									"send": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"action_failure_policy": actionFailurePolicySchema(),
+												"role_arn": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+											},
+										},
+									},
+									"write_to_s3": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"action_failure_policy": actionFailurePolicySchema(),
+												"role_arn": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+												"s3_bucket": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"s3_prefix": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"s3_sse_kms_key_id": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"condition": ruleConditionSchema(),
+						"name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 32),
+						},
+						"unless": ruleConditionSchema(),
+					},
+				},
+			},
+			"rule_set_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceRuleSetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MailManagerConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("rule_set_name").(string)
+	input := &mailmanager.CreateRuleSetInput{
+		ClientToken: aws.String(resource.UniqueId()),
+		RuleSetName: aws.String(name),
+		Rules:       expandRules(d.Get("rule").([]interface{})),
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateRuleSetWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Mail Manager Rule Set (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.RuleSetId))
+
+	return resourceRuleSetRead(ctx, d, meta)
+}
+
+func resourceRuleSetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MailManagerConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	ruleSet, err := FindRuleSetByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Mail Manager Rule Set (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Mail Manager Rule Set (%s): %s", d.Id(), err)
+	}
+
+	arn := aws.StringValue(ruleSet.RuleSetArn)
+	d.Set("arn", arn)
+	if err := d.Set("rule", flattenRules(ruleSet.Rules)); err != nil {
+		return diag.Errorf("setting rule: %s", err)
+	}
+	d.Set("rule_set_name", ruleSet.RuleSetName)
+
+	tags, err := ListTagsWithContext(ctx, conn, arn)
+
+	if err != nil {
+		return diag.Errorf("listing tags for Mail Manager Rule Set (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceRuleSetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MailManagerConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &mailmanager.UpdateRuleSetInput{
+			RuleSetId: aws.String(d.Id()),
+		}
+
+		if d.HasChange("rule") {
+			// An empty rule list removes all rules.
+			input.Rules = expandRules(d.Get("rule").([]interface{}))
+			if input.Rules == nil {
+				input.Rules = []*mailmanager.Rule{}
+			}
+		}
+
+		if d.HasChange("rule_set_name") {
+			input.RuleSetName = aws.String(d.Get("rule_set_name").(string))
+		}
+
+		_, err := conn.UpdateRuleSetWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating Mail Manager Rule Set (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating Mail Manager Rule Set (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceRuleSetRead(ctx, d, meta)
+}
+
+func resourceRuleSetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MailManagerConn
+
+	log.Printf("[DEBUG] Deleting Mail Manager Rule Set: %s", d.Id())
+	_, err := conn.DeleteRuleSetWithContext(ctx, &mailmanager.DeleteRuleSetInput{
+		RuleSetId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, mailmanager.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Mail Manager Rule Set (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandRules(tfList []interface{}) []*mailmanager.Rule {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*mailmanager.Rule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &mailmanager.Rule{
+			Actions: expandRuleActions(tfMap["action"].([]interface{})),
+		}
+
+		if v, ok := tfMap["condition"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Conditions = expandRuleConditions(v)
+		}
+
+		if v, ok := tfMap["name"].(string); ok && v != "" {
+			apiObject.Name = aws.String(v)
+		}
+
+		if v, ok := tfMap["unless"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Unless = expandRuleConditions(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandRuleActions(tfList []interface{}) []*mailmanager.RuleAction {
+	var apiObjects []*mailmanager.RuleAction
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &mailmanager.RuleAction{}
+
+		if v, ok := tfMap["add_header"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.AddHeader = &mailmanager.AddHeaderAction{
+				HeaderName:  aws.String(tfMap["header_name"].(string)),
+				HeaderValue: aws.String(tfMap["header_value"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["archive"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.Archive = &mailmanager.ArchiveAction{
+				TargetArchive: aws.String(tfMap["target_archive"].(string)),
+			}
+
+			if v, ok := tfMap["action_failure_policy"].(string); ok && v != "" {
+				apiObject.Archive.ActionFailurePolicy = aws.String(v)
+			}
+		}
+
+		if v, ok := tfMap["deliver_to_mailbox"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.DeliverToMailbox = &mailmanager.DeliverToMailboxAction{
+				MailboxArn: aws.String(tfMap["mailbox_arn"].(string)),
+				RoleArn:    aws.String(tfMap["role_arn"].(string)),
+			}
+
+			if v, ok := tfMap["action_failure_policy"].(string); ok && v != "" {
+				apiObject.DeliverToMailbox.ActionFailurePolicy = aws.String(v)
+			}
+		}
+
+		if v, ok := tfMap["drop"].(bool); ok && v {
+			apiObject.Drop = &mailmanager.DropAction{}
+		}
+
+		if v, ok := tfMap["relay"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.Relay = &mailmanager.RelayAction{
+				Relay: aws.String(tfMap["relay"].(string)),
+			}
+
+			if v, ok := tfMap["action_failure_policy"].(string); ok && v != "" {
+				apiObject.Relay.ActionFailurePolicy = aws.String(v)
+			}
+
+			if v, ok := tfMap["mail_from"].(string); ok && v != "" {
+				apiObject.Relay.MailFrom = aws.String(v)
+			}
+		}
+
+		if v, ok := tfMap["replace_recipient"].([]interface{}); ok && len(v) > 0 {
+			apiObject.ReplaceRecipient = &mailmanager.ReplaceRecipientAction{}
+
+			if v[0] != nil {
+				if v, ok := v[0].(map[string]interface{})["replace_with"].([]interface{}); ok && len(v) > 0 {
+					apiObject.ReplaceRecipient.ReplaceWith = flex.ExpandStringList(v)
+				}
+			}
+		}
+
+		if v, ok := tfMap["send"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.Send = &mailmanager.SendAction{
+				RoleArn: aws.String(tfMap["role_arn"].(string)),
+			}
+
+			if v, ok := tfMap["action_failure_policy"].(string); ok && v != "" {
+				apiObject.Send.ActionFailurePolicy = aws.String(v)
+			}
+		}
+
+		if v, ok := tfMap["write_to_s3"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.WriteToS3 = &mailmanager.S3Action{
+				RoleArn:  aws.String(tfMap["role_arn"].(string)),
+				S3Bucket: aws.String(tfMap["s3_bucket"].(string)),
+			}
+
+			if v, ok := tfMap["action_failure_policy"].(string); ok && v != "" {
+				apiObject.WriteToS3.ActionFailurePolicy = aws.String(v)
+			}
+
+			if v, ok := tfMap["s3_prefix"].(string); ok && v != "" {
+				apiObject.WriteToS3.S3Prefix = aws.String(v)
+			}
+
+			if v, ok := tfMap["s3_sse_kms_key_id"].(string); ok && v != "" {
+				apiObject.WriteToS3.S3SseKmsKeyId = aws.String(v)
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandRuleConditions(tfList []interface{}) []*mailmanager.RuleCondition {
+	var apiObjects []*mailmanager.RuleCondition
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &mailmanager.RuleCondition{}
+
+		if v, ok := tfMap["boolean_expression"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.BooleanExpression = &mailmanager.RuleBooleanExpression{
+				Evaluate: &mailmanager.RuleBooleanToEvaluate{
+					Attribute: aws.String(tfMap["attribute"].(string)),
+				},
+				Operator: aws.String(tfMap["operator"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["ip_expression"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.IpExpression = &mailmanager.RuleIpExpression{
+				Evaluate: &mailmanager.RuleIpToEvaluate{
+					Attribute: aws.String(tfMap["attribute"].(string)),
+				},
+				Operator: aws.String(tfMap["operator"].(string)),
+				Values:   flex.ExpandStringList(tfMap["values"].([]interface{})),
+			}
+		}
+
+		if v, ok := tfMap["string_expression"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.StringExpression = &mailmanager.RuleStringExpression{
+				Evaluate: &mailmanager.RuleStringToEvaluate{
+					Attribute: aws.String(tfMap["attribute"].(string)),
+				},
+				Operator: aws.String(tfMap["operator"].(string)),
+				Values:   flex.ExpandStringList(tfMap["values"].([]interface{})),
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenRules(apiObjects []*mailmanager.Rule) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"action":    flattenRuleActions(apiObject.Actions),
+			"condition": flattenRuleConditions(apiObject.Conditions),
+			"name":      aws.StringValue(apiObject.Name),
+			"unless":    flattenRuleConditions(apiObject.Unless),
+		})
+	}
+
+	return tfList
+}
+
+func flattenRuleActions(apiObjects []*mailmanager.RuleAction) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"drop": apiObject.Drop != nil,
+		}
+
+		if v := apiObject.AddHeader; v != nil {
+			tfMap["add_header"] = []interface{}{map[string]interface{}{
+				"header_name":  aws.StringValue(v.HeaderName),
+				"header_value": aws.StringValue(v.HeaderValue),
+			}}
+		}
+
+		if v := apiObject.Archive; v != nil {
+			tfMap["archive"] = []interface{}{map[string]interface{}{
+				"action_failure_policy": aws.StringValue(v.ActionFailurePolicy),
+				"target_archive":        aws.StringValue(v.TargetArchive),
+			}}
+		}
+
+		if v := apiObject.DeliverToMailbox; v != nil {
+			tfMap["deliver_to_mailbox"] = []interface{}{map[string]interface{}{
+				"action_failure_policy": aws.StringValue(v.ActionFailurePolicy),
+				"mailbox_arn":           aws.StringValue(v.MailboxArn),
+				"role_arn":              aws.StringValue(v.RoleArn),
+			}}
+		}
+
+		if v := apiObject.Relay; v != nil {
+			tfMap["relay"] = []interface{}{map[string]interface{}{
+				"action_failure_policy": aws.StringValue(v.ActionFailurePolicy),
+				"mail_from":             aws.StringValue(v.MailFrom),
+				"relay":                 aws.StringValue(v.Relay),
+			}}
+		}
+
+		if v := apiObject.ReplaceRecipient; v != nil {
+			tfMap["replace_recipient"] = []interface{}{map[string]interface{}{
+				"replace_with": aws.StringValueSlice(v.ReplaceWith),
+			}}
+		}
+
+		if v := apiObject.Send; v != nil {
+			tfMap["send"] = []interface{}{map[string]interface{}{
+				"action_failure_policy": aws.StringValue(v.ActionFailurePolicy),
+				"role_arn":              aws.StringValue(v.RoleArn),
+			}}
+		}
+
+		if v := apiObject.WriteToS3; v != nil {
+			tfMap["write_to_s3"] = []interface{}{map[string]interface{}{
+				"action_failure_policy": aws.StringValue(v.ActionFailurePolicy),
+				"role_arn":              aws.StringValue(v.RoleArn),
+				"s3_bucket":             aws.StringValue(v.S3Bucket),
+				"s3_prefix":             aws.StringValue(v.S3Prefix),
+				"s3_sse_kms_key_id":     aws.StringValue(v.S3SseKmsKeyId),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenRuleConditions(apiObjects []*mailmanager.RuleCondition) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.BooleanExpression; v != nil && v.Evaluate != nil {
+			tfMap["boolean_expression"] = []interface{}{map[string]interface{}{
+				"attribute": aws.StringValue(v.Evaluate.Attribute),
+				"operator":  aws.StringValue(v.Operator),
+			}}
+		}
+
+		if v := apiObject.IpExpression; v != nil && v.Evaluate != nil {
+			tfMap["ip_expression"] = []interface{}{map[string]interface{}{
+				"attribute": aws.StringValue(v.Evaluate.Attribute),
+				"operator":  aws.StringValue(v.Operator),
+				"values":    aws.StringValueSlice(v.Values),
+			}}
+		}
+
+		if v := apiObject.StringExpression; v != nil && v.Evaluate != nil {
+			tfMap["string_expression"] = []interface{}{map[string]interface{}{
+				"attribute": aws.StringValue(v.Evaluate.Attribute),
+				"operator":  aws.StringValue(v.Operator),
+				"values":    aws.StringValueSlice(v.Values),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
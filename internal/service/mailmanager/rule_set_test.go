@@ -0,0 +1,186 @@
+package mailmanager_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/mailmanager"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmailmanager "github.com/hashicorp/terraform-provider-aws/internal/service/mailmanager"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccMailManagerRuleSet_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mailmanager_rule_set.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mailmanager.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuleSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuleSetConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuleSetExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "ses", regexp.MustCompile(`mailmanager-rule-set/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.action.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.action.0.drop", "true"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.condition.0.string_expression.0.attribute", "SUBJECT"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.name", "drop-spam"),
+					resource.TestCheckResourceAttr(resourceName, "rule_set_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRuleSetConfig_archive(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuleSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.action.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.action.0.add_header.0.header_name", "X-Archived"),
+					resource.TestCheckResourceAttrPair(resourceName, "rule.0.action.1.archive.0.target_archive", "aws_mailmanager_archive.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.unless.0.boolean_expression.0.attribute", "TLS"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMailManagerRuleSet_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mailmanager_rule_set.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mailmanager.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuleSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuleSetConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuleSetExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfmailmanager.ResourceRuleSet(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckRuleSetExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Mail Manager Rule Set ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MailManagerConn
+
+		_, err := tfmailmanager.FindRuleSetByID(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckRuleSetDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).MailManagerConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_mailmanager_rule_set" {
+			continue
+		}
+
+		_, err := tfmailmanager.FindRuleSetByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Mail Manager Rule Set %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccRuleSetConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_mailmanager_rule_set" "test" {
+  rule_set_name = %[1]q
+
+  rule {
+    name = "drop-spam"
+
+    action {
+      drop = true
+    }
+
+    condition {
+      string_expression {
+        attribute = "SUBJECT"
+        operator  = "CONTAINS"
+        values    = ["[SPAM]"]
+      }
+    }
+  }
+}
+`, rName)
+}
+
+func testAccRuleSetConfig_archive(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_mailmanager_archive" "test" {
+  archive_name = %[1]q
+}
+
+resource "aws_mailmanager_rule_set" "test" {
+  rule_set_name = %[1]q
+
+  rule {
+    name = "archive-all"
+
+    action {
+      add_header {
+        header_name  = "X-Archived"
+        header_value = "true"
+      }
+    }
+
+    action {
+      archive {
+        target_archive = aws_mailmanager_archive.test.id
+      }
+    }
+
+    unless {
+      boolean_expression {
+        attribute = "TLS"
+        operator  = "IS_FALSE"
+      }
+    }
+  }
+}
+`, rName)
+}
@@ -0,0 +1,222 @@
+//go:build sweep
+// +build sweep
+
+package mailmanager
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mailmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_mailmanager_archive", &resource.Sweeper{
+		Name: "aws_mailmanager_archive",
+		F:    sweepArchives,
+		Dependencies: []string{
+			"aws_mailmanager_rule_set",
+		},
+	})
+
+	resource.AddTestSweepers("aws_mailmanager_ingress_point", &resource.Sweeper{
+		Name: "aws_mailmanager_ingress_point",
+		F:    sweepIngressPoints,
+	})
+
+	resource.AddTestSweepers("aws_mailmanager_rule_set", &resource.Sweeper{
+		Name: "aws_mailmanager_rule_set",
+		F:    sweepRuleSets,
+		Dependencies: []string{
+			"aws_mailmanager_ingress_point",
+		},
+	})
+
+	resource.AddTestSweepers("aws_mailmanager_traffic_policy", &resource.Sweeper{
+		Name: "aws_mailmanager_traffic_policy",
+		F:    sweepTrafficPolicies,
+		Dependencies: []string{
+			"aws_mailmanager_ingress_point",
+		},
+	})
+}
+
+func sweepArchives(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).MailManagerConn
+	input := &mailmanager.ListArchivesInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListArchivesPages(input, func(page *mailmanager.ListArchivesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Archives {
+			if aws.StringValue(v.ArchiveState) == mailmanager.ArchiveStatePendingDeletion {
+				continue
+			}
+
+			r := ResourceArchive()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.ArchiveId))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Mail Manager Archive sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Mail Manager Archives (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Mail Manager Archives (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepIngressPoints(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).MailManagerConn
+	input := &mailmanager.ListIngressPointsInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListIngressPointsPages(input, func(page *mailmanager.ListIngressPointsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.IngressPoints {
+			r := ResourceIngressPoint()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.IngressPointId))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Mail Manager Ingress Point sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Mail Manager Ingress Points (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Mail Manager Ingress Points (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepRuleSets(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).MailManagerConn
+	input := &mailmanager.ListRuleSetsInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListRuleSetsPages(input, func(page *mailmanager.ListRuleSetsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.RuleSets {
+			r := ResourceRuleSet()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.RuleSetId))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Mail Manager Rule Set sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Mail Manager Rule Sets (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Mail Manager Rule Sets (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepTrafficPolicies(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).MailManagerConn
+	input := &mailmanager.ListTrafficPoliciesInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListTrafficPoliciesPages(input, func(page *mailmanager.ListTrafficPoliciesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.TrafficPolicies {
+			r := ResourceTrafficPolicy()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.TrafficPolicyId))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Mail Manager Traffic Policy sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Mail Manager Traffic Policies (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Mail Manager Traffic Policies (%s): %w", region, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,437 @@
+package mailmanager
+
+import (
+	"context"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mailmanager"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceTrafficPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTrafficPolicyCreate,
+		ReadWithoutTimeout:   resourceTrafficPolicyRead,
+		UpdateWithoutTimeout: resourceTrafficPolicyUpdate,
+		DeleteWithoutTimeout: resourceTrafficPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_action": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(mailmanager.AcceptAction_Values(), false),
+			},
+			"max_message_size_bytes": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"policy_statement": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(mailmanager.AcceptAction_Values(), false),
+						},
+						"condition": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"ip_expression": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"attribute": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(mailmanager.IngressIpv4Attribute_Values(), false),
+												},
+												"operator": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(mailmanager.IngressIpOperator_Values(), false),
+												},
+												"values": {
+													Type:     schema.TypeList,
+													Required: true,
+													Elem: &schema.Schema{
+														Type:         schema.TypeString,
+														ValidateFunc: verify.ValidCIDRNetworkAddress,
+													},
+												},
+											},
+										},
+									},
+									"string_expression": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"attribute": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(mailmanager.IngressStringEmailAttribute_Values(), false),
+												},
+												"operator": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(mailmanager.IngressStringOperator_Values(), false),
+												},
+												"values": {
+													Type:     schema.TypeList,
+													Required: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+									"tls_expression": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"attribute": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(mailmanager.IngressTlsAttribute_Values(), false),
+												},
+												"operator": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(mailmanager.IngressTlsProtocolOperator_Values(), false),
+												},
+												"value": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(mailmanager.IngressTlsProtocolAttribute_Values(), false),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"traffic_policy_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(3, 63),
+					validation.StringMatch(regexp.MustCompile(`^[A-Za-z0-9_\-]+$`), "must contain only letters, numbers, underscores and hyphens"),
+				),
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceTrafficPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MailManagerConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("traffic_policy_name").(string)
+	input := &mailmanager.CreateTrafficPolicyInput{
+		ClientToken:       aws.String(resource.UniqueId()),
+		DefaultAction:     aws.String(d.Get("default_action").(string)),
+		PolicyStatements:  expandPolicyStatements(d.Get("policy_statement").([]interface{})),
+		TrafficPolicyName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("max_message_size_bytes"); ok {
+		input.MaxMessageSizeBytes = aws.Int64(int64(v.(int)))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateTrafficPolicyWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Mail Manager Traffic Policy (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.TrafficPolicyId))
+
+	return resourceTrafficPolicyRead(ctx, d, meta)
+}
+
+func resourceTrafficPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MailManagerConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	policy, err := FindTrafficPolicyByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Mail Manager Traffic Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Mail Manager Traffic Policy (%s): %s", d.Id(), err)
+	}
+
+	arn := aws.StringValue(policy.TrafficPolicyArn)
+	d.Set("arn", arn)
+	d.Set("default_action", policy.DefaultAction)
+	d.Set("max_message_size_bytes", policy.MaxMessageSizeBytes)
+	if err := d.Set("policy_statement", flattenPolicyStatements(policy.PolicyStatements)); err != nil {
+		return diag.Errorf("setting policy_statement: %s", err)
+	}
+	d.Set("traffic_policy_name", policy.TrafficPolicyName)
+
+	tags, err := ListTagsWithContext(ctx, conn, arn)
+
+	if err != nil {
+		return diag.Errorf("listing tags for Mail Manager Traffic Policy (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceTrafficPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MailManagerConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &mailmanager.UpdateTrafficPolicyInput{
+			TrafficPolicyId: aws.String(d.Id()),
+		}
+
+		if d.HasChange("default_action") {
+			input.DefaultAction = aws.String(d.Get("default_action").(string))
+		}
+
+		if d.HasChange("max_message_size_bytes") {
+			input.MaxMessageSizeBytes = aws.Int64(int64(d.Get("max_message_size_bytes").(int)))
+		}
+
+		if d.HasChange("policy_statement") {
+			input.PolicyStatements = expandPolicyStatements(d.Get("policy_statement").([]interface{}))
+		}
+
+		if d.HasChange("traffic_policy_name") {
+			input.TrafficPolicyName = aws.String(d.Get("traffic_policy_name").(string))
+		}
+
+		_, err := conn.UpdateTrafficPolicyWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating Mail Manager Traffic Policy (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating Mail Manager Traffic Policy (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceTrafficPolicyRead(ctx, d, meta)
+}
+
+func resourceTrafficPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MailManagerConn
+
+	log.Printf("[DEBUG] Deleting Mail Manager Traffic Policy: %s", d.Id())
+	_, err := conn.DeleteTrafficPolicyWithContext(ctx, &mailmanager.DeleteTrafficPolicyInput{
+		TrafficPolicyId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, mailmanager.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Mail Manager Traffic Policy (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandPolicyStatements(tfList []interface{}) []*mailmanager.PolicyStatement {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*mailmanager.PolicyStatement
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &mailmanager.PolicyStatement{
+			Action: aws.String(tfMap["action"].(string)),
+		}
+
+		if v, ok := tfMap["condition"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Conditions = expandPolicyConditions(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandPolicyConditions(tfList []interface{}) []*mailmanager.PolicyCondition {
+	var apiObjects []*mailmanager.PolicyCondition
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &mailmanager.PolicyCondition{}
+
+		if v, ok := tfMap["ip_expression"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.IpExpression = &mailmanager.IngressIpv4Expression{
+				Evaluate: &mailmanager.IngressIpToEvaluate{
+					Attribute: aws.String(tfMap["attribute"].(string)),
+				},
+				Operator: aws.String(tfMap["operator"].(string)),
+				Values:   flex.ExpandStringList(tfMap["values"].([]interface{})),
+			}
+		}
+
+		if v, ok := tfMap["string_expression"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.StringExpression = &mailmanager.IngressStringExpression{
+				Evaluate: &mailmanager.IngressStringToEvaluate{
+					Attribute: aws.String(tfMap["attribute"].(string)),
+				},
+				Operator: aws.String(tfMap["operator"].(string)),
+				Values:   flex.ExpandStringList(tfMap["values"].([]interface{})),
+			}
+		}
+
+		if v, ok := tfMap["tls_expression"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.TlsExpression = &mailmanager.IngressTlsProtocolExpression{
+				Evaluate: &mailmanager.IngressTlsProtocolToEvaluate{
+					Attribute: aws.String(tfMap["attribute"].(string)),
+				},
+				Operator: aws.String(tfMap["operator"].(string)),
+				Value:    aws.String(tfMap["value"].(string)),
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenPolicyStatements(apiObjects []*mailmanager.PolicyStatement) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"action":    aws.StringValue(apiObject.Action),
+			"condition": flattenPolicyConditions(apiObject.Conditions),
+		})
+	}
+
+	return tfList
+}
+
+func flattenPolicyConditions(apiObjects []*mailmanager.PolicyCondition) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.IpExpression; v != nil && v.Evaluate != nil {
+			tfMap["ip_expression"] = []interface{}{map[string]interface{}{
+				"attribute": aws.StringValue(v.Evaluate.Attribute),
+				"operator":  aws.StringValue(v.Operator),
+				"values":    aws.StringValueSlice(v.Values),
+			}}
+		}
+
+		if v := apiObject.StringExpression; v != nil && v.Evaluate != nil {
+			tfMap["string_expression"] = []interface{}{map[string]interface{}{
+				"attribute": aws.StringValue(v.Evaluate.Attribute),
+				"operator":  aws.StringValue(v.Operator),
+				"values":    aws.StringValueSlice(v.Values),
+			}}
+		}
+
+		if v := apiObject.TlsExpression; v != nil && v.Evaluate != nil {
+			tfMap["tls_expression"] = []interface{}{map[string]interface{}{
+				"attribute": aws.StringValue(v.Evaluate.Attribute),
+				"operator":  aws.StringValue(v.Operator),
+				"value":     aws.StringValue(v.Value),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,184 @@
+package mailmanager_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/mailmanager"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmailmanager "github.com/hashicorp/terraform-provider-aws/internal/service/mailmanager"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccMailManagerTrafficPolicy_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mailmanager_traffic_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mailmanager.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTrafficPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTrafficPolicyConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrafficPolicyExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "ses", regexp.MustCompile(`mailmanager-traffic-policy/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "default_action", mailmanager.AcceptActionDeny),
+					resource.TestCheckResourceAttr(resourceName, "policy_statement.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "policy_statement.0.action", mailmanager.AcceptActionAllow),
+					resource.TestCheckResourceAttr(resourceName, "policy_statement.0.condition.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "policy_statement.0.condition.0.ip_expression.0.attribute", "SENDER_IP"),
+					resource.TestCheckResourceAttr(resourceName, "policy_statement.0.condition.0.ip_expression.0.operator", "CIDR_MATCHES"),
+					resource.TestCheckResourceAttr(resourceName, "policy_statement.0.condition.0.ip_expression.0.values.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_policy_name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTrafficPolicyConfig_updated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrafficPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "default_action", mailmanager.AcceptActionAllow),
+					resource.TestCheckResourceAttr(resourceName, "max_message_size_bytes", "1048576"),
+					resource.TestCheckResourceAttr(resourceName, "policy_statement.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "policy_statement.0.action", mailmanager.AcceptActionDeny),
+					resource.TestCheckResourceAttr(resourceName, "policy_statement.0.condition.0.tls_expression.0.value", "TLS1_2"),
+					resource.TestCheckResourceAttr(resourceName, "policy_statement.1.condition.0.string_expression.0.attribute", "RECIPIENT"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMailManagerTrafficPolicy_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mailmanager_traffic_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mailmanager.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTrafficPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTrafficPolicyConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrafficPolicyExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfmailmanager.ResourceTrafficPolicy(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckTrafficPolicyExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Mail Manager Traffic Policy ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MailManagerConn
+
+		_, err := tfmailmanager.FindTrafficPolicyByID(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckTrafficPolicyDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).MailManagerConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_mailmanager_traffic_policy" {
+			continue
+		}
+
+		_, err := tfmailmanager.FindTrafficPolicyByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Mail Manager Traffic Policy %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccTrafficPolicyConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_mailmanager_traffic_policy" "test" {
+  default_action      = "DENY"
+  traffic_policy_name = %[1]q
+
+  policy_statement {
+    action = "ALLOW"
+
+    condition {
+      ip_expression {
+        attribute = "SENDER_IP"
+        operator  = "CIDR_MATCHES"
+        values    = ["10.0.0.0/8"]
+      }
+    }
+  }
+}
+`, rName)
+}
+
+func testAccTrafficPolicyConfig_updated(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_mailmanager_traffic_policy" "test" {
+  default_action         = "ALLOW"
+  max_message_size_bytes = 1048576
+  traffic_policy_name    = %[1]q
+
+  policy_statement {
+    action = "DENY"
+
+    condition {
+      tls_expression {
+        attribute = "TLS_PROTOCOL"
+        operator  = "MINIMUM_TLS_VERSION"
+        value     = "TLS1_2"
+      }
+    }
+  }
+
+  policy_statement {
+    action = "DENY"
+
+    condition {
+      string_expression {
+        attribute = "RECIPIENT"
+        operator  = "ENDS_WITH"
+        values    = ["@example.com"]
+      }
+    }
+  }
+}
+`, rName)
+}
@@ -0,0 +1,60 @@
+package mailmanager
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/mailmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func waitIngressPointCreated(ctx context.Context, conn *mailmanager.MailManager, id string, timeout time.Duration) (*mailmanager.GetIngressPointOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{mailmanager.IngressPointStatusProvisioning},
+		Target:  []string{mailmanager.IngressPointStatusActive},
+		Refresh: statusIngressPoint(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*mailmanager.GetIngressPointOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitIngressPointUpdated(ctx context.Context, conn *mailmanager.MailManager, id string, timeout time.Duration) (*mailmanager.GetIngressPointOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{mailmanager.IngressPointStatusUpdating},
+		Target:  []string{mailmanager.IngressPointStatusActive, mailmanager.IngressPointStatusClosed},
+		Refresh: statusIngressPoint(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*mailmanager.GetIngressPointOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitIngressPointDeleted(ctx context.Context, conn *mailmanager.MailManager, id string, timeout time.Duration) (*mailmanager.GetIngressPointOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{mailmanager.IngressPointStatusActive, mailmanager.IngressPointStatusClosed, mailmanager.IngressPointStatusDeprovisioning},
+		Target:  []string{},
+		Refresh: statusIngressPoint(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*mailmanager.GetIngressPointOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,272 @@
+package mediapackagev2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mediapackagev2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourceChannel() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceChannelCreate,
+		ReadWithoutTimeout:   resourceChannelRead,
+		UpdateWithoutTimeout: resourceChannelUpdate,
+		DeleteWithoutTimeout: resourceChannelDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"channel_group_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validResourceName,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"ingest_endpoints": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"input_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(mediapackagev2.InputType_Values(), false),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validResourceName,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	ResNameChannel = "Channel"
+)
+
+func resourceChannelCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MediaPackageV2Conn
+
+	channelGroupName := d.Get("channel_group_name").(string)
+	name := d.Get("name").(string)
+	id := ChannelCreateResourceID(channelGroupName, name)
+	in := &mediapackagev2.CreateChannelInput{
+		ChannelGroupName: aws.String(channelGroupName),
+		ChannelName:      aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		in.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("input_type"); ok {
+		in.InputType = aws.String(v.(string))
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	if len(tags) > 0 {
+		in.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	_, err := conn.CreateChannelWithContext(ctx, in)
+	if err != nil {
+		return names.DiagError(names.MediaPackageV2, names.ErrActionCreating, ResNameChannel, id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceChannelRead(ctx, d, meta)
+}
+
+func resourceChannelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MediaPackageV2Conn
+
+	channelGroupName, name, err := ChannelParseResourceID(d.Id())
+
+	if err != nil {
+		return names.DiagError(names.MediaPackageV2, names.ErrActionReading, ResNameChannel, d.Id(), err)
+	}
+
+	out, err := FindChannelByTwoPartKey(ctx, conn, channelGroupName, name)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MediaPackage V2 Channel (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return names.DiagError(names.MediaPackageV2, names.ErrActionReading, ResNameChannel, d.Id(), err)
+	}
+
+	d.Set("arn", out.Arn)
+	d.Set("channel_group_name", out.ChannelGroupName)
+	d.Set("description", out.Description)
+	d.Set("input_type", out.InputType)
+	d.Set("name", out.ChannelName)
+
+	if err := d.Set("ingest_endpoints", flattenIngestEndpoints(out.IngestEndpoints)); err != nil {
+		return names.DiagError(names.MediaPackageV2, names.ErrActionSetting, ResNameChannel, d.Id(), err)
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	tags := KeyValueTags(out.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return names.DiagError(names.MediaPackageV2, names.ErrActionSetting, ResNameChannel, d.Id(), err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return names.DiagError(names.MediaPackageV2, names.ErrActionSetting, ResNameChannel, d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceChannelUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MediaPackageV2Conn
+
+	if d.HasChange("description") {
+		channelGroupName, name, err := ChannelParseResourceID(d.Id())
+
+		if err != nil {
+			return names.DiagError(names.MediaPackageV2, names.ErrActionUpdating, ResNameChannel, d.Id(), err)
+		}
+
+		in := &mediapackagev2.UpdateChannelInput{
+			ChannelGroupName: aws.String(channelGroupName),
+			ChannelName:      aws.String(name),
+			Description:      aws.String(d.Get("description").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating MediaPackage V2 Channel (%s): %s", d.Id(), in)
+		_, err = conn.UpdateChannelWithContext(ctx, in)
+		if err != nil {
+			return names.DiagError(names.MediaPackageV2, names.ErrActionUpdating, ResNameChannel, d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return names.DiagError(names.MediaPackageV2, names.ErrActionUpdating, ResNameChannel, d.Id(), err)
+		}
+	}
+
+	return resourceChannelRead(ctx, d, meta)
+}
+
+func resourceChannelDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MediaPackageV2Conn
+
+	channelGroupName, name, err := ChannelParseResourceID(d.Id())
+
+	if err != nil {
+		return names.DiagError(names.MediaPackageV2, names.ErrActionDeleting, ResNameChannel, d.Id(), err)
+	}
+
+	log.Printf("[INFO] Deleting MediaPackage V2 Channel %s", d.Id())
+
+	_, err = conn.DeleteChannelWithContext(ctx, &mediapackagev2.DeleteChannelInput{
+		ChannelGroupName: aws.String(channelGroupName),
+		ChannelName:      aws.String(name),
+	})
+
+	if tfawserr.ErrCodeEquals(err, mediapackagev2.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return names.DiagError(names.MediaPackageV2, names.ErrActionDeleting, ResNameChannel, d.Id(), err)
+	}
+
+	return nil
+}
+
+const channelResourceIDSeparator = ","
+
+func ChannelCreateResourceID(channelGroupName, channelName string) string {
+	parts := []string{channelGroupName, channelName}
+	id := strings.Join(parts, channelResourceIDSeparator)
+
+	return id
+}
+
+func ChannelParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, channelResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected CHANNEL-GROUP-NAME%[2]sCHANNEL-NAME", id, channelResourceIDSeparator)
+}
+
+func flattenIngestEndpoints(apiObjects []*mediapackagev2.IngestEndpoint) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"id":  aws.StringValue(apiObject.Id),
+			"url": aws.StringValue(apiObject.Url),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,179 @@
+package mediapackagev2
+
+import (
+	"context"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mediapackagev2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourceChannelGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceChannelGroupCreate,
+		ReadWithoutTimeout:   resourceChannelGroupRead,
+		UpdateWithoutTimeout: resourceChannelGroupUpdate,
+		DeleteWithoutTimeout: resourceChannelGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"egress_domain": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validResourceName,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	ResNameChannelGroup = "Channel Group"
+)
+
+var validResourceName = validation.All(
+	validation.StringLenBetween(1, 256),
+	validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_-]+$`), "must contain only alphanumeric characters, hyphens and underscores"),
+)
+
+func resourceChannelGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MediaPackageV2Conn
+
+	name := d.Get("name").(string)
+	in := &mediapackagev2.CreateChannelGroupInput{
+		ChannelGroupName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		in.Description = aws.String(v.(string))
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	if len(tags) > 0 {
+		in.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	_, err := conn.CreateChannelGroupWithContext(ctx, in)
+	if err != nil {
+		return names.DiagError(names.MediaPackageV2, names.ErrActionCreating, ResNameChannelGroup, name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceChannelGroupRead(ctx, d, meta)
+}
+
+func resourceChannelGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MediaPackageV2Conn
+
+	out, err := FindChannelGroupByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MediaPackage V2 Channel Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return names.DiagError(names.MediaPackageV2, names.ErrActionReading, ResNameChannelGroup, d.Id(), err)
+	}
+
+	d.Set("arn", out.Arn)
+	d.Set("description", out.Description)
+	d.Set("egress_domain", out.EgressDomain)
+	d.Set("name", out.ChannelGroupName)
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	tags := KeyValueTags(out.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return names.DiagError(names.MediaPackageV2, names.ErrActionSetting, ResNameChannelGroup, d.Id(), err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return names.DiagError(names.MediaPackageV2, names.ErrActionSetting, ResNameChannelGroup, d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceChannelGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MediaPackageV2Conn
+
+	if d.HasChange("description") {
+		in := &mediapackagev2.UpdateChannelGroupInput{
+			ChannelGroupName: aws.String(d.Id()),
+			Description:      aws.String(d.Get("description").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating MediaPackage V2 Channel Group (%s): %s", d.Id(), in)
+		_, err := conn.UpdateChannelGroupWithContext(ctx, in)
+		if err != nil {
+			return names.DiagError(names.MediaPackageV2, names.ErrActionUpdating, ResNameChannelGroup, d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return names.DiagError(names.MediaPackageV2, names.ErrActionUpdating, ResNameChannelGroup, d.Id(), err)
+		}
+	}
+
+	return resourceChannelGroupRead(ctx, d, meta)
+}
+
+func resourceChannelGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MediaPackageV2Conn
+
+	log.Printf("[INFO] Deleting MediaPackage V2 Channel Group %s", d.Id())
+
+	_, err := conn.DeleteChannelGroupWithContext(ctx, &mediapackagev2.DeleteChannelGroupInput{
+		ChannelGroupName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, mediapackagev2.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return names.DiagError(names.MediaPackageV2, names.ErrActionDeleting, ResNameChannelGroup, d.Id(), err)
+	}
+
+	return nil
+}
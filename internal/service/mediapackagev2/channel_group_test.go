@@ -0,0 +1,243 @@
+package mediapackagev2_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/mediapackagev2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmediapackagev2 "github.com/hashicorp/terraform-provider-aws/internal/service/mediapackagev2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccMediaPackageV2ChannelGroup_basic(t *testing.T) {
+	var channelGroup mediapackagev2.GetChannelGroupOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mediapackagev2_channel_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mediapackagev2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckChannelGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccChannelGroupConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelGroupExists(resourceName, &channelGroup),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "mediapackagev2", regexp.MustCompile(`channelGroup/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "egress_domain"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccMediaPackageV2ChannelGroup_disappears(t *testing.T) {
+	var channelGroup mediapackagev2.GetChannelGroupOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mediapackagev2_channel_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mediapackagev2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckChannelGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccChannelGroupConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelGroupExists(resourceName, &channelGroup),
+					acctest.CheckResourceDisappears(acctest.Provider, tfmediapackagev2.ResourceChannelGroup(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccMediaPackageV2ChannelGroup_description(t *testing.T) {
+	var channelGroup mediapackagev2.GetChannelGroupOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mediapackagev2_channel_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mediapackagev2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckChannelGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccChannelGroupConfig_description(rName, "description 1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelGroupExists(resourceName, &channelGroup),
+					resource.TestCheckResourceAttr(resourceName, "description", "description 1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccChannelGroupConfig_description(rName, "description 2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelGroupExists(resourceName, &channelGroup),
+					resource.TestCheckResourceAttr(resourceName, "description", "description 2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMediaPackageV2ChannelGroup_tags(t *testing.T) {
+	var channelGroup mediapackagev2.GetChannelGroupOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mediapackagev2_channel_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mediapackagev2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckChannelGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccChannelGroupConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelGroupExists(resourceName, &channelGroup),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccChannelGroupConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelGroupExists(resourceName, &channelGroup),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccChannelGroupConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelGroupExists(resourceName, &channelGroup),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckChannelGroupDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).MediaPackageV2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_mediapackagev2_channel_group" {
+			continue
+		}
+
+		_, err := tfmediapackagev2.FindChannelGroupByName(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return names.Error(names.MediaPackageV2, names.ErrActionCheckingDestroyed, tfmediapackagev2.ResNameChannelGroup, rs.Primary.ID, errors.New("not destroyed"))
+	}
+
+	return nil
+}
+
+func testAccCheckChannelGroupExists(name string, v *mediapackagev2.GetChannelGroupOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return names.Error(names.MediaPackageV2, names.ErrActionCheckingExistence, tfmediapackagev2.ResNameChannelGroup, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return names.Error(names.MediaPackageV2, names.ErrActionCheckingExistence, tfmediapackagev2.ResNameChannelGroup, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaPackageV2Conn
+
+		output, err := tfmediapackagev2.FindChannelGroupByName(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return names.Error(names.MediaPackageV2, names.ErrActionCheckingExistence, tfmediapackagev2.ResNameChannelGroup, rs.Primary.ID, err)
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccChannelGroupConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_mediapackagev2_channel_group" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccChannelGroupConfig_description(rName, description string) string {
+	return fmt.Sprintf(`
+resource "aws_mediapackagev2_channel_group" "test" {
+  name        = %[1]q
+  description = %[2]q
+}
+`, rName, description)
+}
+
+func testAccChannelGroupConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_mediapackagev2_channel_group" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccChannelGroupConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_mediapackagev2_channel_group" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
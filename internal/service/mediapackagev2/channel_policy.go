@@ -0,0 +1,152 @@
+package mediapackagev2
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mediapackagev2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourceChannelPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceChannelPolicyPut,
+		ReadWithoutTimeout:   resourceChannelPolicyRead,
+		UpdateWithoutTimeout: resourceChannelPolicyPut,
+		DeleteWithoutTimeout: resourceChannelPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"channel_group_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validResourceName,
+			},
+			"channel_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validResourceName,
+			},
+			"policy": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     verify.ValidIAMPolicyJSON,
+				DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+		},
+	}
+}
+
+const (
+	ResNameChannelPolicy = "Channel Policy"
+)
+
+func resourceChannelPolicyPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MediaPackageV2Conn
+
+	channelGroupName := d.Get("channel_group_name").(string)
+	channelName := d.Get("channel_name").(string)
+	id := ChannelCreateResourceID(channelGroupName, channelName)
+
+	policy, err := structure.NormalizeJsonString(d.Get("policy").(string))
+
+	if err != nil {
+		return names.DiagError(names.MediaPackageV2, names.ErrActionCreating, ResNameChannelPolicy, id, err)
+	}
+
+	in := &mediapackagev2.PutChannelPolicyInput{
+		ChannelGroupName: aws.String(channelGroupName),
+		ChannelName:      aws.String(channelName),
+		Policy:           aws.String(policy),
+	}
+
+	_, err = conn.PutChannelPolicyWithContext(ctx, in)
+	if err != nil {
+		return names.DiagError(names.MediaPackageV2, names.ErrActionCreating, ResNameChannelPolicy, id, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(id)
+	}
+
+	return resourceChannelPolicyRead(ctx, d, meta)
+}
+
+func resourceChannelPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MediaPackageV2Conn
+
+	channelGroupName, channelName, err := ChannelParseResourceID(d.Id())
+
+	if err != nil {
+		return names.DiagError(names.MediaPackageV2, names.ErrActionReading, ResNameChannelPolicy, d.Id(), err)
+	}
+
+	out, err := FindChannelPolicyByTwoPartKey(ctx, conn, channelGroupName, channelName)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MediaPackage V2 Channel Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return names.DiagError(names.MediaPackageV2, names.ErrActionReading, ResNameChannelPolicy, d.Id(), err)
+	}
+
+	d.Set("channel_group_name", out.ChannelGroupName)
+	d.Set("channel_name", out.ChannelName)
+
+	policyToSet, err := verify.PolicyToSet(d.Get("policy").(string), aws.StringValue(out.Policy))
+
+	if err != nil {
+		return names.DiagError(names.MediaPackageV2, names.ErrActionSetting, ResNameChannelPolicy, d.Id(), err)
+	}
+
+	d.Set("policy", policyToSet)
+
+	return nil
+}
+
+func resourceChannelPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MediaPackageV2Conn
+
+	channelGroupName, channelName, err := ChannelParseResourceID(d.Id())
+
+	if err != nil {
+		return names.DiagError(names.MediaPackageV2, names.ErrActionDeleting, ResNameChannelPolicy, d.Id(), err)
+	}
+
+	log.Printf("[INFO] Deleting MediaPackage V2 Channel Policy %s", d.Id())
+
+	_, err = conn.DeleteChannelPolicyWithContext(ctx, &mediapackagev2.DeleteChannelPolicyInput{
+		ChannelGroupName: aws.String(channelGroupName),
+		ChannelName:      aws.String(channelName),
+	})
+
+	if tfawserr.ErrCodeEquals(err, mediapackagev2.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return names.DiagError(names.MediaPackageV2, names.ErrActionDeleting, ResNameChannelPolicy, d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,161 @@
+package mediapackagev2_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/mediapackagev2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmediapackagev2 "github.com/hashicorp/terraform-provider-aws/internal/service/mediapackagev2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccMediaPackageV2ChannelPolicy_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mediapackagev2_channel_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mediapackagev2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckChannelPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccChannelPolicyConfig_basic(rName, "mediapackagev2:PutObject"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelPolicyExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "channel_group_name", "aws_mediapackagev2_channel_group.test", "name"),
+					resource.TestCheckResourceAttrPair(resourceName, "channel_name", "aws_mediapackagev2_channel.test", "name"),
+					resource.TestMatchResourceAttr(resourceName, "policy", regexp.MustCompile(`mediapackagev2:PutObject`)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccChannelPolicyConfig_basic(rName, "mediapackagev2:*"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelPolicyExists(resourceName),
+					resource.TestMatchResourceAttr(resourceName, "policy", regexp.MustCompile(`mediapackagev2:\*`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMediaPackageV2ChannelPolicy_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mediapackagev2_channel_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mediapackagev2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckChannelPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccChannelPolicyConfig_basic(rName, "mediapackagev2:PutObject"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelPolicyExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfmediapackagev2.ResourceChannelPolicy(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckChannelPolicyDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).MediaPackageV2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_mediapackagev2_channel_policy" {
+			continue
+		}
+
+		channelGroupName, channelName, err := tfmediapackagev2.ChannelParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfmediapackagev2.FindChannelPolicyByTwoPartKey(context.Background(), conn, channelGroupName, channelName)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return names.Error(names.MediaPackageV2, names.ErrActionCheckingDestroyed, tfmediapackagev2.ResNameChannelPolicy, rs.Primary.ID, errors.New("not destroyed"))
+	}
+
+	return nil
+}
+
+func testAccCheckChannelPolicyExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return names.Error(names.MediaPackageV2, names.ErrActionCheckingExistence, tfmediapackagev2.ResNameChannelPolicy, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return names.Error(names.MediaPackageV2, names.ErrActionCheckingExistence, tfmediapackagev2.ResNameChannelPolicy, name, errors.New("not set"))
+		}
+
+		channelGroupName, channelName, err := tfmediapackagev2.ChannelParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaPackageV2Conn
+
+		_, err = tfmediapackagev2.FindChannelPolicyByTwoPartKey(context.Background(), conn, channelGroupName, channelName)
+
+		if err != nil {
+			return names.Error(names.MediaPackageV2, names.ErrActionCheckingExistence, tfmediapackagev2.ResNameChannelPolicy, rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccChannelPolicyConfig_basic(rName, action string) string {
+	return acctest.ConfigCompose(testAccChannelConfig_basic(rName), fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_mediapackagev2_channel_policy" "test" {
+  channel_group_name = aws_mediapackagev2_channel.test.channel_group_name
+  channel_name       = aws_mediapackagev2_channel.test.name
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Sid    = "AllowIngest"
+      Effect = "Allow"
+      Principal = {
+        AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"
+      }
+      Action   = %[1]q
+      Resource = aws_mediapackagev2_channel.test.arn
+    }]
+  })
+}
+`, action))
+}
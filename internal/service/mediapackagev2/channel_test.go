@@ -0,0 +1,199 @@
+package mediapackagev2_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/mediapackagev2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmediapackagev2 "github.com/hashicorp/terraform-provider-aws/internal/service/mediapackagev2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccMediaPackageV2Channel_basic(t *testing.T) {
+	var channel mediapackagev2.GetChannelOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mediapackagev2_channel.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mediapackagev2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccChannelConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelExists(resourceName, &channel),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "mediapackagev2", regexp.MustCompile(`channelGroup/.+/channel/.+`)),
+					resource.TestCheckResourceAttrPair(resourceName, "channel_group_name", "aws_mediapackagev2_channel_group.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "ingest_endpoints.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "input_type", mediapackagev2.InputTypeHls),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccMediaPackageV2Channel_disappears(t *testing.T) {
+	var channel mediapackagev2.GetChannelOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mediapackagev2_channel.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mediapackagev2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccChannelConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelExists(resourceName, &channel),
+					acctest.CheckResourceDisappears(acctest.Provider, tfmediapackagev2.ResourceChannel(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccMediaPackageV2Channel_update(t *testing.T) {
+	var channel mediapackagev2.GetChannelOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mediapackagev2_channel.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mediapackagev2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccChannelConfig_update(rName, "description 1", "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelExists(resourceName, &channel),
+					resource.TestCheckResourceAttr(resourceName, "description", "description 1"),
+					resource.TestCheckResourceAttr(resourceName, "input_type", mediapackagev2.InputTypeCmaf),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccChannelConfig_update(rName, "description 2", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelExists(resourceName, &channel),
+					resource.TestCheckResourceAttr(resourceName, "description", "description 2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckChannelDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).MediaPackageV2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_mediapackagev2_channel" {
+			continue
+		}
+
+		channelGroupName, channelName, err := tfmediapackagev2.ChannelParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfmediapackagev2.FindChannelByTwoPartKey(context.Background(), conn, channelGroupName, channelName)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return names.Error(names.MediaPackageV2, names.ErrActionCheckingDestroyed, tfmediapackagev2.ResNameChannel, rs.Primary.ID, errors.New("not destroyed"))
+	}
+
+	return nil
+}
+
+func testAccCheckChannelExists(name string, v *mediapackagev2.GetChannelOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return names.Error(names.MediaPackageV2, names.ErrActionCheckingExistence, tfmediapackagev2.ResNameChannel, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return names.Error(names.MediaPackageV2, names.ErrActionCheckingExistence, tfmediapackagev2.ResNameChannel, name, errors.New("not set"))
+		}
+
+		channelGroupName, channelName, err := tfmediapackagev2.ChannelParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaPackageV2Conn
+
+		output, err := tfmediapackagev2.FindChannelByTwoPartKey(context.Background(), conn, channelGroupName, channelName)
+
+		if err != nil {
+			return names.Error(names.MediaPackageV2, names.ErrActionCheckingExistence, tfmediapackagev2.ResNameChannel, rs.Primary.ID, err)
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccChannelConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccChannelGroupConfig_basic(rName), fmt.Sprintf(`
+resource "aws_mediapackagev2_channel" "test" {
+  channel_group_name = aws_mediapackagev2_channel_group.test.name
+  name               = %[1]q
+}
+`, rName))
+}
+
+func testAccChannelConfig_update(rName, description, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccChannelGroupConfig_basic(rName), fmt.Sprintf(`
+resource "aws_mediapackagev2_channel" "test" {
+  channel_group_name = aws_mediapackagev2_channel_group.test.name
+  name               = %[1]q
+  description        = %[2]q
+  input_type         = "CMAF"
+
+  tags = {
+    %[3]q = %[4]q
+  }
+}
+`, rName, description, tagKey1, tagValue1))
+}
@@ -0,0 +1,115 @@
+package mediapackagev2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mediapackagev2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindChannelGroupByName(ctx context.Context, conn *mediapackagev2.MediaPackageV2, name string) (*mediapackagev2.GetChannelGroupOutput, error) {
+	input := &mediapackagev2.GetChannelGroupInput{
+		ChannelGroupName: aws.String(name),
+	}
+
+	output, err := conn.GetChannelGroupWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, mediapackagev2.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindChannelByTwoPartKey(ctx context.Context, conn *mediapackagev2.MediaPackageV2, channelGroupName, channelName string) (*mediapackagev2.GetChannelOutput, error) {
+	input := &mediapackagev2.GetChannelInput{
+		ChannelGroupName: aws.String(channelGroupName),
+		ChannelName:      aws.String(channelName),
+	}
+
+	output, err := conn.GetChannelWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, mediapackagev2.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindChannelPolicyByTwoPartKey(ctx context.Context, conn *mediapackagev2.MediaPackageV2, channelGroupName, channelName string) (*mediapackagev2.GetChannelPolicyOutput, error) {
+	input := &mediapackagev2.GetChannelPolicyInput{
+		ChannelGroupName: aws.String(channelGroupName),
+		ChannelName:      aws.String(channelName),
+	}
+
+	output, err := conn.GetChannelPolicyWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, mediapackagev2.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || aws.StringValue(output.Policy) == "" {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindOriginEndpointByThreePartKey(ctx context.Context, conn *mediapackagev2.MediaPackageV2, channelGroupName, channelName, originEndpointName string) (*mediapackagev2.GetOriginEndpointOutput, error) {
+	input := &mediapackagev2.GetOriginEndpointInput{
+		ChannelGroupName:   aws.String(channelGroupName),
+		ChannelName:        aws.String(channelName),
+		OriginEndpointName: aws.String(originEndpointName),
+	}
+
+	output, err := conn.GetOriginEndpointWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, mediapackagev2.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,1205 @@
+package mediapackagev2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mediapackagev2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourceOriginEndpoint() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceOriginEndpointCreate,
+		ReadWithoutTimeout:   resourceOriginEndpointRead,
+		UpdateWithoutTimeout: resourceOriginEndpointUpdate,
+		DeleteWithoutTimeout: resourceOriginEndpointDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"channel_group_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validResourceName,
+			},
+			"channel_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validResourceName,
+			},
+			"container_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(mediapackagev2.ContainerType_Values(), false),
+			},
+			"dash_manifest": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"drm_signaling": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(mediapackagev2.DashDrmSignaling_Values(), false),
+						},
+						"filter_configuration": filterConfigurationSchema(),
+						"manifest_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validResourceName,
+						},
+						"manifest_window_seconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(30),
+						},
+						"min_buffer_time_seconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(0, 3600),
+						},
+						"min_update_period_seconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(1, 3600),
+						},
+						"period_triggers": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(mediapackagev2.DashPeriodTrigger_Values(), false),
+							},
+						},
+						"scte_dash": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"ad_marker_dash": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.StringInSlice(mediapackagev2.AdMarkerDash_Values(), false),
+									},
+								},
+							},
+						},
+						"segment_template_format": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(mediapackagev2.DashSegmentTemplateFormat_Values(), false),
+						},
+						"suggested_presentation_delay_seconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(0, 3600),
+						},
+						"url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"utc_timing": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"timing_mode": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(mediapackagev2.DashUtcTimingMode_Values(), false),
+									},
+									"timing_source": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 1024),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"force_endpoint_error_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"endpoint_error_conditions": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(mediapackagev2.EndpointErrorCondition_Values(), false),
+							},
+						},
+					},
+				},
+			},
+			"hls_manifest":             hlsManifestSchema(),
+			"low_latency_hls_manifest": hlsManifestSchema(),
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validResourceName,
+			},
+			"segment": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"encryption": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"constant_initialization_vector": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(32, 32),
+									},
+									"encryption_method": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"cmaf_encryption_method": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringInSlice(mediapackagev2.CmafEncryptionMethod_Values(), false),
+												},
+												"ts_encryption_method": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringInSlice(mediapackagev2.TsEncryptionMethod_Values(), false),
+												},
+											},
+										},
+									},
+									"key_rotation_interval_seconds": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.IntBetween(300, 31536000),
+									},
+									"speke_key_provider": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"drm_systems": {
+													Type:     schema.TypeSet,
+													Required: true,
+													MinItems: 1,
+													Elem: &schema.Schema{
+														Type:         schema.TypeString,
+														ValidateFunc: validation.StringInSlice(mediapackagev2.DrmSystem_Values(), false),
+													},
+												},
+												"encryption_contract_configuration": {
+													Type:     schema.TypeList,
+													Required: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"preset_speke20_audio": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringInSlice(mediapackagev2.PresetSpeke20Audio_Values(), false),
+															},
+															"preset_speke20_video": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringInSlice(mediapackagev2.PresetSpeke20Video_Values(), false),
+															},
+														},
+													},
+												},
+												"resource_id": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 256),
+												},
+												"role_arn": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+												"url": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.IsURLWithHTTPS,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"include_iframe_only_streams": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"scte": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"scte_filter": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringInSlice(mediapackagev2.ScteFilter_Values(), false),
+										},
+									},
+								},
+							},
+						},
+						"segment_duration_seconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(1, 30),
+						},
+						"segment_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validResourceName,
+						},
+						"ts_include_dvb_subtitles": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"ts_use_audio_rendition_group": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"startover_window_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(60, 1209600),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func hlsManifestSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"child_manifest_name": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validResourceName,
+				},
+				"filter_configuration": filterConfigurationSchema(),
+				"manifest_name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validResourceName,
+				},
+				"manifest_window_seconds": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.IntAtLeast(30),
+				},
+				"program_date_time_interval_seconds": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"scte_hls": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Computed: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"ad_marker_hls": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.StringInSlice(mediapackagev2.AdMarkerHls_Values(), false),
+							},
+						},
+					},
+				},
+				"url": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func filterConfigurationSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"end": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: verify.ValidUTCTimestamp,
+				},
+				"manifest_filter": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(1, 1024),
+				},
+				"start": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: verify.ValidUTCTimestamp,
+				},
+				"time_delay_seconds": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(0, 1209600),
+				},
+			},
+		},
+	}
+}
+
+const (
+	ResNameOriginEndpoint = "Origin Endpoint"
+)
+
+func resourceOriginEndpointCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MediaPackageV2Conn
+
+	channelGroupName := d.Get("channel_group_name").(string)
+	channelName := d.Get("channel_name").(string)
+	name := d.Get("name").(string)
+	id := OriginEndpointCreateResourceID(channelGroupName, channelName, name)
+	in := &mediapackagev2.CreateOriginEndpointInput{
+		ChannelGroupName:   aws.String(channelGroupName),
+		ChannelName:        aws.String(channelName),
+		ContainerType:      aws.String(d.Get("container_type").(string)),
+		OriginEndpointName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("dash_manifest"); ok && len(v.([]interface{})) > 0 {
+		in.DashManifests = expandDashManifestConfigurations(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		in.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("force_endpoint_error_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		in.ForceEndpointErrorConfiguration = expandForceEndpointErrorConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("hls_manifest"); ok && len(v.([]interface{})) > 0 {
+		in.HlsManifests = expandHlsManifestConfigurations(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("low_latency_hls_manifest"); ok && len(v.([]interface{})) > 0 {
+		in.LowLatencyHlsManifests = expandLowLatencyHlsManifestConfigurations(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("segment"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		in.Segment = expandSegment(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("startover_window_seconds"); ok {
+		in.StartoverWindowSeconds = aws.Int64(int64(v.(int)))
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	if len(tags) > 0 {
+		in.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	_, err := conn.CreateOriginEndpointWithContext(ctx, in)
+	if err != nil {
+		return names.DiagError(names.MediaPackageV2, names.ErrActionCreating, ResNameOriginEndpoint, id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceOriginEndpointRead(ctx, d, meta)
+}
+
+func resourceOriginEndpointRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MediaPackageV2Conn
+
+	channelGroupName, channelName, name, err := OriginEndpointParseResourceID(d.Id())
+
+	if err != nil {
+		return names.DiagError(names.MediaPackageV2, names.ErrActionReading, ResNameOriginEndpoint, d.Id(), err)
+	}
+
+	out, err := FindOriginEndpointByThreePartKey(ctx, conn, channelGroupName, channelName, name)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MediaPackage V2 Origin Endpoint (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return names.DiagError(names.MediaPackageV2, names.ErrActionReading, ResNameOriginEndpoint, d.Id(), err)
+	}
+
+	d.Set("arn", out.Arn)
+	d.Set("channel_group_name", out.ChannelGroupName)
+	d.Set("channel_name", out.ChannelName)
+	d.Set("container_type", out.ContainerType)
+	d.Set("description", out.Description)
+	d.Set("name", out.OriginEndpointName)
+	d.Set("startover_window_seconds", out.StartoverWindowSeconds)
+
+	if err := d.Set("dash_manifest", flattenDashManifestConfigurations(out.DashManifests)); err != nil {
+		return names.DiagError(names.MediaPackageV2, names.ErrActionSetting, ResNameOriginEndpoint, d.Id(), err)
+	}
+
+	if err := d.Set("force_endpoint_error_configuration", flattenForceEndpointErrorConfiguration(out.ForceEndpointErrorConfiguration)); err != nil {
+		return names.DiagError(names.MediaPackageV2, names.ErrActionSetting, ResNameOriginEndpoint, d.Id(), err)
+	}
+
+	if err := d.Set("hls_manifest", flattenHlsManifestConfigurations(out.HlsManifests)); err != nil {
+		return names.DiagError(names.MediaPackageV2, names.ErrActionSetting, ResNameOriginEndpoint, d.Id(), err)
+	}
+
+	if err := d.Set("low_latency_hls_manifest", flattenLowLatencyHlsManifestConfigurations(out.LowLatencyHlsManifests)); err != nil {
+		return names.DiagError(names.MediaPackageV2, names.ErrActionSetting, ResNameOriginEndpoint, d.Id(), err)
+	}
+
+	if err := d.Set("segment", flattenSegment(out.Segment)); err != nil {
+		return names.DiagError(names.MediaPackageV2, names.ErrActionSetting, ResNameOriginEndpoint, d.Id(), err)
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	tags := KeyValueTags(out.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return names.DiagError(names.MediaPackageV2, names.ErrActionSetting, ResNameOriginEndpoint, d.Id(), err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return names.DiagError(names.MediaPackageV2, names.ErrActionSetting, ResNameOriginEndpoint, d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceOriginEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MediaPackageV2Conn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		channelGroupName, channelName, name, err := OriginEndpointParseResourceID(d.Id())
+
+		if err != nil {
+			return names.DiagError(names.MediaPackageV2, names.ErrActionUpdating, ResNameOriginEndpoint, d.Id(), err)
+		}
+
+		// The update replaces the origin endpoint's configuration, so send it in full.
+		// Empty lists remove all manifests of the corresponding type.
+		in := &mediapackagev2.UpdateOriginEndpointInput{
+			ChannelGroupName:       aws.String(channelGroupName),
+			ChannelName:            aws.String(channelName),
+			ContainerType:          aws.String(d.Get("container_type").(string)),
+			DashManifests:          expandDashManifestConfigurations(d.Get("dash_manifest").([]interface{})),
+			Description:            aws.String(d.Get("description").(string)),
+			HlsManifests:           expandHlsManifestConfigurations(d.Get("hls_manifest").([]interface{})),
+			LowLatencyHlsManifests: expandLowLatencyHlsManifestConfigurations(d.Get("low_latency_hls_manifest").([]interface{})),
+			OriginEndpointName:     aws.String(name),
+		}
+
+		if v, ok := d.GetOk("force_endpoint_error_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			in.ForceEndpointErrorConfiguration = expandForceEndpointErrorConfiguration(v.([]interface{})[0].(map[string]interface{}))
+		} else {
+			in.ForceEndpointErrorConfiguration = &mediapackagev2.ForceEndpointErrorConfiguration{
+				EndpointErrorConditions: []*string{},
+			}
+		}
+
+		if v, ok := d.GetOk("segment"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			in.Segment = expandSegment(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if v, ok := d.GetOk("startover_window_seconds"); ok {
+			in.StartoverWindowSeconds = aws.Int64(int64(v.(int)))
+		}
+
+		log.Printf("[DEBUG] Updating MediaPackage V2 Origin Endpoint (%s): %s", d.Id(), in)
+		_, err = conn.UpdateOriginEndpointWithContext(ctx, in)
+		if err != nil {
+			return names.DiagError(names.MediaPackageV2, names.ErrActionUpdating, ResNameOriginEndpoint, d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return names.DiagError(names.MediaPackageV2, names.ErrActionUpdating, ResNameOriginEndpoint, d.Id(), err)
+		}
+	}
+
+	return resourceOriginEndpointRead(ctx, d, meta)
+}
+
+func resourceOriginEndpointDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MediaPackageV2Conn
+
+	channelGroupName, channelName, name, err := OriginEndpointParseResourceID(d.Id())
+
+	if err != nil {
+		return names.DiagError(names.MediaPackageV2, names.ErrActionDeleting, ResNameOriginEndpoint, d.Id(), err)
+	}
+
+	log.Printf("[INFO] Deleting MediaPackage V2 Origin Endpoint %s", d.Id())
+
+	_, err = conn.DeleteOriginEndpointWithContext(ctx, &mediapackagev2.DeleteOriginEndpointInput{
+		ChannelGroupName:   aws.String(channelGroupName),
+		ChannelName:        aws.String(channelName),
+		OriginEndpointName: aws.String(name),
+	})
+
+	if tfawserr.ErrCodeEquals(err, mediapackagev2.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return names.DiagError(names.MediaPackageV2, names.ErrActionDeleting, ResNameOriginEndpoint, d.Id(), err)
+	}
+
+	return nil
+}
+
+const originEndpointResourceIDSeparator = ","
+
+func OriginEndpointCreateResourceID(channelGroupName, channelName, originEndpointName string) string {
+	parts := []string{channelGroupName, channelName, originEndpointName}
+	id := strings.Join(parts, originEndpointResourceIDSeparator)
+
+	return id
+}
+
+func OriginEndpointParseResourceID(id string) (string, string, string, error) {
+	parts := strings.Split(id, originEndpointResourceIDSeparator)
+
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected CHANNEL-GROUP-NAME%[2]sCHANNEL-NAME%[2]sORIGIN-ENDPOINT-NAME", id, originEndpointResourceIDSeparator)
+}
+
+func expandSegment(tfMap map[string]interface{}) *mediapackagev2.Segment {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &mediapackagev2.Segment{}
+
+	if v, ok := tfMap["encryption"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Encryption = expandEncryption(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["include_iframe_only_streams"].(bool); ok {
+		apiObject.IncludeIframeOnlyStreams = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["scte"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Scte = expandScte(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["segment_duration_seconds"].(int); ok && v != 0 {
+		apiObject.SegmentDurationSeconds = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["segment_name"].(string); ok && v != "" {
+		apiObject.SegmentName = aws.String(v)
+	}
+
+	if v, ok := tfMap["ts_include_dvb_subtitles"].(bool); ok {
+		apiObject.TsIncludeDvbSubtitles = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["ts_use_audio_rendition_group"].(bool); ok {
+		apiObject.TsUseAudioRenditionGroup = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func expandEncryption(tfMap map[string]interface{}) *mediapackagev2.Encryption {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &mediapackagev2.Encryption{}
+
+	if v, ok := tfMap["constant_initialization_vector"].(string); ok && v != "" {
+		apiObject.ConstantInitializationVector = aws.String(v)
+	}
+
+	if v, ok := tfMap["encryption_method"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.EncryptionMethod = expandEncryptionMethod(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["key_rotation_interval_seconds"].(int); ok && v != 0 {
+		apiObject.KeyRotationIntervalSeconds = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["speke_key_provider"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SpekeKeyProvider = expandSpekeKeyProvider(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandEncryptionMethod(tfMap map[string]interface{}) *mediapackagev2.EncryptionMethod {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &mediapackagev2.EncryptionMethod{}
+
+	if v, ok := tfMap["cmaf_encryption_method"].(string); ok && v != "" {
+		apiObject.CmafEncryptionMethod = aws.String(v)
+	}
+
+	if v, ok := tfMap["ts_encryption_method"].(string); ok && v != "" {
+		apiObject.TsEncryptionMethod = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandSpekeKeyProvider(tfMap map[string]interface{}) *mediapackagev2.SpekeKeyProvider {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &mediapackagev2.SpekeKeyProvider{}
+
+	if v, ok := tfMap["drm_systems"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.DrmSystems = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["encryption_contract_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.EncryptionContractConfiguration = &mediapackagev2.EncryptionContractConfiguration{
+			PresetSpeke20Audio: aws.String(tfMap["preset_speke20_audio"].(string)),
+			PresetSpeke20Video: aws.String(tfMap["preset_speke20_video"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["resource_id"].(string); ok && v != "" {
+		apiObject.ResourceId = aws.String(v)
+	}
+
+	if v, ok := tfMap["role_arn"].(string); ok && v != "" {
+		apiObject.RoleArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["url"].(string); ok && v != "" {
+		apiObject.Url = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandScte(tfMap map[string]interface{}) *mediapackagev2.Scte {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &mediapackagev2.Scte{}
+
+	if v, ok := tfMap["scte_filter"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ScteFilter = flex.ExpandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func expandForceEndpointErrorConfiguration(tfMap map[string]interface{}) *mediapackagev2.ForceEndpointErrorConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &mediapackagev2.ForceEndpointErrorConfiguration{}
+
+	if v, ok := tfMap["endpoint_error_conditions"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.EndpointErrorConditions = flex.ExpandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func expandFilterConfiguration(tfMap map[string]interface{}) *mediapackagev2.FilterConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &mediapackagev2.FilterConfiguration{}
+
+	if v, ok := tfMap["end"].(string); ok && v != "" {
+		t, _ := time.Parse(time.RFC3339, v)
+		apiObject.End = aws.Time(t)
+	}
+
+	if v, ok := tfMap["manifest_filter"].(string); ok && v != "" {
+		apiObject.ManifestFilter = aws.String(v)
+	}
+
+	if v, ok := tfMap["start"].(string); ok && v != "" {
+		t, _ := time.Parse(time.RFC3339, v)
+		apiObject.Start = aws.Time(t)
+	}
+
+	if v, ok := tfMap["time_delay_seconds"].(int); ok && v != 0 {
+		apiObject.TimeDelaySeconds = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandScteHls(tfList []interface{}) *mediapackagev2.ScteHls {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &mediapackagev2.ScteHls{}
+
+	if v, ok := tfMap["ad_marker_hls"].(string); ok && v != "" {
+		apiObject.AdMarkerHls = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandHlsManifestConfigurations(tfList []interface{}) []*mediapackagev2.CreateHlsManifestConfiguration {
+	apiObjects := []*mediapackagev2.CreateHlsManifestConfiguration{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &mediapackagev2.CreateHlsManifestConfiguration{
+			ManifestName: aws.String(tfMap["manifest_name"].(string)),
+		}
+
+		if v, ok := tfMap["child_manifest_name"].(string); ok && v != "" {
+			apiObject.ChildManifestName = aws.String(v)
+		}
+
+		if v, ok := tfMap["filter_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.FilterConfiguration = expandFilterConfiguration(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["manifest_window_seconds"].(int); ok && v != 0 {
+			apiObject.ManifestWindowSeconds = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["program_date_time_interval_seconds"].(int); ok && v != 0 {
+			apiObject.ProgramDateTimeIntervalSeconds = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["scte_hls"].([]interface{}); ok {
+			apiObject.ScteHls = expandScteHls(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandLowLatencyHlsManifestConfigurations(tfList []interface{}) []*mediapackagev2.CreateLowLatencyHlsManifestConfiguration {
+	apiObjects := []*mediapackagev2.CreateLowLatencyHlsManifestConfiguration{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &mediapackagev2.CreateLowLatencyHlsManifestConfiguration{
+			ManifestName: aws.String(tfMap["manifest_name"].(string)),
+		}
+
+		if v, ok := tfMap["child_manifest_name"].(string); ok && v != "" {
+			apiObject.ChildManifestName = aws.String(v)
+		}
+
+		if v, ok := tfMap["filter_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.FilterConfiguration = expandFilterConfiguration(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["manifest_window_seconds"].(int); ok && v != 0 {
+			apiObject.ManifestWindowSeconds = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["program_date_time_interval_seconds"].(int); ok && v != 0 {
+			apiObject.ProgramDateTimeIntervalSeconds = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["scte_hls"].([]interface{}); ok {
+			apiObject.ScteHls = expandScteHls(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandDashManifestConfigurations(tfList []interface{}) []*mediapackagev2.CreateDashManifestConfiguration {
+	apiObjects := []*mediapackagev2.CreateDashManifestConfiguration{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &mediapackagev2.CreateDashManifestConfiguration{
+			ManifestName: aws.String(tfMap["manifest_name"].(string)),
+		}
+
+		if v, ok := tfMap["drm_signaling"].(string); ok && v != "" {
+			apiObject.DrmSignaling = aws.String(v)
+		}
+
+		if v, ok := tfMap["filter_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.FilterConfiguration = expandFilterConfiguration(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["manifest_window_seconds"].(int); ok && v != 0 {
+			apiObject.ManifestWindowSeconds = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["min_buffer_time_seconds"].(int); ok && v != 0 {
+			apiObject.MinBufferTimeSeconds = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["min_update_period_seconds"].(int); ok && v != 0 {
+			apiObject.MinUpdatePeriodSeconds = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["period_triggers"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.PeriodTriggers = flex.ExpandStringSet(v)
+		}
+
+		if v, ok := tfMap["scte_dash"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			if v, ok := v[0].(map[string]interface{})["ad_marker_dash"].(string); ok && v != "" {
+				apiObject.ScteDash = &mediapackagev2.ScteDash{
+					AdMarkerDash: aws.String(v),
+				}
+			}
+		}
+
+		if v, ok := tfMap["segment_template_format"].(string); ok && v != "" {
+			apiObject.SegmentTemplateFormat = aws.String(v)
+		}
+
+		if v, ok := tfMap["suggested_presentation_delay_seconds"].(int); ok && v != 0 {
+			apiObject.SuggestedPresentationDelaySeconds = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["utc_timing"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			utcTiming := &mediapackagev2.DashUtcTiming{}
+
+			if v, ok := tfMap["timing_mode"].(string); ok && v != "" {
+				utcTiming.TimingMode = aws.String(v)
+			}
+
+			if v, ok := tfMap["timing_source"].(string); ok && v != "" {
+				utcTiming.TimingSource = aws.String(v)
+			}
+
+			apiObject.UtcTiming = utcTiming
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenSegment(apiObject *mediapackagev2.Segment) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"encryption":                   flattenEncryption(apiObject.Encryption),
+		"include_iframe_only_streams":  aws.BoolValue(apiObject.IncludeIframeOnlyStreams),
+		"segment_duration_seconds":     aws.Int64Value(apiObject.SegmentDurationSeconds),
+		"segment_name":                 aws.StringValue(apiObject.SegmentName),
+		"ts_include_dvb_subtitles":     aws.BoolValue(apiObject.TsIncludeDvbSubtitles),
+		"ts_use_audio_rendition_group": aws.BoolValue(apiObject.TsUseAudioRenditionGroup),
+	}
+
+	if v := apiObject.Scte; v != nil {
+		tfMap["scte"] = []interface{}{map[string]interface{}{
+			"scte_filter": aws.StringValueSlice(v.ScteFilter),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenEncryption(apiObject *mediapackagev2.Encryption) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"constant_initialization_vector": aws.StringValue(apiObject.ConstantInitializationVector),
+		"key_rotation_interval_seconds":  aws.Int64Value(apiObject.KeyRotationIntervalSeconds),
+	}
+
+	if v := apiObject.EncryptionMethod; v != nil {
+		tfMap["encryption_method"] = []interface{}{map[string]interface{}{
+			"cmaf_encryption_method": aws.StringValue(v.CmafEncryptionMethod),
+			"ts_encryption_method":   aws.StringValue(v.TsEncryptionMethod),
+		}}
+	}
+
+	if v := apiObject.SpekeKeyProvider; v != nil {
+		spekeKeyProvider := map[string]interface{}{
+			"drm_systems": aws.StringValueSlice(v.DrmSystems),
+			"resource_id": aws.StringValue(v.ResourceId),
+			"role_arn":    aws.StringValue(v.RoleArn),
+			"url":         aws.StringValue(v.Url),
+		}
+
+		if v := v.EncryptionContractConfiguration; v != nil {
+			spekeKeyProvider["encryption_contract_configuration"] = []interface{}{map[string]interface{}{
+				"preset_speke20_audio": aws.StringValue(v.PresetSpeke20Audio),
+				"preset_speke20_video": aws.StringValue(v.PresetSpeke20Video),
+			}}
+		}
+
+		tfMap["speke_key_provider"] = []interface{}{spekeKeyProvider}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenForceEndpointErrorConfiguration(apiObject *mediapackagev2.ForceEndpointErrorConfiguration) []interface{} {
+	if apiObject == nil || len(apiObject.EndpointErrorConditions) == 0 {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"endpoint_error_conditions": aws.StringValueSlice(apiObject.EndpointErrorConditions),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenFilterConfiguration(apiObject *mediapackagev2.FilterConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"manifest_filter":    aws.StringValue(apiObject.ManifestFilter),
+		"time_delay_seconds": aws.Int64Value(apiObject.TimeDelaySeconds),
+	}
+
+	if v := apiObject.End; v != nil {
+		tfMap["end"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	if v := apiObject.Start; v != nil {
+		tfMap["start"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenScteHls(apiObject *mediapackagev2.ScteHls) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"ad_marker_hls": aws.StringValue(apiObject.AdMarkerHls),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenHlsManifestConfigurations(apiObjects []*mediapackagev2.GetHlsManifestConfiguration) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"child_manifest_name":                aws.StringValue(apiObject.ChildManifestName),
+			"filter_configuration":               flattenFilterConfiguration(apiObject.FilterConfiguration),
+			"manifest_name":                      aws.StringValue(apiObject.ManifestName),
+			"manifest_window_seconds":            aws.Int64Value(apiObject.ManifestWindowSeconds),
+			"program_date_time_interval_seconds": aws.Int64Value(apiObject.ProgramDateTimeIntervalSeconds),
+			"scte_hls":                           flattenScteHls(apiObject.ScteHls),
+			"url":                                aws.StringValue(apiObject.Url),
+		})
+	}
+
+	return tfList
+}
+
+func flattenLowLatencyHlsManifestConfigurations(apiObjects []*mediapackagev2.GetLowLatencyHlsManifestConfiguration) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"child_manifest_name":                aws.StringValue(apiObject.ChildManifestName),
+			"filter_configuration":               flattenFilterConfiguration(apiObject.FilterConfiguration),
+			"manifest_name":                      aws.StringValue(apiObject.ManifestName),
+			"manifest_window_seconds":            aws.Int64Value(apiObject.ManifestWindowSeconds),
+			"program_date_time_interval_seconds": aws.Int64Value(apiObject.ProgramDateTimeIntervalSeconds),
+			"scte_hls":                           flattenScteHls(apiObject.ScteHls),
+			"url":                                aws.StringValue(apiObject.Url),
+		})
+	}
+
+	return tfList
+}
+
+func flattenDashManifestConfigurations(apiObjects []*mediapackagev2.GetDashManifestConfiguration) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"drm_signaling":                        aws.StringValue(apiObject.DrmSignaling),
+			"filter_configuration":                 flattenFilterConfiguration(apiObject.FilterConfiguration),
+			"manifest_name":                        aws.StringValue(apiObject.ManifestName),
+			"manifest_window_seconds":              aws.Int64Value(apiObject.ManifestWindowSeconds),
+			"min_buffer_time_seconds":              aws.Int64Value(apiObject.MinBufferTimeSeconds),
+			"min_update_period_seconds":            aws.Int64Value(apiObject.MinUpdatePeriodSeconds),
+			"period_triggers":                      aws.StringValueSlice(apiObject.PeriodTriggers),
+			"segment_template_format":              aws.StringValue(apiObject.SegmentTemplateFormat),
+			"suggested_presentation_delay_seconds": aws.Int64Value(apiObject.SuggestedPresentationDelaySeconds),
+			"url":                                  aws.StringValue(apiObject.Url),
+		}
+
+		if v := apiObject.ScteDash; v != nil {
+			tfMap["scte_dash"] = []interface{}{map[string]interface{}{
+				"ad_marker_dash": aws.StringValue(v.AdMarkerDash),
+			}}
+		}
+
+		if v := apiObject.UtcTiming; v != nil {
+			tfMap["utc_timing"] = []interface{}{map[string]interface{}{
+				"timing_mode":   aws.StringValue(v.TimingMode),
+				"timing_source": aws.StringValue(v.TimingSource),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,389 @@
+package mediapackagev2_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/mediapackagev2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmediapackagev2 "github.com/hashicorp/terraform-provider-aws/internal/service/mediapackagev2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestOriginEndpointParseResourceID(t *testing.T) {
+	testCases := []struct {
+		TestName                 string
+		InputID                  string
+		ExpectedError            *regexp.Regexp
+		ExpectedChannelGroupName string
+		ExpectedChannelName      string
+		ExpectedName             string
+	}{
+		{
+			TestName:      "empty ID",
+			InputID:       "",
+			ExpectedError: regexp.MustCompile(`unexpected format for ID`),
+		},
+		{
+			TestName:      "two parts",
+			InputID:       "group,channel",
+			ExpectedError: regexp.MustCompile(`unexpected format for ID`),
+		},
+		{
+			TestName:      "empty part",
+			InputID:       "group,,endpoint",
+			ExpectedError: regexp.MustCompile(`unexpected format for ID`),
+		},
+		{
+			TestName:                 "valid ID",
+			InputID:                  "group,channel,endpoint",
+			ExpectedChannelGroupName: "group",
+			ExpectedChannelName:      "channel",
+			ExpectedName:             "endpoint",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotChannelGroupName, gotChannelName, gotName, err := tfmediapackagev2.OriginEndpointParseResourceID(testCase.InputID)
+
+			if err == nil && testCase.ExpectedError != nil {
+				t.Fatalf("expected error %s, got no error", testCase.ExpectedError.String())
+			}
+
+			if err != nil && testCase.ExpectedError == nil {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if err != nil && !testCase.ExpectedError.MatchString(err.Error()) {
+				t.Fatalf("expected error %s, got: %s", testCase.ExpectedError.String(), err)
+			}
+
+			if gotChannelGroupName != testCase.ExpectedChannelGroupName {
+				t.Errorf("got channel group name %s, expected %s", gotChannelGroupName, testCase.ExpectedChannelGroupName)
+			}
+
+			if gotChannelName != testCase.ExpectedChannelName {
+				t.Errorf("got channel name %s, expected %s", gotChannelName, testCase.ExpectedChannelName)
+			}
+
+			if gotName != testCase.ExpectedName {
+				t.Errorf("got origin endpoint name %s, expected %s", gotName, testCase.ExpectedName)
+			}
+		})
+	}
+}
+
+func TestAccMediaPackageV2OriginEndpoint_basic(t *testing.T) {
+	var originEndpoint mediapackagev2.GetOriginEndpointOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mediapackagev2_origin_endpoint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mediapackagev2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOriginEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOriginEndpointConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOriginEndpointExists(resourceName, &originEndpoint),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "mediapackagev2", regexp.MustCompile(`channelGroup/.+/channel/.+/originEndpoint/.+`)),
+					resource.TestCheckResourceAttrPair(resourceName, "channel_group_name", "aws_mediapackagev2_channel_group.test", "name"),
+					resource.TestCheckResourceAttrPair(resourceName, "channel_name", "aws_mediapackagev2_channel.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "container_type", mediapackagev2.ContainerTypeTs),
+					resource.TestCheckResourceAttr(resourceName, "dash_manifest.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "hls_manifest.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "low_latency_hls_manifest.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "segment.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccMediaPackageV2OriginEndpoint_disappears(t *testing.T) {
+	var originEndpoint mediapackagev2.GetOriginEndpointOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mediapackagev2_origin_endpoint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mediapackagev2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOriginEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOriginEndpointConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOriginEndpointExists(resourceName, &originEndpoint),
+					acctest.CheckResourceDisappears(acctest.Provider, tfmediapackagev2.ResourceOriginEndpoint(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccMediaPackageV2OriginEndpoint_manifests(t *testing.T) {
+	var originEndpoint mediapackagev2.GetOriginEndpointOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mediapackagev2_origin_endpoint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mediapackagev2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOriginEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOriginEndpointConfig_hlsManifests(rName, 60),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOriginEndpointExists(resourceName, &originEndpoint),
+					resource.TestCheckResourceAttr(resourceName, "container_type", mediapackagev2.ContainerTypeCmaf),
+					resource.TestCheckResourceAttr(resourceName, "hls_manifest.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "hls_manifest.0.manifest_name", "index"),
+					resource.TestCheckResourceAttr(resourceName, "hls_manifest.0.manifest_window_seconds", "60"),
+					resource.TestCheckResourceAttrSet(resourceName, "hls_manifest.0.url"),
+					resource.TestCheckResourceAttr(resourceName, "low_latency_hls_manifest.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "low_latency_hls_manifest.0.manifest_name", "lowlatency"),
+					resource.TestCheckResourceAttrSet(resourceName, "low_latency_hls_manifest.0.url"),
+					resource.TestCheckResourceAttr(resourceName, "segment.0.segment_duration_seconds", "4"),
+					resource.TestCheckResourceAttr(resourceName, "startover_window_seconds", "3600"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccOriginEndpointConfig_dashManifest(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOriginEndpointExists(resourceName, &originEndpoint),
+					resource.TestCheckResourceAttr(resourceName, "dash_manifest.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "dash_manifest.0.manifest_name", "dash"),
+					resource.TestCheckResourceAttr(resourceName, "dash_manifest.0.min_buffer_time_seconds", "10"),
+					resource.TestCheckResourceAttr(resourceName, "dash_manifest.0.segment_template_format", mediapackagev2.DashSegmentTemplateFormatNumberWithTimeline),
+					resource.TestCheckResourceAttrSet(resourceName, "dash_manifest.0.url"),
+					resource.TestCheckResourceAttr(resourceName, "hls_manifest.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "low_latency_hls_manifest.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMediaPackageV2OriginEndpoint_tags(t *testing.T) {
+	var originEndpoint mediapackagev2.GetOriginEndpointOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mediapackagev2_origin_endpoint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mediapackagev2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOriginEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOriginEndpointConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOriginEndpointExists(resourceName, &originEndpoint),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccOriginEndpointConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOriginEndpointExists(resourceName, &originEndpoint),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccOriginEndpointConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOriginEndpointExists(resourceName, &originEndpoint),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckOriginEndpointDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).MediaPackageV2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_mediapackagev2_origin_endpoint" {
+			continue
+		}
+
+		channelGroupName, channelName, name, err := tfmediapackagev2.OriginEndpointParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfmediapackagev2.FindOriginEndpointByThreePartKey(context.Background(), conn, channelGroupName, channelName, name)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return names.Error(names.MediaPackageV2, names.ErrActionCheckingDestroyed, tfmediapackagev2.ResNameOriginEndpoint, rs.Primary.ID, errors.New("not destroyed"))
+	}
+
+	return nil
+}
+
+func testAccCheckOriginEndpointExists(name string, v *mediapackagev2.GetOriginEndpointOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return names.Error(names.MediaPackageV2, names.ErrActionCheckingExistence, tfmediapackagev2.ResNameOriginEndpoint, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return names.Error(names.MediaPackageV2, names.ErrActionCheckingExistence, tfmediapackagev2.ResNameOriginEndpoint, name, errors.New("not set"))
+		}
+
+		channelGroupName, channelName, originEndpointName, err := tfmediapackagev2.OriginEndpointParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaPackageV2Conn
+
+		output, err := tfmediapackagev2.FindOriginEndpointByThreePartKey(context.Background(), conn, channelGroupName, channelName, originEndpointName)
+
+		if err != nil {
+			return names.Error(names.MediaPackageV2, names.ErrActionCheckingExistence, tfmediapackagev2.ResNameOriginEndpoint, rs.Primary.ID, err)
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccOriginEndpointConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccChannelConfig_basic(rName), fmt.Sprintf(`
+resource "aws_mediapackagev2_origin_endpoint" "test" {
+  channel_group_name = aws_mediapackagev2_channel.test.channel_group_name
+  channel_name       = aws_mediapackagev2_channel.test.name
+  name               = %[1]q
+  container_type     = "TS"
+}
+`, rName))
+}
+
+func testAccOriginEndpointConfig_hlsManifests(rName string, manifestWindowSeconds int) string {
+	return acctest.ConfigCompose(testAccChannelConfig_basic(rName), fmt.Sprintf(`
+resource "aws_mediapackagev2_origin_endpoint" "test" {
+  channel_group_name       = aws_mediapackagev2_channel.test.channel_group_name
+  channel_name             = aws_mediapackagev2_channel.test.name
+  name                     = %[1]q
+  container_type           = "CMAF"
+  startover_window_seconds = 3600
+
+  segment {
+    segment_duration_seconds = 4
+    segment_name             = "segment"
+  }
+
+  hls_manifest {
+    manifest_name           = "index"
+    manifest_window_seconds = %[2]d
+  }
+
+  low_latency_hls_manifest {
+    manifest_name           = "lowlatency"
+    manifest_window_seconds = %[2]d
+  }
+}
+`, rName, manifestWindowSeconds))
+}
+
+func testAccOriginEndpointConfig_dashManifest(rName string) string {
+	return acctest.ConfigCompose(testAccChannelConfig_basic(rName), fmt.Sprintf(`
+resource "aws_mediapackagev2_origin_endpoint" "test" {
+  channel_group_name       = aws_mediapackagev2_channel.test.channel_group_name
+  channel_name             = aws_mediapackagev2_channel.test.name
+  name                     = %[1]q
+  container_type           = "CMAF"
+  startover_window_seconds = 3600
+
+  segment {
+    segment_duration_seconds = 4
+    segment_name             = "segment"
+  }
+
+  dash_manifest {
+    manifest_name           = "dash"
+    min_buffer_time_seconds = 10
+    segment_template_format = "NUMBER_WITH_TIMELINE"
+  }
+}
+`, rName))
+}
+
+func testAccOriginEndpointConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccChannelConfig_basic(rName), fmt.Sprintf(`
+resource "aws_mediapackagev2_origin_endpoint" "test" {
+  channel_group_name = aws_mediapackagev2_channel.test.channel_group_name
+  channel_name       = aws_mediapackagev2_channel.test.name
+  name               = %[1]q
+  container_type     = "TS"
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccOriginEndpointConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccChannelConfig_basic(rName), fmt.Sprintf(`
+resource "aws_mediapackagev2_origin_endpoint" "test" {
+  channel_group_name = aws_mediapackagev2_channel.test.channel_group_name
+  channel_name       = aws_mediapackagev2_channel.test.name
+  name               = %[1]q
+  container_type     = "TS"
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,232 @@
+//go:build sweep
+// +build sweep
+
+package mediapackagev2
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mediapackagev2"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_mediapackagev2_channel_group", &resource.Sweeper{
+		Name: "aws_mediapackagev2_channel_group",
+		F:    sweepChannelGroups,
+		Dependencies: []string{
+			"aws_mediapackagev2_channel",
+		},
+	})
+
+	resource.AddTestSweepers("aws_mediapackagev2_channel", &resource.Sweeper{
+		Name: "aws_mediapackagev2_channel",
+		F:    sweepChannels,
+		Dependencies: []string{
+			"aws_mediapackagev2_origin_endpoint",
+		},
+	})
+
+	resource.AddTestSweepers("aws_mediapackagev2_origin_endpoint", &resource.Sweeper{
+		Name: "aws_mediapackagev2_origin_endpoint",
+		F:    sweepOriginEndpoints,
+	})
+}
+
+func sweepChannelGroups(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).MediaPackageV2Conn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
+
+	input := &mediapackagev2.ListChannelGroupsInput{}
+
+	err = conn.ListChannelGroupsPages(input, func(page *mediapackagev2.ListChannelGroupsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Items {
+			r := ResourceChannelGroup()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.ChannelGroupName))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing MediaPackage V2 Channel Groups for %s: %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping MediaPackage V2 Channel Groups for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping MediaPackage V2 Channel Groups sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepChannels(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).MediaPackageV2Conn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
+
+	input := &mediapackagev2.ListChannelGroupsInput{}
+
+	err = conn.ListChannelGroupsPages(input, func(page *mediapackagev2.ListChannelGroupsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Items {
+			channelGroupName := aws.StringValue(v.ChannelGroupName)
+			input := &mediapackagev2.ListChannelsInput{
+				ChannelGroupName: aws.String(channelGroupName),
+			}
+
+			err := conn.ListChannelsPages(input, func(page *mediapackagev2.ListChannelsOutput, lastPage bool) bool {
+				if page == nil {
+					return !lastPage
+				}
+
+				for _, v := range page.Items {
+					r := ResourceChannel()
+					d := r.Data(nil)
+					d.SetId(ChannelCreateResourceID(channelGroupName, aws.StringValue(v.ChannelName)))
+
+					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+				}
+
+				return !lastPage
+			})
+
+			if err != nil {
+				errs = multierror.Append(errs, fmt.Errorf("error listing MediaPackage V2 Channels (%s) for %s: %w", channelGroupName, region, err))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing MediaPackage V2 Channel Groups for %s: %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping MediaPackage V2 Channels for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping MediaPackage V2 Channels sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepOriginEndpoints(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).MediaPackageV2Conn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
+
+	input := &mediapackagev2.ListChannelGroupsInput{}
+
+	err = conn.ListChannelGroupsPages(input, func(page *mediapackagev2.ListChannelGroupsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Items {
+			channelGroupName := aws.StringValue(v.ChannelGroupName)
+			input := &mediapackagev2.ListChannelsInput{
+				ChannelGroupName: aws.String(channelGroupName),
+			}
+
+			err := conn.ListChannelsPages(input, func(page *mediapackagev2.ListChannelsOutput, lastPage bool) bool {
+				if page == nil {
+					return !lastPage
+				}
+
+				for _, v := range page.Items {
+					channelName := aws.StringValue(v.ChannelName)
+					input := &mediapackagev2.ListOriginEndpointsInput{
+						ChannelGroupName: aws.String(channelGroupName),
+						ChannelName:      aws.String(channelName),
+					}
+
+					err := conn.ListOriginEndpointsPages(input, func(page *mediapackagev2.ListOriginEndpointsOutput, lastPage bool) bool {
+						if page == nil {
+							return !lastPage
+						}
+
+						for _, v := range page.Items {
+							r := ResourceOriginEndpoint()
+							d := r.Data(nil)
+							d.SetId(OriginEndpointCreateResourceID(channelGroupName, channelName, aws.StringValue(v.OriginEndpointName)))
+
+							sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+						}
+
+						return !lastPage
+					})
+
+					if err != nil {
+						errs = multierror.Append(errs, fmt.Errorf("error listing MediaPackage V2 Origin Endpoints (%s) for %s: %w", ChannelCreateResourceID(channelGroupName, channelName), region, err))
+					}
+				}
+
+				return !lastPage
+			})
+
+			if err != nil {
+				errs = multierror.Append(errs, fmt.Errorf("error listing MediaPackage V2 Channels (%s) for %s: %w", channelGroupName, region, err))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing MediaPackage V2 Channel Groups for %s: %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping MediaPackage V2 Origin Endpoints for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping MediaPackage V2 Origin Endpoints sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
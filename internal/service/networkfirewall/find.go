@@ -97,3 +97,38 @@ func FindRuleGroup(ctx context.Context, conn *networkfirewall.NetworkFirewall, a
 	}
 	return output, nil
 }
+
+// FindRuleGroupMetadata returns the RuleGroupMetadataOutput from a call to DescribeRuleGroupMetadataWithContext
+// given the context and at least one of RuleGroupArn and RuleGroupName.
+func FindRuleGroupMetadata(ctx context.Context, conn *networkfirewall.NetworkFirewall, arn, name, ruleGroupType string) (*networkfirewall.DescribeRuleGroupMetadataOutput, error) {
+	input := &networkfirewall.DescribeRuleGroupMetadataInput{}
+	if arn != "" {
+		input.RuleGroupArn = aws.String(arn)
+	}
+	if name != "" {
+		input.RuleGroupName = aws.String(name)
+	}
+	if ruleGroupType != "" {
+		input.Type = aws.String(ruleGroupType)
+	}
+
+	output, err := conn.DescribeRuleGroupMetadataWithContext(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	return output, nil
+}
+
+// FindTLSInspectionConfiguration returns the TLSInspectionConfigurationOutput from a call to DescribeTLSInspectionConfigurationWithContext
+// given the context and TLS Inspection Configuration ARN.
+// Returns nil if the TLS Inspection Configuration is not found.
+func FindTLSInspectionConfiguration(ctx context.Context, conn *networkfirewall.NetworkFirewall, arn string) (*networkfirewall.DescribeTLSInspectionConfigurationOutput, error) {
+	input := &networkfirewall.DescribeTLSInspectionConfigurationInput{
+		TLSInspectionConfigurationArn: aws.String(arn),
+	}
+	output, err := conn.DescribeTLSInspectionConfigurationWithContext(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	return output, nil
+}
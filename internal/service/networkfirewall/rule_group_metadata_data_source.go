@@ -0,0 +1,118 @@
+package networkfirewall
+
+import (
+	"context"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkfirewall"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourceRuleGroupMetadata() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceRuleGroupMetadataRead,
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:         schema.TypeString,
+				AtLeastOneOf: []string{"arn", "name"},
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"capacity": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"consumed_capacity": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_modified_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"arn", "name"},
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9-]{1,128}$`), "Must have 1-128 valid characters: a-z, A-Z, 0-9 and -(hyphen)"),
+			},
+			"rule_order": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(networkfirewall.RuleGroupType_Values(), false),
+			},
+		},
+	}
+}
+
+func dataSourceRuleGroupMetadataRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).NetworkFirewallConn
+
+	arn := d.Get("arn").(string)
+	name := d.Get("name").(string)
+
+	log.Printf("[DEBUG] Reading NetworkFirewall Rule Group Metadata %s %s", arn, name)
+
+	output, err := FindRuleGroupMetadata(ctx, conn, arn, name, d.Get("type").(string))
+
+	if err != nil {
+		return diag.Errorf("reading NetworkFirewall Rule Group Metadata (%s, %s): %s", arn, name, err)
+	}
+
+	if output == nil {
+		return diag.Errorf("reading NetworkFirewall Rule Group Metadata (%s, %s): empty output", arn, name)
+	}
+
+	arn = aws.StringValue(output.RuleGroupArn)
+
+	d.SetId(arn)
+
+	d.Set("arn", arn)
+	d.Set("capacity", output.Capacity)
+	d.Set("description", output.Description)
+	if output.LastModifiedTime != nil {
+		d.Set("last_modified_time", aws.TimeValue(output.LastModifiedTime).Format(time.RFC3339))
+	} else {
+		d.Set("last_modified_time", nil)
+	}
+	d.Set("name", output.RuleGroupName)
+	if output.StatefulRuleOptions != nil {
+		d.Set("rule_order", output.StatefulRuleOptions.RuleOrder)
+	} else {
+		d.Set("rule_order", nil)
+	}
+	d.Set("type", output.Type)
+
+	// The metadata only reports the configured capacity, the capacity in use comes from the full description.
+	ruleGroup, err := FindRuleGroup(ctx, conn, arn)
+
+	if err != nil {
+		return diag.Errorf("reading NetworkFirewall Rule Group (%s): %s", arn, err)
+	}
+
+	if ruleGroup != nil && ruleGroup.RuleGroupResponse != nil {
+		d.Set("consumed_capacity", ruleGroup.RuleGroupResponse.ConsumedCapacity)
+	} else {
+		d.Set("consumed_capacity", nil)
+	}
+
+	return nil
+}
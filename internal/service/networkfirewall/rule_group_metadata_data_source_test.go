@@ -0,0 +1,117 @@
+package networkfirewall_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/networkfirewall"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccNetworkFirewallRuleGroupMetadataDataSource_arn(t *testing.T) {
+	var ruleGroup networkfirewall.DescribeRuleGroupOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_networkfirewall_rule_group.test"
+	datasourceName := "data.aws_networkfirewall_rule_group_metadata.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, networkfirewall.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuleGroupMetadataDataSourceConfig_arn(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRuleGroupExists(resourceName, &ruleGroup),
+					resource.TestCheckResourceAttrPair(datasourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(datasourceName, "capacity", resourceName, "capacity"),
+					resource.TestCheckResourceAttr(datasourceName, "consumed_capacity", "1"),
+					resource.TestCheckResourceAttrPair(datasourceName, "description", resourceName, "description"),
+					resource.TestCheckResourceAttrSet(datasourceName, "last_modified_time"),
+					resource.TestCheckResourceAttrPair(datasourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(datasourceName, "type", resourceName, "type"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkFirewallRuleGroupMetadataDataSource_name(t *testing.T) {
+	var ruleGroup networkfirewall.DescribeRuleGroupOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_networkfirewall_rule_group.test"
+	datasourceName := "data.aws_networkfirewall_rule_group_metadata.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, networkfirewall.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuleGroupMetadataDataSourceConfig_name(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRuleGroupExists(resourceName, &ruleGroup),
+					resource.TestCheckResourceAttrPair(datasourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(datasourceName, "capacity", resourceName, "capacity"),
+					resource.TestCheckResourceAttrSet(datasourceName, "consumed_capacity"),
+					resource.TestCheckResourceAttrPair(datasourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttr(datasourceName, "type", networkfirewall.RuleGroupTypeStateless),
+				),
+			},
+		},
+	})
+}
+
+func testAccRuleGroupMetadataDataSourceConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_networkfirewall_rule_group" "test" {
+  capacity    = 100
+  description = %[1]q
+  name        = %[1]q
+  type        = "STATELESS"
+
+  rule_group {
+    rules_source {
+      stateless_rules_and_custom_actions {
+        stateless_rule {
+          priority = 1
+
+          rule_definition {
+            actions = ["aws:drop"]
+
+            match_attributes {
+              destination {
+                address_definition = "1.2.3.4/32"
+              }
+
+              source {
+                address_definition = "1.2.3.4/32"
+              }
+            }
+          }
+        }
+      }
+    }
+  }
+}
+`, rName)
+}
+
+func testAccRuleGroupMetadataDataSourceConfig_arn(rName string) string {
+	return acctest.ConfigCompose(testAccRuleGroupMetadataDataSourceConfig_base(rName), `
+data "aws_networkfirewall_rule_group_metadata" "test" {
+  arn = aws_networkfirewall_rule_group.test.arn
+}
+`)
+}
+
+func testAccRuleGroupMetadataDataSourceConfig_name(rName string) string {
+	return acctest.ConfigCompose(testAccRuleGroupMetadataDataSourceConfig_base(rName), `
+data "aws_networkfirewall_rule_group_metadata" "test" {
+  name = aws_networkfirewall_rule_group.test.name
+  type = "STATELESS"
+}
+`)
+}
@@ -134,3 +134,24 @@ func statusRuleGroup(ctx context.Context, conn *networkfirewall.NetworkFirewall,
 		return output.RuleGroup, aws.StringValue(output.RuleGroupResponse.RuleGroupStatus), nil
 	}
 }
+
+// statusTLSInspectionConfiguration fetches the TLS Inspection Configuration and its Status
+func statusTLSInspectionConfiguration(ctx context.Context, conn *networkfirewall.NetworkFirewall, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindTLSInspectionConfiguration(ctx, conn, arn)
+
+		if tfawserr.ErrCodeEquals(err, networkfirewall.ErrCodeResourceNotFoundException) {
+			return output, resourceStatusDeleted, nil
+		}
+
+		if err != nil {
+			return nil, resourceStatusUnknown, err
+		}
+
+		if output == nil || output.TLSInspectionConfigurationResponse == nil {
+			return nil, resourceStatusUnknown, nil
+		}
+
+		return output.TLSInspectionConfiguration, aws.StringValue(output.TLSInspectionConfigurationResponse.TLSInspectionConfigurationStatus), nil
+	}
+}
@@ -0,0 +1,626 @@
+package networkfirewall
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkfirewall"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceTLSInspectionConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTLSInspectionConfigurationCreate,
+		ReadContext:   resourceTLSInspectionConfigurationRead,
+		UpdateContext: resourceTLSInspectionConfigurationUpdate,
+		DeleteContext: resourceTLSInspectionConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"certificate_authority": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     tlsCertificateDataSchema(),
+			},
+			"certificates": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     tlsCertificateDataSchema(),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"encryption_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(networkfirewall.EncryptionType_Values(), false),
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"number_of_associations": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"tls_inspection_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"server_certificate_configuration": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"certificate_authority_arn": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"check_certificate_revocation_status": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"revoked_status_action": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringInSlice(networkfirewall.RevocationCheckAction_Values(), false),
+												},
+												"unknown_status_action": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringInSlice(networkfirewall.RevocationCheckAction_Values(), false),
+												},
+											},
+										},
+									},
+									"scope": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"destination":      tlsInspectionAddressSchema(),
+												"destination_port": tlsInspectionPortRangeSchema(),
+												"protocols": {
+													Type:     schema.TypeSet,
+													Optional: true,
+													Elem: &schema.Schema{
+														Type:         schema.TypeInt,
+														ValidateFunc: validation.IntBetween(0, 255),
+													},
+												},
+												"source":      tlsInspectionAddressSchema(),
+												"source_port": tlsInspectionPortRangeSchema(),
+											},
+										},
+									},
+									"server_certificate": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"resource_arn": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"tls_inspection_configuration_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"update_token": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func tlsInspectionAddressSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"address_definition": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: verify.ValidIPv4CIDRNetworkAddress,
+				},
+			},
+		},
+	}
+}
+
+func tlsInspectionPortRangeSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"from_port": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IsPortNumberOrZero,
+				},
+				"to_port": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IsPortNumberOrZero,
+				},
+			},
+		},
+	}
+}
+
+func tlsCertificateDataSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"certificate_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"certificate_serial": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceTLSInspectionConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).NetworkFirewallConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+	name := d.Get("name").(string)
+
+	input := &networkfirewall.CreateTLSInspectionConfigurationInput{
+		TLSInspectionConfiguration:     expandTLSInspectionConfiguration(d.Get("tls_inspection_configuration").([]interface{})),
+		TLSInspectionConfigurationName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("encryption_configuration"); ok {
+		input.EncryptionConfiguration = expandEncryptionConfiguration(v.([]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating NetworkFirewall TLS Inspection Configuration %s", name)
+
+	output, err := conn.CreateTLSInspectionConfigurationWithContext(ctx, input)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating NetworkFirewall TLS Inspection Configuration (%s): %w", name, err))
+	}
+	if output == nil || output.TLSInspectionConfigurationResponse == nil {
+		return diag.FromErr(fmt.Errorf("error creating NetworkFirewall TLS Inspection Configuration (%s): empty output", name))
+	}
+
+	d.SetId(aws.StringValue(output.TLSInspectionConfigurationResponse.TLSInspectionConfigurationArn))
+
+	return resourceTLSInspectionConfigurationRead(ctx, d, meta)
+}
+
+func resourceTLSInspectionConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).NetworkFirewallConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	log.Printf("[DEBUG] Reading NetworkFirewall TLS Inspection Configuration %s", d.Id())
+
+	output, err := FindTLSInspectionConfiguration(ctx, conn, d.Id())
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, networkfirewall.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] NetworkFirewall TLS Inspection Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading NetworkFirewall TLS Inspection Configuration (%s): %w", d.Id(), err))
+	}
+
+	if output == nil {
+		return diag.FromErr(fmt.Errorf("error reading NetworkFirewall TLS Inspection Configuration (%s): empty output", d.Id()))
+	}
+	if output.TLSInspectionConfigurationResponse == nil {
+		return diag.FromErr(fmt.Errorf("error reading NetworkFirewall TLS Inspection Configuration (%s): empty output.TLSInspectionConfigurationResponse", d.Id()))
+	}
+
+	resp := output.TLSInspectionConfigurationResponse
+
+	d.Set("arn", resp.TLSInspectionConfigurationArn)
+	d.Set("description", resp.Description)
+	d.Set("name", resp.TLSInspectionConfigurationName)
+	d.Set("number_of_associations", resp.NumberOfAssociations)
+	d.Set("tls_inspection_configuration_id", resp.TLSInspectionConfigurationId)
+	d.Set("update_token", output.UpdateToken)
+
+	if err := d.Set("certificate_authority", flattenTLSCertificateData(resp.CertificateAuthority)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting certificate_authority: %w", err))
+	}
+
+	if err := d.Set("certificates", flattenTLSCertificates(resp.Certificates)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting certificates: %w", err))
+	}
+
+	if err := d.Set("encryption_configuration", flattenEncryptionConfiguration(resp.EncryptionConfiguration)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting encryption_configuration: %w", err))
+	}
+
+	if err := d.Set("tls_inspection_configuration", flattenTLSInspectionConfiguration(output.TLSInspectionConfiguration)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting tls_inspection_configuration: %w", err))
+	}
+
+	tags := KeyValueTags(resp.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting tags: %w", err))
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting tags_all: %w", err))
+	}
+
+	return nil
+}
+
+func resourceTLSInspectionConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).NetworkFirewallConn
+	arn := d.Id()
+
+	log.Printf("[DEBUG] Updating NetworkFirewall TLS Inspection Configuration %s", arn)
+
+	if d.HasChanges("description", "encryption_configuration", "tls_inspection_configuration") {
+		input := &networkfirewall.UpdateTLSInspectionConfigurationInput{
+			EncryptionConfiguration:       expandEncryptionConfiguration(d.Get("encryption_configuration").([]interface{})),
+			TLSInspectionConfiguration:    expandTLSInspectionConfiguration(d.Get("tls_inspection_configuration").([]interface{})),
+			TLSInspectionConfigurationArn: aws.String(arn),
+			UpdateToken:                   aws.String(d.Get("update_token").(string)),
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			input.Description = aws.String(v.(string))
+		}
+
+		_, err := conn.UpdateTLSInspectionConfigurationWithContext(ctx, input)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error updating NetworkFirewall TLS Inspection Configuration (%s): %w", arn, err))
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+		if err := UpdateTags(conn, arn, o, n); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating NetworkFirewall TLS Inspection Configuration (%s) tags: %w", arn, err))
+		}
+	}
+
+	return resourceTLSInspectionConfigurationRead(ctx, d, meta)
+}
+
+func resourceTLSInspectionConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).NetworkFirewallConn
+
+	log.Printf("[DEBUG] Deleting NetworkFirewall TLS Inspection Configuration %s", d.Id())
+
+	input := &networkfirewall.DeleteTLSInspectionConfigurationInput{
+		TLSInspectionConfigurationArn: aws.String(d.Id()),
+	}
+
+	err := resource.RetryContext(ctx, tlsInspectionConfigurationDeleteTimeout, func() *resource.RetryError {
+		_, err := conn.DeleteTLSInspectionConfigurationWithContext(ctx, input)
+		if err != nil {
+			if tfawserr.ErrMessageContains(err, networkfirewall.ErrCodeInvalidOperationException, "Unable to delete the object because it is still in use") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+
+	if tfresource.TimedOut(err) {
+		_, err = conn.DeleteTLSInspectionConfigurationWithContext(ctx, input)
+	}
+
+	if err != nil {
+		if tfawserr.ErrCodeEquals(err, networkfirewall.ErrCodeResourceNotFoundException) {
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error deleting NetworkFirewall TLS Inspection Configuration (%s): %w", d.Id(), err))
+	}
+
+	if _, err := waitTLSInspectionConfigurationDeleted(ctx, conn, d.Id()); err != nil {
+		if tfawserr.ErrCodeEquals(err, networkfirewall.ErrCodeResourceNotFoundException) {
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error waiting for NetworkFirewall TLS Inspection Configuration (%s) to delete: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func expandEncryptionConfiguration(l []interface{}) *networkfirewall.EncryptionConfiguration {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	configuration := &networkfirewall.EncryptionConfiguration{
+		Type: aws.String(m["type"].(string)),
+	}
+
+	if v, ok := m["key_id"].(string); ok && v != "" {
+		configuration.KeyId = aws.String(v)
+	}
+
+	return configuration
+}
+
+func expandTLSInspectionConfiguration(l []interface{}) *networkfirewall.TLSInspectionConfiguration {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	configuration := &networkfirewall.TLSInspectionConfiguration{}
+
+	if v, ok := m["server_certificate_configuration"].([]interface{}); ok && len(v) > 0 {
+		configuration.ServerCertificateConfigurations = expandServerCertificateConfigurations(v)
+	}
+
+	return configuration
+}
+
+func expandServerCertificateConfigurations(l []interface{}) []*networkfirewall.ServerCertificateConfiguration {
+	configurations := make([]*networkfirewall.ServerCertificateConfiguration, 0, len(l))
+	for _, tfMapRaw := range l {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		configuration := &networkfirewall.ServerCertificateConfiguration{}
+		if v, ok := tfMap["certificate_authority_arn"].(string); ok && v != "" {
+			configuration.CertificateAuthorityArn = aws.String(v)
+		}
+		if v, ok := tfMap["check_certificate_revocation_status"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			configuration.CheckCertificateRevocationStatus = expandCheckCertificateRevocationStatus(v[0].(map[string]interface{}))
+		}
+		if v, ok := tfMap["scope"].([]interface{}); ok && len(v) > 0 {
+			configuration.Scopes = expandServerCertificateScopes(v)
+		}
+		if v, ok := tfMap["server_certificate"].([]interface{}); ok && len(v) > 0 {
+			configuration.ServerCertificates = expandServerCertificates(v)
+		}
+		configurations = append(configurations, configuration)
+	}
+	return configurations
+}
+
+func expandCheckCertificateRevocationStatus(tfMap map[string]interface{}) *networkfirewall.CheckCertificateRevocationStatusActions {
+	actions := &networkfirewall.CheckCertificateRevocationStatusActions{}
+	if v, ok := tfMap["revoked_status_action"].(string); ok && v != "" {
+		actions.RevokedStatusAction = aws.String(v)
+	}
+	if v, ok := tfMap["unknown_status_action"].(string); ok && v != "" {
+		actions.UnknownStatusAction = aws.String(v)
+	}
+	return actions
+}
+
+func expandServerCertificateScopes(l []interface{}) []*networkfirewall.ServerCertificateScope {
+	scopes := make([]*networkfirewall.ServerCertificateScope, 0, len(l))
+	for _, tfMapRaw := range l {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		scope := &networkfirewall.ServerCertificateScope{}
+		if v, ok := tfMap["destination"].(*schema.Set); ok && v.Len() > 0 {
+			scope.Destinations = expandAddresses(v.List())
+		}
+		if v, ok := tfMap["destination_port"].(*schema.Set); ok && v.Len() > 0 {
+			scope.DestinationPorts = expandPortRanges(v.List())
+		}
+		if v, ok := tfMap["protocols"].(*schema.Set); ok && v.Len() > 0 {
+			scope.Protocols = flex.ExpandInt64Set(v)
+		}
+		if v, ok := tfMap["source"].(*schema.Set); ok && v.Len() > 0 {
+			scope.Sources = expandAddresses(v.List())
+		}
+		if v, ok := tfMap["source_port"].(*schema.Set); ok && v.Len() > 0 {
+			scope.SourcePorts = expandPortRanges(v.List())
+		}
+		scopes = append(scopes, scope)
+	}
+	return scopes
+}
+
+func expandServerCertificates(l []interface{}) []*networkfirewall.ServerCertificate {
+	certificates := make([]*networkfirewall.ServerCertificate, 0, len(l))
+	for _, tfMapRaw := range l {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		certificate := &networkfirewall.ServerCertificate{}
+		if v, ok := tfMap["resource_arn"].(string); ok && v != "" {
+			certificate.ResourceArn = aws.String(v)
+		}
+		certificates = append(certificates, certificate)
+	}
+	return certificates
+}
+
+func flattenEncryptionConfiguration(c *networkfirewall.EncryptionConfiguration) []interface{} {
+	if c == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"key_id": aws.StringValue(c.KeyId),
+		"type":   aws.StringValue(c.Type),
+	}
+
+	return []interface{}{m}
+}
+
+func flattenTLSInspectionConfiguration(c *networkfirewall.TLSInspectionConfiguration) []interface{} {
+	if c == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"server_certificate_configuration": flattenServerCertificateConfigurations(c.ServerCertificateConfigurations),
+	}
+
+	return []interface{}{m}
+}
+
+func flattenServerCertificateConfigurations(c []*networkfirewall.ServerCertificateConfiguration) []interface{} {
+	configurations := make([]interface{}, 0, len(c))
+	for _, configuration := range c {
+		if configuration == nil {
+			continue
+		}
+		m := map[string]interface{}{
+			"certificate_authority_arn":           aws.StringValue(configuration.CertificateAuthorityArn),
+			"check_certificate_revocation_status": flattenCheckCertificateRevocationStatus(configuration.CheckCertificateRevocationStatus),
+			"scope":                               flattenServerCertificateScopes(configuration.Scopes),
+			"server_certificate":                  flattenServerCertificates(configuration.ServerCertificates),
+		}
+		configurations = append(configurations, m)
+	}
+	return configurations
+}
+
+func flattenCheckCertificateRevocationStatus(a *networkfirewall.CheckCertificateRevocationStatusActions) []interface{} {
+	if a == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"revoked_status_action": aws.StringValue(a.RevokedStatusAction),
+		"unknown_status_action": aws.StringValue(a.UnknownStatusAction),
+	}
+
+	return []interface{}{m}
+}
+
+func flattenServerCertificateScopes(s []*networkfirewall.ServerCertificateScope) []interface{} {
+	scopes := make([]interface{}, 0, len(s))
+	for _, scope := range s {
+		if scope == nil {
+			continue
+		}
+		m := map[string]interface{}{
+			"destination":      flattenAddresses(scope.Destinations),
+			"destination_port": flattenPortRanges(scope.DestinationPorts),
+			"protocols":        flex.FlattenInt64Set(scope.Protocols),
+			"source":           flattenAddresses(scope.Sources),
+			"source_port":      flattenPortRanges(scope.SourcePorts),
+		}
+		scopes = append(scopes, m)
+	}
+	return scopes
+}
+
+func flattenServerCertificates(c []*networkfirewall.ServerCertificate) []interface{} {
+	certificates := make([]interface{}, 0, len(c))
+	for _, certificate := range c {
+		if certificate == nil {
+			continue
+		}
+		certificates = append(certificates, map[string]interface{}{
+			"resource_arn": aws.StringValue(certificate.ResourceArn),
+		})
+	}
+	return certificates
+}
+
+func flattenTLSCertificates(c []*networkfirewall.TlsCertificateData) []interface{} {
+	certificates := make([]interface{}, 0, len(c))
+	for _, certificate := range c {
+		certificates = append(certificates, flattenTLSCertificateData(certificate)...)
+	}
+	return certificates
+}
+
+func flattenTLSCertificateData(c *networkfirewall.TlsCertificateData) []interface{} {
+	if c == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"certificate_arn":    aws.StringValue(c.CertificateArn),
+		"certificate_serial": aws.StringValue(c.CertificateSerial),
+		"status":             aws.StringValue(c.Status),
+		"status_message":     aws.StringValue(c.StatusMessage),
+	}
+
+	return []interface{}{m}
+}
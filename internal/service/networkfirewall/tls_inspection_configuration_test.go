@@ -0,0 +1,284 @@
+package networkfirewall_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/networkfirewall"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfnetworkfirewall "github.com/hashicorp/terraform-provider-aws/internal/service/networkfirewall"
+)
+
+func TestAccNetworkFirewallTLSInspectionConfiguration_basic(t *testing.T) {
+	var v networkfirewall.DescribeTLSInspectionConfigurationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_networkfirewall_tls_inspection_configuration.test"
+	key := acctest.TLSRSAPrivateKeyPEM(2048)
+	certificate := acctest.TLSRSAX509SelfSignedCertificatePEM(key, acctest.RandomDomain().String())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, networkfirewall.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTLSInspectionConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTLSInspectionConfigurationConfig_basic(rName, certificate, key, "443"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTLSInspectionConfigurationExists(resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "network-firewall", fmt.Sprintf("tls-configuration/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "certificates.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "encryption_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "encryption_configuration.0.type", networkfirewall.EncryptionTypeAwsOwnedKmsKey),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tls_inspection_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.0.protocols.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.0.destination_port.*", map[string]string{
+						"from_port": "443",
+						"to_port":   "443",
+					}),
+					resource.TestCheckResourceAttrPair(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.server_certificate.0.resource_arn", "aws_acm_certificate.test", "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "update_token"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTLSInspectionConfigurationConfig_basic(rName, certificate, key, "8443"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTLSInspectionConfigurationExists(resourceName, &v),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.scope.0.destination_port.*", map[string]string{
+						"from_port": "8443",
+						"to_port":   "8443",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkFirewallTLSInspectionConfiguration_disappears(t *testing.T) {
+	var v networkfirewall.DescribeTLSInspectionConfigurationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_networkfirewall_tls_inspection_configuration.test"
+	key := acctest.TLSRSAPrivateKeyPEM(2048)
+	certificate := acctest.TLSRSAX509SelfSignedCertificatePEM(key, acctest.RandomDomain().String())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, networkfirewall.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTLSInspectionConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTLSInspectionConfigurationConfig_basic(rName, certificate, key, "443"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTLSInspectionConfigurationExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfnetworkfirewall.ResourceTLSInspectionConfiguration(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccNetworkFirewallTLSInspectionConfiguration_checkCertificateRevocationStatus(t *testing.T) {
+	var v networkfirewall.DescribeTLSInspectionConfigurationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_networkfirewall_tls_inspection_configuration.test"
+	key := acctest.TLSRSAPrivateKeyPEM(2048)
+	certificate := acctest.TLSRSAX509SelfSignedCertificatePEM(key, acctest.RandomDomain().String())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, networkfirewall.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTLSInspectionConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTLSInspectionConfigurationConfig_checkCertificateRevocationStatus(rName, certificate, key, "DROP", "PASS"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTLSInspectionConfigurationExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.check_certificate_revocation_status.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.check_certificate_revocation_status.0.revoked_status_action", networkfirewall.RevocationCheckActionDrop),
+					resource.TestCheckResourceAttr(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.check_certificate_revocation_status.0.unknown_status_action", networkfirewall.RevocationCheckActionPass),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTLSInspectionConfigurationConfig_checkCertificateRevocationStatus(rName, certificate, key, "REJECT", "DROP"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTLSInspectionConfigurationExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.check_certificate_revocation_status.0.revoked_status_action", networkfirewall.RevocationCheckActionReject),
+					resource.TestCheckResourceAttr(resourceName, "tls_inspection_configuration.0.server_certificate_configuration.0.check_certificate_revocation_status.0.unknown_status_action", networkfirewall.RevocationCheckActionDrop),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTLSInspectionConfigurationDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_networkfirewall_tls_inspection_configuration" {
+			continue
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).NetworkFirewallConn
+		output, err := tfnetworkfirewall.FindTLSInspectionConfiguration(context.Background(), conn, rs.Primary.ID)
+		if tfawserr.ErrCodeEquals(err, networkfirewall.ErrCodeResourceNotFoundException) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if output != nil {
+			return fmt.Errorf("NetworkFirewall TLS Inspection Configuration still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckTLSInspectionConfigurationExists(n string, v *networkfirewall.DescribeTLSInspectionConfigurationOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No NetworkFirewall TLS Inspection Configuration ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).NetworkFirewallConn
+		output, err := tfnetworkfirewall.FindTLSInspectionConfiguration(context.Background(), conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("NetworkFirewall TLS Inspection Configuration (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccTLSInspectionConfigurationConfig_base(certificate, key string) string {
+	return fmt.Sprintf(`
+resource "aws_acm_certificate" "test" {
+  certificate_body = "%[1]s"
+  private_key      = "%[2]s"
+}
+`, acctest.TLSPEMEscapeNewlines(certificate), acctest.TLSPEMEscapeNewlines(key))
+}
+
+func testAccTLSInspectionConfigurationConfig_basic(rName, certificate, key, port string) string {
+	return acctest.ConfigCompose(testAccTLSInspectionConfigurationConfig_base(certificate, key), fmt.Sprintf(`
+resource "aws_networkfirewall_tls_inspection_configuration" "test" {
+  name = %[1]q
+
+  tls_inspection_configuration {
+    server_certificate_configuration {
+      server_certificate {
+        resource_arn = aws_acm_certificate.test.arn
+      }
+
+      scope {
+        protocols = [6]
+
+        destination {
+          address_definition = "0.0.0.0/0"
+        }
+
+        destination_port {
+          from_port = %[2]s
+          to_port   = %[2]s
+        }
+
+        source {
+          address_definition = "0.0.0.0/0"
+        }
+
+        source_port {
+          from_port = 0
+          to_port   = 65535
+        }
+      }
+    }
+  }
+}
+`, rName, port))
+}
+
+func testAccTLSInspectionConfigurationConfig_checkCertificateRevocationStatus(rName, certificate, key, revokedStatusAction, unknownStatusAction string) string {
+	return acctest.ConfigCompose(testAccTLSInspectionConfigurationConfig_base(certificate, key), fmt.Sprintf(`
+resource "aws_acmpca_certificate_authority" "test" {
+  permanent_deletion_time_in_days = 7
+  type                            = "ROOT"
+
+  certificate_authority_configuration {
+    key_algorithm     = "RSA_4096"
+    signing_algorithm = "SHA512WITHRSA"
+
+    subject {
+      common_name = "example.com"
+    }
+  }
+}
+
+resource "aws_networkfirewall_tls_inspection_configuration" "test" {
+  name = %[1]q
+
+  tls_inspection_configuration {
+    server_certificate_configuration {
+      certificate_authority_arn = aws_acmpca_certificate_authority.test.arn
+
+      check_certificate_revocation_status {
+        revoked_status_action = %[2]q
+        unknown_status_action = %[3]q
+      }
+
+      scope {
+        protocols = [6]
+
+        destination {
+          address_definition = "0.0.0.0/0"
+        }
+
+        destination_port {
+          from_port = 443
+          to_port   = 443
+        }
+
+        source {
+          address_definition = "0.0.0.0/0"
+        }
+
+        source_port {
+          from_port = 0
+          to_port   = 65535
+        }
+      }
+    }
+  }
+}
+`, rName, revokedStatusAction, unknownStatusAction))
+}
@@ -17,6 +17,8 @@ const (
 	resourcePolicyDeleteTimeout = 2 * time.Minute
 	// Maximum amount of time to wait for a Rule Group to be deleted
 	ruleGroupDeleteTimeout = 10 * time.Minute
+	// Maximum amount of time to wait for a TLS Inspection Configuration to be deleted
+	tlsInspectionConfigurationDeleteTimeout = 10 * time.Minute
 )
 
 func waitFirewallCreated(ctx context.Context, conn *networkfirewall.NetworkFirewall, arn string) (*networkfirewall.Firewall, error) {
@@ -110,3 +112,21 @@ func waitRuleGroupDeleted(ctx context.Context, conn *networkfirewall.NetworkFire
 
 	return nil, err
 }
+
+// waitTLSInspectionConfigurationDeleted waits for a TLS Inspection Configuration to return "Deleted"
+func waitTLSInspectionConfigurationDeleted(ctx context.Context, conn *networkfirewall.NetworkFirewall, arn string) (*networkfirewall.TLSInspectionConfiguration, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkfirewall.ResourceStatusDeleting},
+		Target:  []string{resourceStatusDeleted},
+		Refresh: statusTLSInspectionConfiguration(ctx, conn, arn),
+		Timeout: tlsInspectionConfigurationDeleteTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*networkfirewall.TLSInspectionConfiguration); ok {
+		return v, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,36 @@
+package oam
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/oam"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindLinkByID(ctx context.Context, conn *oam.OAM, id string) (*oam.GetLinkOutput, error) {
+	input := &oam.GetLinkInput{
+		Identifier: aws.String(id),
+	}
+
+	output, err := conn.GetLinkWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, oam.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
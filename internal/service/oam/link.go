@@ -0,0 +1,279 @@
+package oam
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/oam"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceLink() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceLinkCreate,
+		ReadWithoutTimeout:   resourceLinkRead,
+		UpdateWithoutTimeout: resourceLinkUpdate,
+		DeleteWithoutTimeout: resourceLinkDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"label": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"label_template": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"link_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"log_group_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"filter": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 2000),
+									},
+								},
+							},
+						},
+						"metric_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"filter": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 2000),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"link_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource_types": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 50,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(oam.ResourceType_Values(), false),
+				},
+			},
+			"sink_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"sink_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceLinkCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ObservabilityAccessManagerConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &oam.CreateLinkInput{
+		LabelTemplate:  aws.String(d.Get("label_template").(string)),
+		ResourceTypes:  flex.ExpandStringSet(d.Get("resource_types").(*schema.Set)),
+		SinkIdentifier: aws.String(d.Get("sink_identifier").(string)),
+	}
+
+	if v, ok := d.GetOk("link_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.LinkConfiguration = expandLinkConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateLinkWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating CloudWatch Observability Access Manager Link: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.Arn))
+
+	return resourceLinkRead(ctx, d, meta)
+}
+
+func resourceLinkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ObservabilityAccessManagerConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	link, err := FindLinkByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudWatch Observability Access Manager Link (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading CloudWatch Observability Access Manager Link (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", link.Arn)
+	d.Set("label", link.Label)
+	d.Set("label_template", link.LabelTemplate)
+	if err := d.Set("link_configuration", flattenLinkConfiguration(link.LinkConfiguration)); err != nil {
+		return diag.Errorf("setting link_configuration: %s", err)
+	}
+	d.Set("link_id", link.Id)
+	d.Set("resource_types", aws.StringValueSlice(link.ResourceTypes))
+	d.Set("sink_arn", link.SinkArn)
+	if _, ok := d.GetOk("sink_identifier"); !ok {
+		d.Set("sink_identifier", link.SinkArn)
+	}
+
+	tags := KeyValueTags(link.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceLinkUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ObservabilityAccessManagerConn
+
+	if d.HasChanges("link_configuration", "resource_types") {
+		input := &oam.UpdateLinkInput{
+			Identifier:    aws.String(d.Id()),
+			ResourceTypes: flex.ExpandStringSet(d.Get("resource_types").(*schema.Set)),
+		}
+
+		if v, ok := d.GetOk("link_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.LinkConfiguration = expandLinkConfiguration(v.([]interface{})[0].(map[string]interface{}))
+		} else {
+			// An empty configuration removes any existing filters.
+			input.LinkConfiguration = &oam.LinkConfiguration{}
+		}
+
+		_, err := conn.UpdateLinkWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating CloudWatch Observability Access Manager Link (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Id(), o, n); err != nil {
+			return diag.Errorf("updating CloudWatch Observability Access Manager Link (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceLinkRead(ctx, d, meta)
+}
+
+func resourceLinkDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ObservabilityAccessManagerConn
+
+	log.Printf("[DEBUG] Deleting CloudWatch Observability Access Manager Link: %s", d.Id())
+	_, err := conn.DeleteLinkWithContext(ctx, &oam.DeleteLinkInput{
+		Identifier: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, oam.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting CloudWatch Observability Access Manager Link (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandLinkConfiguration(tfMap map[string]interface{}) *oam.LinkConfiguration {
+	apiObject := &oam.LinkConfiguration{}
+
+	if v, ok := tfMap["log_group_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.LogGroupConfiguration = &oam.LogGroupConfiguration{
+			Filter: aws.String(v[0].(map[string]interface{})["filter"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["metric_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.MetricConfiguration = &oam.MetricConfiguration{
+			Filter: aws.String(v[0].(map[string]interface{})["filter"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func flattenLinkConfiguration(apiObject *oam.LinkConfiguration) []interface{} {
+	if apiObject == nil || (apiObject.LogGroupConfiguration == nil && apiObject.MetricConfiguration == nil) {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.LogGroupConfiguration; v != nil {
+		tfMap["log_group_configuration"] = []interface{}{map[string]interface{}{
+			"filter": aws.StringValue(v.Filter),
+		}}
+	}
+
+	if v := apiObject.MetricConfiguration; v != nil {
+		tfMap["metric_configuration"] = []interface{}{map[string]interface{}{
+			"filter": aws.StringValue(v.Filter),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,270 @@
+package oam_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/oam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfoam "github.com/hashicorp/terraform-provider-aws/internal/service/oam"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// Links can only be created to a sink in another (monitoring) account
+// whose sink policy allows the source account.
+func testAccSinkIdentifier(t *testing.T) string {
+	v := os.Getenv("OAM_SINK_IDENTIFIER")
+
+	if v == "" {
+		t.Skip("Environment variable OAM_SINK_IDENTIFIER is not set")
+	}
+
+	return v
+}
+
+func TestAccObservabilityAccessManagerLink_basic(t *testing.T) {
+	sinkIdentifier := testAccSinkIdentifier(t)
+	resourceName := "aws_oam_link.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, oam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLinkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLinkConfig_basic(sinkIdentifier),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinkExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "label"),
+					resource.TestCheckResourceAttr(resourceName, "label_template", "$AccountName"),
+					resource.TestCheckResourceAttr(resourceName, "link_configuration.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "link_id"),
+					resource.TestCheckResourceAttr(resourceName, "resource_types.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "resource_types.*", "AWS::CloudWatch::Metric"),
+					resource.TestCheckResourceAttrSet(resourceName, "sink_arn"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccObservabilityAccessManagerLink_disappears(t *testing.T) {
+	sinkIdentifier := testAccSinkIdentifier(t)
+	resourceName := "aws_oam_link.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, oam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLinkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLinkConfig_basic(sinkIdentifier),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinkExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfoam.ResourceLink(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccObservabilityAccessManagerLink_linkConfiguration(t *testing.T) {
+	sinkIdentifier := testAccSinkIdentifier(t)
+	resourceName := "aws_oam_link.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, oam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLinkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLinkConfig_linkConfiguration(sinkIdentifier, "Namespace IN ('AWS/EC2', 'AWS/ELB')", "LogGroupName LIKE 'aws/lambda/%'"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinkExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "link_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "link_configuration.0.metric_configuration.0.filter", "Namespace IN ('AWS/EC2', 'AWS/ELB')"),
+					resource.TestCheckResourceAttr(resourceName, "link_configuration.0.log_group_configuration.0.filter", "LogGroupName LIKE 'aws/lambda/%'"),
+					resource.TestCheckResourceAttr(resourceName, "resource_types.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccLinkConfig_linkConfiguration(sinkIdentifier, "Namespace NOT LIKE 'AWS/%'", "LogGroupName = 'application'"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinkExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "link_configuration.0.metric_configuration.0.filter", "Namespace NOT LIKE 'AWS/%'"),
+					resource.TestCheckResourceAttr(resourceName, "link_configuration.0.log_group_configuration.0.filter", "LogGroupName = 'application'"),
+				),
+			},
+			{
+				Config: testAccLinkConfig_basic(sinkIdentifier),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinkExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "link_configuration.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "resource_types.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccObservabilityAccessManagerLink_tags(t *testing.T) {
+	sinkIdentifier := testAccSinkIdentifier(t)
+	resourceName := "aws_oam_link.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, oam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLinkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLinkConfig_tags1(sinkIdentifier, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinkExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccLinkConfig_tags2(sinkIdentifier, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinkExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccLinkConfig_tags1(sinkIdentifier, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinkExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLinkExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No CloudWatch Observability Access Manager Link ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ObservabilityAccessManagerConn
+
+		_, err := tfoam.FindLinkByID(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckLinkDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ObservabilityAccessManagerConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_oam_link" {
+			continue
+		}
+
+		_, err := tfoam.FindLinkByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("CloudWatch Observability Access Manager Link %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccLinkConfig_basic(sinkIdentifier string) string {
+	return fmt.Sprintf(`
+resource "aws_oam_link" "test" {
+  label_template  = "$AccountName"
+  resource_types  = ["AWS::CloudWatch::Metric"]
+  sink_identifier = %[1]q
+}
+`, sinkIdentifier)
+}
+
+func testAccLinkConfig_linkConfiguration(sinkIdentifier, metricFilter, logGroupFilter string) string {
+	return fmt.Sprintf(`
+resource "aws_oam_link" "test" {
+  label_template  = "$AccountName"
+  resource_types  = ["AWS::CloudWatch::Metric", "AWS::Logs::LogGroup"]
+  sink_identifier = %[1]q
+
+  link_configuration {
+    metric_configuration {
+      filter = %[2]q
+    }
+
+    log_group_configuration {
+      filter = %[3]q
+    }
+  }
+}
+`, sinkIdentifier, metricFilter, logGroupFilter)
+}
+
+func testAccLinkConfig_tags1(sinkIdentifier, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_oam_link" "test" {
+  label_template  = "$AccountName"
+  resource_types  = ["AWS::CloudWatch::Metric"]
+  sink_identifier = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, sinkIdentifier, tagKey1, tagValue1)
+}
+
+func testAccLinkConfig_tags2(sinkIdentifier, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_oam_link" "test" {
+  label_template  = "$AccountName"
+  resource_types  = ["AWS::CloudWatch::Metric"]
+  sink_identifier = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, sinkIdentifier, tagKey1, tagValue1, tagKey2, tagValue2)
+}
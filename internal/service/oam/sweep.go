@@ -0,0 +1,65 @@
+//go:build sweep
+// +build sweep
+
+package oam
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/oam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_oam_link", &resource.Sweeper{
+		Name: "aws_oam_link",
+		F:    sweepLinks,
+	})
+}
+
+func sweepLinks(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).ObservabilityAccessManagerConn
+	input := &oam.ListLinksInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListLinksPages(input, func(page *oam.ListLinksOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Items {
+			r := ResourceLink()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Arn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping CloudWatch Observability Access Manager Link sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing CloudWatch Observability Access Manager Links (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping CloudWatch Observability Access Manager Links (%s): %w", region, err)
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package organizations
 import (
 	"context"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -17,6 +18,11 @@ func DataSourceDelegatedAdministrators() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceDelegatedAdministratorsRead,
 		Schema: map[string]*schema.Schema{
+			"account_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"service_principal": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -90,6 +96,14 @@ func dataSourceDelegatedAdministratorsRead(ctx context.Context, d *schema.Resour
 		return diag.FromErr(fmt.Errorf("error describing organizations delegated Administrators: %w", err))
 	}
 
+	accountIDs := make([]string, 0, len(delegators))
+	for _, delegator := range delegators {
+		accountIDs = append(accountIDs, aws.StringValue(delegator.Id))
+	}
+	sort.Strings(accountIDs)
+
+	d.Set("account_ids", accountIDs)
+
 	if err = d.Set("delegated_administrators", flattenDelegatedAdministrators(delegators)); err != nil {
 		return diag.FromErr(fmt.Errorf("error setting delegated_administrators: %w", err))
 	}
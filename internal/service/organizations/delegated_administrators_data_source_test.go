@@ -25,6 +25,8 @@ func TestAccOrganizationsDelegatedAdministratorsDataSource_basic(t *testing.T) {
 			{
 				Config: testAccDelegatedAdministratorsDataSourceConfig_basic(servicePrincipal),
 				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "account_ids.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "account_ids.0", dataSourceIdentity, "account_id"),
 					resource.TestCheckResourceAttr(dataSourceName, "delegated_administrators.#", "1"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "delegated_administrators.0.id", dataSourceIdentity, "account_id"),
 					acctest.CheckResourceAttrRFC3339(dataSourceName, "delegated_administrators.0.delegation_enabled_date"),
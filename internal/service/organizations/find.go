@@ -62,3 +62,26 @@ func FindOrganization(conn *organizations.Organizations) (*organizations.Organiz
 
 	return output.Organization, nil
 }
+
+func FindResourcePolicy(conn *organizations.Organizations) (*organizations.ResourcePolicy, error) {
+	input := &organizations.DescribeResourcePolicyInput{}
+
+	output, err := conn.DescribeResourcePolicy(input)
+
+	if tfawserr.ErrCodeEquals(err, organizations.ErrCodeAWSOrganizationsNotInUseException, organizations.ErrCodeResourcePolicyNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ResourcePolicy == nil || output.ResourcePolicy.ResourcePolicySummary == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ResourcePolicy, nil
+}
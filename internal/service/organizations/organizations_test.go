@@ -53,14 +53,24 @@ func TestAccOrganizations_serial(t *testing.T) {
 			"disappears":             testAccPolicy_disappears,
 			"Type_AI_OPT_OUT":        testAccPolicy_type_AI_OPT_OUT,
 			"Type_Backup":            testAccPolicy_type_Backup,
+			"Type_Chatbot":           testAccPolicy_type_Chatbot,
+			"Type_DeclarativeEC2":    testAccPolicy_type_DeclarativeEC2,
 			"Type_SCP":               testAccPolicy_type_SCP,
 			"Type_Tag":               testAccPolicy_type_Tag,
+			"InvalidContent":         testAccPolicy_invalidContent,
+			"SkipDestroy":            testAccPolicy_skipDestroy,
 			"ImportAwsManagedPolicy": testAccPolicy_importManagedPolicy,
 		},
 		"PolicyAttachment": {
 			"Account":            testAccPolicyAttachment_Account,
 			"OrganizationalUnit": testAccPolicyAttachment_OrganizationalUnit,
 			"Root":               testAccPolicyAttachment_Root,
+			"SkipDestroy":        testAccPolicyAttachment_skipDestroy,
+		},
+		"ResourcePolicy": {
+			"basic":      testAccResourcePolicy_basic,
+			"disappears": testAccResourcePolicy_disappears,
+			"tags":       testAccResourcePolicy_tags,
 		},
 		"DelegatedAdministrator": {
 			"basic":      testAccDelegatedAdministrator_basic,
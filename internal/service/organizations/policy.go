@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/organizations"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -53,13 +54,20 @@ func ResourcePolicy() *schema.Resource {
 				Optional:     true,
 				ForceNew:     true,
 				Default:      organizations.PolicyTypeServiceControlPolicy,
-				ValidateFunc: validation.StringInSlice(organizations.PolicyType_Values(), false),
+				ValidateFunc: validation.StringInSlice(policyType_Values(), false),
+			},
+			"skip_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
 			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			resourcePolicyCustomizeDiff,
+		),
 	}
 }
 
@@ -208,6 +216,11 @@ func resourcePolicyUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 func resourcePolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).OrganizationsConn
 
+	if v, ok := d.GetOk("skip_destroy"); ok && v.(bool) {
+		log.Printf("[DEBUG] Retaining Organizations Policy: %s", d.Id())
+		return nil
+	}
+
 	input := &organizations.DeletePolicyInput{
 		PolicyId: aws.String(d.Id()),
 	}
@@ -19,6 +19,7 @@ func ResourcePolicyAttachment() *schema.Resource {
 	return &schema.Resource{
 		Create: resourcePolicyAttachmentCreate,
 		Read:   resourcePolicyAttachmentRead,
+		Update: resourcePolicyAttachmentUpdate,
 		Delete: resourcePolicyAttachmentDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -30,6 +31,10 @@ func ResourcePolicyAttachment() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"skip_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			"target_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -124,6 +129,11 @@ func resourcePolicyAttachmentRead(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
+func resourcePolicyAttachmentUpdate(d *schema.ResourceData, meta interface{}) error {
+	// Only skip_destroy can be updated, and it is only used on destroy.
+	return resourcePolicyAttachmentRead(d, meta)
+}
+
 func resourcePolicyAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).OrganizationsConn
 
@@ -132,6 +142,11 @@ func resourcePolicyAttachmentDelete(d *schema.ResourceData, meta interface{}) er
 		return err
 	}
 
+	if v, ok := d.GetOk("skip_destroy"); ok && v.(bool) {
+		log.Printf("[DEBUG] Retaining Organizations Policy %q attachment to %q", policyID, targetID)
+		return nil
+	}
+
 	input := &organizations.DetachPolicyInput{
 		PolicyId: aws.String(policyID),
 		TargetId: aws.String(targetID),
@@ -115,6 +115,34 @@ func testAccPolicyAttachment_Root(t *testing.T) {
 	})
 }
 
+func testAccPolicyAttachment_skipDestroy(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_organizations_policy_attachment.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckOrganizationsAccount(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, organizations.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyAttachmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyAttachmentConfig_skipDestroy(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyAttachmentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "skip_destroy", "true"),
+				),
+			},
+			{
+				// Removing the attachment from the configuration must leave the policy attached.
+				Config: testAccPolicyAttachmentConfig_skipDestroy(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyAttachmentRetained("aws_organizations_policy.test", "aws_organizations_organizational_unit.test"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckPolicyAttachmentDestroy(s *terraform.State) error {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).OrganizationsConn
 
@@ -210,6 +238,52 @@ func testAccCheckPolicyAttachmentExists(resourceName string) resource.TestCheckF
 	}
 }
 
+// testAccCheckPolicyAttachmentRetained verifies that the policy is still attached
+// to the target after the attachment resource was destroyed, then detaches it.
+func testAccCheckPolicyAttachmentRetained(policyResourceName, targetResourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		policy, ok := s.RootModule().Resources[policyResourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", policyResourceName)
+		}
+
+		target, ok := s.RootModule().Resources[targetResourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", targetResourceName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).OrganizationsConn
+
+		var attached bool
+		err := conn.ListTargetsForPolicyPages(&organizations.ListTargetsForPolicyInput{
+			PolicyId: aws.String(policy.Primary.ID),
+		}, func(page *organizations.ListTargetsForPolicyOutput, lastPage bool) bool {
+			for _, policySummary := range page.Targets {
+				if aws.StringValue(policySummary.TargetId) == target.Primary.ID {
+					attached = true
+					return false
+				}
+			}
+			return !lastPage
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if !attached {
+			return fmt.Errorf("Policy %q was detached from %q", policy.Primary.ID, target.Primary.ID)
+		}
+
+		_, err = conn.DetachPolicy(&organizations.DetachPolicyInput{
+			PolicyId: aws.String(policy.Primary.ID),
+			TargetId: aws.String(target.Primary.ID),
+		})
+
+		return err
+	}
+}
+
 func testAccPolicyAttachmentConfig_account(rName, policyType, policyContent string) string {
 	return fmt.Sprintf(`
 resource "aws_organizations_organization" "test" {
@@ -295,3 +369,45 @@ resource "aws_organizations_policy_attachment" "test" {
 }
 `, rName)
 }
+
+func testAccPolicyAttachmentConfig_skipDestroy(rName string, attach bool) string {
+	config := fmt.Sprintf(`
+resource "aws_organizations_organization" "test" {
+  enabled_policy_types = ["SERVICE_CONTROL_POLICY"]
+}
+
+resource "aws_organizations_organizational_unit" "test" {
+  name      = %[1]q
+  parent_id = aws_organizations_organization.test.roots[0].id
+}
+
+resource "aws_organizations_policy" "test" {
+  depends_on = [aws_organizations_organization.test]
+
+  content = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": {
+    "Effect": "Allow",
+    "Action": "*",
+    "Resource": "*"
+  }
+}
+EOF
+
+  name = %[1]q
+}
+`, rName)
+
+	if !attach {
+		return config
+	}
+
+	return acctest.ConfigCompose(config, `
+resource "aws_organizations_policy_attachment" "test" {
+  policy_id    = aws_organizations_policy.test.id
+  target_id    = aws_organizations_organizational_unit.test.id
+  skip_destroy = true
+}
+`)
+}
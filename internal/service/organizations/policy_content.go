@@ -0,0 +1,97 @@
+package organizations
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Policy types not yet defined by the AWS SDK for Go.
+const (
+	policyTypeChatbotPolicy        = "CHATBOT_POLICY"
+	policyTypeDeclarativePolicyEC2 = "DECLARATIVE_POLICY_EC2"
+)
+
+func policyType_Values() []string {
+	return append(organizations.PolicyType_Values(), policyTypeChatbotPolicy, policyTypeDeclarativePolicyEC2)
+}
+
+// managementPolicyRootKeys maps each management policy type to the top-level
+// key required by its syntax.
+// Reference: https://docs.aws.amazon.com/organizations/latest/userguide/orgs_manage_policies_management_policies_syntax.html
+var managementPolicyRootKeys = map[string]string{
+	organizations.PolicyTypeAiservicesOptOutPolicy: "services",
+	organizations.PolicyTypeBackupPolicy:           "plans",
+	organizations.PolicyTypeTagPolicy:              "tags",
+	policyTypeChatbotPolicy:                        "chatbot",
+	policyTypeDeclarativePolicyEC2:                 "ec2_attributes",
+}
+
+// validatePolicyContent checks that content is well-formed for the policy type.
+func validatePolicyContent(policyType, content string) error {
+	var document map[string]interface{}
+
+	if err := json.Unmarshal([]byte(content), &document); err != nil {
+		return fmt.Errorf("policy content must be a JSON object: %w", err)
+	}
+
+	if policyType == organizations.PolicyTypeServiceControlPolicy {
+		return validateServiceControlPolicyContent(document)
+	}
+
+	rootKey, ok := managementPolicyRootKeys[policyType]
+
+	if !ok {
+		return nil
+	}
+
+	if _, ok := document[rootKey].(map[string]interface{}); !ok {
+		return fmt.Errorf("%s content must contain a top-level %q object", policyType, rootKey)
+	}
+
+	for k := range document {
+		if k != rootKey {
+			return fmt.Errorf("%s content contains unexpected top-level key %q, expected only %q", policyType, k, rootKey)
+		}
+	}
+
+	return nil
+}
+
+func validateServiceControlPolicyContent(document map[string]interface{}) error {
+	var statements []interface{}
+
+	switch v := document["Statement"].(type) {
+	case map[string]interface{}:
+		statements = []interface{}{v}
+	case []interface{}:
+		statements = v
+	default:
+		return fmt.Errorf("%s content must contain a \"Statement\" object or array", organizations.PolicyTypeServiceControlPolicy)
+	}
+
+	for i, v := range statements {
+		statement, ok := v.(map[string]interface{})
+
+		if !ok {
+			return fmt.Errorf("%s content statement %d must be an object", organizations.PolicyTypeServiceControlPolicy, i)
+		}
+
+		if _, ok := statement["Effect"].(string); !ok {
+			return fmt.Errorf("%s content statement %d must contain an \"Effect\"", organizations.PolicyTypeServiceControlPolicy, i)
+		}
+	}
+
+	return nil
+}
+
+func resourcePolicyCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("content") || !diff.NewValueKnown("type") {
+		return nil
+	}
+
+	return validatePolicyContent(diff.Get("type").(string), diff.Get("content").(string))
+}
@@ -0,0 +1,98 @@
+package organizations
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/organizations"
+)
+
+func TestValidatePolicyContent(t *testing.T) {
+	testCases := []struct {
+		name        string
+		policyType  string
+		content     string
+		expectError bool
+	}{
+		{
+			name:        "not JSON",
+			policyType:  organizations.PolicyTypeServiceControlPolicy,
+			content:     `{`,
+			expectError: true,
+		},
+		{
+			name:        "not an object",
+			policyType:  organizations.PolicyTypeTagPolicy,
+			content:     `["tags"]`,
+			expectError: true,
+		},
+		{
+			name:       "SCP single statement",
+			policyType: organizations.PolicyTypeServiceControlPolicy,
+			content:    `{"Version": "2012-10-17", "Statement": {"Effect": "Allow", "Action": "*", "Resource": "*"}}`,
+		},
+		{
+			name:       "SCP statement list",
+			policyType: organizations.PolicyTypeServiceControlPolicy,
+			content:    `{"Version": "2012-10-17", "Statement": [{"Effect": "Deny", "Action": "s3:*", "Resource": "*"}]}`,
+		},
+		{
+			name:        "SCP missing statement",
+			policyType:  organizations.PolicyTypeServiceControlPolicy,
+			content:     `{"Version": "2012-10-17"}`,
+			expectError: true,
+		},
+		{
+			name:        "SCP statement missing effect",
+			policyType:  organizations.PolicyTypeServiceControlPolicy,
+			content:     `{"Version": "2012-10-17", "Statement": [{"Action": "*", "Resource": "*"}]}`,
+			expectError: true,
+		},
+		{
+			name:       "tag policy",
+			policyType: organizations.PolicyTypeTagPolicy,
+			content:    `{"tags": {"Product": {"tag_key": {"@@assign": "Product"}}}}`,
+		},
+		{
+			name:        "tag policy with wrong root",
+			policyType:  organizations.PolicyTypeTagPolicy,
+			content:     `{"services": {}}`,
+			expectError: true,
+		},
+		{
+			name:        "backup policy with extra root",
+			policyType:  organizations.PolicyTypeBackupPolicy,
+			content:     `{"plans": {}, "tags": {}}`,
+			expectError: true,
+		},
+		{
+			name:       "chatbot policy",
+			policyType: policyTypeChatbotPolicy,
+			content:    `{"chatbot": {"platforms": {"slack": {"client": {"@@assign": "disabled"}}}}}`,
+		},
+		{
+			name:       "declarative EC2 policy",
+			policyType: policyTypeDeclarativePolicyEC2,
+			content:    `{"ec2_attributes": {"image_block_public_access": {"state": {"@@assign": "block_new_sharing"}}}}`,
+		},
+		{
+			name:        "declarative EC2 policy with SCP content",
+			policyType:  policyTypeDeclarativePolicyEC2,
+			content:     `{"Version": "2012-10-17", "Statement": {"Effect": "Allow", "Action": "*", "Resource": "*"}}`,
+			expectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := validatePolicyContent(testCase.policyType, testCase.content)
+
+			if err == nil && testCase.expectError {
+				t.Fatal("expected error, got none")
+			}
+
+			if err != nil && !testCase.expectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
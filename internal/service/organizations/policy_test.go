@@ -6,6 +6,7 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/organizations"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -385,6 +386,104 @@ func testAccPolicy_type_Tag(t *testing.T) {
 	})
 }
 
+func testAccPolicy_type_Chatbot(t *testing.T) {
+	var policy organizations.Policy
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_organizations_policy.test"
+	// Reference: https://docs.aws.amazon.com/organizations/latest/userguide/orgs_manage_policies_chatbot_syntax.html
+	chatbotPolicyContent := `{ "chatbot": { "platforms": { "slack": { "client": { "@@assign": "disabled" } } } } }`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckOrganizationsAccount(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, organizations.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyConfig_type(rName, chatbotPolicyContent, "CHATBOT_POLICY"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyExists(resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, "type", "CHATBOT_POLICY"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccPolicy_type_DeclarativeEC2(t *testing.T) {
+	var policy organizations.Policy
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_organizations_policy.test"
+	// Reference: https://docs.aws.amazon.com/organizations/latest/userguide/orgs_manage_policies_declarative_syntax.html
+	declarativePolicyContent := `{ "ec2_attributes": { "image_block_public_access": { "state": { "@@assign": "block_new_sharing" } } } }`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckOrganizationsAccount(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, organizations.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyConfig_type(rName, declarativePolicyContent, "DECLARATIVE_POLICY_EC2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyExists(resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, "type", "DECLARATIVE_POLICY_EC2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccPolicy_invalidContent(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckOrganizationsAccount(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, organizations.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPolicyConfig_type(rName, `{ "services": {} }`, organizations.PolicyTypeTagPolicy),
+				ExpectError: regexp.MustCompile(`TAG_POLICY content must contain a top-level "tags" object`),
+			},
+		},
+	})
+}
+
+func testAccPolicy_skipDestroy(t *testing.T) {
+	var policy organizations.Policy
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_organizations_policy.test"
+	content := `{"Version": "2012-10-17", "Statement": { "Effect": "Allow", "Action": "*", "Resource": "*"}}`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckOrganizationsAccount(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, organizations.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyRetained(&policy),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyConfig_skipDestroy(rName, content),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyExists(resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, "skip_destroy", "true"),
+				),
+			},
+		},
+	})
+}
+
 func testAccPolicy_importManagedPolicy(t *testing.T) {
 	resourceName := "aws_organizations_policy.test"
 
@@ -445,6 +544,23 @@ func testAccCheckPolicyDestroy(s *terraform.State) error {
 
 }
 
+// testAccCheckPolicyRetained verifies that a policy with skip_destroy set
+// still exists after destroy, then deletes it.
+func testAccCheckPolicyRetained(policy *organizations.Policy) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).OrganizationsConn
+		policyID := policy.PolicySummary.Id
+
+		if _, err := conn.DescribePolicy(&organizations.DescribePolicyInput{PolicyId: policyID}); err != nil {
+			return fmt.Errorf("Policy %q was not retained: %w", aws.StringValue(policyID), err)
+		}
+
+		_, err := conn.DeletePolicy(&organizations.DeletePolicyInput{PolicyId: policyID})
+
+		return err
+	}
+}
+
 func testAccCheckPolicyExists(resourceName string, policy *organizations.Policy) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -721,6 +837,20 @@ resource "aws_organizations_policy" "test" {
 `, strconv.Quote(content), rName, policyType)
 }
 
+func testAccPolicyConfig_skipDestroy(rName, content string) string {
+	return fmt.Sprintf(`
+resource "aws_organizations_organization" "test" {}
+
+resource "aws_organizations_policy" "test" {
+  content      = %s
+  name         = "%s"
+  skip_destroy = true
+
+  depends_on = [aws_organizations_organization.test]
+}
+`, strconv.Quote(content), rName)
+}
+
 const testAccPolicyConfig_managedSetup = `
 resource "aws_organizations_organization" "test" {
   enabled_policy_types = ["SERVICE_CONTROL_POLICY"]
@@ -0,0 +1,186 @@
+package organizations
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceResourcePolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceResourcePolicyCreate,
+		ReadWithoutTimeout:   resourceResourcePolicyRead,
+		UpdateWithoutTimeout: resourceResourcePolicyUpdate,
+		DeleteWithoutTimeout: resourceResourcePolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"content": {
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
+				ValidateFunc:     validation.StringIsJSON,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceResourcePolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).OrganizationsConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	policy, err := structure.NormalizeJsonString(d.Get("content").(string))
+
+	if err != nil {
+		return diag.Errorf("policy (%s) is invalid JSON: %s", d.Get("content").(string), err)
+	}
+
+	input := &organizations.PutResourcePolicyInput{
+		Content: aws.String(policy),
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Organizations Resource Policy: %s", input)
+	output, err := conn.PutResourcePolicyWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Organizations Resource Policy: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.ResourcePolicy.ResourcePolicySummary.Id))
+
+	return resourceResourcePolicyRead(ctx, d, meta)
+}
+
+func resourceResourcePolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).OrganizationsConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	policy, err := FindResourcePolicy(conn)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Organizations Resource Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Organizations Resource Policy (%s): %s", d.Id(), err)
+	}
+
+	// There is only one resource policy per organization.
+	// If it was replaced outside of Terraform, the resource is gone.
+	if id := aws.StringValue(policy.ResourcePolicySummary.Id); !d.IsNewResource() && id != d.Id() {
+		log.Printf("[WARN] Organizations Resource Policy (%s) replaced by (%s), removing from state", d.Id(), id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", policy.ResourcePolicySummary.Arn)
+
+	policyToSet, err := verify.PolicyToSet(d.Get("content").(string), aws.StringValue(policy.Content))
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("content", policyToSet)
+
+	tags, err := ListTagsWithContext(ctx, conn, d.Id())
+
+	if err != nil {
+		return diag.Errorf("listing tags for Organizations Resource Policy (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceResourcePolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).OrganizationsConn
+
+	if d.HasChange("content") {
+		policy, err := structure.NormalizeJsonString(d.Get("content").(string))
+
+		if err != nil {
+			return diag.Errorf("policy (%s) is invalid JSON: %s", d.Get("content").(string), err)
+		}
+
+		input := &organizations.PutResourcePolicyInput{
+			Content: aws.String(policy),
+		}
+
+		log.Printf("[DEBUG] Updating Organizations Resource Policy: %s", input)
+		if _, err := conn.PutResourcePolicyWithContext(ctx, input); err != nil {
+			return diag.Errorf("updating Organizations Resource Policy (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Id(), o, n); err != nil {
+			return diag.Errorf("updating Organizations Resource Policy (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceResourcePolicyRead(ctx, d, meta)
+}
+
+func resourceResourcePolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).OrganizationsConn
+
+	log.Printf("[DEBUG] Deleting Organizations Resource Policy: %s", d.Id())
+	_, err := conn.DeleteResourcePolicyWithContext(ctx, &organizations.DeleteResourcePolicyInput{})
+
+	if tfawserr.ErrCodeEquals(err, organizations.ErrCodeAWSOrganizationsNotInUseException, organizations.ErrCodeResourcePolicyNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Organizations Resource Policy (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
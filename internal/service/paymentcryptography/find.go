@@ -0,0 +1,69 @@
+package paymentcryptography
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/paymentcryptography"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindAliasByName(ctx context.Context, conn *paymentcryptography.PaymentCryptography, name string) (*paymentcryptography.Alias, error) {
+	input := &paymentcryptography.GetAliasInput{
+		AliasName: aws.String(name),
+	}
+
+	output, err := conn.GetAliasWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, paymentcryptography.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Alias == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Alias, nil
+}
+
+// FindKeyByARN returns the specified key. Keys pending deletion are treated as not found.
+func FindKeyByARN(ctx context.Context, conn *paymentcryptography.PaymentCryptography, arn string) (*paymentcryptography.Key, error) {
+	input := &paymentcryptography.GetKeyInput{
+		KeyIdentifier: aws.String(arn),
+	}
+
+	output, err := conn.GetKeyWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, paymentcryptography.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Key == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if state := aws.StringValue(output.Key.KeyState); state == paymentcryptography.KeyStateDeletePending || state == paymentcryptography.KeyStateDeleteComplete {
+		return nil, &resource.NotFoundError{
+			Message:     state,
+			LastRequest: input,
+		}
+	}
+
+	return output.Key, nil
+}
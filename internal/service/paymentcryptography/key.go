@@ -0,0 +1,645 @@
+package paymentcryptography
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/paymentcryptography"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceKey() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceKeyCreate,
+		ReadWithoutTimeout:   resourceKeyRead,
+		UpdateWithoutTimeout: resourceKeyUpdate,
+		DeleteWithoutTimeout: resourceKeyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			customizeDiffKeyAttributes,
+		),
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"deletion_window_in_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      7,
+				ValidateFunc: validation.IntBetween(3, 180),
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"exportable": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"import_key_material"},
+			},
+			// Import tokens and wrapped key material can only be used once, so changes
+			// to import_key_material after the key has been imported are ignored.
+			"import_key_material": {
+				Type:             schema.TypeList,
+				Optional:         true,
+				MaxItems:         1,
+				ExactlyOneOf:     []string{"import_key_material", "key_attributes"},
+				DiffSuppressFunc: suppressAfterImport,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key_cryptogram": {
+							Type:             schema.TypeList,
+							Optional:         true,
+							MaxItems:         1,
+							ExactlyOneOf:     importKeyMaterialTypes,
+							DiffSuppressFunc: suppressAfterImport,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"exportable": {
+										Type:             schema.TypeBool,
+										Required:         true,
+										DiffSuppressFunc: suppressAfterImport,
+									},
+									"import_token": {
+										Type:             schema.TypeString,
+										Required:         true,
+										DiffSuppressFunc: suppressAfterImport,
+									},
+									"key_attributes": importKeyAttributesSchema(),
+									"wrapped_key_cryptogram": {
+										Type:             schema.TypeString,
+										Required:         true,
+										Sensitive:        true,
+										DiffSuppressFunc: suppressAfterImport,
+									},
+									"wrapping_spec": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ValidateFunc:     validation.StringInSlice(paymentcryptography.WrappingKeySpec_Values(), false),
+										DiffSuppressFunc: suppressAfterImport,
+									},
+								},
+							},
+						},
+						"root_certificate_public_key": {
+							Type:             schema.TypeList,
+							Optional:         true,
+							MaxItems:         1,
+							ExactlyOneOf:     importKeyMaterialTypes,
+							DiffSuppressFunc: suppressAfterImport,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key_attributes": importKeyAttributesSchema(),
+									"public_key_certificate": {
+										Type:             schema.TypeString,
+										Required:         true,
+										DiffSuppressFunc: suppressAfterImport,
+									},
+								},
+							},
+						},
+						"tr31_key_block": {
+							Type:             schema.TypeList,
+							Optional:         true,
+							MaxItems:         1,
+							ExactlyOneOf:     importKeyMaterialTypes,
+							DiffSuppressFunc: suppressAfterImport,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"wrapped_key_block": {
+										Type:             schema.TypeString,
+										Required:         true,
+										Sensitive:        true,
+										DiffSuppressFunc: suppressAfterImport,
+									},
+									"wrapping_key_identifier": {
+										Type:             schema.TypeString,
+										Required:         true,
+										DiffSuppressFunc: suppressAfterImport,
+									},
+								},
+							},
+						},
+						"tr34_key_block": {
+							Type:             schema.TypeList,
+							Optional:         true,
+							MaxItems:         1,
+							ExactlyOneOf:     importKeyMaterialTypes,
+							DiffSuppressFunc: suppressAfterImport,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"certificate_authority_public_key_identifier": {
+										Type:             schema.TypeString,
+										Required:         true,
+										DiffSuppressFunc: suppressAfterImport,
+									},
+									"import_token": {
+										Type:             schema.TypeString,
+										Required:         true,
+										DiffSuppressFunc: suppressAfterImport,
+									},
+									"key_block_format": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										Default:          paymentcryptography.Tr34KeyBlockFormatX9Tr342012,
+										ValidateFunc:     validation.StringInSlice(paymentcryptography.Tr34KeyBlockFormat_Values(), false),
+										DiffSuppressFunc: suppressAfterImport,
+									},
+									"random_nonce": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										DiffSuppressFunc: suppressAfterImport,
+									},
+									"signing_key_certificate": {
+										Type:             schema.TypeString,
+										Required:         true,
+										DiffSuppressFunc: suppressAfterImport,
+									},
+									"wrapped_key_block": {
+										Type:             schema.TypeString,
+										Required:         true,
+										Sensitive:        true,
+										DiffSuppressFunc: suppressAfterImport,
+									},
+								},
+							},
+						},
+						"trusted_certificate_public_key": {
+							Type:             schema.TypeList,
+							Optional:         true,
+							MaxItems:         1,
+							ExactlyOneOf:     importKeyMaterialTypes,
+							DiffSuppressFunc: suppressAfterImport,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"certificate_authority_public_key_identifier": {
+										Type:             schema.TypeString,
+										Required:         true,
+										DiffSuppressFunc: suppressAfterImport,
+									},
+									"key_attributes": importKeyAttributesSchema(),
+									"public_key_certificate": {
+										Type:             schema.TypeString,
+										Required:         true,
+										DiffSuppressFunc: suppressAfterImport,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"key_attributes": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"import_key_material", "key_attributes"},
+				Elem: &schema.Resource{
+					Schema: keyAttributesSchema(false),
+				},
+			},
+			"key_check_value": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"key_check_value_algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(paymentcryptography.KeyCheckValueAlgorithm_Values(), false),
+			},
+			"key_origin": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"key_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+var importKeyMaterialTypes = []string{
+	"import_key_material.0.key_cryptogram",
+	"import_key_material.0.root_certificate_public_key",
+	"import_key_material.0.tr31_key_block",
+	"import_key_material.0.tr34_key_block",
+	"import_key_material.0.trusted_certificate_public_key",
+}
+
+func resourceKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PaymentCryptographyConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	var key *paymentcryptography.Key
+
+	if v, ok := d.GetOk("import_key_material"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input := &paymentcryptography.ImportKeyInput{
+			Enabled:     aws.Bool(d.Get("enabled").(bool)),
+			KeyMaterial: expandImportKeyMaterial(v.([]interface{})[0].(map[string]interface{})),
+		}
+
+		if v, ok := d.GetOk("key_check_value_algorithm"); ok {
+			input.KeyCheckValueAlgorithm = aws.String(v.(string))
+		}
+
+		if len(tags) > 0 {
+			input.Tags = Tags(tags.IgnoreAWS())
+		}
+
+		output, err := conn.ImportKeyWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("importing Payment Cryptography Key: %s", err)
+		}
+
+		key = output.Key
+	} else {
+		input := &paymentcryptography.CreateKeyInput{
+			Enabled:       aws.Bool(d.Get("enabled").(bool)),
+			Exportable:    aws.Bool(d.Get("exportable").(bool)),
+			KeyAttributes: expandKeyAttributes(d.Get("key_attributes").([]interface{})),
+		}
+
+		if v, ok := d.GetOk("key_check_value_algorithm"); ok {
+			input.KeyCheckValueAlgorithm = aws.String(v.(string))
+		}
+
+		if len(tags) > 0 {
+			input.Tags = Tags(tags.IgnoreAWS())
+		}
+
+		log.Printf("[DEBUG] Creating Payment Cryptography Key: %s", input)
+		output, err := conn.CreateKeyWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("creating Payment Cryptography Key: %s", err)
+		}
+
+		key = output.Key
+	}
+
+	d.SetId(aws.StringValue(key.KeyArn))
+
+	if _, err := waitKeyCreated(ctx, conn, d.Id()); err != nil {
+		return diag.Errorf("waiting for Payment Cryptography Key (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceKeyRead(ctx, d, meta)
+}
+
+func resourceKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PaymentCryptographyConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	key, err := FindKeyByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Payment Cryptography Key (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Payment Cryptography Key (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", key.KeyArn)
+	d.Set("enabled", key.Enabled)
+	d.Set("exportable", key.Exportable)
+	if err := d.Set("key_attributes", flattenKeyAttributes(key.KeyAttributes)); err != nil {
+		return diag.Errorf("setting key_attributes: %s", err)
+	}
+	d.Set("key_check_value", key.KeyCheckValue)
+	d.Set("key_check_value_algorithm", key.KeyCheckValueAlgorithm)
+	d.Set("key_origin", key.KeyOrigin)
+	d.Set("key_state", key.KeyState)
+
+	tags, err := ListTagsWithContext(ctx, conn, d.Id())
+
+	if err != nil {
+		return diag.Errorf("listing tags for Payment Cryptography Key (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceKeyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PaymentCryptographyConn
+
+	if d.HasChange("enabled") {
+		var err error
+
+		if d.Get("enabled").(bool) {
+			_, err = conn.StartKeyUsageWithContext(ctx, &paymentcryptography.StartKeyUsageInput{
+				KeyIdentifier: aws.String(d.Id()),
+			})
+		} else {
+			_, err = conn.StopKeyUsageWithContext(ctx, &paymentcryptography.StopKeyUsageInput{
+				KeyIdentifier: aws.String(d.Id()),
+			})
+		}
+
+		if err != nil {
+			return diag.Errorf("updating Payment Cryptography Key (%s) usage: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Id(), o, n); err != nil {
+			return diag.Errorf("updating Payment Cryptography Key (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceKeyRead(ctx, d, meta)
+}
+
+func resourceKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PaymentCryptographyConn
+
+	log.Printf("[DEBUG] Deleting Payment Cryptography Key: %s", d.Id())
+	_, err := conn.DeleteKeyWithContext(ctx, &paymentcryptography.DeleteKeyInput{
+		DeleteKeyInDays: aws.Int64(int64(d.Get("deletion_window_in_days").(int))),
+		KeyIdentifier:   aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, paymentcryptography.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Payment Cryptography Key (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitKeyDeleted(ctx, conn, d.Id()); err != nil {
+		return diag.Errorf("waiting for Payment Cryptography Key (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// customizeDiffKeyAttributes checks that the configured key attributes are compatible with each other
+// so that an unsupported key usage is reported at plan time rather than when the key is created.
+func customizeDiffKeyAttributes(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.HasChange("key_attributes") {
+		if err := validKeyAttributesList(diff.Get("key_attributes").([]interface{})); err != nil {
+			return fmt.Errorf("key_attributes: %w", err)
+		}
+	}
+
+	if diff.Id() != "" {
+		return nil
+	}
+
+	for _, k := range []string{
+		"import_key_material.0.key_cryptogram.0.key_attributes",
+		"import_key_material.0.root_certificate_public_key.0.key_attributes",
+		"import_key_material.0.trusted_certificate_public_key.0.key_attributes",
+	} {
+		if v, ok := diff.Get(k).([]interface{}); ok {
+			if err := validKeyAttributesList(v); err != nil {
+				return fmt.Errorf("%s: %w", k, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func validKeyAttributesList(tfList []interface{}) error {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	return validKeyAttributes(tfList[0].(map[string]interface{}))
+}
+
+func suppressAfterImport(k, old, new string, d *schema.ResourceData) bool {
+	return d.Id() != ""
+}
+
+func keyAttributesSchema(imported bool) map[string]*schema.Schema {
+	newSchema := func(s *schema.Schema) *schema.Schema {
+		if imported {
+			s.DiffSuppressFunc = suppressAfterImport
+		} else {
+			s.ForceNew = true
+		}
+
+		return s
+	}
+
+	keyModesOfUseSchema := map[string]*schema.Schema{}
+
+	for _, mode := range keyModesOfUse {
+		keyModesOfUseSchema[mode] = newSchema(&schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		})
+	}
+
+	return map[string]*schema.Schema{
+		"key_algorithm": newSchema(&schema.Schema{
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(paymentcryptography.KeyAlgorithm_Values(), false),
+		}),
+		"key_class": newSchema(&schema.Schema{
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(paymentcryptography.KeyClass_Values(), false),
+		}),
+		"key_modes_of_use": newSchema(&schema.Schema{
+			Type:     schema.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: keyModesOfUseSchema,
+			},
+		}),
+		"key_usage": newSchema(&schema.Schema{
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(paymentcryptography.KeyUsage_Values(), false),
+		}),
+	}
+}
+
+func importKeyAttributesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:             schema.TypeList,
+		Required:         true,
+		MaxItems:         1,
+		DiffSuppressFunc: suppressAfterImport,
+		Elem: &schema.Resource{
+			Schema: keyAttributesSchema(true),
+		},
+	}
+}
+
+func expandKeyAttributes(tfList []interface{}) *paymentcryptography.KeyAttributes {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &paymentcryptography.KeyAttributes{
+		KeyAlgorithm: aws.String(tfMap["key_algorithm"].(string)),
+		KeyClass:     aws.String(tfMap["key_class"].(string)),
+		KeyUsage:     aws.String(tfMap["key_usage"].(string)),
+	}
+
+	if v, ok := tfMap["key_modes_of_use"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.KeyModesOfUse = &paymentcryptography.KeyModesOfUse{
+			Decrypt:        aws.Bool(tfMap["decrypt"].(bool)),
+			DeriveKey:      aws.Bool(tfMap["derive_key"].(bool)),
+			Encrypt:        aws.Bool(tfMap["encrypt"].(bool)),
+			Generate:       aws.Bool(tfMap["generate"].(bool)),
+			NoRestrictions: aws.Bool(tfMap["no_restrictions"].(bool)),
+			Sign:           aws.Bool(tfMap["sign"].(bool)),
+			Unwrap:         aws.Bool(tfMap["unwrap"].(bool)),
+			Verify:         aws.Bool(tfMap["verify"].(bool)),
+			Wrap:           aws.Bool(tfMap["wrap"].(bool)),
+		}
+	}
+
+	return apiObject
+}
+
+func expandImportKeyMaterial(tfMap map[string]interface{}) *paymentcryptography.ImportKeyMaterial {
+	apiObject := &paymentcryptography.ImportKeyMaterial{}
+
+	if v, ok := tfMap["key_cryptogram"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.KeyCryptogram = &paymentcryptography.ImportKeyCryptogram{
+			Exportable:           aws.Bool(tfMap["exportable"].(bool)),
+			ImportToken:          aws.String(tfMap["import_token"].(string)),
+			KeyAttributes:        expandKeyAttributes(tfMap["key_attributes"].([]interface{})),
+			WrappedKeyCryptogram: aws.String(tfMap["wrapped_key_cryptogram"].(string)),
+		}
+
+		if v, ok := tfMap["wrapping_spec"].(string); ok && v != "" {
+			apiObject.KeyCryptogram.WrappingSpec = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["root_certificate_public_key"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.RootCertificatePublicKey = &paymentcryptography.RootCertificatePublicKey{
+			KeyAttributes:        expandKeyAttributes(tfMap["key_attributes"].([]interface{})),
+			PublicKeyCertificate: aws.String(tfMap["public_key_certificate"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["tr31_key_block"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.Tr31KeyBlock = &paymentcryptography.ImportTr31KeyBlock{
+			WrappedKeyBlock:       aws.String(tfMap["wrapped_key_block"].(string)),
+			WrappingKeyIdentifier: aws.String(tfMap["wrapping_key_identifier"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["tr34_key_block"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.Tr34KeyBlock = &paymentcryptography.ImportTr34KeyBlock{
+			CertificateAuthorityPublicKeyIdentifier: aws.String(tfMap["certificate_authority_public_key_identifier"].(string)),
+			ImportToken:                             aws.String(tfMap["import_token"].(string)),
+			KeyBlockFormat:                          aws.String(tfMap["key_block_format"].(string)),
+			SigningKeyCertificate:                   aws.String(tfMap["signing_key_certificate"].(string)),
+			WrappedKeyBlock:                         aws.String(tfMap["wrapped_key_block"].(string)),
+		}
+
+		if v, ok := tfMap["random_nonce"].(string); ok && v != "" {
+			apiObject.Tr34KeyBlock.RandomNonce = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["trusted_certificate_public_key"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.TrustedCertificatePublicKey = &paymentcryptography.TrustedCertificatePublicKey{
+			CertificateAuthorityPublicKeyIdentifier: aws.String(tfMap["certificate_authority_public_key_identifier"].(string)),
+			KeyAttributes:                           expandKeyAttributes(tfMap["key_attributes"].([]interface{})),
+			PublicKeyCertificate:                    aws.String(tfMap["public_key_certificate"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func flattenKeyAttributes(apiObject *paymentcryptography.KeyAttributes) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"key_algorithm": aws.StringValue(apiObject.KeyAlgorithm),
+		"key_class":     aws.StringValue(apiObject.KeyClass),
+		"key_usage":     aws.StringValue(apiObject.KeyUsage),
+	}
+
+	if v := apiObject.KeyModesOfUse; v != nil {
+		tfMap["key_modes_of_use"] = []interface{}{map[string]interface{}{
+			"decrypt":         aws.BoolValue(v.Decrypt),
+			"derive_key":      aws.BoolValue(v.DeriveKey),
+			"encrypt":         aws.BoolValue(v.Encrypt),
+			"generate":        aws.BoolValue(v.Generate),
+			"no_restrictions": aws.BoolValue(v.NoRestrictions),
+			"sign":            aws.BoolValue(v.Sign),
+			"unwrap":          aws.BoolValue(v.Unwrap),
+			"verify":          aws.BoolValue(v.Verify),
+			"wrap":            aws.BoolValue(v.Wrap),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,134 @@
+package paymentcryptography
+
+import (
+	"context"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/paymentcryptography"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceKeyAlias() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceKeyAliasCreate,
+		ReadWithoutTimeout:   resourceKeyAliasRead,
+		UpdateWithoutTimeout: resourceKeyAliasUpdate,
+		DeleteWithoutTimeout: resourceKeyAliasDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"alias_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(7, 256),
+					validation.StringMatch(regexp.MustCompile(`^alias/[a-zA-Z0-9/_-]+$`), "must begin with alias/ followed by alphanumeric characters, forward slashes, underscores or hyphens"),
+				),
+			},
+			"key_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func resourceKeyAliasCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PaymentCryptographyConn
+
+	name := d.Get("alias_name").(string)
+	input := &paymentcryptography.CreateAliasInput{
+		AliasName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("key_arn"); ok {
+		input.KeyArn = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Payment Cryptography Key Alias: %s", input)
+	output, err := conn.CreateAliasWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Payment Cryptography Key Alias (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Alias.AliasName))
+
+	return resourceKeyAliasRead(ctx, d, meta)
+}
+
+func resourceKeyAliasRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PaymentCryptographyConn
+
+	alias, err := FindAliasByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Payment Cryptography Key Alias (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Payment Cryptography Key Alias (%s): %s", d.Id(), err)
+	}
+
+	d.Set("alias_name", alias.AliasName)
+	d.Set("key_arn", alias.KeyArn)
+
+	return nil
+}
+
+func resourceKeyAliasUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PaymentCryptographyConn
+
+	// Pointing an existing alias at a new key lets applications rotate keys without
+	// changing the identifier that they use.
+	input := &paymentcryptography.UpdateAliasInput{
+		AliasName: aws.String(d.Id()),
+	}
+
+	if v, ok := d.GetOk("key_arn"); ok {
+		input.KeyArn = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Updating Payment Cryptography Key Alias: %s", input)
+	_, err := conn.UpdateAliasWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("updating Payment Cryptography Key Alias (%s): %s", d.Id(), err)
+	}
+
+	return resourceKeyAliasRead(ctx, d, meta)
+}
+
+func resourceKeyAliasDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PaymentCryptographyConn
+
+	log.Printf("[DEBUG] Deleting Payment Cryptography Key Alias: %s", d.Id())
+	_, err := conn.DeleteAliasWithContext(ctx, &paymentcryptography.DeleteAliasInput{
+		AliasName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, paymentcryptography.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Payment Cryptography Key Alias (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
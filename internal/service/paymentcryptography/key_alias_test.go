@@ -0,0 +1,184 @@
+package paymentcryptography_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/paymentcryptography"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfpaymentcryptography "github.com/hashicorp/terraform-provider-aws/internal/service/paymentcryptography"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccPaymentCryptographyKeyAlias_basic(t *testing.T) {
+	var v paymentcryptography.Alias
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_paymentcryptography_key_alias.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, paymentcryptography.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKeyAliasDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyAliasConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyAliasExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "alias_name", "alias/"+rName),
+					resource.TestCheckResourceAttr(resourceName, "key_arn", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccPaymentCryptographyKeyAlias_disappears(t *testing.T) {
+	var v paymentcryptography.Alias
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_paymentcryptography_key_alias.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, paymentcryptography.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKeyAliasDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyAliasConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyAliasExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfpaymentcryptography.ResourceKeyAlias(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccPaymentCryptographyKeyAlias_rotation(t *testing.T) {
+	var v paymentcryptography.Alias
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_paymentcryptography_key_alias.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, paymentcryptography.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKeyAliasDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyAliasConfig_keyARN(rName, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyAliasExists(resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "key_arn", "aws_paymentcryptography_key.test.0", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccKeyAliasConfig_keyARN(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyAliasExists(resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "key_arn", "aws_paymentcryptography_key.test.1", "arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKeyAliasDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).PaymentCryptographyConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_paymentcryptography_key_alias" {
+			continue
+		}
+
+		_, err := tfpaymentcryptography.FindAliasByName(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Payment Cryptography Key Alias %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckKeyAliasExists(n string, v *paymentcryptography.Alias) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Payment Cryptography Key Alias ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PaymentCryptographyConn
+
+		output, err := tfpaymentcryptography.FindAliasByName(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccKeyAliasConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_paymentcryptography_key_alias" "test" {
+  alias_name = "alias/%[1]s"
+}
+`, rName)
+}
+
+func testAccKeyAliasConfig_keyARN(rName string, index int) string {
+	return fmt.Sprintf(`
+resource "aws_paymentcryptography_key" "test" {
+  count = 2
+
+  exportable = true
+
+  key_attributes {
+    key_algorithm = "TDES_3KEY"
+    key_class     = "SYMMETRIC_KEY"
+    key_usage     = "TR31_K0_KEY_ENCRYPTION_KEY"
+
+    key_modes_of_use {
+      unwrap = true
+      wrap   = true
+    }
+  }
+}
+
+resource "aws_paymentcryptography_key_alias" "test" {
+  alias_name = "alias/%[1]s"
+  key_arn    = aws_paymentcryptography_key.test[%[2]d].arn
+}
+`, rName, index)
+}
@@ -0,0 +1,377 @@
+package paymentcryptography_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/paymentcryptography"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfpaymentcryptography "github.com/hashicorp/terraform-provider-aws/internal/service/paymentcryptography"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccPaymentCryptographyKey_basic(t *testing.T) {
+	var v paymentcryptography.Key
+	resourceName := "aws_paymentcryptography_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, paymentcryptography.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyExists(resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "payment-cryptography", regexp.MustCompile(`key/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "deletion_window_in_days", "7"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "exportable", "true"),
+					resource.TestCheckResourceAttr(resourceName, "key_attributes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "key_attributes.0.key_algorithm", "TDES_3KEY"),
+					resource.TestCheckResourceAttr(resourceName, "key_attributes.0.key_class", "SYMMETRIC_KEY"),
+					resource.TestCheckResourceAttr(resourceName, "key_attributes.0.key_modes_of_use.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "key_attributes.0.key_modes_of_use.0.decrypt", "true"),
+					resource.TestCheckResourceAttr(resourceName, "key_attributes.0.key_modes_of_use.0.encrypt", "true"),
+					resource.TestCheckResourceAttr(resourceName, "key_attributes.0.key_modes_of_use.0.unwrap", "true"),
+					resource.TestCheckResourceAttr(resourceName, "key_attributes.0.key_modes_of_use.0.wrap", "true"),
+					resource.TestCheckResourceAttr(resourceName, "key_attributes.0.key_usage", "TR31_K0_KEY_ENCRYPTION_KEY"),
+					resource.TestCheckResourceAttrSet(resourceName, "key_check_value"),
+					resource.TestCheckResourceAttrSet(resourceName, "key_check_value_algorithm"),
+					resource.TestCheckResourceAttr(resourceName, "key_origin", "AWS_PAYMENT_CRYPTOGRAPHY"),
+					resource.TestCheckResourceAttr(resourceName, "key_state", "CREATE_COMPLETE"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_window_in_days"},
+			},
+		},
+	})
+}
+
+func TestAccPaymentCryptographyKey_disappears(t *testing.T) {
+	var v paymentcryptography.Key
+	resourceName := "aws_paymentcryptography_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, paymentcryptography.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfpaymentcryptography.ResourceKey(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccPaymentCryptographyKey_enabled(t *testing.T) {
+	var v paymentcryptography.Key
+	resourceName := "aws_paymentcryptography_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, paymentcryptography.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyConfig_enabled(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_window_in_days"},
+			},
+			{
+				Config: testAccKeyConfig_enabled(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPaymentCryptographyKey_tags(t *testing.T) {
+	var v paymentcryptography.Key
+	resourceName := "aws_paymentcryptography_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, paymentcryptography.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyConfig_tags1("key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_window_in_days"},
+			},
+			{
+				Config: testAccKeyConfig_tags2("key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccKeyConfig_tags1("key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPaymentCryptographyKey_keyAttributesValidation(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, paymentcryptography.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKeyConfig_keyAttributes("RSA_2048", "SYMMETRIC_KEY", "TR31_K0_KEY_ENCRYPTION_KEY", "wrap"),
+				ExpectError: regexp.MustCompile(`key_algorithm RSA_2048 cannot be used with key_class SYMMETRIC_KEY`),
+			},
+			{
+				Config:      testAccKeyConfig_keyAttributes("RSA_2048", "ASYMMETRIC_KEY_PAIR", "TR31_S0_ASYMMETRIC_KEY_FOR_DIGITAL_SIGNATURE", "encrypt"),
+				ExpectError: regexp.MustCompile(`key mode of use encrypt cannot be used with key_usage TR31_S0_ASYMMETRIC_KEY_FOR_DIGITAL_SIGNATURE`),
+			},
+			{
+				Config:      testAccKeyConfig_importKeyCryptogram("TDES_2KEY", "SYMMETRIC_KEY", "TR31_M1_ISO_9797_1_MAC_KEY", "decrypt"),
+				ExpectError: regexp.MustCompile(`key mode of use decrypt cannot be used with key_usage TR31_M1_ISO_9797_1_MAC_KEY`),
+			},
+		},
+	})
+}
+
+func testAccCheckKeyDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).PaymentCryptographyConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_paymentcryptography_key" {
+			continue
+		}
+
+		_, err := tfpaymentcryptography.FindKeyByARN(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Payment Cryptography Key %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckKeyExists(n string, v *paymentcryptography.Key) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Payment Cryptography Key ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PaymentCryptographyConn
+
+		output, err := tfpaymentcryptography.FindKeyByARN(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccPreCheck(t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).PaymentCryptographyConn
+
+	_, err := conn.ListKeysWithContext(context.Background(), &paymentcryptography.ListKeysInput{})
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccKeyConfig_basic() string {
+	return `
+resource "aws_paymentcryptography_key" "test" {
+  exportable = true
+
+  key_attributes {
+    key_algorithm = "TDES_3KEY"
+    key_class     = "SYMMETRIC_KEY"
+    key_usage     = "TR31_K0_KEY_ENCRYPTION_KEY"
+
+    key_modes_of_use {
+      decrypt = true
+      encrypt = true
+      unwrap  = true
+      wrap    = true
+    }
+  }
+}
+`
+}
+
+func testAccKeyConfig_enabled(enabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_paymentcryptography_key" "test" {
+  enabled    = %[1]t
+  exportable = true
+
+  key_attributes {
+    key_algorithm = "TDES_3KEY"
+    key_class     = "SYMMETRIC_KEY"
+    key_usage     = "TR31_K0_KEY_ENCRYPTION_KEY"
+
+    key_modes_of_use {
+      unwrap = true
+      wrap   = true
+    }
+  }
+}
+`, enabled)
+}
+
+func testAccKeyConfig_tags1(tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_paymentcryptography_key" "test" {
+  exportable = true
+
+  key_attributes {
+    key_algorithm = "TDES_3KEY"
+    key_class     = "SYMMETRIC_KEY"
+    key_usage     = "TR31_K0_KEY_ENCRYPTION_KEY"
+
+    key_modes_of_use {
+      unwrap = true
+      wrap   = true
+    }
+  }
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1)
+}
+
+func testAccKeyConfig_tags2(tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_paymentcryptography_key" "test" {
+  exportable = true
+
+  key_attributes {
+    key_algorithm = "TDES_3KEY"
+    key_class     = "SYMMETRIC_KEY"
+    key_usage     = "TR31_K0_KEY_ENCRYPTION_KEY"
+
+    key_modes_of_use {
+      unwrap = true
+      wrap   = true
+    }
+  }
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2)
+}
+
+func testAccKeyConfig_keyAttributes(keyAlgorithm, keyClass, keyUsage, keyModeOfUse string) string {
+	return fmt.Sprintf(`
+resource "aws_paymentcryptography_key" "test" {
+  exportable = true
+
+  key_attributes {
+    key_algorithm = %[1]q
+    key_class     = %[2]q
+    key_usage     = %[3]q
+
+    key_modes_of_use {
+      %[4]s = true
+    }
+  }
+}
+`, keyAlgorithm, keyClass, keyUsage, keyModeOfUse)
+}
+
+func testAccKeyConfig_importKeyCryptogram(keyAlgorithm, keyClass, keyUsage, keyModeOfUse string) string {
+	return fmt.Sprintf(`
+resource "aws_paymentcryptography_key" "test" {
+  import_key_material {
+    key_cryptogram {
+      exportable             = true
+      import_token           = "import-token"
+      wrapped_key_cryptogram = "0123456789ABCDEF"
+
+      key_attributes {
+        key_algorithm = %[1]q
+        key_class     = %[2]q
+        key_usage     = %[3]q
+
+        key_modes_of_use {
+          %[4]s = true
+        }
+      }
+    }
+  }
+}
+`, keyAlgorithm, keyClass, keyUsage, keyModeOfUse)
+}
@@ -0,0 +1,74 @@
+package paymentcryptography
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/paymentcryptography"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceParametersForExport() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceParametersForExportRead,
+
+		Schema: map[string]*schema.Schema{
+			"export_token": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"key_material_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(paymentcryptography.KeyMaterialType_Values(), false),
+			},
+			"parameters_valid_until_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"signing_key_algorithm": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(paymentcryptography.KeyAlgorithm_Values(), false),
+			},
+			"signing_key_certificate": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"signing_key_certificate_chain": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func dataSourceParametersForExportRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PaymentCryptographyConn
+
+	input := &paymentcryptography.GetParametersForExportInput{
+		KeyMaterialType:     aws.String(d.Get("key_material_type").(string)),
+		SigningKeyAlgorithm: aws.String(d.Get("signing_key_algorithm").(string)),
+	}
+
+	output, err := conn.GetParametersForExportWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("reading Payment Cryptography Parameters For Export: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set("export_token", output.ExportToken)
+	d.Set("parameters_valid_until_timestamp", aws.TimeValue(output.ParametersValidUntilTimestamp).Format(time.RFC3339))
+	d.Set("signing_key_algorithm", output.SigningKeyAlgorithm)
+	d.Set("signing_key_certificate", output.SigningKeyCertificate)
+	d.Set("signing_key_certificate_chain", output.SigningKeyCertificateChain)
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package paymentcryptography
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/paymentcryptography"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceParametersForImport() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceParametersForImportRead,
+
+		Schema: map[string]*schema.Schema{
+			"import_token": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"key_material_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(paymentcryptography.KeyMaterialType_Values(), false),
+			},
+			"parameters_valid_until_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"wrapping_key_algorithm": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(paymentcryptography.KeyAlgorithm_Values(), false),
+			},
+			"wrapping_key_certificate": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"wrapping_key_certificate_chain": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func dataSourceParametersForImportRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PaymentCryptographyConn
+
+	input := &paymentcryptography.GetParametersForImportInput{
+		KeyMaterialType:      aws.String(d.Get("key_material_type").(string)),
+		WrappingKeyAlgorithm: aws.String(d.Get("wrapping_key_algorithm").(string)),
+	}
+
+	output, err := conn.GetParametersForImportWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("reading Payment Cryptography Parameters For Import: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set("import_token", output.ImportToken)
+	d.Set("parameters_valid_until_timestamp", aws.TimeValue(output.ParametersValidUntilTimestamp).Format(time.RFC3339))
+	d.Set("wrapping_key_algorithm", output.WrappingKeyAlgorithm)
+	d.Set("wrapping_key_certificate", output.WrappingKeyCertificate)
+	d.Set("wrapping_key_certificate_chain", output.WrappingKeyCertificateChain)
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package paymentcryptography_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/paymentcryptography"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccPaymentCryptographyParametersForImportDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_paymentcryptography_parameters_for_import.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, paymentcryptography.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParametersForImportDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "import_token"),
+					resource.TestCheckResourceAttr(dataSourceName, "key_material_type", "TR34_KEY_BLOCK"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "parameters_valid_until_timestamp"),
+					resource.TestCheckResourceAttr(dataSourceName, "wrapping_key_algorithm", "RSA_2048"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "wrapping_key_certificate"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "wrapping_key_certificate_chain"),
+				),
+			},
+		},
+	})
+}
+
+const testAccParametersForImportDataSourceConfig_basic = `
+data "aws_paymentcryptography_parameters_for_import" "test" {
+  key_material_type      = "TR34_KEY_BLOCK"
+  wrapping_key_algorithm = "RSA_2048"
+}
+`
@@ -0,0 +1,105 @@
+package paymentcryptography
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/service/paymentcryptography"
+)
+
+var (
+	asymmetricKeyUsages = []string{
+		paymentcryptography.KeyUsageTr31D1AsymmetricKeyForDataEncryption,
+		paymentcryptography.KeyUsageTr31K2Tr34AsymmetricKey,
+		paymentcryptography.KeyUsageTr31K3AsymmetricKeyForKeyAgreement,
+		paymentcryptography.KeyUsageTr31S0AsymmetricKeyForDigitalSignature,
+	}
+
+	keyModesOfUse = []string{
+		"decrypt",
+		"derive_key",
+		"encrypt",
+		"generate",
+		"no_restrictions",
+		"sign",
+		"unwrap",
+		"verify",
+		"wrap",
+	}
+)
+
+// validKeyAttributes checks that the class, algorithm, usage and modes of use in a key_attributes
+// block are compatible with each other. Values that are not yet known are not checked.
+func validKeyAttributes(tfMap map[string]interface{}) error {
+	keyAlgorithm, _ := tfMap["key_algorithm"].(string)
+	keyClass, _ := tfMap["key_class"].(string)
+	keyUsage, _ := tfMap["key_usage"].(string)
+
+	if keyClass != "" {
+		symmetric := keyClass == paymentcryptography.KeyClassSymmetricKey
+
+		if keyAlgorithm != "" && symmetric == strings.HasPrefix(keyAlgorithm, "RSA_") {
+			return fmt.Errorf("key_algorithm %s cannot be used with key_class %s", keyAlgorithm, keyClass)
+		}
+
+		if keyUsage != "" && symmetric == isAsymmetricKeyUsage(keyUsage) {
+			return fmt.Errorf("key_usage %s cannot be used with key_class %s", keyUsage, keyClass)
+		}
+	}
+
+	v, ok := tfMap["key_modes_of_use"].([]interface{})
+
+	if !ok || len(v) == 0 || v[0] == nil {
+		return nil
+	}
+
+	modes := v[0].(map[string]interface{})
+	var enabled []string
+
+	for _, mode := range keyModesOfUse {
+		if v, ok := modes[mode].(bool); ok && v {
+			enabled = append(enabled, mode)
+		}
+	}
+
+	if len(enabled) == 0 {
+		return fmt.Errorf("at least one key mode of use must be enabled")
+	}
+
+	if len(enabled) > 1 && stringInSlice("no_restrictions", enabled) {
+		return fmt.Errorf("no_restrictions cannot be combined with other key modes of use")
+	}
+
+	var allowed []string
+
+	switch {
+	case keyUsage == paymentcryptography.KeyUsageTr31S0AsymmetricKeyForDigitalSignature:
+		allowed = []string{"no_restrictions", "sign", "verify"}
+	case strings.HasPrefix(keyUsage, "TR31_M"):
+		allowed = []string{"generate", "no_restrictions", "verify"}
+	default:
+		return nil
+	}
+
+	for _, mode := range enabled {
+		if !stringInSlice(mode, allowed) {
+			return fmt.Errorf("key mode of use %s cannot be used with key_usage %s", mode, keyUsage)
+		}
+	}
+
+	return nil
+}
+
+func isAsymmetricKeyUsage(keyUsage string) bool {
+	return stringInSlice(keyUsage, asymmetricKeyUsages)
+}
+
+func stringInSlice(s string, l []string) bool {
+	for _, v := range l {
+		if v == s {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,100 @@
+package paymentcryptography
+
+import (
+	"testing"
+)
+
+func TestValidKeyAttributes(t *testing.T) {
+	t.Parallel()
+
+	keyAttributes := func(keyAlgorithm, keyClass, keyUsage string, modes ...string) map[string]interface{} {
+		tfMap := map[string]interface{}{}
+
+		for _, mode := range keyModesOfUse {
+			tfMap[mode] = false
+		}
+
+		for _, mode := range modes {
+			tfMap[mode] = true
+		}
+
+		return map[string]interface{}{
+			"key_algorithm":    keyAlgorithm,
+			"key_class":        keyClass,
+			"key_modes_of_use": []interface{}{tfMap},
+			"key_usage":        keyUsage,
+		}
+	}
+
+	testCases := []struct {
+		name    string
+		tfMap   map[string]interface{}
+		wantErr bool
+	}{
+		{
+			name:  "symmetric data encryption key",
+			tfMap: keyAttributes("TDES_3KEY", "SYMMETRIC_KEY", "TR31_D0_SYMMETRIC_DATA_ENCRYPTION_KEY", "decrypt", "encrypt", "unwrap", "wrap"),
+		},
+		{
+			name:  "asymmetric signature key",
+			tfMap: keyAttributes("RSA_2048", "ASYMMETRIC_KEY_PAIR", "TR31_S0_ASYMMETRIC_KEY_FOR_DIGITAL_SIGNATURE", "sign", "verify"),
+		},
+		{
+			name:  "MAC key",
+			tfMap: keyAttributes("TDES_2KEY", "SYMMETRIC_KEY", "TR31_M1_ISO_9797_1_MAC_KEY", "generate", "verify"),
+		},
+		{
+			name:  "unknown values",
+			tfMap: map[string]interface{}{"key_class": "SYMMETRIC_KEY"},
+		},
+		{
+			name:    "RSA algorithm with symmetric class",
+			tfMap:   keyAttributes("RSA_2048", "SYMMETRIC_KEY", "TR31_D0_SYMMETRIC_DATA_ENCRYPTION_KEY", "encrypt"),
+			wantErr: true,
+		},
+		{
+			name:    "asymmetric usage with symmetric class",
+			tfMap:   keyAttributes("AES_128", "SYMMETRIC_KEY", "TR31_K3_ASYMMETRIC_KEY_FOR_KEY_AGREEMENT", "derive_key"),
+			wantErr: true,
+		},
+		{
+			name:    "symmetric usage with asymmetric class",
+			tfMap:   keyAttributes("RSA_2048", "ASYMMETRIC_KEY_PAIR", "TR31_K0_KEY_ENCRYPTION_KEY", "wrap"),
+			wantErr: true,
+		},
+		{
+			name:    "no modes of use",
+			tfMap:   keyAttributes("AES_128", "SYMMETRIC_KEY", "TR31_K0_KEY_ENCRYPTION_KEY"),
+			wantErr: true,
+		},
+		{
+			name:    "no restrictions with other modes",
+			tfMap:   keyAttributes("AES_128", "SYMMETRIC_KEY", "TR31_K0_KEY_ENCRYPTION_KEY", "no_restrictions", "wrap"),
+			wantErr: true,
+		},
+		{
+			name:    "encrypt with signature key",
+			tfMap:   keyAttributes("RSA_2048", "ASYMMETRIC_KEY_PAIR", "TR31_S0_ASYMMETRIC_KEY_FOR_DIGITAL_SIGNATURE", "encrypt"),
+			wantErr: true,
+		},
+		{
+			name:    "decrypt with MAC key",
+			tfMap:   keyAttributes("TDES_2KEY", "SYMMETRIC_KEY", "TR31_M3_ISO_9797_3_MAC_KEY", "decrypt"),
+			wantErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validKeyAttributes(testCase.tfMap)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Errorf("validKeyAttributes() error = %v, wantErr %t", err, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package paymentcryptography
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/paymentcryptography"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	keyCreatedTimeout = 5 * time.Minute
+	keyDeletedTimeout = 5 * time.Minute
+)
+
+func waitKeyCreated(ctx context.Context, conn *paymentcryptography.PaymentCryptography, arn string) (*paymentcryptography.Key, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{paymentcryptography.KeyStateCreateInProgress},
+		Target:  []string{paymentcryptography.KeyStateCreateComplete},
+		Refresh: statusKeyState(ctx, conn, arn),
+		Timeout: keyCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*paymentcryptography.Key); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitKeyDeleted(ctx context.Context, conn *paymentcryptography.PaymentCryptography, arn string) (*paymentcryptography.Key, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{paymentcryptography.KeyStateCreateComplete},
+		Target:  []string{},
+		Refresh: statusKeyState(ctx, conn, arn),
+		Timeout: keyDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*paymentcryptography.Key); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,224 @@
+package pcaconnectorad
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pcaconnectorad"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceConnector() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceConnectorCreate,
+		ReadWithoutTimeout:   resourceConnectorRead,
+		UpdateWithoutTimeout: resourceConnectorUpdate,
+		DeleteWithoutTimeout: resourceConnectorDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"certificate_authority_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"certificate_enrollment_policy_server_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"directory_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"vpc_information": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"security_group_ids": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							MaxItems: 4,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceConnectorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PCAConnectorADConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &pcaconnectorad.CreateConnectorInput{
+		CertificateAuthorityArn: aws.String(d.Get("certificate_authority_arn").(string)),
+		DirectoryId:             aws.String(d.Get("directory_id").(string)),
+		VpcInformation:          expandVPCInformation(d.Get("vpc_information").([]interface{})[0].(map[string]interface{})),
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateConnectorWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Private CA Connector for Active Directory Connector: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.ConnectorArn))
+
+	if _, err := waitConnectorCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for Private CA Connector for Active Directory Connector (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceConnectorRead(ctx, d, meta)
+}
+
+func resourceConnectorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PCAConnectorADConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	connector, err := FindConnectorByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Private CA Connector for Active Directory Connector (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Private CA Connector for Active Directory Connector (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", connector.Arn)
+	d.Set("certificate_authority_arn", connector.CertificateAuthorityArn)
+	d.Set("certificate_enrollment_policy_server_endpoint", connector.CertificateEnrollmentPolicyServerEndpoint)
+	d.Set("directory_id", connector.DirectoryId)
+	if connector.VpcInformation != nil {
+		if err := d.Set("vpc_information", []interface{}{flattenVPCInformation(connector.VpcInformation)}); err != nil {
+			return diag.Errorf("setting vpc_information: %s", err)
+		}
+	} else {
+		d.Set("vpc_information", nil)
+	}
+
+	tags, err := ListTagsWithContext(ctx, conn, d.Id())
+
+	if err != nil {
+		return diag.Errorf("listing tags for Private CA Connector for Active Directory Connector (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceConnectorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PCAConnectorADConn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Id(), o, n); err != nil {
+			return diag.Errorf("updating Private CA Connector for Active Directory Connector (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceConnectorRead(ctx, d, meta)
+}
+
+func resourceConnectorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PCAConnectorADConn
+
+	log.Printf("[DEBUG] Deleting Private CA Connector for Active Directory Connector: %s", d.Id())
+	_, err := conn.DeleteConnectorWithContext(ctx, &pcaconnectorad.DeleteConnectorInput{
+		ConnectorArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, pcaconnectorad.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Private CA Connector for Active Directory Connector (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitConnectorDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for Private CA Connector for Active Directory Connector (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandVPCInformation(tfMap map[string]interface{}) *pcaconnectorad.VpcInformation {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pcaconnectorad.VpcInformation{}
+
+	if v, ok := tfMap["security_group_ids"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SecurityGroupIds = flex.ExpandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func flattenVPCInformation(apiObject *pcaconnectorad.VpcInformation) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.SecurityGroupIds; v != nil {
+		tfMap["security_group_ids"] = aws.StringValueSlice(v)
+	}
+
+	return tfMap
+}
@@ -0,0 +1,259 @@
+package pcaconnectorad_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/pcaconnectorad"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfpcaconnectorad "github.com/hashicorp/terraform-provider-aws/internal/service/pcaconnectorad"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccPCAConnectorADConnector_basic(t *testing.T) {
+	resourceName := "aws_pcaconnectorad_connector.test"
+	certificateAuthorityResourceName := "aws_acmpca_certificate_authority.test"
+	directoryResourceName := "aws_directory_service_directory.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domain := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, pcaconnectorad.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConnectorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConnectorConfig_basic(rName, domain),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConnectorExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "pca-connector-ad", regexp.MustCompile(`connector/.+$`)),
+					resource.TestCheckResourceAttrPair(resourceName, "certificate_authority_arn", certificateAuthorityResourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "certificate_enrollment_policy_server_endpoint"),
+					resource.TestCheckResourceAttrPair(resourceName, "directory_id", directoryResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_information.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_information.0.security_group_ids.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccPCAConnectorADConnector_disappears(t *testing.T) {
+	resourceName := "aws_pcaconnectorad_connector.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domain := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, pcaconnectorad.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConnectorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConnectorConfig_basic(rName, domain),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConnectorExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfpcaconnectorad.ResourceConnector(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccPCAConnectorADConnector_tags(t *testing.T) {
+	resourceName := "aws_pcaconnectorad_connector.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domain := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, pcaconnectorad.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConnectorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConnectorConfig_tags1(rName, domain, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConnectorExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConnectorConfig_tags2(rName, domain, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConnectorExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccConnectorConfig_tags1(rName, domain, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConnectorExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckConnectorExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Private CA Connector for Active Directory Connector ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PCAConnectorADConn
+
+		_, err := tfpcaconnectorad.FindConnectorByARN(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckConnectorDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).PCAConnectorADConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_pcaconnectorad_connector" {
+			continue
+		}
+
+		_, err := tfpcaconnectorad.FindConnectorByARN(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Private CA Connector for Active Directory Connector %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccConnectorConfig_base(rName, domain string) string {
+	return acctest.ConfigCompose(testAccDirectoryRegistrationConfig_basic(rName, domain), fmt.Sprintf(`
+resource "aws_security_group" "test" {
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_acmpca_certificate_authority" "test" {
+  permanent_deletion_time_in_days = 7
+  type                            = "ROOT"
+
+  certificate_authority_configuration {
+    key_algorithm     = "RSA_4096"
+    signing_algorithm = "SHA512WITHRSA"
+
+    subject {
+      common_name = %[2]q
+    }
+  }
+}
+
+resource "aws_acmpca_certificate" "test" {
+  certificate_authority_arn   = aws_acmpca_certificate_authority.test.arn
+  certificate_signing_request = aws_acmpca_certificate_authority.test.certificate_signing_request
+  signing_algorithm           = "SHA512WITHRSA"
+
+  template_arn = "arn:${data.aws_partition.current.partition}:acm-pca:::template/RootCACertificate/V1"
+
+  validity {
+    type  = "YEARS"
+    value = 1
+  }
+}
+
+resource "aws_acmpca_certificate_authority_certificate" "test" {
+  certificate_authority_arn = aws_acmpca_certificate_authority.test.arn
+
+  certificate       = aws_acmpca_certificate.test.certificate
+  certificate_chain = aws_acmpca_certificate.test.certificate_chain
+}
+
+data "aws_partition" "current" {}
+`, rName, domain))
+}
+
+func testAccConnectorConfig_basic(rName, domain string) string {
+	return acctest.ConfigCompose(testAccConnectorConfig_base(rName, domain), `
+resource "aws_pcaconnectorad_connector" "test" {
+  certificate_authority_arn = aws_acmpca_certificate_authority_certificate.test.certificate_authority_arn
+  directory_id              = aws_pcaconnectorad_directory_registration.test.directory_id
+
+  vpc_information {
+    security_group_ids = [aws_security_group.test.id]
+  }
+}
+`)
+}
+
+func testAccConnectorConfig_tags1(rName, domain, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccConnectorConfig_base(rName, domain), fmt.Sprintf(`
+resource "aws_pcaconnectorad_connector" "test" {
+  certificate_authority_arn = aws_acmpca_certificate_authority_certificate.test.certificate_authority_arn
+  directory_id              = aws_pcaconnectorad_directory_registration.test.directory_id
+
+  vpc_information {
+    security_group_ids = [aws_security_group.test.id]
+  }
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1))
+}
+
+func testAccConnectorConfig_tags2(rName, domain, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccConnectorConfig_base(rName, domain), fmt.Sprintf(`
+resource "aws_pcaconnectorad_connector" "test" {
+  certificate_authority_arn = aws_acmpca_certificate_authority_certificate.test.certificate_authority_arn
+  directory_id              = aws_pcaconnectorad_directory_registration.test.directory_id
+
+  vpc_information {
+    security_group_ids = [aws_security_group.test.id]
+  }
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
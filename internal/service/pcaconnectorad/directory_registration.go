@@ -0,0 +1,157 @@
+package pcaconnectorad
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pcaconnectorad"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceDirectoryRegistration() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDirectoryRegistrationCreate,
+		ReadWithoutTimeout:   resourceDirectoryRegistrationRead,
+		UpdateWithoutTimeout: resourceDirectoryRegistrationUpdate,
+		DeleteWithoutTimeout: resourceDirectoryRegistrationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(15 * time.Minute),
+			Delete: schema.DefaultTimeout(15 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"directory_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceDirectoryRegistrationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PCAConnectorADConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	directoryID := d.Get("directory_id").(string)
+	input := &pcaconnectorad.CreateDirectoryRegistrationInput{
+		DirectoryId: aws.String(directoryID),
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateDirectoryRegistrationWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Private CA Connector for Active Directory Directory Registration (%s): %s", directoryID, err)
+	}
+
+	d.SetId(aws.StringValue(output.DirectoryRegistrationArn))
+
+	if _, err := waitDirectoryRegistrationCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for Private CA Connector for Active Directory Directory Registration (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceDirectoryRegistrationRead(ctx, d, meta)
+}
+
+func resourceDirectoryRegistrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PCAConnectorADConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	directoryRegistration, err := FindDirectoryRegistrationByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Private CA Connector for Active Directory Directory Registration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Private CA Connector for Active Directory Directory Registration (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", directoryRegistration.Arn)
+	d.Set("directory_id", directoryRegistration.DirectoryId)
+
+	tags, err := ListTagsWithContext(ctx, conn, d.Id())
+
+	if err != nil {
+		return diag.Errorf("listing tags for Private CA Connector for Active Directory Directory Registration (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceDirectoryRegistrationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PCAConnectorADConn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Id(), o, n); err != nil {
+			return diag.Errorf("updating Private CA Connector for Active Directory Directory Registration (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceDirectoryRegistrationRead(ctx, d, meta)
+}
+
+func resourceDirectoryRegistrationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PCAConnectorADConn
+
+	log.Printf("[DEBUG] Deleting Private CA Connector for Active Directory Directory Registration: %s", d.Id())
+	_, err := conn.DeleteDirectoryRegistrationWithContext(ctx, &pcaconnectorad.DeleteDirectoryRegistrationInput{
+		DirectoryRegistrationArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, pcaconnectorad.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Private CA Connector for Active Directory Directory Registration (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitDirectoryRegistrationDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for Private CA Connector for Active Directory Directory Registration (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,141 @@
+package pcaconnectorad_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/pcaconnectorad"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfpcaconnectorad "github.com/hashicorp/terraform-provider-aws/internal/service/pcaconnectorad"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccPCAConnectorADDirectoryRegistration_basic(t *testing.T) {
+	resourceName := "aws_pcaconnectorad_directory_registration.test"
+	directoryResourceName := "aws_directory_service_directory.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domain := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, pcaconnectorad.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectoryRegistrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectoryRegistrationConfig_basic(rName, domain),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDirectoryRegistrationExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "pca-connector-ad", regexp.MustCompile(`directory-registration/.+$`)),
+					resource.TestCheckResourceAttrPair(resourceName, "directory_id", directoryResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccPCAConnectorADDirectoryRegistration_disappears(t *testing.T) {
+	resourceName := "aws_pcaconnectorad_directory_registration.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domain := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, pcaconnectorad.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectoryRegistrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectoryRegistrationConfig_basic(rName, domain),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDirectoryRegistrationExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfpcaconnectorad.ResourceDirectoryRegistration(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDirectoryRegistrationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Private CA Connector for Active Directory Directory Registration ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PCAConnectorADConn
+
+		_, err := tfpcaconnectorad.FindDirectoryRegistrationByARN(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckDirectoryRegistrationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).PCAConnectorADConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_pcaconnectorad_directory_registration" {
+			continue
+		}
+
+		_, err := tfpcaconnectorad.FindDirectoryRegistrationByARN(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Private CA Connector for Active Directory Directory Registration %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccDirectoryRegistrationConfig_base(rName, domain string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 2), fmt.Sprintf(`
+resource "aws_directory_service_directory" "test" {
+  name     = %[2]q
+  password = "SuperSecretPassw0rd"
+  type     = "MicrosoftAD"
+  edition  = "Standard"
+
+  vpc_settings {
+    vpc_id     = aws_vpc.test.id
+    subnet_ids = aws_subnet.test[*].id
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, domain))
+}
+
+func testAccDirectoryRegistrationConfig_basic(rName, domain string) string {
+	return acctest.ConfigCompose(testAccDirectoryRegistrationConfig_base(rName, domain), `
+resource "aws_pcaconnectorad_directory_registration" "test" {
+  directory_id = aws_directory_service_directory.test.id
+}
+`)
+}
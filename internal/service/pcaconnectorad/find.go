@@ -0,0 +1,112 @@
+package pcaconnectorad
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pcaconnectorad"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindConnectorByARN(ctx context.Context, conn *pcaconnectorad.PcaConnectorAd, arn string) (*pcaconnectorad.Connector, error) {
+	input := &pcaconnectorad.GetConnectorInput{
+		ConnectorArn: aws.String(arn),
+	}
+
+	output, err := conn.GetConnectorWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, pcaconnectorad.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Connector == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Connector, nil
+}
+
+func FindDirectoryRegistrationByARN(ctx context.Context, conn *pcaconnectorad.PcaConnectorAd, arn string) (*pcaconnectorad.DirectoryRegistration, error) {
+	input := &pcaconnectorad.GetDirectoryRegistrationInput{
+		DirectoryRegistrationArn: aws.String(arn),
+	}
+
+	output, err := conn.GetDirectoryRegistrationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, pcaconnectorad.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.DirectoryRegistration == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.DirectoryRegistration, nil
+}
+
+func FindServicePrincipalNameByTwoPartKey(ctx context.Context, conn *pcaconnectorad.PcaConnectorAd, directoryRegistrationARN, connectorARN string) (*pcaconnectorad.ServicePrincipalName, error) {
+	input := &pcaconnectorad.GetServicePrincipalNameInput{
+		ConnectorArn:             aws.String(connectorARN),
+		DirectoryRegistrationArn: aws.String(directoryRegistrationARN),
+	}
+
+	output, err := conn.GetServicePrincipalNameWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, pcaconnectorad.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ServicePrincipalName == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ServicePrincipalName, nil
+}
+
+func FindTemplateByARN(ctx context.Context, conn *pcaconnectorad.PcaConnectorAd, arn string) (*pcaconnectorad.Template, error) {
+	input := &pcaconnectorad.GetTemplateInput{
+		TemplateArn: aws.String(arn),
+	}
+
+	output, err := conn.GetTemplateWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, pcaconnectorad.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Template == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Template, nil
+}
@@ -0,0 +1,23 @@
+package pcaconnectorad_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/pcaconnectorad"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func testAccPreCheck(t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).PCAConnectorADConn
+
+	_, err := conn.ListConnectors(&pcaconnectorad.ListConnectorsInput{})
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
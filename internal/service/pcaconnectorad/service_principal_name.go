@@ -0,0 +1,152 @@
+package pcaconnectorad
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pcaconnectorad"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceServicePrincipalName() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceServicePrincipalNameCreate,
+		ReadWithoutTimeout:   resourceServicePrincipalNameRead,
+		DeleteWithoutTimeout: resourceServicePrincipalNameDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(15 * time.Minute),
+			Delete: schema.DefaultTimeout(15 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"connector_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"directory_registration_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func resourceServicePrincipalNameCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PCAConnectorADConn
+
+	directoryRegistrationARN := d.Get("directory_registration_arn").(string)
+	connectorARN := d.Get("connector_arn").(string)
+	id := ServicePrincipalNameCreateResourceID(directoryRegistrationARN, connectorARN)
+	input := &pcaconnectorad.CreateServicePrincipalNameInput{
+		ConnectorArn:             aws.String(connectorARN),
+		DirectoryRegistrationArn: aws.String(directoryRegistrationARN),
+	}
+
+	_, err := conn.CreateServicePrincipalNameWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Private CA Connector for Active Directory Service Principal Name (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waitServicePrincipalNameCreated(ctx, conn, directoryRegistrationARN, connectorARN, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for Private CA Connector for Active Directory Service Principal Name (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceServicePrincipalNameRead(ctx, d, meta)
+}
+
+func resourceServicePrincipalNameRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PCAConnectorADConn
+
+	directoryRegistrationARN, connectorARN, err := ServicePrincipalNameParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	servicePrincipalName, err := FindServicePrincipalNameByTwoPartKey(ctx, conn, directoryRegistrationARN, connectorARN)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Private CA Connector for Active Directory Service Principal Name (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Private CA Connector for Active Directory Service Principal Name (%s): %s", d.Id(), err)
+	}
+
+	d.Set("connector_arn", servicePrincipalName.ConnectorArn)
+	d.Set("directory_registration_arn", servicePrincipalName.DirectoryRegistrationArn)
+
+	return nil
+}
+
+func resourceServicePrincipalNameDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PCAConnectorADConn
+
+	directoryRegistrationARN, connectorARN, err := ServicePrincipalNameParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting Private CA Connector for Active Directory Service Principal Name: %s", d.Id())
+	_, err = conn.DeleteServicePrincipalNameWithContext(ctx, &pcaconnectorad.DeleteServicePrincipalNameInput{
+		ConnectorArn:             aws.String(connectorARN),
+		DirectoryRegistrationArn: aws.String(directoryRegistrationARN),
+	})
+
+	if tfawserr.ErrCodeEquals(err, pcaconnectorad.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Private CA Connector for Active Directory Service Principal Name (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitServicePrincipalNameDeleted(ctx, conn, directoryRegistrationARN, connectorARN, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for Private CA Connector for Active Directory Service Principal Name (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+const servicePrincipalNameResourceIDSeparator = ","
+
+func ServicePrincipalNameCreateResourceID(directoryRegistrationARN, connectorARN string) string {
+	parts := []string{directoryRegistrationARN, connectorARN}
+	id := strings.Join(parts, servicePrincipalNameResourceIDSeparator)
+
+	return id
+}
+
+func ServicePrincipalNameParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, servicePrincipalNameResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected DIRECTORY-REGISTRATION-ARN%[2]sCONNECTOR-ARN", id, servicePrincipalNameResourceIDSeparator)
+}
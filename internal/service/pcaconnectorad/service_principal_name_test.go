@@ -0,0 +1,110 @@
+package pcaconnectorad_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/pcaconnectorad"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfpcaconnectorad "github.com/hashicorp/terraform-provider-aws/internal/service/pcaconnectorad"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccPCAConnectorADServicePrincipalName_basic(t *testing.T) {
+	resourceName := "aws_pcaconnectorad_service_principal_name.test"
+	connectorResourceName := "aws_pcaconnectorad_connector.test"
+	directoryRegistrationResourceName := "aws_pcaconnectorad_directory_registration.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domain := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, pcaconnectorad.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServicePrincipalNameDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServicePrincipalNameConfig_basic(rName, domain),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServicePrincipalNameExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "connector_arn", connectorResourceName, "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "directory_registration_arn", directoryRegistrationResourceName, "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckServicePrincipalNameExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Private CA Connector for Active Directory Service Principal Name ID is set")
+		}
+
+		directoryRegistrationARN, connectorARN, err := tfpcaconnectorad.ServicePrincipalNameParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PCAConnectorADConn
+
+		_, err = tfpcaconnectorad.FindServicePrincipalNameByTwoPartKey(context.Background(), conn, directoryRegistrationARN, connectorARN)
+
+		return err
+	}
+}
+
+func testAccCheckServicePrincipalNameDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).PCAConnectorADConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_pcaconnectorad_service_principal_name" {
+			continue
+		}
+
+		directoryRegistrationARN, connectorARN, err := tfpcaconnectorad.ServicePrincipalNameParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfpcaconnectorad.FindServicePrincipalNameByTwoPartKey(context.Background(), conn, directoryRegistrationARN, connectorARN)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Private CA Connector for Active Directory Service Principal Name %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccServicePrincipalNameConfig_basic(rName, domain string) string {
+	return acctest.ConfigCompose(testAccConnectorConfig_basic(rName, domain), `
+resource "aws_pcaconnectorad_service_principal_name" "test" {
+  connector_arn              = aws_pcaconnectorad_connector.test.arn
+  directory_registration_arn = aws_pcaconnectorad_directory_registration.test.arn
+}
+`)
+}
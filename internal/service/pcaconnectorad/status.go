@@ -0,0 +1,58 @@
+package pcaconnectorad
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pcaconnectorad"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusConnector(ctx context.Context, conn *pcaconnectorad.PcaConnectorAd, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindConnectorByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func statusDirectoryRegistration(ctx context.Context, conn *pcaconnectorad.PcaConnectorAd, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindDirectoryRegistrationByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func statusServicePrincipalName(ctx context.Context, conn *pcaconnectorad.PcaConnectorAd, directoryRegistrationARN, connectorARN string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindServicePrincipalNameByTwoPartKey(ctx, conn, directoryRegistrationARN, connectorARN)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
@@ -0,0 +1,254 @@
+//go:build sweep
+// +build sweep
+
+package pcaconnectorad
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pcaconnectorad"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_pcaconnectorad_connector", &resource.Sweeper{
+		Name: "aws_pcaconnectorad_connector",
+		F:    sweepConnectors,
+		Dependencies: []string{
+			"aws_pcaconnectorad_service_principal_name",
+			"aws_pcaconnectorad_template",
+		},
+	})
+
+	resource.AddTestSweepers("aws_pcaconnectorad_directory_registration", &resource.Sweeper{
+		Name: "aws_pcaconnectorad_directory_registration",
+		F:    sweepDirectoryRegistrations,
+		Dependencies: []string{
+			"aws_pcaconnectorad_connector",
+			"aws_pcaconnectorad_service_principal_name",
+		},
+	})
+
+	resource.AddTestSweepers("aws_pcaconnectorad_service_principal_name", &resource.Sweeper{
+		Name: "aws_pcaconnectorad_service_principal_name",
+		F:    sweepServicePrincipalNames,
+	})
+
+	resource.AddTestSweepers("aws_pcaconnectorad_template", &resource.Sweeper{
+		Name: "aws_pcaconnectorad_template",
+		F:    sweepTemplates,
+	})
+}
+
+func sweepConnectors(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).PCAConnectorADConn
+	input := &pcaconnectorad.ListConnectorsInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListConnectorsPages(input, func(page *pcaconnectorad.ListConnectorsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Connectors {
+			r := ResourceConnector()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Arn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Private CA Connector for Active Directory Connector sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Private CA Connector for Active Directory Connectors (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Private CA Connector for Active Directory Connectors (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepDirectoryRegistrations(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).PCAConnectorADConn
+	input := &pcaconnectorad.ListDirectoryRegistrationsInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListDirectoryRegistrationsPages(input, func(page *pcaconnectorad.ListDirectoryRegistrationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.DirectoryRegistrations {
+			r := ResourceDirectoryRegistration()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Arn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Private CA Connector for Active Directory Directory Registration sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Private CA Connector for Active Directory Directory Registrations (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Private CA Connector for Active Directory Directory Registrations (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepServicePrincipalNames(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.(*conns.AWSClient).PCAConnectorADConn
+	input := &pcaconnectorad.ListDirectoryRegistrationsInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
+
+	err = conn.ListDirectoryRegistrationsPages(input, func(page *pcaconnectorad.ListDirectoryRegistrationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.DirectoryRegistrations {
+			directoryRegistrationARN := aws.StringValue(v.Arn)
+			spnInput := &pcaconnectorad.ListServicePrincipalNamesInput{
+				DirectoryRegistrationArn: aws.String(directoryRegistrationARN),
+			}
+
+			err := conn.ListServicePrincipalNamesPages(spnInput, func(page *pcaconnectorad.ListServicePrincipalNamesOutput, lastPage bool) bool {
+				if page == nil {
+					return !lastPage
+				}
+
+				for _, v := range page.ServicePrincipalNames {
+					r := ResourceServicePrincipalName()
+					d := r.Data(nil)
+					d.SetId(ServicePrincipalNameCreateResourceID(aws.StringValue(v.DirectoryRegistrationArn), aws.StringValue(v.ConnectorArn)))
+
+					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+				}
+
+				return !lastPage
+			})
+
+			if err != nil {
+				errs = multierror.Append(errs, fmt.Errorf("error listing Private CA Connector for Active Directory Service Principal Names for Directory Registration (%s): %w", directoryRegistrationARN, err))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing Private CA Connector for Active Directory Directory Registrations (%s): %w", region, err))
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Private CA Connector for Active Directory Service Principal Names (%s): %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Private CA Connector for Active Directory Service Principal Name sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepTemplates(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.(*conns.AWSClient).PCAConnectorADConn
+	input := &pcaconnectorad.ListConnectorsInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
+
+	err = conn.ListConnectorsPages(input, func(page *pcaconnectorad.ListConnectorsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Connectors {
+			connectorARN := aws.StringValue(v.Arn)
+			templateInput := &pcaconnectorad.ListTemplatesInput{
+				ConnectorArn: aws.String(connectorARN),
+			}
+
+			err := conn.ListTemplatesPages(templateInput, func(page *pcaconnectorad.ListTemplatesOutput, lastPage bool) bool {
+				if page == nil {
+					return !lastPage
+				}
+
+				for _, v := range page.Templates {
+					r := ResourceTemplate()
+					d := r.Data(nil)
+					d.SetId(aws.StringValue(v.Arn))
+
+					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+				}
+
+				return !lastPage
+			})
+
+			if err != nil {
+				errs = multierror.Append(errs, fmt.Errorf("error listing Private CA Connector for Active Directory Templates for Connector (%s): %w", connectorARN, err))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing Private CA Connector for Active Directory Connectors (%s): %w", region, err))
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Private CA Connector for Active Directory Templates (%s): %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Private CA Connector for Active Directory Template sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
@@ -0,0 +1,318 @@
+package pcaconnectorad
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
+	"github.com/aws/aws-sdk-go/service/pcaconnectorad"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceTemplate() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTemplateCreate,
+		ReadWithoutTimeout:   resourceTemplateRead,
+		UpdateWithoutTimeout: resourceTemplateUpdate,
+		DeleteWithoutTimeout: resourceTemplateDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"connector_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"definition": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validTemplateDefinitionJSON,
+				DiffSuppressFunc: suppressEquivalentTemplateDefinitionJSONDiffs,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"object_identifier": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"policy_schema": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"reenroll_all_certificate_holders": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"revision": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"major_revision": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"minor_revision": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PCAConnectorADConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	definition, err := expandTemplateDefinitionJSON(d.Get("definition").(string))
+
+	if err != nil {
+		return diag.Errorf("expanding definition: %s", err)
+	}
+
+	name := d.Get("name").(string)
+	input := &pcaconnectorad.CreateTemplateInput{
+		ConnectorArn: aws.String(d.Get("connector_arn").(string)),
+		Definition:   definition,
+		Name:         aws.String(name),
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateTemplateWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Private CA Connector for Active Directory Template (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.TemplateArn))
+
+	return resourceTemplateRead(ctx, d, meta)
+}
+
+func resourceTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PCAConnectorADConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	template, err := FindTemplateByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Private CA Connector for Active Directory Template (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Private CA Connector for Active Directory Template (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", template.Arn)
+	d.Set("connector_arn", template.ConnectorArn)
+	definition, err := flattenTemplateDefinitionJSON(template.Definition)
+	if err != nil {
+		return diag.Errorf("flattening definition: %s", err)
+	}
+	d.Set("definition", definition)
+	d.Set("name", template.Name)
+	d.Set("object_identifier", template.ObjectIdentifier)
+	d.Set("policy_schema", template.PolicySchema)
+	if template.Revision != nil {
+		if err := d.Set("revision", []interface{}{flattenTemplateRevision(template.Revision)}); err != nil {
+			return diag.Errorf("setting revision: %s", err)
+		}
+	} else {
+		d.Set("revision", nil)
+	}
+
+	tags, err := ListTagsWithContext(ctx, conn, d.Id())
+
+	if err != nil {
+		return diag.Errorf("listing tags for Private CA Connector for Active Directory Template (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PCAConnectorADConn
+
+	if d.HasChange("definition") {
+		definition, err := expandTemplateDefinitionJSON(d.Get("definition").(string))
+
+		if err != nil {
+			return diag.Errorf("expanding definition: %s", err)
+		}
+
+		input := &pcaconnectorad.UpdateTemplateInput{
+			Definition:                    definition,
+			ReenrollAllCertificateHolders: aws.Bool(d.Get("reenroll_all_certificate_holders").(bool)),
+			TemplateArn:                   aws.String(d.Id()),
+		}
+
+		_, err = conn.UpdateTemplateWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating Private CA Connector for Active Directory Template (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Id(), o, n); err != nil {
+			return diag.Errorf("updating Private CA Connector for Active Directory Template (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceTemplateRead(ctx, d, meta)
+}
+
+func resourceTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PCAConnectorADConn
+
+	log.Printf("[DEBUG] Deleting Private CA Connector for Active Directory Template: %s", d.Id())
+	_, err := conn.DeleteTemplateWithContext(ctx, &pcaconnectorad.DeleteTemplateInput{
+		TemplateArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, pcaconnectorad.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Private CA Connector for Active Directory Template (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// validTemplateDefinitionJSON checks that definition is a JSON template definition.
+func validTemplateDefinitionJSON(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := expandTemplateDefinitionJSON(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a JSON Private CA Connector for Active Directory template definition: %w", k, err))
+	}
+
+	return
+}
+
+// suppressEquivalentTemplateDefinitionJSONDiffs compares two definition values after
+// round-tripping them through the API shapes, so formatting and key order do not cause diffs.
+func suppressEquivalentTemplateDefinitionJSONDiffs(k, old, new string, d *schema.ResourceData) bool {
+	o, err := expandTemplateDefinitionJSON(old)
+
+	if err != nil {
+		return false
+	}
+
+	n, err := expandTemplateDefinitionJSON(new)
+
+	if err != nil {
+		return false
+	}
+
+	ob, err := flattenTemplateDefinitionJSON(o)
+
+	if err != nil {
+		return false
+	}
+
+	nb, err := flattenTemplateDefinitionJSON(n)
+
+	if err != nil {
+		return false
+	}
+
+	return verify.JSONBytesEqual([]byte(ob), []byte(nb))
+}
+
+// expandTemplateDefinitionJSON decodes a template definition in the format
+// returned by the GetTemplate API, e.g. {"TemplateV2": {...}}.
+func expandTemplateDefinitionJSON(rawDefinition string) (*pcaconnectorad.TemplateDefinition, error) {
+	var definition pcaconnectorad.TemplateDefinition
+
+	if err := json.Unmarshal([]byte(rawDefinition), &definition); err != nil {
+		return nil, err
+	}
+
+	if err := definition.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &definition, nil
+}
+
+func flattenTemplateDefinitionJSON(apiObject *pcaconnectorad.TemplateDefinition) (string, error) {
+	if apiObject == nil {
+		return "", nil
+	}
+
+	b, err := jsonutil.BuildJSON(apiObject)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+func flattenTemplateRevision(apiObject *pcaconnectorad.TemplateRevision) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.MajorRevision; v != nil {
+		tfMap["major_revision"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.MinorRevision; v != nil {
+		tfMap["minor_revision"] = aws.Int64Value(v)
+	}
+
+	return tfMap
+}
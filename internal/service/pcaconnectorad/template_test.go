@@ -0,0 +1,175 @@
+package pcaconnectorad_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/pcaconnectorad"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfpcaconnectorad "github.com/hashicorp/terraform-provider-aws/internal/service/pcaconnectorad"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccPCAConnectorADTemplate_basic(t *testing.T) {
+	resourceName := "aws_pcaconnectorad_template.test"
+	connectorResourceName := "aws_pcaconnectorad_connector.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domain := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, pcaconnectorad.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTemplateConfig_basic(rName, domain, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTemplateExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "pca-connector-ad", regexp.MustCompile(`connector/.+/template/.+$`)),
+					resource.TestCheckResourceAttrPair(resourceName, "connector_arn", connectorResourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "definition"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "object_identifier"),
+					resource.TestCheckResourceAttrSet(resourceName, "policy_schema"),
+					resource.TestCheckResourceAttr(resourceName, "revision.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"reenroll_all_certificate_holders"},
+			},
+			{
+				Config: testAccTemplateConfig_basic(rName, domain, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTemplateExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "revision.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPCAConnectorADTemplate_disappears(t *testing.T) {
+	resourceName := "aws_pcaconnectorad_template.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domain := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, pcaconnectorad.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTemplateConfig_basic(rName, domain, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTemplateExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfpcaconnectorad.ResourceTemplate(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckTemplateExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Private CA Connector for Active Directory Template ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PCAConnectorADConn
+
+		_, err := tfpcaconnectorad.FindTemplateByARN(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckTemplateDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).PCAConnectorADConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_pcaconnectorad_template" {
+			continue
+		}
+
+		_, err := tfpcaconnectorad.FindTemplateByARN(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Private CA Connector for Active Directory Template %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccTemplateConfig_basic(rName, domain string, renewalWeeks int) string {
+	return acctest.ConfigCompose(testAccConnectorConfig_basic(rName, domain), fmt.Sprintf(`
+resource "aws_pcaconnectorad_template" "test" {
+  connector_arn = aws_pcaconnectorad_connector.test.arn
+  name          = %[1]q
+
+  definition = jsonencode({
+    TemplateV2 = {
+      CertificateValidity = {
+        RenewalPeriod = {
+          Period     = %[2]d
+          PeriodType = "WEEKS"
+        }
+        ValidityPeriod = {
+          Period     = 1
+          PeriodType = "YEARS"
+        }
+      }
+      EnrollmentFlags = {
+        EnableKeyReuseOnNtTokenKeysetStorageFull = false
+      }
+      Extensions = {
+        KeyUsage = {
+          UsageFlags = {
+            DigitalSignature = true
+            KeyEncipherment  = true
+          }
+        }
+      }
+      GeneralFlags = {
+        AutoEnrollment = false
+        MachineType    = true
+      }
+      PrivateKeyAttributes = {
+        KeySpec          = "KEY_EXCHANGE"
+        MinimalKeyLength = 2048
+      }
+      PrivateKeyFlags = {
+        ClientVersion = "WINDOWS_SERVER_2003"
+      }
+      SubjectNameFlags = {
+        RequireDnsAsCn = true
+      }
+    }
+  })
+}
+`, rName, renewalWeeks))
+}
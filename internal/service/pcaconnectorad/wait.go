@@ -0,0 +1,126 @@
+package pcaconnectorad
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pcaconnectorad"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func waitConnectorCreated(ctx context.Context, conn *pcaconnectorad.PcaConnectorAd, arn string, timeout time.Duration) (*pcaconnectorad.Connector, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{pcaconnectorad.ConnectorStatusCreating},
+		Target:  []string{pcaconnectorad.ConnectorStatusActive},
+		Refresh: statusConnector(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*pcaconnectorad.Connector); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitConnectorDeleted(ctx context.Context, conn *pcaconnectorad.PcaConnectorAd, arn string, timeout time.Duration) (*pcaconnectorad.Connector, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{pcaconnectorad.ConnectorStatusDeleting},
+		Target:  []string{},
+		Refresh: statusConnector(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*pcaconnectorad.Connector); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDirectoryRegistrationCreated(ctx context.Context, conn *pcaconnectorad.PcaConnectorAd, arn string, timeout time.Duration) (*pcaconnectorad.DirectoryRegistration, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{pcaconnectorad.DirectoryRegistrationStatusCreating},
+		Target:  []string{pcaconnectorad.DirectoryRegistrationStatusActive},
+		Refresh: statusDirectoryRegistration(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*pcaconnectorad.DirectoryRegistration); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDirectoryRegistrationDeleted(ctx context.Context, conn *pcaconnectorad.PcaConnectorAd, arn string, timeout time.Duration) (*pcaconnectorad.DirectoryRegistration, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{pcaconnectorad.DirectoryRegistrationStatusDeleting},
+		Target:  []string{},
+		Refresh: statusDirectoryRegistration(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*pcaconnectorad.DirectoryRegistration); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitServicePrincipalNameCreated(ctx context.Context, conn *pcaconnectorad.PcaConnectorAd, directoryRegistrationARN, connectorARN string, timeout time.Duration) (*pcaconnectorad.ServicePrincipalName, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{pcaconnectorad.ServicePrincipalNameStatusCreating},
+		Target:  []string{pcaconnectorad.ServicePrincipalNameStatusActive},
+		Refresh: statusServicePrincipalName(ctx, conn, directoryRegistrationARN, connectorARN),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*pcaconnectorad.ServicePrincipalName); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitServicePrincipalNameDeleted(ctx context.Context, conn *pcaconnectorad.PcaConnectorAd, directoryRegistrationARN, connectorARN string, timeout time.Duration) (*pcaconnectorad.ServicePrincipalName, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{pcaconnectorad.ServicePrincipalNameStatusDeleting},
+		Target:  []string{},
+		Refresh: statusServicePrincipalName(ctx, conn, directoryRegistrationARN, connectorARN),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*pcaconnectorad.ServicePrincipalName); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
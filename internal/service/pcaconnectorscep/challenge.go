@@ -0,0 +1,156 @@
+package pcaconnectorscep
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pcaconnectorscep"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceChallenge() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceChallengeCreate,
+		ReadWithoutTimeout:   resourceChallengeRead,
+		UpdateWithoutTimeout: resourceChallengeUpdate,
+		DeleteWithoutTimeout: resourceChallengeDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"connector_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"password": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceChallengeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PCAConnectorSCEPConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &pcaconnectorscep.CreateChallengeInput{
+		ConnectorArn: aws.String(d.Get("connector_arn").(string)),
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateChallengeWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Private CA Connector for SCEP Challenge: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.Challenge.Arn))
+
+	return resourceChallengeRead(ctx, d, meta)
+}
+
+func resourceChallengeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PCAConnectorSCEPConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	challenge, err := FindChallengeMetadataByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Private CA Connector for SCEP Challenge (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Private CA Connector for SCEP Challenge (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", challenge.Arn)
+	d.Set("connector_arn", challenge.ConnectorArn)
+
+	password, err := FindChallengePasswordByARN(ctx, conn, d.Id())
+
+	if err != nil {
+		return diag.Errorf("reading Private CA Connector for SCEP Challenge (%s) password: %s", d.Id(), err)
+	}
+
+	d.Set("password", password)
+
+	tags, err := ListTagsWithContext(ctx, conn, d.Id())
+
+	if err != nil {
+		return diag.Errorf("listing tags for Private CA Connector for SCEP Challenge (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceChallengeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PCAConnectorSCEPConn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Id(), o, n); err != nil {
+			return diag.Errorf("updating Private CA Connector for SCEP Challenge (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceChallengeRead(ctx, d, meta)
+}
+
+func resourceChallengeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PCAConnectorSCEPConn
+
+	log.Printf("[DEBUG] Deleting Private CA Connector for SCEP Challenge: %s", d.Id())
+	_, err := conn.DeleteChallengeWithContext(ctx, &pcaconnectorscep.DeleteChallengeInput{
+		ChallengeArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, pcaconnectorscep.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Private CA Connector for SCEP Challenge (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
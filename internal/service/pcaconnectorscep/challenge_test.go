@@ -0,0 +1,119 @@
+package pcaconnectorscep_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/pcaconnectorscep"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfpcaconnectorscep "github.com/hashicorp/terraform-provider-aws/internal/service/pcaconnectorscep"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccPCAConnectorSCEPChallenge_basic(t *testing.T) {
+	resourceName := "aws_pcaconnectorscep_challenge.test"
+	connectorResourceName := "aws_pcaconnectorscep_connector.test"
+	commonName := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, pcaconnectorscep.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckChallengeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccChallengeConfig_basic(commonName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChallengeExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "pca-connector-scep", regexp.MustCompile(`connector/.+/challenge/.+$`)),
+					resource.TestCheckResourceAttrPair(resourceName, "connector_arn", connectorResourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "password"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccPCAConnectorSCEPChallenge_disappears(t *testing.T) {
+	resourceName := "aws_pcaconnectorscep_challenge.test"
+	commonName := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, pcaconnectorscep.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckChallengeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccChallengeConfig_basic(commonName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChallengeExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfpcaconnectorscep.ResourceChallenge(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckChallengeExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Private CA Connector for SCEP Challenge ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PCAConnectorSCEPConn
+
+		_, err := tfpcaconnectorscep.FindChallengeMetadataByARN(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckChallengeDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).PCAConnectorSCEPConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_pcaconnectorscep_challenge" {
+			continue
+		}
+
+		_, err := tfpcaconnectorscep.FindChallengeMetadataByARN(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Private CA Connector for SCEP Challenge %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccChallengeConfig_basic(commonName string) string {
+	return acctest.ConfigCompose(testAccConnectorConfig_basic(commonName), `
+resource "aws_pcaconnectorscep_challenge" "test" {
+  connector_arn = aws_pcaconnectorscep_connector.test.arn
+}
+`)
+}
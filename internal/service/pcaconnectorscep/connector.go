@@ -0,0 +1,324 @@
+package pcaconnectorscep
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pcaconnectorscep"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceConnector() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceConnectorCreate,
+		ReadWithoutTimeout:   resourceConnectorRead,
+		UpdateWithoutTimeout: resourceConnectorUpdate,
+		DeleteWithoutTimeout: resourceConnectorDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(15 * time.Minute),
+			Delete: schema.DefaultTimeout(15 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"certificate_authority_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"mobile_device_management": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"intune": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"azure_application_id": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringLenBetween(15, 100),
+									},
+									"domain": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringLenBetween(1, 256),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"open_id_configuration": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"audience": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"issuer": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"subject": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceConnectorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PCAConnectorSCEPConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &pcaconnectorscep.CreateConnectorInput{
+		CertificateAuthorityArn: aws.String(d.Get("certificate_authority_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("mobile_device_management"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.MobileDeviceManagement = expandMobileDeviceManagement(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateConnectorWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Private CA Connector for SCEP Connector: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.ConnectorArn))
+
+	if _, err := waitConnectorCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for Private CA Connector for SCEP Connector (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceConnectorRead(ctx, d, meta)
+}
+
+func resourceConnectorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PCAConnectorSCEPConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	connector, err := FindConnectorByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Private CA Connector for SCEP Connector (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Private CA Connector for SCEP Connector (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", connector.Arn)
+	d.Set("certificate_authority_arn", connector.CertificateAuthorityArn)
+	d.Set("endpoint", connector.Endpoint)
+	if connector.MobileDeviceManagement != nil {
+		if err := d.Set("mobile_device_management", []interface{}{flattenMobileDeviceManagement(connector.MobileDeviceManagement)}); err != nil {
+			return diag.Errorf("setting mobile_device_management: %s", err)
+		}
+	} else {
+		d.Set("mobile_device_management", nil)
+	}
+	if connector.OpenIdConfiguration != nil {
+		if err := d.Set("open_id_configuration", []interface{}{flattenOpenIDConfiguration(connector.OpenIdConfiguration)}); err != nil {
+			return diag.Errorf("setting open_id_configuration: %s", err)
+		}
+	} else {
+		d.Set("open_id_configuration", nil)
+	}
+	d.Set("type", connector.Type)
+
+	tags, err := ListTagsWithContext(ctx, conn, d.Id())
+
+	if err != nil {
+		return diag.Errorf("listing tags for Private CA Connector for SCEP Connector (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceConnectorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PCAConnectorSCEPConn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Id(), o, n); err != nil {
+			return diag.Errorf("updating Private CA Connector for SCEP Connector (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceConnectorRead(ctx, d, meta)
+}
+
+func resourceConnectorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PCAConnectorSCEPConn
+
+	log.Printf("[DEBUG] Deleting Private CA Connector for SCEP Connector: %s", d.Id())
+	_, err := conn.DeleteConnectorWithContext(ctx, &pcaconnectorscep.DeleteConnectorInput{
+		ConnectorArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, pcaconnectorscep.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Private CA Connector for SCEP Connector (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitConnectorDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for Private CA Connector for SCEP Connector (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandMobileDeviceManagement(tfMap map[string]interface{}) *pcaconnectorscep.MobileDeviceManagement {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pcaconnectorscep.MobileDeviceManagement{}
+
+	if v, ok := tfMap["intune"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Intune = expandIntuneConfiguration(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandIntuneConfiguration(tfMap map[string]interface{}) *pcaconnectorscep.IntuneConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pcaconnectorscep.IntuneConfiguration{}
+
+	if v, ok := tfMap["azure_application_id"].(string); ok && v != "" {
+		apiObject.AzureApplicationId = aws.String(v)
+	}
+
+	if v, ok := tfMap["domain"].(string); ok && v != "" {
+		apiObject.Domain = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenMobileDeviceManagement(apiObject *pcaconnectorscep.MobileDeviceManagement) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Intune; v != nil {
+		tfMap["intune"] = []interface{}{flattenIntuneConfiguration(v)}
+	}
+
+	return tfMap
+}
+
+func flattenIntuneConfiguration(apiObject *pcaconnectorscep.IntuneConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AzureApplicationId; v != nil {
+		tfMap["azure_application_id"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Domain; v != nil {
+		tfMap["domain"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenOpenIDConfiguration(apiObject *pcaconnectorscep.OpenIdConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Audience; v != nil {
+		tfMap["audience"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Issuer; v != nil {
+		tfMap["issuer"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Subject; v != nil {
+		tfMap["subject"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
@@ -0,0 +1,230 @@
+package pcaconnectorscep_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/pcaconnectorscep"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfpcaconnectorscep "github.com/hashicorp/terraform-provider-aws/internal/service/pcaconnectorscep"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccPCAConnectorSCEPConnector_basic(t *testing.T) {
+	resourceName := "aws_pcaconnectorscep_connector.test"
+	certificateAuthorityResourceName := "aws_acmpca_certificate_authority.test"
+	commonName := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, pcaconnectorscep.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConnectorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConnectorConfig_basic(commonName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConnectorExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "pca-connector-scep", regexp.MustCompile(`connector/.+$`)),
+					resource.TestCheckResourceAttrPair(resourceName, "certificate_authority_arn", certificateAuthorityResourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "endpoint"),
+					resource.TestCheckResourceAttr(resourceName, "mobile_device_management.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "type", pcaconnectorscep.ConnectorTypeGeneralPurpose),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccPCAConnectorSCEPConnector_disappears(t *testing.T) {
+	resourceName := "aws_pcaconnectorscep_connector.test"
+	commonName := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, pcaconnectorscep.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConnectorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConnectorConfig_basic(commonName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConnectorExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfpcaconnectorscep.ResourceConnector(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccPCAConnectorSCEPConnector_tags(t *testing.T) {
+	resourceName := "aws_pcaconnectorscep_connector.test"
+	commonName := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, pcaconnectorscep.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConnectorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConnectorConfig_tags1(commonName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConnectorExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConnectorConfig_tags2(commonName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConnectorExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccConnectorConfig_tags1(commonName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConnectorExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckConnectorExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Private CA Connector for SCEP Connector ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PCAConnectorSCEPConn
+
+		_, err := tfpcaconnectorscep.FindConnectorByARN(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckConnectorDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).PCAConnectorSCEPConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_pcaconnectorscep_connector" {
+			continue
+		}
+
+		_, err := tfpcaconnectorscep.FindConnectorByARN(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Private CA Connector for SCEP Connector %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccConnectorConfig_base(commonName string) string {
+	return fmt.Sprintf(`
+resource "aws_acmpca_certificate_authority" "test" {
+  permanent_deletion_time_in_days = 7
+  type                            = "ROOT"
+
+  certificate_authority_configuration {
+    key_algorithm     = "RSA_4096"
+    signing_algorithm = "SHA512WITHRSA"
+
+    subject {
+      common_name = %[1]q
+    }
+  }
+}
+
+resource "aws_acmpca_certificate" "test" {
+  certificate_authority_arn   = aws_acmpca_certificate_authority.test.arn
+  certificate_signing_request = aws_acmpca_certificate_authority.test.certificate_signing_request
+  signing_algorithm           = "SHA512WITHRSA"
+
+  template_arn = "arn:${data.aws_partition.current.partition}:acm-pca:::template/RootCACertificate/V1"
+
+  validity {
+    type  = "YEARS"
+    value = 1
+  }
+}
+
+resource "aws_acmpca_certificate_authority_certificate" "test" {
+  certificate_authority_arn = aws_acmpca_certificate_authority.test.arn
+
+  certificate       = aws_acmpca_certificate.test.certificate
+  certificate_chain = aws_acmpca_certificate.test.certificate_chain
+}
+
+data "aws_partition" "current" {}
+`, commonName)
+}
+
+func testAccConnectorConfig_basic(commonName string) string {
+	return acctest.ConfigCompose(testAccConnectorConfig_base(commonName), `
+resource "aws_pcaconnectorscep_connector" "test" {
+  certificate_authority_arn = aws_acmpca_certificate_authority_certificate.test.certificate_authority_arn
+}
+`)
+}
+
+func testAccConnectorConfig_tags1(commonName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccConnectorConfig_base(commonName), fmt.Sprintf(`
+resource "aws_pcaconnectorscep_connector" "test" {
+  certificate_authority_arn = aws_acmpca_certificate_authority_certificate.test.certificate_authority_arn
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1))
+}
+
+func testAccConnectorConfig_tags2(commonName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccConnectorConfig_base(commonName), fmt.Sprintf(`
+resource "aws_pcaconnectorscep_connector" "test" {
+  certificate_authority_arn = aws_acmpca_certificate_authority_certificate.test.certificate_authority_arn
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
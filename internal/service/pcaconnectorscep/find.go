@@ -0,0 +1,86 @@
+package pcaconnectorscep
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pcaconnectorscep"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindChallengeMetadataByARN(ctx context.Context, conn *pcaconnectorscep.PcaConnectorScep, arn string) (*pcaconnectorscep.ChallengeMetadata, error) {
+	input := &pcaconnectorscep.GetChallengeMetadataInput{
+		ChallengeArn: aws.String(arn),
+	}
+
+	output, err := conn.GetChallengeMetadataWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, pcaconnectorscep.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ChallengeMetadata == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ChallengeMetadata, nil
+}
+
+func FindChallengePasswordByARN(ctx context.Context, conn *pcaconnectorscep.PcaConnectorScep, arn string) (string, error) {
+	input := &pcaconnectorscep.GetChallengePasswordInput{
+		ChallengeArn: aws.String(arn),
+	}
+
+	output, err := conn.GetChallengePasswordWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, pcaconnectorscep.ErrCodeResourceNotFoundException) {
+		return "", &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	if output == nil || output.Password == nil {
+		return "", tfresource.NewEmptyResultError(input)
+	}
+
+	return aws.StringValue(output.Password), nil
+}
+
+func FindConnectorByARN(ctx context.Context, conn *pcaconnectorscep.PcaConnectorScep, arn string) (*pcaconnectorscep.Connector, error) {
+	input := &pcaconnectorscep.GetConnectorInput{
+		ConnectorArn: aws.String(arn),
+	}
+
+	output, err := conn.GetConnectorWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, pcaconnectorscep.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Connector == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Connector, nil
+}
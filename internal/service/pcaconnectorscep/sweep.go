@@ -0,0 +1,135 @@
+//go:build sweep
+// +build sweep
+
+package pcaconnectorscep
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pcaconnectorscep"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_pcaconnectorscep_challenge", &resource.Sweeper{
+		Name: "aws_pcaconnectorscep_challenge",
+		F:    sweepChallenges,
+	})
+
+	resource.AddTestSweepers("aws_pcaconnectorscep_connector", &resource.Sweeper{
+		Name: "aws_pcaconnectorscep_connector",
+		F:    sweepConnectors,
+		Dependencies: []string{
+			"aws_pcaconnectorscep_challenge",
+		},
+	})
+}
+
+func sweepChallenges(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.(*conns.AWSClient).PCAConnectorSCEPConn
+	input := &pcaconnectorscep.ListConnectorsInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
+
+	err = conn.ListConnectorsPages(input, func(page *pcaconnectorscep.ListConnectorsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Connectors {
+			connectorARN := aws.StringValue(v.Arn)
+			challengeInput := &pcaconnectorscep.ListChallengeMetadataInput{
+				ConnectorArn: aws.String(connectorARN),
+			}
+
+			err := conn.ListChallengeMetadataPages(challengeInput, func(page *pcaconnectorscep.ListChallengeMetadataOutput, lastPage bool) bool {
+				if page == nil {
+					return !lastPage
+				}
+
+				for _, v := range page.Challenges {
+					r := ResourceChallenge()
+					d := r.Data(nil)
+					d.SetId(aws.StringValue(v.Arn))
+
+					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+				}
+
+				return !lastPage
+			})
+
+			if err != nil {
+				errs = multierror.Append(errs, fmt.Errorf("error listing Private CA Connector for SCEP Challenges for Connector (%s): %w", connectorARN, err))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing Private CA Connector for SCEP Connectors (%s): %w", region, err))
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Private CA Connector for SCEP Challenges (%s): %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Private CA Connector for SCEP Challenge sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepConnectors(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).PCAConnectorSCEPConn
+	input := &pcaconnectorscep.ListConnectorsInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListConnectorsPages(input, func(page *pcaconnectorscep.ListConnectorsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Connectors {
+			r := ResourceConnector()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Arn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Private CA Connector for SCEP Connector sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Private CA Connector for SCEP Connectors (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Private CA Connector for SCEP Connectors (%s): %w", region, err)
+	}
+
+	return nil
+}
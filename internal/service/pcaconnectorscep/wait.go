@@ -0,0 +1,50 @@
+package pcaconnectorscep
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pcaconnectorscep"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func waitConnectorCreated(ctx context.Context, conn *pcaconnectorscep.PcaConnectorScep, arn string, timeout time.Duration) (*pcaconnectorscep.Connector, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{pcaconnectorscep.ConnectorStatusCreating},
+		Target:  []string{pcaconnectorscep.ConnectorStatusActive},
+		Refresh: statusConnector(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*pcaconnectorscep.Connector); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitConnectorDeleted(ctx context.Context, conn *pcaconnectorscep.PcaConnectorScep, arn string, timeout time.Duration) (*pcaconnectorscep.Connector, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{pcaconnectorscep.ConnectorStatusDeleting},
+		Target:  []string{},
+		Refresh: statusConnector(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*pcaconnectorscep.Connector); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
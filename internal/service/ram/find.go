@@ -209,3 +209,27 @@ func FindResourceSharePrincipalAssociationByShareARNPrincipal(conn *ram.RAM, res
 
 	return output.ResourceShareAssociations[0], nil
 }
+
+// FindResourceSharePermissionsByARN returns the permissions associated with the resource share corresponding to the specified ARN.
+func FindResourceSharePermissionsByARN(conn *ram.RAM, arn string) ([]*ram.ResourceSharePermissionSummary, error) {
+	input := &ram.ListResourceSharePermissionsInput{
+		ResourceShareArn: aws.String(arn),
+	}
+	var output []*ram.ResourceSharePermissionSummary
+
+	err := conn.ListResourceSharePermissionsPages(input, func(page *ram.ListResourceSharePermissionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		output = append(output, page.Permissions...)
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
@@ -3,12 +3,15 @@ package ram
 import (
 	"fmt"
 	"log"
+	"strconv"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ram"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -55,6 +58,14 @@ func ResourceResourceShare() *schema.Resource {
 					ValidateFunc: verify.ValidARN,
 				},
 			},
+			"permission_versions": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeInt,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
@@ -95,6 +106,12 @@ func resourceResourceShareCreate(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("waiting for RAM Resource Share (%s) to become ready: %w", d.Id(), err)
 	}
 
+	if v, ok := d.GetOk("permission_versions"); ok && len(v.(map[string]interface{})) > 0 {
+		if err := updateResourceSharePermissionVersions(conn, d.Id(), v.(map[string]interface{})); err != nil {
+			return err
+		}
+	}
+
 	return resourceResourceShareRead(d, meta)
 }
 
@@ -136,21 +153,36 @@ func resourceResourceShareRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("setting tags_all: %w", err)
 	}
 
-	perms, err := conn.ListResourceSharePermissions(&ram.ListResourceSharePermissionsInput{
-		ResourceShareArn: aws.String(d.Id()),
-	})
+	perms, err := FindResourceSharePermissionsByARN(conn, d.Id())
 
 	if err != nil {
 		return fmt.Errorf("listing RAM Resource Share (%s) permissions: %w", d.Id(), err)
 	}
 
-	permissionARNs := make([]*string, 0, len(perms.Permissions))
+	permissionARNs := make([]*string, 0, len(perms))
+	permissionVersions := make(map[string]interface{})
+	configuredVersions := d.Get("permission_versions").(map[string]interface{})
 
-	for _, v := range perms.Permissions {
+	for _, v := range perms {
+		arn := aws.StringValue(v.Arn)
 		permissionARNs = append(permissionARNs, v.Arn)
+
+		// Only report versions for pinned permissions so that unpinned permissions don't show a diff.
+		if _, ok := configuredVersions[arn]; !ok {
+			continue
+		}
+
+		version, err := strconv.Atoi(aws.StringValue(v.Version))
+
+		if err != nil {
+			return fmt.Errorf("reading RAM Resource Share (%s) permission (%s) version: %w", d.Id(), arn, err)
+		}
+
+		permissionVersions[arn] = version
 	}
 
 	d.Set("permission_arns", aws.StringValueSlice(permissionARNs))
+	d.Set("permission_versions", permissionVersions)
 
 	return nil
 }
@@ -173,6 +205,12 @@ func resourceResourceShareUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
+	if d.HasChange("permission_versions") {
+		if err := updateResourceSharePermissionVersions(conn, d.Id(), d.Get("permission_versions").(map[string]interface{})); err != nil {
+			return err
+		}
+	}
+
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 
@@ -206,3 +244,47 @@ func resourceResourceShareDelete(d *schema.ResourceData, meta interface{}) error
 
 	return nil
 }
+
+// updateResourceSharePermissionVersions pins each of the specified permissions to the specified version.
+// Without pinning, RAM may move a resource share to a newer default version of an AWS managed permission.
+func updateResourceSharePermissionVersions(conn *ram.RAM, arn string, tfMap map[string]interface{}) error {
+	perms, err := FindResourceSharePermissionsByARN(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("listing RAM Resource Share (%s) permissions: %w", arn, err)
+	}
+
+	currentVersions := make(map[string]string, len(perms))
+
+	for _, v := range perms {
+		currentVersions[aws.StringValue(v.Arn)] = aws.StringValue(v.Version)
+	}
+
+	for permissionARN, v := range tfMap {
+		version := v.(int)
+		currentVersion, ok := currentVersions[permissionARN]
+
+		if !ok {
+			return fmt.Errorf("RAM Resource Share (%s) permission version configured for permission (%s) which is not associated with the resource share", arn, permissionARN)
+		}
+
+		if currentVersion == strconv.Itoa(version) {
+			continue
+		}
+
+		input := &ram.AssociateResourceSharePermissionInput{
+			ClientToken:       aws.String(resource.UniqueId()),
+			PermissionArn:     aws.String(permissionARN),
+			PermissionVersion: aws.Int64(int64(version)),
+			Replace:           aws.Bool(true),
+			ResourceShareArn:  aws.String(arn),
+		}
+
+		log.Printf("[DEBUG] Associating RAM Resource Share permission: %s", input)
+		if _, err := conn.AssociateResourceSharePermission(input); err != nil {
+			return fmt.Errorf("associating RAM Resource Share (%s) permission (%s) version %d: %w", arn, permissionARN, version, err)
+		}
+	}
+
+	return nil
+}
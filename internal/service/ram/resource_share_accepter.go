@@ -92,10 +92,26 @@ func resourceResourceShareAccepterCreate(d *schema.ResourceData, meta interface{
 	}
 
 	if invitation == nil || aws.StringValue(invitation.ResourceShareInvitationArn) == "" {
-		return fmt.Errorf(
-			"No RAM Resource Share (%s) invitation found\n\n"+
-				"NOTE: If both AWS accounts are in the same AWS Organization and RAM Sharing with AWS Organizations is enabled, this resource is not necessary",
-			shareARN)
+		// The invitation may already have been accepted, e.g. by a previous, partially applied configuration,
+		// or the share may not use invitations at all because both accounts are in the same organization.
+		// In either case there is nothing left to accept.
+		accepted, err := resourceShareAccepterAlreadyAccepted(conn, shareARN)
+
+		if err != nil {
+			return err
+		}
+
+		if !accepted {
+			return fmt.Errorf(
+				"No RAM Resource Share (%s) invitation found\n\n"+
+					"NOTE: If both AWS accounts are in the same AWS Organization and RAM Sharing with AWS Organizations is enabled, this resource is not necessary",
+				shareARN)
+		}
+
+		log.Printf("[INFO] RAM Resource Share (%s) has no pending invitation but is already shared with this account", shareARN)
+		d.SetId(shareARN)
+
+		return resourceResourceShareAccepterRead(d, meta)
 	}
 
 	input := &ram.AcceptResourceShareInvitationInput{
@@ -104,9 +120,9 @@ func resourceResourceShareAccepterCreate(d *schema.ResourceData, meta interface{
 	}
 
 	log.Printf("[DEBUG] Accept RAM resource share invitation request: %s", input)
-	output, err := conn.AcceptResourceShareInvitation(input)
+	_, err = conn.AcceptResourceShareInvitation(input)
 
-	if err != nil {
+	if err != nil && !tfawserr.ErrCodeEquals(err, ram.ErrCodeResourceShareInvitationAlreadyAcceptedException) {
 		return fmt.Errorf("Error accepting RAM resource share invitation: %s", err)
 	}
 
@@ -114,7 +130,7 @@ func resourceResourceShareAccepterCreate(d *schema.ResourceData, meta interface{
 
 	_, err = WaitResourceShareInvitationAccepted(
 		conn,
-		aws.StringValue(output.ResourceShareInvitation.ResourceShareInvitationArn),
+		aws.StringValue(invitation.ResourceShareInvitationArn),
 		d.Timeout(schema.TimeoutCreate),
 	)
 
@@ -225,6 +241,33 @@ func resourceResourceShareAccepterDelete(d *schema.ResourceData, meta interface{
 	return nil
 }
 
+// resourceShareAccepterAlreadyAccepted returns whether the specified resource share is already available
+// to the current account without a pending invitation, either because its invitation has been accepted
+// or because the share was made within an AWS Organization with RAM sharing enabled.
+func resourceShareAccepterAlreadyAccepted(conn *ram.RAM, shareARN string) (bool, error) {
+	invitation, err := resourceShareInvitationByResourceShareARNAndStatus(conn, shareARN, ram.ResourceShareInvitationStatusAccepted)
+
+	if err != nil {
+		return false, fmt.Errorf("error retrieving invitation for resource share %s: %w", shareARN, err)
+	}
+
+	if invitation != nil {
+		return true, nil
+	}
+
+	resourceShare, err := FindResourceShareOwnerOtherAccountsByARN(conn, shareARN)
+
+	if tfawserr.ErrCodeEquals(err, ram.ErrCodeResourceArnNotFoundException) || tfawserr.ErrCodeEquals(err, ram.ErrCodeUnknownResourceException) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, fmt.Errorf("error retrieving resource share (%s): %w", shareARN, err)
+	}
+
+	return resourceShare != nil && aws.StringValue(resourceShare.Status) == ram.ResourceShareStatusActive, nil
+}
+
 func resourceResourceShareGetIDFromARN(arn string) string {
 	return strings.Replace(arn[strings.LastIndex(arn, ":")+1:], "resource-share/", "rs-", -1)
 }
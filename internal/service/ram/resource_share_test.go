@@ -77,6 +77,35 @@ func TestAccRAMResourceShare_permission(t *testing.T) {
 	})
 }
 
+func TestAccRAMResourceShare_permissionVersion(t *testing.T) {
+	var resourceShare ram.ResourceShare
+	resourceName := "aws_ram_resource_share.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ram.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceShareDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceShareConfig_permissionVersion(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceShareExists(resourceName, &resourceShare),
+					resource.TestCheckResourceAttr(resourceName, "permission_arns.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "permission_versions.%", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"permission_versions"},
+			},
+		},
+	})
+}
+
 func TestAccRAMResourceShare_allowExternalPrincipals(t *testing.T) {
 	var resourceShare1, resourceShare2 ram.ResourceShare
 	resourceName := "aws_ram_resource_share.test"
@@ -317,3 +346,22 @@ resource "aws_ram_resource_share" "test" {
 }
 `, rName)
 }
+
+func testAccResourceShareConfig_permissionVersion(rName string, version int) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+locals {
+  permission_arn = "arn:${data.aws_partition.current.partition}:ram::aws:permission/AWSRAMDefaultPermissionSubnet"
+}
+
+resource "aws_ram_resource_share" "test" {
+  name            = %[1]q
+  permission_arns = [local.permission_arn]
+
+  permission_versions = {
+    (local.permission_arn) = %[2]d
+  }
+}
+`, rName, version)
+}
@@ -252,10 +252,16 @@ func ResourceCluster() *schema.Resource {
 				Default:      -1,
 				ValidateFunc: validation.IntBetween(-1, 3653),
 			},
+			"manage_master_password": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ConflictsWith: []string{"master_password"},
+			},
 			"master_password": {
-				Type:      schema.TypeString,
-				Optional:  true,
-				Sensitive: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"manage_master_password"},
 				ValidateFunc: validation.All(
 					validation.StringLenBetween(8, 64),
 					validation.StringMatch(regexp.MustCompile(`^.*[a-z].*`), "must contain at least one lowercase letter"),
@@ -264,6 +270,15 @@ func ResourceCluster() *schema.Resource {
 					validation.StringMatch(regexp.MustCompile(`^[^\@\/'" ]*$`), "cannot contain [/@\"' ]"),
 				),
 			},
+			"master_password_secret_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"master_password_secret_kms_key_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
 			"master_username": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -405,7 +420,6 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 		ClusterVersion:                   aws.String(d.Get("cluster_version").(string)),
 		DBName:                           aws.String(d.Get("database_name").(string)),
 		MasterUsername:                   aws.String(d.Get("master_username").(string)),
-		MasterUserPassword:               aws.String(d.Get("master_password").(string)),
 		NodeType:                         aws.String(d.Get("node_type").(string)),
 		Port:                             aws.Int64(int64(d.Get("port").(int))),
 		PubliclyAccessible:               aws.Bool(d.Get("publicly_accessible").(bool)),
@@ -472,11 +486,25 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 		input.MaintenanceTrackName = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("manage_master_password"); ok {
+		backupInput.ManageMasterPassword = aws.Bool(v.(bool))
+		input.ManageMasterPassword = aws.Bool(v.(bool))
+	}
+
 	if v, ok := d.GetOk("manual_snapshot_retention_period"); ok {
 		backupInput.ManualSnapshotRetentionPeriod = aws.Int64(int64(v.(int)))
 		input.ManualSnapshotRetentionPeriod = aws.Int64(int64(v.(int)))
 	}
 
+	if v, ok := d.GetOk("master_password"); ok {
+		input.MasterUserPassword = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("master_password_secret_kms_key_id"); ok {
+		backupInput.MasterPasswordSecretKmsKeyId = aws.String(v.(string))
+		input.MasterPasswordSecretKmsKeyId = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("number_of_nodes"); ok {
 		backupInput.NumberOfNodes = aws.Int64(int64(v.(int)))
 		// NumberOfNodes set below for CreateCluster.
@@ -512,8 +540,8 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 
 		d.SetId(aws.StringValue(output.Cluster.ClusterIdentifier))
 	} else {
-		if _, ok := d.GetOk("master_password"); !ok {
-			return fmt.Errorf(`provider.aws: aws_redshift_cluster: %s: "master_password": required field is not set`, d.Get("cluster_identifier").(string))
+		if _, ok := d.GetOk("master_password"); !ok && !d.Get("manage_master_password").(bool) {
+			return fmt.Errorf(`provider.aws: aws_redshift_cluster: %s: one of "manage_master_password" or "master_password" must be set`, d.Get("cluster_identifier").(string))
 		}
 
 		if _, ok := d.GetOk("master_username"); !ok {
@@ -638,6 +666,9 @@ func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.Set("maintenance_track_name", rsc.MaintenanceTrackName)
 	d.Set("manual_snapshot_retention_period", rsc.ManualSnapshotRetentionPeriod)
+	d.Set("manage_master_password", rsc.MasterPasswordSecretArn != nil)
+	d.Set("master_password_secret_arn", rsc.MasterPasswordSecretArn)
+	d.Set("master_password_secret_kms_key_id", rsc.MasterPasswordSecretKmsKeyId)
 	d.Set("master_username", rsc.MasterUsername)
 	d.Set("node_type", rsc.NodeType)
 	d.Set("number_of_nodes", rsc.NumberOfNodes)
@@ -762,8 +793,20 @@ func resourceClusterUpdate(d *schema.ResourceData, meta interface{}) error {
 			input.KmsKeyId = aws.String(d.Get("kms_key_id").(string))
 		}
 
+		if d.HasChange("manage_master_password") {
+			input.ManageMasterPassword = aws.Bool(d.Get("manage_master_password").(bool))
+		}
+
 		if d.HasChange("master_password") {
-			input.MasterUserPassword = aws.String(d.Get("master_password").(string))
+			if v, ok := d.GetOk("master_password"); ok {
+				input.MasterUserPassword = aws.String(v.(string))
+			}
+		}
+
+		if d.Get("manage_master_password").(bool) && d.HasChanges("manage_master_password", "master_password_secret_kms_key_id") {
+			if v, ok := d.GetOk("master_password_secret_kms_key_id"); ok {
+				input.MasterPasswordSecretKmsKeyId = aws.String(v.(string))
+			}
 		}
 
 		if d.HasChange("preferred_maintenance_window") {
@@ -61,6 +61,49 @@ func TestAccRedshiftCluster_basic(t *testing.T) {
 	})
 }
 
+func TestAccRedshiftCluster_manageMasterPassword(t *testing.T) {
+	var v redshift.Cluster
+	resourceName := "aws_redshift_cluster.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, redshift.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_manageMasterPassword(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "manage_master_password", "true"),
+					resource.TestCheckNoResourceAttr(resourceName, "master_password"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "master_password_secret_arn", "secretsmanager", regexp.MustCompile(`secret:.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "master_password_secret_kms_key_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"final_snapshot_identifier",
+					"skip_final_snapshot",
+					"apply_immediately",
+				},
+			},
+			{
+				Config: testAccClusterConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "manage_master_password", "false"),
+					resource.TestCheckResourceAttr(resourceName, "master_password_secret_arn", ""),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRedshiftCluster_aqua(t *testing.T) {
 	var v redshift.Cluster
 	resourceName := "aws_redshift_cluster.test"
@@ -958,6 +1001,22 @@ resource "aws_redshift_cluster" "test" {
 `, rName))
 }
 
+func testAccClusterConfig_manageMasterPassword(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptInExclude("usw2-az2"), fmt.Sprintf(`
+resource "aws_redshift_cluster" "test" {
+  cluster_identifier                  = %[1]q
+  availability_zone                   = data.aws_availability_zones.available.names[0]
+  database_name                       = "mydb"
+  master_username                     = "foo_test"
+  manage_master_password              = true
+  node_type                           = "dc2.large"
+  automated_snapshot_retention_period = 0
+  allow_version_upgrade               = false
+  skip_final_snapshot                 = true
+}
+`, rName))
+}
+
 func testAccClusterConfig_aqua(rName, status string) string {
 	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptInExclude("usw2-az2"), fmt.Sprintf(`
 resource "aws_redshift_cluster" "test" {
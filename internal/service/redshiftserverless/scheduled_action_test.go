@@ -0,0 +1,200 @@
+package redshiftserverless_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/redshiftserverless"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfredshiftserverless "github.com/hashicorp/terraform-provider-aws/internal/service/redshiftserverless"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccRedshiftServerlessScheduledAction_basic(t *testing.T) {
+	resourceName := "aws_redshiftserverless_scheduled_action.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, redshiftserverless.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScheduledActionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduledActionConfig_basic(rName, "00 * * * ? *", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduledActionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "namespace_name", "aws_redshiftserverless_namespace.test", "namespace_name"),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.cron", "00 * * * ? *"),
+					resource.TestCheckResourceAttr(resourceName, "target_action.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_action.0.create_snapshot.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_action.0.create_snapshot.0.retention_period", "7"),
+					resource.TestCheckResourceAttr(resourceName, "target_action.0.create_snapshot.0.snapshot_name_prefix", "test"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccScheduledActionConfig_basic(rName, "30 * * * ? *", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduledActionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.cron", "30 * * * ? *"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRedshiftServerlessScheduledAction_disappears(t *testing.T) {
+	resourceName := "aws_redshiftserverless_scheduled_action.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, redshiftserverless.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScheduledActionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduledActionConfig_basic(rName, "00 * * * ? *", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduledActionExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfredshiftserverless.ResourceScheduledAction(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckScheduledActionDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftServerlessConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_redshiftserverless_scheduled_action" {
+			continue
+		}
+		_, err := tfredshiftserverless.FindScheduledActionByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Redshift Serverless Scheduled Action %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckScheduledActionExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Redshift Serverless Scheduled Action is not set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftServerlessConn
+
+		_, err := tfredshiftserverless.FindScheduledActionByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccScheduledActionConfig_basic(rName, cron string, enabled bool) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_redshiftserverless_namespace" "test" {
+  namespace_name = %[1]q
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": [
+          "scheduler.redshift.${data.aws_partition.current.dns_suffix}"
+        ]
+      },
+      "Action": [
+        "sts:AssumeRole"
+      ]
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": [
+        "redshift-serverless:CreateSnapshot"
+      ],
+      "Resource": "*"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_redshiftserverless_scheduled_action" "test" {
+  name           = %[1]q
+  namespace_name = aws_redshiftserverless_namespace.test.namespace_name
+  role_arn       = aws_iam_role.test.arn
+  enabled        = %[3]t
+
+  schedule {
+    cron = %[2]q
+  }
+
+  target_action {
+    create_snapshot {
+      retention_period     = 7
+      snapshot_name_prefix = "test"
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, cron, enabled)
+}
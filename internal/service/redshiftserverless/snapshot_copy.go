@@ -0,0 +1,147 @@
+package redshiftserverless
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/redshiftserverless"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceSnapshotCopy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSnapshotCopyCreate,
+		Read:   resourceSnapshotCopyRead,
+		Update: resourceSnapshotCopyUpdate,
+		Delete: resourceSnapshotCopyDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"destination_kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"destination_region": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidRegionName,
+			},
+			"namespace_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"snapshot_retention_period": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(-1),
+			},
+		},
+	}
+}
+
+func resourceSnapshotCopyCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RedshiftServerlessConn
+
+	namespaceName := d.Get("namespace_name").(string)
+	input := &redshiftserverless.CreateSnapshotCopyConfigurationInput{
+		DestinationRegion: aws.String(d.Get("destination_region").(string)),
+		NamespaceName:     aws.String(namespaceName),
+	}
+
+	if v, ok := d.GetOk("destination_kms_key_id"); ok {
+		input.DestinationKmsKeyId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("snapshot_retention_period"); ok {
+		input.SnapshotRetentionPeriod = aws.Int64(int64(v.(int)))
+	}
+
+	out, err := conn.CreateSnapshotCopyConfiguration(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Redshift Serverless Snapshot Copy Configuration (%s): %w", namespaceName, err)
+	}
+
+	d.SetId(aws.StringValue(out.SnapshotCopyConfiguration.SnapshotCopyConfigurationId))
+
+	return resourceSnapshotCopyRead(d, meta)
+}
+
+func resourceSnapshotCopyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RedshiftServerlessConn
+
+	out, err := FindSnapshotCopyConfigurationByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Redshift Serverless Snapshot Copy Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Redshift Serverless Snapshot Copy Configuration (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", out.SnapshotCopyConfigurationArn)
+	d.Set("destination_kms_key_id", out.DestinationKmsKeyId)
+	d.Set("destination_region", out.DestinationRegion)
+	d.Set("namespace_name", out.NamespaceName)
+	d.Set("snapshot_retention_period", out.SnapshotRetentionPeriod)
+
+	return nil
+}
+
+func resourceSnapshotCopyUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RedshiftServerlessConn
+
+	input := &redshiftserverless.UpdateSnapshotCopyConfigurationInput{
+		SnapshotCopyConfigurationId: aws.String(d.Id()),
+		SnapshotRetentionPeriod:     aws.Int64(int64(d.Get("snapshot_retention_period").(int))),
+	}
+
+	_, err := conn.UpdateSnapshotCopyConfiguration(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating Redshift Serverless Snapshot Copy Configuration (%s): %w", d.Id(), err)
+	}
+
+	return resourceSnapshotCopyRead(d, meta)
+}
+
+func resourceSnapshotCopyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RedshiftServerlessConn
+
+	log.Printf("[DEBUG] Deleting Redshift Serverless Snapshot Copy Configuration: %s", d.Id())
+	_, err := conn.DeleteSnapshotCopyConfiguration(&redshiftserverless.DeleteSnapshotCopyConfigurationInput{
+		SnapshotCopyConfigurationId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, redshiftserverless.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Redshift Serverless Snapshot Copy Configuration (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,133 @@
+package redshiftserverless_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/redshiftserverless"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfredshiftserverless "github.com/hashicorp/terraform-provider-aws/internal/service/redshiftserverless"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccRedshiftServerlessSnapshotCopy_basic(t *testing.T) {
+	resourceName := "aws_redshiftserverless_snapshot_copy.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckMultipleRegion(t, 2) },
+		ErrorCheck:               acctest.ErrorCheck(t, redshiftserverless.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSnapshotCopyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSnapshotCopyConfig_basic(rName, 7),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSnapshotCopyExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "destination_region", acctest.AlternateRegion()),
+					resource.TestCheckResourceAttrPair(resourceName, "namespace_name", "aws_redshiftserverless_namespace.test", "namespace_name"),
+					resource.TestCheckResourceAttr(resourceName, "snapshot_retention_period", "7"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSnapshotCopyConfig_basic(rName, 14),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSnapshotCopyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "snapshot_retention_period", "14"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRedshiftServerlessSnapshotCopy_disappears(t *testing.T) {
+	resourceName := "aws_redshiftserverless_snapshot_copy.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckMultipleRegion(t, 2) },
+		ErrorCheck:               acctest.ErrorCheck(t, redshiftserverless.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSnapshotCopyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSnapshotCopyConfig_basic(rName, 7),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSnapshotCopyExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfredshiftserverless.ResourceSnapshotCopy(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSnapshotCopyDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftServerlessConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_redshiftserverless_snapshot_copy" {
+			continue
+		}
+		_, err := tfredshiftserverless.FindSnapshotCopyConfigurationByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Redshift Serverless Snapshot Copy Configuration %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckSnapshotCopyExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Redshift Serverless Snapshot Copy Configuration is not set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftServerlessConn
+
+		_, err := tfredshiftserverless.FindSnapshotCopyConfigurationByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccSnapshotCopyConfig_basic(rName string, retentionPeriod int) string {
+	return fmt.Sprintf(`
+resource "aws_redshiftserverless_namespace" "test" {
+  namespace_name = %[1]q
+}
+
+resource "aws_redshiftserverless_snapshot_copy" "test" {
+  namespace_name            = aws_redshiftserverless_namespace.test.namespace_name
+  destination_region        = %[2]q
+  snapshot_retention_period = %[3]d
+}
+`, rName, acctest.AlternateRegion(), retentionPeriod)
+}
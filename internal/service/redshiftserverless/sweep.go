@@ -19,6 +19,20 @@ func init() {
 	resource.AddTestSweepers("aws_redshiftserverless_namespace", &resource.Sweeper{
 		Name: "aws_redshiftserverless_namespace",
 		F:    sweepNamespaces,
+		Dependencies: []string{
+			"aws_redshiftserverless_scheduled_action",
+			"aws_redshiftserverless_snapshot_copy",
+		},
+	})
+
+	resource.AddTestSweepers("aws_redshiftserverless_scheduled_action", &resource.Sweeper{
+		Name: "aws_redshiftserverless_scheduled_action",
+		F:    sweepScheduledActions,
+	})
+
+	resource.AddTestSweepers("aws_redshiftserverless_snapshot_copy", &resource.Sweeper{
+		Name: "aws_redshiftserverless_snapshot_copy",
+		F:    sweepSnapshotCopies,
 	})
 }
 
@@ -64,3 +78,89 @@ func sweepNamespaces(region string) error {
 
 	return nil
 }
+
+func sweepScheduledActions(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.(*conns.AWSClient).RedshiftServerlessConn
+	input := &redshiftserverless.ListScheduledActionsInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
+
+	err = conn.ListScheduledActionsPages(input, func(page *redshiftserverless.ListScheduledActionsOutput, lastPage bool) bool {
+		if len(page.ScheduledActions) == 0 {
+			log.Print("[DEBUG] No Redshift Serverless Scheduled Actions to sweep")
+			return !lastPage
+		}
+
+		for _, v := range page.ScheduledActions {
+			r := ResourceScheduledAction()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.ScheduledActionName))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error describing Redshift Serverless Scheduled Actions: %w", err))
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Redshift Serverless Scheduled Actions for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Redshift Serverless Scheduled Actions sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepSnapshotCopies(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.(*conns.AWSClient).RedshiftServerlessConn
+	input := &redshiftserverless.ListSnapshotCopyConfigurationsInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
+
+	err = conn.ListSnapshotCopyConfigurationsPages(input, func(page *redshiftserverless.ListSnapshotCopyConfigurationsOutput, lastPage bool) bool {
+		if len(page.SnapshotCopyConfigurations) == 0 {
+			log.Print("[DEBUG] No Redshift Serverless Snapshot Copy Configurations to sweep")
+			return !lastPage
+		}
+
+		for _, v := range page.SnapshotCopyConfigurations {
+			r := ResourceSnapshotCopy()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.SnapshotCopyConfigurationId))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error describing Redshift Serverless Snapshot Copy Configurations: %w", err))
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Redshift Serverless Snapshot Copy Configurations for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Redshift Serverless Snapshot Copy Configurations sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
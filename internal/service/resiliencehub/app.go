@@ -0,0 +1,232 @@
+package resiliencehub
+
+import (
+	"context"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/resiliencehub"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceApp() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAppCreate,
+		ReadWithoutTimeout:   resourceAppRead,
+		UpdateWithoutTimeout: resourceAppUpdate,
+		DeleteWithoutTimeout: resourceAppDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"assessment_schedule": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(resiliencehub.AppAssessmentScheduleType_Values(), false),
+			},
+			"compliance_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 500),
+			},
+			"drift_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(2, 60),
+					validation.StringMatch(regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_\-]+$`), "must begin with a letter or number and contain only letters, numbers, underscores and hyphens"),
+				),
+			},
+			"policy_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"resiliency_score": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceAppCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ResilienceHubConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &resiliencehub.CreateAppInput{
+		Name: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("assessment_schedule"); ok {
+		input.AssessmentSchedule = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("policy_arn"); ok {
+		input.PolicyArn = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateAppWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Resilience Hub App (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.App.AppArn))
+
+	return resourceAppRead(ctx, d, meta)
+}
+
+func resourceAppRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ResilienceHubConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	app, err := FindAppByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Resilience Hub App (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Resilience Hub App (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", app.AppArn)
+	d.Set("assessment_schedule", app.AssessmentSchedule)
+	d.Set("compliance_status", app.ComplianceStatus)
+	d.Set("description", app.Description)
+	d.Set("drift_status", app.DriftStatus)
+	d.Set("name", app.Name)
+	d.Set("policy_arn", app.PolicyArn)
+	d.Set("resiliency_score", app.ResiliencyScore)
+	d.Set("status", app.Status)
+
+	tags := KeyValueTags(app.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAppUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ResilienceHubConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &resiliencehub.UpdateAppInput{
+			AppArn: aws.String(d.Id()),
+		}
+
+		if d.HasChange("assessment_schedule") {
+			input.AssessmentSchedule = aws.String(d.Get("assessment_schedule").(string))
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("policy_arn") {
+			if v, ok := d.GetOk("policy_arn"); ok {
+				input.PolicyArn = aws.String(v.(string))
+			} else {
+				input.ClearResiliencyPolicyArn = aws.Bool(true)
+			}
+		}
+
+		_, err := conn.UpdateAppWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating Resilience Hub App (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Id(), o, n); err != nil {
+			return diag.Errorf("updating Resilience Hub App (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAppRead(ctx, d, meta)
+}
+
+func resourceAppDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ResilienceHubConn
+
+	log.Printf("[DEBUG] Deleting Resilience Hub App: %s", d.Id())
+	_, err := conn.DeleteAppWithContext(ctx, &resiliencehub.DeleteAppInput{
+		AppArn:      aws.String(d.Id()),
+		ForceDelete: aws.Bool(true),
+	})
+
+	if tfawserr.ErrCodeEquals(err, resiliencehub.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Resilience Hub App (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitAppDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for Resilience Hub App (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
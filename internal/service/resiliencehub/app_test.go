@@ -0,0 +1,254 @@
+package resiliencehub_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/resiliencehub"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfresiliencehub "github.com/hashicorp/terraform-provider-aws/internal/service/resiliencehub"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccResilienceHubApp_basic(t *testing.T) {
+	var v resiliencehub.App
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_resiliencehub_app.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, resiliencehub.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAppDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppExists(resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "resiliencehub", regexp.MustCompile(`app/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "assessment_schedule", "Disabled"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "policy_arn", ""),
+					resource.TestCheckResourceAttr(resourceName, "status", "Active"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccResilienceHubApp_disappears(t *testing.T) {
+	var v resiliencehub.App
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_resiliencehub_app.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, resiliencehub.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAppDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfresiliencehub.ResourceApp(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccResilienceHubApp_policyAndSchedule(t *testing.T) {
+	var v resiliencehub.App
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_resiliencehub_app.test"
+	policyResourceName := "aws_resiliencehub_resiliency_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, resiliencehub.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAppDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppConfig_policyAndSchedule(rName, "Daily"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "assessment_schedule", "Daily"),
+					resource.TestCheckResourceAttr(resourceName, "description", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "policy_arn", policyResourceName, "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAppConfig_policyAndSchedule(rName, "Disabled"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "assessment_schedule", "Disabled"),
+				),
+			},
+			{
+				Config: testAccAppConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "policy_arn", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResilienceHubApp_tags(t *testing.T) {
+	var v resiliencehub.App
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_resiliencehub_app.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, resiliencehub.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAppDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAppConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAppConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAppExists(n string, v *resiliencehub.App) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Resilience Hub App ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ResilienceHubConn
+
+		output, err := tfresiliencehub.FindAppByARN(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAppDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ResilienceHubConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_resiliencehub_app" {
+			continue
+		}
+
+		_, err := tfresiliencehub.FindAppByARN(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Resilience Hub App %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAppConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_resiliencehub_app" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccAppConfig_policyAndSchedule(rName, schedule string) string {
+	return acctest.ConfigCompose(testAccResiliencyPolicyConfig_basic(rName), fmt.Sprintf(`
+resource "aws_resiliencehub_app" "test" {
+  assessment_schedule = %[2]q
+  description         = %[1]q
+  name                = %[1]q
+  policy_arn          = aws_resiliencehub_resiliency_policy.test.arn
+}
+`, rName, schedule))
+}
+
+func testAccAppConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_resiliencehub_app" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAppConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_resiliencehub_app" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,239 @@
+package resiliencehub
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/resiliencehub"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// ResourceAppVersion manages the resource mappings of a Resilience Hub application.
+// Each apply imports the configured input sources into the draft application version
+// and publishes it, so that subsequent assessments use the new mappings.
+func ResourceAppVersion() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAppVersionCreate,
+		ReadWithoutTimeout:   resourceAppVersionRead,
+		UpdateWithoutTimeout: resourceAppVersionUpdate,
+		DeleteWithoutTimeout: resourceAppVersionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"app_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"app_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cloudformation_stack_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+				AtLeastOneOf: []string{"cloudformation_stack_arns", "terraform_state_file_urls"},
+			},
+			"terraform_state_file_urls": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringMatch(s3URLRegexp, "must be an S3 URL"),
+				},
+				AtLeastOneOf: []string{"cloudformation_stack_arns", "terraform_state_file_urls"},
+			},
+			"version_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAppVersionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ResilienceHubConn
+
+	appARN := d.Get("app_arn").(string)
+
+	if err := importAndPublishAppVersion(ctx, conn, d, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("creating Resilience Hub App Version (%s): %s", appARN, err)
+	}
+
+	d.SetId(appARN)
+
+	return resourceAppVersionRead(ctx, d, meta)
+}
+
+func resourceAppVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ResilienceHubConn
+
+	sources, err := FindAppInputSources(ctx, conn, d.Id(), appVersionDraft)
+
+	if err == nil && len(sources) == 0 {
+		err = tfresource.NewEmptyResultError(nil)
+	}
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Resilience Hub App Version (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Resilience Hub App Version (%s) input sources: %s", d.Id(), err)
+	}
+
+	version, err := FindLatestPublishedAppVersion(ctx, conn, d.Id())
+
+	if err != nil && !tfresource.NotFound(err) {
+		return diag.Errorf("reading Resilience Hub App Version (%s): %s", d.Id(), err)
+	}
+
+	var stackARNs, stateFileURLs []string
+
+	for _, source := range sources {
+		switch aws.StringValue(source.ImportType) {
+		case resiliencehub.ResourceMappingTypeCfnStack:
+			stackARNs = append(stackARNs, aws.StringValue(source.SourceArn))
+		case resiliencehub.ResourceMappingTypeTerraform:
+			if source.TerraformSource != nil {
+				stateFileURLs = append(stateFileURLs, aws.StringValue(source.TerraformSource.S3StateFileUrl))
+			}
+		}
+	}
+
+	d.Set("app_arn", d.Id())
+	d.Set("cloudformation_stack_arns", stackARNs)
+	d.Set("terraform_state_file_urls", stateFileURLs)
+
+	if version != nil {
+		d.Set("app_version", version.AppVersion)
+		d.Set("version_name", version.VersionName)
+	} else {
+		d.Set("app_version", nil)
+		d.Set("version_name", nil)
+	}
+
+	return nil
+}
+
+func resourceAppVersionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ResilienceHubConn
+
+	if err := importAndPublishAppVersion(ctx, conn, d, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return diag.Errorf("updating Resilience Hub App Version (%s): %s", d.Id(), err)
+	}
+
+	return resourceAppVersionRead(ctx, d, meta)
+}
+
+func resourceAppVersionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ResilienceHubConn
+
+	sources, err := FindAppInputSources(ctx, conn, d.Id(), appVersionDraft)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Resilience Hub App Version (%s) input sources: %s", d.Id(), err)
+	}
+
+	for _, source := range sources {
+		input := &resiliencehub.DeleteAppInputSourceInput{
+			AppArn: aws.String(d.Id()),
+		}
+
+		switch aws.StringValue(source.ImportType) {
+		case resiliencehub.ResourceMappingTypeCfnStack:
+			input.SourceArn = source.SourceArn
+		case resiliencehub.ResourceMappingTypeTerraform:
+			input.TerraformSource = source.TerraformSource
+		default:
+			continue
+		}
+
+		log.Printf("[DEBUG] Deleting Resilience Hub App Version (%s) input source: %s", d.Id(), input)
+		_, err := conn.DeleteAppInputSourceWithContext(ctx, input)
+
+		if tfawserr.ErrCodeEquals(err, resiliencehub.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return diag.Errorf("deleting Resilience Hub App Version (%s) input source: %s", d.Id(), err)
+		}
+	}
+
+	return nil
+}
+
+// importAndPublishAppVersion replaces the input sources of an application's draft version
+// with the configured CloudFormation stacks and Terraform state files and publishes the draft.
+func importAndPublishAppVersion(ctx context.Context, conn *resiliencehub.ResilienceHub, d *schema.ResourceData, timeout time.Duration) error {
+	appARN := d.Get("app_arn").(string)
+	input := &resiliencehub.ImportResourcesToDraftAppVersionInput{
+		AppArn:         aws.String(appARN),
+		ImportStrategy: aws.String(resiliencehub.ResourceImportStrategyTypeReplaceAll),
+	}
+
+	if v, ok := d.GetOk("cloudformation_stack_arns"); ok && v.(*schema.Set).Len() > 0 {
+		input.SourceArns = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("terraform_state_file_urls"); ok && v.(*schema.Set).Len() > 0 {
+		for _, url := range flex.ExpandStringValueSet(v.(*schema.Set)) {
+			input.TerraformSources = append(input.TerraformSources, &resiliencehub.TerraformSource{
+				S3StateFileUrl: aws.String(url),
+			})
+		}
+	}
+
+	if _, err := conn.ImportResourcesToDraftAppVersionWithContext(ctx, input); err != nil {
+		return err
+	}
+
+	if _, err := waitDraftAppVersionResourcesImported(ctx, conn, appARN, timeout); err != nil {
+		return fmt.Errorf("waiting for resources import: %w", err)
+	}
+
+	publishInput := &resiliencehub.PublishAppVersionInput{
+		AppArn: aws.String(appARN),
+	}
+
+	if v, ok := d.GetOk("version_name"); ok {
+		publishInput.VersionName = aws.String(v.(string))
+	}
+
+	if _, err := conn.PublishAppVersionWithContext(ctx, publishInput); err != nil {
+		return fmt.Errorf("publishing: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,155 @@
+package resiliencehub_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/resiliencehub"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfresiliencehub "github.com/hashicorp/terraform-provider-aws/internal/service/resiliencehub"
+)
+
+func TestAccResilienceHubAppVersion_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_resiliencehub_app_version.test"
+	appResourceName := "aws_resiliencehub_app.test"
+	stackResourceName := "aws_cloudformation_stack.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, resiliencehub.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAppDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppVersionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppVersionExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "app_arn", appResourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "app_version"),
+					resource.TestCheckResourceAttr(resourceName, "cloudformation_stack_arns.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "cloudformation_stack_arns.*", stackResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "terraform_state_file_urls.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccResilienceHubAppVersion_terraformStateFile(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_resiliencehub_app_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, resiliencehub.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAppDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppVersionConfig_terraformStateFile(rName, "v1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppVersionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "cloudformation_stack_arns.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "terraform_state_file_urls.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "terraform_state_file_urls.*", fmt.Sprintf("s3://%s/terraform.tfstate", rName)),
+					resource.TestCheckResourceAttr(resourceName, "version_name", "v1"),
+				),
+			},
+			{
+				Config: testAccAppVersionConfig_terraformStateFile(rName, "v2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppVersionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "version_name", "v2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAppVersionExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Resilience Hub App Version ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ResilienceHubConn
+
+		_, err := tfresiliencehub.FindLatestPublishedAppVersion(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccAppVersionConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudformation_stack" "test" {
+  name = %[1]q
+
+  template_body = jsonencode({
+    Resources = {
+      Queue = {
+        Type = "AWS::SQS::Queue"
+      }
+    }
+  })
+}
+
+resource "aws_resiliencehub_app" "test" {
+  name = %[1]q
+}
+
+resource "aws_resiliencehub_app_version" "test" {
+  app_arn                   = aws_resiliencehub_app.test.arn
+  cloudformation_stack_arns = [aws_cloudformation_stack.test.id]
+}
+`, rName)
+}
+
+func testAccAppVersionConfig_terraformStateFile(rName, versionName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_object" "test" {
+  bucket = aws_s3_bucket.test.id
+  key    = "terraform.tfstate"
+
+  content = jsonencode({
+    version           = 4
+    terraform_version = "1.3.0"
+    serial            = 1
+    lineage           = %[1]q
+    outputs           = {}
+    resources         = []
+  })
+}
+
+resource "aws_resiliencehub_app" "test" {
+  name = %[1]q
+}
+
+resource "aws_resiliencehub_app_version" "test" {
+  app_arn                   = aws_resiliencehub_app.test.arn
+  terraform_state_file_urls = ["s3://${aws_s3_object.test.bucket}/${aws_s3_object.test.key}"]
+  version_name              = %[2]q
+}
+`, rName, versionName)
+}
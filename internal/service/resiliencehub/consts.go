@@ -0,0 +1,13 @@
+package resiliencehub
+
+import (
+	"regexp"
+)
+
+const (
+	appVersionDraft = "draft"
+)
+
+var (
+	s3URLRegexp = regexp.MustCompile(`^s3://[a-z0-9][a-z0-9.\-]{1,61}[a-z0-9]/.+$`)
+)
@@ -0,0 +1,302 @@
+package resiliencehub
+
+import (
+	"context"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/resiliencehub"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceResiliencyPolicy() *schema.Resource {
+	failurePolicySchema := func(required bool) *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Required: required,
+			Optional: !required,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"rpo_in_secs": {
+						Type:         schema.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntAtLeast(0),
+					},
+					"rto_in_secs": {
+						Type:         schema.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntAtLeast(0),
+					},
+				},
+			},
+		}
+	}
+
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceResiliencyPolicyCreate,
+		ReadWithoutTimeout:   resourceResiliencyPolicyRead,
+		UpdateWithoutTimeout: resourceResiliencyPolicyUpdate,
+		DeleteWithoutTimeout: resourceResiliencyPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"data_location_constraint": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(resiliencehub.DataLocationConstraint_Values(), false),
+			},
+			"estimated_cost_tier": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"policy": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"az":       failurePolicySchema(true),
+						"hardware": failurePolicySchema(true),
+						"region":   failurePolicySchema(false),
+						"software": failurePolicySchema(true),
+					},
+				},
+			},
+			"policy_description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 500),
+			},
+			"policy_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(2, 60),
+					validation.StringMatch(regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_\-]+$`), "must begin with a letter or number and contain only letters, numbers, underscores and hyphens"),
+				),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"tier": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(resiliencehub.ResiliencyPolicyTier_Values(), false),
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceResiliencyPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ResilienceHubConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("policy_name").(string)
+	input := &resiliencehub.CreateResiliencyPolicyInput{
+		Policy:     expandFailurePolicies(d.Get("policy").([]interface{})),
+		PolicyName: aws.String(name),
+		Tier:       aws.String(d.Get("tier").(string)),
+	}
+
+	if v, ok := d.GetOk("data_location_constraint"); ok {
+		input.DataLocationConstraint = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("policy_description"); ok {
+		input.PolicyDescription = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateResiliencyPolicyWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Resilience Hub Resiliency Policy (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Policy.PolicyArn))
+
+	return resourceResiliencyPolicyRead(ctx, d, meta)
+}
+
+func resourceResiliencyPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ResilienceHubConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	policy, err := FindResiliencyPolicyByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Resilience Hub Resiliency Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Resilience Hub Resiliency Policy (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", policy.PolicyArn)
+	d.Set("data_location_constraint", policy.DataLocationConstraint)
+	d.Set("estimated_cost_tier", policy.EstimatedCostTier)
+	if err := d.Set("policy", flattenFailurePolicies(policy.Policy)); err != nil {
+		return diag.Errorf("setting policy: %s", err)
+	}
+	d.Set("policy_description", policy.PolicyDescription)
+	d.Set("policy_name", policy.PolicyName)
+	d.Set("tier", policy.Tier)
+
+	tags := KeyValueTags(policy.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceResiliencyPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ResilienceHubConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &resiliencehub.UpdateResiliencyPolicyInput{
+			PolicyArn: aws.String(d.Id()),
+		}
+
+		if d.HasChange("data_location_constraint") {
+			input.DataLocationConstraint = aws.String(d.Get("data_location_constraint").(string))
+		}
+
+		if d.HasChange("policy") {
+			input.Policy = expandFailurePolicies(d.Get("policy").([]interface{}))
+		}
+
+		if d.HasChange("policy_description") {
+			input.PolicyDescription = aws.String(d.Get("policy_description").(string))
+		}
+
+		if d.HasChange("policy_name") {
+			input.PolicyName = aws.String(d.Get("policy_name").(string))
+		}
+
+		if d.HasChange("tier") {
+			input.Tier = aws.String(d.Get("tier").(string))
+		}
+
+		_, err := conn.UpdateResiliencyPolicyWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating Resilience Hub Resiliency Policy (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Id(), o, n); err != nil {
+			return diag.Errorf("updating Resilience Hub Resiliency Policy (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceResiliencyPolicyRead(ctx, d, meta)
+}
+
+func resourceResiliencyPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ResilienceHubConn
+
+	log.Printf("[DEBUG] Deleting Resilience Hub Resiliency Policy: %s", d.Id())
+	_, err := conn.DeleteResiliencyPolicyWithContext(ctx, &resiliencehub.DeleteResiliencyPolicyInput{
+		PolicyArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, resiliencehub.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Resilience Hub Resiliency Policy (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// Maps each Terraform block name to the disruption type it configures.
+var failurePolicyDisruptionTypes = map[string]string{
+	"az":       resiliencehub.DisruptionTypeAz,
+	"hardware": resiliencehub.DisruptionTypeHardware,
+	"region":   resiliencehub.DisruptionTypeRegion,
+	"software": resiliencehub.DisruptionTypeSoftware,
+}
+
+func expandFailurePolicies(tfList []interface{}) map[string]*resiliencehub.FailurePolicy {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := make(map[string]*resiliencehub.FailurePolicy)
+
+	for k, disruptionType := range failurePolicyDisruptionTypes {
+		if v, ok := tfMap[k].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject[disruptionType] = expandFailurePolicy(v[0].(map[string]interface{}))
+		}
+	}
+
+	return apiObject
+}
+
+func expandFailurePolicy(tfMap map[string]interface{}) *resiliencehub.FailurePolicy {
+	if tfMap == nil {
+		return nil
+	}
+
+	return &resiliencehub.FailurePolicy{
+		RpoInSecs: aws.Int64(int64(tfMap["rpo_in_secs"].(int))),
+		RtoInSecs: aws.Int64(int64(tfMap["rto_in_secs"].(int))),
+	}
+}
+
+func flattenFailurePolicies(apiObject map[string]*resiliencehub.FailurePolicy) []interface{} {
+	if len(apiObject) == 0 {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	for k, disruptionType := range failurePolicyDisruptionTypes {
+		if v, ok := apiObject[disruptionType]; ok && v != nil {
+			tfMap[k] = []interface{}{map[string]interface{}{
+				"rpo_in_secs": aws.Int64Value(v.RpoInSecs),
+				"rto_in_secs": aws.Int64Value(v.RtoInSecs),
+			}}
+		}
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,42 @@
+package resiliencehub
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/resiliencehub"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusApp(ctx context.Context, conn *resiliencehub.ResilienceHub, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindAppByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func statusDraftAppVersionResourcesImport(ctx context.Context, conn *resiliencehub.ResilienceHub, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindDraftAppVersionResourcesImportStatus(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
@@ -0,0 +1,116 @@
+//go:build sweep
+// +build sweep
+
+package resiliencehub
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/resiliencehub"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_resiliencehub_app", &resource.Sweeper{
+		Name: "aws_resiliencehub_app",
+		F:    sweepApps,
+	})
+
+	resource.AddTestSweepers("aws_resiliencehub_resiliency_policy", &resource.Sweeper{
+		Name: "aws_resiliencehub_resiliency_policy",
+		F:    sweepResiliencyPolicies,
+		Dependencies: []string{
+			"aws_resiliencehub_app",
+		},
+	})
+}
+
+func sweepApps(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).ResilienceHubConn
+	input := &resiliencehub.ListAppsInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListAppsPages(input, func(page *resiliencehub.ListAppsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.AppSummaries {
+			r := ResourceApp()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.AppArn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Resilience Hub App sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Resilience Hub Apps (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Resilience Hub Apps (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepResiliencyPolicies(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).ResilienceHubConn
+	input := &resiliencehub.ListResiliencyPoliciesInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListResiliencyPoliciesPages(input, func(page *resiliencehub.ListResiliencyPoliciesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ResiliencyPolicies {
+			r := ResourceResiliencyPolicy()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.PolicyArn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Resilience Hub Resiliency Policy sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Resilience Hub Resiliency Policies (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Resilience Hub Resiliency Policies (%s): %w", region, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package resiliencehub
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/resiliencehub"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func waitAppDeleted(ctx context.Context, conn *resiliencehub.ResilienceHub, arn string, timeout time.Duration) (*resiliencehub.App, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{resiliencehub.AppStatusTypeActive, resiliencehub.AppStatusTypeDeleting},
+		Target:  []string{},
+		Refresh: statusApp(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*resiliencehub.App); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDraftAppVersionResourcesImported(ctx context.Context, conn *resiliencehub.ResilienceHub, arn string, timeout time.Duration) (*resiliencehub.DescribeDraftAppVersionResourcesImportStatusOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{resiliencehub.ResourceImportStatusTypePending, resiliencehub.ResourceImportStatusTypeInProgress},
+		Target:  []string{resiliencehub.ResourceImportStatusTypeSuccess},
+		Refresh: statusDraftAppVersionResourcesImport(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*resiliencehub.DescribeDraftAppVersionResourcesImportStatusOutput); ok {
+		if status := aws.StringValue(output.Status); status == resiliencehub.ResourceImportStatusTypeFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.ErrorMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
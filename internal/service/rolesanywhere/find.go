@@ -7,6 +7,8 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/rolesanywhere"
 	"github.com/aws/aws-sdk-go-v2/service/rolesanywhere/types"
+	rolesanywherev1 "github.com/aws/aws-sdk-go/service/rolesanywhere"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
@@ -62,3 +64,53 @@ func FindTrustAnchorByID(ctx context.Context, conn *rolesanywhere.Client, id str
 
 	return out.TrustAnchor, nil
 }
+
+func findProfileV1ByID(ctx context.Context, conn *rolesanywherev1.RolesAnywhere, id string) (*rolesanywherev1.ProfileDetail, error) {
+	in := &rolesanywherev1.GetProfileInput{
+		ProfileId: aws.String(id),
+	}
+
+	out, err := conn.GetProfileWithContext(ctx, in)
+
+	if tfawserr.ErrCodeEquals(err, rolesanywherev1.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.Profile == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out.Profile, nil
+}
+
+func findTrustAnchorV1ByID(ctx context.Context, conn *rolesanywherev1.RolesAnywhere, id string) (*rolesanywherev1.TrustAnchorDetail, error) {
+	in := &rolesanywherev1.GetTrustAnchorInput{
+		TrustAnchorId: aws.String(id),
+	}
+
+	out, err := conn.GetTrustAnchorWithContext(ctx, in)
+
+	if tfawserr.ErrCodeEquals(err, rolesanywherev1.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.TrustAnchor == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out.TrustAnchor, nil
+}
@@ -3,13 +3,16 @@ package rolesanywhere
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/service/rolesanywhere"
 	"github.com/aws/aws-sdk-go-v2/service/rolesanywhere/types"
 	"github.com/aws/aws-sdk-go/aws"
+	rolesanywherev1 "github.com/aws/aws-sdk-go/service/rolesanywhere"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -31,6 +34,31 @@ func ResourceProfile() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"attribute_mapping": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"certificate_field": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(rolesanywherev1.CertificateField_Values(), false),
+						},
+						"mapping_rule": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"specifier": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"duration_seconds": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -114,6 +142,12 @@ func resourceProfileCreate(ctx context.Context, d *schema.ResourceData, meta int
 
 	d.SetId(aws.StringValue(output.Profile.ProfileId))
 
+	if v, ok := d.GetOk("attribute_mapping"); ok && v.(*schema.Set).Len() > 0 {
+		if err := putAttributeMappings(ctx, meta.(*conns.AWSClient).RolesAnywhereV1Conn, d.Id(), v.(*schema.Set).List()); err != nil {
+			return diag.Errorf("creating RolesAnywhere Profile (%s) attribute mappings: %s", d.Id(), err)
+		}
+	}
+
 	return resourceProfileRead(ctx, d, meta)
 }
 
@@ -143,6 +177,16 @@ func resourceProfileRead(ctx context.Context, d *schema.ResourceData, meta inter
 	d.Set("role_arns", profile.RoleArns)
 	d.Set("session_policy", profile.SessionPolicy)
 
+	profileV1, err := findProfileV1ByID(ctx, meta.(*conns.AWSClient).RolesAnywhereV1Conn, d.Id())
+
+	if err != nil {
+		return diag.Errorf("reading RolesAnywhere Profile (%s) attribute mappings: %s", d.Id(), err)
+	}
+
+	if err := d.Set("attribute_mapping", flattenAttributeMappings(profileV1.AttributeMappings, configuredCertificateFields(d))); err != nil {
+		return diag.Errorf("setting attribute_mapping: %s", err)
+	}
+
 	tags, err := ListTags(ctx, conn, d.Get("arn").(string))
 	if err != nil {
 		return diag.Errorf("listing tags for RolesAnywhere Profile (%s): %s", d.Id(), err)
@@ -165,7 +209,7 @@ func resourceProfileRead(ctx context.Context, d *schema.ResourceData, meta inter
 func resourceProfileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).RolesAnywhereConn
 
-	if d.HasChangesExcept("enabled", "tags_all") {
+	if d.HasChangesExcept("attribute_mapping", "enabled", "tags_all") {
 		input := &rolesanywhere.UpdateProfileInput{
 			ProfileId: aws.String(d.Id()),
 		}
@@ -197,6 +241,12 @@ func resourceProfileUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 	}
 
+	if d.HasChange("attribute_mapping") {
+		if err := updateAttributeMappings(ctx, meta.(*conns.AWSClient).RolesAnywhereV1Conn, d); err != nil {
+			return diag.Errorf("updating RolesAnywhere Profile (%s) attribute mappings: %s", d.Id(), err)
+		}
+	}
+
 	if d.HasChange("enabled") {
 		_, n := d.GetChange("enabled")
 		if n == "true" {
@@ -263,3 +313,167 @@ func enableProfile(ctx context.Context, profileId string, meta interface{}) erro
 	_, err := conn.EnableProfile(ctx, input)
 	return err
 }
+
+func putAttributeMappings(ctx context.Context, conn *rolesanywherev1.RolesAnywhere, profileID string, tfList []interface{}) error {
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		input := &rolesanywherev1.PutAttributeMappingInput{
+			CertificateField: aws.String(tfMap["certificate_field"].(string)),
+			MappingRules:     expandMappingRules(tfMap["mapping_rule"].(*schema.Set).List()),
+			ProfileId:        aws.String(profileID),
+		}
+
+		log.Printf("[DEBUG] Putting RolesAnywhere Profile attribute mapping: %s", input)
+		if _, err := conn.PutAttributeMappingWithContext(ctx, input); err != nil {
+			return fmt.Errorf("putting attribute mapping (%s): %w", aws.StringValue(input.CertificateField), err)
+		}
+	}
+
+	return nil
+}
+
+// defaultAttributeMappingSpecifiers are the mapping rule specifiers of the
+// attribute mappings that profiles are created with for each certificate field.
+var defaultAttributeMappingSpecifiers = map[string][]string{
+	rolesanywherev1.CertificateFieldX509subject: {"C", "CN", "L", "O", "OU", "ST"},
+	rolesanywherev1.CertificateFieldX509issuer:  {"C", "CN", "L", "O", "OU", "ST"},
+	rolesanywherev1.CertificateFieldX509san:     {"DNS", "Name", "URI"},
+}
+
+// updateAttributeMappings restores the default mappings for certificate fields
+// no longer configured and puts the mappings for new or changed certificate fields.
+func updateAttributeMappings(ctx context.Context, conn *rolesanywherev1.RolesAnywhere, d *schema.ResourceData) error {
+	o, n := d.GetChange("attribute_mapping")
+	os, ns := o.(*schema.Set), n.(*schema.Set)
+
+	newFields := make(map[string]bool)
+	for _, tfMapRaw := range ns.List() {
+		newFields[tfMapRaw.(map[string]interface{})["certificate_field"].(string)] = true
+	}
+
+	for _, tfMapRaw := range os.List() {
+		certificateField := tfMapRaw.(map[string]interface{})["certificate_field"].(string)
+
+		if newFields[certificateField] {
+			continue
+		}
+
+		var mappingRules []*rolesanywherev1.MappingRule
+
+		for _, v := range defaultAttributeMappingSpecifiers[certificateField] {
+			mappingRules = append(mappingRules, &rolesanywherev1.MappingRule{
+				Specifier: aws.String(v),
+			})
+		}
+
+		input := &rolesanywherev1.PutAttributeMappingInput{
+			CertificateField: aws.String(certificateField),
+			MappingRules:     mappingRules,
+			ProfileId:        aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Restoring RolesAnywhere Profile default attribute mapping: %s", input)
+		if _, err := conn.PutAttributeMappingWithContext(ctx, input); err != nil {
+			return fmt.Errorf("restoring default attribute mapping (%s): %w", certificateField, err)
+		}
+	}
+
+	return putAttributeMappings(ctx, conn, d.Id(), ns.Difference(os).List())
+}
+
+// configuredCertificateFields returns the certificate fields with attribute
+// mappings in configuration. Profiles are created with default attribute
+// mappings for every certificate field; only configured fields and fields
+// whose mappings differ from the defaults are tracked.
+func configuredCertificateFields(d *schema.ResourceData) map[string]bool {
+	fields := make(map[string]bool)
+
+	for _, tfMapRaw := range d.Get("attribute_mapping").(*schema.Set).List() {
+		if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+			fields[tfMap["certificate_field"].(string)] = true
+		}
+	}
+
+	return fields
+}
+
+// isDefaultAttributeMapping returns whether the attribute mapping has the
+// mapping rules that profiles are created with for its certificate field.
+func isDefaultAttributeMapping(apiObject *rolesanywherev1.AttributeMapping) bool {
+	defaults, ok := defaultAttributeMappingSpecifiers[aws.StringValue(apiObject.CertificateField)]
+
+	if !ok || len(apiObject.MappingRules) != len(defaults) {
+		return false
+	}
+
+	specifiers := make(map[string]bool)
+	for _, v := range apiObject.MappingRules {
+		if v != nil {
+			specifiers[aws.StringValue(v.Specifier)] = true
+		}
+	}
+
+	for _, v := range defaults {
+		if !specifiers[v] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func expandMappingRules(tfList []interface{}) []*rolesanywherev1.MappingRule {
+	var apiObjects []*rolesanywherev1.MappingRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &rolesanywherev1.MappingRule{
+			Specifier: aws.String(tfMap["specifier"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenAttributeMappings(apiObjects []*rolesanywherev1.AttributeMapping, certificateFields map[string]bool) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		if !certificateFields[aws.StringValue(apiObject.CertificateField)] && isDefaultAttributeMapping(apiObject) {
+			continue
+		}
+
+		var mappingRules []interface{}
+
+		for _, v := range apiObject.MappingRules {
+			if v == nil {
+				continue
+			}
+
+			mappingRules = append(mappingRules, map[string]interface{}{
+				"specifier": aws.StringValue(v.Specifier),
+			})
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"certificate_field": aws.StringValue(apiObject.CertificateField),
+			"mapping_rule":      mappingRules,
+		})
+	}
+
+	return tfList
+}
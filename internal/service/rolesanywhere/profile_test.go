@@ -115,6 +115,56 @@ func TestAccRolesAnywhereProfile_disappears(t *testing.T) {
 	})
 }
 
+func TestAccRolesAnywhereProfile_attributeMapping(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	roleName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rolesanywhere_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RolesAnywhereEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProfileConfig_attributeMapping1(rName, roleName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProfileExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "attribute_mapping.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "attribute_mapping.*", map[string]string{
+						"certificate_field": "x509Subject",
+						"mapping_rule.#":    "1",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "attribute_mapping.*.mapping_rule.*", map[string]string{
+						"specifier": "CN",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccProfileConfig_attributeMapping2(rName, roleName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProfileExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "attribute_mapping.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "attribute_mapping.*", map[string]string{
+						"certificate_field": "x509Issuer",
+						"mapping_rule.#":    "2",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckProfileDestroy(s *terraform.State) error {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).RolesAnywhereConn
 
@@ -225,3 +275,45 @@ resource "aws_rolesanywhere_profile" "test" {
 }
 `, rName, tag1, value1, tag2, value2))
 }
+
+func testAccProfileConfig_attributeMapping1(rName, roleName string) string {
+	return acctest.ConfigCompose(
+		testAccProfileConfig_base(roleName),
+		fmt.Sprintf(`
+resource "aws_rolesanywhere_profile" "test" {
+  name      = %[1]q
+  role_arns = [aws_iam_role.test.arn]
+
+  attribute_mapping {
+    certificate_field = "x509Subject"
+
+    mapping_rule {
+      specifier = "CN"
+    }
+  }
+}
+`, rName))
+}
+
+func testAccProfileConfig_attributeMapping2(rName, roleName string) string {
+	return acctest.ConfigCompose(
+		testAccProfileConfig_base(roleName),
+		fmt.Sprintf(`
+resource "aws_rolesanywhere_profile" "test" {
+  name      = %[1]q
+  role_arns = [aws_iam_role.test.arn]
+
+  attribute_mapping {
+    certificate_field = "x509Issuer"
+
+    mapping_rule {
+      specifier = "CN"
+    }
+
+    mapping_rule {
+      specifier = "O"
+    }
+  }
+}
+`, rName))
+}
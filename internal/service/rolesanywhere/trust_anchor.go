@@ -3,15 +3,18 @@ package rolesanywhere
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/service/rolesanywhere"
 	"github.com/aws/aws-sdk-go-v2/service/rolesanywhere/types"
 	"github.com/aws/aws-sdk-go/aws"
+	rolesanywherev1 "github.com/aws/aws-sdk-go/service/rolesanywhere"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -42,6 +45,40 @@ func ResourceTrustAnchor() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"notification_settings": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Set:      notificationSettingHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"channel": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      rolesanywherev1.NotificationChannelAll,
+							ValidateFunc: validation.StringInSlice(rolesanywherev1.NotificationChannel_Values(), false),
+						},
+						"configured_by": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"event": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(rolesanywherev1.NotificationEvent_Values(), false),
+						},
+						"threshold": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(1, 360),
+						},
+					},
+				},
+			},
 			"source": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -106,6 +143,17 @@ func resourceTrustAnchorCreate(ctx context.Context, d *schema.ResourceData, meta
 
 	d.SetId(aws.StringValue(output.TrustAnchor.TrustAnchorId))
 
+	if v, ok := d.GetOk("notification_settings"); ok && v.(*schema.Set).Len() > 0 {
+		input := &rolesanywherev1.PutNotificationSettingsInput{
+			NotificationSettings: expandNotificationSettings(v.(*schema.Set).List()),
+			TrustAnchorId:        aws.String(d.Id()),
+		}
+
+		if _, err := meta.(*conns.AWSClient).RolesAnywhereV1Conn.PutNotificationSettingsWithContext(ctx, input); err != nil {
+			return diag.Errorf("putting RolesAnywhere Trust Anchor (%s) notification settings: %s", d.Id(), err)
+		}
+	}
+
 	return resourceTrustAnchorRead(ctx, d, meta)
 }
 
@@ -134,6 +182,16 @@ func resourceTrustAnchorRead(ctx context.Context, d *schema.ResourceData, meta i
 		return diag.Errorf("setting source: %s", err)
 	}
 
+	trustAnchorV1, err := findTrustAnchorV1ByID(ctx, meta.(*conns.AWSClient).RolesAnywhereV1Conn, d.Id())
+
+	if err != nil {
+		return diag.Errorf("reading RolesAnywhere Trust Anchor (%s) notification settings: %s", d.Id(), err)
+	}
+
+	if err := d.Set("notification_settings", flattenNotificationSettingDetails(trustAnchorV1.NotificationSettings)); err != nil {
+		return diag.Errorf("setting notification_settings: %s", err)
+	}
+
 	tags, err := ListTags(ctx, conn, d.Get("arn").(string))
 
 	if err != nil {
@@ -157,7 +215,7 @@ func resourceTrustAnchorRead(ctx context.Context, d *schema.ResourceData, meta i
 func resourceTrustAnchorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).RolesAnywhereConn
 
-	if d.HasChangesExcept("tags", "tags_all") {
+	if d.HasChangesExcept("notification_settings", "tags", "tags_all") {
 		input := &rolesanywhere.UpdateTrustAnchorInput{
 			TrustAnchorId: aws.String(d.Id()),
 			Name:          aws.String(d.Get("name").(string)),
@@ -185,6 +243,12 @@ func resourceTrustAnchorUpdate(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 
+	if d.HasChange("notification_settings") {
+		if err := updateNotificationSettings(ctx, meta.(*conns.AWSClient).RolesAnywhereV1Conn, d); err != nil {
+			return diag.Errorf("updating RolesAnywhere Trust Anchor (%s) notification settings: %s", d.Id(), err)
+		}
+	}
+
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 		if err := UpdateTags(ctx, conn, d.Get("arn").(string), o, n); err != nil {
@@ -327,3 +391,115 @@ func trustAnchorTypeValues(input ...types.TrustAnchorType) []string {
 
 	return output
 }
+
+// notificationSettingsConfiguredByService is the principal of the default
+// notification settings that IAM Roles Anywhere applies to every trust anchor.
+const notificationSettingsConfiguredByService = "rolesanywhere.amazonaws.com"
+
+// updateNotificationSettings resets the notification settings no longer
+// configured to their defaults and puts the new or changed settings.
+func updateNotificationSettings(ctx context.Context, conn *rolesanywherev1.RolesAnywhere, d *schema.ResourceData) error {
+	o, n := d.GetChange("notification_settings")
+	os, ns := o.(*schema.Set), n.(*schema.Set)
+
+	var keys []*rolesanywherev1.NotificationSettingKey
+
+	for _, tfMapRaw := range os.List() {
+		if ns.Contains(tfMapRaw) {
+			continue
+		}
+
+		tfMap := tfMapRaw.(map[string]interface{})
+		keys = append(keys, &rolesanywherev1.NotificationSettingKey{
+			Channel: aws.String(tfMap["channel"].(string)),
+			Event:   aws.String(tfMap["event"].(string)),
+		})
+	}
+
+	if len(keys) > 0 {
+		input := &rolesanywherev1.ResetNotificationSettingsInput{
+			NotificationSettingKeys: keys,
+			TrustAnchorId:           aws.String(d.Id()),
+		}
+
+		if _, err := conn.ResetNotificationSettingsWithContext(ctx, input); err != nil {
+			return fmt.Errorf("resetting notification settings: %w", err)
+		}
+	}
+
+	if ns.Len() > 0 {
+		input := &rolesanywherev1.PutNotificationSettingsInput{
+			NotificationSettings: expandNotificationSettings(ns.List()),
+			TrustAnchorId:        aws.String(d.Id()),
+		}
+
+		if _, err := conn.PutNotificationSettingsWithContext(ctx, input); err != nil {
+			return fmt.Errorf("putting notification settings: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// notificationSettingHash identifies a notification setting by its event and channel.
+func notificationSettingHash(v interface{}) int {
+	tfMap := v.(map[string]interface{})
+	channel, _ := tfMap["channel"].(string)
+
+	if channel == "" {
+		channel = rolesanywherev1.NotificationChannelAll
+	}
+
+	return create.StringHashcode(fmt.Sprintf("%s-%s", tfMap["event"].(string), channel))
+}
+
+func expandNotificationSettings(tfList []interface{}) []*rolesanywherev1.NotificationSetting {
+	var apiObjects []*rolesanywherev1.NotificationSetting
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &rolesanywherev1.NotificationSetting{
+			Enabled: aws.Bool(tfMap["enabled"].(bool)),
+			Event:   aws.String(tfMap["event"].(string)),
+		}
+
+		if v, ok := tfMap["channel"].(string); ok && v != "" {
+			apiObject.Channel = aws.String(v)
+		}
+
+		if v, ok := tfMap["threshold"].(int); ok && v != 0 {
+			apiObject.Threshold = aws.Int64(int64(v))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+// flattenNotificationSettingDetails returns the customized notification
+// settings, omitting the defaults configured by IAM Roles Anywhere.
+func flattenNotificationSettingDetails(apiObjects []*rolesanywherev1.NotificationSettingDetail) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil || aws.StringValue(apiObject.ConfiguredBy) == notificationSettingsConfiguredByService {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"channel":       aws.StringValue(apiObject.Channel),
+			"configured_by": aws.StringValue(apiObject.ConfiguredBy),
+			"enabled":       aws.BoolValue(apiObject.Enabled),
+			"event":         aws.StringValue(apiObject.Event),
+			"threshold":     int(aws.Int64Value(apiObject.Threshold)),
+		})
+	}
+
+	return tfList
+}
@@ -146,6 +146,59 @@ func TestAccRolesAnywhereTrustAnchor_certificateBundle(t *testing.T) {
 	})
 }
 
+func TestAccRolesAnywhereTrustAnchor_notificationSettings(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	caCommonName := acctest.RandomDomainName()
+	resourceName := "aws_rolesanywhere_trust_anchor.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RolesAnywhereEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTrustAnchorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTrustAnchorConfig_notificationSettings(rName, caCommonName, true, 60),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrustAnchorExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "notification_settings.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "notification_settings.*", map[string]string{
+						"channel":   "ALL",
+						"enabled":   "true",
+						"event":     "CA_CERTIFICATE_EXPIRY",
+						"threshold": "60",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "notification_settings.*.configured_by", "data.aws_caller_identity.current", "account_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTrustAnchorConfig_notificationSettings(rName, caCommonName, false, 30),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrustAnchorExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "notification_settings.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "notification_settings.*", map[string]string{
+						"enabled":   "false",
+						"event":     "CA_CERTIFICATE_EXPIRY",
+						"threshold": "30",
+					}),
+				),
+			},
+			{
+				Config: testAccTrustAnchorConfig_basic(rName, caCommonName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrustAnchorExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "notification_settings.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckTrustAnchorDestroy(s *terraform.State) error {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).RolesAnywhereConn
 
@@ -306,6 +359,32 @@ resource "aws_rolesanywhere_trust_anchor" "test" {
 `, rName, acctest.TLSPEMEscapeNewlines(caCertificate))
 }
 
+func testAccTrustAnchorConfig_notificationSettings(rName, caCommonName string, enabled bool, threshold int) string {
+	return acctest.ConfigCompose(
+		testAccTrustAnchorConfig_acmBase(caCommonName),
+		fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_rolesanywhere_trust_anchor" "test" {
+  name = %[1]q
+  source {
+    source_data {
+      acm_pca_arn = aws_acmpca_certificate_authority.test.arn
+    }
+    source_type = "AWS_ACM_PCA"
+  }
+
+  notification_settings {
+    enabled   = %[2]t
+    event     = "CA_CERTIFICATE_EXPIRY"
+    threshold = %[3]d
+  }
+
+  depends_on = [aws_acmpca_certificate_authority_certificate.test]
+}
+`, rName, enabled, threshold))
+}
+
 func testAccPreCheck(t *testing.T) {
 	acctest.PreCheckPartitionHasService(names.RolesAnywhereEndpointID, t)
 
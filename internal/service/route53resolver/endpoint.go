@@ -92,6 +92,21 @@ func ResourceEndpoint() *schema.Resource {
 				ValidateFunc: validResolverName,
 			},
 
+			"outpost_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+				RequiredWith: []string{"preferred_instance_type"},
+			},
+
+			"preferred_instance_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"outpost_arn"},
+			},
+
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
 
@@ -130,6 +145,12 @@ func resourceEndpointCreate(d *schema.ResourceData, meta interface{}) error {
 	if v, ok := d.GetOk("name"); ok {
 		req.Name = aws.String(v.(string))
 	}
+	if v, ok := d.GetOk("outpost_arn"); ok {
+		req.OutpostArn = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("preferred_instance_type"); ok {
+		req.PreferredInstanceType = aws.String(v.(string))
+	}
 	if v, ok := d.GetOk("tags"); ok && len(v.(map[string]interface{})) > 0 {
 		req.Tags = Tags(tags.IgnoreAWS())
 	}
@@ -172,6 +193,8 @@ func resourceEndpointRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("direction", ep.Direction)
 	d.Set("host_vpc_id", ep.HostVPCId)
 	d.Set("name", ep.Name)
+	d.Set("outpost_arn", ep.OutpostArn)
+	d.Set("preferred_instance_type", ep.PreferredInstanceType)
 	if err := d.Set("security_group_ids", flex.FlattenStringSet(ep.SecurityGroupIds)); err != nil {
 		return err
 	}
@@ -3,12 +3,14 @@ package route53resolver
 import (
 	"fmt"
 	"log"
+	"regexp"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/route53resolver"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -39,10 +41,23 @@ func ResourceFirewallDomainList() *schema.Resource {
 			},
 
 			"domains": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				MinItems: 0,
-				Elem:     &schema.Schema{Type: schema.TypeString},
+				Type:          schema.TypeSet,
+				Optional:      true,
+				MinItems:      0,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"domain_file_url"},
+			},
+
+			"domain_file_url": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringMatch(regexp.MustCompile(`^s3://`), "must be an S3 URL (s3://bucket/key)"),
+				ConflictsWith: []string{"domains"},
+			},
+
+			"domain_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
 			},
 
 			"tags": tftags.TagsSchema(),
@@ -105,24 +120,28 @@ func resourceFirewallDomainListRead(d *schema.ResourceData, meta interface{}) er
 
 	arn := aws.StringValue(firewallDomainList.Arn)
 	d.Set("arn", arn)
+	d.Set("domain_count", firewallDomainList.DomainCount)
 	d.Set("name", firewallDomainList.Name)
 
-	input := &route53resolver.ListFirewallDomainsInput{
-		FirewallDomainListId: aws.String(d.Id()),
-	}
+	// Domains imported from a file are managed through the file, not the domains argument.
+	if d.Get("domain_file_url").(string) == "" {
+		input := &route53resolver.ListFirewallDomainsInput{
+			FirewallDomainListId: aws.String(d.Id()),
+		}
 
-	domains := []*string{}
+		domains := []*string{}
 
-	err = conn.ListFirewallDomainsPages(input, func(output *route53resolver.ListFirewallDomainsOutput, lastPage bool) bool {
-		domains = append(domains, output.Domains...)
-		return !lastPage
-	})
+		err = conn.ListFirewallDomainsPages(input, func(output *route53resolver.ListFirewallDomainsOutput, lastPage bool) bool {
+			domains = append(domains, output.Domains...)
+			return !lastPage
+		})
 
-	if err != nil {
-		return fmt.Errorf("error listing Route 53 Resolver DNS Firewall domain list (%s) domains: %w", d.Id(), err)
-	}
+		if err != nil {
+			return fmt.Errorf("error listing Route 53 Resolver DNS Firewall domain list (%s) domains: %w", d.Id(), err)
+		}
 
-	d.Set("domains", flex.FlattenStringSet(domains))
+		d.Set("domains", flex.FlattenStringSet(domains))
+	}
 
 	tags, err := ListTags(conn, arn)
 	if err != nil {
@@ -182,6 +201,24 @@ func resourceFirewallDomainListUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
+	if v, ok := d.GetOk("domain_file_url"); ok && d.HasChange("domain_file_url") {
+		_, err := conn.ImportFirewallDomains(&route53resolver.ImportFirewallDomainsInput{
+			DomainFileUrl:        aws.String(v.(string)),
+			FirewallDomainListId: aws.String(d.Id()),
+			Operation:            aws.String(route53resolver.FirewallDomainImportOperationReplace),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error importing Route 53 Resolver DNS Firewall domain list (%s) domains from %s: %w", d.Id(), v.(string), err)
+		}
+
+		_, err = WaitFirewallDomainListUpdated(conn, d.Id())
+
+		if err != nil {
+			return fmt.Errorf("error waiting for Route 53 Resolver DNS Firewall domain list (%s) domains to be imported: %w", d.Id(), err)
+		}
+	}
+
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
@@ -91,6 +91,36 @@ func TestAccRoute53ResolverFirewallDomainList_domains(t *testing.T) {
 	})
 }
 
+func TestAccRoute53ResolverFirewallDomainList_domainFileURL(t *testing.T) {
+	var v route53resolver.FirewallDomainList
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_route53_resolver_firewall_domain_list.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, route53resolver.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFirewallDomainListDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirewallDomainListConfig_domainFileURL(rName, "example.com\nexample.org\n"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFirewallDomainListExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "domain_count", "2"),
+					resource.TestCheckResourceAttr(resourceName, "domain_file_url", fmt.Sprintf("s3://%s/domains.txt", rName)),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"domain_file_url", "domains"},
+			},
+		},
+	})
+}
+
 func TestAccRoute53ResolverFirewallDomainList_disappears(t *testing.T) {
 	var v route53resolver.FirewallDomainList
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -225,6 +255,25 @@ resource "aws_route53_resolver_firewall_domain_list" "test" {
 `, rName, domain)
 }
 
+func testAccFirewallDomainListConfig_domainFileURL(rName, content string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.test.id
+  key     = "domains.txt"
+  content = %[2]q
+}
+
+resource "aws_route53_resolver_firewall_domain_list" "test" {
+  name            = %[1]q
+  domain_file_url = "s3://${aws_s3_object.test.bucket}/${aws_s3_object.test.key}"
+}
+`, rName, content)
+}
+
 func testAccFirewallDomainListConfig_tags1(rName, tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`
 resource "aws_route53_resolver_firewall_domain_list" "test" {
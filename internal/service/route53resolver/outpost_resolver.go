@@ -0,0 +1,237 @@
+package route53resolver
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53resolver"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	outpostResolverCreatedDefaultTimeout = 30 * time.Minute
+	outpostResolverUpdatedDefaultTimeout = 30 * time.Minute
+	outpostResolverDeletedDefaultTimeout = 30 * time.Minute
+)
+
+func ResourceOutpostResolver() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceOutpostResolverCreate,
+		Read:   resourceOutpostResolverRead,
+		Update: resourceOutpostResolverUpdate,
+		Delete: resourceOutpostResolverDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(outpostResolverCreatedDefaultTimeout),
+			Update: schema.DefaultTimeout(outpostResolverUpdatedDefaultTimeout),
+			Delete: schema.DefaultTimeout(outpostResolverDeletedDefaultTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"instance_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validResolverName,
+			},
+
+			"outpost_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+
+			"preferred_instance_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"status_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceOutpostResolverCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).Route53ResolverConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &route53resolver.CreateOutpostResolverInput{
+		CreatorRequestId:      aws.String(resource.PrefixedUniqueId("tf-r53-resolver-outpost-resolver-")),
+		Name:                  aws.String(d.Get("name").(string)),
+		OutpostArn:            aws.String(d.Get("outpost_arn").(string)),
+		PreferredInstanceType: aws.String(d.Get("preferred_instance_type").(string)),
+	}
+
+	if v, ok := d.GetOk("instance_count"); ok {
+		input.InstanceCount = aws.Int64(int64(v.(int)))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Route 53 Resolver on Outposts resolver: %s", input)
+	output, err := conn.CreateOutpostResolver(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Route 53 Resolver on Outposts resolver: %w", err)
+	}
+
+	d.SetId(aws.StringValue(output.OutpostResolver.Id))
+
+	if _, err := WaitOutpostResolverCreated(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Route 53 Resolver on Outposts resolver (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceOutpostResolverRead(d, meta)
+}
+
+func resourceOutpostResolverRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).Route53ResolverConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	outpostResolver, err := FindOutpostResolverByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Route 53 Resolver on Outposts resolver (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Route 53 Resolver on Outposts resolver (%s): %w", d.Id(), err)
+	}
+
+	arn := aws.StringValue(outpostResolver.Arn)
+	d.Set("arn", arn)
+	d.Set("instance_count", outpostResolver.InstanceCount)
+	d.Set("name", outpostResolver.Name)
+	d.Set("outpost_arn", outpostResolver.OutpostArn)
+	d.Set("preferred_instance_type", outpostResolver.PreferredInstanceType)
+	d.Set("status", outpostResolver.Status)
+	d.Set("status_message", outpostResolver.StatusMessage)
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Route 53 Resolver on Outposts resolver (%s): %w", arn, err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceOutpostResolverUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).Route53ResolverConn
+
+	if d.HasChanges("instance_count", "name", "preferred_instance_type") {
+		input := &route53resolver.UpdateOutpostResolverInput{
+			Id: aws.String(d.Id()),
+		}
+
+		if d.HasChange("instance_count") {
+			input.InstanceCount = aws.Int64(int64(d.Get("instance_count").(int)))
+		}
+
+		if d.HasChange("name") {
+			input.Name = aws.String(d.Get("name").(string))
+		}
+
+		if d.HasChange("preferred_instance_type") {
+			input.PreferredInstanceType = aws.String(d.Get("preferred_instance_type").(string))
+		}
+
+		log.Printf("[DEBUG] Updating Route 53 Resolver on Outposts resolver: %s", input)
+		_, err := conn.UpdateOutpostResolver(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Route 53 Resolver on Outposts resolver (%s): %w", d.Id(), err)
+		}
+
+		if _, err := WaitOutpostResolverUpdated(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for Route 53 Resolver on Outposts resolver (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Route 53 Resolver on Outposts resolver (%s) tags: %w", d.Get("arn").(string), err)
+		}
+	}
+
+	return resourceOutpostResolverRead(d, meta)
+}
+
+func resourceOutpostResolverDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).Route53ResolverConn
+
+	log.Printf("[DEBUG] Deleting Route 53 Resolver on Outposts resolver: %s", d.Id())
+	_, err := conn.DeleteOutpostResolver(&route53resolver.DeleteOutpostResolverInput{
+		Id: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, route53resolver.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Route 53 Resolver on Outposts resolver (%s): %w", d.Id(), err)
+	}
+
+	if _, err := WaitOutpostResolverDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Route 53 Resolver on Outposts resolver (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
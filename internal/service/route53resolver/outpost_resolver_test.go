@@ -0,0 +1,137 @@
+package route53resolver_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/route53resolver"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfroute53resolver "github.com/hashicorp/terraform-provider-aws/internal/service/route53resolver"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccRoute53ResolverOutpostResolver_basic(t *testing.T) {
+	var v route53resolver.OutpostResolver
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_route53_resolver_outpost_resolver.test"
+	outpostDataSourceName := "data.aws_outposts_outpost.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t); acctest.PreCheckOutpostsOutposts(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, route53resolver.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOutpostResolverDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOutpostResolverConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOutpostResolverExists(resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "instance_count", "4"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "outpost_arn", outpostDataSourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "status", route53resolver.OutpostResolverStatusOperational),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccRoute53ResolverOutpostResolver_disappears(t *testing.T) {
+	var v route53resolver.OutpostResolver
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_route53_resolver_outpost_resolver.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t); acctest.PreCheckOutpostsOutposts(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, route53resolver.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOutpostResolverDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOutpostResolverConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOutpostResolverExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfroute53resolver.ResourceOutpostResolver(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckOutpostResolverDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).Route53ResolverConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_route53_resolver_outpost_resolver" {
+			continue
+		}
+
+		_, err := tfroute53resolver.FindOutpostResolverByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Route 53 Resolver on Outposts resolver still exists: %s", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckOutpostResolverExists(n string, v *route53resolver.OutpostResolver) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Route 53 Resolver on Outposts resolver ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53ResolverConn
+
+		output, err := tfroute53resolver.FindOutpostResolverByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccOutpostResolverConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+data "aws_outposts_outposts" "test" {}
+
+data "aws_outposts_outpost" "test" {
+  id = tolist(data.aws_outposts_outposts.test.ids)[0]
+}
+
+resource "aws_route53_resolver_outpost_resolver" "test" {
+  name                    = %[1]q
+  outpost_arn             = data.aws_outposts_outpost.test.arn
+  preferred_instance_type = "m5.large"
+  instance_count          = 4
+}
+`, rName)
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/route53resolver"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -22,6 +23,8 @@ const (
 
 	resolverFirewallRuleGroupAssociationStatusNotFound = "NotFound"
 	resolverFirewallRuleGroupAssociationStatusUnknown  = "Unknown"
+
+	outpostResolverStatusNotFound = "NotFound"
 )
 
 // StatusQueryLogConfigAssociation fetches the QueryLogConfigAssociation and its Status
@@ -128,3 +131,20 @@ func StatusFirewallRuleGroupAssociation(conn *route53resolver.Route53Resolver, f
 		return firewallRuleGroupAssociation, aws.StringValue(firewallRuleGroupAssociation.Status), nil
 	}
 }
+
+// StatusOutpostResolver fetches the OutpostResolver and its Status
+func StatusOutpostResolver(conn *route53resolver.Route53Resolver, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		outpostResolver, err := FindOutpostResolverByID(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, outpostResolverStatusNotFound, nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return outpostResolver, aws.StringValue(outpostResolver.Status), nil
+	}
+}
@@ -1,12 +1,14 @@
 package route53resolver
 
 import (
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/route53resolver"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -250,3 +252,63 @@ func WaitFirewallRuleGroupAssociationDeleted(conn *route53resolver.Route53Resolv
 
 	return nil, err
 }
+
+// WaitOutpostResolverCreated waits for an OutpostResolver to return OPERATIONAL
+func WaitOutpostResolverCreated(conn *route53resolver.Route53Resolver, id string, timeout time.Duration) (*route53resolver.OutpostResolver, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{route53resolver.OutpostResolverStatusCreating},
+		Target:  []string{route53resolver.OutpostResolverStatusOperational},
+		Refresh: StatusOutpostResolver(conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*route53resolver.OutpostResolver); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(v.StatusMessage)))
+
+		return v, err
+	}
+
+	return nil, err
+}
+
+// WaitOutpostResolverUpdated waits for an OutpostResolver to return OPERATIONAL
+func WaitOutpostResolverUpdated(conn *route53resolver.Route53Resolver, id string, timeout time.Duration) (*route53resolver.OutpostResolver, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{route53resolver.OutpostResolverStatusUpdating},
+		Target:  []string{route53resolver.OutpostResolverStatusOperational},
+		Refresh: StatusOutpostResolver(conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*route53resolver.OutpostResolver); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(v.StatusMessage)))
+
+		return v, err
+	}
+
+	return nil, err
+}
+
+// WaitOutpostResolverDeleted waits for an OutpostResolver to be deleted
+func WaitOutpostResolverDeleted(conn *route53resolver.Route53Resolver, id string, timeout time.Duration) (*route53resolver.OutpostResolver, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{route53resolver.OutpostResolverStatusDeleting},
+		Target:  []string{},
+		Refresh: StatusOutpostResolver(conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*route53resolver.OutpostResolver); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(v.StatusMessage)))
+
+		return v, err
+	}
+
+	return nil, err
+}
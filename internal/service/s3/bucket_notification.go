@@ -1,8 +1,10 @@
 package s3
 
 import (
+	"bytes"
 	"fmt"
 	"log"
+	"sort"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -11,10 +13,17 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+const (
+	bucketNotificationKindLambdaFunction = "lambda"
+	bucketNotificationKindQueue          = "queue"
+	bucketNotificationKindTopic          = "topic"
+)
+
 func ResourceBucketNotification() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceBucketNotificationPut,
@@ -25,6 +34,15 @@ func ResourceBucketNotification() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourceBucketNotificationV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: BucketNotificationStateUpgradeV0,
+				Version: 0,
+			},
+		},
+
 		Schema: map[string]*schema.Schema{
 			"bucket": {
 				Type:     schema.TypeString,
@@ -39,8 +57,9 @@ func ResourceBucketNotification() *schema.Resource {
 			},
 
 			"topic": {
-				Type:     schema.TypeList,
+				Type:     schema.TypeSet,
 				Optional: true,
+				Set:      bucketNotificationConfigurationHash("topic_arn"),
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"id": {
@@ -71,8 +90,9 @@ func ResourceBucketNotification() *schema.Resource {
 			},
 
 			"queue": {
-				Type:     schema.TypeList,
+				Type:     schema.TypeSet,
 				Optional: true,
+				Set:      bucketNotificationConfigurationHash("queue_arn"),
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"id": {
@@ -103,8 +123,9 @@ func ResourceBucketNotification() *schema.Resource {
 			},
 
 			"lambda_function": {
-				Type:     schema.TypeList,
+				Type:     schema.TypeSet,
 				Optional: true,
+				Set:      bucketNotificationConfigurationHash("lambda_function_arn"),
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"id": {
@@ -141,182 +162,70 @@ func resourceBucketNotificationPut(d *schema.ResourceData, meta interface{}) err
 	conn := meta.(*conns.AWSClient).S3Conn
 	bucket := d.Get("bucket").(string)
 
-	// EventBridge
-	eventbridgeNotifications := d.Get("eventbridge").(bool)
-	var eventbridgeConfig *s3.EventBridgeConfiguration
-	if eventbridgeNotifications {
-		eventbridgeConfig = &s3.EventBridgeConfiguration{}
-	}
+	// The notification configuration is a single document per bucket.
+	// Only replace the configurations managed by this resource so that
+	// configurations created outside of it are preserved.
+	mutexKey := bucketNotificationMutexKey(bucket)
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
 
-	// TopicNotifications
-	topicNotifications := d.Get("topic").([]interface{})
-	topicConfigs := make([]*s3.TopicConfiguration, 0, len(topicNotifications))
-	for i, c := range topicNotifications {
-		tc := &s3.TopicConfiguration{}
-
-		c := c.(map[string]interface{})
-
-		// Id
-		if val, ok := c["id"].(string); ok && val != "" {
-			tc.Id = aws.String(val)
-		} else {
-			tc.Id = aws.String(resource.PrefixedUniqueId("tf-s3-topic-"))
-		}
+	notificationConfiguration, err := findBucketNotificationConfiguration(conn, bucket)
 
-		// TopicArn
-		if val, ok := c["topic_arn"].(string); ok {
-			tc.TopicArn = aws.String(val)
-		}
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		notificationConfiguration = &s3.NotificationConfiguration{}
+	} else if err != nil {
+		return fmt.Errorf("error reading S3 Bucket Notification Configuration (%s): %w", bucket, err)
+	}
 
-		// Events
-		events := d.Get(fmt.Sprintf("topic.%d.events", i)).(*schema.Set).List()
-		tc.Events = make([]*string, 0, len(events))
-		for _, e := range events {
-			tc.Events = append(tc.Events, aws.String(e.(string)))
-		}
+	// EventBridge
+	if o, n := d.GetChange("eventbridge"); n.(bool) {
+		notificationConfiguration.EventBridgeConfiguration = &s3.EventBridgeConfiguration{}
+	} else if o.(bool) {
+		notificationConfiguration.EventBridgeConfiguration = nil
+	}
 
-		// Filter
-		filterRules := make([]*s3.FilterRule, 0, filterRulesSliceStartLen)
-		if val, ok := c["filter_prefix"].(string); ok && val != "" {
-			filterRule := &s3.FilterRule{
-				Name:  aws.String("prefix"),
-				Value: aws.String(val),
-			}
-			filterRules = append(filterRules, filterRule)
-		}
-		if val, ok := c["filter_suffix"].(string); ok && val != "" {
-			filterRule := &s3.FilterRule{
-				Name:  aws.String("suffix"),
-				Value: aws.String(val),
-			}
-			filterRules = append(filterRules, filterRule)
-		}
-		if len(filterRules) > 0 {
-			tc.Filter = &s3.NotificationConfigurationFilter{
-				Key: &s3.KeyFilter{
-					FilterRules: filterRules,
-				},
-			}
+	// TopicNotifications
+	o, n := d.GetChange("topic")
+	managedIDs := bucketNotificationConfigurationIDs(bucketNotificationKindTopic, "topic_arn", o.(*schema.Set).List(), n.(*schema.Set).List())
+	topicConfigs := make([]*s3.TopicConfiguration, 0)
+	for _, v := range notificationConfiguration.TopicConfigurations {
+		if _, ok := managedIDs[aws.StringValue(v.Id)]; !ok {
+			topicConfigs = append(topicConfigs, v)
 		}
-		topicConfigs = append(topicConfigs, tc)
 	}
+	topicConfigs = append(topicConfigs, expandTopicConfigurations(n.(*schema.Set).List())...)
 
 	// SQS
-	queueNotifications := d.Get("queue").([]interface{})
-	queueConfigs := make([]*s3.QueueConfiguration, 0, len(queueNotifications))
-	for i, c := range queueNotifications {
-		qc := &s3.QueueConfiguration{}
-
-		c := c.(map[string]interface{})
-
-		// Id
-		if val, ok := c["id"].(string); ok && val != "" {
-			qc.Id = aws.String(val)
-		} else {
-			qc.Id = aws.String(resource.PrefixedUniqueId("tf-s3-queue-"))
-		}
-
-		// QueueArn
-		if val, ok := c["queue_arn"].(string); ok {
-			qc.QueueArn = aws.String(val)
-		}
-
-		// Events
-		events := d.Get(fmt.Sprintf("queue.%d.events", i)).(*schema.Set).List()
-		qc.Events = make([]*string, 0, len(events))
-		for _, e := range events {
-			qc.Events = append(qc.Events, aws.String(e.(string)))
-		}
-
-		// Filter
-		filterRules := make([]*s3.FilterRule, 0, filterRulesSliceStartLen)
-		if val, ok := c["filter_prefix"].(string); ok && val != "" {
-			filterRule := &s3.FilterRule{
-				Name:  aws.String("prefix"),
-				Value: aws.String(val),
-			}
-			filterRules = append(filterRules, filterRule)
-		}
-		if val, ok := c["filter_suffix"].(string); ok && val != "" {
-			filterRule := &s3.FilterRule{
-				Name:  aws.String("suffix"),
-				Value: aws.String(val),
-			}
-			filterRules = append(filterRules, filterRule)
-		}
-		if len(filterRules) > 0 {
-			qc.Filter = &s3.NotificationConfigurationFilter{
-				Key: &s3.KeyFilter{
-					FilterRules: filterRules,
-				},
-			}
+	o, n = d.GetChange("queue")
+	managedIDs = bucketNotificationConfigurationIDs(bucketNotificationKindQueue, "queue_arn", o.(*schema.Set).List(), n.(*schema.Set).List())
+	queueConfigs := make([]*s3.QueueConfiguration, 0)
+	for _, v := range notificationConfiguration.QueueConfigurations {
+		if _, ok := managedIDs[aws.StringValue(v.Id)]; !ok {
+			queueConfigs = append(queueConfigs, v)
 		}
-		queueConfigs = append(queueConfigs, qc)
 	}
+	queueConfigs = append(queueConfigs, expandQueueConfigurations(n.(*schema.Set).List())...)
 
 	// Lambda
-	lambdaFunctionNotifications := d.Get("lambda_function").([]interface{})
-	lambdaConfigs := make([]*s3.LambdaFunctionConfiguration, 0, len(lambdaFunctionNotifications))
-	for i, c := range lambdaFunctionNotifications {
-		lc := &s3.LambdaFunctionConfiguration{}
-
-		c := c.(map[string]interface{})
-
-		// Id
-		if val, ok := c["id"].(string); ok && val != "" {
-			lc.Id = aws.String(val)
-		} else {
-			lc.Id = aws.String(resource.PrefixedUniqueId("tf-s3-lambda-"))
-		}
-
-		// LambdaFunctionArn
-		if val, ok := c["lambda_function_arn"].(string); ok {
-			lc.LambdaFunctionArn = aws.String(val)
-		}
-
-		// Events
-		events := d.Get(fmt.Sprintf("lambda_function.%d.events", i)).(*schema.Set).List()
-		lc.Events = make([]*string, 0, len(events))
-		for _, e := range events {
-			lc.Events = append(lc.Events, aws.String(e.(string)))
-		}
-
-		// Filter
-		filterRules := make([]*s3.FilterRule, 0, filterRulesSliceStartLen)
-		if val, ok := c["filter_prefix"].(string); ok && val != "" {
-			filterRule := &s3.FilterRule{
-				Name:  aws.String("prefix"),
-				Value: aws.String(val),
-			}
-			filterRules = append(filterRules, filterRule)
-		}
-		if val, ok := c["filter_suffix"].(string); ok && val != "" {
-			filterRule := &s3.FilterRule{
-				Name:  aws.String("suffix"),
-				Value: aws.String(val),
-			}
-			filterRules = append(filterRules, filterRule)
-		}
-		if len(filterRules) > 0 {
-			lc.Filter = &s3.NotificationConfigurationFilter{
-				Key: &s3.KeyFilter{
-					FilterRules: filterRules,
-				},
-			}
+	o, n = d.GetChange("lambda_function")
+	managedIDs = bucketNotificationConfigurationIDs(bucketNotificationKindLambdaFunction, "lambda_function_arn", o.(*schema.Set).List(), n.(*schema.Set).List())
+	lambdaConfigs := make([]*s3.LambdaFunctionConfiguration, 0)
+	for _, v := range notificationConfiguration.LambdaFunctionConfigurations {
+		if _, ok := managedIDs[aws.StringValue(v.Id)]; !ok {
+			lambdaConfigs = append(lambdaConfigs, v)
 		}
-		lambdaConfigs = append(lambdaConfigs, lc)
 	}
+	lambdaConfigs = append(lambdaConfigs, expandLambdaFunctionConfigurations(n.(*schema.Set).List())...)
 
-	notificationConfiguration := &s3.NotificationConfiguration{}
-	if eventbridgeConfig != nil {
-		notificationConfiguration.EventBridgeConfiguration = eventbridgeConfig
-	}
+	notificationConfiguration.LambdaFunctionConfigurations = nil
 	if len(lambdaConfigs) > 0 {
 		notificationConfiguration.LambdaFunctionConfigurations = lambdaConfigs
 	}
+	notificationConfiguration.QueueConfigurations = nil
 	if len(queueConfigs) > 0 {
 		notificationConfiguration.QueueConfigurations = queueConfigs
 	}
+	notificationConfiguration.TopicConfigurations = nil
 	if len(topicConfigs) > 0 {
 		notificationConfiguration.TopicConfigurations = topicConfigs
 	}
@@ -326,7 +235,7 @@ func resourceBucketNotificationPut(d *schema.ResourceData, meta interface{}) err
 	}
 
 	log.Printf("[DEBUG] S3 bucket: %s, Putting notification: %v", bucket, i)
-	err := resource.Retry(propagationTimeout, func() *resource.RetryError {
+	err = resource.Retry(propagationTimeout, func() *resource.RetryError {
 		_, err := conn.PutBucketNotificationConfiguration(i)
 
 		if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
@@ -356,13 +265,59 @@ func resourceBucketNotificationPut(d *schema.ResourceData, meta interface{}) err
 func resourceBucketNotificationDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).S3Conn
 
+	mutexKey := bucketNotificationMutexKey(d.Id())
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+	notificationConfiguration, err := findBucketNotificationConfiguration(conn, d.Id())
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket Notification Configuration (%s): %w", d.Id(), err)
+	}
+
+	// Only remove the configurations managed by this resource.
+	if d.Get("eventbridge").(bool) {
+		notificationConfiguration.EventBridgeConfiguration = nil
+	}
+
+	managedIDs := bucketNotificationConfigurationIDs(bucketNotificationKindTopic, "topic_arn", d.Get("topic").(*schema.Set).List())
+	var topicConfigs []*s3.TopicConfiguration
+	for _, v := range notificationConfiguration.TopicConfigurations {
+		if _, ok := managedIDs[aws.StringValue(v.Id)]; !ok {
+			topicConfigs = append(topicConfigs, v)
+		}
+	}
+	notificationConfiguration.TopicConfigurations = topicConfigs
+
+	managedIDs = bucketNotificationConfigurationIDs(bucketNotificationKindQueue, "queue_arn", d.Get("queue").(*schema.Set).List())
+	var queueConfigs []*s3.QueueConfiguration
+	for _, v := range notificationConfiguration.QueueConfigurations {
+		if _, ok := managedIDs[aws.StringValue(v.Id)]; !ok {
+			queueConfigs = append(queueConfigs, v)
+		}
+	}
+	notificationConfiguration.QueueConfigurations = queueConfigs
+
+	managedIDs = bucketNotificationConfigurationIDs(bucketNotificationKindLambdaFunction, "lambda_function_arn", d.Get("lambda_function").(*schema.Set).List())
+	var lambdaConfigs []*s3.LambdaFunctionConfiguration
+	for _, v := range notificationConfiguration.LambdaFunctionConfigurations {
+		if _, ok := managedIDs[aws.StringValue(v.Id)]; !ok {
+			lambdaConfigs = append(lambdaConfigs, v)
+		}
+	}
+	notificationConfiguration.LambdaFunctionConfigurations = lambdaConfigs
+
 	i := &s3.PutBucketNotificationConfigurationInput{
 		Bucket:                    aws.String(d.Id()),
-		NotificationConfiguration: &s3.NotificationConfiguration{},
+		NotificationConfiguration: notificationConfiguration,
 	}
 
 	log.Printf("[DEBUG] S3 bucket: %s, Deleting notification: %v", d.Id(), i)
-	_, err := conn.PutBucketNotificationConfiguration(i)
+	_, err = conn.PutBucketNotificationConfiguration(i)
 
 	if err != nil {
 		return fmt.Errorf("error deleting S3 Bucket Notification Configuration (%s): %w", d.Id(), err)
@@ -374,9 +329,7 @@ func resourceBucketNotificationDelete(d *schema.ResourceData, meta interface{})
 func resourceBucketNotificationRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).S3Conn
 
-	notificationConfigs, err := conn.GetBucketNotificationConfiguration(&s3.GetBucketNotificationConfigurationRequest{
-		Bucket: aws.String(d.Id()),
-	})
+	notificationConfigs, err := findBucketNotificationConfiguration(conn, d.Id())
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
 		log.Printf("[WARN] S3 Bucket Notification Configuration (%s) not found, removing from state", d.Id())
@@ -388,35 +341,261 @@ func resourceBucketNotificationRead(d *schema.ResourceData, meta interface{}) er
 		return fmt.Errorf("error reading S3 Bucket Notification Configuration (%s): %w", d.Id(), err)
 	}
 
-	if notificationConfigs == nil {
-		return fmt.Errorf("error reading S3 Bucket Notification Configuration (%s): empty response", d.Id())
-	}
-
 	log.Printf("[DEBUG] S3 Bucket: %s, get notification: %v", d.Id(), notificationConfigs)
 
+	// On import nothing is known about which configurations are managed, so all of them are read.
+	// Otherwise only configurations managed by this resource are read so that configurations
+	// created outside of it do not show up as drift.
+	importing := d.Get("bucket").(string) == ""
+
 	d.Set("bucket", d.Id())
 
 	// EventBridge Notification
-	d.Set("eventbridge", notificationConfigs.EventBridgeConfiguration != nil)
+	if importing || d.Get("eventbridge").(bool) {
+		d.Set("eventbridge", notificationConfigs.EventBridgeConfiguration != nil)
+	}
 
 	// Topic Notification
-	if err := d.Set("topic", flattenTopicConfigurations(notificationConfigs.TopicConfigurations)); err != nil {
+	topicConfigs := notificationConfigs.TopicConfigurations
+	if !importing {
+		managedIDs := bucketNotificationConfigurationIDs(bucketNotificationKindTopic, "topic_arn", d.Get("topic").(*schema.Set).List())
+		topicConfigs = nil
+		for _, v := range notificationConfigs.TopicConfigurations {
+			if _, ok := managedIDs[aws.StringValue(v.Id)]; ok {
+				topicConfigs = append(topicConfigs, v)
+			}
+		}
+	}
+	if err := d.Set("topic", flattenTopicConfigurations(topicConfigs)); err != nil {
 		return fmt.Errorf("error reading S3 bucket \"%s\" topic notification: %s", d.Id(), err)
 	}
 
 	// SQS Notification
-	if err := d.Set("queue", flattenQueueConfigurations(notificationConfigs.QueueConfigurations)); err != nil {
+	queueConfigs := notificationConfigs.QueueConfigurations
+	if !importing {
+		managedIDs := bucketNotificationConfigurationIDs(bucketNotificationKindQueue, "queue_arn", d.Get("queue").(*schema.Set).List())
+		queueConfigs = nil
+		for _, v := range notificationConfigs.QueueConfigurations {
+			if _, ok := managedIDs[aws.StringValue(v.Id)]; ok {
+				queueConfigs = append(queueConfigs, v)
+			}
+		}
+	}
+	if err := d.Set("queue", flattenQueueConfigurations(queueConfigs)); err != nil {
 		return fmt.Errorf("error reading S3 bucket \"%s\" queue notification: %s", d.Id(), err)
 	}
 
 	// Lambda Notification
-	if err := d.Set("lambda_function", flattenLambdaFunctionConfigurations(notificationConfigs.LambdaFunctionConfigurations)); err != nil {
+	lambdaConfigs := notificationConfigs.LambdaFunctionConfigurations
+	if !importing {
+		managedIDs := bucketNotificationConfigurationIDs(bucketNotificationKindLambdaFunction, "lambda_function_arn", d.Get("lambda_function").(*schema.Set).List())
+		lambdaConfigs = nil
+		for _, v := range notificationConfigs.LambdaFunctionConfigurations {
+			if _, ok := managedIDs[aws.StringValue(v.Id)]; ok {
+				lambdaConfigs = append(lambdaConfigs, v)
+			}
+		}
+	}
+	if err := d.Set("lambda_function", flattenLambdaFunctionConfigurations(lambdaConfigs)); err != nil {
 		return fmt.Errorf("error reading S3 bucket \"%s\" lambda function notification: %s", d.Id(), err)
 	}
 
 	return nil
 }
 
+func findBucketNotificationConfiguration(conn *s3.S3, bucket string) (*s3.NotificationConfiguration, error) {
+	input := &s3.GetBucketNotificationConfigurationRequest{
+		Bucket: aws.String(bucket),
+	}
+
+	output, err := conn.GetBucketNotificationConfiguration(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+// bucketNotificationMutexKey returns the key used to serialize read-modify-write
+// updates of a bucket's notification configuration.
+func bucketNotificationMutexKey(bucket string) string {
+	return fmt.Sprintf("s3-bucket-notification-%s", bucket)
+}
+
+// bucketNotificationConfigurationHash returns a set hash function for a notification configuration.
+// The configuration's ID is excluded so that configurations without an explicit ID keep the same
+// hash once the generated ID has been read back.
+func bucketNotificationConfigurationHash(arnKey string) schema.SchemaSetFunc {
+	return func(v interface{}) int {
+		var buf bytes.Buffer
+
+		m, ok := v.(map[string]interface{})
+
+		if !ok {
+			return 0
+		}
+
+		// Missing and empty values must hash identically.
+		for _, k := range []string{arnKey, "filter_prefix", "filter_suffix"} {
+			v, _ := m[k].(string)
+			buf.WriteString(fmt.Sprintf("%s-", v))
+		}
+
+		if v, ok := m["events"].(*schema.Set); ok {
+			events := flex.ExpandStringValueSet(v)
+			sort.Strings(events)
+			buf.WriteString(fmt.Sprintf("%s-", strings.Join(events, ",")))
+		}
+
+		return create.StringHashcode(buf.String())
+	}
+}
+
+// bucketNotificationConfigurationID returns the ID of a configured notification.
+// Configurations without an explicit ID get a stable ID derived from their contents.
+func bucketNotificationConfigurationID(kind, arnKey string, tfMap map[string]interface{}) string {
+	if v, ok := tfMap["id"].(string); ok && v != "" {
+		return v
+	}
+
+	return fmt.Sprintf("tf-s3-%s-%d", kind, bucketNotificationConfigurationHash(arnKey)(tfMap))
+}
+
+func bucketNotificationConfigurationIDs(kind, arnKey string, tfLists ...[]interface{}) map[string]struct{} {
+	ids := make(map[string]struct{})
+
+	for _, tfList := range tfLists {
+		for _, tfMapRaw := range tfList {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			ids[bucketNotificationConfigurationID(kind, arnKey, tfMap)] = struct{}{}
+		}
+	}
+
+	return ids
+}
+
+func expandNotificationConfigurationFilter(tfMap map[string]interface{}) *s3.NotificationConfigurationFilter {
+	filterRules := make([]*s3.FilterRule, 0, filterRulesSliceStartLen)
+	if val, ok := tfMap["filter_prefix"].(string); ok && val != "" {
+		filterRule := &s3.FilterRule{
+			Name:  aws.String("prefix"),
+			Value: aws.String(val),
+		}
+		filterRules = append(filterRules, filterRule)
+	}
+	if val, ok := tfMap["filter_suffix"].(string); ok && val != "" {
+		filterRule := &s3.FilterRule{
+			Name:  aws.String("suffix"),
+			Value: aws.String(val),
+		}
+		filterRules = append(filterRules, filterRule)
+	}
+
+	if len(filterRules) == 0 {
+		return nil
+	}
+
+	return &s3.NotificationConfigurationFilter{
+		Key: &s3.KeyFilter{
+			FilterRules: filterRules,
+		},
+	}
+}
+
+func expandTopicConfigurations(tfList []interface{}) []*s3.TopicConfiguration {
+	topicConfigs := make([]*s3.TopicConfiguration, 0, len(tfList))
+	for _, c := range tfList {
+		c, ok := c.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		tc := &s3.TopicConfiguration{
+			Filter: expandNotificationConfigurationFilter(c),
+			Id:     aws.String(bucketNotificationConfigurationID(bucketNotificationKindTopic, "topic_arn", c)),
+		}
+
+		if val, ok := c["topic_arn"].(string); ok {
+			tc.TopicArn = aws.String(val)
+		}
+
+		if val, ok := c["events"].(*schema.Set); ok {
+			tc.Events = flex.ExpandStringSet(val)
+		}
+
+		topicConfigs = append(topicConfigs, tc)
+	}
+
+	return topicConfigs
+}
+
+func expandQueueConfigurations(tfList []interface{}) []*s3.QueueConfiguration {
+	queueConfigs := make([]*s3.QueueConfiguration, 0, len(tfList))
+	for _, c := range tfList {
+		c, ok := c.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		qc := &s3.QueueConfiguration{
+			Filter: expandNotificationConfigurationFilter(c),
+			Id:     aws.String(bucketNotificationConfigurationID(bucketNotificationKindQueue, "queue_arn", c)),
+		}
+
+		if val, ok := c["queue_arn"].(string); ok {
+			qc.QueueArn = aws.String(val)
+		}
+
+		if val, ok := c["events"].(*schema.Set); ok {
+			qc.Events = flex.ExpandStringSet(val)
+		}
+
+		queueConfigs = append(queueConfigs, qc)
+	}
+
+	return queueConfigs
+}
+
+func expandLambdaFunctionConfigurations(tfList []interface{}) []*s3.LambdaFunctionConfiguration {
+	lambdaConfigs := make([]*s3.LambdaFunctionConfiguration, 0, len(tfList))
+	for _, c := range tfList {
+		c, ok := c.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		lc := &s3.LambdaFunctionConfiguration{
+			Filter: expandNotificationConfigurationFilter(c),
+			Id:     aws.String(bucketNotificationConfigurationID(bucketNotificationKindLambdaFunction, "lambda_function_arn", c)),
+		}
+
+		if val, ok := c["lambda_function_arn"].(string); ok {
+			lc.LambdaFunctionArn = aws.String(val)
+		}
+
+		if val, ok := c["events"].(*schema.Set); ok {
+			lc.Events = flex.ExpandStringSet(val)
+		}
+
+		lambdaConfigs = append(lambdaConfigs, lc)
+	}
+
+	return lambdaConfigs
+}
+
 func flattenNotificationConfigurationFilter(filter *s3.NotificationConfigurationFilter) map[string]interface{} {
 	filterRules := map[string]interface{}{}
 	if filter.Key == nil || filter.Key.FilterRules == nil {
@@ -0,0 +1,195 @@
+package s3
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceBucketNotificationV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"eventbridge": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"topic": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"filter_prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"filter_suffix": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"topic_arn": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"events": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+					},
+				},
+			},
+
+			"queue": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"filter_prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"filter_suffix": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"queue_arn": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"events": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+					},
+				},
+			},
+
+			"lambda_function": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"filter_prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"filter_suffix": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"lambda_function_arn": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"events": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// BucketNotificationStateUpgradeV0 migrates the topic, queue and lambda_function
+// blocks from lists to sets. Sets cannot hold duplicate elements, so duplicate
+// configurations are removed, keeping the first occurrence.
+func BucketNotificationStateUpgradeV0(_ context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	if rawState == nil {
+		rawState = map[string]interface{}{}
+	}
+
+	for k, arnKey := range map[string]string{
+		"lambda_function": "lambda_function_arn",
+		"queue":           "queue_arn",
+		"topic":           "topic_arn",
+	} {
+		tfList, ok := rawState[k].([]interface{})
+
+		if !ok {
+			continue
+		}
+
+		seen := make(map[string]struct{})
+		var upgraded []interface{}
+
+		for _, tfMapRaw := range tfList {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			key := bucketNotificationConfigurationKeyV0(arnKey, tfMap)
+
+			if _, ok := seen[key]; ok {
+				continue
+			}
+
+			seen[key] = struct{}{}
+			upgraded = append(upgraded, tfMap)
+		}
+
+		rawState[k] = upgraded
+	}
+
+	return rawState, nil
+}
+
+// bucketNotificationConfigurationKeyV0 returns a key identifying a raw state
+// configuration by the same attributes as bucketNotificationConfigurationHash.
+func bucketNotificationConfigurationKeyV0(arnKey string, tfMap map[string]interface{}) string {
+	var parts []string
+
+	for _, k := range []string{arnKey, "filter_prefix", "filter_suffix"} {
+		v, _ := tfMap[k].(string)
+		parts = append(parts, v)
+	}
+
+	var events []string
+
+	if v, ok := tfMap["events"].([]interface{}); ok {
+		for _, v := range v {
+			if v, ok := v.(string); ok {
+				events = append(events, v)
+			}
+		}
+	}
+
+	sort.Strings(events)
+	parts = append(parts, strings.Join(events, ","))
+
+	return fmt.Sprintf("%q", parts)
+}
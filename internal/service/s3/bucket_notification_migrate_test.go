@@ -0,0 +1,69 @@
+package s3_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+)
+
+func testBucketNotificationStateDataV0() map[string]interface{} {
+	return map[string]interface{}{
+		"bucket":      "testbucket",
+		"eventbridge": false,
+		"queue": []interface{}{
+			map[string]interface{}{
+				"id":            "tf-s3-queue-1",
+				"queue_arn":     "arn:aws:sqs:us-west-2:123456789012:testqueue", //lintignore:AWSAT003,AWSAT005
+				"filter_prefix": "tf-acc-test/",
+				"filter_suffix": "",
+				"events":        []interface{}{"s3:ObjectCreated:*", "s3:ObjectRemoved:Delete"},
+			},
+			map[string]interface{}{
+				"id":            "tf-s3-queue-2",
+				"queue_arn":     "arn:aws:sqs:us-west-2:123456789012:testqueue", //lintignore:AWSAT003,AWSAT005
+				"filter_prefix": "tf-acc-test/",
+				"filter_suffix": "",
+				"events":        []interface{}{"s3:ObjectRemoved:Delete", "s3:ObjectCreated:*"},
+			},
+			map[string]interface{}{
+				"id":            "tf-s3-queue-3",
+				"queue_arn":     "arn:aws:sqs:us-west-2:123456789012:testqueue", //lintignore:AWSAT003,AWSAT005
+				"filter_prefix": "",
+				"filter_suffix": ".png",
+				"events":        []interface{}{"s3:ObjectCreated:*"},
+			},
+		},
+		"topic": []interface{}{
+			map[string]interface{}{
+				"id":        "tf-s3-topic-1",
+				"topic_arn": "arn:aws:sns:us-west-2:123456789012:testtopic", //lintignore:AWSAT003,AWSAT005
+				"events":    []interface{}{"s3:ObjectCreated:*"},
+			},
+		},
+	}
+}
+
+func testBucketNotificationStateDataV1() map[string]interface{} {
+	v0 := testBucketNotificationStateDataV0()
+	queue := v0["queue"].([]interface{})
+	return map[string]interface{}{
+		"bucket":      v0["bucket"],
+		"eventbridge": v0["eventbridge"],
+		"queue":       []interface{}{queue[0], queue[2]},
+		"topic":       v0["topic"],
+	}
+}
+
+func TestBucketNotificationStateUpgradeV0(t *testing.T) {
+	expected := testBucketNotificationStateDataV1()
+	actual, err := tfs3.BucketNotificationStateUpgradeV0(context.Background(), testBucketNotificationStateDataV0(), nil)
+	if err != nil {
+		t.Fatalf("error migrating state: %s", err)
+	}
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("\n\nexpected:\n\n%#v\n\ngot:\n\n%#v\n\n", expected, actual)
+	}
+}
@@ -3,6 +3,7 @@ package s3_test
 import (
 	"fmt"
 	"reflect"
+	"regexp"
 	"sort"
 	"testing"
 	"time"
@@ -291,6 +292,64 @@ func TestAccS3BucketNotification_update(t *testing.T) {
 	})
 }
 
+func TestAccS3BucketNotification_eventbridgeWithQueue(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_notification.notification"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketNotificationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketNotificationConfig_eventBridgeQueue(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketEventBridgeNotification("aws_s3_bucket.bucket"),
+					resource.TestCheckResourceAttr(resourceName, "eventbridge", "true"),
+					resource.TestCheckResourceAttr(resourceName, "queue.#", "1"),
+					resource.TestMatchTypeSetElemNestedAttrs(resourceName, "queue.*", map[string]*regexp.Regexp{
+						"id": regexp.MustCompile(`^tf-s3-queue-`),
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccS3BucketNotification_preservesUnmanaged(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_notification.notification"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketNotificationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketNotificationConfig_queue(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "queue.#", "1"),
+					testAccCheckBucketNotificationAddUnmanagedQueue("aws_s3_bucket.bucket", "unmanaged-sqs", "aws_sqs_queue.queue", "s3:ObjectRestore:Completed"),
+				),
+			},
+			{
+				Config: testAccBucketNotificationConfig_eventBridgeQueue(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "queue.#", "1"),
+					testAccCheckBucketQueueNotification("aws_s3_bucket.bucket", "unmanaged-sqs", "aws_sqs_queue.queue", []string{"s3:ObjectRestore:Completed"}, nil),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckBucketNotificationDestroy(s *terraform.State) error {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
 
@@ -413,6 +472,40 @@ func testAccCheckBucketEventBridgeNotification(n string) resource.TestCheckFunc
 	}
 }
 
+func testAccCheckBucketNotificationAddUnmanagedQueue(n, i, t, event string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs := s.RootModule().Resources[n]
+		queueArn := s.RootModule().Resources[t].Primary.Attributes["arn"]
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+
+		out, err := conn.GetBucketNotificationConfiguration(&s3.GetBucketNotificationConfigurationRequest{
+			Bucket: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return fmt.Errorf("GetBucketNotification error: %v", err)
+		}
+
+		input := &s3.PutBucketNotificationConfigurationInput{
+			Bucket: aws.String(rs.Primary.ID),
+			NotificationConfiguration: &s3.NotificationConfiguration{
+				EventBridgeConfiguration:     out.EventBridgeConfiguration,
+				LambdaFunctionConfigurations: out.LambdaFunctionConfigurations,
+				QueueConfigurations: append(out.QueueConfigurations, &s3.QueueConfiguration{
+					Events:   aws.StringSlice([]string{event}),
+					Id:       aws.String(i),
+					QueueArn: aws.String(queueArn),
+				}),
+				TopicConfigurations: out.TopicConfigurations,
+			},
+		}
+
+		_, err = conn.PutBucketNotificationConfiguration(input)
+
+		return err
+	}
+}
+
 func testAccCheckBucketQueueNotification(n, i, t string, events []string, filters *s3.KeyFilter) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs := s.RootModule().Resources[n]
@@ -671,6 +764,53 @@ resource "aws_s3_bucket_notification" "notification" {
 `, rName)
 }
 
+func testAccBucketNotificationConfig_eventBridgeQueue(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_sqs_queue" "queue" {
+  name = %[1]q
+
+  policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": "*",
+      "Action": "sqs:SendMessage",
+      "Resource": "arn:${data.aws_partition.current.partition}:sqs:*:*:%[1]s",
+      "Condition": {
+        "ArnEquals": {
+          "aws:SourceArn": "${aws_s3_bucket.bucket.arn}"
+        }
+      }
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_s3_bucket" "bucket" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_notification" "notification" {
+  bucket = aws_s3_bucket.bucket.id
+
+  eventbridge = true
+
+  queue {
+    queue_arn = aws_sqs_queue.queue.arn
+
+    events = [
+      "s3:ObjectCreated:*",
+    ]
+  }
+}
+`, rName)
+}
+
 func testAccBucketNotificationConfig_lambdaFunction(rName string) string {
 	return fmt.Sprintf(`
 data "aws_partition" "current" {}
@@ -0,0 +1,318 @@
+package sagemaker
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfservicecatalog "github.com/hashicorp/terraform-provider-aws/internal/service/servicecatalog"
+)
+
+// studioVisibilityTagKey is the Service Catalog product tag that makes a
+// product visible in SageMaker Studio as a project template.
+const studioVisibilityTagKey = "sagemaker:studio-visibility"
+
+func ResourceDomainDefaultProjectTemplates() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDomainDefaultProjectTemplatesCreate,
+		Read:   resourceDomainDefaultProjectTemplatesRead,
+		Update: resourceDomainDefaultProjectTemplatesUpdate,
+		Delete: resourceDomainDefaultProjectTemplatesDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"domain_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"execution_role_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"portfolio_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"product_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceDomainDefaultProjectTemplatesCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SageMakerConn
+	scConn := meta.(*conns.AWSClient).ServiceCatalogConn
+
+	domainID := d.Get("domain_id").(string)
+	portfolioID := d.Get("portfolio_id").(string)
+	id := DomainDefaultProjectTemplatesCreateResourceID(domainID, portfolioID)
+
+	executionRoleARN, err := findDomainDefaultExecutionRoleARN(conn, domainID)
+
+	if err != nil {
+		return fmt.Errorf("error creating SageMaker Domain Default Project Templates (%s): %w", id, err)
+	}
+
+	input := &servicecatalog.AssociatePrincipalWithPortfolioInput{
+		PortfolioId:   aws.String(portfolioID),
+		PrincipalARN:  aws.String(executionRoleARN),
+		PrincipalType: aws.String(servicecatalog.PrincipalTypeIam),
+	}
+
+	log.Printf("[DEBUG] Associating Service Catalog Portfolio principal: %s", input)
+	if _, err := scConn.AssociatePrincipalWithPortfolio(input); err != nil {
+		return fmt.Errorf("error associating SageMaker Domain (%s) execution role with Service Catalog Portfolio (%s): %w", domainID, portfolioID, err)
+	}
+
+	d.SetId(id)
+
+	if err := addProjectTemplates(scConn, portfolioID, flex.ExpandStringValueSet(d.Get("product_ids").(*schema.Set))); err != nil {
+		return fmt.Errorf("error creating SageMaker Domain Default Project Templates (%s): %w", d.Id(), err)
+	}
+
+	return resourceDomainDefaultProjectTemplatesRead(d, meta)
+}
+
+func resourceDomainDefaultProjectTemplatesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SageMakerConn
+	scConn := meta.(*conns.AWSClient).ServiceCatalogConn
+
+	domainID, portfolioID, err := DomainDefaultProjectTemplatesParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	executionRoleARN, err := findDomainDefaultExecutionRoleARN(conn, domainID)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, sagemaker.ErrCodeResourceNotFound) {
+		log.Printf("[WARN] SageMaker Domain (%s) not found, removing SageMaker Domain Default Project Templates (%s) from state", domainID, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading SageMaker Domain Default Project Templates (%s): %w", d.Id(), err)
+	}
+
+	principal, err := tfservicecatalog.FindPrincipalPortfolioAssociation(scConn, "", executionRoleARN, portfolioID)
+
+	if !d.IsNewResource() && (tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) || (err == nil && principal == nil)) {
+		log.Printf("[WARN] SageMaker Domain Default Project Templates (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading SageMaker Domain Default Project Templates (%s) principal: %w", d.Id(), err)
+	}
+
+	// A product is a project template if it is in the portfolio and visible in Studio.
+	var productIDs []string
+	for _, productID := range flex.ExpandStringValueSet(d.Get("product_ids").(*schema.Set)) {
+		ok, err := isProjectTemplate(scConn, portfolioID, productID)
+
+		if err != nil {
+			return fmt.Errorf("error reading SageMaker Domain Default Project Templates (%s) product (%s): %w", d.Id(), productID, err)
+		}
+
+		if ok {
+			productIDs = append(productIDs, productID)
+		}
+	}
+
+	d.Set("domain_id", domainID)
+	d.Set("execution_role_arn", executionRoleARN)
+	d.Set("portfolio_id", portfolioID)
+	d.Set("product_ids", productIDs)
+
+	return nil
+}
+
+func resourceDomainDefaultProjectTemplatesUpdate(d *schema.ResourceData, meta interface{}) error {
+	scConn := meta.(*conns.AWSClient).ServiceCatalogConn
+
+	if d.HasChange("product_ids") {
+		portfolioID := d.Get("portfolio_id").(string)
+		o, n := d.GetChange("product_ids")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		if err := removeProjectTemplates(scConn, portfolioID, flex.ExpandStringValueSet(os.Difference(ns))); err != nil {
+			return fmt.Errorf("error updating SageMaker Domain Default Project Templates (%s): %w", d.Id(), err)
+		}
+
+		if err := addProjectTemplates(scConn, portfolioID, flex.ExpandStringValueSet(ns.Difference(os))); err != nil {
+			return fmt.Errorf("error updating SageMaker Domain Default Project Templates (%s): %w", d.Id(), err)
+		}
+	}
+
+	return resourceDomainDefaultProjectTemplatesRead(d, meta)
+}
+
+func resourceDomainDefaultProjectTemplatesDelete(d *schema.ResourceData, meta interface{}) error {
+	scConn := meta.(*conns.AWSClient).ServiceCatalogConn
+
+	portfolioID := d.Get("portfolio_id").(string)
+
+	if err := removeProjectTemplates(scConn, portfolioID, flex.ExpandStringValueSet(d.Get("product_ids").(*schema.Set))); err != nil {
+		return fmt.Errorf("error deleting SageMaker Domain Default Project Templates (%s): %w", d.Id(), err)
+	}
+
+	if v := d.Get("execution_role_arn").(string); v != "" {
+		if err := disassociateServicecatalogPortfolioPrincipals(scConn, portfolioID, []string{v}); err != nil {
+			return fmt.Errorf("error deleting SageMaker Domain Default Project Templates (%s): %w", d.Id(), err)
+		}
+	}
+
+	return nil
+}
+
+// findDomainDefaultExecutionRoleARN returns the execution role of the domain's default user settings.
+func findDomainDefaultExecutionRoleARN(conn *sagemaker.SageMaker, domainID string) (string, error) {
+	domain, err := FindDomainByName(conn, domainID)
+
+	if err != nil {
+		return "", err
+	}
+
+	if domain == nil || domain.DefaultUserSettings == nil || aws.StringValue(domain.DefaultUserSettings.ExecutionRole) == "" {
+		return "", fmt.Errorf("SageMaker Domain (%s) has no default execution role", domainID)
+	}
+
+	return aws.StringValue(domain.DefaultUserSettings.ExecutionRole), nil
+}
+
+// addProjectTemplates associates the products with the portfolio and makes them visible in Studio.
+func addProjectTemplates(conn *servicecatalog.ServiceCatalog, portfolioID string, productIDs []string) error {
+	for _, productID := range productIDs {
+		log.Printf("[DEBUG] Associating Service Catalog Product (%s) with Portfolio (%s)", productID, portfolioID)
+		_, err := conn.AssociateProductWithPortfolio(&servicecatalog.AssociateProductWithPortfolioInput{
+			PortfolioId: aws.String(portfolioID),
+			ProductId:   aws.String(productID),
+		})
+
+		if err != nil {
+			return fmt.Errorf("associating product (%s) with portfolio (%s): %w", productID, portfolioID, err)
+		}
+
+		log.Printf("[DEBUG] Tagging Service Catalog Product (%s) visible in SageMaker Studio", productID)
+		_, err = conn.UpdateProduct(&servicecatalog.UpdateProductInput{
+			AddTags: []*servicecatalog.Tag{{
+				Key:   aws.String(studioVisibilityTagKey),
+				Value: aws.String("true"),
+			}},
+			Id: aws.String(productID),
+		})
+
+		if err != nil {
+			return fmt.Errorf("tagging product (%s): %w", productID, err)
+		}
+	}
+
+	return nil
+}
+
+// removeProjectTemplates hides the products in Studio and disassociates them from the portfolio.
+func removeProjectTemplates(conn *servicecatalog.ServiceCatalog, portfolioID string, productIDs []string) error {
+	for _, productID := range productIDs {
+		log.Printf("[DEBUG] Untagging Service Catalog Product (%s)", productID)
+		_, err := conn.UpdateProduct(&servicecatalog.UpdateProductInput{
+			Id:         aws.String(productID),
+			RemoveTags: aws.StringSlice([]string{studioVisibilityTagKey}),
+		})
+
+		if tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("untagging product (%s): %w", productID, err)
+		}
+
+		log.Printf("[DEBUG] Disassociating Service Catalog Product (%s) from Portfolio (%s)", productID, portfolioID)
+		_, err = conn.DisassociateProductFromPortfolio(&servicecatalog.DisassociateProductFromPortfolioInput{
+			PortfolioId: aws.String(portfolioID),
+			ProductId:   aws.String(productID),
+		})
+
+		if tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("disassociating product (%s) from portfolio (%s): %w", productID, portfolioID, err)
+		}
+	}
+
+	return nil
+}
+
+func isProjectTemplate(conn *servicecatalog.ServiceCatalog, portfolioID, productID string) (bool, error) {
+	portfolio, err := tfservicecatalog.FindProductPortfolioAssociation(conn, "", portfolioID, productID)
+
+	if tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	if portfolio == nil {
+		return false, nil
+	}
+
+	output, err := conn.DescribeProductAsAdmin(&servicecatalog.DescribeProductAsAdminInput{
+		Id: aws.String(productID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	for _, tag := range output.Tags {
+		if aws.StringValue(tag.Key) == studioVisibilityTagKey && aws.StringValue(tag.Value) == "true" {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+const domainDefaultProjectTemplatesResourceIDSeparator = ","
+
+func DomainDefaultProjectTemplatesCreateResourceID(domainID, portfolioID string) string {
+	parts := []string{domainID, portfolioID}
+	id := strings.Join(parts, domainDefaultProjectTemplatesResourceIDSeparator)
+
+	return id
+}
+
+func DomainDefaultProjectTemplatesParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, domainDefaultProjectTemplatesResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected DOMAIN-ID%[2]sPORTFOLIO-ID", id, domainDefaultProjectTemplatesResourceIDSeparator)
+}
@@ -0,0 +1,170 @@
+package sagemaker_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfservicecatalog "github.com/hashicorp/terraform-provider-aws/internal/service/servicecatalog"
+)
+
+func testAccDomainDefaultProjectTemplates_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sagemaker_domain_default_project_templates.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, sagemaker.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDomainDefaultProjectTemplatesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainDefaultProjectTemplatesConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainDefaultProjectTemplatesExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "domain_id", "aws_sagemaker_domain.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "execution_role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "portfolio_id", "aws_servicecatalog_portfolio.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "product_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "product_ids.*", "aws_servicecatalog_product.test", "id"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"product_ids"},
+			},
+		},
+	})
+}
+
+func testAccCheckDomainDefaultProjectTemplatesDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ServiceCatalogConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_sagemaker_domain_default_project_templates" {
+			continue
+		}
+
+		principal, err := tfservicecatalog.FindPrincipalPortfolioAssociation(conn, "", rs.Primary.Attributes["execution_role_arn"], rs.Primary.Attributes["portfolio_id"])
+
+		if tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if principal != nil {
+			return fmt.Errorf("SageMaker Domain Default Project Templates %s still exist", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckDomainDefaultProjectTemplatesExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SageMaker Domain Default Project Templates ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ServiceCatalogConn
+
+		principal, err := tfservicecatalog.FindPrincipalPortfolioAssociation(conn, "", rs.Primary.Attributes["execution_role_arn"], rs.Primary.Attributes["portfolio_id"])
+
+		if err != nil {
+			return err
+		}
+
+		if principal == nil {
+			return fmt.Errorf("SageMaker Domain Default Project Templates %s not found", rs.Primary.ID)
+		}
+
+		output, err := conn.DescribeProductAsAdmin(&servicecatalog.DescribeProductAsAdminInput{
+			Id: aws.String(rs.Primary.Attributes["product_ids.0"]),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		for _, tag := range output.Tags {
+			if aws.StringValue(tag.Key) == "sagemaker:studio-visibility" && aws.StringValue(tag.Value) == "true" {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("SageMaker Domain Default Project Templates %s product is not visible in Studio", rs.Primary.ID)
+	}
+}
+
+func testAccDomainDefaultProjectTemplatesConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccDomainConfig_basic(rName), fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_object" "test" {
+  bucket = aws_s3_bucket.test.id
+  key    = "%[1]s.json"
+
+  content = jsonencode({
+    AWSTemplateFormatVersion = "2010-09-09"
+
+    Resources = {
+      MyVPC = {
+        Type = "AWS::EC2::VPC"
+        Properties = {
+          CidrBlock = "10.1.0.0/16"
+        }
+      }
+    }
+  })
+}
+
+resource "aws_servicecatalog_product" "test" {
+  name  = %[1]q
+  owner = %[1]q
+  type  = "CLOUD_FORMATION_TEMPLATE"
+
+  provisioning_artifact_parameters {
+    disable_template_validation = true
+    name                        = %[1]q
+    template_url                = "https://${aws_s3_bucket.test.bucket_regional_domain_name}/${aws_s3_object.test.key}"
+    type                        = "CLOUD_FORMATION_TEMPLATE"
+  }
+
+  lifecycle {
+    ignore_changes = [tags, tags_all]
+  }
+}
+
+resource "aws_servicecatalog_portfolio" "test" {
+  name          = %[1]q
+  provider_name = %[1]q
+}
+
+resource "aws_sagemaker_domain_default_project_templates" "test" {
+  domain_id    = aws_sagemaker_domain.test.id
+  portfolio_id = aws_servicecatalog_portfolio.test.id
+  product_ids  = [aws_servicecatalog_product.test.id]
+}
+`, rName))
+}
@@ -1,16 +1,19 @@
 package sagemaker
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/sagemaker"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfservicequotas "github.com/hashicorp/terraform-provider-aws/internal/service/servicequotas"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -157,7 +160,14 @@ func ResourceEndpoint() *schema.Resource {
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			tfservicequotas.Precheck{
+				ServiceCode: "sagemaker",
+				QuotaName:   "Number of endpoints",
+				Usage:       endpointsInUse,
+			}.CustomizeDiff("aws_sagemaker_endpoint"),
+		),
 	}
 }
 
@@ -518,3 +528,19 @@ func flattenEndpointDeploymentConfigAutoRollbackConfigAlarms(configured []*sagem
 	}
 	return result
 }
+
+// endpointsInUse returns the number of endpoints counting towards the Number of endpoints quota.
+func endpointsInUse(ctx context.Context, meta interface{}) (int, error) {
+	conn := meta.(*conns.AWSClient).SageMakerConn
+
+	var n int
+	err := conn.ListEndpointsPagesWithContext(ctx, &sagemaker.ListEndpointsInput{}, func(page *sagemaker.ListEndpointsOutput, lastPage bool) bool {
+		if page != nil {
+			n += len(page.Endpoints)
+		}
+
+		return !lastPage
+	})
+
+	return n, err
+}
@@ -513,3 +513,19 @@ func FindNotebookInstanceByName(conn *sagemaker.SageMaker, name string) (*sagema
 
 	return output, nil
 }
+
+func FindServicecatalogPortfolioStatus(conn *sagemaker.SageMaker) (string, error) {
+	input := &sagemaker.GetSagemakerServicecatalogPortfolioStatusInput{}
+
+	output, err := conn.GetSagemakerServicecatalogPortfolioStatus(input)
+
+	if err != nil {
+		return "", err
+	}
+
+	if output == nil {
+		return "", tfresource.NewEmptyResultError(input)
+	}
+
+	return aws.StringValue(output.Status), nil
+}
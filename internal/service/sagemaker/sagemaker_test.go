@@ -47,6 +47,9 @@ func TestAccSageMaker_serial(t *testing.T) {
 			"sharingSettings":                                        testAccDomain_sharingSettings,
 			"defaultUserSettingsUpdated":                             testAccDomain_defaultUserSettingsUpdated,
 		},
+		"DomainDefaultProjectTemplates": {
+			"basic": testAccDomainDefaultProjectTemplates_basic,
+		},
 		"FlowDefinition": {
 			"basic":                          testAccFlowDefinition_basic,
 			"disappears":                     testAccFlowDefinition_disappears,
@@ -54,6 +57,10 @@ func TestAccSageMaker_serial(t *testing.T) {
 			"HumanLoopRequestSource":         testAccFlowDefinition_humanLoopRequestSource,
 			"Tags":                           testAccFlowDefinition_tags,
 		},
+		"ServicecatalogPortfolioStatus": {
+			"basic":      testAccServicecatalogPortfolioStatus_basic,
+			"principals": testAccServicecatalogPortfolioStatus_principals,
+		},
 		"UserProfile": {
 			"basic":                           testAccUserProfile_basic,
 			"disappears":                      testAccUserProfile_tags,
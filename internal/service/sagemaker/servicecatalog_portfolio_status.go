@@ -0,0 +1,314 @@
+package sagemaker
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	// servicecatalogPortfolioProviderName is the provider name of the
+	// portfolio that SageMaker shares with the account once enabled.
+	servicecatalogPortfolioProviderName = "Amazon SageMaker"
+
+	servicecatalogPortfolioShareTimeout = 2 * time.Minute
+)
+
+func ResourceServicecatalogPortfolioStatus() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceServicecatalogPortfolioStatusPut,
+		Read:   resourceServicecatalogPortfolioStatusRead,
+		Update: resourceServicecatalogPortfolioStatusPut,
+		Delete: resourceServicecatalogPortfolioStatusDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: resourceServicecatalogPortfolioStatusCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"portfolio_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"principal_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(sagemaker.SagemakerServicecatalogStatus_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceServicecatalogPortfolioStatusPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SageMakerConn
+
+	status := d.Get("status").(string)
+
+	// Principals are disassociated while the portfolio is still shared with the account.
+	if o, _ := d.GetChange("principal_arns"); status != sagemaker.SagemakerServicecatalogStatusEnabled && o.(*schema.Set).Len() > 0 {
+		scConn := meta.(*conns.AWSClient).ServiceCatalogConn
+
+		portfolioID, err := findServicecatalogPortfolioID(scConn)
+
+		if err != nil && !tfresource.NotFound(err) {
+			return fmt.Errorf("error reading SageMaker Servicecatalog Portfolio: %w", err)
+		}
+
+		if err == nil {
+			if err := disassociateServicecatalogPortfolioPrincipals(scConn, portfolioID, flex.ExpandStringValueSet(o.(*schema.Set))); err != nil {
+				return err
+			}
+		}
+	}
+
+	var err error
+	if status == sagemaker.SagemakerServicecatalogStatusEnabled {
+		_, err = conn.EnableSagemakerServicecatalogPortfolio(&sagemaker.EnableSagemakerServicecatalogPortfolioInput{})
+	} else {
+		_, err = conn.DisableSagemakerServicecatalogPortfolio(&sagemaker.DisableSagemakerServicecatalogPortfolioInput{})
+	}
+
+	if err != nil {
+		return fmt.Errorf("error setting SageMaker Servicecatalog Portfolio Status (%s): %w", status, err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+
+	if status == sagemaker.SagemakerServicecatalogStatusEnabled && d.HasChange("principal_arns") {
+		if err := updateServicecatalogPortfolioPrincipals(d, meta); err != nil {
+			return err
+		}
+	}
+
+	return resourceServicecatalogPortfolioStatusRead(d, meta)
+}
+
+func resourceServicecatalogPortfolioStatusRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SageMakerConn
+
+	status, err := FindServicecatalogPortfolioStatus(conn)
+
+	if err != nil {
+		return fmt.Errorf("error reading SageMaker Servicecatalog Portfolio Status: %w", err)
+	}
+
+	d.Set("status", status)
+
+	if status != sagemaker.SagemakerServicecatalogStatusEnabled {
+		d.Set("portfolio_id", "")
+		d.Set("principal_arns", nil)
+
+		return nil
+	}
+
+	scConn := meta.(*conns.AWSClient).ServiceCatalogConn
+
+	portfolioID, err := findServicecatalogPortfolioID(scConn)
+
+	if tfresource.NotFound(err) {
+		log.Printf("[WARN] SageMaker Servicecatalog Portfolio not shared with account yet")
+		d.Set("portfolio_id", "")
+		d.Set("principal_arns", nil)
+
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading SageMaker Servicecatalog Portfolio: %w", err)
+	}
+
+	d.Set("portfolio_id", portfolioID)
+
+	principalARNs, err := findServicecatalogPortfolioPrincipalARNs(scConn, portfolioID)
+
+	if err != nil {
+		return fmt.Errorf("error reading SageMaker Servicecatalog Portfolio (%s) principals: %w", portfolioID, err)
+	}
+
+	d.Set("principal_arns", principalARNs)
+
+	return nil
+}
+
+func resourceServicecatalogPortfolioStatusDelete(d *schema.ResourceData, meta interface{}) error {
+	if v := d.Get("principal_arns").(*schema.Set); v.Len() > 0 {
+		portfolioID, err := findServicecatalogPortfolioID(meta.(*conns.AWSClient).ServiceCatalogConn)
+
+		if tfresource.NotFound(err) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error reading SageMaker Servicecatalog Portfolio: %w", err)
+		}
+
+		if err := disassociateServicecatalogPortfolioPrincipals(meta.(*conns.AWSClient).ServiceCatalogConn, portfolioID, flex.ExpandStringValueSet(v)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceServicecatalogPortfolioStatusCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("status") || !diff.NewValueKnown("principal_arns") {
+		return nil
+	}
+
+	if diff.Get("status").(string) == sagemaker.SagemakerServicecatalogStatusEnabled {
+		return nil
+	}
+
+	if v := diff.GetRawConfig().GetAttr("principal_arns"); v.IsKnown() && !v.IsNull() && v.LengthInt() > 0 {
+		return fmt.Errorf("principal_arns can only be set when status is %s", sagemaker.SagemakerServicecatalogStatusEnabled)
+	}
+
+	// All principals are disassociated before the portfolio is disabled.
+	if o, _ := diff.GetChange("principal_arns"); o.(*schema.Set).Len() > 0 {
+		return diff.SetNew("principal_arns", []string{})
+	}
+
+	return nil
+}
+
+func updateServicecatalogPortfolioPrincipals(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ServiceCatalogConn
+
+	o, n := d.GetChange("principal_arns")
+	os, ns := o.(*schema.Set), n.(*schema.Set)
+
+	// The portfolio is shared with the account asynchronously after enablement.
+	outputRaw, err := tfresource.RetryWhenNotFound(servicecatalogPortfolioShareTimeout, func() (interface{}, error) {
+		return findServicecatalogPortfolioID(conn)
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading SageMaker Servicecatalog Portfolio: %w", err)
+	}
+
+	portfolioID := outputRaw.(string)
+
+	if err := disassociateServicecatalogPortfolioPrincipals(conn, portfolioID, flex.ExpandStringValueSet(os.Difference(ns))); err != nil {
+		return err
+	}
+
+	for _, principalARN := range flex.ExpandStringValueSet(ns.Difference(os)) {
+		input := &servicecatalog.AssociatePrincipalWithPortfolioInput{
+			PortfolioId:   aws.String(portfolioID),
+			PrincipalARN:  aws.String(principalARN),
+			PrincipalType: aws.String(servicecatalog.PrincipalTypeIam),
+		}
+
+		log.Printf("[DEBUG] Associating SageMaker Servicecatalog Portfolio principal: %s", input)
+		if _, err := conn.AssociatePrincipalWithPortfolio(input); err != nil {
+			return fmt.Errorf("error associating principal (%s) with SageMaker Servicecatalog Portfolio (%s): %w", principalARN, portfolioID, err)
+		}
+	}
+
+	return nil
+}
+
+func disassociateServicecatalogPortfolioPrincipals(conn *servicecatalog.ServiceCatalog, portfolioID string, principalARNs []string) error {
+	for _, principalARN := range principalARNs {
+		input := &servicecatalog.DisassociatePrincipalFromPortfolioInput{
+			PortfolioId:   aws.String(portfolioID),
+			PrincipalARN:  aws.String(principalARN),
+			PrincipalType: aws.String(servicecatalog.PrincipalTypeIam),
+		}
+
+		log.Printf("[DEBUG] Disassociating SageMaker Servicecatalog Portfolio principal: %s", input)
+		_, err := conn.DisassociatePrincipalFromPortfolio(input)
+
+		if tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error disassociating principal (%s) from SageMaker Servicecatalog Portfolio (%s): %w", principalARN, portfolioID, err)
+		}
+	}
+
+	return nil
+}
+
+// findServicecatalogPortfolioID returns the ID of the portfolio that SageMaker shares with the account.
+func findServicecatalogPortfolioID(conn *servicecatalog.ServiceCatalog) (string, error) {
+	input := &servicecatalog.ListAcceptedPortfolioSharesInput{
+		PortfolioShareType: aws.String(servicecatalog.PortfolioShareTypeAwsServicecatalog),
+	}
+
+	var portfolioID string
+	err := conn.ListAcceptedPortfolioSharesPages(input, func(page *servicecatalog.ListAcceptedPortfolioSharesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.PortfolioDetails {
+			if v != nil && aws.StringValue(v.ProviderName) == servicecatalogPortfolioProviderName {
+				portfolioID = aws.StringValue(v.Id)
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	if portfolioID == "" {
+		return "", &resource.NotFoundError{
+			Message:     "SageMaker Servicecatalog Portfolio not found",
+			LastRequest: input,
+		}
+	}
+
+	return portfolioID, nil
+}
+
+func findServicecatalogPortfolioPrincipalARNs(conn *servicecatalog.ServiceCatalog, portfolioID string) ([]string, error) {
+	input := &servicecatalog.ListPrincipalsForPortfolioInput{
+		PortfolioId: aws.String(portfolioID),
+	}
+
+	var principalARNs []string
+	err := conn.ListPrincipalsForPortfolioPages(input, func(page *servicecatalog.ListPrincipalsForPortfolioOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Principals {
+			if v != nil {
+				principalARNs = append(principalARNs, aws.StringValue(v.PrincipalARN))
+			}
+		}
+
+		return !lastPage
+	})
+
+	return principalARNs, err
+}
@@ -0,0 +1,111 @@
+package sagemaker_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func testAccServicecatalogPortfolioStatus_basic(t *testing.T) {
+	resourceName := "aws_sagemaker_servicecatalog_portfolio_status.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, sagemaker.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServicecatalogPortfolioStatusConfig_basic("Enabled"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "status", "Enabled"),
+					resource.TestCheckResourceAttrSet(resourceName, "portfolio_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccServicecatalogPortfolioStatusConfig_basic("Disabled"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "status", "Disabled"),
+					resource.TestCheckResourceAttr(resourceName, "portfolio_id", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccServicecatalogPortfolioStatus_principals(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sagemaker_servicecatalog_portfolio_status.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, sagemaker.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServicecatalogPortfolioStatusConfig_principals(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "status", "Enabled"),
+					resource.TestCheckResourceAttrSet(resourceName, "portfolio_id"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "principal_arns.*", "aws_iam_role.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccServicecatalogPortfolioStatusConfig_basic("Disabled"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "status", "Disabled"),
+					resource.TestCheckResourceAttr(resourceName, "principal_arns.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccServicecatalogPortfolioStatusConfig_basic(status string) string {
+	return fmt.Sprintf(`
+resource "aws_sagemaker_servicecatalog_portfolio_status" "test" {
+  status = %[1]q
+}
+`, status)
+}
+
+func testAccServicecatalogPortfolioStatusConfig_principals(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "sagemaker.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_sagemaker_servicecatalog_portfolio_status" "test" {
+  status         = "Enabled"
+  principal_arns = [aws_iam_role.test.arn]
+}
+`, rName)
+}
@@ -0,0 +1,497 @@
+package secretsmanager
+
+import ( // nosemgrep:ci.aws-sdk-go-multiple-service-imports
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	serverlessrepo "github.com/aws/aws-sdk-go/service/serverlessapplicationrepository"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfcloudformation "github.com/hashicorp/terraform-provider-aws/internal/service/cloudformation"
+	tfserverlessrepo "github.com/hashicorp/terraform-provider-aws/internal/service/serverlessrepo"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	managedRotationEngineDocumentDB = "docdb"
+	managedRotationEngineMariaDB    = "mariadb"
+	managedRotationEngineMySQL      = "mysql"
+	managedRotationEngineOracle     = "oracle"
+	managedRotationEnginePostgreSQL = "postgres"
+	managedRotationEngineRedshift   = "redshift"
+	managedRotationEngineSQLServer  = "sqlserver"
+
+	// Output of the AWS-provided rotation templates that holds the rotation function's ARN.
+	managedRotationStackOutputRotationLambdaARN = "RotationLambdaARN"
+
+	// Tag that the Serverless Application Repository adds to the stacks it deploys.
+	managedRotationStackTagApplicationID = "serverlessrepo:applicationId"
+)
+
+func managedRotationEngine_Values() []string {
+	return []string{
+		managedRotationEngineDocumentDB,
+		managedRotationEngineMariaDB,
+		managedRotationEngineMySQL,
+		managedRotationEngineOracle,
+		managedRotationEnginePostgreSQL,
+		managedRotationEngineRedshift,
+		managedRotationEngineSQLServer,
+	}
+}
+
+// managedRotationApplicationNames maps each engine to the name of the
+// Serverless Application Repository application that AWS publishes for it.
+var managedRotationApplicationNames = map[string]string{
+	managedRotationEngineDocumentDB: "SecretsManagerMongoDBRotation",
+	managedRotationEngineMariaDB:    "SecretsManagerRDSMariaDBRotation",
+	managedRotationEngineMySQL:      "SecretsManagerRDSMySQLRotation",
+	managedRotationEngineOracle:     "SecretsManagerRDSOracleRotation",
+	managedRotationEnginePostgreSQL: "SecretsManagerRDSPostgreSQLRotation",
+	managedRotationEngineRedshift:   "SecretsManagerRedshiftRotation",
+	managedRotationEngineSQLServer:  "SecretsManagerRDSSQLServerRotation",
+}
+
+func ResourceSecretManagedRotation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSecretManagedRotationCreate,
+		Read:   resourceSecretManagedRotationRead,
+		Update: resourceSecretManagedRotationUpdate,
+		Delete: resourceSecretManagedRotationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceSecretManagedRotationImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(tfcloudformation.StackCreatedDefaultTimeout),
+			Delete: schema.DefaultTimeout(tfcloudformation.StackDeletedDefaultTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"engine": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(managedRotationEngine_Values(), false),
+			},
+			"function_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"kms_key_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"master_secret_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"master_secret_kms_key_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"master_secret_arn"},
+				ValidateFunc: verify.ValidARN,
+			},
+			"rotation_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"rotation_lambda_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"rotation_rules": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"automatically_after_days": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+					},
+				},
+			},
+			"secret_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"stack_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"vpc_security_group_ids": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"vpc_subnet_ids"},
+				Elem:         &schema.Schema{Type: schema.TypeString},
+			},
+			"vpc_subnet_ids": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"vpc_security_group_ids"},
+				Elem:         &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceSecretManagedRotationCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*conns.AWSClient)
+	cfConn := client.CloudFormationConn
+	conn := client.SecretsManagerConn
+	serverlessConn := client.ServerlessRepoConn
+
+	secretID := d.Get("secret_id").(string)
+	secret, err := FindSecretByID(conn, secretID)
+
+	if err != nil {
+		return fmt.Errorf("error reading Secrets Manager Secret (%s): %w", secretID, err)
+	}
+
+	applicationID, err := managedRotationApplicationID(client.Partition, d.Get("engine").(string), d.Get("master_secret_arn").(string) != "")
+
+	if err != nil {
+		return err
+	}
+
+	functionName := d.Get("function_name").(string)
+	parameters := map[string]string{
+		"endpoint":     fmt.Sprintf("https://secretsmanager.%s.%s", client.Region, client.DNSSuffix),
+		"functionName": functionName,
+	}
+
+	if v, ok := d.GetOk("kms_key_arn"); ok {
+		parameters["kmsKeyArn"] = v.(string)
+	}
+
+	if v, ok := d.GetOk("master_secret_arn"); ok {
+		parameters["masterSecretArn"] = v.(string)
+	}
+
+	if v, ok := d.GetOk("master_secret_kms_key_arn"); ok {
+		parameters["masterSecretKmsKeyArn"] = v.(string)
+	}
+
+	if v, ok := d.GetOk("vpc_security_group_ids"); ok && v.(*schema.Set).Len() > 0 {
+		parameters["vpcSecurityGroupIds"] = strings.Join(flex.ExpandStringValueSet(v.(*schema.Set)), ",")
+	}
+
+	if v, ok := d.GetOk("vpc_subnet_ids"); ok && v.(*schema.Set).Len() > 0 {
+		parameters["vpcSubnetIds"] = strings.Join(flex.ExpandStringValueSet(v.(*schema.Set)), ",")
+	}
+
+	changeSetInput := &serverlessrepo.CreateCloudFormationChangeSetRequest{
+		ApplicationId: aws.String(applicationID),
+		Capabilities: aws.StringSlice([]string{
+			serverlessrepo.CapabilityCapabilityIam,
+			serverlessrepo.CapabilityCapabilityResourcePolicy,
+		}),
+		StackName: aws.String(functionName),
+	}
+
+	for k, v := range parameters {
+		changeSetInput.ParameterOverrides = append(changeSetInput.ParameterOverrides, &serverlessrepo.ParameterValue{
+			Name:  aws.String(k),
+			Value: aws.String(v),
+		})
+	}
+
+	log.Printf("[DEBUG] Creating Secrets Manager Secret managed rotation function change set: %s", changeSetInput)
+	changeSetOutput, err := serverlessConn.CreateCloudFormationChangeSet(changeSetInput)
+
+	if err != nil {
+		return fmt.Errorf("error creating Secrets Manager Secret managed rotation function (%s) change set: %w", functionName, err)
+	}
+
+	stackID := aws.StringValue(changeSetOutput.StackId)
+
+	if _, err := tfcloudformation.WaitChangeSetCreated(cfConn, stackID, aws.StringValue(changeSetOutput.ChangeSetId)); err != nil {
+		return fmt.Errorf("error waiting for Secrets Manager Secret managed rotation function (%s) change set create: %w", functionName, err)
+	}
+
+	requestToken := resource.UniqueId()
+	_, err = cfConn.ExecuteChangeSet(&cloudformation.ExecuteChangeSetInput{
+		ChangeSetName:      changeSetOutput.ChangeSetId,
+		ClientRequestToken: aws.String(requestToken),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error executing Secrets Manager Secret managed rotation function (%s) change set: %w", functionName, err)
+	}
+
+	// Track the stack as soon as it exists so that a failure below taints the resource and Delete removes the stack.
+	d.SetId(aws.StringValue(secret.ARN))
+	d.Set("stack_id", stackID)
+
+	stack, err := tfcloudformation.WaitStackCreated(cfConn, stackID, requestToken, d.Timeout(schema.TimeoutCreate))
+
+	if err != nil {
+		return fmt.Errorf("error waiting for Secrets Manager Secret managed rotation function (%s) stack create: %w", functionName, err)
+	}
+
+	rotationLambdaARN := managedRotationStackOutput(stack, managedRotationStackOutputRotationLambdaARN)
+
+	if rotationLambdaARN == "" {
+		return fmt.Errorf("Secrets Manager Secret managed rotation function (%s) stack has no %s output", functionName, managedRotationStackOutputRotationLambdaARN)
+	}
+
+	if _, err := rotateSecret(conn, d.Id(), rotationLambdaARN, d.Get("rotation_rules").([]interface{})); err != nil {
+		return fmt.Errorf("error enabling Secrets Manager Secret (%s) managed rotation: %w", d.Id(), err)
+	}
+
+	return resourceSecretManagedRotationRead(d, meta)
+}
+
+func resourceSecretManagedRotationRead(d *schema.ResourceData, meta interface{}) error {
+	cfConn := meta.(*conns.AWSClient).CloudFormationConn
+	conn := meta.(*conns.AWSClient).SecretsManagerConn
+
+	stack, err := tfcloudformation.FindStackByID(cfConn, d.Get("stack_id").(string))
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Secrets Manager Secret managed rotation function stack (%s) not found, removing from state", d.Get("stack_id").(string))
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Secrets Manager Secret managed rotation function stack (%s): %w", d.Get("stack_id").(string), err)
+	}
+
+	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(PropagationTimeout, func() (interface{}, error) {
+		return FindSecretByID(conn, d.Id())
+	}, d.IsNewResource())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Secrets Manager Secret (%s) not found, removing managed rotation from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Secrets Manager Secret (%s): %w", d.Id(), err)
+	}
+
+	output := outputRaw.(*secretsmanager.DescribeSecretOutput)
+
+	parameters := make(map[string]string)
+	for _, v := range stack.Parameters {
+		parameters[aws.StringValue(v.ParameterKey)] = aws.StringValue(v.ParameterValue)
+	}
+
+	if v, ok := tfcloudformation.KeyValueTags(stack.Tags)[managedRotationStackTagApplicationID]; ok {
+		d.Set("engine", managedRotationApplicationEngine(aws.StringValue(v.Value)))
+	}
+	d.Set("function_name", parameters["functionName"])
+	d.Set("kms_key_arn", parameters["kmsKeyArn"])
+	d.Set("master_secret_arn", parameters["masterSecretArn"])
+	d.Set("master_secret_kms_key_arn", parameters["masterSecretKmsKeyArn"])
+	d.Set("rotation_enabled", output.RotationEnabled)
+	d.Set("rotation_lambda_arn", managedRotationStackOutput(stack, managedRotationStackOutputRotationLambdaARN))
+
+	if aws.BoolValue(output.RotationEnabled) {
+		if err := d.Set("rotation_rules", flattenRotationRules(output.RotationRules)); err != nil {
+			return fmt.Errorf("error setting rotation_rules: %w", err)
+		}
+	} else {
+		d.Set("rotation_rules", []interface{}{})
+	}
+	d.Set("vpc_security_group_ids", managedRotationStackParameterList(parameters["vpcSecurityGroupIds"]))
+	d.Set("vpc_subnet_ids", managedRotationStackParameterList(parameters["vpcSubnetIds"]))
+
+	return nil
+}
+
+func resourceSecretManagedRotationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SecretsManagerConn
+
+	if d.HasChange("rotation_rules") {
+		if _, err := rotateSecret(conn, d.Id(), d.Get("rotation_lambda_arn").(string), d.Get("rotation_rules").([]interface{})); err != nil {
+			return fmt.Errorf("error updating Secrets Manager Secret (%s) managed rotation: %w", d.Id(), err)
+		}
+	}
+
+	return resourceSecretManagedRotationRead(d, meta)
+}
+
+func resourceSecretManagedRotationDelete(d *schema.ResourceData, meta interface{}) error {
+	cfConn := meta.(*conns.AWSClient).CloudFormationConn
+	conn := meta.(*conns.AWSClient).SecretsManagerConn
+
+	// Rotation is not enabled if the create failed before the rotation function was attached.
+	if d.Get("rotation_enabled").(bool) {
+		log.Printf("[DEBUG] Cancelling Secrets Manager Secret managed rotation: %s", d.Id())
+		_, err := conn.CancelRotateSecret(&secretsmanager.CancelRotateSecretInput{
+			SecretId: aws.String(d.Id()),
+		})
+
+		if err != nil && !tfawserr.ErrCodeEquals(err, secretsmanager.ErrCodeResourceNotFoundException) {
+			return fmt.Errorf("error cancelling Secrets Manager Secret (%s) managed rotation: %w", d.Id(), err)
+		}
+	}
+
+	stackID := d.Get("stack_id").(string)
+	requestToken := resource.UniqueId()
+
+	log.Printf("[DEBUG] Deleting Secrets Manager Secret managed rotation function stack: %s", stackID)
+	_, err := cfConn.DeleteStack(&cloudformation.DeleteStackInput{
+		ClientRequestToken: aws.String(requestToken),
+		StackName:          aws.String(stackID),
+	})
+
+	if tfawserr.ErrMessageContains(err, tfcloudformation.ErrCodeValidationError, "does not exist") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Secrets Manager Secret managed rotation function stack (%s): %w", stackID, err)
+	}
+
+	if _, err := tfcloudformation.WaitStackDeleted(cfConn, stackID, requestToken, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Secrets Manager Secret managed rotation function stack (%s) delete: %w", stackID, err)
+	}
+
+	return nil
+}
+
+func resourceSecretManagedRotationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	cfConn := meta.(*conns.AWSClient).CloudFormationConn
+	conn := meta.(*conns.AWSClient).SecretsManagerConn
+
+	secretID := d.Id()
+	output, err := FindSecretByID(conn, secretID)
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading Secrets Manager Secret (%s): %w", secretID, err)
+	}
+
+	rotationLambdaARN, err := arn.Parse(aws.StringValue(output.RotationLambdaARN))
+
+	if err != nil {
+		return nil, fmt.Errorf("Secrets Manager Secret (%s) has no rotation function", secretID)
+	}
+
+	// The rotation function and its stack are both named after function_name, the stack with the prefix added by the Serverless Application Repository.
+	functionName := strings.TrimPrefix(rotationLambdaARN.Resource, "function:")
+	stack, err := tfcloudformation.FindStackByID(cfConn, tfserverlessrepo.CloudFormationStackNamePrefix+functionName)
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading Secrets Manager Secret (%s) managed rotation function stack: %w", secretID, err)
+	}
+
+	d.SetId(aws.StringValue(output.ARN))
+	d.Set("secret_id", secretID)
+	d.Set("stack_id", stack.StackId)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// managedRotationApplicationID returns the ARN of the AWS-provided rotation
+// application for the specified engine and strategy.
+func managedRotationApplicationID(partition, engine string, multiUser bool) (string, error) {
+	name, ok := managedRotationApplicationNames[engine]
+
+	if !ok {
+		return "", fmt.Errorf("unsupported Secrets Manager managed rotation engine: %s", engine)
+	}
+
+	if multiUser {
+		name += "MultiUser"
+	} else {
+		name += "SingleUser"
+	}
+
+	var region, accountID string
+
+	switch partition {
+	case endpoints.AwsPartitionID:
+		region, accountID = endpoints.UsEast1RegionID, "297356227824"
+	case endpoints.AwsUsGovPartitionID:
+		region, accountID = endpoints.UsGovWest1RegionID, "023102451235"
+	default:
+		return "", fmt.Errorf("Secrets Manager managed rotation is not supported in partition: %s", partition)
+	}
+
+	return arn.ARN{
+		Partition: partition,
+		Service:   serverlessrepo.ServiceName,
+		Region:    region,
+		AccountID: accountID,
+		Resource:  "applications/" + name,
+	}.String(), nil
+}
+
+// managedRotationApplicationEngine returns the engine of the specified AWS-provided rotation application.
+func managedRotationApplicationEngine(applicationID string) string {
+	for engine, name := range managedRotationApplicationNames {
+		if strings.HasSuffix(applicationID, "/"+name+"SingleUser") || strings.HasSuffix(applicationID, "/"+name+"MultiUser") {
+			return engine
+		}
+	}
+
+	return ""
+}
+
+// managedRotationStackParameterList splits a comma-delimited list stack parameter.
+func managedRotationStackParameterList(v string) []string {
+	if v == "" {
+		return nil
+	}
+
+	return strings.Split(v, ",")
+}
+
+func managedRotationStackOutput(stack *cloudformation.Stack, key string) string {
+	for _, output := range stack.Outputs {
+		if aws.StringValue(output.OutputKey) == key {
+			return aws.StringValue(output.OutputValue)
+		}
+	}
+
+	return ""
+}
+
+func rotateSecret(conn *secretsmanager.SecretsManager, secretID, rotationLambdaARN string, rotationRules []interface{}) (*secretsmanager.RotateSecretOutput, error) {
+	input := &secretsmanager.RotateSecretInput{
+		RotationLambdaARN: aws.String(rotationLambdaARN),
+		RotationRules:     expandRotationRules(rotationRules),
+		SecretId:          aws.String(secretID),
+	}
+
+	log.Printf("[DEBUG] Enabling Secrets Manager Secret rotation: %s", input)
+	// AccessDeniedException: Secrets Manager cannot invoke the specified Lambda function.
+	outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals(1*time.Minute, func() (interface{}, error) {
+		return conn.RotateSecret(input)
+	}, "AccessDeniedException")
+
+	if err != nil {
+		return nil, err
+	}
+
+	return outputRaw.(*secretsmanager.RotateSecretOutput), nil
+}
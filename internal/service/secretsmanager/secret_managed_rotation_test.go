@@ -0,0 +1,162 @@
+package secretsmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcloudformation "github.com/hashicorp/terraform-provider-aws/internal/service/cloudformation"
+	tfsecretsmanager "github.com/hashicorp/terraform-provider-aws/internal/service/secretsmanager"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccSecretsManagerSecretManagedRotation_basic(t *testing.T) {
+	var secret secretsmanager.DescribeSecretOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_secretsmanager_secret_managed_rotation.test"
+	secretResourceName := "aws_secretsmanager_secret.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			testAccPreCheck(t)
+			acctest.PreCheckPartitionHasService("serverlessrepo", t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, secretsmanager.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecretManagedRotationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecretManagedRotationConfig_basic(rName, 7),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecretManagedRotationExists(resourceName, &secret),
+					resource.TestCheckResourceAttrPair(resourceName, "id", secretResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "engine", "postgres"),
+					resource.TestCheckResourceAttr(resourceName, "function_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "rotation_enabled", "true"),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "rotation_lambda_arn", "lambda", fmt.Sprintf("function:%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "rotation_rules.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rotation_rules.0.automatically_after_days", "7"),
+					resource.TestCheckResourceAttrSet(resourceName, "stack_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSecretManagedRotationConfig_basic(rName, 14),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecretManagedRotationExists(resourceName, &secret),
+					resource.TestCheckResourceAttr(resourceName, "rotation_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "rotation_rules.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rotation_rules.0.automatically_after_days", "14"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSecretManagedRotationDestroy(s *terraform.State) error {
+	cfConn := acctest.Provider.Meta().(*conns.AWSClient).CloudFormationConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SecretsManagerConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_secretsmanager_secret_managed_rotation" {
+			continue
+		}
+
+		_, err := tfcloudformation.FindStackByID(cfConn, rs.Primary.Attributes["stack_id"])
+
+		if err == nil {
+			return fmt.Errorf("Secrets Manager Secret managed rotation function stack %s still exists", rs.Primary.Attributes["stack_id"])
+		}
+
+		if !tfresource.NotFound(err) {
+			return err
+		}
+
+		output, err := tfsecretsmanager.FindSecretByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if aws.BoolValue(output.RotationEnabled) {
+			return fmt.Errorf("Secrets Manager Secret %s rotation still enabled", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckSecretManagedRotationExists(n string, v *secretsmanager.DescribeSecretOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Secrets Manager Secret ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SecretsManagerConn
+
+		output, err := tfsecretsmanager.FindSecretByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if !aws.BoolValue(output.RotationEnabled) {
+			return fmt.Errorf("Secrets Manager Secret %s rotation not enabled", rs.Primary.ID)
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccSecretManagedRotationConfig_basic(rName string, automaticallyAfterDays int) string {
+	return fmt.Sprintf(`
+resource "aws_secretsmanager_secret" "test" {
+  name = %[1]q
+}
+
+resource "aws_secretsmanager_secret_version" "test" {
+  secret_id = aws_secretsmanager_secret.test.id
+
+  secret_string = jsonencode({
+    engine   = "postgres"
+    host     = "example.com"
+    username = "test"
+    password = "test-password"
+    dbname   = "test"
+    port     = 5432
+  })
+}
+
+resource "aws_secretsmanager_secret_managed_rotation" "test" {
+  secret_id     = aws_secretsmanager_secret_version.test.secret_id
+  engine        = "postgres"
+  function_name = %[1]q
+
+  rotation_rules {
+    automatically_after_days = %[2]d
+  }
+}
+`, rName, automaticallyAfterDays)
+}
@@ -0,0 +1,783 @@
+package securityhub
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceAutomationRule() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAutomationRuleCreate,
+		ReadWithoutTimeout:   resourceAutomationRuleRead,
+		UpdateWithoutTimeout: resourceAutomationRuleUpdate,
+		DeleteWithoutTimeout: resourceAutomationRuleDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"actions": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"finding_fields_update": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"confidence": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(0, 100),
+									},
+									"criticality": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(0, 100),
+									},
+									"note": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"text": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"updated_by": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+									"related_findings": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"id": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"product_arn": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+											},
+										},
+									},
+									"severity": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"label": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringInSlice(securityhub.SeverityLabel_Values(), false),
+												},
+												"product": {
+													Type:     schema.TypeFloat,
+													Optional: true,
+												},
+											},
+										},
+									},
+									"types": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"user_defined_fields": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"verification_state": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(securityhub.VerificationState_Values(), false),
+									},
+									"workflow": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"status": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(securityhub.WorkflowStatus_Values(), false),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      securityhub.AutomationRulesActionTypeFindingFieldsUpdate,
+							ValidateFunc: validation.StringInSlice(securityhub.AutomationRulesActionType_Values(), false),
+						},
+					},
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"criteria": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"aws_account_id":                     stringFilterSchema(),
+						"aws_account_name":                   stringFilterSchema(),
+						"company_name":                       stringFilterSchema(),
+						"compliance_associated_standards_id": stringFilterSchema(),
+						"compliance_security_control_id":     stringFilterSchema(),
+						"compliance_status":                  stringFilterSchema(),
+						"confidence":                         numberFilterSchema(),
+						"created_at":                         dateFilterSchema(),
+						"criticality":                        numberFilterSchema(),
+						"description":                        stringFilterSchema(),
+						"first_observed_at":                  dateFilterSchema(),
+						"generator_id":                       stringFilterSchema(),
+						"id":                                 stringFilterSchema(),
+						"last_observed_at":                   dateFilterSchema(),
+						"note_text":                          stringFilterSchema(),
+						"note_updated_at":                    dateFilterSchema(),
+						"note_updated_by":                    stringFilterSchema(),
+						"product_arn":                        stringFilterSchema(),
+						"product_name":                       stringFilterSchema(),
+						"record_state":                       stringFilterSchema(),
+						"related_findings_id":                stringFilterSchema(),
+						"related_findings_product_arn":       stringFilterSchema(),
+						"resource_application_arn":           stringFilterSchema(),
+						"resource_application_name":          stringFilterSchema(),
+						"resource_details_other":             mapFilterSchema(),
+						"resource_id":                        stringFilterSchema(),
+						"resource_partition":                 stringFilterSchema(),
+						"resource_region":                    stringFilterSchema(),
+						"resource_tags":                      mapFilterSchema(),
+						"resource_type":                      stringFilterSchema(),
+						"severity_label":                     stringFilterSchema(),
+						"source_url":                         stringFilterSchema(),
+						"title":                              stringFilterSchema(),
+						"type":                               stringFilterSchema(),
+						"updated_at":                         dateFilterSchema(),
+						"user_defined_fields":                mapFilterSchema(),
+						"verification_state":                 stringFilterSchema(),
+						"workflow_status":                    workflowStatusSchema(),
+					},
+				},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"is_terminal": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"rule_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"rule_order": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 1000),
+			},
+			"rule_status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      securityhub.RuleStatusEnabled,
+				ValidateFunc: validation.StringInSlice(securityhub.RuleStatus_Values(), false),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceAutomationRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SecurityHubConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("rule_name").(string)
+	input := &securityhub.CreateAutomationRuleInput{
+		Actions:     expandAutomationRulesActions(d.Get("actions").(*schema.Set).List()),
+		Criteria:    &securityhub.AutomationRulesFindingFilters{},
+		Description: aws.String(d.Get("description").(string)),
+		IsTerminal:  aws.Bool(d.Get("is_terminal").(bool)),
+		RuleName:    aws.String(name),
+		RuleOrder:   aws.Int64(int64(d.Get("rule_order").(int))),
+		RuleStatus:  aws.String(d.Get("rule_status").(string)),
+	}
+
+	if v, ok := d.GetOk("criteria"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Criteria = expandAutomationRulesFindingFilters(v.([]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Security Hub Automation Rule: %s", input)
+	output, err := conn.CreateAutomationRuleWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Security Hub Automation Rule (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.RuleArn))
+
+	return resourceAutomationRuleRead(ctx, d, meta)
+}
+
+func resourceAutomationRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SecurityHubConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	rule, err := FindAutomationRuleByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Security Hub Automation Rule (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Security Hub Automation Rule (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("actions", flattenAutomationRulesActions(rule.Actions)); err != nil {
+		return diag.Errorf("setting actions: %s", err)
+	}
+	d.Set("arn", rule.RuleArn)
+	if err := d.Set("criteria", flattenAutomationRulesFindingFilters(rule.Criteria)); err != nil {
+		return diag.Errorf("setting criteria: %s", err)
+	}
+	d.Set("description", rule.Description)
+	d.Set("is_terminal", rule.IsTerminal)
+	d.Set("rule_name", rule.RuleName)
+	d.Set("rule_order", rule.RuleOrder)
+	d.Set("rule_status", rule.RuleStatus)
+
+	tags, err := ListTagsWithContext(ctx, conn, d.Id())
+
+	if err != nil {
+		return diag.Errorf("listing tags for Security Hub Automation Rule (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAutomationRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SecurityHubConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		item := &securityhub.UpdateAutomationRulesRequestItem{
+			Actions:     expandAutomationRulesActions(d.Get("actions").(*schema.Set).List()),
+			Criteria:    &securityhub.AutomationRulesFindingFilters{},
+			Description: aws.String(d.Get("description").(string)),
+			IsTerminal:  aws.Bool(d.Get("is_terminal").(bool)),
+			RuleArn:     aws.String(d.Id()),
+			RuleName:    aws.String(d.Get("rule_name").(string)),
+			RuleOrder:   aws.Int64(int64(d.Get("rule_order").(int))),
+			RuleStatus:  aws.String(d.Get("rule_status").(string)),
+		}
+
+		if v, ok := d.GetOk("criteria"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			item.Criteria = expandAutomationRulesFindingFilters(v.([]interface{}))
+		}
+
+		input := &securityhub.BatchUpdateAutomationRulesInput{
+			UpdateAutomationRulesRequestItems: []*securityhub.UpdateAutomationRulesRequestItem{item},
+		}
+
+		log.Printf("[DEBUG] Updating Security Hub Automation Rule: %s", input)
+		output, err := conn.BatchUpdateAutomationRulesWithContext(ctx, input)
+
+		if err == nil && output != nil && len(output.UnprocessedAutomationRules) > 0 {
+			err = unprocessedAutomationRuleError(output.UnprocessedAutomationRules[0])
+		}
+
+		if err != nil {
+			return diag.Errorf("updating Security Hub Automation Rule (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Id(), o, n); err != nil {
+			return diag.Errorf("updating Security Hub Automation Rule (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAutomationRuleRead(ctx, d, meta)
+}
+
+func resourceAutomationRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SecurityHubConn
+
+	log.Printf("[DEBUG] Deleting Security Hub Automation Rule: %s", d.Id())
+	output, err := conn.BatchDeleteAutomationRulesWithContext(ctx, &securityhub.BatchDeleteAutomationRulesInput{
+		AutomationRulesArns: aws.StringSlice([]string{d.Id()}),
+	})
+
+	if tfawserr.ErrCodeEquals(err, securityhub.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err == nil && output != nil && len(output.UnprocessedAutomationRules) > 0 {
+		v := output.UnprocessedAutomationRules[0]
+
+		if aws.Int64Value(v.ErrorCode) == http.StatusNotFound {
+			return nil
+		}
+
+		err = unprocessedAutomationRuleError(v)
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Security Hub Automation Rule (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func unprocessedAutomationRuleError(apiObject *securityhub.UnprocessedAutomationRule) error {
+	return fmt.Errorf("%s (%d): %s", aws.StringValue(apiObject.RuleArn), aws.Int64Value(apiObject.ErrorCode), aws.StringValue(apiObject.ErrorMessage))
+}
+
+func expandAutomationRulesActions(l []interface{}) []*securityhub.AutomationRulesAction {
+	var actions []*securityhub.AutomationRulesAction
+
+	for _, item := range l {
+		tfMap, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		action := &securityhub.AutomationRulesAction{}
+
+		if v, ok := tfMap["finding_fields_update"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			action.FindingFieldsUpdate = expandAutomationRulesFindingFieldsUpdate(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["type"].(string); ok && v != "" {
+			action.Type = aws.String(v)
+		}
+
+		actions = append(actions, action)
+	}
+
+	return actions
+}
+
+func expandAutomationRulesFindingFieldsUpdate(tfMap map[string]interface{}) *securityhub.AutomationRulesFindingFieldsUpdate {
+	update := &securityhub.AutomationRulesFindingFieldsUpdate{}
+
+	if v, ok := tfMap["confidence"].(int); ok && v != 0 {
+		update.Confidence = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["criticality"].(int); ok && v != 0 {
+		update.Criticality = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["note"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		m := v[0].(map[string]interface{})
+
+		update.Note = &securityhub.NoteUpdate{
+			Text:      aws.String(m["text"].(string)),
+			UpdatedBy: aws.String(m["updated_by"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["related_findings"].(*schema.Set); ok && v.Len() > 0 {
+		for _, item := range v.List() {
+			m := item.(map[string]interface{})
+
+			update.RelatedFindings = append(update.RelatedFindings, &securityhub.RelatedFinding{
+				Id:         aws.String(m["id"].(string)),
+				ProductArn: aws.String(m["product_arn"].(string)),
+			})
+		}
+	}
+
+	if v, ok := tfMap["severity"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		m := v[0].(map[string]interface{})
+		severity := &securityhub.SeverityUpdate{}
+
+		if v, ok := m["label"].(string); ok && v != "" {
+			severity.Label = aws.String(v)
+		}
+
+		if v, ok := m["product"].(float64); ok && v != 0 {
+			severity.Product = aws.Float64(v)
+		}
+
+		update.Severity = severity
+	}
+
+	if v, ok := tfMap["types"].(*schema.Set); ok && v.Len() > 0 {
+		update.Types = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["user_defined_fields"].(map[string]interface{}); ok && len(v) > 0 {
+		update.UserDefinedFields = flex.ExpandStringMap(v)
+	}
+
+	if v, ok := tfMap["verification_state"].(string); ok && v != "" {
+		update.VerificationState = aws.String(v)
+	}
+
+	if v, ok := tfMap["workflow"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		m := v[0].(map[string]interface{})
+
+		update.Workflow = &securityhub.WorkflowUpdate{
+			Status: aws.String(m["status"].(string)),
+		}
+	}
+
+	return update
+}
+
+func expandAutomationRulesFindingFilters(l []interface{}) *securityhub.AutomationRulesFindingFilters {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	tfMap, ok := l[0].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	filters := &securityhub.AutomationRulesFindingFilters{}
+
+	if v, ok := tfMap["aws_account_id"].(*schema.Set); ok && v.Len() > 0 {
+		filters.AwsAccountId = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["aws_account_name"].(*schema.Set); ok && v.Len() > 0 {
+		filters.AwsAccountName = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["company_name"].(*schema.Set); ok && v.Len() > 0 {
+		filters.CompanyName = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["compliance_associated_standards_id"].(*schema.Set); ok && v.Len() > 0 {
+		filters.ComplianceAssociatedStandardsId = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["compliance_security_control_id"].(*schema.Set); ok && v.Len() > 0 {
+		filters.ComplianceSecurityControlId = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["compliance_status"].(*schema.Set); ok && v.Len() > 0 {
+		filters.ComplianceStatus = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["confidence"].(*schema.Set); ok && v.Len() > 0 {
+		filters.Confidence = expandNumberFilters(v.List())
+	}
+
+	if v, ok := tfMap["created_at"].(*schema.Set); ok && v.Len() > 0 {
+		filters.CreatedAt = expandDateFilters(v.List())
+	}
+
+	if v, ok := tfMap["criticality"].(*schema.Set); ok && v.Len() > 0 {
+		filters.Criticality = expandNumberFilters(v.List())
+	}
+
+	if v, ok := tfMap["description"].(*schema.Set); ok && v.Len() > 0 {
+		filters.Description = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["first_observed_at"].(*schema.Set); ok && v.Len() > 0 {
+		filters.FirstObservedAt = expandDateFilters(v.List())
+	}
+
+	if v, ok := tfMap["generator_id"].(*schema.Set); ok && v.Len() > 0 {
+		filters.GeneratorId = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["id"].(*schema.Set); ok && v.Len() > 0 {
+		filters.Id = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["last_observed_at"].(*schema.Set); ok && v.Len() > 0 {
+		filters.LastObservedAt = expandDateFilters(v.List())
+	}
+
+	if v, ok := tfMap["note_text"].(*schema.Set); ok && v.Len() > 0 {
+		filters.NoteText = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["note_updated_at"].(*schema.Set); ok && v.Len() > 0 {
+		filters.NoteUpdatedAt = expandDateFilters(v.List())
+	}
+
+	if v, ok := tfMap["note_updated_by"].(*schema.Set); ok && v.Len() > 0 {
+		filters.NoteUpdatedBy = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["product_arn"].(*schema.Set); ok && v.Len() > 0 {
+		filters.ProductArn = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["product_name"].(*schema.Set); ok && v.Len() > 0 {
+		filters.ProductName = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["record_state"].(*schema.Set); ok && v.Len() > 0 {
+		filters.RecordState = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["related_findings_id"].(*schema.Set); ok && v.Len() > 0 {
+		filters.RelatedFindingsId = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["related_findings_product_arn"].(*schema.Set); ok && v.Len() > 0 {
+		filters.RelatedFindingsProductArn = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["resource_application_arn"].(*schema.Set); ok && v.Len() > 0 {
+		filters.ResourceApplicationArn = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["resource_application_name"].(*schema.Set); ok && v.Len() > 0 {
+		filters.ResourceApplicationName = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["resource_details_other"].(*schema.Set); ok && v.Len() > 0 {
+		filters.ResourceDetailsOther = expandMapFilters(v.List())
+	}
+
+	if v, ok := tfMap["resource_id"].(*schema.Set); ok && v.Len() > 0 {
+		filters.ResourceId = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["resource_partition"].(*schema.Set); ok && v.Len() > 0 {
+		filters.ResourcePartition = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["resource_region"].(*schema.Set); ok && v.Len() > 0 {
+		filters.ResourceRegion = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["resource_tags"].(*schema.Set); ok && v.Len() > 0 {
+		filters.ResourceTags = expandMapFilters(v.List())
+	}
+
+	if v, ok := tfMap["resource_type"].(*schema.Set); ok && v.Len() > 0 {
+		filters.ResourceType = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["severity_label"].(*schema.Set); ok && v.Len() > 0 {
+		filters.SeverityLabel = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["source_url"].(*schema.Set); ok && v.Len() > 0 {
+		filters.SourceUrl = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["title"].(*schema.Set); ok && v.Len() > 0 {
+		filters.Title = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["type"].(*schema.Set); ok && v.Len() > 0 {
+		filters.Type = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["updated_at"].(*schema.Set); ok && v.Len() > 0 {
+		filters.UpdatedAt = expandDateFilters(v.List())
+	}
+
+	if v, ok := tfMap["user_defined_fields"].(*schema.Set); ok && v.Len() > 0 {
+		filters.UserDefinedFields = expandMapFilters(v.List())
+	}
+
+	if v, ok := tfMap["verification_state"].(*schema.Set); ok && v.Len() > 0 {
+		filters.VerificationState = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["workflow_status"].(*schema.Set); ok && v.Len() > 0 {
+		filters.WorkflowStatus = expandStringFilters(v.List())
+	}
+
+	return filters
+}
+
+func flattenAutomationRulesActions(actions []*securityhub.AutomationRulesAction) []interface{} {
+	var l []interface{}
+
+	for _, action := range actions {
+		if action == nil {
+			continue
+		}
+
+		m := map[string]interface{}{
+			"type": aws.StringValue(action.Type),
+		}
+
+		if action.FindingFieldsUpdate != nil {
+			m["finding_fields_update"] = []interface{}{flattenAutomationRulesFindingFieldsUpdate(action.FindingFieldsUpdate)}
+		}
+
+		l = append(l, m)
+	}
+
+	return l
+}
+
+func flattenAutomationRulesFindingFieldsUpdate(update *securityhub.AutomationRulesFindingFieldsUpdate) map[string]interface{} {
+	m := map[string]interface{}{
+		"confidence":          aws.Int64Value(update.Confidence),
+		"criticality":         aws.Int64Value(update.Criticality),
+		"types":               aws.StringValueSlice(update.Types),
+		"user_defined_fields": aws.StringValueMap(update.UserDefinedFields),
+		"verification_state":  aws.StringValue(update.VerificationState),
+	}
+
+	if v := update.Note; v != nil {
+		m["note"] = []interface{}{map[string]interface{}{
+			"text":       aws.StringValue(v.Text),
+			"updated_by": aws.StringValue(v.UpdatedBy),
+		}}
+	}
+
+	var relatedFindings []interface{}
+
+	for _, v := range update.RelatedFindings {
+		if v == nil {
+			continue
+		}
+
+		relatedFindings = append(relatedFindings, map[string]interface{}{
+			"id":          aws.StringValue(v.Id),
+			"product_arn": aws.StringValue(v.ProductArn),
+		})
+	}
+
+	m["related_findings"] = relatedFindings
+
+	if v := update.Severity; v != nil {
+		m["severity"] = []interface{}{map[string]interface{}{
+			"label":   aws.StringValue(v.Label),
+			"product": aws.Float64Value(v.Product),
+		}}
+	}
+
+	if v := update.Workflow; v != nil {
+		m["workflow"] = []interface{}{map[string]interface{}{
+			"status": aws.StringValue(v.Status),
+		}}
+	}
+
+	return m
+}
+
+func flattenAutomationRulesFindingFilters(filters *securityhub.AutomationRulesFindingFilters) []interface{} {
+	if filters == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{
+		"aws_account_id":                     flattenStringFilters(filters.AwsAccountId),
+		"aws_account_name":                   flattenStringFilters(filters.AwsAccountName),
+		"company_name":                       flattenStringFilters(filters.CompanyName),
+		"compliance_associated_standards_id": flattenStringFilters(filters.ComplianceAssociatedStandardsId),
+		"compliance_security_control_id":     flattenStringFilters(filters.ComplianceSecurityControlId),
+		"compliance_status":                  flattenStringFilters(filters.ComplianceStatus),
+		"confidence":                         flattenNumberFilters(filters.Confidence),
+		"created_at":                         flattenDateFilters(filters.CreatedAt),
+		"criticality":                        flattenNumberFilters(filters.Criticality),
+		"description":                        flattenStringFilters(filters.Description),
+		"first_observed_at":                  flattenDateFilters(filters.FirstObservedAt),
+		"generator_id":                       flattenStringFilters(filters.GeneratorId),
+		"id":                                 flattenStringFilters(filters.Id),
+		"last_observed_at":                   flattenDateFilters(filters.LastObservedAt),
+		"note_text":                          flattenStringFilters(filters.NoteText),
+		"note_updated_at":                    flattenDateFilters(filters.NoteUpdatedAt),
+		"note_updated_by":                    flattenStringFilters(filters.NoteUpdatedBy),
+		"product_arn":                        flattenStringFilters(filters.ProductArn),
+		"product_name":                       flattenStringFilters(filters.ProductName),
+		"record_state":                       flattenStringFilters(filters.RecordState),
+		"related_findings_id":                flattenStringFilters(filters.RelatedFindingsId),
+		"related_findings_product_arn":       flattenStringFilters(filters.RelatedFindingsProductArn),
+		"resource_application_arn":           flattenStringFilters(filters.ResourceApplicationArn),
+		"resource_application_name":          flattenStringFilters(filters.ResourceApplicationName),
+		"resource_details_other":             flattenMapFilters(filters.ResourceDetailsOther),
+		"resource_id":                        flattenStringFilters(filters.ResourceId),
+		"resource_partition":                 flattenStringFilters(filters.ResourcePartition),
+		"resource_region":                    flattenStringFilters(filters.ResourceRegion),
+		"resource_tags":                      flattenMapFilters(filters.ResourceTags),
+		"resource_type":                      flattenStringFilters(filters.ResourceType),
+		"severity_label":                     flattenStringFilters(filters.SeverityLabel),
+		"source_url":                         flattenStringFilters(filters.SourceUrl),
+		"title":                              flattenStringFilters(filters.Title),
+		"type":                               flattenStringFilters(filters.Type),
+		"updated_at":                         flattenDateFilters(filters.UpdatedAt),
+		"user_defined_fields":                flattenMapFilters(filters.UserDefinedFields),
+		"verification_state":                 flattenStringFilters(filters.VerificationState),
+		"workflow_status":                    flattenStringFilters(filters.WorkflowStatus),
+	}
+
+	// A rule without criteria applies to all findings and is returned with an empty criteria object.
+	for _, v := range m {
+		if len(v.([]interface{})) > 0 {
+			return []interface{}{m}
+		}
+	}
+
+	return nil
+}
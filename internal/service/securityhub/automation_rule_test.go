@@ -0,0 +1,456 @@
+package securityhub_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsecurityhub "github.com/hashicorp/terraform-provider-aws/internal/service/securityhub"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccAutomationRule_basic(t *testing.T) {
+	var v securityhub.AutomationRulesConfig
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_securityhub_automation_rule.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, securityhub.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAutomationRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAutomationRuleConfig_basic(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAutomationRuleExists(resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "securityhub", regexp.MustCompile(`automation-rule/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "actions.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "actions.*", map[string]string{
+						"type":                                       securityhub.AutomationRulesActionTypeFindingFieldsUpdate,
+						"finding_fields_update.#":                    "1",
+						"finding_fields_update.0.severity.#":         "1",
+						"finding_fields_update.0.severity.0.label":   securityhub.SeverityLabelLow,
+						"finding_fields_update.0.workflow.#":         "0",
+						"finding_fields_update.0.related_findings.#": "0",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "criteria.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.resource_type.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "criteria.0.resource_type.*", map[string]string{
+						"comparison": securityhub.StringFilterComparisonEquals,
+						"value":      "AwsS3Bucket",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "description", "test description"),
+					resource.TestCheckResourceAttr(resourceName, "is_terminal", "false"),
+					resource.TestCheckResourceAttr(resourceName, "rule_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "rule_order", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule_status", securityhub.RuleStatusEnabled),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAutomationRuleConfig_basic(rName, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAutomationRuleExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "rule_order", "10"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAutomationRule_disappears(t *testing.T) {
+	var v securityhub.AutomationRulesConfig
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_securityhub_automation_rule.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, securityhub.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAutomationRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAutomationRuleConfig_basic(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAutomationRuleExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfsecurityhub.ResourceAutomationRule(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccAutomationRule_findingFields(t *testing.T) {
+	var v securityhub.AutomationRulesConfig
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_securityhub_automation_rule.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, securityhub.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAutomationRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAutomationRuleConfig_findingFields(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAutomationRuleExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "actions.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "actions.*", map[string]string{
+						"finding_fields_update.#":                                 "1",
+						"finding_fields_update.0.confidence":                      "20",
+						"finding_fields_update.0.criticality":                     "90",
+						"finding_fields_update.0.note.#":                          "1",
+						"finding_fields_update.0.note.0.text":                     "example note",
+						"finding_fields_update.0.note.0.updated_by":               "TerraformAcceptanceTest",
+						"finding_fields_update.0.types.#":                         "1",
+						"finding_fields_update.0.user_defined_fields.%":           "1",
+						"finding_fields_update.0.user_defined_fields.reviewed_by": "security-team",
+						"finding_fields_update.0.verification_state":              securityhub.VerificationStateTruePositive,
+					}),
+					resource.TestCheckResourceAttr(resourceName, "criteria.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.criticality.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "criteria.0.criticality.*", map[string]string{
+						"gte": "50",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.resource_tags.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "criteria.0.resource_tags.*", map[string]string{
+						"comparison": securityhub.MapFilterComparisonEquals,
+						"key":        "Environment",
+						"value":      "production",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "is_terminal", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAutomationRule_suppression(t *testing.T) {
+	var v securityhub.AutomationRulesConfig
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_securityhub_automation_rule.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, securityhub.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAutomationRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAutomationRuleConfig_suppression(rName, securityhub.RuleStatusEnabled),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAutomationRuleExists(resourceName, &v),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "actions.*", map[string]string{
+						"finding_fields_update.0.workflow.#":        "1",
+						"finding_fields_update.0.workflow.0.status": securityhub.WorkflowStatusSuppressed,
+					}),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.compliance_security_control_id.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule_status", securityhub.RuleStatusEnabled),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAutomationRuleConfig_suppression(rName, securityhub.RuleStatusDisabled),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAutomationRuleExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "rule_status", securityhub.RuleStatusDisabled),
+				),
+			},
+		},
+	})
+}
+
+func testAccAutomationRule_tags(t *testing.T) {
+	var v securityhub.AutomationRulesConfig
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_securityhub_automation_rule.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, securityhub.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAutomationRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAutomationRuleConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAutomationRuleExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAutomationRuleConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAutomationRuleExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAutomationRuleConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAutomationRuleExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAutomationRuleExists(n string, v *securityhub.AutomationRulesConfig) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Security Hub Automation Rule ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityHubConn
+
+		output, err := tfsecurityhub.FindAutomationRuleByARN(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAutomationRuleDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityHubConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_securityhub_automation_rule" {
+			continue
+		}
+
+		_, err := tfsecurityhub.FindAutomationRuleByARN(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if tfawserr.ErrMessageContains(err, securityhub.ErrCodeInvalidAccessException, "not subscribed to AWS Security Hub") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Security Hub Automation Rule %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+const testAccAutomationRuleConfig_base = `
+resource "aws_securityhub_account" "test" {}
+`
+
+func testAccAutomationRuleConfig_basic(rName string, ruleOrder int) string {
+	return acctest.ConfigCompose(testAccAutomationRuleConfig_base, fmt.Sprintf(`
+resource "aws_securityhub_automation_rule" "test" {
+  description = "test description"
+  rule_name   = %[1]q
+  rule_order  = %[2]d
+
+  actions {
+    finding_fields_update {
+      severity {
+        label = "LOW"
+      }
+    }
+  }
+
+  criteria {
+    resource_type {
+      comparison = "EQUALS"
+      value      = "AwsS3Bucket"
+    }
+  }
+
+  depends_on = [aws_securityhub_account.test]
+}
+`, rName, ruleOrder))
+}
+
+func testAccAutomationRuleConfig_findingFields(rName string) string {
+	return acctest.ConfigCompose(testAccAutomationRuleConfig_base, fmt.Sprintf(`
+resource "aws_securityhub_automation_rule" "test" {
+  description = "test description"
+  is_terminal = true
+  rule_name   = %[1]q
+  rule_order  = 1
+
+  actions {
+    type = "FINDING_FIELDS_UPDATE"
+
+    finding_fields_update {
+      confidence         = 20
+      criticality        = 90
+      types              = ["Software and Configuration Checks/Industry and Regulatory Standards"]
+      verification_state = "TRUE_POSITIVE"
+
+      note {
+        text       = "example note"
+        updated_by = "TerraformAcceptanceTest"
+      }
+
+      user_defined_fields = {
+        reviewed_by = "security-team"
+      }
+    }
+  }
+
+  criteria {
+    criticality {
+      gte = "50"
+    }
+
+    resource_tags {
+      comparison = "EQUALS"
+      key        = "Environment"
+      value      = "production"
+    }
+  }
+
+  depends_on = [aws_securityhub_account.test]
+}
+`, rName))
+}
+
+func testAccAutomationRuleConfig_suppression(rName, ruleStatus string) string {
+	return acctest.ConfigCompose(testAccAutomationRuleConfig_base, fmt.Sprintf(`
+resource "aws_securityhub_automation_rule" "test" {
+  description = "Suppress findings for a control that does not apply"
+  rule_name   = %[1]q
+  rule_order  = 1
+  rule_status = %[2]q
+
+  actions {
+    finding_fields_update {
+      note {
+        text       = "Suppressed by automation rule"
+        updated_by = "TerraformAcceptanceTest"
+      }
+
+      workflow {
+        status = "SUPPRESSED"
+      }
+    }
+  }
+
+  criteria {
+    compliance_security_control_id {
+      comparison = "EQUALS"
+      value      = "S3.1"
+    }
+  }
+
+  depends_on = [aws_securityhub_account.test]
+}
+`, rName, ruleStatus))
+}
+
+func testAccAutomationRuleConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccAutomationRuleConfig_base, fmt.Sprintf(`
+resource "aws_securityhub_automation_rule" "test" {
+  description = "test description"
+  rule_name   = %[1]q
+  rule_order  = 1
+
+  actions {
+    finding_fields_update {
+      severity {
+        label = "LOW"
+      }
+    }
+  }
+
+  criteria {
+    resource_type {
+      comparison = "EQUALS"
+      value      = "AwsS3Bucket"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+
+  depends_on = [aws_securityhub_account.test]
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccAutomationRuleConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccAutomationRuleConfig_base, fmt.Sprintf(`
+resource "aws_securityhub_automation_rule" "test" {
+  description = "test description"
+  rule_name   = %[1]q
+  rule_order  = 1
+
+  actions {
+    finding_fields_update {
+      severity {
+        label = "LOW"
+      }
+    }
+  }
+
+  criteria {
+    resource_type {
+      comparison = "EQUALS"
+      value      = "AwsS3Bucket"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+
+  depends_on = [aws_securityhub_account.test]
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,475 @@
+package securityhub
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceConfigurationPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceConfigurationPolicyCreate,
+		ReadWithoutTimeout:   resourceConfigurationPolicyRead,
+		UpdateWithoutTimeout: resourceConfigurationPolicyUpdate,
+		DeleteWithoutTimeout: resourceConfigurationPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configuration_policy": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled_standard_arns": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: verify.ValidARN,
+							},
+						},
+						"security_controls_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"disabled_control_identifiers": {
+										Type:          schema.TypeSet,
+										Optional:      true,
+										Elem:          &schema.Schema{Type: schema.TypeString},
+										ConflictsWith: []string{"configuration_policy.0.security_controls_configuration.0.enabled_control_identifiers"},
+									},
+									"enabled_control_identifiers": {
+										Type:          schema.TypeSet,
+										Optional:      true,
+										Elem:          &schema.Schema{Type: schema.TypeString},
+										ConflictsWith: []string{"configuration_policy.0.security_controls_configuration.0.disabled_control_identifiers"},
+									},
+									"security_control_custom_parameter": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"parameter": {
+													Type:     schema.TypeSet,
+													Required: true,
+													MinItems: 1,
+													Elem:     securityControlParameterResource(),
+												},
+												"security_control_id": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"service_enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func securityControlParameterResource() *schema.Resource {
+	valueBlock := func(s *schema.Schema) *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"value": s,
+				},
+			},
+		}
+	}
+
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"bool":   valueBlock(&schema.Schema{Type: schema.TypeBool, Required: true}),
+			"double": valueBlock(&schema.Schema{Type: schema.TypeFloat, Required: true}),
+			"enum":   valueBlock(&schema.Schema{Type: schema.TypeString, Required: true}),
+			"enum_list": valueBlock(&schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			}),
+			"int": valueBlock(&schema.Schema{Type: schema.TypeInt, Required: true}),
+			"int_list": valueBlock(&schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			}),
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"string": valueBlock(&schema.Schema{Type: schema.TypeString, Required: true}),
+			"string_list": valueBlock(&schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			}),
+			"value_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(securityhub.ParameterValueType_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceConfigurationPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SecurityHubConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &securityhub.CreateConfigurationPolicyInput{
+		ConfigurationPolicy: expandConfigurationPolicy(d.Get("configuration_policy").([]interface{})),
+		Name:                aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Security Hub Configuration Policy: %s", input)
+	output, err := conn.CreateConfigurationPolicyWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Security Hub Configuration Policy (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Id))
+
+	return resourceConfigurationPolicyRead(ctx, d, meta)
+}
+
+func resourceConfigurationPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SecurityHubConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindConfigurationPolicyByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Security Hub Configuration Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Security Hub Configuration Policy (%s): %s", d.Id(), err)
+	}
+
+	arn := aws.StringValue(output.Arn)
+	d.Set("arn", arn)
+	if err := d.Set("configuration_policy", flattenConfigurationPolicy(output.ConfigurationPolicy)); err != nil {
+		return diag.Errorf("setting configuration_policy: %s", err)
+	}
+	d.Set("description", output.Description)
+	d.Set("name", output.Name)
+
+	tags, err := ListTagsWithContext(ctx, conn, arn)
+
+	if err != nil {
+		return diag.Errorf("listing tags for Security Hub Configuration Policy (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceConfigurationPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SecurityHubConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &securityhub.UpdateConfigurationPolicyInput{
+			ConfigurationPolicy: expandConfigurationPolicy(d.Get("configuration_policy").([]interface{})),
+			Description:         aws.String(d.Get("description").(string)),
+			Identifier:          aws.String(d.Id()),
+			Name:                aws.String(d.Get("name").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating Security Hub Configuration Policy: %s", input)
+		_, err := conn.UpdateConfigurationPolicyWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating Security Hub Configuration Policy (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating Security Hub Configuration Policy (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceConfigurationPolicyRead(ctx, d, meta)
+}
+
+func resourceConfigurationPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SecurityHubConn
+
+	log.Printf("[DEBUG] Deleting Security Hub Configuration Policy: %s", d.Id())
+	_, err := conn.DeleteConfigurationPolicyWithContext(ctx, &securityhub.DeleteConfigurationPolicyInput{
+		Identifier: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, securityhub.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Security Hub Configuration Policy (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandConfigurationPolicy(tfList []interface{}) *securityhub.Policy {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &securityhub.SecurityHubPolicy{
+		ServiceEnabled: aws.Bool(tfMap["service_enabled"].(bool)),
+	}
+
+	if !aws.BoolValue(apiObject.ServiceEnabled) {
+		return &securityhub.Policy{SecurityHub: apiObject}
+	}
+
+	apiObject.EnabledStandardIdentifiers = aws.StringSlice([]string{})
+	if v, ok := tfMap["enabled_standard_arns"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.EnabledStandardIdentifiers = flex.ExpandStringSet(v)
+	}
+
+	// An empty list of disabled controls enables all controls, including new ones.
+	apiObject.SecurityControlsConfiguration = &securityhub.SecurityControlsConfiguration{
+		DisabledSecurityControlIdentifiers: aws.StringSlice([]string{}),
+	}
+	if v, ok := tfMap["security_controls_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SecurityControlsConfiguration = expandSecurityControlsConfiguration(v[0].(map[string]interface{}))
+	}
+
+	return &securityhub.Policy{SecurityHub: apiObject}
+}
+
+func expandSecurityControlsConfiguration(tfMap map[string]interface{}) *securityhub.SecurityControlsConfiguration {
+	apiObject := &securityhub.SecurityControlsConfiguration{}
+
+	if v, ok := tfMap["enabled_control_identifiers"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.EnabledSecurityControlIdentifiers = flex.ExpandStringSet(v)
+	} else if v, ok := tfMap["disabled_control_identifiers"].(*schema.Set); ok {
+		apiObject.DisabledSecurityControlIdentifiers = aws.StringSlice([]string{})
+
+		if v.Len() > 0 {
+			apiObject.DisabledSecurityControlIdentifiers = flex.ExpandStringSet(v)
+		}
+	}
+
+	if v, ok := tfMap["security_control_custom_parameter"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			customParameter := &securityhub.SecurityControlCustomParameter{
+				Parameters:        map[string]*securityhub.ParameterConfiguration{},
+				SecurityControlId: aws.String(tfMap["security_control_id"].(string)),
+			}
+
+			for _, tfMapRaw := range tfMap["parameter"].(*schema.Set).List() {
+				tfMap := tfMapRaw.(map[string]interface{})
+
+				customParameter.Parameters[tfMap["name"].(string)] = expandParameterConfiguration(tfMap)
+			}
+
+			apiObject.SecurityControlCustomParameters = append(apiObject.SecurityControlCustomParameters, customParameter)
+		}
+	}
+
+	return apiObject
+}
+
+func expandParameterConfiguration(tfMap map[string]interface{}) *securityhub.ParameterConfiguration {
+	apiObject := &securityhub.ParameterConfiguration{
+		ValueType: aws.String(tfMap["value_type"].(string)),
+	}
+
+	value := func(k string) (interface{}, bool) {
+		if v, ok := tfMap[k].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			return v[0].(map[string]interface{})["value"], true
+		}
+
+		return nil, false
+	}
+
+	if v, ok := value("bool"); ok {
+		apiObject.Value = &securityhub.ParameterValue{Boolean: aws.Bool(v.(bool))}
+	} else if v, ok := value("double"); ok {
+		apiObject.Value = &securityhub.ParameterValue{Double: aws.Float64(v.(float64))}
+	} else if v, ok := value("enum"); ok {
+		apiObject.Value = &securityhub.ParameterValue{Enum: aws.String(v.(string))}
+	} else if v, ok := value("enum_list"); ok {
+		apiObject.Value = &securityhub.ParameterValue{EnumList: flex.ExpandStringList(v.([]interface{}))}
+	} else if v, ok := value("int"); ok {
+		apiObject.Value = &securityhub.ParameterValue{Integer: aws.Int64(int64(v.(int)))}
+	} else if v, ok := value("int_list"); ok {
+		apiObject.Value = &securityhub.ParameterValue{IntegerList: flex.ExpandInt64List(v.([]interface{}))}
+	} else if v, ok := value("string"); ok {
+		apiObject.Value = &securityhub.ParameterValue{String_: aws.String(v.(string))}
+	} else if v, ok := value("string_list"); ok {
+		apiObject.Value = &securityhub.ParameterValue{StringList: flex.ExpandStringList(v.([]interface{}))}
+	}
+
+	return apiObject
+}
+
+func flattenConfigurationPolicy(apiObject *securityhub.Policy) []interface{} {
+	if apiObject == nil || apiObject.SecurityHub == nil {
+		return nil
+	}
+
+	policy := apiObject.SecurityHub
+	tfMap := map[string]interface{}{
+		"enabled_standard_arns": aws.StringValueSlice(policy.EnabledStandardIdentifiers),
+		"service_enabled":       aws.BoolValue(policy.ServiceEnabled),
+	}
+
+	// An empty list of disabled controls is sent when security_controls_configuration is omitted.
+	if v := policy.SecurityControlsConfiguration; v != nil && (len(v.DisabledSecurityControlIdentifiers) > 0 || len(v.EnabledSecurityControlIdentifiers) > 0 || len(v.SecurityControlCustomParameters) > 0) {
+		tfMap["security_controls_configuration"] = []interface{}{flattenSecurityControlsConfiguration(v)}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenSecurityControlsConfiguration(apiObject *securityhub.SecurityControlsConfiguration) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"disabled_control_identifiers": aws.StringValueSlice(apiObject.DisabledSecurityControlIdentifiers),
+		"enabled_control_identifiers":  aws.StringValueSlice(apiObject.EnabledSecurityControlIdentifiers),
+	}
+
+	var customParameters []interface{}
+
+	for _, v := range apiObject.SecurityControlCustomParameters {
+		if v == nil {
+			continue
+		}
+
+		var parameters []interface{}
+
+		for name, parameter := range v.Parameters {
+			if parameter == nil {
+				continue
+			}
+
+			parameters = append(parameters, flattenParameterConfiguration(name, parameter))
+		}
+
+		customParameters = append(customParameters, map[string]interface{}{
+			"parameter":           parameters,
+			"security_control_id": aws.StringValue(v.SecurityControlId),
+		})
+	}
+
+	tfMap["security_control_custom_parameter"] = customParameters
+
+	return tfMap
+}
+
+func flattenParameterConfiguration(name string, apiObject *securityhub.ParameterConfiguration) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"name":       name,
+		"value_type": aws.StringValue(apiObject.ValueType),
+	}
+
+	value := func(v interface{}) []interface{} {
+		return []interface{}{map[string]interface{}{"value": v}}
+	}
+
+	if v := apiObject.Value; v != nil {
+		switch {
+		case v.Boolean != nil:
+			tfMap["bool"] = value(aws.BoolValue(v.Boolean))
+		case v.Double != nil:
+			tfMap["double"] = value(aws.Float64Value(v.Double))
+		case v.Enum != nil:
+			tfMap["enum"] = value(aws.StringValue(v.Enum))
+		case v.EnumList != nil:
+			tfMap["enum_list"] = value(aws.StringValueSlice(v.EnumList))
+		case v.Integer != nil:
+			tfMap["int"] = value(int(aws.Int64Value(v.Integer)))
+		case v.IntegerList != nil:
+			tfMap["int_list"] = value(flex.FlattenInt64List(v.IntegerList))
+		case v.String_ != nil:
+			tfMap["string"] = value(aws.StringValue(v.String_))
+		case v.StringList != nil:
+			tfMap["string_list"] = value(aws.StringValueSlice(v.StringList))
+		}
+	}
+
+	return tfMap
+}
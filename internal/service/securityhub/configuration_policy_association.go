@@ -0,0 +1,160 @@
+package securityhub
+
+import (
+	"context"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceConfigurationPolicyAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceConfigurationPolicyAssociationCreate,
+		ReadWithoutTimeout:   resourceConfigurationPolicyAssociationRead,
+		UpdateWithoutTimeout: resourceConfigurationPolicyAssociationUpdate,
+		DeleteWithoutTimeout: resourceConfigurationPolicyAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(3 * time.Minute),
+			Update: schema.DefaultTimeout(3 * time.Minute),
+			Delete: schema.DefaultTimeout(3 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"target_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.Any(
+					validation.StringMatch(regexp.MustCompile(`^\d{12}$`), "must be an AWS account ID"),
+					validation.StringMatch(regexp.MustCompile(`^ou-[0-9a-z]{4,32}-[a-z0-9]{8,32}$`), "must be an organizational unit ID"),
+					validation.StringMatch(regexp.MustCompile(`^r-[0-9a-z]{4,32}$`), "must be an organization root ID"),
+				),
+			},
+		},
+	}
+}
+
+func resourceConfigurationPolicyAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SecurityHubConn
+
+	targetID := d.Get("target_id").(string)
+
+	if err := startConfigurationPolicyAssociation(ctx, conn, d.Get("policy_id").(string), targetID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("creating Security Hub Configuration Policy Association (%s): %s", targetID, err)
+	}
+
+	d.SetId(targetID)
+
+	return resourceConfigurationPolicyAssociationRead(ctx, d, meta)
+}
+
+func resourceConfigurationPolicyAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SecurityHubConn
+
+	output, err := FindConfigurationPolicyAssociationByTargetID(ctx, conn, d.Id())
+
+	// A target that no longer has a policy applied directly inherits its parent's policy.
+	if err == nil && aws.StringValue(output.AssociationType) != securityhub.AssociationTypeApplied {
+		err = tfresource.NewEmptyResultError(nil)
+	}
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Security Hub Configuration Policy Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Security Hub Configuration Policy Association (%s): %s", d.Id(), err)
+	}
+
+	d.Set("policy_id", output.ConfigurationPolicyId)
+	d.Set("target_id", output.TargetId)
+
+	return nil
+}
+
+func resourceConfigurationPolicyAssociationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SecurityHubConn
+
+	if d.HasChange("policy_id") {
+		if err := startConfigurationPolicyAssociation(ctx, conn, d.Get("policy_id").(string), d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("updating Security Hub Configuration Policy Association (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceConfigurationPolicyAssociationRead(ctx, d, meta)
+}
+
+func resourceConfigurationPolicyAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SecurityHubConn
+
+	log.Printf("[DEBUG] Deleting Security Hub Configuration Policy Association: %s", d.Id())
+	_, err := conn.StartConfigurationPolicyDisassociationWithContext(ctx, &securityhub.StartConfigurationPolicyDisassociationInput{
+		ConfigurationPolicyIdentifier: aws.String(d.Get("policy_id").(string)),
+		Target:                        expandConfigurationPolicyAssociationTarget(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, securityhub.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Security Hub Configuration Policy Association (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitConfigurationPolicyAssociationSucceeded(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for Security Hub Configuration Policy Association (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func startConfigurationPolicyAssociation(ctx context.Context, conn *securityhub.SecurityHub, policyID, targetID string, timeout time.Duration) error {
+	input := &securityhub.StartConfigurationPolicyAssociationInput{
+		ConfigurationPolicyIdentifier: aws.String(policyID),
+		Target:                        expandConfigurationPolicyAssociationTarget(targetID),
+	}
+
+	log.Printf("[DEBUG] Starting Security Hub Configuration Policy Association: %s", input)
+	if _, err := conn.StartConfigurationPolicyAssociationWithContext(ctx, input); err != nil {
+		return err
+	}
+
+	if _, err := waitConfigurationPolicyAssociationSucceeded(ctx, conn, targetID, timeout); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// expandConfigurationPolicyAssociationTarget returns the association target for an account, organizational unit or root ID.
+func expandConfigurationPolicyAssociationTarget(targetID string) *securityhub.Target {
+	switch {
+	case strings.HasPrefix(targetID, "ou-"):
+		return &securityhub.Target{OrganizationalUnitId: aws.String(targetID)}
+	case strings.HasPrefix(targetID, "r-"):
+		return &securityhub.Target{RootId: aws.String(targetID)}
+	default:
+		return &securityhub.Target{AccountId: aws.String(targetID)}
+	}
+}
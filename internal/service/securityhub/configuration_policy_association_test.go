@@ -0,0 +1,177 @@
+package securityhub_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/securityhub"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsecurityhub "github.com/hashicorp/terraform-provider-aws/internal/service/securityhub"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccConfigurationPolicyAssociation_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_securityhub_configuration_policy_association.test"
+	policy1ResourceName := "aws_securityhub_configuration_policy.test1"
+	policy2ResourceName := "aws_securityhub_configuration_policy.test2"
+	ouResourceName := "aws_organizations_organizational_unit.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckOrganizationManagementAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, securityhub.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfigurationPolicyAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigurationPolicyAssociationConfig_basic(rName, "test1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationPolicyAssociationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "id", ouResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "policy_id", policy1ResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "target_id", ouResourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfigurationPolicyAssociationConfig_basic(rName, "test2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationPolicyAssociationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "policy_id", policy2ResourceName, "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccConfigurationPolicyAssociation_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_securityhub_configuration_policy_association.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckOrganizationManagementAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, securityhub.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfigurationPolicyAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigurationPolicyAssociationConfig_basic(rName, "test1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationPolicyAssociationExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfsecurityhub.ResourceConfigurationPolicyAssociation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckConfigurationPolicyAssociationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Security Hub Configuration Policy Association ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityHubConn
+
+		output, err := tfsecurityhub.FindConfigurationPolicyAssociationByTargetID(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if v := aws.StringValue(output.AssociationType); v != securityhub.AssociationTypeApplied {
+			return fmt.Errorf("Security Hub Configuration Policy Association (%s) has unexpected association type: %s", rs.Primary.ID, v)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckConfigurationPolicyAssociationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityHubConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_securityhub_configuration_policy_association" {
+			continue
+		}
+
+		output, err := tfsecurityhub.FindConfigurationPolicyAssociationByTargetID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if aws.StringValue(output.AssociationType) != securityhub.AssociationTypeApplied {
+			continue
+		}
+
+		return fmt.Errorf("Security Hub Configuration Policy Association %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccConfigurationPolicyAssociationConfig_basic(rName, policyName string) string {
+	return acctest.ConfigCompose(testAccCentralConfigurationConfig_base, fmt.Sprintf(`
+data "aws_organizations_organization" "current" {}
+
+resource "aws_organizations_organizational_unit" "test" {
+  name      = %[1]q
+  parent_id = data.aws_organizations_organization.current.roots[0].id
+}
+
+resource "aws_securityhub_configuration_policy" "test1" {
+  name = "%[1]s-1"
+
+  configuration_policy {
+    service_enabled = true
+    enabled_standard_arns = [
+      "arn:${data.aws_partition.current.partition}:securityhub:${data.aws_region.current.name}::standards/aws-foundational-security-best-practices/v/1.0.0",
+    ]
+  }
+
+  depends_on = [aws_securityhub_organization_configuration.test]
+}
+
+resource "aws_securityhub_configuration_policy" "test2" {
+  name = "%[1]s-2"
+
+  configuration_policy {
+    service_enabled = false
+  }
+
+  depends_on = [aws_securityhub_organization_configuration.test]
+}
+
+resource "aws_securityhub_configuration_policy_association" "test" {
+  policy_id = aws_securityhub_configuration_policy.%[2]s.id
+  target_id = aws_organizations_organizational_unit.test.id
+}
+`, rName, policyName))
+}
@@ -0,0 +1,438 @@
+package securityhub_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/securityhub"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsecurityhub "github.com/hashicorp/terraform-provider-aws/internal/service/securityhub"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccConfigurationPolicy_basic(t *testing.T) {
+	var v securityhub.GetConfigurationPolicyOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_securityhub_configuration_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckOrganizationManagementAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, securityhub.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfigurationPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigurationPolicyConfig_basic(rName, "description 1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationPolicyExists(resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "securityhub", regexp.MustCompile(`configuration-policy/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "configuration_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration_policy.0.enabled_standard_arns.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration_policy.0.security_controls_configuration.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "configuration_policy.0.service_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "description", "description 1"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfigurationPolicyConfig_basic(rName, "description 2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationPolicyExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "description 2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccConfigurationPolicy_disappears(t *testing.T) {
+	var v securityhub.GetConfigurationPolicyOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_securityhub_configuration_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckOrganizationManagementAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, securityhub.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfigurationPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigurationPolicyConfig_basic(rName, "description 1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationPolicyExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfsecurityhub.ResourceConfigurationPolicy(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccConfigurationPolicy_controlsConfiguration(t *testing.T) {
+	var v securityhub.GetConfigurationPolicyOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_securityhub_configuration_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckOrganizationManagementAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, securityhub.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfigurationPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigurationPolicyConfig_disabledControls(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationPolicyExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "configuration_policy.0.security_controls_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration_policy.0.security_controls_configuration.0.disabled_control_identifiers.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "configuration_policy.0.security_controls_configuration.0.disabled_control_identifiers.*", "APIGateway.1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "configuration_policy.0.security_controls_configuration.0.disabled_control_identifiers.*", "IAM.7"),
+					resource.TestCheckResourceAttr(resourceName, "configuration_policy.0.security_controls_configuration.0.enabled_control_identifiers.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfigurationPolicyConfig_enabledControls(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationPolicyExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "configuration_policy.0.security_controls_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration_policy.0.security_controls_configuration.0.disabled_control_identifiers.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "configuration_policy.0.security_controls_configuration.0.enabled_control_identifiers.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration_policy.0.security_controls_configuration.0.security_control_custom_parameter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration_policy.0.security_controls_configuration.0.security_control_custom_parameter.0.security_control_id", "IAM.7"),
+					resource.TestCheckResourceAttr(resourceName, "configuration_policy.0.security_controls_configuration.0.security_control_custom_parameter.0.parameter.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "configuration_policy.0.security_controls_configuration.0.security_control_custom_parameter.0.parameter.*", map[string]string{
+						"name":         "RequireLowercaseCharacters",
+						"value_type":   securityhub.ParameterValueTypeCustom,
+						"bool.#":       "1",
+						"bool.0.value": "false",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "configuration_policy.0.security_controls_configuration.0.security_control_custom_parameter.0.parameter.*", map[string]string{
+						"name":        "MaxPasswordAge",
+						"value_type":  securityhub.ParameterValueTypeCustom,
+						"int.#":       "1",
+						"int.0.value": "60",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccConfigurationPolicy_serviceDisabled(t *testing.T) {
+	var v securityhub.GetConfigurationPolicyOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_securityhub_configuration_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckOrganizationManagementAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, securityhub.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfigurationPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigurationPolicyConfig_serviceDisabled(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationPolicyExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "configuration_policy.0.enabled_standard_arns.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "configuration_policy.0.security_controls_configuration.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "configuration_policy.0.service_enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccConfigurationPolicy_tags(t *testing.T) {
+	var v securityhub.GetConfigurationPolicyOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_securityhub_configuration_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckOrganizationManagementAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, securityhub.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfigurationPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigurationPolicyConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationPolicyExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfigurationPolicyConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationPolicyExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccConfigurationPolicyConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationPolicyExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckConfigurationPolicyExists(n string, v *securityhub.GetConfigurationPolicyOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Security Hub Configuration Policy ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityHubConn
+
+		output, err := tfsecurityhub.FindConfigurationPolicyByID(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckConfigurationPolicyDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityHubConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_securityhub_configuration_policy" {
+			continue
+		}
+
+		_, err := tfsecurityhub.FindConfigurationPolicyByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Security Hub Configuration Policy %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+// testAccCentralConfigurationConfig_base designates the current account as the Security Hub
+// delegated administrator and turns on central configuration.
+const testAccCentralConfigurationConfig_base = `
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_securityhub_organization_admin_account" "test" {
+  admin_account_id = data.aws_caller_identity.current.account_id
+}
+
+resource "aws_securityhub_finding_aggregator" "test" {
+  linking_mode = "ALL_REGIONS"
+
+  depends_on = [aws_securityhub_organization_admin_account.test]
+}
+
+resource "aws_securityhub_organization_configuration" "test" {
+  auto_enable           = false
+  auto_enable_standards = "NONE"
+
+  organization_configuration {
+    configuration_type = "CENTRAL"
+  }
+
+  depends_on = [aws_securityhub_finding_aggregator.test]
+}
+`
+
+func testAccConfigurationPolicyConfig_basic(rName, description string) string {
+	return acctest.ConfigCompose(testAccCentralConfigurationConfig_base, fmt.Sprintf(`
+resource "aws_securityhub_configuration_policy" "test" {
+  name        = %[1]q
+  description = %[2]q
+
+  configuration_policy {
+    service_enabled = true
+    enabled_standard_arns = [
+      "arn:${data.aws_partition.current.partition}:securityhub:${data.aws_region.current.name}::standards/aws-foundational-security-best-practices/v/1.0.0",
+    ]
+  }
+
+  depends_on = [aws_securityhub_organization_configuration.test]
+}
+`, rName, description))
+}
+
+func testAccConfigurationPolicyConfig_disabledControls(rName string) string {
+	return acctest.ConfigCompose(testAccCentralConfigurationConfig_base, fmt.Sprintf(`
+resource "aws_securityhub_configuration_policy" "test" {
+  name = %[1]q
+
+  configuration_policy {
+    service_enabled = true
+    enabled_standard_arns = [
+      "arn:${data.aws_partition.current.partition}:securityhub:${data.aws_region.current.name}::standards/aws-foundational-security-best-practices/v/1.0.0",
+    ]
+
+    security_controls_configuration {
+      disabled_control_identifiers = ["APIGateway.1", "IAM.7"]
+    }
+  }
+
+  depends_on = [aws_securityhub_organization_configuration.test]
+}
+`, rName))
+}
+
+func testAccConfigurationPolicyConfig_enabledControls(rName string) string {
+	return acctest.ConfigCompose(testAccCentralConfigurationConfig_base, fmt.Sprintf(`
+resource "aws_securityhub_configuration_policy" "test" {
+  name = %[1]q
+
+  configuration_policy {
+    service_enabled = true
+    enabled_standard_arns = [
+      "arn:${data.aws_partition.current.partition}:securityhub:${data.aws_region.current.name}::standards/aws-foundational-security-best-practices/v/1.0.0",
+    ]
+
+    security_controls_configuration {
+      enabled_control_identifiers = ["IAM.7"]
+
+      security_control_custom_parameter {
+        security_control_id = "IAM.7"
+
+        parameter {
+          name       = "RequireLowercaseCharacters"
+          value_type = "CUSTOM"
+
+          bool {
+            value = false
+          }
+        }
+
+        parameter {
+          name       = "MaxPasswordAge"
+          value_type = "CUSTOM"
+
+          int {
+            value = 60
+          }
+        }
+      }
+    }
+  }
+
+  depends_on = [aws_securityhub_organization_configuration.test]
+}
+`, rName))
+}
+
+func testAccConfigurationPolicyConfig_serviceDisabled(rName string) string {
+	return acctest.ConfigCompose(testAccCentralConfigurationConfig_base, fmt.Sprintf(`
+resource "aws_securityhub_configuration_policy" "test" {
+  name = %[1]q
+
+  configuration_policy {
+    service_enabled = false
+  }
+
+  depends_on = [aws_securityhub_organization_configuration.test]
+}
+`, rName))
+}
+
+func testAccConfigurationPolicyConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccCentralConfigurationConfig_base, fmt.Sprintf(`
+resource "aws_securityhub_configuration_policy" "test" {
+  name = %[1]q
+
+  configuration_policy {
+    service_enabled = false
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+
+  depends_on = [aws_securityhub_organization_configuration.test]
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccConfigurationPolicyConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccCentralConfigurationConfig_base, fmt.Sprintf(`
+resource "aws_securityhub_configuration_policy" "test" {
+  name = %[1]q
+
+  configuration_policy {
+    service_enabled = false
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+
+  depends_on = [aws_securityhub_organization_configuration.test]
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/securityhub"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func FindAdminAccount(conn *securityhub.SecurityHub, adminAccountID string) (*securityhub.AdminAccount, error) {
@@ -132,3 +133,98 @@ func FindStandardsSubscriptionByARN(conn *securityhub.SecurityHub, arn string) (
 
 	return subscription, nil
 }
+
+func FindAutomationRuleByARN(ctx context.Context, conn *securityhub.SecurityHub, arn string) (*securityhub.AutomationRulesConfig, error) {
+	input := &securityhub.BatchGetAutomationRulesInput{
+		AutomationRulesArns: aws.StringSlice([]string{arn}),
+	}
+
+	output, err := conn.BatchGetAutomationRulesWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, securityhub.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Rules) == 0 || output.Rules[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.Rules); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.Rules[0], nil
+}
+
+func FindOrganizationConfiguration(ctx context.Context, conn *securityhub.SecurityHub) (*securityhub.DescribeOrganizationConfigurationOutput, error) {
+	input := &securityhub.DescribeOrganizationConfigurationInput{}
+
+	output, err := conn.DescribeOrganizationConfigurationWithContext(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindConfigurationPolicyByID(ctx context.Context, conn *securityhub.SecurityHub, id string) (*securityhub.GetConfigurationPolicyOutput, error) {
+	input := &securityhub.GetConfigurationPolicyInput{
+		Identifier: aws.String(id),
+	}
+
+	output, err := conn.GetConfigurationPolicyWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, securityhub.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ConfigurationPolicy == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindConfigurationPolicyAssociationByTargetID(ctx context.Context, conn *securityhub.SecurityHub, targetID string) (*securityhub.GetConfigurationPolicyAssociationOutput, error) {
+	input := &securityhub.GetConfigurationPolicyAssociationInput{
+		Target: expandConfigurationPolicyAssociationTarget(targetID),
+	}
+
+	output, err := conn.GetConfigurationPolicyAssociationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, securityhub.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -1,23 +1,33 @@
 package securityhub
 
 import (
-	"fmt"
+	"context"
+	"log"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 )
 
 func ResourceOrganizationConfiguration() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceOrganizationConfigurationUpdate,
-		Read:   resourceOrganizationConfigurationRead,
-		Update: resourceOrganizationConfigurationUpdate,
-		Delete: schema.Noop,
+		CreateWithoutTimeout: resourceOrganizationConfigurationUpdate,
+		ReadWithoutTimeout:   resourceOrganizationConfigurationRead,
+		UpdateWithoutTimeout: resourceOrganizationConfigurationUpdate,
+		DeleteWithoutTimeout: resourceOrganizationConfigurationDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(3 * time.Minute),
+			Update: schema.DefaultTimeout(3 * time.Minute),
+			Delete: schema.DefaultTimeout(3 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -25,38 +35,145 @@ func ResourceOrganizationConfiguration() *schema.Resource {
 				Type:     schema.TypeBool,
 				Required: true,
 			},
+			"auto_enable_standards": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(securityhub.AutoEnableStandards_Values(), false),
+			},
+			"organization_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"configuration_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(securityhub.OrganizationConfigurationConfigurationType_Values(), false),
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
-func resourceOrganizationConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceOrganizationConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).SecurityHubConn
 
 	input := &securityhub.UpdateOrganizationConfigurationInput{
 		AutoEnable: aws.Bool(d.Get("auto_enable").(bool)),
 	}
 
-	_, err := conn.UpdateOrganizationConfiguration(input)
+	if v, ok := d.GetOk("auto_enable_standards"); ok {
+		input.AutoEnableStandards = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("organization_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.OrganizationConfiguration = expandOrganizationConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	_, err := conn.UpdateOrganizationConfigurationWithContext(ctx, input)
 
 	if err != nil {
-		return fmt.Errorf("error updating Security Hub Organization Configuration (%s): %w", d.Id(), err)
+		return diag.Errorf("updating Security Hub Organization Configuration (%s): %s", d.Id(), err)
 	}
 
 	d.SetId(meta.(*conns.AWSClient).AccountID)
 
-	return resourceOrganizationConfigurationRead(d, meta)
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutCreate)
+	}
+
+	if input.OrganizationConfiguration != nil {
+		if _, err := waitOrganizationConfigurationEnabled(ctx, conn, timeout); err != nil {
+			return diag.Errorf("waiting for Security Hub Organization Configuration (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return resourceOrganizationConfigurationRead(ctx, d, meta)
 }
 
-func resourceOrganizationConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceOrganizationConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).SecurityHubConn
 
-	output, err := conn.DescribeOrganizationConfiguration(&securityhub.DescribeOrganizationConfigurationInput{})
+	output, err := FindOrganizationConfiguration(ctx, conn)
 
 	if err != nil {
-		return fmt.Errorf("error reading Security Hub Organization Configuration: %w", err)
+		return diag.Errorf("reading Security Hub Organization Configuration (%s): %s", d.Id(), err)
 	}
 
 	d.Set("auto_enable", output.AutoEnable)
+	d.Set("auto_enable_standards", output.AutoEnableStandards)
+	if output.OrganizationConfiguration != nil {
+		if err := d.Set("organization_configuration", []interface{}{flattenOrganizationConfiguration(output.OrganizationConfiguration)}); err != nil {
+			return diag.Errorf("setting organization_configuration: %s", err)
+		}
+	} else {
+		d.Set("organization_configuration", nil)
+	}
+
+	return nil
+}
+
+func resourceOrganizationConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SecurityHubConn
+
+	// Central configuration must be turned off before the delegated administrator can be removed.
+	// Local configuration is left as-is.
+	if v, ok := d.GetOk("organization_configuration.0.configuration_type"); !ok || v.(string) != securityhub.OrganizationConfigurationConfigurationTypeCentral {
+		return nil
+	}
+
+	input := &securityhub.UpdateOrganizationConfigurationInput{
+		AutoEnable:          aws.Bool(false),
+		AutoEnableStandards: aws.String(securityhub.AutoEnableStandardsNone),
+		OrganizationConfiguration: &securityhub.OrganizationConfiguration{
+			ConfigurationType: aws.String(securityhub.OrganizationConfigurationConfigurationTypeLocal),
+		},
+	}
+
+	log.Printf("[DEBUG] Reverting Security Hub Organization Configuration to local configuration: %s", d.Id())
+	_, err := conn.UpdateOrganizationConfigurationWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("deleting Security Hub Organization Configuration (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitOrganizationConfigurationEnabled(ctx, conn, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for Security Hub Organization Configuration (%s) delete: %s", d.Id(), err)
+	}
 
 	return nil
 }
+
+func expandOrganizationConfiguration(tfMap map[string]interface{}) *securityhub.OrganizationConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &securityhub.OrganizationConfiguration{}
+
+	if v, ok := tfMap["configuration_type"].(string); ok && v != "" {
+		apiObject.ConfigurationType = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenOrganizationConfiguration(apiObject *securityhub.OrganizationConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ConfigurationType; v != nil {
+		tfMap["configuration_type"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
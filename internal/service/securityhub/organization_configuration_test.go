@@ -43,6 +43,34 @@ func testAccOrganizationConfiguration_basic(t *testing.T) {
 	})
 }
 
+func testAccOrganizationConfiguration_centralConfiguration(t *testing.T) {
+	resourceName := "aws_securityhub_organization_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckOrganizationManagementAccount(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, securityhub.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil, //lintignore:AT001
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCentralConfigurationConfig_base,
+				Check: resource.ComposeTestCheckFunc(
+					testAccOrganizationConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable", "false"),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable_standards", "NONE"),
+					resource.TestCheckResourceAttr(resourceName, "organization_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "organization_configuration.0.configuration_type", "CENTRAL"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccOrganizationConfigurationExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		_, ok := s.RootModule().Resources[n]
@@ -19,6 +19,24 @@ func TestAccSecurityHub_serial(t *testing.T) {
 			"Description": testAccActionTarget_Description,
 			"Name":        testAccActionTarget_Name,
 		},
+		"AutomationRule": {
+			"basic":         testAccAutomationRule_basic,
+			"disappears":    testAccAutomationRule_disappears,
+			"FindingFields": testAccAutomationRule_findingFields,
+			"Suppression":   testAccAutomationRule_suppression,
+			"Tags":          testAccAutomationRule_tags,
+		},
+		"ConfigurationPolicy": {
+			"basic":                 testAccConfigurationPolicy_basic,
+			"disappears":            testAccConfigurationPolicy_disappears,
+			"ControlsConfiguration": testAccConfigurationPolicy_controlsConfiguration,
+			"ServiceDisabled":       testAccConfigurationPolicy_serviceDisabled,
+			"Tags":                  testAccConfigurationPolicy_tags,
+		},
+		"ConfigurationPolicyAssociation": {
+			"basic":      testAccConfigurationPolicyAssociation_basic,
+			"disappears": testAccConfigurationPolicyAssociation_disappears,
+		},
 		"Insight": {
 			"basic":            testAccInsight_basic,
 			"disappears":       testAccInsight_disappears,
@@ -41,7 +59,8 @@ func TestAccSecurityHub_serial(t *testing.T) {
 			"MultiRegion": testAccOrganizationAdminAccount_MultiRegion,
 		},
 		"OrganizationConfiguration": {
-			"basic": testAccOrganizationConfiguration_basic,
+			"basic":                testAccOrganizationConfiguration_basic,
+			"CentralConfiguration": testAccOrganizationConfiguration_centralConfiguration,
 		},
 		"ProductSubscription": {
 			"basic": testAccProductSubscription_basic,
@@ -1,6 +1,8 @@
 package securityhub
 
 import (
+	"context"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/securityhub"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -51,3 +53,35 @@ func statusStandardsSubscription(conn *securityhub.SecurityHub, arn string) reso
 		return output, aws.StringValue(output.StandardsStatus), nil
 	}
 }
+
+func statusOrganizationConfiguration(ctx context.Context, conn *securityhub.SecurityHub) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindOrganizationConfiguration(ctx, conn)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output.OrganizationConfiguration == nil {
+			return output, "", nil
+		}
+
+		return output, aws.StringValue(output.OrganizationConfiguration.Status), nil
+	}
+}
+
+func statusConfigurationPolicyAssociation(ctx context.Context, conn *securityhub.SecurityHub, targetID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindConfigurationPolicyAssociationByTargetID(ctx, conn, targetID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.AssociationStatus), nil
+	}
+}
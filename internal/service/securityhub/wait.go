@@ -1,10 +1,14 @@
 package securityhub
 
 import (
+	"context"
+	"errors"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/securityhub"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -87,3 +91,47 @@ func waitStandardsSubscriptionDeleted(conn *securityhub.SecurityHub, arn string)
 
 	return nil, err
 }
+
+func waitOrganizationConfigurationEnabled(ctx context.Context, conn *securityhub.SecurityHub, timeout time.Duration) (*securityhub.DescribeOrganizationConfigurationOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{securityhub.OrganizationConfigurationStatusPending},
+		Target:  []string{securityhub.OrganizationConfigurationStatusEnabled},
+		Refresh: statusOrganizationConfiguration(ctx, conn),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*securityhub.DescribeOrganizationConfigurationOutput); ok {
+		if v := output.OrganizationConfiguration; v != nil && aws.StringValue(v.Status) == securityhub.OrganizationConfigurationStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(v.StatusMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitConfigurationPolicyAssociationSucceeded(ctx context.Context, conn *securityhub.SecurityHub, targetID string, timeout time.Duration) (*securityhub.GetConfigurationPolicyAssociationOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{securityhub.ConfigurationPolicyAssociationStatusPending},
+		Target:  []string{securityhub.ConfigurationPolicyAssociationStatusSuccess},
+		Refresh: statusConfigurationPolicyAssociation(ctx, conn, targetID),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*securityhub.GetConfigurationPolicyAssociationOutput); ok {
+		if aws.StringValue(output.AssociationStatus) == securityhub.ConfigurationPolicyAssociationStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.AssociationStatusMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -35,7 +35,6 @@ func ResourceHTTPNamespace() *schema.Resource {
 			"description": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
 			},
 			"http_name": {
 				Type:     schema.TypeString,
@@ -148,6 +147,29 @@ func resourceHTTPNamespaceRead(ctx context.Context, d *schema.ResourceData, meta
 func resourceHTTPNamespaceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).ServiceDiscoveryConn
 
+	if d.HasChange("description") {
+		input := &servicediscovery.UpdateHttpNamespaceInput{
+			Id: aws.String(d.Id()),
+			Namespace: &servicediscovery.HttpNamespaceChange{
+				Description: aws.String(d.Get("description").(string)),
+			},
+			UpdaterRequestId: aws.String(resource.UniqueId()),
+		}
+
+		log.Printf("[DEBUG] Updating Service Discovery HTTP Namespace: %s", input)
+		output, err := conn.UpdateHttpNamespaceWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating Service Discovery HTTP Namespace (%s): %s", d.Id(), err)
+		}
+
+		if output != nil && output.OperationId != nil {
+			if _, err := WaitOperationSuccess(ctx, conn, aws.StringValue(output.OperationId)); err != nil {
+				return diag.Errorf("waiting for Service Discovery HTTP Namespace (%s) update: %s", d.Id(), err)
+			}
+		}
+	}
+
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 
@@ -103,6 +103,13 @@ func TestAccServiceDiscoveryHTTPNamespace_description(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				Config: testAccHTTPNamespaceConfig_description(rName, "test2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHTTPNamespaceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "test2"),
+				),
+			},
 		},
 	})
 }
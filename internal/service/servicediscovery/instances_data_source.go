@@ -0,0 +1,134 @@
+package servicediscovery
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicediscovery"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+func DataSourceInstances() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceInstancesRead,
+
+		Schema: map[string]*schema.Schema{
+			"health_status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(servicediscovery.HealthStatusFilter_Values(), false),
+			},
+			"instances": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attributes": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"health_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"instance_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"max_results": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 1000),
+			},
+			"namespace_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"optional_parameters": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"query_parameters": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ServiceDiscoveryConn
+
+	namespaceName := d.Get("namespace_name").(string)
+	serviceName := d.Get("service_name").(string)
+	input := &servicediscovery.DiscoverInstancesInput{
+		NamespaceName: aws.String(namespaceName),
+		ServiceName:   aws.String(serviceName),
+	}
+
+	if v, ok := d.GetOk("health_status"); ok {
+		input.HealthStatus = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("max_results"); ok {
+		input.MaxResults = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("optional_parameters"); ok && len(v.(map[string]interface{})) > 0 {
+		input.OptionalParameters = flex.ExpandStringMap(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("query_parameters"); ok && len(v.(map[string]interface{})) > 0 {
+		input.QueryParameters = flex.ExpandStringMap(v.(map[string]interface{}))
+	}
+
+	output, err := conn.DiscoverInstancesWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("discovering Service Discovery Instances (%s/%s): %s", namespaceName, serviceName, err)
+	}
+
+	d.SetId(namespaceName + "/" + serviceName)
+
+	if err := d.Set("instances", flattenHTTPInstanceSummaries(output.Instances)); err != nil {
+		return diag.Errorf("setting instances: %s", err)
+	}
+
+	return nil
+}
+
+func flattenHTTPInstanceSummaries(apiObjects []*servicediscovery.HttpInstanceSummary) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"attributes":    aws.StringValueMap(apiObject.Attributes),
+			"health_status": aws.StringValue(apiObject.HealthStatus),
+			"instance_id":   aws.StringValue(apiObject.InstanceId),
+		})
+	}
+
+	return tfList
+}
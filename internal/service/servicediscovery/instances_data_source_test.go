@@ -0,0 +1,83 @@
+package servicediscovery_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/servicediscovery"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccServiceDiscoveryInstancesDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_service_discovery_instances.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(servicediscovery.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, servicediscovery.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstancesDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "instances.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "instances.0.attributes.%", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "instances.0.attributes.stage", "blue"),
+					resource.TestCheckResourceAttr(dataSourceName, "instances.0.health_status", "UNKNOWN"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "instances.0.instance_id", "aws_service_discovery_instance.test1", "instance_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccInstancesDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_service_discovery_http_namespace" "test" {
+  name = %[1]q
+}
+
+resource "aws_service_discovery_service" "test" {
+  name         = %[1]q
+  namespace_id = aws_service_discovery_http_namespace.test.id
+}
+
+resource "aws_service_discovery_instance" "test1" {
+  service_id  = aws_service_discovery_service.test.id
+  instance_id = "%[1]s-1"
+
+  attributes = {
+    AWS_INSTANCE_IPV4 = "10.0.0.1"
+    stage             = "blue"
+  }
+}
+
+resource "aws_service_discovery_instance" "test2" {
+  service_id  = aws_service_discovery_service.test.id
+  instance_id = "%[1]s-2"
+
+  attributes = {
+    AWS_INSTANCE_IPV4 = "10.0.0.2"
+    stage             = "green"
+  }
+}
+
+data "aws_service_discovery_instances" "test" {
+  namespace_name = aws_service_discovery_http_namespace.test.name
+  service_name   = aws_service_discovery_service.test.name
+  health_status  = "ALL"
+
+  query_parameters = {
+    stage = "blue"
+  }
+
+  depends_on = [aws_service_discovery_instance.test1, aws_service_discovery_instance.test2]
+}
+`, rName)
+}
@@ -97,6 +97,13 @@ func TestAccServiceDiscoveryPrivateDNSNamespace_description(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "description", "test"),
 				),
 			},
+			{
+				Config: testAccPrivateDNSNamespaceConfig_description(rName, "test2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPrivateDNSNamespaceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "test2"),
+				),
+			},
 		},
 	})
 }
@@ -96,6 +96,13 @@ func TestAccServiceDiscoveryPublicDNSNamespace_description(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "description", "test"),
 				),
 			},
+			{
+				Config: testAccPublicDNSNamespaceConfig_description(rName, "test2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPublicDNSNamespaceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "test2"),
+				),
+			},
 		},
 	})
 }
@@ -114,9 +114,16 @@ func ResourceService() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"failure_threshold": {
-							Type:     schema.TypeInt,
-							Optional: true,
-							ForceNew: true,
+							Type:       schema.TypeInt,
+							Optional:   true,
+							Computed:   true,
+							ForceNew:   true,
+							Deprecated: "failure_threshold is deprecated. The value is ignored by AWS Cloud Map, which always uses a failure threshold of 1.",
+							// AWS Cloud Map ignores the configured value and reports 1, so a difference
+							// is only suppressed against that reported value or when no value is configured.
+							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+								return d.Id() != "" && (old == "1" || new == "" || new == "0")
+							},
 						},
 					},
 				},
@@ -0,0 +1,157 @@
+package servicequotas
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	// PrecheckModeWarn logs a warning when a planned resource would exceed its quota.
+	PrecheckModeWarn = "warn"
+	// PrecheckModeError fails the plan when a planned resource would exceed its quota.
+	PrecheckModeError = "error"
+)
+
+// PrecheckModes returns the valid values of the service_quota_precheck provider argument.
+func PrecheckModes() []string {
+	return []string{
+		PrecheckModeWarn,
+		PrecheckModeError,
+	}
+}
+
+// UsageFunc returns the number of resources currently counting towards a quota.
+type UsageFunc func(ctx context.Context, meta interface{}) (int, error)
+
+// Precheck describes the Service Quotas quota that limits the number of
+// resources of a given type and how to count the resources currently in use.
+type Precheck struct {
+	ServiceCode string
+	// QuotaCode identifies the quota. If empty, the quota is looked up by QuotaName.
+	QuotaCode string
+	QuotaName string
+	Usage     UsageFunc
+}
+
+// CustomizeDiff returns a CustomizeDiff function that checks at plan time whether
+// creating the resource would exceed the quota. The check is a no-op unless enabled
+// by the service_quota_precheck provider argument.
+// Each planned resource is checked on its own against the current usage: other
+// resources of the same type planned alongside it are not counted.
+// In error mode exceeding the quota fails the plan. CustomizeDiff cannot return
+// warnings, so in warn mode it is logged as a warning.
+func (p Precheck) CustomizeDiff(resourceType string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		client, ok := meta.(*conns.AWSClient)
+
+		if !ok || client == nil || client.ServiceQuotaPrecheck == "" {
+			return nil
+		}
+
+		// Only resources being created count towards the quota.
+		if diff.Id() != "" {
+			return nil
+		}
+
+		err := p.check(ctx, client, meta)
+
+		if err == nil {
+			return nil
+		}
+
+		if client.ServiceQuotaPrecheck == PrecheckModeError {
+			return fmt.Errorf("%s: %w", resourceType, err)
+		}
+
+		log.Printf("[WARN] %s: Service Quotas precheck: %s", resourceType, err)
+
+		return nil
+	}
+}
+
+// check returns an error if creating one more resource would exceed the quota.
+// Failures to determine the quota or current usage are logged and ignored so
+// that the precheck never blocks a plan on its own.
+func (p Precheck) check(ctx context.Context, client *conns.AWSClient, meta interface{}) error {
+	quota, err := p.findQuota(ctx, client.ServiceQuotasConn)
+
+	if err != nil {
+		log.Printf("[WARN] Service Quotas precheck: reading quota (%s/%s): %s", p.ServiceCode, p.QuotaName, err)
+		return nil
+	}
+
+	usage, err := p.Usage(ctx, meta)
+
+	if err != nil {
+		log.Printf("[WARN] Service Quotas precheck: counting usage for quota (%s/%s): %s", p.ServiceCode, p.QuotaName, err)
+		return nil
+	}
+
+	limit := aws.Float64Value(quota.Value)
+
+	log.Printf("[DEBUG] Service Quotas precheck: quota (%s/%s) value %g, current usage %d", p.ServiceCode, p.QuotaName, limit, usage)
+
+	if float64(usage+1) > limit {
+		return fmt.Errorf("creating this resource would exceed the %q quota (%s/%s): current usage %d, quota value %g", aws.StringValue(quota.QuotaName), p.ServiceCode, aws.StringValue(quota.QuotaCode), usage, limit)
+	}
+
+	return nil
+}
+
+// findQuota returns the applied quota, falling back to the AWS default value.
+func (p Precheck) findQuota(ctx context.Context, conn *servicequotas.ServiceQuotas) (*servicequotas.ServiceQuota, error) {
+	if p.QuotaCode == "" {
+		quota, err := findServiceQuotaByName(ctx, conn, p.ServiceCode, p.QuotaName)
+
+		if tfresource.NotFound(err) {
+			return findServiceQuotaDefaultByName(conn, p.ServiceCode, p.QuotaName)
+		}
+
+		return quota, err
+	}
+
+	quota, err := findServiceQuotaByID(conn, p.ServiceCode, p.QuotaCode)
+
+	if tfresource.NotFound(err) {
+		return findServiceQuotaDefaultByID(conn, p.ServiceCode, p.QuotaCode)
+	}
+
+	return quota, err
+}
+
+func findServiceQuotaByName(ctx context.Context, conn *servicequotas.ServiceQuotas, serviceCode, quotaName string) (*servicequotas.ServiceQuota, error) {
+	input := &servicequotas.ListServiceQuotasInput{
+		ServiceCode: aws.String(serviceCode),
+	}
+
+	var quota *servicequotas.ServiceQuota
+	err := conn.ListServiceQuotasPagesWithContext(ctx, input, func(page *servicequotas.ListServiceQuotasOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, q := range page.Quotas {
+			if aws.StringValue(q.QuotaName) == quotaName && q.Value != nil {
+				quota = q
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+	if quota == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return quota, nil
+}
@@ -0,0 +1,31 @@
+package servicequotas_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfservicequotas "github.com/hashicorp/terraform-provider-aws/internal/service/servicequotas"
+)
+
+func TestPrecheckCustomizeDiff_disabled(t *testing.T) {
+	var called bool
+
+	precheck := tfservicequotas.Precheck{
+		ServiceCode: "vpc",
+		QuotaCode:   "L-F678F1CE",
+		QuotaName:   "VPCs per Region",
+		Usage: func(context.Context, interface{}) (int, error) {
+			called = true
+			return 0, nil
+		},
+	}
+
+	if err := precheck.CustomizeDiff("aws_vpc")(context.Background(), nil, &conns.AWSClient{}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if called {
+		t.Errorf("expected usage not to be counted when the precheck is disabled")
+	}
+}
@@ -0,0 +1,188 @@
+package sesv2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sesv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func ResourceAccountVDMAttributes() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAccountVDMAttributesPut,
+		ReadWithoutTimeout:   resourceAccountVDMAttributesRead,
+		UpdateWithoutTimeout: resourceAccountVDMAttributesPut,
+		DeleteWithoutTimeout: resourceAccountVDMAttributesDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"dashboard_attributes": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"engagement_metrics": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(sesv2.FeatureStatus_Values(), false),
+						},
+					},
+				},
+			},
+			"guardian_attributes": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"optimized_shared_delivery": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(sesv2.FeatureStatus_Values(), false),
+						},
+					},
+				},
+			},
+			"vdm_enabled": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(sesv2.FeatureStatus_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceAccountVDMAttributesPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SESV2Conn
+
+	vdmAttributes := &sesv2.VdmAttributes{
+		VdmEnabled: aws.String(d.Get("vdm_enabled").(string)),
+	}
+
+	if v, ok := d.GetOk("dashboard_attributes"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		vdmAttributes.DashboardAttributes = expandDashboardAttributes(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("guardian_attributes"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		vdmAttributes.GuardianAttributes = expandGuardianAttributes(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	_, err := conn.PutAccountVdmAttributesWithContext(ctx, &sesv2.PutAccountVdmAttributesInput{
+		VdmAttributes: vdmAttributes,
+	})
+
+	if err != nil {
+		return diag.Errorf("putting SESv2 Account VDM Attributes: %s", err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(meta.(*conns.AWSClient).AccountID)
+	}
+
+	return resourceAccountVDMAttributesRead(ctx, d, meta)
+}
+
+func resourceAccountVDMAttributesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SESV2Conn
+
+	attributes, err := FindAccountVDMAttributes(ctx, conn)
+
+	if err != nil {
+		return diag.Errorf("reading SESv2 Account VDM Attributes (%s): %s", d.Id(), err)
+	}
+
+	if attributes.DashboardAttributes != nil {
+		if err := d.Set("dashboard_attributes", []interface{}{flattenDashboardAttributes(attributes.DashboardAttributes)}); err != nil {
+			return diag.Errorf("setting dashboard_attributes: %s", err)
+		}
+	} else {
+		d.Set("dashboard_attributes", nil)
+	}
+	if attributes.GuardianAttributes != nil {
+		if err := d.Set("guardian_attributes", []interface{}{flattenGuardianAttributes(attributes.GuardianAttributes)}); err != nil {
+			return diag.Errorf("setting guardian_attributes: %s", err)
+		}
+	} else {
+		d.Set("guardian_attributes", nil)
+	}
+	d.Set("vdm_enabled", attributes.VdmEnabled)
+
+	return nil
+}
+
+func resourceAccountVDMAttributesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SESV2Conn
+
+	// The account VDM attributes can't be deleted; disable VDM instead.
+	_, err := conn.PutAccountVdmAttributesWithContext(ctx, &sesv2.PutAccountVdmAttributesInput{
+		VdmAttributes: &sesv2.VdmAttributes{
+			VdmEnabled: aws.String(sesv2.FeatureStatusDisabled),
+		},
+	})
+
+	if err != nil {
+		return diag.Errorf("deleting SESv2 Account VDM Attributes (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandDashboardAttributes(tfMap map[string]interface{}) *sesv2.DashboardAttributes {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &sesv2.DashboardAttributes{}
+
+	if v, ok := tfMap["engagement_metrics"].(string); ok && v != "" {
+		apiObject.EngagementMetrics = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandGuardianAttributes(tfMap map[string]interface{}) *sesv2.GuardianAttributes {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &sesv2.GuardianAttributes{}
+
+	if v, ok := tfMap["optimized_shared_delivery"].(string); ok && v != "" {
+		apiObject.OptimizedSharedDelivery = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenDashboardAttributes(apiObject *sesv2.DashboardAttributes) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"engagement_metrics": aws.StringValue(apiObject.EngagementMetrics),
+	}
+}
+
+func flattenGuardianAttributes(apiObject *sesv2.GuardianAttributes) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"optimized_shared_delivery": aws.StringValue(apiObject.OptimizedSharedDelivery),
+	}
+}
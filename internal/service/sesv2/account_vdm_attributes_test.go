@@ -0,0 +1,177 @@
+package sesv2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/sesv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsesv2 "github.com/hashicorp/terraform-provider-aws/internal/service/sesv2"
+)
+
+func testAccAccountVDMAttributes_basic(t *testing.T) {
+	resourceName := "aws_sesv2_account_vdm_attributes.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, sesv2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccountVDMAttributesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountVDMAttributesConfig_basic(sesv2.FeatureStatusEnabled),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountVDMAttributesExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "vdm_enabled", sesv2.FeatureStatusEnabled),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAccountVDMAttributesConfig_basic(sesv2.FeatureStatusDisabled),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountVDMAttributesExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "vdm_enabled", sesv2.FeatureStatusDisabled),
+				),
+			},
+		},
+	})
+}
+
+func testAccAccountVDMAttributes_engagementMetrics(t *testing.T) {
+	resourceName := "aws_sesv2_account_vdm_attributes.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, sesv2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccountVDMAttributesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountVDMAttributesConfig_engagementMetrics(sesv2.FeatureStatusEnabled),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountVDMAttributesExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "dashboard_attributes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "dashboard_attributes.0.engagement_metrics", sesv2.FeatureStatusEnabled),
+				),
+			},
+			{
+				Config: testAccAccountVDMAttributesConfig_engagementMetrics(sesv2.FeatureStatusDisabled),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountVDMAttributesExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "dashboard_attributes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "dashboard_attributes.0.engagement_metrics", sesv2.FeatureStatusDisabled),
+				),
+			},
+		},
+	})
+}
+
+func testAccAccountVDMAttributes_optimizedSharedDelivery(t *testing.T) {
+	resourceName := "aws_sesv2_account_vdm_attributes.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, sesv2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccountVDMAttributesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountVDMAttributesConfig_optimizedSharedDelivery(sesv2.FeatureStatusEnabled),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountVDMAttributesExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "guardian_attributes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "guardian_attributes.0.optimized_shared_delivery", sesv2.FeatureStatusEnabled),
+				),
+			},
+			{
+				Config: testAccAccountVDMAttributesConfig_optimizedSharedDelivery(sesv2.FeatureStatusDisabled),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountVDMAttributesExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "guardian_attributes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "guardian_attributes.0.optimized_shared_delivery", sesv2.FeatureStatusDisabled),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAccountVDMAttributesExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SESv2 Account VDM Attributes ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SESV2Conn
+
+		_, err := tfsesv2.FindAccountVDMAttributes(context.Background(), conn)
+
+		return err
+	}
+}
+
+func testAccCheckAccountVDMAttributesDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SESV2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_sesv2_account_vdm_attributes" {
+			continue
+		}
+
+		output, err := tfsesv2.FindAccountVDMAttributes(context.Background(), conn)
+
+		if err != nil {
+			return err
+		}
+
+		if v := output.VdmEnabled; v != nil && *v == sesv2.FeatureStatusEnabled {
+			return fmt.Errorf("SESv2 Account VDM Attributes %s still enabled", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAccountVDMAttributesConfig_basic(vdmEnabled string) string {
+	return fmt.Sprintf(`
+resource "aws_sesv2_account_vdm_attributes" "test" {
+  vdm_enabled = %[1]q
+}
+`, vdmEnabled)
+}
+
+func testAccAccountVDMAttributesConfig_engagementMetrics(engagementMetrics string) string {
+	return fmt.Sprintf(`
+resource "aws_sesv2_account_vdm_attributes" "test" {
+  vdm_enabled = "ENABLED"
+
+  dashboard_attributes {
+    engagement_metrics = %[1]q
+  }
+}
+`, engagementMetrics)
+}
+
+func testAccAccountVDMAttributesConfig_optimizedSharedDelivery(optimizedSharedDelivery string) string {
+	return fmt.Sprintf(`
+resource "aws_sesv2_account_vdm_attributes" "test" {
+  vdm_enabled = "ENABLED"
+
+  guardian_attributes {
+    optimized_shared_delivery = %[1]q
+  }
+}
+`, optimizedSharedDelivery)
+}
@@ -0,0 +1,52 @@
+package sesv2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sesv2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindAccountVDMAttributes(ctx context.Context, conn *sesv2.SESV2) (*sesv2.VdmAttributes, error) {
+	input := &sesv2.GetAccountInput{}
+
+	output, err := conn.GetAccountWithContext(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.VdmAttributes == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.VdmAttributes, nil
+}
+
+func FindConfigurationSetByName(ctx context.Context, conn *sesv2.SESV2, name string) (*sesv2.GetConfigurationSetOutput, error) {
+	input := &sesv2.GetConfigurationSetInput{
+		ConfigurationSetName: aws.String(name),
+	}
+
+	output, err := conn.GetConfigurationSetWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, sesv2.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,27 @@
+package sesv2_test
+
+import (
+	"testing"
+)
+
+func TestAccSESV2_serial(t *testing.T) {
+	testCases := map[string]map[string]func(t *testing.T){
+		"AccountVDMAttributes": {
+			"basic":                   testAccAccountVDMAttributes_basic,
+			"EngagementMetrics":       testAccAccountVDMAttributes_engagementMetrics,
+			"OptimizedSharedDelivery": testAccAccountVDMAttributes_optimizedSharedDelivery,
+		},
+	}
+
+	for group, m := range testCases {
+		m := m
+		t.Run(group, func(t *testing.T) {
+			for name, tc := range m {
+				tc := tc
+				t.Run(name, func(t *testing.T) {
+					tc(t)
+				})
+			}
+		})
+	}
+}
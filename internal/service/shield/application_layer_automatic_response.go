@@ -0,0 +1,167 @@
+package shield
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	applicationLayerAutomaticResponseActionBlock = "BLOCK"
+	applicationLayerAutomaticResponseActionCount = "COUNT"
+)
+
+func applicationLayerAutomaticResponseAction_Values() []string {
+	return []string{
+		applicationLayerAutomaticResponseActionBlock,
+		applicationLayerAutomaticResponseActionCount,
+	}
+}
+
+func ResourceApplicationLayerAutomaticResponse() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceApplicationLayerAutomaticResponseCreate,
+		Read:   resourceApplicationLayerAutomaticResponseRead,
+		Update: resourceApplicationLayerAutomaticResponseUpdate,
+		Delete: resourceApplicationLayerAutomaticResponseDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"action": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(applicationLayerAutomaticResponseAction_Values(), false),
+			},
+			"resource_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func resourceApplicationLayerAutomaticResponseCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ShieldConn
+
+	resourceARN := d.Get("resource_arn").(string)
+	input := &shield.EnableApplicationLayerAutomaticResponseInput{
+		Action:      expandResponseAction(d.Get("action").(string)),
+		ResourceArn: aws.String(resourceARN),
+	}
+
+	log.Printf("[DEBUG] Enabling Shield Application Layer Automatic Response: %s", input)
+	_, err := conn.EnableApplicationLayerAutomaticResponse(input)
+
+	if err != nil {
+		return fmt.Errorf("error enabling Shield Application Layer Automatic Response (%s): %w", resourceARN, err)
+	}
+
+	d.SetId(resourceARN)
+
+	return resourceApplicationLayerAutomaticResponseRead(d, meta)
+}
+
+func resourceApplicationLayerAutomaticResponseRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ShieldConn
+
+	output, err := FindApplicationLayerAutomaticResponseByResourceARN(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Shield Application Layer Automatic Response (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Shield Application Layer Automatic Response (%s): %w", d.Id(), err)
+	}
+
+	d.Set("action", flattenResponseAction(output.Action))
+	d.Set("resource_arn", d.Id())
+
+	return nil
+}
+
+func resourceApplicationLayerAutomaticResponseUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ShieldConn
+
+	input := &shield.UpdateApplicationLayerAutomaticResponseInput{
+		Action:      expandResponseAction(d.Get("action").(string)),
+		ResourceArn: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Updating Shield Application Layer Automatic Response: %s", input)
+	_, err := conn.UpdateApplicationLayerAutomaticResponse(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating Shield Application Layer Automatic Response (%s): %w", d.Id(), err)
+	}
+
+	return resourceApplicationLayerAutomaticResponseRead(d, meta)
+}
+
+func resourceApplicationLayerAutomaticResponseDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ShieldConn
+
+	log.Printf("[DEBUG] Disabling Shield Application Layer Automatic Response: %s", d.Id())
+	_, err := conn.DisableApplicationLayerAutomaticResponse(&shield.DisableApplicationLayerAutomaticResponseInput{
+		ResourceArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, shield.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if tfawserr.ErrMessageContains(err, shield.ErrCodeInvalidOperationException, "not enabled") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error disabling Shield Application Layer Automatic Response (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandResponseAction(action string) *shield.ResponseAction {
+	switch action {
+	case applicationLayerAutomaticResponseActionBlock:
+		return &shield.ResponseAction{
+			Block: &shield.BlockAction{},
+		}
+	case applicationLayerAutomaticResponseActionCount:
+		return &shield.ResponseAction{
+			Count: &shield.CountAction{},
+		}
+	}
+
+	return nil
+}
+
+func flattenResponseAction(apiObject *shield.ResponseAction) string {
+	if apiObject == nil {
+		return ""
+	}
+
+	if apiObject.Block != nil {
+		return applicationLayerAutomaticResponseActionBlock
+	}
+
+	if apiObject.Count != nil {
+		return applicationLayerAutomaticResponseActionCount
+	}
+
+	return ""
+}
@@ -0,0 +1,157 @@
+package shield_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/shield"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfshield "github.com/hashicorp/terraform-provider-aws/internal/service/shield"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccShieldApplicationLayerAutomaticResponse_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_shield_application_layer_automatic_response.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(shield.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, shield.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationLayerAutomaticResponseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationLayerAutomaticResponseConfig_basic(rName, "COUNT"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationLayerAutomaticResponseExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "action", "COUNT"),
+					resource.TestCheckResourceAttrPair(resourceName, "resource_arn", "aws_lb.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccApplicationLayerAutomaticResponseConfig_basic(rName, "BLOCK"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationLayerAutomaticResponseExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "action", "BLOCK"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccShieldApplicationLayerAutomaticResponse_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_shield_application_layer_automatic_response.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(shield.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, shield.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationLayerAutomaticResponseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationLayerAutomaticResponseConfig_basic(rName, "COUNT"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationLayerAutomaticResponseExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfshield.ResourceApplicationLayerAutomaticResponse(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckApplicationLayerAutomaticResponseDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ShieldConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_shield_application_layer_automatic_response" {
+			continue
+		}
+
+		_, err := tfshield.FindApplicationLayerAutomaticResponseByResourceARN(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Shield Application Layer Automatic Response %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckApplicationLayerAutomaticResponseExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Shield Application Layer Automatic Response ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ShieldConn
+
+		_, err := tfshield.FindApplicationLayerAutomaticResponseByResourceARN(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccApplicationLayerAutomaticResponseConfig_basic(rName, action string) string {
+	return acctest.ConfigCompose(testAccProtectionConfig_alb(rName), fmt.Sprintf(`
+resource "aws_wafv2_web_acl" "test" {
+  name  = %[1]q
+  scope = "REGIONAL"
+
+  default_action {
+    allow {}
+  }
+
+  visibility_config {
+    cloudwatch_metrics_enabled = false
+    metric_name                = %[1]q
+    sampled_requests_enabled   = false
+  }
+
+  lifecycle {
+    ignore_changes = [rule]
+  }
+}
+
+resource "aws_wafv2_web_acl_association" "test" {
+  resource_arn = aws_lb.test.arn
+  web_acl_arn  = aws_wafv2_web_acl.test.arn
+}
+
+resource "aws_shield_application_layer_automatic_response" "test" {
+  resource_arn = aws_shield_protection.test.resource_arn
+  action       = %[2]q
+
+  depends_on = [aws_wafv2_web_acl_association.test]
+}
+`, rName, action))
+}
@@ -0,0 +1,101 @@
+package shield
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindProtectionByResourceARN(conn *shield.Shield, arn string) (*shield.Protection, error) {
+	input := &shield.DescribeProtectionInput{
+		ResourceArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeProtection(input)
+
+	if tfawserr.ErrCodeEquals(err, shield.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Protection == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Protection, nil
+}
+
+// FindApplicationLayerAutomaticResponseByResourceARN returns the automatic
+// application layer DDoS mitigation configuration of the protected resource.
+// A disabled configuration is reported as not found.
+func FindApplicationLayerAutomaticResponseByResourceARN(conn *shield.Shield, arn string) (*shield.ApplicationLayerAutomaticResponseConfiguration, error) {
+	protection, err := FindProtectionByResourceARN(conn, arn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	output := protection.ApplicationLayerAutomaticResponseConfiguration
+
+	if output == nil || aws.StringValue(output.Status) == shield.ApplicationLayerAutomaticResponseStatusDisabled {
+		return nil, &resource.NotFoundError{
+			Message: "application layer automatic response is disabled",
+		}
+	}
+
+	return output, nil
+}
+
+func FindSubscription(conn *shield.Shield) (*shield.Subscription, error) {
+	input := &shield.DescribeSubscriptionInput{}
+
+	output, err := conn.DescribeSubscription(input)
+
+	if tfawserr.ErrCodeEquals(err, shield.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Subscription == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Subscription, nil
+}
+
+func FindEmergencyContacts(conn *shield.Shield) ([]*shield.EmergencyContact, error) {
+	input := &shield.DescribeEmergencyContactSettingsInput{}
+
+	output, err := conn.DescribeEmergencyContactSettings(input)
+
+	if tfawserr.ErrCodeEquals(err, shield.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.EmergencyContactList, nil
+}
@@ -0,0 +1,218 @@
+package shield
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceProactiveEngagement() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceProactiveEngagementPut,
+		Read:   resourceProactiveEngagementRead,
+		Update: resourceProactiveEngagementPut,
+		Delete: resourceProactiveEngagementDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"emergency_contact": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 10,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"contact_notes": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"email_address": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 150),
+						},
+						"phone_number": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 16),
+						},
+					},
+				},
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceProactiveEngagementPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ShieldConn
+
+	if d.HasChange("emergency_contact") {
+		input := &shield.UpdateEmergencyContactSettingsInput{
+			EmergencyContactList: expandEmergencyContacts(d.Get("emergency_contact").([]interface{})),
+		}
+
+		log.Printf("[DEBUG] Updating Shield Emergency Contact Settings: %s", input)
+		_, err := conn.UpdateEmergencyContactSettings(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Shield Emergency Contact Settings: %w", err)
+		}
+	}
+
+	if d.HasChange("enabled") {
+		if err := updateProactiveEngagement(conn, d.Get("enabled").(bool)); err != nil {
+			return err
+		}
+	}
+
+	if d.IsNewResource() {
+		d.SetId(meta.(*conns.AWSClient).AccountID)
+	}
+
+	return resourceProactiveEngagementRead(d, meta)
+}
+
+func resourceProactiveEngagementRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ShieldConn
+
+	subscription, err := FindSubscription(conn)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Shield Proactive Engagement (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Shield Subscription: %w", err)
+	}
+
+	status := aws.StringValue(subscription.ProactiveEngagementStatus)
+
+	if !d.IsNewResource() && status == "" {
+		log.Printf("[WARN] Shield Proactive Engagement (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	contacts, err := FindEmergencyContacts(conn)
+
+	if err != nil {
+		return fmt.Errorf("error reading Shield Emergency Contact Settings: %w", err)
+	}
+
+	if err := d.Set("emergency_contact", flattenEmergencyContacts(contacts)); err != nil {
+		return fmt.Errorf("error setting emergency_contact: %w", err)
+	}
+
+	// PENDING means the change has been requested and is being processed.
+	d.Set("enabled", status == shield.ProactiveEngagementStatusEnabled || status == shield.ProactiveEngagementStatusPending)
+
+	return nil
+}
+
+func resourceProactiveEngagementDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ShieldConn
+
+	if err := updateProactiveEngagement(conn, false); err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Removing Shield Emergency Contact Settings")
+	_, err := conn.UpdateEmergencyContactSettings(&shield.UpdateEmergencyContactSettingsInput{
+		EmergencyContactList: []*shield.EmergencyContact{},
+	})
+
+	if tfawserr.ErrCodeEquals(err, shield.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error removing Shield Emergency Contact Settings: %w", err)
+	}
+
+	return nil
+}
+
+func updateProactiveEngagement(conn *shield.Shield, enabled bool) error {
+	var err error
+
+	if enabled {
+		log.Printf("[DEBUG] Enabling Shield Proactive Engagement")
+		_, err = conn.EnableProactiveEngagement(&shield.EnableProactiveEngagementInput{})
+	} else {
+		log.Printf("[DEBUG] Disabling Shield Proactive Engagement")
+		_, err = conn.DisableProactiveEngagement(&shield.DisableProactiveEngagementInput{})
+	}
+
+	// The API reports an invalid operation when the requested state is already in effect.
+	if tfawserr.ErrMessageContains(err, shield.ErrCodeInvalidOperationException, "already") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error updating Shield Proactive Engagement (enabled: %t): %w", enabled, err)
+	}
+
+	return nil
+}
+
+func expandEmergencyContacts(tfList []interface{}) []*shield.EmergencyContact {
+	apiObjects := make([]*shield.EmergencyContact, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &shield.EmergencyContact{
+			EmailAddress: aws.String(tfMap["email_address"].(string)),
+		}
+
+		if v, ok := tfMap["contact_notes"].(string); ok && v != "" {
+			apiObject.ContactNotes = aws.String(v)
+		}
+
+		if v, ok := tfMap["phone_number"].(string); ok && v != "" {
+			apiObject.PhoneNumber = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenEmergencyContacts(apiObjects []*shield.EmergencyContact) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"contact_notes": aws.StringValue(apiObject.ContactNotes),
+			"email_address": aws.StringValue(apiObject.EmailAddress),
+			"phone_number":  aws.StringValue(apiObject.PhoneNumber),
+		})
+	}
+
+	return tfList
+}
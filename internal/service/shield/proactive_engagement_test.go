@@ -0,0 +1,116 @@
+package shield_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfshield "github.com/hashicorp/terraform-provider-aws/internal/service/shield"
+)
+
+// Proactive engagement is an account-wide setting, so these tests must not run in parallel.
+func TestAccShieldProactiveEngagement_basic(t *testing.T) {
+	resourceName := "aws_shield_proactive_engagement.test"
+	domain := acctest.RandomDomainName()
+	address1 := acctest.RandomEmailAddress(domain)
+	address2 := acctest.RandomEmailAddress(domain)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(shield.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, shield.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProactiveEngagementDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProactiveEngagementConfig_basic(address1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProactiveEngagementExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "emergency_contact.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "emergency_contact.0.email_address", address1),
+					resource.TestCheckResourceAttr(resourceName, "emergency_contact.0.phone_number", "+12358132134"),
+					resource.TestCheckResourceAttr(resourceName, "emergency_contact.0.contact_notes", "Notes"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccProactiveEngagementConfig_basic(address2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProactiveEngagementExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "emergency_contact.0.email_address", address2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckProactiveEngagementDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ShieldConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_shield_proactive_engagement" {
+			continue
+		}
+
+		subscription, err := tfshield.FindSubscription(conn)
+
+		if err != nil {
+			return err
+		}
+
+		if status := aws.StringValue(subscription.ProactiveEngagementStatus); status == shield.ProactiveEngagementStatusEnabled {
+			return fmt.Errorf("Shield Proactive Engagement %s still enabled", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckProactiveEngagementExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Shield Proactive Engagement ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ShieldConn
+
+		_, err := tfshield.FindEmergencyContacts(conn)
+
+		return err
+	}
+}
+
+// Enabling proactive engagement additionally requires the Shield Response Team
+// (SRT) access role to be associated with the account, which is not managed here.
+func testAccProactiveEngagementConfig_basic(emailAddress string) string {
+	return fmt.Sprintf(`
+resource "aws_shield_proactive_engagement" "test" {
+  enabled = false
+
+  emergency_contact {
+    contact_notes = "Notes"
+    email_address = %[1]q
+    phone_number  = "+12358132134"
+  }
+}
+`, emailAddress)
+}
@@ -6,6 +6,18 @@ const (
 	FIFOTopicNameSuffix = ".fifo"
 )
 
+const (
+	FIFOThroughputScopeMessageGroup = "MessageGroup"
+	FIFOThroughputScopeTopic        = "Topic"
+)
+
+func FIFOThroughputScope_Values() []string {
+	return []string{
+		FIFOThroughputScopeMessageGroup,
+		FIFOThroughputScopeTopic,
+	}
+}
+
 const (
 	SubscriptionProtocolApplication = "application"
 	SubscriptionProtocolEmail       = "email"
@@ -51,9 +63,12 @@ const (
 	TopicAttributeNameApplicationFailureFeedbackRoleARN    = "ApplicationFailureFeedbackRoleArn"
 	TopicAttributeNameApplicationSuccessFeedbackRoleARN    = "ApplicationSuccessFeedbackRoleArn"
 	TopicAttributeNameApplicationSuccessFeedbackSampleRate = "ApplicationSuccessFeedbackSampleRate"
+	TopicAttributeNameArchivePolicy                        = "ArchivePolicy"
+	TopicAttributeNameBeginningArchiveTime                 = "BeginningArchiveTime"
 	TopicAttributeNameContentBasedDeduplication            = "ContentBasedDeduplication"
 	TopicAttributeNameDeliveryPolicy                       = "DeliveryPolicy"
 	TopicAttributeNameDisplayName                          = "DisplayName"
+	TopicAttributeNameFIFOThroughputScope                  = "FifoThroughputScope"
 	TopicAttributeNameFIFOTopic                            = "FifoTopic"
 	TopicAttributeNameFirehoseFailureFeedbackRoleARN       = "FirehoseFailureFeedbackRoleArn"
 	TopicAttributeNameFirehoseSuccessFeedbackRoleARN       = "FirehoseSuccessFeedbackRoleArn"
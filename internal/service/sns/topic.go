@@ -39,10 +39,24 @@ var (
 			Optional:     true,
 			ValidateFunc: validation.IntBetween(0, 100),
 		},
+		"archive_policy": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+			StateFunc: func(v interface{}) string {
+				json, _ := structure.NormalizeJsonString(v)
+				return json
+			},
+		},
 		"arn": {
 			Type:     schema.TypeString,
 			Computed: true,
 		},
+		"beginning_archive_time": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
 		"content_based_deduplication": {
 			Type:     schema.TypeBool,
 			Optional: true,
@@ -62,6 +76,12 @@ var (
 			Type:     schema.TypeString,
 			Optional: true,
 		},
+		"fifo_throughput_scope": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice(FIFOThroughputScope_Values(), false),
+		},
 		"fifo_topic": {
 			Type:     schema.TypeBool,
 			Optional: true,
@@ -169,10 +189,13 @@ var (
 		"application_failure_feedback_role_arn":    TopicAttributeNameApplicationFailureFeedbackRoleARN,
 		"application_success_feedback_role_arn":    TopicAttributeNameApplicationSuccessFeedbackRoleARN,
 		"application_success_feedback_sample_rate": TopicAttributeNameApplicationSuccessFeedbackSampleRate,
+		"archive_policy":                        TopicAttributeNameArchivePolicy,
 		"arn":                                   TopicAttributeNameTopicARN,
+		"beginning_archive_time":                TopicAttributeNameBeginningArchiveTime,
 		"content_based_deduplication":           TopicAttributeNameContentBasedDeduplication,
 		"delivery_policy":                       TopicAttributeNameDeliveryPolicy,
 		"display_name":                          TopicAttributeNameDisplayName,
+		"fifo_throughput_scope":                 TopicAttributeNameFIFOThroughputScope,
 		"fifo_topic":                            TopicAttributeNameFIFOTopic,
 		"firehose_failure_feedback_role_arn":    TopicAttributeNameFirehoseFailureFeedbackRoleARN,
 		"firehose_success_feedback_role_arn":    TopicAttributeNameFirehoseSuccessFeedbackRoleARN,
@@ -437,6 +460,16 @@ func resourceTopicCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, me
 		return fmt.Errorf("content-based deduplication can only be set for FIFO topics")
 	}
 
+	if !fifoTopic {
+		if v := diff.Get("archive_policy").(string); v != "" {
+			return fmt.Errorf("archive policy can only be set for FIFO topics")
+		}
+
+		if v := diff.Get("fifo_throughput_scope").(string); v != "" {
+			return fmt.Errorf("FIFO throughput scope can only be set for FIFO topics")
+		}
+	}
+
 	return nil
 }
 
@@ -447,6 +480,11 @@ func putTopicAttributes(conn *sns.SNS, arn string, attributes map[string]string)
 			continue
 		}
 
+		// An archive policy is removed by setting it to an empty JSON object.
+		if name == TopicAttributeNameArchivePolicy && value == "" {
+			value = "{}"
+		}
+
 		err := putTopicAttribute(conn, arn, name, value)
 
 		if err != nil {
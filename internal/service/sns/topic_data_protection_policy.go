@@ -0,0 +1,128 @@
+package sns
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceTopicDataProtectionPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTopicDataProtectionPolicyUpsert,
+		Read:   resourceTopicDataProtectionPolicyRead,
+		Update: resourceTopicDataProtectionPolicyUpsert,
+		Delete: resourceTopicDataProtectionPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"policy": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validDataProtectionPolicy,
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+		},
+	}
+}
+
+func resourceTopicDataProtectionPolicyUpsert(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SNSConn
+
+	policy, err := structure.NormalizeJsonString(d.Get("policy").(string))
+
+	if err != nil {
+		return fmt.Errorf("policy (%s) is invalid JSON: %w", d.Get("policy").(string), err)
+	}
+
+	arn := d.Get("arn").(string)
+
+	err = putDataProtectionPolicy(conn, arn, policy)
+
+	if err != nil {
+		return err
+	}
+
+	if d.IsNewResource() {
+		d.SetId(arn)
+	}
+
+	return resourceTopicDataProtectionPolicyRead(d, meta)
+}
+
+func resourceTopicDataProtectionPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SNSConn
+
+	policy, err := FindDataProtectionPolicyByARN(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SNS Topic Data Protection Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading SNS Topic Data Protection Policy (%s): %w", d.Id(), err)
+	}
+
+	policyToSet, err := structure.NormalizeJsonString(policy)
+
+	if err != nil {
+		return fmt.Errorf("policy (%s) is invalid JSON: %w", policy, err)
+	}
+
+	d.Set("arn", d.Id())
+	d.Set("policy", policyToSet)
+
+	return nil
+}
+
+func resourceTopicDataProtectionPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SNSConn
+
+	err := putDataProtectionPolicy(conn, d.Id(), "")
+
+	if tfawserr.ErrCodeEquals(err, sns.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	return err
+}
+
+func putDataProtectionPolicy(conn *sns.SNS, arn string, policy string) error {
+	input := &sns.PutDataProtectionPolicyInput{
+		DataProtectionPolicy: aws.String(policy),
+		ResourceArn:          aws.String(arn),
+	}
+
+	log.Printf("[DEBUG] Putting SNS Topic Data Protection Policy: %s", input)
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(topicPutAttributeTimeout, func() (interface{}, error) {
+		return conn.PutDataProtectionPolicy(input)
+	}, sns.ErrCodeInvalidParameterException)
+
+	if err != nil {
+		return fmt.Errorf("error putting SNS Topic (%s) Data Protection Policy: %w", arn, err)
+	}
+
+	return nil
+}
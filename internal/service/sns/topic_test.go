@@ -416,6 +416,116 @@ func TestAccSNSTopic_fifoExpectContentBasedDeduplicationError(t *testing.T) {
 	})
 }
 
+func TestAccSNSTopic_fifoThroughputScope(t *testing.T) {
+	var attributes map[string]string
+	resourceName := "aws_sns_topic.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, sns.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTopicDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTopicConfig_fifoThroughputScope(rName, "Topic"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTopicExists(resourceName, &attributes),
+					resource.TestCheckResourceAttr(resourceName, "fifo_throughput_scope", "Topic"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTopicConfig_fifoThroughputScope(rName, "MessageGroup"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTopicExists(resourceName, &attributes),
+					resource.TestCheckResourceAttr(resourceName, "fifo_throughput_scope", "MessageGroup"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSNSTopic_fifoExpectThroughputScopeError(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, sns.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTopicDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTopicConfig_expectThroughputScopeError(rName),
+				ExpectError: regexp.MustCompile(`FIFO throughput scope can only be set for FIFO topics`),
+			},
+		},
+	})
+}
+
+func TestAccSNSTopic_archivePolicy(t *testing.T) {
+	var attributes map[string]string
+	resourceName := "aws_sns_topic.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, sns.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTopicDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTopicConfig_archivePolicy(rName, 30),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTopicExists(resourceName, &attributes),
+					resource.TestCheckResourceAttr(resourceName, "archive_policy", `{"MessageRetentionPeriod":30}`),
+					resource.TestCheckResourceAttrSet(resourceName, "beginning_archive_time"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTopicConfig_archivePolicy(rName, 60),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTopicExists(resourceName, &attributes),
+					resource.TestCheckResourceAttr(resourceName, "archive_policy", `{"MessageRetentionPeriod":60}`),
+				),
+			},
+			{
+				Config: testAccTopicConfig_nameFIFO(rName + tfsns.FIFOTopicNameSuffix),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTopicExists(resourceName, &attributes),
+					resource.TestCheckResourceAttr(resourceName, "archive_policy", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSNSTopic_expectArchivePolicyError(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, sns.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTopicDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTopicConfig_expectArchivePolicyError(rName),
+				ExpectError: regexp.MustCompile(`archive policy can only be set for FIFO topics`),
+			},
+		},
+	})
+}
+
 func TestAccSNSTopic_encryption(t *testing.T) {
 	var attributes map[string]string
 	resourceName := "aws_sns_topic.test"
@@ -939,6 +1049,50 @@ resource "aws_sns_topic" "test" {
 `, r)
 }
 
+func testAccTopicConfig_fifoThroughputScope(rName, scope string) string {
+	return fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name                  = "%[1]s.fifo"
+  fifo_topic            = true
+  fifo_throughput_scope = %[2]q
+}
+`, rName, scope)
+}
+
+func testAccTopicConfig_expectThroughputScopeError(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name                  = %[1]q
+  fifo_throughput_scope = "Topic"
+}
+`, rName)
+}
+
+func testAccTopicConfig_archivePolicy(rName string, retentionPeriod int) string {
+	return fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name       = "%[1]s.fifo"
+  fifo_topic = true
+
+  archive_policy = jsonencode({
+    MessageRetentionPeriod = %[2]d
+  })
+}
+`, rName, retentionPeriod)
+}
+
+func testAccTopicConfig_expectArchivePolicyError(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+
+  archive_policy = jsonencode({
+    MessageRetentionPeriod = 30
+  })
+}
+`, rName)
+}
+
 func testAccTopicConfig_tags1(r, tag1Key, tag1Value string) string {
 	return fmt.Sprintf(`
 resource "aws_sns_topic" "test" {
@@ -0,0 +1,94 @@
+package sns
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+const (
+	dataProtectionPolicyVersion = "2021-06-01"
+
+	dataProtectionPolicyDataDirectionInbound  = "Inbound"
+	dataProtectionPolicyDataDirectionOutbound = "Outbound"
+)
+
+type dataProtectionPolicy struct {
+	Name          string                          `json:"Name"`
+	Description   string                          `json:"Description,omitempty"`
+	Version       string                          `json:"Version"`
+	Statement     []dataProtectionPolicyStatement `json:"Statement"`
+	Configuration json.RawMessage                 `json:"Configuration,omitempty"`
+}
+
+type dataProtectionPolicyStatement struct {
+	Sid            string                     `json:"Sid,omitempty"`
+	DataDirection  string                     `json:"DataDirection"`
+	Principal      []string                   `json:"Principal"`
+	DataIdentifier []string                   `json:"DataIdentifier"`
+	Operation      map[string]json.RawMessage `json:"Operation"`
+}
+
+// validDataProtectionPolicy checks the structure of an SNS message data protection policy.
+// The API only reports the first problem it finds, so validating the whole document up front
+// surfaces every mistake at plan time.
+func validDataProtectionPolicy(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	var policy dataProtectionPolicy
+
+	if err := json.Unmarshal([]byte(value), &policy); err != nil {
+		errors = append(errors, fmt.Errorf("%q contains an invalid data protection policy: %w", k, err))
+		return
+	}
+
+	if policy.Name == "" {
+		errors = append(errors, fmt.Errorf("%q: Name must be set", k))
+	}
+
+	if policy.Version != dataProtectionPolicyVersion {
+		errors = append(errors, fmt.Errorf("%q: Version must be %q, got %q", k, dataProtectionPolicyVersion, policy.Version))
+	}
+
+	if len(policy.Statement) == 0 {
+		errors = append(errors, fmt.Errorf("%q: at least one Statement must be set", k))
+	}
+
+	for i, statement := range policy.Statement {
+		switch statement.DataDirection {
+		case dataProtectionPolicyDataDirectionInbound, dataProtectionPolicyDataDirectionOutbound:
+		default:
+			errors = append(errors, fmt.Errorf("%q: Statement[%d].DataDirection must be one of %q or %q, got %q", k, i, dataProtectionPolicyDataDirectionInbound, dataProtectionPolicyDataDirectionOutbound, statement.DataDirection))
+		}
+
+		if len(statement.Principal) == 0 {
+			errors = append(errors, fmt.Errorf("%q: Statement[%d].Principal must contain at least one principal", k, i))
+		}
+
+		if len(statement.DataIdentifier) == 0 {
+			errors = append(errors, fmt.Errorf("%q: Statement[%d].DataIdentifier must contain at least one data identifier", k, i))
+		}
+
+		if len(statement.Operation) != 1 {
+			errors = append(errors, fmt.Errorf("%q: Statement[%d].Operation must contain exactly one of Audit, Deidentify or Deny", k, i))
+			continue
+		}
+
+		for operation := range statement.Operation {
+			switch operation {
+			case "Audit":
+				if statement.DataDirection == dataProtectionPolicyDataDirectionOutbound {
+					errors = append(errors, fmt.Errorf("%q: Statement[%d].Operation Audit is only supported for Inbound data", k, i))
+				}
+			case "Deidentify", "Deny":
+			default:
+				errors = append(errors, fmt.Errorf("%q: Statement[%d].Operation %q is not one of Audit, Deidentify or Deny", k, i, operation))
+			}
+		}
+	}
+
+	return
+}
@@ -0,0 +1,111 @@
+package sns
+
+import (
+	"testing"
+)
+
+func TestValidDataProtectionPolicy(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value: `{
+  "Name": "example",
+  "Version": "2021-06-01",
+  "Statement": [
+    {
+      "Sid": "audit",
+      "DataDirection": "Inbound",
+      "Principal": ["*"],
+      "DataIdentifier": ["arn:aws:dataprotection::aws:data-identifier/EmailAddress"],
+      "Operation": {"Audit": {"SampleRate": "99", "FindingsDestination": {}}}
+    },
+    {
+      "Sid": "deny",
+      "DataDirection": "Outbound",
+      "Principal": ["arn:aws:iam::123456789012:role/example"],
+      "DataIdentifier": ["arn:aws:dataprotection::aws:data-identifier/EmailAddress"],
+      "Operation": {"Deny": {}}
+    }
+  ]
+}`,
+			ErrCount: 0,
+		},
+		{
+			Value:    `not json`,
+			ErrCount: 1,
+		},
+		{
+			Value:    `{"Name": "example", "Version": "2021-06-01", "Statement": []}`,
+			ErrCount: 1,
+		},
+		{
+			Value: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "DataDirection": "Inbound",
+      "Principal": ["*"],
+      "DataIdentifier": ["arn:aws:dataprotection::aws:data-identifier/EmailAddress"],
+      "Operation": {"Deny": {}}
+    }
+  ]
+}`,
+			ErrCount: 2,
+		},
+		{
+			Value: `{
+  "Name": "example",
+  "Version": "2021-06-01",
+  "Statement": [
+    {
+      "DataDirection": "Sideways",
+      "Principal": [],
+      "DataIdentifier": [],
+      "Operation": {"Deny": {}, "Audit": {}}
+    }
+  ]
+}`,
+			ErrCount: 4,
+		},
+		{
+			Value: `{
+  "Name": "example",
+  "Version": "2021-06-01",
+  "Statement": [
+    {
+      "DataDirection": "Outbound",
+      "Principal": ["*"],
+      "DataIdentifier": ["arn:aws:dataprotection::aws:data-identifier/EmailAddress"],
+      "Operation": {"Audit": {}}
+    }
+  ]
+}`,
+			ErrCount: 1,
+		},
+		{
+			Value: `{
+  "Name": "example",
+  "Version": "2021-06-01",
+  "Statement": [
+    {
+      "DataDirection": "Inbound",
+      "Principal": ["*"],
+      "DataIdentifier": ["arn:aws:dataprotection::aws:data-identifier/EmailAddress"],
+      "Operation": {"Redact": {}}
+    }
+  ]
+}`,
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validDataProtectionPolicy(tc.Value, "policy")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d errors for %s, got %d: %v", tc.ErrCount, tc.Value, len(errors), errors)
+		}
+	}
+}
@@ -36,3 +36,21 @@ func FIFOThroughputLimit_Values() []string {
 		FIFOThroughputLimitPerQueue,
 	}
 }
+
+const (
+	RedrivePermissionAllowAll = "allowAll"
+	RedrivePermissionByQueue  = "byQueue"
+	RedrivePermissionDenyAll  = "denyAll"
+)
+
+func RedrivePermission_Values() []string {
+	return []string{
+		RedrivePermissionAllowAll,
+		RedrivePermissionByQueue,
+		RedrivePermissionDenyAll,
+	}
+}
+
+const (
+	redriveAllowPolicyMaxSourceQueues = 10
+)
@@ -130,6 +130,7 @@ var (
 		"sqs_managed_sse_enabled": {
 			Type:          schema.TypeBool,
 			Optional:      true,
+			Computed:      true,
 			ConflictsWith: []string{"kms_master_key_id"},
 		},
 		"tags":     tftags.TagsSchema(),
@@ -436,5 +437,33 @@ func resourceQueueCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, me
 		return fmt.Errorf("content-based deduplication can only be set for FIFO queue")
 	}
 
+	if diff.NewValueKnown("redrive_policy") {
+		if v := diff.Get("redrive_policy").(string); v != "" {
+			if err := validateQueueRedrivePolicy(v, fifoQueue); err != nil {
+				return err
+			}
+		}
+	}
+
+	if diff.NewValueKnown("redrive_allow_policy") {
+		if v := diff.Get("redrive_allow_policy").(string); v != "" {
+			if err := validateQueueRedriveAllowPolicy(v, fifoQueue); err != nil {
+				return err
+			}
+		}
+	}
+
+	// SSE-SQS is enabled by default on new queues, so an unconfigured sqs_managed_sse_enabled
+	// follows the choice of KMS key rather than conflicting with it.
+	if diff.GetRawConfig().GetAttr("sqs_managed_sse_enabled").IsNull() && diff.HasChange("kms_master_key_id") {
+		if v := diff.Get("kms_master_key_id").(string); diff.NewValueKnown("kms_master_key_id") && v != "" {
+			if err := diff.SetNew("sqs_managed_sse_enabled", false); err != nil {
+				return fmt.Errorf("error setting sqs_managed_sse_enabled: %w", err)
+			}
+		} else if err := diff.SetNewComputed("sqs_managed_sse_enabled"); err != nil {
+			return fmt.Errorf("error setting sqs_managed_sse_enabled to computed: %w", err)
+		}
+	}
+
 	return nil
 }
@@ -507,6 +507,40 @@ func TestAccSQSQueue_redriveAllowPolicy(t *testing.T) {
 	})
 }
 
+func TestAccSQSQueue_RedrivePolicy_expectQueueTypeError(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, sqs.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccQueueConfig_redrivePolicyMixedQueueTypes(rName),
+				ExpectError: regexp.MustCompile(`dead-letter queue .* must be a FIFO queue`),
+			},
+		},
+	})
+}
+
+func TestAccSQSQueue_RedriveAllowPolicy_expectPermissionError(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, sqs.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccQueueConfig_redriveAllowPolicyNoSourceQueues(rName),
+				ExpectError: regexp.MustCompile(`sourceQueueArns must contain between 1 and 10 queue ARNs`),
+			},
+		},
+	})
+}
+
 func TestAccSQSQueue_fifoQueue(t *testing.T) {
 	var queueAttributes map[string]string
 	resourceName := "aws_sqs_queue.test"
@@ -679,6 +713,61 @@ func TestAccSQSQueue_encryption(t *testing.T) {
 	})
 }
 
+func TestAccSQSQueue_managedEncryptionToKMS(t *testing.T) {
+	var queueAttributes map[string]string
+	resourceName := "aws_sqs_queue.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, sqs.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueueConfig_name(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueueExists(resourceName, &queueAttributes),
+					resource.TestCheckResourceAttr(resourceName, "kms_master_key_id", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "sqs_managed_sse_enabled"),
+				),
+			},
+			{
+				Config: testAccQueueConfig_encryption(rName, "null"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueueExists(resourceName, &queueAttributes),
+					resource.TestCheckResourceAttr(resourceName, "kms_master_key_id", "alias/aws/sqs"),
+					resource.TestCheckResourceAttr(resourceName, "sqs_managed_sse_enabled", "false"),
+				),
+			},
+			{
+				Config: testAccQueueConfig_name(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueueExists(resourceName, &queueAttributes),
+					resource.TestCheckResourceAttr(resourceName, "kms_master_key_id", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSQSQueue_managedEncryption_expectKMSConflictError(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, sqs.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccQueueConfig_managedEncryptionAndKMS(rName),
+				ExpectError: regexp.MustCompile(`"kms_master_key_id": conflicts with sqs_managed_sse_enabled`),
+			},
+		},
+	})
+}
+
 func TestAccSQSQueue_zeroVisibilityTimeoutSeconds(t *testing.T) {
 	var queueAttributes map[string]string
 	resourceName := "aws_sqs_queue.test"
@@ -1076,6 +1165,38 @@ resource "aws_sqs_queue" "dlq" {
 `, rName)
 }
 
+func testAccQueueConfig_redrivePolicyMixedQueueTypes(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {
+  name       = "%[1]s-1.fifo"
+  fifo_queue = true
+
+  redrive_policy = jsonencode({
+    maxReceiveCount     = 3
+    deadLetterTargetArn = "arn:${data.aws_partition.current.partition}:sqs:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:%[1]s-2"
+  })
+}
+
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+`, rName)
+}
+
+func testAccQueueConfig_redriveAllowPolicyNoSourceQueues(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {
+  name = %[1]q
+
+  redrive_allow_policy = jsonencode({
+    redrivePermission = "byQueue"
+  })
+}
+`, rName)
+}
+
 func testAccQueueConfig_fifo(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_sqs_queue" "test" {
@@ -1143,6 +1264,16 @@ resource "aws_sqs_queue" "test" {
 `, rName, sqsManagedSseEnabled)
 }
 
+func testAccQueueConfig_managedEncryptionAndKMS(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {
+  name                    = %[1]q
+  kms_master_key_id       = "alias/aws/sqs"
+  sqs_managed_sse_enabled = true
+}
+`, rName)
+}
+
 func testAccQueueConfig_zeroVisibilityTimeoutSeconds(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_sqs_queue" "test" {
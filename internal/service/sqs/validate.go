@@ -0,0 +1,83 @@
+package sqs
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+)
+
+type queueRedrivePolicy struct {
+	DeadLetterTargetARN string `json:"deadLetterTargetArn"`
+}
+
+type queueRedriveAllowPolicy struct {
+	RedrivePermission string   `json:"redrivePermission"`
+	SourceQueueARNs   []string `json:"sourceQueueArns"`
+}
+
+// validateQueueRedrivePolicy checks that the dead-letter queue in a redrive policy is of the same
+// type (FIFO or standard) as the source queue.
+func validateQueueRedrivePolicy(policy string, fifoQueue bool) error {
+	var v queueRedrivePolicy
+
+	if err := json.Unmarshal([]byte(policy), &v); err != nil {
+		return fmt.Errorf("redrive_policy (%s) is invalid JSON: %w", policy, err)
+	}
+
+	if v.DeadLetterTargetARN == "" {
+		return fmt.Errorf("redrive_policy must contain deadLetterTargetArn")
+	}
+
+	return validateQueueTypeMatches(v.DeadLetterTargetARN, fifoQueue, "dead-letter")
+}
+
+// validateQueueRedriveAllowPolicy checks the redrive permission, its source queue principals, and
+// that every source queue is of the same type (FIFO or standard) as the dead-letter queue.
+func validateQueueRedriveAllowPolicy(policy string, fifoQueue bool) error {
+	var v queueRedriveAllowPolicy
+
+	if err := json.Unmarshal([]byte(policy), &v); err != nil {
+		return fmt.Errorf("redrive_allow_policy (%s) is invalid JSON: %w", policy, err)
+	}
+
+	switch v.RedrivePermission {
+	case RedrivePermissionAllowAll, RedrivePermissionDenyAll:
+		if len(v.SourceQueueARNs) > 0 {
+			return fmt.Errorf("redrive_allow_policy sourceQueueArns can only be set when redrivePermission is %q", RedrivePermissionByQueue)
+		}
+	case RedrivePermissionByQueue:
+		if n := len(v.SourceQueueARNs); n == 0 || n > redriveAllowPolicyMaxSourceQueues {
+			return fmt.Errorf("redrive_allow_policy sourceQueueArns must contain between 1 and %d queue ARNs when redrivePermission is %q", redriveAllowPolicyMaxSourceQueues, RedrivePermissionByQueue)
+		}
+	default:
+		return fmt.Errorf("redrive_allow_policy redrivePermission must be one of %q, got %q", RedrivePermission_Values(), v.RedrivePermission)
+	}
+
+	for _, sourceQueueARN := range v.SourceQueueARNs {
+		if err := validateQueueTypeMatches(sourceQueueARN, fifoQueue, "source"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateQueueTypeMatches(queueARN string, fifoQueue bool, role string) error {
+	v, err := arn.Parse(queueARN)
+
+	if err != nil {
+		return fmt.Errorf("%s queue ARN (%s) is invalid: %w", role, queueARN, err)
+	}
+
+	if strings.HasSuffix(v.Resource, FIFOQueueNameSuffix) != fifoQueue {
+		if fifoQueue {
+			return fmt.Errorf("%s queue (%s) must be a FIFO queue", role, queueARN)
+		}
+
+		return fmt.Errorf("%s queue (%s) must be a standard queue", role, queueARN)
+	}
+
+	return nil
+}
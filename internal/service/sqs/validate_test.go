@@ -0,0 +1,122 @@
+package sqs
+
+import (
+	"testing"
+)
+
+func TestValidateQueueRedrivePolicy(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Policy      string
+		FIFOQueue   bool
+		ExpectError bool
+	}{
+		{
+			Name:      "standard queues",
+			Policy:    `{"maxReceiveCount": 3, "deadLetterTargetArn": "arn:aws:sqs:us-west-2:123456789012:dlq"}`,
+			FIFOQueue: false,
+		},
+		{
+			Name:      "FIFO queues",
+			Policy:    `{"maxReceiveCount": 3, "deadLetterTargetArn": "arn:aws:sqs:us-west-2:123456789012:dlq.fifo"}`,
+			FIFOQueue: true,
+		},
+		{
+			Name:        "FIFO source, standard DLQ",
+			Policy:      `{"maxReceiveCount": 3, "deadLetterTargetArn": "arn:aws:sqs:us-west-2:123456789012:dlq"}`,
+			FIFOQueue:   true,
+			ExpectError: true,
+		},
+		{
+			Name:        "standard source, FIFO DLQ",
+			Policy:      `{"maxReceiveCount": 3, "deadLetterTargetArn": "arn:aws:sqs:us-west-2:123456789012:dlq.fifo"}`,
+			FIFOQueue:   false,
+			ExpectError: true,
+		},
+		{
+			Name:        "missing DLQ",
+			Policy:      `{"maxReceiveCount": 3}`,
+			ExpectError: true,
+		},
+		{
+			Name:        "invalid DLQ ARN",
+			Policy:      `{"maxReceiveCount": 3, "deadLetterTargetArn": "dlq"}`,
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			err := validateQueueRedrivePolicy(testCase.Policy, testCase.FIFOQueue)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatalf("expected error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestValidateQueueRedriveAllowPolicy(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Policy      string
+		FIFOQueue   bool
+		ExpectError bool
+	}{
+		{
+			Name:   "allowAll",
+			Policy: `{"redrivePermission": "allowAll"}`,
+		},
+		{
+			Name:   "denyAll",
+			Policy: `{"redrivePermission": "denyAll"}`,
+		},
+		{
+			Name:   "byQueue",
+			Policy: `{"redrivePermission": "byQueue", "sourceQueueArns": ["arn:aws:sqs:us-west-2:123456789012:source"]}`,
+		},
+		{
+			Name:      "byQueue FIFO",
+			Policy:    `{"redrivePermission": "byQueue", "sourceQueueArns": ["arn:aws:sqs:us-west-2:123456789012:source.fifo"]}`,
+			FIFOQueue: true,
+		},
+		{
+			Name:        "byQueue mixed queue types",
+			Policy:      `{"redrivePermission": "byQueue", "sourceQueueArns": ["arn:aws:sqs:us-west-2:123456789012:source.fifo"]}`,
+			ExpectError: true,
+		},
+		{
+			Name:        "byQueue without source queues",
+			Policy:      `{"redrivePermission": "byQueue"}`,
+			ExpectError: true,
+		},
+		{
+			Name:        "allowAll with source queues",
+			Policy:      `{"redrivePermission": "allowAll", "sourceQueueArns": ["arn:aws:sqs:us-west-2:123456789012:source"]}`,
+			ExpectError: true,
+		},
+		{
+			Name:        "invalid permission",
+			Policy:      `{"redrivePermission": "allowSome"}`,
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			err := validateQueueRedriveAllowPolicy(testCase.Policy, testCase.FIFOQueue)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatalf("expected error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
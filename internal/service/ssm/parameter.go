@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -80,6 +81,12 @@ func ResourceParameter() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
+			"policies": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
 			"tier": {
@@ -118,6 +125,19 @@ func ResourceParameter() *schema.Resource {
 			customdiff.ForceNewIfChange("tier", func(_ context.Context, old, new, meta interface{}) bool {
 				return old.(string) == ssm.ParameterTierAdvanced && new.(string) == ssm.ParameterTierStandard
 			}),
+			// Parameter policies are only supported by advanced parameters.
+			func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+				if diff.Get("policies").(string) == "" {
+					return nil
+				}
+
+				tier := diff.GetRawConfig().GetAttr("tier")
+				if tier.IsKnown() && !tier.IsNull() && tier.AsString() == ssm.ParameterTierStandard {
+					return fmt.Errorf("policies cannot be set when tier is %s", ssm.ParameterTierStandard)
+				}
+
+				return nil
+			},
 			customdiff.ComputedIf("version", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
 				return diff.HasChange("value")
 			}),
@@ -165,6 +185,10 @@ func resourceParameterCreate(d *schema.ResourceData, meta interface{}) error {
 		paramInput.Description = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("policies"); ok {
+		paramInput.Policies = aws.String(v.(string))
+	}
+
 	if keyID, ok := d.GetOk("key_id"); ok && d.Get("type").(string) == ssm.ParameterTypeSecureString {
 		paramInput.SetKeyId(keyID.(string))
 	}
@@ -272,6 +296,7 @@ func resourceParameterRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("tier", detail.Tier)
 	d.Set("allowed_pattern", detail.AllowedPattern)
 	d.Set("data_type", detail.DataType)
+	d.Set("policies", flattenParameterPolicies(detail.Policies))
 
 	tags, err := ListTags(conn, name, ssm.ResourceTypeForTaggingParameter)
 
@@ -331,6 +356,17 @@ func resourceParameterUpdate(d *schema.ResourceData, meta interface{}) error {
 			paramInput.SetKeyId(d.Get("key_id").(string))
 		}
 
+		if d.HasChange("policies") {
+			policies := d.Get("policies").(string)
+
+			// An empty list removes all policies from the parameter.
+			if policies == "" {
+				policies = "[]"
+			}
+
+			paramInput.Policies = aws.String(policies)
+		}
+
 		_, err := conn.PutParameter(paramInput)
 
 		if tfawserr.ErrMessageContains(err, "ValidationException", "Tier is not supported") {
@@ -383,3 +419,22 @@ func ShouldUpdateParameter(d *schema.ResourceData) bool {
 	// if it is not a new resource, otherwise overwrite should be set to false.
 	return !d.IsNewResource()
 }
+
+// flattenParameterPolicies returns the parameter's policies as a JSON array.
+func flattenParameterPolicies(apiObjects []*ssm.ParameterInlinePolicy) string {
+	if len(apiObjects) == 0 {
+		return ""
+	}
+
+	var policies []string
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		policies = append(policies, aws.StringValue(apiObject.PolicyText))
+	}
+
+	return "[" + strings.Join(policies, ",") + "]"
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
@@ -251,6 +252,79 @@ func TestAccSSMParameter_Tier_intelligentTieringOnUpdate(t *testing.T) {
 	})
 }
 
+func TestAccSSMParameter_policies(t *testing.T) {
+	var parameter ssm.Parameter
+	rName := fmt.Sprintf("%s_%s", t.Name(), sdkacctest.RandString(10))
+	resourceName := "aws_ssm_parameter.test"
+	expiration := time.Now().UTC().AddDate(0, 1, 0).Format(time.RFC3339)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParameterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParameterConfig_policies(rName, ssm.ParameterTierAdvanced, expiration),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterExists(resourceName, &parameter),
+					resource.TestCheckResourceAttrSet(resourceName, "policies"),
+					resource.TestCheckResourceAttr(resourceName, "tier", ssm.ParameterTierAdvanced),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"overwrite"},
+			},
+			{
+				Config: testAccParameterConfig_policiesUpdated(rName, ssm.ParameterTierAdvanced),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterExists(resourceName, &parameter),
+					resource.TestCheckResourceAttrSet(resourceName, "policies"),
+					resource.TestCheckResourceAttr(resourceName, "tier", ssm.ParameterTierAdvanced),
+				),
+			},
+			{
+				Config: testAccParameterConfig_tier(rName, ssm.ParameterTierAdvanced),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterExists(resourceName, &parameter),
+					resource.TestCheckResourceAttr(resourceName, "policies", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMParameter_Policies_intelligentTiering(t *testing.T) {
+	var parameter ssm.Parameter
+	rName := fmt.Sprintf("%s_%s", t.Name(), sdkacctest.RandString(10))
+	resourceName := "aws_ssm_parameter.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParameterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccParameterConfig_policiesUpdated(rName, ssm.ParameterTierStandard),
+				ExpectError: regexp.MustCompile(`policies cannot be set when tier is Standard`),
+			},
+			{
+				// Intelligent-Tiering selects the advanced tier for parameters with policies
+				Config: testAccParameterConfig_policiesUpdated(rName, ssm.ParameterTierIntelligentTiering),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterExists(resourceName, &parameter),
+					resource.TestCheckResourceAttrSet(resourceName, "policies"),
+					resource.TestCheckResourceAttr(resourceName, "tier", ssm.ParameterTierAdvanced),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSSMParameter_disappears(t *testing.T) {
 	var param ssm.Parameter
 	name := fmt.Sprintf("%s_%s", t.Name(), sdkacctest.RandString(10))
@@ -934,6 +1008,57 @@ resource "aws_ssm_parameter" "test" {
 `, rName, tier, value)
 }
 
+func testAccParameterConfig_policies(rName, tier, expiration string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameter" "test" {
+  name  = %[1]q
+  tier  = %[2]q
+  type  = "String"
+  value = "test2"
+
+  policies = jsonencode([
+    {
+      Type    = "Expiration"
+      Version = "1.0"
+      Attributes = {
+        Timestamp = %[3]q
+      }
+    },
+    {
+      Type    = "ExpirationNotification"
+      Version = "1.0"
+      Attributes = {
+        Before = "5"
+        Unit   = "Days"
+      }
+    },
+  ])
+}
+`, rName, tier, expiration)
+}
+
+func testAccParameterConfig_policiesUpdated(rName, tier string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameter" "test" {
+  name  = %[1]q
+  tier  = %[2]q
+  type  = "String"
+  value = "test2"
+
+  policies = jsonencode([
+    {
+      Type    = "NoChangeNotification"
+      Version = "1.0"
+      Attributes = {
+        After = "60"
+        Unit  = "Days"
+      }
+    },
+  ])
+}
+`, rName, tier)
+}
+
 func testAccParameterConfig_dataTypeEC2Image(rName string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigLatestAmazonLinuxHVMEBSAMI(),
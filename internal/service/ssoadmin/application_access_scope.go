@@ -0,0 +1,153 @@
+package ssoadmin
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceApplicationAccessScope() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceApplicationAccessScopeCreate,
+		Read:   resourceApplicationAccessScopeRead,
+		Delete: resourceApplicationAccessScopeDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"application_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+
+			"authorized_targets": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+
+			"scope": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceApplicationAccessScopeCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSOAdminConn
+
+	applicationARN := d.Get("application_arn").(string)
+	scope := d.Get("scope").(string)
+	id := ApplicationAccessScopeCreateResourceID(applicationARN, scope)
+
+	input := &ssoadmin.PutApplicationAccessScopeInput{
+		ApplicationArn: aws.String(applicationARN),
+		Scope:          aws.String(scope),
+	}
+
+	if v, ok := d.GetOk("authorized_targets"); ok && len(v.([]interface{})) > 0 {
+		input.AuthorizedTargets = flex.ExpandStringList(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating SSO Application Access Scope: %s", input)
+	if _, err := conn.PutApplicationAccessScope(input); err != nil {
+		return fmt.Errorf("error creating SSO Application Access Scope (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceApplicationAccessScopeRead(d, meta)
+}
+
+func resourceApplicationAccessScopeRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSOAdminConn
+
+	applicationARN, scope, err := ApplicationAccessScopeParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	output, err := FindApplicationAccessScope(conn, applicationARN, scope)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SSO Application Access Scope (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading SSO Application Access Scope (%s): %w", d.Id(), err)
+	}
+
+	d.Set("application_arn", applicationARN)
+	d.Set("authorized_targets", aws.StringValueSlice(output.AuthorizedTargets))
+	d.Set("scope", output.Scope)
+
+	return nil
+}
+
+func resourceApplicationAccessScopeDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSOAdminConn
+
+	applicationARN, scope, err := ApplicationAccessScopeParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting SSO Application Access Scope: %s", d.Id())
+	_, err = conn.DeleteApplicationAccessScope(&ssoadmin.DeleteApplicationAccessScopeInput{
+		ApplicationArn: aws.String(applicationARN),
+		Scope:          aws.String(scope),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ssoadmin.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting SSO Application Access Scope (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+const applicationAccessScopeResourceIDSeparator = ","
+
+func ApplicationAccessScopeCreateResourceID(applicationARN, scope string) string {
+	parts := []string{applicationARN, scope}
+	id := strings.Join(parts, applicationAccessScopeResourceIDSeparator)
+
+	return id
+}
+
+func ApplicationAccessScopeParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, applicationAccessScopeResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected APPLICATION_ARN%[2]sSCOPE", id, applicationAccessScopeResourceIDSeparator)
+}
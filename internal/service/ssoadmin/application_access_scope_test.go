@@ -0,0 +1,109 @@
+package ssoadmin_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfssoadmin "github.com/hashicorp/terraform-provider-aws/internal/service/ssoadmin"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccSSOAdminApplicationAccessScope_basic(t *testing.T) {
+	resourceName := "aws_ssoadmin_application_access_scope.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckInstances(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssoadmin.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationAccessScopeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationAccessScopeConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationAccessScopeExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "application_arn", "aws_ssoadmin_application.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "authorized_targets.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "authorized_targets.0", "aws_ssoadmin_application.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "scope", "sso:account:access"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckApplicationAccessScopeDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SSOAdminConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ssoadmin_application_access_scope" {
+			continue
+		}
+
+		applicationARN, scope, err := tfssoadmin.ApplicationAccessScopeParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfssoadmin.FindApplicationAccessScope(conn, applicationARN, scope)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("SSO Application Access Scope %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckApplicationAccessScopeExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SSO Application Access Scope ID is set")
+		}
+
+		applicationARN, scope, err := tfssoadmin.ApplicationAccessScopeParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSOAdminConn
+
+		_, err = tfssoadmin.FindApplicationAccessScope(conn, applicationARN, scope)
+
+		return err
+	}
+}
+
+func testAccApplicationAccessScopeConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccApplicationConfig_basic(rName, "ENABLED"), `
+resource "aws_ssoadmin_application_access_scope" "test" {
+  application_arn    = aws_ssoadmin_application.test.arn
+  authorized_targets = [aws_ssoadmin_application.test.arn]
+  scope              = "sso:account:access"
+}
+`)
+}
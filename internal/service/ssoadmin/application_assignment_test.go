@@ -0,0 +1,122 @@
+package ssoadmin_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfssoadmin "github.com/hashicorp/terraform-provider-aws/internal/service/ssoadmin"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccSSOAdminApplicationAssignment_basic(t *testing.T) {
+	resourceName := "aws_ssoadmin_application_assignment.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	groupName := os.Getenv("AWS_IDENTITY_STORE_GROUP_NAME")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			testAccPreCheckInstances(t)
+			testAccPreCheckIdentityStoreGroupName(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, ssoadmin.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationAssignmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationAssignmentConfig_basic(rName, groupName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationAssignmentExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "application_arn", "aws_ssoadmin_application.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "principal_id", "data.aws_identitystore_group.test", "group_id"),
+					resource.TestCheckResourceAttr(resourceName, "principal_type", "GROUP"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckApplicationAssignmentDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SSOAdminConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ssoadmin_application_assignment" {
+			continue
+		}
+
+		applicationARN, principalID, principalType, err := tfssoadmin.ApplicationAssignmentParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfssoadmin.FindApplicationAssignment(conn, applicationARN, principalID, principalType)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("SSO Application Assignment %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckApplicationAssignmentExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SSO Application Assignment ID is set")
+		}
+
+		applicationARN, principalID, principalType, err := tfssoadmin.ApplicationAssignmentParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSOAdminConn
+
+		_, err = tfssoadmin.FindApplicationAssignment(conn, applicationARN, principalID, principalType)
+
+		return err
+	}
+}
+
+func testAccApplicationAssignmentConfig_basic(rName, groupName string) string {
+	return acctest.ConfigCompose(testAccApplicationConfig_basic(rName, "ENABLED"), fmt.Sprintf(`
+data "aws_identitystore_group" "test" {
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+  filter {
+    attribute_path  = "DisplayName"
+    attribute_value = %[1]q
+  }
+}
+
+resource "aws_ssoadmin_application_assignment" "test" {
+  application_arn = aws_ssoadmin_application.test.arn
+  principal_id    = data.aws_identitystore_group.test.group_id
+  principal_type  = "GROUP"
+}
+`, groupName))
+}
@@ -3,6 +3,9 @@ package ssoadmin
 import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 // FindAccountAssignment returns the account assigned to a permission set within a specified SSO instance.
@@ -69,3 +72,106 @@ func FindManagedPolicy(conn *ssoadmin.SSOAdmin, managedPolicyArn, permissionSetA
 
 	return attachedPolicy, err
 }
+
+func FindApplicationByARN(conn *ssoadmin.SSOAdmin, arn string) (*ssoadmin.DescribeApplicationOutput, error) {
+	input := &ssoadmin.DescribeApplicationInput{
+		ApplicationArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeApplication(input)
+
+	if tfawserr.ErrCodeEquals(err, ssoadmin.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindApplicationAssignment(conn *ssoadmin.SSOAdmin, applicationARN, principalID, principalType string) (*ssoadmin.DescribeApplicationAssignmentOutput, error) {
+	input := &ssoadmin.DescribeApplicationAssignmentInput{
+		ApplicationArn: aws.String(applicationARN),
+		PrincipalId:    aws.String(principalID),
+		PrincipalType:  aws.String(principalType),
+	}
+
+	output, err := conn.DescribeApplicationAssignment(input)
+
+	if tfawserr.ErrCodeEquals(err, ssoadmin.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindApplicationAccessScope(conn *ssoadmin.SSOAdmin, applicationARN, scope string) (*ssoadmin.GetApplicationAccessScopeOutput, error) {
+	input := &ssoadmin.GetApplicationAccessScopeInput{
+		ApplicationArn: aws.String(applicationARN),
+		Scope:          aws.String(scope),
+	}
+
+	output, err := conn.GetApplicationAccessScope(input)
+
+	if tfawserr.ErrCodeEquals(err, ssoadmin.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindTrustedTokenIssuerByARN(conn *ssoadmin.SSOAdmin, arn string) (*ssoadmin.DescribeTrustedTokenIssuerOutput, error) {
+	input := &ssoadmin.DescribeTrustedTokenIssuerInput{
+		TrustedTokenIssuerArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeTrustedTokenIssuer(input)
+
+	if tfawserr.ErrCodeEquals(err, ssoadmin.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.TrustedTokenIssuerConfiguration == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
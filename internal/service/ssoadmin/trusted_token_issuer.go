@@ -0,0 +1,332 @@
+package ssoadmin
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceTrustedTokenIssuer() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTrustedTokenIssuerCreate,
+		Read:   resourceTrustedTokenIssuerRead,
+		Update: resourceTrustedTokenIssuerUpdate,
+		Delete: resourceTrustedTokenIssuerDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"instance_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+
+			"trusted_token_issuer_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"oidc_jwt_configuration": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"claim_attribute_path": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 255),
+									},
+									"identity_store_attribute_path": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 255),
+									},
+									"issuer_url": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IsURLWithHTTPS,
+									},
+									"jwks_retrieval_option": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(ssoadmin.JwksRetrievalOption_Values(), false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"trusted_token_issuer_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(ssoadmin.TrustedTokenIssuerType_Values(), false),
+			},
+
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceTrustedTokenIssuerCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSOAdminConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	instanceARN := d.Get("instance_arn").(string)
+	name := d.Get("name").(string)
+	input := &ssoadmin.CreateTrustedTokenIssuerInput{
+		InstanceArn:            aws.String(instanceARN),
+		Name:                   aws.String(name),
+		TrustedTokenIssuerType: aws.String(d.Get("trusted_token_issuer_type").(string)),
+	}
+
+	if v, ok := d.GetOk("trusted_token_issuer_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.TrustedTokenIssuerConfiguration = expandTrustedTokenIssuerConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating SSO Trusted Token Issuer: %s", input)
+	output, err := conn.CreateTrustedTokenIssuer(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating SSO Trusted Token Issuer (%s): %w", name, err)
+	}
+
+	d.SetId(TrustedTokenIssuerCreateResourceID(aws.StringValue(output.TrustedTokenIssuerArn), instanceARN))
+
+	return resourceTrustedTokenIssuerRead(d, meta)
+}
+
+func resourceTrustedTokenIssuerRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSOAdminConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	arn, instanceARN, err := TrustedTokenIssuerParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	output, err := FindTrustedTokenIssuerByARN(conn, arn)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SSO Trusted Token Issuer (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading SSO Trusted Token Issuer (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", output.TrustedTokenIssuerArn)
+	d.Set("instance_arn", instanceARN)
+	d.Set("name", output.Name)
+	if err := d.Set("trusted_token_issuer_configuration", flattenTrustedTokenIssuerConfiguration(output.TrustedTokenIssuerConfiguration)); err != nil {
+		return fmt.Errorf("error setting trusted_token_issuer_configuration: %w", err)
+	}
+	d.Set("trusted_token_issuer_type", output.TrustedTokenIssuerType)
+
+	tags, err := ListTags(conn, arn, instanceARN)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for SSO Trusted Token Issuer (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceTrustedTokenIssuerUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSOAdminConn
+
+	arn, instanceARN, err := TrustedTokenIssuerParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	if d.HasChanges("name", "trusted_token_issuer_configuration") {
+		input := &ssoadmin.UpdateTrustedTokenIssuerInput{
+			TrustedTokenIssuerArn: aws.String(arn),
+		}
+
+		if d.HasChange("name") {
+			input.Name = aws.String(d.Get("name").(string))
+		}
+
+		if d.HasChange("trusted_token_issuer_configuration") {
+			if v, ok := d.GetOk("trusted_token_issuer_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.TrustedTokenIssuerConfiguration = expandTrustedTokenIssuerUpdateConfiguration(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		log.Printf("[DEBUG] Updating SSO Trusted Token Issuer: %s", input)
+		if _, err := conn.UpdateTrustedTokenIssuer(input); err != nil {
+			return fmt.Errorf("error updating SSO Trusted Token Issuer (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+		if err := UpdateTags(conn, arn, instanceARN, o, n); err != nil {
+			return fmt.Errorf("error updating SSO Trusted Token Issuer (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceTrustedTokenIssuerRead(d, meta)
+}
+
+func resourceTrustedTokenIssuerDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSOAdminConn
+
+	arn, _, err := TrustedTokenIssuerParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting SSO Trusted Token Issuer: %s", d.Id())
+	_, err = conn.DeleteTrustedTokenIssuer(&ssoadmin.DeleteTrustedTokenIssuerInput{
+		TrustedTokenIssuerArn: aws.String(arn),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ssoadmin.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting SSO Trusted Token Issuer (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+const trustedTokenIssuerResourceIDSeparator = ","
+
+func TrustedTokenIssuerCreateResourceID(arn, instanceARN string) string {
+	parts := []string{arn, instanceARN}
+	id := strings.Join(parts, trustedTokenIssuerResourceIDSeparator)
+
+	return id
+}
+
+func TrustedTokenIssuerParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, trustedTokenIssuerResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected TRUSTED_TOKEN_ISSUER_ARN%[2]sINSTANCE_ARN", id, trustedTokenIssuerResourceIDSeparator)
+}
+
+func expandTrustedTokenIssuerConfiguration(tfMap map[string]interface{}) *ssoadmin.TrustedTokenIssuerConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ssoadmin.TrustedTokenIssuerConfiguration{}
+
+	if v, ok := tfMap["oidc_jwt_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.OidcJwtConfiguration = &ssoadmin.OidcJwtConfiguration{
+			ClaimAttributePath:         aws.String(tfMap["claim_attribute_path"].(string)),
+			IdentityStoreAttributePath: aws.String(tfMap["identity_store_attribute_path"].(string)),
+			IssuerUrl:                  aws.String(tfMap["issuer_url"].(string)),
+			JwksRetrievalOption:        aws.String(tfMap["jwks_retrieval_option"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func expandTrustedTokenIssuerUpdateConfiguration(tfMap map[string]interface{}) *ssoadmin.TrustedTokenIssuerUpdateConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ssoadmin.TrustedTokenIssuerUpdateConfiguration{}
+
+	if v, ok := tfMap["oidc_jwt_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.OidcJwtConfiguration = &ssoadmin.OidcJwtUpdateConfiguration{
+			ClaimAttributePath:         aws.String(tfMap["claim_attribute_path"].(string)),
+			IdentityStoreAttributePath: aws.String(tfMap["identity_store_attribute_path"].(string)),
+			JwksRetrievalOption:        aws.String(tfMap["jwks_retrieval_option"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func flattenTrustedTokenIssuerConfiguration(apiObject *ssoadmin.TrustedTokenIssuerConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.OidcJwtConfiguration; v != nil {
+		tfMap["oidc_jwt_configuration"] = []interface{}{
+			map[string]interface{}{
+				"claim_attribute_path":          aws.StringValue(v.ClaimAttributePath),
+				"identity_store_attribute_path": aws.StringValue(v.IdentityStoreAttributePath),
+				"issuer_url":                    aws.StringValue(v.IssuerUrl),
+				"jwks_retrieval_option":         aws.StringValue(v.JwksRetrievalOption),
+			},
+		}
+	}
+
+	return []interface{}{tfMap}
+}
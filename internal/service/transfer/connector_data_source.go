@@ -0,0 +1,178 @@
+package transfer
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/transfer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func DataSourceConnector() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceConnectorRead,
+
+		Schema: map[string]*schema.Schema{
+			"access_role": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"as2_config": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"basic_auth_secret_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"compression": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"encryption_algorithm": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"local_profile_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"mdn_response": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"mdn_signing_algorithm": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"message_subject": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"partner_profile_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"signing_algorithm": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"connector_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"logging_role": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"security_policy_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"service_managed_egress_ip_addresses": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"sftp_config": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"trusted_host_keys": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"user_secret_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"tags": tftags.TagsSchemaComputed(),
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceConnectorRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).TransferConn
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	connectorID := d.Get("connector_id").(string)
+
+	output, err := FindConnectorByID(conn, connectorID)
+
+	if err != nil {
+		return fmt.Errorf("error reading Transfer Connector (%s): %w", connectorID, err)
+	}
+
+	d.SetId(aws.StringValue(output.ConnectorId))
+	d.Set("access_role", output.AccessRole)
+	d.Set("arn", output.Arn)
+	if err := d.Set("as2_config", flattenAs2ConnectorConfig(output.As2Config)); err != nil {
+		return fmt.Errorf("error setting as2_config: %w", err)
+	}
+	d.Set("connector_id", output.ConnectorId)
+	d.Set("logging_role", output.LoggingRole)
+	d.Set("security_policy_name", output.SecurityPolicyName)
+	d.Set("service_managed_egress_ip_addresses", aws.StringValueSlice(output.ServiceManagedEgressIpAddresses))
+	if err := d.Set("sftp_config", flattenSftpConnectorConfig(output.SftpConfig)); err != nil {
+		return fmt.Errorf("error setting sftp_config: %w", err)
+	}
+	d.Set("url", output.Url)
+
+	if err := d.Set("tags", KeyValueTags(output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
+
+func flattenAs2ConnectorConfig(apiObject *transfer.As2ConnectorConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"basic_auth_secret_id":  aws.StringValue(apiObject.BasicAuthSecretId),
+		"compression":           aws.StringValue(apiObject.Compression),
+		"encryption_algorithm":  aws.StringValue(apiObject.EncryptionAlgorithm),
+		"local_profile_id":      aws.StringValue(apiObject.LocalProfileId),
+		"mdn_response":          aws.StringValue(apiObject.MdnResponse),
+		"mdn_signing_algorithm": aws.StringValue(apiObject.MdnSigningAlgorithm),
+		"message_subject":       aws.StringValue(apiObject.MessageSubject),
+		"partner_profile_id":    aws.StringValue(apiObject.PartnerProfileId),
+		"signing_algorithm":     aws.StringValue(apiObject.SigningAlgorithm),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenSftpConnectorConfig(apiObject *transfer.SftpConnectorConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"trusted_host_keys": aws.StringValueSlice(apiObject.TrustedHostKeys),
+		"user_secret_id":    aws.StringValue(apiObject.UserSecretId),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,49 @@
+package transfer_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/transfer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccTransferConnectorDataSource_basic(t *testing.T) {
+	connectorID := os.Getenv("TRANSFER_CONNECTOR_ID")
+	if connectorID == "" {
+		t.Skip(
+			"Environment variable TRANSFER_CONNECTOR_ID is not set. " +
+				"To properly test the Transfer Connector data source, " +
+				"the ID of an existing SFTP or AS2 connector must be provided.")
+	}
+
+	datasourceName := "data.aws_transfer_connector.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, transfer.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConnectorDataSourceConfig_basic(connectorID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, "id", connectorID),
+					resource.TestCheckResourceAttrSet(datasourceName, "access_role"),
+					resource.TestCheckResourceAttrSet(datasourceName, "arn"),
+					resource.TestCheckResourceAttrSet(datasourceName, "service_managed_egress_ip_addresses.#"),
+					resource.TestCheckResourceAttrSet(datasourceName, "url"),
+				),
+			},
+		},
+	})
+}
+
+func testAccConnectorDataSourceConfig_basic(connectorID string) string {
+	return fmt.Sprintf(`
+data "aws_transfer_connector" "test" {
+  connector_id = %[1]q
+}
+`, connectorID)
+}
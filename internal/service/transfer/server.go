@@ -613,9 +613,23 @@ func resourceServerUpdate(d *schema.ResourceData, meta interface{}) error {
 			input.WorkflowDetails = expandWorkflowDetails(d.Get("workflow_details").([]interface{}))
 		}
 
+		// Only stop (and later restart) the server if it's currently online.
+		// A server that has been stopped outside of Terraform is left offline.
+		var restartServer bool
+
 		if offlineUpdate {
-			if err := stopServer(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
-				return err
+			server, err := FindServerByID(conn, d.Id())
+
+			if err != nil {
+				return fmt.Errorf("error reading Transfer Server (%s): %w", d.Id(), err)
+			}
+
+			if aws.StringValue(server.State) == transfer.StateOnline {
+				if err := stopServer(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+					return err
+				}
+
+				restartServer = true
 			}
 		}
 
@@ -652,7 +666,7 @@ func resourceServerUpdate(d *schema.ResourceData, meta interface{}) error {
 			}
 		}
 
-		if offlineUpdate {
+		if restartServer {
 			if err := startServer(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
 				return err
 			}
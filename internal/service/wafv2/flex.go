@@ -271,6 +271,48 @@ func expandVisibilityConfig(l []interface{}) *wafv2.VisibilityConfig {
 	return configuration
 }
 
+func expandCaptchaConfig(l []interface{}) *wafv2.CaptchaConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	configuration := &wafv2.CaptchaConfig{}
+
+	if v, ok := m["immunity_time_property"].([]interface{}); ok {
+		configuration.ImmunityTimeProperty = expandImmunityTimeProperty(v)
+	}
+
+	return configuration
+}
+
+func expandChallengeConfig(l []interface{}) *wafv2.ChallengeConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	configuration := &wafv2.ChallengeConfig{}
+
+	if v, ok := m["immunity_time_property"].([]interface{}); ok {
+		configuration.ImmunityTimeProperty = expandImmunityTimeProperty(v)
+	}
+
+	return configuration
+}
+
+func expandImmunityTimeProperty(l []interface{}) *wafv2.ImmunityTimeProperty {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &wafv2.ImmunityTimeProperty{
+		ImmunityTime: aws.Int64(int64(m["immunity_time"].(int))),
+	}
+}
+
 func expandRootStatement(l []interface{}) *wafv2.Statement {
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -395,6 +437,10 @@ func expandFieldToMatch(l []interface{}) *wafv2.FieldToMatch {
 		f.Body = &wafv2.Body{}
 	}
 
+	if v, ok := m["ja3_fingerprint"]; ok && len(v.([]interface{})) > 0 {
+		f.JA3Fingerprint = expandJA3Fingerprint(v.([]interface{}))
+	}
+
 	if v, ok := m["method"]; ok && len(v.([]interface{})) > 0 {
 		f.Method = &wafv2.Method{}
 	}
@@ -418,6 +464,18 @@ func expandFieldToMatch(l []interface{}) *wafv2.FieldToMatch {
 	return f
 }
 
+func expandJA3Fingerprint(l []interface{}) *wafv2.JA3Fingerprint {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &wafv2.JA3Fingerprint{
+		FallbackBehavior: aws.String(m["fallback_behavior"].(string)),
+	}
+}
+
 func expandForwardedIPConfig(l []interface{}) *wafv2.ForwardedIPConfig {
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -928,6 +986,10 @@ func flattenFieldToMatch(f *wafv2.FieldToMatch) interface{} {
 		m["body"] = make([]map[string]interface{}, 1)
 	}
 
+	if f.JA3Fingerprint != nil {
+		m["ja3_fingerprint"] = flattenJA3Fingerprint(f.JA3Fingerprint)
+	}
+
 	if f.Method != nil {
 		m["method"] = make([]map[string]interface{}, 1)
 	}
@@ -951,6 +1013,18 @@ func flattenFieldToMatch(f *wafv2.FieldToMatch) interface{} {
 	return []interface{}{m}
 }
 
+func flattenJA3Fingerprint(j *wafv2.JA3Fingerprint) interface{} {
+	if j == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"fallback_behavior": aws.StringValue(j.FallbackBehavior),
+	}
+
+	return []interface{}{m}
+}
+
 func flattenForwardedIPConfig(f *wafv2.ForwardedIPConfig) interface{} {
 	if f == nil {
 		return []interface{}{}
@@ -1131,6 +1205,42 @@ func flattenXSSMatchStatement(s *wafv2.XssMatchStatement) interface{} {
 	return []interface{}{m}
 }
 
+func flattenCaptchaConfig(config *wafv2.CaptchaConfig) interface{} {
+	if config == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"immunity_time_property": flattenImmunityTimeProperty(config.ImmunityTimeProperty),
+	}
+
+	return []interface{}{m}
+}
+
+func flattenChallengeConfig(config *wafv2.ChallengeConfig) interface{} {
+	if config == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"immunity_time_property": flattenImmunityTimeProperty(config.ImmunityTimeProperty),
+	}
+
+	return []interface{}{m}
+}
+
+func flattenImmunityTimeProperty(property *wafv2.ImmunityTimeProperty) interface{} {
+	if property == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"immunity_time": int(aws.Int64Value(property.ImmunityTime)),
+	}
+
+	return []interface{}{m}
+}
+
 func flattenVisibilityConfig(config *wafv2.VisibilityConfig) interface{} {
 	if config == nil {
 		return []interface{}{}
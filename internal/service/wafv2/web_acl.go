@@ -1,13 +1,18 @@
 package wafv2
 
 import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"log"
 	"regexp"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
 	"github.com/aws/aws-sdk-go/service/wafv2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -56,6 +61,8 @@ func ResourceWebACL() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"captcha_config":       immunityConfigSchema(),
+			"challenge_config":     immunityConfigSchema(),
 			"custom_response_body": customResponseBodySchema(),
 			"default_action": {
 				Type:     schema.TypeList,
@@ -96,8 +103,9 @@ func ResourceWebACL() *schema.Resource {
 				}, false),
 			},
 			"rule": {
-				Type:     schema.TypeSet,
-				Optional: true,
+				Type:          schema.TypeSet,
+				Optional:      true,
+				ConflictsWith: []string{"rule_json"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"action": {
@@ -113,6 +121,8 @@ func ResourceWebACL() *schema.Resource {
 								},
 							},
 						},
+						"captcha_config":   immunityConfigSchema(),
+						"challenge_config": immunityConfigSchema(),
 						"name": {
 							Type:         schema.TypeString,
 							Required:     true,
@@ -139,6 +149,13 @@ func ResourceWebACL() *schema.Resource {
 					},
 				},
 			},
+			"rule_json": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ConflictsWith:    []string{"rule"},
+				ValidateFunc:     validWebACLRulesJSON,
+				DiffSuppressFunc: suppressEquivalentWebACLRulesJSONDiffs,
+			},
 			"tags":              tftags.TagsSchema(),
 			"tags_all":          tftags.TagsSchemaComputed(),
 			"visibility_config": visibilityConfigSchema(),
@@ -162,6 +179,24 @@ func resourceWebACLCreate(d *schema.ResourceData, meta interface{}) error {
 		VisibilityConfig: expandVisibilityConfig(d.Get("visibility_config").([]interface{})),
 	}
 
+	if v, ok := d.GetOk("rule_json"); ok {
+		rules, err := expandWebACLRulesJSON(v.(string))
+
+		if err != nil {
+			return fmt.Errorf("Error expanding WAFv2 WebACL rule_json: %w", err)
+		}
+
+		params.Rules = rules
+	}
+
+	if v, ok := d.GetOk("captcha_config"); ok {
+		params.CaptchaConfig = expandCaptchaConfig(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("challenge_config"); ok {
+		params.ChallengeConfig = expandChallengeConfig(v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk("custom_response_body"); ok && v.(*schema.Set).Len() > 0 {
 		params.CustomResponseBodies = expandCustomResponseBodies(v.(*schema.Set).List())
 	}
@@ -234,6 +269,14 @@ func resourceWebACLRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("arn", resp.WebACL.ARN)
 	d.Set("lock_token", resp.LockToken)
 
+	if err := d.Set("captcha_config", flattenCaptchaConfig(resp.WebACL.CaptchaConfig)); err != nil {
+		return fmt.Errorf("Error setting captcha_config: %w", err)
+	}
+
+	if err := d.Set("challenge_config", flattenChallengeConfig(resp.WebACL.ChallengeConfig)); err != nil {
+		return fmt.Errorf("Error setting challenge_config: %w", err)
+	}
+
 	if err := d.Set("custom_response_body", flattenCustomResponseBodies(resp.WebACL.CustomResponseBodies)); err != nil {
 		return fmt.Errorf("Error setting custom_response_body: %w", err)
 	}
@@ -242,8 +285,19 @@ func resourceWebACLRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error setting default_action: %w", err)
 	}
 
-	if err := d.Set("rule", flattenWebACLRules(resp.WebACL.Rules)); err != nil {
-		return fmt.Errorf("Error setting rule: %w", err)
+	if _, ok := d.GetOk("rule_json"); ok {
+		rulesJSON, err := flattenWebACLRulesJSON(resp.WebACL.Rules)
+
+		if err != nil {
+			return fmt.Errorf("Error flattening WAFv2 WebACL rules: %w", err)
+		}
+
+		d.Set("rule", nil)
+		d.Set("rule_json", rulesJSON)
+	} else {
+		if err := d.Set("rule", flattenWebACLRules(resp.WebACL.Rules)); err != nil {
+			return fmt.Errorf("Error setting rule: %w", err)
+		}
 	}
 
 	if err := d.Set("visibility_config", flattenVisibilityConfig(resp.WebACL.VisibilityConfig)); err != nil {
@@ -273,7 +327,7 @@ func resourceWebACLRead(d *schema.ResourceData, meta interface{}) error {
 func resourceWebACLUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).WAFV2Conn
 
-	if d.HasChanges("custom_response_body", "default_action", "description", "rule", "visibility_config") {
+	if d.HasChanges("captcha_config", "challenge_config", "custom_response_body", "default_action", "description", "rule", "rule_json", "visibility_config") {
 		u := &wafv2.UpdateWebACLInput{
 			Id:               aws.String(d.Id()),
 			Name:             aws.String(d.Get("name").(string)),
@@ -284,6 +338,24 @@ func resourceWebACLUpdate(d *schema.ResourceData, meta interface{}) error {
 			VisibilityConfig: expandVisibilityConfig(d.Get("visibility_config").([]interface{})),
 		}
 
+		if v, ok := d.GetOk("rule_json"); ok {
+			rules, err := expandWebACLRulesJSON(v.(string))
+
+			if err != nil {
+				return fmt.Errorf("Error expanding WAFv2 WebACL rule_json: %w", err)
+			}
+
+			u.Rules = rules
+		}
+
+		if v, ok := d.GetOk("captcha_config"); ok {
+			u.CaptchaConfig = expandCaptchaConfig(v.([]interface{}))
+		}
+
+		if v, ok := d.GetOk("challenge_config"); ok {
+			u.ChallengeConfig = expandChallengeConfig(v.([]interface{}))
+		}
+
 		if v, ok := d.GetOk("custom_response_body"); ok && v.(*schema.Set).Len() > 0 {
 			u.CustomResponseBodies = expandCustomResponseBodies(v.(*schema.Set).List())
 		}
@@ -535,6 +607,14 @@ func expandWebACLRule(m map[string]interface{}) *wafv2.Rule {
 		VisibilityConfig: expandVisibilityConfig(m["visibility_config"].([]interface{})),
 	}
 
+	if v, ok := m["captcha_config"].([]interface{}); ok {
+		rule.CaptchaConfig = expandCaptchaConfig(v)
+	}
+
+	if v, ok := m["challenge_config"].([]interface{}); ok {
+		rule.ChallengeConfig = expandChallengeConfig(v)
+	}
+
 	if v, ok := m["rule_label"].(*schema.Set); ok && v.Len() > 0 {
 		rule.RuleLabels = expandRuleLabels(v.List())
 	}
@@ -821,6 +901,8 @@ func flattenWebACLRules(r []*wafv2.Rule) interface{} {
 	for i, rule := range r {
 		m := make(map[string]interface{})
 		m["action"] = flattenRuleAction(rule.Action)
+		m["captcha_config"] = flattenCaptchaConfig(rule.CaptchaConfig)
+		m["challenge_config"] = flattenChallengeConfig(rule.ChallengeConfig)
 		m["override_action"] = flattenOverrideAction(rule.OverrideAction)
 		m["name"] = aws.StringValue(rule.Name)
 		m["priority"] = int(aws.Int64Value(rule.Priority))
@@ -948,3 +1030,155 @@ func flattenExcludedRules(r []*wafv2.ExcludedRule) interface{} {
 
 	return out
 }
+
+// validWebACLRulesJSON checks that rule_json is a JSON array of WAF rules.
+func validWebACLRulesJSON(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := expandWebACLRulesJSON(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a JSON array of WAFv2 rules: %w", k, err))
+	}
+
+	return
+}
+
+// suppressEquivalentWebACLRulesJSONDiffs compares two rule_json values after
+// round-tripping them through the API shapes, so formatting, key order and
+// rule order do not cause diffs.
+func suppressEquivalentWebACLRulesJSONDiffs(k, old, new string, d *schema.ResourceData) bool {
+	o, err := normalizeWebACLRulesJSON(old)
+
+	if err != nil {
+		return false
+	}
+
+	n, err := normalizeWebACLRulesJSON(new)
+
+	if err != nil {
+		return false
+	}
+
+	return verify.JSONBytesEqual([]byte(o), []byte(n))
+}
+
+func normalizeWebACLRulesJSON(rawRules string) (string, error) {
+	rules, err := expandWebACLRulesJSON(rawRules)
+
+	if err != nil {
+		return "", err
+	}
+
+	sort.SliceStable(rules, func(i, j int) bool {
+		return aws.Int64Value(rules[i].Priority) < aws.Int64Value(rules[j].Priority)
+	})
+
+	return flattenWebACLRulesJSON(rules)
+}
+
+// expandWebACLRulesJSON decodes a JSON rules array in the format used by the
+// WAF console JSON editor. Byte match search strings are plain text there,
+// whereas the API shapes expect them base64-encoded.
+func expandWebACLRulesJSON(rawRules string) ([]*wafv2.Rule, error) {
+	var tfList []interface{}
+
+	if err := json.Unmarshal([]byte(rawRules), &tfList); err != nil {
+		return nil, err
+	}
+
+	if err := transformWebACLRulesJSONSearchStrings(tfList, func(s string) (string, error) {
+		return base64.StdEncoding.EncodeToString([]byte(s)), nil
+	}); err != nil {
+		return nil, err
+	}
+
+	b, err := json.Marshal(tfList)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var rules []*wafv2.Rule
+
+	// Reject keys that the API shapes don't model rather than silently dropping them.
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(&rules); err != nil {
+		return nil, err
+	}
+
+	for i, rule := range rules {
+		if rule == nil {
+			return nil, fmt.Errorf("rule %d is null", i)
+		}
+
+		if err := rule.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	return rules, nil
+}
+
+func flattenWebACLRulesJSON(rules []*wafv2.Rule) (string, error) {
+	if rules == nil {
+		rules = []*wafv2.Rule{}
+	}
+
+	b, err := jsonutil.BuildJSON(rules)
+
+	if err != nil {
+		return "", err
+	}
+
+	var tfList []interface{}
+
+	if err := json.Unmarshal(b, &tfList); err != nil {
+		return "", err
+	}
+
+	if err := transformWebACLRulesJSONSearchStrings(tfList, func(s string) (string, error) {
+		v, err := base64.StdEncoding.DecodeString(s)
+
+		return string(v), err
+	}); err != nil {
+		return "", err
+	}
+
+	b, err = json.Marshal(tfList)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+func transformWebACLRulesJSONSearchStrings(v interface{}, f func(string) (string, error)) error {
+	switch v := v.(type) {
+	case []interface{}:
+		for _, v := range v {
+			if err := transformWebACLRulesJSONSearchStrings(v, f); err != nil {
+				return err
+			}
+		}
+	case map[string]interface{}:
+		for k, e := range v {
+			if s, ok := e.(string); ok && k == "SearchString" {
+				s, err := f(s)
+
+				if err != nil {
+					return err
+				}
+
+				v[k] = s
+
+				continue
+			}
+
+			if err := transformWebACLRulesJSONSearchStrings(e, f); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
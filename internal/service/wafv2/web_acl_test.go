@@ -1641,6 +1641,180 @@ func TestAccWAFV2WebACL_Operators_maxNested(t *testing.T) {
 	})
 }
 
+func TestAccWAFV2WebACL_ruleJSON(t *testing.T) {
+	var v wafv2.WebACL
+	webACLName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_wafv2_web_acl.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckScopeRegional(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, wafv2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWebACLDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWebACLConfig_ruleJSON(webACLName, "/admin"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWebACLExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "rule_json"),
+				),
+			},
+			{
+				Config:   testAccWebACLConfig_ruleJSONReformatted(webACLName),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccWebACLConfig_ruleJSON(webACLName, "/internal"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWebACLExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "0"),
+					resource.TestMatchResourceAttr(resourceName, "rule_json", regexp.MustCompile(`"SearchString":"/internal"`)),
+				),
+			},
+			{
+				Config: testAccWebACLConfig_basicRule(webACLName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWebACLExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rule_json", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestWebACLRuleJSONValidation(t *testing.T) {
+	t.Parallel()
+
+	validateFunc := tfwafv2.ResourceWebACL().Schema["rule_json"].ValidateFunc
+
+	testCases := []struct {
+		Name     string
+		Value    string
+		Expected *regexp.Regexp
+	}{
+		{
+			Name:  "valid",
+			Value: `[{"Name":"test","Priority":1,"Action":{"Block":{}},"Statement":{"ByteMatchStatement":{"SearchString":"/admin","FieldToMatch":{"UriPath":{}},"TextTransformations":[{"Priority":0,"Type":"NONE"}],"PositionalConstraint":"STARTS_WITH"}},"VisibilityConfig":{"CloudWatchMetricsEnabled":false,"MetricName":"test","SampledRequestsEnabled":false}}]`,
+		},
+		{
+			Name:     "unknown key",
+			Value:    `[{"Name":"test","Priority":1,"Action":{"Block":{}},"Statement":{"ByteMatchStatement":{"SearchString":"/admin","FieldToMatch":{"UriPath":{}},"TextTransformations":[{"Priority":0,"Type":"NONE"}],"PositionalConstraint":"STARTS_WITH"}},"VisibilityConfig":{"CloudWatchMetricsEnabled":false,"MetricName":"test","SampledRequestsEnabled":false},"RuleLabelz":[]}]`,
+			Expected: regexp.MustCompile(`unknown field "RuleLabelz"`),
+		},
+		{
+			Name:     "unknown nested key",
+			Value:    `[{"Name":"test","Priority":1,"Action":{"Block":{}},"Statement":{"ByteMatchStatement":{"SearchString":"/admin","FieldToMatch":{"UriPath":{}},"TextTransformations":[{"Priority":0,"Type":"NONE"}],"PositionalConstraint":"STARTS_WITH","Unsupported":true}},"VisibilityConfig":{"CloudWatchMetricsEnabled":false,"MetricName":"test","SampledRequestsEnabled":false}}]`,
+			Expected: regexp.MustCompile(`unknown field "Unsupported"`),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			_, errs := validateFunc(testCase.Value, "rule_json")
+
+			if testCase.Expected == nil {
+				if len(errs) > 0 {
+					t.Fatalf("unexpected errors: %s", errs)
+				}
+
+				return
+			}
+
+			if len(errs) != 1 {
+				t.Fatalf("got %d errors, want 1: %s", len(errs), errs)
+			}
+
+			if !testCase.Expected.MatchString(errs[0].Error()) {
+				t.Errorf("error = %q, want to match %q", errs[0], testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestAccWAFV2WebACL_immunityConfig(t *testing.T) {
+	var v wafv2.WebACL
+	webACLName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_wafv2_web_acl.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckScopeRegional(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, wafv2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWebACLDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWebACLConfig_immunityConfig(webACLName, 300, 600),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWebACLExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "captcha_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "captcha_config.0.immunity_time_property.0.immunity_time", "300"),
+					resource.TestCheckResourceAttr(resourceName, "challenge_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "challenge_config.0.immunity_time_property.0.immunity_time", "600"),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "rule.*", map[string]string{
+						"captcha_config.#": "1",
+						"captcha_config.0.immunity_time_property.0.immunity_time": "120",
+						"challenge_config.#": "1",
+						"challenge_config.0.immunity_time_property.0.immunity_time": "180",
+					}),
+				),
+			},
+			{
+				Config: testAccWebACLConfig_immunityConfig(webACLName, 3600, 7200),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWebACLExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "captcha_config.0.immunity_time_property.0.immunity_time", "3600"),
+					resource.TestCheckResourceAttr(resourceName, "challenge_config.0.immunity_time_property.0.immunity_time", "7200"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccWebACLImportStateIdFunc(resourceName),
+			},
+		},
+	})
+}
+
+func TestAccWAFV2WebACL_ja3Fingerprint(t *testing.T) {
+	var v wafv2.WebACL
+	webACLName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_wafv2_web_acl.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckScopeRegional(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, wafv2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWebACLDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWebACLConfig_ja3Fingerprint(webACLName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWebACLExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "rule.*", map[string]string{
+						"statement.0.byte_match_statement.0.field_to_match.0.ja3_fingerprint.#":                   "1",
+						"statement.0.byte_match_statement.0.field_to_match.0.ja3_fingerprint.0.fallback_behavior": "MATCH",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccWebACLImportStateIdFunc(resourceName),
+			},
+		},
+	})
+}
+
 func testAccCheckWebACLDestroy(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_wafv2_web_acl" {
@@ -3189,3 +3363,233 @@ func testAccWebACLImportStateIdFunc(resourceName string) resource.ImportStateIdF
 		return fmt.Sprintf("%s/%s/%s", rs.Primary.ID, rs.Primary.Attributes["name"], rs.Primary.Attributes["scope"]), nil
 	}
 }
+
+func testAccWebACLConfig_ruleJSON(name, searchString string) string {
+	return fmt.Sprintf(`
+resource "aws_wafv2_web_acl" "test" {
+  name        = %[1]q
+  description = %[1]q
+  scope       = "REGIONAL"
+
+  default_action {
+    allow {}
+  }
+
+  rule_json = jsonencode([
+    {
+      Name     = "%[1]s-managed"
+      Priority = 1
+      OverrideAction = {
+        None = {}
+      }
+      Statement = {
+        ManagedRuleGroupStatement = {
+          VendorName = "AWS"
+          Name       = "AWSManagedRulesCommonRuleSet"
+        }
+      }
+      VisibilityConfig = {
+        CloudWatchMetricsEnabled = false
+        MetricName               = "managed"
+        SampledRequestsEnabled   = false
+      }
+    },
+    {
+      Name     = "%[1]s-admin"
+      Priority = 2
+      Action = {
+        Block = {}
+      }
+      Statement = {
+        ByteMatchStatement = {
+          SearchString = %[2]q
+          FieldToMatch = {
+            UriPath = {}
+          }
+          TextTransformations = [{
+            Priority = 0
+            Type     = "NONE"
+          }]
+          PositionalConstraint = "STARTS_WITH"
+        }
+      }
+      VisibilityConfig = {
+        CloudWatchMetricsEnabled = false
+        MetricName               = "admin"
+        SampledRequestsEnabled   = false
+      }
+    },
+  ])
+
+  visibility_config {
+    cloudwatch_metrics_enabled = false
+    metric_name                = "friendly-metric-name"
+    sampled_requests_enabled   = false
+  }
+}
+`, name, searchString)
+}
+
+func testAccWebACLConfig_ruleJSONReformatted(name string) string {
+	return fmt.Sprintf(`
+resource "aws_wafv2_web_acl" "test" {
+  name        = %[1]q
+  description = %[1]q
+  scope       = "REGIONAL"
+
+  default_action {
+    allow {}
+  }
+
+  rule_json = <<EOT
+[
+  {
+    "VisibilityConfig": {"SampledRequestsEnabled": false, "MetricName": "admin", "CloudWatchMetricsEnabled": false},
+    "Statement": {
+      "ByteMatchStatement": {
+        "PositionalConstraint": "STARTS_WITH",
+        "TextTransformations": [{"Type": "NONE", "Priority": 0}],
+        "FieldToMatch": {"UriPath": {}},
+        "SearchString": "/admin"
+      }
+    },
+    "Action": {"Block": {}},
+    "Priority": 2,
+    "Name": "%[1]s-admin"
+  },
+  {
+    "VisibilityConfig": {"SampledRequestsEnabled": false, "MetricName": "managed", "CloudWatchMetricsEnabled": false},
+    "Statement": {"ManagedRuleGroupStatement": {"Name": "AWSManagedRulesCommonRuleSet", "VendorName": "AWS"}},
+    "OverrideAction": {"None": {}},
+    "Priority": 1,
+    "Name": "%[1]s-managed"
+  }
+]
+EOT
+
+  visibility_config {
+    cloudwatch_metrics_enabled = false
+    metric_name                = "friendly-metric-name"
+    sampled_requests_enabled   = false
+  }
+}
+`, name)
+}
+
+func testAccWebACLConfig_immunityConfig(name string, captchaImmunityTime, challengeImmunityTime int) string {
+	return fmt.Sprintf(`
+resource "aws_wafv2_web_acl" "test" {
+  name        = %[1]q
+  description = %[1]q
+  scope       = "REGIONAL"
+
+  default_action {
+    allow {}
+  }
+
+  captcha_config {
+    immunity_time_property {
+      immunity_time = %[2]d
+    }
+  }
+
+  challenge_config {
+    immunity_time_property {
+      immunity_time = %[3]d
+    }
+  }
+
+  rule {
+    name     = "rule-1"
+    priority = 1
+
+    action {
+      captcha {}
+    }
+
+    captcha_config {
+      immunity_time_property {
+        immunity_time = 120
+      }
+    }
+
+    challenge_config {
+      immunity_time_property {
+        immunity_time = 180
+      }
+    }
+
+    statement {
+      geo_match_statement {
+        country_codes = ["US", "NL"]
+      }
+    }
+
+    visibility_config {
+      cloudwatch_metrics_enabled = false
+      metric_name                = "friendly-rule-metric-name"
+      sampled_requests_enabled   = false
+    }
+  }
+
+  visibility_config {
+    cloudwatch_metrics_enabled = false
+    metric_name                = "friendly-metric-name"
+    sampled_requests_enabled   = false
+  }
+}
+`, name, captchaImmunityTime, challengeImmunityTime)
+}
+
+func testAccWebACLConfig_ja3Fingerprint(name string) string {
+	return fmt.Sprintf(`
+resource "aws_wafv2_web_acl" "test" {
+  name        = %[1]q
+  description = %[1]q
+  scope       = "REGIONAL"
+
+  default_action {
+    allow {}
+  }
+
+  rule {
+    name     = "rule-1"
+    priority = 1
+
+    action {
+      block {}
+    }
+
+    statement {
+      byte_match_statement {
+        positional_constraint = "EXACTLY"
+        search_string         = "e7d705a3286e19ea42f587b344ee6865"
+
+        field_to_match {
+          ja3_fingerprint {
+            fallback_behavior = "MATCH"
+          }
+        }
+
+        text_transformation {
+          priority = 0
+          type     = "NONE"
+        }
+      }
+    }
+
+    visibility_config {
+      cloudwatch_metrics_enabled = false
+      metric_name                = "friendly-rule-metric-name"
+      sampled_requests_enabled   = false
+    }
+  }
+
+  visibility_config {
+    cloudwatch_metrics_enabled = false
+    metric_name                = "friendly-metric-name"
+    sampled_requests_enabled   = false
+  }
+}
+`, name)
+}
@@ -30,6 +30,27 @@ func ResourceDirectory() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"certificate_based_auth_properties": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"certificate_authority_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"status": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      workspaces.CertificateBasedAuthStatusEnumDisabled,
+							ValidateFunc: validation.StringInSlice(workspaces.CertificateBasedAuthStatusEnum_Values(), false),
+						},
+					},
+				},
+			},
 			"customer_user_name": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -66,6 +87,33 @@ func ResourceDirectory() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"saml_properties": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"relay_state_parameter_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "RelayState",
+							ValidateFunc: validation.StringLenBetween(1, 2000),
+						},
+						"status": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      workspaces.SamlStatusEnumDisabled,
+							ValidateFunc: validation.StringInSlice(workspaces.SamlStatusEnum_Values(), false),
+						},
+						"user_access_url": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(8, 200),
+						},
+					},
+				},
+			},
 			"self_service_permissions": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -110,6 +158,13 @@ func ResourceDirectory() *schema.Resource {
 			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
+			"user_identity_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(workspaces.UserIdentityType_Values(), false),
+			},
 			"workspace_access_properties": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -197,6 +252,13 @@ func ResourceDirectory() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"workspace_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(workspaces.WorkspaceType_Values(), false),
+			},
 		},
 
 		CustomizeDiff: verify.SetTagsDiff,
@@ -221,6 +283,14 @@ func resourceDirectoryCreate(d *schema.ResourceData, meta interface{}) error {
 		input.SubnetIds = flex.ExpandStringSet(v.(*schema.Set))
 	}
 
+	if v, ok := d.GetOk("user_identity_type"); ok {
+		input.UserIdentityType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("workspace_type"); ok {
+		input.WorkspaceType = aws.String(v.(string))
+	}
+
 	log.Printf("[DEBUG] Registering WorkSpaces Directory: %s", input)
 	_, err := tfresource.RetryWhenAWSErrCodeEquals(
 		DirectoryRegisterInvalidResourceStateTimeout,
@@ -243,6 +313,31 @@ func resourceDirectoryCreate(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error waiting for WorkSpaces Directory (%s) to register: %w", d.Id(), err)
 	}
 
+	// Certificate-based authentication requires SAML authentication to be enabled first.
+	if v, ok := d.GetOk("saml_properties"); ok {
+		log.Printf("[DEBUG] Modifying WorkSpaces Directory (%s) SAML properties", directoryID)
+		_, err := conn.ModifySamlProperties(&workspaces.ModifySamlPropertiesInput{
+			ResourceId:     aws.String(directoryID),
+			SamlProperties: ExpandSAMLProperties(v.([]interface{})),
+		})
+		if err != nil {
+			return fmt.Errorf("error setting WorkSpaces Directory (%s) SAML properties: %w", directoryID, err)
+		}
+		log.Printf("[INFO] Modified WorkSpaces Directory (%s) SAML properties", directoryID)
+	}
+
+	if v, ok := d.GetOk("certificate_based_auth_properties"); ok {
+		log.Printf("[DEBUG] Modifying WorkSpaces Directory (%s) certificate-based authentication properties", directoryID)
+		_, err := conn.ModifyCertificateBasedAuthProperties(&workspaces.ModifyCertificateBasedAuthPropertiesInput{
+			ResourceId:                     aws.String(directoryID),
+			CertificateBasedAuthProperties: ExpandCertificateBasedAuthProperties(v.([]interface{})),
+		})
+		if err != nil {
+			return fmt.Errorf("error setting WorkSpaces Directory (%s) certificate-based authentication properties: %w", directoryID, err)
+		}
+		log.Printf("[INFO] Modified WorkSpaces Directory (%s) certificate-based authentication properties", directoryID)
+	}
+
 	if v, ok := d.GetOk("self_service_permissions"); ok {
 		log.Printf("[DEBUG] Modifying WorkSpaces Directory (%s) self-service permissions", directoryID)
 		_, err := conn.ModifySelfservicePermissions(&workspaces.ModifySelfservicePermissionsInput{
@@ -322,6 +417,16 @@ func resourceDirectoryRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("directory_name", directory.DirectoryName)
 	d.Set("directory_type", directory.DirectoryType)
 	d.Set("alias", directory.Alias)
+	d.Set("user_identity_type", directory.UserIdentityType)
+	d.Set("workspace_type", directory.WorkspaceType)
+
+	if err := d.Set("saml_properties", FlattenSAMLProperties(directory.SamlProperties)); err != nil {
+		return fmt.Errorf("error setting saml_properties: %w", err)
+	}
+
+	if err := d.Set("certificate_based_auth_properties", FlattenCertificateBasedAuthProperties(directory.CertificateBasedAuthProperties)); err != nil {
+		return fmt.Errorf("error setting certificate_based_auth_properties: %w", err)
+	}
 
 	if err := d.Set("self_service_permissions", FlattenSelfServicePermissions(directory.SelfservicePermissions)); err != nil {
 		return fmt.Errorf("error setting self_service_permissions: %w", err)
@@ -365,6 +470,51 @@ func resourceDirectoryRead(d *schema.ResourceData, meta interface{}) error {
 func resourceDirectoryUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).WorkSpacesConn
 
+	// Disabling SAML authentication requires certificate-based authentication to be disabled first,
+	// and enabling certificate-based authentication requires SAML authentication to be enabled first.
+	samlFirst := false
+	if v := ExpandSAMLProperties(d.Get("saml_properties").([]interface{})); v != nil && aws.StringValue(v.Status) != workspaces.SamlStatusEnumDisabled {
+		samlFirst = true
+	}
+
+	if samlFirst {
+		if err := modifySAMLProperties(conn, d); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("certificate_based_auth_properties") {
+		log.Printf("[DEBUG] Modifying WorkSpaces Directory (%s) certificate-based authentication properties", d.Id())
+		input := &workspaces.ModifyCertificateBasedAuthPropertiesInput{
+			ResourceId: aws.String(d.Id()),
+		}
+
+		properties := d.Get("certificate_based_auth_properties").([]interface{})
+		input.CertificateBasedAuthProperties = ExpandCertificateBasedAuthProperties(properties)
+
+		if input.CertificateBasedAuthProperties == nil || input.CertificateBasedAuthProperties.CertificateAuthorityArn == nil {
+			input.PropertiesToDelete = aws.StringSlice([]string{workspaces.DeletableCertificateBasedAuthPropertyCertificateBasedAuthPropertiesCertificateAuthorityArn})
+		}
+
+		if input.CertificateBasedAuthProperties == nil {
+			input.CertificateBasedAuthProperties = &workspaces.CertificateBasedAuthProperties{
+				Status: aws.String(workspaces.CertificateBasedAuthStatusEnumDisabled),
+			}
+		}
+
+		_, err := conn.ModifyCertificateBasedAuthProperties(input)
+		if err != nil {
+			return fmt.Errorf("error updating WorkSpaces Directory (%s) certificate-based authentication properties: %w", d.Id(), err)
+		}
+		log.Printf("[INFO] Modified WorkSpaces Directory (%s) certificate-based authentication properties", d.Id())
+	}
+
+	if !samlFirst {
+		if err := modifySAMLProperties(conn, d); err != nil {
+			return err
+		}
+	}
+
 	if d.HasChange("self_service_permissions") {
 		log.Printf("[DEBUG] Modifying WorkSpaces Directory (%s) self-service permissions", d.Id())
 		permissions := d.Get("self_service_permissions").([]interface{})
@@ -445,6 +595,42 @@ func resourceDirectoryUpdate(d *schema.ResourceData, meta interface{}) error {
 	return resourceDirectoryRead(d, meta)
 }
 
+func modifySAMLProperties(conn *workspaces.WorkSpaces, d *schema.ResourceData) error {
+	if !d.HasChange("saml_properties") {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Modifying WorkSpaces Directory (%s) SAML properties", d.Id())
+	input := &workspaces.ModifySamlPropertiesInput{
+		ResourceId: aws.String(d.Id()),
+	}
+
+	properties := d.Get("saml_properties").([]interface{})
+	input.SamlProperties = ExpandSAMLProperties(properties)
+
+	if input.SamlProperties == nil {
+		input.SamlProperties = &workspaces.SamlProperties{
+			Status: aws.String(workspaces.SamlStatusEnumDisabled),
+		}
+	}
+
+	if input.SamlProperties.UserAccessUrl == nil {
+		input.PropertiesToDelete = append(input.PropertiesToDelete, aws.String(workspaces.DeletableSamlPropertySamlPropertiesUserAccessUrl))
+	}
+
+	if input.SamlProperties.RelayStateParameterName == nil {
+		input.PropertiesToDelete = append(input.PropertiesToDelete, aws.String(workspaces.DeletableSamlPropertySamlPropertiesRelayStateParameterName))
+	}
+
+	_, err := conn.ModifySamlProperties(input)
+	if err != nil {
+		return fmt.Errorf("error updating WorkSpaces Directory (%s) SAML properties: %w", d.Id(), err)
+	}
+	log.Printf("[INFO] Modified WorkSpaces Directory (%s) SAML properties", d.Id())
+
+	return nil
+}
+
 func resourceDirectoryDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).WorkSpacesConn
 
@@ -587,6 +773,50 @@ func ExpandWorkspaceCreationProperties(properties []interface{}) *workspaces.Wor
 	return result
 }
 
+func ExpandSAMLProperties(properties []interface{}) *workspaces.SamlProperties {
+	if len(properties) == 0 || properties[0] == nil {
+		return nil
+	}
+
+	result := &workspaces.SamlProperties{}
+
+	p := properties[0].(map[string]interface{})
+
+	if p["relay_state_parameter_name"].(string) != "" {
+		result.RelayStateParameterName = aws.String(p["relay_state_parameter_name"].(string))
+	}
+
+	if p["status"].(string) != "" {
+		result.Status = aws.String(p["status"].(string))
+	}
+
+	if p["user_access_url"].(string) != "" {
+		result.UserAccessUrl = aws.String(p["user_access_url"].(string))
+	}
+
+	return result
+}
+
+func ExpandCertificateBasedAuthProperties(properties []interface{}) *workspaces.CertificateBasedAuthProperties {
+	if len(properties) == 0 || properties[0] == nil {
+		return nil
+	}
+
+	result := &workspaces.CertificateBasedAuthProperties{}
+
+	p := properties[0].(map[string]interface{})
+
+	if p["certificate_authority_arn"].(string) != "" {
+		result.CertificateAuthorityArn = aws.String(p["certificate_authority_arn"].(string))
+	}
+
+	if p["status"].(string) != "" {
+		result.Status = aws.String(p["status"].(string))
+	}
+
+	return result
+}
+
 func FlattenWorkspaceAccessProperties(properties *workspaces.WorkspaceAccessProperties) []interface{} {
 	if properties == nil {
 		return []interface{}{}
@@ -676,3 +906,30 @@ func FlattenWorkspaceCreationProperties(properties *workspaces.DefaultWorkspaceC
 		},
 	}
 }
+
+func FlattenSAMLProperties(properties *workspaces.SamlProperties) []interface{} {
+	if properties == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"relay_state_parameter_name": aws.StringValue(properties.RelayStateParameterName),
+			"status":                     aws.StringValue(properties.Status),
+			"user_access_url":            aws.StringValue(properties.UserAccessUrl),
+		},
+	}
+}
+
+func FlattenCertificateBasedAuthProperties(properties *workspaces.CertificateBasedAuthProperties) []interface{} {
+	if properties == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"certificate_authority_arn": aws.StringValue(properties.CertificateAuthorityArn),
+			"status":                    aws.StringValue(properties.Status),
+		},
+	}
+}
@@ -410,6 +410,201 @@ func testAccDirectory_ipGroupIDs(t *testing.T) {
 	})
 }
 
+func testAccDirectory_samlProperties(t *testing.T) {
+	var v workspaces.WorkspaceDirectory
+	rName := sdkacctest.RandString(8)
+
+	resourceName := "aws_workspaces_directory.main"
+
+	domain := acctest.RandomDomainName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			testAccPreCheckDirectory(t)
+			acctest.PreCheckDirectoryServiceSimpleDirectory(t)
+			acctest.PreCheckHasIAMRole(t, "workspaces_DefaultRole")
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, workspaces.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectoryConfig_samlProperties(rName, domain, workspaces.SamlStatusEnumEnabledWithDirectoryLoginFallback),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectoryExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "saml_properties.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "saml_properties.0.relay_state_parameter_name", "LinkMode"),
+					resource.TestCheckResourceAttr(resourceName, "saml_properties.0.status", workspaces.SamlStatusEnumEnabledWithDirectoryLoginFallback),
+					resource.TestCheckResourceAttr(resourceName, "saml_properties.0.user_access_url", "https://sso.example.com/"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDirectoryConfig_samlProperties(rName, domain, workspaces.SamlStatusEnumDisabled),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectoryExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "saml_properties.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "saml_properties.0.status", workspaces.SamlStatusEnumDisabled),
+				),
+			},
+		},
+	})
+}
+
+func TestExpandSAMLProperties(t *testing.T) {
+	cases := []struct {
+		input    []interface{}
+		expected *workspaces.SamlProperties
+	}{
+		// Empty
+		{
+			input:    []interface{}{},
+			expected: nil,
+		},
+		// Full
+		{
+			input: []interface{}{
+				map[string]interface{}{
+					"relay_state_parameter_name": "LinkMode",
+					"status":                     workspaces.SamlStatusEnumEnabled,
+					"user_access_url":            "https://sso.example.com/",
+				},
+			},
+			expected: &workspaces.SamlProperties{
+				RelayStateParameterName: aws.String("LinkMode"),
+				Status:                  aws.String(workspaces.SamlStatusEnumEnabled),
+				UserAccessUrl:           aws.String("https://sso.example.com/"),
+			},
+		},
+		// Without user access URL
+		{
+			input: []interface{}{
+				map[string]interface{}{
+					"relay_state_parameter_name": "RelayState",
+					"status":                     workspaces.SamlStatusEnumDisabled,
+					"user_access_url":            "",
+				},
+			},
+			expected: &workspaces.SamlProperties{
+				RelayStateParameterName: aws.String("RelayState"),
+				Status:                  aws.String(workspaces.SamlStatusEnumDisabled),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		actual := tfworkspaces.ExpandSAMLProperties(c.input)
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Fatalf("expected\n\n%#+v\n\ngot\n\n%#+v", c.expected, actual)
+		}
+	}
+}
+
+func TestFlattenSAMLProperties(t *testing.T) {
+	cases := []struct {
+		input    *workspaces.SamlProperties
+		expected []interface{}
+	}{
+		// Empty
+		{
+			input:    nil,
+			expected: []interface{}{},
+		},
+		// Full
+		{
+			input: &workspaces.SamlProperties{
+				RelayStateParameterName: aws.String("LinkMode"),
+				Status:                  aws.String(workspaces.SamlStatusEnumEnabled),
+				UserAccessUrl:           aws.String("https://sso.example.com/"),
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					"relay_state_parameter_name": "LinkMode",
+					"status":                     workspaces.SamlStatusEnumEnabled,
+					"user_access_url":            "https://sso.example.com/",
+				},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		actual := tfworkspaces.FlattenSAMLProperties(c.input)
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Fatalf("expected\n\n%#+v\n\ngot\n\n%#+v", c.expected, actual)
+		}
+	}
+}
+
+func TestExpandCertificateBasedAuthProperties(t *testing.T) {
+	cases := []struct {
+		input    []interface{}
+		expected *workspaces.CertificateBasedAuthProperties
+	}{
+		// Empty
+		{
+			input:    []interface{}{},
+			expected: nil,
+		},
+		// Full
+		{
+			input: []interface{}{
+				map[string]interface{}{
+					"certificate_authority_arn": "arn:aws:acm-pca:us-east-1:123456789012:certificate-authority/12345678-1234-1234-1234-123456789012", //lintignore:AWSAT003,AWSAT005
+					"status":                    workspaces.CertificateBasedAuthStatusEnumEnabled,
+				},
+			},
+			expected: &workspaces.CertificateBasedAuthProperties{
+				CertificateAuthorityArn: aws.String("arn:aws:acm-pca:us-east-1:123456789012:certificate-authority/12345678-1234-1234-1234-123456789012"), //lintignore:AWSAT003,AWSAT005
+				Status:                  aws.String(workspaces.CertificateBasedAuthStatusEnumEnabled),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		actual := tfworkspaces.ExpandCertificateBasedAuthProperties(c.input)
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Fatalf("expected\n\n%#+v\n\ngot\n\n%#+v", c.expected, actual)
+		}
+	}
+}
+
+func TestFlattenCertificateBasedAuthProperties(t *testing.T) {
+	cases := []struct {
+		input    *workspaces.CertificateBasedAuthProperties
+		expected []interface{}
+	}{
+		// Empty
+		{
+			input:    nil,
+			expected: []interface{}{},
+		},
+		// Disabled
+		{
+			input: &workspaces.CertificateBasedAuthProperties{
+				Status: aws.String(workspaces.CertificateBasedAuthStatusEnumDisabled),
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					"certificate_authority_arn": "",
+					"status":                    workspaces.CertificateBasedAuthStatusEnumDisabled,
+				},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		actual := tfworkspaces.FlattenCertificateBasedAuthProperties(c.input)
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Fatalf("expected\n\n%#+v\n\ngot\n\n%#+v", c.expected, actual)
+		}
+	}
+}
+
 func TestExpandSelfServicePermissions(t *testing.T) {
 	cases := []struct {
 		input    []interface{}
@@ -909,6 +1104,26 @@ resource "aws_workspaces_directory" "main" {
 `, rName))
 }
 
+func testAccDirectoryConfig_samlProperties(rName, domain, status string) string {
+	return acctest.ConfigCompose(
+		testAccDirectoryConfig_Prerequisites(rName, domain),
+		fmt.Sprintf(`
+resource "aws_workspaces_directory" "main" {
+  directory_id = aws_directory_service_directory.main.id
+
+  saml_properties {
+    relay_state_parameter_name = "LinkMode"
+    status                     = %[2]q
+    user_access_url            = "https://sso.example.com/"
+  }
+
+  tags = {
+    Name = "tf-testacc-workspaces-directory-%[1]s"
+  }
+}
+`, rName, status))
+}
+
 func testAccDirectoryConfig_workspaceCreationProperties(rName, domain string) string {
 	return acctest.ConfigCompose(
 		testAccDirectoryConfig_Prerequisites(rName, domain),
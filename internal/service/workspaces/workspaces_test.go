@@ -10,6 +10,7 @@ func TestAccWorkSpaces_serial(t *testing.T) {
 			"basic":                       testAccDirectory_basic,
 			"disappears":                  testAccDirectory_disappears,
 			"ipGroupIds":                  testAccDirectory_ipGroupIDs,
+			"samlProperties":              testAccDirectory_samlProperties,
 			"selfServicePermissions":      testAccDirectory_selfServicePermissions,
 			"subnetIDs":                   testAccDirectory_subnetIDs,
 			"tags":                        testAccDirectory_tags,
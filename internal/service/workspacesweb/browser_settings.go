@@ -0,0 +1,203 @@
+package workspacesweb
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workspacesweb"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceBrowserSettings() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceBrowserSettingsCreate,
+		ReadWithoutTimeout:   resourceBrowserSettingsRead,
+		UpdateWithoutTimeout: resourceBrowserSettingsUpdate,
+		DeleteWithoutTimeout: resourceBrowserSettingsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"additional_encryption_context": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"associated_portal_arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"browser_policy": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"customer_managed_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceBrowserSettingsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &workspacesweb.CreateBrowserSettingsInput{
+		BrowserPolicy: aws.String(d.Get("browser_policy").(string)),
+	}
+
+	if v, ok := d.GetOk("additional_encryption_context"); ok && len(v.(map[string]interface{})) > 0 {
+		input.AdditionalEncryptionContext = flex.ExpandStringMap(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("customer_managed_key"); ok {
+		input.CustomerManagedKey = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateBrowserSettingsWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating WorkSpaces Web Browser Settings: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.BrowserSettingsArn))
+
+	return resourceBrowserSettingsRead(ctx, d, meta)
+}
+
+func resourceBrowserSettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	browserSettings, err := FindBrowserSettingsByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] WorkSpaces Web Browser Settings (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading WorkSpaces Web Browser Settings (%s): %s", d.Id(), err)
+	}
+
+	d.Set("additional_encryption_context", aws.StringValueMap(browserSettings.AdditionalEncryptionContext))
+	d.Set("arn", browserSettings.BrowserSettingsArn)
+	d.Set("associated_portal_arns", aws.StringValueSlice(browserSettings.AssociatedPortalArns))
+
+	policyToSet, err := verify.SecondJSONUnlessEquivalent(d.Get("browser_policy").(string), aws.StringValue(browserSettings.BrowserPolicy))
+
+	if err != nil {
+		return diag.Errorf("while setting policy (%s), encountered: %s", policyToSet, err)
+	}
+
+	policyToSet, err = structure.NormalizeJsonString(policyToSet)
+
+	if err != nil {
+		return diag.Errorf("policy (%s) is invalid JSON: %s", policyToSet, err)
+	}
+
+	d.Set("browser_policy", policyToSet)
+	d.Set("customer_managed_key", browserSettings.CustomerManagedKey)
+
+	tags, err := ListTagsWithContext(ctx, conn, d.Id())
+
+	if err != nil {
+		return diag.Errorf("listing tags for WorkSpaces Web Browser Settings (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceBrowserSettingsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn
+
+	if d.HasChange("browser_policy") {
+		input := &workspacesweb.UpdateBrowserSettingsInput{
+			BrowserPolicy:      aws.String(d.Get("browser_policy").(string)),
+			BrowserSettingsArn: aws.String(d.Id()),
+		}
+
+		_, err := conn.UpdateBrowserSettingsWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating WorkSpaces Web Browser Settings (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+		if err := UpdateTagsWithContext(ctx, conn, d.Id(), o, n); err != nil {
+			return diag.Errorf("updating WorkSpaces Web Browser Settings (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceBrowserSettingsRead(ctx, d, meta)
+}
+
+func resourceBrowserSettingsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn
+
+	log.Printf("[DEBUG] Deleting WorkSpaces Web Browser Settings: %s", d.Id())
+	_, err := conn.DeleteBrowserSettingsWithContext(ctx, &workspacesweb.DeleteBrowserSettingsInput{
+		BrowserSettingsArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, workspacesweb.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting WorkSpaces Web Browser Settings (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,257 @@
+package workspacesweb_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/workspacesweb"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfworkspacesweb "github.com/hashicorp/terraform-provider-aws/internal/service/workspacesweb"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccWorkSpacesWebBrowserSettings_basic(t *testing.T) {
+	var v workspacesweb.BrowserSettings
+	resourceName := "aws_workspacesweb_browser_settings.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, workspacesweb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBrowserSettingsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBrowserSettingsConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrowserSettingsExists(resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "workspaces-web", regexp.MustCompile(`browserSettings/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "associated_portal_arns.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "browser_policy"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccWorkSpacesWebBrowserSettings_disappears(t *testing.T) {
+	var v workspacesweb.BrowserSettings
+	resourceName := "aws_workspacesweb_browser_settings.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, workspacesweb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBrowserSettingsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBrowserSettingsConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrowserSettingsExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfworkspacesweb.ResourceBrowserSettings(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccWorkSpacesWebBrowserSettings_update(t *testing.T) {
+	var v workspacesweb.BrowserSettings
+	resourceName := "aws_workspacesweb_browser_settings.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, workspacesweb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBrowserSettingsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBrowserSettingsConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrowserSettingsExists(resourceName, &v),
+				),
+			},
+			{
+				Config: testAccBrowserSettingsConfig_updated(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrowserSettingsExists(resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "browser_policy"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccWorkSpacesWebBrowserSettings_tags(t *testing.T) {
+	var v workspacesweb.BrowserSettings
+	resourceName := "aws_workspacesweb_browser_settings.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, workspacesweb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBrowserSettingsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBrowserSettingsConfig_tags1("key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrowserSettingsExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBrowserSettingsConfig_tags2("key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrowserSettingsExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccBrowserSettingsConfig_tags1("key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrowserSettingsExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBrowserSettingsExists(n string, v *workspacesweb.BrowserSettings) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No WorkSpaces Web Browser Settings ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesWebConn
+
+		output, err := tfworkspacesweb.FindBrowserSettingsByARN(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckBrowserSettingsDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesWebConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_workspacesweb_browser_settings" {
+			continue
+		}
+
+		_, err := tfworkspacesweb.FindBrowserSettingsByARN(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("WorkSpaces Web Browser Settings %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccBrowserSettingsConfig_basic() string {
+	return `
+resource "aws_workspacesweb_browser_settings" "test" {
+  browser_policy = jsonencode({
+    chromePolicies = {
+      DefaultDownloadDirectory = {
+        value = "/home/as2-streaming-user/MyFiles/TemporaryFiles1"
+      }
+    }
+  })
+}
+`
+}
+
+func testAccBrowserSettingsConfig_updated() string {
+	return `
+resource "aws_workspacesweb_browser_settings" "test" {
+  browser_policy = jsonencode({
+    chromePolicies = {
+      DefaultDownloadDirectory = {
+        value = "/home/as2-streaming-user/MyFiles/TemporaryFiles2"
+      }
+    }
+  })
+}
+`
+}
+
+func testAccBrowserSettingsConfig_tags1(tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_workspacesweb_browser_settings" "test" {
+  browser_policy = jsonencode({
+    chromePolicies = {
+      DefaultDownloadDirectory = {
+        value = "/home/as2-streaming-user/MyFiles/TemporaryFiles1"
+      }
+    }
+  })
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1)
+}
+
+func testAccBrowserSettingsConfig_tags2(tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_workspacesweb_browser_settings" "test" {
+  browser_policy = jsonencode({
+    chromePolicies = {
+      DefaultDownloadDirectory = {
+        value = "/home/as2-streaming-user/MyFiles/TemporaryFiles1"
+      }
+    }
+  })
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2)
+}
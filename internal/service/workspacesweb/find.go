@@ -0,0 +1,221 @@
+package workspacesweb
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workspacesweb"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindBrowserSettingsByARN(ctx context.Context, conn *workspacesweb.WorkSpacesWeb, arn string) (*workspacesweb.BrowserSettings, error) {
+	input := &workspacesweb.GetBrowserSettingsInput{
+		BrowserSettingsArn: aws.String(arn),
+	}
+
+	output, err := conn.GetBrowserSettingsWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, workspacesweb.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.BrowserSettings == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.BrowserSettings, nil
+}
+
+func FindIPAccessSettingsByARN(ctx context.Context, conn *workspacesweb.WorkSpacesWeb, arn string) (*workspacesweb.IpAccessSettings, error) {
+	input := &workspacesweb.GetIpAccessSettingsInput{
+		IpAccessSettingsArn: aws.String(arn),
+	}
+
+	output, err := conn.GetIpAccessSettingsWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, workspacesweb.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.IpAccessSettings == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.IpAccessSettings, nil
+}
+
+func FindNetworkSettingsByARN(ctx context.Context, conn *workspacesweb.WorkSpacesWeb, arn string) (*workspacesweb.NetworkSettings, error) {
+	input := &workspacesweb.GetNetworkSettingsInput{
+		NetworkSettingsArn: aws.String(arn),
+	}
+
+	output, err := conn.GetNetworkSettingsWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, workspacesweb.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.NetworkSettings == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.NetworkSettings, nil
+}
+
+func FindPortalByARN(ctx context.Context, conn *workspacesweb.WorkSpacesWeb, arn string) (*workspacesweb.Portal, error) {
+	input := &workspacesweb.GetPortalInput{
+		PortalArn: aws.String(arn),
+	}
+
+	output, err := conn.GetPortalWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, workspacesweb.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Portal == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Portal, nil
+}
+
+func FindTrustStoreByARN(ctx context.Context, conn *workspacesweb.WorkSpacesWeb, arn string) (*workspacesweb.TrustStore, error) {
+	input := &workspacesweb.GetTrustStoreInput{
+		TrustStoreArn: aws.String(arn),
+	}
+
+	output, err := conn.GetTrustStoreWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, workspacesweb.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.TrustStore == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.TrustStore, nil
+}
+
+func FindUserSettingsByARN(ctx context.Context, conn *workspacesweb.WorkSpacesWeb, arn string) (*workspacesweb.UserSettings, error) {
+	input := &workspacesweb.GetUserSettingsInput{
+		UserSettingsArn: aws.String(arn),
+	}
+
+	output, err := conn.GetUserSettingsWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, workspacesweb.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.UserSettings == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.UserSettings, nil
+}
+
+func FindTrustStoreCertificatesByARN(ctx context.Context, conn *workspacesweb.WorkSpacesWeb, arn string) ([]*workspacesweb.CertificateSummary, error) {
+	input := &workspacesweb.ListTrustStoreCertificatesInput{
+		TrustStoreArn: aws.String(arn),
+	}
+	var output []*workspacesweb.CertificateSummary
+
+	err := conn.ListTrustStoreCertificatesPagesWithContext(ctx, input, func(page *workspacesweb.ListTrustStoreCertificatesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.CertificateList {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, workspacesweb.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func FindTrustStoreCertificateByTwoPartKey(ctx context.Context, conn *workspacesweb.WorkSpacesWeb, arn, thumbprint string) (*workspacesweb.Certificate, error) {
+	input := &workspacesweb.GetTrustStoreCertificateInput{
+		Thumbprint:    aws.String(thumbprint),
+		TrustStoreArn: aws.String(arn),
+	}
+
+	output, err := conn.GetTrustStoreCertificateWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, workspacesweb.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Certificate == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Certificate, nil
+}
@@ -0,0 +1,279 @@
+package workspacesweb
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workspacesweb"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceIPAccessSettings() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceIPAccessSettingsCreate,
+		ReadWithoutTimeout:   resourceIPAccessSettingsRead,
+		UpdateWithoutTimeout: resourceIPAccessSettingsUpdate,
+		DeleteWithoutTimeout: resourceIPAccessSettingsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"additional_encryption_context": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"associated_portal_arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"customer_managed_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"display_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"ip_rule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 100,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 256),
+						},
+						"ip_range": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidIPv4CIDRNetworkAddress,
+						},
+					},
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceIPAccessSettingsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("display_name").(string)
+	input := &workspacesweb.CreateIpAccessSettingsInput{
+		DisplayName: aws.String(name),
+		IpRules:     expandIPRules(d.Get("ip_rule").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("additional_encryption_context"); ok && len(v.(map[string]interface{})) > 0 {
+		input.AdditionalEncryptionContext = flex.ExpandStringMap(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("customer_managed_key"); ok {
+		input.CustomerManagedKey = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateIpAccessSettingsWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating WorkSpaces Web IP Access Settings (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.IpAccessSettingsArn))
+
+	return resourceIPAccessSettingsRead(ctx, d, meta)
+}
+
+func resourceIPAccessSettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	ipAccessSettings, err := FindIPAccessSettingsByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] WorkSpaces Web IP Access Settings (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading WorkSpaces Web IP Access Settings (%s): %s", d.Id(), err)
+	}
+
+	d.Set("additional_encryption_context", aws.StringValueMap(ipAccessSettings.AdditionalEncryptionContext))
+	d.Set("arn", ipAccessSettings.IpAccessSettingsArn)
+	d.Set("associated_portal_arns", aws.StringValueSlice(ipAccessSettings.AssociatedPortalArns))
+	d.Set("customer_managed_key", ipAccessSettings.CustomerManagedKey)
+	d.Set("description", ipAccessSettings.Description)
+	d.Set("display_name", ipAccessSettings.DisplayName)
+	if err := d.Set("ip_rule", flattenIPRules(ipAccessSettings.IpRules)); err != nil {
+		return diag.Errorf("setting ip_rule: %s", err)
+	}
+
+	tags, err := ListTagsWithContext(ctx, conn, d.Id())
+
+	if err != nil {
+		return diag.Errorf("listing tags for WorkSpaces Web IP Access Settings (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceIPAccessSettingsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &workspacesweb.UpdateIpAccessSettingsInput{
+			IpAccessSettingsArn: aws.String(d.Id()),
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("display_name") {
+			input.DisplayName = aws.String(d.Get("display_name").(string))
+		}
+
+		if d.HasChange("ip_rule") {
+			input.IpRules = expandIPRules(d.Get("ip_rule").([]interface{}))
+		}
+
+		_, err := conn.UpdateIpAccessSettingsWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating WorkSpaces Web IP Access Settings (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+		if err := UpdateTagsWithContext(ctx, conn, d.Id(), o, n); err != nil {
+			return diag.Errorf("updating WorkSpaces Web IP Access Settings (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceIPAccessSettingsRead(ctx, d, meta)
+}
+
+func resourceIPAccessSettingsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn
+
+	log.Printf("[DEBUG] Deleting WorkSpaces Web IP Access Settings: %s", d.Id())
+	_, err := conn.DeleteIpAccessSettingsWithContext(ctx, &workspacesweb.DeleteIpAccessSettingsInput{
+		IpAccessSettingsArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, workspacesweb.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting WorkSpaces Web IP Access Settings (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandIPRules(tfList []interface{}) []*workspacesweb.IpRule {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*workspacesweb.IpRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &workspacesweb.IpRule{
+			IpRange: aws.String(tfMap["ip_range"].(string)),
+		}
+
+		if v, ok := tfMap["description"].(string); ok && v != "" {
+			apiObject.Description = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenIPRules(apiObjects []*workspacesweb.IpRule) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"description": aws.StringValue(apiObject.Description),
+			"ip_range":    aws.StringValue(apiObject.IpRange),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,269 @@
+package workspacesweb_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/workspacesweb"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfworkspacesweb "github.com/hashicorp/terraform-provider-aws/internal/service/workspacesweb"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccWorkSpacesWebIPAccessSettings_basic(t *testing.T) {
+	var v workspacesweb.IpAccessSettings
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_workspacesweb_ip_access_settings.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, workspacesweb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIPAccessSettingsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIPAccessSettingsConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIPAccessSettingsExists(resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "workspaces-web", regexp.MustCompile(`ipAccessSettings/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "associated_portal_arns.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "display_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "ip_rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ip_rule.0.description", ""),
+					resource.TestCheckResourceAttr(resourceName, "ip_rule.0.ip_range", "10.0.0.0/16"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccWorkSpacesWebIPAccessSettings_disappears(t *testing.T) {
+	var v workspacesweb.IpAccessSettings
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_workspacesweb_ip_access_settings.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, workspacesweb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIPAccessSettingsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIPAccessSettingsConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIPAccessSettingsExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfworkspacesweb.ResourceIPAccessSettings(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccWorkSpacesWebIPAccessSettings_update(t *testing.T) {
+	var v workspacesweb.IpAccessSettings
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_workspacesweb_ip_access_settings.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, workspacesweb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIPAccessSettingsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIPAccessSettingsConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIPAccessSettingsExists(resourceName, &v),
+				),
+			},
+			{
+				Config: testAccIPAccessSettingsConfig_updated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIPAccessSettingsExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+					resource.TestCheckResourceAttr(resourceName, "ip_rule.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "ip_rule.0.description", "first"),
+					resource.TestCheckResourceAttr(resourceName, "ip_rule.1.description", "second"),
+					resource.TestCheckResourceAttr(resourceName, "ip_rule.1.ip_range", "192.168.0.0/24"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccWorkSpacesWebIPAccessSettings_tags(t *testing.T) {
+	var v workspacesweb.IpAccessSettings
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_workspacesweb_ip_access_settings.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, workspacesweb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIPAccessSettingsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIPAccessSettingsConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIPAccessSettingsExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccIPAccessSettingsConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIPAccessSettingsExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccIPAccessSettingsConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIPAccessSettingsExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIPAccessSettingsExists(n string, v *workspacesweb.IpAccessSettings) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No WorkSpaces Web IP Access Settings ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesWebConn
+
+		output, err := tfworkspacesweb.FindIPAccessSettingsByARN(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckIPAccessSettingsDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesWebConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_workspacesweb_ip_access_settings" {
+			continue
+		}
+
+		_, err := tfworkspacesweb.FindIPAccessSettingsByARN(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("WorkSpaces Web IP Access Settings %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccIPAccessSettingsConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_workspacesweb_ip_access_settings" "test" {
+  display_name = %[1]q
+
+  ip_rule {
+    ip_range = "10.0.0.0/16"
+  }
+}
+`, rName)
+}
+
+func testAccIPAccessSettingsConfig_updated(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_workspacesweb_ip_access_settings" "test" {
+  description  = "updated"
+  display_name = %[1]q
+
+  ip_rule {
+    description = "first"
+    ip_range    = "10.0.0.0/16"
+  }
+
+  ip_rule {
+    description = "second"
+    ip_range    = "192.168.0.0/24"
+  }
+}
+`, rName)
+}
+
+func testAccIPAccessSettingsConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_workspacesweb_ip_access_settings" "test" {
+  display_name = %[1]q
+
+  ip_rule {
+    ip_range = "10.0.0.0/16"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccIPAccessSettingsConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_workspacesweb_ip_access_settings" "test" {
+  display_name = %[1]q
+
+  ip_rule {
+    ip_range = "10.0.0.0/16"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
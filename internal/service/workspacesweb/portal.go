@@ -0,0 +1,413 @@
+package workspacesweb
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workspacesweb"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourcePortal() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePortalCreate,
+		ReadWithoutTimeout:   resourcePortalRead,
+		UpdateWithoutTimeout: resourcePortalUpdate,
+		DeleteWithoutTimeout: resourcePortalDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"additional_encryption_context": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"authentication_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(workspacesweb.AuthenticationType_Values(), false),
+			},
+			"browser_settings_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"browser_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"customer_managed_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"display_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"instance_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(workspacesweb.InstanceType_Values(), false),
+			},
+			"ip_access_settings_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"max_concurrent_sessions": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 5000),
+			},
+			"network_settings_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"portal_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"portal_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"renderer_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"trust_store_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"user_settings_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+// portalAssociation describes how a settings resource is associated with, and disassociated from, a portal.
+type portalAssociation struct {
+	attribute    string
+	name         string
+	associate    func(ctx context.Context, conn *workspacesweb.WorkSpacesWeb, portalARN, arn string) error
+	disassociate func(ctx context.Context, conn *workspacesweb.WorkSpacesWeb, portalARN string) error
+}
+
+var portalAssociations = []portalAssociation{
+	{
+		attribute: "browser_settings_arn",
+		name:      "Browser Settings",
+		associate: func(ctx context.Context, conn *workspacesweb.WorkSpacesWeb, portalARN, arn string) error {
+			_, err := conn.AssociateBrowserSettingsWithContext(ctx, &workspacesweb.AssociateBrowserSettingsInput{
+				BrowserSettingsArn: aws.String(arn),
+				PortalArn:          aws.String(portalARN),
+			})
+			return err
+		},
+		disassociate: func(ctx context.Context, conn *workspacesweb.WorkSpacesWeb, portalARN string) error {
+			_, err := conn.DisassociateBrowserSettingsWithContext(ctx, &workspacesweb.DisassociateBrowserSettingsInput{
+				PortalArn: aws.String(portalARN),
+			})
+			return err
+		},
+	},
+	{
+		attribute: "ip_access_settings_arn",
+		name:      "IP Access Settings",
+		associate: func(ctx context.Context, conn *workspacesweb.WorkSpacesWeb, portalARN, arn string) error {
+			_, err := conn.AssociateIpAccessSettingsWithContext(ctx, &workspacesweb.AssociateIpAccessSettingsInput{
+				IpAccessSettingsArn: aws.String(arn),
+				PortalArn:           aws.String(portalARN),
+			})
+			return err
+		},
+		disassociate: func(ctx context.Context, conn *workspacesweb.WorkSpacesWeb, portalARN string) error {
+			_, err := conn.DisassociateIpAccessSettingsWithContext(ctx, &workspacesweb.DisassociateIpAccessSettingsInput{
+				PortalArn: aws.String(portalARN),
+			})
+			return err
+		},
+	},
+	{
+		attribute: "network_settings_arn",
+		name:      "Network Settings",
+		associate: func(ctx context.Context, conn *workspacesweb.WorkSpacesWeb, portalARN, arn string) error {
+			_, err := conn.AssociateNetworkSettingsWithContext(ctx, &workspacesweb.AssociateNetworkSettingsInput{
+				NetworkSettingsArn: aws.String(arn),
+				PortalArn:          aws.String(portalARN),
+			})
+			return err
+		},
+		disassociate: func(ctx context.Context, conn *workspacesweb.WorkSpacesWeb, portalARN string) error {
+			_, err := conn.DisassociateNetworkSettingsWithContext(ctx, &workspacesweb.DisassociateNetworkSettingsInput{
+				PortalArn: aws.String(portalARN),
+			})
+			return err
+		},
+	},
+	{
+		attribute: "trust_store_arn",
+		name:      "Trust Store",
+		associate: func(ctx context.Context, conn *workspacesweb.WorkSpacesWeb, portalARN, arn string) error {
+			_, err := conn.AssociateTrustStoreWithContext(ctx, &workspacesweb.AssociateTrustStoreInput{
+				PortalArn:     aws.String(portalARN),
+				TrustStoreArn: aws.String(arn),
+			})
+			return err
+		},
+		disassociate: func(ctx context.Context, conn *workspacesweb.WorkSpacesWeb, portalARN string) error {
+			_, err := conn.DisassociateTrustStoreWithContext(ctx, &workspacesweb.DisassociateTrustStoreInput{
+				PortalArn: aws.String(portalARN),
+			})
+			return err
+		},
+	},
+	{
+		attribute: "user_settings_arn",
+		name:      "User Settings",
+		associate: func(ctx context.Context, conn *workspacesweb.WorkSpacesWeb, portalARN, arn string) error {
+			_, err := conn.AssociateUserSettingsWithContext(ctx, &workspacesweb.AssociateUserSettingsInput{
+				PortalArn:       aws.String(portalARN),
+				UserSettingsArn: aws.String(arn),
+			})
+			return err
+		},
+		disassociate: func(ctx context.Context, conn *workspacesweb.WorkSpacesWeb, portalARN string) error {
+			_, err := conn.DisassociateUserSettingsWithContext(ctx, &workspacesweb.DisassociateUserSettingsInput{
+				PortalArn: aws.String(portalARN),
+			})
+			return err
+		},
+	},
+}
+
+func resourcePortalCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &workspacesweb.CreatePortalInput{}
+
+	if v, ok := d.GetOk("additional_encryption_context"); ok && len(v.(map[string]interface{})) > 0 {
+		input.AdditionalEncryptionContext = flex.ExpandStringMap(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("authentication_type"); ok {
+		input.AuthenticationType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("customer_managed_key"); ok {
+		input.CustomerManagedKey = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("display_name"); ok {
+		input.DisplayName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("instance_type"); ok {
+		input.InstanceType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("max_concurrent_sessions"); ok {
+		input.MaxConcurrentSessions = aws.Int64(int64(v.(int)))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreatePortalWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating WorkSpaces Web Portal: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.PortalArn))
+
+	for _, association := range portalAssociations {
+		if v, ok := d.GetOk(association.attribute); ok {
+			if err := association.associate(ctx, conn, d.Id(), v.(string)); err != nil {
+				return diag.Errorf("associating WorkSpaces Web Portal (%s) %s (%s): %s", d.Id(), association.name, v.(string), err)
+			}
+		}
+	}
+
+	return resourcePortalRead(ctx, d, meta)
+}
+
+func resourcePortalRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	portal, err := FindPortalByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] WorkSpaces Web Portal (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading WorkSpaces Web Portal (%s): %s", d.Id(), err)
+	}
+
+	d.Set("additional_encryption_context", aws.StringValueMap(portal.AdditionalEncryptionContext))
+	d.Set("arn", portal.PortalArn)
+	d.Set("authentication_type", portal.AuthenticationType)
+	d.Set("browser_settings_arn", portal.BrowserSettingsArn)
+	d.Set("browser_type", portal.BrowserType)
+	if portal.CreationDate != nil {
+		d.Set("creation_date", aws.TimeValue(portal.CreationDate).Format(time.RFC3339))
+	} else {
+		d.Set("creation_date", nil)
+	}
+	d.Set("customer_managed_key", portal.CustomerManagedKey)
+	d.Set("display_name", portal.DisplayName)
+	d.Set("instance_type", portal.InstanceType)
+	d.Set("ip_access_settings_arn", portal.IpAccessSettingsArn)
+	d.Set("max_concurrent_sessions", portal.MaxConcurrentSessions)
+	d.Set("network_settings_arn", portal.NetworkSettingsArn)
+	d.Set("portal_endpoint", portal.PortalEndpoint)
+	d.Set("portal_status", portal.PortalStatus)
+	d.Set("renderer_type", portal.RendererType)
+	d.Set("status_reason", portal.StatusReason)
+	d.Set("trust_store_arn", portal.TrustStoreArn)
+	d.Set("user_settings_arn", portal.UserSettingsArn)
+
+	tags, err := ListTagsWithContext(ctx, conn, d.Id())
+
+	if err != nil {
+		return diag.Errorf("listing tags for WorkSpaces Web Portal (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourcePortalUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn
+
+	if d.HasChanges("authentication_type", "display_name", "instance_type", "max_concurrent_sessions") {
+		input := &workspacesweb.UpdatePortalInput{
+			PortalArn: aws.String(d.Id()),
+		}
+
+		if d.HasChange("authentication_type") {
+			input.AuthenticationType = aws.String(d.Get("authentication_type").(string))
+		}
+
+		if d.HasChange("display_name") {
+			input.DisplayName = aws.String(d.Get("display_name").(string))
+		}
+
+		if d.HasChange("instance_type") {
+			input.InstanceType = aws.String(d.Get("instance_type").(string))
+		}
+
+		if d.HasChange("max_concurrent_sessions") {
+			input.MaxConcurrentSessions = aws.Int64(int64(d.Get("max_concurrent_sessions").(int)))
+		}
+
+		_, err := conn.UpdatePortalWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating WorkSpaces Web Portal (%s): %s", d.Id(), err)
+		}
+	}
+
+	for _, association := range portalAssociations {
+		if !d.HasChange(association.attribute) {
+			continue
+		}
+
+		if v := d.Get(association.attribute).(string); v != "" {
+			if err := association.associate(ctx, conn, d.Id(), v); err != nil {
+				return diag.Errorf("associating WorkSpaces Web Portal (%s) %s (%s): %s", d.Id(), association.name, v, err)
+			}
+		} else {
+			if err := association.disassociate(ctx, conn, d.Id()); err != nil {
+				return diag.Errorf("disassociating WorkSpaces Web Portal (%s) %s: %s", d.Id(), association.name, err)
+			}
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+		if err := UpdateTagsWithContext(ctx, conn, d.Id(), o, n); err != nil {
+			return diag.Errorf("updating WorkSpaces Web Portal (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourcePortalRead(ctx, d, meta)
+}
+
+func resourcePortalDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn
+
+	log.Printf("[DEBUG] Deleting WorkSpaces Web Portal: %s", d.Id())
+	_, err := conn.DeletePortalWithContext(ctx, &workspacesweb.DeletePortalInput{
+		PortalArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, workspacesweb.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting WorkSpaces Web Portal (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
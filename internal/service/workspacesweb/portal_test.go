@@ -0,0 +1,316 @@
+package workspacesweb_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/workspacesweb"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfworkspacesweb "github.com/hashicorp/terraform-provider-aws/internal/service/workspacesweb"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccWorkSpacesWebPortal_basic(t *testing.T) {
+	var v workspacesweb.Portal
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_workspacesweb_portal.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, workspacesweb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPortalDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPortalConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPortalExists(resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "workspaces-web", regexp.MustCompile(`portal/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "authentication_type", "Standard"),
+					resource.TestCheckResourceAttr(resourceName, "browser_settings_arn", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "browser_type"),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_date"),
+					resource.TestCheckResourceAttr(resourceName, "display_name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "portal_endpoint"),
+					resource.TestCheckResourceAttr(resourceName, "portal_status", "Incomplete"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccWorkSpacesWebPortal_disappears(t *testing.T) {
+	var v workspacesweb.Portal
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_workspacesweb_portal.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, workspacesweb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPortalDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPortalConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPortalExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfworkspacesweb.ResourcePortal(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccWorkSpacesWebPortal_update(t *testing.T) {
+	var v workspacesweb.Portal
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameUpdated := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_workspacesweb_portal.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, workspacesweb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPortalDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPortalConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPortalExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "display_name", rName),
+				),
+			},
+			{
+				Config: testAccPortalConfig_updated(rNameUpdated),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPortalExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "display_name", rNameUpdated),
+					resource.TestCheckResourceAttr(resourceName, "instance_type", "standard.large"),
+					resource.TestCheckResourceAttr(resourceName, "max_concurrent_sessions", "10"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccWorkSpacesWebPortal_associations(t *testing.T) {
+	var v workspacesweb.Portal
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_workspacesweb_portal.test"
+	browserSettingsResourceName := "aws_workspacesweb_browser_settings.test"
+	userSettingsResourceName := "aws_workspacesweb_user_settings.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, workspacesweb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPortalDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPortalConfig_associations(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPortalExists(resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "browser_settings_arn", browserSettingsResourceName, "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "user_settings_arn", userSettingsResourceName, "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPortalConfig_associations(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPortalExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "browser_settings_arn", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "user_settings_arn", userSettingsResourceName, "arn"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccWorkSpacesWebPortal_tags(t *testing.T) {
+	var v workspacesweb.Portal
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_workspacesweb_portal.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, workspacesweb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPortalDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPortalConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPortalExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPortalConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPortalExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccPortalConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPortalExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPortalExists(n string, v *workspacesweb.Portal) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No WorkSpaces Web Portal ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesWebConn
+
+		output, err := tfworkspacesweb.FindPortalByARN(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckPortalDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesWebConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_workspacesweb_portal" {
+			continue
+		}
+
+		_, err := tfworkspacesweb.FindPortalByARN(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("WorkSpaces Web Portal %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccPortalConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_workspacesweb_portal" "test" {
+  display_name = %[1]q
+}
+`, rName)
+}
+
+func testAccPortalConfig_updated(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_workspacesweb_portal" "test" {
+  display_name            = %[1]q
+  instance_type           = "standard.large"
+  max_concurrent_sessions = 10
+}
+`, rName)
+}
+
+func testAccPortalConfig_associations(rName string, associateBrowserSettings bool) string {
+	return fmt.Sprintf(`
+resource "aws_workspacesweb_browser_settings" "test" {
+  browser_policy = jsonencode({
+    chromePolicies = {
+      DefaultDownloadDirectory = {
+        value = "/home/as2-streaming-user/MyFiles/TemporaryFiles1"
+      }
+    }
+  })
+}
+
+resource "aws_workspacesweb_user_settings" "test" {
+  copy_allowed     = "Enabled"
+  download_allowed = "Enabled"
+  paste_allowed    = "Enabled"
+  print_allowed    = "Enabled"
+  upload_allowed   = "Enabled"
+}
+
+resource "aws_workspacesweb_portal" "test" {
+  display_name = %[1]q
+
+  browser_settings_arn = %[2]t ? aws_workspacesweb_browser_settings.test.arn : null
+  user_settings_arn    = aws_workspacesweb_user_settings.test.arn
+}
+`, rName, associateBrowserSettings)
+}
+
+func testAccPortalConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_workspacesweb_portal" "test" {
+  display_name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccPortalConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_workspacesweb_portal" "test" {
+  display_name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,320 @@
+//go:build sweep
+// +build sweep
+
+package workspacesweb
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workspacesweb"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_workspacesweb_browser_settings", &resource.Sweeper{
+		Name: "aws_workspacesweb_browser_settings",
+		F:    sweepBrowserSettings,
+		Dependencies: []string{
+			"aws_workspacesweb_portal",
+		},
+	})
+
+	resource.AddTestSweepers("aws_workspacesweb_ip_access_settings", &resource.Sweeper{
+		Name: "aws_workspacesweb_ip_access_settings",
+		F:    sweepIPAccessSettings,
+		Dependencies: []string{
+			"aws_workspacesweb_portal",
+		},
+	})
+
+	resource.AddTestSweepers("aws_workspacesweb_network_settings", &resource.Sweeper{
+		Name: "aws_workspacesweb_network_settings",
+		F:    sweepNetworkSettings,
+		Dependencies: []string{
+			"aws_workspacesweb_portal",
+		},
+	})
+
+	resource.AddTestSweepers("aws_workspacesweb_portal", &resource.Sweeper{
+		Name: "aws_workspacesweb_portal",
+		F:    sweepPortals,
+	})
+
+	resource.AddTestSweepers("aws_workspacesweb_trust_store", &resource.Sweeper{
+		Name: "aws_workspacesweb_trust_store",
+		F:    sweepTrustStores,
+		Dependencies: []string{
+			"aws_workspacesweb_portal",
+		},
+	})
+
+	resource.AddTestSweepers("aws_workspacesweb_user_settings", &resource.Sweeper{
+		Name: "aws_workspacesweb_user_settings",
+		F:    sweepUserSettings,
+		Dependencies: []string{
+			"aws_workspacesweb_portal",
+		},
+	})
+}
+
+func sweepBrowserSettings(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).WorkSpacesWebConn
+	input := &workspacesweb.ListBrowserSettingsInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListBrowserSettingsPages(input, func(page *workspacesweb.ListBrowserSettingsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.BrowserSettings {
+			r := ResourceBrowserSettings()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.BrowserSettingsArn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping WorkSpaces Web Browser Settings sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing WorkSpaces Web Browser Settings (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping WorkSpaces Web Browser Settings (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepIPAccessSettings(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).WorkSpacesWebConn
+	input := &workspacesweb.ListIpAccessSettingsInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListIpAccessSettingsPages(input, func(page *workspacesweb.ListIpAccessSettingsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.IpAccessSettings {
+			r := ResourceIPAccessSettings()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.IpAccessSettingsArn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping WorkSpaces Web IP Access Settings sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing WorkSpaces Web IP Access Settings (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping WorkSpaces Web IP Access Settings (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepNetworkSettings(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).WorkSpacesWebConn
+	input := &workspacesweb.ListNetworkSettingsInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListNetworkSettingsPages(input, func(page *workspacesweb.ListNetworkSettingsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.NetworkSettings {
+			r := ResourceNetworkSettings()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.NetworkSettingsArn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping WorkSpaces Web Network Settings sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing WorkSpaces Web Network Settings (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping WorkSpaces Web Network Settings (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepPortals(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).WorkSpacesWebConn
+	input := &workspacesweb.ListPortalsInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListPortalsPages(input, func(page *workspacesweb.ListPortalsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Portals {
+			r := ResourcePortal()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.PortalArn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping WorkSpaces Web Portal sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing WorkSpaces Web Portals (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping WorkSpaces Web Portals (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepTrustStores(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).WorkSpacesWebConn
+	input := &workspacesweb.ListTrustStoresInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListTrustStoresPages(input, func(page *workspacesweb.ListTrustStoresOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.TrustStores {
+			r := ResourceTrustStore()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.TrustStoreArn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping WorkSpaces Web Trust Store sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing WorkSpaces Web Trust Stores (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping WorkSpaces Web Trust Stores (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepUserSettings(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).WorkSpacesWebConn
+	input := &workspacesweb.ListUserSettingsInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListUserSettingsPages(input, func(page *workspacesweb.ListUserSettingsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.UserSettings {
+			r := ResourceUserSettings()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.UserSettingsArn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping WorkSpaces Web User Settings sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing WorkSpaces Web User Settings (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping WorkSpaces Web User Settings (%s): %w", region, err)
+	}
+
+	return nil
+}
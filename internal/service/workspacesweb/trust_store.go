@@ -0,0 +1,338 @@
+package workspacesweb
+
+import (
+	"bytes"
+	"context"
+	"encoding/pem"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workspacesweb"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceTrustStore() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTrustStoreCreate,
+		ReadWithoutTimeout:   resourceTrustStoreRead,
+		UpdateWithoutTimeout: resourceTrustStoreUpdate,
+		DeleteWithoutTimeout: resourceTrustStoreDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"associated_portal_arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"certificate": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"issuer": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"not_valid_after": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"not_valid_before": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"subject": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"thumbprint": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"certificate_list": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceTrustStoreCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &workspacesweb.CreateTrustStoreInput{
+		CertificateList: expandTrustStoreCertificates(d.Get("certificate_list").(*schema.Set).List()),
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateTrustStoreWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating WorkSpaces Web Trust Store: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.TrustStoreArn))
+
+	return resourceTrustStoreRead(ctx, d, meta)
+}
+
+func resourceTrustStoreRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	trustStore, err := FindTrustStoreByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] WorkSpaces Web Trust Store (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading WorkSpaces Web Trust Store (%s): %s", d.Id(), err)
+	}
+
+	certificates, err := findTrustStoreCertificateBodies(ctx, conn, d.Id())
+
+	if err != nil {
+		return diag.Errorf("reading WorkSpaces Web Trust Store (%s) certificates: %s", d.Id(), err)
+	}
+
+	d.Set("arn", trustStore.TrustStoreArn)
+	d.Set("associated_portal_arns", aws.StringValueSlice(trustStore.AssociatedPortalArns))
+	if err := d.Set("certificate", flattenTrustStoreCertificates(certificates)); err != nil {
+		return diag.Errorf("setting certificate: %s", err)
+	}
+	if err := d.Set("certificate_list", trustStoreCertificateListToSet(d.Get("certificate_list").(*schema.Set).List(), certificates)); err != nil {
+		return diag.Errorf("setting certificate_list: %s", err)
+	}
+
+	tags, err := ListTagsWithContext(ctx, conn, d.Id())
+
+	if err != nil {
+		return diag.Errorf("listing tags for WorkSpaces Web Trust Store (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceTrustStoreUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn
+
+	if d.HasChange("certificate_list") {
+		o, n := d.GetChange("certificate_list")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		input := &workspacesweb.UpdateTrustStoreInput{
+			TrustStoreArn: aws.String(d.Id()),
+		}
+
+		if add := ns.Difference(os).List(); len(add) > 0 {
+			input.CertificatesToAdd = expandTrustStoreCertificates(add)
+		}
+
+		if del := os.Difference(ns).List(); len(del) > 0 {
+			// Certificates are removed by thumbprint, so match the removed certificates
+			// against the certificates currently in the trust store.
+			certificates, err := findTrustStoreCertificateBodies(ctx, conn, d.Id())
+
+			if err != nil {
+				return diag.Errorf("reading WorkSpaces Web Trust Store (%s) certificates: %s", d.Id(), err)
+			}
+
+			for _, v := range del {
+				der := trustStoreCertificateDER([]byte(v.(string)))
+
+				for _, certificate := range certificates {
+					if bytes.Equal(der, certificate.der) {
+						input.CertificatesToDelete = append(input.CertificatesToDelete, certificate.apiObject.Thumbprint)
+					}
+				}
+			}
+		}
+
+		if len(input.CertificatesToAdd) > 0 || len(input.CertificatesToDelete) > 0 {
+			_, err := conn.UpdateTrustStoreWithContext(ctx, input)
+
+			if err != nil {
+				return diag.Errorf("updating WorkSpaces Web Trust Store (%s): %s", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+		if err := UpdateTagsWithContext(ctx, conn, d.Id(), o, n); err != nil {
+			return diag.Errorf("updating WorkSpaces Web Trust Store (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceTrustStoreRead(ctx, d, meta)
+}
+
+func resourceTrustStoreDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn
+
+	log.Printf("[DEBUG] Deleting WorkSpaces Web Trust Store: %s", d.Id())
+	_, err := conn.DeleteTrustStoreWithContext(ctx, &workspacesweb.DeleteTrustStoreInput{
+		TrustStoreArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, workspacesweb.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting WorkSpaces Web Trust Store (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+type trustStoreCertificate struct {
+	apiObject *workspacesweb.Certificate
+	der       []byte
+}
+
+func findTrustStoreCertificateBodies(ctx context.Context, conn *workspacesweb.WorkSpacesWeb, arn string) ([]*trustStoreCertificate, error) {
+	summaries, err := FindTrustStoreCertificatesByARN(ctx, conn, arn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var certificates []*trustStoreCertificate
+
+	for _, summary := range summaries {
+		certificate, err := FindTrustStoreCertificateByTwoPartKey(ctx, conn, arn, aws.StringValue(summary.Thumbprint))
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		certificates = append(certificates, &trustStoreCertificate{
+			apiObject: certificate,
+			der:       trustStoreCertificateDER(certificate.Body),
+		})
+	}
+
+	return certificates, nil
+}
+
+// trustStoreCertificateDER returns the DER encoding of a PEM or DER encoded certificate.
+func trustStoreCertificateDER(b []byte) []byte {
+	if block, _ := pem.Decode(bytes.TrimSpace(b)); block != nil {
+		return block.Bytes
+	}
+
+	return b
+}
+
+// trustStoreCertificateListToSet returns the certificates in the trust store, keeping the
+// configured encoding of the certificates that are already known.
+func trustStoreCertificateListToSet(configured []interface{}, certificates []*trustStoreCertificate) []string {
+	var certificateList []string
+
+	for _, certificate := range certificates {
+		var found bool
+
+		for _, v := range configured {
+			if bytes.Equal(trustStoreCertificateDER([]byte(v.(string))), certificate.der) {
+				certificateList = append(certificateList, v.(string))
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			certificateList = append(certificateList, string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certificate.der})))
+		}
+	}
+
+	return certificateList
+}
+
+func expandTrustStoreCertificates(tfList []interface{}) [][]byte {
+	var apiObjects [][]byte
+
+	for _, v := range flex.ExpandStringValueList(tfList) {
+		apiObjects = append(apiObjects, []byte(v))
+	}
+
+	return apiObjects
+}
+
+func flattenTrustStoreCertificates(certificates []*trustStoreCertificate) []interface{} {
+	if len(certificates) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, certificate := range certificates {
+		apiObject := certificate.apiObject
+		tfMap := map[string]interface{}{
+			"issuer":     aws.StringValue(apiObject.Issuer),
+			"subject":    aws.StringValue(apiObject.Subject),
+			"thumbprint": aws.StringValue(apiObject.Thumbprint),
+		}
+
+		if v := apiObject.NotValidAfter; v != nil {
+			tfMap["not_valid_after"] = aws.TimeValue(v).Format(time.RFC3339)
+		}
+
+		if v := apiObject.NotValidBefore; v != nil {
+			tfMap["not_valid_before"] = aws.TimeValue(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
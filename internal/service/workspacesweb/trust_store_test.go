@@ -0,0 +1,254 @@
+package workspacesweb_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/workspacesweb"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfworkspacesweb "github.com/hashicorp/terraform-provider-aws/internal/service/workspacesweb"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccWorkSpacesWebTrustStore_basic(t *testing.T) {
+	var v workspacesweb.TrustStore
+	resourceName := "aws_workspacesweb_trust_store.test"
+	key := acctest.TLSRSAPrivateKeyPEM(2048)
+	certificate := acctest.TLSRSAX509SelfSignedCertificatePEM(key, "example.com")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, workspacesweb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTrustStoreDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTrustStoreConfig_basic(certificate),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrustStoreExists(resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "workspaces-web", regexp.MustCompile(`trustStore/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "associated_portal_arns.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "certificate.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "certificate.0.thumbprint"),
+					resource.TestCheckResourceAttr(resourceName, "certificate_list.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccWorkSpacesWebTrustStore_disappears(t *testing.T) {
+	var v workspacesweb.TrustStore
+	resourceName := "aws_workspacesweb_trust_store.test"
+	key := acctest.TLSRSAPrivateKeyPEM(2048)
+	certificate := acctest.TLSRSAX509SelfSignedCertificatePEM(key, "example.com")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, workspacesweb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTrustStoreDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTrustStoreConfig_basic(certificate),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrustStoreExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfworkspacesweb.ResourceTrustStore(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccWorkSpacesWebTrustStore_update(t *testing.T) {
+	var v workspacesweb.TrustStore
+	resourceName := "aws_workspacesweb_trust_store.test"
+	key := acctest.TLSRSAPrivateKeyPEM(2048)
+	certificate := acctest.TLSRSAX509SelfSignedCertificatePEM(key, "example.com")
+	key2 := acctest.TLSRSAPrivateKeyPEM(2048)
+	certificate2 := acctest.TLSRSAX509SelfSignedCertificatePEM(key2, "example.org")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, workspacesweb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTrustStoreDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTrustStoreConfig_basic(certificate),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrustStoreExists(resourceName, &v),
+				),
+			},
+			{
+				Config: testAccTrustStoreConfig_updated(certificate, certificate2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrustStoreExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "certificate.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "certificate_list.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTrustStoreConfig_basic(certificate),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrustStoreExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "certificate.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "certificate_list.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccWorkSpacesWebTrustStore_tags(t *testing.T) {
+	var v workspacesweb.TrustStore
+	resourceName := "aws_workspacesweb_trust_store.test"
+	key := acctest.TLSRSAPrivateKeyPEM(2048)
+	certificate := acctest.TLSRSAX509SelfSignedCertificatePEM(key, "example.com")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, workspacesweb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTrustStoreDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTrustStoreConfig_tags1(certificate, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrustStoreExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTrustStoreConfig_tags2(certificate, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrustStoreExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccTrustStoreConfig_tags1(certificate, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrustStoreExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTrustStoreExists(n string, v *workspacesweb.TrustStore) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No WorkSpaces Web Trust Store ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesWebConn
+
+		output, err := tfworkspacesweb.FindTrustStoreByARN(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckTrustStoreDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesWebConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_workspacesweb_trust_store" {
+			continue
+		}
+
+		_, err := tfworkspacesweb.FindTrustStoreByARN(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("WorkSpaces Web Trust Store %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccTrustStoreConfig_basic(certificate1 string) string {
+	return fmt.Sprintf(`
+resource "aws_workspacesweb_trust_store" "test" {
+  certificate_list = ["%[1]s"]
+}
+`, acctest.TLSPEMEscapeNewlines(certificate1))
+}
+
+func testAccTrustStoreConfig_updated(certificate1, certificate2 string) string {
+	return fmt.Sprintf(`
+resource "aws_workspacesweb_trust_store" "test" {
+  certificate_list = ["%[1]s", "%[2]s"]
+}
+`, acctest.TLSPEMEscapeNewlines(certificate1), acctest.TLSPEMEscapeNewlines(certificate2))
+}
+
+func testAccTrustStoreConfig_tags1(certificate1, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_workspacesweb_trust_store" "test" {
+  certificate_list = ["%[1]s"]
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, acctest.TLSPEMEscapeNewlines(certificate1), tagKey1, tagValue1)
+}
+
+func testAccTrustStoreConfig_tags2(certificate1, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_workspacesweb_trust_store" "test" {
+  certificate_list = ["%[1]s"]
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, acctest.TLSPEMEscapeNewlines(certificate1), tagKey1, tagValue1, tagKey2, tagValue2)
+}
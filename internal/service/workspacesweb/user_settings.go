@@ -0,0 +1,413 @@
+package workspacesweb
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workspacesweb"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceUserSettings() *schema.Resource {
+	cookieSpecificationSchema := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"domain": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"path": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceUserSettingsCreate,
+		ReadWithoutTimeout:   resourceUserSettingsRead,
+		UpdateWithoutTimeout: resourceUserSettingsUpdate,
+		DeleteWithoutTimeout: resourceUserSettingsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"additional_encryption_context": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"associated_portal_arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"cookie_synchronization_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allowlist": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem:     cookieSpecificationSchema,
+						},
+						"blocklist": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     cookieSpecificationSchema,
+						},
+					},
+				},
+			},
+			"copy_allowed": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(workspacesweb.EnabledType_Values(), false),
+			},
+			"customer_managed_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"deep_link_allowed": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(workspacesweb.EnabledType_Values(), false),
+			},
+			"disconnect_timeout_in_minutes": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 600),
+			},
+			"download_allowed": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(workspacesweb.EnabledType_Values(), false),
+			},
+			"idle_disconnect_timeout_in_minutes": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 60),
+			},
+			"paste_allowed": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(workspacesweb.EnabledType_Values(), false),
+			},
+			"print_allowed": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(workspacesweb.EnabledType_Values(), false),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"upload_allowed": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(workspacesweb.EnabledType_Values(), false),
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceUserSettingsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &workspacesweb.CreateUserSettingsInput{
+		CopyAllowed:     aws.String(d.Get("copy_allowed").(string)),
+		DownloadAllowed: aws.String(d.Get("download_allowed").(string)),
+		PasteAllowed:    aws.String(d.Get("paste_allowed").(string)),
+		PrintAllowed:    aws.String(d.Get("print_allowed").(string)),
+		UploadAllowed:   aws.String(d.Get("upload_allowed").(string)),
+	}
+
+	if v, ok := d.GetOk("additional_encryption_context"); ok && len(v.(map[string]interface{})) > 0 {
+		input.AdditionalEncryptionContext = flex.ExpandStringMap(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("cookie_synchronization_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.CookieSynchronizationConfiguration = expandCookieSynchronizationConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("customer_managed_key"); ok {
+		input.CustomerManagedKey = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("deep_link_allowed"); ok {
+		input.DeepLinkAllowed = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("disconnect_timeout_in_minutes"); ok {
+		input.DisconnectTimeoutInMinutes = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOkExists("idle_disconnect_timeout_in_minutes"); ok {
+		input.IdleDisconnectTimeoutInMinutes = aws.Int64(int64(v.(int)))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateUserSettingsWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating WorkSpaces Web User Settings: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.UserSettingsArn))
+
+	return resourceUserSettingsRead(ctx, d, meta)
+}
+
+func resourceUserSettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	userSettings, err := FindUserSettingsByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] WorkSpaces Web User Settings (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading WorkSpaces Web User Settings (%s): %s", d.Id(), err)
+	}
+
+	d.Set("additional_encryption_context", aws.StringValueMap(userSettings.AdditionalEncryptionContext))
+	d.Set("arn", userSettings.UserSettingsArn)
+	d.Set("associated_portal_arns", aws.StringValueSlice(userSettings.AssociatedPortalArns))
+	if userSettings.CookieSynchronizationConfiguration != nil {
+		if err := d.Set("cookie_synchronization_configuration", []interface{}{flattenCookieSynchronizationConfiguration(userSettings.CookieSynchronizationConfiguration)}); err != nil {
+			return diag.Errorf("setting cookie_synchronization_configuration: %s", err)
+		}
+	} else {
+		d.Set("cookie_synchronization_configuration", nil)
+	}
+	d.Set("copy_allowed", userSettings.CopyAllowed)
+	d.Set("customer_managed_key", userSettings.CustomerManagedKey)
+	d.Set("deep_link_allowed", userSettings.DeepLinkAllowed)
+	d.Set("disconnect_timeout_in_minutes", userSettings.DisconnectTimeoutInMinutes)
+	d.Set("download_allowed", userSettings.DownloadAllowed)
+	d.Set("idle_disconnect_timeout_in_minutes", userSettings.IdleDisconnectTimeoutInMinutes)
+	d.Set("paste_allowed", userSettings.PasteAllowed)
+	d.Set("print_allowed", userSettings.PrintAllowed)
+	d.Set("upload_allowed", userSettings.UploadAllowed)
+
+	tags, err := ListTagsWithContext(ctx, conn, d.Id())
+
+	if err != nil {
+		return diag.Errorf("listing tags for WorkSpaces Web User Settings (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceUserSettingsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &workspacesweb.UpdateUserSettingsInput{
+			UserSettingsArn: aws.String(d.Id()),
+		}
+
+		if d.HasChange("cookie_synchronization_configuration") {
+			if v, ok := d.GetOk("cookie_synchronization_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.CookieSynchronizationConfiguration = expandCookieSynchronizationConfiguration(v.([]interface{})[0].(map[string]interface{}))
+			} else {
+				input.CookieSynchronizationConfiguration = &workspacesweb.CookieSynchronizationConfiguration{
+					Allowlist: []*workspacesweb.CookieSpecification{},
+				}
+			}
+		}
+
+		if d.HasChange("copy_allowed") {
+			input.CopyAllowed = aws.String(d.Get("copy_allowed").(string))
+		}
+
+		if d.HasChange("deep_link_allowed") {
+			input.DeepLinkAllowed = aws.String(d.Get("deep_link_allowed").(string))
+		}
+
+		if d.HasChange("disconnect_timeout_in_minutes") {
+			input.DisconnectTimeoutInMinutes = aws.Int64(int64(d.Get("disconnect_timeout_in_minutes").(int)))
+		}
+
+		if d.HasChange("download_allowed") {
+			input.DownloadAllowed = aws.String(d.Get("download_allowed").(string))
+		}
+
+		if d.HasChange("idle_disconnect_timeout_in_minutes") {
+			input.IdleDisconnectTimeoutInMinutes = aws.Int64(int64(d.Get("idle_disconnect_timeout_in_minutes").(int)))
+		}
+
+		if d.HasChange("paste_allowed") {
+			input.PasteAllowed = aws.String(d.Get("paste_allowed").(string))
+		}
+
+		if d.HasChange("print_allowed") {
+			input.PrintAllowed = aws.String(d.Get("print_allowed").(string))
+		}
+
+		if d.HasChange("upload_allowed") {
+			input.UploadAllowed = aws.String(d.Get("upload_allowed").(string))
+		}
+
+		_, err := conn.UpdateUserSettingsWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating WorkSpaces Web User Settings (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+		if err := UpdateTagsWithContext(ctx, conn, d.Id(), o, n); err != nil {
+			return diag.Errorf("updating WorkSpaces Web User Settings (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceUserSettingsRead(ctx, d, meta)
+}
+
+func resourceUserSettingsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn
+
+	log.Printf("[DEBUG] Deleting WorkSpaces Web User Settings: %s", d.Id())
+	_, err := conn.DeleteUserSettingsWithContext(ctx, &workspacesweb.DeleteUserSettingsInput{
+		UserSettingsArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, workspacesweb.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting WorkSpaces Web User Settings (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandCookieSynchronizationConfiguration(tfMap map[string]interface{}) *workspacesweb.CookieSynchronizationConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &workspacesweb.CookieSynchronizationConfiguration{}
+
+	if v, ok := tfMap["allowlist"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Allowlist = expandCookieSpecifications(v)
+	}
+
+	if v, ok := tfMap["blocklist"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Blocklist = expandCookieSpecifications(v)
+	}
+
+	return apiObject
+}
+
+func expandCookieSpecifications(tfList []interface{}) []*workspacesweb.CookieSpecification {
+	var apiObjects []*workspacesweb.CookieSpecification
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &workspacesweb.CookieSpecification{
+			Domain: aws.String(tfMap["domain"].(string)),
+		}
+
+		if v, ok := tfMap["name"].(string); ok && v != "" {
+			apiObject.Name = aws.String(v)
+		}
+
+		if v, ok := tfMap["path"].(string); ok && v != "" {
+			apiObject.Path = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenCookieSynchronizationConfiguration(apiObject *workspacesweb.CookieSynchronizationConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"allowlist": flattenCookieSpecifications(apiObject.Allowlist),
+		"blocklist": flattenCookieSpecifications(apiObject.Blocklist),
+	}
+}
+
+func flattenCookieSpecifications(apiObjects []*workspacesweb.CookieSpecification) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"domain": aws.StringValue(apiObject.Domain),
+			"name":   aws.StringValue(apiObject.Name),
+			"path":   aws.StringValue(apiObject.Path),
+		})
+	}
+
+	return tfList
+}
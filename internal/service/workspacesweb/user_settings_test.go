@@ -0,0 +1,274 @@
+package workspacesweb_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/workspacesweb"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfworkspacesweb "github.com/hashicorp/terraform-provider-aws/internal/service/workspacesweb"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccWorkSpacesWebUserSettings_basic(t *testing.T) {
+	var v workspacesweb.UserSettings
+	resourceName := "aws_workspacesweb_user_settings.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, workspacesweb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUserSettingsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserSettingsConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserSettingsExists(resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "workspaces-web", regexp.MustCompile(`userSettings/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "associated_portal_arns.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "cookie_synchronization_configuration.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "copy_allowed", "Enabled"),
+					resource.TestCheckResourceAttrSet(resourceName, "disconnect_timeout_in_minutes"),
+					resource.TestCheckResourceAttr(resourceName, "download_allowed", "Enabled"),
+					resource.TestCheckResourceAttr(resourceName, "paste_allowed", "Enabled"),
+					resource.TestCheckResourceAttr(resourceName, "print_allowed", "Enabled"),
+					resource.TestCheckResourceAttr(resourceName, "upload_allowed", "Enabled"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccWorkSpacesWebUserSettings_disappears(t *testing.T) {
+	var v workspacesweb.UserSettings
+	resourceName := "aws_workspacesweb_user_settings.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, workspacesweb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUserSettingsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserSettingsConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserSettingsExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfworkspacesweb.ResourceUserSettings(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccWorkSpacesWebUserSettings_update(t *testing.T) {
+	var v workspacesweb.UserSettings
+	resourceName := "aws_workspacesweb_user_settings.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, workspacesweb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUserSettingsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserSettingsConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserSettingsExists(resourceName, &v),
+				),
+			},
+			{
+				Config: testAccUserSettingsConfig_updated(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserSettingsExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "cookie_synchronization_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "cookie_synchronization_configuration.0.allowlist.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "cookie_synchronization_configuration.0.blocklist.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "copy_allowed", "Disabled"),
+					resource.TestCheckResourceAttr(resourceName, "disconnect_timeout_in_minutes", "120"),
+					resource.TestCheckResourceAttr(resourceName, "idle_disconnect_timeout_in_minutes", "30"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccWorkSpacesWebUserSettings_tags(t *testing.T) {
+	var v workspacesweb.UserSettings
+	resourceName := "aws_workspacesweb_user_settings.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, workspacesweb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUserSettingsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserSettingsConfig_tags1("key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserSettingsExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccUserSettingsConfig_tags2("key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserSettingsExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccUserSettingsConfig_tags1("key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserSettingsExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckUserSettingsExists(n string, v *workspacesweb.UserSettings) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No WorkSpaces Web User Settings ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesWebConn
+
+		output, err := tfworkspacesweb.FindUserSettingsByARN(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckUserSettingsDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesWebConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_workspacesweb_user_settings" {
+			continue
+		}
+
+		_, err := tfworkspacesweb.FindUserSettingsByARN(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("WorkSpaces Web User Settings %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccUserSettingsConfig_basic() string {
+	return `
+resource "aws_workspacesweb_user_settings" "test" {
+  copy_allowed     = "Enabled"
+  download_allowed = "Enabled"
+  paste_allowed    = "Enabled"
+  print_allowed    = "Enabled"
+  upload_allowed   = "Enabled"
+}
+`
+}
+
+func testAccUserSettingsConfig_updated() string {
+	return `
+resource "aws_workspacesweb_user_settings" "test" {
+  copy_allowed                       = "Disabled"
+  disconnect_timeout_in_minutes      = 120
+  download_allowed                   = "Disabled"
+  idle_disconnect_timeout_in_minutes = 30
+  paste_allowed                      = "Enabled"
+  print_allowed                      = "Disabled"
+  upload_allowed                     = "Enabled"
+
+  cookie_synchronization_configuration {
+    allowlist {
+      domain = "example.com"
+      path   = "/"
+    }
+
+    blocklist {
+      domain = "example.com"
+      name   = "session"
+    }
+  }
+}
+`
+}
+
+func testAccUserSettingsConfig_tags1(tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_workspacesweb_user_settings" "test" {
+  copy_allowed     = "Enabled"
+  download_allowed = "Enabled"
+  paste_allowed    = "Enabled"
+  print_allowed    = "Enabled"
+  upload_allowed   = "Enabled"
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1)
+}
+
+func testAccUserSettingsConfig_tags2(tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_workspacesweb_user_settings" "test" {
+  copy_allowed     = "Enabled"
+  download_allowed = "Enabled"
+  paste_allowed    = "Enabled"
+  print_allowed    = "Enabled"
+  upload_allowed   = "Enabled"
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,47 @@
+package xray
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/xray"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindResourcePolicyByName(ctx context.Context, conn *xray.XRay, name string) (*xray.ResourcePolicy, error) {
+	input := &xray.ListResourcePoliciesInput{}
+	var output *xray.ResourcePolicy
+
+	err := conn.ListResourcePoliciesPagesWithContext(ctx, input, func(page *xray.ListResourcePoliciesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ResourcePolicies {
+			if v != nil && aws.StringValue(v.PolicyName) == name {
+				output = v
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	if output.PolicyDocument == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
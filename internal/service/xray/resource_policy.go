@@ -0,0 +1,177 @@
+package xray
+
+import (
+	"context"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/xray"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceResourcePolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceResourcePolicyCreate,
+		ReadWithoutTimeout:   resourceResourcePolicyRead,
+		UpdateWithoutTimeout: resourceResourcePolicyUpdate,
+		DeleteWithoutTimeout: resourceResourcePolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bypass_policy_lockout_check": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"last_updated_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"policy_document": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"policy_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 128),
+					validation.StringMatch(regexp.MustCompile(`^[\w+=,.@-]+$`), "must contain only alphanumeric characters and +=,.@-_"),
+				),
+			},
+			"policy_revision_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceResourcePolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).XRayConn
+
+	policy, err := structure.NormalizeJsonString(d.Get("policy_document").(string))
+
+	if err != nil {
+		return diag.Errorf("policy (%s) is invalid JSON: %s", d.Get("policy_document").(string), err)
+	}
+
+	name := d.Get("policy_name").(string)
+	input := &xray.PutResourcePolicyInput{
+		BypassPolicyLockoutCheck: aws.Bool(d.Get("bypass_policy_lockout_check").(bool)),
+		PolicyDocument:           aws.String(policy),
+		PolicyName:               aws.String(name),
+	}
+
+	log.Printf("[DEBUG] Creating XRay Resource Policy: %s", input)
+	_, err = conn.PutResourcePolicyWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating XRay Resource Policy (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceResourcePolicyRead(ctx, d, meta)
+}
+
+func resourceResourcePolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).XRayConn
+
+	policy, err := FindResourcePolicyByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] XRay Resource Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading XRay Resource Policy (%s): %s", d.Id(), err)
+	}
+
+	if v := policy.LastUpdatedTime; v != nil {
+		d.Set("last_updated_time", aws.TimeValue(v).Format(time.RFC3339))
+	} else {
+		d.Set("last_updated_time", nil)
+	}
+
+	policyToSet, err := verify.PolicyToSet(d.Get("policy_document").(string), aws.StringValue(policy.PolicyDocument))
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("policy_document", policyToSet)
+	d.Set("policy_name", policy.PolicyName)
+	d.Set("policy_revision_id", policy.PolicyRevisionId)
+
+	return nil
+}
+
+func resourceResourcePolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).XRayConn
+
+	if d.HasChange("policy_document") {
+		policy, err := structure.NormalizeJsonString(d.Get("policy_document").(string))
+
+		if err != nil {
+			return diag.Errorf("policy (%s) is invalid JSON: %s", d.Get("policy_document").(string), err)
+		}
+
+		input := &xray.PutResourcePolicyInput{
+			BypassPolicyLockoutCheck: aws.Bool(d.Get("bypass_policy_lockout_check").(bool)),
+			PolicyDocument:           aws.String(policy),
+			PolicyName:               aws.String(d.Id()),
+			PolicyRevisionId:         aws.String(d.Get("policy_revision_id").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating XRay Resource Policy: %s", input)
+		_, err = conn.PutResourcePolicyWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating XRay Resource Policy (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceResourcePolicyRead(ctx, d, meta)
+}
+
+func resourceResourcePolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).XRayConn
+
+	log.Printf("[INFO] Deleting XRay Resource Policy: %s", d.Id())
+	_, err := conn.DeleteResourcePolicyWithContext(ctx, &xray.DeleteResourcePolicyInput{
+		PolicyName:       aws.String(d.Id()),
+		PolicyRevisionId: aws.String(d.Get("policy_revision_id").(string)),
+	})
+
+	if tfawserr.ErrCodeEquals(err, xray.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting XRay Resource Policy (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -17,16 +17,22 @@ import (
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/apigatewayv2"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/appconfig"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/applicationinsights"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/applicationsignals"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/appmesh"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/apprunner"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/appstream"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/appsync"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/athena"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/auditmanager"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/autoscaling"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/autoscalingplans"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/b2bi"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/backup"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/batch"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/budgets"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/chatbot"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/cleanrooms"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/cleanroomsml"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/cloud9"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/cloudformation"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/cloudfront"
@@ -41,9 +47,11 @@ import (
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/cognitoidp"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/configservice"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/connect"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/controltower"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/cur"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/dataexchange"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/datasync"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/datazone"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/dax"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/deploy"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/devicefarm"
@@ -51,6 +59,7 @@ import (
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/dlm"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/dms"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/docdb"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/drs"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/ds"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/dynamodb"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
@@ -67,6 +76,7 @@ import (
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/emr"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/emrcontainers"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/emrserverless"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/entityresolution"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/events"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/firehose"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/fis"
@@ -78,7 +88,10 @@ import (
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/guardduty"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/imagebuilder"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/inspector2"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/iot"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/ivs"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/ivschat"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/kafka"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/kafkaconnect"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/keyspaces"
@@ -92,20 +105,27 @@ import (
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/lightsail"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/location"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/logs"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/mailmanager"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/mediapackagev2"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/memorydb"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/mq"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/mwaa"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/neptune"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/networkfirewall"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/networkmanager"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/oam"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/opensearch"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/opsworks"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/paymentcryptography"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/pcaconnectorad"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/pcaconnectorscep"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/pinpoint"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/qldb"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/quicksight"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/rds"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/redshift"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/redshiftserverless"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/resiliencehub"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/route53"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/route53recoverycontrolconfig"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/route53resolver"
@@ -131,6 +151,7 @@ import (
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/wafregional"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/wafv2"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/workspaces"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/workspacesweb"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
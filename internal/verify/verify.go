@@ -1,8 +1,11 @@
 package verify
 
 import (
+	"reflect"
+
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"gopkg.in/yaml.v2"
 )
 
@@ -35,6 +38,26 @@ func checkYAMLString(yamlString interface{}) (string, error) {
 	return s, err
 }
 
+// YAMLBytesEqual returns whether two YAML documents are semantically equal.
+// Documents that fail to parse are never equal.
+func YAMLBytesEqual(b1, b2 []byte) bool {
+	var o1 interface{}
+	if err := yaml.Unmarshal(b1, &o1); err != nil {
+		return false
+	}
+
+	var o2 interface{}
+	if err := yaml.Unmarshal(b2, &o2); err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(o1, o2)
+}
+
+func SuppressEquivalentYAMLDiffs(k, old, new string, d *schema.ResourceData) bool {
+	return YAMLBytesEqual([]byte(old), []byte(new))
+}
+
 const (
 	ErrCodeAccessDenied                = "AccessDenied"
 	ErrCodeAuthorizationError          = "AuthorizationError"
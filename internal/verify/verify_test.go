@@ -39,3 +39,64 @@ abc:
 		t.Fatalf("Got:\n\n%s\n\nExpected:\n\n%s\n", actual, invalidYaml)
 	}
 }
+
+func TestYAMLBytesEqual(t *testing.T) {
+	testCases := []struct {
+		description string
+		equal       bool
+		b1          string
+		b2          string
+	}{
+		{
+			description: "identical",
+			equal:       true,
+			b1:          "a: 1\nb: [x, y]\n",
+			b2:          "a: 1\nb: [x, y]\n",
+		},
+		{
+			description: "indentation, quoting and key order",
+			equal:       true,
+			b1: `groups:
+- name: test
+  rules:
+  - record: metric:recording_rule
+    expr: avg(rate(container_cpu_usage_seconds_total[5m]))
+`,
+			b2: `---
+groups:
+    -   rules:
+            -   expr: "avg(rate(container_cpu_usage_seconds_total[5m]))"
+                record: 'metric:recording_rule'
+        name: test
+`,
+		},
+		{
+			description: "value change",
+			equal:       false,
+			b1:          "a: 1\n",
+			b2:          "a: 2\n",
+		},
+		{
+			description: "list order change",
+			equal:       false,
+			b1:          "a: [x, y]\n",
+			b2:          "a: [y, x]\n",
+		},
+		{
+			description: "invalid",
+			equal:       false,
+			b1:          "a: [",
+			b2:          "a: [",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.description, func(t *testing.T) {
+			if got, want := YAMLBytesEqual([]byte(testCase.b1), []byte(testCase.b2)), testCase.equal; got != want {
+				t.Errorf("YAMLBytesEqual(%q, %q) = %t, want %t", testCase.b1, testCase.b2, got, want)
+			}
+		})
+	}
+}
@@ -10,7 +10,6 @@ const (
 	APIGatewayV2                 = "apigatewayv2"
 	AccessAnalyzer               = "accessanalyzer"
 	Account                      = "account"
-	AlexaForBusiness             = "alexaforbusiness"
 	Amplify                      = "amplify"
 	AmplifyBackend               = "amplifybackend"
 	AmplifyUIBuilder             = "amplifyuibuilder"
@@ -25,10 +24,12 @@ const (
 	AppSync                      = "appsync"
 	ApplicationCostProfiler      = "applicationcostprofiler"
 	ApplicationInsights          = "applicationinsights"
+	ApplicationSignals           = "applicationsignals"
 	Athena                       = "athena"
 	AuditManager                 = "auditmanager"
 	AutoScaling                  = "autoscaling"
 	AutoScalingPlans             = "autoscalingplans"
+	B2BI                         = "b2bi"
 	Backup                       = "backup"
 	BackupGateway                = "backupgateway"
 	Batch                        = "batch"
@@ -37,10 +38,13 @@ const (
 	Budgets                      = "budgets"
 	CE                           = "ce"
 	CUR                          = "cur"
+	Chatbot                      = "chatbot"
 	Chime                        = "chime"
 	ChimeSDKIdentity             = "chimesdkidentity"
 	ChimeSDKMeetings             = "chimesdkmeetings"
 	ChimeSDKMessaging            = "chimesdkmessaging"
+	CleanRooms                   = "cleanrooms"
+	CleanRoomsML                 = "cleanroomsml"
 	Cloud9                       = "cloud9"
 	CloudControl                 = "cloudcontrol"
 	CloudDirectory               = "clouddirectory"
@@ -70,6 +74,7 @@ const (
 	Connect                      = "connect"
 	ConnectContactLens           = "connectcontactlens"
 	ConnectParticipant           = "connectparticipant"
+	ControlTower                 = "controltower"
 	CustomerProfiles             = "customerprofiles"
 	DAX                          = "dax"
 	DLM                          = "dlm"
@@ -80,6 +85,7 @@ const (
 	DataExchange                 = "dataexchange"
 	DataPipeline                 = "datapipeline"
 	DataSync                     = "datasync"
+	DataZone                     = "datazone"
 	Deploy                       = "deploy"
 	Detective                    = "detective"
 	DevOpsGuru                   = "devopsguru"
@@ -107,6 +113,7 @@ const (
 	ElasticInference             = "elasticinference"
 	ElasticTranscoder            = "elastictranscoder"
 	Elasticsearch                = "elasticsearch"
+	EntityResolution             = "entityresolution"
 	Events                       = "events"
 	Evidently                    = "evidently"
 	FIS                          = "fis"
@@ -129,9 +136,10 @@ const (
 	GuardDuty                    = "guardduty"
 	Health                       = "health"
 	HealthLake                   = "healthlake"
-	Honeycode                    = "honeycode"
 	IAM                          = "iam"
 	IVS                          = "ivs"
+	IVSChat                      = "ivschat"
+	IVSRealTime                  = "ivsrealtime"
 	IdentityStore                = "identitystore"
 	ImageBuilder                 = "imagebuilder"
 	Inspector                    = "inspector"
@@ -180,8 +188,8 @@ const (
 	MTurk                        = "mturk"
 	MWAA                         = "mwaa"
 	MachineLearning              = "machinelearning"
-	Macie                        = "macie"
 	Macie2                       = "macie2"
+	MailManager                  = "mailmanager"
 	ManagedBlockchain            = "managedblockchain"
 	MarketplaceCatalog           = "marketplacecatalog"
 	MarketplaceCommerceAnalytics = "marketplacecommerceanalytics"
@@ -191,6 +199,7 @@ const (
 	MediaConvert                 = "mediaconvert"
 	MediaLive                    = "medialive"
 	MediaPackage                 = "mediapackage"
+	MediaPackageV2               = "mediapackagev2"
 	MediaPackageVOD              = "mediapackagevod"
 	MediaStore                   = "mediastore"
 	MediaStoreData               = "mediastoredata"
@@ -201,18 +210,21 @@ const (
 	MigrationHubConfig           = "migrationhubconfig"
 	MigrationHubRefactorSpaces   = "migrationhubrefactorspaces"
 	MigrationHubStrategy         = "migrationhubstrategy"
-	Mobile                       = "mobile"
 	Neptune                      = "neptune"
 	NetworkFirewall              = "networkfirewall"
 	NetworkManager               = "networkmanager"
 	Nimble                       = "nimble"
+	ObservabilityAccessManager   = "oam"
 	OpenSearch                   = "opensearch"
 	OpsWorks                     = "opsworks"
 	OpsWorksCM                   = "opsworkscm"
 	Organizations                = "organizations"
 	Outposts                     = "outposts"
+	PCAConnectorAD               = "pcaconnectorad"
+	PCAConnectorSCEP             = "pcaconnectorscep"
 	PI                           = "pi"
 	Panorama                     = "panorama"
+	PaymentCryptography          = "paymentcryptography"
 	Personalize                  = "personalize"
 	PersonalizeEvents            = "personalizeevents"
 	PersonalizeRuntime           = "personalizeruntime"
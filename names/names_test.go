@@ -126,7 +126,7 @@ func TestServicesForDirectories(t *testing.T) {
 		"iotthingsgraph",
 		"iottwinmaker",
 		"iotwireless",
-		"ivs",
+		"ivsrealtime",
 		"kendra",
 		"kinesisvideoarchivedmedia",
 		"kinesisvideomedia",